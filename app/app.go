@@ -288,12 +288,13 @@ type App struct {
 	ScopedICQKeeper      capabilitykeeper.ScopedKeeper
 	ScopedOracleKeeper   capabilitykeeper.ScopedKeeper
 
-	TransferStack       *ibchooks.IBCMiddleware
-	Ics20WasmHooks      *ibchooks.WasmHooks
-	Ics20MarkerHooks    *ibchooks.MarkerHooks
-	IbcHooks            *ibchooks.IbcHooks
-	HooksICS4Wrapper    ibchooks.ICS4Middleware
-	RateLimitMiddleware porttypes.Middleware
+	TransferStack           *ibchooks.IBCMiddleware
+	Ics20WasmHooks          *ibchooks.WasmHooks
+	Ics20MarkerHooks        *ibchooks.MarkerHooks
+	Ics20PacketForwardHooks *ibchooks.PacketForwardHooks
+	IbcHooks                *ibchooks.IbcHooks
+	HooksICS4Wrapper        ibchooks.ICS4Middleware
+	RateLimitMiddleware     porttypes.Middleware
 
 	// the module manager
 	mm                 *module.Manager
@@ -490,6 +491,7 @@ func New(
 		appCodec, keys[msgfeestypes.StoreKey], authtypes.FeeCollectorName,
 		pioconfig.GetProvenanceConfig().FeeDenom, app.SimulateProv,
 		app.txConfig.TxDecoder(), interfaceRegistry,
+		&app.AttributeKeeper, // The attribute keeper needs to be set later
 	)
 
 	pioMsgFeesRouter := app.MsgServiceRouter().(*piohandlers.PioMsgServiceRouter)
@@ -524,7 +526,9 @@ func New(
 	app.Ics20WasmHooks = &wasmHooks
 	markerHooks := ibchooks.NewMarkerHooks(nil)
 	app.Ics20MarkerHooks = &markerHooks
-	ibcHooks := ibchooks.NewIbcHooks(appCodec, &hooksKeeper, app.IBCKeeper, app.Ics20WasmHooks, app.Ics20MarkerHooks, nil)
+	packetForwardHooks := ibchooks.NewPacketForwardHooks(&hooksKeeper, nil, app.BankKeeper, addrPrefix) // The transfer keeper needs to be set later
+	app.Ics20PacketForwardHooks = &packetForwardHooks
+	ibcHooks := ibchooks.NewIbcHooks(appCodec, &hooksKeeper, app.IBCKeeper, app.Ics20WasmHooks, app.Ics20MarkerHooks, app.Ics20PacketForwardHooks, nil)
 	app.IbcHooks = &ibcHooks
 
 	app.HooksICS4Wrapper = ibchooks.NewICS4Middleware(
@@ -532,7 +536,7 @@ func New(
 		app.IbcHooks,
 	)
 
-	rateLimtingKeeper := ibcratelimitkeeper.NewKeeper(appCodec, keys[ibcratelimit.StoreKey], nil)
+	rateLimtingKeeper := ibcratelimitkeeper.NewKeeper(appCodec, keys[ibcratelimit.StoreKey], nil, app.BankKeeper)
 	app.RateLimitingKeeper = &rateLimtingKeeper
 
 	// Create Transfer Keepers
@@ -550,6 +554,7 @@ func New(
 		govAuthority,
 	)
 	app.TransferKeeper = &transferKeeper
+	app.Ics20PacketForwardHooks.TransferKeeper = app.TransferKeeper
 	transferModule := ibctransfer.NewIBCModule(*app.TransferKeeper)
 	app.RateLimitMiddleware = rateLimitingTransferModule.WithIBCModule(transferModule)
 	hooksTransferModule := ibchooks.NewIBCMiddleware(app.RateLimitMiddleware, &app.HooksICS4Wrapper)
@@ -577,14 +582,15 @@ func New(
 		markerReqAttrBypassAddrs, NewGroupCheckerFunc(app.GroupKeeper),
 	)
 
-	app.MetadataKeeper = metadatakeeper.NewKeeper(
-		appCodec, keys[metadatatypes.StoreKey], app.AccountKeeper, app.AuthzKeeper, app.AttributeKeeper, app.MarkerKeeper, app.BankKeeper,
-	)
-
 	app.HoldKeeper = holdkeeper.NewKeeper(
 		appCodec, keys[hold.StoreKey], app.BankKeeper,
 	)
 
+	app.MetadataKeeper = metadatakeeper.NewKeeper(
+		appCodec, keys[metadatatypes.StoreKey], app.AccountKeeper, app.AuthzKeeper, app.AttributeKeeper, app.MarkerKeeper, app.BankKeeper,
+		app.HoldKeeper,
+	)
+
 	app.ExchangeKeeper = exchangekeeper.NewKeeper(
 		appCodec, keys[exchange.StoreKey], authtypes.FeeCollectorName,
 		app.AccountKeeper, app.AttributeKeeper, app.BankKeeper, app.HoldKeeper, app.MarkerKeeper,
@@ -670,6 +676,7 @@ func New(
 		app.IBCKeeper.PortKeeper,
 		scopedOracleKeeper,
 		wasmkeeper.Querier(app.WasmKeeper),
+		app.ContractKeeper,
 	)
 	oracleModule := oraclemodule.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper, app.IBCKeeper.ChannelKeeper)
 
@@ -811,6 +818,8 @@ func New(
 		markertypes.ModuleName,
 		attributetypes.ModuleName,
 		authz.ModuleName,
+		hold.ModuleName,
+		metadatatypes.ModuleName,
 		triggertypes.ModuleName,
 	)
 
@@ -820,7 +829,9 @@ func New(
 		stakingtypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
+		exchange.ModuleName,
 		triggertypes.ModuleName,
+		oracletypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -62,8 +62,8 @@ func (mfd MsgFeesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 		floorGasPrice := mfd.msgFeeKeeper.GetFloorGasPrice(ctx)
 		msgs := feeTx.GetMsgs()
 
-		// Compute msg all additional fees
-		msgFeesDistribution, calcErr := mfd.msgFeeKeeper.CalculateAdditionalFeesToBePaid(ctx, msgs...)
+		// Compute msg all additional fees, less any discounts from the fee payer's attributes
+		msgFeesDistribution, calcErr := mfd.msgFeeKeeper.CalculateAdditionalFeesToBePaid(ctx, feeTx.FeePayer(), msgs...)
 		if calcErr != nil && !simulate {
 			return ctx, sdkerrors.ErrInsufficientFee.Wrap(calcErr.Error())
 		}
@@ -87,7 +87,7 @@ func (dfd ProvenanceDeductFeeDecorator) checkDeductBaseFee(ctx sdk.Context, feeT
 	// Note: The MsgFeesDecorator only checks stuff during IsCheckTx, so we need to do it here too.
 	msgs := feeTx.GetMsgs()
 	baseFeeToConsume := CalculateBaseFee(ctx, feeTx, dfd.msgFeeKeeper)
-	feeDist, err := dfd.msgFeeKeeper.CalculateAdditionalFeesToBePaid(ctx, msgs...)
+	feeDist, err := dfd.msgFeeKeeper.CalculateAdditionalFeesToBePaid(ctx, feeTx.FeePayer(), msgs...)
 	if err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -256,7 +256,7 @@ func (msr *PioMsgServiceRouter) consumeMsgFees(ctx sdk.Context, req sdk.Msg) err
 		panic(err)
 	}
 
-	feeDist, err := msr.msgFeesKeeper.CalculateAdditionalFeesToBePaid(ctx, req)
+	feeDist, err := msr.msgFeesKeeper.CalculateAdditionalFeesToBePaid(ctx, feeTx.FeePayer(), req)
 	if err != nil {
 		return err
 	}
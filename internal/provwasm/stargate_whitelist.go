@@ -207,7 +207,9 @@ func init() {
 	setWhitelistedQuery("/provenance.attribute.v1.Query/Attributes", &attributetypes.QueryAttributesResponse{})
 	setWhitelistedQuery("/provenance.attribute.v1.Query/Scan", &attributetypes.QueryScanResponse{})
 	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeAccounts", &attributetypes.QueryAttributeAccountsResponse{})
+	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeAccountsByValue", &attributetypes.QueryAttributeAccountsByValueResponse{})
 	setWhitelistedQuery("/provenance.attribute.v1.Query/AccountData", &attributetypes.QueryAccountDataResponse{})
+	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeSchema", &attributetypes.QueryAttributeSchemaResponse{})
 
 	// exchange
 	setWhitelistedQuery("/provenance.exchange.v1.Query/OrderFeeCalc", &exchange.QueryOrderFeeCalcResponse{})
@@ -233,13 +235,18 @@ func init() {
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetPaymentsWithTarget", &exchange.QueryGetPaymentsWithTargetResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAllPayments", &exchange.QueryGetAllPaymentsResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/PaymentFeeCalc", &exchange.QueryPaymentFeeCalcResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/ResolveNav", &exchange.QueryResolveNavResponse{})
 
 	// hold
 	setWhitelistedQuery("/provenance.hold.v1.Query/GetHolds", &hold.GetHoldsResponse{})
 	setWhitelistedQuery("/provenance.hold.v1.Query/GetAllHolds", &hold.GetAllHoldsResponse{})
+	setWhitelistedQuery("/provenance.hold.v1.Query/GetHoldEntry", &hold.GetHoldEntryResponse{})
+	setWhitelistedQuery("/provenance.hold.v1.Query/GetUpcomingReleases", &hold.GetUpcomingReleasesResponse{})
 
 	// ibcratelimit
 	setWhitelistedQuery("/provenance.ibcratelimit.v1.Query/Params", &ibcratelimit.ParamsResponse{})
+	setWhitelistedQuery("/provenance.ibcratelimit.v1.Query/RateLimits", &ibcratelimit.QueryRateLimitsResponse{})
+	setWhitelistedQuery("/provenance.ibcratelimit.v1.Query/RateLimit", &ibcratelimit.QueryRateLimitResponse{})
 
 	// ibchooks
 	setWhitelistedQuery("/provenance.ibchooks.v1.Query/Params", &ibchookstypes.QueryParamsResponse{})
@@ -255,6 +262,10 @@ func init() {
 	setWhitelistedQuery("/provenance.marker.v1.Query/DenomMetadata", &markertypes.QueryDenomMetadataResponse{})
 	setWhitelistedQuery("/provenance.marker.v1.Query/AccountData", &markertypes.QueryAccountDataResponse{})
 	setWhitelistedQuery("/provenance.marker.v1.Query/NetAssetValues", &markertypes.QueryNetAssetValuesResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/NetAssetValueAtHeight", &markertypes.QueryNetAssetValueAtHeightResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/NetAssetValueTWAP", &markertypes.QueryNetAssetValueTWAPResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/SendLimits", &markertypes.QuerySendLimitsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/SendLimitUsage", &markertypes.QuerySendLimitUsageResponse{})
 
 	// metadata
 	setWhitelistedQuery("/provenance.metadata.v1.Query/Params", &metadatatypes.QueryParamsResponse{})
@@ -264,10 +275,13 @@ func init() {
 	setWhitelistedQuery("/provenance.metadata.v1.Query/SessionsAll", &metadatatypes.SessionsAllResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/Records", &metadatatypes.RecordsResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/RecordsAll", &metadatatypes.RecordsAllResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/RecordHistory", &metadatatypes.RecordHistoryResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/SessionHistory", &metadatatypes.SessionHistoryResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/Ownership", &metadatatypes.OwnershipResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/ValueOwnership", &metadatatypes.ValueOwnershipResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeSpecification", &metadatatypes.ScopeSpecificationResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeSpecificationsAll", &metadatatypes.ScopeSpecificationsAllResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeSpecificationScopes", &metadatatypes.ScopeSpecificationScopesResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/ContractSpecification", &metadatatypes.ContractSpecificationResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/ContractSpecificationsAll", &metadatatypes.ContractSpecificationsAllResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/RecordSpecificationsForContractSpecification", &metadatatypes.RecordSpecificationsForContractSpecificationResponse{})
@@ -276,16 +290,22 @@ func init() {
 	setWhitelistedQuery("/provenance.metadata.v1.Query/GetByAddr", &metadatatypes.GetByAddrResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSLocatorParams", &metadatatypes.OSLocatorParamsResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSLocator", &metadatatypes.OSLocatorResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/OSLocatorKeys", &metadatatypes.OSLocatorKeysResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSLocatorsByURI", &metadatatypes.OSLocatorsByURIResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSLocatorsByScope", &metadatatypes.OSLocatorsByScopeResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/OSAllLocators", &metadatatypes.OSAllLocatorsResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/AccountData", &metadatatypes.AccountDataResponse{})
 	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeNetAssetValues", &metadatatypes.QueryScopeNetAssetValuesResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeNetAssetValueAtHeight", &metadatatypes.QueryScopeNetAssetValueAtHeightResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeNetAssetValueTWAP", &metadatatypes.QueryScopeNetAssetValueTWAPResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeOffer", &metadatatypes.ScopeOfferResponse{})
+	setWhitelistedQuery("/provenance.metadata.v1.Query/ScopeOffers", &metadatatypes.ScopeOffersResponse{})
 
 	// msg fee
 	setWhitelistedQuery("/provenance.msgfees.v1.Query/Params", &msgfeestypes.QueryParamsResponse{})
 	setWhitelistedQuery("/provenance.msgfees.v1.Query/QueryAllMsgFees", &msgfeestypes.QueryAllMsgFeesResponse{})
 	setWhitelistedQuery("/provenance.msgfees.v1.Query/CalculateTxFees", &msgfeestypes.CalculateTxFeesResponse{})
+	setWhitelistedQuery("/provenance.msgfees.v1.Query/QueryAllFeeSchedules", &msgfeestypes.QueryAllFeeSchedulesResponse{})
 
 	// name
 	setWhitelistedQuery("/provenance.name.v1.Query/Params", &nametypes.QueryParamsResponse{})
@@ -295,6 +315,8 @@ func init() {
 	// oracle
 	setWhitelistedQuery("/provenance.oracle.v1.Query/OracleAddress", &oracletypes.QueryOracleAddressResponse{})
 	setWhitelistedQuery("/provenance.oracle.v1.Query/Oracle", &oracletypes.QueryOracleResponse{})
+	setWhitelistedQuery("/provenance.oracle.v1.Query/Oracles", &oracletypes.QueryOraclesResponse{})
+	setWhitelistedQuery("/provenance.oracle.v1.Query/OracleQueryResult", &oracletypes.QueryOracleQueryResultResponse{})
 
 	// quarantine
 	setWhitelistedQuery("/cosmos.quarantine.v1beta1.Query/IsQuarantined", &quarantine.QueryIsQuarantinedResponse{})
@@ -30,7 +30,9 @@ func GetQueryCmd() *cobra.Command {
 		ListAccountAttributesCmd(),
 		ScanAccountAttributesCmd(),
 		GetAttributeAccountsCmd(),
+		GetAttributeAccountsByValueCmd(),
 		GetAccountDataCmd(),
+		GetAttributeSchemaCmd(),
 	)
 
 	return queryCmd
@@ -243,6 +245,59 @@ func GetAttributeAccountsCmd() *cobra.Command {
 	return cmd
 }
 
+// GetAttributeAccountsByValueCmd gets account addresses with an attribute name and value
+func GetAttributeAccountsByValueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accounts-by-value <name> <type> <value>",
+		Aliases: []string{"abv"},
+		Short:   "List account addresses that have an attribute with name and value",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute accounts-by-value example.provenance.io string "test value"
+				$ %[1]s query attribute accounts-by-value example.provenance.io string "test value" --page=2 --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			attributeType, err := types.AttributeTypeFromString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("account attribute type is invalid: %w", err)
+			}
+			value, err := encodeAttributeValue(strings.TrimSpace(args[2]), attributeType)
+			if err != nil {
+				return fmt.Errorf("error encoding value %s to type %s : %w", args[2], attributeType.String(), err)
+			}
+
+			req := &types.QueryAttributeAccountsByValueRequest{
+				AttributeName: strings.ToLower(strings.TrimSpace(args[0])),
+				Value:         value,
+				Pagination:    pageReq,
+			}
+			response, err := queryClient.AttributeAccountsByValue(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query accounts by value for %q: %w", req.AttributeName, err)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "accounts-by-value")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAccountDataCmd gets data for an account
 func GetAccountDataCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -273,3 +328,33 @@ func GetAccountDataCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetAttributeSchemaCmd gets the schema registered for an attribute name.
+func GetAttributeSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schema <name>",
+		Short:   "Look up the schema registered for an attribute name",
+		Example: fmt.Sprintf(`$ %[1]s query attribute schema "kyc.pb"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAttributeSchemaRequest{Name: strings.ToLower(strings.TrimSpace(args[0]))}
+
+			response, err := queryClient.AttributeSchema(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query schema for %q: %w", req.Name, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
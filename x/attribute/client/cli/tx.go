@@ -37,6 +37,8 @@ func NewTxCmd() *cobra.Command {
 		NewSetAccountDataCmd(),
 		NewUpdateAccountAttributeExpirationCmd(),
 		NewUpdateParamsCmd(),
+		NewSetAttributeSchemaCmd(),
+		NewDeleteAttributeSchemaCmd(),
 	)
 	return txCmd
 }
@@ -319,6 +321,61 @@ $ %[1]s tx attribute account-data --%s
 	return cmd
 }
 
+// NewSetAttributeSchemaCmd creates a command for registering the schema of attributes with a name.
+func NewSetAttributeSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-schema <name>",
+		Short: "Register the schema that attributes with a name must conform to",
+		Long: `Register the schema that attributes with a name must conform to, replacing any existing schema for that name.
+The name must resolve to the --from address. Existing attributes are not checked against the new schema.`,
+		Example: fmt.Sprintf(`$ %[1]s tx attribute set-schema "kyc.pb" --type json --json-schema-file kyc-schema.json --max-per-account 1
+$ %[1]s tx attribute set-schema "rating.pb" --type int --min-value 1 --max-value 5`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			schema, err := ReadAttributeSchemaFlags(cmd.Flags(), args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAttributeSchemaRequest(*schema, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	AddAttributeSchemaFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteAttributeSchemaCmd creates a command for removing the schema of attributes with a name.
+func NewDeleteAttributeSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete-schema <name>",
+		Short:   "Remove the schema of attributes with a name",
+		Example: fmt.Sprintf(`$ %s tx attribute delete-schema "kyc.pb"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteAttributeSchemaRequest(args[0], clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewUpdateParamsCmd creates a command to update the attribute module's params via governance proposal.
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
 const (
@@ -25,6 +27,23 @@ const (
 
 	// AccountDataFlagsUse is a use string for the mutually exclusive account data flags.
 	AccountDataFlagsUse = "{" + flagValueUse + "|" + flagFileUse + "|" + flagDeleteUse + "}"
+
+	// FlagType is a flag name for defining an attribute type.
+	FlagType = "type"
+	// FlagJSONSchema is a flag name for defining a JSON Schema.
+	FlagJSONSchema = "json-schema"
+	// FlagJSONSchemaFile is a flag name for defining a file containing a JSON Schema.
+	FlagJSONSchemaFile = "json-schema-file"
+	// FlagPattern is a flag name for defining a regular expression.
+	FlagPattern = "pattern"
+	// FlagMinValue is a flag name for defining a minimum value.
+	FlagMinValue = "min-value"
+	// FlagMaxValue is a flag name for defining a maximum value.
+	FlagMaxValue = "max-value"
+	// FlagMaxPerAccount is a flag name for defining the maximum number of attributes per account.
+	FlagMaxPerAccount = "max-per-account"
+	// FlagIndexValues is a flag name for enabling attribute value indexing.
+	FlagIndexValues = "index-values"
 )
 
 // AddAccountDataFlagsToCmd adds flags to a command for providing account data.
@@ -87,3 +106,66 @@ func ReadAccountDataFlags(flagSet *flag.FlagSet) (string, error) {
 	}
 	return string(bz), nil
 }
+
+// AddAttributeSchemaFlagsToCmd adds flags to a command for defining an attribute schema.
+// See also: ReadAttributeSchemaFlags
+func AddAttributeSchemaFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagType, "", "The type that the attributes must have, e.g. json (default: any type)")
+	cmd.Flags().String(FlagJSONSchema, "", "A JSON Schema that json attribute values must conform to")
+	cmd.Flags().String(FlagJSONSchemaFile, "", "A file containing a JSON Schema that json attribute values must conform to")
+	cmd.Flags().String(FlagPattern, "", "A regular expression that string and uri attribute values must match")
+	cmd.Flags().String(FlagMinValue, "", "The smallest value allowed for int and float attributes")
+	cmd.Flags().String(FlagMaxValue, "", "The largest value allowed for int and float attributes")
+	cmd.Flags().Uint32(FlagMaxPerAccount, 0, "The maximum number of attributes with the name that an account can have (default: no limit)")
+	cmd.Flags().Bool(FlagIndexValues, false, "Index attributes with the name by value so accounts can be looked up by value")
+	cmd.MarkFlagsMutuallyExclusive(FlagJSONSchema, FlagJSONSchemaFile)
+}
+
+// ReadAttributeSchemaFlags parses the attribute schema flags into a schema for the provided attribute name.
+// See also: AddAttributeSchemaFlagsToCmd
+func ReadAttributeSchemaFlags(flagSet *flag.FlagSet, name string) (*types.AttributeSchema, error) {
+	rv := &types.AttributeSchema{Name: strings.ToLower(strings.TrimSpace(name))}
+
+	attrType, err := flagSet.GetString(FlagType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagType, err)
+	}
+	if len(attrType) > 0 {
+		if rv.AttributeType, err = types.AttributeTypeFromString(strings.TrimSpace(attrType)); err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", FlagType, err)
+		}
+	}
+
+	if rv.JsonSchema, err = flagSet.GetString(FlagJSONSchema); err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagJSONSchema, err)
+	}
+	file, err := flagSet.GetString(FlagJSONSchemaFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagJSONSchemaFile, err)
+	}
+	if len(file) > 0 {
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read json schema from --%s: %w", FlagJSONSchemaFile, err)
+		}
+		rv.JsonSchema = string(bz)
+	}
+
+	if rv.StringPattern, err = flagSet.GetString(FlagPattern); err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagPattern, err)
+	}
+	if rv.MinValue, err = flagSet.GetString(FlagMinValue); err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagMinValue, err)
+	}
+	if rv.MaxValue, err = flagSet.GetString(FlagMaxValue); err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagMaxValue, err)
+	}
+	if rv.MaxPerAccount, err = flagSet.GetUint32(FlagMaxPerAccount); err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagMaxPerAccount, err)
+	}
+	if rv.IndexValues, err = flagSet.GetBool(FlagIndexValues); err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagIndexValues, err)
+	}
+
+	return rv, nil
+}
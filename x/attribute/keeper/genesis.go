@@ -16,6 +16,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	if err := data.ValidateBasic(); err != nil {
 		panic(err)
 	}
+	for _, schema := range data.Schemas {
+		if err := k.importAttributeSchema(ctx, schema); err != nil {
+			panic(err)
+		}
+	}
 	for _, attr := range data.Attributes {
 		if err := k.importAttribute(ctx, attr); err != nil {
 			panic(err)
@@ -97,5 +102,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, attrs)
+	var schemas []types.AttributeSchema
+	err := k.IterateAttributeSchemas(ctx, func(schema types.AttributeSchema) bool {
+		schemas = append(schemas, schema)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, attrs, schemas)
 }
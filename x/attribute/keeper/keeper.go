@@ -166,17 +166,22 @@ func (k Keeper) SetAttribute(
 	if !k.nameKeeper.ResolvesTo(ctx, attr.Name, owner) {
 		return fmt.Errorf("%q does not resolve to address %q", attr.Name, owner.String())
 	}
+
+	key := types.AddrAttributeKey(attr.GetAddressBytes(), attr)
+	store := ctx.KVStore(k.storeKey)
+	// Verify the attribute conforms to the name's schema (if there is one)
+	if err = k.validateAttributeSchema(ctx, attr, !store.Has(key)); err != nil {
+		return err
+	}
+
 	// Store the sanitized account attribute
 	bz, err := k.cdc.Marshal(&attr)
 	if err != nil {
 		return err
 	}
-
-	key := types.AddrAttributeKey(attr.GetAddressBytes(), attr)
-
-	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
 	k.IncAttrNameAddressLookup(ctx, attr.Name, attr.GetAddressBytes())
+	k.addAttributeValueIndex(ctx, store, attr)
 	k.addAttributeExpireLookup(store, attr)
 
 	attributeAddEvent := types.NewEventAttributeAdd(attr, owner.String())
@@ -255,6 +260,10 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 		return fmt.Errorf("%q does not resolve to address %q", updateAttribute.Name, owner.String())
 	}
 
+	if err = k.validateAttributeSchema(ctx, updateAttribute, false); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	addrBz := originalAttribute.GetAddressBytes()
 	attrKey := types.AddrAttributeKey(addrBz, originalAttribute)
@@ -272,6 +281,7 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 
 			store.Delete(attrKey)
 			k.DecAttrNameAddressLookup(ctx, attr.Name, addrBz)
+			k.deleteAttributeValueIndex(store, attr)
 			k.deleteAttributeExpireLookup(store, attr)
 
 			bz, err := k.cdc.Marshal(&updateAttribute)
@@ -281,6 +291,7 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 			updatedKey := types.AddrAttributeKey(addrBz, updateAttribute)
 			store.Set(updatedKey, bz)
 			k.IncAttrNameAddressLookup(ctx, updateAttribute.Name, updateAttribute.GetAddressBytes())
+			k.addAttributeValueIndex(ctx, store, updateAttribute)
 			k.addAttributeExpireLookup(store, updateAttribute)
 
 			attributeUpdateEvent := types.NewEventAttributeUpdate(originalAttribute, updateAttribute, owner.String())
@@ -415,6 +426,7 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 		addrBz := attr.GetAddressBytes()
 		store.Delete(types.AddrAttributeKey(addrBz, attr))
 		k.DecAttrNameAddressLookup(ctx, attr.Name, addrBz)
+		k.deleteAttributeValueIndex(store, attr)
 		k.deleteAttributeExpireLookup(store, attr)
 		if !deleteDistinct {
 			deleteEvent := types.NewEventAttributeDelete(name, addr, owner.String())
@@ -464,6 +476,7 @@ func (k Keeper) PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddres
 			k.DecAttrNameAddressLookup(ctx, name, acct)
 		}
 	}
+	clearAttributeValueIndex(store, name)
 	return nil
 }
 
@@ -523,6 +536,7 @@ func (k Keeper) importAttribute(ctx sdk.Context, attr types.Attribute) error {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
 	k.IncAttrNameAddressLookup(ctx, attr.Name, attr.GetAddressBytes())
+	k.addAttributeValueIndex(ctx, store, attr)
 	k.addAttributeExpireLookup(store, attr)
 	return nil
 }
@@ -550,6 +564,7 @@ func (k Keeper) DeleteExpiredAttributes(ctx sdk.Context, limit int) int {
 				store.Delete(attrKey)
 				// dec name to address lookup table count
 				k.DecAttrNameAddressLookup(ctx, attribute.Name, attribute.GetAddressBytes())
+				k.deleteAttributeValueIndex(store, attribute)
 
 				deleteExpirationEvent := types.NewEventAttributeExpired(attribute)
 				if err = ctx.EventManager().EmitTypedEvent(deleteExpirationEvent); err != nil {
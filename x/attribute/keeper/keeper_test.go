@@ -1279,3 +1279,114 @@ func (s *KeeperTestSuite) TestSetAccountData() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestAttributeSchema() {
+	name := "example.attribute"
+	newAttr := func(attrType types.AttributeType, value string) types.Attribute {
+		return types.Attribute{Name: name, Value: []byte(value), Address: s.user2, AttributeType: attrType}
+	}
+
+	schema := types.AttributeSchema{Name: name, AttributeType: types.AttributeType_Int, MinValue: "1", MaxValue: "9", MaxPerAccount: 2}
+	err := s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema, s.user2Addr)
+	s.Assert().EqualError(err, fmt.Sprintf("no account found for owner address %q", s.user2), "SetAttributeSchema by non-account")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	err = s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema, s.user2Addr)
+	s.Assert().EqualError(err, fmt.Sprintf("%q does not resolve to address %q", name, s.user2), "SetAttributeSchema by non-owner")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema, s.user1Addr), "SetAttributeSchema by owner")
+
+	got, err := s.app.AttributeKeeper.GetAttributeSchema(s.ctx, " Example.Attribute ")
+	s.Require().NoError(err, "GetAttributeSchema")
+	s.Assert().Equal(&schema, got, "GetAttributeSchema")
+	resp, err := s.app.AttributeKeeper.AttributeSchema(s.ctx, &types.QueryAttributeSchemaRequest{Name: "attribute"})
+	s.Require().NoError(err, "AttributeSchema query for name without schema")
+	s.Assert().Nil(resp.Schema, "AttributeSchema query for name without schema")
+
+	// Values must conform to the schema.
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(types.AttributeType_String, "3"), s.user1Addr)
+	s.Assert().EqualError(err, `attribute "example.attribute" must have type ATTRIBUTE_TYPE_INT, got ATTRIBUTE_TYPE_STRING`, "SetAttribute wrong type")
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(types.AttributeType_Int, "10"), s.user1Addr)
+	s.Assert().EqualError(err, `attribute "example.attribute" value 10 is greater than the max value 9`, "SetAttribute out of range")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(types.AttributeType_Int, "1"), s.user1Addr), "SetAttribute 1")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(types.AttributeType_Int, "2"), s.user1Addr), "SetAttribute 2")
+
+	// An account can only have so many, but an existing value can be set again, and updated.
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(types.AttributeType_Int, "3"), s.user1Addr)
+	s.Assert().EqualError(err, fmt.Sprintf(`account %s already has the maximum of 2 "example.attribute" attribute(s)`, s.user2), "SetAttribute third")
+	s.Assert().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(types.AttributeType_Int, "2"), s.user1Addr), "SetAttribute 2 again")
+	err = s.app.AttributeKeeper.UpdateAttribute(s.ctx, newAttr(types.AttributeType_Int, "2"), newAttr(types.AttributeType_Int, "0"), s.user1Addr)
+	s.Assert().EqualError(err, `attribute "example.attribute" value 0 is less than the min value 1`, "UpdateAttribute out of range")
+	s.Assert().NoError(s.app.AttributeKeeper.UpdateAttribute(s.ctx, newAttr(types.AttributeType_Int, "2"), newAttr(types.AttributeType_Int, "3"), s.user1Addr), "UpdateAttribute in range")
+
+	// Schemas are included in genesis.
+	genState := s.app.AttributeKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal([]types.AttributeSchema{schema}, genState.Schemas, "exported schemas")
+
+	// Once the schema is removed, the values are no longer restricted.
+	err = s.app.AttributeKeeper.DeleteAttributeSchema(s.ctx, name, s.user2Addr)
+	s.Assert().EqualError(err, fmt.Sprintf("%q does not resolve to address %q", name, s.user2), "DeleteAttributeSchema by non-owner")
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttributeSchema(s.ctx, " Example.Attribute ", s.user1Addr), "DeleteAttributeSchema by owner (not normalized)")
+	err = s.app.AttributeKeeper.DeleteAttributeSchema(s.ctx, name, s.user1Addr)
+	s.Assert().EqualError(err, `no schema found for attribute "example.attribute"`, "DeleteAttributeSchema again")
+	s.Assert().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(types.AttributeType_String, "anything"), s.user1Addr), "SetAttribute after schema removed")
+}
+
+func (s *KeeperTestSuite) TestAttributeValueIndex() {
+	name := "indexed.attribute"
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.user1Addr, false), "SetNameRecord")
+	newAttr := func(addr string, value string) types.Attribute {
+		return types.Attribute{Name: name, Value: []byte(value), Address: addr, AttributeType: types.AttributeType_String}
+	}
+	queryAccounts := func(value string) ([]string, error) {
+		resp, err := s.app.AttributeKeeper.AttributeAccountsByValue(s.ctx, &types.QueryAttributeAccountsByValueRequest{AttributeName: name, Value: []byte(value)})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Accounts, nil
+	}
+
+	// Attributes set before indexing is turned on are indexed when it is.
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(s.user1, "blue"), s.user1Addr), "SetAttribute user1 blue")
+	_, err := queryAccounts("blue")
+	s.Assert().EqualError(err, `rpc error: code = InvalidArgument desc = attribute "indexed.attribute" is not indexed by value`, "query before indexing")
+	schema := types.AttributeSchema{Name: name, IndexValues: true}
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema, s.user1Addr), "SetAttributeSchema indexed")
+	accts, err := queryAccounts("blue")
+	s.Require().NoError(err, "query after indexing")
+	s.Assert().Equal([]string{s.user1}, accts, "accounts with blue after indexing")
+
+	// New, updated, and deleted attributes are reflected in the index.
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(s.user2, "blue"), s.user1Addr), "SetAttribute user2 blue")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr(s.user2, "red"), s.user1Addr), "SetAttribute user2 red")
+	addrs, err := s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, name, []byte("blue"))
+	s.Require().NoError(err, "AccountsByAttributeValue blue")
+	s.Assert().ElementsMatch([]sdk.AccAddress{s.user1Addr, s.user2Addr}, addrs, "AccountsByAttributeValue blue")
+	s.Require().NoError(s.app.AttributeKeeper.UpdateAttribute(s.ctx, newAttr(s.user1, "blue"), newAttr(s.user1, "green"), s.user1Addr), "UpdateAttribute user1 blue to green")
+	accts, err = queryAccounts("blue")
+	s.Require().NoError(err, "query blue after update")
+	s.Assert().Equal([]string{s.user2}, accts, "accounts with blue after update")
+	accts, err = queryAccounts("green")
+	s.Require().NoError(err, "query green after update")
+	s.Assert().Equal([]string{s.user1}, accts, "accounts with green after update")
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user2, name, nil, s.user1Addr), "DeleteAttribute user2")
+	accts, err = queryAccounts("blue")
+	s.Require().NoError(err, "query blue after delete")
+	s.Assert().Empty(accts, "accounts with blue after delete")
+	accts, err = queryAccounts("red")
+	s.Require().NoError(err, "query red after delete")
+	s.Assert().Empty(accts, "accounts with red after delete")
+
+	// Changing only the type of an attribute leaves its value indexed.
+	bytesGreen := newAttr(s.user1, "green")
+	bytesGreen.AttributeType = types.AttributeType_Bytes
+	s.Require().NoError(s.app.AttributeKeeper.UpdateAttribute(s.ctx, newAttr(s.user1, "green"), bytesGreen, s.user1Addr), "UpdateAttribute user1 green to bytes")
+	accts, err = queryAccounts("green")
+	s.Require().NoError(err, "query green after changing its type")
+	s.Assert().Equal([]string{s.user1}, accts, "accounts with green after changing its type")
+
+	// Turning indexing off removes the index.
+	schema.IndexValues = false
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema, s.user1Addr), "SetAttributeSchema not indexed")
+	addrs, err = s.app.AttributeKeeper.AccountsByAttributeValue(s.ctx, name, []byte("green"))
+	s.Require().NoError(err, "AccountsByAttributeValue green after indexing turned off")
+	s.Assert().Empty(addrs, "AccountsByAttributeValue green after indexing turned off")
+}
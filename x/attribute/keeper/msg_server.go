@@ -272,3 +272,35 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetAttributeSchema defines a method for the owner of a name to register the schema of attributes with that name.
+func (k msgServer) SetAttributeSchema(goCtx context.Context, msg *types.MsgSetAttributeSchemaRequest) (*types.MsgSetAttributeSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.SetAttributeSchema(ctx, msg.Schema, ownerAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttributeSchemaResponse{}, nil
+}
+
+// DeleteAttributeSchema defines a method for the owner of a name to remove the schema of attributes with that name.
+func (k msgServer) DeleteAttributeSchema(goCtx context.Context, msg *types.MsgDeleteAttributeSchemaRequest) (*types.MsgDeleteAttributeSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.DeleteAttributeSchema(ctx, msg.Name, ownerAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteAttributeSchemaResponse{}, nil
+}
@@ -167,6 +167,35 @@ func (k Keeper) AttributeAccounts(c context.Context, req *types.QueryAttributeAc
 	return &types.QueryAttributeAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
 }
 
+// AttributeAccountsByValue queries for all accounts that have an attribute with a given name and value.
+func (k Keeper) AttributeAccountsByValue(c context.Context, req *types.QueryAttributeAccountsByValueRequest) (*types.QueryAttributeAccountsByValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := k.nameKeeper.Normalize(ctx, req.AttributeName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute name %q: %v", req.AttributeName, err)
+	}
+	if !k.IsValueIndexed(ctx, name) {
+		return nil, status.Errorf(codes.InvalidArgument, "attribute %q is not indexed by value", name)
+	}
+
+	accounts := make([]string, 0)
+	store := ctx.KVStore(k.storeKey)
+	valueStore := prefix.NewStore(store, types.AttributeValueIndexValuePrefix(name, req.Value))
+	pageRes, err := query.Paginate(valueStore, req.Pagination, func(key []byte, _ []byte) error {
+		addressLength := int32(key[0])
+		accounts = append(accounts, sdk.AccAddress(key[1:addressLength+1]).String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAttributeAccountsByValueResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
 // AccountData returns the accountdata for a specified account.
 func (k Keeper) AccountData(c context.Context, req *types.QueryAccountDataRequest) (*types.QueryAccountDataResponse, error) {
 	if req == nil {
@@ -184,3 +213,21 @@ func (k Keeper) AccountData(c context.Context, req *types.QueryAccountDataReques
 	}
 	return resp, nil
 }
+
+// AttributeSchema returns the schema registered for an attribute name.
+func (k Keeper) AttributeSchema(c context.Context, req *types.QueryAttributeSchemaRequest) (*types.QueryAttributeSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schema, err := k.GetAttributeSchema(ctx, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAttributeSchemaResponse{Schema: schema}, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// GetAttributeSchema gets the schema registered for the provided attribute name. Returns nil if there isn't one.
+func (k Keeper) GetAttributeSchema(ctx sdk.Context, name string) (*types.AttributeSchema, error) {
+	normalizedName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to normalize attribute name %q: %w", name, err)
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.AttributeSchemaKey(normalizedName))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var schema types.AttributeSchema
+	if err = k.cdc.Unmarshal(bz, &schema); err != nil {
+		return nil, fmt.Errorf("could not read schema for attribute %q: %w", normalizedName, err)
+	}
+	return &schema, nil
+}
+
+// SetAttributeSchema registers a schema for the attribute name it contains, replacing any existing one.
+// The attribute name must resolve to the given owner address.
+// Attributes that already exist are not checked against the new schema, but are (un)indexed by value as needed.
+func (k Keeper) SetAttributeSchema(ctx sdk.Context, schema types.AttributeSchema, owner sdk.AccAddress) error {
+	if err := schema.ValidateBasic(); err != nil {
+		return err
+	}
+	normalizedName, err := k.nameKeeper.Normalize(ctx, schema.Name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name %q: %w", schema.Name, err)
+	}
+	schema.Name = normalizedName
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address %q", owner.String())
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, schema.Name, owner) {
+		return fmt.Errorf("%q does not resolve to address %q", schema.Name, owner.String())
+	}
+
+	wasIndexed := k.IsValueIndexed(ctx, schema.Name)
+	if err = k.importAttributeSchema(ctx, schema); err != nil {
+		return err
+	}
+	switch {
+	case schema.IndexValues && !wasIndexed:
+		if err = k.indexAttributeValues(ctx, schema.Name); err != nil {
+			return err
+		}
+	case !schema.IndexValues && wasIndexed:
+		clearAttributeValueIndex(ctx.KVStore(k.storeKey), schema.Name)
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeSchemaSet(schema.Name, owner.String()))
+}
+
+// DeleteAttributeSchema removes the schema registered for the provided attribute name.
+// The attribute name must resolve to the given owner address (unless the name no longer exists).
+func (k Keeper) DeleteAttributeSchema(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	normalizedName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name %q: %w", name, err)
+	}
+	name = normalizedName
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address %q", owner.String())
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, name, owner) {
+		if k.nameKeeper.NameExists(ctx, name) {
+			return fmt.Errorf("%q does not resolve to address %q", name, owner.String())
+		}
+		// else name does not exist (anymore) so we can't enforce permission check on delete here, proceed.
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.AttributeSchemaKey(name)
+	if !store.Has(key) {
+		return fmt.Errorf("no schema found for attribute %q", name)
+	}
+	store.Delete(key)
+	clearAttributeValueIndex(store, name)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeSchemaDeleted(name, owner.String()))
+}
+
+// IterateAttributeSchemas iterates over all the registered attribute schemas, calling the provided function for each.
+// If the function returns true, iteration stops.
+func (k Keeper) IterateAttributeSchemas(ctx sdk.Context, cb func(schema types.AttributeSchema) bool) error {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AttributeSchemaKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schema types.AttributeSchema
+		if err := k.cdc.Unmarshal(iter.Value(), &schema); err != nil {
+			return err
+		}
+		if cb(schema) {
+			break
+		}
+	}
+	return nil
+}
+
+// importAttributeSchema stores an attribute schema without owner checks.
+func (k Keeper) importAttributeSchema(ctx sdk.Context, schema types.AttributeSchema) error {
+	if err := schema.ValidateBasic(); err != nil {
+		return err
+	}
+	nameOrig := schema.Name
+	var err error
+	if schema.Name, err = k.nameKeeper.Normalize(ctx, schema.Name); err != nil {
+		return fmt.Errorf("unable to normalize attribute name %q: %w", nameOrig, err)
+	}
+	bz, err := k.cdc.Marshal(&schema)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.AttributeSchemaKey(schema.Name), bz)
+	return nil
+}
+
+// validateAttributeSchema returns an error if the provided attribute does not conform to the schema registered for
+// its name. If isNew is true, the number of attributes the account already has with that name is also checked.
+// The attribute's name must already be normalized.
+func (k Keeper) validateAttributeSchema(ctx sdk.Context, attr types.Attribute, isNew bool) error {
+	schema, err := k.GetAttributeSchema(ctx, attr.Name)
+	if err != nil || schema == nil {
+		return err
+	}
+	if err = schema.ValidateAttribute(attr); err != nil {
+		return err
+	}
+	if !isNew || schema.MaxPerAccount == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	count := uint32(len(k.getAddrAttributesKeysByName(store, attr.GetAddressBytes(), attr.Name)))
+	if count >= schema.MaxPerAccount {
+		return fmt.Errorf("account %s already has the maximum of %d %q attribute(s)", attr.Address, schema.MaxPerAccount, attr.Name)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// IsValueIndexed returns true if attributes with the provided name are indexed by value.
+func (k Keeper) IsValueIndexed(ctx sdk.Context, name string) bool {
+	schema, err := k.GetAttributeSchema(ctx, name)
+	return err == nil && schema != nil && schema.IndexValues
+}
+
+// AccountsByAttributeValue returns the addresses of all accounts that have an attribute with the provided name and value.
+// Only attribute names that are indexed by value can be looked up this way.
+func (k Keeper) AccountsByAttributeValue(ctx sdk.Context, name string, value []byte) (addresses []sdk.AccAddress, err error) {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := types.AttributeValueIndexValuePrefix(name, value)
+	it := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		addresses = append(addresses, sdk.AccAddress(it.Key()[len(keyPrefix)+1:]))
+	}
+	return
+}
+
+// addAttributeValueIndex adds the value index entry for an attribute if its name is indexed by value, else no-op.
+func (k Keeper) addAttributeValueIndex(ctx sdk.Context, store storetypes.KVStore, attr types.Attribute) {
+	if k.IsValueIndexed(ctx, attr.Name) {
+		store.Set(types.AttributeValueIndexKey(attr), []byte{})
+	}
+}
+
+// deleteAttributeValueIndex removes the value index entry for an attribute, if there is one, unless the account still
+// has an attribute with the same name and value. The attribute must have already been removed from the store.
+func (k Keeper) deleteAttributeValueIndex(store storetypes.KVStore, attr types.Attribute) {
+	it := storetypes.KVStorePrefixIterator(store, types.AddrAttributesNameKeyPrefix(attr.GetAddressBytes(), attr.Name))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var other types.Attribute
+		if err := k.cdc.Unmarshal(it.Value(), &other); err == nil && other.Name == attr.Name && bytes.Equal(other.Value, attr.Value) {
+			return
+		}
+	}
+	store.Delete(types.AttributeValueIndexKey(attr))
+}
+
+// indexAttributeValues adds value index entries for all existing attributes with the provided name.
+func (k Keeper) indexAttributeValues(ctx sdk.Context, name string) error {
+	accts, err := k.AccountsByAttribute(ctx, name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, acct := range accts {
+		attrs, err := k.prefixScan(ctx, types.AddrAttributesNameKeyPrefix(acct, name), func(string) bool { return true })
+		if err != nil {
+			return err
+		}
+		for _, attr := range attrs {
+			store.Set(types.AttributeValueIndexKey(attr), []byte{})
+		}
+	}
+	return nil
+}
+
+// clearAttributeValueIndex removes all value index entries for the provided attribute name.
+func clearAttributeValueIndex(store storetypes.KVStore, name string) {
+	it := storetypes.KVStorePrefixIterator(store, types.AttributeValueIndexNamePrefix(name))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
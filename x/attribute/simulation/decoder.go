@@ -22,6 +22,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &attribB)
 
 			return fmt.Sprintf("%v\n%v", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.AttributeSchemaKeyPrefix):
+			var schemaA, schemaB types.AttributeSchema
+
+			cdc.MustUnmarshal(kvA.Value, &schemaA)
+			cdc.MustUnmarshal(kvB.Value, &schemaB)
+
+			return fmt.Sprintf("%v\n%v", schemaA, schemaB)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
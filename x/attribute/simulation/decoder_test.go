@@ -18,10 +18,12 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	testAttributeRecord := types.NewAttribute("test", "", types.AttributeType_Int, []byte{1}, nil)
+	testAttributeSchema := types.NewAttributeSchema("test", types.AttributeType_Int)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.AttributeKeyPrefix, Value: cdc.MustMarshal(&testAttributeRecord)},
+			{Key: types.AttributeSchemaKey("test"), Value: cdc.MustMarshal(testAttributeSchema)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +33,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Attribute Record", fmt.Sprintf("%v\n%v", testAttributeRecord, testAttributeRecord)},
+		{"Attribute Schema", fmt.Sprintf("%v\n%v", *testAttributeSchema, *testAttributeSchema)},
 		{"other", ""},
 	}
 
@@ -210,6 +210,116 @@ func (m *Attribute) GetExpirationDate() *time.Time {
 	return nil
 }
 
+// AttributeSchema defines the constraints that attributes with a specific name must satisfy.
+// A schema is registered by the address that the name resolves to.
+type AttributeSchema struct {
+	// The attribute name that this schema applies to.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type that attributes with this name must have. If unspecified, any type is allowed.
+	AttributeType AttributeType `protobuf:"varint,2,opt,name=attribute_type,json=attributeType,proto3,enum=provenance.attribute.v1.AttributeType" json:"attribute_type,omitempty"`
+	// A JSON Schema document that JSON attribute values must conform to. Only a subset of JSON Schema is supported.
+	JsonSchema string `protobuf:"bytes,3,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	// A regular expression that string and uri attribute values must match.
+	StringPattern string `protobuf:"bytes,4,opt,name=string_pattern,json=stringPattern,proto3" json:"string_pattern,omitempty"`
+	// The smallest value allowed for int and float attribute values.
+	MinValue string `protobuf:"bytes,5,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	// The largest value allowed for int and float attribute values.
+	MaxValue string `protobuf:"bytes,6,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	// The maximum number of attributes with this name that a single account can have. Zero means no limit.
+	MaxPerAccount uint32 `protobuf:"varint,7,opt,name=max_per_account,json=maxPerAccount,proto3" json:"max_per_account,omitempty"`
+	// Whether attributes with this name are indexed by value so that accounts can be looked up by attribute value.
+	IndexValues bool `protobuf:"varint,8,opt,name=index_values,json=indexValues,proto3" json:"index_values,omitempty"`
+}
+
+func (m *AttributeSchema) Reset()         { *m = AttributeSchema{} }
+func (m *AttributeSchema) String() string { return proto.CompactTextString(m) }
+func (*AttributeSchema) ProtoMessage()    {}
+func (*AttributeSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+}
+func (m *AttributeSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeSchema.Merge(m, src)
+}
+func (m *AttributeSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeSchema proto.InternalMessageInfo
+
+func (m *AttributeSchema) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeSchema) GetAttributeType() AttributeType {
+	if m != nil {
+		return m.AttributeType
+	}
+	return AttributeType_Unspecified
+}
+
+func (m *AttributeSchema) GetJsonSchema() string {
+	if m != nil {
+		return m.JsonSchema
+	}
+	return ""
+}
+
+func (m *AttributeSchema) GetStringPattern() string {
+	if m != nil {
+		return m.StringPattern
+	}
+	return ""
+}
+
+func (m *AttributeSchema) GetMinValue() string {
+	if m != nil {
+		return m.MinValue
+	}
+	return ""
+}
+
+func (m *AttributeSchema) GetMaxValue() string {
+	if m != nil {
+		return m.MaxValue
+	}
+	return ""
+}
+
+func (m *AttributeSchema) GetMaxPerAccount() uint32 {
+	if m != nil {
+		return m.MaxPerAccount
+	}
+	return 0
+}
+
+func (m *AttributeSchema) GetIndexValues() bool {
+	if m != nil {
+		return m.IndexValues
+	}
+	return false
+}
+
 // EventAttributeAdd event emitted when attribute is added
 type EventAttributeAdd struct {
 	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -224,7 +334,7 @@ func (m *EventAttributeAdd) Reset()         { *m = EventAttributeAdd{} }
 func (m *EventAttributeAdd) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeAdd) ProtoMessage()    {}
 func (*EventAttributeAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+	return fileDescriptor_14fe7eb43c711f5e, []int{3}
 }
 func (m *EventAttributeAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +420,7 @@ func (m *EventAttributeUpdate) Reset()         { *m = EventAttributeUpdate{} }
 func (m *EventAttributeUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeUpdate) ProtoMessage()    {}
 func (*EventAttributeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+	return fileDescriptor_14fe7eb43c711f5e, []int{4}
 }
 func (m *EventAttributeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +512,7 @@ func (m *EventAttributeExpirationUpdate) Reset()         { *m = EventAttributeEx
 func (m *EventAttributeExpirationUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeExpirationUpdate) ProtoMessage()    {}
 func (*EventAttributeExpirationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+	return fileDescriptor_14fe7eb43c711f5e, []int{5}
 }
 func (m *EventAttributeExpirationUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +594,7 @@ func (m *EventAttributeDelete) Reset()         { *m = EventAttributeDelete{} }
 func (m *EventAttributeDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDelete) ProtoMessage()    {}
 func (*EventAttributeDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{5}
+	return fileDescriptor_14fe7eb43c711f5e, []int{6}
 }
 func (m *EventAttributeDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +657,7 @@ func (m *EventAttributeDistinctDelete) Reset()         { *m = EventAttributeDist
 func (m *EventAttributeDistinctDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDistinctDelete) ProtoMessage()    {}
 func (*EventAttributeDistinctDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{6}
+	return fileDescriptor_14fe7eb43c711f5e, []int{7}
 }
 func (m *EventAttributeDistinctDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +734,7 @@ func (m *EventAttributeExpired) Reset()         { *m = EventAttributeExpired{} }
 func (m *EventAttributeExpired) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeExpired) ProtoMessage()    {}
 func (*EventAttributeExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{7}
+	return fileDescriptor_14fe7eb43c711f5e, []int{8}
 }
 func (m *EventAttributeExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +807,7 @@ func (m *EventAccountDataUpdated) Reset()         { *m = EventAccountDataUpdated
 func (m *EventAccountDataUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventAccountDataUpdated) ProtoMessage()    {}
 func (*EventAccountDataUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{8}
+	return fileDescriptor_14fe7eb43c711f5e, []int{9}
 }
 func (m *EventAccountDataUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +852,7 @@ func (m *EventAttributeParamsUpdated) Reset()         { *m = EventAttributeParam
 func (m *EventAttributeParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeParamsUpdated) ProtoMessage()    {}
 func (*EventAttributeParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{9}
+	return fileDescriptor_14fe7eb43c711f5e, []int{10}
 }
 func (m *EventAttributeParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,10 +888,117 @@ func (m *EventAttributeParamsUpdated) GetMaxValueLength() string {
 	return ""
 }
 
+// EventAttributeSchemaSet event emitted when an attribute schema is added or updated.
+type EventAttributeSchemaSet struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventAttributeSchemaSet) Reset()         { *m = EventAttributeSchemaSet{} }
+func (m *EventAttributeSchemaSet) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeSchemaSet) ProtoMessage()    {}
+func (*EventAttributeSchemaSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{11}
+}
+func (m *EventAttributeSchemaSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeSchemaSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeSchemaSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeSchemaSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeSchemaSet.Merge(m, src)
+}
+func (m *EventAttributeSchemaSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeSchemaSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeSchemaSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeSchemaSet proto.InternalMessageInfo
+
+func (m *EventAttributeSchemaSet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeSchemaSet) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// EventAttributeSchemaDeleted event emitted when an attribute schema is removed.
+type EventAttributeSchemaDeleted struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventAttributeSchemaDeleted) Reset()         { *m = EventAttributeSchemaDeleted{} }
+func (m *EventAttributeSchemaDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeSchemaDeleted) ProtoMessage()    {}
+func (*EventAttributeSchemaDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{12}
+}
+func (m *EventAttributeSchemaDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeSchemaDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeSchemaDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeSchemaDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeSchemaDeleted.Merge(m, src)
+}
+func (m *EventAttributeSchemaDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeSchemaDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeSchemaDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeSchemaDeleted proto.InternalMessageInfo
+
+func (m *EventAttributeSchemaDeleted) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeSchemaDeleted) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
 	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
 	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
+	proto.RegisterType((*AttributeSchema)(nil), "provenance.attribute.v1.AttributeSchema")
 	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
 	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
 	proto.RegisterType((*EventAttributeExpirationUpdate)(nil), "provenance.attribute.v1.EventAttributeExpirationUpdate")
@@ -790,6 +1007,8 @@ func init() {
 	proto.RegisterType((*EventAttributeExpired)(nil), "provenance.attribute.v1.EventAttributeExpired")
 	proto.RegisterType((*EventAccountDataUpdated)(nil), "provenance.attribute.v1.EventAccountDataUpdated")
 	proto.RegisterType((*EventAttributeParamsUpdated)(nil), "provenance.attribute.v1.EventAttributeParamsUpdated")
+	proto.RegisterType((*EventAttributeSchemaSet)(nil), "provenance.attribute.v1.EventAttributeSchemaSet")
+	proto.RegisterType((*EventAttributeSchemaDeleted)(nil), "provenance.attribute.v1.EventAttributeSchemaDeleted")
 }
 
 func init() {
@@ -797,61 +1016,70 @@ func init() {
 }
 
 var fileDescriptor_14fe7eb43c711f5e = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0xd6, 0x5a, 0x3f, 0x36, 0xc7, 0xb6, 0xc2, 0x6c, 0x1c, 0x44, 0x60, 0x5b, 0x89, 0x51, 0xe0,
-	0x56, 0x28, 0x10, 0x11, 0x71, 0xd0, 0x4b, 0x6f, 0x56, 0x25, 0xa7, 0x2a, 0x12, 0x5b, 0xa0, 0xa8,
-	0x02, 0xc9, 0x85, 0x58, 0x4b, 0x1b, 0x89, 0x80, 0xf8, 0x03, 0x72, 0xa5, 0xda, 0xaf, 0xa0, 0x53,
-	0x8e, 0xbd, 0x08, 0x6d, 0xcf, 0x7d, 0x91, 0x1c, 0x73, 0x2c, 0x7a, 0x48, 0x0b, 0xfb, 0xd6, 0x6b,
-	0x5f, 0xa0, 0xd0, 0xae, 0xf9, 0x23, 0x9a, 0x72, 0x51, 0xf4, 0xb6, 0x33, 0xfb, 0xed, 0xcc, 0xf7,
-	0xcd, 0xec, 0xce, 0xc2, 0x17, 0x9e, 0xef, 0xce, 0xa9, 0x43, 0x9c, 0x21, 0xd5, 0x08, 0x63, 0xbe,
-	0x75, 0x3e, 0x63, 0x54, 0x9b, 0x3f, 0x8b, 0x8d, 0xa6, 0xe7, 0xbb, 0xcc, 0xc5, 0x8f, 0x62, 0x60,
-	0x33, 0xde, 0x9b, 0x3f, 0x53, 0x0e, 0xc6, 0xee, 0xd8, 0xe5, 0x18, 0x6d, 0xb5, 0x12, 0x70, 0xa5,
-	0x36, 0x76, 0xdd, 0xf1, 0x94, 0x6a, 0xdc, 0x3a, 0x9f, 0xbd, 0xd5, 0x98, 0x65, 0xd3, 0x80, 0x11,
-	0xdb, 0x13, 0x80, 0xfa, 0x11, 0x94, 0x7a, 0xc4, 0x27, 0x76, 0x80, 0x1b, 0x20, 0xdb, 0xe4, 0xc2,
-	0x9c, 0x93, 0xe9, 0x8c, 0x9a, 0x53, 0xea, 0x8c, 0xd9, 0xa4, 0x82, 0x54, 0xd4, 0xd8, 0xd7, 0xcb,
-	0x36, 0xb9, 0xf8, 0x7e, 0xe5, 0x7e, 0xc9, 0xbd, 0xf5, 0xbf, 0x11, 0x48, 0xc7, 0x61, 0x6e, 0x8c,
-	0xa1, 0xe0, 0x10, 0x9b, 0x72, 0xac, 0xa4, 0xf3, 0x35, 0x3e, 0x80, 0x22, 0x8f, 0x53, 0xd9, 0x52,
-	0x51, 0x63, 0x4f, 0x17, 0x06, 0x7e, 0x05, 0xe5, 0x88, 0xb2, 0xc9, 0x2e, 0x3d, 0x5a, 0xc9, 0xab,
-	0xa8, 0x51, 0x3e, 0xfa, 0xbc, 0xb9, 0x41, 0x54, 0x33, 0xca, 0x62, 0x5c, 0x7a, 0x54, 0xdf, 0x27,
-	0x49, 0x13, 0x57, 0x60, 0x9b, 0x8c, 0x46, 0x3e, 0x0d, 0x82, 0x4a, 0x81, 0xe7, 0x0e, 0x4d, 0xfc,
-	0x0a, 0xee, 0xd1, 0x0b, 0xcf, 0xf2, 0x09, 0xb3, 0x5c, 0xc7, 0x1c, 0x11, 0x46, 0x2b, 0x45, 0x15,
-	0x35, 0x76, 0x8f, 0x94, 0xa6, 0xa8, 0x47, 0x33, 0xac, 0x47, 0xd3, 0x08, 0xeb, 0xd1, 0xda, 0x79,
-	0xff, 0xb1, 0x86, 0xde, 0xfd, 0x51, 0x43, 0x7a, 0x39, 0x3e, 0xdc, 0x26, 0x8c, 0x7e, 0x5d, 0xf8,
-	0xf1, 0xe7, 0x5a, 0xae, 0xfe, 0x0b, 0x82, 0xfb, 0x9d, 0x39, 0x75, 0x58, 0x44, 0xea, 0x78, 0x34,
-	0xfa, 0x77, 0xf5, 0x52, 0xa8, 0x1e, 0x43, 0x21, 0xd2, 0x2c, 0xe9, 0x7c, 0xcd, 0x25, 0x0c, 0x87,
-	0xee, 0xcc, 0x61, 0x91, 0x04, 0x61, 0xae, 0x62, 0xb8, 0x3f, 0x38, 0xd4, 0xe7, 0xc4, 0x25, 0x5d,
-	0x18, 0xb8, 0x0a, 0x10, 0x73, 0xab, 0x94, 0xf8, 0x56, 0xc2, 0x53, 0xff, 0x0b, 0xc1, 0xc1, 0x3a,
-	0xc7, 0x81, 0xb7, 0x92, 0x9f, 0x49, 0xf3, 0x10, 0xca, 0xae, 0x6f, 0x8d, 0x2d, 0x87, 0x4c, 0xcd,
-	0x24, 0xdf, 0xfd, 0xd0, 0xcb, 0x7b, 0x8e, 0x9f, 0x40, 0xe4, 0x30, 0x13, 0x02, 0xf6, 0x42, 0x27,
-	0xef, 0xc5, 0x63, 0xd8, 0x9b, 0xf1, 0x4c, 0x37, 0x91, 0x84, 0x9a, 0x5d, 0xe1, 0x13, 0x71, 0x6a,
-	0x70, 0x63, 0x8a, 0x28, 0x42, 0x17, 0x08, 0x97, 0x91, 0x2a, 0x46, 0x69, 0x43, 0x31, 0xb6, 0x13,
-	0xc5, 0xa8, 0xff, 0x8e, 0xa0, 0xba, 0x2e, 0xb6, 0x13, 0x55, 0xe2, 0x0e, 0xd9, 0xd9, 0xdd, 0x49,
-	0x24, 0xcf, 0x6f, 0x48, 0x5e, 0x48, 0x76, 0x42, 0x83, 0x07, 0x51, 0x55, 0x12, 0x2d, 0x11, 0xaa,
-	0x70, 0xb8, 0x15, 0x13, 0xc2, 0x4f, 0x01, 0x0b, 0xad, 0x23, 0xf3, 0x56, 0x0b, 0xef, 0xdf, 0xec,
-	0xc4, 0xf0, 0xfa, 0x9b, 0x74, 0x23, 0xdb, 0x74, 0x4a, 0x37, 0x28, 0x4a, 0x70, 0xdf, 0xda, 0xc0,
-	0x3d, 0x9f, 0x2c, 0xdc, 0x4f, 0x08, 0x3e, 0x4d, 0x05, 0xb7, 0x02, 0x66, 0x39, 0x43, 0x76, 0x47,
-	0x92, 0xec, 0xb2, 0x1d, 0x66, 0x3e, 0x69, 0x29, 0xeb, 0xa9, 0xfe, 0x87, 0x7b, 0x5e, 0xff, 0x15,
-	0xc1, 0xc3, 0x8c, 0xd6, 0xd2, 0xec, 0xf7, 0xf6, 0x19, 0x80, 0x98, 0x5a, 0x13, 0x12, 0x4c, 0x6e,
-	0xf8, 0x49, 0xdc, 0xf3, 0x2d, 0x09, 0x26, 0xff, 0x9f, 0xe3, 0xfa, 0xab, 0x2b, 0xde, 0x7a, 0x75,
-	0xcf, 0xe1, 0x91, 0x20, 0x2b, 0xf0, 0x6d, 0xc2, 0x88, 0xb8, 0x7f, 0xa3, 0x64, 0x50, 0xb4, 0x16,
-	0xb4, 0xfe, 0x02, 0x3e, 0x59, 0x57, 0x28, 0xc6, 0x70, 0x78, 0x70, 0xd3, 0x34, 0x96, 0xd2, 0xd3,
-	0xf8, 0xcb, 0x8f, 0x5b, 0xb0, 0xbf, 0x36, 0x27, 0xb1, 0x06, 0xca, 0xb1, 0x61, 0xe8, 0xdd, 0xd6,
-	0xc0, 0xe8, 0x98, 0xc6, 0xeb, 0x5e, 0xc7, 0x1c, 0x9c, 0xf6, 0x7b, 0x9d, 0x6f, 0xba, 0x27, 0xdd,
-	0x4e, 0x5b, 0xce, 0x29, 0xf7, 0x16, 0x4b, 0x75, 0x77, 0xe0, 0x04, 0x1e, 0x1d, 0x5a, 0x6f, 0x2d,
-	0x3a, 0xc2, 0x8f, 0xe1, 0x41, 0xfa, 0xc0, 0xa0, 0xdb, 0x96, 0x91, 0xb2, 0xb3, 0x58, 0xaa, 0x85,
-	0xd5, 0x3a, 0x03, 0xf2, 0x5d, 0xff, 0xec, 0x54, 0xde, 0x12, 0x90, 0xd5, 0x1a, 0x1f, 0xc2, 0xc3,
-	0x14, 0xa4, 0x6f, 0xe8, 0xdd, 0xd3, 0x17, 0x72, 0x5e, 0x81, 0xc5, 0x52, 0x2d, 0xf5, 0x99, 0x6f,
-	0x39, 0x63, 0x5c, 0x03, 0x9c, 0x4e, 0xa6, 0x77, 0xe5, 0x82, 0xb2, 0xbd, 0x58, 0xaa, 0xf9, 0x81,
-	0x6f, 0x65, 0x00, 0xba, 0xa7, 0x86, 0x5c, 0x14, 0x80, 0xae, 0xc3, 0xf0, 0x13, 0x38, 0x48, 0x01,
-	0x4e, 0x5e, 0x9e, 0x1d, 0x1b, 0x72, 0x49, 0x91, 0x16, 0x4b, 0xb5, 0x78, 0x32, 0x75, 0x49, 0x16,
-	0xa8, 0xa7, 0x9f, 0x19, 0x67, 0xf2, 0xb6, 0x00, 0xf5, 0xf8, 0x6f, 0x7a, 0x1b, 0xd4, 0x7a, 0x6d,
-	0x74, 0xfa, 0xf2, 0x8e, 0x00, 0xb5, 0x2e, 0x19, 0x0d, 0x5a, 0xf6, 0xfb, 0xab, 0x2a, 0xfa, 0x70,
-	0x55, 0x45, 0x7f, 0x5e, 0x55, 0xd1, 0xbb, 0xeb, 0x6a, 0xee, 0xc3, 0x75, 0x35, 0xf7, 0xdb, 0x75,
-	0x35, 0x07, 0x8a, 0xe5, 0x6e, 0xfa, 0xba, 0x7a, 0xe8, 0xcd, 0x57, 0x63, 0x8b, 0x4d, 0x66, 0xe7,
-	0xcd, 0xa1, 0x6b, 0x6b, 0x31, 0xea, 0xa9, 0xe5, 0x26, 0x2c, 0xed, 0x22, 0xf1, 0xdd, 0xaf, 0xee,
-	0x66, 0x70, 0x5e, 0xe2, 0x7f, 0xd3, 0xf3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x36, 0x7d, 0x76,
-	0x18, 0x13, 0x08, 0x00, 0x00,
+	// 1002 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1a, 0x47,
+	0x14, 0xf7, 0x60, 0xc0, 0xec, 0xb3, 0xc1, 0x9b, 0x89, 0x23, 0xa3, 0x4d, 0x0b, 0x6b, 0x22, 0xa7,
+	0xa8, 0x52, 0x40, 0x71, 0xd4, 0x4b, 0x6f, 0x76, 0x8c, 0x53, 0xaa, 0xc4, 0x46, 0xcb, 0x52, 0x29,
+	0xb9, 0xac, 0xc6, 0xec, 0x04, 0xb6, 0x62, 0xff, 0x68, 0x77, 0x70, 0xf1, 0x57, 0xe0, 0x94, 0x63,
+	0x2f, 0xa8, 0xed, 0xb9, 0x9f, 0xa2, 0xb7, 0x1c, 0x73, 0xac, 0x7a, 0x48, 0x2b, 0xfb, 0xd6, 0x6b,
+	0xbf, 0x40, 0xc5, 0xcc, 0x2e, 0x2c, 0xb0, 0xa4, 0x8d, 0x72, 0x9b, 0xf7, 0xe6, 0xb7, 0xef, 0xbd,
+	0xdf, 0xef, 0xbd, 0x99, 0x59, 0xf8, 0xc2, 0xf3, 0xdd, 0x2b, 0xea, 0x10, 0xa7, 0x4b, 0xeb, 0x84,
+	0x31, 0xdf, 0xba, 0x1c, 0x32, 0x5a, 0xbf, 0x7a, 0x3c, 0x37, 0x6a, 0x9e, 0xef, 0x32, 0x17, 0xef,
+	0xcf, 0x81, 0xb5, 0xf9, 0xde, 0xd5, 0x63, 0x65, 0xaf, 0xe7, 0xf6, 0x5c, 0x8e, 0xa9, 0x4f, 0x57,
+	0x02, 0xae, 0x94, 0x7b, 0xae, 0xdb, 0x1b, 0xd0, 0x3a, 0xb7, 0x2e, 0x87, 0xaf, 0xeb, 0xcc, 0xb2,
+	0x69, 0xc0, 0x88, 0xed, 0x09, 0x40, 0xe5, 0x08, 0xb2, 0x2d, 0xe2, 0x13, 0x3b, 0xc0, 0x55, 0x90,
+	0x6d, 0x32, 0x32, 0xae, 0xc8, 0x60, 0x48, 0x8d, 0x01, 0x75, 0x7a, 0xac, 0x5f, 0x44, 0x2a, 0xaa,
+	0xe6, 0xb5, 0x82, 0x4d, 0x46, 0xdf, 0x4d, 0xdd, 0xcf, 0xb9, 0xb7, 0xf2, 0x0f, 0x02, 0xe9, 0x38,
+	0xca, 0x8d, 0x31, 0xa4, 0x1d, 0x62, 0x53, 0x8e, 0x95, 0x34, 0xbe, 0xc6, 0x7b, 0x90, 0xe1, 0x71,
+	0x8a, 0x29, 0x15, 0x55, 0x77, 0x34, 0x61, 0xe0, 0x17, 0x50, 0x98, 0x95, 0x6c, 0xb0, 0x6b, 0x8f,
+	0x16, 0x37, 0x55, 0x54, 0x2d, 0x1c, 0x3d, 0xac, 0xad, 0x21, 0x55, 0x9b, 0x65, 0xd1, 0xaf, 0x3d,
+	0xaa, 0xe5, 0x49, 0xdc, 0xc4, 0x45, 0xd8, 0x22, 0xa6, 0xe9, 0xd3, 0x20, 0x28, 0xa6, 0x79, 0xee,
+	0xc8, 0xc4, 0x2f, 0x60, 0x97, 0x8e, 0x3c, 0xcb, 0x27, 0xcc, 0x72, 0x1d, 0xc3, 0x24, 0x8c, 0x16,
+	0x33, 0x2a, 0xaa, 0x6e, 0x1f, 0x29, 0x35, 0xa1, 0x47, 0x2d, 0xd2, 0xa3, 0xa6, 0x47, 0x7a, 0x9c,
+	0xe4, 0xde, 0xbe, 0x2f, 0xa3, 0x37, 0x7f, 0x96, 0x91, 0x56, 0x98, 0x7f, 0x7c, 0x4a, 0x18, 0xfd,
+	0x3a, 0xfd, 0xe3, 0xcf, 0xe5, 0x8d, 0xca, 0x6f, 0x29, 0xd8, 0x9d, 0xd5, 0xd3, 0xee, 0xf6, 0xa9,
+	0x4d, 0x12, 0xb9, 0xaf, 0xb2, 0x4c, 0x7d, 0x0a, 0xcb, 0x32, 0x6c, 0x7f, 0x1f, 0xb8, 0x8e, 0x11,
+	0xf0, 0x8c, 0x5c, 0x31, 0x49, 0x83, 0xa9, 0x2b, 0xac, 0xe1, 0x10, 0x0a, 0x01, 0xf3, 0x2d, 0xa7,
+	0x67, 0x78, 0x84, 0x31, 0xea, 0x3b, 0xa1, 0x1a, 0x79, 0xe1, 0x6d, 0x09, 0x27, 0xbe, 0x0f, 0x92,
+	0x6d, 0x39, 0xa2, 0xbd, 0x5c, 0x0d, 0x49, 0xcb, 0xd9, 0x96, 0xc3, 0xfb, 0xca, 0x37, 0xa3, 0xde,
+	0x17, 0xb3, 0xe1, 0x66, 0xd8, 0x74, 0xfc, 0x10, 0x76, 0xa7, 0x9b, 0x1e, 0xf5, 0x0d, 0xd2, 0xed,
+	0xba, 0x43, 0x87, 0x15, 0xb7, 0xf8, 0x5c, 0xe4, 0x6d, 0x32, 0x6a, 0x51, 0xff, 0x58, 0x38, 0xf1,
+	0x01, 0xec, 0x58, 0x8e, 0x49, 0xc3, 0x30, 0x41, 0x31, 0xa7, 0xa2, 0x6a, 0x4e, 0xdb, 0xe6, 0x3e,
+	0x1e, 0x29, 0xa8, 0xfc, 0x82, 0xe0, 0x4e, 0xe3, 0x8a, 0x3a, 0x6c, 0x46, 0xf9, 0xd8, 0x34, 0xff,
+	0x7b, 0x82, 0xa4, 0x68, 0x82, 0x30, 0xa4, 0x67, 0x73, 0x23, 0x69, 0x69, 0x16, 0x8d, 0x41, 0x58,
+	0x56, 0x34, 0x06, 0x61, 0x41, 0x7b, 0x90, 0x71, 0x7f, 0x70, 0xa8, 0x1f, 0xd2, 0x15, 0x06, 0x2e,
+	0x01, 0xcc, 0xfb, 0x1b, 0x92, 0x8d, 0x79, 0x2a, 0x7f, 0x23, 0xd8, 0x5b, 0xac, 0xb1, 0xe3, 0x4d,
+	0x47, 0x28, 0xb1, 0xcc, 0x43, 0x28, 0xb8, 0xbe, 0xd5, 0xb3, 0x1c, 0x32, 0x30, 0xe2, 0xf5, 0xe6,
+	0x23, 0xaf, 0x90, 0xf0, 0x01, 0xcc, 0x1c, 0x46, 0x8c, 0xc0, 0x4e, 0xe4, 0xe4, 0x9d, 0x3e, 0x80,
+	0x9d, 0x21, 0xcf, 0x14, 0x46, 0x12, 0x6c, 0xb6, 0x85, 0x4f, 0xc4, 0x29, 0x43, 0x68, 0x8a, 0x28,
+	0x82, 0x17, 0x08, 0x97, 0xbe, 0x24, 0x46, 0x76, 0x8d, 0x18, 0x5b, 0x31, 0x31, 0x2a, 0x7f, 0x20,
+	0x28, 0x2d, 0x92, 0x6d, 0xcc, 0x94, 0xf8, 0x00, 0xed, 0xe4, 0xee, 0xc4, 0x92, 0x6f, 0xae, 0x49,
+	0x9e, 0x8e, 0x77, 0xa2, 0x0e, 0x77, 0x67, 0xaa, 0xc4, 0x5a, 0x22, 0x58, 0xe1, 0x68, 0x6b, 0x5e,
+	0x10, 0x7e, 0x04, 0x58, 0x70, 0x35, 0x8d, 0x95, 0x16, 0xde, 0x09, 0x77, 0xe6, 0xf0, 0xca, 0xab,
+	0xe5, 0x46, 0x9e, 0xd2, 0x01, 0x5d, 0xc3, 0x28, 0x56, 0x7b, 0x6a, 0x4d, 0xed, 0x9b, 0x71, 0xe1,
+	0x7e, 0x42, 0xf0, 0xd9, 0x52, 0x70, 0x2b, 0x60, 0x96, 0xd3, 0x65, 0x1f, 0x48, 0x92, 0x2c, 0xdb,
+	0x61, 0xe2, 0xb5, 0x28, 0x25, 0x5d, 0x77, 0x1f, 0x31, 0xe7, 0x95, 0x5f, 0x11, 0xdc, 0x4b, 0x68,
+	0x2d, 0x4d, 0x3e, 0x6f, 0x9f, 0x03, 0x88, 0x9b, 0xbf, 0x4f, 0x82, 0x7e, 0x58, 0x9f, 0xc4, 0x3d,
+	0xdf, 0x90, 0xa0, 0xff, 0xe9, 0x35, 0x2e, 0x9e, 0xba, 0xcc, 0xca, 0xa9, 0x7b, 0x02, 0xfb, 0xa2,
+	0x58, 0x81, 0x3f, 0x25, 0x8c, 0x88, 0xf9, 0x33, 0xe3, 0x41, 0xd1, 0x42, 0xd0, 0xca, 0x33, 0xb8,
+	0xbf, 0xc8, 0x50, 0x3c, 0x65, 0xd1, 0x87, 0xeb, 0x5e, 0x34, 0x69, 0xe5, 0x45, 0x7b, 0x0a, 0xfb,
+	0x8b, 0x81, 0xc4, 0xdd, 0xda, 0xa6, 0x6c, 0x5d, 0x1f, 0x85, 0xe0, 0xa9, 0xb8, 0xe0, 0x2b, 0xd5,
+	0x88, 0x20, 0x62, 0x1e, 0xcc, 0xff, 0x1f, 0xe8, 0xcb, 0xf7, 0x29, 0xc8, 0x2f, 0xbc, 0x09, 0xb8,
+	0x0e, 0xca, 0xb1, 0xae, 0x6b, 0xcd, 0x93, 0x8e, 0xde, 0x30, 0xf4, 0x97, 0xad, 0x86, 0xd1, 0x39,
+	0x6f, 0xb7, 0x1a, 0x4f, 0x9b, 0x67, 0xcd, 0xc6, 0xa9, 0xbc, 0xa1, 0xec, 0x8e, 0x27, 0xea, 0x76,
+	0xc7, 0x09, 0x3c, 0xda, 0xb5, 0x5e, 0x5b, 0xd4, 0xc4, 0x07, 0x70, 0x77, 0xf9, 0x83, 0x4e, 0xf3,
+	0x54, 0x46, 0x4a, 0x6e, 0x3c, 0x51, 0xd3, 0xd3, 0x75, 0x02, 0xe4, 0xdb, 0xf6, 0xc5, 0xb9, 0x9c,
+	0x12, 0x90, 0xe9, 0x1a, 0x1f, 0xc2, 0xbd, 0x25, 0x48, 0x5b, 0xd7, 0x9a, 0xe7, 0xcf, 0xe4, 0x4d,
+	0x05, 0xc6, 0x13, 0x35, 0xdb, 0xe6, 0x2f, 0x0c, 0x2e, 0x03, 0x5e, 0x4e, 0xa6, 0x35, 0xe5, 0xb4,
+	0xb2, 0x35, 0x9e, 0xa8, 0x9b, 0x1d, 0xdf, 0x4a, 0x00, 0x34, 0xcf, 0x75, 0x39, 0x23, 0x00, 0x4d,
+	0x87, 0xe1, 0x07, 0xb0, 0xb7, 0x04, 0x38, 0x7b, 0x7e, 0x71, 0xac, 0xcb, 0x59, 0x45, 0x1a, 0x4f,
+	0xd4, 0xcc, 0xd9, 0xc0, 0x25, 0x49, 0xa0, 0x96, 0x76, 0xa1, 0x5f, 0xc8, 0x5b, 0x02, 0xd4, 0xe2,
+	0xff, 0x47, 0xab, 0xa0, 0x93, 0x97, 0x7a, 0xa3, 0x2d, 0xe7, 0x04, 0xe8, 0xe4, 0x9a, 0xd1, 0xe0,
+	0xc4, 0x7e, 0x7b, 0x53, 0x42, 0xef, 0x6e, 0x4a, 0xe8, 0xaf, 0x9b, 0x12, 0x7a, 0x73, 0x5b, 0xda,
+	0x78, 0x77, 0x5b, 0xda, 0xf8, 0xfd, 0xb6, 0xb4, 0x01, 0x8a, 0xe5, 0xae, 0x7b, 0xa6, 0x5b, 0xe8,
+	0xd5, 0x57, 0x3d, 0x8b, 0xf5, 0x87, 0x97, 0xb5, 0xae, 0x6b, 0xd7, 0xe7, 0xa8, 0x47, 0x96, 0x1b,
+	0xb3, 0xea, 0xa3, 0xd8, 0x0f, 0xdc, 0xf4, 0xa4, 0x04, 0x97, 0x59, 0xfe, 0xb7, 0xf1, 0xe4, 0xdf,
+	0x01, 0x00, 0xbe, 0x67, 0xf9, 0x22, 0xe5, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -941,6 +1169,84 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttributeSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexValues {
+		i--
+		if m.IndexValues {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPerAccount != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxPerAccount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MaxValue) > 0 {
+		i -= len(m.MaxValue)
+		copy(dAtA[i:], m.MaxValue)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MinValue) > 0 {
+		i -= len(m.MinValue)
+		copy(dAtA[i:], m.MinValue)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MinValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StringPattern) > 0 {
+		i -= len(m.StringPattern)
+		copy(dAtA[i:], m.StringPattern)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.StringPattern)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JsonSchema) > 0 {
+		i -= len(m.JsonSchema)
+		copy(dAtA[i:], m.JsonSchema)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.JsonSchema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AttributeType != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.AttributeType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1363,39 +1669,113 @@ func (m *EventAttributeParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAttribute(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventAttributeSchemaSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventAttributeSchemaSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeSchemaSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxValueLength != 0 {
-		n += 1 + sovAttribute(uint64(m.MaxValueLength))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Attribute) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventAttributeSchemaDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeSchemaDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeSchemaDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttribute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxValueLength != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxValueLength))
+	}
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
@@ -1414,6 +1794,44 @@ func (m *Attribute) Size() (n int) {
 	return n
 }
 
+func (m *AttributeSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovAttribute(uint64(m.AttributeType))
+	}
+	l = len(m.JsonSchema)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.StringPattern)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.MinValue)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.MaxValue)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.MaxPerAccount != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxPerAccount))
+	}
+	if m.IndexValues {
+		n += 2
+	}
+	return n
+}
+
 func (m *EventAttributeAdd) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1622,6 +2040,40 @@ func (m *EventAttributeParamsUpdated) Size() (n int) {
 	return n
 }
 
+func (m *EventAttributeSchemaSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeSchemaDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
 func sovAttribute(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1847,7 +2299,272 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StringPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerAccount", wireType)
+			}
+			m.MaxPerAccount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1857,28 +2574,31 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPerAccount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexValues", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.IndexValues = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
@@ -3388,6 +4108,234 @@ func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventAttributeSchemaSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeSchemaSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeSchemaSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeSchemaDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeSchemaDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeSchemaDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAttribute(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -126,3 +126,17 @@ func NewEventAttributeExpired(attribute Attribute) *EventAttributeExpired {
 func NewEventAttributeParamsUpdated(params Params) *EventAttributeParamsUpdated {
 	return &EventAttributeParamsUpdated{MaxValueLength: strconv.FormatUint(uint64(params.MaxValueLength), 10)}
 }
+
+func NewEventAttributeSchemaSet(name string, owner string) *EventAttributeSchemaSet {
+	return &EventAttributeSchemaSet{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func NewEventAttributeSchemaDeleted(name string, owner string) *EventAttributeSchemaDeleted {
+	return &EventAttributeSchemaDeleted{
+		Name:  name,
+		Owner: owner,
+	}
+}
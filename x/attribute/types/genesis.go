@@ -1,10 +1,13 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, attributes []Attribute) *GenesisState {
+func NewGenesisState(params Params, attributes []Attribute, schemas []AttributeSchema) *GenesisState {
 	return &GenesisState{
 		Params:     params,
 		Attributes: attributes,
+		Schemas:    schemas,
 	}
 }
 
@@ -15,6 +18,16 @@ func (state GenesisState) ValidateBasic() error {
 			return err
 		}
 	}
+	seen := make(map[string]bool, len(state.Schemas))
+	for _, s := range state.Schemas {
+		if err := s.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid schema for %q: %w", s.Name, err)
+		}
+		if seen[s.Name] {
+			return fmt.Errorf("duplicate schema for %q", s.Name)
+		}
+		seen[s.Name] = true
+	}
 	return nil
 }
 
@@ -0,0 +1,445 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// jsonSchema is a parsed JSON Schema document. Only the subset of JSON Schema keywords needed to describe the shape
+// of attribute values is supported. Every supported keyword can be checked deterministically and without looking
+// anything up outside of the document itself (e.g. there's no $ref support).
+type jsonSchema struct {
+	types                []string
+	properties           map[string]*jsonSchema
+	required             []string
+	additionalProperties *bool
+	items                *jsonSchema
+	enum                 []interface{}
+	minimum              *big.Rat
+	maximum              *big.Rat
+	exclusiveMinimum     *big.Rat
+	exclusiveMaximum     *big.Rat
+	minLength            *uint64
+	maxLength            *uint64
+	pattern              *regexp.Regexp
+	minItems             *uint64
+	maxItems             *uint64
+}
+
+// jsonSchemaAnnotations are keywords that are allowed in a schema but have no effect on validation.
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"examples":    true,
+}
+
+// maxDecimalExponent is the largest exponent magnitude allowed in numbers that are compared exactly.
+// Without a limit, a short value like 1e999999999 would be very expensive to compare.
+const maxDecimalExponent = 1000
+
+// jsonSchemaTypes are the allowed values of the "type" keyword.
+var jsonSchemaTypes = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"object":  true,
+	"array":   true,
+	"number":  true,
+	"integer": true,
+	"string":  true,
+}
+
+// decodeJSON decodes the provided JSON, keeping numbers as json.Number so that they can be compared exactly.
+func decodeJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var rv interface{}
+	if err := dec.Decode(&rv); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+	return rv, nil
+}
+
+// parseJSONSchema parses the provided JSON Schema document.
+// An error is returned if the document uses a keyword that isn't supported.
+func parseJSONSchema(doc string) (*jsonSchema, error) {
+	raw, err := decodeJSON([]byte(doc))
+	if err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	return newJSONSchema("$", raw)
+}
+
+// newJSONSchema creates a jsonSchema from a decoded JSON Schema (sub-)document.
+func newJSONSchema(path string, raw interface{}) (*jsonSchema, error) {
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: schema must be an object", path)
+	}
+
+	rv := &jsonSchema{}
+	for _, key := range sortedKeys(obj) {
+		val := obj[key]
+		kPath := path + "." + key
+		var err error
+		switch key {
+		case "type":
+			rv.types, err = parseSchemaTypes(kPath, val)
+		case "properties":
+			props, isObj := val.(map[string]interface{})
+			if !isObj {
+				return nil, fmt.Errorf("%s: must be an object", kPath)
+			}
+			rv.properties = make(map[string]*jsonSchema, len(props))
+			for _, name := range sortedKeys(props) {
+				if rv.properties[name], err = newJSONSchema(kPath+"."+name, props[name]); err != nil {
+					return nil, err
+				}
+			}
+		case "required":
+			rv.required, err = parseStringList(kPath, val)
+		case "additionalProperties":
+			b, isBool := val.(bool)
+			if !isBool {
+				return nil, fmt.Errorf("%s: must be a boolean", kPath)
+			}
+			rv.additionalProperties = &b
+		case "items":
+			rv.items, err = newJSONSchema(kPath, val)
+		case "enum":
+			list, isList := val.([]interface{})
+			if !isList || len(list) == 0 {
+				return nil, fmt.Errorf("%s: must be a non-empty array", kPath)
+			}
+			rv.enum = list
+		case "minimum":
+			rv.minimum, err = parseSchemaNumber(kPath, val)
+		case "maximum":
+			rv.maximum, err = parseSchemaNumber(kPath, val)
+		case "exclusiveMinimum":
+			rv.exclusiveMinimum, err = parseSchemaNumber(kPath, val)
+		case "exclusiveMaximum":
+			rv.exclusiveMaximum, err = parseSchemaNumber(kPath, val)
+		case "minLength":
+			rv.minLength, err = parseSchemaCount(kPath, val)
+		case "maxLength":
+			rv.maxLength, err = parseSchemaCount(kPath, val)
+		case "minItems":
+			rv.minItems, err = parseSchemaCount(kPath, val)
+		case "maxItems":
+			rv.maxItems, err = parseSchemaCount(kPath, val)
+		case "pattern":
+			str, isStr := val.(string)
+			if !isStr {
+				return nil, fmt.Errorf("%s: must be a string", kPath)
+			}
+			if rv.pattern, err = regexp.Compile(str); err != nil {
+				return nil, fmt.Errorf("%s: %w", kPath, err)
+			}
+		default:
+			if !jsonSchemaAnnotations[key] {
+				return nil, fmt.Errorf("%s: unsupported json schema keyword", kPath)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// parseSchemaTypes parses the value of a "type" keyword, which is either a single type or a list of them.
+func parseSchemaTypes(path string, val interface{}) ([]string, error) {
+	var rv []string
+	if str, ok := val.(string); ok {
+		rv = []string{str}
+	} else {
+		var err error
+		if rv, err = parseStringList(path, val); err != nil {
+			return nil, err
+		}
+	}
+	if len(rv) == 0 {
+		return nil, fmt.Errorf("%s: must not be empty", path)
+	}
+	for _, t := range rv {
+		if !jsonSchemaTypes[t] {
+			return nil, fmt.Errorf("%s: unknown type %q", path, t)
+		}
+	}
+	return rv, nil
+}
+
+// parseStringList parses a JSON array of strings.
+func parseStringList(path string, val interface{}) ([]string, error) {
+	list, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: must be an array of strings", path)
+	}
+	rv := make([]string, len(list))
+	for i, entry := range list {
+		if rv[i], ok = entry.(string); !ok {
+			return nil, fmt.Errorf("%s: must be an array of strings", path)
+		}
+	}
+	return rv, nil
+}
+
+// parseDecimal parses a decimal number (possibly with an exponent) so that it can be compared exactly.
+func parseDecimal(str string) (*big.Rat, bool) {
+	if len(str) == 0 || strings.Contains(str, "/") {
+		return nil, false
+	}
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		exp, err := strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil || exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return nil, false
+		}
+	}
+	return new(big.Rat).SetString(str)
+}
+
+// parseSchemaNumber parses a JSON number.
+func parseSchemaNumber(path string, val interface{}) (*big.Rat, error) {
+	num, ok := val.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a number", path)
+	}
+	rv, ok := parseDecimal(num.String())
+	if !ok {
+		return nil, fmt.Errorf("%s: invalid number %s", path, num)
+	}
+	return rv, nil
+}
+
+// parseSchemaCount parses a JSON number that must be a non-negative integer.
+func parseSchemaCount(path string, val interface{}) (*uint64, error) {
+	num, err := parseSchemaNumber(path, val)
+	if err != nil {
+		return nil, err
+	}
+	if !num.IsInt() || num.Sign() < 0 || !num.Num().IsUint64() {
+		return nil, fmt.Errorf("%s: must be a non-negative integer", path)
+	}
+	rv := num.Num().Uint64()
+	return &rv, nil
+}
+
+// Validate returns an error if the provided JSON does not conform to this schema.
+func (s *jsonSchema) Validate(bz []byte) error {
+	val, err := decodeJSON(bz)
+	if err != nil {
+		return fmt.Errorf("invalid json: %w", err)
+	}
+	return s.validate("$", val)
+}
+
+// validate returns an error if the provided decoded JSON value does not conform to this schema.
+func (s *jsonSchema) validate(path string, val interface{}) error {
+	if len(s.types) > 0 && !hasJSONType(s.types, val) {
+		return fmt.Errorf("%s: must be of type %v", path, s.types)
+	}
+
+	if len(s.enum) > 0 {
+		found := false
+		for _, opt := range s.enum {
+			if jsonValuesEqual(opt, val) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: must be one of the enum values", path)
+		}
+	}
+
+	switch v := val.(type) {
+	case json.Number:
+		return s.validateNumber(path, v)
+	case string:
+		return s.validateString(path, v)
+	case []interface{}:
+		return s.validateArray(path, v)
+	case map[string]interface{}:
+		return s.validateObject(path, v)
+	}
+	return nil
+}
+
+// validateNumber applies the numeric keywords to a number.
+func (s *jsonSchema) validateNumber(path string, val json.Number) error {
+	num, ok := parseDecimal(val.String())
+	if !ok {
+		return fmt.Errorf("%s: invalid number %s", path, val)
+	}
+	if s.minimum != nil && num.Cmp(s.minimum) < 0 {
+		return fmt.Errorf("%s: must be at least %s", path, s.minimum.RatString())
+	}
+	if s.maximum != nil && num.Cmp(s.maximum) > 0 {
+		return fmt.Errorf("%s: must be at most %s", path, s.maximum.RatString())
+	}
+	if s.exclusiveMinimum != nil && num.Cmp(s.exclusiveMinimum) <= 0 {
+		return fmt.Errorf("%s: must be more than %s", path, s.exclusiveMinimum.RatString())
+	}
+	if s.exclusiveMaximum != nil && num.Cmp(s.exclusiveMaximum) >= 0 {
+		return fmt.Errorf("%s: must be less than %s", path, s.exclusiveMaximum.RatString())
+	}
+	return nil
+}
+
+// validateString applies the string keywords to a string.
+func (s *jsonSchema) validateString(path string, val string) error {
+	length := uint64(utf8.RuneCountInString(val))
+	if s.minLength != nil && length < *s.minLength {
+		return fmt.Errorf("%s: length must be at least %d", path, *s.minLength)
+	}
+	if s.maxLength != nil && length > *s.maxLength {
+		return fmt.Errorf("%s: length must be at most %d", path, *s.maxLength)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(val) {
+		return fmt.Errorf("%s: must match pattern %q", path, s.pattern.String())
+	}
+	return nil
+}
+
+// validateArray applies the array keywords to an array and validates each of its items.
+func (s *jsonSchema) validateArray(path string, val []interface{}) error {
+	count := uint64(len(val))
+	if s.minItems != nil && count < *s.minItems {
+		return fmt.Errorf("%s: must have at least %d items", path, *s.minItems)
+	}
+	if s.maxItems != nil && count > *s.maxItems {
+		return fmt.Errorf("%s: must have at most %d items", path, *s.maxItems)
+	}
+	if s.items != nil {
+		for i, item := range val {
+			if err := s.items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateObject applies the object keywords to an object and validates each of its properties.
+func (s *jsonSchema) validateObject(path string, val map[string]interface{}) error {
+	for _, name := range s.required {
+		if _, ok := val[name]; !ok {
+			return fmt.Errorf("%s: missing required property %q", path, name)
+		}
+	}
+	for _, name := range sortedKeys(val) {
+		prop, known := s.properties[name]
+		if !known {
+			if s.additionalProperties != nil && !*s.additionalProperties {
+				return fmt.Errorf("%s: unexpected property %q", path, name)
+			}
+			continue
+		}
+		if err := prop.validate(path+"."+name, val[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasJSONType returns true if the provided decoded JSON value is one of the provided JSON Schema types.
+func hasJSONType(types []string, val interface{}) bool {
+	for _, t := range types {
+		switch v := val.(type) {
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case []interface{}:
+			if t == "array" {
+				return true
+			}
+		case map[string]interface{}:
+			if t == "object" {
+				return true
+			}
+		case json.Number:
+			if t == "number" {
+				return true
+			}
+			if t == "integer" {
+				num, ok := parseDecimal(v.String())
+				if ok && num.IsInt() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// jsonValuesEqual returns true if the two decoded JSON values are the same. Numbers are compared by value.
+func jsonValuesEqual(a, b interface{}) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		an, aok := parseDecimal(av.String())
+		bn, bok := parseDecimal(bv.String())
+		return aok && bok && an.Cmp(bn) == 0
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonValuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			bItem, has := bv[k]
+			if !has || !jsonValuesEqual(v, bItem) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// sortedKeys returns the keys of the provided map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	rv := make([]string, 0, len(m))
+	for k := range m {
+		rv = append(rv, k)
+	}
+	sort.Strings(rv)
+	return rv
+}
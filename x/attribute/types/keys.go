@@ -32,6 +32,8 @@ var (
 	AttributeAddrLookupKeyPrefix = []byte{0x03}
 	AttributeExpirationKeyPrefix = []byte{0x04}
 	AttributeParamPrefix         = []byte{0x05}
+	AttributeSchemaKeyPrefix     = []byte{0x06}
+	AttributeValueIndexPrefix    = []byte{0x07}
 )
 
 // AddrAttributeKey creates a key for an account attribute
@@ -93,6 +95,30 @@ func AttributeNameAddrKeyPrefix(attributeName string, addr []byte) []byte {
 	return append(key, address.MustLengthPrefix(addr)...)
 }
 
+// AttributeSchemaKey returns a key for the schema of an attribute name [AttributeSchemaKeyPrefix][name hash]
+func AttributeSchemaKey(attributeName string) []byte {
+	key := AttributeSchemaKeyPrefix
+	return append(key, GetNameKeyBytes(attributeName)...)
+}
+
+// AttributeValueIndexNamePrefix returns a prefix key for the value index entries of an attribute name [AttributeValueIndexPrefix][name hash]
+func AttributeValueIndexNamePrefix(attributeName string) []byte {
+	key := AttributeValueIndexPrefix
+	return append(key, GetNameKeyBytes(attributeName)...)
+}
+
+// AttributeValueIndexValuePrefix returns a prefix key for all addresses with an attribute name and value [AttributeValueIndexPrefix][name hash][value hash]
+func AttributeValueIndexValuePrefix(attributeName string, value []byte) []byte {
+	valueHash := sha256.Sum256(value)
+	return append(AttributeValueIndexNamePrefix(attributeName), valueHash[:]...)
+}
+
+// AttributeValueIndexKey returns a value index key for an attribute [AttributeValueIndexPrefix][name hash][value hash][length + address bytes]
+func AttributeValueIndexKey(attr Attribute) []byte {
+	key := AttributeValueIndexValuePrefix(attr.Name, attr.Value)
+	return append(key, address.MustLengthPrefix(attr.GetAddressBytes())...)
+}
+
 // GetAddressFromKey returns the AccAddress from full attribute address key ([prefix][name hash][length + AccAddress bytes][attribute hash])
 func GetAddressFromKey(nameAddrKey []byte) (sdk.AccAddress, error) {
 	// start index of slice is [prefix (1)] + [name hash (32)] + [address len prefix (1)]
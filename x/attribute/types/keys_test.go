@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
 	"testing"
 	"time"
@@ -111,6 +112,23 @@ func TestAttributeExpireKey(t *testing.T) {
 	assert.Nil(t, actual)
 }
 
+func TestAttributeValueIndexKey(t *testing.T) {
+	attr1 := Attribute{
+		Name:          "long.address.name",
+		Value:         []byte("0123456789"),
+		Address:       "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4",
+		AttributeType: AttributeType_String,
+	}
+	valueHash := sha256.Sum256(attr1.Value)
+	expected := AttributeValueIndexPrefix
+	expected = append(expected, GetNameKeyBytes(attr1.Name)...)
+	assert.Equal(t, expected, AttributeValueIndexNamePrefix(attr1.Name), "AttributeValueIndexNamePrefix")
+	expected = append(expected, valueHash[:]...)
+	assert.Equal(t, expected, AttributeValueIndexValuePrefix(attr1.Name, attr1.Value), "AttributeValueIndexValuePrefix")
+	expected = append(expected, address.MustLengthPrefix(attr1.GetAddressBytes())...)
+	assert.Equal(t, expected, AttributeValueIndexKey(attr1), "AttributeValueIndexKey")
+}
+
 func TestGetAttributeExpireTimePrefix(t *testing.T) {
 	oneSecond := time.Unix(1, 0)
 	actual := GetAttributeExpireTimePrefix(oneSecond)
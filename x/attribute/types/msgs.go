@@ -17,6 +17,8 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgDeleteDistinctAttributeRequest)(nil),
 	(*MsgSetAccountDataRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetAttributeSchemaRequest)(nil),
+	(*MsgDeleteAttributeSchemaRequest)(nil),
 }
 
 func NewMsgAddAttributeRequest(account string, owner sdk.AccAddress, name string, attributeType AttributeType, value []byte) *MsgAddAttributeRequest {
@@ -166,3 +168,48 @@ func (m MsgUpdateParamsRequest) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgSetAttributeSchemaRequest creates a new SetAttributeSchemaRequest message.
+func NewMsgSetAttributeSchemaRequest(schema AttributeSchema, owner sdk.AccAddress) *MsgSetAttributeSchemaRequest {
+	schema.Name = strings.ToLower(strings.TrimSpace(schema.Name))
+	return &MsgSetAttributeSchemaRequest{
+		Schema: schema,
+		Owner:  owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetAttributeSchemaRequest) ValidateBasic() error {
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if err := msg.Schema.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
+	}
+	return nil
+}
+
+// NewMsgDeleteAttributeSchemaRequest creates a new DeleteAttributeSchemaRequest message.
+func NewMsgDeleteAttributeSchemaRequest(name string, owner sdk.AccAddress) *MsgDeleteAttributeSchemaRequest {
+	return &MsgDeleteAttributeSchemaRequest{
+		Name:  strings.ToLower(strings.TrimSpace(name)),
+		Owner: owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgDeleteAttributeSchemaRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("empty name")
+	}
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	return nil
+}
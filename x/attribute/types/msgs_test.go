@@ -32,6 +32,8 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgDeleteDistinctAttributeRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgSetAccountDataRequest{Account: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAttributeSchemaRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgDeleteAttributeSchemaRequest{Owner: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
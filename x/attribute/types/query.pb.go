@@ -598,6 +598,125 @@ func (m *QueryAttributeAccountsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAttributeAccountsByValueRequest is the request type for the Query/AttributeAccountsByValue method.
+type QueryAttributeAccountsByValueRequest struct {
+	// attribute_name is the attribute name to query for.
+	AttributeName string `protobuf:"bytes,1,opt,name=attribute_name,json=attributeName,proto3" json:"attribute_name,omitempty"`
+	// value is the attribute value to query for.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeAccountsByValueRequest) Reset()         { *m = QueryAttributeAccountsByValueRequest{} }
+func (m *QueryAttributeAccountsByValueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeAccountsByValueRequest) ProtoMessage()    {}
+func (*QueryAttributeAccountsByValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{10}
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeAccountsByValueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeAccountsByValueRequest.Merge(m, src)
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeAccountsByValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeAccountsByValueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeAccountsByValueRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeAccountsByValueRequest) GetAttributeName() string {
+	if m != nil {
+		return m.AttributeName
+	}
+	return ""
+}
+
+func (m *QueryAttributeAccountsByValueRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryAttributeAccountsByValueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttributeAccountsByValueResponse is the response type for the Query/AttributeAccountsByValue method.
+type QueryAttributeAccountsByValueResponse struct {
+	// list of account addresses that have an attribute with the requested name and value.
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeAccountsByValueResponse) Reset()         { *m = QueryAttributeAccountsByValueResponse{} }
+func (m *QueryAttributeAccountsByValueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeAccountsByValueResponse) ProtoMessage()    {}
+func (*QueryAttributeAccountsByValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{11}
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeAccountsByValueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeAccountsByValueResponse.Merge(m, src)
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeAccountsByValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeAccountsByValueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeAccountsByValueResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeAccountsByValueResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAttributeAccountsByValueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryAccountDataRequest is the request type for the Query/AccountData method.
 type QueryAccountDataRequest struct {
 	// account is the bech32 address of the account to get the data for
@@ -608,7 +727,7 @@ func (m *QueryAccountDataRequest) Reset()         { *m = QueryAccountDataRequest
 func (m *QueryAccountDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDataRequest) ProtoMessage()    {}
 func (*QueryAccountDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79f9aff39a1796c1, []int{10}
+	return fileDescriptor_79f9aff39a1796c1, []int{12}
 }
 func (m *QueryAccountDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,7 +773,7 @@ func (m *QueryAccountDataResponse) Reset()         { *m = QueryAccountDataRespon
 func (m *QueryAccountDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDataResponse) ProtoMessage()    {}
 func (*QueryAccountDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79f9aff39a1796c1, []int{11}
+	return fileDescriptor_79f9aff39a1796c1, []int{13}
 }
 func (m *QueryAccountDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,6 +809,98 @@ func (m *QueryAccountDataResponse) GetValue() string {
 	return ""
 }
 
+// QueryAttributeSchemaRequest is the request type for the Query/AttributeSchema method.
+type QueryAttributeSchemaRequest struct {
+	// name is the attribute name to get the schema for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryAttributeSchemaRequest) Reset()         { *m = QueryAttributeSchemaRequest{} }
+func (m *QueryAttributeSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeSchemaRequest) ProtoMessage()    {}
+func (*QueryAttributeSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{14}
+}
+func (m *QueryAttributeSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeSchemaRequest.Merge(m, src)
+}
+func (m *QueryAttributeSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeSchemaRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryAttributeSchemaResponse is the response type for the Query/AttributeSchema method.
+type QueryAttributeSchemaResponse struct {
+	// schema is the schema registered for the requested attribute name, or empty if there isn't one.
+	Schema *AttributeSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *QueryAttributeSchemaResponse) Reset()         { *m = QueryAttributeSchemaResponse{} }
+func (m *QueryAttributeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeSchemaResponse) ProtoMessage()    {}
+func (*QueryAttributeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{15}
+}
+func (m *QueryAttributeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeSchemaResponse.Merge(m, src)
+}
+func (m *QueryAttributeSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeSchemaResponse) GetSchema() *AttributeSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
@@ -701,8 +912,12 @@ func init() {
 	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
 	proto.RegisterType((*QueryAttributeAccountsRequest)(nil), "provenance.attribute.v1.QueryAttributeAccountsRequest")
 	proto.RegisterType((*QueryAttributeAccountsResponse)(nil), "provenance.attribute.v1.QueryAttributeAccountsResponse")
+	proto.RegisterType((*QueryAttributeAccountsByValueRequest)(nil), "provenance.attribute.v1.QueryAttributeAccountsByValueRequest")
+	proto.RegisterType((*QueryAttributeAccountsByValueResponse)(nil), "provenance.attribute.v1.QueryAttributeAccountsByValueResponse")
 	proto.RegisterType((*QueryAccountDataRequest)(nil), "provenance.attribute.v1.QueryAccountDataRequest")
 	proto.RegisterType((*QueryAccountDataResponse)(nil), "provenance.attribute.v1.QueryAccountDataResponse")
+	proto.RegisterType((*QueryAttributeSchemaRequest)(nil), "provenance.attribute.v1.QueryAttributeSchemaRequest")
+	proto.RegisterType((*QueryAttributeSchemaResponse)(nil), "provenance.attribute.v1.QueryAttributeSchemaResponse")
 }
 
 func init() {
@@ -710,55 +925,64 @@ func init() {
 }
 
 var fileDescriptor_79f9aff39a1796c1 = []byte{
-	// 761 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0x3b, 0x05, 0xca, 0x8f, 0x47, 0x7e, 0xbf, 0xfc, 0x1c, 0x11, 0x9a, 0x8d, 0x16, 0x5c,
-	0xa3, 0x20, 0xca, 0x0e, 0x2d, 0x82, 0x09, 0xea, 0x01, 0x62, 0xd4, 0x93, 0xc1, 0xea, 0xc9, 0x8b,
-	0x99, 0xae, 0xcb, 0xba, 0x09, 0xdd, 0x59, 0x3a, 0xbb, 0x0d, 0xd8, 0xf4, 0x62, 0xe2, 0x0d, 0x8d,
-	0x89, 0x7f, 0x81, 0x17, 0x13, 0xfd, 0x2b, 0xbc, 0x68, 0x38, 0x92, 0x78, 0xf1, 0x64, 0x0c, 0xf8,
-	0x7f, 0x68, 0x76, 0x66, 0xba, 0xdd, 0xb6, 0x2e, 0x5b, 0x88, 0x17, 0x6e, 0x3b, 0xd3, 0xf7, 0xe6,
-	0x7d, 0xde, 0x77, 0xde, 0x7b, 0x53, 0xb8, 0xe0, 0xd5, 0x58, 0xdd, 0x72, 0xa9, 0x6b, 0x5a, 0x84,
-	0xfa, 0x7e, 0xcd, 0xa9, 0x04, 0xbe, 0x45, 0xea, 0x45, 0xb2, 0x19, 0x58, 0xb5, 0x6d, 0xc3, 0xab,
-	0x31, 0x9f, 0xe1, 0x89, 0xb6, 0x91, 0x11, 0x19, 0x19, 0xf5, 0xa2, 0x36, 0x6b, 0x32, 0x5e, 0x65,
-	0x9c, 0x54, 0x28, 0xb7, 0xa4, 0x07, 0xa9, 0x17, 0x2b, 0x96, 0x4f, 0x8b, 0xc4, 0xa3, 0xb6, 0xe3,
-	0x52, 0xdf, 0x61, 0xae, 0x3c, 0x44, 0x1b, 0xb3, 0x99, 0xcd, 0xc4, 0x27, 0x09, 0xbf, 0xd4, 0xee,
-	0x59, 0x9b, 0x31, 0x7b, 0xc3, 0x22, 0xd4, 0x73, 0x08, 0x75, 0x5d, 0xe6, 0x0b, 0x17, 0xae, 0x7e,
-	0x9d, 0x4e, 0xa2, 0x6b, 0x53, 0x08, 0x43, 0x7d, 0x0c, 0xf0, 0x83, 0x30, 0xfc, 0x1a, 0xad, 0xd1,
-	0x2a, 0x2f, 0x5b, 0x9b, 0x81, 0xc5, 0x7d, 0xfd, 0x11, 0x9c, 0xee, 0xd8, 0xe5, 0x1e, 0x73, 0xb9,
-	0x85, 0x6f, 0x41, 0xce, 0x13, 0x3b, 0x79, 0x34, 0x85, 0x66, 0x46, 0x4b, 0x93, 0x46, 0x42, 0x7e,
-	0x86, 0x74, 0x5c, 0x1d, 0xdc, 0xfd, 0x3e, 0x99, 0x29, 0x2b, 0x27, 0xfd, 0x15, 0x82, 0x33, 0xe2,
-	0xd8, 0x95, 0x96, 0xa9, 0x8a, 0x87, 0xf3, 0x30, 0x4c, 0x4d, 0x93, 0x05, 0xae, 0x2f, 0x4e, 0x1e,
-	0x29, 0xb7, 0x96, 0x18, 0xc3, 0xa0, 0x4b, 0xab, 0x56, 0x3e, 0x2b, 0xb6, 0xc5, 0x37, 0xbe, 0x03,
-	0xd0, 0x16, 0x29, 0x3f, 0x20, 0x50, 0x2e, 0x19, 0x52, 0x51, 0x23, 0x54, 0xd4, 0x90, 0x77, 0xa0,
-	0x14, 0x35, 0xd6, 0xa8, 0xdd, 0x8a, 0x54, 0x8e, 0x79, 0xea, 0x9f, 0x11, 0x8c, 0x77, 0xf3, 0xa8,
-	0x4c, 0x93, 0x81, 0xee, 0x01, 0x44, 0x99, 0xf2, 0x7c, 0x76, 0x6a, 0x60, 0x66, 0xb4, 0xa4, 0x27,
-	0xea, 0x10, 0x9d, 0xac, 0xa4, 0x88, 0xf9, 0xe2, 0xbb, 0x7f, 0x48, 0x63, 0x3a, 0x35, 0x0d, 0x09,
-	0xd8, 0x91, 0xc7, 0xf3, 0xee, 0x34, 0x78, 0xba, 0xae, 0x9d, 0x1a, 0x66, 0x8f, 0xad, 0xe1, 0x17,
-	0x04, 0x13, 0x3d, 0xc1, 0x4f, 0xa2, 0x88, 0x3b, 0x08, 0xfe, 0x17, 0x89, 0x3c, 0x34, 0xa9, 0x9b,
-	0xae, 0xdf, 0x38, 0xe4, 0x78, 0xb0, 0xbe, 0xee, 0x6c, 0xa9, 0xca, 0x54, 0xab, 0xbf, 0x56, 0x9b,
-	0x9f, 0x10, 0x9c, 0x8a, 0xe1, 0x9c, 0x44, 0x45, 0x5f, 0x23, 0x38, 0xd7, 0x59, 0x1a, 0x2b, 0x12,
-	0x36, 0x2a, 0xcf, 0x8b, 0xf0, 0x5f, 0x14, 0xf8, 0x89, 0x68, 0x73, 0x99, 0xd5, 0xbf, 0xd1, 0xee,
-	0xfd, 0xde, 0x7e, 0x37, 0x8f, 0xad, 0xe9, 0x4b, 0x04, 0x85, 0x24, 0x20, 0x25, 0xb0, 0x06, 0xff,
-	0x28, 0x45, 0xc3, 0x19, 0x37, 0x30, 0x33, 0x52, 0x8e, 0xd6, 0x5d, 0xc2, 0x98, 0xc7, 0x17, 0x66,
-	0xa1, 0xd5, 0x32, 0xf2, 0xe4, 0xdb, 0xd4, 0xa7, 0xa9, 0x05, 0xa7, 0xcf, 0x43, 0xbe, 0xd7, 0x49,
-	0x51, 0x8f, 0xc1, 0x50, 0x9d, 0x6e, 0x04, 0x2d, 0xf9, 0xe4, 0xa2, 0xf4, 0x6b, 0x18, 0x86, 0x84,
-	0x0b, 0xde, 0x41, 0x90, 0x93, 0x13, 0x19, 0x5f, 0x49, 0xac, 0x89, 0xde, 0x67, 0x40, 0xbb, 0xda,
-	0x9f, 0xb1, 0xa4, 0xd0, 0xa7, 0x5f, 0x7c, 0xfd, 0xf9, 0x36, 0x7b, 0x1e, 0x4f, 0x92, 0xa4, 0xc7,
-	0x47, 0xbe, 0x03, 0xf8, 0x03, 0x82, 0x91, 0xe8, 0x0a, 0xb0, 0x71, 0x78, 0x90, 0xee, 0xb7, 0x42,
-	0x23, 0x7d, 0xdb, 0x2b, 0xae, 0x1b, 0x82, 0x6b, 0x11, 0x2f, 0x90, 0xd4, 0x47, 0x91, 0x34, 0x94,
-	0xdc, 0x4d, 0xd2, 0x08, 0xeb, 0xb1, 0x89, 0xdf, 0x23, 0x80, 0xf6, 0x68, 0xc3, 0xfd, 0x06, 0x8f,
-	0x24, 0x9c, 0xef, 0xdf, 0x41, 0xe1, 0x2e, 0x0a, 0x5c, 0x82, 0xe7, 0xd2, 0x71, 0x79, 0x9b, 0x17,
-	0xbf, 0x43, 0x30, 0x18, 0xce, 0x0a, 0x7c, 0xf9, 0xf0, 0x88, 0xb1, 0xf1, 0xa6, 0xcd, 0xf6, 0x63,
-	0xaa, 0xb0, 0x56, 0x05, 0xd6, 0x4d, 0xbc, 0x7c, 0x24, 0x15, 0xb9, 0x49, 0x5d, 0xd2, 0x90, 0xb3,
-	0xb1, 0x89, 0xc3, 0xa1, 0xd6, 0xd3, 0x7b, 0x78, 0xa9, 0x4f, 0x89, 0xba, 0xa6, 0x87, 0x76, 0xfd,
-	0xc8, 0x7e, 0x2a, 0x95, 0x65, 0x91, 0xca, 0x35, 0x5c, 0x4a, 0x4e, 0x45, 0xb9, 0x90, 0x46, 0xe7,
-	0x7c, 0x6a, 0xe2, 0x8f, 0x08, 0x46, 0x63, 0x2d, 0x88, 0xd3, 0xee, 0xb7, 0xa7, 0xc5, 0xb5, 0xe2,
-	0x11, 0x3c, 0x14, 0xf0, 0x92, 0x00, 0x9e, 0xc7, 0x46, 0x1a, 0xf0, 0x53, 0xea, 0xd3, 0xb6, 0xfa,
-	0xab, 0xd5, 0xdd, 0xfd, 0x02, 0xda, 0xdb, 0x2f, 0xa0, 0x1f, 0xfb, 0x05, 0xf4, 0xe6, 0xa0, 0x90,
-	0xd9, 0x3b, 0x28, 0x64, 0xbe, 0x1d, 0x14, 0x32, 0xa0, 0x39, 0x2c, 0x09, 0x63, 0x0d, 0x3d, 0x5e,
-	0xb4, 0x1d, 0xff, 0x59, 0x50, 0x31, 0x4c, 0x56, 0x8d, 0x45, 0x9c, 0x73, 0x58, 0x3c, 0xfe, 0x56,
-	0x8c, 0xc0, 0xdf, 0xf6, 0x2c, 0x5e, 0xc9, 0x89, 0xbf, 0x94, 0x0b, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x70, 0x45, 0xc2, 0xe9, 0x1b, 0x0b, 0x00, 0x00,
+	// 900 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0xdf, 0x4f, 0x2b, 0x45,
+	0x14, 0xc7, 0x3b, 0x05, 0xaa, 0x9c, 0xfa, 0x73, 0x44, 0x68, 0x56, 0x2c, 0xb8, 0x0a, 0x54, 0x94,
+	0x1d, 0x5a, 0x28, 0x26, 0x20, 0x46, 0xaa, 0x11, 0x9f, 0x0c, 0x16, 0xe3, 0x83, 0x2f, 0x3a, 0x5d,
+	0x97, 0xb2, 0x09, 0xdd, 0x29, 0xdd, 0x6d, 0x43, 0x6d, 0xfa, 0x62, 0xe2, 0x1b, 0x1a, 0x8d, 0x7f,
+	0x81, 0x2f, 0x26, 0x6a, 0xe2, 0xdf, 0xe0, 0x8b, 0x37, 0x3c, 0x92, 0xdc, 0x97, 0xfb, 0x44, 0x6e,
+	0xe0, 0xfe, 0x21, 0x37, 0x3b, 0x33, 0xdd, 0xee, 0xb6, 0x5d, 0xb6, 0x6d, 0xc8, 0x4d, 0x78, 0xdb,
+	0x19, 0xe6, 0x3b, 0xe7, 0x73, 0xbe, 0x3d, 0x7b, 0xce, 0x02, 0x6f, 0x57, 0x6b, 0xac, 0x61, 0x58,
+	0xd4, 0xd2, 0x0d, 0x42, 0x1d, 0xa7, 0x66, 0x96, 0xea, 0x8e, 0x41, 0x1a, 0x59, 0x72, 0x5a, 0x37,
+	0x6a, 0x4d, 0xad, 0x5a, 0x63, 0x0e, 0xc3, 0x73, 0xdd, 0x43, 0x9a, 0x77, 0x48, 0x6b, 0x64, 0x95,
+	0x55, 0x9d, 0xd9, 0x15, 0x66, 0x93, 0x12, 0xb5, 0x0d, 0xa1, 0x20, 0x8d, 0x6c, 0xc9, 0x70, 0x68,
+	0x96, 0x54, 0x69, 0xd9, 0xb4, 0xa8, 0x63, 0x32, 0x4b, 0x5c, 0xa2, 0xcc, 0x94, 0x59, 0x99, 0xf1,
+	0x47, 0xe2, 0x3e, 0xc9, 0xdd, 0xf9, 0x32, 0x63, 0xe5, 0x13, 0x83, 0xd0, 0xaa, 0x49, 0xa8, 0x65,
+	0x31, 0x87, 0x4b, 0x6c, 0xf9, 0xd7, 0x95, 0x30, 0xba, 0x2e, 0x05, 0x3f, 0xa8, 0xce, 0x00, 0xfe,
+	0xd2, 0x0d, 0x7f, 0x40, 0x6b, 0xb4, 0x62, 0x17, 0x8d, 0xd3, 0xba, 0x61, 0x3b, 0xea, 0x57, 0xf0,
+	0x5a, 0x60, 0xd7, 0xae, 0x32, 0xcb, 0x36, 0xf0, 0x2e, 0x24, 0xaa, 0x7c, 0x27, 0x85, 0x16, 0x51,
+	0x26, 0x99, 0x5b, 0xd0, 0x42, 0xf2, 0xd3, 0x84, 0xb0, 0x30, 0x79, 0x71, 0xb5, 0x10, 0x2b, 0x4a,
+	0x91, 0xfa, 0x33, 0x82, 0xd7, 0xf9, 0xb5, 0x7b, 0x9d, 0xa3, 0x32, 0x1e, 0x4e, 0xc1, 0x73, 0x54,
+	0xd7, 0x59, 0xdd, 0x72, 0xf8, 0xcd, 0xd3, 0xc5, 0xce, 0x12, 0x63, 0x98, 0xb4, 0x68, 0xc5, 0x48,
+	0xc5, 0xf9, 0x36, 0x7f, 0xc6, 0x9f, 0x01, 0x74, 0x4d, 0x4a, 0x4d, 0x70, 0x94, 0x65, 0x4d, 0x38,
+	0xaa, 0xb9, 0x8e, 0x6a, 0xe2, 0x37, 0x90, 0x8e, 0x6a, 0x07, 0xb4, 0xdc, 0x89, 0x54, 0xf4, 0x29,
+	0xd5, 0xff, 0x11, 0xcc, 0xf6, 0xf2, 0xc8, 0x4c, 0xc3, 0x81, 0x3e, 0x07, 0xf0, 0x32, 0xb5, 0x53,
+	0xf1, 0xc5, 0x89, 0x4c, 0x32, 0xa7, 0x86, 0xfa, 0xe0, 0xdd, 0x2c, 0xad, 0xf0, 0x69, 0xf1, 0xfe,
+	0x80, 0x34, 0x56, 0x22, 0xd3, 0x10, 0x80, 0x81, 0x3c, 0x7e, 0xe8, 0x4d, 0xc3, 0x8e, 0xf6, 0x35,
+	0xe8, 0x61, 0x7c, 0x6c, 0x0f, 0x1f, 0x20, 0x98, 0xeb, 0x0b, 0x7e, 0x1f, 0x4d, 0x3c, 0x47, 0xf0,
+	0x0a, 0x4f, 0xe4, 0x50, 0xa7, 0x56, 0xb4, 0x7f, 0xb3, 0x90, 0xb0, 0xeb, 0x47, 0x47, 0xe6, 0x99,
+	0xac, 0x4c, 0xb9, 0xba, 0xb3, 0xda, 0xfc, 0x0f, 0xc1, 0xab, 0x3e, 0x9c, 0xfb, 0xe8, 0xe8, 0x2f,
+	0x08, 0xde, 0x0c, 0x96, 0xc6, 0x9e, 0x80, 0xf5, 0xca, 0x73, 0x09, 0x5e, 0xf2, 0x02, 0x7f, 0xcb,
+	0x5f, 0x73, 0x91, 0xd5, 0x8b, 0xde, 0xee, 0x17, 0xfd, 0xef, 0xbb, 0x3e, 0xb6, 0xa7, 0x3f, 0x21,
+	0x48, 0x87, 0x01, 0x49, 0x83, 0x15, 0x78, 0x5e, 0x3a, 0xea, 0xf6, 0xb8, 0x89, 0xcc, 0x74, 0xd1,
+	0x5b, 0xe3, 0xfd, 0x01, 0x18, 0x63, 0x19, 0xf3, 0x0f, 0x82, 0x77, 0x06, 0x73, 0x14, 0x9a, 0x5f,
+	0xd3, 0x93, 0xba, 0x31, 0xa2, 0x3f, 0x33, 0x30, 0xd5, 0x70, 0x65, 0xbc, 0x14, 0x5f, 0x28, 0x8a,
+	0xc5, 0x9d, 0xb9, 0x76, 0x8e, 0x60, 0x29, 0x82, 0xf6, 0x59, 0x9a, 0xb7, 0xd1, 0xe9, 0x37, 0xe2,
+	0xe6, 0x4f, 0xa9, 0x43, 0x23, 0xdf, 0x56, 0x75, 0x1d, 0x52, 0xfd, 0x22, 0x49, 0xed, 0xb9, 0x27,
+	0x34, 0x62, 0xa1, 0x66, 0xe1, 0x8d, 0x60, 0xd2, 0x87, 0xfa, 0xb1, 0x51, 0xf1, 0x42, 0x75, 0xc6,
+	0x12, 0xea, 0x8e, 0x25, 0xf5, 0x3b, 0x98, 0x1f, 0x2c, 0x91, 0x81, 0x3e, 0x86, 0x84, 0xcd, 0x77,
+	0xe4, 0xf4, 0xcc, 0x44, 0xbf, 0x9e, 0xf2, 0x06, 0xa9, 0xcb, 0xfd, 0x96, 0x84, 0x29, 0x1e, 0x02,
+	0x9f, 0x23, 0x48, 0x88, 0x19, 0x8b, 0xdf, 0x0b, 0xbd, 0xa6, 0x7f, 0xb0, 0x2b, 0xef, 0x0f, 0x77,
+	0x58, 0x10, 0xab, 0x2b, 0x3f, 0x3e, 0x7c, 0xf2, 0x7b, 0xfc, 0x2d, 0xbc, 0x40, 0xc2, 0x3e, 0x27,
+	0xc4, 0x64, 0xc7, 0x7f, 0x21, 0x98, 0xf6, 0xa0, 0xb1, 0x76, 0x7b, 0x90, 0xde, 0xe9, 0xaf, 0x90,
+	0xa1, 0xcf, 0x4b, 0xae, 0x1d, 0xce, 0x95, 0xc7, 0x1b, 0x24, 0xf2, 0x33, 0x87, 0xb4, 0x64, 0x0d,
+	0xb4, 0x49, 0xcb, 0xfd, 0x95, 0xda, 0xf8, 0x4f, 0x04, 0xd0, 0x1d, 0x56, 0x78, 0xd8, 0xe0, 0x9e,
+	0x85, 0xeb, 0xc3, 0x0b, 0x24, 0x6e, 0x9e, 0xe3, 0x12, 0xbc, 0x16, 0x8d, 0x6b, 0x77, 0x79, 0xf1,
+	0x1f, 0x08, 0x26, 0xdd, 0xee, 0x8f, 0xdf, 0xbd, 0x3d, 0xa2, 0x6f, 0x60, 0x29, 0xab, 0xc3, 0x1c,
+	0x95, 0x58, 0x05, 0x8e, 0xf5, 0x21, 0xde, 0x1e, 0xc9, 0x45, 0x5b, 0xa7, 0x16, 0x69, 0x89, 0x69,
+	0xd7, 0xc6, 0xee, 0x98, 0xea, 0xeb, 0x0b, 0x78, 0x6b, 0x48, 0x8b, 0x7a, 0xe6, 0x81, 0xf2, 0xc1,
+	0xc8, 0x3a, 0x99, 0xca, 0x36, 0x4f, 0x65, 0x13, 0xe7, 0xc2, 0x53, 0x91, 0x12, 0xd2, 0x0a, 0x76,
+	0xd4, 0x36, 0xbe, 0x42, 0x90, 0x0a, 0x6b, 0x6d, 0x78, 0x77, 0x44, 0xa2, 0x60, 0x03, 0x57, 0x3e,
+	0x1a, 0x57, 0x2e, 0xf3, 0xfa, 0x84, 0xe7, 0xb5, 0x8b, 0x77, 0x46, 0xcf, 0x8b, 0x94, 0x9a, 0x6b,
+	0x62, 0x10, 0xfc, 0x8d, 0x20, 0xe9, 0x6b, 0x7c, 0x38, 0xaa, 0x80, 0xfb, 0x1a, 0xab, 0x92, 0x1d,
+	0x41, 0x21, 0xc9, 0xb7, 0x38, 0xf9, 0x3a, 0xd6, 0xa2, 0xc8, 0xbf, 0xa7, 0x0e, 0xf5, 0x15, 0xfd,
+	0xbf, 0x08, 0x5e, 0xee, 0x69, 0x7f, 0x78, 0x73, 0x48, 0x17, 0x03, 0x2d, 0x5a, 0xc9, 0x8f, 0xa8,
+	0x92, 0xe0, 0x1a, 0x07, 0xcf, 0xe0, 0xe5, 0x50, 0x70, 0xd1, 0x8c, 0x65, 0x3b, 0x29, 0x54, 0x2e,
+	0xae, 0xd3, 0xe8, 0xf2, 0x3a, 0x8d, 0x1e, 0x5f, 0xa7, 0xd1, 0xaf, 0x37, 0xe9, 0xd8, 0xe5, 0x4d,
+	0x3a, 0xf6, 0xe8, 0x26, 0x1d, 0x03, 0xc5, 0x64, 0x61, 0x08, 0x07, 0xe8, 0x9b, 0x7c, 0xd9, 0x74,
+	0x8e, 0xeb, 0x25, 0x4d, 0x67, 0x15, 0x5f, 0xa4, 0x35, 0x93, 0xf9, 0xe3, 0x9e, 0xf9, 0x22, 0x3b,
+	0xcd, 0xaa, 0x61, 0x97, 0x12, 0xfc, 0xdf, 0xb6, 0x8d, 0xa7, 0x03, 0x00, 0xaf, 0x4e, 0x29, 0xec,
+	0x7f, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -783,8 +1007,13 @@ type QueryClient interface {
 	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
 	// AttributeAccounts queries accounts on a given attribute name
 	AttributeAccounts(ctx context.Context, in *QueryAttributeAccountsRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsResponse, error)
+	// AttributeAccountsByValue queries accounts that have an attribute with a given name and value.
+	// The attribute name must have a schema with index_values enabled.
+	AttributeAccountsByValue(ctx context.Context, in *QueryAttributeAccountsByValueRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsByValueResponse, error)
 	// AccountData returns the accountdata for a specified account.
 	AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error)
+	// AttributeSchema returns the schema registered for an attribute name.
+	AttributeSchema(ctx context.Context, in *QueryAttributeSchemaRequest, opts ...grpc.CallOption) (*QueryAttributeSchemaResponse, error)
 }
 
 type queryClient struct {
@@ -840,6 +1069,15 @@ func (c *queryClient) AttributeAccounts(ctx context.Context, in *QueryAttributeA
 	return out, nil
 }
 
+func (c *queryClient) AttributeAccountsByValue(ctx context.Context, in *QueryAttributeAccountsByValueRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsByValueResponse, error) {
+	out := new(QueryAttributeAccountsByValueResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeAccountsByValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error) {
 	out := new(QueryAccountDataResponse)
 	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AccountData", in, out, opts...)
@@ -849,6 +1087,15 @@ func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataReque
 	return out, nil
 }
 
+func (c *queryClient) AttributeSchema(ctx context.Context, in *QueryAttributeSchemaRequest, opts ...grpc.CallOption) (*QueryAttributeSchemaResponse, error) {
+	out := new(QueryAttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the attribute module.
@@ -861,8 +1108,13 @@ type QueryServer interface {
 	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
 	// AttributeAccounts queries accounts on a given attribute name
 	AttributeAccounts(context.Context, *QueryAttributeAccountsRequest) (*QueryAttributeAccountsResponse, error)
+	// AttributeAccountsByValue queries accounts that have an attribute with a given name and value.
+	// The attribute name must have a schema with index_values enabled.
+	AttributeAccountsByValue(context.Context, *QueryAttributeAccountsByValueRequest) (*QueryAttributeAccountsByValueResponse, error)
 	// AccountData returns the accountdata for a specified account.
 	AccountData(context.Context, *QueryAccountDataRequest) (*QueryAccountDataResponse, error)
+	// AttributeSchema returns the schema registered for an attribute name.
+	AttributeSchema(context.Context, *QueryAttributeSchemaRequest) (*QueryAttributeSchemaResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -884,9 +1136,15 @@ func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest
 func (*UnimplementedQueryServer) AttributeAccounts(ctx context.Context, req *QueryAttributeAccountsRequest) (*QueryAttributeAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AttributeAccounts not implemented")
 }
+func (*UnimplementedQueryServer) AttributeAccountsByValue(ctx context.Context, req *QueryAttributeAccountsByValueRequest) (*QueryAttributeAccountsByValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeAccountsByValue not implemented")
+}
 func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *QueryAccountDataRequest) (*QueryAccountDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
 }
+func (*UnimplementedQueryServer) AttributeSchema(ctx context.Context, req *QueryAttributeSchemaRequest) (*QueryAttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeSchema not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -982,6 +1240,24 @@ func _Query_AttributeAccounts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttributeAccountsByValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeAccountsByValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeAccountsByValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeAccountsByValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeAccountsByValue(ctx, req.(*QueryAttributeAccountsByValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAccountDataRequest)
 	if err := dec(in); err != nil {
@@ -1000,6 +1276,24 @@ func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeSchema(ctx, req.(*QueryAttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Query",
@@ -1025,10 +1319,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AttributeAccounts",
 			Handler:    _Query_AttributeAccounts_Handler,
 		},
+		{
+			MethodName: "AttributeAccountsByValue",
+			Handler:    _Query_AttributeAccountsByValue_Handler,
+		},
 		{
 			MethodName: "AccountData",
 			Handler:    _Query_AccountData_Handler,
 		},
+		{
+			MethodName: "AttributeSchema",
+			Handler:    _Query_AttributeSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/query.proto",
@@ -1488,7 +1790,7 @@ func (m *QueryAttributeAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttributeAccountsByValueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1498,27 +1800,48 @@ func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttributeAccountsByValueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttributeAccountsByValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttributeName) > 0 {
+		i -= len(m.AttributeName)
+		copy(dAtA[i:], m.AttributeName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttributeName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttributeAccountsByValueResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1528,36 +1851,177 @@ func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttributeAccountsByValueResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttributeAccountsByValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAccountDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
@@ -1743,6 +2207,46 @@ func (m *QueryAttributeAccountsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAttributeAccountsByValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttributeName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsByValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryAccountDataRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1769,6 +2273,32 @@ func (m *QueryAccountDataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAttributeSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1883,7 +2413,579 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1908,7 +3010,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1931,10 +3033,10 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1971,7 +3073,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1999,7 +3101,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Suffix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2058,7 +3160,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2081,10 +3183,10 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2210,7 +3312,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2233,15 +3335,15 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2269,9 +3371,9 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.AttributeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2328,7 +3430,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2351,15 +3453,15 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2387,43 +3489,9 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2480,7 +3548,7 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsByValueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2503,15 +3571,15 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2539,13 +3607,13 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.AttributeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2555,25 +3623,27 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2630,7 +3700,7 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsByValueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2653,15 +3723,15 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsByValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2689,43 +3759,9 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2782,7 +3818,7 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2805,15 +3841,15 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2841,43 +3877,7 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2900,7 +3900,7 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2923,15 +3923,15 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2959,43 +3959,7 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3018,7 +3982,7 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3041,15 +4005,15 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3077,7 +4041,7 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3100,7 +4064,7 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3123,17 +4087,17 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3143,23 +4107,27 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.Schema == nil {
+				m.Schema = &AttributeSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// NewAttributeSchema creates a new AttributeSchema for the provided attribute name that only restricts the type.
+func NewAttributeSchema(name string, attrType AttributeType) *AttributeSchema {
+	return &AttributeSchema{
+		Name:          strings.ToLower(strings.TrimSpace(name)),
+		AttributeType: attrType,
+	}
+}
+
+// ValidateBasic ensures an attribute schema is valid.
+func (s AttributeSchema) ValidateBasic() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("invalid name: empty")
+	}
+	if s.AttributeType != AttributeType_Unspecified && !ValidAttributeType(s.AttributeType) {
+		return fmt.Errorf("invalid attribute type")
+	}
+
+	if len(s.JsonSchema) > 0 {
+		if !s.allowsType(AttributeType_JSON) {
+			return fmt.Errorf("a json schema cannot be used with attribute type %s", s.AttributeType)
+		}
+		if _, err := parseJSONSchema(s.JsonSchema); err != nil {
+			return fmt.Errorf("invalid json schema: %w", err)
+		}
+	}
+
+	if len(s.StringPattern) > 0 {
+		if !s.allowsType(AttributeType_String) && !s.allowsType(AttributeType_Uri) {
+			return fmt.Errorf("a string pattern cannot be used with attribute type %s", s.AttributeType)
+		}
+		if _, err := regexp.Compile(s.StringPattern); err != nil {
+			return fmt.Errorf("invalid string pattern: %w", err)
+		}
+	}
+
+	if len(s.MinValue) > 0 || len(s.MaxValue) > 0 {
+		if !s.allowsType(AttributeType_Int) && !s.allowsType(AttributeType_Float) {
+			return fmt.Errorf("a value range cannot be used with attribute type %s", s.AttributeType)
+		}
+		minValue, err := parseSchemaBound("min value", s.MinValue)
+		if err != nil {
+			return err
+		}
+		maxValue, err := parseSchemaBound("max value", s.MaxValue)
+		if err != nil {
+			return err
+		}
+		if minValue != nil && maxValue != nil && minValue.Cmp(maxValue) > 0 {
+			return fmt.Errorf("min value %s cannot be greater than max value %s", s.MinValue, s.MaxValue)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAttribute returns an error if the provided attribute does not conform to this schema.
+// The attribute's maximum per account is not checked here since that requires knowledge of the account's attributes.
+func (s AttributeSchema) ValidateAttribute(attr Attribute) error {
+	if !s.allowsType(attr.AttributeType) {
+		return fmt.Errorf("attribute %q must have type %s, got %s", attr.Name, s.AttributeType, attr.AttributeType)
+	}
+
+	switch attr.AttributeType {
+	case AttributeType_JSON:
+		if len(s.JsonSchema) == 0 {
+			return nil
+		}
+		schema, err := parseJSONSchema(s.JsonSchema)
+		if err != nil {
+			return fmt.Errorf("invalid json schema for attribute %q: %w", attr.Name, err)
+		}
+		if err = schema.Validate(attr.Value); err != nil {
+			return fmt.Errorf("attribute %q value does not conform to its json schema: %w", attr.Name, err)
+		}
+	case AttributeType_String, AttributeType_Uri:
+		if len(s.StringPattern) == 0 {
+			return nil
+		}
+		pattern, err := regexp.Compile(s.StringPattern)
+		if err != nil {
+			return fmt.Errorf("invalid string pattern for attribute %q: %w", attr.Name, err)
+		}
+		if !pattern.Match(attr.Value) {
+			return fmt.Errorf("attribute %q value does not match pattern %q", attr.Name, s.StringPattern)
+		}
+	case AttributeType_Int, AttributeType_Float:
+		if len(s.MinValue) == 0 && len(s.MaxValue) == 0 {
+			return nil
+		}
+		value, ok := parseDecimal(strings.TrimSpace(string(attr.Value)))
+		if !ok {
+			return fmt.Errorf("attribute %q value %q cannot be compared to the allowed range", attr.Name, string(attr.Value))
+		}
+		if minValue, _ := parseSchemaBound("min value", s.MinValue); minValue != nil && value.Cmp(minValue) < 0 {
+			return fmt.Errorf("attribute %q value %s is less than the min value %s", attr.Name, string(attr.Value), s.MinValue)
+		}
+		if maxValue, _ := parseSchemaBound("max value", s.MaxValue); maxValue != nil && value.Cmp(maxValue) > 0 {
+			return fmt.Errorf("attribute %q value %s is greater than the max value %s", attr.Name, string(attr.Value), s.MaxValue)
+		}
+	}
+	return nil
+}
+
+// allowsType returns true if attributes of the provided type are allowed by this schema.
+func (s AttributeSchema) allowsType(attrType AttributeType) bool {
+	return s.AttributeType == AttributeType_Unspecified || s.AttributeType == attrType
+}
+
+// parseSchemaBound parses one end of a schema's value range. Returns nil if the value is empty.
+func parseSchemaBound(field, value string) (*big.Rat, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	rv, ok := parseDecimal(value)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s %q: must be a number", field, value)
+	}
+	return rv, nil
+}
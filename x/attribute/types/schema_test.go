@@ -0,0 +1,226 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttributeSchemaValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema AttributeSchema
+		expErr string
+	}{
+		{
+			name:   "type only",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_String},
+		},
+		{
+			name:   "any type with all constraints",
+			schema: AttributeSchema{Name: "test.attr", JsonSchema: `{"type":"object"}`, StringPattern: "^a+$", MinValue: "-1.5", MaxValue: "1e3", MaxPerAccount: 2},
+		},
+		{
+			name:   "empty name",
+			schema: AttributeSchema{Name: " ", AttributeType: AttributeType_String},
+			expErr: "invalid name: empty",
+		},
+		{
+			name:   "invalid type",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: 99},
+			expErr: "invalid attribute type",
+		},
+		{
+			name:   "json schema on string type",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_String, JsonSchema: `{}`},
+			expErr: "a json schema cannot be used with attribute type ATTRIBUTE_TYPE_STRING",
+		},
+		{
+			name:   "json schema not json",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_JSON, JsonSchema: `{`},
+			expErr: "invalid json schema: invalid json: unexpected EOF",
+		},
+		{
+			name:   "json schema with unsupported keyword",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_JSON, JsonSchema: `{"properties":{"a":{"$ref":"#/x"}}}`},
+			expErr: "invalid json schema: $.properties.a.$ref: unsupported json schema keyword",
+		},
+		{
+			name:   "pattern on int type",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_Int, StringPattern: "a"},
+			expErr: "a string pattern cannot be used with attribute type ATTRIBUTE_TYPE_INT",
+		},
+		{
+			name:   "invalid pattern",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_String, StringPattern: "("},
+			expErr: "invalid string pattern: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name:   "range on json type",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_JSON, MinValue: "1"},
+			expErr: "a value range cannot be used with attribute type ATTRIBUTE_TYPE_JSON",
+		},
+		{
+			name:   "invalid min value",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_Int, MinValue: "one"},
+			expErr: "invalid min value \"one\": must be a number",
+		},
+		{
+			name:   "max value exponent too large",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_Float, MaxValue: "1e999999999"},
+			expErr: "invalid max value \"1e999999999\": must be a number",
+		},
+		{
+			name:   "min more than max",
+			schema: AttributeSchema{Name: "test.attr", AttributeType: AttributeType_Int, MinValue: "10", MaxValue: "9"},
+			expErr: "min value 10 cannot be greater than max value 9",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schema.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestAttributeSchemaValidateAttribute(t *testing.T) {
+	jsonSchema := `{
+  "type": "object",
+  "required": ["level", "tags"],
+  "additionalProperties": false,
+  "properties": {
+    "level": {"type": "integer", "minimum": 1, "exclusiveMaximum": 4},
+    "country": {"type": "string", "pattern": "^[A-Z]{2}$"},
+    "tags": {"type": "array", "maxItems": 2, "items": {"enum": ["a", "b", 3.0]}},
+    "note": {"type": ["string", "null"], "maxLength": 3}
+  }
+}`
+	kyc := AttributeSchema{Name: "kyc.attr", AttributeType: AttributeType_JSON, JsonSchema: jsonSchema}
+	rating := AttributeSchema{Name: "rating.attr", MinValue: "1", MaxValue: "5", StringPattern: "^[a-z]+$"}
+	newAttr := func(name string, attrType AttributeType, value string) Attribute {
+		return Attribute{Name: name, AttributeType: attrType, Value: []byte(value)}
+	}
+
+	tests := []struct {
+		name   string
+		schema AttributeSchema
+		attr   Attribute
+		expErr string
+	}{
+		{
+			name:   "json conforms",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":3,"country":"US","tags":["a",3],"note":null}`),
+		},
+		{
+			name:   "json wrong attribute type",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_String, `{"level":3}`),
+			expErr: `attribute "kyc.attr" must have type ATTRIBUTE_TYPE_JSON, got ATTRIBUTE_TYPE_STRING`,
+		},
+		{
+			name:   "json missing required property",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":3}`),
+			expErr: `attribute "kyc.attr" value does not conform to its json schema: $: missing required property "tags"`,
+		},
+		{
+			name:   "json unexpected property",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":3,"tags":[],"extra":true}`),
+			expErr: `attribute "kyc.attr" value does not conform to its json schema: $: unexpected property "extra"`,
+		},
+		{
+			name:   "json not an integer",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":2.5,"tags":[]}`),
+			expErr: `attribute "kyc.attr" value does not conform to its json schema: $.level: must be of type [integer]`,
+		},
+		{
+			name:   "json number at exclusive maximum",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":4,"tags":[]}`),
+			expErr: `attribute "kyc.attr" value does not conform to its json schema: $.level: must be less than 4`,
+		},
+		{
+			name:   "json string does not match pattern",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":1,"tags":[],"country":"USA"}`),
+			expErr: `attribute "kyc.attr" value does not conform to its json schema: $.country: must match pattern "^[A-Z]{2}$"`,
+		},
+		{
+			name:   "json array item not in enum",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":1,"tags":["a","c"]}`),
+			expErr: `attribute "kyc.attr" value does not conform to its json schema: $.tags[1]: must be one of the enum values`,
+		},
+		{
+			name:   "json too many items",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":1,"tags":["a","b","a"]}`),
+			expErr: `attribute "kyc.attr" value does not conform to its json schema: $.tags: must have at most 2 items`,
+		},
+		{
+			name:   "json string too long",
+			schema: kyc,
+			attr:   newAttr("kyc.attr", AttributeType_JSON, `{"level":1,"tags":[],"note":"four"}`),
+			expErr: `attribute "kyc.attr" value does not conform to its json schema: $.note: length must be at most 3`,
+		},
+		{
+			name:   "any type: int in range",
+			schema: rating,
+			attr:   newAttr("rating.attr", AttributeType_Int, "5"),
+		},
+		{
+			name:   "any type: float below range",
+			schema: rating,
+			attr:   newAttr("rating.attr", AttributeType_Float, "0.99"),
+			expErr: `attribute "rating.attr" value 0.99 is less than the min value 1`,
+		},
+		{
+			name:   "any type: int above range",
+			schema: rating,
+			attr:   newAttr("rating.attr", AttributeType_Int, "6"),
+			expErr: `attribute "rating.attr" value 6 is greater than the max value 5`,
+		},
+		{
+			name:   "any type: float that cannot be compared",
+			schema: rating,
+			attr:   newAttr("rating.attr", AttributeType_Float, "Inf"),
+			expErr: `attribute "rating.attr" value "Inf" cannot be compared to the allowed range`,
+		},
+		{
+			name:   "any type: string matches pattern",
+			schema: rating,
+			attr:   newAttr("rating.attr", AttributeType_String, "good"),
+		},
+		{
+			name:   "any type: string does not match pattern",
+			schema: rating,
+			attr:   newAttr("rating.attr", AttributeType_String, "Good"),
+			expErr: `attribute "rating.attr" value does not match pattern "^[a-z]+$"`,
+		},
+		{
+			name:   "any type: bytes are not constrained",
+			schema: rating,
+			attr:   newAttr("rating.attr", AttributeType_Bytes, "anything"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schema.ValidateAttribute(tc.attr)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ValidateAttribute")
+			} else {
+				assert.NoError(t, err, "ValidateAttribute")
+			}
+		})
+	}
+}
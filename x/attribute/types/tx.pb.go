@@ -816,6 +816,192 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetAttributeSchemaRequest defines a message to add or replace the schema of attributes with a name.
+// Schemas may only be set by the account that the attribute name resolves to.
+type MsgSetAttributeSchemaRequest struct {
+	// The schema to register. Its name is the attribute name that it applies to.
+	Schema AttributeSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgSetAttributeSchemaRequest) Reset()         { *m = MsgSetAttributeSchemaRequest{} }
+func (m *MsgSetAttributeSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeSchemaRequest) ProtoMessage()    {}
+func (*MsgSetAttributeSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{14}
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeSchemaRequest.Merge(m, src)
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeSchemaRequest proto.InternalMessageInfo
+
+func (m *MsgSetAttributeSchemaRequest) GetSchema() AttributeSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return AttributeSchema{}
+}
+
+func (m *MsgSetAttributeSchemaRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgSetAttributeSchemaResponse defines the Msg/SetAttributeSchema response type.
+type MsgSetAttributeSchemaResponse struct {
+}
+
+func (m *MsgSetAttributeSchemaResponse) Reset()         { *m = MsgSetAttributeSchemaResponse{} }
+func (m *MsgSetAttributeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeSchemaResponse) ProtoMessage()    {}
+func (*MsgSetAttributeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{15}
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeSchemaResponse.Merge(m, src)
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeSchemaResponse proto.InternalMessageInfo
+
+// MsgDeleteAttributeSchemaRequest defines a message to remove the schema of attributes with a name.
+// Schemas may only be removed by the account that the attribute name resolves to.
+type MsgDeleteAttributeSchemaRequest struct {
+	// The attribute name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) Reset()         { *m = MsgDeleteAttributeSchemaRequest{} }
+func (m *MsgDeleteAttributeSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAttributeSchemaRequest) ProtoMessage()    {}
+func (*MsgDeleteAttributeSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{16}
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAttributeSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAttributeSchemaRequest.Merge(m, src)
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAttributeSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAttributeSchemaRequest proto.InternalMessageInfo
+
+func (m *MsgDeleteAttributeSchemaRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgDeleteAttributeSchemaResponse defines the Msg/DeleteAttributeSchema response type.
+type MsgDeleteAttributeSchemaResponse struct {
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) Reset()         { *m = MsgDeleteAttributeSchemaResponse{} }
+func (m *MsgDeleteAttributeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAttributeSchemaResponse) ProtoMessage()    {}
+func (*MsgDeleteAttributeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{17}
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAttributeSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAttributeSchemaResponse.Merge(m, src)
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAttributeSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAttributeSchemaResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddAttributeRequest)(nil), "provenance.attribute.v1.MsgAddAttributeRequest")
 	proto.RegisterType((*MsgAddAttributeResponse)(nil), "provenance.attribute.v1.MsgAddAttributeResponse")
@@ -831,66 +1017,76 @@ func init() {
 	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.attribute.v1.MsgSetAccountDataResponse")
 	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.attribute.v1.MsgUpdateParamsRequest")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.attribute.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetAttributeSchemaRequest)(nil), "provenance.attribute.v1.MsgSetAttributeSchemaRequest")
+	proto.RegisterType((*MsgSetAttributeSchemaResponse)(nil), "provenance.attribute.v1.MsgSetAttributeSchemaResponse")
+	proto.RegisterType((*MsgDeleteAttributeSchemaRequest)(nil), "provenance.attribute.v1.MsgDeleteAttributeSchemaRequest")
+	proto.RegisterType((*MsgDeleteAttributeSchemaResponse)(nil), "provenance.attribute.v1.MsgDeleteAttributeSchemaResponse")
 }
 
 func init() { proto.RegisterFile("provenance/attribute/v1/tx.proto", fileDescriptor_5de344c1a12714be) }
 
 var fileDescriptor_5de344c1a12714be = []byte{
-	// 850 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0xe4, 0x57, 0xd9, 0x69, 0x36, 0x2b, 0x0d, 0x5d, 0xe2, 0x18, 0x94, 0x64, 0xc3, 0xb2,
-	0x44, 0x2b, 0xad, 0x4d, 0xb3, 0x82, 0x43, 0xa0, 0x87, 0x44, 0xe1, 0x18, 0xa9, 0x4a, 0x0b, 0x42,
-	0x3d, 0x10, 0x4d, 0x92, 0xc1, 0xb5, 0x88, 0x3d, 0xae, 0x67, 0x9c, 0xb6, 0x9c, 0x10, 0x37, 0x6e,
-	0x15, 0x5c, 0x38, 0x20, 0xf1, 0x2f, 0xf4, 0xc0, 0x1f, 0xd1, 0x63, 0xc5, 0x09, 0x71, 0x28, 0xa8,
-	0x3d, 0xf4, 0xcc, 0x7f, 0x80, 0xe2, 0x19, 0x27, 0xce, 0x0f, 0x9b, 0x26, 0x7b, 0xf3, 0x9b, 0x79,
-	0xef, 0xfb, 0x3e, 0x7f, 0x6f, 0xde, 0xd8, 0xb0, 0xe2, 0xb8, 0x74, 0x4c, 0x6c, 0x6c, 0x0f, 0x88,
-	0x8e, 0x39, 0x77, 0xcd, 0xbe, 0xc7, 0x89, 0x3e, 0xde, 0xd5, 0xf9, 0x99, 0xe6, 0xb8, 0x94, 0x53,
-	0x54, 0x98, 0x65, 0x68, 0xd3, 0x0c, 0x6d, 0xbc, 0xab, 0x16, 0x06, 0x94, 0x59, 0x94, 0xe9, 0x16,
-	0x33, 0x26, 0x05, 0x16, 0x33, 0x44, 0x85, 0x5a, 0x14, 0x1b, 0x3d, 0x3f, 0xd2, 0x45, 0x20, 0xb7,
-	0x76, 0x0c, 0x6a, 0x50, 0xb1, 0x3e, 0x79, 0x92, 0xab, 0x65, 0x83, 0x52, 0x63, 0x44, 0x74, 0x3f,
-	0xea, 0x7b, 0xdf, 0xe8, 0xdc, 0xb4, 0x08, 0xe3, 0xd8, 0x72, 0x64, 0xc2, 0x87, 0x51, 0x2a, 0x67,
-	0x82, 0xfc, 0xc4, 0xea, 0xaf, 0x49, 0xf8, 0x4e, 0x87, 0x19, 0xcd, 0xe1, 0xb0, 0x19, 0xec, 0x74,
-	0xc9, 0x89, 0x47, 0x18, 0x47, 0x08, 0xa6, 0x6d, 0x6c, 0x11, 0x05, 0x54, 0x40, 0xed, 0x51, 0xd7,
-	0x7f, 0x46, 0x3b, 0x30, 0x33, 0xc6, 0x23, 0x8f, 0x28, 0xc9, 0x0a, 0xa8, 0xe5, 0xba, 0x22, 0x40,
-	0x1d, 0x98, 0x9f, 0xe2, 0xf6, 0xf8, 0xb9, 0x43, 0x94, 0x54, 0x05, 0xd4, 0xf2, 0xf5, 0x17, 0x5a,
-	0x84, 0x15, 0xda, 0x94, 0xec, 0xf0, 0xdc, 0x21, 0xdd, 0xc7, 0x38, 0x1c, 0x22, 0x05, 0x6e, 0xe1,
-	0xc1, 0x80, 0x7a, 0x36, 0x57, 0xd2, 0x3e, 0x77, 0x10, 0x4e, 0xe8, 0xe9, 0xa9, 0x4d, 0x5c, 0x25,
-	0xe3, 0xaf, 0x8b, 0x00, 0x75, 0xe0, 0x13, 0x72, 0xe6, 0x98, 0x2e, 0xe6, 0x26, 0xb5, 0x7b, 0x43,
-	0xcc, 0x89, 0x92, 0xad, 0x80, 0xda, 0x76, 0x5d, 0xd5, 0x84, 0x4f, 0x5a, 0xe0, 0x93, 0x76, 0x18,
-	0xf8, 0xd4, 0x7a, 0xeb, 0xea, 0xa6, 0x0c, 0x2e, 0xfe, 0x2e, 0x83, 0x6e, 0x7e, 0x56, 0xdc, 0xc6,
-	0x9c, 0x34, 0xe0, 0x0f, 0xf7, 0x97, 0x2f, 0x05, 0x74, 0xb5, 0x08, 0x0b, 0x4b, 0xee, 0x30, 0x87,
-	0xda, 0x8c, 0x54, 0xff, 0x4d, 0xc2, 0x62, 0x87, 0x19, 0x5f, 0x38, 0x13, 0xc2, 0x07, 0x99, 0xf7,
-	0x01, 0xcc, 0x53, 0xd7, 0x34, 0x4c, 0x1b, 0x8f, 0x7a, 0x61, 0x17, 0x1f, 0x07, 0xab, 0x5f, 0xfa,
-	0x6e, 0x3e, 0x83, 0x39, 0xcf, 0x07, 0x95, 0x49, 0x29, 0x3f, 0x69, 0x5b, 0xac, 0x89, 0x94, 0xaf,
-	0x61, 0x61, 0x8a, 0xb4, 0xe0, 0x7c, 0x7a, 0x2d, 0xe7, 0x9f, 0x06, 0x30, 0x73, 0xcb, 0xe8, 0x08,
-	0x3e, 0x95, 0x12, 0x16, 0xd0, 0x33, 0x6b, 0xa1, 0xbf, 0xed, 0xcd, 0x9b, 0xb3, 0xd8, 0xdd, 0x6c,
-	0x44, 0x77, 0xb7, 0x42, 0xdd, 0x9d, 0x6b, 0xc7, 0x7b, 0x50, 0x5d, 0x65, 0xb9, 0xec, 0xc8, 0x5f,
-	0x00, 0xbe, 0xbf, 0xbc, 0xfd, 0xf9, 0xb4, 0xbb, 0x9b, 0x1c, 0xec, 0xa5, 0x93, 0x95, 0xda, 0xfc,
-	0x64, 0xad, 0x7b, 0xb0, 0xe7, 0x5e, 0xfd, 0x05, 0x7c, 0x1e, 0xff, 0x6e, 0xd2, 0x84, 0x6f, 0xfd,
-	0x53, 0xd9, 0x26, 0x23, 0xf2, 0xc0, 0x53, 0x19, 0x12, 0x95, 0x8c, 0x10, 0x95, 0x8a, 0xef, 0xc7,
-	0x12, 0x99, 0x94, 0xf2, 0x23, 0x80, 0xcf, 0xa6, 0xdb, 0x6d, 0x93, 0x71, 0xd3, 0x1e, 0xf0, 0x37,
-	0xb8, 0x66, 0x42, 0x4a, 0x53, 0x11, 0x4a, 0xd3, 0x51, 0x4a, 0x9f, 0xc3, 0x6a, 0x9c, 0x14, 0xa9,
-	0xf8, 0x2b, 0xa8, 0x74, 0x98, 0x71, 0x40, 0x78, 0x53, 0x00, 0xb7, 0x31, 0xc7, 0x81, 0xce, 0xa9,
-	0x26, 0x21, 0x74, 0x59, 0xd3, 0xbc, 0x7b, 0x8d, 0xdc, 0x84, 0x3d, 0x88, 0xaa, 0xef, 0xfa, 0x6d,
-	0x59, 0x44, 0x96, 0xb4, 0xbf, 0x01, 0xff, 0x12, 0x16, 0xcd, 0xdd, 0xc7, 0x2e, 0xb6, 0x58, 0xc0,
-	0xfa, 0x09, 0x7c, 0x84, 0x3d, 0x7e, 0x4c, 0x5d, 0x93, 0x9f, 0x0b, 0xe6, 0x96, 0xf2, 0xc7, 0xef,
-	0xaf, 0x76, 0xe4, 0x47, 0xa2, 0x39, 0x1c, 0xba, 0x84, 0xb1, 0x03, 0xee, 0x9a, 0xb6, 0xd1, 0x9d,
-	0xa5, 0xa2, 0x3d, 0x98, 0x75, 0x7c, 0x20, 0x5f, 0xd6, 0x76, 0xbd, 0x1c, 0x39, 0xb2, 0x82, 0xaf,
-	0x95, 0xbe, 0xba, 0x29, 0x27, 0xba, 0xb2, 0xa8, 0x91, 0x9f, 0x88, 0x9f, 0xc1, 0xc9, 0x7b, 0x70,
-	0x5e, 0xa0, 0x10, 0x5f, 0xff, 0x79, 0x0b, 0xa6, 0x3a, 0xcc, 0x40, 0x27, 0x30, 0x17, 0xbe, 0x27,
-	0x91, 0x1e, 0xc9, 0xb8, 0xfa, 0x7b, 0xa3, 0x7e, 0xf4, 0xf0, 0x02, 0x41, 0x8d, 0xbe, 0x83, 0x4f,
-	0x16, 0x06, 0x02, 0xd5, 0xe3, 0x40, 0x56, 0xdf, 0xd5, 0xea, 0xeb, 0xb5, 0x6a, 0x24, 0xf7, 0x2f,
-	0x00, 0x16, 0x23, 0xa7, 0x11, 0x7d, 0xb6, 0x06, 0xe4, 0xd2, 0x05, 0xa5, 0xee, 0x6d, 0x58, 0x3d,
-	0xb3, 0x65, 0x61, 0x24, 0xe3, 0x6d, 0x59, 0x7d, 0x59, 0xc4, 0xdb, 0x12, 0x31, 0xf3, 0xe8, 0x27,
-	0x00, 0x0b, 0x11, 0x53, 0x86, 0x1a, 0xff, 0x0f, 0x18, 0x75, 0x4b, 0xa8, 0x9f, 0x6e, 0x54, 0x2b,
-	0x45, 0x9d, 0xc2, 0xfc, 0xfc, 0xe4, 0xa1, 0xdd, 0x38, 0xb8, 0x95, 0xf3, 0xaf, 0xd6, 0xd7, 0x29,
-	0x91, 0xc4, 0x27, 0x30, 0x17, 0x9e, 0x99, 0xf8, 0x99, 0x58, 0x31, 0xfe, 0xf1, 0x33, 0xb1, 0x6a,
-	0x1c, 0xd5, 0xcc, 0xf7, 0xf7, 0x97, 0x2f, 0x41, 0xcb, 0xba, 0xba, 0x2d, 0x81, 0xeb, 0xdb, 0x12,
-	0xf8, 0xe7, 0xb6, 0x04, 0x2e, 0xee, 0x4a, 0x89, 0xeb, 0xbb, 0x52, 0xe2, 0xcf, 0xbb, 0x52, 0x02,
-	0xaa, 0x26, 0x8d, 0x02, 0xdd, 0x07, 0x47, 0x1f, 0x1b, 0x26, 0x3f, 0xf6, 0xfa, 0xda, 0x80, 0x5a,
-	0xfa, 0x2c, 0xeb, 0x95, 0x49, 0x43, 0x91, 0x7e, 0x16, 0xfa, 0xa7, 0x9c, 0xfc, 0x16, 0xb0, 0x7e,
-	0xd6, 0xff, 0x0e, 0xbe, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xc7, 0x08, 0x30, 0x1e, 0x0b,
-	0x00, 0x00,
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0xf6, 0x49, 0xb2, 0x52, 0x3f, 0x2b, 0x0a, 0x70, 0xb5, 0x23, 0x8a, 0x4d, 0x25, 0x45, 0x4d,
+	0x53, 0x21, 0x40, 0xc8, 0x58, 0x46, 0x82, 0xd6, 0x6d, 0x06, 0x1b, 0x6e, 0x37, 0x01, 0x81, 0x9c,
+	0xfe, 0x40, 0x86, 0x1a, 0xb4, 0x74, 0xa5, 0x89, 0x9a, 0x3c, 0x9a, 0x77, 0x74, 0xec, 0x4e, 0x45,
+	0xbb, 0xb4, 0x40, 0x87, 0xa0, 0x53, 0x87, 0x02, 0x5d, 0x3b, 0x66, 0xe8, 0x1f, 0xe1, 0x31, 0xe8,
+	0x54, 0x74, 0x48, 0x0b, 0x7b, 0xc8, 0xdc, 0xff, 0xa0, 0x10, 0xef, 0x48, 0x91, 0x12, 0x29, 0x9b,
+	0xca, 0xa6, 0x77, 0xf7, 0xde, 0xf7, 0x7d, 0xfc, 0xde, 0xd3, 0xa3, 0x04, 0x2d, 0xd7, 0xa3, 0x47,
+	0xc4, 0x31, 0x9c, 0x01, 0xd1, 0x0d, 0xce, 0x3d, 0x6b, 0xcf, 0xe7, 0x44, 0x3f, 0x5a, 0xd3, 0xf9,
+	0xb1, 0xe6, 0x7a, 0x94, 0x53, 0x5c, 0x1b, 0x67, 0x68, 0x51, 0x86, 0x76, 0xb4, 0xa6, 0xd6, 0x06,
+	0x94, 0xd9, 0x94, 0xe9, 0x36, 0x33, 0x47, 0x05, 0x36, 0x33, 0x45, 0x85, 0x5a, 0x17, 0x17, 0xbb,
+	0x41, 0xa4, 0x8b, 0x40, 0x5e, 0xad, 0x98, 0xd4, 0xa4, 0xe2, 0x7c, 0xf4, 0x49, 0x9e, 0x36, 0x4d,
+	0x4a, 0xcd, 0x03, 0xa2, 0x07, 0xd1, 0x9e, 0xff, 0x95, 0xce, 0x2d, 0x9b, 0x30, 0x6e, 0xd8, 0xae,
+	0x4c, 0x78, 0x2f, 0x4b, 0xe5, 0x58, 0x50, 0x90, 0xd8, 0xfe, 0xb5, 0x00, 0xd7, 0x7b, 0xcc, 0xdc,
+	0x1c, 0x0e, 0x37, 0xc3, 0x9b, 0x3e, 0x39, 0xf4, 0x09, 0xe3, 0x18, 0x43, 0xc9, 0x31, 0x6c, 0xa2,
+	0xa0, 0x16, 0xea, 0x2c, 0xf5, 0x83, 0xcf, 0x78, 0x05, 0x16, 0x8f, 0x8c, 0x03, 0x9f, 0x28, 0x85,
+	0x16, 0xea, 0x54, 0xfa, 0x22, 0xc0, 0x3d, 0xa8, 0x46, 0xb8, 0xbb, 0xfc, 0xc4, 0x25, 0x4a, 0xb1,
+	0x85, 0x3a, 0xd5, 0xee, 0x6d, 0x2d, 0xc3, 0x0a, 0x2d, 0x22, 0x7b, 0x7c, 0xe2, 0x92, 0xfe, 0x55,
+	0x23, 0x1e, 0x62, 0x05, 0xae, 0x18, 0x83, 0x01, 0xf5, 0x1d, 0xae, 0x94, 0x02, 0xee, 0x30, 0x1c,
+	0xd1, 0xd3, 0xa7, 0x0e, 0xf1, 0x94, 0xc5, 0xe0, 0x5c, 0x04, 0xb8, 0x07, 0xd7, 0xc8, 0xb1, 0x6b,
+	0x79, 0x06, 0xb7, 0xa8, 0xb3, 0x3b, 0x34, 0x38, 0x51, 0xca, 0x2d, 0xd4, 0x59, 0xee, 0xaa, 0x9a,
+	0xf0, 0x49, 0x0b, 0x7d, 0xd2, 0x1e, 0x87, 0x3e, 0x6d, 0xbd, 0x71, 0xfa, 0xb2, 0x89, 0x9e, 0xfd,
+	0xd3, 0x44, 0xfd, 0xea, 0xb8, 0x78, 0xdb, 0xe0, 0x64, 0x03, 0xbe, 0x7b, 0xf5, 0xfc, 0x8e, 0x80,
+	0x6e, 0xd7, 0xa1, 0x36, 0xe5, 0x0e, 0x73, 0xa9, 0xc3, 0x48, 0xfb, 0xbf, 0x02, 0xd4, 0x7b, 0xcc,
+	0xfc, 0xd4, 0x1d, 0x11, 0x5e, 0xca, 0xbc, 0x77, 0xa1, 0x4a, 0x3d, 0xcb, 0xb4, 0x1c, 0xe3, 0x60,
+	0x37, 0xee, 0xe2, 0xd5, 0xf0, 0xf4, 0xb3, 0xc0, 0xcd, 0x9b, 0x50, 0xf1, 0x03, 0x50, 0x99, 0x54,
+	0x0c, 0x92, 0x96, 0xc5, 0x99, 0x48, 0xf9, 0x12, 0x6a, 0x11, 0xd2, 0x84, 0xf3, 0xa5, 0x5c, 0xce,
+	0xaf, 0x86, 0x30, 0x89, 0x63, 0xfc, 0x04, 0x56, 0xa5, 0x84, 0x09, 0xf4, 0xc5, 0x5c, 0xe8, 0x6f,
+	0xfa, 0x49, 0x73, 0x26, 0xbb, 0x5b, 0xce, 0xe8, 0xee, 0x95, 0x58, 0x77, 0x13, 0xed, 0xb8, 0x01,
+	0x6a, 0x9a, 0xe5, 0xb2, 0x23, 0x7f, 0x23, 0x78, 0x67, 0xfa, 0xfa, 0xe3, 0xa8, 0xbb, 0xf3, 0x0c,
+	0xf6, 0xd4, 0x64, 0x15, 0xe7, 0x9f, 0xac, 0xbc, 0x83, 0x9d, 0x78, 0xf4, 0xdb, 0x70, 0x6b, 0xf6,
+	0xb3, 0x49, 0x13, 0xbe, 0x0e, 0xa6, 0x72, 0x9b, 0x1c, 0x90, 0x4b, 0x4e, 0x65, 0x4c, 0x54, 0x21,
+	0x43, 0x54, 0x71, 0x76, 0x3f, 0xa6, 0xc8, 0xa4, 0x94, 0x1f, 0x11, 0xdc, 0x8c, 0xae, 0xb7, 0x2d,
+	0xc6, 0x2d, 0x67, 0xc0, 0x5f, 0x63, 0xcd, 0xc4, 0x94, 0x16, 0x33, 0x94, 0x96, 0xb2, 0x94, 0xde,
+	0x82, 0xf6, 0x2c, 0x29, 0x52, 0xf1, 0x17, 0xa0, 0xf4, 0x98, 0xb9, 0x43, 0xf8, 0xa6, 0x00, 0xde,
+	0x36, 0xb8, 0x11, 0xea, 0x8c, 0x34, 0x09, 0xa1, 0xd3, 0x9a, 0x92, 0xee, 0x6d, 0x54, 0x46, 0xec,
+	0x61, 0xd4, 0x7e, 0x0b, 0xea, 0x29, 0xc8, 0x92, 0xf6, 0x37, 0x04, 0xd7, 0xa3, 0xe6, 0x3e, 0x32,
+	0x3c, 0xc3, 0x66, 0x21, 0xeb, 0x03, 0x58, 0x32, 0x7c, 0xbe, 0x4f, 0x3d, 0x8b, 0x9f, 0x08, 0xe6,
+	0x2d, 0xe5, 0xcf, 0x3f, 0xee, 0xae, 0xc8, 0x97, 0xc4, 0xe6, 0x70, 0xe8, 0x11, 0xc6, 0x76, 0xb8,
+	0x67, 0x39, 0x66, 0x7f, 0x9c, 0x8a, 0x1f, 0x42, 0xd9, 0x0d, 0x80, 0x02, 0x59, 0xcb, 0xdd, 0x66,
+	0xe6, 0x57, 0x56, 0xf0, 0x6d, 0x95, 0x4e, 0x5f, 0x36, 0x17, 0xfa, 0xb2, 0x68, 0xa3, 0x3a, 0x12,
+	0x3f, 0x86, 0x93, 0x7b, 0x30, 0x29, 0x50, 0x8a, 0xff, 0x01, 0xc1, 0x0d, 0xf9, 0x68, 0x21, 0xec,
+	0xce, 0x60, 0x9f, 0xd8, 0x91, 0x71, 0x9f, 0x40, 0x99, 0x05, 0x07, 0x81, 0xfe, 0xe5, 0x6e, 0xe7,
+	0xe2, 0xed, 0x21, 0x00, 0x42, 0x4d, 0xa2, 0x7a, 0xdc, 0xe4, 0x42, 0x56, 0x93, 0x9b, 0xf0, 0x76,
+	0x86, 0x12, 0xa9, 0xf5, 0x73, 0x68, 0x4e, 0xcf, 0x6b, 0x52, 0x6d, 0xc6, 0x38, 0x5e, 0xc0, 0xdc,
+	0x86, 0x56, 0x36, 0xb0, 0x20, 0xef, 0xfe, 0xbe, 0x04, 0xc5, 0x1e, 0x33, 0xf1, 0x21, 0x54, 0xe2,
+	0x2f, 0x14, 0xac, 0x67, 0xfa, 0x91, 0xfe, 0x62, 0x56, 0xef, 0x5d, 0xbe, 0x40, 0x50, 0xe3, 0x6f,
+	0xe0, 0xda, 0xc4, 0xe6, 0xc0, 0xdd, 0x59, 0x20, 0xe9, 0x2f, 0x35, 0x75, 0x3d, 0x57, 0x8d, 0xe4,
+	0xfe, 0x05, 0x41, 0x3d, 0x73, 0x6d, 0xe1, 0x8f, 0x72, 0x40, 0x4e, 0x6d, 0x72, 0xf5, 0xe1, 0x9c,
+	0xd5, 0x63, 0x5b, 0x26, 0x5a, 0x36, 0xdb, 0x96, 0xf4, 0xad, 0xaa, 0xae, 0xe7, 0xaa, 0x91, 0xdc,
+	0x3f, 0x23, 0xa8, 0x65, 0xac, 0x23, 0xbc, 0x71, 0x31, 0x60, 0xd6, 0x3a, 0x55, 0x3f, 0x9c, 0xab,
+	0x56, 0x8a, 0x7a, 0x0a, 0xd5, 0xe4, 0x8a, 0xc2, 0x6b, 0xb3, 0xe0, 0x52, 0x17, 0xa5, 0xda, 0xcd,
+	0x53, 0x22, 0x89, 0x0f, 0xa1, 0x12, 0x5f, 0x2e, 0xb3, 0xbf, 0x13, 0x29, 0x7b, 0x52, 0xbd, 0x77,
+	0xf9, 0x02, 0x49, 0xf9, 0x3d, 0x02, 0x3c, 0xbd, 0x2a, 0xf0, 0xfd, 0x8b, 0xd4, 0xa7, 0xae, 0x0d,
+	0xf5, 0x41, 0xde, 0x32, 0xa9, 0xe2, 0x27, 0x04, 0xab, 0xa9, 0x6b, 0x03, 0xbf, 0x9f, 0x63, 0xaa,
+	0x92, 0x5a, 0x3e, 0x98, 0xa3, 0x52, 0xc8, 0x51, 0x17, 0xbf, 0x7d, 0xf5, 0xfc, 0x0e, 0xda, 0xb2,
+	0x4f, 0xcf, 0x1a, 0xe8, 0xc5, 0x59, 0x03, 0xfd, 0x7b, 0xd6, 0x40, 0xcf, 0xce, 0x1b, 0x0b, 0x2f,
+	0xce, 0x1b, 0x0b, 0x7f, 0x9d, 0x37, 0x16, 0x40, 0xb5, 0x68, 0x16, 0xfa, 0x23, 0xf4, 0xe4, 0xbe,
+	0x69, 0xf1, 0x7d, 0x7f, 0x4f, 0x1b, 0x50, 0x5b, 0x1f, 0x67, 0xdd, 0xb5, 0x68, 0x2c, 0xd2, 0x8f,
+	0x63, 0xff, 0x48, 0x46, 0x3f, 0x2a, 0xd9, 0x5e, 0x39, 0xf8, 0x15, 0xb5, 0xfe, 0xff, 0x00, 0x22,
+	0x99, 0x78, 0xb0, 0x5c, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -919,6 +1115,10 @@ type MsgClient interface {
 	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the attribute module's params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAttributeSchema defines a method for the owner of a name to register the schema of attributes with that name.
+	SetAttributeSchema(ctx context.Context, in *MsgSetAttributeSchemaRequest, opts ...grpc.CallOption) (*MsgSetAttributeSchemaResponse, error)
+	// DeleteAttributeSchema defines a method for the owner of a name to remove the schema of attributes with that name.
+	DeleteAttributeSchema(ctx context.Context, in *MsgDeleteAttributeSchemaRequest, opts ...grpc.CallOption) (*MsgDeleteAttributeSchemaResponse, error)
 }
 
 type msgClient struct {
@@ -992,6 +1192,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest
 	return out, nil
 }
 
+func (c *msgClient) SetAttributeSchema(ctx context.Context, in *MsgSetAttributeSchemaRequest, opts ...grpc.CallOption) (*MsgSetAttributeSchemaResponse, error) {
+	out := new(MsgSetAttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/SetAttributeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteAttributeSchema(ctx context.Context, in *MsgDeleteAttributeSchemaRequest, opts ...grpc.CallOption) (*MsgDeleteAttributeSchemaResponse, error) {
+	out := new(MsgDeleteAttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/DeleteAttributeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddAttribute defines a method to verify a particular invariance.
@@ -1008,6 +1226,10 @@ type MsgServer interface {
 	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the attribute module's params.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// SetAttributeSchema defines a method for the owner of a name to register the schema of attributes with that name.
+	SetAttributeSchema(context.Context, *MsgSetAttributeSchemaRequest) (*MsgSetAttributeSchemaResponse, error)
+	// DeleteAttributeSchema defines a method for the owner of a name to remove the schema of attributes with that name.
+	DeleteAttributeSchema(context.Context, *MsgDeleteAttributeSchemaRequest) (*MsgDeleteAttributeSchemaResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1035,6 +1257,12 @@ func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAc
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetAttributeSchema(ctx context.Context, req *MsgSetAttributeSchemaRequest) (*MsgSetAttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeSchema not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAttributeSchema(ctx context.Context, req *MsgDeleteAttributeSchemaRequest) (*MsgDeleteAttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttributeSchema not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1166,6 +1394,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/SetAttributeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttributeSchema(ctx, req.(*MsgSetAttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/DeleteAttributeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAttributeSchema(ctx, req.(*MsgDeleteAttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Msg",
@@ -1199,6 +1463,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAttributeSchema",
+			Handler:    _Msg_SetAttributeSchema_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeSchema",
+			Handler:    _Msg_DeleteAttributeSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/tx.proto",
@@ -1732,68 +2004,191 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAttributeSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetAttributeSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AttributeType != 0 {
-		n += 1 + sovTx(uint64(m.AttributeType))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExpirationDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAttributeSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttributeSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteAttributeSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovTx(uint64(m.AttributeType))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.OriginalValue)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1980,6 +2375,56 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAttributeSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schema.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAttributeSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2438,7 +2883,469 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2470,7 +3377,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2523,7 +3430,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2546,10 +3453,10 @@ func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2573,7 +3480,7 @@ func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2596,10 +3503,10 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2669,42 +3576,6 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2736,7 +3607,7 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2789,7 +3660,7 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2812,10 +3683,10 @@ func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2839,7 +3710,7 @@ func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,15 +3733,15 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2898,7 +3769,7 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2932,38 +3803,6 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2985,7 +3824,7 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3008,10 +3847,10 @@ func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3035,7 +3874,7 @@ func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3058,15 +3897,15 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3094,47 +3933,13 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3144,55 +3949,24 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3215,7 +3989,7 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3238,10 +4012,10 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3265,7 +4039,7 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,17 +4062,17 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3308,27 +4082,28 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3356,7 +4131,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3379,7 +4154,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3402,10 +4177,10 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3429,7 +4204,7 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAttributeSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3452,15 +4227,15 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAttributeSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAttributeSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3488,13 +4263,13 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3504,24 +4279,23 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3544,7 +4318,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAttributeSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3567,10 +4341,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAttributeSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAttributeSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
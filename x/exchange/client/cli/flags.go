@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -36,6 +37,7 @@ const (
 	FlagAsks                 = "asks"
 	FlagAssets               = "assets"
 	FlagAuthority            = "authority"
+	FlagAutoMatch            = "auto-match"
 	FlagBid                  = "bid"
 	FlagBidAdd               = "bid-add"
 	FlagBidRemove            = "bid-remove"
@@ -64,6 +66,8 @@ const (
 	FlagExternalID           = "external-id"
 	FlagExternalIDs          = "external-ids"
 	FlagFile                 = "file"
+	FlagGoodTilHeight        = "good-til-height"
+	FlagGoodTilTime          = "good-til-time"
 	FlagGrant                = "grant"
 	FlagIcon                 = "icon"
 	FlagInputs               = "inputs"
@@ -433,6 +437,20 @@ func ReadReqCoinFlag(flagSet *pflag.FlagSet, name string) (sdk.Coin, error) {
 	return *rv, nil
 }
 
+// ReadTimeFlag reads a string flag and converts it into a *time.Time.
+// The value must be in RFC3339 format. If the flag wasn't provided, this returns nil, nil.
+func ReadTimeFlag(flagSet *pflag.FlagSet, name string) (*time.Time, error) {
+	value, err := flagSet.GetString(name)
+	if len(value) == 0 || err != nil {
+		return nil, err
+	}
+	rv, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing --%s as an RFC3339 time: %w", name, err)
+	}
+	return &rv, nil
+}
+
 // ReadOrderIDsFlag reads a UintSlice flag and converts it into a []uint64.
 func ReadOrderIDsFlag(flagSet *pflag.FlagSet, name string) ([]uint64, error) {
 	ids, err := flagSet.GetUintSlice(name)
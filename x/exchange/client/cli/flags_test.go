@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -1418,6 +1419,68 @@ func TestReadReqCoinFlag(t *testing.T) {
 	}
 }
 
+func TestReadTimeFlag(t *testing.T) {
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string
+		expTime  *time.Time
+		expErr   string
+	}{
+		{
+			testName: "unknown flag",
+			name:     "unknown",
+			expErr:   "flag accessed but not defined: unknown",
+		},
+		{
+			testName: "wrong flag type",
+			name:     flagInt,
+			expErr:   "trying to get string value of flag of type int",
+		},
+		{
+			testName: "nothing provided",
+			name:     flagString,
+			expErr:   "",
+		},
+		{
+			testName: "invalid time",
+			flags:    []string{"--" + flagString, "2023-11-14"},
+			name:     flagString,
+			expErr: "error parsing --" + flagString + " as an RFC3339 time: " +
+				"parsing time \"2023-11-14\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"\" as \"T\"",
+		},
+		{
+			testName: "utc time",
+			flags:    []string{"--" + flagString, "2023-11-14T22:13:20Z"},
+			name:     flagString,
+			expTime:  timeP(time.Unix(1_700_000_000, 0).UTC()),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(flagString, "", "A string")
+			flagSet.Int(flagInt, 0, "An int")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var actTime *time.Time
+			testFunc := func() {
+				actTime, err = cli.ReadTimeFlag(flagSet, tc.name)
+			}
+			require.NotPanics(t, testFunc, "ReadTimeFlag(%q)", tc.name)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadTimeFlag(%q) error", tc.name)
+			assert.Equal(t, tc.expTime, actTime, "ReadTimeFlag(%q)", tc.name)
+		})
+	}
+}
+
+// timeP returns a pointer to the provided time.
+func timeP(t time.Time) *time.Time {
+	return &t
+}
+
 func TestReadOrderIDsFlag(t *testing.T) {
 	tests := []struct {
 		testName string
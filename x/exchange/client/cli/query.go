@@ -43,6 +43,7 @@ func CmdQuery() *cobra.Command {
 		CmdQueryGetPaymentsWithTarget(),
 		CmdQueryGetAllPayments(),
 		CmdQueryPaymentFeeCalc(),
+		CmdQueryResolveNav(),
 	)
 
 	return cmd
@@ -368,3 +369,17 @@ func CmdQueryPaymentFeeCalc() *cobra.Command {
 	SetupCmdQueryPaymentFeeCalc(cmd)
 	return cmd
 }
+
+// CmdQueryResolveNav creates the nav sub-command for the exchange query command.
+func CmdQueryResolveNav() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nav",
+		Aliases: []string{"resolve-nav"},
+		Short:   "Get the NAV from one denom to another, possibly derived through an intermediary denom",
+		RunE:    genericQueryRunE(MakeQueryResolveNav, exchange.QueryClient.ResolveNav),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryResolveNav(cmd)
+	return cmd
+}
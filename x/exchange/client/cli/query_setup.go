@@ -703,3 +703,39 @@ func MakeQueryPaymentFeeCalc(clientCtx client.Context, flagSet *pflag.FlagSet, _
 
 	return req, errors.Join(errs...)
 }
+
+// SetupCmdQueryResolveNav adds all the flags needed for MakeQueryResolveNav.
+func SetupCmdQueryResolveNav(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id whose intermediary denom can be used")
+
+	AddUseArgs(cmd,
+		"<assets denom> <price denom>",
+		OptFlagUse(FlagMarket, "market id"),
+	)
+	AddUseDetails(cmd,
+		"If there isn't a NAV directly from the <assets denom> to the <price denom>, one is derived",
+		"through the market's intermediary denom (when a market is provided), or one of the nav reference denoms.",
+	)
+	AddQueryExample(cmd, "nhash", "usd.trading")
+	AddQueryExample(cmd, "nhash", "usd.trading", "--"+FlagMarket, "3")
+
+	cmd.Args = cobra.ExactArgs(2)
+}
+
+// MakeQueryResolveNav reads all the SetupCmdQueryResolveNav flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryResolveNav(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryResolveNavRequest, error) {
+	req := &exchange.QueryResolveNavRequest{}
+
+	if len(args) > 0 {
+		req.AssetsDenom = args[0]
+	}
+	if len(args) > 1 {
+		req.PriceDenom = args[1]
+	}
+
+	var err error
+	req.MarketId, err = flagSet.GetUint32(FlagMarket)
+
+	return req, err
+}
@@ -1323,7 +1323,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 			cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 			cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
 			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAutoMatch, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
 			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
 			cli.FlagBips, cli.FlagDenom,
 			cli.FlagProposal,
@@ -1334,7 +1334,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 			"[--create-ask <coins>]", "[--create-bid <coins>]", "[--create-commitment <coins>]",
 			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]",
 			"[--buyer-flat <coins>]", "[--buyer-ratios <fee ratios>]",
-			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
+			"[--accepting-orders]", "[--allow-user-settle]", "[--auto-match]", "[--accepting-commitments]",
 			"[--access-grants <access grants>]",
 			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
 			"[--bips <bips>]", "[--denom <denom>]",
@@ -1348,7 +1348,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 		cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 		cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
 		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAutoMatch, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
 		cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
 		cli.FlagBips, cli.FlagDenom,
 		cli.FlagProposal,
@@ -2117,3 +2117,48 @@ func TestMakeQueryPaymentFeeCalc(t *testing.T) {
 		})
 	}
 }
+
+func TestSetupCmdQueryResolveNav(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryResolveNav",
+		setup:    cli.SetupCmdQueryResolveNav,
+		expFlags: []string{cli.FlagMarket},
+		expInUse: []string{
+			"<assets denom> <price denom>", "[--market <market id>]",
+			"If there isn't a NAV directly from the <assets denom> to the <price denom>, one is derived",
+			"through the market's intermediary denom (when a market is provided), or one of the nav reference denoms.",
+		},
+		expExamples: []string{
+			exampleStart + " nhash usd.trading",
+			exampleStart + " nhash usd.trading --market 3",
+		},
+	})
+}
+
+func TestMakeQueryResolveNav(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryResolveNavRequest]{
+		makerName: "MakeQueryResolveNav",
+		maker:     cli.MakeQueryResolveNav,
+		setup:     cli.SetupCmdQueryResolveNav,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryResolveNavRequest]{
+		{
+			name:   "just denoms",
+			args:   []string{"apple", "banana"},
+			expReq: &exchange.QueryResolveNavRequest{AssetsDenom: "apple", PriceDenom: "banana"},
+		},
+		{
+			name:   "with market",
+			flags:  []string{"--market", "7"},
+			args:   []string{"apple", "banana"},
+			expReq: &exchange.QueryResolveNavRequest{AssetsDenom: "apple", PriceDenom: "banana", MarketId: 7},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
@@ -117,6 +117,8 @@ func (s *CmdTestSuite) TestCmdQueryGetOrder() {
       amount: "4200"
       denom: acorn
     external_id: my-id-42
+    good_til_block_height: "0"
+    good_til_time: null
     market_id: 420
     price:
       amount: "17640"
@@ -575,6 +577,7 @@ market:
     - PERMISSION_PERMISSIONS
     - PERMISSION_ATTRIBUTES
   allow_user_settlement: true
+  auto_match: false
   commitment_settlement_bips: 50
   fee_buyer_settlement_flat:
   - amount: "105"
@@ -682,6 +685,7 @@ func (s *CmdTestSuite) TestCmdQueryParams() {
   fee_create_payment_flat:
   - amount: "10000000000"
     denom: nhash
+  nav_reference_denoms: []
 `,
 		},
 		{
@@ -1106,3 +1110,29 @@ fee_create:
 		})
 	}
 }
+
+func (s *CmdTestSuite) TestCmdQueryResolveNav() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"nav", "apple"},
+			expInErr: []string{"accepts 2 arg(s), received 1"},
+		},
+		{
+			name:     "no nav",
+			args:     []string{"resolve-nav", "apple", "pear", "--market", "420"},
+			expInErr: []string{"no nav found from \"apple\" to \"pear\""},
+		},
+		{
+			name:     "unknown market",
+			args:     []string{"nav", "apple", "pear", "--market", "419"},
+			expInErr: []string{"market 419 does not exist"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
@@ -28,6 +28,7 @@ func CmdTx() *cobra.Command {
 		CmdTxCreateBid(),
 		CmdTxCommitFunds(),
 		CmdTxCancelOrder(),
+		CmdTxAmendOrder(),
 		CmdTxFillBids(),
 		CmdTxFillAsks(),
 		CmdTxMarketSettle(),
@@ -38,6 +39,7 @@ func CmdTx() *cobra.Command {
 		CmdTxMarketUpdateDetails(),
 		CmdTxMarketUpdateAcceptingOrders(),
 		CmdTxMarketUpdateUserSettle(),
+		CmdTxMarketUpdateAutoMatch(),
 		CmdTxMarketUpdateAcceptingCommitments(),
 		CmdTxMarketUpdateIntermediaryDenom(),
 		CmdTxMarketManagePermissions(),
@@ -113,6 +115,20 @@ func CmdTxCancelOrder() *cobra.Command {
 	return cmd
 }
 
+// CmdTxAmendOrder creates the amend-order sub-command for the exchange tx command.
+func CmdTxAmendOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "amend-order",
+		Aliases: []string{"amend", "update-order"},
+		Short:   "Change the assets, price, and settlement fees of an existing order",
+		RunE:    genericTxRunE(MakeMsgAmendOrder),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxAmendOrder(cmd)
+	return cmd
+}
+
 // CmdTxFillBids creates the fill-bids sub-command for the exchange tx command.
 func CmdTxFillBids() *cobra.Command {
 	cmd := &cobra.Command{
@@ -251,6 +267,20 @@ func CmdTxMarketUpdateUserSettle() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketUpdateAutoMatch creates the market-auto-match sub-command for the exchange tx command.
+func CmdTxMarketUpdateAutoMatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-auto-match",
+		Aliases: []string{"market-update-auto-match", "update-market-auto-match", "update-auto-match"},
+		Short:   "Change whether a market automatically matches and settles its orders",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateAutoMatch),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateAutoMatch(cmd)
+	return cmd
+}
+
 // CmdTxMarketUpdateAcceptingCommitments creates the market-accepting-commitments sub-command for the exchange tx command.
 func CmdTxMarketUpdateAcceptingCommitments() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,6 +23,8 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
 	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
 	cmd.Flags().String(FlagCreationFee, "", "The ask order creation fee, e.g. 10nhash")
+	cmd.Flags().String(FlagGoodTilTime, "", "The time (RFC3339) at which this order expires, e.g. 2025-01-02T15:04:05Z")
+	cmd.Flags().Uint64(FlagGoodTilHeight, 0, "The block height at which this order expires")
 
 	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagSeller)
 	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPrice)
@@ -37,6 +39,8 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 		OptFlagUse(FlagPartial, ""),
 		OptFlagUse(FlagExternalID, "external id"),
 		OptFlagUse(FlagCreationFee, "creation fee"),
+		OptFlagUse(FlagGoodTilTime, "good til time"),
+		OptFlagUse(FlagGoodTilHeight, "good til height"),
 	)
 	AddUseDetails(cmd, ReqSignerDesc(FlagSeller))
 
@@ -48,7 +52,7 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 func MakeMsgCreateAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateAskRequest, error) {
 	msg := &exchange.MsgCreateAskRequest{}
 
-	errs := make([]error, 8)
+	errs := make([]error, 10)
 	msg.AskOrder.Seller, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSeller)
 	msg.AskOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 	msg.AskOrder.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
@@ -57,6 +61,8 @@ func MakeMsgCreateAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []stri
 	msg.AskOrder.AllowPartial, errs[5] = flagSet.GetBool(FlagPartial)
 	msg.AskOrder.ExternalId, errs[6] = flagSet.GetString(FlagExternalID)
 	msg.OrderCreationFee, errs[7] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.AskOrder.GoodTilTime, errs[8] = ReadTimeFlag(flagSet, FlagGoodTilTime)
+	msg.AskOrder.GoodTilBlockHeight, errs[9] = flagSet.GetUint64(FlagGoodTilHeight)
 
 	return msg, errors.Join(errs...)
 }
@@ -71,6 +77,8 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
 	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
 	cmd.Flags().String(FlagCreationFee, "", "The bid order creation fee, e.g. 10nhash")
+	cmd.Flags().String(FlagGoodTilTime, "", "The time (RFC3339) at which this order expires, e.g. 2025-01-02T15:04:05Z")
+	cmd.Flags().Uint64(FlagGoodTilHeight, 0, "The block height at which this order expires")
 
 	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagBuyer)
 	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPrice)
@@ -85,6 +93,8 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 		OptFlagUse(FlagPartial, ""),
 		OptFlagUse(FlagExternalID, "external id"),
 		OptFlagUse(FlagCreationFee, "creation fee"),
+		OptFlagUse(FlagGoodTilTime, "good til time"),
+		OptFlagUse(FlagGoodTilHeight, "good til height"),
 	)
 	AddUseDetails(cmd, ReqSignerDesc(FlagBuyer))
 
@@ -96,7 +106,7 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 func MakeMsgCreateBid(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateBidRequest, error) {
 	msg := &exchange.MsgCreateBidRequest{}
 
-	errs := make([]error, 8)
+	errs := make([]error, 10)
 	msg.BidOrder.Buyer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagBuyer)
 	msg.BidOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 	msg.BidOrder.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
@@ -105,6 +115,8 @@ func MakeMsgCreateBid(clientCtx client.Context, flagSet *pflag.FlagSet, _ []stri
 	msg.BidOrder.AllowPartial, errs[5] = flagSet.GetBool(FlagPartial)
 	msg.BidOrder.ExternalId, errs[6] = flagSet.GetString(FlagExternalID)
 	msg.OrderCreationFee, errs[7] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.BidOrder.GoodTilTime, errs[8] = ReadTimeFlag(flagSet, FlagGoodTilTime)
+	msg.BidOrder.GoodTilBlockHeight, errs[9] = flagSet.GetUint64(FlagGoodTilHeight)
 
 	return msg, errors.Join(errs...)
 }
@@ -179,6 +191,50 @@ func MakeMsgCancelOrder(clientCtx client.Context, flagSet *pflag.FlagSet, args [
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxAmendOrder adds all the flags needed for the MakeMsgAmendOrder.
+func SetupCmdTxAmendOrder(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOwner, "", "The order owner (defaults to --from account)")
+	cmd.Flags().Uint64(FlagOrder, 0, "The order id")
+	cmd.Flags().String(FlagAssets, "", "The new assets for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagPrice, "", "The new price for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagSettlementFee, "", "The new settlement fee Coin string for this order, e.g. 10nhash")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagOwner)
+	MarkFlagsRequired(cmd, FlagAssets, FlagPrice)
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<order id>|--%s <order id>}", FlagOrder),
+		ReqSignerUse(FlagOwner),
+		ReqFlagUse(FlagAssets, "assets"),
+		ReqFlagUse(FlagPrice, "price"),
+		UseFlagsBreak,
+		OptFlagUse(FlagSettlementFee, "settlement fees"),
+	)
+	AddUseDetails(cmd,
+		ReqSignerDesc(FlagOwner),
+		"The <order id> must be provided either as the first argument or using the --order flag, but not both.",
+		`The provided assets, price, and settlement fees replace the order's current values.
+For ask orders, the settlement fee can have at most one coin, which becomes the seller settlement flat fee.`,
+	)
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeMsgAmendOrder reads all the SetupCmdTxAmendOrder flags and the provided args and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgAmendOrder(clientCtx client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.MsgAmendOrderRequest, error) {
+	msg := &exchange.MsgAmendOrderRequest{}
+
+	errs := make([]error, 5)
+	msg.Owner, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagOwner)
+	msg.OrderId, errs[1] = ReadFlagOrderOrArg(flagSet, args)
+	msg.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
+	msg.Price, errs[3] = ReadReqCoinFlag(flagSet, FlagPrice)
+	msg.SettlementFees, errs[4] = ReadCoinsFlag(flagSet, FlagSettlementFee)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxFillBids adds all the flags needed for MakeMsgFillBids.
 func SetupCmdTxFillBids(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSeller, "", "The seller (defaults to --from account)")
@@ -588,6 +644,37 @@ func MakeMsgMarketUpdateUserSettle(clientCtx client.Context, flagSet *pflag.Flag
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxMarketUpdateAutoMatch adds all the flags needed for MakeMsgMarketUpdateAutoMatch.
+func SetupCmdTxMarketUpdateAutoMatch(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	AddFlagsEnableDisable(cmd, "auto_match")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqEnableDisableUse,
+	)
+	AddUseDetails(cmd, ReqAdminDesc, ReqEnableDisableDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateAutoMatch reads all the SetupCmdTxMarketUpdateAutoMatch flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateAutoMatch(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateAutoMatchRequest, error) {
+	msg := &exchange.MsgMarketUpdateAutoMatchRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.AutoMatch, errs[2] = ReadFlagsEnableDisable(flagSet)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxMarketUpdateAcceptingCommitments adds all the flags needed for MakeMarketUpdateAcceptingCommitmentsOrders.
 func SetupCmdTxMarketUpdateAcceptingCommitments(cmd *cobra.Command) {
 	AddFlagsAdmin(cmd)
@@ -964,6 +1051,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagBuyerRatios, nil, "The buyer settlement fee ratios, e.g. 100nhash:1nhash (repeatable)")
 	cmd.Flags().Bool(FlagAcceptingOrders, false, "The market should allow orders to be created")
 	cmd.Flags().Bool(FlagAllowUserSettle, false, "The market should allow user-initiated settlement")
+	cmd.Flags().Bool(FlagAutoMatch, false, "The market should automatically match and settle orders")
 	cmd.Flags().StringSlice(FlagAccessGrants, nil, "The <access grants> that the market should have (repeatable)")
 	cmd.Flags().StringSlice(FlagReqAttrAsk, nil, "Attributes required to create ask orders (repeatable)")
 	cmd.Flags().StringSlice(FlagReqAttrBid, nil, "Attributes required to create bid orders (repeatable)")
@@ -978,7 +1066,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 		FlagMarket, FlagName, FlagDescription, FlagURL, FlagIcon,
 		FlagCreateAsk, FlagCreateBid, FlagCreateCommitment,
 		FlagSellerFlat, FlagSellerRatios, FlagBuyerFlat, FlagBuyerRatios,
-		FlagAcceptingOrders, FlagAllowUserSettle, FlagAcceptingCommitments, FlagAccessGrants,
+		FlagAcceptingOrders, FlagAllowUserSettle, FlagAutoMatch, FlagAcceptingCommitments, FlagAccessGrants,
 		FlagReqAttrAsk, FlagReqAttrBid, FlagReqAttrCommitment,
 		FlagBips, FlagDenom,
 		FlagProposal,
@@ -1005,6 +1093,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 		UseFlagsBreak,
 		OptFlagUse(FlagAcceptingOrders, ""),
 		OptFlagUse(FlagAllowUserSettle, ""),
+		OptFlagUse(FlagAutoMatch, ""),
 		OptFlagUse(FlagAcceptingCommitments, ""),
 		UseFlagsBreak,
 		OptFlagUse(FlagAccessGrants, "access grants"),
@@ -1031,7 +1120,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 func MakeMsgGovCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgGovCreateMarketRequest, error) {
 	var msg *exchange.MsgGovCreateMarketRequest
 
-	errs := make([]error, 20)
+	errs := make([]error, 21)
 	msg, errs[0] = ReadMsgGovCreateMarketRequestFromProposalFlag(clientCtx, flagSet)
 	msg.Authority, errs[1] = ReadFlagAuthorityOrDefault(flagSet, msg.Authority)
 	msg.Market.MarketId, errs[2] = ReadFlagUint32OrDefault(flagSet, FlagMarket, msg.Market.MarketId)
@@ -1052,6 +1141,7 @@ func MakeMsgGovCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _
 	msg.Market.ReqAttrCreateCommitment, errs[17] = ReadFlagStringSliceOrDefault(flagSet, FlagReqAttrCommitment, msg.Market.ReqAttrCreateCommitment)
 	msg.Market.CommitmentSettlementBips, errs[18] = ReadFlagUint32OrDefault(flagSet, FlagBips, msg.Market.CommitmentSettlementBips)
 	msg.Market.IntermediaryDenom, errs[19] = ReadFlagStringOrDefault(flagSet, FlagDenom, msg.Market.IntermediaryDenom)
+	msg.Market.AutoMatch, errs[20] = ReadFlagBoolOrDefault(flagSet, FlagAutoMatch, msg.Market.AutoMatch)
 
 	return msg, errors.Join(errs...)
 }
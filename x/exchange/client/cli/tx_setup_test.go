@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -86,6 +87,7 @@ func TestSetupCmdTxCreateAsk(t *testing.T) {
 		expFlags: []string{
 			cli.FlagSeller, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
 			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagCreationFee,
+			cli.FlagGoodTilTime, cli.FlagGoodTilHeight,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -99,6 +101,7 @@ func TestSetupCmdTxCreateAsk(t *testing.T) {
 			"--seller", "--market <market id>", "--assets <assets>", "--price <price>",
 			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
 			"[--external-id <external id>]", "[--creation-fee <creation fee>]",
+			"[--good-til-time <good til time>]", "[--good-til-height <good til height>]",
 			cli.ReqSignerDesc(cli.FlagSeller),
 		},
 	})
@@ -132,6 +135,7 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 				"--assets", "10apple", "--price", "55plum",
 				"--settlement-fee", "5fig", "--partial",
 				"--external-id", "uuid", "--creation-fee", "6grape",
+				"--good-til-time", "2023-11-14T22:13:20Z", "--good-til-height", "12345",
 			},
 			expMsg: &exchange.MsgCreateAskRequest{
 				AskOrder: exchange.AskOrder{
@@ -142,6 +146,8 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 					SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
 					AllowPartial:            true,
 					ExternalId:              "uuid",
+					GoodTilTime:             timeP(time.Unix(1_700_000_000, 0).UTC()),
+					GoodTilBlockHeight:      12345,
 				},
 				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
 			},
@@ -162,6 +168,7 @@ func TestSetupCmdTxCreateBid(t *testing.T) {
 		expFlags: []string{
 			cli.FlagBuyer, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
 			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagCreationFee,
+			cli.FlagGoodTilTime, cli.FlagGoodTilHeight,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -175,6 +182,7 @@ func TestSetupCmdTxCreateBid(t *testing.T) {
 			"--buyer", "--market <market id>", "--assets <assets>", "--price <price>",
 			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
 			"[--external-id <external id>]", "[--creation-fee <creation fee>]",
+			"[--good-til-time <good til time>]", "[--good-til-height <good til height>]",
 			cli.ReqSignerDesc(cli.FlagBuyer),
 		},
 	})
@@ -208,6 +216,7 @@ func TestMakeMsgCreateBid(t *testing.T) {
 				"--assets", "10apple", "--price", "55plum",
 				"--settlement-fee", "5fig", "--partial",
 				"--external-id", "uuid", "--creation-fee", "6grape",
+				"--good-til-time", "2023-11-14T22:13:20Z", "--good-til-height", "12345",
 			},
 			expMsg: &exchange.MsgCreateBidRequest{
 				BidOrder: exchange.BidOrder{
@@ -218,6 +227,8 @@ func TestMakeMsgCreateBid(t *testing.T) {
 					BuyerSettlementFees: sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)}},
 					AllowPartial:        true,
 					ExternalId:          "uuid",
+					GoodTilTime:         timeP(time.Unix(1_700_000_000, 0).UTC()),
+					GoodTilBlockHeight:  12345,
 				},
 				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
 			},
@@ -359,6 +370,98 @@ func TestMakeMsgCancelOrder(t *testing.T) {
 	}
 }
 
+func TestSetupCmdTxAmendOrder(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxAmendOrder",
+		setup: cli.SetupCmdTxAmendOrder,
+		expFlags: []string{
+			cli.FlagOwner, cli.FlagOrder, cli.FlagAssets, cli.FlagPrice, cli.FlagSettlementFee,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagOwner:  {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagAssets: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+		},
+		expInUse: []string{
+			"{<order id>|--order <order id>}",
+			"{--from|--owner} <owner>",
+			"--assets <assets>", "--price <price>",
+			"[--settlement-fee <settlement fees>]",
+			cli.ReqSignerDesc(cli.FlagOwner),
+			"The <order id> must be provided either as the first argument or using the --order flag, but not both.",
+			"For ask orders, the settlement fee can have at most one coin, which becomes the seller settlement flat fee.",
+		},
+	})
+}
+
+func TestMakeMsgAmendOrder(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgAmendOrderRequest]{
+		makerName: "MakeMsgAmendOrder",
+		maker:     cli.MakeMsgAmendOrder,
+		setup:     cli.SetupCmdTxAmendOrder,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgAmendOrderRequest]{
+		{
+			name:   "nothing",
+			expMsg: &exchange.MsgAmendOrderRequest{},
+			expErr: joinErrs(
+				"no <owner> provided",
+				"no <order id> provided",
+				"missing required --assets flag",
+				"missing required --price flag",
+			),
+		},
+		{
+			name:      "from and arg",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--assets", "10apple", "--price", "15peach"},
+			args:      []string{"87"},
+			expMsg: &exchange.MsgAmendOrderRequest{
+				Owner:   sdk.AccAddress("FromAddress_________").String(),
+				OrderId: 87,
+				Assets:  sdk.NewInt64Coin("apple", 10),
+				Price:   sdk.NewInt64Coin("peach", 15),
+			},
+		},
+		{
+			name: "all the flags",
+			flags: []string{
+				"--order", "52", "--owner", "someone", "--assets", "3acorn", "--price", "7plum",
+				"--settlement-fee", "1plum,2fig",
+			},
+			expMsg: &exchange.MsgAmendOrderRequest{
+				Owner:          "someone",
+				OrderId:        52,
+				Assets:         sdk.NewInt64Coin("acorn", 3),
+				Price:          sdk.NewInt64Coin("plum", 7),
+				SettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 2), sdk.NewInt64Coin("plum", 1)),
+			},
+		},
+		{
+			name:  "bad coins",
+			flags: []string{"--owner", "someone", "--order", "3", "--assets", "x", "--price", "7plum", "--settlement-fee", "q"},
+			expMsg: &exchange.MsgAmendOrderRequest{
+				Owner:   "someone",
+				OrderId: 3,
+				Price:   sdk.NewInt64Coin("plum", 7),
+			},
+			expErr: joinErrs(
+				"error parsing --assets as a coin: invalid coin expression: \"x\"",
+				"error parsing --settlement-fee as coins: invalid coin expression: \"q\"",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdTxFillBids(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdTxFillBids",
@@ -1380,6 +1483,88 @@ func TestMakeMsgMarketUpdateUserSettle(t *testing.T) {
 	}
 }
 
+func TestSetupCmdTxMarketUpdateAutoMatch(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateAutoMatch",
+		setup: cli.SetupCmdTxMarketUpdateAutoMatch,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateAutoMatch(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateAutoMatchRequest]{
+		makerName: "MakeMsgMarketUpdateAutoMatch",
+		maker:     cli.MakeMsgMarketUpdateAutoMatch,
+		setup:     cli.SetupCmdTxMarketUpdateAutoMatch,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAutoMatchRequest]{
+		{
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateAutoMatchRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     sdk.AccAddress("FromAddress_________").String(),
+				MarketId:  4,
+				AutoMatch: true,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     "Blake",
+				MarketId:  94,
+				AutoMatch: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdTxMarketUpdateAcceptingCommitments(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdTxMarketUpdateAcceptingCommitments",
@@ -2270,7 +2455,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 			cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 			cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
 			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAutoMatch, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
 			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
 			cli.FlagBips, cli.FlagDenom,
 			cli.FlagProposal,
@@ -2281,7 +2466,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 			"[--create-ask <coins>]", "[--create-bid <coins>]", "[--create-commitment <coins>]",
 			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]",
 			"[--buyer-flat <coins>]", "[--buyer-ratios <fee ratios>]",
-			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
+			"[--accepting-orders]", "[--allow-user-settle]", "[--auto-match]", "[--accepting-commitments]",
 			"[--access-grants <access grants>]",
 			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
 			"[--bips <bips>]", "[--denom <denom>]",
@@ -2295,7 +2480,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 		cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 		cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
 		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAutoMatch, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
 		cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
 		cli.FlagBips, cli.FlagDenom,
 		cli.FlagProposal,
@@ -2345,6 +2530,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 			},
 			AcceptingOrders:     true,
 			AllowUserSettlement: true,
+			AutoMatch:           true,
 			AccessGrants: []exchange.AccessGrant{
 				{
 					Address:     sdk.AccAddress("ag1_________________").String(),
@@ -2395,7 +2581,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 				"--create-ask", "10fig", "--create-bid", "5grape", "--create-commitment", "7honeydew",
 				"--seller-flat", "12fig", "--seller-ratios", "100prune:1prune",
 				"--buyer-flat", "17fig", "--buyer-ratios", "88plum:3plum",
-				"--accepting-orders", "--allow-user-settle", "--accepting-commitments",
+				"--accepting-orders", "--allow-user-settle", "--auto-match", "--accepting-commitments",
 				"--access-grants", "addr1:settle+cancel", "--access-grants", "addr2:update+permissions",
 				"--req-attr-ask", "seller.kyc", "--req-attr-bid", "buyer.kyc", "--req-attr-commitment", "com.kyc",
 				"--name", "Special market", "--description", "This market is special.",
@@ -2425,6 +2611,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 					},
 					AcceptingOrders:     true,
 					AllowUserSettlement: true,
+					AutoMatch:           true,
 					AccessGrants: []exchange.AccessGrant{
 						{
 							Address:     "addr1",
@@ -2481,6 +2668,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 					CommitmentSettlementBips:  fileMsg.Market.CommitmentSettlementBips,
 					IntermediaryDenom:         fileMsg.Market.IntermediaryDenom,
 					ReqAttrCreateCommitment:   fileMsg.Market.ReqAttrCreateCommitment,
+					AutoMatch:                 fileMsg.Market.AutoMatch,
 				},
 			},
 		},
@@ -233,6 +233,53 @@ func (s *CmdTestSuite) TestCmdTxCancelOrder() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxAmendOrder() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no order id",
+			args:     []string{"amend-order", "--from", s.addr2.String(), "--assets", "10apple", "--price", "10peach"},
+			expInErr: []string{"no <order id> provided"},
+		},
+		{
+			name: "order does not exist",
+			args: []string{"amend", "18446744073709551615", "--from", s.addr2.String(),
+				"--assets", "10apple", "--price", "10peach"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"order 18446744073709551615 does not exist"},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "order exists",
+			preRun: func() ([]string, func(txResponse *sdk.TxResponse)) {
+				newOrder := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 5,
+					Seller:   s.addr2.String(),
+					Assets:   sdk.NewInt64Coin("apple", 100),
+					Price:    sdk.NewInt64Coin("peach", 150),
+				})
+				orderID := s.createOrder(newOrder, nil)
+				orderIDStr := orderIDStringer(orderID)
+
+				expOrder := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					MarketId: 5,
+					Seller:   s.addr2.String(),
+					Assets:   sdk.NewInt64Coin("apple", 80),
+					Price:    sdk.NewInt64Coin("peach", 130),
+				})
+				return []string{"--order", orderIDStr}, s.getOrderFollowup(orderIDStr, expOrder)
+			},
+			args:         []string{"amend-order", "--from", s.addr2.String(), "--assets", "80apple", "--price", "130peach"},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxFillBids() {
 	tests := []txCmdTestCase{
 		{
@@ -367,6 +414,7 @@ func (s *CmdTestSuite) TestCmdTxFillAsks() {
 			},
 			args: []string{"fill-asks", "--from", s.addr4.String(), "--market", "5",
 				"--price", "2500peach", "--settlement-fee", "75peach", "--creation-fee", "10peach"},
+			gas:          300_000,
 			expectedCode: 0,
 		},
 	}
@@ -3,6 +3,7 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -138,6 +139,41 @@ func (n NetAssetPrice) Validate() error {
 	return nil
 }
 
+// Invert returns a NetAssetPrice with the assets and price of this one swapped.
+func (n NetAssetPrice) Invert() NetAssetPrice {
+	return NetAssetPrice{Assets: n.Price, Price: n.Assets}
+}
+
+// ChainNAVs combines a NAV from some assets to an intermediary denom with a NAV from that intermediary
+// denom to a price denom, resulting in a NAV from the assets to the price denom. The resulting amounts
+// are the products of the provided amounts, reduced to lowest terms.
+func ChainNAVs(toVia, fromVia NetAssetPrice) (*NetAssetPrice, error) {
+	if toVia.Price.Denom != fromVia.Assets.Denom {
+		return nil, fmt.Errorf("cannot chain nav %s with nav %s: denom mismatch", toVia, fromVia)
+	}
+	if !toVia.Assets.Amount.IsPositive() || !fromVia.Assets.Amount.IsPositive() || toVia.Price.Amount.IsNegative() || fromVia.Price.Amount.IsNegative() {
+		return nil, fmt.Errorf("cannot chain nav %s with nav %s: assets must be positive and prices cannot be negative", toVia, fromVia)
+	}
+
+	assets := new(big.Int).Mul(toVia.Assets.Amount.BigInt(), fromVia.Assets.Amount.BigInt())
+	price := new(big.Int).Mul(toVia.Price.Amount.BigInt(), fromVia.Price.Amount.BigInt())
+	if price.Sign() == 0 {
+		assets.SetInt64(1)
+	} else {
+		gcd := new(big.Int).GCD(nil, nil, assets, price)
+		assets.Quo(assets, gcd)
+		price.Quo(price, gcd)
+	}
+	if assets.BitLen() > sdkmath.MaxBitLen || price.BitLen() > sdkmath.MaxBitLen {
+		return nil, fmt.Errorf("cannot chain nav %s with nav %s: amount overflow", toVia, fromVia)
+	}
+
+	return &NetAssetPrice{
+		Assets: sdk.Coin{Denom: toVia.Assets.Denom, Amount: sdkmath.NewIntFromBigInt(assets)},
+		Price:  sdk.Coin{Denom: fromVia.Price.Denom, Amount: sdkmath.NewIntFromBigInt(price)},
+	}, nil
+}
+
 // ValidateEventTag makes sure an event tag is okay.
 func ValidateEventTag(eventTag string) error {
 	if len(eventTag) > MaxEventTagLength {
@@ -27,6 +27,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// NavConfidence indicates how a NAV was found.
+type NavConfidence int32
+
+const (
+	// NAV_CONFIDENCE_UNSPECIFIED is the zero-value NavConfidence; it is not used for a found NAV.
+	NavConfidence_unspecified NavConfidence = 0
+	// NAV_CONFIDENCE_DIRECT is for a NAV that exists from the assets denom to the price denom.
+	NavConfidence_direct NavConfidence = 1
+	// NAV_CONFIDENCE_DERIVED is for a NAV derived from a NAV to an intermediary denom and one from there to the price denom.
+	NavConfidence_derived NavConfidence = 2
+	// NAV_CONFIDENCE_DERIVED_INVERTED is for a NAV derived like NAV_CONFIDENCE_DERIVED, except that the second
+	// step used the inverse of a NAV from the price denom to the intermediary denom.
+	NavConfidence_derived_inverted NavConfidence = 3
+)
+
+var NavConfidence_name = map[int32]string{
+	0: "NAV_CONFIDENCE_UNSPECIFIED",
+	1: "NAV_CONFIDENCE_DIRECT",
+	2: "NAV_CONFIDENCE_DERIVED",
+	3: "NAV_CONFIDENCE_DERIVED_INVERTED",
+}
+
+var NavConfidence_value = map[string]int32{
+	"NAV_CONFIDENCE_UNSPECIFIED":      0,
+	"NAV_CONFIDENCE_DIRECT":           1,
+	"NAV_CONFIDENCE_DERIVED":          2,
+	"NAV_CONFIDENCE_DERIVED_INVERTED": 3,
+}
+
+func (x NavConfidence) String() string {
+	return proto.EnumName(NavConfidence_name, int32(x))
+}
+
+func (NavConfidence) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5607ea444303a1f8, []int{0}
+}
+
 // Commitment contains information on committed funds.
 type Commitment struct {
 	// account is the bech32 address string with the committed funds.
@@ -254,11 +291,105 @@ func (m *NetAssetPrice) GetPrice() types.Coin {
 	return types.Coin{}
 }
 
+// ResolvedNav is a NAV between two denoms along with information about how it was found.
+type ResolvedNav struct {
+	// nav is the NAV from the requested assets denom to the requested price denom.
+	// If it was derived, the amounts are the products of the amounts of the NAVs used, reduced to lowest terms.
+	Nav NetAssetPrice `protobuf:"bytes,1,opt,name=nav,proto3" json:"nav"`
+	// path is the NAVs used to get the nav, as they are stored. It has one entry for a direct NAV, and two for a derived one.
+	Path []NetAssetPrice `protobuf:"bytes,2,rep,name=path,proto3" json:"path"`
+	// via_denom is the denom that the nav was derived through. It is empty for a direct NAV.
+	ViaDenom string `protobuf:"bytes,3,opt,name=via_denom,json=viaDenom,proto3" json:"via_denom,omitempty"`
+	// confidence indicates how the nav was found.
+	Confidence NavConfidence `protobuf:"varint,4,opt,name=confidence,proto3,enum=provenance.exchange.v1.NavConfidence" json:"confidence,omitempty"`
+	// updated_block_height is the oldest block height that any NAV in the path was set at.
+	UpdatedBlockHeight uint64 `protobuf:"varint,5,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+	// age_blocks is the number of blocks since the updated_block_height.
+	AgeBlocks uint64 `protobuf:"varint,6,opt,name=age_blocks,json=ageBlocks,proto3" json:"age_blocks,omitempty"`
+}
+
+func (m *ResolvedNav) Reset()         { *m = ResolvedNav{} }
+func (m *ResolvedNav) String() string { return proto.CompactTextString(m) }
+func (*ResolvedNav) ProtoMessage()    {}
+func (*ResolvedNav) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5607ea444303a1f8, []int{4}
+}
+func (m *ResolvedNav) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolvedNav) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolvedNav.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolvedNav) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolvedNav.Merge(m, src)
+}
+func (m *ResolvedNav) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolvedNav) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolvedNav.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolvedNav proto.InternalMessageInfo
+
+func (m *ResolvedNav) GetNav() NetAssetPrice {
+	if m != nil {
+		return m.Nav
+	}
+	return NetAssetPrice{}
+}
+
+func (m *ResolvedNav) GetPath() []NetAssetPrice {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *ResolvedNav) GetViaDenom() string {
+	if m != nil {
+		return m.ViaDenom
+	}
+	return ""
+}
+
+func (m *ResolvedNav) GetConfidence() NavConfidence {
+	if m != nil {
+		return m.Confidence
+	}
+	return NavConfidence_unspecified
+}
+
+func (m *ResolvedNav) GetUpdatedBlockHeight() uint64 {
+	if m != nil {
+		return m.UpdatedBlockHeight
+	}
+	return 0
+}
+
+func (m *ResolvedNav) GetAgeBlocks() uint64 {
+	if m != nil {
+		return m.AgeBlocks
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("provenance.exchange.v1.NavConfidence", NavConfidence_name, NavConfidence_value)
 	proto.RegisterType((*Commitment)(nil), "provenance.exchange.v1.Commitment")
 	proto.RegisterType((*AccountAmount)(nil), "provenance.exchange.v1.AccountAmount")
 	proto.RegisterType((*MarketAmount)(nil), "provenance.exchange.v1.MarketAmount")
 	proto.RegisterType((*NetAssetPrice)(nil), "provenance.exchange.v1.NetAssetPrice")
+	proto.RegisterType((*ResolvedNav)(nil), "provenance.exchange.v1.ResolvedNav")
 }
 
 func init() {
@@ -266,36 +397,53 @@ func init() {
 }
 
 var fileDescriptor_5607ea444303a1f8 = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xcf, 0x6d, 0x09, 0xd4, 0x34, 0x03, 0xa7, 0x0a, 0x25, 0x45, 0xba, 0x44, 0x99, 0x4e,
-	0x95, 0x62, 0xab, 0x45, 0x08, 0x89, 0x2d, 0xa9, 0x84, 0xc4, 0x00, 0xaa, 0x8e, 0x8d, 0x25, 0xf2,
-	0xf9, 0xac, 0xab, 0x55, 0x6c, 0x9f, 0xce, 0x6e, 0xd4, 0xfc, 0x01, 0xec, 0x8c, 0x88, 0x89, 0x11,
-	0x21, 0x86, 0x0e, 0xcc, 0x4c, 0x0c, 0x1d, 0x2b, 0x26, 0x26, 0x40, 0xc9, 0xd0, 0x7f, 0x03, 0xf9,
-	0x47, 0x7b, 0x01, 0x01, 0x62, 0xa2, 0x4b, 0x72, 0xef, 0xf9, 0xfb, 0x9e, 0x3f, 0xdf, 0x27, 0x3f,
-	0x98, 0x56, 0xb5, 0x9a, 0x32, 0x49, 0x24, 0x65, 0x98, 0x1d, 0xd3, 0x03, 0x22, 0x4b, 0x86, 0xa7,
-	0x3b, 0x98, 0x2a, 0x21, 0xb8, 0x11, 0x4c, 0x1a, 0x8d, 0xaa, 0x5a, 0x19, 0x15, 0xdf, 0x6e, 0x94,
-	0xe8, 0x42, 0x89, 0xa6, 0x3b, 0x5b, 0xb7, 0x88, 0xe0, 0x52, 0x61, 0xf7, 0xeb, 0xa5, 0x5b, 0x09,
-	0x55, 0x5a, 0x28, 0x8d, 0x73, 0xa2, 0x6d, 0xb3, 0x9c, 0x19, 0x62, 0x3b, 0x72, 0x19, 0xce, 0xbb,
-	0xfe, 0x7c, 0xe2, 0x22, 0xec, 0x83, 0x70, 0xb4, 0x59, 0xaa, 0x52, 0xf9, 0xbc, 0xfd, 0xf2, 0xd9,
-	0xc1, 0x47, 0x00, 0xe1, 0xde, 0x25, 0x51, 0xdc, 0x81, 0xd7, 0x09, 0xa5, 0xea, 0x48, 0x9a, 0x0e,
-	0xe8, 0x83, 0x74, 0x3d, 0xbb, 0x08, 0xe3, 0x3b, 0x70, 0x5d, 0x90, 0xfa, 0x90, 0x99, 0x09, 0x2f,
-	0x3a, 0x2b, 0x7d, 0x90, 0xb6, 0xb3, 0x1b, 0x3e, 0xf1, 0xa8, 0x88, 0x67, 0xb0, 0x45, 0x84, 0xab,
-	0x5a, 0xed, 0xaf, 0xa6, 0x37, 0x77, 0xbb, 0x28, 0x5c, 0x6d, 0x39, 0x51, 0xe0, 0x44, 0x7b, 0x8a,
-	0xcb, 0xf1, 0xc3, 0xd3, 0xaf, 0xbd, 0xe8, 0xdd, 0xb7, 0x5e, 0x5a, 0x72, 0x73, 0x70, 0x94, 0x23,
-	0xaa, 0x44, 0xe0, 0x0c, 0x7f, 0x43, 0x5d, 0x1c, 0x62, 0x33, 0xab, 0x98, 0x76, 0x05, 0xfa, 0xf5,
-	0xf9, 0xc9, 0xf6, 0xc6, 0x73, 0x56, 0x12, 0x3a, 0x9b, 0x58, 0xa7, 0xfa, 0xed, 0xf9, 0xc9, 0x36,
-	0xc8, 0xc2, 0x85, 0x83, 0x4f, 0x00, 0xb6, 0x47, 0x9e, 0x71, 0xe4, 0x32, 0xf1, 0xee, 0x2f, 0x1e,
-	0xc6, 0x9d, 0xcf, 0x1f, 0x86, 0x9b, 0x01, 0x68, 0x54, 0x14, 0x35, 0xd3, 0xfa, 0xa9, 0xa9, 0xb9,
-	0x2c, 0x1b, 0x77, 0x8d, 0x81, 0x95, 0xff, 0x6c, 0xe0, 0xc1, 0xda, 0xab, 0x37, 0xbd, 0x68, 0xf0,
-	0x1e, 0xc0, 0x8d, 0xc7, 0x6e, 0x9c, 0xc1, 0xc5, 0x4f, 0xf3, 0x06, 0x7f, 0x9c, 0xf7, 0x15, 0xe1,
-	0xbe, 0x00, 0xb0, 0xfd, 0x84, 0x99, 0x91, 0xd6, 0xcc, 0xec, 0xd7, 0x9c, 0xb2, 0xf8, 0x3e, 0x6c,
-	0x11, 0x1b, 0x69, 0x07, 0xfb, 0x57, 0xa4, 0x35, 0x8b, 0x94, 0x05, 0x79, 0x7c, 0x0f, 0x5e, 0xab,
-	0x6c, 0x07, 0xf7, 0xa8, 0xfe, 0xa1, 0xce, 0xab, 0x3d, 0xc7, 0x98, 0x9d, 0xce, 0x13, 0x70, 0x36,
-	0x4f, 0xc0, 0xf7, 0x79, 0x02, 0x5e, 0x2e, 0x92, 0xe8, 0x6c, 0x91, 0x44, 0x5f, 0x16, 0x49, 0x04,
-	0xbb, 0xdc, 0x3d, 0xf1, 0xdf, 0xec, 0xd5, 0x3e, 0x78, 0x86, 0x96, 0x86, 0xd1, 0x88, 0x86, 0x5c,
-	0x2d, 0x45, 0xf8, 0xf8, 0x72, 0x6d, 0xf3, 0x96, 0x5b, 0x96, 0xbb, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x85, 0x93, 0x37, 0x22, 0xd4, 0x03, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xc1, 0x6b, 0x13, 0x4b,
+	0x18, 0xcf, 0x24, 0x69, 0xda, 0x4e, 0xda, 0xf7, 0xf2, 0x86, 0xbc, 0xb2, 0xcd, 0xe3, 0x25, 0x4b,
+	0xa1, 0x18, 0x0a, 0xdd, 0xb5, 0x15, 0x11, 0x05, 0x91, 0x34, 0xd9, 0x62, 0x0e, 0xc6, 0xb2, 0xad,
+	0x3d, 0x78, 0x59, 0x26, 0xbb, 0xd3, 0xcd, 0xd0, 0xee, 0x4e, 0xd8, 0x99, 0x2c, 0xed, 0x1f, 0xe0,
+	0x25, 0x27, 0x8f, 0x22, 0x04, 0x3c, 0x8a, 0x78, 0xe8, 0xc1, 0xb3, 0x07, 0xf1, 0x50, 0xf0, 0x52,
+	0x3c, 0x79, 0x52, 0x69, 0x0f, 0xfd, 0x37, 0x64, 0x77, 0xa7, 0x4d, 0xac, 0x6d, 0xd1, 0x8b, 0x5e,
+	0x92, 0xfd, 0xbe, 0xef, 0xf7, 0xfb, 0xbe, 0xdf, 0xef, 0x1b, 0x66, 0x60, 0xb5, 0x1b, 0xb0, 0x90,
+	0xf8, 0xd8, 0xb7, 0x89, 0x4e, 0x76, 0xed, 0x0e, 0xf6, 0x5d, 0xa2, 0x87, 0x4b, 0xba, 0xcd, 0x3c,
+	0x8f, 0x0a, 0x8f, 0xf8, 0x82, 0x6b, 0xdd, 0x80, 0x09, 0x86, 0x66, 0x86, 0x48, 0xed, 0x14, 0xa9,
+	0x85, 0x4b, 0xa5, 0x7f, 0xb0, 0x47, 0x7d, 0xa6, 0xc7, 0xbf, 0x09, 0xb4, 0x54, 0xb6, 0x19, 0xf7,
+	0x18, 0xd7, 0xdb, 0x98, 0x47, 0xcd, 0xda, 0x44, 0xe0, 0xa8, 0x23, 0xf5, 0x65, 0x7d, 0x36, 0xa9,
+	0x5b, 0x71, 0xa4, 0x27, 0x81, 0x2c, 0x15, 0x5d, 0xe6, 0xb2, 0x24, 0x1f, 0x7d, 0x25, 0xd9, 0xb9,
+	0xb7, 0x00, 0xc2, 0xfa, 0x99, 0x22, 0xa4, 0xc0, 0x71, 0x6c, 0xdb, 0xac, 0xe7, 0x0b, 0x05, 0xa8,
+	0xa0, 0x3a, 0x69, 0x9e, 0x86, 0xe8, 0x3f, 0x38, 0xe9, 0xe1, 0x60, 0x9b, 0x08, 0x8b, 0x3a, 0x4a,
+	0x5a, 0x05, 0xd5, 0x69, 0x73, 0x22, 0x49, 0x34, 0x1d, 0xb4, 0x07, 0x73, 0xd8, 0x8b, 0x59, 0x19,
+	0x35, 0x53, 0xcd, 0x2f, 0xcf, 0x6a, 0x72, 0x74, 0xa4, 0x53, 0x93, 0x3a, 0xb5, 0x3a, 0xa3, 0xfe,
+	0xca, 0xea, 0xc1, 0xe7, 0x4a, 0xea, 0xd5, 0x97, 0x4a, 0xd5, 0xa5, 0xa2, 0xd3, 0x6b, 0x6b, 0x36,
+	0xf3, 0xa4, 0x4e, 0xf9, 0xb7, 0xc8, 0x9d, 0x6d, 0x5d, 0xec, 0x75, 0x09, 0x8f, 0x09, 0xfc, 0xf9,
+	0xc9, 0xfe, 0xc2, 0xd4, 0x0e, 0x71, 0xb1, 0xbd, 0x67, 0x45, 0x4e, 0xf9, 0xcb, 0x93, 0xfd, 0x05,
+	0x60, 0xca, 0x81, 0x73, 0xef, 0x01, 0x9c, 0xae, 0x25, 0x1a, 0x6b, 0x71, 0x06, 0x2d, 0x9f, 0xf3,
+	0xb0, 0xa2, 0x7c, 0x7c, 0xb3, 0x58, 0x94, 0x82, 0x6a, 0x8e, 0x13, 0x10, 0xce, 0xd7, 0x45, 0x40,
+	0x7d, 0x77, 0xe8, 0x6e, 0x68, 0x20, 0xfd, 0x9b, 0x0d, 0xdc, 0xc9, 0x3e, 0x7b, 0x51, 0x49, 0xcd,
+	0xbd, 0x06, 0x70, 0xea, 0x41, 0xbc, 0xce, 0x9a, 0xf7, 0xe3, 0xbe, 0xc1, 0xa5, 0xfb, 0xfe, 0x43,
+	0x72, 0x9f, 0x00, 0x38, 0xdd, 0x22, 0xa2, 0xc6, 0x39, 0x11, 0x6b, 0x01, 0xb5, 0x09, 0xba, 0x05,
+	0x73, 0x38, 0x8a, 0x78, 0x2c, 0xf6, 0x4a, 0x49, 0xd9, 0x48, 0x92, 0x29, 0xe1, 0xe8, 0x26, 0x1c,
+	0xeb, 0x46, 0x1d, 0x94, 0xf4, 0xcf, 0xf1, 0x12, 0xb4, 0xd4, 0xf1, 0x2e, 0x0d, 0xf3, 0x26, 0xe1,
+	0x6c, 0x27, 0x24, 0x4e, 0x0b, 0x87, 0xe8, 0x2e, 0xcc, 0xf8, 0x38, 0x94, 0x12, 0xe6, 0xb5, 0x8b,
+	0x2f, 0x96, 0xf6, 0x9d, 0x72, 0xd9, 0x36, 0xe2, 0xa1, 0x7b, 0x30, 0xdb, 0xc5, 0xa2, 0x23, 0xb7,
+	0xfa, 0x4b, 0xfc, 0x98, 0x18, 0x9d, 0x5a, 0x48, 0xb1, 0xe5, 0x10, 0x9f, 0x79, 0x4a, 0x26, 0xbe,
+	0x41, 0x13, 0x21, 0xc5, 0x8d, 0x28, 0x46, 0x06, 0x84, 0x36, 0xf3, 0xb7, 0xa8, 0x43, 0x7c, 0x9b,
+	0x28, 0x59, 0x15, 0x54, 0xff, 0xba, 0x62, 0x06, 0x0e, 0xeb, 0x67, 0x60, 0x73, 0x84, 0x88, 0xae,
+	0xc3, 0x62, 0xaf, 0xeb, 0x60, 0x41, 0x1c, 0xab, 0xbd, 0xc3, 0xec, 0x6d, 0xab, 0x43, 0xa8, 0xdb,
+	0x11, 0xca, 0x98, 0x0a, 0xaa, 0x59, 0x13, 0xc9, 0xda, 0x4a, 0x54, 0xba, 0x1f, 0x57, 0xd0, 0xff,
+	0x10, 0x62, 0x97, 0x24, 0x68, 0xae, 0xe4, 0x62, 0xdc, 0x24, 0x76, 0x49, 0x8c, 0xe1, 0x0b, 0x1f,
+	0xa2, 0xc3, 0x1c, 0x1d, 0x87, 0x74, 0x58, 0x6a, 0xd5, 0x36, 0xad, 0xfa, 0xc3, 0xd6, 0x6a, 0xb3,
+	0x61, 0xb4, 0xea, 0x86, 0xf5, 0xa8, 0xb5, 0xbe, 0x66, 0xd4, 0x9b, 0xab, 0x4d, 0xa3, 0x51, 0x48,
+	0x95, 0xfe, 0xee, 0x0f, 0xd4, 0x7c, 0xcf, 0xe7, 0x5d, 0x62, 0xd3, 0x2d, 0x4a, 0x1c, 0x34, 0x0f,
+	0xff, 0x3d, 0x47, 0x68, 0x34, 0x4d, 0xa3, 0xbe, 0x51, 0x00, 0x25, 0xd8, 0x1f, 0xa8, 0x39, 0x87,
+	0x06, 0xc4, 0x16, 0xe8, 0x1a, 0x9c, 0x39, 0x0f, 0x33, 0xcc, 0xe6, 0xa6, 0xd1, 0x28, 0xa4, 0x4b,
+	0xf9, 0xfe, 0x40, 0x1d, 0x77, 0x48, 0x40, 0x43, 0xe2, 0xa0, 0xdb, 0xb0, 0x72, 0x31, 0xd0, 0x6a,
+	0xb6, 0x36, 0x0d, 0x73, 0xc3, 0x68, 0x14, 0x32, 0xa5, 0x62, 0x7f, 0xa0, 0x16, 0x24, 0xc3, 0xa2,
+	0x7e, 0x48, 0x82, 0xc8, 0x37, 0x39, 0x38, 0x2a, 0x83, 0xc3, 0xa3, 0x32, 0xf8, 0x7a, 0x54, 0x06,
+	0x4f, 0x8f, 0xcb, 0xa9, 0xc3, 0xe3, 0x72, 0xea, 0xd3, 0x71, 0x39, 0x05, 0x67, 0x29, 0xbb, 0x64,
+	0xdb, 0x6b, 0xe0, 0xb1, 0x36, 0x72, 0x3f, 0x86, 0xa0, 0x45, 0xca, 0x46, 0x22, 0x7d, 0xf7, 0xec,
+	0x25, 0x6f, 0xe7, 0xe2, 0xf7, 0xf3, 0xc6, 0xb7, 0x01, 0x00, 0x76, 0xcf, 0x13, 0x4e, 0xe7, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Commitment) Marshal() (dAtA []byte, err error) {
@@ -476,6 +624,75 @@ func (m *NetAssetPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResolvedNav) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolvedNav) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolvedNav) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AgeBlocks != 0 {
+		i = encodeVarintCommitments(dAtA, i, uint64(m.AgeBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UpdatedBlockHeight != 0 {
+		i = encodeVarintCommitments(dAtA, i, uint64(m.UpdatedBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Confidence != 0 {
+		i = encodeVarintCommitments(dAtA, i, uint64(m.Confidence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ViaDenom) > 0 {
+		i -= len(m.ViaDenom)
+		copy(dAtA[i:], m.ViaDenom)
+		i = encodeVarintCommitments(dAtA, i, uint64(len(m.ViaDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Path[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommitments(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCommitments(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommitments(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommitments(v)
 	base := offset
@@ -559,6 +776,36 @@ func (m *NetAssetPrice) Size() (n int) {
 	return n
 }
 
+func (m *ResolvedNav) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Nav.Size()
+	n += 1 + l + sovCommitments(uint64(l))
+	if len(m.Path) > 0 {
+		for _, e := range m.Path {
+			l = e.Size()
+			n += 1 + l + sovCommitments(uint64(l))
+		}
+	}
+	l = len(m.ViaDenom)
+	if l > 0 {
+		n += 1 + l + sovCommitments(uint64(l))
+	}
+	if m.Confidence != 0 {
+		n += 1 + sovCommitments(uint64(m.Confidence))
+	}
+	if m.UpdatedBlockHeight != 0 {
+		n += 1 + sovCommitments(uint64(m.UpdatedBlockHeight))
+	}
+	if m.AgeBlocks != 0 {
+		n += 1 + sovCommitments(uint64(m.AgeBlocks))
+	}
+	return n
+}
+
 func sovCommitments(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1035,6 +1282,212 @@ func (m *NetAssetPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResolvedNav) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitments
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolvedNav: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolvedNav: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path, NetAssetPrice{})
+			if err := m.Path[len(m.Path)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViaDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViaDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			m.Confidence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confidence |= NavConfidence(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeBlocks", wireType)
+			}
+			m.AgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitments
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitments(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitments
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommitments(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
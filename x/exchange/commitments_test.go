@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 	"strings"
 	"testing"
@@ -727,6 +728,93 @@ func TestNetAssetPrice_Validate(t *testing.T) {
 	}
 }
 
+func TestNetAssetPrice_Invert(t *testing.T) {
+	nav := NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("plum", 8)}
+	exp := NetAssetPrice{Assets: sdk.NewInt64Coin("plum", 8), Price: sdk.NewInt64Coin("apple", 3)}
+	var act NetAssetPrice
+	testFunc := func() {
+		act = nav.Invert()
+	}
+	require.NotPanics(t, testFunc, "Invert()")
+	assert.Equal(t, exp, act, "Invert() result")
+	assert.Equal(t, nav, act.Invert(), "Invert().Invert() result")
+}
+
+func TestChainNAVs(t *testing.T) {
+	newNav := func(assets, price string) NetAssetPrice {
+		assetsCoin, err := sdk.ParseCoinNormalized(assets)
+		require.NoError(t, err, "ParseCoinNormalized(%q)", assets)
+		priceCoin, err := sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "ParseCoinNormalized(%q)", price)
+		return NetAssetPrice{Assets: assetsCoin, Price: priceCoin}
+	}
+	hugeAmt := sdkmath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200))
+
+	tests := []struct {
+		name    string
+		toVia   NetAssetPrice
+		fromVia NetAssetPrice
+		expNav  *NetAssetPrice
+		expErr  string
+	}{
+		{
+			name:    "denom mismatch",
+			toVia:   newNav("3apple", "5banana"),
+			fromVia: newNav("7cherry", "11plum"),
+			expErr:  `cannot chain nav "3apple"="5banana" with nav "7cherry"="11plum": denom mismatch`,
+		},
+		{
+			name:    "zero assets",
+			toVia:   NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("banana", 5)},
+			fromVia: newNav("7banana", "11plum"),
+			expErr:  `cannot chain nav "0apple"="5banana" with nav "7banana"="11plum": assets must be positive and prices cannot be negative`,
+		},
+		{
+			name:    "negative price",
+			toVia:   newNav("3apple", "5banana"),
+			fromVia: NetAssetPrice{Assets: sdk.NewInt64Coin("banana", 7), Price: sdk.Coin{Denom: "plum", Amount: sdkmath.NewInt(-1)}},
+			expErr:  `cannot chain nav "3apple"="5banana" with nav "7banana"="-1plum": assets must be positive and prices cannot be negative`,
+		},
+		{
+			name:    "overflow",
+			toVia:   NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.Coin{Denom: "banana", Amount: hugeAmt}},
+			fromVia: NetAssetPrice{Assets: sdk.NewInt64Coin("banana", 1), Price: sdk.Coin{Denom: "plum", Amount: hugeAmt}},
+			expErr:  `cannot chain nav "1apple"="` + hugeAmt.String() + `banana" with nav "1banana"="` + hugeAmt.String() + `plum": amount overflow`,
+		},
+		{
+			name:    "already reduced",
+			toVia:   newNav("3apple", "5banana"),
+			fromVia: newNav("7banana", "11plum"),
+			expNav:  &NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 21), Price: sdk.NewInt64Coin("plum", 55)},
+		},
+		{
+			name:    "reducible",
+			toVia:   newNav("10apple", "4banana"),
+			fromVia: newNav("6banana", "15plum"),
+			expNav:  &NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.NewInt64Coin("plum", 1)},
+		},
+		{
+			name:    "zero price",
+			toVia:   NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("banana", 0)},
+			fromVia: newNav("6banana", "15plum"),
+			expNav:  &NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.NewInt64Coin("plum", 0)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var nav *NetAssetPrice
+			var err error
+			testFunc := func() {
+				nav, err = ChainNAVs(tc.toVia, tc.fromVia)
+			}
+			require.NotPanics(t, testFunc, "ChainNAVs")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ChainNAVs error")
+			assert.Equal(t, tc.expNav, nav, "ChainNAVs result")
+		})
+	}
+}
+
 func TestValidateEventTag(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -23,6 +23,24 @@ func NewEventOrderCancelled(order OrderI, cancelledBy string) *EventOrderCancell
 	}
 }
 
+// NewEventOrderExpired returns a new EventOrderCancelled for an order that was cancelled because it expired.
+func NewEventOrderExpired(order OrderI, cancelledBy string) *EventOrderCancelled {
+	rv := NewEventOrderCancelled(order, cancelledBy)
+	rv.Reason = CancelReasonExpired
+	return rv
+}
+
+func NewEventOrderAmended(order OrderI) *EventOrderAmended {
+	return &EventOrderAmended{
+		OrderId:    order.GetOrderID(),
+		Assets:     order.GetAssets().String(),
+		Price:      order.GetPrice().String(),
+		Fees:       order.GetSettlementFees().String(),
+		MarketId:   order.GetMarketID(),
+		ExternalId: order.GetExternalID(),
+	}
+}
+
 func NewEventOrderFilled(order OrderI) *EventOrderFilled {
 	return &EventOrderFilled{
 		OrderId:    order.GetOrderID(),
@@ -133,6 +151,29 @@ func NewEventMarketUserSettleDisabled(marketID uint32, updatedBy string) *EventM
 	}
 }
 
+// NewEventMarketAutoMatchUpdated returns a new EventMarketAutoMatchEnabled if isEnabled == true,
+// or a new EventMarketAutoMatchDisabled if isEnabled == false.
+func NewEventMarketAutoMatchUpdated(marketID uint32, updatedBy string, isEnabled bool) proto.Message {
+	if isEnabled {
+		return NewEventMarketAutoMatchEnabled(marketID, updatedBy)
+	}
+	return NewEventMarketAutoMatchDisabled(marketID, updatedBy)
+}
+
+func NewEventMarketAutoMatchEnabled(marketID uint32, updatedBy string) *EventMarketAutoMatchEnabled {
+	return &EventMarketAutoMatchEnabled{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketAutoMatchDisabled(marketID uint32, updatedBy string) *EventMarketAutoMatchDisabled {
+	return &EventMarketAutoMatchDisabled{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
 // NewEventMarketAcceptingCommitmentsUpdated returns a new NewEventMarketCommitmentsEnabled if isAccepting == true,
 // or a new NewEventMarketCommitmentsDisabled if isAccepting == false.
 func NewEventMarketAcceptingCommitmentsUpdated(marketID uint32, updatedBy string, isAccepting bool) proto.Message {
@@ -106,6 +106,9 @@ type EventOrderCancelled struct {
 	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// external_id is the order's external id.
 	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// reason is a short indicator of why the order was cancelled.
+	// It is empty when the order was cancelled by request, or "expired" when the order was cancelled due to expiration.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *EventOrderCancelled) Reset()         { *m = EventOrderCancelled{} }
@@ -169,6 +172,104 @@ func (m *EventOrderCancelled) GetExternalId() string {
 	return ""
 }
 
+func (m *EventOrderCancelled) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventOrderAmended is an event emitted when an order's assets, price, or settlement fees are changed by its owner.
+type EventOrderAmended struct {
+	// order_id is the numerical identifier of the order amended.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// assets is the coins amount string of the order's new assets.
+	Assets string `protobuf:"bytes,2,opt,name=assets,proto3" json:"assets,omitempty"`
+	// price is the coins amount string of the order's new price.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// fees is the coins amount string of the order's new settlement fees.
+	Fees string `protobuf:"bytes,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventOrderAmended) Reset()         { *m = EventOrderAmended{} }
+func (m *EventOrderAmended) String() string { return proto.CompactTextString(m) }
+func (*EventOrderAmended) ProtoMessage()    {}
+func (*EventOrderAmended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{2}
+}
+func (m *EventOrderAmended) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderAmended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderAmended.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderAmended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderAmended.Merge(m, src)
+}
+func (m *EventOrderAmended) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderAmended) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderAmended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderAmended proto.InternalMessageInfo
+
+func (m *EventOrderAmended) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventOrderAmended) GetAssets() string {
+	if m != nil {
+		return m.Assets
+	}
+	return ""
+}
+
+func (m *EventOrderAmended) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventOrderAmended) GetFees() string {
+	if m != nil {
+		return m.Fees
+	}
+	return ""
+}
+
+func (m *EventOrderAmended) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventOrderAmended) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
 // EventOrderFilled is an event emitted when an order has been filled in full.
 // This event is also used for orders that were previously partially filled, but have now been filled in full.
 type EventOrderFilled struct {
@@ -190,7 +291,7 @@ func (m *EventOrderFilled) Reset()         { *m = EventOrderFilled{} }
 func (m *EventOrderFilled) String() string { return proto.CompactTextString(m) }
 func (*EventOrderFilled) ProtoMessage()    {}
 func (*EventOrderFilled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{2}
+	return fileDescriptor_c1b69385a348cffa, []int{3}
 }
 func (m *EventOrderFilled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +384,7 @@ func (m *EventOrderPartiallyFilled) Reset()         { *m = EventOrderPartiallyFi
 func (m *EventOrderPartiallyFilled) String() string { return proto.CompactTextString(m) }
 func (*EventOrderPartiallyFilled) ProtoMessage()    {}
 func (*EventOrderPartiallyFilled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{3}
+	return fileDescriptor_c1b69385a348cffa, []int{4}
 }
 func (m *EventOrderPartiallyFilled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +469,7 @@ func (m *EventOrderExternalIDUpdated) Reset()         { *m = EventOrderExternalI
 func (m *EventOrderExternalIDUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventOrderExternalIDUpdated) ProtoMessage()    {}
 func (*EventOrderExternalIDUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{4}
+	return fileDescriptor_c1b69385a348cffa, []int{5}
 }
 func (m *EventOrderExternalIDUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +535,7 @@ func (m *EventFundsCommitted) Reset()         { *m = EventFundsCommitted{} }
 func (m *EventFundsCommitted) String() string { return proto.CompactTextString(m) }
 func (*EventFundsCommitted) ProtoMessage()    {}
 func (*EventFundsCommitted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{5}
+	return fileDescriptor_c1b69385a348cffa, []int{6}
 }
 func (m *EventFundsCommitted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +608,7 @@ func (m *EventCommitmentReleased) Reset()         { *m = EventCommitmentReleased
 func (m *EventCommitmentReleased) String() string { return proto.CompactTextString(m) }
 func (*EventCommitmentReleased) ProtoMessage()    {}
 func (*EventCommitmentReleased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{6}
+	return fileDescriptor_c1b69385a348cffa, []int{7}
 }
 func (m *EventCommitmentReleased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +681,7 @@ func (m *EventMarketWithdraw) Reset()         { *m = EventMarketWithdraw{} }
 func (m *EventMarketWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventMarketWithdraw) ProtoMessage()    {}
 func (*EventMarketWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{7}
+	return fileDescriptor_c1b69385a348cffa, []int{8}
 }
 func (m *EventMarketWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +750,7 @@ func (m *EventMarketDetailsUpdated) Reset()         { *m = EventMarketDetailsUpd
 func (m *EventMarketDetailsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketDetailsUpdated) ProtoMessage()    {}
 func (*EventMarketDetailsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{8}
+	return fileDescriptor_c1b69385a348cffa, []int{9}
 }
 func (m *EventMarketDetailsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +808,7 @@ func (m *EventMarketEnabled) Reset()         { *m = EventMarketEnabled{} }
 func (m *EventMarketEnabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketEnabled) ProtoMessage()    {}
 func (*EventMarketEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{9}
+	return fileDescriptor_c1b69385a348cffa, []int{10}
 }
 func (m *EventMarketEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +866,7 @@ func (m *EventMarketDisabled) Reset()         { *m = EventMarketDisabled{} }
 func (m *EventMarketDisabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketDisabled) ProtoMessage()    {}
 func (*EventMarketDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{10}
+	return fileDescriptor_c1b69385a348cffa, []int{11}
 }
 func (m *EventMarketDisabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +921,7 @@ func (m *EventMarketOrdersEnabled) Reset()         { *m = EventMarketOrdersEnabl
 func (m *EventMarketOrdersEnabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketOrdersEnabled) ProtoMessage()    {}
 func (*EventMarketOrdersEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{11}
+	return fileDescriptor_c1b69385a348cffa, []int{12}
 }
 func (m *EventMarketOrdersEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +976,7 @@ func (m *EventMarketOrdersDisabled) Reset()         { *m = EventMarketOrdersDisa
 func (m *EventMarketOrdersDisabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketOrdersDisabled) ProtoMessage()    {}
 func (*EventMarketOrdersDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{12}
+	return fileDescriptor_c1b69385a348cffa, []int{13}
 }
 func (m *EventMarketOrdersDisabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1031,7 @@ func (m *EventMarketUserSettleEnabled) Reset()         { *m = EventMarketUserSet
 func (m *EventMarketUserSettleEnabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketUserSettleEnabled) ProtoMessage()    {}
 func (*EventMarketUserSettleEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{13}
+	return fileDescriptor_c1b69385a348cffa, []int{14}
 }
 func (m *EventMarketUserSettleEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1086,7 @@ func (m *EventMarketUserSettleDisabled) Reset()         { *m = EventMarketUserSe
 func (m *EventMarketUserSettleDisabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketUserSettleDisabled) ProtoMessage()    {}
 func (*EventMarketUserSettleDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{14}
+	return fileDescriptor_c1b69385a348cffa, []int{15}
 }
 func (m *EventMarketUserSettleDisabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,6 +1129,116 @@ func (m *EventMarketUserSettleDisabled) GetUpdatedBy() string {
 	return ""
 }
 
+// EventMarketAutoMatchEnabled is an event emitted when a market's auto_match option is enabled.
+type EventMarketAutoMatchEnabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the auto_match option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (m *EventMarketAutoMatchEnabled) Reset()         { *m = EventMarketAutoMatchEnabled{} }
+func (m *EventMarketAutoMatchEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAutoMatchEnabled) ProtoMessage()    {}
+func (*EventMarketAutoMatchEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{16}
+}
+func (m *EventMarketAutoMatchEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketAutoMatchEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketAutoMatchEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarketAutoMatchEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAutoMatchEnabled.Merge(m, src)
+}
+func (m *EventMarketAutoMatchEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketAutoMatchEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAutoMatchEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketAutoMatchEnabled proto.InternalMessageInfo
+
+func (m *EventMarketAutoMatchEnabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventMarketAutoMatchEnabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+// EventMarketAutoMatchDisabled is an event emitted when a market's auto_match option is disabled.
+type EventMarketAutoMatchDisabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the auto_match option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (m *EventMarketAutoMatchDisabled) Reset()         { *m = EventMarketAutoMatchDisabled{} }
+func (m *EventMarketAutoMatchDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAutoMatchDisabled) ProtoMessage()    {}
+func (*EventMarketAutoMatchDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{17}
+}
+func (m *EventMarketAutoMatchDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketAutoMatchDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketAutoMatchDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarketAutoMatchDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAutoMatchDisabled.Merge(m, src)
+}
+func (m *EventMarketAutoMatchDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketAutoMatchDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAutoMatchDisabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketAutoMatchDisabled proto.InternalMessageInfo
+
+func (m *EventMarketAutoMatchDisabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventMarketAutoMatchDisabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
 // EventMarketCommitmentsEnabled is an event emitted when a market's accepting_commitments option is enabled.
 type EventMarketCommitmentsEnabled struct {
 	// market_id is the numerical identifier of the market.
@@ -1040,7 +1251,7 @@ func (m *EventMarketCommitmentsEnabled) Reset()         { *m = EventMarketCommit
 func (m *EventMarketCommitmentsEnabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketCommitmentsEnabled) ProtoMessage()    {}
 func (*EventMarketCommitmentsEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{15}
+	return fileDescriptor_c1b69385a348cffa, []int{18}
 }
 func (m *EventMarketCommitmentsEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1306,7 @@ func (m *EventMarketCommitmentsDisabled) Reset()         { *m = EventMarketCommi
 func (m *EventMarketCommitmentsDisabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketCommitmentsDisabled) ProtoMessage()    {}
 func (*EventMarketCommitmentsDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{16}
+	return fileDescriptor_c1b69385a348cffa, []int{19}
 }
 func (m *EventMarketCommitmentsDisabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,7 +1362,7 @@ func (m *EventMarketIntermediaryDenomUpdated) Reset()         { *m = EventMarket
 func (m *EventMarketIntermediaryDenomUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketIntermediaryDenomUpdated) ProtoMessage()    {}
 func (*EventMarketIntermediaryDenomUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{17}
+	return fileDescriptor_c1b69385a348cffa, []int{20}
 }
 func (m *EventMarketIntermediaryDenomUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1417,7 @@ func (m *EventMarketPermissionsUpdated) Reset()         { *m = EventMarketPermis
 func (m *EventMarketPermissionsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketPermissionsUpdated) ProtoMessage()    {}
 func (*EventMarketPermissionsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{18}
+	return fileDescriptor_c1b69385a348cffa, []int{21}
 }
 func (m *EventMarketPermissionsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1261,7 +1472,7 @@ func (m *EventMarketReqAttrUpdated) Reset()         { *m = EventMarketReqAttrUpd
 func (m *EventMarketReqAttrUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketReqAttrUpdated) ProtoMessage()    {}
 func (*EventMarketReqAttrUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{19}
+	return fileDescriptor_c1b69385a348cffa, []int{22}
 }
 func (m *EventMarketReqAttrUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1314,7 +1525,7 @@ func (m *EventMarketCreated) Reset()         { *m = EventMarketCreated{} }
 func (m *EventMarketCreated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketCreated) ProtoMessage()    {}
 func (*EventMarketCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{20}
+	return fileDescriptor_c1b69385a348cffa, []int{23}
 }
 func (m *EventMarketCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1571,7 @@ func (m *EventMarketFeesUpdated) Reset()         { *m = EventMarketFeesUpdated{}
 func (m *EventMarketFeesUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketFeesUpdated) ProtoMessage()    {}
 func (*EventMarketFeesUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{21}
+	return fileDescriptor_c1b69385a348cffa, []int{24}
 }
 func (m *EventMarketFeesUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1404,7 +1615,7 @@ func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
 func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventParamsUpdated) ProtoMessage()    {}
 func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{22}
+	return fileDescriptor_c1b69385a348cffa, []int{25}
 }
 func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1662,7 @@ func (m *EventPaymentCreated) Reset()         { *m = EventPaymentCreated{} }
 func (m *EventPaymentCreated) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentCreated) ProtoMessage()    {}
 func (*EventPaymentCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{23}
+	return fileDescriptor_c1b69385a348cffa, []int{26}
 }
 func (m *EventPaymentCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1746,7 @@ func (m *EventPaymentUpdated) Reset()         { *m = EventPaymentUpdated{} }
 func (m *EventPaymentUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentUpdated) ProtoMessage()    {}
 func (*EventPaymentUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{24}
+	return fileDescriptor_c1b69385a348cffa, []int{27}
 }
 func (m *EventPaymentUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1624,7 +1835,7 @@ func (m *EventPaymentAccepted) Reset()         { *m = EventPaymentAccepted{} }
 func (m *EventPaymentAccepted) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentAccepted) ProtoMessage()    {}
 func (*EventPaymentAccepted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{25}
+	return fileDescriptor_c1b69385a348cffa, []int{28}
 }
 func (m *EventPaymentAccepted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +1913,7 @@ func (m *EventPaymentRejected) Reset()         { *m = EventPaymentRejected{} }
 func (m *EventPaymentRejected) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentRejected) ProtoMessage()    {}
 func (*EventPaymentRejected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{26}
+	return fileDescriptor_c1b69385a348cffa, []int{29}
 }
 func (m *EventPaymentRejected) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1766,7 +1977,7 @@ func (m *EventPaymentCancelled) Reset()         { *m = EventPaymentCancelled{} }
 func (m *EventPaymentCancelled) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentCancelled) ProtoMessage()    {}
 func (*EventPaymentCancelled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{27}
+	return fileDescriptor_c1b69385a348cffa, []int{30}
 }
 func (m *EventPaymentCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1819,6 +2030,7 @@ func (m *EventPaymentCancelled) GetExternalId() string {
 func init() {
 	proto.RegisterType((*EventOrderCreated)(nil), "provenance.exchange.v1.EventOrderCreated")
 	proto.RegisterType((*EventOrderCancelled)(nil), "provenance.exchange.v1.EventOrderCancelled")
+	proto.RegisterType((*EventOrderAmended)(nil), "provenance.exchange.v1.EventOrderAmended")
 	proto.RegisterType((*EventOrderFilled)(nil), "provenance.exchange.v1.EventOrderFilled")
 	proto.RegisterType((*EventOrderPartiallyFilled)(nil), "provenance.exchange.v1.EventOrderPartiallyFilled")
 	proto.RegisterType((*EventOrderExternalIDUpdated)(nil), "provenance.exchange.v1.EventOrderExternalIDUpdated")
@@ -1832,6 +2044,8 @@ func init() {
 	proto.RegisterType((*EventMarketOrdersDisabled)(nil), "provenance.exchange.v1.EventMarketOrdersDisabled")
 	proto.RegisterType((*EventMarketUserSettleEnabled)(nil), "provenance.exchange.v1.EventMarketUserSettleEnabled")
 	proto.RegisterType((*EventMarketUserSettleDisabled)(nil), "provenance.exchange.v1.EventMarketUserSettleDisabled")
+	proto.RegisterType((*EventMarketAutoMatchEnabled)(nil), "provenance.exchange.v1.EventMarketAutoMatchEnabled")
+	proto.RegisterType((*EventMarketAutoMatchDisabled)(nil), "provenance.exchange.v1.EventMarketAutoMatchDisabled")
 	proto.RegisterType((*EventMarketCommitmentsEnabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsEnabled")
 	proto.RegisterType((*EventMarketCommitmentsDisabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsDisabled")
 	proto.RegisterType((*EventMarketIntermediaryDenomUpdated)(nil), "provenance.exchange.v1.EventMarketIntermediaryDenomUpdated")
@@ -1852,63 +2066,65 @@ func init() {
 }
 
 var fileDescriptor_c1b69385a348cffa = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x31, 0x6f, 0xe4, 0x44,
-	0x14, 0xce, 0x6c, 0x92, 0xbd, 0xdb, 0x97, 0x9c, 0x74, 0x98, 0x10, 0x36, 0x1c, 0xb7, 0x44, 0x4e,
-	0x93, 0xe6, 0x76, 0x2f, 0x20, 0x14, 0xe9, 0xa8, 0xb2, 0x97, 0x44, 0x4a, 0x81, 0x58, 0xf9, 0x72,
-	0x42, 0xa2, 0x59, 0x4d, 0xec, 0xc7, 0x66, 0xc0, 0x9e, 0xf1, 0xcd, 0xcc, 0x6e, 0x62, 0xf1, 0x13,
-	0x68, 0xae, 0xa0, 0x83, 0x92, 0x0e, 0xd1, 0x21, 0xfe, 0x00, 0x0d, 0xe5, 0x89, 0x8a, 0x12, 0x25,
-	0xf0, 0x3f, 0x90, 0x3d, 0x76, 0xd6, 0xce, 0xe6, 0xec, 0x15, 0xc8, 0x22, 0xa2, 0x9b, 0x19, 0xbf,
-	0xf7, 0xbe, 0xef, 0x7b, 0x9e, 0x79, 0x6f, 0x06, 0xb6, 0x42, 0x29, 0x26, 0xc8, 0x29, 0x77, 0xb1,
-	0x87, 0xe7, 0xee, 0x29, 0xe5, 0x23, 0xec, 0x4d, 0x76, 0x7a, 0x38, 0x41, 0xae, 0x55, 0x37, 0x94,
-	0x42, 0x0b, 0x6b, 0x7d, 0x6a, 0xd4, 0xcd, 0x8c, 0xba, 0x93, 0x9d, 0x77, 0x36, 0x5c, 0xa1, 0x02,
-	0xa1, 0x86, 0x89, 0x55, 0xcf, 0x4c, 0x8c, 0x8b, 0xfd, 0x35, 0x81, 0x37, 0x0e, 0xe2, 0x18, 0x9f,
-	0x48, 0x0f, 0xe5, 0x53, 0x89, 0x54, 0xa3, 0x67, 0x6d, 0xc0, 0x5d, 0x11, 0xcf, 0x87, 0xcc, 0x6b,
-	0x93, 0x4d, 0xb2, 0xbd, 0xe4, 0xdc, 0x49, 0xe6, 0x47, 0x9e, 0xf5, 0x10, 0xc0, 0x7c, 0xd2, 0x51,
-	0x88, 0xed, 0xc6, 0x26, 0xd9, 0x6e, 0x39, 0xad, 0x64, 0xe5, 0x38, 0x0a, 0xd1, 0x7a, 0x00, 0xad,
-	0x80, 0xca, 0x2f, 0x51, 0xc7, 0xae, 0x8b, 0x9b, 0x64, 0xfb, 0x9e, 0x73, 0xd7, 0x2c, 0x1c, 0x79,
-	0xd6, 0x7b, 0xb0, 0x82, 0xe7, 0x1a, 0x25, 0xa7, 0x7e, 0xfc, 0x79, 0x29, 0x71, 0x86, 0x6c, 0xe9,
-	0xc8, 0xb3, 0x7f, 0x20, 0xf0, 0x66, 0x8e, 0x4d, 0x2c, 0xc4, 0xf7, 0xcb, 0xf9, 0x7c, 0x04, 0xab,
-	0x6e, 0x66, 0x37, 0x3c, 0x89, 0x0c, 0xa3, 0x7e, 0xfb, 0xb7, 0x9f, 0x1e, 0xad, 0xa5, 0x42, 0xf7,
-	0x3c, 0x4f, 0xa2, 0x52, 0xcf, 0xb4, 0x64, 0x7c, 0xe4, 0xac, 0x5c, 0x59, 0xf7, 0xa3, 0x7f, 0xc9,
-	0xf6, 0x47, 0x02, 0xf7, 0xa7, 0x6c, 0x0f, 0x59, 0x15, 0xd5, 0x75, 0x68, 0x52, 0xa5, 0x50, 0xab,
-	0x34, 0x6d, 0xe9, 0xcc, 0x5a, 0x83, 0xe5, 0x50, 0x32, 0x17, 0x13, 0x06, 0x2d, 0xc7, 0x4c, 0x2c,
-	0x0b, 0x96, 0x3e, 0x47, 0x54, 0x29, 0x6e, 0x32, 0x2e, 0xf2, 0x5d, 0x2e, 0xe7, 0xdb, 0x9c, 0xe1,
-	0xfb, 0x33, 0x81, 0x8d, 0x29, 0xdf, 0x01, 0x95, 0x9a, 0x51, 0xdf, 0x8f, 0x6e, 0x3f, 0xf1, 0x09,
-	0x3c, 0x98, 0xf2, 0x3e, 0xc8, 0xd6, 0xf7, 0x9f, 0x87, 0x5e, 0xd5, 0x6e, 0x2d, 0xe0, 0x36, 0xca,
-	0x71, 0x17, 0x67, 0x70, 0x5f, 0x66, 0xdb, 0xf1, 0x70, 0xcc, 0x3d, 0xf5, 0x54, 0x04, 0x01, 0xd3,
-	0x31, 0xe0, 0xfb, 0x70, 0x87, 0xba, 0xae, 0x18, 0x73, 0x9d, 0xe0, 0x95, 0x6d, 0xb7, 0xcc, 0xb0,
-	0x9c, 0x49, 0x9c, 0xe0, 0x20, 0x89, 0xb7, 0x98, 0x26, 0x38, 0x99, 0x59, 0xf7, 0x61, 0x51, 0xd3,
-	0x51, 0x9a, 0xc9, 0x78, 0x68, 0x7f, 0x43, 0xe0, 0xed, 0x84, 0x92, 0x61, 0x13, 0x20, 0xd7, 0x0e,
-	0xfa, 0x48, 0xd5, 0x7f, 0x4b, 0xeb, 0x97, 0x2c, 0x53, 0x1f, 0x27, 0xbe, 0x9f, 0x32, 0x7d, 0xea,
-	0x49, 0x7a, 0x56, 0x0c, 0x4f, 0x5e, 0x1b, 0xbe, 0x51, 0x08, 0xff, 0x04, 0x56, 0x3c, 0x54, 0x9a,
-	0x71, 0xaa, 0x99, 0xe0, 0x06, 0xbb, 0xec, 0x44, 0xe7, 0x8c, 0xe3, 0x72, 0x70, 0x96, 0x82, 0xf3,
-	0xb8, 0x1c, 0x2c, 0x55, 0x39, 0x5f, 0x59, 0xf7, 0x23, 0xfb, 0x45, 0x7a, 0x3e, 0x8c, 0x88, 0x7d,
-	0xd4, 0x94, 0xf9, 0x2a, 0xdb, 0x65, 0xa5, 0x52, 0x76, 0x01, 0xc6, 0xc6, 0x6e, 0x9e, 0x1a, 0xd4,
-	0x4a, 0x6d, 0xfb, 0x91, 0xcd, 0xc1, 0xca, 0x41, 0x1e, 0x70, 0x7a, 0xe2, 0xd7, 0x85, 0xf5, 0xa4,
-	0xd1, 0x26, 0xb6, 0x28, 0xfc, 0xa7, 0x7d, 0xa6, 0xea, 0x06, 0x0c, 0xa1, 0x9d, 0x03, 0x4c, 0x4e,
-	0xb0, 0xaa, 0x55, 0xe6, 0xb5, 0xbf, 0x68, 0x10, 0xeb, 0x15, 0x6a, 0x6b, 0x78, 0x37, 0x07, 0xf9,
-	0x5c, 0xa1, 0x7c, 0x86, 0x5a, 0xfb, 0x58, 0xaf, 0xd0, 0x31, 0x3c, 0xbc, 0x11, 0xb5, 0x66, 0xb1,
-	0x45, 0xd8, 0x69, 0x1d, 0xaa, 0xf9, 0xb7, 0x4e, 0xa0, 0x73, 0x33, 0x6c, 0xcd, 0x72, 0xbf, 0x82,
-	0xad, 0x1c, 0xee, 0x11, 0xd7, 0x28, 0x03, 0xf4, 0x18, 0x95, 0xd1, 0x3e, 0x72, 0x11, 0xd4, 0x5b,
-	0x1e, 0x8a, 0xb9, 0x1e, 0xa0, 0x0c, 0x98, 0x52, 0x4c, 0xf0, 0x9a, 0xab, 0x52, 0xf1, 0x08, 0x39,
-	0xf8, 0x62, 0x4f, 0x6b, 0x59, 0x2f, 0xe4, 0x4e, 0xa1, 0x10, 0x66, 0x17, 0xd1, 0x32, 0x2c, 0xfb,
-	0x43, 0x58, 0xcf, 0xb9, 0x1c, 0x22, 0xce, 0x95, 0x15, 0x7b, 0x2d, 0x45, 0x1a, 0x50, 0x49, 0x83,
-	0xcc, 0xc5, 0xfe, 0x33, 0xeb, 0x60, 0x03, 0x1a, 0xc5, 0xdb, 0x2a, 0x63, 0xf0, 0x18, 0x9a, 0x4a,
-	0x8c, 0xa5, 0x8b, 0x95, 0x3d, 0x35, 0xb5, 0xb3, 0xb6, 0xe0, 0x9e, 0x19, 0x0d, 0x0b, 0xdd, 0x6d,
-	0xd5, 0x2c, 0xee, 0x99, 0x1e, 0xf7, 0x18, 0x9a, 0x9a, 0xca, 0x11, 0xea, 0xca, 0xf6, 0x96, 0xda,
-	0xc5, 0x61, 0xcd, 0x28, 0x0b, 0x6b, 0xda, 0xef, 0xaa, 0x59, 0x4c, 0xc3, 0x5e, 0xbb, 0xd2, 0x2c,
-	0xcf, 0x5c, 0x69, 0xbe, 0x6f, 0x14, 0x65, 0x66, 0x19, 0xab, 0x49, 0xe6, 0x2e, 0x80, 0xf0, 0xbd,
-	0xe1, 0x9c, 0x52, 0x5b, 0xc2, 0xf7, 0x8e, 0x8d, 0xda, 0x5d, 0x00, 0x8e, 0x67, 0x99, 0x63, 0x55,
-	0x17, 0x6f, 0x71, 0x3c, 0x3b, 0x7e, 0x4d, 0x9a, 0x96, 0xab, 0xd3, 0x34, 0x7b, 0xe3, 0xfc, 0x8b,
-	0xc0, 0x5a, 0x3e, 0x4d, 0x7b, 0xae, 0x8b, 0xe1, 0xff, 0x70, 0x3b, 0x7c, 0x7b, 0x4d, 0xa7, 0x83,
-	0x5f, 0xa0, 0xfb, 0xcf, 0x74, 0x4e, 0x25, 0x34, 0xe6, 0x94, 0x50, 0x79, 0xff, 0xfe, 0x8e, 0xc0,
-	0x5b, 0x85, 0x33, 0x79, 0xf5, 0x20, 0xbc, 0x0d, 0xf4, 0xfa, 0xf8, 0xeb, 0x45, 0x87, 0xbc, 0xba,
-	0xe8, 0x90, 0x3f, 0x2e, 0x3a, 0xe4, 0xe5, 0x65, 0x67, 0xe1, 0xd5, 0x65, 0x67, 0xe1, 0xf7, 0xcb,
-	0xce, 0x02, 0x6c, 0x30, 0xd1, 0xbd, 0xf9, 0x2d, 0x3e, 0x20, 0x9f, 0x75, 0x47, 0x4c, 0x9f, 0x8e,
-	0x4f, 0xba, 0xae, 0x08, 0x7a, 0x53, 0xa3, 0x47, 0x4c, 0xe4, 0x66, 0xbd, 0xf3, 0xab, 0x57, 0xfe,
-	0x49, 0x33, 0x79, 0xa9, 0x7f, 0xf0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x79, 0x48, 0x20,
-	0x03, 0x10, 0x00, 0x00,
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xef, 0x24, 0x4d, 0x76, 0xf3, 0xda, 0x95, 0x16, 0x53, 0x4a, 0x42, 0xd9, 0x50, 0xb9, 0x97,
+	0x5e, 0x36, 0xa1, 0x20, 0x54, 0x69, 0x39, 0x25, 0xdb, 0x56, 0xea, 0x61, 0x45, 0x94, 0xed, 0x0a,
+	0x89, 0x4b, 0x34, 0xf5, 0x3c, 0xd2, 0x01, 0x7b, 0xc6, 0x3b, 0x33, 0x49, 0x6b, 0xf1, 0x11, 0xb8,
+	0xec, 0x81, 0x1b, 0x1c, 0xb9, 0xc2, 0x09, 0xf1, 0x05, 0xb8, 0x70, 0x5c, 0x71, 0xe2, 0x88, 0x5a,
+	0xf8, 0x1e, 0xc8, 0xff, 0x12, 0xbb, 0xed, 0xc6, 0x15, 0xc8, 0x50, 0xed, 0xcd, 0xef, 0xf9, 0xbd,
+	0xf7, 0xfb, 0xfd, 0x9e, 0x67, 0x9e, 0xc7, 0x86, 0x2d, 0x5f, 0xc9, 0x29, 0x0a, 0x2a, 0x1c, 0xec,
+	0xe2, 0x99, 0x73, 0x42, 0xc5, 0x18, 0xbb, 0xd3, 0x9d, 0x2e, 0x4e, 0x51, 0x18, 0xdd, 0xf1, 0x95,
+	0x34, 0xd2, 0x5a, 0x9f, 0x07, 0x75, 0xd2, 0xa0, 0xce, 0x74, 0xe7, 0x9d, 0x96, 0x23, 0xb5, 0x27,
+	0xf5, 0x28, 0x8a, 0xea, 0xc6, 0x46, 0x9c, 0x62, 0x7f, 0x4d, 0xe0, 0x8d, 0xfd, 0xb0, 0xc6, 0x27,
+	0x8a, 0xa1, 0x7a, 0xac, 0x90, 0x1a, 0x64, 0x56, 0x0b, 0xee, 0xca, 0xd0, 0x1e, 0x71, 0xd6, 0x24,
+	0x9b, 0x64, 0x7b, 0x79, 0x78, 0x27, 0xb2, 0x0f, 0x99, 0xf5, 0x00, 0x20, 0xbe, 0x65, 0x02, 0x1f,
+	0x9b, 0x95, 0x4d, 0xb2, 0xdd, 0x18, 0x36, 0x22, 0xcf, 0x51, 0xe0, 0xa3, 0xb5, 0x01, 0x0d, 0x8f,
+	0xaa, 0x2f, 0xd1, 0x84, 0xa9, 0xd5, 0x4d, 0xb2, 0x7d, 0x6f, 0x78, 0x37, 0x76, 0x1c, 0x32, 0xeb,
+	0x3d, 0x58, 0xc1, 0x33, 0x83, 0x4a, 0x50, 0x37, 0xbc, 0xbd, 0x1c, 0x25, 0x43, 0xea, 0x3a, 0x64,
+	0xf6, 0x2f, 0x04, 0xde, 0xcc, 0xb0, 0x09, 0x85, 0xb8, 0xee, 0x62, 0x3e, 0x1f, 0xc3, 0xaa, 0x93,
+	0xc6, 0x8d, 0x8e, 0x83, 0x98, 0x51, 0xbf, 0xf9, 0xdb, 0x4f, 0x0f, 0xd7, 0x12, 0xa1, 0x3d, 0xc6,
+	0x14, 0x6a, 0xfd, 0xd4, 0x28, 0x2e, 0xc6, 0xc3, 0x95, 0x59, 0x74, 0x3f, 0xf8, 0x77, 0x6c, 0xad,
+	0x75, 0xa8, 0x2b, 0xa4, 0x5a, 0x8a, 0x66, 0x2d, 0xba, 0x97, 0x58, 0xf6, 0x8f, 0xb9, 0x9e, 0xf6,
+	0x3c, 0x14, 0x6c, 0xb1, 0x86, 0x75, 0xa8, 0x53, 0xad, 0xd1, 0xe8, 0xa4, 0x9f, 0x89, 0x65, 0xad,
+	0x41, 0xcd, 0x57, 0xdc, 0xc1, 0x88, 0x5a, 0x63, 0x18, 0x1b, 0x96, 0x05, 0xcb, 0x9f, 0x23, 0xea,
+	0x84, 0x50, 0x74, 0x9d, 0x17, 0x52, 0x5b, 0x2c, 0xa4, 0x7e, 0xa5, 0xed, 0x3f, 0x10, 0xb8, 0x3f,
+	0x27, 0x7c, 0xc0, 0x5d, 0xf7, 0x76, 0xf3, 0xfd, 0x99, 0x40, 0x6b, 0xce, 0x77, 0x40, 0x95, 0xe1,
+	0xd4, 0x75, 0x83, 0xdb, 0x4f, 0x7c, 0x0a, 0x1b, 0x73, 0xde, 0xfb, 0xa9, 0x7f, 0xef, 0x99, 0xcf,
+	0x8a, 0xb6, 0x5d, 0x0e, 0xb7, 0xb2, 0x18, 0xb7, 0x7a, 0x05, 0xf7, 0x45, 0xba, 0xaf, 0x0e, 0x26,
+	0x82, 0xe9, 0xc7, 0xd2, 0xf3, 0xb8, 0x09, 0x01, 0x3f, 0x80, 0x3b, 0xd4, 0x71, 0xe4, 0x44, 0x98,
+	0x26, 0x29, 0xd8, 0x37, 0x69, 0xe0, 0x62, 0x26, 0x61, 0x83, 0xbd, 0xa8, 0x5e, 0x35, 0x69, 0x70,
+	0x64, 0x59, 0xf7, 0xa1, 0x6a, 0xe8, 0x38, 0xe9, 0x64, 0x78, 0x69, 0x7f, 0x43, 0xe0, 0xed, 0x88,
+	0x52, 0xcc, 0xc6, 0x43, 0x61, 0x86, 0xe8, 0x22, 0xd5, 0xff, 0x2f, 0xad, 0xd9, 0x04, 0x7a, 0x12,
+	0xe5, 0x7e, 0xca, 0xcd, 0x09, 0x53, 0xf4, 0x34, 0x5f, 0x9e, 0xbc, 0xb2, 0x7c, 0x25, 0x57, 0xfe,
+	0x11, 0xac, 0x30, 0xd4, 0x86, 0x0b, 0x6a, 0xb8, 0x14, 0xcd, 0x6a, 0x81, 0x96, 0x6c, 0x70, 0x38,
+	0xd7, 0x4e, 0x13, 0x70, 0x11, 0xce, 0xb5, 0xe5, 0xa2, 0xe4, 0x59, 0x74, 0x3f, 0xb0, 0x9f, 0x43,
+	0x2b, 0x23, 0x62, 0x0f, 0x0d, 0xe5, 0xae, 0x4e, 0x57, 0xd9, 0x42, 0x29, 0xbb, 0x00, 0x93, 0x38,
+	0xee, 0x26, 0xc3, 0xb4, 0x91, 0xc4, 0xf6, 0x03, 0x5b, 0x80, 0x95, 0x81, 0xdc, 0x17, 0xf4, 0xd8,
+	0x2d, 0x0b, 0xeb, 0x51, 0xa5, 0x49, 0x6c, 0x99, 0x7b, 0x4e, 0x7b, 0x5c, 0x97, 0x0d, 0xe8, 0x43,
+	0x33, 0x03, 0x18, 0xed, 0x60, 0x5d, 0xaa, 0xcc, 0x4b, 0x4f, 0x31, 0x46, 0x2c, 0x57, 0xa8, 0x6d,
+	0xe0, 0xdd, 0x0c, 0xe4, 0x33, 0x8d, 0xea, 0x29, 0x1a, 0xe3, 0x62, 0xb9, 0x42, 0x27, 0xf0, 0xe0,
+	0x5a, 0xd4, 0x92, 0xc5, 0x6a, 0xd8, 0xc8, 0xc0, 0xf6, 0x26, 0x46, 0x3e, 0xa1, 0xc6, 0x39, 0xd9,
+	0x17, 0xff, 0x5d, 0x87, 0x67, 0xa0, 0x25, 0x4b, 0xcd, 0x77, 0x78, 0x3e, 0x72, 0x4b, 0x5e, 0xc1,
+	0x53, 0x68, 0x5f, 0x0f, 0x5b, 0xb2, 0xdc, 0xaf, 0x60, 0x2b, 0x83, 0x7b, 0x28, 0x0c, 0x2a, 0x0f,
+	0x19, 0xa7, 0x2a, 0xd8, 0x43, 0x21, 0xbd, 0x72, 0x27, 0x61, 0xbe, 0xd7, 0x03, 0x54, 0x1e, 0xd7,
+	0x9a, 0x4b, 0x51, 0xf2, 0x00, 0xce, 0x4f, 0x8b, 0x21, 0x3e, 0xef, 0x19, 0xa3, 0xca, 0x85, 0xdc,
+	0xc9, 0xcd, 0xfc, 0xf4, 0xe3, 0x61, 0x11, 0x96, 0xfd, 0x11, 0xac, 0x67, 0x52, 0x0e, 0x10, 0x6f,
+	0xd4, 0x15, 0x7b, 0x2d, 0x41, 0x1a, 0x50, 0x45, 0xbd, 0x34, 0xc5, 0xfe, 0x33, 0x7d, 0x59, 0x0f,
+	0x68, 0x10, 0x2e, 0xab, 0x94, 0xc1, 0xfb, 0x50, 0xd7, 0x72, 0xa2, 0x1c, 0x2c, 0x3c, 0x3e, 0x24,
+	0x71, 0xd6, 0x16, 0xdc, 0x8b, 0xaf, 0x46, 0xb9, 0x17, 0xf9, 0x6a, 0xec, 0xec, 0x45, 0xbe, 0xb0,
+	0xac, 0xa1, 0x6a, 0x8c, 0xa6, 0xf0, 0x4d, 0x9e, 0xc4, 0x85, 0x65, 0xe3, 0xab, 0xb4, 0x6c, 0x7c,
+	0xd2, 0x58, 0x8d, 0x9d, 0x49, 0xd9, 0x4b, 0xa7, 0xb7, 0xda, 0x95, 0xd3, 0xdb, 0xf7, 0x95, 0xbc,
+	0xcc, 0xb4, 0x63, 0x25, 0xc9, 0xdc, 0x05, 0x90, 0x2e, 0x1b, 0xdd, 0x50, 0x6a, 0x43, 0xba, 0xec,
+	0x28, 0x56, 0xbb, 0x0b, 0x20, 0xf0, 0x34, 0x4d, 0x2c, 0x3a, 0xb0, 0x34, 0x04, 0x9e, 0x1e, 0xbd,
+	0xa2, 0x4d, 0xb5, 0xe2, 0x36, 0x5d, 0x3d, 0x5c, 0xff, 0x45, 0x60, 0x2d, 0xdb, 0xa6, 0x9e, 0xe3,
+	0xa0, 0xff, 0x1a, 0x2e, 0x87, 0x6f, 0x2f, 0xe9, 0x1c, 0xe2, 0x17, 0xe8, 0xfc, 0x33, 0x9d, 0x73,
+	0x09, 0x95, 0x1b, 0x4a, 0x28, 0xfc, 0xd4, 0xf8, 0x8e, 0xc0, 0x5b, 0xb9, 0x3d, 0x39, 0xfb, 0x88,
+	0xbf, 0x0d, 0xf4, 0xfa, 0xf8, 0xeb, 0x79, 0x9b, 0xbc, 0x3c, 0x6f, 0x93, 0x3f, 0xce, 0xdb, 0xe4,
+	0xc5, 0x45, 0x7b, 0xe9, 0xe5, 0x45, 0x7b, 0xe9, 0xf7, 0x8b, 0xf6, 0x12, 0xb4, 0xb8, 0xec, 0x5c,
+	0xff, 0xff, 0x64, 0x40, 0x3e, 0xeb, 0x8c, 0xb9, 0x39, 0x99, 0x1c, 0x77, 0x1c, 0xe9, 0x75, 0xe7,
+	0x41, 0x0f, 0xb9, 0xcc, 0x58, 0xdd, 0xb3, 0xd9, 0x9f, 0x99, 0xe3, 0x7a, 0xf4, 0x77, 0xe5, 0xc3,
+	0xbf, 0x07, 0x00, 0x85, 0x29, 0xe7, 0x6f, 0xb7, 0x11, 0x00, 0x00,
 }
 
 func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
@@ -1978,6 +2194,13 @@ func (m *EventOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
@@ -2005,6 +2228,67 @@ func (m *EventOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOrderAmended) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderAmended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderAmended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2559,7 +2843,77 @@ func (m *EventMarketUserSettleDisabled) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketAutoMatchEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketAutoMatchEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketAutoMatchEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketAutoMatchDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketAutoMatchDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketAutoMatchDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3136,6 +3490,41 @@ func (m *EventOrderCancelled) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderAmended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -3404,6 +3793,38 @@ func (m *EventMarketUserSettleDisabled) Size() (n int) {
 	return n
 }
 
+func (m *EventMarketAutoMatchEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketAutoMatchDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventMarketCommitmentsEnabled) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3939,6 +4360,38 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3960,7 +4413,7 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
+func (m *EventOrderAmended) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,10 +4436,10 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderAmended: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderAmended: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4176,7 +4629,7 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
+func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4199,10 +4652,10 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderPartiallyFilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderPartiallyFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4392,7 +4845,7 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4415,10 +4868,10 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderExternalIDUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderPartiallyFilled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderExternalIDUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderPartiallyFilled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4441,10 +4894,10 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4454,14 +4907,27 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4489,61 +4955,11 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundsCommitted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundsCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4571,9 +4987,262 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Fees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExternalIDUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExternalIDUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundsCommitted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundsCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -5714,6 +6383,208 @@ func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventMarketAutoMatchEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketAutoMatchEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketAutoMatchEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketAutoMatchDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketAutoMatchDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketAutoMatchDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -141,11 +141,119 @@ func TestNewEventOrderCancelled(t *testing.T) {
 			}
 			require.NotPanics(t, testFunc, "NewEventOrderCancelled")
 			assert.Equal(t, tc.expected, event, "NewEventOrderCancelled result")
+			assertEventContent(t, event, "EventOrderCancelled", false)
+		})
+	}
+}
+
+func TestNewEventOrderExpired(t *testing.T) {
+	tests := []struct {
+		name        string
+		order       OrderI
+		cancelledBy string
+		expected    *EventOrderCancelled
+	}{
+		{
+			name:        "ask order",
+			order:       NewOrder(12).WithAsk(&AskOrder{MarketId: 72, ExternalId: "an external identifier"}),
+			cancelledBy: "CancelledBy_________",
+			expected: &EventOrderCancelled{
+				OrderId:     12,
+				CancelledBy: "CancelledBy_________",
+				MarketId:    72,
+				ExternalId:  "an external identifier",
+				Reason:      "expired",
+			},
+		},
+		{
+			name:        "bid order",
+			order:       NewOrder(56).WithBid(&BidOrder{MarketId: 89, ExternalId: "another external identifier"}),
+			cancelledBy: "cancelled_by________",
+			expected: &EventOrderCancelled{
+				OrderId:     56,
+				CancelledBy: "cancelled_by________",
+				MarketId:    89,
+				ExternalId:  "another external identifier",
+				Reason:      "expired",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventOrderCancelled
+			testFunc := func() {
+				event = NewEventOrderExpired(tc.order, tc.cancelledBy)
+			}
+			require.NotPanics(t, testFunc, "NewEventOrderExpired")
+			assert.Equal(t, tc.expected, event, "NewEventOrderExpired result")
 			assertEverythingSet(t, event, "EventOrderCancelled")
 		})
 	}
 }
 
+func TestNewEventOrderAmended(t *testing.T) {
+	coinP := func(denom string, amount int64) *sdk.Coin {
+		rv := sdk.NewInt64Coin(denom, amount)
+		return &rv
+	}
+
+	tests := []struct {
+		name     string
+		order    OrderI
+		expected *EventOrderAmended
+	}{
+		{
+			name: "ask",
+			order: NewOrder(4).WithAsk(&AskOrder{
+				MarketId:                57,
+				Assets:                  sdk.NewInt64Coin("apple", 22),
+				Price:                   sdk.NewInt64Coin("plum", 18),
+				SellerSettlementFlatFee: coinP("fig", 57),
+				ExternalId:              "one",
+			}),
+			expected: &EventOrderAmended{
+				OrderId:    4,
+				Assets:     "22apple",
+				Price:      "18plum",
+				Fees:       "57fig",
+				MarketId:   57,
+				ExternalId: "one",
+			},
+		},
+		{
+			name: "bid",
+			order: NewOrder(104).WithBid(&BidOrder{
+				MarketId:            8,
+				Assets:              sdk.NewInt64Coin("apple", 23),
+				Price:               sdk.NewInt64Coin("plum", 19),
+				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 3), sdk.NewInt64Coin("grape", 4)),
+				ExternalId:          "two",
+			}),
+			expected: &EventOrderAmended{
+				OrderId:    104,
+				Assets:     "23apple",
+				Price:      "19plum",
+				Fees:       "3fig,4grape",
+				MarketId:   8,
+				ExternalId: "two",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventOrderAmended
+			testFunc := func() {
+				event = NewEventOrderAmended(tc.order)
+			}
+			require.NotPanics(t, testFunc, "NewEventOrderAmended")
+			assert.Equal(t, tc.expected, event, "NewEventOrderAmended result")
+			assertEverythingSet(t, event, "EventOrderAmended")
+		})
+	}
+}
+
 func TestNewEventOrderFilled(t *testing.T) {
 	coinP := func(denom string, amount int64) *sdk.Coin {
 		rv := sdk.NewInt64Coin(denom, amount)
@@ -586,6 +694,74 @@ func TestNewEventMarketUserSettleDisabled(t *testing.T) {
 	assertEverythingSet(t, event, "EventMarketUserSettleDisabled")
 }
 
+func TestNewEventMarketAutoMatchUpdated(t *testing.T) {
+	someAddr := sdk.AccAddress("some_address________").String()
+
+	tests := []struct {
+		name      string
+		marketID  uint32
+		updatedBy string
+		isEnabled bool
+		expected  proto.Message
+	}{
+		{
+			name:      "enabled",
+			marketID:  34,
+			updatedBy: someAddr,
+			isEnabled: true,
+			expected:  NewEventMarketAutoMatchEnabled(34, someAddr),
+		},
+		{
+			name:      "disabled",
+			marketID:  557,
+			updatedBy: someAddr,
+			isEnabled: false,
+			expected:  NewEventMarketAutoMatchDisabled(557, someAddr),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event proto.Message
+			testFunc := func() {
+				event = NewEventMarketAutoMatchUpdated(tc.marketID, tc.updatedBy, tc.isEnabled)
+			}
+			require.NotPanics(t, testFunc, "NewEventMarketAutoMatchUpdated(%d, %q, %t) result",
+				tc.marketID, tc.updatedBy, tc.isEnabled)
+			assert.Equal(t, tc.expected, event, "NewEventMarketAutoMatchUpdated(%d, %q, %t) result",
+				tc.marketID, tc.updatedBy, tc.isEnabled)
+		})
+	}
+}
+
+func TestNewEventMarketAutoMatchEnabled(t *testing.T) {
+	marketID := uint32(123)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketAutoMatchEnabled
+	testFunc := func() {
+		event = NewEventMarketAutoMatchEnabled(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketAutoMatchEnabled(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketAutoMatchEnabled")
+}
+
+func TestNewEventMarketAutoMatchDisabled(t *testing.T) {
+	marketID := uint32(123)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketAutoMatchDisabled
+	testFunc := func() {
+		event = NewEventMarketAutoMatchDisabled(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketAutoMatchDisabled(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketAutoMatchDisabled")
+}
+
 func TestNewEventMarketAcceptingCommitmentsUpdated(t *testing.T) {
 	updatedBy := sdk.AccAddress("updatedBy___________").String()
 
@@ -1323,6 +1499,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "external_id", Value: quoteStr("outside 8")},
 					{Key: "market_id", Value: "66"},
 					{Key: "order_id", Value: quoteStr("3")},
+					{Key: "reason", Value: quoteStr("")},
 				},
 			},
 		},
@@ -1336,6 +1513,28 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "external_id", Value: quoteStr("outside 8")},
 					{Key: "market_id", Value: "55"},
 					{Key: "order_id", Value: quoteStr("3")},
+					{Key: "reason", Value: quoteStr("")},
+				},
+			},
+		},
+		{
+			name: "EventOrderAmended",
+			tev: NewEventOrderAmended(NewOrder(5).WithAsk(&AskOrder{
+				MarketId:                34,
+				Assets:                  acoin,
+				Price:                   pcoin,
+				SellerSettlementFlatFee: &fcoin,
+				ExternalId:              "amended",
+			})),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventOrderAmended",
+				Attributes: []abci.EventAttribute{
+					{Key: "assets", Value: acoinQ},
+					{Key: "external_id", Value: quoteStr("amended")},
+					{Key: "fees", Value: fcoinQ},
+					{Key: "market_id", Value: "34"},
+					{Key: "order_id", Value: quoteStr("5")},
+					{Key: "price", Value: pcoinQ},
 				},
 			},
 		},
@@ -1541,6 +1740,28 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventMarketAutoMatchEnabled",
+			tev:  NewEventMarketAutoMatchEnabled(12, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketAutoMatchEnabled",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "12"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketAutoMatchDisabled",
+			tev:  NewEventMarketAutoMatchDisabled(13, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketAutoMatchDisabled",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "13"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
 		{
 			name: "EventMarketCommitmentsEnabled",
 			tev:  NewEventMarketCommitmentsEnabled(52, updatedBy),
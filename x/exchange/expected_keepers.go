@@ -2,11 +2,13 @@ package exchange
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/hold"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
@@ -33,6 +35,9 @@ type HoldKeeper interface {
 	AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error
 	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error
 	GetHoldCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
+	AddHoldEntry(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string, expiration *time.Time) (uint64, error)
+	ReleaseHoldByID(ctx sdk.Context, holder string, holdID uint64) error
+	IterateAllHoldEntries(ctx sdk.Context, process func(*hold.HoldEntry) bool) error
 }
 
 type MarkerKeeper interface {
@@ -3,6 +3,7 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -303,6 +304,16 @@ func (f orderFulfillment) GetHoldAmount() sdk.Coins {
 	return f.Order.GetHoldAmount()
 }
 
+// GetGoodTilTime gets this fulfillment's order's good-til-time.
+func (f orderFulfillment) GetGoodTilTime() *time.Time {
+	return f.Order.GetGoodTilTime()
+}
+
+// GetGoodTilBlockHeight gets this fulfillment's order's good-til-block-height.
+func (f orderFulfillment) GetGoodTilBlockHeight() uint64 {
+	return f.Order.GetGoodTilBlockHeight()
+}
+
 // DistributeAssets records the distribution of assets in the provided amount to/from the given order.
 func (f *orderFulfillment) DistributeAssets(order OrderI, amount sdkmath.Int) error {
 	if f.AssetsUnfilledAmt.LT(amount) {
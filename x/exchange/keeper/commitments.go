@@ -116,12 +116,14 @@ func (k Keeper) addCommitment(ctx sdk.Context, marketID uint32, addr sdk.AccAddr
 		}
 	}
 
-	err := k.holdKeeper.AddHold(ctx, addr, amount, fmt.Sprintf("x/exchange: commitment to %d", marketID))
+	cur := getCommitmentAmount(store, marketID, addr)
+	newAmt := cur.Add(amount...)
+	err := k.updateHold(ctx, MakeKeyCommitment(marketID, addr), addr, cur, newAmt, commitmentHoldReason(marketID))
 	if err != nil {
 		return err
 	}
 
-	addCommitmentAmount(k.getStore(ctx), marketID, addr, amount)
+	setCommitmentAmount(store, marketID, addr, newAmt)
 	k.emitEvent(ctx, exchange.NewEventFundsCommitted(addr.String(), marketID, amount, eventTag))
 	return nil
 }
@@ -178,7 +180,7 @@ func (k Keeper) ReleaseCommitment(ctx sdk.Context, marketID uint32, addr sdk.Acc
 		toRelease = cur
 	}
 
-	err := k.holdKeeper.ReleaseHold(ctx, addr, toRelease)
+	err := k.updateHold(ctx, MakeKeyCommitment(marketID, addr), addr, cur, newAmt, commitmentHoldReason(marketID))
 	if err != nil {
 		return err
 	}
@@ -265,13 +267,13 @@ func (k Keeper) ValidateAndCollectCommitmentCreationFee(ctx sdk.Context, marketI
 }
 
 // lookupNav gets a nav from the provided known navs, or if not known, gets it from the marker or metadata module.
-func (k Keeper) lookupNav(ctx sdk.Context, assetsDenom, priceDenom string, known []exchange.NetAssetPrice) *exchange.NetAssetPrice {
-	for _, nav := range known {
-		if nav.Assets.Denom == assetsDenom && nav.Price.Denom == priceDenom {
-			return &nav
-		}
+// If there isn't a direct nav, one is derived through the market's intermediary denom or the nav reference denoms.
+func (k Keeper) lookupNav(ctx sdk.Context, marketID uint32, assetsDenom, priceDenom string, known []exchange.NetAssetPrice) *exchange.NetAssetPrice {
+	resolved := k.resolveNav(ctx, marketID, assetsDenom, priceDenom, known)
+	if resolved == nil {
+		return nil
 	}
-	return k.GetNav(ctx, assetsDenom, priceDenom)
+	return &resolved.Nav
 }
 
 // CalculateCommitmentSettlementFee calculates the fee that the exchange must be paid (by the market) for the provided
@@ -299,7 +301,7 @@ func (k Keeper) CalculateCommitmentSettlementFee(ctx sdk.Context, req *exchange.
 
 	feeDenom := pioconfig.GetProvenanceConfig().FeeDenom
 	if convDenom != feeDenom {
-		rv.ToFeeNav = k.lookupNav(ctx, convDenom, feeDenom, req.Navs)
+		rv.ToFeeNav = k.lookupNav(ctx, req.MarketId, convDenom, feeDenom, req.Navs)
 		if rv.ToFeeNav == nil {
 			return nil, fmt.Errorf("no nav found from intermediary denom %q to fee denom %q", convDenom, feeDenom)
 		}
@@ -323,7 +325,7 @@ func (k Keeper) CalculateCommitmentSettlementFee(ctx sdk.Context, req *exchange.
 		case convDenom:
 			convDecAmt = convDecAmt.Add(sdkmath.LegacyNewDecFromInt(coin.Amount))
 		default:
-			nav := k.lookupNav(ctx, coin.Denom, convDenom, req.Navs)
+			nav := k.lookupNav(ctx, req.MarketId, coin.Denom, convDenom, req.Navs)
 			if nav == nil {
 				errs = append(errs, fmt.Errorf("no nav found from assets denom %q to intermediary denom %q", coin.Denom, convDenom))
 			} else {
@@ -1766,6 +1766,43 @@ func (s *TestSuite) TestKeeper_CalculateCommitmentSettlementFee() {
 				ToFeeNav: &exchange.NetAssetPrice{Assets: s.coin("10cherry"), Price: s.coin("31nhash")},
 			},
 		},
+		{
+			name: "one input denom: nav derived through reference denom",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:                 2,
+					CommitmentSettlementBips: 100,
+					IntermediaryDenom:        "cherry",
+				})
+				s.k.SetParams(s.ctx, &exchange.Params{NavReferenceDenoms: []string{"nhash"}})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueResult(s.coin("10cherry"), s.coin("30nhash")).
+				WithGetNetAssetValueResult(s.coin("20apple"), s.coin("10nhash")),
+			expGetNav: []*GetNetAssetValueArgs{
+				{markerDenom: "cherry", priceDenom: "nhash"},
+				{markerDenom: "apple", priceDenom: "cherry"},
+				{markerDenom: "apple", priceDenom: "nhash"},
+				{markerDenom: "nhash", priceDenom: "cherry"},
+				{markerDenom: "cherry", priceDenom: "nhash"},
+			},
+			req: &exchange.MsgMarketCommitmentSettleRequest{
+				MarketId: 2,
+				Inputs:   []exchange.AccountAmount{{Account: s.addr2.String(), Amount: s.coins("40apple")}},
+				Outputs:  []exchange.AccountAmount{{Account: s.addr3.String(), Amount: s.coins("40apple")}},
+			},
+			expResp: &exchange.QueryCommitmentSettlementFeeCalcResponse{
+				InputTotal: s.coins("40apple"),
+				// 20apple:10nhash and 30nhash:10cherry (inverted) => 600apple:100cherry => 6apple:1cherry
+				// 40apple*1cherry/6apple = 6.67 => 7cherry
+				ConvertedTotal: s.coins("7cherry"),
+				// 7cherry*30nhash/10cherry = 21nhash
+				// 21nhash * 100/20000 = 0.105nhash => 1nhash
+				ExchangeFees:   s.coins("1nhash"),
+				ConversionNavs: []exchange.NetAssetPrice{{Assets: s.coin("6apple"), Price: s.coin("1cherry")}},
+				ToFeeNav:       &exchange.NetAssetPrice{Assets: s.coin("10cherry"), Price: s.coin("30nhash")},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -98,6 +98,8 @@ var (
 	SetParamsFeeCreatePaymentFlat = setParamsFeeCreatePaymentFlat
 	// SetParamsFeeAcceptPaymentFlat is a test-only exposure of setParamsFeeAcceptPaymentFlat.
 	SetParamsFeeAcceptPaymentFlat = setParamsFeeAcceptPaymentFlat
+	// SetParamsNavReferenceDenoms is a test-only exposure of setParamsNavReferenceDenoms.
+	SetParamsNavReferenceDenoms = setParamsNavReferenceDenoms
 
 	// GetLastAutoMarketID is a test-only exposure of getLastAutoMarketID.
 	GetLastAutoMarketID = getLastAutoMarketID
@@ -127,6 +129,8 @@ var (
 	SetMarketAcceptingOrders = setMarketAcceptingOrders
 	// SetUserSettlementAllowed is a test-only exposure of setUserSettlementAllowed.
 	SetUserSettlementAllowed = setUserSettlementAllowed
+	// SetAutoMatchEnabled is a test-only exposure of setAutoMatchEnabled.
+	SetAutoMatchEnabled = setAutoMatchEnabled
 	// SetMarketAcceptingCommitments is a test-only exposure of setMarketAcceptingCommitments.
 	SetMarketAcceptingCommitments = setMarketAcceptingCommitments
 	// GrantPermissions is a test-only exposure of grantPermissions.
@@ -151,4 +155,7 @@ var (
 
 	// SetCommitmentAmount is a test-only exposure of setCommitmentAmount.
 	SetCommitmentAmount = setCommitmentAmount
+
+	// GetHoldID is a test-only exposure of getHoldID.
+	GetHoldID = getHoldID
 )
@@ -273,7 +273,7 @@ func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marke
 		}
 	}
 	if settlement.PartialOrderFilled != nil {
-		if err := k.releaseHoldOnOrder(ctx, settlement.PartialOrderFilled); err != nil {
+		if err := k.reduceHoldOnPartialOrder(ctx, settlement.PartialOrderFilled, settlement.PartialOrderLeft); err != nil {
 			errs = append(errs, err)
 		}
 	}
@@ -444,25 +444,116 @@ func (k Keeper) emitMetadataNAVEvents(ctx sdk.Context, scopeID metadatatypes.Met
 
 // GetNav looks up a NAV from the marker or metadata module and returns it as a NetAssetPrice.
 func (k Keeper) GetNav(ctx sdk.Context, assetsDenom, priceDenom string) *exchange.NetAssetPrice {
+	nav, _ := k.getNavWithHeight(ctx, assetsDenom, priceDenom)
+	return nav
+}
+
+// getNavWithHeight looks up a NAV from the marker or metadata module and returns it as a NetAssetPrice
+// along with the block height that it was last updated at.
+func (k Keeper) getNavWithHeight(ctx sdk.Context, assetsDenom, priceDenom string) (*exchange.NetAssetPrice, uint64) {
 	if strings.HasPrefix(assetsDenom, metadatatypes.DenomPrefix) {
 		// Get the nav from the metadata module.
 		nav, _ := k.metadataKeeper.GetNetAssetValue(ctx, assetsDenom, priceDenom)
 		if nav == nil {
-			return nil
+			return nil, 0
 		}
 		return &exchange.NetAssetPrice{
 			Assets: sdk.Coin{Denom: assetsDenom, Amount: sdkmath.NewIntFromUint64(nav.Volume)},
 			Price:  nav.Price,
-		}
+		}, nav.UpdatedBlockHeight
 	}
 
 	// Look for the nav in the marker module.
 	nav, _ := k.markerKeeper.GetNetAssetValue(ctx, assetsDenom, priceDenom)
 	if nav == nil {
-		return nil
+		return nil, 0
 	}
 	return &exchange.NetAssetPrice{
 		Assets: sdk.Coin{Denom: assetsDenom, Amount: sdkmath.NewIntFromUint64(nav.Volume)},
 		Price:  nav.Price,
+	}, nav.UpdatedBlockHeight
+}
+
+// GetResolvedNav finds a NAV from the assets denom to the price denom. If there isn't a direct NAV between them,
+// it will try to derive one through the market's intermediary denom (if a market id is provided), then
+// through each of the nav reference denoms defined in the params. Returns nil if no NAV can be found.
+func (k Keeper) GetResolvedNav(ctx sdk.Context, marketID uint32, assetsDenom, priceDenom string) *exchange.ResolvedNav {
+	return k.resolveNav(ctx, marketID, assetsDenom, priceDenom, nil)
+}
+
+// resolveNav finds a NAV from the assets denom to the price denom, using the known navs before looking them up.
+// See also: GetResolvedNav.
+func (k Keeper) resolveNav(ctx sdk.Context, marketID uint32, assetsDenom, priceDenom string, known []exchange.NetAssetPrice) *exchange.ResolvedNav {
+	getNav := func(assets, price string) (*exchange.NetAssetPrice, uint64) {
+		for _, nav := range known {
+			if nav.Assets.Denom == assets && nav.Price.Denom == price {
+				return &nav, uint64(ctx.BlockHeight()) //nolint:gosec // G115: Block heights are never negative.
+			}
+		}
+		return k.getNavWithHeight(ctx, assets, price)
+	}
+
+	if nav, height := getNav(assetsDenom, priceDenom); nav != nil {
+		return newResolvedNav(ctx, *nav, []exchange.NetAssetPrice{*nav}, "", exchange.NavConfidence_direct, height)
+	}
+
+	var viaDenoms []string
+	if marketID != 0 {
+		if convDenom := getIntermediaryDenom(k.getStore(ctx), marketID); len(convDenom) > 0 {
+			viaDenoms = append(viaDenoms, convDenom)
+		}
+	}
+	if params := k.GetParams(ctx); params != nil {
+		viaDenoms = append(viaDenoms, params.NavReferenceDenoms...)
+	}
+
+	tried := make(map[string]bool)
+	for _, via := range viaDenoms {
+		if tried[via] || via == assetsDenom || via == priceDenom {
+			continue
+		}
+		tried[via] = true
+
+		toVia, toViaHeight := getNav(assetsDenom, via)
+		if toVia == nil {
+			continue
+		}
+
+		confidence := exchange.NavConfidence_derived
+		fromVia, fromViaHeight := getNav(via, priceDenom)
+		var fromViaUsed exchange.NetAssetPrice
+		if fromVia != nil {
+			fromViaUsed = *fromVia
+		} else {
+			fromVia, fromViaHeight = getNav(priceDenom, via)
+			if fromVia == nil {
+				continue
+			}
+			confidence = exchange.NavConfidence_derived_inverted
+			fromViaUsed = fromVia.Invert()
+		}
+
+		nav, err := exchange.ChainNAVs(*toVia, fromViaUsed)
+		if err != nil {
+			continue
+		}
+		return newResolvedNav(ctx, *nav, []exchange.NetAssetPrice{*toVia, *fromVia}, via, confidence, min(toViaHeight, fromViaHeight))
+	}
+
+	return nil
+}
+
+// newResolvedNav creates a new ResolvedNav with its age calculated from the provided height.
+func newResolvedNav(ctx sdk.Context, nav exchange.NetAssetPrice, path []exchange.NetAssetPrice, via string, confidence exchange.NavConfidence, height uint64) *exchange.ResolvedNav {
+	rv := &exchange.ResolvedNav{
+		Nav:                nav,
+		Path:               path,
+		ViaDenom:           via,
+		Confidence:         confidence,
+		UpdatedBlockHeight: height,
+	}
+	if blockHeight := uint64(ctx.BlockHeight()); blockHeight > height { //nolint:gosec // G115: Block heights are never negative.
+		rv.AgeBlocks = blockHeight - height
 	}
+	return rv
 }
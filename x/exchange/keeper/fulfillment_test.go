@@ -2718,3 +2718,189 @@ func (s *TestSuite) TestKeeper_GetNav() {
 		})
 	}
 }
+
+func (s *TestSuite) TestKeeper_GetResolvedNav() {
+	navArgs := func(assetsDenom, priceDenom string) *GetNetAssetValueArgs {
+		return &GetNetAssetValueArgs{markerDenom: assetsDenom, priceDenom: priceDenom}
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		markerKeeper *MockMarkerKeeper
+		marketID     uint32
+		assetsDenom  string
+		priceDenom   string
+		expNav       *exchange.ResolvedNav
+		expGetNav    []*GetNetAssetValueArgs
+	}{
+		{
+			name:        "no nav and no via denoms",
+			assetsDenom: "apple",
+			priceDenom:  "pear",
+			expNav:      nil,
+			expGetNav:   []*GetNetAssetValueArgs{navArgs("apple", "pear")},
+		},
+		{
+			name: "direct nav",
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{NavReferenceDenoms: []string{"nhash"}})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueResult(s.coin("10apple"), s.coin("3pear")),
+			assetsDenom: "apple",
+			priceDenom:  "pear",
+			expNav: &exchange.ResolvedNav{
+				Nav:        exchange.NetAssetPrice{Assets: s.coin("10apple"), Price: s.coin("3pear")},
+				Path:       []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("3pear")}},
+				Confidence: exchange.NavConfidence_direct,
+				AgeBlocks:  100,
+			},
+			expGetNav: []*GetNetAssetValueArgs{navArgs("apple", "pear")},
+		},
+		{
+			name: "derived through reference denom",
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{NavReferenceDenoms: []string{"nhash"}})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueResult(s.coin("10apple"), s.coin("30nhash")).
+				WithGetNetAssetValueResult(s.coin("3nhash"), s.coin("2pear")),
+			assetsDenom: "apple",
+			priceDenom:  "pear",
+			expNav: &exchange.ResolvedNav{
+				Nav: exchange.NetAssetPrice{Assets: s.coin("1apple"), Price: s.coin("2pear")},
+				Path: []exchange.NetAssetPrice{
+					{Assets: s.coin("10apple"), Price: s.coin("30nhash")},
+					{Assets: s.coin("3nhash"), Price: s.coin("2pear")},
+				},
+				ViaDenom:   "nhash",
+				Confidence: exchange.NavConfidence_derived,
+				AgeBlocks:  100,
+			},
+			expGetNav: []*GetNetAssetValueArgs{
+				navArgs("apple", "pear"), navArgs("apple", "nhash"), navArgs("nhash", "pear"),
+			},
+		},
+		{
+			name: "derived through reference denom using inverted nav",
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{NavReferenceDenoms: []string{"nhash"}})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueResult(s.coin("10apple"), s.coin("30nhash")).
+				WithGetNetAssetValueResult(s.coin("4pear"), s.coin("6nhash")),
+			assetsDenom: "apple",
+			priceDenom:  "pear",
+			expNav: &exchange.ResolvedNav{
+				Nav: exchange.NetAssetPrice{Assets: s.coin("1apple"), Price: s.coin("2pear")},
+				Path: []exchange.NetAssetPrice{
+					{Assets: s.coin("10apple"), Price: s.coin("30nhash")},
+					{Assets: s.coin("4pear"), Price: s.coin("6nhash")},
+				},
+				ViaDenom:   "nhash",
+				Confidence: exchange.NavConfidence_derived_inverted,
+				AgeBlocks:  100,
+			},
+			expGetNav: []*GetNetAssetValueArgs{
+				navArgs("apple", "pear"), navArgs("apple", "nhash"), navArgs("nhash", "pear"), navArgs("pear", "nhash"),
+			},
+		},
+		{
+			name: "market intermediary denom tried before reference denoms",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 3, IntermediaryDenom: "cherry"})
+				s.k.SetParams(s.ctx, &exchange.Params{NavReferenceDenoms: []string{"nhash"}})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueResult(s.coin("10apple"), s.coin("30nhash")).
+				WithGetNetAssetValueResult(s.coin("3nhash"), s.coin("2pear")).
+				WithGetNetAssetValueResult(s.coin("5apple"), s.coin("7cherry")).
+				WithGetNetAssetValueResult(s.coin("2cherry"), s.coin("3pear")),
+			marketID:    3,
+			assetsDenom: "apple",
+			priceDenom:  "pear",
+			expNav: &exchange.ResolvedNav{
+				Nav: exchange.NetAssetPrice{Assets: s.coin("10apple"), Price: s.coin("21pear")},
+				Path: []exchange.NetAssetPrice{
+					{Assets: s.coin("5apple"), Price: s.coin("7cherry")},
+					{Assets: s.coin("2cherry"), Price: s.coin("3pear")},
+				},
+				ViaDenom:   "cherry",
+				Confidence: exchange.NavConfidence_derived,
+				AgeBlocks:  100,
+			},
+			expGetNav: []*GetNetAssetValueArgs{
+				navArgs("apple", "pear"), navArgs("apple", "cherry"), navArgs("cherry", "pear"),
+			},
+		},
+		{
+			name: "market intermediary denom without second nav falls back to reference denom",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 3, IntermediaryDenom: "cherry"})
+				s.k.SetParams(s.ctx, &exchange.Params{NavReferenceDenoms: []string{"nhash"}})
+			},
+			markerKeeper: NewMockMarkerKeeper().
+				WithGetNetAssetValueResult(s.coin("10apple"), s.coin("30nhash")).
+				WithGetNetAssetValueResult(s.coin("3nhash"), s.coin("2pear")).
+				WithGetNetAssetValueResult(s.coin("5apple"), s.coin("7cherry")),
+			marketID:    3,
+			assetsDenom: "apple",
+			priceDenom:  "pear",
+			expNav: &exchange.ResolvedNav{
+				Nav: exchange.NetAssetPrice{Assets: s.coin("1apple"), Price: s.coin("2pear")},
+				Path: []exchange.NetAssetPrice{
+					{Assets: s.coin("10apple"), Price: s.coin("30nhash")},
+					{Assets: s.coin("3nhash"), Price: s.coin("2pear")},
+				},
+				ViaDenom:   "nhash",
+				Confidence: exchange.NavConfidence_derived,
+				AgeBlocks:  100,
+			},
+			expGetNav: []*GetNetAssetValueArgs{
+				navArgs("apple", "pear"),
+				navArgs("apple", "cherry"), navArgs("cherry", "pear"), navArgs("pear", "cherry"),
+				navArgs("apple", "nhash"), navArgs("nhash", "pear"),
+			},
+		},
+		{
+			name: "via denoms that are repeated or one of the requested denoms are skipped",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 3, IntermediaryDenom: "cherry"})
+				s.k.SetParams(s.ctx, &exchange.Params{NavReferenceDenoms: []string{"apple", "cherry", "pear"}})
+			},
+			marketID:    3,
+			assetsDenom: "apple",
+			priceDenom:  "pear",
+			expNav:      nil,
+			expGetNav:   []*GetNetAssetValueArgs{navArgs("apple", "pear"), navArgs("apple", "cherry")},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			expMarkerCalls := MarkerCalls{GetNetAssetValue: tc.expGetNav}
+			if tc.markerKeeper == nil {
+				tc.markerKeeper = NewMockMarkerKeeper()
+			}
+			kpr := s.k.WithMarkerKeeper(tc.markerKeeper)
+
+			ctx := s.ctx.WithBlockHeight(100)
+			var actNav *exchange.ResolvedNav
+			testFunc := func() {
+				actNav = kpr.GetResolvedNav(ctx, tc.marketID, tc.assetsDenom, tc.priceDenom)
+			}
+			s.Require().NotPanics(testFunc, "GetResolvedNav(%d, %q, %q)", tc.marketID, tc.assetsDenom, tc.priceDenom)
+			if !s.Assert().Equal(tc.expNav, actNav, "GetResolvedNav result") && tc.expNav != nil && actNav != nil {
+				s.Assert().Equal(tc.expNav.Nav.String(), actNav.Nav.String(), "nav (string)")
+				assertEqualSlice(s, tc.expNav.Path, actNav.Path, exchange.NetAssetPrice.String, "path")
+			}
+			s.assertMarkerKeeperCalls(tc.markerKeeper, expMarkerCalls, "GetResolvedNav")
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/hold"
 )
 
 // InitGenesis writes the provided genesis state to the state store.
@@ -35,12 +36,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 		}
 	}
 
+	// The hold entry ids aren't part of the genesis state, so we identify the exchange's
+	// hold entries using the account and reason, and link them back up to their objects.
+	objKeys := make(map[string][]byte)
+	recordObjKey := func(addr, reason string, objKey []byte) {
+		objKeys[addr+" "+reason] = objKey
+	}
+
 	var maxOrderID uint64
 	for i, order := range genState.Orders {
 		if err := k.setOrderInStore(store, order); err != nil {
 			panic(fmt.Errorf("failed to store Orders[%d]: %w", i, err))
 		}
 		recordHold(order.GetOwner(), order.GetHoldAmount())
+		recordObjKey(order.GetOwner(), orderHoldReason(order.OrderId), MakeKeyOrder(order.OrderId))
 		if order.OrderId > maxOrderID {
 			maxOrderID = order.OrderId
 		}
@@ -58,6 +67,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 		}
 		addCommitmentAmount(store, com.MarketId, addr, com.Amount)
 		recordHold(com.Account, com.Amount)
+		recordObjKey(com.Account, commitmentHoldReason(com.MarketId), MakeKeyCommitment(com.MarketId, addr))
 	}
 
 	for i := range genState.Payments {
@@ -67,6 +77,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 			panic(fmt.Errorf("failed to store Payments[%d]: %w", i, err))
 		}
 		recordHold(payment.Source, payment.SourceAmount)
+		source, _ := sdk.AccAddressFromBech32(payment.Source)
+		recordObjKey(payment.Source, paymentHoldReason(payment.ExternalId), MakeKeyPayment(source, payment.ExternalId))
+	}
+
+	err := k.holdKeeper.IterateAllHoldEntries(ctx, func(entry *hold.HoldEntry) bool {
+		if entry.Holder != exchange.ModuleName {
+			return false
+		}
+		if objKey, found := objKeys[entry.Address+" "+entry.Reason]; found {
+			setHoldID(store, objKey, entry.HoldId)
+		}
+		return false
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to read hold entries: %w", err))
 	}
 
 	// Make sure all the needed funds have holds on them. These should have been placed during initialization of the hold module.
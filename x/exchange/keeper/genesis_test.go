@@ -8,6 +8,7 @@ import (
 
 	"github.com/provenance-io/provenance/x/exchange"
 	"github.com/provenance-io/provenance/x/exchange/keeper"
+	"github.com/provenance-io/provenance/x/hold"
 )
 
 // assertEqualGenState asserts that the provided gen states are equal and returns true if they are.
@@ -269,6 +270,7 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 						FeeBuyerSettlementRatios:  nil,
 						AcceptingOrders:           true,
 						AllowUserSettlement:       true,
+						AutoMatch:                 true,
 						AccessGrants: []exchange.AccessGrant{
 							{Address: s.addr1.String(), Permissions: []exchange.Permission{1, 2}},
 							{Address: s.addr2.String(), Permissions: []exchange.Permission{3, 4}},
@@ -734,3 +736,52 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 		})
 	}
 }
+
+func (s *TestSuite) TestKeeper_InitGenesis_HoldEntries() {
+	s.clearExchangeState()
+	genState := &exchange.GenesisState{
+		LastOrderId: 1,
+		Orders: []exchange.Order{
+			*exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+				MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("5pear"),
+			}),
+		},
+		Commitments: []exchange.Commitment{{Account: s.addr2.String(), MarketId: 3, Amount: s.coins("7cherry")}},
+		Payments:    []exchange.Payment{*s.newTestPayment(s.addr3, "4fig", s.addr4, "", "pay")},
+	}
+
+	holdKeeper := NewMockHoldKeeper().
+		WithGetHoldCoinResult(s.addr1, s.coin("10apple")).
+		WithGetHoldCoinResult(s.addr2, s.coin("7cherry")).
+		WithGetHoldCoinResult(s.addr3, s.coin("4fig"))
+	holdKeeper.HoldEntries = []*hold.HoldEntry{
+		{HoldId: 2, Address: s.addr1.String(), Holder: "marker", Reason: "x/exchange: order 1", Amount: s.coins("10apple")},
+		{HoldId: 5, Address: s.addr1.String(), Holder: exchange.ModuleName, Reason: "x/exchange: order 1", Amount: s.coins("10apple")},
+		{HoldId: 6, Address: s.addr2.String(), Holder: exchange.ModuleName, Reason: "x/exchange: commitment to 3", Amount: s.coins("7cherry")},
+		{HoldId: 8, Address: s.addr3.String(), Holder: exchange.ModuleName, Reason: `x/exchange: payment "pay"`, Amount: s.coins("4fig")},
+	}
+
+	kpr := s.k.WithHoldKeeper(holdKeeper)
+	testInit := func() {
+		kpr.InitGenesis(s.ctx, genState)
+	}
+	s.Require().NotPanics(testInit, "InitGenesis")
+
+	tests := []struct {
+		name   string
+		objKey []byte
+		expID  uint64
+	}{
+		{name: "order", objKey: keeper.MakeKeyOrder(1), expID: 5},
+		{name: "commitment", objKey: keeper.MakeKeyCommitment(3, s.addr2), expID: 6},
+		{name: "payment", objKey: keeper.MakeKeyPayment(s.addr3, "pay"), expID: 8},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			holdID, found := keeper.GetHoldID(s.getStore(), tc.objKey)
+			s.Assert().True(found, "GetHoldID found")
+			s.Assert().Equal(int(tc.expID), int(holdID), "GetHoldID hold id")
+		})
+	}
+}
@@ -715,3 +715,27 @@ func (k QueryServer) PaymentFeeCalc(goCtx context.Context, req *exchange.QueryPa
 	resp := k.CalculatePaymentFees(ctx, &req.Payment)
 	return resp, nil
 }
+
+// ResolveNav finds a NAV from one denom to another, deriving it through an intermediary or reference denom if needed.
+func (k QueryServer) ResolveNav(goCtx context.Context, req *exchange.QueryResolveNavRequest) (*exchange.QueryResolveNavResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.AssetsDenom) == 0 || len(req.PriceDenom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.MarketId != 0 {
+		if err := validateMarketExists(k.getStore(ctx), req.MarketId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	nav := k.GetResolvedNav(ctx, req.MarketId, req.AssetsDenom, req.PriceDenom)
+	if nav == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no nav found from %q to %q", req.AssetsDenom, req.PriceDenom)
+	}
+
+	return &exchange.QueryResolveNavResponse{Nav: nav}, nil
+}
@@ -5071,3 +5071,75 @@ func (s *TestSuite) TestQueryServer_PaymentFeeCalc() {
 		})
 	}
 }
+
+func (s *TestSuite) TestQueryServer_ResolveNav() {
+	markerKeeper := NewMockMarkerKeeper().
+		WithGetNetAssetValueResult(s.coin("10apple"), s.coin("30nhash")).
+		WithGetNetAssetValueResult(s.coin("4pear"), s.coin("6nhash"))
+	testDef := queryTestDef[exchange.QueryResolveNavRequest, exchange.QueryResolveNavResponse]{
+		queryName: "ResolveNav",
+		query:     keeper.NewQueryServer(s.k.WithMarkerKeeper(markerKeeper)).ResolveNav,
+	}
+
+	tests := []queryTestCase[exchange.QueryResolveNavRequest, exchange.QueryResolveNavResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no assets denom",
+			req:      &exchange.QueryResolveNavRequest{PriceDenom: "nhash"},
+			expInErr: []string{invalidArgErr, "invalid request"},
+		},
+		{
+			name:     "no price denom",
+			req:      &exchange.QueryResolveNavRequest{AssetsDenom: "apple"},
+			expInErr: []string{invalidArgErr, "invalid request"},
+		},
+		{
+			name:     "unknown market",
+			req:      &exchange.QueryResolveNavRequest{AssetsDenom: "apple", PriceDenom: "nhash", MarketId: 419},
+			expInErr: []string{invalidArgErr, "market 419 does not exist"},
+		},
+		{
+			name:     "no nav",
+			req:      &exchange.QueryResolveNavRequest{AssetsDenom: "apple", PriceDenom: "pear"},
+			expInErr: []string{invalidArgErr, "no nav found from \"apple\" to \"pear\""},
+		},
+		{
+			name: "direct nav",
+			req:  &exchange.QueryResolveNavRequest{AssetsDenom: "apple", PriceDenom: "nhash"},
+			expResp: &exchange.QueryResolveNavResponse{Nav: &exchange.ResolvedNav{
+				Nav:        exchange.NetAssetPrice{Assets: s.coin("10apple"), Price: s.coin("30nhash")},
+				Path:       []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("30nhash")}},
+				Confidence: exchange.NavConfidence_direct,
+				AgeBlocks:  uint64(s.ctx.BlockHeight()),
+			}},
+		},
+		{
+			name: "derived nav",
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{NavReferenceDenoms: []string{"nhash"}})
+			},
+			req: &exchange.QueryResolveNavRequest{AssetsDenom: "apple", PriceDenom: "pear"},
+			expResp: &exchange.QueryResolveNavResponse{Nav: &exchange.ResolvedNav{
+				// 10apple:30nhash and 6nhash:4pear => 60apple:120pear => 1apple:2pear
+				Nav: exchange.NetAssetPrice{Assets: s.coin("1apple"), Price: s.coin("2pear")},
+				Path: []exchange.NetAssetPrice{
+					{Assets: s.coin("10apple"), Price: s.coin("30nhash")},
+					{Assets: s.coin("4pear"), Price: s.coin("6nhash")},
+				},
+				ViaDenom:   "nhash",
+				Confidence: exchange.NavConfidence_derived_inverted,
+				AgeBlocks:  uint64(s.ctx.BlockHeight()),
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getHoldID gets the id of the hold entry that holds the funds of the object with the provided key.
+// The returned boolean is false if the object does not have a hold entry.
+func getHoldID(store storetypes.KVStore, objKey []byte) (uint64, bool) {
+	value := store.Get(MakeKeyHoldID(objKey))
+	return uint64FromBz(value)
+}
+
+// setHoldID records the id of the hold entry that holds the funds of the object with the provided key.
+func setHoldID(store storetypes.KVStore, objKey []byte, holdID uint64) {
+	store.Set(MakeKeyHoldID(objKey), uint64Bz(holdID))
+}
+
+// deleteHoldID deletes the record of the hold entry for the object with the provided key.
+func deleteHoldID(store storetypes.KVStore, objKey []byte) {
+	store.Delete(MakeKeyHoldID(objKey))
+}
+
+// orderHoldReason gets the reason to use for the hold on an order's funds.
+func orderHoldReason(orderID uint64) string {
+	return fmt.Sprintf("x/exchange: order %d", orderID)
+}
+
+// commitmentHoldReason gets the reason to use for the hold on funds committed to a market.
+func commitmentHoldReason(marketID uint32) string {
+	return fmt.Sprintf("x/exchange: commitment to %d", marketID)
+}
+
+// paymentHoldReason gets the reason to use for the hold on a payment's source funds.
+func paymentHoldReason(externalID string) string {
+	return fmt.Sprintf("x/exchange: payment %q", externalID)
+}
+
+// updateHold changes the funds on hold for the object with the provided key from curHold to newHold.
+//
+// Funds are held using hold entries owned by the exchange module, which only this module can release.
+// If the object has a hold entry, it is released in full and, if newHold isn't zero, a new one is created for newHold.
+// If the object does not have a hold entry, but curHold isn't zero, its funds were put on hold before this
+// module used hold entries; for those, the difference is released from (or added to) the aggregate hold amounts.
+func (k Keeper) updateHold(ctx sdk.Context, objKey []byte, addr sdk.AccAddress, curHold, newHold sdk.Coins, reason string) error {
+	store := k.getStore(ctx)
+	holdID, hasEntry := getHoldID(store, objKey)
+	if !hasEntry {
+		switch {
+		case newHold.IsZero():
+			return k.holdKeeper.ReleaseHold(ctx, addr, curHold)
+		case !curHold.IsZero():
+			toRelease, toAdd := getHoldDelta(curHold, newHold)
+			if !toRelease.IsZero() {
+				if err := k.holdKeeper.ReleaseHold(ctx, addr, toRelease); err != nil {
+					return err
+				}
+			}
+			if !toAdd.IsZero() {
+				if err := k.holdKeeper.AddHold(ctx, addr, toAdd, reason); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	} else {
+		if curHold.Equal(newHold) {
+			return nil
+		}
+		if err := k.holdKeeper.ReleaseHoldByID(ctx, exchange.ModuleName, holdID); err != nil {
+			return err
+		}
+		deleteHoldID(store, objKey)
+		if newHold.IsZero() {
+			return nil
+		}
+	}
+
+	newID, err := k.holdKeeper.AddHoldEntry(ctx, addr, newHold, exchange.ModuleName, reason, nil)
+	if err != nil {
+		return err
+	}
+	setHoldID(store, objKey, newID)
+	return nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// requireHoldEntry gets the hold entry for the object with the provided key and makes sure it looks right.
+func (s *TestSuite) requireHoldEntry(objKey []byte, addr sdk.AccAddress, amount string, reason string) uint64 {
+	s.T().Helper()
+	holdID, found := keeper.GetHoldID(s.getStore(), objKey)
+	s.Require().True(found, "GetHoldID found")
+	entry, err := s.app.HoldKeeper.GetHoldEntryByID(s.ctx, holdID)
+	s.Require().NoError(err, "GetHoldEntryByID(%d)", holdID)
+	s.Require().NotNil(entry, "GetHoldEntryByID(%d)", holdID)
+	expEntry := &hold.HoldEntry{
+		HoldId:  holdID,
+		Address: addr.String(),
+		Holder:  exchange.ModuleName,
+		Reason:  reason,
+		Amount:  s.coins(amount),
+	}
+	s.Require().Equal(expEntry, entry, "GetHoldEntryByID(%d)", holdID)
+	return holdID
+}
+
+// assertNoHoldEntry asserts that the object with the provided key does not have a hold entry.
+func (s *TestSuite) assertNoHoldEntry(objKey []byte, addr sdk.AccAddress) {
+	s.T().Helper()
+	_, found := keeper.GetHoldID(s.getStore(), objKey)
+	s.Assert().False(found, "GetHoldID found")
+	entries, err := s.app.HoldKeeper.GetHoldEntries(s.ctx, addr)
+	s.Assert().NoError(err, "GetHoldEntries(%s)", s.getAddrName(addr))
+	s.Assert().Empty(entries, "GetHoldEntries(%s)", s.getAddrName(addr))
+}
+
+// assertOthersCannotRelease asserts that a hold entry can't be released by a different holder or using ReleaseHold.
+func (s *TestSuite) assertOthersCannotRelease(holdID uint64, addr sdk.AccAddress, amount string) {
+	s.T().Helper()
+	err := s.app.HoldKeeper.ReleaseHoldByID(s.ctx, "marker", holdID)
+	expErr := fmt.Sprintf("cannot release hold entry %d: it is held by %q, not %q", holdID, exchange.ModuleName, "marker")
+	s.Assert().EqualError(err, expErr, "ReleaseHoldByID(marker, %d)", holdID)
+
+	err = s.app.HoldKeeper.ReleaseHold(s.ctx, addr, s.coins(amount))
+	s.Assert().ErrorContains(err, "on hold that is not in a hold entry", "ReleaseHold(%s, %q)", s.getAddrName(addr), amount)
+
+	for _, coin := range s.coins(amount) {
+		s.assertAmountOnHold(addr, coin)
+	}
+}
+
+// assertAmountOnHold asserts that the provided address has the provided amount on hold.
+func (s *TestSuite) assertAmountOnHold(addr sdk.AccAddress, exp sdk.Coin) {
+	s.T().Helper()
+	held, err := s.app.HoldKeeper.GetHoldCoin(s.ctx, addr, exp.Denom)
+	s.Assert().NoError(err, "GetHoldCoin(%s, %q)", s.getAddrName(addr), exp.Denom)
+	s.Assert().Equal(exp.String(), held.String(), "GetHoldCoin(%s, %q)", s.getAddrName(addr), exp.Denom)
+}
+
+func (s *TestSuite) TestKeeper_HoldEntries_Order() {
+	s.requireCreateMarketUnmocked(exchange.Market{MarketId: 1, AcceptingOrders: true})
+	s.requireFundAccount(s.addr1, "100apple")
+
+	askOrder := exchange.AskOrder{MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("60apple"), Price: s.coin("30pear")}
+	orderID, err := s.k.CreateAskOrder(s.ctx, askOrder, nil)
+	s.Require().NoError(err, "CreateAskOrder")
+	orderKey := keeper.MakeKeyOrder(orderID)
+	reason := fmt.Sprintf("x/exchange: order %d", orderID)
+	holdID := s.requireHoldEntry(orderKey, s.addr1, "60apple", reason)
+	s.assertOthersCannotRelease(holdID, s.addr1, "60apple")
+
+	amendMsg := &exchange.MsgAmendOrderRequest{
+		Owner: s.addr1.String(), OrderId: orderID, Assets: s.coin("40apple"), Price: s.coin("20pear"),
+	}
+	err = s.k.AmendOrder(s.ctx, amendMsg)
+	s.Require().NoError(err, "AmendOrder")
+	newHoldID := s.requireHoldEntry(orderKey, s.addr1, "40apple", reason)
+	s.Assert().NotEqual(holdID, newHoldID, "hold id after AmendOrder")
+	s.assertOthersCannotRelease(newHoldID, s.addr1, "40apple")
+
+	err = s.k.CancelOrder(s.ctx, orderID, s.addr1.String())
+	s.Require().NoError(err, "CancelOrder")
+	s.assertNoHoldEntry(orderKey, s.addr1)
+	s.assertAmountOnHold(s.addr1, s.zeroCoin("apple"))
+}
+
+func (s *TestSuite) TestKeeper_HoldEntries_LegacyOrder() {
+	s.requireCreateMarketUnmocked(exchange.Market{MarketId: 1, AcceptingOrders: true})
+	s.requireFundAccount(s.addr1, "100apple")
+	order := exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+		MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("60apple"), Price: s.coin("30pear"),
+	})
+	s.requireSetOrderInStore(s.getStore(), order)
+	s.requireAddHold(s.addr1, "60apple", 3)
+
+	err := s.k.CancelOrder(s.ctx, 3, s.addr1.String())
+	s.Require().NoError(err, "CancelOrder")
+	s.assertNoHoldEntry(keeper.MakeKeyOrder(3), s.addr1)
+	s.assertAmountOnHold(s.addr1, s.zeroCoin("apple"))
+}
+
+func (s *TestSuite) TestKeeper_HoldEntries_Commitment() {
+	s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingCommitments: true})
+	s.requireFundAccount(s.addr2, "100apple")
+	comKey := keeper.MakeKeyCommitment(2, s.addr2)
+	reason := "x/exchange: commitment to 2"
+
+	err := s.k.AddCommitment(s.ctx, 2, s.addr2, s.coins("50apple"), "")
+	s.Require().NoError(err, "AddCommitment")
+	holdID := s.requireHoldEntry(comKey, s.addr2, "50apple", reason)
+	s.assertOthersCannotRelease(holdID, s.addr2, "50apple")
+
+	err = s.k.ReleaseCommitment(s.ctx, 2, s.addr2, s.coins("20apple"), "")
+	s.Require().NoError(err, "ReleaseCommitment(20apple)")
+	holdID = s.requireHoldEntry(comKey, s.addr2, "30apple", reason)
+	s.assertOthersCannotRelease(holdID, s.addr2, "30apple")
+
+	err = s.k.ReleaseCommitment(s.ctx, 2, s.addr2, nil, "")
+	s.Require().NoError(err, "ReleaseCommitment(all)")
+	s.assertNoHoldEntry(comKey, s.addr2)
+	s.assertAmountOnHold(s.addr2, s.zeroCoin("apple"))
+}
+
+func (s *TestSuite) TestKeeper_HoldEntries_Payment() {
+	s.requireFundAccount(s.addr3, "100apple")
+	payment := s.newTestPayment(s.addr3, "10apple", s.addr4, "", "p1")
+	payKey := keeper.MakeKeyPayment(s.addr3, "p1")
+
+	err := s.k.CreatePayment(s.ctx, payment)
+	s.Require().NoError(err, "CreatePayment")
+	holdID := s.requireHoldEntry(payKey, s.addr3, "10apple", `x/exchange: payment "p1"`)
+	s.assertOthersCannotRelease(holdID, s.addr3, "10apple")
+
+	err = s.k.CancelPayments(s.ctx, s.addr3, []string{"p1"})
+	s.Require().NoError(err, "CancelPayments")
+	s.assertNoHoldEntry(payKey, s.addr3)
+	s.assertAmountOnHold(s.addr3, s.zeroCoin("apple"))
+}
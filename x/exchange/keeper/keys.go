@@ -5,7 +5,9 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -25,6 +27,7 @@ import (
 //   The payment flat fees are stored as string versions of the coins.
 //   Create Payment Flat: 0x00 | "fee_create_payment_flat" => string(coins)
 //   Accept Payment Flat: 0x00 | "fee_accept_payment_flat" => string(coins)
+//   NAV Reference Denoms: 0x00 | "nav_reference_denoms" => 0x1E-separated list of denoms.
 //
 // Last Market ID: 0x06 => uint32
 //   This stores the last auto-selected market id.
@@ -53,6 +56,8 @@ import (
 //   Market Create-Commitment Flat Fee: 0x01 | <market_id> | 0x11 | <denom> => <amount> (string)
 //   Market Commitment Settlement Bips: 0x01 | <market_id> | 0x12 => uint16
 //   Market Intermediary Denom: 0x01 | <market_id> | 0x13 => <denom>
+//   Market auto-match indicator: 0x01 | <market_id> | 0x14 => nil
+//   Market auto-match failed order: 0x01 | <market_id> | 0x15 | <order_id> (8 bytes) => <last order id> (8 bytes)
 //
 //   The <permission_type_byte> is a single byte as uint8 with the same values as the enum entries.
 //   The <req_attr_type_byte> is either an order type byte or 0x63 (= 'c' for commitments).
@@ -80,6 +85,23 @@ import (
 //    Asset denom to order: 0x05 | <asset_denom> | <order_id> (8 bytes) => <order type byte>
 //    Market + external id to order: 0x09 | <market id> (4 bytes) | <external_id> => <order id> (8 bytes)
 //    Target to payment: 0x10 | len(<target>) (1 byte) | <target> | len(<source>) (1 byte) | <source> | <external id>
+//    Expiration time to order: 0x11 | <good til time> (8 bytes) | <order_id> (8 bytes) => <order type byte>
+//    Expiration height to order: 0x12 | <good til block height> (8 bytes) | <order_id> (8 bytes) => <order type byte>
+//    Market price to order: 0x14 | <market_id> (4 bytes) | len(<asset_denom>) (1 byte) | <asset_denom>
+//        | len(<price_denom>) (1 byte) | <price_denom> | <order type byte> | <unit price> | <order_id> (8 bytes) => <order type byte>
+//
+//    The <good til time> is the unix timestamp (in seconds) as a uint64 in big-endian order (8 bytes).
+//    The <good til block height> is a uint64 in big-endian order (8 bytes).
+//    The <unit price> is the order's price amount / assets amount, multiplied by 10^18 and truncated to an integer.
+//    It is stored as a length byte followed by the integer's big-endian bytes, so that the keys sort by unit price.
+//
+// Hold ids:
+//   The funds for orders, commitments, and payments are held using hold entries in the hold module.
+//   The id of each one's hold entry is stored using the object's key (above) prefixed with 0x13.
+//   Order hold id: 0x13 | 0x02 | <order_id> (8 bytes) => <hold id> (8 bytes)
+//   Commitment hold id: 0x13 | 0x63 | <market_id> (4 bytes) | <address> => <hold id> (8 bytes)
+//   Payment hold id: 0x13 | 0x70 | len(<source>) (1 byte) | <source> | <external id> => <hold id> (8 bytes)
+//   Objects without a hold id entry have their funds held in the hold module's (legacy) aggregate amounts.
 
 const (
 	// KeyTypeParams is the type byte for params entries.
@@ -108,6 +130,14 @@ const (
 	KeyTypePayment = byte(0x70)
 	// KeyTypeTargetToPaymentIndex is the type byte for entries in the target to payment index.
 	KeyTypeTargetToPaymentIndex = byte(0x10)
+	// KeyTypeExpirationTimeToOrderIndex is the type byte for entries in the expiration time to order index.
+	KeyTypeExpirationTimeToOrderIndex = byte(0x11)
+	// KeyTypeExpirationHeightToOrderIndex is the type byte for entries in the expiration height to order index.
+	KeyTypeExpirationHeightToOrderIndex = byte(0x12)
+	// KeyTypeHoldID is the type byte for the hold entry ids of orders, commitments, and payments.
+	KeyTypeHoldID = byte(0x13)
+	// KeyTypeMarketPriceToOrderIndex is the type byte for entries in the market and unit price to order index.
+	KeyTypeMarketPriceToOrderIndex = byte(0x14)
 
 	// ParamsKeyTypeSplit is the type string used in the keys for params.DefaultSplit and params.DenomSplits.
 	ParamsKeyTypeSplit = "split"
@@ -115,6 +145,8 @@ const (
 	ParamsKeyTypeFeeCreatePaymentFlat = "fee_create_payment_flat"
 	// ParamsKeyTypeFeeAcceptPaymentFlat is the type string used in the keys for params.FeeAcceptPaymentFlat.
 	ParamsKeyTypeFeeAcceptPaymentFlat = "fee_accept_payment_flat"
+	// ParamsKeyTypeNavReferenceDenoms is the type string used in the key for params.NavReferenceDenoms.
+	ParamsKeyTypeNavReferenceDenoms = "nav_reference_denoms"
 
 	// MarketKeyTypeCreateAskFlat is the market-specific type byte for the create-ask flat fees.
 	MarketKeyTypeCreateAskFlat = byte(0x00)
@@ -144,6 +176,10 @@ const (
 	MarketKeyTypeCommitmentSettlementBips = byte(0x12)
 	// MarketKeyTypeIntermediaryDenom is the market-specific type byte for the intermediary denom used in fee calcs.
 	MarketKeyTypeIntermediaryDenom = byte(0x13)
+	// MarketKeyTypeAutoMatch is the market-specific type byte for the auto-match indicators.
+	MarketKeyTypeAutoMatch = byte(0x14)
+	// MarketKeyTypeAutoMatchFailedOrder is the market-specific type byte for orders that auto-matching could not fill.
+	MarketKeyTypeAutoMatchFailedOrder = byte(0x15)
 
 	// OrderKeyTypeAsk is the order-specific type byte for ask orders.
 	OrderKeyTypeAsk = exchange.OrderTypeByteAsk
@@ -256,6 +292,11 @@ func MakeKeyParamsFeeAcceptPaymentFlat() []byte {
 	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeFeeAcceptPaymentFlat), 0)
 }
 
+// MakeKeyParamsNavReferenceDenoms creates the key to use for the params NavReferenceDenoms entry.
+func MakeKeyParamsNavReferenceDenoms() []byte {
+	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeNavReferenceDenoms), 0)
+}
+
 // MakeKeyLastMarketID creates the key for the last auto-selected market id.
 func MakeKeyLastMarketID() []byte {
 	return []byte{KeyTypeLastMarketID}
@@ -613,6 +654,18 @@ func MakeKeyMarketIntermediaryDenom(marketID uint32) []byte {
 	return keyPrefixMarketType(marketID, MarketKeyTypeIntermediaryDenom, 0)
 }
 
+// MakeKeyMarketAutoMatch creates the key to use to indicate that a market's orders should be matched automatically.
+func MakeKeyMarketAutoMatch(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeAutoMatch, 0)
+}
+
+// MakeKeyMarketAutoMatchFailedOrder creates the key to use to indicate that auto-matching could not fill an order.
+func MakeKeyMarketAutoMatchFailedOrder(marketID uint32, orderID uint64) []byte {
+	rv := keyPrefixMarketType(marketID, MarketKeyTypeAutoMatchFailedOrder, 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
 // keyPrefixOrder creates the key prefix for orders with the provided extra capacity for additional elements.
 func keyPrefixOrder(extraCap int) []byte {
 	return prepKey(KeyTypeOrder, nil, extraCap)
@@ -818,6 +871,137 @@ func MakeIndexKeyMarketExternalIDToOrder(marketID uint32, externalID string) []b
 	return rv
 }
 
+// GetIndexKeyPrefixExpirationTimeToOrder gets the key prefix for all entries in the expiration time to order index.
+func GetIndexKeyPrefixExpirationTimeToOrder() []byte {
+	return prepKey(KeyTypeExpirationTimeToOrderIndex, nil, 0)
+}
+
+// GetIndexKeyPrefixExpirationTimeToOrderUpTo gets the key prefix for the expiration time to order index
+// entries with a time up to (but not including) the provided time.
+// It's meant to be used as the end of an iterator.
+func GetIndexKeyPrefixExpirationTimeToOrderUpTo(upTo time.Time) []byte {
+	return prepKey(KeyTypeExpirationTimeToOrderIndex, uint64Bz(uint64(upTo.Unix())), 0)
+}
+
+// MakeIndexKeyExpirationTimeToOrder creates the key to use for the expiration time to order index for the provided values.
+func MakeIndexKeyExpirationTimeToOrder(goodTilTime time.Time, orderID uint64) []byte {
+	rv := prepKey(KeyTypeExpirationTimeToOrderIndex, uint64Bz(uint64(goodTilTime.Unix())), 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
+// GetIndexKeyPrefixExpirationHeightToOrder gets the key prefix for all entries in the expiration height to order index.
+func GetIndexKeyPrefixExpirationHeightToOrder() []byte {
+	return prepKey(KeyTypeExpirationHeightToOrderIndex, nil, 0)
+}
+
+// GetIndexKeyPrefixExpirationHeightToOrderUpTo gets the key prefix for the expiration height to order index
+// entries with a height up to (but not including) the provided height.
+// It's meant to be used as the end of an iterator.
+func GetIndexKeyPrefixExpirationHeightToOrderUpTo(upTo uint64) []byte {
+	return prepKey(KeyTypeExpirationHeightToOrderIndex, uint64Bz(upTo), 0)
+}
+
+// MakeIndexKeyExpirationHeightToOrder creates the key to use for the expiration height to order index for the provided values.
+func MakeIndexKeyExpirationHeightToOrder(goodTilBlockHeight uint64, orderID uint64) []byte {
+	rv := prepKey(KeyTypeExpirationHeightToOrderIndex, uint64Bz(goodTilBlockHeight), 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
+// unitPriceScale is the factor applied to unit prices (price / assets) before truncating them for the market price to order index.
+var unitPriceScale = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// unitPriceBz gets the <unit price> portion of a market price to order index key: a length byte followed by
+// the big-endian bytes of (price * 10^18 / assets), truncated. Keys with these bytes sort by unit price.
+// Invalid amounts (e.g. non-positive assets) are given a unit price of zero.
+func unitPriceBz(assetsAmount, priceAmount sdkmath.Int) []byte {
+	if assetsAmount.IsNil() || priceAmount.IsNil() || !assetsAmount.IsPositive() || priceAmount.IsNegative() {
+		return []byte{0}
+	}
+	unitPrice := new(big.Int).Mul(priceAmount.BigInt(), unitPriceScale)
+	unitPrice.Quo(unitPrice, assetsAmount.BigInt())
+	bz := unitPrice.Bytes()
+	if len(bz) > 255 {
+		panic(fmt.Errorf("cannot create unit price for %s / %s: too large", priceAmount, assetsAmount))
+	}
+	rv := make([]byte, 0, 1+len(bz))
+	rv = append(rv, byte(len(bz)))
+	rv = append(rv, bz...)
+	return rv
+}
+
+// indexPrefixMarketPriceToOrder creates the prefix for the market price to order index entries with some extra space for the rest.
+func indexPrefixMarketPriceToOrder(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeMarketPriceToOrderIndex, uint32Bz(marketID), extraCap)
+}
+
+// GetIndexKeyPrefixMarketPriceToOrder creates the prefix for the market price to order index limited to the given market id.
+func GetIndexKeyPrefixMarketPriceToOrder(marketID uint32) []byte {
+	return indexPrefixMarketPriceToOrder(marketID, 0)
+}
+
+// indexPrefixMarketPriceToOrderBook creates the prefix for the market price to order index entries
+// with the given market id and denoms, with some extra space for the rest.
+func indexPrefixMarketPriceToOrderBook(marketID uint32, assetsDenom, priceDenom string, extraCap int) []byte {
+	if len(assetsDenom) == 0 || len(priceDenom) == 0 {
+		panic(errors.New("empty denom not allowed"))
+	}
+	suffix := append(address.MustLengthPrefix([]byte(assetsDenom)), address.MustLengthPrefix([]byte(priceDenom))...)
+	rv := indexPrefixMarketPriceToOrder(marketID, len(suffix)+extraCap)
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// GetIndexKeyPrefixMarketPriceToOrderBook creates the prefix for the market price to order index
+// limited to the given market id, assets denom, and price denom.
+func GetIndexKeyPrefixMarketPriceToOrderBook(marketID uint32, assetsDenom, priceDenom string) []byte {
+	return indexPrefixMarketPriceToOrderBook(marketID, assetsDenom, priceDenom, 0)
+}
+
+// GetIndexKeyPrefixMarketPriceToOrderType creates the prefix for the market price to order index
+// limited to the given market id, assets denom, price denom, and order type.
+func GetIndexKeyPrefixMarketPriceToOrderType(marketID uint32, assetsDenom, priceDenom string, orderTypeByte byte) []byte {
+	rv := indexPrefixMarketPriceToOrderBook(marketID, assetsDenom, priceDenom, 1)
+	rv = append(rv, orderTypeByte)
+	return rv
+}
+
+// MakeIndexKeyMarketPriceToOrder creates the key to use for the market price to order index for the provided order.
+func MakeIndexKeyMarketPriceToOrder(order exchange.Order) []byte {
+	assets, price := order.GetAssets(), order.GetPrice()
+	suffix := unitPriceBz(assets.Amount, price.Amount)
+	rv := indexPrefixMarketPriceToOrderBook(order.GetMarketID(), assets.Denom, price.Denom, 1+len(suffix)+8)
+	rv = append(rv, order.GetOrderTypeByte())
+	rv = append(rv, suffix...)
+	rv = append(rv, uint64Bz(order.GetOrderID())...)
+	return rv
+}
+
+// ParseIndexKeyMarketPriceToOrder extracts the parts of a market price to order index key.
+// The input must have the format: <type byte> | <market id> | len(<assets denom>) | <assets denom>
+// | len(<price denom>) | <price denom> | <order type byte> | <unit price> | <order id>.
+// The returned book prefix is everything up to (but not including) the order type byte.
+// The returned unit price includes its length byte.
+func ParseIndexKeyMarketPriceToOrder(key []byte) (bookPrefix []byte, orderTypeByte byte, unitPrice []byte, orderID uint64, err error) {
+	if len(key) < 6 || key[0] != KeyTypeMarketPriceToOrderIndex {
+		return nil, 0, nil, 0, fmt.Errorf("cannot parse market price to order key: unknown type byte or length %d", len(key))
+	}
+	i := 5
+	for _, denomName := range []string{"assets", "price"} {
+		if len(key) <= i || key[i] == 0 || len(key) <= i+int(key[i]) {
+			return nil, 0, nil, 0, fmt.Errorf("cannot parse market price to order key: invalid %s denom", denomName)
+		}
+		i += 1 + int(key[i])
+	}
+	bookPrefix, rest := key[:i], key[i:]
+	if len(rest) < 1+1+8 || len(rest) != 1+1+int(rest[1])+8 {
+		return nil, 0, nil, 0, fmt.Errorf("cannot parse market price to order key: invalid unit price and order id length %d", len(rest))
+	}
+	orderID, _ = uint64FromBz(rest[len(rest)-8:])
+	return bookPrefix, rest[0], rest[1 : len(rest)-8], orderID, nil
+}
+
 // keyPrefixCommitment creates the key prefix for commitments with the provided extra capacity for additional elements.
 func keyPrefixCommitment(extraCap int) []byte {
 	return prepKey(KeyTypeCommitment, nil, extraCap)
@@ -1011,3 +1195,15 @@ func ParseIndexKeySuffixTargetToPayment(suffix []byte) (sdk.AccAddress, string,
 	}
 	return source, string(left), nil
 }
+
+// MakeKeyHoldID creates the key for the hold entry id of the object with the provided key.
+// The provided key should be the result of MakeKeyOrder, MakeKeyCommitment, or MakeKeyPayment.
+func MakeKeyHoldID(objKey []byte) []byte {
+	if len(objKey) == 0 {
+		panic(errors.New("empty object key not allowed"))
+	}
+	rv := make([]byte, 1, 1+len(objKey))
+	rv[0] = KeyTypeHoldID
+	rv = append(rv, objKey...)
+	return rv
+}
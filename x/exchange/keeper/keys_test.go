@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -106,6 +107,10 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "KeyTypeCommitment", value: keeper.KeyTypeCommitment},
 				{name: "KeyTypePayment", value: keeper.KeyTypePayment},
 				{name: "KeyTypeTargetToPaymentIndex", value: keeper.KeyTypeTargetToPaymentIndex},
+				{name: "KeyTypeExpirationTimeToOrderIndex", value: keeper.KeyTypeExpirationTimeToOrderIndex},
+				{name: "KeyTypeExpirationHeightToOrderIndex", value: keeper.KeyTypeExpirationHeightToOrderIndex},
+				{name: "KeyTypeHoldID", value: keeper.KeyTypeHoldID},
+				{name: "KeyTypeMarketPriceToOrderIndex", value: keeper.KeyTypeMarketPriceToOrderIndex},
 			},
 		},
 		{
@@ -125,6 +130,8 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "MarketKeyTypeCreateCommitmentFlat", value: keeper.MarketKeyTypeCreateCommitmentFlat},
 				{name: "MarketKeyTypeCommitmentSettlementBips", value: keeper.MarketKeyTypeCommitmentSettlementBips},
 				{name: "MarketKeyTypeIntermediaryDenom", value: keeper.MarketKeyTypeIntermediaryDenom},
+				{name: "MarketKeyTypeAutoMatch", value: keeper.MarketKeyTypeAutoMatch},
+				{name: "MarketKeyTypeAutoMatchFailedOrder", value: keeper.MarketKeyTypeAutoMatchFailedOrder},
 			},
 		},
 		{
@@ -3231,6 +3238,123 @@ func TestMakeKeyMarketIntermediaryDenom(t *testing.T) {
 	}
 }
 
+func TestMakeKeyMarketAutoMatch(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeAutoMatch
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketAutoMatch(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketAutoMatch(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyMarketAutoMatchFailedOrder(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeAutoMatchFailedOrder
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "market id 0 order id 0",
+			marketID: 0,
+			orderID:  0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "market id 1 order id 1",
+			marketID: 1,
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "market id 16,843,009 order id 72,340,172,838,076,673",
+			marketID: 16_843_009,
+			orderID:  72_340_172_838_076_673,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			name:     "max market id and order id",
+			marketID: 4_294_967_295,
+			orderID:  18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketAutoMatchFailedOrder(tc.marketID, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketAutoMatchFailedOrder(%d, %d)", tc.marketID, tc.orderID)
+		})
+	}
+}
+
 func TestGetKeyPrefixOrder(t *testing.T) {
 	ktc := keyTestCase{
 		maker: func() []byte {
@@ -4277,6 +4401,386 @@ func TestMakeIndexKeyMarketExternalIDToOrder(t *testing.T) {
 	}
 }
 
+func TestGetIndexKeyPrefixExpirationTimeToOrder(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixExpirationTimeToOrder()
+		},
+		expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixExpirationTimeToOrder")
+}
+
+func TestGetIndexKeyPrefixExpirationTimeToOrderUpTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		upTo     time.Time
+		expected []byte
+	}{
+		{
+			name:     "epoch",
+			upTo:     time.Unix(0, 0),
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "one second after epoch",
+			upTo:     time.Unix(1, 0),
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "nanoseconds are ignored",
+			upTo:     time.Unix(257, 999_999_999),
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex, 0, 0, 0, 0, 0, 0, 1, 1},
+		},
+		{
+			name:     "2023-11-14T22:13:20Z",
+			upTo:     time.Unix(1_700_000_000, 0).UTC(),
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex, 0, 0, 0, 0, 0x65, 0x53, 0xf1, 0x00},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixExpirationTimeToOrderUpTo(tc.upTo)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationTimeToOrder", value: keeper.GetIndexKeyPrefixExpirationTimeToOrder()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixExpirationTimeToOrderUpTo(%s)", tc.upTo)
+		})
+	}
+}
+
+func TestMakeIndexKeyExpirationTimeToOrder(t *testing.T) {
+	tests := []struct {
+		name        string
+		goodTilTime time.Time
+		orderID     uint64
+		expected    []byte
+	}{
+		{
+			name:        "epoch, order 0",
+			goodTilTime: time.Unix(0, 0),
+			orderID:     0,
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex,
+				0, 0, 0, 0, 0, 0, 0, 0,
+				0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:        "2023-11-14T22:13:20Z, order 1",
+			goodTilTime: time.Unix(1_700_000_000, 0).UTC(),
+			orderID:     1,
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex,
+				0, 0, 0, 0, 0x65, 0x53, 0xf1, 0x00,
+				0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:        "nanoseconds are ignored, order 72,623,859,790,382,856",
+			goodTilTime: time.Unix(1_700_000_000, 500_000_000).UTC(),
+			orderID:     72_623_859_790_382_856,
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex,
+				0, 0, 0, 0, 0x65, 0x53, 0xf1, 0x00,
+				1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExpirationTimeToOrder(tc.goodTilTime, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationTimeToOrder", value: keeper.GetIndexKeyPrefixExpirationTimeToOrder()},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyExpirationTimeToOrder(%s, %d)", tc.goodTilTime, tc.orderID)
+
+			orderID, ok := keeper.ParseIndexKeySuffixOrderID(tc.expected)
+			if assert.True(t, ok, "ParseIndexKeySuffixOrderID ok") {
+				assert.Equal(t, tc.orderID, orderID, "ParseIndexKeySuffixOrderID order id")
+			}
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixExpirationHeightToOrder(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixExpirationHeightToOrder()
+		},
+		expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixExpirationHeightToOrder")
+}
+
+func TestGetIndexKeyPrefixExpirationHeightToOrderUpTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		upTo     uint64
+		expected []byte
+	}{
+		{
+			name:     "zero",
+			upTo:     0,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "257",
+			upTo:     257,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex, 0, 0, 0, 0, 0, 0, 1, 1},
+		},
+		{
+			name:     "max uint64",
+			upTo:     18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetIndexKeyPrefixExpirationHeightToOrderUpTo(tc.upTo)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationHeightToOrder", value: keeper.GetIndexKeyPrefixExpirationHeightToOrder()},
+				},
+			}
+			checkKey(t, ktc, "GetIndexKeyPrefixExpirationHeightToOrderUpTo(%d)", tc.upTo)
+		})
+	}
+}
+
+func TestMakeIndexKeyExpirationHeightToOrder(t *testing.T) {
+	tests := []struct {
+		name               string
+		goodTilBlockHeight uint64
+		orderID            uint64
+		expected           []byte
+	}{
+		{
+			name:               "height 0, order 0",
+			goodTilBlockHeight: 0,
+			orderID:            0,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex,
+				0, 0, 0, 0, 0, 0, 0, 0,
+				0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:               "height 257, order 1",
+			goodTilBlockHeight: 257,
+			orderID:            1,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex,
+				0, 0, 0, 0, 0, 0, 1, 1,
+				0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:               "height 72,623,859,790,382,856, order max uint64",
+			goodTilBlockHeight: 72_623_859_790_382_856,
+			orderID:            18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex,
+				1, 2, 3, 4, 5, 6, 7, 8,
+				255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExpirationHeightToOrder(tc.goodTilBlockHeight, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixExpirationHeightToOrder", value: keeper.GetIndexKeyPrefixExpirationHeightToOrder()},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyExpirationHeightToOrder(%d, %d)", tc.goodTilBlockHeight, tc.orderID)
+
+			orderID, ok := keeper.ParseIndexKeySuffixOrderID(tc.expected)
+			if assert.True(t, ok, "ParseIndexKeySuffixOrderID ok") {
+				assert.Equal(t, tc.orderID, orderID, "ParseIndexKeySuffixOrderID order id")
+			}
+		})
+	}
+}
+
+func TestMakeIndexKeyMarketPriceToOrder(t *testing.T) {
+	coin := func(amount int64, denom string) *sdk.Coin {
+		rv := sdk.NewInt64Coin(denom, amount)
+		return &rv
+	}
+	book := func(marketID uint32, assetsDenom, priceDenom string) []byte {
+		marketIDBz := []byte{byte(marketID >> 24), byte(marketID >> 16), byte(marketID >> 8), byte(marketID)}
+		return concatBz([]byte{keeper.KeyTypeMarketPriceToOrderIndex}, marketIDBz,
+			[]byte{byte(len(assetsDenom))}, []byte(assetsDenom), []byte{byte(len(priceDenom))}, []byte(priceDenom))
+	}
+
+	tests := []struct {
+		name     string
+		order    exchange.Order
+		expected []byte
+	}{
+		{
+			name: "ask order",
+			order: *exchange.NewOrder(7).WithAsk(&exchange.AskOrder{
+				MarketId: 3, Assets: *coin(2, "apple"), Price: *coin(5, "peach"),
+			}),
+			expected: concatBz(book(3, "apple", "peach"), []byte{keeper.OrderKeyTypeAsk},
+				[]byte{8, 34, 177, 200, 193, 34, 122, 0, 0}, []byte{0, 0, 0, 0, 0, 0, 0, 7}),
+		},
+		{
+			name: "bid order with truncated unit price",
+			order: *exchange.NewOrder(258).WithBid(&exchange.BidOrder{
+				MarketId: 4_294_967_295, Assets: *coin(3, "acorn"), Price: *coin(1, "nut"),
+			}),
+			expected: concatBz(book(4_294_967_295, "acorn", "nut"), []byte{keeper.OrderKeyTypeBid},
+				[]byte{8, 4, 160, 60, 230, 141, 33, 85, 85}, []byte{0, 0, 0, 0, 0, 0, 1, 2}),
+		},
+		{
+			name: "zero price",
+			order: *exchange.NewOrder(1).WithBid(&exchange.BidOrder{
+				MarketId: 1, Assets: *coin(3, "acorn"), Price: *coin(0, "nut"),
+			}),
+			expected: concatBz(book(1, "acorn", "nut"), []byte{keeper.OrderKeyTypeBid},
+				[]byte{0}, []byte{0, 0, 0, 0, 0, 0, 0, 1}),
+		},
+		{
+			name: "zero assets",
+			order: *exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+				MarketId: 1, Assets: *coin(0, "acorn"), Price: *coin(3, "nut"),
+			}),
+			expected: concatBz(book(1, "acorn", "nut"), []byte{keeper.OrderKeyTypeAsk},
+				[]byte{0}, []byte{0, 0, 0, 0, 0, 0, 0, 1}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyMarketPriceToOrder(tc.order)
+				},
+				expected: tc.expected,
+			}
+			assets, price := tc.order.GetAssets(), tc.order.GetPrice()
+			ktc.expPrefixes = []expectedPrefix{
+				{
+					name:  "GetIndexKeyPrefixMarketPriceToOrder",
+					value: keeper.GetIndexKeyPrefixMarketPriceToOrder(tc.order.GetMarketID()),
+				},
+				{
+					name:  "GetIndexKeyPrefixMarketPriceToOrderBook",
+					value: keeper.GetIndexKeyPrefixMarketPriceToOrderBook(tc.order.GetMarketID(), assets.Denom, price.Denom),
+				},
+				{
+					name: "GetIndexKeyPrefixMarketPriceToOrderType",
+					value: keeper.GetIndexKeyPrefixMarketPriceToOrderType(tc.order.GetMarketID(),
+						assets.Denom, price.Denom, tc.order.GetOrderTypeByte()),
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyMarketPriceToOrder(%d)", tc.order.OrderId)
+
+			bookPrefix, orderTypeByte, unitPrice, orderID, err := keeper.ParseIndexKeyMarketPriceToOrder(tc.expected)
+			if assert.NoError(t, err, "ParseIndexKeyMarketPriceToOrder") {
+				assert.Equal(t, keeper.GetIndexKeyPrefixMarketPriceToOrderBook(tc.order.GetMarketID(),
+					assets.Denom, price.Denom), bookPrefix, "book prefix")
+				assert.Equal(t, tc.order.GetOrderTypeByte(), orderTypeByte, "order type byte")
+				assert.Equal(t, tc.expected[len(bookPrefix)+1:len(tc.expected)-8], unitPrice, "unit price")
+				assert.Equal(t, tc.order.OrderId, orderID, "order id")
+			}
+		})
+	}
+}
+
+func TestMakeIndexKeyMarketPriceToOrder_SortsByUnitPrice(t *testing.T) {
+	// These are in order of increasing unit price.
+	prices := []struct {
+		assets int64
+		price  int64
+	}{
+		{assets: 1_000_000, price: 0},
+		{assets: 3, price: 1},
+		{assets: 2, price: 1},
+		{assets: 1, price: 1},
+		{assets: 2, price: 5},
+		{assets: 1, price: 255},
+		{assets: 1, price: 256},
+		{assets: 1, price: 1_000_000_000_000},
+	}
+
+	var prevKey []byte
+	for i, p := range prices {
+		order := exchange.NewOrder(uint64(len(prices) - i)).WithAsk(&exchange.AskOrder{
+			MarketId: 1,
+			Assets:   sdk.NewInt64Coin("apple", p.assets),
+			Price:    sdk.NewInt64Coin("peach", p.price),
+		})
+		key := keeper.MakeIndexKeyMarketPriceToOrder(*order)
+		if i > 0 {
+			assert.Equal(t, 1, bytes.Compare(key, prevKey), "key for %d/%d compared to previous key", p.price, p.assets)
+		}
+		prevKey = key
+	}
+}
+
+func TestParseIndexKeyMarketPriceToOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    []byte
+		expErr string
+	}{
+		{
+			name:   "nil",
+			key:    nil,
+			expErr: "cannot parse market price to order key: unknown type byte or length 0",
+		},
+		{
+			name:   "wrong type byte",
+			key:    []byte{keeper.KeyTypeMarketToOrderIndex, 0, 0, 0, 1, 1, 'a', 1, 'b', 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse market price to order key: unknown type byte or length 19",
+		},
+		{
+			name:   "empty assets denom",
+			key:    []byte{keeper.KeyTypeMarketPriceToOrderIndex, 0, 0, 0, 1, 0, 1, 'b', 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse market price to order key: invalid assets denom",
+		},
+		{
+			name:   "price denom too short",
+			key:    []byte{keeper.KeyTypeMarketPriceToOrderIndex, 0, 0, 0, 1, 1, 'a', 5, 'b'},
+			expErr: "cannot parse market price to order key: invalid price denom",
+		},
+		{
+			name:   "missing order id",
+			key:    []byte{keeper.KeyTypeMarketPriceToOrderIndex, 0, 0, 0, 1, 1, 'a', 1, 'b', 0, 1, 5, 0, 0, 0, 1},
+			expErr: "cannot parse market price to order key: invalid unit price and order id length 7",
+		},
+		{
+			name:   "unit price length too long",
+			key:    []byte{keeper.KeyTypeMarketPriceToOrderIndex, 0, 0, 0, 1, 1, 'a', 1, 'b', 0, 2, 5, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot parse market price to order key: invalid unit price and order id length 11",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				_, _, _, _, err = keeper.ParseIndexKeyMarketPriceToOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyMarketPriceToOrder")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyMarketPriceToOrder error")
+		})
+	}
+}
+
 func TestGetKeyPrefixCommitments(t *testing.T) {
 	ktc := keyTestCase{
 		maker: func() []byte {
@@ -5375,3 +5879,55 @@ func TestParseIndexKeySuffixTargetToPayment(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeKeyHoldID(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+
+	tests := []struct {
+		name     string
+		objKey   []byte
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil object key",
+			objKey:   nil,
+			expPanic: "empty object key not allowed",
+		},
+		{
+			name:     "empty object key",
+			objKey:   []byte{},
+			expPanic: "empty object key not allowed",
+		},
+		{
+			name:     "order",
+			objKey:   keeper.MakeKeyOrder(72_340_172_838_076_673),
+			expected: []byte{keeper.KeyTypeHoldID, keeper.KeyTypeOrder, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			name:   "commitment",
+			objKey: keeper.MakeKeyCommitment(16_843_009, addr),
+			expected: append([]byte{keeper.KeyTypeHoldID, keeper.KeyTypeCommitment, 1, 1, 1, 1, byte(len(addr))},
+				addr...),
+		},
+		{
+			name:   "payment",
+			objKey: keeper.MakeKeyPayment(addr, "pay"),
+			expected: append(append([]byte{keeper.KeyTypeHoldID, keeper.KeyTypePayment, byte(len(addr))}, addr...),
+				"pay"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyHoldID(tc.objKey)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			checkKey(t, ktc, "MakeKeyHoldID(%v)", tc.objKey)
+		})
+	}
+}
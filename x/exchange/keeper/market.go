@@ -863,6 +863,22 @@ func setUserSettlementAllowed(store storetypes.KVStore, marketID uint32, allowed
 	}
 }
 
+// isAutoMatchEnabled gets whether automatic order matching is enabled for a market.
+func isAutoMatchEnabled(store storetypes.KVStore, marketID uint32) bool {
+	key := MakeKeyMarketAutoMatch(marketID)
+	return store.Has(key)
+}
+
+// setAutoMatchEnabled sets whether automatic order matching is enabled for a market.
+func setAutoMatchEnabled(store storetypes.KVStore, marketID uint32, enabled bool) {
+	key := MakeKeyMarketAutoMatch(marketID)
+	if enabled {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
 // isMarketAcceptingCommitments gets whether commitments are allowed for a market.
 func isMarketAcceptingCommitments(store storetypes.KVStore, marketID uint32) bool {
 	key := MakeKeyMarketAcceptingCommitments(marketID)
@@ -924,6 +940,32 @@ func (k Keeper) UpdateUserSettlementAllowed(ctx sdk.Context, marketID uint32, al
 	return nil
 }
 
+// IsAutoMatchEnabled gets whether automatic order matching is enabled for a market.
+func (k Keeper) IsAutoMatchEnabled(ctx sdk.Context, marketID uint32) bool {
+	return isAutoMatchEnabled(k.getStore(ctx), marketID)
+}
+
+// UpdateAutoMatch updates the auto-match flag for a market.
+// An error is returned if the setting is already what is provided.
+//
+// When enabling auto-match, the market's orders are (re)indexed by unit price, since
+// orders created before that index existed won't have an entry in it.
+func (k Keeper) UpdateAutoMatch(ctx sdk.Context, marketID uint32, enabled bool, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := isAutoMatchEnabled(store, marketID)
+	if current == enabled {
+		return fmt.Errorf("market %d already has auto-match %t", marketID, enabled)
+	}
+	if enabled {
+		if err := k.indexMarketOrderPrices(ctx, store, marketID); err != nil {
+			return err
+		}
+	}
+	setAutoMatchEnabled(store, marketID, enabled)
+	k.emitEvent(ctx, exchange.NewEventMarketAutoMatchUpdated(marketID, updatedBy, enabled))
+	return nil
+}
+
 // IsMarketAcceptingCommitments gets whether commitments are allowed for a market.
 func (k Keeper) IsMarketAcceptingCommitments(ctx sdk.Context, marketID uint32) bool {
 	return isMarketAcceptingCommitments(k.getStore(ctx), marketID)
@@ -1414,6 +1456,7 @@ func storeMarket(store storetypes.KVStore, market exchange.Market) {
 	setMarketAcceptingCommitments(store, marketID, market.AcceptingCommitments)
 	setCommitmentSettlementBips(store, marketID, market.CommitmentSettlementBips)
 	setIntermediaryDenom(store, marketID, market.IntermediaryDenom)
+	setAutoMatchEnabled(store, marketID, market.AutoMatch)
 }
 
 // initMarket is similar to CreateMarket but assumes the market has already been
@@ -1506,6 +1549,7 @@ func (k Keeper) GetMarket(ctx sdk.Context, marketID uint32) *exchange.Market {
 	market.AcceptingCommitments = isMarketAcceptingCommitments(store, marketID)
 	market.CommitmentSettlementBips = getCommitmentSettlementBips(store, marketID)
 	market.IntermediaryDenom = getIntermediaryDenom(store, marketID)
+	market.AutoMatch = isAutoMatchEnabled(store, marketID)
 
 	if marketAcc := k.GetMarketAccount(ctx, marketID); marketAcc != nil {
 		market.MarketDetails = marketAcc.MarketDetails
@@ -1579,7 +1623,7 @@ func (k Keeper) ValidateMarket(ctx sdk.Context, marketID uint32) error {
 
 	if len(convDenom) > 0 {
 		feeDenom := pioconfig.GetProvenanceConfig().FeeDenom
-		feeNav := k.GetNav(ctx, convDenom, feeDenom)
+		feeNav := k.GetResolvedNav(ctx, marketID, convDenom, feeDenom)
 		if feeNav == nil {
 			errs = append(errs, fmt.Errorf("no nav exists from intermediary denom %q to fee denom %q", convDenom, feeDenom))
 		}
@@ -4268,6 +4268,216 @@ func (s *TestSuite) TestKeeper_UpdateUserSettlementAllowed() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_IsAutoMatchEnabled() {
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected bool
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: false,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetAutoMatchEnabled(store, 1, true)
+				keeper.SetAutoMatchEnabled(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "disabled",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetAutoMatchEnabled(store, 1, true)
+				keeper.SetAutoMatchEnabled(store, 2, false)
+				keeper.SetAutoMatchEnabled(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "enabled",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetAutoMatchEnabled(store, 1, true)
+				keeper.SetAutoMatchEnabled(store, 2, true)
+				keeper.SetAutoMatchEnabled(store, 3, true)
+			},
+			marketID: 2,
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual bool
+			testFunc := func() {
+				actual = s.k.IsAutoMatchEnabled(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "IsAutoMatchEnabled(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "IsAutoMatchEnabled(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateAutoMatch() {
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		enabled   bool
+		updatedBy string
+		expErr    string
+	}{
+		{
+			name:      "empty state to enabled",
+			marketID:  1,
+			enabled:   true,
+			updatedBy: "updatedBy___________",
+			expErr:    "",
+		},
+		{
+			name:      "empty state to disabled",
+			marketID:  1,
+			enabled:   false,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 already has auto-match false",
+		},
+		{
+			name: "enabled to enabled",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetAutoMatchEnabled(store, 1, true)
+				keeper.SetAutoMatchEnabled(store, 2, false)
+				keeper.SetAutoMatchEnabled(store, 3, true)
+				keeper.SetAutoMatchEnabled(store, 4, true)
+				keeper.SetAutoMatchEnabled(store, 5, false)
+			},
+			marketID:  3,
+			enabled:   true,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has auto-match true",
+		},
+		{
+			name: "enabled to disabled",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetAutoMatchEnabled(store, 1, true)
+				keeper.SetAutoMatchEnabled(store, 2, false)
+				keeper.SetAutoMatchEnabled(store, 3, true)
+				keeper.SetAutoMatchEnabled(store, 4, true)
+				keeper.SetAutoMatchEnabled(store, 5, false)
+			},
+			marketID:  3,
+			enabled:   false,
+			updatedBy: "updated_by__________",
+			expErr:    "",
+		},
+		{
+			name: "disabled to enabled",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetAutoMatchEnabled(store, 11, true)
+				keeper.SetAutoMatchEnabled(store, 12, false)
+				keeper.SetAutoMatchEnabled(store, 13, false)
+				keeper.SetAutoMatchEnabled(store, 14, true)
+				keeper.SetAutoMatchEnabled(store, 15, false)
+			},
+			marketID:  13,
+			enabled:   true,
+			updatedBy: "updated___by________",
+			expErr:    "",
+		},
+		{
+			name: "disabled to disabled",
+			setup: func() {
+				store := s.getStore()
+				keeper.SetAutoMatchEnabled(store, 11, true)
+				keeper.SetAutoMatchEnabled(store, 12, false)
+				keeper.SetAutoMatchEnabled(store, 13, false)
+				keeper.SetAutoMatchEnabled(store, 14, true)
+				keeper.SetAutoMatchEnabled(store, 15, false)
+			},
+			marketID:  13,
+			enabled:   false,
+			updatedBy: "__updated_____by____",
+			expErr:    "market 13 already has auto-match false",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketAutoMatchUpdated(tc.marketID, tc.updatedBy, tc.enabled)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateAutoMatch(ctx, tc.marketID, tc.enabled, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateAutoMatch(%d, %t, %s)", tc.marketID, tc.enabled, tc.updatedBy)
+			s.assertErrorValue(err, tc.expErr, "UpdateAutoMatch(%d, %t, %s)", tc.marketID, tc.enabled, tc.updatedBy)
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateAutoMatch")
+
+			if len(tc.expErr) == 0 {
+				isEnabled := s.k.IsAutoMatchEnabled(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.enabled, isEnabled, "IsAutoMatchEnabled(%d) after UpdateAutoMatch(%d, %t, ...)",
+					tc.marketID, tc.marketID, tc.enabled)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateAutoMatch_IndexesOrderPrices() {
+	s.clearExchangeState()
+	store := s.getStore()
+	orders := s.requireSetOrdersInStore(store,
+		exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+			MarketId: 1, Seller: s.addr1.String(), Assets: s.coin("2apple"), Price: s.coin("5peach"),
+		}),
+		exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+			MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin("3apple"), Price: s.coin("7peach"),
+		}),
+		exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+			MarketId: 2, Buyer: s.addr2.String(), Assets: s.coin("3apple"), Price: s.coin("7peach"),
+		}),
+	)
+	// Orders created before the index existed won't have an entry in it.
+	for _, order := range orders {
+		store.Delete(keeper.MakeIndexKeyMarketPriceToOrder(*order))
+	}
+
+	err := s.k.UpdateAutoMatch(s.ctx, 1, true, "updatedBy___________")
+	s.Require().NoError(err, "UpdateAutoMatch(1, true)")
+	for _, order := range orders {
+		exp := order.GetMarketID() == 1
+		has := store.Has(keeper.MakeIndexKeyMarketPriceToOrder(*order))
+		s.Assert().Equal(exp, has, "has market price to order index entry for order %d", order.OrderId)
+	}
+}
+
 func (s *TestSuite) TestKeeper_IsMarketAcceptingCommitments() {
 	setter := keeper.SetMarketAcceptingCommitments
 	tests := []struct {
@@ -7289,6 +7499,7 @@ func (s *TestSuite) TestKeeper_CreateMarket() {
 				},
 				AcceptingOrders:     true,
 				AllowUserSettlement: true,
+				AutoMatch:           true,
 				AccessGrants: []exchange.AccessGrant{
 					{
 						Address:     sdk.AccAddress("just_some_address___").String(),
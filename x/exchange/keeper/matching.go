@@ -0,0 +1,399 @@
+package keeper
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+const (
+	// AutoMatchMaxOrdersPerMarket is the maximum number of orders that auto-matching will read for a market in a single block.
+	AutoMatchMaxOrdersPerMarket = 2_000
+	// AutoMatchMaxSettlementsPerMarket is the maximum number of settlements that auto-matching will attempt for a market in a single block.
+	AutoMatchMaxSettlementsPerMarket = 100
+)
+
+// matchBudget keeps track of how much more auto-matching work can be done for a market in the current block.
+type matchBudget struct {
+	// orders is the number of orders that can still be loaded.
+	orders int
+	// settlements is the number of settlements that can still be attempted.
+	settlements int
+}
+
+// newMatchBudget creates a new matchBudget with the per-market maximums.
+func newMatchBudget() *matchBudget {
+	return &matchBudget{
+		orders:      AutoMatchMaxOrdersPerMarket,
+		settlements: AutoMatchMaxSettlementsPerMarket,
+	}
+}
+
+// isFailedOrderUnchanged returns true if auto-matching previously failed to fill the order and
+// neither the order nor the set of orders has changed since then (so it would just fail again).
+func isFailedOrderUnchanged(store storetypes.KVStore, marketID uint32, orderID, lastOrderID uint64) bool {
+	failedAt, ok := uint64FromBz(store.Get(MakeKeyMarketAutoMatchFailedOrder(marketID, orderID)))
+	return ok && failedAt == lastOrderID
+}
+
+// setFailedOrder records that auto-matching could not fill an order given the current set of orders.
+// The entry is deleted whenever the order is updated or deleted.
+func setFailedOrder(store storetypes.KVStore, marketID uint32, orderID, lastOrderID uint64) {
+	store.Set(MakeKeyMarketAutoMatchFailedOrder(marketID, orderID), uint64Bz(lastOrderID))
+}
+
+// orderBook is a set of orders in a market that all have the same assets and price denoms.
+type orderBook struct {
+	// asks are the ask orders in priority order: lowest unit price first, then lowest order id.
+	asks []*exchange.Order
+	// bids are the bid orders in priority order: highest unit price first, then lowest order id.
+	bids []*exchange.Order
+}
+
+// cmpUnitPrice compares the unit prices (price / assets) of two orders.
+// Returns -1 if o1's unit price is less than o2's, 0 if they're equal, or 1 if o1's is more than o2's.
+func cmpUnitPrice(o1, o2 *exchange.Order) int {
+	// Cross-multiplying to avoid any division. Using big.Int so that we don't have to worry about overflow.
+	lhs := new(big.Int).Mul(o1.GetPrice().Amount.BigInt(), o2.GetAssets().Amount.BigInt())
+	rhs := new(big.Int).Mul(o2.GetPrice().Amount.BigInt(), o1.GetAssets().Amount.BigInt())
+	return lhs.Cmp(rhs)
+}
+
+// cmpAskPriority is a sorting comparison function for ask orders: lowest unit price first, then lowest order id.
+func cmpAskPriority(a, b *exchange.Order) int {
+	if rv := cmpUnitPrice(a, b); rv != 0 {
+		return rv
+	}
+	return cmp.Compare(a.OrderId, b.OrderId)
+}
+
+// cmpBidPriority is a sorting comparison function for bid orders: highest unit price first, then lowest order id.
+func cmpBidPriority(a, b *exchange.Order) int {
+	if rv := cmpUnitPrice(b, a); rv != 0 {
+		return rv
+	}
+	return cmp.Compare(a.OrderId, b.OrderId)
+}
+
+// ordersCross returns true if the provided orders can be settled with each other, i.e. the
+// ask order's unit price is less than or equal to the bid order's unit price.
+// One of the orders must be an ask order, and the other, a bid order.
+func ordersCross(o1, o2 *exchange.Order) bool {
+	if o1.IsAskOrder() {
+		return cmpUnitPrice(o1, o2) <= 0
+	}
+	return cmpUnitPrice(o2, o1) <= 0
+}
+
+// selectCounterOrders identifies the orders (from the provided counter orders) to use to fill the primary order.
+// The counter orders must be in priority order and are selected in that order until there are enough assets to fill
+// the primary order, or until one is found that does not cross the primary order. Orders that would need to be
+// partially filled, but do not allow it, are skipped. The result might not have enough assets to fill the primary.
+func selectCounterOrders(primary *exchange.Order, counterOrders []*exchange.Order) []*exchange.Order {
+	var rv []*exchange.Order
+	need := primary.GetAssets().Amount
+	for _, order := range counterOrders {
+		if !ordersCross(primary, order) {
+			break
+		}
+		amount := order.GetAssets().Amount
+		if amount.GT(need) && !order.PartialFillAllowed() {
+			continue
+		}
+		rv = append(rv, order)
+		need = need.Sub(amount)
+		if !need.IsPositive() {
+			break
+		}
+	}
+	return rv
+}
+
+// sumAssetsAmount gets the sum of the assets amounts of the provided orders.
+func sumAssetsAmount(orders []*exchange.Order) sdkmath.Int {
+	rv := sdkmath.ZeroInt()
+	for _, order := range orders {
+		rv = rv.Add(order.GetAssets().Amount)
+	}
+	return rv
+}
+
+// updateOrderList returns a new list of orders without the filled orders and with the partial order updated.
+func updateOrderList(orders []*exchange.Order, filled map[uint64]bool, partial *exchange.Order) []*exchange.Order {
+	rv := make([]*exchange.Order, 0, len(orders))
+	for _, order := range orders {
+		switch {
+		case filled[order.OrderId]:
+			continue
+		case partial != nil && partial.OrderId == order.OrderId:
+			rv = append(rv, partial)
+		default:
+			rv = append(rv, order)
+		}
+	}
+	return rv
+}
+
+// removeOrder returns a new list of orders without the one with the provided order id.
+func removeOrder(orders []*exchange.Order, orderID uint64) []*exchange.Order {
+	return updateOrderList(orders, map[uint64]bool{orderID: true}, nil)
+}
+
+// indexMarketOrderPrices writes the market price to order index entries for all the orders in a market.
+func (k Keeper) indexMarketOrderPrices(ctx sdk.Context, store storetypes.KVStore, marketID uint32) error {
+	var orderIDs []uint64
+	k.IterateMarketOrders(ctx, marketID, func(orderID uint64, _ byte) bool {
+		orderIDs = append(orderIDs, orderID)
+		return false
+	})
+
+	for _, orderID := range orderIDs {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil {
+			return err
+		}
+		if order != nil {
+			store.Set(MakeIndexKeyMarketPriceToOrder(*order), []byte{order.GetOrderTypeByte()})
+		}
+	}
+	return nil
+}
+
+// nextOrderBookPrefix gets the book prefix of the first market price to order index entry at or after start (and before end).
+// The returned bool is false if there are no such entries.
+func nextOrderBookPrefix(store storetypes.KVStore, start, end []byte) ([]byte, bool, error) {
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, false, nil
+	}
+	bookPrefix, _, _, _, err := ParseIndexKeyMarketPriceToOrder(iter.Key())
+	if err != nil {
+		return nil, false, err
+	}
+	return bookPrefix, true, nil
+}
+
+// getOrderBooks gets the crossing orders in a market, split up by assets and price denoms.
+// The orders in each book are sorted by priority.
+//
+// Each book is read from the market price to order index, best price first, and only as far as the prices cross:
+// asks are loaded up to the best bid's unit price, and bids down to the best ask's unit price. So orders that
+// can't be matched are never loaded. Orders that previously failed to fill, and haven't changed since, are
+// skipped. No more index entries are read once the budget runs out, so the books might not have every order.
+func (k Keeper) getOrderBooks(ctx sdk.Context, marketID uint32, budget *matchBudget) ([]*orderBook, error) {
+	store := k.getStore(ctx)
+	lastOrderID := getLastOrderID(store)
+	start := GetIndexKeyPrefixMarketPriceToOrder(marketID)
+	end := storetypes.PrefixEndBytes(start)
+
+	var errs []error
+	var books []*orderBook
+	for budget.orders > 0 {
+		bookPrefix, found, err := nextOrderBookPrefix(store, start, end)
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if !found {
+			break
+		}
+
+		book, bookErrs := k.loadOrderBook(store, marketID, bookPrefix, lastOrderID, budget)
+		errs = append(errs, bookErrs...)
+		if book != nil {
+			books = append(books, book)
+		}
+		start = storetypes.PrefixEndBytes(bookPrefix)
+	}
+
+	return books, errors.Join(errs...)
+}
+
+// loadOrderBook loads the crossing orders from the market price to order index entries with the provided book prefix.
+// Returns nil if none of the orders cross.
+func (k Keeper) loadOrderBook(store storetypes.KVStore, marketID uint32, bookPrefix []byte, lastOrderID uint64, budget *matchBudget) (*orderBook, []error) {
+	var errs []error
+	// next returns the next order from the iterator (and its unit price bytes) that might still be matched.
+	next := func(iter storetypes.Iterator) (*exchange.Order, []byte) {
+		for ; iter.Valid() && budget.orders > 0; iter.Next() {
+			budget.orders--
+			_, _, unitPrice, orderID, err := ParseIndexKeyMarketPriceToOrder(iter.Key())
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if isFailedOrderUnchanged(store, marketID, orderID, lastOrderID) {
+				continue
+			}
+			order, err := k.getOrderFromStore(store, orderID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if order == nil {
+				continue
+			}
+			iter.Next()
+			return order, unitPrice
+		}
+		return nil, nil
+	}
+
+	askIter := storetypes.KVStorePrefixIterator(store, append(slices.Clip(bookPrefix), OrderKeyTypeAsk))
+	defer askIter.Close()
+	bidIter := storetypes.KVStoreReversePrefixIterator(store, append(slices.Clip(bookPrefix), OrderKeyTypeBid))
+	defer bidIter.Close()
+
+	ask, askPrice := next(askIter)
+	bid, bidPrice := next(bidIter)
+	// The index's unit prices are truncated, so if they say the best ask is more than the best bid, it really is.
+	// But if they say they're equal, they might not actually cross. The matching uses the exact unit prices though.
+	if ask == nil || bid == nil || bytes.Compare(askPrice, bidPrice) > 0 {
+		return nil, errs
+	}
+
+	book := &orderBook{}
+	bestAskPrice, bestBidPrice := askPrice, bidPrice
+	for ask != nil && bytes.Compare(askPrice, bestBidPrice) <= 0 {
+		book.asks = append(book.asks, ask)
+		ask, askPrice = next(askIter)
+	}
+	for bid != nil && bytes.Compare(bidPrice, bestAskPrice) >= 0 {
+		book.bids = append(book.bids, bid)
+		bid, bidPrice = next(bidIter)
+	}
+
+	slices.SortFunc(book.asks, cmpAskPriority)
+	slices.SortFunc(book.bids, cmpBidPriority)
+	return book, errs
+}
+
+// settleMatch builds and closes a settlement for the provided orders. A cache context is used so
+// that if there's a problem, nothing is left half-done. The settlement is returned if it succeeds.
+func (k Keeper) settleMatch(ctx sdk.Context, marketID uint32, askOrders, bidOrders []*exchange.Order) (*exchange.Settlement, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	store := k.getStore(cacheCtx)
+
+	ratioGetter := func(denom string) (*exchange.FeeRatio, error) {
+		return getSellerSettlementRatio(store, marketID, denom)
+	}
+
+	settlement, err := exchange.BuildSettlement(askOrders, bidOrders, ratioGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.closeSettlement(cacheCtx, store, marketID, settlement); err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	return settlement, nil
+}
+
+// matchOrderBook settles all the crossing orders in the provided book and returns any errors encountered.
+//
+// At each step, the larger of the best ask and best bid is the primary order. It is filled using as many
+// orders from the other side as are needed (in priority order) as long as they cross it. If there aren't
+// enough, the primary order is partially filled (if allowed). If the primary order cannot be filled, it is
+// skipped until either it or the set of orders changes.
+//
+// Matching stops once the budget's settlements have been used up.
+func (k Keeper) matchOrderBook(ctx sdk.Context, marketID uint32, book *orderBook, budget *matchBudget) []error {
+	var errs []error
+	store := k.getStore(ctx)
+	asks, bids := book.asks, book.bids
+	for len(asks) > 0 && len(bids) > 0 && budget.settlements > 0 && ordersCross(asks[0], bids[0]) {
+		primary, counterOrders := asks[0], bids
+		if primary.GetAssets().Amount.LT(bids[0].GetAssets().Amount) {
+			primary, counterOrders = bids[0], asks
+		}
+
+		// The first counter order is never bigger than the primary, so this will always have at least one entry.
+		toFill := selectCounterOrders(primary, counterOrders)
+
+		// If the primary order doesn't allow partial fills, only try if there's enough to fill it in full.
+		var settlement *exchange.Settlement
+		if primary.PartialFillAllowed() || !sumAssetsAmount(toFill).LT(primary.GetAssets().Amount) {
+			askOrders, bidOrders := []*exchange.Order{primary}, toFill
+			if primary.IsBidOrder() {
+				askOrders, bidOrders = toFill, []*exchange.Order{primary}
+			}
+			var err error
+			budget.settlements--
+			settlement, err = k.settleMatch(ctx, marketID, askOrders, bidOrders)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("could not fill %s order %d: %w", primary.GetOrderType(), primary.OrderId, err))
+			}
+		}
+
+		// If the primary order wasn't filled, skip it until it or the set of orders changes.
+		if settlement == nil {
+			setFailedOrder(store, marketID, primary.OrderId, getLastOrderID(store))
+			if primary.IsAskOrder() {
+				asks = removeOrder(asks, primary.OrderId)
+			} else {
+				bids = removeOrder(bids, primary.OrderId)
+			}
+			continue
+		}
+
+		filled := make(map[uint64]bool, len(settlement.FullyFilledOrders))
+		for _, order := range settlement.FullyFilledOrders {
+			filled[order.GetOrderID()] = true
+		}
+		asks = updateOrderList(asks, filled, settlement.PartialOrderLeft)
+		bids = updateOrderList(bids, filled, settlement.PartialOrderLeft)
+	}
+	return errs
+}
+
+// matchMarketOrders settles the crossing orders in a market (as allowed by the per-market budget).
+func (k Keeper) matchMarketOrders(ctx sdk.Context, marketID uint32) {
+	budget := newMatchBudget()
+	books, err := k.getOrderBooks(ctx, marketID, budget)
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, book := range books {
+		errs = append(errs, k.matchOrderBook(ctx, marketID, book, budget)...)
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered matching orders in market %d:\n%v", len(errs), marketID, errors.Join(errs...))
+	}
+}
+
+// MatchOrders settles all crossing orders in each market that has auto-match enabled.
+// Orders are matched using price-time priority: asks with the lowest unit price and bids with the highest
+// unit price go first, with ties going to the order that was created first (i.e. has the lower order id).
+// Each match is settled the same way as a MarketSettle would be, but without any transfer agents.
+//
+// The work done for each market in a block is limited by AutoMatchMaxOrdersPerMarket and AutoMatchMaxSettlementsPerMarket,
+// so one busy market can't starve the others.
+func (k Keeper) MatchOrders(ctx sdk.Context) {
+	store := k.getStore(ctx)
+	var marketIDs []uint32
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		if isAutoMatchEnabled(store, marketID) {
+			marketIDs = append(marketIDs, marketID)
+		}
+		return false
+	})
+
+	for _, marketID := range marketIDs {
+		k.matchMarketOrders(ctx, marketID)
+	}
+}
@@ -0,0 +1,359 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestKeeper_MatchOrders() {
+	appleMarker := s.markerAccount("1000000000apple")
+	askOrder := func(orderID uint64, marketID uint32, assets, price string, allowPartial bool) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId:     marketID,
+			Seller:       s.addr1.String(),
+			Assets:       s.coin(assets),
+			Price:        s.coin(price),
+			AllowPartial: allowPartial,
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, assets, price string, allowPartial bool) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId:     marketID,
+			Buyer:        s.addr2.String(),
+			Assets:       s.coin(assets),
+			Price:        s.coin(price),
+			AllowPartial: allowPartial,
+		})
+	}
+
+	tests := []struct {
+		name       string
+		markets    []exchange.Market
+		orders     []*exchange.Order
+		holdKeeper *MockHoldKeeper
+		expEvents  []proto.Message
+		expOrders  []*exchange.Order
+		expLog     []string
+	}{
+		{
+			name: "no markets",
+		},
+		{
+			name:    "auto-match not enabled",
+			markets: []exchange.Market{{MarketId: 1}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "10apple", "50peach", false),
+			},
+			expOrders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "10apple", "50peach", false),
+			},
+		},
+		{
+			name:    "orders do not cross",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "51peach", false),
+				bidOrder(2, 1, "10apple", "50peach", false),
+			},
+			expOrders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "51peach", false),
+				bidOrder(2, 1, "10apple", "50peach", false),
+			},
+		},
+		{
+			name:    "different denoms",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "10apple", "50plum", false),
+				bidOrder(3, 1, "10acorn", "50peach", false),
+			},
+			expOrders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "10apple", "50plum", false),
+				bidOrder(3, 1, "10acorn", "50peach", false),
+			},
+		},
+		{
+			name:    "one ask one bid: same size, bid price higher",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "10apple", "60peach", false),
+			},
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "60peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "10apple", Price: "60peach", MarketId: 1},
+			},
+		},
+		{
+			name: "only markets with auto-match are matched",
+			markets: []exchange.Market{
+				{MarketId: 1, AutoMatch: false},
+				{MarketId: 2, AutoMatch: true},
+			},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "10apple", "50peach", false),
+				askOrder(3, 2, "10apple", "50peach", false),
+				bidOrder(4, 2, "10apple", "50peach", false),
+			},
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 3, Assets: "10apple", Price: "50peach", MarketId: 2},
+				&exchange.EventOrderFilled{OrderId: 4, Assets: "10apple", Price: "50peach", MarketId: 2},
+			},
+			expOrders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "10apple", "50peach", false),
+			},
+		},
+		{
+			name:    "best price first then lowest order id",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "60peach", false),
+				askOrder(2, 1, "10apple", "50peach", false),
+				askOrder(3, 1, "10apple", "50peach", false),
+				bidOrder(4, 1, "10apple", "55peach", false),
+			},
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "10apple", Price: "55peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 4, Assets: "10apple", Price: "55peach", MarketId: 1},
+			},
+			expOrders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "60peach", false),
+				askOrder(3, 1, "10apple", "50peach", false),
+			},
+		},
+		{
+			name:    "partial ask",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", true),
+				bidOrder(2, 1, "4apple", "20peach", false),
+			},
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "4apple", Price: "20peach", MarketId: 1},
+				&exchange.EventOrderPartiallyFilled{OrderId: 1, Assets: "4apple", Price: "20peach", MarketId: 1},
+			},
+			expOrders: []*exchange.Order{
+				askOrder(1, 1, "6apple", "30peach", true),
+			},
+		},
+		{
+			name:    "partial not allowed on larger order",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "4apple", "20peach", true),
+			},
+			expOrders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "4apple", "20peach", true),
+			},
+		},
+		{
+			name:    "one ask filled by multiple bids",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "10apple", "50peach", false),
+				bidOrder(2, 1, "4apple", "24peach", false),
+				bidOrder(3, 1, "2apple", "10peach", true),
+				bidOrder(4, 1, "5apple", "40peach", false),
+				bidOrder(5, 1, "3apple", "14peach", true),
+			},
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "69peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 4, Assets: "5apple", Price: "40peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "4apple", Price: "24peach", MarketId: 1},
+				&exchange.EventOrderPartiallyFilled{OrderId: 3, Assets: "1apple", Price: "5peach", MarketId: 1},
+			},
+			expOrders: []*exchange.Order{
+				bidOrder(3, 1, "1apple", "5peach", true),
+				bidOrder(5, 1, "3apple", "14peach", true),
+			},
+		},
+		{
+			name:    "error settling first match",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, "1apple", "5peach", false),
+				bidOrder(2, 1, "1apple", "5peach", false),
+				askOrder(3, 1, "1apple", "6peach", false),
+				bidOrder(4, 1, "1apple", "6peach", false),
+			},
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("injected error"),
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 3, Assets: "1apple", Price: "6peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 4, Assets: "1apple", Price: "6peach", MarketId: 1},
+			},
+			expOrders: []*exchange.Order{
+				askOrder(1, 1, "1apple", "5peach", false),
+				bidOrder(2, 1, "1apple", "5peach", false),
+			},
+			expLog: []string{
+				"ERR 1 error(s) encountered matching orders in market 1:",
+				"could not fill ask order 1: error releasing hold for ask order 1: injected error module=x/exchange",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			for _, market := range tc.markets {
+				s.requireCreateMarket(market)
+			}
+			s.requireSetOrdersInStore(s.getStore(), tc.orders...)
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			expEvents := untypeEvents(s, tc.expEvents)
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			kpr := s.k.WithAccountKeeper(s.accKeeper).
+				WithBankKeeper(NewMockBankKeeper()).
+				WithHoldKeeper(tc.holdKeeper).
+				WithMarkerKeeper(NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker)).
+				WithMetadataKeeper(NewMockMetadataKeeper())
+			s.logBuffer.Reset()
+			testFunc := func() {
+				kpr.MatchOrders(ctx)
+			}
+			s.Require().NotPanics(testFunc, "MatchOrders")
+
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "MatchOrders events")
+
+			outputLog := s.getLogOutput("MatchOrders")
+			actLog := s.splitOutputLog(outputLog)
+			s.Assert().Equal(tc.expLog, actLog, "Lines logged during MatchOrders")
+
+			var actOrders []*exchange.Order
+			err := s.k.IterateOrders(s.ctx, func(order *exchange.Order) bool {
+				actOrders = append(actOrders, order)
+				return false
+			})
+			s.Require().NoError(err, "IterateOrders after MatchOrders")
+			s.assertEqualOrders(tc.expOrders, actOrders, "orders in state after MatchOrders")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_MatchOrders_FailedOrders() {
+	appleMarker := s.markerAccount("1000000000apple")
+	askOrder := exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+		MarketId: 1,
+		Seller:   s.addr1.String(),
+		Assets:   s.coin("1apple"),
+		Price:    s.coin("5peach"),
+	})
+	bidOrder := exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+		MarketId: 1,
+		Buyer:    s.addr2.String(),
+		Assets:   s.coin("1apple"),
+		Price:    s.coin("5peach"),
+	})
+
+	s.clearExchangeState()
+	s.requireCreateMarket(exchange.Market{MarketId: 1, AutoMatch: true})
+	store := s.getStore()
+	s.requireSetOrdersInStore(store, askOrder, bidOrder)
+	store.Set(keeper.MakeKeyLastOrderID(), []byte{0, 0, 0, 0, 0, 0, 0, 2})
+
+	holdKeeper := NewMockHoldKeeper().WithReleaseHoldResults("injected error")
+	kpr := s.k.WithAccountKeeper(s.accKeeper).
+		WithBankKeeper(NewMockBankKeeper()).
+		WithHoldKeeper(holdKeeper).
+		WithMarkerKeeper(NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker)).
+		WithMetadataKeeper(NewMockMetadataKeeper())
+	getOrderCount := func() int {
+		count := 0
+		err := s.k.IterateOrders(s.ctx, func(_ *exchange.Order) bool {
+			count++
+			return false
+		})
+		s.Require().NoError(err, "IterateOrders")
+		return count
+	}
+
+	s.Require().NotPanics(func() { kpr.MatchOrders(s.ctx) }, "first MatchOrders")
+	s.Assert().Len(holdKeeper.Calls.ReleaseHold, 1, "ReleaseHold calls after first MatchOrders")
+	s.Assert().True(store.Has(keeper.MakeKeyMarketAutoMatchFailedOrder(1, 1)), "failed order entry after first MatchOrders")
+	s.Assert().Equal(2, getOrderCount(), "order count after first MatchOrders")
+
+	// Nothing has changed, so the failed order should be skipped.
+	s.Require().NotPanics(func() { kpr.MatchOrders(s.ctx) }, "second MatchOrders")
+	s.Assert().Len(holdKeeper.Calls.ReleaseHold, 1, "ReleaseHold calls after second MatchOrders")
+	s.Assert().Equal(2, getOrderCount(), "order count after second MatchOrders")
+
+	// A new order has been created, so the failed order should be tried again.
+	store.Set(keeper.MakeKeyLastOrderID(), []byte{0, 0, 0, 0, 0, 0, 0, 3})
+	s.Require().NotPanics(func() { kpr.MatchOrders(s.ctx) }, "third MatchOrders")
+	s.Assert().Len(holdKeeper.Calls.ReleaseHold, 3, "ReleaseHold calls after third MatchOrders")
+	s.Assert().Equal(0, getOrderCount(), "order count after third MatchOrders")
+	s.Assert().False(store.Has(keeper.MakeKeyMarketAutoMatchFailedOrder(1, 1)), "failed order entry after third MatchOrders")
+}
+
+func (s *TestSuite) TestKeeper_MatchOrders_SettlementLimit() {
+	appleMarker := s.markerAccount("1000000000apple")
+	s.clearExchangeState()
+	s.requireCreateMarket(exchange.Market{MarketId: 1, AutoMatch: true})
+	s.requireCreateMarket(exchange.Market{MarketId: 2, AutoMatch: true})
+	store := s.getStore()
+	pairs := keeper.AutoMatchMaxSettlementsPerMarket + 1
+	// Market 2 has its own budget, so its orders are matched even though market 1 uses up all of its own.
+	s.requireSetOrdersInStore(store,
+		exchange.NewOrder(uint64(2*pairs+1)).WithAsk(&exchange.AskOrder{
+			MarketId: 2,
+			Seller:   s.addr1.String(),
+			Assets:   s.coin("1apple"),
+			Price:    s.coin("5peach"),
+		}),
+		exchange.NewOrder(uint64(2*pairs+2)).WithBid(&exchange.BidOrder{
+			MarketId: 2,
+			Buyer:    s.addr2.String(),
+			Assets:   s.coin("1apple"),
+			Price:    s.coin("5peach"),
+		}),
+	)
+	for i := 0; i < pairs; i++ {
+		s.requireSetOrdersInStore(store,
+			exchange.NewOrder(uint64(2*i+1)).WithAsk(&exchange.AskOrder{
+				MarketId: 1,
+				Seller:   s.addr1.String(),
+				Assets:   s.coin("1apple"),
+				Price:    s.coin("5peach"),
+			}),
+			exchange.NewOrder(uint64(2*i+2)).WithBid(&exchange.BidOrder{
+				MarketId: 1,
+				Buyer:    s.addr2.String(),
+				Assets:   s.coin("1apple"),
+				Price:    s.coin("5peach"),
+			}),
+		)
+	}
+
+	kpr := s.k.WithAccountKeeper(s.accKeeper).
+		WithBankKeeper(NewMockBankKeeper()).
+		WithHoldKeeper(NewMockHoldKeeper()).
+		WithMarkerKeeper(NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker)).
+		WithMetadataKeeper(NewMockMetadataKeeper())
+	s.Require().NotPanics(func() { kpr.MatchOrders(s.ctx) }, "MatchOrders")
+
+	var orderIDs []uint64
+	err := s.k.IterateOrders(s.ctx, func(order *exchange.Order) bool {
+		orderIDs = append(orderIDs, order.OrderId)
+		return false
+	})
+	s.Require().NoError(err, "IterateOrders after MatchOrders")
+	s.Assert().Equal([]uint64{uint64(2*pairs - 1), uint64(2 * pairs)}, orderIDs, "orders left after MatchOrders")
+}
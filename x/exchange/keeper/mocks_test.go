@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/provenance-io/provenance/internal/provutils"
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/hold"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	"github.com/provenance-io/provenance/x/quarantine"
@@ -509,11 +511,17 @@ func (s *TestSuite) outputsString(vals []banktypes.Output) string {
 var _ exchange.HoldKeeper = (*MockHoldKeeper)(nil)
 
 // MockHoldKeeper satisfies the exchange.HoldKeeper interface but just records the calls and allows dictation of results.
+//
+// Calls to AddHoldEntry are recorded (and get their results) as if they were calls to AddHold, and calls to
+// ReleaseHoldByID are recorded (and get their results) as if they were calls to ReleaseHold with the entry's funds.
+// That way, tests can check what's been put on hold (or released) regardless of whether a hold entry was used.
 type MockHoldKeeper struct {
 	Calls                   HoldCalls
 	AddHoldResultsQueue     []string
 	ReleaseHoldResultsQueue []string
 	GetHoldCoinResultsMap   map[string]map[string]*GetHoldCoinResults
+	HoldEntries             []*hold.HoldEntry
+	LastHoldID              uint64
 }
 
 // HoldCalls contains all the calls that the mock hold keeper makes.
@@ -628,6 +636,48 @@ func (k *MockHoldKeeper) ReleaseHold(_ sdk.Context, addr sdk.AccAddress, funds s
 	return err
 }
 
+func (k *MockHoldKeeper) AddHoldEntry(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string, expiration *time.Time) (uint64, error) {
+	if err := k.AddHold(ctx, addr, funds, reason); err != nil {
+		return 0, err
+	}
+	k.LastHoldID++
+	k.HoldEntries = append(k.HoldEntries, &hold.HoldEntry{
+		HoldId:     k.LastHoldID,
+		Address:    addr.String(),
+		Holder:     holder,
+		Reason:     reason,
+		Amount:     funds,
+		Expiration: expiration,
+	})
+	return k.LastHoldID, nil
+}
+
+func (k *MockHoldKeeper) ReleaseHoldByID(ctx sdk.Context, holder string, holdID uint64) error {
+	for i, entry := range k.HoldEntries {
+		if entry.HoldId != holdID {
+			continue
+		}
+		if entry.Holder != holder {
+			return fmt.Errorf("cannot release hold entry %d: it is held by %q, not %q", holdID, entry.Holder, holder)
+		}
+		if err := k.ReleaseHold(ctx, sdk.MustAccAddressFromBech32(entry.Address), entry.Amount); err != nil {
+			return err
+		}
+		k.HoldEntries = append(k.HoldEntries[:i:i], k.HoldEntries[i+1:]...)
+		return nil
+	}
+	return fmt.Errorf("hold entry %d does not exist", holdID)
+}
+
+func (k *MockHoldKeeper) IterateAllHoldEntries(_ sdk.Context, process func(*hold.HoldEntry) bool) error {
+	for _, entry := range k.HoldEntries {
+		if process(entry) {
+			break
+		}
+	}
+	return nil
+}
+
 func (k *MockHoldKeeper) GetHoldCoin(_ sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
 	k.Calls.GetHoldCoin = append(k.Calls.GetHoldCoin, NewGetHoldCoinArgs(addr, denom))
 	if denomMap, aFound := k.GetHoldCoinResultsMap[string(addr)]; aFound {
@@ -71,6 +71,16 @@ func (k MsgServer) CancelOrder(goCtx context.Context, msg *exchange.MsgCancelOrd
 	return &exchange.MsgCancelOrderResponse{}, nil
 }
 
+// AmendOrder changes the assets, price, and settlement fees of an existing order.
+func (k MsgServer) AmendOrder(goCtx context.Context, msg *exchange.MsgAmendOrderRequest) (*exchange.MsgAmendOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.AmendOrder(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgAmendOrderResponse{}, nil
+}
+
 // FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
 func (k MsgServer) FillBids(goCtx context.Context, msg *exchange.MsgFillBidsRequest) (*exchange.MsgFillBidsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -212,6 +222,19 @@ func (k MsgServer) MarketUpdateUserSettle(goCtx context.Context, msg *exchange.M
 	return &exchange.MsgMarketUpdateUserSettleResponse{}, nil
 }
 
+// MarketUpdateAutoMatch is a market endpoint to update whether its orders are automatically matched and settled.
+func (k MsgServer) MarketUpdateAutoMatch(goCtx context.Context, msg *exchange.MsgMarketUpdateAutoMatchRequest) (*exchange.MsgMarketUpdateAutoMatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateAutoMatch(ctx, msg.MarketId, msg.AutoMatch, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateAutoMatchResponse{}, nil
+}
+
 // MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
 func (k MsgServer) MarketUpdateAcceptingCommitments(goCtx context.Context, msg *exchange.MsgMarketUpdateAcceptingCommitmentsRequest) (*exchange.MsgMarketUpdateAcceptingCommitmentsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -174,18 +174,42 @@ func (s *TestSuite) eventHoldAddedCommitment(addr sdk.AccAddress, amount string,
 	})
 }
 
-// eventHoldAddedPayment creates a new event emitted when a hold is added for a payment (emitted by the hold module).
-func (s *TestSuite) eventHoldAddedPayment(addr sdk.AccAddress, amount string, externalID string) sdk.Event {
-	return s.untypeEvent(&hold.EventHoldAdded{
-		Address: addr.String(), Amount: amount, Reason: fmt.Sprintf("x/exchange: payment %q", externalID),
-	})
-}
-
 // eventHoldReleased creates a new event emitted when a hold is released (emitted by the hold module).
 func (s *TestSuite) eventHoldReleased(addr sdk.AccAddress, amount string) sdk.Event {
 	return s.untypeEvent(&hold.EventHoldReleased{Address: addr.String(), Amount: amount})
 }
 
+// eventHoldEntryAddedOrder creates a new event emitted when a hold entry is added for an order (emitted by the hold module).
+func (s *TestSuite) eventHoldEntryAddedOrder(holdID uint64, addr sdk.AccAddress, amount string, orderID uint64) sdk.Event {
+	return s.untypeEvent(&hold.EventHoldEntryAdded{
+		HoldId: holdID, Address: addr.String(), Holder: exchange.ModuleName, Amount: amount,
+		Reason: fmt.Sprintf("x/exchange: order %d", orderID),
+	})
+}
+
+// eventHoldEntryAddedCommitment creates a new event emitted when a hold entry is added for a commitment (emitted by the hold module).
+func (s *TestSuite) eventHoldEntryAddedCommitment(holdID uint64, addr sdk.AccAddress, amount string, marketID uint32) sdk.Event {
+	return s.untypeEvent(&hold.EventHoldEntryAdded{
+		HoldId: holdID, Address: addr.String(), Holder: exchange.ModuleName, Amount: amount,
+		Reason: fmt.Sprintf("x/exchange: commitment to %d", marketID),
+	})
+}
+
+// eventHoldEntryAddedPayment creates a new event emitted when a hold entry is added for a payment (emitted by the hold module).
+func (s *TestSuite) eventHoldEntryAddedPayment(holdID uint64, addr sdk.AccAddress, amount string, externalID string) sdk.Event {
+	return s.untypeEvent(&hold.EventHoldEntryAdded{
+		HoldId: holdID, Address: addr.String(), Holder: exchange.ModuleName, Amount: amount,
+		Reason: fmt.Sprintf("x/exchange: payment %q", externalID),
+	})
+}
+
+// eventHoldEntryReleased creates a new event emitted when a hold entry is released (emitted by the hold module).
+func (s *TestSuite) eventHoldEntryReleased(holdID uint64, addr sdk.AccAddress, amount string) sdk.Event {
+	return s.untypeEvent(&hold.EventHoldEntryReleased{
+		HoldId: holdID, Address: addr.String(), Holder: exchange.ModuleName, Amount: amount,
+	})
+}
+
 // eventFundsCommitted creates a new event emitted when funds are committed.
 func (s *TestSuite) eventFundsCommitted(addr sdk.AccAddress, marketID uint32, amount string, eventTag string) sdk.Event {
 	return s.untypeEvent(exchange.NewEventFundsCommitted(addr.String(), marketID, s.coins(amount), eventTag))
@@ -337,6 +361,12 @@ type expBalances struct {
 	expSpend []sdk.Coin
 }
 
+// orderAndBalances is the definition of an expected order along with an account's expected balances.
+type orderAndBalances struct {
+	order *exchange.Order
+	eb    expBalances
+}
+
 // checkBalances looks up the actual balances and asserts that they're the same as provided.
 func (s *TestSuite) checkBalances(eb expBalances) bool {
 	addrName := s.getAddrName(eb.addr)
@@ -549,7 +579,7 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldAddedOrder(s.addr2, "60apple", 84),
+				s.eventHoldEntryAddedOrder(1, s.addr2, "60apple", 84),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 84, OrderType: "ask", MarketId: 5, ExternalId: "",
 				}),
@@ -593,7 +623,7 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1pear"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr2, "1pear"),
 				s.eventMessageSender(s.marketAddr2),
-				s.eventHoldAddedOrder(s.addr2, "75apple", 7),
+				s.eventHoldEntryAddedOrder(1, s.addr2, "75apple", 7),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 7, OrderType: "ask", MarketId: 2, ExternalId: "just-an-id",
 				}),
@@ -636,7 +666,7 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1fig"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr3, "1fig"),
 				s.eventMessageSender(s.marketAddr3),
-				s.eventHoldAddedOrder(s.addr2, "75apple,12fig", 12345),
+				s.eventHoldEntryAddedOrder(1, s.addr2, "75apple,12fig", 12345),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 12345, OrderType: "ask", MarketId: 3, ExternalId: "",
 				}),
@@ -799,7 +829,7 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldAddedOrder(s.addr2, "45pear", 84),
+				s.eventHoldEntryAddedOrder(1, s.addr2, "45pear", 84),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 84, OrderType: "bid", MarketId: 2, ExternalId: "",
 				}),
@@ -843,7 +873,7 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1pear"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr2, "1pear"),
 				s.eventMessageSender(s.marketAddr2),
-				s.eventHoldAddedOrder(s.addr2, "87pear", 7),
+				s.eventHoldEntryAddedOrder(1, s.addr2, "87pear", 7),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 7, OrderType: "bid", MarketId: 2, ExternalId: "some-random-id",
 				}),
@@ -971,7 +1001,7 @@ func (s *TestSuite) TestMsgServer_CommitFunds() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1cherry"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr3, "1cherry"),
 				s.eventMessageSender(s.marketAddr3),
-				s.untypeEvent(hold.NewEventHoldAdded(s.addr2, s.coins("50apple,90cherry"), "x/exchange: commitment to 3")),
+				s.eventHoldEntryAddedCommitment(1, s.addr2, "50apple,90cherry", 3),
 				s.untypeEvent(exchange.NewEventFundsCommitted(s.addr2.String(), 3, s.coins("50apple,90cherry"), "yayayayeah")),
 			},
 		},
@@ -1205,6 +1235,146 @@ func (s *TestSuite) TestMsgServer_CancelOrder() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_AmendOrder() {
+	testDef := msgServerTestDef[exchange.MsgAmendOrderRequest, exchange.MsgAmendOrderResponse, orderAndBalances]{
+		endpointName: "AmendOrder",
+		endpoint:     keeper.NewMsgServer(s.k).AmendOrder,
+		expResp:      &exchange.MsgAmendOrderResponse{},
+		followup: func(msg *exchange.MsgAmendOrderRequest, fArgs orderAndBalances) {
+			order, err := s.k.GetOrder(s.ctx, msg.OrderId)
+			s.Assert().NoError(err, "GetOrder(%d) error", msg.OrderId)
+			s.Assert().Equal(fArgs.order, order, "GetOrder(%d) order", msg.OrderId)
+			s.checkBalances(fArgs.eb)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgAmendOrderRequest, orderAndBalances]{
+		{
+			name: "order does not exist",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 3, AcceptingOrders: true})
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 6, Assets: s.coin("1apple"), Price: s.coin("1pear"),
+			},
+			expInErr: []string{invReqErr, "order 6 does not exist"},
+		},
+		{
+			name: "wrong owner",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(83).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1pear"),
+				}))
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr2.String(), OrderId: 83, Assets: s.coin("1apple"), Price: s.coin("2pear"),
+			},
+			expInErr: []string{invReqErr, "account " + s.addr2.String() + " does not own order 83"},
+		},
+		{
+			name: "insufficient funds for bigger ask",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(83).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr1.String(), Assets: s.coin("10apple"), Price: s.coin("5pear"),
+				}))
+				s.requireFundAccount(s.addr1, "12apple")
+				s.requireAddHold(s.addr1, "10apple", 83)
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 83, Assets: s.coin("15apple"), Price: s.coin("8pear"),
+			},
+			expInErr: []string{invReqErr, "error updating hold for ask order 83", "spendable balance 2apple is less than hold amount 5apple"},
+		},
+		{
+			name: "ask: fewer assets, new fee",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 1, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(5555).WithAsk(&exchange.AskOrder{
+					MarketId:   1,
+					Seller:     s.addr1.String(),
+					Assets:     s.coin("10apple"),
+					Price:      s.coin("5pear"),
+					ExternalId: "ext-id-5555",
+				}))
+				s.requireFundAccount(s.addr1, "15apple,5fig")
+				s.requireAddHold(s.addr1, "10apple", 5555)
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 5555, Assets: s.coin("8apple"), Price: s.coin("4pear"),
+				SettlementFees: s.coins("1fig"),
+			},
+			fArgs: orderAndBalances{
+				order: exchange.NewOrder(5555).WithAsk(&exchange.AskOrder{
+					MarketId:                1,
+					Seller:                  s.addr1.String(),
+					Assets:                  s.coin("8apple"),
+					Price:                   s.coin("4pear"),
+					SellerSettlementFlatFee: s.coinP("1fig"),
+					ExternalId:              "ext-id-5555",
+				}),
+				eb: expBalances{
+					addr:     s.addr1,
+					expBal:   s.coins("15apple,5fig"),
+					expHold:  s.coins("8apple,1fig"),
+					expSpend: s.coins("7apple,4fig"),
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldReleased(s.addr1, "2apple"),
+				s.eventHoldAddedOrder(s.addr1, "1fig", 5555),
+				s.untypeEvent(&exchange.EventOrderAmended{
+					OrderId: 5555, Assets: "8apple", Price: "4pear", Fees: "1fig", MarketId: 1, ExternalId: "ext-id-5555",
+				}),
+			},
+		},
+		{
+			name: "bid: higher price",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 1, AcceptingOrders: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(44).WithBid(&exchange.BidOrder{
+					MarketId: 1,
+					Buyer:    s.addr2.String(),
+					Assets:   s.coin("10apple"),
+					Price:    s.coin("5pear"),
+				}))
+				s.requireFundAccount(s.addr2, "20pear")
+				s.requireAddHold(s.addr2, "5pear", 44)
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr2.String(), OrderId: 44, Assets: s.coin("10apple"), Price: s.coin("7pear"),
+			},
+			fArgs: orderAndBalances{
+				order: exchange.NewOrder(44).WithBid(&exchange.BidOrder{
+					MarketId: 1,
+					Buyer:    s.addr2.String(),
+					Assets:   s.coin("10apple"),
+					Price:    s.coin("7pear"),
+				}),
+				eb: expBalances{
+					addr:     s.addr2,
+					expBal:   s.coins("20pear"),
+					expHold:  s.coins("7pear"),
+					expSpend: s.coins("13pear"),
+				},
+			},
+			expEvents: sdk.Events{
+				s.eventHoldAddedOrder(s.addr2, "2pear", 44),
+				s.untypeEvent(&exchange.EventOrderAmended{
+					OrderId: 44, Assets: "10apple", Price: "7pear", Fees: "", MarketId: 1,
+				}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_FillBids() {
 	testDef := msgServerTestDef[exchange.MsgFillBidsRequest, exchange.MsgFillBidsResponse, []expBalances]{
 		endpointName: "FillBids",
@@ -3268,7 +3438,7 @@ func (s *TestSuite) TestMsgServer_MarketCommitmentSettle() {
 				s.eventFundsCommitted(s.addr2, 3, "57apple", "tagtestbackagain"),
 				s.eventHoldAddedCommitment(s.addr3, "12apple", 3),
 				s.eventFundsCommitted(s.addr3, 3, "12apple", "tagtestbackagain"),
-				s.eventHoldAddedCommitment(s.addr4, "26apple,37plum", 3),
+				s.eventHoldEntryAddedCommitment(1, s.addr4, "26apple,37plum", 3),
 				s.eventFundsCommitted(s.addr4, 3, "26apple,37plum", "tagtestbackagain"),
 			},
 			fArgs: []expBalances{
@@ -4183,6 +4353,107 @@ func (s *TestSuite) TestMsgServer_MarketUpdateUserSettle() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_MarketUpdateAutoMatch() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateAutoMatchRequest, exchange.MsgMarketUpdateAutoMatchResponse, struct{}]{
+		endpointName: "MarketUpdateAutoMatch",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateAutoMatch,
+		expResp:      &exchange.MsgMarketUpdateAutoMatchResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateAutoMatchRequest, _ struct{}) {
+			enabled := s.k.IsAutoMatchEnabled(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.AutoMatch, enabled, "IsAutoMatchEnabled(%d)", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateAutoMatchRequest, struct{}]{
+		{
+			name: "admin does not have permission to update market",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: true,
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
+		},
+		{
+			name: "false to false",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AutoMatch: false,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: false,
+			},
+			expInErr: []string{invReqErr, "market 3 already has auto-match false"},
+		},
+		{
+			name: "true to true",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AutoMatch: true,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: true,
+			},
+			expInErr: []string{invReqErr, "market 3 already has auto-match true"},
+		},
+		{
+			name: "false to true",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AutoMatch: false,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: true,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAutoMatchEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+			},
+		},
+		{
+			name: "true to false",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AutoMatch: true,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: false,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAutoMatchDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingCommitments() {
 	testDef := msgServerTestDef[exchange.MsgMarketUpdateAcceptingCommitmentsRequest, exchange.MsgMarketUpdateAcceptingCommitmentsResponse, struct{}]{
 		endpointName: "MarketUpdateAcceptingCommitments",
@@ -4620,7 +4891,7 @@ func (s *TestSuite) TestMsgServer_CreatePayment() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldAddedPayment(s.addr1, "23strawberry", "four-five-six"),
+				s.eventHoldEntryAddedPayment(1, s.addr1, "23strawberry", "four-five-six"),
 				s.untypeEvent(exchange.NewEventPaymentCreated(
 					s.newTestPayment(s.addr1, "23strawberry", s.addr2, "12tangerine", "four-five-six"))),
 			},
@@ -4687,7 +4958,7 @@ func (s *TestSuite) TestMsgServer_AcceptPayment() {
 			},
 			expEvents: sdk.Events{
 				// Hold released.
-				s.eventHoldReleased(s.longAddr1, "5starfruit"),
+				s.eventHoldEntryReleased(1, s.longAddr1, "5starfruit"),
 				// Send from source to target.
 				s.eventCoinSpent(s.longAddr1, "5starfruit"),
 				s.eventCoinReceived(s.addr4, "5starfruit"),
@@ -4818,7 +5089,7 @@ func (s *TestSuite) TestMsgServer_RejectPayment() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "1starfruit,49strawberry"),
+				s.eventHoldEntryReleased(1, s.addr2, "1starfruit,49strawberry"),
 				s.untypeEvent(exchange.NewEventPaymentRejected(
 					s.newTestPayment(s.addr2, "1starfruit,49strawberry", s.addr3, "100tangerine", "four-oh-six"))),
 			},
@@ -4940,9 +5211,9 @@ func (s *TestSuite) TestMsgServer_RejectPayments() {
 			},
 			expEvents: sdk.Events{
 				// no hold release event for s.longAddr3 because that payment doesn't have any source funds.
-				s.eventHoldReleased(s.addr2, "7starfruit"),
-				s.eventHoldReleased(s.addr2, "33strawberry"),
-				s.eventHoldReleased(s.addr1, "13strawberry"),
+				s.eventHoldEntryReleased(2, s.addr2, "7starfruit"),
+				s.eventHoldEntryReleased(3, s.addr2, "33strawberry"),
+				s.eventHoldEntryReleased(1, s.addr1, "13strawberry"),
 				s.untypeEvent(exchange.NewEventPaymentRejected(s.newTestPayment(s.longAddr3, "", s.longAddr1, "100tangerine,100tomato", ""))),
 				s.untypeEvent(exchange.NewEventPaymentRejected(s.newTestPayment(s.addr2, "7starfruit", s.longAddr1, "16tangerine", "a"))),
 				s.untypeEvent(exchange.NewEventPaymentRejected(s.newTestPayment(s.addr2, "33strawberry", s.longAddr1, "54tomato", "b"))),
@@ -5049,9 +5320,9 @@ func (s *TestSuite) TestMsgServer_CancelPayments() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.longAddr3, "4strawberry"),
-				s.eventHoldReleased(s.longAddr3, "8strawberry"),
-				s.eventHoldReleased(s.longAddr3, "1strawberry"),
+				s.eventHoldEntryReleased(3, s.longAddr3, "4strawberry"),
+				s.eventHoldEntryReleased(4, s.longAddr3, "8strawberry"),
+				s.eventHoldEntryReleased(1, s.longAddr3, "1strawberry"),
 				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "4strawberry", s.addr4, "12tangerine", "ghi"))),
 				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "8strawberry", s.addr1, "13tangerine", ""))),
 				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "1strawberry", s.longAddr2, "10tangerine", "abc"))),
@@ -3,7 +3,9 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 
@@ -13,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/provenance-io/provenance/x/exchange"
 )
@@ -127,7 +130,7 @@ func createConstantIndexEntries(order exchange.Order) []kv.Pair {
 	addr := sdk.MustAccAddressFromBech32(owner)
 	assets := order.GetAssets()
 
-	return []kv.Pair{
+	rv := []kv.Pair{
 		{
 			Key:   MakeIndexKeyMarketToOrder(marketID, orderID),
 			Value: []byte{orderTypeByte},
@@ -141,6 +144,21 @@ func createConstantIndexEntries(order exchange.Order) []kv.Pair {
 			Value: []byte{orderTypeByte},
 		},
 	}
+
+	if goodTilTime := order.GetGoodTilTime(); goodTilTime != nil {
+		rv = append(rv, kv.Pair{
+			Key:   MakeIndexKeyExpirationTimeToOrder(*goodTilTime, orderID),
+			Value: []byte{orderTypeByte},
+		})
+	}
+	if goodTilBlockHeight := order.GetGoodTilBlockHeight(); goodTilBlockHeight != 0 {
+		rv = append(rv, kv.Pair{
+			Key:   MakeIndexKeyExpirationHeightToOrder(goodTilBlockHeight, orderID),
+			Value: []byte{orderTypeByte},
+		})
+	}
+
+	return rv
 }
 
 // createMarketExternalIDToOrderEntry creates the market external id to order store entry.
@@ -188,7 +206,20 @@ func (k Keeper) setOrderInStore(store storetypes.KVStore, order exchange.Order)
 	}
 
 	isUpdate := store.Has(key)
+	if isUpdate {
+		// The unit price might change (e.g. from a partial fill), so the old price index entry is replaced.
+		existing, err := k.getOrderFromStore(store, order.GetOrderID())
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			store.Delete(MakeIndexKeyMarketPriceToOrder(*existing))
+		}
+	}
 	store.Set(key, value)
+	store.Set(MakeIndexKeyMarketPriceToOrder(order), []byte{order.GetOrderTypeByte()})
+	// The order has changed, so auto-matching should try it again.
+	store.Delete(MakeKeyMarketAutoMatchFailedOrder(order.GetMarketID(), order.GetOrderID()))
 
 	if !isUpdate {
 		indexEntries := createConstantIndexEntries(order)
@@ -207,6 +238,8 @@ func (k Keeper) setOrderInStore(store storetypes.KVStore, order exchange.Order)
 func deleteAndDeIndexOrder(store storetypes.KVStore, order exchange.Order) {
 	key := MakeKeyOrder(order.OrderId)
 	store.Delete(key)
+	store.Delete(MakeKeyMarketAutoMatchFailedOrder(order.GetMarketID(), order.GetOrderID()))
+	store.Delete(MakeIndexKeyMarketPriceToOrder(order))
 	indexEntries := createConstantIndexEntries(order)
 	for _, entry := range indexEntries {
 		store.Delete(entry.Key)
@@ -449,6 +482,23 @@ func validateMarketIsAcceptingOrders(store storetypes.KVStore, marketID uint32)
 	return nil
 }
 
+// validateOrderNotExpired makes sure the provided order has not already expired.
+func validateOrderNotExpired(ctx sdk.Context, order exchange.SubOrderI) error {
+	goodTilTime := order.GetGoodTilTime()
+	goodTilBlockHeight := order.GetGoodTilBlockHeight()
+	blockTime := ctx.BlockTime()
+	blockHeight := uint64(ctx.BlockHeight()) //nolint:gosec // G115: Block heights are never negative.
+	if !exchange.IsExpired(goodTilTime, goodTilBlockHeight, blockTime, blockHeight) {
+		return nil
+	}
+	if goodTilTime != nil && !blockTime.Before(*goodTilTime) {
+		return fmt.Errorf("invalid good til time %s: must be after the current block time %s",
+			goodTilTime.UTC().Format(time.RFC3339), blockTime.UTC().Format(time.RFC3339))
+	}
+	return fmt.Errorf("invalid good til block height %d: must be after the current block height %d",
+		goodTilBlockHeight, blockHeight)
+}
+
 // validateUserCanCreateAsk makes sure the user can create an ask order in the given market.
 func (k Keeper) validateUserCanCreateAsk(ctx sdk.Context, marketID uint32, seller sdk.AccAddress) error {
 	if !k.CanCreateAsk(ctx, marketID, seller) {
@@ -571,7 +621,7 @@ func (k Keeper) placeHoldOnOrder(ctx sdk.Context, order exchange.OrderI) error {
 		return fmt.Errorf("invalid %s order %d owner %q: %w", orderType, orderID, owner, err)
 	}
 	toHold := order.GetHoldAmount()
-	err = k.holdKeeper.AddHold(ctx, ownerAddr, toHold, fmt.Sprintf("x/exchange: order %d", orderID))
+	err = k.updateHold(ctx, MakeKeyOrder(orderID), ownerAddr, nil, toHold, orderHoldReason(orderID))
 	if err != nil {
 		return fmt.Errorf("error placing hold for %s order %d: %w", orderType, orderID, err)
 	}
@@ -588,7 +638,29 @@ func (k Keeper) releaseHoldOnOrder(ctx sdk.Context, order exchange.OrderI) error
 		return fmt.Errorf("invalid %s order %d owner %q: %w", orderType, orderID, owner, err)
 	}
 	held := order.GetHoldAmount()
-	err = k.holdKeeper.ReleaseHold(ctx, ownerAddr, held)
+	err = k.updateHold(ctx, MakeKeyOrder(orderID), ownerAddr, held, nil, orderHoldReason(orderID))
+	if err != nil {
+		return fmt.Errorf("error releasing hold for %s order %d: %w", orderType, orderID, err)
+	}
+	return nil
+}
+
+// reduceHoldOnPartialOrder releases the hold on the filled portion of a partially filled order,
+// leaving the funds for the unfilled portion on hold.
+func (k Keeper) reduceHoldOnPartialOrder(ctx sdk.Context, filled exchange.OrderI, unfilled *exchange.Order) error {
+	orderID := filled.GetOrderID()
+	orderType := filled.GetOrderType()
+	owner := filled.GetOwner()
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return fmt.Errorf("invalid %s order %d owner %q: %w", orderType, orderID, owner, err)
+	}
+	var left sdk.Coins
+	if unfilled != nil {
+		left = unfilled.GetHoldAmount()
+	}
+	held := filled.GetHoldAmount().Add(left...)
+	err = k.updateHold(ctx, MakeKeyOrder(orderID), ownerAddr, held, left, orderHoldReason(orderID))
 	if err != nil {
 		return fmt.Errorf("error releasing hold for %s order %d: %w", orderType, orderID, err)
 	}
@@ -632,6 +704,9 @@ func (k Keeper) CreateAskOrder(ctx sdk.Context, askOrder exchange.AskOrder, crea
 	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
 		return 0, err
 	}
+	if err := validateOrderNotExpired(ctx, askOrder); err != nil {
+		return 0, err
+	}
 	seller := sdk.MustAccAddressFromBech32(askOrder.Seller)
 	if err := k.validateUserCanCreateAsk(ctx, marketID, seller); err != nil {
 		return 0, err
@@ -676,6 +751,9 @@ func (k Keeper) CreateBidOrder(ctx sdk.Context, bidOrder exchange.BidOrder, crea
 	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
 		return 0, err
 	}
+	if err := validateOrderNotExpired(ctx, bidOrder); err != nil {
+		return 0, err
+	}
 	buyer := sdk.MustAccAddressFromBech32(bidOrder.Buyer)
 	if err := k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
 		return 0, err
@@ -720,16 +798,131 @@ func (k Keeper) CancelOrder(ctx sdk.Context, orderID uint64, signer string) erro
 		return fmt.Errorf("account %s does not have permission to cancel order %d", signer, orderID)
 	}
 
-	orderOwnerAddr := sdk.MustAccAddressFromBech32(orderOwner)
+	if err = k.releaseAndDeleteOrder(ctx, order); err != nil {
+		return err
+	}
+	k.emitEvent(ctx, exchange.NewEventOrderCancelled(order, signer))
+
+	return nil
+}
+
+// releaseAndDeleteOrder releases an order's held funds and deletes it (along with its indexes).
+func (k Keeper) releaseAndDeleteOrder(ctx sdk.Context, order *exchange.Order) error {
+	orderOwnerAddr := sdk.MustAccAddressFromBech32(order.GetOwner())
 	heldAmount := order.GetHoldAmount()
-	err = k.holdKeeper.ReleaseHold(ctx, orderOwnerAddr, heldAmount)
+	err := k.updateHold(ctx, MakeKeyOrder(order.OrderId), orderOwnerAddr, heldAmount, nil, orderHoldReason(order.OrderId))
 	if err != nil {
-		return fmt.Errorf("unable to release hold on order %d funds: %w", orderID, err)
+		return fmt.Errorf("unable to release hold on order %d funds: %w", order.OrderId, err)
 	}
 
 	deleteAndDeIndexOrder(k.getStore(ctx), *order)
-	k.emitEvent(ctx, exchange.NewEventOrderCancelled(order, signer))
+	return nil
+}
+
+// getHoldDelta compares the funds currently on hold for an order with the funds that should be on hold for it.
+// It returns the funds that should no longer be on hold and the funds that need to be added to the hold.
+func getHoldDelta(curHold, newHold sdk.Coins) (toRelease sdk.Coins, toAdd sdk.Coins) {
+	for _, coin := range curHold {
+		newAmt := newHold.AmountOf(coin.Denom)
+		if coin.Amount.GT(newAmt) {
+			toRelease = toRelease.Add(sdk.Coin{Denom: coin.Denom, Amount: coin.Amount.Sub(newAmt)})
+		}
+	}
+	for _, coin := range newHold {
+		curAmt := curHold.AmountOf(coin.Denom)
+		if coin.Amount.GT(curAmt) {
+			toAdd = toAdd.Add(sdk.Coin{Denom: coin.Denom, Amount: coin.Amount.Sub(curAmt)})
+		}
+	}
+	return toRelease, toAdd
+}
+
+// getAmendedOrder creates a copy of the provided order with the changes from the msg, and makes sure
+// that the new version is valid and satisfies the market's requirements.
+func (k Keeper) getAmendedOrder(ctx sdk.Context, store storetypes.KVStore, order *exchange.Order, msg *exchange.MsgAmendOrderRequest) (*exchange.Order, error) {
+	marketID := order.GetMarketID()
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
 
+	switch {
+	case order.IsAskOrder():
+		if len(msg.SettlementFees) > 1 {
+			return nil, fmt.Errorf("invalid settlement fees %q: ask orders can have at most one settlement fee",
+				msg.SettlementFees)
+		}
+		var fee *sdk.Coin
+		if len(msg.SettlementFees) == 1 {
+			feeCoin := msg.SettlementFees[0]
+			fee = &feeCoin
+		}
+		askOrder := order.GetAskOrder().CopyChange(msg.Assets, msg.Price, fee)
+		if err := askOrder.Validate(); err != nil {
+			return nil, err
+		}
+		if err := k.validateUserCanCreateAsk(ctx, marketID, owner); err != nil {
+			return nil, err
+		}
+		if err := validateSellerSettlementFlatFee(store, marketID, fee); err != nil {
+			return nil, err
+		}
+		if err := validateAskPrice(store, marketID, askOrder.Price, fee); err != nil {
+			return nil, err
+		}
+		return exchange.NewOrder(order.OrderId).WithAsk(askOrder), nil
+	case order.IsBidOrder():
+		bidOrder := order.GetBidOrder().CopyChange(msg.Assets, msg.Price, msg.SettlementFees)
+		if err := bidOrder.Validate(); err != nil {
+			return nil, err
+		}
+		if err := k.validateUserCanCreateBid(ctx, marketID, owner); err != nil {
+			return nil, err
+		}
+		if err := validateBuyerSettlementFee(store, marketID, bidOrder.Price, bidOrder.BuyerSettlementFees); err != nil {
+			return nil, err
+		}
+		return exchange.NewOrder(order.OrderId).WithBid(bidOrder), nil
+	default:
+		return nil, fmt.Errorf("order %d has unknown type %s", order.OrderId, order.GetOrderType())
+	}
+}
+
+// AmendOrder changes the assets, price, and settlement fees of an existing order.
+// The hold on the owner's funds is adjusted to match the new version of the order.
+// The order keeps its id, external id, and all other fields. No order creation fee is charged.
+func (k Keeper) AmendOrder(ctx sdk.Context, msg *exchange.MsgAmendOrderRequest) error {
+	store := k.getStore(ctx)
+	order, err := k.getOrderFromStore(store, msg.OrderId)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return fmt.Errorf("order %d does not exist", msg.OrderId)
+	}
+	if msg.Owner != order.GetOwner() {
+		return fmt.Errorf("account %s does not own order %d", msg.Owner, msg.OrderId)
+	}
+	if err = validateMarketIsAcceptingOrders(store, order.GetMarketID()); err != nil {
+		return err
+	}
+
+	amended, err := k.getAmendedOrder(ctx, store, order, msg)
+	if err != nil {
+		return err
+	}
+
+	orderType := order.GetOrderType()
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	err = k.updateHold(ctx, MakeKeyOrder(order.OrderId), owner, order.GetHoldAmount(), amended.GetHoldAmount(), orderHoldReason(order.OrderId))
+	if err != nil {
+		return fmt.Errorf("error updating hold for %s order %d: %w", orderType, order.OrderId, err)
+	}
+
+	// The assets denom might be different now, so the order needs to be fully re-indexed.
+	deleteAndDeIndexOrder(store, *order)
+	if err = k.setOrderInStore(store, *amended); err != nil {
+		return fmt.Errorf("error storing %s order: %w", orderType, err)
+	}
+
+	k.emitEvent(ctx, exchange.NewEventOrderAmended(amended))
 	return nil
 }
 
@@ -837,3 +1030,69 @@ func (k Keeper) CancelAllOrdersForMarket(ctx sdk.Context, marketID uint32, signe
 			len(errs), marketID, errors.Join(errs...))
 	}
 }
+
+// getExpiredOrderIDs gets the ids of all orders that have an index entry indicating they might be expired.
+// The orders still need to be checked for expiration since the time index has a granularity of one second.
+// The returned order ids are sorted.
+func getExpiredOrderIDs(store storetypes.KVStore, blockTime time.Time, blockHeight uint64) []uint64 {
+	var rv []uint64
+	seen := make(map[uint64]bool)
+	addOrderIDs := func(start, end []byte) {
+		iter := store.Iterator(start, end)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			orderID, ok := ParseIndexKeySuffixOrderID(iter.Key())
+			if ok && !seen[orderID] {
+				rv = append(rv, orderID)
+				seen[orderID] = true
+			}
+		}
+	}
+
+	// The end of an iterator is exclusive, so we look up to the next second and next block height.
+	addOrderIDs(GetIndexKeyPrefixExpirationTimeToOrder(),
+		GetIndexKeyPrefixExpirationTimeToOrderUpTo(blockTime.Add(time.Second)))
+	addOrderIDs(GetIndexKeyPrefixExpirationHeightToOrder(),
+		GetIndexKeyPrefixExpirationHeightToOrderUpTo(blockHeight+1))
+
+	slices.Sort(rv)
+	return rv
+}
+
+// CancelExpiredOrders cancels all orders that have expired, releasing their holds and deleting them.
+// An EventOrderCancelled is emitted (with reason "expired") for each cancelled order.
+func (k Keeper) CancelExpiredOrders(ctx sdk.Context) {
+	store := k.getStore(ctx)
+	blockTime := ctx.BlockTime()
+	blockHeight := uint64(ctx.BlockHeight()) //nolint:gosec // G115: Block heights are never negative.
+	orderIDs := getExpiredOrderIDs(store, blockTime, blockHeight)
+	if len(orderIDs) == 0 {
+		return
+	}
+
+	cancelledBy := authtypes.NewModuleAddress(exchange.ModuleName).String()
+	var errs []error
+	for _, orderID := range orderIDs {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if order == nil || !order.IsExpired(blockTime, blockHeight) {
+			continue
+		}
+
+		// Using a cache context so that if there's a problem with one order, it doesn't leave things half-done.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.releaseAndDeleteOrder(cacheCtx, order); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		writeCache()
+		k.emitEvent(ctx, exchange.NewEventOrderExpired(order, cancelledBy))
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered canceling expired orders:\n%v", len(errs), errors.Join(errs...))
+	}
+}
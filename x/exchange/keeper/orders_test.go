@@ -3,13 +3,16 @@ package keeper_test
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/provenance-io/provenance/x/exchange"
 	"github.com/provenance-io/provenance/x/exchange/keeper"
@@ -460,6 +463,10 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 		return fmt.Sprintf("x/exchange: order %d", orderID)
 	}
 
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	blockHeight := int64(100)
+	futureTime := blockTime.Add(time.Second)
+
 	tests := []struct {
 		name         string
 		attrKeeper   *MockAttributeKeeper
@@ -510,6 +517,41 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 			},
 			expErr: "market 2 is not accepting orders",
 		},
+		{
+			name: "good til time already reached",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId:    2,
+				Seller:      s.addr3.String(),
+				Assets:      s.coin("35apple"),
+				Price:       s.coin("10peach"),
+				GoodTilTime: &blockTime,
+			},
+			expErr: "invalid good til time 2023-11-14T22:13:20Z: must be after the current block time 2023-11-14T22:13:20Z",
+		},
+		{
+			name: "good til block height already reached",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId:           2,
+				Seller:             s.addr3.String(),
+				Assets:             s.coin("35apple"),
+				Price:              s.coin("10peach"),
+				GoodTilTime:        &futureTime,
+				GoodTilBlockHeight: 100,
+			},
+			expErr: "invalid good til block height 100: must be after the current block height 100",
+		},
 		{
 			name: "attrs required: does not have",
 			attrKeeper: NewMockAttributeKeeper().
@@ -690,6 +732,27 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 		},
 
 		// Tests that should not give an error.
+		{
+			name: "with good til time and block height",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        3,
+					AcceptingOrders: true,
+				})
+			},
+			askOrder: exchange.AskOrder{
+				MarketId:           3,
+				Seller:             s.addr2.String(),
+				Assets:             s.coin("100apple"),
+				Price:              s.coin("3pineapple"),
+				GoodTilTime:        &futureTime,
+				GoodTilBlockHeight: 101,
+			},
+			expOrderID: 1,
+			expHoldCalls: HoldCalls{
+				AddHold: []*AddHoldArgs{{addr: s.addr2, funds: s.coins("100apple"), reason: reason(1)}},
+			},
+		},
 		{
 			name: "no attrs required",
 			setup: func() {
@@ -901,7 +964,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 			kpr := s.k.WithAttributeKeeper(tc.attrKeeper).WithBankKeeper(tc.bankKeeper).WithHoldKeeper(tc.holdKeeper)
 
 			em := sdk.NewEventManager()
-			ctx := s.ctx.WithEventManager(em)
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime).WithBlockHeight(blockHeight)
 			var orderID uint64
 			var err error
 			testFunc := func() {
@@ -933,6 +996,10 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 		return fmt.Sprintf("x/exchange: order %d", orderID)
 	}
 
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	blockHeight := int64(100)
+	futureTime := blockTime.Add(time.Second)
+
 	tests := []struct {
 		name         string
 		attrKeeper   *MockAttributeKeeper
@@ -983,6 +1050,41 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 			},
 			expErr: "market 2 is not accepting orders",
 		},
+		{
+			name: "good til time already reached",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId:    2,
+				Buyer:       s.addr3.String(),
+				Assets:      s.coin("35apple"),
+				Price:       s.coin("10peach"),
+				GoodTilTime: &blockTime,
+			},
+			expErr: "invalid good til time 2023-11-14T22:13:20Z: must be after the current block time 2023-11-14T22:13:20Z",
+		},
+		{
+			name: "good til block height already reached",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        2,
+					AcceptingOrders: true,
+				})
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId:           2,
+				Buyer:              s.addr3.String(),
+				Assets:             s.coin("35apple"),
+				Price:              s.coin("10peach"),
+				GoodTilTime:        &futureTime,
+				GoodTilBlockHeight: 100,
+			},
+			expErr: "invalid good til block height 100: must be after the current block height 100",
+		},
 		{
 			name: "attrs required: does not have",
 			attrKeeper: NewMockAttributeKeeper().
@@ -1182,6 +1284,27 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 		},
 
 		// Tests that should not give an error.
+		{
+			name: "with good til time and block height",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:        3,
+					AcceptingOrders: true,
+				})
+			},
+			bidOrder: exchange.BidOrder{
+				MarketId:           3,
+				Buyer:              s.addr2.String(),
+				Assets:             s.coin("100apple"),
+				Price:              s.coin("3pineapple"),
+				GoodTilTime:        &futureTime,
+				GoodTilBlockHeight: 101,
+			},
+			expOrderID: 1,
+			expHoldCalls: HoldCalls{
+				AddHold: []*AddHoldArgs{{addr: s.addr2, funds: s.coins("3pineapple"), reason: reason(1)}},
+			},
+		},
 		{
 			name: "no attrs required",
 			setup: func() {
@@ -1402,7 +1525,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 			kpr := s.k.WithAttributeKeeper(tc.attrKeeper).WithBankKeeper(tc.bankKeeper).WithHoldKeeper(tc.holdKeeper)
 
 			em := sdk.NewEventManager()
-			ctx := s.ctx.WithEventManager(em)
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime).WithBlockHeight(blockHeight)
 			var orderID uint64
 			var err error
 			testFunc := func() {
@@ -1703,6 +1826,277 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_AmendOrder() {
+	reason := func(orderID uint64) string {
+		return fmt.Sprintf("x/exchange: order %d", orderID)
+	}
+	askOrder := exchange.NewOrder(52).WithAsk(&exchange.AskOrder{
+		MarketId:                1,
+		Seller:                  s.addr1.String(),
+		Assets:                  s.coin("50apricot"),
+		Price:                   s.coin("55plum"),
+		SellerSettlementFlatFee: s.coinP("8fig"),
+		AllowPartial:            true,
+		ExternalId:              "bananas",
+	})
+	bidOrder := exchange.NewOrder(57).WithBid(&exchange.BidOrder{
+		MarketId:            1,
+		Buyer:               s.addr4.String(),
+		Assets:              s.coin("50apricot"),
+		Price:               s.coin("55plum"),
+		BuyerSettlementFees: s.coins("8fig"),
+		ExternalId:          "whatever",
+	})
+	otherOrder := exchange.NewOrder(58).WithAsk(&exchange.AskOrder{
+		MarketId:   1,
+		Seller:     s.addr4.String(),
+		Assets:     s.coin("13apricot"),
+		Price:      s.coin("80plum"),
+		ExternalId: "order 58",
+	})
+	defaultMarket := exchange.Market{MarketId: 1, AcceptingOrders: true}
+
+	tests := []struct {
+		name         string
+		market       *exchange.Market
+		attrKeeper   *MockAttributeKeeper
+		holdKeeper   *MockHoldKeeper
+		msg          exchange.MsgAmendOrderRequest
+		expErr       string
+		expOrder     *exchange.Order
+		expHoldCalls HoldCalls
+	}{
+		{
+			name: "order does not exist",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 55, Assets: s.coin("1apricot"), Price: s.coin("1plum"),
+			},
+			expErr: "order 55 does not exist",
+		},
+		{
+			name: "not the owner",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr2.String(), OrderId: 52, Assets: s.coin("1apricot"), Price: s.coin("1plum"),
+			},
+			expErr: "account " + s.addr2.String() + " does not own order 52",
+		},
+		{
+			name:   "market not accepting orders",
+			market: &exchange.Market{MarketId: 1},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("1apricot"), Price: s.coin("1plum"),
+			},
+			expErr: "market 1 is not accepting orders",
+		},
+		{
+			name: "ask with two settlement fees",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("1apricot"), Price: s.coin("1plum"),
+				SettlementFees: s.coins("1fig,2grape"),
+			},
+			expErr: "invalid settlement fees \"1fig,2grape\": ask orders can have at most one settlement fee",
+		},
+		{
+			name: "ask invalid",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("1plum"), Price: s.coin("1plum"),
+			},
+			expErr: "invalid assets: price denom plum cannot also be the assets denom",
+		},
+		{
+			name:       "ask: attrs required: does not have",
+			attrKeeper: NewMockAttributeKeeper().WithGetAllAttributesAddrResult(s.addr1, []string{"ccc.bb.aa"}, ""),
+			market:     &exchange.Market{MarketId: 1, AcceptingOrders: true, ReqAttrCreateAsk: []string{"cc.bb.aa"}},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("1apricot"), Price: s.coin("1plum"),
+			},
+			expErr: "account " + s.addr1.String() + " is not allowed to create ask orders in market 1",
+		},
+		{
+			name: "ask: insufficient settlement fee",
+			market: &exchange.Market{
+				MarketId:                1,
+				AcceptingOrders:         true,
+				FeeSellerSettlementFlat: s.coins("10fig"),
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("1apricot"), Price: s.coin("1plum"),
+				SettlementFees: s.coins("8fig"),
+			},
+			expErr: "insufficient seller settlement flat fee: \"8fig\" is less than required amount \"10fig\"",
+		},
+		{
+			name:       "bid: attrs required: does not have",
+			attrKeeper: NewMockAttributeKeeper().WithGetAllAttributesAddrResult(s.addr4, []string{"ccc.bb.aa"}, ""),
+			market:     &exchange.Market{MarketId: 1, AcceptingOrders: true, ReqAttrCreateBid: []string{"cc.bb.aa"}},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr4.String(), OrderId: 57, Assets: s.coin("1apricot"), Price: s.coin("1plum"),
+			},
+			expErr: "account " + s.addr4.String() + " is not allowed to create bid orders in market 1",
+		},
+		{
+			name: "bid: insufficient settlement fee",
+			market: &exchange.Market{
+				MarketId:               1,
+				AcceptingOrders:        true,
+				FeeBuyerSettlementFlat: s.coins("10fig"),
+			},
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr4.String(), OrderId: 57, Assets: s.coin("1apricot"), Price: s.coin("1plum"),
+				SettlementFees: s.coins("8fig"),
+			},
+			expErr: s.joinErrs(
+				"8fig is less than required flat fee 10fig",
+				"required flat fee not satisfied, valid options: 10fig",
+				"insufficient buyer settlement fee 8fig",
+			),
+		},
+		{
+			name:       "error releasing hold",
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("not enough there"),
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("40apricot"), Price: s.coin("55plum"),
+				SettlementFees: s.coins("8fig"),
+			},
+			expErr:       "error updating hold for ask order 52: not enough there",
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("10apricot")}}},
+		},
+		{
+			name:       "error adding hold",
+			holdKeeper: NewMockHoldKeeper().WithAddHoldResults("not enough there"),
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("60apricot"), Price: s.coin("55plum"),
+				SettlementFees: s.coins("8fig"),
+			},
+			expErr:       "error updating hold for ask order 52: not enough there",
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("10apricot"), reason(52))}},
+		},
+		{
+			name: "ask: nothing changed",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("50apricot"), Price: s.coin("55plum"),
+				SettlementFees: s.coins("8fig"),
+			},
+			expOrder: askOrder,
+		},
+		{
+			name: "ask: fewer assets and bigger fee",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("40apricot"), Price: s.coin("60plum"),
+				SettlementFees: s.coins("10fig"),
+			},
+			expOrder: exchange.NewOrder(52).WithAsk(&exchange.AskOrder{
+				MarketId:                1,
+				Seller:                  s.addr1.String(),
+				Assets:                  s.coin("40apricot"),
+				Price:                   s.coin("60plum"),
+				SellerSettlementFlatFee: s.coinP("10fig"),
+				AllowPartial:            true,
+				ExternalId:              "bananas",
+			}),
+			expHoldCalls: HoldCalls{
+				AddHold:     []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("2fig"), reason(52))},
+				ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("10apricot")}},
+			},
+		},
+		{
+			name: "ask: settlement fee removed",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr1.String(), OrderId: 52, Assets: s.coin("50apricot"), Price: s.coin("55plum"),
+			},
+			expOrder: exchange.NewOrder(52).WithAsk(&exchange.AskOrder{
+				MarketId:     1,
+				Seller:       s.addr1.String(),
+				Assets:       s.coin("50apricot"),
+				Price:        s.coin("55plum"),
+				AllowPartial: true,
+				ExternalId:   "bananas",
+			}),
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("8fig")}}},
+		},
+		{
+			name: "bid: new assets denom, higher price, no fees",
+			msg: exchange.MsgAmendOrderRequest{
+				Owner: s.addr4.String(), OrderId: 57, Assets: s.coin("20apple"), Price: s.coin("60plum"),
+			},
+			expOrder: exchange.NewOrder(57).WithBid(&exchange.BidOrder{
+				MarketId:   1,
+				Buyer:      s.addr4.String(),
+				Assets:     s.coin("20apple"),
+				Price:      s.coin("60plum"),
+				ExternalId: "whatever",
+			}),
+			expHoldCalls: HoldCalls{
+				AddHold:     []*AddHoldArgs{NewAddHoldArgs(s.addr4, s.coins("5plum"), reason(57))},
+				ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr4, funds: s.coins("8fig")}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.market == nil {
+				tc.market = &defaultMarket
+			}
+			s.requireCreateMarket(*tc.market)
+			store := s.getStore()
+			s.requireSetOrdersInStore(store, askOrder, bidOrder, otherOrder)
+			origOrder, err := s.k.GetOrder(s.ctx, tc.msg.OrderId)
+			s.Require().NoError(err, "GetOrder(%d) before AmendOrder", tc.msg.OrderId)
+
+			var expEvents sdk.Events
+			if tc.expOrder != nil {
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderAmended(tc.expOrder)))
+			}
+
+			if tc.attrKeeper == nil {
+				tc.attrKeeper = NewMockAttributeKeeper()
+			}
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithAttributeKeeper(tc.attrKeeper).WithHoldKeeper(tc.holdKeeper)
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			testFunc := func() {
+				err = kpr.AmendOrder(ctx, &tc.msg)
+			}
+			s.Require().NotPanics(testFunc, "AmendOrder")
+			s.assertErrorValue(err, tc.expErr, "AmendOrder error")
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "AmendOrder events")
+			s.assertHoldKeeperCalls(tc.holdKeeper, tc.expHoldCalls, "AmendOrder")
+
+			if len(tc.expErr) > 0 {
+				if origOrder != nil {
+					actOrder, aerr := s.k.GetOrder(s.ctx, tc.msg.OrderId)
+					s.Assert().NoError(aerr, "GetOrder(%d) after failed AmendOrder", tc.msg.OrderId)
+					s.Assert().Equal(origOrder, actOrder, "GetOrder(%d) after failed AmendOrder", tc.msg.OrderId)
+				}
+				return
+			}
+
+			actOrder, err := s.k.GetOrder(s.ctx, tc.msg.OrderId)
+			s.Require().NoError(err, "GetOrder(%d) after AmendOrder", tc.msg.OrderId)
+			s.Assert().Equal(tc.expOrder, actOrder, "GetOrder(%d) after AmendOrder", tc.msg.OrderId)
+
+			if origOrder.GetAssets().Denom != tc.expOrder.GetAssets().Denom {
+				oldKey := keeper.MakeIndexKeyAssetToOrder(origOrder.GetAssets().Denom, tc.msg.OrderId)
+				s.Assert().False(store.Has(oldKey), "store.Has(%q) (old asset index entry)", oldKey)
+			}
+			for i, pair := range keeper.CreateConstantIndexEntries(*tc.expOrder) {
+				s.Assert().True(store.Has(pair.Key), "[%d]: store.Has(%q) (index entry)", i, pair.Key)
+			}
+
+			byExtID, err := s.k.GetOrderByExternalID(s.ctx, tc.expOrder.GetMarketID(), tc.expOrder.GetExternalID())
+			s.Require().NoError(err, "GetOrderByExternalID after AmendOrder")
+			s.Assert().Equal(tc.expOrder, byExtID, "GetOrderByExternalID after AmendOrder")
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_SetOrderExternalID() {
 	tests := []struct {
 		name          string
@@ -3237,3 +3631,188 @@ func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket() {
 		})
 	}
 }
+
+func (s *TestSuite) TestKeeper_CancelExpiredOrders() {
+	blockTime := time.Unix(1_700_000_000, 500_000_000).UTC()
+	blockHeight := int64(100)
+	timeP := func(offset time.Duration) *time.Time {
+		rv := blockTime.Add(offset)
+		return &rv
+	}
+	cancelledBy := authtypes.NewModuleAddress(exchange.ModuleName).String()
+	assetDenom, priceDenom := "apple", "prune"
+	bidOrder := func(orderID uint64, goodTilTime *time.Time, goodTilBlockHeight uint64) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId:           1,
+			Buyer:              sdk.AccAddress(fmt.Sprintf("buyer%d_______________", orderID)[:20]).String(),
+			Assets:             sdk.Coin{Denom: assetDenom, Amount: sdkmath.NewInt(500 + int64(orderID))},
+			Price:              sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(1000 + int64(orderID))},
+			ExternalId:         fmt.Sprintf("order-%d", orderID),
+			GoodTilTime:        goodTilTime,
+			GoodTilBlockHeight: goodTilBlockHeight,
+		})
+	}
+	askOrder := func(orderID uint64, goodTilTime *time.Time, goodTilBlockHeight uint64) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId:           1,
+			Seller:             sdk.AccAddress(fmt.Sprintf("seller%d______________", orderID)[:20]).String(),
+			Assets:             sdk.Coin{Denom: assetDenom, Amount: sdkmath.NewInt(500 + int64(orderID))},
+			Price:              sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(1000 + int64(orderID))},
+			ExternalId:         fmt.Sprintf("order-%d", orderID),
+			GoodTilTime:        goodTilTime,
+			GoodTilBlockHeight: goodTilBlockHeight,
+		})
+	}
+
+	tests := []struct {
+		name       string
+		setup      func() (expKept []*exchange.Order, expDel []*exchange.Order)
+		holdKeeper *MockHoldKeeper
+		expLog     []string
+		expKept    []uint64
+	}{
+		{
+			name: "no orders in state",
+		},
+		{
+			name: "no orders with expirations",
+			setup: func() ([]*exchange.Order, []*exchange.Order) {
+				expKept := s.requireSetOrdersInStore(s.getStore(),
+					askOrder(1, nil, 0), bidOrder(2, nil, 0),
+				)
+				return expKept, nil
+			},
+		},
+		{
+			name: "no orders expired yet",
+			setup: func() ([]*exchange.Order, []*exchange.Order) {
+				expKept := s.requireSetOrdersInStore(s.getStore(),
+					askOrder(1, timeP(time.Second), 0),
+					bidOrder(2, nil, 101),
+					askOrder(3, timeP(time.Hour), 500),
+					bidOrder(4, timeP(-1*time.Nanosecond+time.Second), 0),
+				)
+				return expKept, nil
+			},
+		},
+		{
+			name: "some expired by time, some by height, some by both",
+			setup: func() ([]*exchange.Order, []*exchange.Order) {
+				store := s.getStore()
+				expKept := s.requireSetOrdersInStore(store,
+					askOrder(1, timeP(time.Second), 0),
+					bidOrder(2, nil, 101),
+					askOrder(3, nil, 0),
+					bidOrder(7, timeP(time.Nanosecond), 0),
+				)
+				expDel := s.requireSetOrdersInStore(store,
+					askOrder(4, timeP(0), 0),
+					bidOrder(5, timeP(-1*time.Hour), 0),
+					askOrder(6, nil, 100),
+					bidOrder(8, nil, 5),
+					askOrder(9, timeP(-1*time.Second), 99),
+					bidOrder(10, timeP(-1*time.Nanosecond), 500),
+				)
+				return expKept, expDel
+			},
+		},
+		{
+			name: "error releasing hold on one order",
+			setup: func() ([]*exchange.Order, []*exchange.Order) {
+				store := s.getStore()
+				expKept := s.requireSetOrdersInStore(store,
+					askOrder(1, timeP(time.Second), 0),
+				)
+				expDel := s.requireSetOrdersInStore(store,
+					askOrder(2, timeP(-1*time.Second), 0),
+					bidOrder(3, nil, 50),
+					askOrder(4, nil, 100),
+				)
+				return expKept, expDel
+			},
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("", "injected error for 3"),
+			expLog: []string{
+				"ERR 1 error(s) encountered canceling expired orders:",
+				"unable to release hold on order 3 funds: injected error for 3 module=x/exchange",
+			},
+			expKept: []uint64{3},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			var expOrdersLeft, expOrdersCancelled []*exchange.Order
+			if tc.setup != nil {
+				expOrdersLeft, expOrdersCancelled = tc.setup()
+			}
+			sort.Slice(expOrdersLeft, func(i, j int) bool {
+				return expOrdersLeft[i].OrderId < expOrdersLeft[j].OrderId
+			})
+			sort.Slice(expOrdersCancelled, func(i, j int) bool {
+				return expOrdersCancelled[i].OrderId < expOrdersCancelled[j].OrderId
+			})
+
+			expHoldCalls := HoldCalls{}
+			for _, order := range expOrdersCancelled {
+				addr, _ := sdk.AccAddressFromBech32(order.GetOwner())
+				expHoldCalls.ReleaseHold = append(expHoldCalls.ReleaseHold, NewReleaseHoldArgs(addr, order.GetHoldAmount()))
+			}
+			var expEvents sdk.Events
+			for _, order := range expOrdersCancelled {
+				if !slices.Contains(tc.expKept, order.OrderId) {
+					expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderExpired(order, cancelledBy)))
+				}
+			}
+			for _, orderID := range tc.expKept {
+				for i, order := range expOrdersCancelled {
+					if order.OrderId == orderID {
+						expOrdersLeft = append(expOrdersLeft, order)
+						expOrdersCancelled = append(expOrdersCancelled[:i], expOrdersCancelled[i+1:]...)
+						break
+					}
+				}
+			}
+			sort.Slice(expOrdersLeft, func(i, j int) bool {
+				return expOrdersLeft[i].OrderId < expOrdersLeft[j].OrderId
+			})
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime).WithBlockHeight(blockHeight)
+			s.logBuffer.Reset()
+			testFunc := func() {
+				kpr.CancelExpiredOrders(ctx)
+			}
+			s.Require().NotPanics(testFunc, "CancelExpiredOrders")
+
+			outputLog := s.getLogOutput("CancelExpiredOrders")
+			actLog := s.splitOutputLog(outputLog)
+			s.Assert().Equal(tc.expLog, actLog, "Lines logged during CancelExpiredOrders")
+
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "Events emitted during CancelExpiredOrders")
+
+			s.assertHoldKeeperCalls(tc.holdKeeper, expHoldCalls, "CancelExpiredOrders")
+
+			var ordersLeft []*exchange.Order
+			err := s.k.IterateOrders(s.ctx, func(order *exchange.Order) bool {
+				ordersLeft = append(ordersLeft, order)
+				return false
+			})
+			if s.Assert().NoError(err, "IterateOrders") {
+				s.assertEqualOrders(expOrdersLeft, ordersLeft, "orders left in state after CancelExpiredOrders")
+			}
+
+			store := s.getStore()
+			for _, order := range expOrdersCancelled {
+				for _, entry := range keeper.CreateConstantIndexEntries(*order) {
+					s.Assert().False(store.Has(entry.Key), "store.Has(%v) for cancelled order %d index entry", entry.Key, order.OrderId)
+				}
+			}
+		})
+	}
+}
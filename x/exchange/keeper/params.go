@@ -112,6 +112,25 @@ func getParamsFeeAcceptPaymentFlat(store storetypes.KVStore) []sdk.Coin {
 	return getParamsPaymentFlatFee(store, MakeKeyParamsFeeAcceptPaymentFlat())
 }
 
+// setParamsNavReferenceDenoms sets the params entry for the nav reference denoms.
+func setParamsNavReferenceDenoms(store storetypes.KVStore, denoms []string) {
+	key := MakeKeyParamsNavReferenceDenoms()
+	if len(denoms) == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, []byte(strings.Join(denoms, string(RecordSeparator))))
+}
+
+// getParamsNavReferenceDenoms gets the params entry for the nav reference denoms.
+func getParamsNavReferenceDenoms(store storetypes.KVStore) []string {
+	val := store.Get(MakeKeyParamsNavReferenceDenoms())
+	if len(val) == 0 {
+		return nil
+	}
+	return strings.Split(string(val), string(RecordSeparator))
+}
+
 // SetParams updates the params to match those provided.
 // If nil is provided, all params are deleted.
 func (k Keeper) SetParams(ctx sdk.Context, params *exchange.Params) {
@@ -119,6 +138,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params *exchange.Params) {
 
 	deleteAllParamsSplits(store)
 	var feeCreate, feeAccept []sdk.Coin
+	var navRefDenoms []string
 	if params != nil {
 		setParamsSplit(store, "", uint16(params.DefaultSplit)) //nolint:gosec // G115: Validated elsewhere to be 10,000 max.
 		for _, split := range params.DenomSplits {
@@ -126,10 +146,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params *exchange.Params) {
 		}
 		feeCreate = params.FeeCreatePaymentFlat
 		feeAccept = params.FeeAcceptPaymentFlat
+		navRefDenoms = params.NavReferenceDenoms
 	}
 
 	setParamsFeeCreatePaymentFlat(store, feeCreate)
 	setParamsFeeAcceptPaymentFlat(store, feeAccept)
+	setParamsNavReferenceDenoms(store, navRefDenoms)
 }
 
 // GetParams gets the exchange module params.
@@ -159,6 +181,13 @@ func (k Keeper) GetParams(ctx sdk.Context) *exchange.Params {
 		rv.FeeAcceptPaymentFlat = opts
 	}
 
+	if denoms := getParamsNavReferenceDenoms(store); len(denoms) > 0 {
+		if rv == nil {
+			rv = &exchange.Params{}
+		}
+		rv.NavReferenceDenoms = denoms
+	}
+
 	return rv
 }
 
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/exchange"
@@ -21,6 +23,10 @@ func (s *TestSuite) TestKeeper_SetParams() {
 		keyBz := keeper.MakeKeyParamsFeeCreatePaymentFlat()
 		return s.stateEntryString(keyBz, []byte(value))
 	}
+	expNavRefEntry := func(denoms ...string) string {
+		keyBz := keeper.MakeKeyParamsNavReferenceDenoms()
+		return s.stateEntryString(keyBz, []byte(strings.Join(denoms, string(keeper.RecordSeparator))))
+	}
 
 	tests := []struct {
 		name     string
@@ -107,6 +113,16 @@ func (s *TestSuite) TestKeeper_SetParams() {
 				expEntry("", 0),
 			},
 		},
+		{
+			name: "just nav reference denoms",
+			params: &exchange.Params{
+				NavReferenceDenoms: []string{"nhash", "usd.trading"},
+			},
+			expState: []string{
+				expNavRefEntry("nhash", "usd.trading"),
+				expEntry("", 0),
+			},
+		},
 		{
 			name: "one split",
 			params: &exchange.Params{
@@ -151,6 +167,7 @@ func (s *TestSuite) TestKeeper_GetParams() {
 		splits            []exchange.DenomSplit
 		createPaymentFlat []sdk.Coin
 		acceptPaymentFlat []sdk.Coin
+		navRefDenoms      []string
 		exp               *exchange.Params
 	}{
 		{
@@ -177,6 +194,11 @@ func (s *TestSuite) TestKeeper_GetParams() {
 			acceptPaymentFlat: coins("57apple"),
 			exp:               &exchange.Params{FeeAcceptPaymentFlat: coins("57apple")},
 		},
+		{
+			name:         "just nav reference denoms",
+			navRefDenoms: []string{"nhash", "usd.trading"},
+			exp:          &exchange.Params{NavReferenceDenoms: []string{"nhash", "usd.trading"}},
+		},
 		{
 			name: "a little of everything",
 			splits: []exchange.DenomSplit{
@@ -187,6 +209,7 @@ func (s *TestSuite) TestKeeper_GetParams() {
 			},
 			createPaymentFlat: coins("72cactus"),
 			acceptPaymentFlat: coins("21apricot"),
+			navRefDenoms:      []string{"nhash"},
 			exp: &exchange.Params{
 				DefaultSplit: 432,
 				DenomSplits: []exchange.DenomSplit{
@@ -196,6 +219,7 @@ func (s *TestSuite) TestKeeper_GetParams() {
 				},
 				FeeCreatePaymentFlat: coins("72cactus"),
 				FeeAcceptPaymentFlat: coins("21apricot"),
+				NavReferenceDenoms:   []string{"nhash"},
 			},
 		},
 	}
@@ -209,6 +233,7 @@ func (s *TestSuite) TestKeeper_GetParams() {
 			}
 			keeper.SetParamsFeeCreatePaymentFlat(store, tc.createPaymentFlat)
 			keeper.SetParamsFeeAcceptPaymentFlat(store, tc.acceptPaymentFlat)
+			keeper.SetParamsNavReferenceDenoms(store, tc.navRefDenoms)
 
 			var actual *exchange.Params
 			testFunc := func() {
@@ -177,7 +177,7 @@ func (k Keeper) deletePaymentAndReleaseHold(ctx sdk.Context, store storetypes.KV
 	}
 
 	source, _ := sdk.AccAddressFromBech32(payment.Source)
-	err = k.holdKeeper.ReleaseHold(ctx, source, payment.SourceAmount)
+	err = k.updateHold(ctx, MakeKeyPayment(source, payment.ExternalId), source, payment.SourceAmount, nil, paymentHoldReason(payment.ExternalId))
 	if err != nil {
 		return fmt.Errorf("error releasing hold on payment source: %w", err)
 	}
@@ -215,10 +215,12 @@ func (k Keeper) CreatePayment(ctx sdk.Context, payment *exchange.Payment) error
 		return fmt.Errorf("failed to create payment: %w", err)
 	}
 
-	source, _ := sdk.AccAddressFromBech32(payment.Source)
-	err = k.holdKeeper.AddHold(ctx, source, payment.SourceAmount, fmt.Sprintf("x/exchange: payment %q", payment.ExternalId))
-	if err != nil {
-		return fmt.Errorf("error placing hold on payment source: %w", err)
+	if !payment.SourceAmount.IsZero() {
+		source, _ := sdk.AccAddressFromBech32(payment.Source)
+		err = k.updateHold(ctx, MakeKeyPayment(source, payment.ExternalId), source, nil, payment.SourceAmount, paymentHoldReason(payment.ExternalId))
+		if err != nil {
+			return fmt.Errorf("error placing hold on payment source: %w", err)
+		}
 	}
 
 	k.emitEvent(ctx, exchange.NewEventPaymentCreated(payment))
@@ -290,7 +290,7 @@ func (s *TestSuite) TestKeeper_CreatePayment() {
 			payment:    s.newTestPayment(s.longAddr2, "", nil, "3tomato", "soon"),
 			expStored:  true,
 			expIndex:   false,
-			expAddHold: true,
+			expAddHold: false,
 			expEvent:   true,
 		},
 	}
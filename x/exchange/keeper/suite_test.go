@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
@@ -309,6 +310,15 @@ func (s *TestSuite) copyStrings(orig []string) []string {
 	return copySlice(orig, noOpCopier[string])
 }
 
+// copyTimeP creates a copy of a time pointer.
+func (s *TestSuite) copyTimeP(orig *time.Time) *time.Time {
+	if orig == nil {
+		return nil
+	}
+	rv := *orig
+	return &rv
+}
+
 // copyMarket creates a deep copy of a market.
 func (s *TestSuite) copyMarket(orig exchange.Market) exchange.Market {
 	return exchange.Market{
@@ -335,6 +345,7 @@ func (s *TestSuite) copyMarket(orig exchange.Market) exchange.Market {
 		CommitmentSettlementBips:  orig.CommitmentSettlementBips,
 		IntermediaryDenom:         orig.IntermediaryDenom,
 		ReqAttrCreateCommitment:   s.copyStrings(orig.ReqAttrCreateCommitment),
+		AutoMatch:                 orig.AutoMatch,
 	}
 }
 
@@ -375,6 +386,8 @@ func (s *TestSuite) copyAskOrder(orig *exchange.AskOrder) *exchange.AskOrder {
 		SellerSettlementFlatFee: s.copyCoinP(orig.SellerSettlementFlatFee),
 		AllowPartial:            orig.AllowPartial,
 		ExternalId:              orig.ExternalId,
+		GoodTilTime:             s.copyTimeP(orig.GoodTilTime),
+		GoodTilBlockHeight:      orig.GoodTilBlockHeight,
 	}
 }
 
@@ -391,6 +404,8 @@ func (s *TestSuite) copyBidOrder(orig *exchange.BidOrder) *exchange.BidOrder {
 		BuyerSettlementFees: s.copyCoins(orig.BuyerSettlementFees),
 		AllowPartial:        orig.AllowPartial,
 		ExternalId:          orig.ExternalId,
+		GoodTilTime:         s.copyTimeP(orig.GoodTilTime),
+		GoodTilBlockHeight:  orig.GoodTilBlockHeight,
 	}
 }
 
@@ -54,6 +54,7 @@ func (m Market) Validate() error {
 		ValidateBips("commitment settlement", m.CommitmentSettlementBips),
 		ValidateIntermediaryDenom(m.IntermediaryDenom),
 		ValidateReqAttrs("create-commitment", m.ReqAttrCreateCommitment),
+		// Nothing to check for the AutoMatch boolean.
 	)
 }
 
@@ -337,6 +337,10 @@ type Market struct {
 	// An entry that starts with "*." will match any attributes that end with the rest of it.
 	// E.g. "*.b.a" will match all of "c.b.a", "x.b.a", and "e.d.c.b.a"; but not "b.a", "xb.a", "c.b.x.a", or "c.b.a.x".
 	ReqAttrCreateCommitment []string `protobuf:"bytes,18,rep,name=req_attr_create_commitment,json=reqAttrCreateCommitment,proto3" json:"req_attr_create_commitment,omitempty"`
+	// auto_match is whether this market's crossing orders should be matched and settled automatically.
+	// When true, at the end of each block, asks and bids with the same assets and price denoms are paired
+	// using price-time priority (best price first, then lowest order id) and settled.
+	AutoMatch bool `protobuf:"varint,19,opt,name=auto_match,json=autoMatch,proto3" json:"auto_match,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -498,6 +502,13 @@ func (m *Market) GetReqAttrCreateCommitment() []string {
 	return nil
 }
 
+func (m *Market) GetAutoMatch() bool {
+	if m != nil {
+		return m.AutoMatch
+	}
+	return false
+}
+
 // FeeRatio defines a ratio of price amount to fee amount.
 // For an order to be valid, its price must be evenly divisible by a FeeRatio's price.
 type FeeRatio struct {
@@ -623,78 +634,79 @@ func init() {
 }
 
 var fileDescriptor_d5cf198f1dd7e167 = []byte{
-	// 1127 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6b, 0x1b, 0xc7,
-	0x1b, 0xd6, 0x5a, 0x8a, 0x2d, 0x8d, 0x6c, 0x67, 0x33, 0xfe, 0x93, 0xb5, 0xf2, 0x43, 0xda, 0x9f,
-	0x4c, 0x40, 0x69, 0xb1, 0x84, 0x1d, 0x7a, 0x71, 0x0b, 0x45, 0xff, 0xdc, 0x0a, 0x12, 0xc7, 0xac,
-	0x24, 0x02, 0xa1, 0xb0, 0x8c, 0x76, 0x5f, 0xc9, 0x83, 0xa5, 0x5d, 0x65, 0x66, 0x64, 0x27, 0xfd,
-	0x02, 0x2d, 0x3e, 0xf5, 0xd8, 0x8b, 0xc1, 0x1f, 0xa2, 0xf7, 0xde, 0x4a, 0x8e, 0xa6, 0x50, 0xe8,
-	0x29, 0x14, 0xfb, 0xd2, 0x4b, 0xbf, 0x43, 0xd9, 0xd9, 0x95, 0x76, 0xad, 0xc8, 0xb5, 0x43, 0xe9,
-	0x6d, 0xe7, 0x7d, 0x9e, 0x79, 0xde, 0xf7, 0x79, 0xe7, 0x65, 0x66, 0xd1, 0xe6, 0x90, 0xb9, 0xc7,
-	0xe0, 0x10, 0xc7, 0x82, 0x12, 0xbc, 0xb1, 0x0e, 0x89, 0xd3, 0x83, 0xd2, 0xf1, 0x76, 0x69, 0x40,
-	0xd8, 0x11, 0x88, 0xe2, 0x90, 0xb9, 0xc2, 0xc5, 0xeb, 0x21, 0xa9, 0x38, 0x26, 0x15, 0x8f, 0xb7,
-	0x33, 0x59, 0xcb, 0xe5, 0x03, 0x97, 0x97, 0xc8, 0x48, 0x1c, 0x96, 0x8e, 0xb7, 0x3b, 0x20, 0xc8,
-	0xb6, 0x5c, 0xf8, 0xfb, 0x26, 0x78, 0x87, 0x70, 0x98, 0xe0, 0x96, 0x4b, 0x9d, 0x00, 0xdf, 0xf0,
-	0x71, 0x53, 0xae, 0x4a, 0xfe, 0x22, 0x80, 0x56, 0x7b, 0x6e, 0xcf, 0xf5, 0xe3, 0xde, 0x97, 0x1f,
-	0xcd, 0xff, 0xa6, 0xa0, 0xa5, 0xe7, 0xb2, 0xb2, 0xb2, 0x65, 0xb9, 0x23, 0x47, 0xe0, 0x06, 0x5a,
-	0xf4, 0xd4, 0x4d, 0xe2, 0xaf, 0x35, 0x45, 0x57, 0x0a, 0xe9, 0x1d, 0xbd, 0x18, 0x88, 0xc9, 0x62,
-	0x82, 0xcc, 0xc5, 0x0a, 0xe1, 0x10, 0xec, 0xab, 0x24, 0x2e, 0xde, 0xe7, 0x14, 0x23, 0xdd, 0x09,
-	0x43, 0xf8, 0x11, 0x4a, 0xf9, 0xae, 0x4d, 0x6a, 0x6b, 0x73, 0xba, 0x52, 0x58, 0x32, 0x92, 0x7e,
-	0xa0, 0x61, 0x63, 0x03, 0x2d, 0x07, 0xa0, 0x0d, 0x82, 0xd0, 0x3e, 0xd7, 0xe2, 0x32, 0xd3, 0xe3,
-	0xe2, 0xec, 0xde, 0x14, 0xfd, 0x32, 0x6b, 0x3e, 0xb9, 0x92, 0x78, 0xf7, 0x3e, 0x17, 0x33, 0x96,
-	0x06, 0xd1, 0xe0, 0x6e, 0xf2, 0xfb, 0xf3, 0x5c, 0xec, 0xc7, 0xf3, 0x5c, 0x2c, 0xff, 0xdd, 0xc4,
-	0x57, 0x80, 0x61, 0x8c, 0x12, 0x0e, 0x19, 0x80, 0xf4, 0x93, 0x32, 0xe4, 0x37, 0xd6, 0x51, 0xda,
-	0x06, 0x6e, 0x31, 0x3a, 0x14, 0xd4, 0x75, 0x64, 0x89, 0x29, 0x23, 0x1a, 0xc2, 0x39, 0x94, 0x3e,
-	0x81, 0x0e, 0xa7, 0x02, 0xcc, 0x11, 0xeb, 0xcb, 0x12, 0x53, 0x06, 0x0a, 0x42, 0x6d, 0xd6, 0xc7,
-	0x1b, 0x28, 0x49, 0x2d, 0xd7, 0x31, 0x47, 0x8c, 0x6a, 0x09, 0x89, 0x2e, 0x78, 0xeb, 0x36, 0xa3,
-	0xbb, 0x89, 0x3f, 0xcf, 0x73, 0x4a, 0xfe, 0x67, 0x05, 0xa5, 0xfd, 0x4a, 0x2a, 0x8c, 0x42, 0xf7,
-	0x7a, 0x53, 0x94, 0xa9, 0xa6, 0x7c, 0x39, 0x69, 0x0a, 0xb1, 0x6d, 0x06, 0x9c, 0xfb, 0x35, 0x55,
-	0xb4, 0x5f, 0x7f, 0xda, 0x5a, 0x0d, 0x4e, 0xa0, 0xec, 0x23, 0x4d, 0xc1, 0xa8, 0xd3, 0x1b, 0x77,
-	0x20, 0x08, 0xfe, 0x17, 0x5d, 0xcd, 0xff, 0x95, 0x42, 0xf3, 0x3e, 0xed, 0x9f, 0x8b, 0xff, 0x30,
-	0xf7, 0xdc, 0xbf, 0xcd, 0x8d, 0xf7, 0xd1, 0x4a, 0x17, 0xc0, 0xb4, 0x18, 0x10, 0x01, 0x26, 0xe1,
-	0x47, 0x66, 0xb7, 0x4f, 0x84, 0x16, 0xd7, 0xe3, 0x85, 0xf4, 0xce, 0xc6, 0x78, 0x28, 0xbd, 0xa1,
-	0x9b, 0x0c, 0x65, 0xd5, 0xa5, 0x4e, 0x20, 0xa6, 0x76, 0x01, 0xaa, 0x72, 0x6b, 0x99, 0x1f, 0xed,
-	0xf5, 0x89, 0x98, 0xd2, 0xeb, 0x50, 0xdb, 0xd7, 0x4b, 0x7c, 0xac, 0x5e, 0x85, 0xda, 0x52, 0xef,
-	0x1b, 0x94, 0xf1, 0xf4, 0x38, 0xf4, 0xfb, 0xc0, 0x4c, 0x0e, 0x42, 0xf4, 0x61, 0x00, 0x8e, 0xf0,
-	0x65, 0xef, 0xdd, 0x4d, 0xf6, 0x61, 0x17, 0xa0, 0x29, 0x15, 0x9a, 0x13, 0x01, 0xa9, 0xde, 0x43,
-	0xff, 0x9b, 0xad, 0xce, 0x88, 0xa0, 0x2e, 0xd7, 0xe6, 0xa5, 0xbe, 0x7e, 0x53, 0x7f, 0xf7, 0x00,
-	0x0c, 0x8f, 0x18, 0xa4, 0xd9, 0x98, 0x91, 0x46, 0xe2, 0x1c, 0xbf, 0x42, 0x1e, 0x68, 0x76, 0x46,
-	0x6f, 0x67, 0xb8, 0x58, 0xb8, 0x9b, 0x8b, 0xf5, 0x2e, 0x40, 0xc5, 0x13, 0x98, 0x32, 0x01, 0xe8,
-	0xd1, 0x4c, 0xed, 0xc0, 0x43, 0xf2, 0xa3, 0x3c, 0x68, 0x1f, 0x26, 0x09, 0x2c, 0x3c, 0x41, 0x2a,
-	0xb1, 0x2c, 0x18, 0x0a, 0xea, 0xf4, 0x4c, 0x97, 0xd9, 0xc0, 0xb8, 0x96, 0xd2, 0x95, 0x42, 0xd2,
-	0xb8, 0x3f, 0x89, 0xbf, 0x90, 0x61, 0xbc, 0x83, 0xd6, 0x48, 0xbf, 0xef, 0x9e, 0x98, 0x23, 0x7e,
-	0xad, 0x24, 0x0d, 0x49, 0xfe, 0x8a, 0x04, 0xdb, 0x3c, 0x9a, 0x04, 0xef, 0xa3, 0x25, 0x4f, 0x86,
-	0x73, 0xb3, 0xc7, 0x88, 0x23, 0xb8, 0x96, 0x96, 0x75, 0x6f, 0xde, 0x54, 0x77, 0x59, 0x92, 0xbf,
-	0xf2, 0xb8, 0x41, 0xe9, 0x8b, 0x24, 0x0c, 0x71, 0xbc, 0x85, 0x56, 0x18, 0xbc, 0x36, 0x89, 0x10,
-	0x2c, 0x32, 0xdd, 0xda, 0xa2, 0x1e, 0x2f, 0xa4, 0x0c, 0x95, 0xc1, 0xeb, 0xb2, 0x10, 0x6c, 0x32,
-	0xbb, 0xb3, 0xe8, 0x1d, 0x6a, 0x6b, 0x4b, 0x33, 0xe8, 0x15, 0x6a, 0xe3, 0xa7, 0x68, 0x2d, 0x6c,
-	0x86, 0xe5, 0x0e, 0x06, 0x54, 0x78, 0x2e, 0xb8, 0xb6, 0x2c, 0x1d, 0xae, 0x4e, 0xc0, 0x6a, 0x88,
-	0x8d, 0x67, 0x39, 0x90, 0x0f, 0x77, 0xf9, 0x53, 0x70, 0xff, 0xee, 0xb3, 0xec, 0xd7, 0x11, 0x4a,
-	0xcb, 0x31, 0xf8, 0x02, 0x65, 0x22, 0x92, 0x91, 0x39, 0xe8, 0xd0, 0x21, 0xd7, 0x54, 0x79, 0x97,
-	0x68, 0x21, 0x23, 0x6c, 0x7d, 0x85, 0x0e, 0xbd, 0x76, 0x61, 0xea, 0x08, 0x60, 0x03, 0xb0, 0x29,
-	0x61, 0x6f, 0x4d, 0x1b, 0x1c, 0x77, 0xa0, 0x3d, 0x90, 0x17, 0xee, 0x83, 0x28, 0x52, 0xf3, 0x00,
-	0xfc, 0x39, 0xca, 0x4c, 0xb7, 0x2b, 0x94, 0xd6, 0xb0, 0xec, 0xda, 0xc3, 0x6b, 0x5d, 0x0b, 0xab,
-	0xcd, 0x7f, 0x8b, 0x92, 0xe3, 0xa9, 0xc3, 0x9f, 0xa1, 0x7b, 0x43, 0x46, 0x2d, 0x08, 0x9e, 0xc1,
-	0x5b, 0xed, 0xfb, 0x6c, 0xbc, 0x8d, 0xe2, 0x5d, 0x80, 0xe0, 0xfe, 0xbb, 0x75, 0x93, 0xc7, 0xdd,
-	0x4d, 0x8c, 0xdf, 0xad, 0x74, 0x64, 0x74, 0xf0, 0x0e, 0x5a, 0x18, 0xbf, 0x04, 0xca, 0x2d, 0x2f,
-	0xc1, 0x98, 0x88, 0x6b, 0x28, 0x3d, 0x04, 0x36, 0xa0, 0x9c, 0x53, 0xd7, 0xf1, 0x2e, 0xe1, 0x78,
-	0x61, 0x79, 0x27, 0x7f, 0xd3, 0xa0, 0x1e, 0x4c, 0xa8, 0x46, 0x74, 0xdb, 0x27, 0xbf, 0xcc, 0x21,
-	0x14, 0x62, 0xf8, 0x53, 0xb4, 0x7e, 0x50, 0x37, 0x9e, 0x37, 0x9a, 0xcd, 0xc6, 0x8b, 0x7d, 0xb3,
-	0xbd, 0xdf, 0x3c, 0xa8, 0x57, 0x1b, 0x7b, 0x8d, 0x7a, 0x4d, 0x8d, 0x65, 0xee, 0x9f, 0x9e, 0xe9,
-	0xe9, 0x91, 0xc3, 0x87, 0x60, 0xd1, 0x2e, 0x05, 0x1b, 0xff, 0x1f, 0x3d, 0x88, 0x90, 0x9b, 0xf5,
-	0x56, 0xeb, 0x59, 0x5d, 0x55, 0x32, 0xe8, 0xf4, 0x4c, 0x9f, 0xf7, 0x4f, 0x1e, 0x6f, 0x22, 0x7c,
-	0x9d, 0x62, 0x36, 0x6a, 0x4d, 0x75, 0x2e, 0x93, 0x3e, 0x3d, 0xd3, 0x17, 0xb8, 0x7c, 0x60, 0xf8,
-	0x94, 0x4e, 0xb5, 0xbc, 0x5f, 0xad, 0x3f, 0x53, 0xe3, 0xbe, 0x8e, 0xe5, 0x39, 0xe9, 0xe3, 0xc7,
-	0x68, 0x25, 0x42, 0x79, 0xd9, 0x68, 0x7d, 0x5d, 0x33, 0xca, 0x2f, 0xd5, 0x44, 0x66, 0xf1, 0xf4,
-	0x4c, 0x4f, 0x9e, 0x50, 0x71, 0x68, 0x33, 0x72, 0x32, 0xa5, 0xd4, 0x3e, 0xa8, 0x95, 0x5b, 0x75,
-	0xf5, 0x9e, 0xaf, 0x34, 0x1a, 0xda, 0x44, 0xc0, 0x94, 0xc3, 0xf0, 0xb3, 0xa9, 0xce, 0xfb, 0x0e,
-	0x23, 0xdd, 0xc1, 0x4f, 0xd0, 0x5a, 0x84, 0x5c, 0x6e, 0xb5, 0x8c, 0x46, 0xa5, 0xdd, 0xaa, 0x37,
-	0xd5, 0x85, 0xcc, 0xf2, 0xe9, 0x99, 0x8e, 0xbc, 0xc9, 0xa3, 0x9d, 0x91, 0x00, 0x5e, 0x81, 0x77,
-	0x97, 0x59, 0xe5, 0xe2, 0x32, 0xab, 0xfc, 0x71, 0x99, 0x55, 0x7e, 0xb8, 0xca, 0xc6, 0x2e, 0xae,
-	0xb2, 0xb1, 0xdf, 0xaf, 0xb2, 0x31, 0xb4, 0x41, 0xdd, 0x1b, 0x4e, 0xe5, 0x40, 0x79, 0x55, 0xec,
-	0x51, 0x71, 0x38, 0xea, 0x14, 0x2d, 0x77, 0x50, 0x0a, 0x49, 0x5b, 0xd4, 0x8d, 0xac, 0x4a, 0x6f,
-	0x26, 0xbf, 0x98, 0x9d, 0x79, 0xf9, 0x43, 0xf7, 0xf4, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5,
-	0x10, 0x90, 0x0d, 0x80, 0x0a, 0x00, 0x00,
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6b, 0x1b, 0x47,
+	0x18, 0xd5, 0x5a, 0x8a, 0x2d, 0x8d, 0x6c, 0x67, 0x33, 0xce, 0x8f, 0xb5, 0xd2, 0x4a, 0x5b, 0x85,
+	0x80, 0xd2, 0x12, 0x09, 0x3b, 0xf4, 0x92, 0x16, 0x8a, 0x7e, 0xa5, 0x15, 0x24, 0x8e, 0x59, 0x49,
+	0x04, 0x42, 0x61, 0x19, 0xed, 0x7e, 0x92, 0x87, 0x68, 0x77, 0x95, 0x99, 0x59, 0x3b, 0xe9, 0x3f,
+	0xd0, 0x62, 0x7a, 0xe8, 0xb1, 0x17, 0x83, 0xff, 0x88, 0xde, 0x7b, 0x2b, 0x39, 0x9a, 0x42, 0xa1,
+	0xa7, 0x50, 0xec, 0x4b, 0xff, 0x8c, 0xb2, 0xb3, 0x2b, 0xed, 0x5a, 0x91, 0x1b, 0x87, 0xd2, 0xdb,
+	0xce, 0xf7, 0xde, 0xbc, 0xf9, 0xbe, 0xb7, 0x8f, 0x9d, 0x45, 0x77, 0x26, 0xcc, 0xdb, 0x07, 0x97,
+	0xb8, 0x16, 0xd4, 0xe0, 0x95, 0xb5, 0x47, 0xdc, 0x11, 0xd4, 0xf6, 0xb7, 0x6a, 0x0e, 0x61, 0x2f,
+	0x40, 0x54, 0x27, 0xcc, 0x13, 0x1e, 0xbe, 0x19, 0x93, 0xaa, 0x53, 0x52, 0x75, 0x7f, 0xab, 0x50,
+	0xb4, 0x3c, 0xee, 0x78, 0xbc, 0x46, 0x7c, 0xb1, 0x57, 0xdb, 0xdf, 0x1a, 0x80, 0x20, 0x5b, 0x72,
+	0x11, 0xee, 0x9b, 0xe1, 0x03, 0xc2, 0x61, 0x86, 0x5b, 0x1e, 0x75, 0x23, 0x7c, 0x33, 0xc4, 0x4d,
+	0xb9, 0xaa, 0x85, 0x8b, 0x08, 0xba, 0x3e, 0xf2, 0x46, 0x5e, 0x58, 0x0f, 0x9e, 0xc2, 0x6a, 0xf9,
+	0x0f, 0x05, 0xad, 0x3d, 0x91, 0x9d, 0xd5, 0x2d, 0xcb, 0xf3, 0x5d, 0x81, 0x3b, 0x68, 0x35, 0x50,
+	0x37, 0x49, 0xb8, 0xd6, 0x14, 0x5d, 0xa9, 0xe4, 0xb7, 0xf5, 0x6a, 0x24, 0x26, 0x9b, 0x89, 0x4e,
+	0xae, 0x36, 0x08, 0x87, 0x68, 0x5f, 0x23, 0x73, 0xf2, 0xb6, 0xa4, 0x18, 0xf9, 0x41, 0x5c, 0xc2,
+	0xb7, 0x51, 0x2e, 0x9c, 0xda, 0xa4, 0xb6, 0xb6, 0xa4, 0x2b, 0x95, 0x35, 0x23, 0x1b, 0x16, 0x3a,
+	0x36, 0x36, 0xd0, 0x7a, 0x04, 0xda, 0x20, 0x08, 0x1d, 0x73, 0x2d, 0x2d, 0x4f, 0xba, 0x5b, 0x5d,
+	0xec, 0x4d, 0x35, 0x6c, 0xb3, 0x15, 0x92, 0x1b, 0x99, 0x37, 0x6f, 0x4b, 0x29, 0x63, 0xcd, 0x49,
+	0x16, 0x1f, 0x66, 0x7f, 0x38, 0x2e, 0xa5, 0x7e, 0x3e, 0x2e, 0xa5, 0xca, 0xdf, 0xcf, 0xe6, 0x8a,
+	0x30, 0x8c, 0x51, 0xc6, 0x25, 0x0e, 0xc8, 0x79, 0x72, 0x86, 0x7c, 0xc6, 0x3a, 0xca, 0xdb, 0xc0,
+	0x2d, 0x46, 0x27, 0x82, 0x7a, 0xae, 0x6c, 0x31, 0x67, 0x24, 0x4b, 0xb8, 0x84, 0xf2, 0x07, 0x30,
+	0xe0, 0x54, 0x80, 0xe9, 0xb3, 0xb1, 0x6c, 0x31, 0x67, 0xa0, 0xa8, 0xd4, 0x67, 0x63, 0xbc, 0x89,
+	0xb2, 0xd4, 0xf2, 0x5c, 0xd3, 0x67, 0x54, 0xcb, 0x48, 0x74, 0x25, 0x58, 0xf7, 0x19, 0x7d, 0x98,
+	0xf9, 0xfb, 0xb8, 0xa4, 0x94, 0x7f, 0x55, 0x50, 0x3e, 0xec, 0xa4, 0xc1, 0x28, 0x0c, 0xcf, 0x9b,
+	0xa2, 0xcc, 0x99, 0xf2, 0xd5, 0xcc, 0x14, 0x62, 0xdb, 0x0c, 0x38, 0x0f, 0x7b, 0x6a, 0x68, 0xbf,
+	0xff, 0x72, 0xff, 0x7a, 0xf4, 0x06, 0xea, 0x21, 0xd2, 0x15, 0x8c, 0xba, 0xa3, 0xa9, 0x03, 0x51,
+	0xf1, 0xff, 0x70, 0xb5, 0xfc, 0x23, 0x42, 0xcb, 0x21, 0xed, 0xdf, 0x9b, 0x7f, 0xf7, 0xec, 0xa5,
+	0xff, 0x7a, 0x36, 0xde, 0x41, 0x1b, 0x43, 0x00, 0xd3, 0x62, 0x40, 0x04, 0x98, 0x84, 0xbf, 0x30,
+	0x87, 0x63, 0x22, 0xb4, 0xb4, 0x9e, 0xae, 0xe4, 0xb7, 0x37, 0xa7, 0xa1, 0x0c, 0x42, 0x37, 0x0b,
+	0x65, 0xd3, 0xa3, 0x6e, 0x24, 0xa6, 0x0e, 0x01, 0x9a, 0x72, 0x6b, 0x9d, 0xbf, 0x78, 0x34, 0x26,
+	0x62, 0x4e, 0x6f, 0x40, 0xed, 0x50, 0x2f, 0xf3, 0xa1, 0x7a, 0x0d, 0x6a, 0x4b, 0xbd, 0x6f, 0x51,
+	0x21, 0xd0, 0xe3, 0x30, 0x1e, 0x03, 0x33, 0x39, 0x08, 0x31, 0x06, 0x07, 0x5c, 0x11, 0xca, 0x5e,
+	0xb9, 0x9c, 0xec, 0xad, 0x21, 0x40, 0x57, 0x2a, 0x74, 0x67, 0x02, 0x52, 0x7d, 0x84, 0x3e, 0x5a,
+	0xac, 0xce, 0x88, 0xa0, 0x1e, 0xd7, 0x96, 0xa5, 0xbe, 0x7e, 0x91, 0xbf, 0x8f, 0x00, 0x8c, 0x80,
+	0x18, 0x1d, 0xb3, 0xb9, 0xe0, 0x18, 0x89, 0x73, 0xfc, 0x1c, 0x05, 0xa0, 0x39, 0xf0, 0x5f, 0x2f,
+	0x98, 0x62, 0xe5, 0x72, 0x53, 0xdc, 0x1c, 0x02, 0x34, 0xfc, 0xd7, 0x49, 0x75, 0x39, 0x04, 0xa0,
+	0xdb, 0x0b, 0xb5, 0xa3, 0x19, 0xb2, 0x1f, 0x34, 0x83, 0xf6, 0xee, 0x21, 0xd1, 0x08, 0xf7, 0x90,
+	0x4a, 0x2c, 0x0b, 0x26, 0x82, 0xba, 0x23, 0xd3, 0x63, 0x36, 0x30, 0xae, 0xe5, 0x74, 0xa5, 0x92,
+	0x35, 0xae, 0xce, 0xea, 0x4f, 0x65, 0x19, 0x6f, 0xa3, 0x1b, 0x64, 0x3c, 0xf6, 0x0e, 0x4c, 0x9f,
+	0x9f, 0x6b, 0x49, 0x43, 0x92, 0xbf, 0x21, 0xc1, 0x3e, 0x4f, 0x1e, 0x82, 0x77, 0xd0, 0x5a, 0x20,
+	0xc3, 0xb9, 0x39, 0x62, 0xc4, 0x15, 0x5c, 0xcb, 0xcb, 0xbe, 0xef, 0x5c, 0xd4, 0x77, 0x5d, 0x92,
+	0xbf, 0x0e, 0xb8, 0x51, 0xeb, 0xab, 0x24, 0x2e, 0x71, 0x7c, 0x1f, 0x6d, 0x30, 0x78, 0x69, 0x12,
+	0x21, 0x58, 0x22, 0xdd, 0xda, 0xaa, 0x9e, 0xae, 0xe4, 0x0c, 0x95, 0xc1, 0xcb, 0xba, 0x10, 0x6c,
+	0x96, 0xdd, 0x45, 0xf4, 0x01, 0xb5, 0xb5, 0xb5, 0x05, 0xf4, 0x06, 0xb5, 0xf1, 0x03, 0x74, 0x23,
+	0x36, 0xc3, 0xf2, 0x1c, 0x87, 0x8a, 0x60, 0x0a, 0xae, 0xad, 0xcb, 0x09, 0xaf, 0xcf, 0xc0, 0x66,
+	0x8c, 0x4d, 0xb3, 0x1c, 0xc9, 0xc7, 0xbb, 0xc2, 0x14, 0x5c, 0xbd, 0x7c, 0x96, 0xc3, 0x3e, 0x62,
+	0x69, 0x19, 0x83, 0x2f, 0x51, 0x21, 0x21, 0x99, 0xc8, 0xc1, 0x80, 0x4e, 0xb8, 0xa6, 0xca, 0x6f,
+	0x89, 0x16, 0x33, 0x62, 0xeb, 0x1b, 0x74, 0x12, 0xd8, 0x85, 0xa9, 0x2b, 0x80, 0x39, 0x60, 0x53,
+	0xc2, 0x5e, 0x9b, 0x36, 0xb8, 0x9e, 0xa3, 0x5d, 0x93, 0x1f, 0xdc, 0x6b, 0x49, 0xa4, 0x15, 0x00,
+	0xf8, 0x0b, 0x54, 0x98, 0xb7, 0x2b, 0x96, 0xd6, 0xb0, 0x74, 0xed, 0xd6, 0x39, 0xd7, 0xe2, 0x6e,
+	0xf1, 0xc7, 0x08, 0x11, 0x5f, 0x78, 0xa6, 0x43, 0x84, 0xb5, 0xa7, 0x6d, 0x48, 0xc7, 0x72, 0x41,
+	0xe5, 0x49, 0x50, 0x28, 0x7f, 0x87, 0xb2, 0xd3, 0x50, 0xe2, 0xcf, 0xd1, 0x95, 0x09, 0xa3, 0x16,
+	0x44, 0xb7, 0xe4, 0x7b, 0xdd, 0x09, 0xd9, 0x78, 0x0b, 0xa5, 0x87, 0x00, 0xda, 0xd2, 0xe5, 0x36,
+	0x05, 0xdc, 0x87, 0x99, 0xe9, 0xb5, 0x96, 0x4f, 0x24, 0x0b, 0x6f, 0xa3, 0x95, 0xe9, 0x45, 0xa1,
+	0xbc, 0xe7, 0xa2, 0x98, 0x12, 0x71, 0x0b, 0xe5, 0x27, 0xc0, 0x1c, 0xca, 0x39, 0xf5, 0xdc, 0xe0,
+	0x1b, 0x9d, 0xae, 0xac, 0x6f, 0x97, 0x2f, 0xca, 0xf1, 0xee, 0x8c, 0x6a, 0x24, 0xb7, 0x7d, 0xfa,
+	0xdb, 0x12, 0x42, 0x31, 0x86, 0x3f, 0x43, 0x37, 0x77, 0xdb, 0xc6, 0x93, 0x4e, 0xb7, 0xdb, 0x79,
+	0xba, 0x63, 0xf6, 0x77, 0xba, 0xbb, 0xed, 0x66, 0xe7, 0x51, 0xa7, 0xdd, 0x52, 0x53, 0x85, 0xab,
+	0x87, 0x47, 0x7a, 0xde, 0x77, 0xf9, 0x04, 0x2c, 0x3a, 0xa4, 0x60, 0xe3, 0x4f, 0xd0, 0xb5, 0x04,
+	0xb9, 0xdb, 0xee, 0xf5, 0x1e, 0xb7, 0x55, 0xa5, 0x80, 0x0e, 0x8f, 0xf4, 0xe5, 0x30, 0x18, 0xf8,
+	0x0e, 0xc2, 0xe7, 0x29, 0x66, 0xa7, 0xd5, 0x55, 0x97, 0x0a, 0xf9, 0xc3, 0x23, 0x7d, 0x85, 0xcb,
+	0xfb, 0x87, 0xcf, 0xe9, 0x34, 0xeb, 0x3b, 0xcd, 0xf6, 0x63, 0x35, 0x1d, 0xea, 0x58, 0xc1, 0x24,
+	0x63, 0x7c, 0x17, 0x6d, 0x24, 0x28, 0xcf, 0x3a, 0xbd, 0x6f, 0x5a, 0x46, 0xfd, 0x99, 0x9a, 0x29,
+	0xac, 0x1e, 0x1e, 0xe9, 0xd9, 0x03, 0x2a, 0xf6, 0x6c, 0x46, 0x0e, 0xe6, 0x94, 0xfa, 0xbb, 0xad,
+	0x7a, 0xaf, 0xad, 0x5e, 0x09, 0x95, 0xfc, 0x89, 0x4d, 0x04, 0xcc, 0x4d, 0x18, 0x3f, 0x76, 0xd5,
+	0xe5, 0x70, 0xc2, 0x84, 0x3b, 0xf8, 0x1e, 0xba, 0x91, 0x20, 0xd7, 0x7b, 0x3d, 0xa3, 0xd3, 0xe8,
+	0xf7, 0xda, 0x5d, 0x75, 0xa5, 0xb0, 0x7e, 0x78, 0xa4, 0xa3, 0x20, 0x98, 0x74, 0xe0, 0x0b, 0xe0,
+	0x0d, 0x78, 0x73, 0x5a, 0x54, 0x4e, 0x4e, 0x8b, 0xca, 0x5f, 0xa7, 0x45, 0xe5, 0xa7, 0xb3, 0x62,
+	0xea, 0xe4, 0xac, 0x98, 0xfa, 0xf3, 0xac, 0x98, 0x42, 0x9b, 0xd4, 0xbb, 0xe0, 0xad, 0xec, 0x2a,
+	0xcf, 0xab, 0x23, 0x2a, 0xf6, 0xfc, 0x41, 0xd5, 0xf2, 0x9c, 0x5a, 0x4c, 0xba, 0x4f, 0xbd, 0xc4,
+	0xaa, 0xf6, 0x6a, 0xf6, 0x07, 0x3a, 0x58, 0x96, 0xff, 0x7b, 0x0f, 0xfe, 0x19, 0x00, 0x06, 0x34,
+	0xce, 0xf1, 0x9f, 0x0a, 0x00, 0x00,
 }
 
 func (this *MarketDetails) Equal(that interface{}) bool {
@@ -896,6 +908,18 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoMatch {
+		i--
+		if m.AutoMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if len(m.ReqAttrCreateCommitment) > 0 {
 		for iNdEx := len(m.ReqAttrCreateCommitment) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReqAttrCreateCommitment[iNdEx])
@@ -1357,6 +1381,9 @@ func (m *Market) Size() (n int) {
 			n += 2 + l + sovMarket(uint64(l))
 		}
 	}
+	if m.AutoMatch {
+		n += 3
+	}
 	return n
 }
 
@@ -2409,6 +2436,26 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			}
 			m.ReqAttrCreateCommitment = append(m.ReqAttrCreateCommitment, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoMatch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -30,7 +30,8 @@ var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 type AppModuleBasic struct {
@@ -125,6 +126,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// EndBlock is the EndBlocker for the exchange module. It cancels all orders that have expired,
+// then matches and settles crossing orders in markets that have auto-match enabled.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.CancelExpiredOrders(sdkCtx)
+	am.keeper.MatchOrders(sdkCtx)
+	return nil
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
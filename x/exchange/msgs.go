@@ -19,6 +19,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgCreateBidRequest)(nil),
 	(*MsgCommitFundsRequest)(nil),
 	(*MsgCancelOrderRequest)(nil),
+	(*MsgAmendOrderRequest)(nil),
 	(*MsgFillBidsRequest)(nil),
 	(*MsgFillAsksRequest)(nil),
 	(*MsgMarketSettleRequest)(nil),
@@ -30,6 +31,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgMarketUpdateEnabledRequest)(nil),
 	(*MsgMarketUpdateAcceptingOrdersRequest)(nil),
 	(*MsgMarketUpdateUserSettleRequest)(nil),
+	(*MsgMarketUpdateAutoMatchRequest)(nil),
 	(*MsgMarketUpdateAcceptingCommitmentsRequest)(nil),
 	(*MsgMarketUpdateIntermediaryDenomRequest)(nil),
 	(*MsgMarketManagePermissionsRequest)(nil),
@@ -154,6 +156,39 @@ func (m MsgCancelOrderRequest) ValidateBasic() error {
 	return nil
 }
 
+func (m MsgAmendOrderRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		errs = append(errs, fmt.Errorf("invalid owner: %w", err))
+	}
+
+	if m.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: cannot be zero"))
+	}
+
+	var priceDenom string
+	if err := validateCoin("price", m.Price); err != nil {
+		errs = append(errs, err)
+	} else {
+		priceDenom = m.Price.Denom
+	}
+
+	if err := validateCoin("assets", m.Assets); err != nil {
+		errs = append(errs, err)
+	} else if len(priceDenom) > 0 && m.Assets.Denom == priceDenom {
+		errs = append(errs, fmt.Errorf("invalid assets: price denom %s cannot also be the assets denom", priceDenom))
+	}
+
+	if len(m.SettlementFees) > 0 {
+		if err := m.SettlementFees.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid settlement fees: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func (m MsgFillBidsRequest) ValidateBasic() error {
 	var errs []error
 
@@ -462,6 +497,22 @@ func (m MsgMarketUpdateUserSettleRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgMarketUpdateAutoMatchRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+
+	if m.MarketId == 0 {
+		errs = append(errs, fmt.Errorf("invalid market id: cannot be zero"))
+	}
+
+	// Nothing to validate for the AutoMatch field.
+
+	return errors.Join(errs...)
+}
+
 func (m MsgMarketUpdateAcceptingCommitmentsRequest) ValidateBasic() error {
 	var errs []error
 	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
@@ -35,6 +35,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgCreateBidRequest{BidOrder: BidOrder{Buyer: signer}} },
 		func(signer string) sdk.Msg { return &MsgCommitFundsRequest{Account: signer} },
 		func(signer string) sdk.Msg { return &MsgCancelOrderRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgAmendOrderRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgFillBidsRequest{Seller: signer} },
 		func(signer string) sdk.Msg { return &MsgFillAsksRequest{Buyer: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketSettleRequest{Admin: signer} },
@@ -46,6 +47,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgMarketUpdateEnabledRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateAcceptingOrdersRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateUserSettleRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateAutoMatchRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateAcceptingCommitmentsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateIntermediaryDenomRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManagePermissionsRequest{Admin: signer} },
@@ -471,6 +473,130 @@ func TestMsgCancelOrderRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgAmendOrderRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgAmendOrderRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgAmendOrderRequest{
+				Owner:          owner,
+				OrderId:        1,
+				Assets:         coin(10, "apple"),
+				Price:          coin(15, "peach"),
+				SettlementFees: sdk.Coins{coin(1, "peach")},
+			},
+			expErr: nil,
+		},
+		{
+			name: "no settlement fees",
+			msg: MsgAmendOrderRequest{
+				Owner:   owner,
+				OrderId: 1,
+				Assets:  coin(10, "apple"),
+				Price:   coin(15, "peach"),
+			},
+			expErr: nil,
+		},
+		{
+			name: "missing owner",
+			msg: MsgAmendOrderRequest{
+				OrderId: 1,
+				Assets:  coin(10, "apple"),
+				Price:   coin(15, "peach"),
+			},
+			expErr: []string{"invalid owner: ", emptyAddrErr},
+		},
+		{
+			name: "invalid owner",
+			msg: MsgAmendOrderRequest{
+				Owner:   "notgonnawork",
+				OrderId: 1,
+				Assets:  coin(10, "apple"),
+				Price:   coin(15, "peach"),
+			},
+			expErr: []string{"invalid owner: ", bech32Err + "invalid separator index -1"},
+		},
+		{
+			name: "order 0",
+			msg: MsgAmendOrderRequest{
+				Owner:   owner,
+				OrderId: 0,
+				Assets:  coin(10, "apple"),
+				Price:   coin(15, "peach"),
+			},
+			expErr: []string{"invalid order id: cannot be zero"},
+		},
+		{
+			name: "zero assets",
+			msg: MsgAmendOrderRequest{
+				Owner:   owner,
+				OrderId: 1,
+				Assets:  coin(0, "apple"),
+				Price:   coin(15, "peach"),
+			},
+			expErr: []string{"invalid assets: cannot be zero"},
+		},
+		{
+			name: "negative price",
+			msg: MsgAmendOrderRequest{
+				Owner:   owner,
+				OrderId: 1,
+				Assets:  coin(10, "apple"),
+				Price:   coin(-1, "peach"),
+			},
+			expErr: []string{"invalid price: negative coin amount: -1"},
+		},
+		{
+			name: "same assets and price denom",
+			msg: MsgAmendOrderRequest{
+				Owner:   owner,
+				OrderId: 1,
+				Assets:  coin(10, "peach"),
+				Price:   coin(15, "peach"),
+			},
+			expErr: []string{"invalid assets: price denom peach cannot also be the assets denom"},
+		},
+		{
+			name: "zero settlement fee",
+			msg: MsgAmendOrderRequest{
+				Owner:          owner,
+				OrderId:        1,
+				Assets:         coin(10, "apple"),
+				Price:          coin(15, "peach"),
+				SettlementFees: sdk.Coins{coin(0, "peach")},
+			},
+			expErr: []string{"invalid settlement fees: coin 0peach amount is not positive"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgAmendOrderRequest{
+				Assets: coin(0, "apple"),
+				Price:  coin(0, "peach"),
+			},
+			expErr: []string{
+				"invalid owner: ", emptyAddrErr,
+				"invalid order id: cannot be zero",
+				"invalid price: cannot be zero",
+				"invalid assets: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgFillBidsRequest_ValidateBasic(t *testing.T) {
 	coin := func(amount int64, denom string) *sdk.Coin {
 		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
@@ -1849,6 +1975,71 @@ func TestMsgMarketUpdateUserSettleRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgMarketUpdateAutoMatchRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdateAutoMatchRequest
+		expErr []string
+	}{
+		{
+			name: "control: true",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:     admin,
+				MarketId:  1,
+				AutoMatch: true,
+			},
+			expErr: nil,
+		},
+		{
+			name: "control: false",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:     admin,
+				MarketId:  1,
+				AutoMatch: false,
+			},
+			expErr: nil,
+		},
+		{
+			name: "empty admin",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:    "",
+				MarketId: 1,
+			},
+			expErr: []string{
+				`invalid administrator ""`, emptyAddrErr,
+			},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:    "badadmin",
+				MarketId: 1,
+			},
+			expErr: []string{
+				`invalid administrator "badadmin"`, bech32Err,
+			},
+		},
+		{
+			name: "market id zero",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:    admin,
+				MarketId: 0,
+			},
+			expErr: []string{
+				"invalid market id", "cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgMarketUpdateAcceptingCommitmentsRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
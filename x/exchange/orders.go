@@ -3,6 +3,7 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -19,6 +20,9 @@ const (
 	OrderTypeByteBid = byte(0x01)
 )
 
+// CancelReasonExpired is the EventOrderCancelled reason used when an order is cancelled because it expired.
+const CancelReasonExpired = "expired"
+
 // MaxExternalIDLength is the maximum length that an external id can have.
 // A 32 byte address as a bech32 string is 59 characters + the hrp.
 // E.g. a 32 byte address with hrp "pb" will be 61 characters long.
@@ -38,6 +42,8 @@ type SubOrderI interface {
 	GetOrderType() string
 	GetOrderTypeByte() byte
 	GetHoldAmount() sdk.Coins
+	GetGoodTilTime() *time.Time
+	GetGoodTilBlockHeight() uint64
 	Validate() error
 }
 
@@ -98,6 +104,24 @@ func ValidateOrderIDs(field string, orderIDs []uint64) error {
 	return nil
 }
 
+// validateGoodTilTime makes sure a good-til-time is okay (if provided).
+func validateGoodTilTime(goodTilTime *time.Time) error {
+	if goodTilTime != nil && goodTilTime.Unix() <= 0 {
+		return fmt.Errorf("invalid good til time %s: must be after %s",
+			goodTilTime.UTC().Format(time.RFC3339), time.Unix(0, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// IsExpired returns true if either the provided good-til-time or good-til-block-height
+// has been reached by the provided block time or height.
+func IsExpired(goodTilTime *time.Time, goodTilBlockHeight uint64, blockTime time.Time, blockHeight uint64) bool {
+	if goodTilTime != nil && !blockTime.Before(*goodTilTime) {
+		return true
+	}
+	return goodTilBlockHeight != 0 && blockHeight >= goodTilBlockHeight
+}
+
 // ValidateExternalID makes sure an external id is okay.
 func ValidateExternalID(externalID string) error {
 	if len(externalID) > MaxExternalIDLength {
@@ -227,6 +251,24 @@ func (o Order) GetHoldAmount() sdk.Coins {
 	return o.MustGetSubOrder().GetHoldAmount()
 }
 
+// GetGoodTilTime returns the block time after which this order is no longer valid (or nil if there isn't one).
+// Panics if the sub-order is not set or is something unexpected.
+func (o Order) GetGoodTilTime() *time.Time {
+	return o.MustGetSubOrder().GetGoodTilTime()
+}
+
+// GetGoodTilBlockHeight returns the block height after which this order is no longer valid (or 0 if there isn't one).
+// Panics if the sub-order is not set or is something unexpected.
+func (o Order) GetGoodTilBlockHeight() uint64 {
+	return o.MustGetSubOrder().GetGoodTilBlockHeight()
+}
+
+// IsExpired returns true if this order's good-til-time or good-til-block-height has been reached.
+// Panics if the sub-order is not set or is something unexpected.
+func (o Order) IsExpired(blockTime time.Time, blockHeight uint64) bool {
+	return IsExpired(o.GetGoodTilTime(), o.GetGoodTilBlockHeight(), blockTime, blockHeight)
+}
+
 // Validate returns an error if anything in this order is invalid.
 func (o Order) Validate() error {
 	if o.OrderId == 0 {
@@ -373,6 +415,16 @@ func (a AskOrder) GetHoldAmount() sdk.Coins {
 	return rv
 }
 
+// GetGoodTilTime returns the block time after which this ask order is no longer valid (or nil if there isn't one).
+func (a AskOrder) GetGoodTilTime() *time.Time {
+	return a.GoodTilTime
+}
+
+// GetGoodTilBlockHeight returns the block height after which this ask order is no longer valid (or 0 if there isn't one).
+func (a AskOrder) GetGoodTilBlockHeight() uint64 {
+	return a.GoodTilBlockHeight
+}
+
 // Validate returns an error if anything in this ask order is invalid.
 func (a AskOrder) Validate() error {
 	var errs []error
@@ -417,6 +469,10 @@ func (a AskOrder) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := validateGoodTilTime(a.GoodTilTime); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -430,6 +486,8 @@ func (a AskOrder) CopyChange(newAssets, newPrice sdk.Coin, newFee *sdk.Coin) *As
 		SellerSettlementFlatFee: newFee,
 		AllowPartial:            a.AllowPartial,
 		ExternalId:              a.ExternalId,
+		GoodTilTime:             a.GoodTilTime,
+		GoodTilBlockHeight:      a.GoodTilBlockHeight,
 	}
 }
 
@@ -483,6 +541,16 @@ func (b BidOrder) GetHoldAmount() sdk.Coins {
 	return b.BuyerSettlementFees.Add(b.Price)
 }
 
+// GetGoodTilTime returns the block time after which this bid order is no longer valid (or nil if there isn't one).
+func (b BidOrder) GetGoodTilTime() *time.Time {
+	return b.GoodTilTime
+}
+
+// GetGoodTilBlockHeight returns the block height after which this bid order is no longer valid (or 0 if there isn't one).
+func (b BidOrder) GetGoodTilBlockHeight() uint64 {
+	return b.GoodTilBlockHeight
+}
+
 // Validate returns an error if anything in this ask order is invalid.
 func (b BidOrder) Validate() error {
 	var errs []error
@@ -523,6 +591,10 @@ func (b BidOrder) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := validateGoodTilTime(b.GoodTilTime); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -536,6 +608,8 @@ func (b BidOrder) CopyChange(newAssets, newPrice sdk.Coin, newFees sdk.Coins) *B
 		BuyerSettlementFees: newFees,
 		AllowPartial:        b.AllowPartial,
 		ExternalId:          b.ExternalId,
+		GoodTilTime:         b.GoodTilTime,
+		GoodTilBlockHeight:  b.GoodTilBlockHeight,
 	}
 }
 
@@ -640,6 +714,16 @@ func (o FilledOrder) GetHoldAmount() sdk.Coins {
 	return o.order.GetHoldAmount()
 }
 
+// GetGoodTilTime returns this order's good-til-time.
+func (o FilledOrder) GetGoodTilTime() *time.Time {
+	return o.order.GetGoodTilTime()
+}
+
+// GetGoodTilBlockHeight returns this order's good-til-block-height.
+func (o FilledOrder) GetGoodTilBlockHeight() uint64 {
+	return o.order.GetGoodTilBlockHeight()
+}
+
 // Validate returns nil (because it's assumed that the order was validated long ago).
 // This is just here to fulfill the OrderI interface.
 func (o FilledOrder) Validate() error {
@@ -12,15 +12,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -141,6 +145,12 @@ type AskOrder struct {
 	// external_id is an optional string used to externally identify this order. Max length is 100 characters.
 	// If an order in this market with this external id already exists, this order will be rejected.
 	ExternalId string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// good_til_time is an optional block time after which this order is no longer valid.
+	// Once a block has a time at or after this, the order is cancelled (and its hold released) in that block's EndBlocker.
+	GoodTilTime *time.Time `protobuf:"bytes,8,opt,name=good_til_time,json=goodTilTime,proto3,stdtime" json:"good_til_time,omitempty"`
+	// good_til_block_height is an optional block height after which this order is no longer valid.
+	// The order is cancelled (and its hold released) in the EndBlocker of this block height. Zero = no height expiration.
+	GoodTilBlockHeight uint64 `protobuf:"varint,9,opt,name=good_til_block_height,json=goodTilBlockHeight,proto3" json:"good_til_block_height,omitempty"`
 }
 
 func (m *AskOrder) Reset()         { *m = AskOrder{} }
@@ -196,6 +206,12 @@ type BidOrder struct {
 	// external_id is an optional string used to externally identify this order. Max length is 100 characters.
 	// If an order in this market with this external id already exists, this order will be rejected.
 	ExternalId string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// good_til_time is an optional block time after which this order is no longer valid.
+	// Once a block has a time at or after this, the order is cancelled (and its hold released) in that block's EndBlocker.
+	GoodTilTime *time.Time `protobuf:"bytes,8,opt,name=good_til_time,json=goodTilTime,proto3,stdtime" json:"good_til_time,omitempty"`
+	// good_til_block_height is an optional block height after which this order is no longer valid.
+	// The order is cancelled (and its hold released) in the EndBlocker of this block height. Zero = no height expiration.
+	GoodTilBlockHeight uint64 `protobuf:"varint,9,opt,name=good_til_block_height,json=goodTilBlockHeight,proto3" json:"good_til_block_height,omitempty"`
 }
 
 func (m *BidOrder) Reset()         { *m = BidOrder{} }
@@ -242,45 +258,51 @@ func init() {
 }
 
 var fileDescriptor_dab7cbe63f582471 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xb6, 0xdb, 0x38, 0x75, 0xaf, 0xed, 0xef, 0x27, 0x4c, 0xa1, 0x4e, 0x91, 0x9c, 0xa8, 0x5d,
-	0xa2, 0x4a, 0xb5, 0x29, 0x08, 0x21, 0x75, 0x41, 0x0d, 0x52, 0x45, 0x27, 0x2a, 0x57, 0x62, 0x60,
-	0xb1, 0xce, 0xf6, 0xab, 0x7b, 0x8a, 0xed, 0x8b, 0x7c, 0xd7, 0xd2, 0xae, 0x4c, 0x8c, 0x2c, 0x2c,
-	0x4c, 0x8c, 0x88, 0x29, 0x12, 0xf0, 0x3f, 0x74, 0xac, 0x98, 0x98, 0x00, 0x25, 0x43, 0xfe, 0x05,
-	0x46, 0xe4, 0xbb, 0x73, 0x1a, 0x24, 0x08, 0x1d, 0x10, 0x8b, 0x7d, 0xef, 0xbd, 0xef, 0x7d, 0xef,
-	0xdd, 0xf7, 0xf4, 0x0e, 0xad, 0xf7, 0x0a, 0x7a, 0x02, 0x39, 0xce, 0x23, 0xf0, 0xe0, 0x34, 0x3a,
-	0xc2, 0x79, 0x02, 0xde, 0xc9, 0x96, 0x47, 0x8b, 0x18, 0x0a, 0xe6, 0xf6, 0x0a, 0xca, 0xa9, 0x75,
-	0xf3, 0x12, 0xe4, 0x56, 0x20, 0xf7, 0x64, 0x6b, 0xf5, 0x1a, 0xce, 0x48, 0x4e, 0x3d, 0xf1, 0x95,
-	0xd0, 0x55, 0x27, 0xa2, 0x2c, 0xa3, 0xcc, 0x0b, 0x31, 0x2b, 0x79, 0x42, 0xe0, 0x78, 0xcb, 0x8b,
-	0x28, 0xc9, 0x55, 0x7c, 0x45, 0xc5, 0x33, 0x96, 0x94, 0x65, 0x32, 0x96, 0xa8, 0x40, 0x43, 0x06,
-	0x02, 0x61, 0x79, 0xd2, 0x50, 0xa1, 0xe5, 0x84, 0x26, 0x54, 0xfa, 0xcb, 0x93, 0xf4, 0xae, 0xbd,
-	0xd7, 0x91, 0xf1, 0xb8, 0xec, 0xd2, 0x6a, 0x20, 0x53, 0xb4, 0x1b, 0x90, 0xd8, 0xd6, 0x5b, 0x7a,
-	0xbb, 0xe6, 0xcf, 0x09, 0x7b, 0x2f, 0xb6, 0x1e, 0xa0, 0x79, 0xcc, 0xba, 0x81, 0x30, 0xed, 0x99,
-	0x96, 0xde, 0x5e, 0xb8, 0xd3, 0x72, 0x7f, 0x7d, 0x1b, 0x77, 0x87, 0x75, 0x05, 0xdf, 0x23, 0xcd,
-	0x37, 0xb1, 0x3a, 0x97, 0x04, 0x21, 0x89, 0x15, 0xc1, 0xec, 0x74, 0x82, 0x0e, 0x89, 0xc7, 0x04,
-	0xa1, 0x3a, 0x6f, 0xd7, 0x5e, 0xbc, 0x69, 0x6a, 0x9d, 0x39, 0x64, 0x08, 0x8a, 0xb5, 0xef, 0x33,
-	0xc8, 0xac, 0x0a, 0x59, 0xb7, 0xd0, 0x7c, 0x86, 0x8b, 0x2e, 0xf0, 0xaa, 0xf3, 0x25, 0xdf, 0x94,
-	0x8e, 0xbd, 0xd8, 0xba, 0x8d, 0xea, 0x0c, 0xd2, 0x54, 0xf5, 0x3d, 0xdf, 0xb1, 0x3f, 0x7d, 0xd8,
-	0x5c, 0x56, 0xba, 0xec, 0xc4, 0x71, 0x01, 0x8c, 0x1d, 0xf0, 0x82, 0xe4, 0x89, 0xaf, 0x70, 0xd6,
-	0x7d, 0x54, 0xc7, 0x8c, 0x01, 0x67, 0xaa, 0xd1, 0x86, 0xab, 0xe0, 0xe5, 0x30, 0x5c, 0x35, 0x0c,
-	0xf7, 0x21, 0x25, 0x79, 0xa7, 0x76, 0xfe, 0xa5, 0xa9, 0xf9, 0x0a, 0x6e, 0xdd, 0x43, 0x46, 0xaf,
-	0x20, 0x11, 0xd8, 0xb5, 0xab, 0xe5, 0x49, 0xb4, 0xf5, 0x04, 0xad, 0xca, 0xca, 0x01, 0x03, 0xce,
-	0x53, 0xc8, 0x20, 0xe7, 0xc1, 0x61, 0x8a, 0x79, 0x70, 0x08, 0x60, 0x1b, 0x7f, 0xe0, 0xf2, 0x57,
-	0x64, 0xf2, 0xc1, 0x38, 0x77, 0x37, 0xc5, 0x7c, 0x17, 0xc0, 0x5a, 0x47, 0x4b, 0x38, 0x4d, 0xe9,
-	0xb3, 0xa0, 0x87, 0x0b, 0x4e, 0x70, 0x6a, 0xd7, 0x5b, 0x7a, 0xdb, 0xf4, 0x17, 0x85, 0x73, 0x5f,
-	0xfa, 0xac, 0x26, 0x5a, 0x80, 0x53, 0x0e, 0x45, 0x8e, 0xd3, 0x52, 0xbd, 0xb9, 0x52, 0x23, 0x1f,
-	0x55, 0xae, 0xbd, 0x78, 0xfb, 0xff, 0x52, 0xf8, 0xe7, 0xa3, 0xfe, 0x86, 0x92, 0x67, 0xed, 0xe3,
-	0x2c, 0x32, 0xab, 0x11, 0x4d, 0x97, 0xde, 0x45, 0x46, 0x78, 0x7c, 0x76, 0x05, 0xe5, 0x25, 0xec,
-	0x9f, 0x0b, 0xff, 0x4a, 0x47, 0x37, 0x44, 0xe5, 0x9f, 0x84, 0x07, 0x60, 0xb6, 0xd1, 0x9a, 0x9d,
-	0xce, 0xb3, 0x5b, 0xf2, 0xbc, 0xfb, 0xda, 0x6c, 0x27, 0x84, 0x1f, 0x1d, 0x87, 0x6e, 0x44, 0x33,
-	0xb5, 0x6c, 0xea, 0xb7, 0xc9, 0xe2, 0xae, 0xc7, 0xcf, 0x7a, 0xc0, 0x44, 0x02, 0x7b, 0x3d, 0xea,
-	0x6f, 0x2c, 0xa6, 0x90, 0xe0, 0xe8, 0x2c, 0x28, 0xf7, 0x98, 0xbd, 0x1d, 0xf5, 0x37, 0x74, 0xff,
-	0xba, 0xa8, 0x3f, 0x31, 0x3b, 0x00, 0xf6, 0x97, 0x06, 0xf7, 0x5f, 0x35, 0x38, 0xa9, 0x6e, 0x07,
-	0xce, 0x07, 0x8e, 0x7e, 0x31, 0x70, 0xf4, 0x6f, 0x03, 0x47, 0x7f, 0x39, 0x74, 0xb4, 0x8b, 0xa1,
-	0xa3, 0x7d, 0x1e, 0x3a, 0x1a, 0x6a, 0x10, 0xfa, 0x9b, 0x5d, 0xdc, 0xd7, 0x9f, 0xba, 0x13, 0x37,
-	0xbc, 0x04, 0x6d, 0x12, 0x3a, 0x61, 0x79, 0xa7, 0xe3, 0x47, 0x2f, 0xac, 0x8b, 0x67, 0xe5, 0xee,
-	0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0x15, 0x76, 0x98, 0x12, 0x05, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xb1, 0x4f, 0xdb, 0x4a,
+	0x18, 0x8f, 0x1f, 0x49, 0x70, 0x0e, 0x78, 0x4f, 0xcf, 0x0f, 0x1e, 0x4e, 0x2a, 0x25, 0x11, 0x2c,
+	0x11, 0x12, 0x76, 0xd3, 0xaa, 0xaa, 0xc4, 0x52, 0xe1, 0x4a, 0x08, 0xa6, 0x22, 0x83, 0x3a, 0x74,
+	0xb1, 0xce, 0xf6, 0x87, 0x73, 0xca, 0xd9, 0x17, 0xf9, 0x0e, 0x0a, 0x6b, 0xa7, 0x8e, 0x2c, 0x5d,
+	0x3a, 0x75, 0xac, 0x3a, 0xa1, 0xb6, 0x7f, 0x04, 0x23, 0xea, 0xd4, 0x09, 0x2a, 0x18, 0xf8, 0x0b,
+	0xba, 0x57, 0x77, 0x3e, 0x07, 0x2a, 0xb5, 0x94, 0xa9, 0x43, 0x97, 0xe4, 0xbe, 0xef, 0xfb, 0x7d,
+	0xbf, 0xef, 0xee, 0x7e, 0x3f, 0x9d, 0xd1, 0xe2, 0x28, 0x67, 0x7b, 0x90, 0xe1, 0x2c, 0x02, 0x17,
+	0xf6, 0xa3, 0x01, 0xce, 0x12, 0x70, 0xf7, 0xfa, 0x2e, 0xcb, 0x63, 0xc8, 0xb9, 0x33, 0xca, 0x99,
+	0x60, 0xd6, 0xff, 0x57, 0x20, 0xa7, 0x04, 0x39, 0x7b, 0xfd, 0xd6, 0xbf, 0x38, 0x25, 0x19, 0x73,
+	0xd5, 0x6f, 0x01, 0x6d, 0xb5, 0x23, 0xc6, 0x53, 0xc6, 0xdd, 0x10, 0x73, 0xc9, 0x13, 0x82, 0xc0,
+	0x7d, 0x37, 0x62, 0x24, 0xd3, 0xf5, 0x79, 0x5d, 0x4f, 0x79, 0x22, 0xc7, 0xa4, 0x3c, 0xd1, 0x85,
+	0x66, 0x51, 0x08, 0x54, 0xe4, 0x16, 0x81, 0x2e, 0xcd, 0x26, 0x2c, 0x61, 0x45, 0x5e, 0xae, 0x74,
+	0xb6, 0x93, 0x30, 0x96, 0x50, 0x70, 0x55, 0x14, 0xee, 0xee, 0xb8, 0x82, 0xa4, 0xc0, 0x05, 0x4e,
+	0x47, 0x05, 0x60, 0xe1, 0x83, 0x81, 0x6a, 0x4f, 0xe4, 0x31, 0xac, 0x26, 0x32, 0xd5, 0x79, 0x02,
+	0x12, 0xdb, 0x46, 0xd7, 0xe8, 0x55, 0xfd, 0x49, 0x15, 0x6f, 0xc4, 0xd6, 0x23, 0xd4, 0xc0, 0x7c,
+	0x18, 0xa8, 0xd0, 0xfe, 0xab, 0x6b, 0xf4, 0xa6, 0xee, 0x75, 0x9d, 0x1f, 0x1f, 0xd7, 0x59, 0xe5,
+	0x43, 0xc5, 0xb7, 0x5e, 0xf1, 0x4d, 0xac, 0xd7, 0x92, 0x20, 0x24, 0xb1, 0x26, 0x98, 0xb8, 0x99,
+	0xc0, 0x23, 0xf1, 0x98, 0x20, 0xd4, 0xeb, 0x95, 0xea, 0xcb, 0x37, 0x9d, 0x8a, 0x37, 0x89, 0x6a,
+	0x8a, 0x62, 0xe1, 0xeb, 0x04, 0x32, 0xcb, 0x41, 0xd6, 0x1d, 0xd4, 0x48, 0x71, 0x3e, 0x04, 0x51,
+	0xee, 0x7c, 0xc6, 0x37, 0x8b, 0xc4, 0x46, 0x6c, 0xdd, 0x45, 0x75, 0x0e, 0x94, 0xea, 0x7d, 0x37,
+	0x3c, 0xfb, 0xd3, 0xc7, 0xe5, 0x59, 0x7d, 0x71, 0xab, 0x71, 0x9c, 0x03, 0xe7, 0x5b, 0x22, 0x27,
+	0x59, 0xe2, 0x6b, 0x9c, 0xf5, 0x10, 0xd5, 0x31, 0xe7, 0x20, 0xb8, 0xde, 0x68, 0xd3, 0xd1, 0x70,
+	0xa9, 0x96, 0xa3, 0xd5, 0x72, 0x1e, 0x33, 0x92, 0x79, 0xd5, 0xe3, 0xd3, 0x4e, 0xc5, 0xd7, 0x70,
+	0xeb, 0x01, 0xaa, 0x8d, 0x72, 0x12, 0x81, 0x5d, 0xbd, 0x5d, 0x5f, 0x81, 0xb6, 0x9e, 0xa2, 0x56,
+	0x31, 0x39, 0xe0, 0x20, 0x04, 0x85, 0x14, 0x32, 0x11, 0xec, 0x50, 0x2c, 0x82, 0x1d, 0x00, 0xbb,
+	0xf6, 0x0b, 0x2e, 0x7f, 0xbe, 0x68, 0xde, 0x1a, 0xf7, 0xae, 0x51, 0x2c, 0xd6, 0x00, 0xac, 0x45,
+	0x34, 0x83, 0x29, 0x65, 0xcf, 0x83, 0x11, 0xce, 0x05, 0xc1, 0xd4, 0xae, 0x77, 0x8d, 0x9e, 0xe9,
+	0x4f, 0xab, 0xe4, 0x66, 0x91, 0xb3, 0x3a, 0x68, 0x0a, 0xf6, 0x05, 0xe4, 0x19, 0xa6, 0xf2, 0xf6,
+	0x26, 0xe5, 0x1d, 0xf9, 0xa8, 0x4c, 0x6d, 0xc4, 0xd6, 0x3a, 0x9a, 0x49, 0x18, 0x8b, 0x03, 0x41,
+	0x68, 0x20, 0xbd, 0x63, 0x9b, 0x6a, 0x43, 0x2d, 0xa7, 0x30, 0x96, 0x53, 0x1a, 0xcb, 0xd9, 0x2e,
+	0x8d, 0xe5, 0x99, 0xc7, 0xa7, 0x1d, 0xe3, 0xf0, 0xac, 0x63, 0xf8, 0x53, 0xb2, 0x75, 0x9b, 0x50,
+	0x59, 0xb3, 0xfa, 0x68, 0x6e, 0xcc, 0x14, 0x52, 0x16, 0x0d, 0x83, 0x01, 0x90, 0x64, 0x20, 0xec,
+	0x86, 0x32, 0x9b, 0xa5, 0xb1, 0x9e, 0x2c, 0xad, 0xab, 0xca, 0xca, 0x3f, 0x52, 0xf5, 0x17, 0x97,
+	0x47, 0x4b, 0x5a, 0x9b, 0x85, 0xf7, 0x55, 0x64, 0x96, 0xfe, 0xb8, 0x59, 0x77, 0x07, 0xd5, 0xc2,
+	0xdd, 0x83, 0x5b, 0xc8, 0x5e, 0xc0, 0x7e, 0xbb, 0xea, 0xaf, 0x0c, 0x34, 0xa7, 0x26, 0x7f, 0xa7,
+	0x3a, 0x00, 0xb7, 0x6b, 0xdd, 0x89, 0x9b, 0x79, 0xd6, 0x24, 0xcf, 0xbb, 0xb3, 0x4e, 0x2f, 0x21,
+	0x62, 0xb0, 0x1b, 0x3a, 0x11, 0x4b, 0xf5, 0x53, 0xa0, 0xff, 0x96, 0x79, 0x3c, 0x74, 0xc5, 0xc1,
+	0x08, 0xb8, 0x6a, 0xe0, 0xaf, 0x2f, 0x8f, 0x96, 0xa6, 0x29, 0x24, 0x38, 0x3a, 0x08, 0xe4, 0x2b,
+	0xc3, 0xdf, 0x5e, 0x1e, 0x2d, 0x19, 0xfe, 0x7f, 0x6a, 0xfe, 0x35, 0xe3, 0x00, 0xf0, 0x3f, 0xc1,
+	0x35, 0x7f, 0x97, 0xae, 0x29, 0xa4, 0xf5, 0xe0, 0xf8, 0xbc, 0x6d, 0x9c, 0x9c, 0xb7, 0x8d, 0x2f,
+	0xe7, 0x6d, 0xe3, 0xf0, 0xa2, 0x5d, 0x39, 0xb9, 0x68, 0x57, 0x3e, 0x5f, 0xb4, 0x2b, 0xa8, 0x49,
+	0xd8, 0x4f, 0x5e, 0xa1, 0x4d, 0xe3, 0x99, 0x73, 0xed, 0x7a, 0xaf, 0x40, 0xcb, 0x84, 0x5d, 0x8b,
+	0xdc, 0xfd, 0xf1, 0xf7, 0x20, 0xac, 0xab, 0x43, 0xdd, 0xff, 0x36, 0x00, 0x54, 0x94, 0xf7, 0xb0,
+	0x2d, 0x06, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -382,6 +404,21 @@ func (m *AskOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GoodTilBlockHeight != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.GoodTilBlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GoodTilTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.GoodTilTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintOrders(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
@@ -466,6 +503,21 @@ func (m *BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GoodTilBlockHeight != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.GoodTilBlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GoodTilTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.GoodTilTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintOrders(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
@@ -610,6 +662,13 @@ func (m *AskOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrders(uint64(l))
 	}
+	if m.GoodTilTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilTime)
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.GoodTilBlockHeight != 0 {
+		n += 1 + sovOrders(uint64(m.GoodTilBlockHeight))
+	}
 	return n
 }
 
@@ -643,6 +702,13 @@ func (m *BidOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrders(uint64(l))
 	}
+	if m.GoodTilTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilTime)
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.GoodTilBlockHeight != 0 {
+		n += 1 + sovOrders(uint64(m.GoodTilBlockHeight))
+	}
 	return n
 }
 
@@ -1025,6 +1091,61 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTilTime == nil {
+				m.GoodTilTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.GoodTilTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockHeight", wireType)
+			}
+			m.GoodTilBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -1278,6 +1399,61 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTilTime == nil {
+				m.GoodTilTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.GoodTilTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockHeight", wireType)
+			}
+			m.GoodTilBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -53,6 +54,8 @@ func copyAskOrder(askOrder *AskOrder) *AskOrder {
 		SellerSettlementFlatFee: copyCoinP(askOrder.SellerSettlementFlatFee),
 		AllowPartial:            askOrder.AllowPartial,
 		ExternalId:              askOrder.ExternalId,
+		GoodTilTime:             copyTimeP(askOrder.GoodTilTime),
+		GoodTilBlockHeight:      askOrder.GoodTilBlockHeight,
 	}
 }
 
@@ -69,9 +72,20 @@ func copyBidOrder(bidOrder *BidOrder) *BidOrder {
 		BuyerSettlementFees: copyCoins(bidOrder.BuyerSettlementFees),
 		AllowPartial:        bidOrder.AllowPartial,
 		ExternalId:          bidOrder.ExternalId,
+		GoodTilTime:         copyTimeP(bidOrder.GoodTilTime),
+		GoodTilBlockHeight:  bidOrder.GoodTilBlockHeight,
 	}
 }
 
+// copyTimeP creates a copy of the provided time pointer.
+func copyTimeP(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	rv := *t
+	return &rv
+}
+
 // orderString is similar to %v except with easier to understand Coin and Int entries.
 func orderString(order *Order) string {
 	if order == nil {
@@ -298,6 +312,100 @@ func TestValidateExternalID(t *testing.T) {
 	}
 }
 
+func TestIsExpired(t *testing.T) {
+	gtt := time.Unix(1_700_000_000, 0).UTC()
+	tests := []struct {
+		name               string
+		goodTilTime        *time.Time
+		goodTilBlockHeight uint64
+		blockTime          time.Time
+		blockHeight        uint64
+		exp                bool
+	}{
+		{
+			name:        "no expiration",
+			blockTime:   gtt,
+			blockHeight: 1_000_000,
+			exp:         false,
+		},
+		{
+			name:        "time: before",
+			goodTilTime: &gtt,
+			blockTime:   gtt.Add(-1 * time.Second),
+			blockHeight: 1_000_000,
+			exp:         false,
+		},
+		{
+			name:        "time: equal",
+			goodTilTime: &gtt,
+			blockTime:   gtt,
+			exp:         true,
+		},
+		{
+			name:        "time: after",
+			goodTilTime: &gtt,
+			blockTime:   gtt.Add(time.Second),
+			exp:         true,
+		},
+		{
+			name:               "height: before",
+			goodTilBlockHeight: 50,
+			blockTime:          gtt,
+			blockHeight:        49,
+			exp:                false,
+		},
+		{
+			name:               "height: equal",
+			goodTilBlockHeight: 50,
+			blockTime:          gtt,
+			blockHeight:        50,
+			exp:                true,
+		},
+		{
+			name:               "height: after",
+			goodTilBlockHeight: 50,
+			blockTime:          gtt,
+			blockHeight:        51,
+			exp:                true,
+		},
+		{
+			name:               "both: neither reached",
+			goodTilTime:        &gtt,
+			goodTilBlockHeight: 50,
+			blockTime:          gtt.Add(-1 * time.Second),
+			blockHeight:        49,
+			exp:                false,
+		},
+		{
+			name:               "both: only time reached",
+			goodTilTime:        &gtt,
+			goodTilBlockHeight: 50,
+			blockTime:          gtt,
+			blockHeight:        49,
+			exp:                true,
+		},
+		{
+			name:               "both: only height reached",
+			goodTilTime:        &gtt,
+			goodTilBlockHeight: 50,
+			blockTime:          gtt.Add(-1 * time.Second),
+			blockHeight:        50,
+			exp:                true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act bool
+			testFunc := func() {
+				act = IsExpired(tc.goodTilTime, tc.goodTilBlockHeight, tc.blockTime, tc.blockHeight)
+			}
+			require.NotPanics(t, testFunc, "IsExpired")
+			assert.Equal(t, tc.exp, act, "IsExpired")
+		})
+	}
+}
+
 func TestOrderSizes(t *testing.T) {
 	// This unit test is mostly just to see the sizes of different orders and compare
 	// that to the initial array size used in getOrderStoreKeyValue.
@@ -1660,6 +1768,10 @@ func TestAskOrder_Validate(t *testing.T) {
 		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
 
+	goodTilTime := time.Unix(1_700_000_000, 0).UTC()
+	zeroTime := time.Unix(0, 0).UTC()
+	negTime := time.Unix(-1, 0).UTC()
+
 	tests := []struct {
 		name  string
 		order AskOrder
@@ -1854,6 +1966,43 @@ func TestAskOrder_Validate(t *testing.T) {
 			},
 			exp: []string{"invalid seller settlement flat fee", "negative coin amount: -3"},
 		},
+		{
+			name: "good til time and height",
+			order: AskOrder{
+				MarketId:                1,
+				Seller:                  sdk.AccAddress("control_address_____").String(),
+				Assets:                  *coin(99, "bender"),
+				Price:                   *coin(42, "farnsworth"),
+				SellerSettlementFlatFee: coin(1, "farnsworth"),
+				GoodTilTime:             &goodTilTime,
+				GoodTilBlockHeight:      5,
+			},
+			exp: nil,
+		},
+		{
+			name: "good til time zero",
+			order: AskOrder{
+				MarketId:                1,
+				Seller:                  sdk.AccAddress("control_address_____").String(),
+				Assets:                  *coin(99, "bender"),
+				Price:                   *coin(42, "farnsworth"),
+				SellerSettlementFlatFee: coin(1, "farnsworth"),
+				GoodTilTime:             &zeroTime,
+			},
+			exp: []string{"invalid good til time 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
+		{
+			name: "good til time before epoch",
+			order: AskOrder{
+				MarketId:                1,
+				Seller:                  sdk.AccAddress("control_address_____").String(),
+				Assets:                  *coin(99, "bender"),
+				Price:                   *coin(42, "farnsworth"),
+				SellerSettlementFlatFee: coin(1, "farnsworth"),
+				GoodTilTime:             &negTime,
+			},
+			exp: []string{"invalid good til time 1969-12-31T23:59:59Z: must be after 1970-01-01T00:00:00Z"},
+		},
 		{
 			name: "multiple problems",
 			order: AskOrder{
@@ -2278,6 +2427,10 @@ func TestBidOrder_Validate(t *testing.T) {
 		return rv
 	}
 
+	goodTilTime := time.Unix(1_700_000_000, 0).UTC()
+	zeroTime := time.Unix(0, 0).UTC()
+	negTime := time.Unix(-1, 0).UTC()
+
 	tests := []struct {
 		name  string
 		order BidOrder
@@ -2473,6 +2626,43 @@ func TestBidOrder_Validate(t *testing.T) {
 			},
 			exp: []string{"invalid buyer settlement fees", "coin nibbler amount is not positive"},
 		},
+		{
+			name: "good til time and height",
+			order: BidOrder{
+				MarketId:            1,
+				Buyer:               sdk.AccAddress("control_address_____").String(),
+				Assets:              coin(99, "bender"),
+				Price:               coin(42, "farnsworth"),
+				BuyerSettlementFees: coins("1farnsworth"),
+				GoodTilTime:         &goodTilTime,
+				GoodTilBlockHeight:  5,
+			},
+			exp: nil,
+		},
+		{
+			name: "good til time zero",
+			order: BidOrder{
+				MarketId:            1,
+				Buyer:               sdk.AccAddress("control_address_____").String(),
+				Assets:              coin(99, "bender"),
+				Price:               coin(42, "farnsworth"),
+				BuyerSettlementFees: coins("1farnsworth"),
+				GoodTilTime:         &zeroTime,
+			},
+			exp: []string{"invalid good til time 1970-01-01T00:00:00Z: must be after 1970-01-01T00:00:00Z"},
+		},
+		{
+			name: "good til time before epoch",
+			order: BidOrder{
+				MarketId:            1,
+				Buyer:               sdk.AccAddress("control_address_____").String(),
+				Assets:              coin(99, "bender"),
+				Price:               coin(42, "farnsworth"),
+				BuyerSettlementFees: coins("1farnsworth"),
+				GoodTilTime:         &negTime,
+			},
+			exp: []string{"invalid good til time 1969-12-31T23:59:59Z: must be after 1970-01-01T00:00:00Z"},
+		},
 		{
 			name: "multiple problems",
 			order: BidOrder{
@@ -2630,6 +2820,7 @@ func TestNewFilledOrder(t *testing.T) {
 }
 
 func TestFilledOrderGetters(t *testing.T) {
+	askGTT := time.Unix(1_700_000_000, 0).UTC()
 	askOrder := &AskOrder{
 		MarketId:                333,
 		Seller:                  "SEllER",
@@ -2638,6 +2829,8 @@ func TestFilledOrderGetters(t *testing.T) {
 		SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(8)},
 		AllowPartial:            true,
 		ExternalId:              "ask order abc",
+		GoodTilTime:             &askGTT,
+		GoodTilBlockHeight:      1001,
 	}
 	ask := NewOrder(51).WithAsk(askOrder)
 	askActualPrice := sdk.NewInt64Coin("peach", 123)
@@ -2652,6 +2845,7 @@ func TestFilledOrderGetters(t *testing.T) {
 		BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 9)),
 		AllowPartial:        true,
 		ExternalId:          "bid order def",
+		GoodTilBlockHeight:  1002,
 	}
 	bid := NewOrder(52).WithBid(bidOrder)
 	bidActualPrice := sdk.NewInt64Coin("peach", 124)
@@ -2760,6 +2954,18 @@ func TestFilledOrderGetters(t *testing.T) {
 			expAsk: askOrder.GetHoldAmount(),
 			expBid: bidOrder.GetHoldAmount(),
 		},
+		{
+			name:   "GetGoodTilTime",
+			getter: func(of *FilledOrder) interface{} { return of.GetGoodTilTime() },
+			expAsk: &askGTT,
+			expBid: (*time.Time)(nil),
+		},
+		{
+			name:   "GetGoodTilBlockHeight",
+			getter: func(of *FilledOrder) interface{} { return of.GetGoodTilBlockHeight() },
+			expAsk: uint64(1001),
+			expBid: uint64(1002),
+		},
 		{
 			name:   "Validate",
 			getter: func(of *FilledOrder) interface{} { return of.Validate() },
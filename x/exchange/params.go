@@ -19,6 +19,8 @@ const (
 
 	// MaxSplit is the maximum split value. 10,000 basis points = 100%.
 	MaxSplit = uint32(10_000)
+	// MaxNavReferenceDenoms is the maximum number of entries allowed in the NavReferenceDenoms param.
+	MaxNavReferenceDenoms = 10
 )
 
 // DefaultParams returns the default exchange module params.
@@ -50,6 +52,9 @@ func (p Params) Validate() error {
 	if err := validatePaymentFlatFee("accept", p.FeeAcceptPaymentFlat); err != nil {
 		errs = append(errs, err)
 	}
+	if err := validateNavReferenceDenoms(p.NavReferenceDenoms); err != nil {
+		errs = append(errs, err)
+	}
 
 	return errors.Join(errs...)
 }
@@ -94,3 +99,23 @@ func validatePaymentFlatFee(name string, feeOpts []sdk.Coin) error {
 		return fmt.Errorf("invalid %s payment flat fee %q: max entries is 1", name, sdk.Coins(feeOpts).String())
 	}
 }
+
+// validateNavReferenceDenoms makes sure that the provided nav reference denoms are valid, unique, and not too many.
+func validateNavReferenceDenoms(denoms []string) error {
+	if len(denoms) > MaxNavReferenceDenoms {
+		return fmt.Errorf("invalid nav reference denoms: cannot have more than %d entries", MaxNavReferenceDenoms)
+	}
+	var errs []error
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			errs = append(errs, fmt.Errorf("invalid nav reference denom: %w", err))
+			continue
+		}
+		if seen[denom] {
+			errs = append(errs, fmt.Errorf("duplicate nav reference denom %q", denom))
+		}
+		seen[denom] = true
+	}
+	return errors.Join(errs...)
+}
@@ -41,6 +41,10 @@ type Params struct {
 	// If the target amount is not zero then one of these fee entries is required to accept the payment.
 	// This field is currently limited to zero or one entries.
 	FeeAcceptPaymentFlat []types.Coin `protobuf:"bytes,4,rep,name=fee_accept_payment_flat,json=feeAcceptPaymentFlat,proto3" json:"fee_accept_payment_flat"`
+	// nav_reference_denoms are denoms that can be used to derive a NAV between two denoms that do not have one.
+	// They are tried in order, after a market's intermediary denom, when there is no NAV from one denom to the other.
+	// This field is limited to 10 entries.
+	NavReferenceDenoms []string `protobuf:"bytes,5,rep,name=nav_reference_denoms,json=navReferenceDenoms,proto3" json:"nav_reference_denoms,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -104,6 +108,13 @@ func (m *Params) GetFeeAcceptPaymentFlat() []types.Coin {
 	return nil
 }
 
+func (m *Params) GetNavReferenceDenoms() []string {
+	if m != nil {
+		return m.NavReferenceDenoms
+	}
+	return nil
+}
+
 // DenomSplit associates a coin denomination with an amount the exchange receives for that denom.
 type DenomSplit struct {
 	// denom is the coin denomination this split applies to.
@@ -170,31 +181,32 @@ func init() {
 }
 
 var fileDescriptor_5d689cfc7a7422f1 = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
-	0x1c, 0xc6, 0x5b, 0xf8, 0xfd, 0x48, 0x38, 0x60, 0xb0, 0x21, 0x0a, 0x0c, 0x95, 0xc0, 0x42, 0x4c,
-	0xbc, 0x0b, 0xba, 0xb8, 0x0a, 0xc6, 0xb9, 0xc1, 0x4d, 0x87, 0xe6, 0x7a, 0x7c, 0x5b, 0x9a, 0xb4,
-	0x77, 0x4d, 0x7b, 0x10, 0x7c, 0x17, 0xbe, 0x0c, 0x47, 0x57, 0xdf, 0x01, 0x23, 0xa3, 0x93, 0x31,
-	0x30, 0xf8, 0x36, 0x4c, 0xef, 0xf8, 0x67, 0xa2, 0x83, 0x4b, 0xf3, 0xfd, 0x3e, 0x7d, 0xfa, 0x69,
-	0x9f, 0xa7, 0x87, 0xba, 0x49, 0x2a, 0x66, 0xc0, 0x29, 0x67, 0x40, 0x60, 0xce, 0x26, 0x94, 0x07,
-	0x40, 0x66, 0x7d, 0x92, 0xd0, 0x94, 0xc6, 0x19, 0x4e, 0x52, 0x21, 0x85, 0x75, 0xbc, 0x37, 0xe1,
-	0xad, 0x09, 0xcf, 0xfa, 0xad, 0x23, 0x1a, 0x87, 0x5c, 0x10, 0x75, 0xd5, 0xd6, 0x56, 0x3d, 0x10,
-	0x81, 0x50, 0x23, 0xc9, 0xa7, 0x8d, 0x6a, 0x33, 0x91, 0xc5, 0x22, 0x23, 0x1e, 0xcd, 0x72, 0xba,
-	0x07, 0x92, 0xf6, 0x09, 0x13, 0x21, 0xd7, 0xf7, 0x3b, 0xaf, 0x05, 0x54, 0x72, 0xd4, 0x1b, 0xad,
-	0x2e, 0xaa, 0x8d, 0xc1, 0xa7, 0xd3, 0x48, 0xba, 0x59, 0x12, 0x85, 0xb2, 0x61, 0xb6, 0xcd, 0x5e,
-	0x6d, 0x54, 0xdd, 0x88, 0x77, 0xb9, 0x66, 0x39, 0xa8, 0x3a, 0x06, 0x2e, 0x62, 0x6d, 0xc9, 0x1a,
-	0x85, 0x76, 0xb1, 0x57, 0xb9, 0xe8, 0xe0, 0x9f, 0xbf, 0x13, 0xdf, 0xe4, 0x5e, 0xf5, 0xe4, 0xa0,
-	0xbc, 0x78, 0x3f, 0x35, 0x9e, 0x3f, 0x5f, 0xce, 0xcc, 0x51, 0x65, 0xbc, 0x93, 0x33, 0xeb, 0x01,
-	0x9d, 0xf8, 0x00, 0x2e, 0x4b, 0x81, 0x4a, 0x70, 0x13, 0xfa, 0x18, 0x03, 0x97, 0xae, 0x1f, 0x51,
-	0xd9, 0x28, 0x2a, 0x78, 0x13, 0xeb, 0x0c, 0x38, 0xcf, 0x80, 0x37, 0x19, 0xf0, 0x50, 0x84, 0xfc,
-	0x90, 0x59, 0xf7, 0x01, 0x86, 0x8a, 0xe1, 0x68, 0xc4, 0x6d, 0x44, 0xe5, 0x16, 0x4e, 0x19, 0x83,
-	0x44, 0x7e, 0x87, 0xff, 0xfb, 0x23, 0xfc, 0x5a, 0x31, 0x0e, 0xe0, 0x9d, 0x2b, 0x84, 0xf6, 0xf9,
-	0xac, 0x3a, 0xfa, 0xaf, 0x62, 0xa9, 0xda, 0xca, 0x23, 0xbd, 0xe4, 0xaa, 0x2e, 0xb3, 0xa0, 0xca,
-	0xd4, 0xcb, 0x00, 0x16, 0x2b, 0xdb, 0x5c, 0xae, 0x6c, 0xf3, 0x63, 0x65, 0x9b, 0x4f, 0x6b, 0xdb,
-	0x58, 0xae, 0x6d, 0xe3, 0x6d, 0x6d, 0x1b, 0xa8, 0x19, 0x8a, 0x5f, 0xba, 0x74, 0xcc, 0x7b, 0x1c,
-	0x84, 0x72, 0x32, 0xf5, 0x30, 0x13, 0x31, 0xd9, 0x9b, 0xce, 0x43, 0x71, 0xb0, 0x91, 0xf9, 0xee,
-	0x34, 0x79, 0x25, 0xf5, 0x8f, 0x2f, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x9c, 0x91, 0x75,
-	0x6b, 0x02, 0x00, 0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbd, 0xee, 0xd3, 0x30,
+	0x14, 0xc5, 0x93, 0x96, 0x56, 0xaa, 0xdb, 0x0e, 0x44, 0x11, 0xa4, 0x1d, 0x42, 0xd5, 0x2e, 0x15,
+	0x12, 0x36, 0x85, 0x85, 0x95, 0x16, 0x31, 0x47, 0x61, 0x83, 0x21, 0x72, 0xdc, 0x9b, 0x34, 0x52,
+	0x62, 0x47, 0xb1, 0x1b, 0x95, 0xb7, 0xe0, 0x31, 0x18, 0x79, 0x8c, 0x8e, 0x1d, 0x99, 0x10, 0x6a,
+	0x07, 0x06, 0x5e, 0x02, 0xc5, 0xee, 0x17, 0x12, 0x0c, 0xff, 0x25, 0xf2, 0x3d, 0x3e, 0xfe, 0xc5,
+	0xe7, 0xc8, 0x68, 0x56, 0x56, 0xa2, 0x06, 0x4e, 0x39, 0x03, 0x02, 0x3b, 0xb6, 0xa1, 0x3c, 0x05,
+	0x52, 0x2f, 0x48, 0x49, 0x2b, 0x5a, 0x48, 0x5c, 0x56, 0x42, 0x09, 0xe7, 0xc9, 0xcd, 0x84, 0x2f,
+	0x26, 0x5c, 0x2f, 0xc6, 0x8f, 0x69, 0x91, 0x71, 0x41, 0xf4, 0xd7, 0x58, 0xc7, 0x6e, 0x2a, 0x52,
+	0xa1, 0x97, 0xa4, 0x59, 0x9d, 0x55, 0x9f, 0x09, 0x59, 0x08, 0x49, 0x62, 0x2a, 0x1b, 0x7a, 0x0c,
+	0x8a, 0x2e, 0x08, 0x13, 0x19, 0x37, 0xfb, 0xd3, 0xdf, 0x2d, 0xd4, 0x0d, 0xf4, 0x1f, 0x9d, 0x19,
+	0x1a, 0xae, 0x21, 0xa1, 0xdb, 0x5c, 0x45, 0xb2, 0xcc, 0x33, 0xe5, 0xd9, 0x13, 0x7b, 0x3e, 0x0c,
+	0x07, 0x67, 0xf1, 0x43, 0xa3, 0x39, 0x01, 0x1a, 0xac, 0x81, 0x8b, 0xc2, 0x58, 0xa4, 0xd7, 0x9a,
+	0xb4, 0xe7, 0xfd, 0x57, 0x53, 0xfc, 0xef, 0x7b, 0xe2, 0x77, 0x8d, 0x57, 0x9f, 0x5c, 0xf6, 0xf6,
+	0x3f, 0x9e, 0x59, 0x5f, 0x7f, 0x7d, 0x7b, 0x6e, 0x87, 0xfd, 0xf5, 0x55, 0x96, 0xce, 0x27, 0xf4,
+	0x34, 0x01, 0x88, 0x58, 0x05, 0x54, 0x41, 0x54, 0xd2, 0xcf, 0x05, 0x70, 0x15, 0x25, 0x39, 0x55,
+	0x5e, 0x5b, 0xc3, 0x47, 0xd8, 0x64, 0xc0, 0x4d, 0x06, 0x7c, 0xce, 0x80, 0x57, 0x22, 0xe3, 0xf7,
+	0x4c, 0x37, 0x01, 0x58, 0x69, 0x46, 0x60, 0x10, 0xef, 0x73, 0xaa, 0x2e, 0x70, 0xca, 0x18, 0x94,
+	0xea, 0x6f, 0xf8, 0xa3, 0x07, 0xc2, 0xdf, 0x6a, 0xc6, 0x3d, 0xfc, 0x25, 0x72, 0x39, 0xad, 0xa3,
+	0x0a, 0x12, 0xa8, 0x80, 0x33, 0x88, 0x74, 0x2c, 0xe9, 0x75, 0x26, 0xed, 0x79, 0x2f, 0x74, 0x38,
+	0xad, 0xc3, 0xcb, 0x96, 0xee, 0x41, 0x4e, 0xdf, 0x20, 0x74, 0x6b, 0xc4, 0x71, 0x51, 0x47, 0x9f,
+	0xd0, 0x45, 0xf7, 0x42, 0x33, 0x34, 0xaa, 0xa9, 0xbf, 0xa5, 0xeb, 0x37, 0xc3, 0x12, 0xf6, 0x47,
+	0xdf, 0x3e, 0x1c, 0x7d, 0xfb, 0xe7, 0xd1, 0xb7, 0xbf, 0x9c, 0x7c, 0xeb, 0x70, 0xf2, 0xad, 0xef,
+	0x27, 0xdf, 0x42, 0xa3, 0x4c, 0xfc, 0xa7, 0xfd, 0xc0, 0xfe, 0x88, 0xd3, 0x4c, 0x6d, 0xb6, 0x31,
+	0x66, 0xa2, 0x20, 0x37, 0xd3, 0x8b, 0x4c, 0xdc, 0x4d, 0x64, 0x77, 0x7d, 0x7f, 0x71, 0x57, 0xbf,
+	0x8a, 0xd7, 0x7f, 0x06, 0x00, 0xe8, 0x84, 0x59, 0xda, 0x9d, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -217,6 +229,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NavReferenceDenoms) > 0 {
+		for iNdEx := len(m.NavReferenceDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NavReferenceDenoms[iNdEx])
+			copy(dAtA[i:], m.NavReferenceDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.NavReferenceDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.FeeAcceptPaymentFlat) > 0 {
 		for iNdEx := len(m.FeeAcceptPaymentFlat) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -340,6 +361,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.NavReferenceDenoms) > 0 {
+		for _, s := range m.NavReferenceDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -515,6 +542,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NavReferenceDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NavReferenceDenoms = append(m.NavReferenceDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
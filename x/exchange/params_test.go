@@ -107,6 +107,26 @@ func TestParams_Validate(t *testing.T) {
 			params: Params{FeeAcceptPaymentFlat: []sdk.Coin{{Denom: "banana", Amount: sdkmath.NewInt(-1)}}},
 			expErr: []string{"invalid accept payment flat fee \"-1banana\": negative coin amount: -1"},
 		},
+		{
+			name:   "two nav reference denoms",
+			params: Params{NavReferenceDenoms: []string{"nhash", "usd.trading"}},
+			expErr: nil,
+		},
+		{
+			name:   "too many nav reference denoms",
+			params: Params{NavReferenceDenoms: []string{"aa1", "aa2", "aa3", "aa4", "aa5", "aa6", "aa7", "aa8", "aa9", "aa10", "aa11"}},
+			expErr: []string{"invalid nav reference denoms: cannot have more than 10 entries"},
+		},
+		{
+			name:   "invalid nav reference denom",
+			params: Params{NavReferenceDenoms: []string{"nhash", "x"}},
+			expErr: []string{"invalid nav reference denom: invalid denom: x"},
+		},
+		{
+			name:   "duplicate nav reference denom",
+			params: Params{NavReferenceDenoms: []string{"nhash", "usd.trading", "nhash"}},
+			expErr: []string{"duplicate nav reference denom \"nhash\""},
+		},
 		{
 			name: "multiple errors",
 			params: Params{
@@ -2502,6 +2502,116 @@ func (m *QueryPaymentFeeCalcResponse) GetFeeAccept() github_com_cosmos_cosmos_sd
 	return nil
 }
 
+// QueryResolveNavRequest is a request message for the ResolveNav query.
+type QueryResolveNavRequest struct {
+	// assets_denom is the denom to get the value of.
+	AssetsDenom string `protobuf:"bytes,1,opt,name=assets_denom,json=assetsDenom,proto3" json:"assets_denom,omitempty"`
+	// price_denom is the denom to get the value in.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// market_id is the optional id of a market whose intermediary denom should be tried first when deriving a NAV.
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *QueryResolveNavRequest) Reset()         { *m = QueryResolveNavRequest{} }
+func (m *QueryResolveNavRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveNavRequest) ProtoMessage()    {}
+func (*QueryResolveNavRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{46}
+}
+func (m *QueryResolveNavRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveNavRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveNavRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveNavRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveNavRequest.Merge(m, src)
+}
+func (m *QueryResolveNavRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveNavRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveNavRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveNavRequest proto.InternalMessageInfo
+
+func (m *QueryResolveNavRequest) GetAssetsDenom() string {
+	if m != nil {
+		return m.AssetsDenom
+	}
+	return ""
+}
+
+func (m *QueryResolveNavRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *QueryResolveNavRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+// QueryResolveNavResponse is a response message for the ResolveNav query.
+type QueryResolveNavResponse struct {
+	// nav is the resolved NAV along with how it was found.
+	Nav *ResolvedNav `protobuf:"bytes,1,opt,name=nav,proto3" json:"nav,omitempty"`
+}
+
+func (m *QueryResolveNavResponse) Reset()         { *m = QueryResolveNavResponse{} }
+func (m *QueryResolveNavResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveNavResponse) ProtoMessage()    {}
+func (*QueryResolveNavResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{47}
+}
+func (m *QueryResolveNavResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveNavResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveNavResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveNavResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveNavResponse.Merge(m, src)
+}
+func (m *QueryResolveNavResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveNavResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveNavResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveNavResponse proto.InternalMessageInfo
+
+func (m *QueryResolveNavResponse) GetNav() *ResolvedNav {
+	if m != nil {
+		return m.Nav
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryOrderFeeCalcRequest)(nil), "provenance.exchange.v1.QueryOrderFeeCalcRequest")
 	proto.RegisterType((*QueryOrderFeeCalcResponse)(nil), "provenance.exchange.v1.QueryOrderFeeCalcResponse")
@@ -2549,6 +2659,8 @@ func init() {
 	proto.RegisterType((*QueryGetAllPaymentsResponse)(nil), "provenance.exchange.v1.QueryGetAllPaymentsResponse")
 	proto.RegisterType((*QueryPaymentFeeCalcRequest)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcRequest")
 	proto.RegisterType((*QueryPaymentFeeCalcResponse)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcResponse")
+	proto.RegisterType((*QueryResolveNavRequest)(nil), "provenance.exchange.v1.QueryResolveNavRequest")
+	proto.RegisterType((*QueryResolveNavResponse)(nil), "provenance.exchange.v1.QueryResolveNavResponse")
 }
 
 func init() {
@@ -2556,159 +2668,165 @@ func init() {
 }
 
 var fileDescriptor_00949b75b1c10bfe = []byte{
-	// 2424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0xdc, 0xd6,
-	0xf5, 0xf6, 0x95, 0x2d, 0x59, 0x3a, 0xb6, 0x65, 0xf8, 0x5a, 0xf6, 0x6f, 0x34, 0xb6, 0x47, 0x32,
-	0xfd, 0x12, 0x64, 0x7b, 0x68, 0x49, 0xb6, 0x22, 0xe7, 0x07, 0xd7, 0x91, 0x94, 0x4a, 0x30, 0xd0,
-	0xd8, 0xca, 0x58, 0xa8, 0x03, 0x01, 0xed, 0x84, 0xe2, 0x5c, 0x8d, 0x09, 0x71, 0xc8, 0x09, 0x49,
-	0x8d, 0x25, 0x08, 0x02, 0xda, 0xf4, 0x11, 0x24, 0x8b, 0xa2, 0x40, 0x17, 0x4d, 0x1b, 0x34, 0x5d,
-	0xb8, 0x68, 0x8b, 0x6c, 0xe2, 0x45, 0xbb, 0x2a, 0x8a, 0x2c, 0xba, 0xa8, 0x37, 0x05, 0x82, 0x76,
-	0xd3, 0x02, 0x45, 0x1b, 0xd8, 0x05, 0xb2, 0x69, 0xff, 0x85, 0xa2, 0xe0, 0xbd, 0x87, 0x43, 0x72,
-	0x86, 0xaf, 0x51, 0x26, 0x86, 0x36, 0x1e, 0x91, 0x3c, 0xe7, 0x9e, 0xef, 0x7c, 0xf7, 0x71, 0xee,
-	0xfd, 0xae, 0x41, 0xaa, 0x5b, 0x66, 0x83, 0x19, 0x8a, 0xa1, 0x32, 0x99, 0x6d, 0xaa, 0x0f, 0x15,
-	0xa3, 0xca, 0xe4, 0xc6, 0x84, 0xfc, 0xd6, 0x06, 0xb3, 0xb6, 0x8a, 0x75, 0xcb, 0x74, 0x4c, 0x7a,
-	0xd2, 0xb7, 0x29, 0x7a, 0x36, 0xc5, 0xc6, 0x44, 0xfe, 0x98, 0x52, 0xd3, 0x0c, 0x53, 0xe6, 0xff,
-	0x0a, 0xd3, 0xfc, 0xb0, 0x6a, 0xda, 0x35, 0xd3, 0x2e, 0xf3, 0x27, 0x59, 0x3c, 0xe0, 0xa7, 0x71,
-	0xf1, 0x24, 0xaf, 0x2a, 0x36, 0x13, 0xcd, 0xcb, 0x8d, 0x89, 0x55, 0xe6, 0x28, 0x13, 0x72, 0x5d,
-	0xa9, 0x6a, 0x86, 0xe2, 0x68, 0xa6, 0x81, 0xb6, 0x85, 0xa0, 0xad, 0x67, 0xa5, 0x9a, 0x9a, 0xf7,
-	0xfd, 0x74, 0xd5, 0x34, 0xab, 0x3a, 0x93, 0x95, 0xba, 0x26, 0x2b, 0x86, 0x61, 0x3a, 0xdc, 0xd9,
-	0x8b, 0x34, 0x54, 0x35, 0xab, 0xa6, 0x40, 0xe0, 0xfe, 0x85, 0x6f, 0xc7, 0x62, 0x32, 0x55, 0xcd,
-	0x5a, 0x4d, 0x73, 0x6a, 0xcc, 0x70, 0x3c, 0xff, 0x73, 0x31, 0x96, 0x35, 0xc5, 0x5a, 0x67, 0x4e,
-	0x8a, 0x91, 0x69, 0x55, 0x98, 0x95, 0xd6, 0x52, 0x5d, 0xb1, 0x94, 0x9a, 0x67, 0x74, 0x21, 0xd6,
-	0x68, 0x2b, 0x88, 0x6a, 0x24, 0xc6, 0xcc, 0xd9, 0x14, 0x06, 0xd2, 0xfb, 0x04, 0x72, 0xaf, 0xbb,
-	0xbc, 0xde, 0x73, 0x21, 0x2c, 0x30, 0x36, 0xaf, 0xe8, 0x6a, 0x89, 0xbd, 0xb5, 0xc1, 0x6c, 0x87,
-	0xde, 0x82, 0x01, 0xc5, 0x5e, 0x2f, 0x73, 0x74, 0xb9, 0x9e, 0x51, 0x32, 0x76, 0x68, 0x72, 0xb4,
-	0x18, 0xdd, 0xaf, 0xc5, 0x59, 0x7b, 0x9d, 0x37, 0x51, 0xea, 0x57, 0xf0, 0x2f, 0xd7, 0x7d, 0x55,
-	0xab, 0xa0, 0xfb, 0xfe, 0x64, 0xf7, 0x39, 0xad, 0x82, 0xee, 0xab, 0xf8, 0x97, 0xf4, 0xa4, 0x07,
-	0x86, 0x23, 0xa0, 0xd9, 0x75, 0xd3, 0xb0, 0x19, 0x7d, 0x1d, 0x86, 0x54, 0x8b, 0xf1, 0x2e, 0x2c,
-	0xaf, 0x31, 0x56, 0x36, 0xeb, 0xbc, 0x37, 0x73, 0x64, 0x74, 0xff, 0xd8, 0xa1, 0xc9, 0xe1, 0x22,
-	0x0e, 0x23, 0x77, 0x30, 0x14, 0x71, 0x30, 0x14, 0xe7, 0x4d, 0xcd, 0x98, 0x3b, 0xf0, 0xf4, 0x1f,
-	0x23, 0xfb, 0x4a, 0xd4, 0x73, 0x5e, 0x60, 0xec, 0x9e, 0x70, 0xa5, 0xdf, 0x84, 0x53, 0x36, 0x73,
-	0x1c, 0x9d, 0xb9, 0x0c, 0x96, 0xd7, 0x74, 0xc5, 0x09, 0xb5, 0xdc, 0x93, 0xad, 0xe5, 0x9c, 0xdf,
-	0xc6, 0x82, 0xae, 0x38, 0x81, 0xf6, 0xdf, 0x84, 0xd3, 0x81, 0xf6, 0x2d, 0x37, 0x7c, 0x28, 0xc0,
-	0xfe, 0x6c, 0x01, 0x86, 0xfd, 0x46, 0x4a, 0x6e, 0x1b, 0x7e, 0x04, 0x69, 0x02, 0x86, 0x38, 0x63,
-	0x8b, 0xcc, 0x11, 0x6c, 0x62, 0x47, 0x0e, 0x43, 0x3f, 0xef, 0x85, 0xb2, 0x56, 0xc9, 0x91, 0x51,
-	0x32, 0x76, 0xa0, 0x74, 0x90, 0x3f, 0xdf, 0xa9, 0x48, 0x5f, 0x83, 0x13, 0x2d, 0x2e, 0x48, 0xf0,
-	0x14, 0xf4, 0x8a, 0x9e, 0x23, 0xbc, 0xe7, 0xce, 0xc4, 0xf5, 0x9c, 0xf0, 0x12, 0xb6, 0xd2, 0x9b,
-	0x30, 0x1a, 0x6a, 0x6d, 0x6e, 0xeb, 0xab, 0x9b, 0x0e, 0xb3, 0x0c, 0x45, 0xbf, 0xf3, 0xaa, 0x07,
-	0xe6, 0x14, 0x0c, 0x88, 0x49, 0xe1, 0xa1, 0x39, 0x52, 0xea, 0x17, 0x2f, 0xee, 0x54, 0xe8, 0x08,
-	0x1c, 0x62, 0xe8, 0xe1, 0x7e, 0x76, 0x07, 0xdd, 0x40, 0x09, 0xbc, 0x57, 0x77, 0x2a, 0xd2, 0x1b,
-	0x70, 0x36, 0x21, 0xc2, 0x17, 0xc1, 0xfe, 0x47, 0x02, 0xa7, 0xbc, 0xa6, 0x5f, 0xe3, 0x78, 0xf8,
-	0x67, 0x3b, 0x13, 0xee, 0x33, 0x00, 0x82, 0x61, 0x67, 0xab, 0xce, 0x10, 0xf6, 0x00, 0x7f, 0xb3,
-	0xbc, 0x55, 0x67, 0xf4, 0x3c, 0x0c, 0x2a, 0x6b, 0x0e, 0xb3, 0xca, 0xcd, 0x6e, 0xd8, 0xcf, 0xbb,
-	0xe1, 0x30, 0x7f, 0x7b, 0x4f, 0xf4, 0x05, 0x5d, 0x00, 0xf0, 0x57, 0xb5, 0x9c, 0xca, 0xb1, 0x5f,
-	0x0c, 0x0d, 0x07, 0xb1, 0xc2, 0x7a, 0x83, 0x62, 0x49, 0xa9, 0x32, 0x44, 0x57, 0x0a, 0x78, 0x4a,
-	0x1f, 0x12, 0x38, 0x1d, 0x9d, 0x09, 0xf2, 0x73, 0x03, 0xfa, 0xc4, 0x92, 0x83, 0xd3, 0x25, 0x85,
-	0x20, 0x34, 0xa6, 0x8b, 0x11, 0xf8, 0x2e, 0xa5, 0xe2, 0x13, 0x31, 0x43, 0x00, 0xff, 0x46, 0x20,
-	0xdf, 0xec, 0xc5, 0x47, 0x06, 0x32, 0xd0, 0x64, 0xba, 0x08, 0xbd, 0xa6, 0xfb, 0x96, 0xb3, 0x3c,
-	0x30, 0x97, 0xfb, 0xf3, 0x6f, 0xae, 0x0e, 0x61, 0x94, 0xd9, 0x4a, 0xc5, 0x62, 0xb6, 0x7d, 0xdf,
-	0xb1, 0x34, 0xa3, 0x5a, 0x12, 0x66, 0x7b, 0x8b, 0xfc, 0x9f, 0x05, 0x86, 0x51, 0x28, 0xb7, 0x3d,
-	0xc2, 0xfd, 0x27, 0x01, 0xee, 0x67, 0x6d, 0xbb, 0x75, 0x94, 0x0f, 0x41, 0xaf, 0xe2, 0xbe, 0x15,
-	0xdc, 0x97, 0xc4, 0xc3, 0xde, 0x65, 0x38, 0x94, 0xc1, 0x1e, 0x61, 0x78, 0x15, 0x4b, 0xaa, 0x0b,
-	0x4f, 0xd7, 0xc3, 0xf4, 0x76, 0x8b, 0x83, 0x0f, 0x08, 0x16, 0xc7, 0x70, 0x90, 0x3d, 0xc2, 0x80,
-	0xee, 0x83, 0x9b, 0x6f, 0xee, 0x94, 0x3c, 0x0a, 0x26, 0xe1, 0xa0, 0xa2, 0xaa, 0xe6, 0x86, 0xe1,
-	0xa4, 0xce, 0x6f, 0xcf, 0x30, 0xbc, 0xf6, 0xf6, 0x84, 0xd7, 0x5e, 0xe9, 0xc7, 0x81, 0x11, 0x1d,
-	0x0c, 0x87, 0x64, 0x6c, 0x41, 0x9f, 0x52, 0xc3, 0x70, 0x29, 0x05, 0x76, 0xc1, 0x2d, 0xb0, 0x1f,
-	0xfd, 0x73, 0x64, 0xac, 0xaa, 0x39, 0x0f, 0x37, 0x56, 0x8b, 0xaa, 0x59, 0xc3, 0xfd, 0x28, 0xfe,
-	0x5c, 0xb5, 0x2b, 0xeb, 0xb2, 0x3b, 0x07, 0x6c, 0xee, 0x60, 0xff, 0xf4, 0xf3, 0x27, 0xe3, 0x87,
-	0x75, 0x56, 0x55, 0xd4, 0xad, 0xb2, 0xbb, 0xd5, 0xb4, 0x7f, 0xfd, 0xf9, 0x93, 0x71, 0x52, 0xc2,
-	0x80, 0xd2, 0x03, 0xbf, 0x58, 0xcd, 0x8a, 0x4c, 0x7c, 0x7c, 0xf6, 0x17, 0xe0, 0x43, 0xd2, 0x41,
-	0x4a, 0x6a, 0x18, 0x33, 0x5f, 0x80, 0x43, 0x81, 0x8d, 0x2a, 0xa6, 0x7f, 0x3e, 0x6e, 0x2c, 0x88,
-	0x4a, 0x31, 0xcb, 0x91, 0x97, 0x82, 0x8e, 0xd2, 0x3b, 0xc4, 0x2f, 0xeb, 0xc2, 0x2a, 0x22, 0x8d,
-	0xc4, 0xf2, 0xd8, 0xad, 0x61, 0xff, 0x5b, 0xe2, 0x33, 0x1a, 0x81, 0x04, 0xf3, 0x5e, 0x8c, 0xca,
-	0xfb, 0x42, 0xec, 0xce, 0x55, 0x10, 0x18, 0x91, 0x78, 0xf7, 0x26, 0x44, 0x15, 0xce, 0x04, 0x66,
-	0x6b, 0x04, 0x7b, 0xdd, 0x22, 0xe8, 0x63, 0x02, 0x85, 0xb8, 0x48, 0xc8, 0xce, 0xab, 0x51, 0xec,
-	0x48, 0x71, 0xec, 0x04, 0x26, 0xd4, 0x97, 0x43, 0xcd, 0x75, 0x7f, 0x03, 0x2a, 0x7a, 0x34, 0xcb,
-	0x80, 0x92, 0xbe, 0x4b, 0xe0, 0x64, 0xab, 0x1b, 0xe6, 0xe7, 0xce, 0x27, 0x31, 0x6b, 0x32, 0xcc,
-	0x27, 0xf1, 0x48, 0xa7, 0xa1, 0x4f, 0x34, 0x8d, 0xc7, 0x9c, 0x42, 0xf2, 0x24, 0x29, 0xa1, 0xb5,
-	0xa4, 0x86, 0x56, 0x61, 0xf1, 0xb1, 0xeb, 0x7d, 0xfa, 0x8b, 0x60, 0xc5, 0x0e, 0x44, 0xc1, 0x7c,
-	0x6f, 0xc1, 0x41, 0x81, 0xc6, 0xeb, 0xcb, 0x73, 0xc9, 0xe0, 0xe7, 0x2c, 0x8d, 0xad, 0x95, 0x3c,
-	0x9f, 0xee, 0x75, 0xe4, 0x10, 0x50, 0x8e, 0x72, 0x89, 0x9f, 0x53, 0x31, 0x11, 0xe9, 0x35, 0x38,
-	0x1e, 0x7a, 0x8b, 0xa0, 0xa7, 0xa1, 0x4f, 0x9c, 0x67, 0x71, 0x8b, 0x1e, 0x4b, 0x38, 0xfa, 0xa1,
-	0xb5, 0xf4, 0x7b, 0x02, 0x97, 0x78, 0x7b, 0xfe, 0xb8, 0xbc, 0xef, 0x9f, 0xb7, 0xc2, 0xc7, 0xd7,
-	0x37, 0x00, 0xfc, 0xa3, 0x12, 0xc6, 0x99, 0x89, 0xe5, 0xc6, 0xae, 0xb6, 0x2e, 0x28, 0xa2, 0xe1,
-	0x66, 0x8f, 0xf8, 0x6d, 0xd1, 0x19, 0xc8, 0x69, 0x86, 0xaa, 0x6f, 0x54, 0x58, 0x79, 0xd5, 0x62,
-	0xca, 0x7a, 0xc5, 0x7c, 0x64, 0x94, 0xd7, 0x34, 0xa6, 0x57, 0x6c, 0x3e, 0x80, 0xfa, 0x4b, 0x27,
-	0xf1, 0xfb, 0x9c, 0xf7, 0x79, 0x81, 0x7f, 0x95, 0x3e, 0x3b, 0x00, 0x63, 0xe9, 0xf8, 0x91, 0xa4,
-	0xef, 0x13, 0x38, 0xe2, 0x61, 0x74, 0x4f, 0x8a, 0xf6, 0x8b, 0xab, 0x60, 0x87, 0xbd, 0xb8, 0x0b,
-	0x8c, 0xd9, 0xf4, 0x6d, 0x02, 0x87, 0x34, 0xa3, 0xbe, 0xe1, 0x94, 0x1d, 0xd3, 0x51, 0xf4, 0xf4,
-	0xa3, 0x70, 0xb7, 0x60, 0x00, 0x8f, 0xba, 0xec, 0x06, 0xa5, 0xef, 0x11, 0x38, 0xaa, 0x9a, 0x46,
-	0x83, 0x59, 0x0e, 0xab, 0x20, 0x90, 0xfd, 0x2f, 0x0a, 0xc8, 0x60, 0x33, 0xb2, 0x00, 0xb3, 0xec,
-	0x61, 0xb1, 0x35, 0xd3, 0x28, 0x1b, 0x4a, 0xc3, 0xce, 0x1d, 0x48, 0x2e, 0x33, 0x77, 0x71, 0xb3,
-	0xba, 0x64, 0x69, 0x2a, 0xc3, 0xa3, 0xfc, 0xa0, 0xdf, 0xc6, 0x5d, 0xa5, 0x61, 0xd3, 0x79, 0x00,
-	0x47, 0x68, 0x02, 0x86, 0xd2, 0xc8, 0xf5, 0xf2, 0x11, 0x9b, 0xad, 0xc1, 0x52, 0xbf, 0x63, 0x2e,
-	0x30, 0x76, 0x57, 0x69, 0x48, 0xef, 0x7a, 0xd5, 0xfa, 0xeb, 0x8a, 0xae, 0x55, 0x14, 0x87, 0xcd,
-	0x5b, 0x4c, 0x71, 0x58, 0x78, 0x71, 0x65, 0x70, 0x82, 0x2b, 0x20, 0xac, 0x8c, 0x6b, 0xac, 0x25,
-	0x3e, 0xe0, 0x34, 0x99, 0x48, 0x98, 0x26, 0x8b, 0x66, 0x23, 0xa2, 0xc5, 0xd2, 0x71, 0xb5, 0xfd,
-	0xa5, 0xb4, 0x86, 0xe5, 0x3a, 0x1a, 0x0a, 0x0e, 0xf3, 0x21, 0xe8, 0x65, 0x96, 0x65, 0x5a, 0xde,
-	0x91, 0x83, 0x3f, 0xd0, 0xcb, 0x40, 0xab, 0x66, 0xa3, 0x5c, 0xb7, 0xcc, 0x7a, 0xf9, 0x91, 0xa6,
-	0xeb, 0xe5, 0xba, 0x62, 0x7b, 0xb3, 0xeb, 0x68, 0xd5, 0x6c, 0x2c, 0x59, 0x66, 0xfd, 0x81, 0xa6,
-	0xeb, 0x4b, 0x8a, 0x6d, 0x4b, 0x37, 0x71, 0x85, 0xf4, 0xe2, 0x74, 0x50, 0x49, 0xa6, 0xf0, 0x30,
-	0xd1, 0xea, 0x9a, 0x04, 0x4e, 0xfa, 0xb6, 0x57, 0x66, 0x7d, 0x2f, 0x43, 0x11, 0x93, 0xc5, 0x0b,
-	0x5a, 0x86, 0xe3, 0x35, 0xfe, 0x92, 0xcf, 0xdc, 0x16, 0x7e, 0xe5, 0x64, 0x7e, 0xdb, 0x5a, 0x2b,
-	0x1d, 0xab, 0xb5, 0xbe, 0x92, 0x2a, 0x30, 0x12, 0x0b, 0xa1, 0x7b, 0xcc, 0xae, 0xfb, 0x75, 0x76,
-	0x49, 0x68, 0x8b, 0x5e, 0x82, 0xd7, 0xa0, 0xcf, 0x36, 0x37, 0x2c, 0x95, 0xa5, 0x96, 0x59, 0xb4,
-	0x4b, 0x17, 0x77, 0x96, 0xe1, 0xff, 0xda, 0x82, 0x61, 0x2a, 0x37, 0xe1, 0x20, 0x6a, 0x9b, 0x48,
-	0xe1, 0x48, 0x7c, 0xc5, 0x10, 0x9e, 0x9e, 0xbd, 0x7b, 0x5e, 0x3c, 0xdb, 0xd2, 0xac, 0xfd, 0x40,
-	0x73, 0x1e, 0xde, 0xe7, 0xa8, 0x76, 0x9f, 0x4e, 0xb7, 0xea, 0xfb, 0x47, 0xc4, 0xdf, 0xcd, 0x47,
-	0xe1, 0x43, 0x06, 0xfe, 0x1f, 0xfa, 0x3d, 0x75, 0x17, 0xeb, 0x40, 0x2a, 0x05, 0x4d, 0x87, 0xee,
-	0x55, 0xf9, 0x38, 0x32, 0x97, 0x15, 0xab, 0xca, 0x82, 0x63, 0xc3, 0xe1, 0x2f, 0xd2, 0xc9, 0x14,
-	0x76, 0x5f, 0x3a, 0x99, 0x1e, 0xbe, 0x3d, 0x45, 0x66, 0x25, 0xb4, 0xb1, 0xf3, 0xe0, 0x76, 0x7b,
-	0xff, 0xf8, 0x38, 0xa8, 0x97, 0x04, 0xc3, 0xec, 0x29, 0x2e, 0xbe, 0x81, 0x5c, 0x60, 0x88, 0x96,
-	0xbd, 0xdc, 0xed, 0x4e, 0xa7, 0x3f, 0x56, 0xd8, 0xe6, 0x22, 0xf0, 0xb8, 0x07, 0x49, 0x68, 0x6d,
-	0x1f, 0x49, 0xf8, 0x16, 0x01, 0x70, 0x0b, 0xaf, 0xa8, 0x62, 0x2f, 0x6e, 0xa3, 0x35, 0xb0, 0xc6,
-	0xb0, 0x2a, 0x36, 0x21, 0x28, 0xaa, 0xca, 0xea, 0xce, 0x8b, 0xdb, 0x64, 0xb9, 0x10, 0x66, 0x79,
-	0xcc, 0xc9, 0x5f, 0x9e, 0x83, 0x5e, 0xce, 0x12, 0xfd, 0x39, 0x81, 0xc3, 0xc1, 0x8b, 0x17, 0x7a,
-	0x2d, 0x8e, 0xf0, 0xb8, 0xeb, 0xa3, 0xfc, 0x44, 0x07, 0x1e, 0xa2, 0x17, 0xa4, 0xf1, 0xb7, 0xff,
-	0xf2, 0xaf, 0x1f, 0xf5, 0x9c, 0xa7, 0x92, 0x1c, 0x73, 0x71, 0xe5, 0xd6, 0x52, 0x71, 0x5d, 0x46,
-	0x7f, 0x42, 0xa0, 0xdf, 0xbb, 0x05, 0xa0, 0x57, 0x12, 0x63, 0xb5, 0xdc, 0x87, 0xe4, 0xaf, 0x66,
-	0xb4, 0x46, 0x54, 0xd7, 0x38, 0xaa, 0x71, 0x3a, 0x26, 0x27, 0xdd, 0xdf, 0xc9, 0xdb, 0x9e, 0xfa,
-	0xb9, 0x43, 0xdf, 0xef, 0x81, 0xa1, 0xa8, 0x1b, 0x0a, 0x3a, 0x93, 0x29, 0x72, 0xc4, 0xb5, 0x49,
-	0xfe, 0xe6, 0x2e, 0x3c, 0x11, 0xff, 0x7b, 0x84, 0x27, 0xf0, 0x1d, 0xb2, 0xf2, 0x0a, 0xfd, 0x8a,
-	0x9c, 0x78, 0x51, 0x29, 0x6f, 0x37, 0x77, 0x4a, 0x3b, 0x5e, 0x5a, 0x81, 0x9a, 0xbd, 0x43, 0x6f,
-	0x27, 0x72, 0x60, 0x47, 0x35, 0x13, 0x6e, 0xe0, 0xdf, 0x04, 0x8e, 0xb6, 0xdc, 0x4b, 0xd0, 0xa9,
-	0xb4, 0xdc, 0x22, 0xee, 0x63, 0xf2, 0xd7, 0x3b, 0x73, 0x42, 0x2e, 0x0c, 0x4e, 0xc5, 0xc3, 0x95,
-	0x29, 0x3a, 0xd1, 0x29, 0x13, 0x76, 0xbc, 0x4b, 0x6c, 0xf2, 0xf4, 0x63, 0x02, 0x83, 0xe1, 0x9b,
-	0x00, 0x3a, 0x99, 0xda, 0x93, 0x6d, 0x57, 0x22, 0xf9, 0xa9, 0x8e, 0x7c, 0x30, 0xd7, 0xeb, 0x3c,
-	0xd7, 0x22, 0xbd, 0x92, 0x02, 0x9b, 0xdf, 0xa2, 0xc8, 0xdb, 0xfc, 0xa7, 0x89, 0x38, 0xa0, 0xac,
-	0xa7, 0x23, 0x6e, 0xbf, 0x48, 0x48, 0x47, 0x1c, 0x21, 0xdd, 0x67, 0x46, 0xcc, 0x6f, 0x25, 0xe4,
-	0x6d, 0xfe, 0xb3, 0x43, 0x3f, 0x20, 0x70, 0x38, 0xa8, 0x83, 0xa7, 0xac, 0x55, 0x11, 0xba, 0x7c,
-	0xca, 0x5a, 0x15, 0x25, 0xb2, 0x4b, 0x17, 0x39, 0xd6, 0x51, 0x5a, 0x48, 0xc6, 0x4a, 0x3f, 0x21,
-	0x70, 0x24, 0xa4, 0x4c, 0xd3, 0xd4, 0x60, 0x6d, 0xa2, 0x79, 0x7e, 0xb2, 0x13, 0x17, 0x04, 0xb8,
-	0xc8, 0x01, 0xce, 0xc6, 0x4f, 0xd9, 0x88, 0x81, 0xee, 0x4b, 0x7c, 0xf2, 0x36, 0x8a, 0xcd, 0x3b,
-	0xf4, 0x4f, 0x04, 0x4e, 0x44, 0x2a, 0xcd, 0x34, 0x75, 0x51, 0x8a, 0x95, 0xbd, 0xf3, 0x2f, 0xef,
-	0xc6, 0x15, 0x33, 0xbb, 0xc5, 0x33, 0x7b, 0x89, 0xde, 0x90, 0xd3, 0xff, 0x7f, 0x86, 0x8c, 0x69,
-	0x04, 0xf2, 0xf9, 0x9e, 0x58, 0x9d, 0xdb, 0x04, 0xe4, 0xf4, 0xd5, 0x39, 0x4e, 0xfd, 0x4e, 0x5f,
-	0x9d, 0x63, 0xd5, 0x6a, 0x69, 0x93, 0x27, 0x63, 0xad, 0xcc, 0xd0, 0xe9, 0x5d, 0x75, 0x94, 0x1d,
-	0xef, 0x17, 0xa4, 0x21, 0x7a, 0x6d, 0x3a, 0xd6, 0xa6, 0x13, 0xd3, 0x1b, 0x19, 0xa6, 0x42, 0x04,
-	0x03, 0xd3, 0x9d, 0xba, 0x61, 0xfa, 0x97, 0x79, 0xfa, 0x17, 0xe8, 0xb9, 0x0c, 0x49, 0xd0, 0x0f,
-	0x09, 0x0c, 0x34, 0xc9, 0xa4, 0x57, 0xb3, 0x91, 0xee, 0x21, 0x2c, 0x66, 0x35, 0x47, 0x64, 0x93,
-	0x1c, 0xd9, 0x15, 0x3a, 0x9e, 0xbd, 0x5b, 0xdc, 0x6d, 0xd3, 0x91, 0x90, 0x4c, 0x4b, 0xb3, 0xac,
-	0x2c, 0x61, 0xe1, 0x38, 0x7d, 0xb2, 0xb7, 0xab, 0xc0, 0xd2, 0x25, 0x0e, 0xf6, 0x2c, 0x1d, 0x49,
-	0x06, 0x6b, 0xd3, 0x77, 0x09, 0xf4, 0x09, 0x51, 0x95, 0x8e, 0x27, 0xc6, 0x09, 0xe9, 0xb8, 0xf9,
-	0xcb, 0x99, 0x6c, 0xb3, 0x2e, 0x8d, 0x42, 0xcd, 0xa5, 0x7f, 0x27, 0x70, 0x2a, 0x41, 0x08, 0xa5,
-	0xb7, 0x13, 0x83, 0xa6, 0x4b, 0xc0, 0xf9, 0x57, 0x76, 0xdf, 0x00, 0xa6, 0xf2, 0x32, 0x4f, 0xe5,
-	0x3a, 0x9d, 0x4c, 0xdc, 0x91, 0xfa, 0x63, 0xb4, 0x1c, 0x90, 0x89, 0xff, 0x40, 0x60, 0x28, 0x4a,
-	0xf9, 0x4a, 0x59, 0x67, 0x12, 0x74, 0xbb, 0x94, 0x75, 0x26, 0x49, 0x66, 0x93, 0xa6, 0x79, 0x26,
-	0xd7, 0x68, 0x31, 0x2e, 0x93, 0x06, 0x7a, 0xcb, 0x21, 0x65, 0x90, 0xfe, 0x87, 0xc0, 0x60, 0x58,
-	0x1c, 0x4b, 0xd9, 0x0f, 0x44, 0x8a, 0x70, 0x29, 0xfb, 0x81, 0x68, 0xf5, 0x4d, 0xb2, 0x38, 0x66,
-	0x7d, 0xe5, 0x06, 0x9d, 0xea, 0x60, 0x6d, 0xf4, 0x12, 0x89, 0x77, 0x6a, 0xa6, 0x1a, 0x31, 0x85,
-	0x7f, 0x47, 0x80, 0xb6, 0x6b, 0x6a, 0x74, 0x3a, 0x23, 0xfe, 0x16, 0x99, 0x2e, 0xff, 0x52, 0xc7,
-	0x7e, 0x59, 0xf7, 0x42, 0x81, 0x24, 0x9a, 0x3a, 0x23, 0xfd, 0x2f, 0x01, 0xf0, 0xa5, 0x0f, 0x9a,
-	0xba, 0xe6, 0x85, 0x45, 0xbd, 0xbc, 0x9c, 0xd9, 0x1e, 0x51, 0xfe, 0x40, 0x9c, 0x2d, 0xde, 0x21,
-	0x2b, 0x09, 0xe7, 0x23, 0x3c, 0x84, 0xcb, 0xdb, 0x42, 0x39, 0xdb, 0x49, 0xaa, 0x75, 0xad, 0xb6,
-	0x2d, 0xc7, 0x87, 0x91, 0x14, 0x3f, 0xfa, 0x54, 0x6c, 0x56, 0xda, 0x85, 0xb4, 0xf4, 0xcd, 0x4a,
-	0xac, 0x38, 0x98, 0xbe, 0x59, 0x89, 0xd7, 0xed, 0xa4, 0x19, 0x4e, 0xd0, 0x24, 0xbd, 0x96, 0x82,
-	0xdc, 0x96, 0x45, 0xc6, 0xcd, 0xcc, 0xa3, 0x52, 0x11, 0x32, 0x56, 0x67, 0xa9, 0x84, 0xa4, 0xb9,
-	0xce, 0x52, 0x09, 0xab, 0x66, 0x1d, 0xa4, 0x22, 0x54, 0x3d, 0x79, 0x5b, 0xfc, 0xee, 0xd0, 0xc7,
-	0x78, 0xa8, 0xf0, 0xe5, 0x27, 0x9a, 0xa5, 0xca, 0xb5, 0x48, 0x62, 0x19, 0x0e, 0x15, 0xed, 0xfa,
-	0x96, 0x34, 0xc6, 0x51, 0x4b, 0x74, 0x34, 0x0d, 0x35, 0xfd, 0x15, 0x81, 0xc1, 0xb0, 0x3e, 0x94,
-	0x82, 0x32, 0x52, 0xac, 0x4a, 0x41, 0x19, 0x2d, 0x40, 0x49, 0x57, 0x38, 0xca, 0x8b, 0xf4, 0x7c,
-	0x62, 0xa1, 0x41, 0xa8, 0x73, 0xec, 0xe9, 0xb3, 0x02, 0xf9, 0xf4, 0x59, 0x81, 0x7c, 0xf6, 0xac,
-	0x40, 0x7e, 0xf8, 0xbc, 0xb0, 0xef, 0xd3, 0xe7, 0x85, 0x7d, 0x7f, 0x7d, 0x5e, 0xd8, 0x07, 0xc3,
-	0x9a, 0x19, 0x13, 0x7e, 0x89, 0xac, 0x14, 0x03, 0x52, 0x91, 0x6f, 0x74, 0x55, 0x33, 0x83, 0x41,
-	0x37, 0x9b, 0x61, 0x57, 0xfb, 0xf8, 0xff, 0x12, 0x9e, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xf2, 0x8a, 0xf8, 0x1c, 0xf2, 0x2d, 0x00, 0x00,
+	// 2526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdb, 0x6f, 0x14, 0xd7,
+	0x19, 0xe7, 0xd8, 0xd8, 0xd8, 0x9f, 0x1d, 0x23, 0x0e, 0x86, 0xac, 0x07, 0xf0, 0x65, 0xb8, 0x59,
+	0x06, 0x76, 0xb0, 0x17, 0x1c, 0x43, 0x45, 0x89, 0x0d, 0x35, 0x42, 0x2d, 0xe0, 0x2c, 0xa8, 0x44,
+	0x48, 0xed, 0x66, 0xbc, 0x7b, 0xbc, 0x8c, 0x3c, 0x3b, 0xb3, 0x99, 0x19, 0x2f, 0x58, 0x96, 0xa5,
+	0x34, 0xbd, 0x44, 0xe4, 0xa1, 0x8a, 0xd4, 0x87, 0xa6, 0x8d, 0x9a, 0x3c, 0x50, 0xa9, 0x6d, 0x5e,
+	0xc2, 0x43, 0xfb, 0x54, 0x55, 0x79, 0xe8, 0x43, 0x79, 0xa9, 0x14, 0xb5, 0x2f, 0xad, 0x54, 0xb5,
+	0x11, 0x54, 0xca, 0x4b, 0xfb, 0x2f, 0x54, 0xd5, 0x9c, 0xf3, 0xcd, 0xce, 0xcc, 0xee, 0xdc, 0xd6,
+	0x71, 0x90, 0x5f, 0xf0, 0xce, 0xcc, 0x77, 0xf9, 0x7d, 0xbf, 0x73, 0xff, 0x1d, 0x40, 0xae, 0x5b,
+	0x66, 0x83, 0x19, 0xaa, 0x51, 0x66, 0x0a, 0x7b, 0x58, 0xbe, 0xaf, 0x1a, 0x55, 0xa6, 0x34, 0xa6,
+	0x95, 0x37, 0xd7, 0x98, 0xb5, 0x9e, 0xaf, 0x5b, 0xa6, 0x63, 0xd2, 0x83, 0xbe, 0x4d, 0xde, 0xb3,
+	0xc9, 0x37, 0xa6, 0xa5, 0x7d, 0x6a, 0x4d, 0x33, 0x4c, 0x85, 0xff, 0x2b, 0x4c, 0xa5, 0x91, 0xb2,
+	0x69, 0xd7, 0x4c, 0xbb, 0xc4, 0x9f, 0x14, 0xf1, 0x80, 0x9f, 0xa6, 0xc4, 0x93, 0xb2, 0xac, 0xda,
+	0x4c, 0x84, 0x57, 0x1a, 0xd3, 0xcb, 0xcc, 0x51, 0xa7, 0x95, 0xba, 0x5a, 0xd5, 0x0c, 0xd5, 0xd1,
+	0x4c, 0x03, 0x6d, 0x47, 0x83, 0xb6, 0x9e, 0x55, 0xd9, 0xd4, 0xbc, 0xef, 0x87, 0xab, 0xa6, 0x59,
+	0xd5, 0x99, 0xa2, 0xd6, 0x35, 0x45, 0x35, 0x0c, 0xd3, 0xe1, 0xce, 0x5e, 0xa6, 0xe1, 0xaa, 0x59,
+	0x35, 0x05, 0x02, 0xf7, 0x17, 0xbe, 0x9d, 0x8c, 0xa9, 0xb4, 0x6c, 0xd6, 0x6a, 0x9a, 0x53, 0x63,
+	0x86, 0xe3, 0xf9, 0x1f, 0x8d, 0xb1, 0xac, 0xa9, 0xd6, 0x2a, 0x73, 0x52, 0x8c, 0x4c, 0xab, 0xc2,
+	0xac, 0xb4, 0x48, 0x75, 0xd5, 0x52, 0x6b, 0x9e, 0xd1, 0xf1, 0x58, 0xa3, 0xf5, 0x20, 0xaa, 0xb1,
+	0x18, 0x33, 0xe7, 0xa1, 0x30, 0x90, 0xdf, 0x27, 0x90, 0x7b, 0xcd, 0xe5, 0xf5, 0x96, 0x0b, 0x61,
+	0x91, 0xb1, 0x2b, 0xaa, 0x5e, 0x2e, 0xb2, 0x37, 0xd7, 0x98, 0xed, 0xd0, 0x4b, 0xd0, 0xaf, 0xda,
+	0xab, 0x25, 0x8e, 0x2e, 0xd7, 0x35, 0x4e, 0x26, 0x07, 0x66, 0xc6, 0xf3, 0xd1, 0xed, 0x9a, 0x9f,
+	0xb7, 0x57, 0x79, 0x88, 0x62, 0x9f, 0x8a, 0xbf, 0x5c, 0xf7, 0x65, 0xad, 0x82, 0xee, 0xdd, 0xc9,
+	0xee, 0x0b, 0x5a, 0x05, 0xdd, 0x97, 0xf1, 0x97, 0xfc, 0xa4, 0x0b, 0x46, 0x22, 0xa0, 0xd9, 0x75,
+	0xd3, 0xb0, 0x19, 0x7d, 0x0d, 0x86, 0xcb, 0x16, 0xe3, 0x4d, 0x58, 0x5a, 0x61, 0xac, 0x64, 0xd6,
+	0xdd, 0x9f, 0x76, 0x8e, 0x8c, 0x77, 0x4f, 0x0e, 0xcc, 0x8c, 0xe4, 0xb1, 0x1b, 0xb9, 0x9d, 0x21,
+	0x8f, 0x9d, 0x21, 0x7f, 0xc5, 0xd4, 0x8c, 0x85, 0xdd, 0x4f, 0xff, 0x39, 0xb6, 0xab, 0x48, 0x3d,
+	0xe7, 0x45, 0xc6, 0x6e, 0x09, 0x57, 0xfa, 0x5d, 0x38, 0x64, 0x33, 0xc7, 0xd1, 0x99, 0xcb, 0x60,
+	0x69, 0x45, 0x57, 0x9d, 0x50, 0xe4, 0xae, 0x6c, 0x91, 0x73, 0x7e, 0x8c, 0x45, 0x5d, 0x75, 0x02,
+	0xf1, 0xdf, 0x80, 0xc3, 0x81, 0xf8, 0x96, 0x9b, 0x3e, 0x94, 0xa0, 0x3b, 0x5b, 0x82, 0x11, 0x3f,
+	0x48, 0xd1, 0x8d, 0xe1, 0x67, 0x90, 0xa7, 0x61, 0x98, 0x33, 0x76, 0x8d, 0x39, 0x82, 0x4d, 0x6c,
+	0xc8, 0x11, 0xe8, 0xe3, 0xad, 0x50, 0xd2, 0x2a, 0x39, 0x32, 0x4e, 0x26, 0x77, 0x17, 0xf7, 0xf0,
+	0xe7, 0xeb, 0x15, 0xf9, 0x5b, 0x70, 0xa0, 0xc5, 0x05, 0x09, 0x2e, 0x40, 0x8f, 0x68, 0x39, 0xc2,
+	0x5b, 0xee, 0x48, 0x5c, 0xcb, 0x09, 0x2f, 0x61, 0x2b, 0xbf, 0x01, 0xe3, 0xa1, 0x68, 0x0b, 0xeb,
+	0xdf, 0x78, 0xe8, 0x30, 0xcb, 0x50, 0xf5, 0xeb, 0x57, 0x3d, 0x30, 0x87, 0xa0, 0x5f, 0x0c, 0x0a,
+	0x0f, 0xcd, 0x4b, 0xc5, 0x3e, 0xf1, 0xe2, 0x7a, 0x85, 0x8e, 0xc1, 0x00, 0x43, 0x0f, 0xf7, 0xb3,
+	0xdb, 0xe9, 0xfa, 0x8b, 0xe0, 0xbd, 0xba, 0x5e, 0x91, 0x5f, 0x87, 0x89, 0x84, 0x0c, 0x5f, 0x06,
+	0xfb, 0x9f, 0x08, 0x1c, 0xf2, 0x42, 0xdf, 0xe0, 0x78, 0xf8, 0x67, 0x3b, 0x13, 0xee, 0x23, 0x00,
+	0x82, 0x61, 0x67, 0xbd, 0xce, 0x10, 0x76, 0x3f, 0x7f, 0x73, 0x67, 0xbd, 0xce, 0xe8, 0x31, 0x18,
+	0x52, 0x57, 0x1c, 0x66, 0x95, 0x9a, 0xcd, 0xd0, 0xcd, 0x9b, 0x61, 0x90, 0xbf, 0xbd, 0x25, 0xda,
+	0x82, 0x2e, 0x02, 0xf8, 0xb3, 0x5a, 0xae, 0xcc, 0xb1, 0x9f, 0x08, 0x75, 0x07, 0x31, 0xc3, 0x7a,
+	0x9d, 0x62, 0x49, 0xad, 0x32, 0x44, 0x57, 0x0c, 0x78, 0xca, 0x1f, 0x12, 0x38, 0x1c, 0x5d, 0x09,
+	0xf2, 0x73, 0x1e, 0x7a, 0xc5, 0x94, 0x83, 0xc3, 0x25, 0x85, 0x20, 0x34, 0xa6, 0xd7, 0x22, 0xf0,
+	0x9d, 0x4c, 0xc5, 0x27, 0x72, 0x86, 0x00, 0xfe, 0x9d, 0x80, 0xd4, 0x6c, 0xc5, 0x07, 0x06, 0xb3,
+	0xc2, 0x4c, 0xe7, 0xa1, 0xc7, 0x74, 0xdf, 0x72, 0x96, 0xfb, 0x17, 0x72, 0x7f, 0xf9, 0xed, 0x99,
+	0x61, 0xcc, 0x32, 0x5f, 0xa9, 0x58, 0xcc, 0xb6, 0x6f, 0x3b, 0x96, 0x66, 0x54, 0x8b, 0xc2, 0x6c,
+	0x67, 0x91, 0xff, 0x8b, 0x40, 0x37, 0x0a, 0xd5, 0xb6, 0x43, 0xb8, 0xff, 0x34, 0xc0, 0xfd, 0xbc,
+	0x6d, 0xb7, 0xf6, 0xf2, 0x61, 0xe8, 0x51, 0xdd, 0xb7, 0x82, 0xfb, 0xa2, 0x78, 0xd8, 0xb9, 0x0c,
+	0x87, 0x2a, 0xd8, 0x21, 0x0c, 0x2f, 0x43, 0xae, 0x09, 0x4f, 0xd7, 0xc3, 0xf4, 0x6e, 0x17, 0x07,
+	0x1f, 0x10, 0x18, 0x89, 0x48, 0xb2, 0x43, 0x18, 0xd0, 0x7d, 0x70, 0x57, 0x9a, 0x3b, 0x25, 0x8f,
+	0x82, 0x19, 0xd8, 0xa3, 0x96, 0xcb, 0xe6, 0x9a, 0xe1, 0xa4, 0x8e, 0x6f, 0xcf, 0x30, 0x3c, 0xf7,
+	0x76, 0x85, 0xe7, 0x5e, 0xf9, 0xa7, 0x81, 0x1e, 0x1d, 0x4c, 0x87, 0x64, 0xac, 0x43, 0xaf, 0x5a,
+	0xc3, 0x74, 0x29, 0x0b, 0xec, 0xa2, 0xbb, 0xc0, 0x7e, 0xfc, 0xaf, 0xb1, 0xc9, 0xaa, 0xe6, 0xdc,
+	0x5f, 0x5b, 0xce, 0x97, 0xcd, 0x1a, 0xee, 0x47, 0xf1, 0xcf, 0x19, 0xbb, 0xb2, 0xaa, 0xb8, 0x63,
+	0xc0, 0xe6, 0x0e, 0xf6, 0xcf, 0xbf, 0x78, 0x32, 0x35, 0xa8, 0xb3, 0xaa, 0x5a, 0x5e, 0x2f, 0xb9,
+	0x5b, 0x4d, 0xfb, 0xd7, 0x5f, 0x3c, 0x99, 0x22, 0x45, 0x4c, 0x28, 0xdf, 0xf5, 0x17, 0xab, 0x79,
+	0x51, 0x89, 0x8f, 0xcf, 0xfe, 0x12, 0x7c, 0xc8, 0x3a, 0xc8, 0x49, 0x81, 0xb1, 0xf2, 0x45, 0x18,
+	0x08, 0x6c, 0x54, 0xb1, 0xfc, 0x63, 0x71, 0x7d, 0x41, 0xac, 0x14, 0xf3, 0x1c, 0x79, 0x31, 0xe8,
+	0x28, 0xbf, 0x43, 0xfc, 0x65, 0x5d, 0x58, 0x45, 0x94, 0x91, 0xb8, 0x3c, 0x6e, 0x57, 0xb7, 0xff,
+	0x1d, 0x81, 0x89, 0x04, 0x24, 0x58, 0xf7, 0xb5, 0xa8, 0xba, 0x8f, 0xc7, 0xee, 0x5c, 0x05, 0x81,
+	0x11, 0x85, 0x6f, 0xdf, 0x80, 0xa8, 0xc2, 0x91, 0xc0, 0x68, 0x8d, 0x60, 0x6f, 0xbb, 0x08, 0xfa,
+	0x84, 0xc0, 0x68, 0x5c, 0x26, 0x64, 0xe7, 0x6a, 0x14, 0x3b, 0x72, 0x1c, 0x3b, 0x81, 0x01, 0xf5,
+	0xd5, 0x50, 0x73, 0x0e, 0x0e, 0x84, 0x5b, 0x34, 0x4b, 0x87, 0x92, 0x7f, 0x40, 0xe0, 0x60, 0xab,
+	0x1b, 0xd6, 0xe7, 0x8e, 0x27, 0x31, 0x6a, 0x32, 0x8c, 0x27, 0xf1, 0x48, 0x67, 0xa1, 0x57, 0x84,
+	0xc6, 0x63, 0xce, 0x68, 0xf2, 0x20, 0x29, 0xa2, 0xb5, 0x5c, 0x0e, 0xcd, 0xc2, 0xe2, 0xe3, 0xb6,
+	0xb7, 0xe9, 0x2f, 0x83, 0x2b, 0x76, 0x20, 0x0b, 0xd6, 0x7b, 0x09, 0xf6, 0x08, 0x34, 0x5e, 0x5b,
+	0x1e, 0x4d, 0x06, 0xbf, 0x60, 0x69, 0x6c, 0xa5, 0xe8, 0xf9, 0x6c, 0x5f, 0x43, 0x0e, 0x03, 0xe5,
+	0x28, 0x97, 0xf8, 0x39, 0x15, 0x0b, 0x91, 0x6f, 0xc0, 0xfe, 0xd0, 0x5b, 0x04, 0x3d, 0x0b, 0xbd,
+	0xe2, 0x3c, 0x9b, 0x23, 0xc9, 0x84, 0xa3, 0x1f, 0x5a, 0xcb, 0x7f, 0x20, 0x70, 0x92, 0xc7, 0xf3,
+	0xfb, 0xe5, 0x6d, 0xff, 0xbc, 0x15, 0x3e, 0xbe, 0xbe, 0x0e, 0xe0, 0x1f, 0x95, 0x30, 0xcf, 0x5c,
+	0x2c, 0x37, 0x76, 0xb5, 0x75, 0x42, 0x11, 0x81, 0x9b, 0x2d, 0xe2, 0xc7, 0xa2, 0x73, 0x90, 0xd3,
+	0x8c, 0xb2, 0xbe, 0x56, 0x61, 0xa5, 0x65, 0x8b, 0xa9, 0xab, 0x15, 0xf3, 0x81, 0x51, 0x5a, 0xd1,
+	0x98, 0x5e, 0xb1, 0x79, 0x07, 0xea, 0x2b, 0x1e, 0xc4, 0xef, 0x0b, 0xde, 0xe7, 0x45, 0xfe, 0x55,
+	0xfe, 0x7c, 0x37, 0x4c, 0xa6, 0xe3, 0x47, 0x92, 0x7e, 0x44, 0xe0, 0x25, 0x0f, 0xa3, 0x7b, 0x52,
+	0xb4, 0x5f, 0xdc, 0x0a, 0x36, 0xe8, 0xe5, 0x5d, 0x64, 0xcc, 0xa6, 0x6f, 0x13, 0x18, 0xd0, 0x8c,
+	0xfa, 0x9a, 0x53, 0x72, 0x4c, 0x47, 0xd5, 0x73, 0x5d, 0x2f, 0x0a, 0x06, 0xf0, 0xac, 0x77, 0xdc,
+	0xa4, 0xf4, 0x5d, 0x02, 0x7b, 0xcb, 0xa6, 0xd1, 0x60, 0x96, 0xc3, 0x2a, 0x08, 0xa4, 0xfb, 0x45,
+	0x01, 0x19, 0x6a, 0x66, 0x16, 0x60, 0xee, 0x78, 0x58, 0x6c, 0x57, 0x80, 0x30, 0xd4, 0x86, 0x9d,
+	0xdb, 0x9d, 0xbc, 0xcc, 0xdc, 0xc4, 0xcd, 0xea, 0x92, 0xa5, 0x95, 0x19, 0x1e, 0xe5, 0x87, 0xfc,
+	0x18, 0x37, 0xd5, 0x86, 0x4d, 0xaf, 0x00, 0x38, 0x42, 0x13, 0x30, 0xd4, 0x46, 0xae, 0x67, 0x9c,
+	0x64, 0x0e, 0x58, 0xec, 0x73, 0x5c, 0x21, 0xe0, 0xa6, 0xda, 0x90, 0x1f, 0x79, 0xab, 0xf5, 0xb7,
+	0x55, 0x5d, 0xab, 0xa8, 0x0e, 0xbb, 0x62, 0x31, 0xd5, 0x61, 0xe1, 0xc9, 0x95, 0xc1, 0x01, 0xae,
+	0x80, 0xb0, 0x12, 0xce, 0xb1, 0x96, 0xf8, 0x80, 0xc3, 0x64, 0x3a, 0x61, 0x98, 0x5c, 0x33, 0x1b,
+	0x11, 0x11, 0x8b, 0xfb, 0xcb, 0xed, 0x2f, 0xe5, 0x15, 0x98, 0x48, 0x80, 0x82, 0xdd, 0x7c, 0x18,
+	0x7a, 0x98, 0x65, 0x99, 0x96, 0x77, 0xe4, 0xe0, 0x0f, 0xf4, 0x14, 0xd0, 0xaa, 0xd9, 0x70, 0x45,
+	0xc1, 0x7a, 0xe9, 0x81, 0xa6, 0xeb, 0xa5, 0xba, 0x6a, 0x7b, 0xa3, 0x6b, 0x6f, 0xd5, 0x6c, 0x2c,
+	0x59, 0x66, 0xfd, 0xae, 0xa6, 0xeb, 0x4b, 0xaa, 0x6d, 0xcb, 0x17, 0x40, 0x0a, 0xe5, 0xe9, 0x60,
+	0x25, 0x29, 0xc0, 0xa1, 0x48, 0xd7, 0x24, 0x70, 0xf2, 0xf7, 0xbc, 0x65, 0xd6, 0xf7, 0x32, 0x54,
+	0x31, 0x58, 0xbc, 0xa4, 0x25, 0xd8, 0x5f, 0xe3, 0x2f, 0xf9, 0xc8, 0x6d, 0xe1, 0x57, 0x49, 0xe6,
+	0xb7, 0x2d, 0x5a, 0x71, 0x5f, 0xad, 0xf5, 0x95, 0x5c, 0x81, 0xb1, 0x58, 0x08, 0xdb, 0xc7, 0xec,
+	0xaa, 0xbf, 0xce, 0x2e, 0x09, 0x6d, 0xd1, 0x2b, 0xf0, 0x2c, 0xf4, 0xda, 0xe6, 0x9a, 0x55, 0x66,
+	0xa9, 0xcb, 0x2c, 0xda, 0xa5, 0x8b, 0x3b, 0x77, 0xe0, 0xe5, 0xb6, 0x64, 0x58, 0xca, 0x05, 0xd8,
+	0x83, 0xda, 0x26, 0x52, 0x38, 0x16, 0xbf, 0x62, 0x08, 0x4f, 0xcf, 0xde, 0x3d, 0x2f, 0x4e, 0xb4,
+	0x84, 0xb5, 0xef, 0x6a, 0xce, 0xfd, 0xdb, 0x1c, 0xd5, 0xd6, 0xcb, 0xd9, 0xae, 0xf5, 0xfd, 0x63,
+	0x02, 0x72, 0x12, 0x3e, 0x64, 0xe0, 0x6b, 0xd0, 0x87, 0x15, 0x79, 0xeb, 0x40, 0x2a, 0x05, 0x4d,
+	0x87, 0xed, 0x5b, 0xe5, 0xe3, 0xc8, 0xbc, 0xa3, 0x5a, 0x55, 0x16, 0xec, 0x1b, 0x0e, 0x7f, 0x91,
+	0x4e, 0xa6, 0xb0, 0xfb, 0xca, 0xc9, 0xf4, 0xf0, 0xed, 0x28, 0x32, 0x2b, 0xa1, 0x8d, 0x9d, 0x07,
+	0x77, 0xbb, 0xf7, 0x8f, 0x8f, 0x83, 0x7a, 0x49, 0x30, 0xcd, 0x8e, 0xe2, 0xe2, 0x3b, 0xc8, 0x05,
+	0xa6, 0x68, 0xd9, 0xcb, 0x5d, 0xee, 0x74, 0xf8, 0xe3, 0x0a, 0xdb, 0x9c, 0x04, 0x1e, 0x77, 0x21,
+	0x09, 0xad, 0xf1, 0x91, 0x84, 0xb7, 0x08, 0x80, 0xbb, 0xf0, 0x8a, 0x55, 0xec, 0xc5, 0x6d, 0xb4,
+	0xfa, 0x57, 0x18, 0xae, 0x8a, 0x4d, 0x08, 0x6a, 0xb9, 0xcc, 0xea, 0x4e, 0xae, 0xeb, 0x45, 0x42,
+	0x98, 0xe7, 0x39, 0xe5, 0x75, 0x9c, 0xed, 0x8b, 0xcc, 0x36, 0xf5, 0x86, 0xbb, 0x9d, 0xf0, 0x1a,
+	0x60, 0x02, 0x06, 0xb9, 0x14, 0x68, 0x97, 0x2a, 0xcc, 0x30, 0x6b, 0xb8, 0xa2, 0x0c, 0x88, 0x77,
+	0x57, 0xdd, 0x57, 0xee, 0xf4, 0x5e, 0x77, 0xf7, 0x22, 0x68, 0x81, 0xd3, 0x3b, 0x7f, 0x25, 0x0c,
+	0x42, 0xeb, 0x70, 0x77, 0xcb, 0x3a, 0xbc, 0x04, 0x2f, 0xb7, 0xa5, 0x6e, 0xca, 0x59, 0xdd, 0xee,
+	0x7e, 0x48, 0x34, 0x7c, 0xec, 0xe9, 0x06, 0x1d, 0x2b, 0xae, 0xa7, 0x6b, 0x3f, 0xf3, 0x9b, 0xe3,
+	0xd0, 0xc3, 0x43, 0xd2, 0x8f, 0x08, 0x0c, 0x06, 0x6f, 0x91, 0xe8, 0xd9, 0xb8, 0x20, 0x71, 0x77,
+	0x61, 0xd2, 0x74, 0x07, 0x1e, 0x02, 0xb6, 0x3c, 0xf5, 0xf6, 0x5f, 0xff, 0xfd, 0x93, 0xae, 0x63,
+	0x54, 0x56, 0x62, 0x6e, 0xe1, 0xdc, 0x8d, 0x81, 0xb8, 0xfb, 0xa3, 0x3f, 0x23, 0xd0, 0xe7, 0x5d,
+	0x69, 0xd0, 0xd3, 0x89, 0xb9, 0x5a, 0x2e, 0x77, 0xa4, 0x33, 0x19, 0xad, 0x11, 0xd5, 0x59, 0x8e,
+	0x6a, 0x8a, 0x4e, 0x2a, 0x49, 0x97, 0x91, 0xca, 0x86, 0x27, 0xe5, 0x6e, 0xd2, 0xf7, 0xbb, 0x60,
+	0x38, 0xea, 0xba, 0x85, 0xce, 0x65, 0xca, 0x1c, 0x71, 0x07, 0x24, 0x5d, 0xd8, 0x82, 0x27, 0xe2,
+	0x7f, 0x97, 0xf0, 0x02, 0xbe, 0x4f, 0xe8, 0xe5, 0xc4, 0x0a, 0x6c, 0xbc, 0x7a, 0x55, 0x36, 0x9a,
+	0x7d, 0x6e, 0x53, 0xd9, 0x08, 0xec, 0x3f, 0x36, 0xef, 0xbd, 0x4a, 0xbf, 0xae, 0x24, 0x5e, 0xdb,
+	0x86, 0x7c, 0x91, 0x97, 0x60, 0x04, 0xfa, 0x1f, 0x02, 0x7b, 0x5b, 0x2e, 0x59, 0x68, 0x21, 0xad,
+	0xb6, 0x88, 0xcb, 0x25, 0xe9, 0x5c, 0x67, 0x4e, 0xc8, 0x85, 0xc1, 0xa9, 0xb8, 0x4f, 0xa7, 0x3b,
+	0x66, 0xe2, 0x5e, 0x21, 0xde, 0x29, 0xae, 0x76, 0x9b, 0x7e, 0x42, 0x60, 0x28, 0x7c, 0xad, 0x41,
+	0x67, 0x52, 0x5b, 0xb2, 0xed, 0x7e, 0x47, 0x2a, 0x74, 0xe4, 0x83, 0xb5, 0x9e, 0xe3, 0xb5, 0xe6,
+	0xe9, 0xe9, 0x94, 0x5a, 0xf9, 0x95, 0x90, 0xb2, 0xc1, 0xff, 0x6c, 0x7a, 0x88, 0x03, 0xd7, 0x04,
+	0xe9, 0x88, 0xdb, 0x6f, 0x45, 0xa4, 0x42, 0x47, 0x3e, 0x1d, 0x22, 0xe6, 0x73, 0xa8, 0xb2, 0xc1,
+	0xff, 0x6c, 0xd2, 0x0f, 0x08, 0x0c, 0x06, 0x45, 0xfd, 0x94, 0xb9, 0x2a, 0xe2, 0x92, 0x41, 0x9a,
+	0xee, 0xc0, 0x03, 0xb1, 0x9e, 0xe0, 0x58, 0xc7, 0xe9, 0x68, 0x32, 0x56, 0xfa, 0x29, 0x81, 0x97,
+	0x42, 0x32, 0x3b, 0x4d, 0x4d, 0xd6, 0x76, 0x03, 0x20, 0xcd, 0x74, 0xe2, 0x82, 0x00, 0xaf, 0x71,
+	0x80, 0xf3, 0xf1, 0x83, 0x3e, 0xa2, 0xd7, 0xfa, 0x7a, 0xa5, 0xb2, 0x81, 0xca, 0xf9, 0x26, 0xfd,
+	0x33, 0x81, 0x03, 0x91, 0xb2, 0x39, 0x4d, 0x9d, 0x94, 0x62, 0x35, 0x7c, 0xe9, 0xe2, 0x56, 0x5c,
+	0xb1, 0xb2, 0x4b, 0xbc, 0xb2, 0x57, 0xe8, 0x79, 0x25, 0xfd, 0x3f, 0x9b, 0x28, 0x58, 0x46, 0xa0,
+	0x9e, 0x1f, 0x8a, 0xd9, 0xb9, 0x4d, 0x0d, 0x4f, 0x9f, 0x9d, 0xe3, 0xa4, 0x7c, 0xe9, 0xc2, 0x16,
+	0x3c, 0xb1, 0x98, 0x87, 0xbc, 0x18, 0x8b, 0xce, 0x66, 0x29, 0x26, 0x62, 0x5a, 0x9a, 0x8b, 0xf7,
+	0x4c, 0x6c, 0x60, 0x3e, 0x37, 0xed, 0x6b, 0x13, 0xbd, 0xe9, 0xf9, 0x0c, 0x43, 0x21, 0x82, 0x81,
+	0xd9, 0x4e, 0xdd, 0xb0, 0xfc, 0x53, 0xbc, 0xfc, 0xe3, 0xf4, 0x68, 0x86, 0xf2, 0xe9, 0x87, 0x04,
+	0xfa, 0x9b, 0x64, 0xd2, 0x33, 0xd9, 0x48, 0xf7, 0x10, 0xe6, 0xb3, 0x9a, 0x23, 0xb2, 0x19, 0x8e,
+	0xec, 0x34, 0x9d, 0xca, 0x4e, 0x2f, 0xfd, 0x48, 0x0c, 0x76, 0x5f, 0x73, 0xa6, 0x59, 0x66, 0x96,
+	0xb0, 0x0a, 0x2e, 0xcd, 0x74, 0xe2, 0x82, 0x60, 0x4f, 0x72, 0xb0, 0x13, 0x74, 0x2c, 0x19, 0xac,
+	0x4d, 0x1f, 0x11, 0xe8, 0x15, 0x0a, 0x31, 0x9d, 0x4a, 0xcc, 0x13, 0x12, 0xa5, 0xa5, 0x53, 0x99,
+	0x6c, 0xb3, 0x4e, 0x8d, 0x42, 0x9a, 0xa6, 0xff, 0x20, 0x70, 0x28, 0x41, 0xd5, 0xa5, 0x97, 0x13,
+	0x93, 0xa6, 0xeb, 0xd9, 0xd2, 0xab, 0x5b, 0x0f, 0x80, 0xa5, 0x5c, 0xe4, 0xa5, 0x9c, 0xa3, 0x33,
+	0x89, 0x3b, 0x52, 0xbf, 0x8f, 0x96, 0x02, 0x9a, 0xf7, 0x1f, 0x09, 0x0c, 0x47, 0xc9, 0x78, 0x29,
+	0xf3, 0x4c, 0x82, 0x08, 0x29, 0x5d, 0xd8, 0x82, 0x27, 0x56, 0x32, 0xcb, 0x2b, 0x39, 0x4b, 0xf3,
+	0x71, 0x95, 0x34, 0xd0, 0x5b, 0x09, 0xc9, 0x9c, 0xf4, 0xbf, 0x04, 0x86, 0xc2, 0x4a, 0x5f, 0xca,
+	0x7e, 0x20, 0x52, 0x51, 0x94, 0x0a, 0x1d, 0xf9, 0x20, 0x66, 0x8b, 0x63, 0xd6, 0x69, 0x21, 0x15,
+	0x73, 0xc4, 0xc4, 0x78, 0x3e, 0xde, 0xad, 0xdd, 0xba, 0x19, 0x89, 0xfe, 0x9e, 0x00, 0x6d, 0x17,
+	0x08, 0xe9, 0x6c, 0x46, 0xfc, 0x2d, 0x9a, 0xa3, 0xf4, 0x4a, 0xc7, 0x7e, 0x59, 0xf7, 0x42, 0x81,
+	0xda, 0x9b, 0xa2, 0x29, 0xfd, 0x1f, 0x01, 0xf0, 0x75, 0x1c, 0x9a, 0x3a, 0xe7, 0x85, 0x15, 0x4a,
+	0x49, 0xc9, 0x6c, 0x8f, 0x28, 0x7f, 0x2c, 0xce, 0x16, 0xef, 0x90, 0xf8, 0x99, 0x07, 0xf5, 0x84,
+	0x7b, 0x09, 0x07, 0x28, 0x34, 0x51, 0x36, 0x84, 0x4e, 0x98, 0xb8, 0xa8, 0xb5, 0xda, 0xb6, 0x9c,
+	0x2f, 0x9e, 0x8a, 0xcd, 0x4a, 0xbb, 0x2a, 0x98, 0xbe, 0x59, 0x89, 0x55, 0x3a, 0xa5, 0x8b, 0x5b,
+	0x71, 0x45, 0x86, 0xe6, 0x38, 0x41, 0x33, 0xf4, 0x6c, 0x4a, 0x41, 0xb6, 0x22, 0x0a, 0x6a, 0x16,
+	0x16, 0x55, 0x8a, 0xd0, 0xe4, 0x3a, 0x2b, 0x25, 0xa4, 0x33, 0x4a, 0x17, 0xb7, 0xe2, 0xda, 0x71,
+	0x29, 0x42, 0xa2, 0x54, 0x36, 0xc4, 0xdf, 0x4d, 0xfa, 0x18, 0x0f, 0x15, 0xbe, 0x96, 0x46, 0xb3,
+	0xac, 0x72, 0x2d, 0xfa, 0x9e, 0x54, 0xe8, 0xc8, 0x07, 0x51, 0x4f, 0x72, 0xd4, 0x32, 0x1d, 0x4f,
+	0x43, 0x4d, 0x7f, 0x45, 0x60, 0x28, 0x2c, 0x76, 0xa5, 0xa0, 0x8c, 0x54, 0xde, 0xa4, 0x42, 0x47,
+	0x3e, 0x88, 0xf2, 0x34, 0x47, 0x79, 0x82, 0x1e, 0x4b, 0x5c, 0x68, 0x10, 0x2a, 0x7d, 0xab, 0x0b,
+	0xc0, 0x97, 0x7d, 0x52, 0x86, 0x79, 0x9b, 0x34, 0x25, 0x29, 0x99, 0xed, 0x11, 0xdd, 0x7b, 0x62,
+	0x98, 0x3f, 0x22, 0xf7, 0x6e, 0xd0, 0x6f, 0x76, 0x30, 0xab, 0x1a, 0x6a, 0x43, 0xd9, 0x08, 0xaa,
+	0x60, 0x9b, 0xca, 0x46, 0x40, 0xf1, 0xda, 0xa4, 0x73, 0x71, 0xc1, 0xd2, 0x3c, 0x17, 0xd8, 0xd3,
+	0x67, 0xa3, 0xe4, 0xb3, 0x67, 0xa3, 0xe4, 0xf3, 0x67, 0xa3, 0xe4, 0xbd, 0xe7, 0xa3, 0xbb, 0x3e,
+	0x7b, 0x3e, 0xba, 0xeb, 0x6f, 0xcf, 0x47, 0x77, 0xc1, 0x88, 0x66, 0xc6, 0xd4, 0xb7, 0x44, 0xee,
+	0xe5, 0x03, 0xd2, 0x9f, 0x6f, 0x74, 0x46, 0x33, 0x83, 0x40, 0x1e, 0x36, 0xa1, 0x2c, 0xf7, 0xf2,
+	0xff, 0xf5, 0x5d, 0xf8, 0xff, 0x00, 0x14, 0x26, 0x77, 0xb0, 0xc2, 0x2f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2769,6 +2887,8 @@ type QueryClient interface {
 	GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error)
 	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
 	PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error)
+	// ResolveNav gets the NAV from one denom to another, deriving it through an intermediary denom if needed.
+	ResolveNav(ctx context.Context, in *QueryResolveNavRequest, opts ...grpc.CallOption) (*QueryResolveNavResponse, error)
 }
 
 type queryClient struct {
@@ -2986,6 +3106,15 @@ func (c *queryClient) PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCal
 	return out, nil
 }
 
+func (c *queryClient) ResolveNav(ctx context.Context, in *QueryResolveNavRequest, opts ...grpc.CallOption) (*QueryResolveNavResponse, error) {
+	out := new(QueryResolveNavResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ResolveNav", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// OrderFeeCalc calculates the fees that will be associated with the provided order.
@@ -3034,6 +3163,8 @@ type QueryServer interface {
 	GetAllPayments(context.Context, *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error)
 	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
 	PaymentFeeCalc(context.Context, *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error)
+	// ResolveNav gets the NAV from one denom to another, deriving it through an intermediary denom if needed.
+	ResolveNav(context.Context, *QueryResolveNavRequest) (*QueryResolveNavResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -3109,6 +3240,9 @@ func (*UnimplementedQueryServer) GetAllPayments(ctx context.Context, req *QueryG
 func (*UnimplementedQueryServer) PaymentFeeCalc(ctx context.Context, req *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PaymentFeeCalc not implemented")
 }
+func (*UnimplementedQueryServer) ResolveNav(ctx context.Context, req *QueryResolveNavRequest) (*QueryResolveNavResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveNav not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3528,6 +3662,24 @@ func _Query_PaymentFeeCalc_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ResolveNav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveNavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveNav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/ResolveNav",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveNav(ctx, req.(*QueryResolveNavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.exchange.v1.Query",
@@ -3625,6 +3777,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PaymentFeeCalc",
 			Handler:    _Query_PaymentFeeCalc_Handler,
 		},
+		{
+			MethodName: "ResolveNav",
+			Handler:    _Query_ResolveNav_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/exchange/v1/query.proto",
@@ -5596,6 +5752,83 @@ func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryResolveNavRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveNavRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveNavRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetsDenom) > 0 {
+		i -= len(m.AssetsDenom)
+		copy(dAtA[i:], m.AssetsDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetsDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveNavResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveNavResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveNavResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nav != nil {
+		{
+			size, err := m.Nav.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -6376,6 +6609,39 @@ func (m *QueryPaymentFeeCalcResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryResolveNavRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetsDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryResolveNavResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nav != nil {
+		l = m.Nav.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -11307,6 +11573,225 @@ func (m *QueryPaymentFeeCalcResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryResolveNavRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveNavRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveNavRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetsDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveNavResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveNavResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveNavResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nav", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nav == nil {
+				m.Nav = &ResolvedNav{}
+			}
+			if err := m.Nav.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
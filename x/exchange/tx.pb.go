@@ -448,6 +448,127 @@ func (m *MsgCancelOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrderResponse proto.InternalMessageInfo
 
+// MsgAmendOrderRequest is a request message for the AmendOrder endpoint.
+type MsgAmendOrderRequest struct {
+	// owner is the account that owns the order (i.e. the seller of an ask order or the buyer of a bid order).
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// order_id is the id of the order to amend.
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// assets is the new quantity of assets for the order.
+	Assets types.Coin `protobuf:"bytes,3,opt,name=assets,proto3" json:"assets"`
+	// price is the new price for the order.
+	Price types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	// settlement_fees are the new settlement fees for the order.
+	// For ask orders, this can have at most one entry, which becomes the seller settlement flat fee.
+	// For bid orders, these become the buyer settlement fees.
+	SettlementFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=settlement_fees,json=settlementFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"settlement_fees"`
+}
+
+func (m *MsgAmendOrderRequest) Reset()         { *m = MsgAmendOrderRequest{} }
+func (m *MsgAmendOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendOrderRequest) ProtoMessage()    {}
+func (*MsgAmendOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{8}
+}
+func (m *MsgAmendOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendOrderRequest.Merge(m, src)
+}
+func (m *MsgAmendOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendOrderRequest proto.InternalMessageInfo
+
+func (m *MsgAmendOrderRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgAmendOrderRequest) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *MsgAmendOrderRequest) GetAssets() types.Coin {
+	if m != nil {
+		return m.Assets
+	}
+	return types.Coin{}
+}
+
+func (m *MsgAmendOrderRequest) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *MsgAmendOrderRequest) GetSettlementFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SettlementFees
+	}
+	return nil
+}
+
+// MsgAmendOrderResponse is a response message for the AmendOrder endpoint.
+type MsgAmendOrderResponse struct {
+}
+
+func (m *MsgAmendOrderResponse) Reset()         { *m = MsgAmendOrderResponse{} }
+func (m *MsgAmendOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendOrderResponse) ProtoMessage()    {}
+func (*MsgAmendOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{9}
+}
+func (m *MsgAmendOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendOrderResponse.Merge(m, src)
+}
+func (m *MsgAmendOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendOrderResponse proto.InternalMessageInfo
+
 // MsgFillBidsRequest is a request message for the FillBids endpoint.
 type MsgFillBidsRequest struct {
 	// seller is the address of the account with the assets to sell.
@@ -471,7 +592,7 @@ func (m *MsgFillBidsRequest) Reset()         { *m = MsgFillBidsRequest{} }
 func (m *MsgFillBidsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgFillBidsRequest) ProtoMessage()    {}
 func (*MsgFillBidsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{8}
+	return fileDescriptor_e333fcffc093bd1b, []int{10}
 }
 func (m *MsgFillBidsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +671,7 @@ func (m *MsgFillBidsResponse) Reset()         { *m = MsgFillBidsResponse{} }
 func (m *MsgFillBidsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFillBidsResponse) ProtoMessage()    {}
 func (*MsgFillBidsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{9}
+	return fileDescriptor_e333fcffc093bd1b, []int{11}
 }
 func (m *MsgFillBidsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +724,7 @@ func (m *MsgFillAsksRequest) Reset()         { *m = MsgFillAsksRequest{} }
 func (m *MsgFillAsksRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgFillAsksRequest) ProtoMessage()    {}
 func (*MsgFillAsksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{10}
+	return fileDescriptor_e333fcffc093bd1b, []int{12}
 }
 func (m *MsgFillAsksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,7 +803,7 @@ func (m *MsgFillAsksResponse) Reset()         { *m = MsgFillAsksResponse{} }
 func (m *MsgFillAsksResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFillAsksResponse) ProtoMessage()    {}
 func (*MsgFillAsksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{11}
+	return fileDescriptor_e333fcffc093bd1b, []int{13}
 }
 func (m *MsgFillAsksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,7 +852,7 @@ func (m *MsgMarketSettleRequest) Reset()         { *m = MsgMarketSettleRequest{}
 func (m *MsgMarketSettleRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketSettleRequest) ProtoMessage()    {}
 func (*MsgMarketSettleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{12}
+	return fileDescriptor_e333fcffc093bd1b, []int{14}
 }
 func (m *MsgMarketSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +924,7 @@ func (m *MsgMarketSettleResponse) Reset()         { *m = MsgMarketSettleResponse
 func (m *MsgMarketSettleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketSettleResponse) ProtoMessage()    {}
 func (*MsgMarketSettleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{13}
+	return fileDescriptor_e333fcffc093bd1b, []int{15}
 }
 func (m *MsgMarketSettleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +976,7 @@ func (m *MsgMarketCommitmentSettleRequest) Reset()         { *m = MsgMarketCommi
 func (m *MsgMarketCommitmentSettleRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketCommitmentSettleRequest) ProtoMessage()    {}
 func (*MsgMarketCommitmentSettleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{14}
+	return fileDescriptor_e333fcffc093bd1b, []int{16}
 }
 func (m *MsgMarketCommitmentSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1062,7 @@ func (m *MsgMarketCommitmentSettleResponse) Reset()         { *m = MsgMarketComm
 func (m *MsgMarketCommitmentSettleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketCommitmentSettleResponse) ProtoMessage()    {}
 func (*MsgMarketCommitmentSettleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{15}
+	return fileDescriptor_e333fcffc093bd1b, []int{17}
 }
 func (m *MsgMarketCommitmentSettleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,7 +1108,7 @@ func (m *MsgMarketReleaseCommitmentsRequest) Reset()         { *m = MsgMarketRel
 func (m *MsgMarketReleaseCommitmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketReleaseCommitmentsRequest) ProtoMessage()    {}
 func (*MsgMarketReleaseCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{16}
+	return fileDescriptor_e333fcffc093bd1b, []int{18}
 }
 func (m *MsgMarketReleaseCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1173,7 @@ func (m *MsgMarketReleaseCommitmentsResponse) Reset()         { *m = MsgMarketRe
 func (m *MsgMarketReleaseCommitmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketReleaseCommitmentsResponse) ProtoMessage()    {}
 func (*MsgMarketReleaseCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{17}
+	return fileDescriptor_e333fcffc093bd1b, []int{19}
 }
 func (m *MsgMarketReleaseCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1098,7 +1219,7 @@ func (m *MsgMarketSetOrderExternalIDRequest) Reset()         { *m = MsgMarketSet
 func (m *MsgMarketSetOrderExternalIDRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketSetOrderExternalIDRequest) ProtoMessage()    {}
 func (*MsgMarketSetOrderExternalIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{18}
+	return fileDescriptor_e333fcffc093bd1b, []int{20}
 }
 func (m *MsgMarketSetOrderExternalIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,7 +1284,7 @@ func (m *MsgMarketSetOrderExternalIDResponse) Reset()         { *m = MsgMarketSe
 func (m *MsgMarketSetOrderExternalIDResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketSetOrderExternalIDResponse) ProtoMessage()    {}
 func (*MsgMarketSetOrderExternalIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{19}
+	return fileDescriptor_e333fcffc093bd1b, []int{21}
 }
 func (m *MsgMarketSetOrderExternalIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1208,7 +1329,7 @@ func (m *MsgMarketWithdrawRequest) Reset()         { *m = MsgMarketWithdrawReque
 func (m *MsgMarketWithdrawRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketWithdrawRequest) ProtoMessage()    {}
 func (*MsgMarketWithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{20}
+	return fileDescriptor_e333fcffc093bd1b, []int{22}
 }
 func (m *MsgMarketWithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1394,7 @@ func (m *MsgMarketWithdrawResponse) Reset()         { *m = MsgMarketWithdrawResp
 func (m *MsgMarketWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketWithdrawResponse) ProtoMessage()    {}
 func (*MsgMarketWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{21}
+	return fileDescriptor_e333fcffc093bd1b, []int{23}
 }
 func (m *MsgMarketWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,7 +1437,7 @@ func (m *MsgMarketUpdateDetailsRequest) Reset()         { *m = MsgMarketUpdateDe
 func (m *MsgMarketUpdateDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateDetailsRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{22}
+	return fileDescriptor_e333fcffc093bd1b, []int{24}
 }
 func (m *MsgMarketUpdateDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1374,7 +1495,7 @@ func (m *MsgMarketUpdateDetailsResponse) Reset()         { *m = MsgMarketUpdateD
 func (m *MsgMarketUpdateDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateDetailsResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{23}
+	return fileDescriptor_e333fcffc093bd1b, []int{25}
 }
 func (m *MsgMarketUpdateDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1544,7 @@ func (m *MsgMarketUpdateEnabledRequest) Reset()         { *m = MsgMarketUpdateEn
 func (m *MsgMarketUpdateEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateEnabledRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{24}
+	return fileDescriptor_e333fcffc093bd1b, []int{26}
 }
 func (m *MsgMarketUpdateEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1487,7 +1608,7 @@ func (m *MsgMarketUpdateEnabledResponse) Reset()         { *m = MsgMarketUpdateE
 func (m *MsgMarketUpdateEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateEnabledResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{25}
+	return fileDescriptor_e333fcffc093bd1b, []int{27}
 }
 func (m *MsgMarketUpdateEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,7 +1651,7 @@ func (m *MsgMarketUpdateAcceptingOrdersRequest) Reset()         { *m = MsgMarket
 func (m *MsgMarketUpdateAcceptingOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateAcceptingOrdersRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateAcceptingOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{26}
+	return fileDescriptor_e333fcffc093bd1b, []int{28}
 }
 func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1590,7 +1711,7 @@ func (m *MsgMarketUpdateAcceptingOrdersResponse) Reset() {
 func (m *MsgMarketUpdateAcceptingOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateAcceptingOrdersResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateAcceptingOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{27}
+	return fileDescriptor_e333fcffc093bd1b, []int{29}
 }
 func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1756,7 @@ func (m *MsgMarketUpdateUserSettleRequest) Reset()         { *m = MsgMarketUpdat
 func (m *MsgMarketUpdateUserSettleRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateUserSettleRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateUserSettleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{28}
+	return fileDescriptor_e333fcffc093bd1b, []int{30}
 }
 func (m *MsgMarketUpdateUserSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1693,7 +1814,7 @@ func (m *MsgMarketUpdateUserSettleResponse) Reset()         { *m = MsgMarketUpda
 func (m *MsgMarketUpdateUserSettleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateUserSettleResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateUserSettleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{29}
+	return fileDescriptor_e333fcffc093bd1b, []int{31}
 }
 func (m *MsgMarketUpdateUserSettleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1722,6 +1843,108 @@ func (m *MsgMarketUpdateUserSettleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMarketUpdateUserSettleResponse proto.InternalMessageInfo
 
+// MsgMarketUpdateAutoMatchRequest is a request message for the MarketUpdateAutoMatch endpoint.
+type MsgMarketUpdateAutoMatchRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market to enable or disable automatic matching for.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// auto_match is whether this market's crossing orders should be matched and settled automatically.
+	// The MarketSettle endpoint is available (only to market actors) regardless of the value of this field.
+	AutoMatch bool `protobuf:"varint,3,opt,name=auto_match,json=autoMatch,proto3" json:"auto_match,omitempty"`
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) Reset()         { *m = MsgMarketUpdateAutoMatchRequest{} }
+func (m *MsgMarketUpdateAutoMatchRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateAutoMatchRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateAutoMatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{32}
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarketUpdateAutoMatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateAutoMatchRequest.Merge(m, src)
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateAutoMatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarketUpdateAutoMatchRequest proto.InternalMessageInfo
+
+func (m *MsgMarketUpdateAutoMatchRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) GetAutoMatch() bool {
+	if m != nil {
+		return m.AutoMatch
+	}
+	return false
+}
+
+// MsgMarketUpdateAutoMatchResponse is a response message for the MarketUpdateAutoMatch endpoint.
+type MsgMarketUpdateAutoMatchResponse struct {
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) Reset()         { *m = MsgMarketUpdateAutoMatchResponse{} }
+func (m *MsgMarketUpdateAutoMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateAutoMatchResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateAutoMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{33}
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarketUpdateAutoMatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateAutoMatchResponse.Merge(m, src)
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateAutoMatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarketUpdateAutoMatchResponse proto.InternalMessageInfo
+
 // MsgMarketUpdateAcceptingCommitmentsRequest is a request message for the MarketUpdateAcceptingCommitments endpoint.
 type MsgMarketUpdateAcceptingCommitmentsRequest struct {
 	// admin is the account with "update" permission requesting this change.
@@ -1742,7 +1965,7 @@ func (m *MsgMarketUpdateAcceptingCommitmentsRequest) String() string {
 }
 func (*MsgMarketUpdateAcceptingCommitmentsRequest) ProtoMessage() {}
 func (*MsgMarketUpdateAcceptingCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{30}
+	return fileDescriptor_e333fcffc093bd1b, []int{34}
 }
 func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1804,7 +2027,7 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) String() string {
 }
 func (*MsgMarketUpdateAcceptingCommitmentsResponse) ProtoMessage() {}
 func (*MsgMarketUpdateAcceptingCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{31}
+	return fileDescriptor_e333fcffc093bd1b, []int{35}
 }
 func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1849,7 +2072,7 @@ func (m *MsgMarketUpdateIntermediaryDenomRequest) Reset() {
 func (m *MsgMarketUpdateIntermediaryDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateIntermediaryDenomRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateIntermediaryDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{32}
+	return fileDescriptor_e333fcffc093bd1b, []int{36}
 }
 func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1909,7 +2132,7 @@ func (m *MsgMarketUpdateIntermediaryDenomResponse) Reset() {
 func (m *MsgMarketUpdateIntermediaryDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateIntermediaryDenomResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateIntermediaryDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{33}
+	return fileDescriptor_e333fcffc093bd1b, []int{37}
 }
 func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1956,7 +2179,7 @@ func (m *MsgMarketManagePermissionsRequest) Reset()         { *m = MsgMarketMana
 func (m *MsgMarketManagePermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManagePermissionsRequest) ProtoMessage()    {}
 func (*MsgMarketManagePermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{34}
+	return fileDescriptor_e333fcffc093bd1b, []int{38}
 }
 func (m *MsgMarketManagePermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2028,7 +2251,7 @@ func (m *MsgMarketManagePermissionsResponse) Reset()         { *m = MsgMarketMan
 func (m *MsgMarketManagePermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManagePermissionsResponse) ProtoMessage()    {}
 func (*MsgMarketManagePermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{35}
+	return fileDescriptor_e333fcffc093bd1b, []int{39}
 }
 func (m *MsgMarketManagePermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2081,7 +2304,7 @@ func (m *MsgMarketManageReqAttrsRequest) Reset()         { *m = MsgMarketManageR
 func (m *MsgMarketManageReqAttrsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManageReqAttrsRequest) ProtoMessage()    {}
 func (*MsgMarketManageReqAttrsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{36}
+	return fileDescriptor_e333fcffc093bd1b, []int{40}
 }
 func (m *MsgMarketManageReqAttrsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2174,7 +2397,7 @@ func (m *MsgMarketManageReqAttrsResponse) Reset()         { *m = MsgMarketManage
 func (m *MsgMarketManageReqAttrsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManageReqAttrsResponse) ProtoMessage()    {}
 func (*MsgMarketManageReqAttrsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{37}
+	return fileDescriptor_e333fcffc093bd1b, []int{41}
 }
 func (m *MsgMarketManageReqAttrsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2213,7 +2436,7 @@ func (m *MsgCreatePaymentRequest) Reset()         { *m = MsgCreatePaymentRequest
 func (m *MsgCreatePaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePaymentRequest) ProtoMessage()    {}
 func (*MsgCreatePaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{38}
+	return fileDescriptor_e333fcffc093bd1b, []int{42}
 }
 func (m *MsgCreatePaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2257,7 +2480,7 @@ func (m *MsgCreatePaymentResponse) Reset()         { *m = MsgCreatePaymentRespon
 func (m *MsgCreatePaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePaymentResponse) ProtoMessage()    {}
 func (*MsgCreatePaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{39}
+	return fileDescriptor_e333fcffc093bd1b, []int{43}
 }
 func (m *MsgCreatePaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2296,7 +2519,7 @@ func (m *MsgAcceptPaymentRequest) Reset()         { *m = MsgAcceptPaymentRequest
 func (m *MsgAcceptPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptPaymentRequest) ProtoMessage()    {}
 func (*MsgAcceptPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{40}
+	return fileDescriptor_e333fcffc093bd1b, []int{44}
 }
 func (m *MsgAcceptPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2563,7 @@ func (m *MsgAcceptPaymentResponse) Reset()         { *m = MsgAcceptPaymentRespon
 func (m *MsgAcceptPaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptPaymentResponse) ProtoMessage()    {}
 func (*MsgAcceptPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{41}
+	return fileDescriptor_e333fcffc093bd1b, []int{45}
 }
 func (m *MsgAcceptPaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2383,7 +2606,7 @@ func (m *MsgRejectPaymentRequest) Reset()         { *m = MsgRejectPaymentRequest
 func (m *MsgRejectPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentRequest) ProtoMessage()    {}
 func (*MsgRejectPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{42}
+	return fileDescriptor_e333fcffc093bd1b, []int{46}
 }
 func (m *MsgRejectPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2441,7 +2664,7 @@ func (m *MsgRejectPaymentResponse) Reset()         { *m = MsgRejectPaymentRespon
 func (m *MsgRejectPaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentResponse) ProtoMessage()    {}
 func (*MsgRejectPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{43}
+	return fileDescriptor_e333fcffc093bd1b, []int{47}
 }
 func (m *MsgRejectPaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2482,7 +2705,7 @@ func (m *MsgRejectPaymentsRequest) Reset()         { *m = MsgRejectPaymentsReque
 func (m *MsgRejectPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentsRequest) ProtoMessage()    {}
 func (*MsgRejectPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{44}
+	return fileDescriptor_e333fcffc093bd1b, []int{48}
 }
 func (m *MsgRejectPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2533,7 +2756,7 @@ func (m *MsgRejectPaymentsResponse) Reset()         { *m = MsgRejectPaymentsResp
 func (m *MsgRejectPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentsResponse) ProtoMessage()    {}
 func (*MsgRejectPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{45}
+	return fileDescriptor_e333fcffc093bd1b, []int{49}
 }
 func (m *MsgRejectPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2574,7 +2797,7 @@ func (m *MsgCancelPaymentsRequest) Reset()         { *m = MsgCancelPaymentsReque
 func (m *MsgCancelPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelPaymentsRequest) ProtoMessage()    {}
 func (*MsgCancelPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{46}
+	return fileDescriptor_e333fcffc093bd1b, []int{50}
 }
 func (m *MsgCancelPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2625,7 +2848,7 @@ func (m *MsgCancelPaymentsResponse) Reset()         { *m = MsgCancelPaymentsResp
 func (m *MsgCancelPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelPaymentsResponse) ProtoMessage()    {}
 func (*MsgCancelPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{47}
+	return fileDescriptor_e333fcffc093bd1b, []int{51}
 }
 func (m *MsgCancelPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2668,7 +2891,7 @@ func (m *MsgChangePaymentTargetRequest) Reset()         { *m = MsgChangePaymentT
 func (m *MsgChangePaymentTargetRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgChangePaymentTargetRequest) ProtoMessage()    {}
 func (*MsgChangePaymentTargetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{48}
+	return fileDescriptor_e333fcffc093bd1b, []int{52}
 }
 func (m *MsgChangePaymentTargetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2726,7 +2949,7 @@ func (m *MsgChangePaymentTargetResponse) Reset()         { *m = MsgChangePayment
 func (m *MsgChangePaymentTargetResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChangePaymentTargetResponse) ProtoMessage()    {}
 func (*MsgChangePaymentTargetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{49}
+	return fileDescriptor_e333fcffc093bd1b, []int{53}
 }
 func (m *MsgChangePaymentTargetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2769,7 +2992,7 @@ func (m *MsgGovCreateMarketRequest) Reset()         { *m = MsgGovCreateMarketReq
 func (m *MsgGovCreateMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCreateMarketRequest) ProtoMessage()    {}
 func (*MsgGovCreateMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{50}
+	return fileDescriptor_e333fcffc093bd1b, []int{54}
 }
 func (m *MsgGovCreateMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2820,7 +3043,7 @@ func (m *MsgGovCreateMarketResponse) Reset()         { *m = MsgGovCreateMarketRe
 func (m *MsgGovCreateMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCreateMarketResponse) ProtoMessage()    {}
 func (*MsgGovCreateMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{51}
+	return fileDescriptor_e333fcffc093bd1b, []int{55}
 }
 func (m *MsgGovCreateMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3118,7 @@ func (m *MsgGovManageFeesRequest) Reset()         { *m = MsgGovManageFeesRequest
 func (m *MsgGovManageFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovManageFeesRequest) ProtoMessage()    {}
 func (*MsgGovManageFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{52}
+	return fileDescriptor_e333fcffc093bd1b, []int{56}
 }
 func (m *MsgGovManageFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3058,7 +3281,7 @@ func (m *MsgGovManageFeesResponse) Reset()         { *m = MsgGovManageFeesRespon
 func (m *MsgGovManageFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovManageFeesResponse) ProtoMessage()    {}
 func (*MsgGovManageFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{53}
+	return fileDescriptor_e333fcffc093bd1b, []int{57}
 }
 func (m *MsgGovManageFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3099,7 +3322,7 @@ func (m *MsgGovCloseMarketRequest) Reset()         { *m = MsgGovCloseMarketReque
 func (m *MsgGovCloseMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCloseMarketRequest) ProtoMessage()    {}
 func (*MsgGovCloseMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{54}
+	return fileDescriptor_e333fcffc093bd1b, []int{58}
 }
 func (m *MsgGovCloseMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3150,7 +3373,7 @@ func (m *MsgGovCloseMarketResponse) Reset()         { *m = MsgGovCloseMarketResp
 func (m *MsgGovCloseMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCloseMarketResponse) ProtoMessage()    {}
 func (*MsgGovCloseMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{55}
+	return fileDescriptor_e333fcffc093bd1b, []int{59}
 }
 func (m *MsgGovCloseMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3194,7 +3417,7 @@ func (m *MsgGovUpdateParamsRequest) Reset()         { *m = MsgGovUpdateParamsReq
 func (m *MsgGovUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovUpdateParamsRequest) ProtoMessage()    {}
 func (*MsgGovUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{56}
+	return fileDescriptor_e333fcffc093bd1b, []int{60}
 }
 func (m *MsgGovUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3248,7 +3471,7 @@ func (m *MsgGovUpdateParamsResponse) Reset()         { *m = MsgGovUpdateParamsRe
 func (m *MsgGovUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgGovUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{57}
+	return fileDescriptor_e333fcffc093bd1b, []int{61}
 }
 func (m *MsgGovUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,7 +3512,7 @@ func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{}
 func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsRequest) ProtoMessage()    {}
 func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{58}
+	return fileDescriptor_e333fcffc093bd1b, []int{62}
 }
 func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3340,7 +3563,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{59}
+	return fileDescriptor_e333fcffc093bd1b, []int{63}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3378,6 +3601,8 @@ func init() {
 	proto.RegisterType((*MsgCommitFundsResponse)(nil), "provenance.exchange.v1.MsgCommitFundsResponse")
 	proto.RegisterType((*MsgCancelOrderRequest)(nil), "provenance.exchange.v1.MsgCancelOrderRequest")
 	proto.RegisterType((*MsgCancelOrderResponse)(nil), "provenance.exchange.v1.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgAmendOrderRequest)(nil), "provenance.exchange.v1.MsgAmendOrderRequest")
+	proto.RegisterType((*MsgAmendOrderResponse)(nil), "provenance.exchange.v1.MsgAmendOrderResponse")
 	proto.RegisterType((*MsgFillBidsRequest)(nil), "provenance.exchange.v1.MsgFillBidsRequest")
 	proto.RegisterType((*MsgFillBidsResponse)(nil), "provenance.exchange.v1.MsgFillBidsResponse")
 	proto.RegisterType((*MsgFillAsksRequest)(nil), "provenance.exchange.v1.MsgFillAsksRequest")
@@ -3400,6 +3625,8 @@ func init() {
 	proto.RegisterType((*MsgMarketUpdateAcceptingOrdersResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingOrdersResponse")
 	proto.RegisterType((*MsgMarketUpdateUserSettleRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateUserSettleRequest")
 	proto.RegisterType((*MsgMarketUpdateUserSettleResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateUserSettleResponse")
+	proto.RegisterType((*MsgMarketUpdateAutoMatchRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateAutoMatchRequest")
+	proto.RegisterType((*MsgMarketUpdateAutoMatchResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAutoMatchResponse")
 	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsRequest")
 	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsResponse")
 	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomRequest")
@@ -3435,184 +3662,192 @@ func init() {
 func init() { proto.RegisterFile("provenance/exchange/v1/tx.proto", fileDescriptor_e333fcffc093bd1b) }
 
 var fileDescriptor_e333fcffc093bd1b = []byte{
-	// 2830 bytes of a gzipped FileDescriptorProto
+	// 2949 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xcf, 0x78, 0xfd, 0xb5, 0xc7, 0x1f, 0x4d, 0xae, 0xed, 0x64, 0x3d, 0xae, 0xd7, 0x9b, 0x4d,
-	0x03, 0xc6, 0xc5, 0xbb, 0xb1, 0x2b, 0x5a, 0x30, 0x2d, 0xa9, 0xd7, 0x89, 0x23, 0x57, 0x4a, 0xb0,
-	0x36, 0x09, 0x48, 0xe5, 0x61, 0x75, 0xbd, 0x73, 0xb3, 0x19, 0x3c, 0x3b, 0xb3, 0x99, 0x3b, 0xeb,
-	0xd8, 0x12, 0x08, 0x84, 0x2a, 0x01, 0x0f, 0x95, 0x2a, 0x21, 0x5e, 0x10, 0x42, 0x02, 0x24, 0x04,
-	0xe4, 0x81, 0x20, 0x10, 0xe2, 0xe3, 0x91, 0x97, 0x3e, 0xf4, 0xa1, 0xe2, 0x89, 0x27, 0xa8, 0x12,
-	0x89, 0xfc, 0x17, 0x08, 0xdd, 0x8f, 0xd9, 0x9d, 0xef, 0x99, 0x75, 0xb3, 0x11, 0x2f, 0x6d, 0x76,
-	0xe6, 0x9c, 0xf3, 0x3b, 0xbf, 0x73, 0xee, 0x9d, 0x7b, 0xe6, 0x9c, 0x31, 0xac, 0x74, 0x6c, 0xeb,
-	0x88, 0x98, 0xd8, 0x6c, 0x92, 0x2a, 0x39, 0x6e, 0xde, 0xc7, 0x66, 0x8b, 0x54, 0x8f, 0x36, 0xaa,
-	0xce, 0x71, 0xa5, 0x63, 0x5b, 0x8e, 0x85, 0xce, 0xf7, 0x05, 0x2a, 0xae, 0x40, 0xe5, 0x68, 0x43,
-	0x3d, 0x87, 0xdb, 0xba, 0x69, 0x55, 0xf9, 0x7f, 0x85, 0xa8, 0x5a, 0x6c, 0x5a, 0xb4, 0x6d, 0xd1,
-	0xea, 0x01, 0xa6, 0xcc, 0xc6, 0x01, 0x71, 0xf0, 0x46, 0xb5, 0x69, 0xe9, 0xa6, 0xbc, 0x7f, 0x41,
-	0xde, 0x6f, 0xd3, 0x16, 0x83, 0x68, 0xd3, 0x96, 0xbc, 0xb1, 0x28, 0x6e, 0x34, 0xf8, 0xaf, 0xaa,
-	0xf8, 0x21, 0x6f, 0xcd, 0xb7, 0xac, 0x96, 0x25, 0xae, 0xb3, 0x7f, 0xc9, 0xab, 0xab, 0x31, 0x5e,
-	0x37, 0xad, 0x76, 0x5b, 0x77, 0xda, 0xc4, 0x74, 0x5c, 0xfd, 0x4b, 0x31, 0x92, 0x6d, 0x6c, 0x1f,
-	0x12, 0x27, 0x45, 0xc8, 0xb2, 0x35, 0x62, 0xa7, 0x59, 0xea, 0x60, 0x1b, 0xb7, 0x5d, 0xa1, 0xcb,
-	0xb1, 0x42, 0x27, 0x1e, 0xaf, 0xca, 0x7f, 0x50, 0x60, 0xee, 0x26, 0x6d, 0xed, 0xd8, 0x04, 0x3b,
-	0x64, 0x9b, 0x1e, 0xd6, 0xc9, 0x83, 0x2e, 0xa1, 0x0e, 0xda, 0x81, 0x3c, 0xa6, 0x87, 0x0d, 0x8e,
-	0x5b, 0x50, 0x4a, 0xca, 0xea, 0xd4, 0x66, 0xa9, 0x12, 0x9d, 0x80, 0xca, 0x36, 0x3d, 0xfc, 0x2a,
-	0x93, 0xab, 0x8d, 0x7e, 0xf8, 0xaf, 0x95, 0x33, 0xf5, 0x49, 0x2c, 0x7f, 0xa3, 0x1b, 0x80, 0xb8,
-	0x81, 0x46, 0x93, 0x99, 0xd7, 0x2d, 0xb3, 0x71, 0x8f, 0x90, 0xc2, 0x08, 0xb7, 0xb6, 0x58, 0x91,
-	0xd1, 0x65, 0x39, 0xaa, 0xc8, 0x1c, 0x55, 0x76, 0x2c, 0xdd, 0xac, 0x9f, 0xe5, 0x4a, 0x3b, 0x52,
-	0x67, 0x97, 0x90, 0xad, 0xd9, 0xef, 0x3d, 0x7b, 0xbc, 0xd6, 0x77, 0xa8, 0xbc, 0x01, 0xf3, 0x7e,
-	0xa7, 0x69, 0xc7, 0x32, 0x29, 0x41, 0x8b, 0x30, 0x29, 0x00, 0x75, 0x8d, 0x3b, 0x3d, 0x5a, 0x9f,
-	0xe0, 0xbf, 0xf7, 0x34, 0x3f, 0xd1, 0x9a, 0xae, 0x79, 0x88, 0x1e, 0xe8, 0x5a, 0x36, 0xa2, 0x35,
-	0x5d, 0xf3, 0x11, 0x3d, 0x90, 0xbf, 0x9f, 0x37, 0xd1, 0x9e, 0x43, 0x3e, 0xa2, 0xdc, 0xe9, 0x74,
-	0xa2, 0x1f, 0x8d, 0xc0, 0x02, 0xd3, 0xe1, 0x0b, 0x70, 0xb7, 0x6b, 0x6a, 0xd4, 0xa5, 0xba, 0x09,
-	0x13, 0xb8, 0xd9, 0xb4, 0xba, 0xa6, 0xc3, 0x75, 0xf2, 0xb5, 0xc2, 0x3f, 0xfe, 0xb8, 0x3e, 0x2f,
-	0xbd, 0xdb, 0xd6, 0x34, 0x9b, 0x50, 0x7a, 0xdb, 0xb1, 0x75, 0xb3, 0x55, 0x77, 0x05, 0xd1, 0x12,
-	0xe4, 0xc5, 0x02, 0x65, 0x48, 0x8c, 0xd0, 0x4c, 0x7d, 0x52, 0x5c, 0xd8, 0xd3, 0xd0, 0x09, 0x8c,
-	0xe3, 0x36, 0xb7, 0x97, 0x2b, 0xe5, 0x12, 0xa9, 0xd6, 0x76, 0x59, 0xc4, 0x7e, 0xfb, 0xef, 0x95,
-	0xd5, 0x96, 0xee, 0xdc, 0xef, 0x1e, 0x54, 0x9a, 0x56, 0x5b, 0x6e, 0x2f, 0xf9, 0xbf, 0x75, 0xaa,
-	0x1d, 0x56, 0x9d, 0x93, 0x0e, 0xa1, 0x5c, 0x81, 0xfe, 0xe4, 0xd9, 0xe3, 0xb5, 0x69, 0x83, 0xb4,
-	0x70, 0xf3, 0xa4, 0xc1, 0x76, 0x2e, 0xfd, 0xf5, 0xb3, 0xc7, 0x6b, 0x4a, 0x5d, 0x02, 0xa2, 0x37,
-	0x61, 0xda, 0x17, 0xeb, 0xd1, 0xb4, 0x58, 0x4f, 0x35, 0xfb, 0x61, 0x66, 0xac, 0xc8, 0x11, 0x31,
-	0x9d, 0x86, 0x83, 0x5b, 0x85, 0x31, 0x16, 0x8b, 0xfa, 0x24, 0xbf, 0x70, 0x07, 0xb7, 0xb6, 0xa6,
-	0x59, 0x0e, 0xdc, 0x00, 0x94, 0x0b, 0x70, 0x3e, 0x18, 0x4d, 0x91, 0x83, 0xf2, 0x03, 0x11, 0x67,
-	0xb6, 0x4a, 0x0c, 0xbe, 0x0c, 0xdc, 0x38, 0x5f, 0x81, 0x71, 0xaa, 0xb7, 0x4c, 0xb9, 0x9e, 0x92,
-	0xc2, 0x2c, 0xe5, 0x7c, 0xe9, 0x1c, 0xf1, 0xa5, 0x73, 0x6b, 0x8a, 0x79, 0x23, 0xe5, 0x5c, 0x67,
-	0xbc, 0x90, 0xd2, 0x99, 0xbf, 0xe7, 0x00, 0xdd, 0xa4, 0xad, 0x5d, 0xdd, 0x30, 0x6a, 0x7a, 0x3f,
-	0xe5, 0xcc, 0x15, 0x62, 0x18, 0x99, 0x5c, 0xe1, 0x72, 0xc9, 0x09, 0x7f, 0x4f, 0x81, 0x69, 0xc7,
-	0x72, 0xb0, 0xd1, 0xc0, 0x94, 0x12, 0x87, 0xbe, 0xb8, 0xbc, 0x4f, 0x71, 0xd8, 0x6d, 0x8e, 0x8a,
-	0xca, 0x30, 0xd3, 0xdb, 0x22, 0x0d, 0x5d, 0xa3, 0x85, 0xd1, 0x52, 0x6e, 0x75, 0xb4, 0x3e, 0xe5,
-	0xee, 0xc7, 0x3d, 0x8d, 0xa2, 0xaf, 0x81, 0x2a, 0x18, 0x35, 0x28, 0x71, 0x1c, 0x83, 0xb0, 0x87,
-	0x5e, 0xe3, 0x9e, 0x81, 0x1d, 0xbe, 0x5c, 0xc6, 0xd2, 0x96, 0xcb, 0x05, 0xa1, 0x7c, 0xbb, 0xa7,
-	0xbb, 0x6b, 0x60, 0x87, 0x2d, 0x9d, 0x5b, 0x70, 0xbe, 0xf7, 0x1c, 0xf2, 0x6f, 0xf7, 0xf1, 0x34,
-	0x9b, 0x73, 0xee, 0x83, 0xd1, 0xbb, 0xe3, 0x65, 0x7e, 0x39, 0x5a, 0x79, 0x81, 0x3f, 0xa3, 0xfa,
-	0x49, 0x94, 0xc9, 0xfd, 0x5b, 0x3f, 0xb9, 0xdb, 0xf4, 0xb0, 0x97, 0xdc, 0x0a, 0x8c, 0x1d, 0x74,
-	0x4f, 0x32, 0xe4, 0x56, 0x88, 0x25, 0xa7, 0xf6, 0x6d, 0x10, 0x21, 0x6e, 0x74, 0x6c, 0xbd, 0x49,
-	0x0a, 0xb9, 0x14, 0x32, 0xf2, 0x11, 0x08, 0x5c, 0x67, 0x9f, 0xa9, 0xb0, 0xac, 0xf4, 0x23, 0xe3,
-	0xc9, 0x8a, 0xcb, 0x9a, 0x65, 0xe5, 0xc7, 0x0a, 0x2c, 0x70, 0x67, 0x7c, 0x59, 0x21, 0x84, 0x16,
-	0xc6, 0x5e, 0xd4, 0x4a, 0x9a, 0xe3, 0xf8, 0x9e, 0xc4, 0x12, 0x42, 0x59, 0x56, 0xfb, 0x2b, 0x6a,
-	0xc0, 0xac, 0xba, 0xab, 0xce, 0x9b, 0x55, 0x60, 0x59, 0x15, 0x61, 0xf7, 0x24, 0x55, 0x24, 0x4f,
-	0x26, 0xf5, 0x13, 0x85, 0x6f, 0xe6, 0x9b, 0x3c, 0x01, 0xc2, 0x1d, 0x4f, 0x62, 0xb1, 0xd6, 0xd6,
-	0xcd, 0xf4, 0xc4, 0x72, 0xb1, 0xe4, 0xc4, 0x86, 0xd2, 0x92, 0x0b, 0xa7, 0x25, 0xcb, 0x86, 0xba,
-	0x0c, 0xb3, 0xe4, 0xb8, 0x43, 0x9a, 0x4e, 0xa3, 0x83, 0x6d, 0x47, 0xc7, 0x06, 0xdf, 0x44, 0x93,
-	0xf5, 0x19, 0x71, 0x75, 0x5f, 0x5c, 0x94, 0xcc, 0xb9, 0x5f, 0xe5, 0x45, 0xb8, 0x10, 0x62, 0x28,
-	0xd9, 0xff, 0x2a, 0x07, 0xa5, 0xde, 0xbd, 0x9d, 0x5e, 0xb1, 0x34, 0xc4, 0x38, 0xec, 0xc0, 0xb8,
-	0x6e, 0x76, 0xba, 0xbd, 0x87, 0xd6, 0xe5, 0xd8, 0x72, 0x46, 0x3c, 0xf9, 0xb7, 0xf9, 0x41, 0x23,
-	0xd7, 0xb9, 0x54, 0x45, 0xd7, 0x61, 0xc2, 0xea, 0x3a, 0xdc, 0xca, 0xe8, 0xe0, 0x56, 0x5c, 0x5d,
-	0x74, 0x15, 0x46, 0x3d, 0x8b, 0x7e, 0x20, 0x1b, 0x5c, 0x91, 0x19, 0x30, 0xf1, 0x11, 0x2d, 0x8c,
-	0x27, 0x1b, 0xb8, 0x45, 0x1c, 0xfe, 0xc8, 0xe4, 0x1b, 0xd4, 0x35, 0xc0, 0x14, 0xfd, 0x27, 0xe0,
-	0x44, 0xe0, 0x04, 0xf4, 0xe6, 0xf0, 0x12, 0x5c, 0x4c, 0xc8, 0x93, 0xcc, 0xe6, 0x7f, 0x14, 0x28,
-	0xf7, 0xa4, 0xea, 0xc4, 0x20, 0x98, 0x92, 0xbe, 0x30, 0x1d, 0x4a, 0x3e, 0xdf, 0x01, 0x70, 0xac,
-	0x86, 0x2d, 0xc0, 0x4e, 0x93, 0xd3, 0xbc, 0x63, 0x49, 0x57, 0xfd, 0xd1, 0x18, 0x4d, 0x88, 0xc6,
-	0x65, 0xb8, 0x94, 0xc8, 0x53, 0xc6, 0xe3, 0x2f, 0xde, 0x78, 0xdc, 0x26, 0x0e, 0xdf, 0x44, 0xd7,
-	0x8f, 0x1d, 0x62, 0x9b, 0xd8, 0xd8, 0xbb, 0x36, 0x94, 0x78, 0x78, 0x6b, 0x88, 0x9c, 0xaf, 0x86,
-	0x40, 0x2b, 0x30, 0x45, 0x24, 0x38, 0xbb, 0x2b, 0x08, 0x82, 0x7b, 0x69, 0x4f, 0x8b, 0xa5, 0x18,
-	0xe5, 0xba, 0xa4, 0xf8, 0xfe, 0x08, 0x14, 0x7a, 0x72, 0x5f, 0xd7, 0x9d, 0xfb, 0x9a, 0x8d, 0x1f,
-	0x0e, 0x85, 0xd8, 0x32, 0x4f, 0x34, 0x16, 0x7a, 0x9c, 0x5a, 0x9e, 0xe5, 0x4e, 0x1a, 0xf2, 0x14,
-	0xa1, 0xa3, 0x2f, 0xb8, 0x08, 0xf5, 0x85, 0x6d, 0x09, 0x16, 0x23, 0xc2, 0x21, 0x83, 0xf5, 0x91,
-	0x02, 0xcb, 0xbd, 0xbb, 0x77, 0x3b, 0x1a, 0x76, 0xc8, 0x35, 0xe2, 0x60, 0xdd, 0x18, 0xce, 0xd6,
-	0xa8, 0xc3, 0xac, 0xbc, 0xa9, 0x09, 0x14, 0x79, 0x9c, 0xc7, 0x6e, 0x0f, 0xe1, 0x98, 0x74, 0x49,
-	0x6e, 0x8f, 0x99, 0xb6, 0xf7, 0xa2, 0x8f, 0x6b, 0x09, 0x8a, 0x71, 0x6c, 0x24, 0xe1, 0xdf, 0x85,
-	0x09, 0x5f, 0x37, 0xf1, 0x81, 0x41, 0xb4, 0x7e, 0x65, 0xea, 0x23, 0xac, 0xc6, 0x11, 0x2e, 0x28,
-	0x2e, 0xe5, 0x95, 0x10, 0xe5, 0xda, 0x48, 0x41, 0xf1, 0xd0, 0x5e, 0x87, 0xb3, 0xb8, 0xd9, 0x24,
-	0x1d, 0x47, 0x37, 0x5b, 0xe2, 0x2c, 0x13, 0xc4, 0x27, 0xb9, 0xdc, 0x4b, 0xbd, 0x7b, 0x7c, 0x49,
-	0x53, 0x51, 0xe7, 0xbb, 0x4e, 0x94, 0x5f, 0x09, 0x71, 0xea, 0x39, 0x2c, 0x38, 0x6d, 0x8d, 0x14,
-	0x94, 0xf2, 0x23, 0x05, 0x2e, 0x07, 0xc4, 0xb6, 0xfd, 0x66, 0x87, 0x92, 0xd0, 0xcf, 0xc5, 0x31,
-	0x0b, 0xb3, 0xf2, 0xe6, 0x69, 0x15, 0x3e, 0x93, 0xe6, 0x6c, 0x3f, 0x5f, 0xa5, 0x80, 0xe8, 0x5d,
-	0xea, 0x56, 0x49, 0x43, 0xa1, 0xb4, 0x09, 0x0b, 0xd8, 0x30, 0xac, 0x87, 0x8d, 0x2e, 0xf5, 0x55,
-	0x83, 0x92, 0xd7, 0x1c, 0xbf, 0xd9, 0xf7, 0x81, 0xdd, 0x8a, 0x3d, 0x97, 0xc2, 0x0e, 0x4b, 0x5a,
-	0x7f, 0x55, 0x60, 0x2d, 0x2e, 0x02, 0xc3, 0x3e, 0x9f, 0x5e, 0x83, 0x85, 0x7e, 0xce, 0x3c, 0xed,
-	0x20, 0x49, 0x70, 0x1e, 0x47, 0x38, 0xe2, 0x63, 0xb8, 0x0e, 0xaf, 0x66, 0xf2, 0x5d, 0x72, 0xfd,
-	0xbd, 0x02, 0x9f, 0x0d, 0xc8, 0xef, 0x99, 0x0e, 0xb1, 0xdb, 0x44, 0xd3, 0xb1, 0x7d, 0x72, 0x8d,
-	0x98, 0x56, 0x7b, 0x28, 0x44, 0xd7, 0x01, 0xe9, 0x1e, 0xa0, 0x86, 0xc6, 0x90, 0xe4, 0x73, 0xfa,
-	0x9c, 0x1e, 0x74, 0xc1, 0x47, 0x71, 0x0d, 0x56, 0xd3, 0x5d, 0x96, 0xfc, 0x7e, 0x33, 0xe2, 0xc9,
-	0xf8, 0x4d, 0x6c, 0xe2, 0x16, 0xd9, 0x27, 0x76, 0x5b, 0xa7, 0x54, 0xb7, 0x4c, 0x3a, 0xac, 0x93,
-	0xc7, 0x26, 0x47, 0xd6, 0x21, 0x69, 0x60, 0xc3, 0xe0, 0x25, 0x46, 0xbe, 0x9e, 0x17, 0x57, 0xb6,
-	0x0d, 0x03, 0xed, 0x42, 0x9e, 0x57, 0x20, 0xec, 0xb7, 0x3c, 0x7c, 0x2e, 0x25, 0x14, 0x20, 0x84,
-	0xd2, 0x1b, 0x36, 0xee, 0x95, 0x1f, 0x93, 0xac, 0xfc, 0x60, 0xaa, 0xe8, 0x1a, 0x4c, 0x3a, 0x56,
-	0xa3, 0xc5, 0xee, 0xc9, 0x8a, 0x70, 0x00, 0x33, 0x13, 0x8e, 0xc5, 0x7f, 0xfa, 0xe2, 0xfa, 0x8a,
-	0xa7, 0xfc, 0x88, 0x08, 0x95, 0x1b, 0xd1, 0x9c, 0xe7, 0x99, 0x27, 0xc4, 0xea, 0xe4, 0xc1, 0xb6,
-	0xe3, 0x0c, 0xed, 0x29, 0x76, 0x8e, 0xbf, 0x5a, 0x91, 0x06, 0x7b, 0x21, 0x11, 0x67, 0xba, 0x8c,
-	0xea, 0x6c, 0xd3, 0xed, 0xe5, 0xdd, 0x61, 0x07, 0x3b, 0xaa, 0xc2, 0xbc, 0x5f, 0xd4, 0x26, 0x6d,
-	0xeb, 0x48, 0x44, 0x39, 0x5f, 0x3f, 0xe7, 0x91, 0xae, 0xf3, 0x1b, 0x1e, 0xdb, 0xec, 0x45, 0x46,
-	0xda, 0x1e, 0xf3, 0xda, 0xae, 0xe9, 0x5a, 0xd0, 0xb6, 0x14, 0x95, 0xb6, 0xc7, 0xbd, 0xb6, 0xb9,
-	0xb4, 0xb4, 0xfd, 0x06, 0x14, 0xa4, 0x42, 0x7f, 0x1b, 0xbb, 0x10, 0x13, 0x5c, 0x69, 0x41, 0xdc,
-	0xef, 0x6f, 0x4b, 0x81, 0xf4, 0x16, 0x2c, 0x45, 0x2a, 0x4a, 0xc0, 0x49, 0xae, 0x5b, 0x08, 0xeb,
-	0x0a, 0x5c, 0x5f, 0x46, 0x2f, 0xc2, 0x4a, 0x6c, 0xaa, 0x64, 0x3a, 0xdf, 0xe5, 0x6f, 0x5b, 0xa2,
-	0x57, 0xb8, 0x2f, 0xba, 0xbc, 0x6e, 0x1a, 0xaf, 0xc2, 0x84, 0xec, 0xfb, 0xca, 0x16, 0xe7, 0x4a,
-	0xdc, 0x02, 0x93, 0x8a, 0xee, 0xe2, 0x92, 0x5a, 0x65, 0x95, 0x17, 0x7b, 0x01, 0xdb, 0x3e, 0x5c,
-	0xf1, 0x6c, 0x1a, 0x0e, 0x6e, 0xc0, 0xb6, 0xc4, 0x7d, 0xa4, 0x70, 0xe0, 0x3a, 0xf9, 0x26, 0x7f,
-	0xfd, 0xf4, 0x01, 0x5f, 0x81, 0x71, 0x07, 0xdb, 0x2d, 0x92, 0xde, 0xe9, 0x94, 0x72, 0xbc, 0x53,
-	0x66, 0x75, 0xed, 0xa6, 0x68, 0xdb, 0x26, 0x77, 0xca, 0xb8, 0x5c, 0xb0, 0xaa, 0xce, 0x85, 0xaa,
-	0x6a, 0xd1, 0xda, 0x11, 0xf6, 0x25, 0x93, 0x80, 0xb3, 0x6e, 0x2d, 0xad, 0x84, 0x6f, 0xd2, 0xd3,
-	0x53, 0xd9, 0x84, 0x09, 0xe1, 0x22, 0x2d, 0x8c, 0xb0, 0x25, 0x96, 0xd4, 0xe7, 0x95, 0x82, 0x7e,
-	0x5f, 0x45, 0x2d, 0x1b, 0x74, 0x47, 0x3a, 0xfb, 0x2d, 0xb1, 0x14, 0x78, 0x0f, 0x32, 0xc2, 0x57,
-	0x19, 0x44, 0x25, 0x63, 0x10, 0x2f, 0xc2, 0xb4, 0x27, 0x88, 0xd2, 0xe1, 0xfa, 0x54, 0x3f, 0x8a,
-	0xae, 0x6b, 0x42, 0x5e, 0xba, 0x16, 0x44, 0x97, 0xae, 0xfd, 0x59, 0x54, 0x9d, 0x3b, 0x7c, 0x55,
-	0xc9, 0xbb, 0x77, 0x38, 0xa5, 0xd3, 0x3b, 0x18, 0xc8, 0xf2, 0x48, 0x30, 0xcb, 0xe8, 0x0d, 0x00,
-	0x93, 0x3c, 0x6c, 0xc8, 0x1c, 0xe5, 0x52, 0xcc, 0xe6, 0x4d, 0xf2, 0x50, 0xb8, 0xe4, 0xe7, 0x25,
-	0x4a, 0xea, 0x48, 0xcf, 0x25, 0xb9, 0x9f, 0x2b, 0x9c, 0xfa, 0x0d, 0xeb, 0x48, 0x6c, 0x43, 0xf7,
-	0x25, 0x54, 0x10, 0x7b, 0x1d, 0xf2, 0xb8, 0xeb, 0xdc, 0xb7, 0x6c, 0xdd, 0x39, 0x49, 0xe5, 0xd6,
-	0x17, 0x45, 0x6f, 0xc2, 0xb8, 0x78, 0x3e, 0xcb, 0x69, 0x45, 0x31, 0xf9, 0x15, 0xc1, 0x6d, 0x87,
-	0x08, 0x1d, 0x77, 0x2e, 0xe3, 0x5a, 0x2b, 0xbf, 0x0c, 0x6a, 0x94, 0x8b, 0x92, 0xc1, 0x9f, 0x66,
-	0xf8, 0x86, 0xbd, 0x61, 0x1d, 0x89, 0x27, 0xd8, 0x2e, 0x21, 0xf4, 0xd3, 0xfa, 0x9f, 0x78, 0xe0,
-	0xdc, 0x85, 0x0b, 0x58, 0xd3, 0x1a, 0xf7, 0x08, 0x69, 0x78, 0x4e, 0x93, 0x7b, 0x06, 0xce, 0x30,
-	0xb0, 0x10, 0x44, 0xe7, 0xb0, 0xa6, 0xed, 0x12, 0xd2, 0x9b, 0x34, 0xed, 0x1a, 0xd8, 0x41, 0xdf,
-	0x00, 0x55, 0x3c, 0xc1, 0x23, 0x2d, 0x8f, 0x66, 0xb3, 0x7c, 0x5e, 0x98, 0x08, 0x19, 0x0f, 0xfb,
-	0xcc, 0x4e, 0x29, 0x6e, 0x79, 0xec, 0x14, 0x3e, 0xd7, 0x74, 0x2d, 0xde, 0xe7, 0x9e, 0xe5, 0xf1,
-	0xd3, 0xf9, 0xec, 0x1a, 0x6f, 0x42, 0xd1, 0xf5, 0x39, 0xba, 0xe7, 0xce, 0x8f, 0xc9, 0x0c, 0x00,
-	0xaa, 0x70, 0xfd, 0x76, 0x44, 0xef, 0x1d, 0xe9, 0x70, 0xd1, 0xc3, 0x20, 0x06, 0x67, 0x32, 0x1b,
-	0xce, 0x72, 0x8f, 0x48, 0x24, 0x94, 0x09, 0xa5, 0x78, 0x3e, 0x36, 0x76, 0x74, 0x8b, 0x16, 0xf2,
-	0x1c, 0x29, 0x76, 0x54, 0xb8, 0x4b, 0x48, 0x9d, 0x09, 0x4a, 0xc0, 0x97, 0xa3, 0x89, 0x71, 0x11,
-	0x8a, 0x1c, 0xb8, 0x94, 0x48, 0x4d, 0x42, 0xc2, 0x40, 0x90, 0x2b, 0xb1, 0x1c, 0x25, 0x2a, 0x86,
-	0x65, 0x97, 0x65, 0xb8, 0x25, 0xcf, 0x82, 0x39, 0x95, 0x2d, 0x98, 0x8b, 0x82, 0x5b, 0x2d, 0xd0,
-	0x56, 0x67, 0x81, 0x6c, 0x41, 0xc9, 0x43, 0x2c, 0x1a, 0x65, 0x3a, 0x1b, 0xca, 0xcb, 0x3d, 0x3a,
-	0x51, 0x40, 0x06, 0xac, 0xc4, 0x72, 0x91, 0xd1, 0x9b, 0x19, 0x28, 0x7a, 0x4b, 0x91, 0xa4, 0x64,
-	0xe4, 0x6c, 0x28, 0x27, 0xd1, 0x92, 0x80, 0xb3, 0x03, 0x01, 0x16, 0xe3, 0xf8, 0x49, 0x4c, 0xcf,
-	0x1e, 0x0b, 0xd7, 0x94, 0x3c, 0x90, 0x2f, 0x0d, 0xb4, 0xc7, 0x76, 0x02, 0x55, 0x67, 0xc4, 0x1e,
-	0x8b, 0xc1, 0x39, 0x3b, 0xe8, 0x1e, 0x8b, 0x84, 0x7a, 0x07, 0xca, 0x94, 0x38, 0x02, 0xa7, 0x0f,
-	0xe0, 0x89, 0xe2, 0x81, 0xde, 0xa1, 0x85, 0x73, 0xfc, 0x89, 0x5e, 0xa4, 0xc4, 0x61, 0x76, 0x02,
-	0xed, 0x67, 0x5e, 0x30, 0xea, 0x1d, 0x8a, 0x6e, 0xc1, 0x2b, 0x5d, 0x33, 0x83, 0x35, 0xc4, 0xdf,
-	0xbc, 0x4b, 0x5c, 0x36, 0xc1, 0x5e, 0xe8, 0x58, 0x13, 0xb5, 0x5b, 0xe0, 0xdc, 0x92, 0x87, 0xda,
-	0x77, 0xdc, 0x7b, 0x3b, 0x86, 0x45, 0x9f, 0xd3, 0xa1, 0x9c, 0x74, 0xa8, 0x85, 0x9c, 0x5b, 0xea,
-	0x95, 0x05, 0x5e, 0x07, 0xa4, 0x77, 0xbf, 0xec, 0x15, 0x0d, 0xe2, 0xf5, 0x7a, 0x9f, 0x7f, 0x22,
-	0xf2, 0x1c, 0x8a, 0x06, 0xf1, 0xad, 0x49, 0x5a, 0xd1, 0x20, 0xe0, 0xdc, 0xa2, 0x41, 0xe8, 0x6c,
-	0x9d, 0xf5, 0x13, 0x28, 0x28, 0xe5, 0x92, 0x5b, 0x36, 0xf8, 0x9d, 0xf4, 0xf4, 0xdd, 0x7e, 0x26,
-	0x86, 0x65, 0xff, 0x3f, 0x24, 0x82, 0x59, 0x10, 0xa3, 0xae, 0x28, 0xff, 0x37, 0xff, 0xbb, 0x0c,
-	0xb9, 0x9b, 0xb4, 0x85, 0xee, 0x41, 0xbe, 0x77, 0xd4, 0xa3, 0x57, 0x63, 0xeb, 0xac, 0xf0, 0xc7,
-	0x38, 0xea, 0xe7, 0xb3, 0x09, 0xcb, 0x6f, 0x43, 0x7a, 0x38, 0x35, 0x5d, 0xcb, 0x80, 0xd3, 0xff,
-	0x16, 0x26, 0x03, 0x8e, 0xf7, 0x1b, 0x14, 0x03, 0xa6, 0x3c, 0x9f, 0x45, 0xa0, 0xf5, 0x24, 0xe5,
-	0xd0, 0xc7, 0x28, 0x6a, 0x25, 0xab, 0xb8, 0x07, 0xad, 0xff, 0xdd, 0x43, 0x32, 0x5a, 0xe8, 0x93,
-	0x8c, 0x64, 0xb4, 0xf0, 0xe7, 0x14, 0xa8, 0x09, 0x93, 0xee, 0x14, 0x1e, 0xad, 0x25, 0xe8, 0x06,
-	0xbe, 0xb7, 0x50, 0x5f, 0xcd, 0x24, 0xeb, 0x07, 0xd9, 0xa6, 0x87, 0xe9, 0x20, 0x9e, 0xb9, 0x7f,
-	0x2a, 0x88, 0x77, 0xcc, 0x8c, 0x2c, 0x98, 0xf6, 0x0e, 0x60, 0x51, 0x52, 0x24, 0x22, 0x66, 0xd1,
-	0x6a, 0x35, 0xb3, 0xbc, 0x04, 0x7c, 0x9f, 0x6d, 0xd5, 0xc8, 0x71, 0x21, 0xfa, 0x62, 0xaa, 0xad,
-	0x98, 0x49, 0xb0, 0xfa, 0xa5, 0x53, 0x68, 0x4a, 0x7f, 0x7e, 0xc4, 0x5e, 0xae, 0x63, 0x06, 0x76,
-	0x68, 0x2b, 0xd5, 0x6e, 0xec, 0x34, 0x53, 0xfd, 0xf2, 0xa9, 0x74, 0x43, 0x5e, 0x85, 0x67, 0x6c,
-	0x19, 0xbc, 0x8a, 0x9d, 0x29, 0x66, 0xf0, 0x2a, 0x7e, 0xa8, 0x87, 0xba, 0x30, 0xeb, 0x9f, 0x60,
-	0xa1, 0x2b, 0xa9, 0xe6, 0x02, 0xb3, 0x3f, 0x75, 0x63, 0x00, 0x0d, 0x09, 0xfb, 0x9e, 0x02, 0x73,
-	0x11, 0xd3, 0x24, 0xf4, 0x85, 0x54, 0x53, 0x51, 0xb3, 0x34, 0xf5, 0xf5, 0x41, 0xd5, 0xa4, 0x1b,
-	0x3f, 0x0c, 0xb8, 0x21, 0x07, 0x40, 0x99, 0xdd, 0xf0, 0x4f, 0xb8, 0x32, 0xbb, 0x11, 0x98, 0x33,
-	0x95, 0x73, 0x3f, 0x18, 0x51, 0xd0, 0x4f, 0x15, 0x58, 0x4a, 0x18, 0xdc, 0xa0, 0xb7, 0x32, 0x1a,
-	0x8f, 0x9e, 0x4e, 0xa9, 0x5f, 0x39, 0xad, 0x7a, 0x68, 0x93, 0x07, 0x67, 0x2f, 0x19, 0x36, 0x79,
-	0xcc, 0x7c, 0x29, 0xc3, 0x26, 0x8f, 0x1b, 0xf4, 0xa0, 0x47, 0x0a, 0x94, 0xd2, 0x26, 0x25, 0xa8,
-	0x36, 0x28, 0xe9, 0x88, 0x4d, 0xbf, 0xf3, 0xa9, 0x6c, 0x48, 0x6f, 0x7f, 0xa1, 0xc0, 0x72, 0xe2,
-	0xd0, 0x03, 0x5d, 0xcd, 0x08, 0x13, 0x37, 0xe1, 0x51, 0xdf, 0x3e, 0xbd, 0x01, 0xe9, 0xe4, 0x07,
-	0x0a, 0x5c, 0x88, 0x99, 0x20, 0xa0, 0xf4, 0x4c, 0xc5, 0x0d, 0x68, 0xd4, 0xad, 0xd3, 0xa8, 0x4a,
-	0x97, 0xbe, 0xaf, 0xc0, 0x7c, 0x54, 0x0b, 0x1c, 0xbd, 0x9e, 0xd1, 0x68, 0x60, 0xbc, 0xa1, 0xbe,
-	0x31, 0xb0, 0x9e, 0xf4, 0xc4, 0x86, 0x19, 0x5f, 0x33, 0x1c, 0x55, 0x53, 0x4b, 0x27, 0x7f, 0x87,
-	0x5a, 0xbd, 0x92, 0x5d, 0xa1, 0x8f, 0xe9, 0x6b, 0x84, 0x27, 0x62, 0x46, 0xb5, 0xe3, 0x13, 0x31,
-	0x23, 0x7b, 0xec, 0x0c, 0xd3, 0xd7, 0x06, 0x4e, 0xc4, 0x8c, 0xea, 0xc4, 0x27, 0x62, 0x46, 0x76,
-	0xc3, 0xd9, 0x21, 0xe4, 0x6f, 0x3d, 0xa3, 0xcc, 0x36, 0x68, 0x96, 0x43, 0x28, 0xba, 0xaf, 0xcd,
-	0x60, 0xfd, 0x6d, 0xe5, 0x44, 0xd8, 0xc8, 0xfe, 0x77, 0x22, 0x6c, 0x74, 0xcf, 0x9a, 0x9f, 0x7d,
-	0x11, 0x6d, 0xdf, 0xc4, 0x43, 0x27, 0xbe, 0xc1, 0x9d, 0x78, 0xe8, 0x24, 0x74, 0x97, 0xd1, 0x31,
-	0xbc, 0x14, 0x68, 0xdb, 0xa2, 0x24, 0x32, 0xd1, 0x5d, 0x68, 0x75, 0x73, 0x10, 0x95, 0xfe, 0x12,
-	0xf3, 0xbd, 0x59, 0x27, 0x2e, 0xb1, 0xa8, 0xde, 0x71, 0xe2, 0x12, 0x8b, 0x7c, 0x69, 0x67, 0xb9,
-	0xf6, 0xbf, 0x30, 0xa3, 0x14, 0x1b, 0xe1, 0x97, 0x7b, 0x75, 0x63, 0x00, 0x0d, 0x09, 0xfb, 0x6d,
-	0x1e, 0x64, 0xef, 0x4b, 0x62, 0x5a, 0x90, 0x23, 0x5e, 0x78, 0xd3, 0x82, 0x1c, 0xf5, 0x0e, 0x2a,
-	0x6a, 0x0a, 0x0b, 0xa6, 0x7d, 0xd8, 0x49, 0xaf, 0x02, 0x51, 0xc0, 0xd5, 0xcc, 0xf2, 0x02, 0x55,
-	0x1d, 0xfb, 0xee, 0xb3, 0xc7, 0x6b, 0x4a, 0x8d, 0x7c, 0xf8, 0xa4, 0xa8, 0x7c, 0xfc, 0xa4, 0xa8,
-	0x7c, 0xf2, 0xa4, 0xa8, 0x7c, 0xf0, 0xb4, 0x78, 0xe6, 0xe3, 0xa7, 0xc5, 0x33, 0xff, 0x7c, 0x5a,
-	0x3c, 0x03, 0x8b, 0xba, 0x15, 0x63, 0x73, 0x5f, 0x79, 0xb7, 0xe2, 0xf9, 0x4a, 0xab, 0x2f, 0xb4,
-	0xae, 0x5b, 0x9e, 0x5f, 0xd5, 0xe3, 0xde, 0x1f, 0xb7, 0x1c, 0x8c, 0xf3, 0xbf, 0x68, 0x79, 0xed,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x40, 0x23, 0x7a, 0x49, 0x34, 0x00, 0x00,
+	0x15, 0xcf, 0x78, 0xfd, 0xb5, 0xc7, 0x1f, 0x49, 0x26, 0x71, 0xb2, 0x9e, 0x34, 0xeb, 0xcd, 0xa6,
+	0x81, 0x90, 0xd4, 0xbb, 0xb6, 0xab, 0x26, 0xad, 0xdb, 0xd2, 0x7a, 0x9d, 0x3a, 0x72, 0x25, 0x17,
+	0x6b, 0xd3, 0x82, 0x54, 0x1e, 0x56, 0xe3, 0x9d, 0xdb, 0xcd, 0xe0, 0xd9, 0x99, 0xed, 0xdc, 0x59,
+	0xc7, 0x96, 0x40, 0x20, 0x54, 0x09, 0xa8, 0x54, 0xa9, 0x12, 0xe2, 0x01, 0x84, 0x90, 0x00, 0x09,
+	0x01, 0x7d, 0xa0, 0x08, 0x84, 0xf8, 0x78, 0xe4, 0xa5, 0x0f, 0x7d, 0xa8, 0x78, 0xe2, 0x09, 0xaa,
+	0x56, 0xa2, 0x12, 0x7f, 0x00, 0xcf, 0xe8, 0xde, 0x7b, 0x66, 0xe7, 0xfb, 0x63, 0xb7, 0xdd, 0xc0,
+	0x4b, 0x9b, 0x9d, 0x7b, 0x3e, 0x7e, 0xbf, 0x73, 0xee, 0x9d, 0x7b, 0xef, 0x39, 0x63, 0x58, 0xe9,
+	0xd9, 0xd6, 0x11, 0x31, 0x55, 0xb3, 0x4d, 0xea, 0xe4, 0xb8, 0x7d, 0x5f, 0x35, 0x3b, 0xa4, 0x7e,
+	0xb4, 0x5e, 0x77, 0x8e, 0x6b, 0x3d, 0xdb, 0x72, 0x2c, 0xf9, 0x82, 0x27, 0x50, 0x73, 0x05, 0x6a,
+	0x47, 0xeb, 0xca, 0x59, 0xb5, 0xab, 0x9b, 0x56, 0x9d, 0xff, 0x57, 0x88, 0x2a, 0xe5, 0xb6, 0x45,
+	0xbb, 0x16, 0xad, 0x1f, 0xa8, 0x94, 0xd9, 0x38, 0x20, 0x8e, 0xba, 0x5e, 0x6f, 0x5b, 0xba, 0x89,
+	0xe3, 0x17, 0x71, 0xbc, 0x4b, 0x3b, 0xcc, 0x45, 0x97, 0x76, 0x70, 0x60, 0x59, 0x0c, 0xb4, 0xf8,
+	0xaf, 0xba, 0xf8, 0x81, 0x43, 0xe7, 0x3b, 0x56, 0xc7, 0x12, 0xcf, 0xd9, 0xbf, 0xf0, 0xe9, 0xf5,
+	0x04, 0xd4, 0x6d, 0xab, 0xdb, 0xd5, 0x9d, 0x2e, 0x31, 0x1d, 0x57, 0xff, 0x6a, 0x82, 0x64, 0x57,
+	0xb5, 0x0f, 0x89, 0x93, 0x21, 0x64, 0xd9, 0x1a, 0xb1, 0xb3, 0x2c, 0xf5, 0x54, 0x5b, 0xed, 0xba,
+	0x42, 0xd7, 0x12, 0x85, 0x4e, 0x7c, 0xa8, 0xaa, 0xbf, 0x93, 0xe0, 0xdc, 0x1e, 0xed, 0x6c, 0xdb,
+	0x44, 0x75, 0xc8, 0x16, 0x3d, 0x6c, 0x92, 0xd7, 0xfb, 0x84, 0x3a, 0xf2, 0x36, 0x14, 0x55, 0x7a,
+	0xd8, 0xe2, 0x7e, 0x4b, 0x52, 0x45, 0xba, 0x3e, 0xb7, 0x51, 0xa9, 0xc5, 0x27, 0xa0, 0xb6, 0x45,
+	0x0f, 0xbf, 0xc4, 0xe4, 0x1a, 0x93, 0xef, 0xfd, 0x63, 0xe5, 0x54, 0x73, 0x56, 0xc5, 0xdf, 0xf2,
+	0x5d, 0x90, 0xb9, 0x81, 0x56, 0x9b, 0x99, 0xd7, 0x2d, 0xb3, 0xf5, 0x1a, 0x21, 0xa5, 0x09, 0x6e,
+	0x6d, 0xb9, 0x86, 0xd1, 0x65, 0x39, 0xaa, 0x61, 0x8e, 0x6a, 0xdb, 0x96, 0x6e, 0x36, 0xcf, 0x70,
+	0xa5, 0x6d, 0xd4, 0xd9, 0x21, 0x64, 0x73, 0xf1, 0xdb, 0x9f, 0xbc, 0x7b, 0xc3, 0x03, 0x54, 0x5d,
+	0x87, 0xf3, 0x41, 0xd0, 0xb4, 0x67, 0x99, 0x94, 0xc8, 0xcb, 0x30, 0x2b, 0x1c, 0xea, 0x1a, 0x07,
+	0x3d, 0xd9, 0x9c, 0xe1, 0xbf, 0x77, 0xb5, 0x20, 0xd1, 0x86, 0xae, 0xf9, 0x88, 0x1e, 0xe8, 0x5a,
+	0x3e, 0xa2, 0x0d, 0x5d, 0x0b, 0x10, 0x3d, 0xd0, 0xb5, 0xb1, 0x10, 0x1d, 0x00, 0x0a, 0x10, 0xe5,
+	0xa0, 0xb3, 0x89, 0xbe, 0x3f, 0x01, 0x4b, 0x4c, 0x87, 0x4f, 0xc0, 0x9d, 0xbe, 0xa9, 0x51, 0x97,
+	0xea, 0x06, 0xcc, 0xa8, 0xed, 0xb6, 0xd5, 0x37, 0x1d, 0xae, 0x53, 0x6c, 0x94, 0xfe, 0xf6, 0xfb,
+	0xd5, 0xf3, 0x88, 0x6e, 0x4b, 0xd3, 0x6c, 0x42, 0xe9, 0x3d, 0xc7, 0xd6, 0xcd, 0x4e, 0xd3, 0x15,
+	0x94, 0x2f, 0x41, 0x51, 0x4c, 0x50, 0xe6, 0x89, 0x11, 0x5a, 0x68, 0xce, 0x8a, 0x07, 0xbb, 0x9a,
+	0x7c, 0x02, 0xd3, 0x6a, 0x97, 0xdb, 0x2b, 0x54, 0x0a, 0xa9, 0x54, 0x1b, 0x3b, 0x2c, 0x62, 0xbf,
+	0xfe, 0xe7, 0xca, 0xf5, 0x8e, 0xee, 0xdc, 0xef, 0x1f, 0xd4, 0xda, 0x56, 0x17, 0x97, 0x17, 0xfe,
+	0x6f, 0x95, 0x6a, 0x87, 0x75, 0xe7, 0xa4, 0x47, 0x28, 0x57, 0xa0, 0x3f, 0xfa, 0xe4, 0xdd, 0x1b,
+	0xf3, 0x06, 0xe9, 0xa8, 0xed, 0x93, 0x16, 0x5b, 0xb9, 0xf4, 0x97, 0x9f, 0xbc, 0x7b, 0x43, 0x6a,
+	0xa2, 0x43, 0xf9, 0x19, 0x98, 0x0f, 0xc4, 0x7a, 0x32, 0x2b, 0xd6, 0x73, 0x6d, 0x2f, 0xcc, 0x8c,
+	0x15, 0x39, 0x22, 0xa6, 0xd3, 0x72, 0xd4, 0x4e, 0x69, 0x8a, 0xc5, 0xa2, 0x39, 0xcb, 0x1f, 0xbc,
+	0xac, 0x76, 0x36, 0xe7, 0x59, 0x0e, 0xdc, 0x00, 0x54, 0x4b, 0x70, 0x21, 0x1c, 0x4d, 0x91, 0x83,
+	0xea, 0xeb, 0x22, 0xce, 0x6c, 0x96, 0x18, 0x7c, 0x1a, 0xb8, 0x71, 0x5e, 0x83, 0x69, 0xaa, 0x77,
+	0x4c, 0x62, 0x67, 0x86, 0x19, 0xe5, 0x02, 0xe9, 0x9c, 0x08, 0xa4, 0x73, 0x73, 0x8e, 0xa1, 0x41,
+	0x39, 0x17, 0x8c, 0xdf, 0x25, 0x82, 0xf9, 0xf7, 0x04, 0x9f, 0x29, 0x5b, 0x5d, 0x62, 0x6a, 0x01,
+	0x30, 0x35, 0x98, 0xb2, 0x1e, 0xe4, 0xc1, 0x22, 0xc4, 0x52, 0xa0, 0xc8, 0xb7, 0x61, 0x5a, 0xa5,
+	0x94, 0x38, 0xb4, 0x54, 0xc8, 0x88, 0x36, 0x2e, 0x10, 0x14, 0x97, 0x9f, 0x80, 0xa9, 0x9e, 0xad,
+	0xb7, 0xb3, 0xb3, 0x84, 0x7a, 0x42, 0x5a, 0x7e, 0x53, 0x82, 0xd3, 0x94, 0x38, 0x8e, 0x41, 0xba,
+	0x2c, 0x57, 0xaf, 0x11, 0x42, 0x4b, 0x53, 0x0f, 0x6b, 0xa2, 0x2d, 0x7a, 0x9e, 0x77, 0x08, 0xa1,
+	0x9b, 0xc0, 0xf2, 0x20, 0x62, 0x54, 0xbd, 0x08, 0x4b, 0xa1, 0x58, 0x63, 0x16, 0xfe, 0x5a, 0x00,
+	0x79, 0x8f, 0x76, 0x76, 0x74, 0xc3, 0x68, 0xe8, 0x1a, 0xf5, 0x4f, 0x08, 0x62, 0x18, 0xb9, 0x26,
+	0x04, 0x97, 0x4b, 0x5f, 0x76, 0x6f, 0x48, 0x30, 0xef, 0x58, 0x8e, 0x6a, 0xb4, 0x06, 0xe9, 0x78,
+	0x48, 0x41, 0x99, 0xe3, 0x6e, 0xb7, 0x44, 0x56, 0xab, 0xb0, 0x30, 0x78, 0x51, 0xb5, 0x74, 0x8d,
+	0x96, 0x26, 0x2b, 0x85, 0xeb, 0x93, 0xcd, 0x39, 0xf7, 0xad, 0xb8, 0xab, 0x51, 0xf9, 0xcb, 0xa0,
+	0x08, 0x46, 0x2d, 0x7f, 0x22, 0x0d, 0x95, 0x67, 0x93, 0xaf, 0xbc, 0xd4, 0x45, 0x7b, 0x51, 0x28,
+	0xdf, 0xf3, 0x52, 0x61, 0xa8, 0x2c, 0x1d, 0xf2, 0x4b, 0x70, 0x61, 0xb0, 0x1b, 0x04, 0x5f, 0xba,
+	0xd3, 0x59, 0x36, 0xcf, 0xb9, 0xdb, 0x93, 0xff, 0xbd, 0x8b, 0xab, 0x8c, 0x7b, 0xab, 0x2e, 0xc1,
+	0xb9, 0x40, 0x12, 0x31, 0xb9, 0x7f, 0xf1, 0x92, 0xbb, 0x45, 0x0f, 0xa9, 0x6f, 0x81, 0x1d, 0xf4,
+	0x4f, 0xf2, 0x2c, 0x30, 0x2e, 0x96, 0x9e, 0xda, 0xe7, 0x41, 0x84, 0xb8, 0x25, 0xd6, 0x4b, 0xce,
+	0x75, 0x06, 0x5c, 0x67, 0x9f, 0x2f, 0x9a, 0x2a, 0x2c, 0x78, 0x91, 0xf1, 0x65, 0xc5, 0x65, 0xcd,
+	0xb2, 0xf2, 0x03, 0x09, 0x96, 0x38, 0x98, 0xd6, 0xff, 0x6c, 0x79, 0x9d, 0xe3, 0xfe, 0xef, 0x05,
+	0xd6, 0x18, 0xcb, 0xaa, 0x37, 0xa3, 0x86, 0xcc, 0xaa, 0x3b, 0xeb, 0xfc, 0x59, 0x15, 0x6b, 0x96,
+	0x7b, 0xf2, 0x25, 0x55, 0x24, 0x0f, 0x93, 0xfa, 0xa1, 0xc4, 0x5f, 0xa9, 0x7b, 0x3c, 0x01, 0x02,
+	0x8e, 0x2f, 0xb1, 0xaa, 0xd6, 0xd5, 0xcd, 0xec, 0xc4, 0x72, 0xb1, 0xf4, 0xc4, 0x46, 0xd2, 0x52,
+	0x88, 0xa6, 0x25, 0xcf, 0x82, 0xba, 0x06, 0x8b, 0xe4, 0xb8, 0x47, 0xda, 0x4e, 0xab, 0xa7, 0xda,
+	0x8e, 0xae, 0x1a, 0x7c, 0x11, 0xcd, 0x36, 0x17, 0xc4, 0xd3, 0x7d, 0xf1, 0x10, 0x99, 0x73, 0x5c,
+	0xd5, 0x65, 0xb8, 0x18, 0x61, 0x88, 0xec, 0x7f, 0x51, 0x80, 0xca, 0x60, 0x6c, 0x7b, 0x70, 0x64,
+	0x1d, 0x63, 0x1c, 0xb6, 0x61, 0x5a, 0x37, 0x7b, 0xfd, 0xc1, 0x4b, 0xeb, 0x5a, 0xe2, 0xa1, 0x52,
+	0xec, 0xbf, 0x5b, 0x7c, 0xbb, 0x77, 0xf7, 0x13, 0xa1, 0x2a, 0xbf, 0x00, 0x33, 0x56, 0xdf, 0xe1,
+	0x56, 0x26, 0x87, 0xb7, 0xe2, 0xea, 0xca, 0xcf, 0xc1, 0xa4, 0x6f, 0xd2, 0x0f, 0x65, 0x83, 0x2b,
+	0x32, 0x03, 0xa6, 0x7a, 0x44, 0x4b, 0xd3, 0xe9, 0x06, 0x5e, 0x22, 0x0e, 0x7f, 0x65, 0xf2, 0x05,
+	0xea, 0x1a, 0x60, 0x8a, 0xc1, 0x73, 0xc8, 0x4c, 0xe8, 0x1c, 0xe2, 0xcf, 0xe1, 0x55, 0xb8, 0x92,
+	0x92, 0x27, 0xcc, 0xe6, 0xbf, 0x24, 0xa8, 0x0e, 0xa4, 0x9a, 0xc4, 0x20, 0x2a, 0x25, 0x9e, 0x30,
+	0x1d, 0x4b, 0x3e, 0x5f, 0x04, 0x70, 0xac, 0x96, 0x2d, 0x9c, 0x8d, 0x92, 0xd3, 0xa2, 0x63, 0x21,
+	0xd4, 0x60, 0x34, 0x26, 0x53, 0xa2, 0x71, 0x0d, 0xae, 0xa6, 0xf2, 0xc4, 0x78, 0xfc, 0xc9, 0x1f,
+	0x8f, 0x7b, 0xc4, 0xe1, 0x8b, 0xe8, 0x85, 0x63, 0x87, 0xd8, 0xa6, 0x6a, 0xec, 0xde, 0x19, 0x4b,
+	0x3c, 0xfc, 0xc7, 0xa7, 0x42, 0xf0, 0xf8, 0xb4, 0x02, 0x73, 0x04, 0x9d, 0xb3, 0x51, 0x41, 0x10,
+	0xdc, 0x47, 0xbb, 0x5a, 0x22, 0xc5, 0x38, 0xe8, 0x48, 0xf1, 0xad, 0x09, 0x28, 0x0d, 0xe4, 0xbe,
+	0xa2, 0x3b, 0xf7, 0x35, 0x5b, 0x7d, 0x30, 0x16, 0x62, 0x97, 0x79, 0xa2, 0x55, 0xa1, 0xc7, 0xa9,
+	0x15, 0x59, 0xee, 0xd0, 0x90, 0xef, 0x2a, 0x30, 0xf9, 0x90, 0xaf, 0x02, 0x81, 0xb0, 0x5d, 0x82,
+	0xe5, 0x98, 0x70, 0x60, 0xb0, 0xde, 0x97, 0xe0, 0xf2, 0x60, 0xf4, 0x95, 0x9e, 0xa6, 0x3a, 0xe4,
+	0x0e, 0x71, 0x54, 0xdd, 0x18, 0xcf, 0xd2, 0x68, 0xc2, 0x22, 0x0e, 0x6a, 0xc2, 0x0b, 0x6e, 0xe7,
+	0x89, 0xcb, 0x43, 0x00, 0x43, 0x48, 0xb8, 0x3c, 0x16, 0xba, 0xfe, 0x87, 0x01, 0xae, 0x15, 0x28,
+	0x27, 0xb1, 0x41, 0xc2, 0xbf, 0x89, 0x12, 0x7e, 0xc1, 0x54, 0x0f, 0x0c, 0xa2, 0x79, 0x27, 0xd3,
+	0x00, 0x61, 0x25, 0x89, 0x70, 0x49, 0x72, 0x29, 0xaf, 0x44, 0x28, 0x37, 0x26, 0x4a, 0x92, 0x8f,
+	0xf6, 0x2a, 0x9c, 0x51, 0xdb, 0x6d, 0xd2, 0x73, 0x74, 0xb3, 0x23, 0xf6, 0x32, 0x41, 0x7c, 0x96,
+	0xcb, 0x9d, 0x1e, 0x8c, 0xf1, 0x29, 0x4d, 0xc5, 0x6d, 0xcb, 0x05, 0x51, 0x7d, 0x14, 0xca, 0x49,
+	0x80, 0x05, 0xa7, 0xcd, 0x89, 0x92, 0x54, 0x7d, 0x47, 0x82, 0x6b, 0x21, 0xb1, 0xad, 0xa0, 0xd9,
+	0xb1, 0x24, 0xf4, 0x0b, 0x49, 0xcc, 0xa2, 0xac, 0xfc, 0x79, 0xba, 0x0e, 0x9f, 0xcb, 0x02, 0xeb,
+	0xe5, 0xab, 0x12, 0x12, 0x7d, 0x85, 0xba, 0xa7, 0xa4, 0xb1, 0x50, 0xda, 0x80, 0x25, 0xd5, 0x30,
+	0xac, 0x07, 0xad, 0x3e, 0x0d, 0x9c, 0x06, 0x91, 0xd7, 0x39, 0x3e, 0xe8, 0x61, 0x60, 0x43, 0x89,
+	0xfb, 0x52, 0x14, 0x30, 0xd2, 0xfa, 0xa1, 0x04, 0x2b, 0xe1, 0x08, 0xf4, 0x1d, 0x6b, 0x4f, 0x75,
+	0xda, 0xf7, 0xc7, 0xf5, 0xae, 0x52, 0xfb, 0x8e, 0xd5, 0xea, 0x32, 0x0f, 0x48, 0xa5, 0xa8, 0xba,
+	0x2e, 0x03, 0x04, 0xaa, 0x50, 0x49, 0x86, 0x86, 0xf8, 0xff, 0x2c, 0xc1, 0x8d, 0xa4, 0x0c, 0x8e,
+	0x7b, 0x7f, 0x7d, 0x1c, 0x96, 0xbc, 0x39, 0xe7, 0x2b, 0x2a, 0x22, 0xab, 0xf3, 0x6a, 0x0c, 0x90,
+	0x00, 0xc1, 0x55, 0xb8, 0x99, 0x0b, 0x3b, 0x72, 0xfd, 0xad, 0x04, 0x9f, 0x0f, 0xc9, 0xef, 0x9a,
+	0x0e, 0xb1, 0xbb, 0x44, 0xd3, 0x55, 0xfb, 0xe4, 0x0e, 0x31, 0xad, 0xee, 0x58, 0x88, 0xae, 0x82,
+	0xac, 0xfb, 0x1c, 0xb5, 0x34, 0xe6, 0x09, 0xf7, 0x99, 0xb3, 0x7a, 0x18, 0x42, 0x80, 0xe2, 0x0d,
+	0xb8, 0x9e, 0x0d, 0x19, 0xf9, 0xfd, 0x6a, 0xc2, 0x37, 0x63, 0xf7, 0x54, 0x53, 0xed, 0x90, 0x7d,
+	0x62, 0x77, 0x75, 0x4a, 0x75, 0xcb, 0xa4, 0xe3, 0x9a, 0x8d, 0x36, 0x39, 0xb2, 0x0e, 0x49, 0x4b,
+	0x35, 0x0c, 0x7e, 0x44, 0x2a, 0x36, 0x8b, 0xe2, 0xc9, 0x96, 0x61, 0xc8, 0x3b, 0x50, 0xe4, 0x27,
+	0x28, 0xf6, 0x1b, 0x37, 0xcf, 0xab, 0x29, 0x07, 0x28, 0x42, 0xe9, 0x5d, 0x5b, 0x1d, 0x1c, 0x9f,
+	0x66, 0xd9, 0xf1, 0x89, 0xa9, 0xca, 0x77, 0x60, 0xd6, 0xb1, 0x5a, 0x1d, 0x36, 0x56, 0x9a, 0x1a,
+	0xd6, 0xcc, 0x8c, 0x63, 0xf1, 0x9f, 0x81, 0xb8, 0x3e, 0x0a, 0xd5, 0xb4, 0x50, 0xb9, 0x11, 0x2d,
+	0x40, 0x39, 0x24, 0xd6, 0x24, 0xaf, 0x6f, 0x39, 0xce, 0xd8, 0xde, 0xc2, 0x67, 0xf9, 0xd5, 0x90,
+	0xb4, 0xd8, 0x85, 0x4a, 0x9c, 0x49, 0x30, 0xaa, 0x8b, 0x6d, 0xb7, 0x22, 0xfc, 0x32, 0x3b, 0x98,
+	0xc8, 0x75, 0x38, 0x1f, 0x14, 0xb5, 0x49, 0xd7, 0x3a, 0x12, 0x51, 0x2e, 0x36, 0xcf, 0xfa, 0xa4,
+	0x9b, 0x7c, 0xc0, 0x67, 0x9b, 0x5d, 0xc4, 0xd0, 0xf6, 0x94, 0xdf, 0x76, 0x43, 0xd7, 0xc2, 0xb6,
+	0x51, 0x14, 0x6d, 0x4f, 0xfb, 0x6d, 0x73, 0x69, 0xb4, 0x7d, 0x1b, 0x4a, 0xa8, 0xe0, 0x2d, 0x63,
+	0xd7, 0xc5, 0x0c, 0x57, 0x5a, 0x12, 0xe3, 0xde, 0xb2, 0x14, 0x9e, 0x9e, 0x85, 0x4b, 0xb1, 0x8a,
+	0xe8, 0x70, 0x96, 0xeb, 0x96, 0xa2, 0xba, 0xc2, 0x6f, 0x20, 0xa3, 0x57, 0x60, 0x25, 0x31, 0x55,
+	0x98, 0xce, 0x57, 0xf9, 0x6d, 0x51, 0x54, 0x9c, 0xf7, 0x45, 0xaf, 0xc0, 0x4d, 0xe3, 0x73, 0x30,
+	0x83, 0xdd, 0x03, 0x2c, 0x94, 0xaf, 0x24, 0x4d, 0x30, 0x54, 0x74, 0x27, 0x17, 0x6a, 0x55, 0x15,
+	0x28, 0x45, 0x6d, 0x07, 0xfc, 0x8a, 0x77, 0xd3, 0x78, 0xfc, 0x86, 0x6c, 0xa3, 0xdf, 0x77, 0x24,
+	0xee, 0xb8, 0x49, 0xbe, 0x46, 0xda, 0xde, 0xe0, 0xa0, 0x6e, 0xe7, 0xa8, 0x76, 0x87, 0x64, 0xd7,
+	0xcb, 0x51, 0x8e, 0x69, 0x50, 0xab, 0x6f, 0xb7, 0x45, 0xf1, 0x3f, 0x55, 0x43, 0xc8, 0x85, 0x6f,
+	0x05, 0x85, 0xc8, 0xad, 0x40, 0x94, 0xa6, 0x84, 0x7d, 0x64, 0x12, 0x02, 0xeb, 0xde, 0x05, 0xa4,
+	0xe8, 0x20, 0x1d, 0x9d, 0xca, 0x06, 0xcc, 0x08, 0x88, 0xb4, 0x34, 0x51, 0x29, 0xa4, 0xaa, 0xb8,
+	0x82, 0x41, 0xac, 0xe2, 0x2c, 0x1e, 0x86, 0x83, 0x60, 0xbf, 0x2e, 0xa6, 0x02, 0xaf, 0x64, 0xc7,
+	0x60, 0xc5, 0x20, 0x4a, 0x39, 0x83, 0x78, 0x05, 0xe6, 0x7d, 0x41, 0x44, 0xc0, 0xcd, 0x39, 0x2f,
+	0x8a, 0x2e, 0x34, 0x21, 0x8f, 0xd0, 0xc2, 0xde, 0x11, 0xda, 0x1f, 0xc5, 0xa9, 0x79, 0x9b, 0xcf,
+	0x2a, 0x1c, 0x7d, 0x99, 0x53, 0x1a, 0x1d, 0x60, 0x28, 0xcb, 0x13, 0xe1, 0x2c, 0xcb, 0xb7, 0x01,
+	0x4c, 0xf2, 0xa0, 0x85, 0x39, 0x2a, 0x64, 0x98, 0x2d, 0x9a, 0xe4, 0x81, 0x80, 0x14, 0xe4, 0x25,
+	0xae, 0x04, 0xb1, 0xc8, 0x91, 0xdc, 0x4f, 0x25, 0x4e, 0xfd, 0xae, 0x75, 0x24, 0x96, 0xa1, 0x7b,
+	0x89, 0x16, 0xc4, 0x6e, 0x01, 0x3b, 0x26, 0xdd, 0xb7, 0x6c, 0xdd, 0x39, 0xc9, 0xe4, 0xe6, 0x89,
+	0xca, 0xcf, 0xc0, 0xb4, 0x78, 0x3f, 0x63, 0xcf, 0xab, 0x9c, 0x7e, 0xc5, 0x71, 0xcb, 0x39, 0x42,
+	0xc7, 0xed, 0xee, 0xb9, 0xd6, 0xaa, 0x8f, 0x80, 0x12, 0x07, 0x11, 0x19, 0xfc, 0x61, 0x81, 0x2f,
+	0xd8, 0xbb, 0xd6, 0x91, 0x78, 0x83, 0xed, 0x10, 0x42, 0x3f, 0x2d, 0xfe, 0xd4, 0x0d, 0xe7, 0x15,
+	0xb8, 0xa8, 0x6a, 0x1a, 0x2b, 0x43, 0xb6, 0x7c, 0xbb, 0x09, 0x2b, 0x62, 0x67, 0x17, 0xde, 0x05,
+	0xd1, 0x73, 0xaa, 0xa6, 0xed, 0x10, 0x32, 0xe8, 0x57, 0xb2, 0x2a, 0xb6, 0xfc, 0x55, 0x50, 0xc4,
+	0x1b, 0x3c, 0xd6, 0xf2, 0x64, 0x3e, 0xcb, 0x17, 0x84, 0x89, 0x88, 0xf1, 0x28, 0x66, 0xb6, 0x4b,
+	0x71, 0xcb, 0x53, 0x23, 0x60, 0x6e, 0xe8, 0x5a, 0x32, 0xe6, 0x81, 0xe5, 0xe9, 0xd1, 0x30, 0xbb,
+	0xc6, 0xdb, 0x50, 0x76, 0x31, 0xc7, 0xf7, 0x0c, 0x4a, 0x33, 0xf9, 0x1c, 0x28, 0x02, 0xfa, 0xbd,
+	0x98, 0xde, 0x81, 0xac, 0xc3, 0x15, 0x1f, 0x83, 0x04, 0x3f, 0xb3, 0xf9, 0xfc, 0x5c, 0x1e, 0x10,
+	0x89, 0x75, 0x65, 0x42, 0x25, 0x99, 0x8f, 0xcd, 0x8a, 0xd4, 0xb4, 0x54, 0xac, 0x14, 0xd2, 0x1a,
+	0xce, 0x3b, 0x84, 0x34, 0x99, 0x20, 0x3a, 0x7c, 0x24, 0x9e, 0x18, 0x17, 0xa1, 0xb2, 0x03, 0x57,
+	0x53, 0xa9, 0xa1, 0x4b, 0x18, 0xca, 0xe5, 0x4a, 0x22, 0x47, 0xf4, 0xaa, 0xc2, 0x65, 0x97, 0x65,
+	0xb4, 0xa5, 0xc0, 0x82, 0x39, 0x97, 0x2f, 0x98, 0xcb, 0x82, 0x5b, 0xa3, 0x7f, 0x12, 0x09, 0x64,
+	0x07, 0x2a, 0x3e, 0x62, 0xf1, 0x5e, 0xe6, 0xf3, 0x79, 0x79, 0x64, 0x40, 0x27, 0xce, 0x91, 0x01,
+	0x2b, 0x89, 0x5c, 0x30, 0x7a, 0x0b, 0x43, 0x45, 0xef, 0x52, 0x2c, 0x29, 0x8c, 0x9c, 0x0d, 0xd5,
+	0x34, 0x5a, 0xe8, 0x70, 0x71, 0x28, 0x87, 0xe5, 0x24, 0x7e, 0xe8, 0xd3, 0xb7, 0xc6, 0xa2, 0x67,
+	0x4a, 0x1e, 0xc8, 0xd3, 0x43, 0xad, 0xb1, 0xed, 0xd0, 0xa9, 0x33, 0x66, 0x8d, 0x25, 0xf8, 0x39,
+	0x33, 0xec, 0x1a, 0x8b, 0x75, 0xf5, 0x22, 0x54, 0x29, 0x71, 0x84, 0x1f, 0xcf, 0x81, 0x2f, 0x8a,
+	0x07, 0x7a, 0x8f, 0x96, 0xce, 0xf2, 0x37, 0x7a, 0x99, 0x12, 0x87, 0xd9, 0x09, 0x95, 0xcf, 0xd9,
+	0xbf, 0x1a, 0x7a, 0x8f, 0x75, 0x9f, 0x1e, 0xed, 0x9b, 0x39, 0xac, 0xc9, 0xfc, 0xe6, 0x5d, 0xe9,
+	0x9b, 0xe9, 0xf6, 0x22, 0xdb, 0x9a, 0x38, 0xbb, 0x85, 0xf6, 0x2d, 0xdc, 0xd4, 0xbe, 0xe9, 0x8e,
+	0x6d, 0x1b, 0x16, 0xfd, 0x8c, 0x36, 0xe5, 0xb4, 0x4d, 0x2d, 0x02, 0xee, 0x12, 0x2c, 0xc7, 0x00,
+	0x40, 0x74, 0x3f, 0x1f, 0x1c, 0x1a, 0xc4, 0xf5, 0x7a, 0x9f, 0x7f, 0x68, 0xf4, 0x19, 0x1c, 0x1a,
+	0xc4, 0x17, 0x4b, 0x59, 0x87, 0x06, 0xe1, 0xce, 0x3d, 0x34, 0x08, 0x9d, 0xcd, 0x33, 0x41, 0x02,
+	0x25, 0xa9, 0x5a, 0x01, 0x25, 0x0e, 0xa4, 0xaf, 0x6e, 0xf8, 0x13, 0xd1, 0xec, 0xfb, 0xff, 0x21,
+	0x11, 0xce, 0x82, 0x68, 0xd5, 0xc5, 0xe1, 0xdf, 0xf8, 0xcf, 0x0a, 0x14, 0xf6, 0x68, 0x47, 0x7e,
+	0x0d, 0x8a, 0x83, 0xad, 0x5e, 0xbe, 0x99, 0x78, 0xce, 0x8a, 0x7e, 0xd2, 0xa5, 0x3c, 0x96, 0x4f,
+	0x58, 0xf8, 0xf3, 0xfc, 0x34, 0x74, 0x2d, 0x87, 0x1f, 0xef, 0x8b, 0x2a, 0xe5, 0xb1, 0x7c, 0xc2,
+	0xe8, 0xc7, 0x80, 0x39, 0xdf, 0xc7, 0x35, 0xf2, 0x6a, 0x9a, 0x72, 0xe4, 0x93, 0x26, 0xa5, 0x96,
+	0x57, 0xdc, 0xe7, 0xcd, 0xfb, 0x7a, 0x26, 0xdd, 0x5b, 0xe4, 0xc3, 0x1e, 0xa5, 0x96, 0x57, 0x1c,
+	0xbd, 0xe9, 0x00, 0xde, 0x47, 0x22, 0x72, 0x5a, 0x5c, 0x22, 0xdf, 0xed, 0x28, 0xab, 0x39, 0xa5,
+	0xd1, 0x55, 0x1b, 0x66, 0xdd, 0x0f, 0x16, 0xe4, 0x1b, 0x29, 0xaa, 0xa1, 0x4f, 0x53, 0x94, 0x9b,
+	0xb9, 0x64, 0x83, 0x4e, 0x58, 0x03, 0x3d, 0xd3, 0x89, 0xef, 0x13, 0x09, 0xe5, 0x66, 0x2e, 0x59,
+	0x74, 0x62, 0xc1, 0xbc, 0xbf, 0x57, 0x2d, 0xa7, 0x05, 0x3d, 0xa6, 0x6d, 0xaf, 0xd4, 0x73, 0xcb,
+	0xa3, 0xc3, 0xb7, 0xd8, 0x5b, 0x21, 0xb6, 0xb3, 0x2a, 0x3f, 0x99, 0x69, 0x2b, 0xa1, 0x69, 0xae,
+	0x3c, 0x35, 0x82, 0x26, 0xe2, 0xf9, 0x3e, 0xbb, 0xc7, 0x27, 0xf4, 0x36, 0xe5, 0xcd, 0x4c, 0xbb,
+	0x89, 0x8d, 0x5f, 0xe5, 0xe9, 0x91, 0x74, 0x23, 0xa8, 0xa2, 0xed, 0xc8, 0x1c, 0xa8, 0x12, 0xdb,
+	0xaf, 0xca, 0xd3, 0x23, 0xe9, 0x22, 0xaa, 0x3e, 0x2c, 0x06, 0x9b, 0x7d, 0xf2, 0x5a, 0xa6, 0xb9,
+	0x50, 0x9b, 0x54, 0x59, 0x1f, 0x42, 0x03, 0xdd, 0xbe, 0xc1, 0x3e, 0x26, 0x8d, 0x36, 0xde, 0xe4,
+	0x27, 0x32, 0x4d, 0xc5, 0xb5, 0x1d, 0x95, 0x5b, 0xc3, 0xaa, 0x21, 0x8c, 0xef, 0x85, 0x60, 0x60,
+	0xaf, 0x2c, 0x37, 0x8c, 0x60, 0x33, 0x50, 0xb9, 0x35, 0xac, 0x1a, 0x1e, 0x0f, 0x0a, 0xdf, 0x9d,
+	0x90, 0xe4, 0x1f, 0x4b, 0x70, 0x29, 0xa5, 0xc7, 0x25, 0x3f, 0x9b, 0xd3, 0x78, 0x7c, 0x23, 0x4f,
+	0xf9, 0xe2, 0xa8, 0xea, 0x91, 0x45, 0x1e, 0x6e, 0x53, 0xe5, 0x58, 0xe4, 0x09, 0xad, 0x38, 0xe5,
+	0xa9, 0x11, 0x34, 0x11, 0xcf, 0x9b, 0x12, 0x2c, 0xc5, 0x76, 0x9d, 0xe4, 0xdb, 0x79, 0x99, 0x86,
+	0x5a, 0x68, 0xca, 0x93, 0xc3, 0x2b, 0x22, 0x98, 0x77, 0x58, 0xdf, 0x31, 0xa3, 0x43, 0x24, 0x37,
+	0x86, 0xcd, 0x40, 0xcc, 0x1b, 0x68, 0xfb, 0x53, 0xd9, 0x40, 0xb4, 0x3f, 0x63, 0xf5, 0xb9, 0xb4,
+	0x66, 0x8f, 0xfc, 0x5c, 0x4e, 0x37, 0x49, 0x9d, 0x2d, 0xe5, 0xf9, 0xd1, 0x0d, 0x20, 0xc8, 0xb7,
+	0x59, 0x59, 0x39, 0xbe, 0x73, 0x22, 0x67, 0x4f, 0x9b, 0xa4, 0xc6, 0x94, 0xb2, 0x39, 0x8a, 0x2a,
+	0x42, 0xfa, 0x8e, 0x04, 0xe7, 0xe3, 0x4a, 0xff, 0xf2, 0xad, 0x9c, 0x46, 0x43, 0x6d, 0x1d, 0xe5,
+	0xf6, 0xd0, 0x7a, 0x88, 0xc4, 0x86, 0x85, 0x40, 0x13, 0x40, 0xae, 0x67, 0x1e, 0x19, 0x83, 0x95,
+	0x79, 0x65, 0x2d, 0xbf, 0x82, 0xe7, 0x33, 0xd0, 0x00, 0x48, 0xf5, 0x19, 0xd7, 0x86, 0x50, 0xd6,
+	0xf2, 0x2b, 0x78, 0x3e, 0x03, 0xe5, 0xef, 0x54, 0x9f, 0x71, 0x1d, 0x08, 0x65, 0x2d, 0xbf, 0x82,
+	0xb7, 0x23, 0x06, 0x06, 0xa8, 0x9c, 0xdb, 0x06, 0xcd, 0xb3, 0x23, 0xc6, 0xd7, 0xf3, 0x99, 0xdb,
+	0x60, 0x39, 0x3d, 0xd5, 0x6d, 0x6c, 0xdd, 0x5f, 0x59, 0x1f, 0x42, 0xc3, 0xb7, 0x11, 0xc7, 0x94,
+	0xbb, 0x53, 0x77, 0xc0, 0xe4, 0xc2, 0xbe, 0x72, 0x6b, 0x58, 0x35, 0x84, 0x71, 0x0c, 0xa7, 0x43,
+	0xe5, 0x6a, 0x39, 0x8d, 0x4c, 0x7c, 0xf5, 0x5d, 0xd9, 0x18, 0x46, 0xc5, 0x9b, 0x62, 0x81, 0x8a,
+	0x42, 0xea, 0x14, 0x8b, 0xab, 0x99, 0x2b, 0x6b, 0xf9, 0x15, 0xbc, 0x5c, 0x07, 0x0b, 0x05, 0x72,
+	0x86, 0x8d, 0x68, 0x51, 0x43, 0x59, 0x1f, 0x42, 0x03, 0xdd, 0x7e, 0x83, 0x07, 0xd9, 0x7f, 0x39,
+	0xce, 0x0a, 0x72, 0xcc, 0x45, 0x5f, 0xd9, 0x18, 0x46, 0xc5, 0x7f, 0xc0, 0xb1, 0x60, 0x3e, 0xe0,
+	0x3b, 0xed, 0x5e, 0x12, 0xe7, 0xb8, 0x9e, 0x5b, 0x5e, 0x78, 0x55, 0xa6, 0xbe, 0xc5, 0x3e, 0x73,
+	0x6b, 0x90, 0xf7, 0x3e, 0x2a, 0x4b, 0x1f, 0x7c, 0x54, 0x96, 0x3e, 0xfc, 0xa8, 0x2c, 0xbd, 0xfd,
+	0x71, 0xf9, 0xd4, 0x07, 0x1f, 0x97, 0x4f, 0xfd, 0xfd, 0xe3, 0xf2, 0x29, 0x58, 0xd6, 0xad, 0x04,
+	0x9b, 0xfb, 0xd2, 0xab, 0x35, 0xdf, 0xd7, 0x75, 0x9e, 0xd0, 0xaa, 0x6e, 0xf9, 0x7e, 0xd5, 0x8f,
+	0x07, 0x7f, 0x1a, 0x76, 0x30, 0xcd, 0xff, 0x1e, 0xec, 0xf1, 0xff, 0x0e, 0x00, 0x82, 0x6b, 0xb5,
+	0x07, 0x87, 0x37, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3635,6 +3870,8 @@ type MsgClient interface {
 	CommitFunds(ctx context.Context, in *MsgCommitFundsRequest, opts ...grpc.CallOption) (*MsgCommitFundsResponse, error)
 	// CancelOrder cancels an order.
 	CancelOrder(ctx context.Context, in *MsgCancelOrderRequest, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// AmendOrder changes the assets, price, and settlement fees of an existing order.
+	AmendOrder(ctx context.Context, in *MsgAmendOrderRequest, opts ...grpc.CallOption) (*MsgAmendOrderResponse, error)
 	// FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
 	FillBids(ctx context.Context, in *MsgFillBidsRequest, opts ...grpc.CallOption) (*MsgFillBidsResponse, error)
 	// FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
@@ -3658,6 +3895,8 @@ type MsgClient interface {
 	MarketUpdateAcceptingOrders(ctx context.Context, in *MsgMarketUpdateAcceptingOrdersRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingOrdersResponse, error)
 	// MarketUpdateUserSettle is a market endpoint to update whether it allows user-initiated settlement.
 	MarketUpdateUserSettle(ctx context.Context, in *MsgMarketUpdateUserSettleRequest, opts ...grpc.CallOption) (*MsgMarketUpdateUserSettleResponse, error)
+	// MarketUpdateAutoMatch is a market endpoint to update whether its orders are automatically matched and settled.
+	MarketUpdateAutoMatch(ctx context.Context, in *MsgMarketUpdateAutoMatchRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAutoMatchResponse, error)
 	// MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
 	MarketUpdateAcceptingCommitments(ctx context.Context, in *MsgMarketUpdateAcceptingCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
 	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
@@ -3736,6 +3975,15 @@ func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrderRequest,
 	return out, nil
 }
 
+func (c *msgClient) AmendOrder(ctx context.Context, in *MsgAmendOrderRequest, opts ...grpc.CallOption) (*MsgAmendOrderResponse, error) {
+	out := new(MsgAmendOrderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/AmendOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) FillBids(ctx context.Context, in *MsgFillBidsRequest, opts ...grpc.CallOption) (*MsgFillBidsResponse, error) {
 	out := new(MsgFillBidsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/FillBids", in, out, opts...)
@@ -3836,6 +4084,15 @@ func (c *msgClient) MarketUpdateUserSettle(ctx context.Context, in *MsgMarketUpd
 	return out, nil
 }
 
+func (c *msgClient) MarketUpdateAutoMatch(ctx context.Context, in *MsgMarketUpdateAutoMatchRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAutoMatchResponse, error) {
+	out := new(MsgMarketUpdateAutoMatchResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateAutoMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) MarketUpdateAcceptingCommitments(ctx context.Context, in *MsgMarketUpdateAcceptingCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingCommitmentsResponse, error) {
 	out := new(MsgMarketUpdateAcceptingCommitmentsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateAcceptingCommitments", in, out, opts...)
@@ -3982,6 +4239,8 @@ type MsgServer interface {
 	CommitFunds(context.Context, *MsgCommitFundsRequest) (*MsgCommitFundsResponse, error)
 	// CancelOrder cancels an order.
 	CancelOrder(context.Context, *MsgCancelOrderRequest) (*MsgCancelOrderResponse, error)
+	// AmendOrder changes the assets, price, and settlement fees of an existing order.
+	AmendOrder(context.Context, *MsgAmendOrderRequest) (*MsgAmendOrderResponse, error)
 	// FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
 	FillBids(context.Context, *MsgFillBidsRequest) (*MsgFillBidsResponse, error)
 	// FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
@@ -4005,6 +4264,8 @@ type MsgServer interface {
 	MarketUpdateAcceptingOrders(context.Context, *MsgMarketUpdateAcceptingOrdersRequest) (*MsgMarketUpdateAcceptingOrdersResponse, error)
 	// MarketUpdateUserSettle is a market endpoint to update whether it allows user-initiated settlement.
 	MarketUpdateUserSettle(context.Context, *MsgMarketUpdateUserSettleRequest) (*MsgMarketUpdateUserSettleResponse, error)
+	// MarketUpdateAutoMatch is a market endpoint to update whether its orders are automatically matched and settled.
+	MarketUpdateAutoMatch(context.Context, *MsgMarketUpdateAutoMatchRequest) (*MsgMarketUpdateAutoMatchResponse, error)
 	// MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
 	MarketUpdateAcceptingCommitments(context.Context, *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
 	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
@@ -4055,6 +4316,9 @@ func (*UnimplementedMsgServer) CommitFunds(ctx context.Context, req *MsgCommitFu
 func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrderRequest) (*MsgCancelOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (*UnimplementedMsgServer) AmendOrder(ctx context.Context, req *MsgAmendOrderRequest) (*MsgAmendOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendOrder not implemented")
+}
 func (*UnimplementedMsgServer) FillBids(ctx context.Context, req *MsgFillBidsRequest) (*MsgFillBidsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FillBids not implemented")
 }
@@ -4088,6 +4352,9 @@ func (*UnimplementedMsgServer) MarketUpdateAcceptingOrders(ctx context.Context,
 func (*UnimplementedMsgServer) MarketUpdateUserSettle(ctx context.Context, req *MsgMarketUpdateUserSettleRequest) (*MsgMarketUpdateUserSettleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateUserSettle not implemented")
 }
+func (*UnimplementedMsgServer) MarketUpdateAutoMatch(ctx context.Context, req *MsgMarketUpdateAutoMatchRequest) (*MsgMarketUpdateAutoMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateAutoMatch not implemented")
+}
 func (*UnimplementedMsgServer) MarketUpdateAcceptingCommitments(ctx context.Context, req *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateAcceptingCommitments not implemented")
 }
@@ -4210,27 +4477,45 @@ func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_FillBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFillBidsRequest)
+func _Msg_AmendOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).FillBids(ctx, in)
+		return srv.(MsgServer).AmendOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/FillBids",
+		FullMethod: "/provenance.exchange.v1.Msg/AmendOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FillBids(ctx, req.(*MsgFillBidsRequest))
+		return srv.(MsgServer).AmendOrder(ctx, req.(*MsgAmendOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_FillAsks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFillAsksRequest)
-	if err := dec(in); err != nil {
+func _Msg_FillBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFillBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FillBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/FillBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FillBids(ctx, req.(*MsgFillBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FillAsks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFillAsksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -4408,6 +4693,24 @@ func _Msg_MarketUpdateUserSettle_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MarketUpdateAutoMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateAutoMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateAutoMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateAutoMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateAutoMatch(ctx, req.(*MsgMarketUpdateAutoMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_MarketUpdateAcceptingCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgMarketUpdateAcceptingCommitmentsRequest)
 	if err := dec(in); err != nil {
@@ -4699,6 +5002,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Msg_CancelOrder_Handler,
 		},
+		{
+			MethodName: "AmendOrder",
+			Handler:    _Msg_AmendOrder_Handler,
+		},
 		{
 			MethodName: "FillBids",
 			Handler:    _Msg_FillBids_Handler,
@@ -4743,6 +5050,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarketUpdateUserSettle",
 			Handler:    _Msg_MarketUpdateUserSettle_Handler,
 		},
+		{
+			MethodName: "MarketUpdateAutoMatch",
+			Handler:    _Msg_MarketUpdateAutoMatch_Handler,
+		},
 		{
 			MethodName: "MarketUpdateAcceptingCommitments",
 			Handler:    _Msg_MarketUpdateAcceptingCommitments_Handler,
@@ -5103,6 +5414,98 @@ func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAmendOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettlementFees) > 0 {
+		for iNdEx := len(m.SettlementFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFillBidsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5148,20 +5551,20 @@ func (m *MsgFillBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.BidOrderIds) > 0 {
-		dAtA9 := make([]byte, len(m.BidOrderIds)*10)
-		var j8 int
+		dAtA11 := make([]byte, len(m.BidOrderIds)*10)
+		var j10 int
 		for _, num := range m.BidOrderIds {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j10++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5264,20 +5667,20 @@ func (m *MsgFillAsksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.AskOrderIds) > 0 {
-		dAtA12 := make([]byte, len(m.AskOrderIds)*10)
-		var j11 int
+		dAtA14 := make([]byte, len(m.AskOrderIds)*10)
+		var j13 int
 		for _, num := range m.AskOrderIds {
 			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j11++
+				j13++
 			}
-			dAtA12[j11] = uint8(num)
-			j11++
+			dAtA14[j13] = uint8(num)
+			j13++
 		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5360,38 +5763,38 @@ func (m *MsgMarketSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x28
 	}
 	if len(m.BidOrderIds) > 0 {
-		dAtA15 := make([]byte, len(m.BidOrderIds)*10)
-		var j14 int
+		dAtA17 := make([]byte, len(m.BidOrderIds)*10)
+		var j16 int
 		for _, num := range m.BidOrderIds {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j16++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintTx(dAtA, i, uint64(j14))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTx(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.AskOrderIds) > 0 {
-		dAtA17 := make([]byte, len(m.AskOrderIds)*10)
-		var j16 int
+		dAtA19 := make([]byte, len(m.AskOrderIds)*10)
+		var j18 int
 		for _, num := range m.AskOrderIds {
 			for num >= 1<<7 {
-				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j16++
+				j18++
 			}
-			dAtA17[j16] = uint8(num)
-			j16++
+			dAtA19[j18] = uint8(num)
+			j18++
 		}
-		i -= j16
-		copy(dAtA[i:], dAtA17[:j16])
-		i = encodeVarintTx(dAtA, i, uint64(j16))
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintTx(dAtA, i, uint64(j18))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6054,6 +6457,74 @@ func (m *MsgMarketUpdateUserSettleResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMarketUpdateAutoMatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoMatch {
+		i--
+		if m.AutoMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7415,6 +7886,41 @@ func (m *MsgCancelOrderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAmendOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SettlementFees) > 0 {
+		for _, e := range m.SettlementFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAmendOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgFillBidsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7813,6 +8319,34 @@ func (m *MsgMarketUpdateUserSettleResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMarketUpdateAutoMatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.AutoMatch {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9128,7 +9662,7 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9151,15 +9685,15 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFillBidsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFillBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9187,13 +9721,13 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			m.MarketId = 0
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9203,14 +9737,14 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAssets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9237,7 +9771,258 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalAssets = append(m.TotalAssets, types.Coin{})
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementFees = append(m.SettlementFees, types.Coin{})
+			if err := m.SettlementFees[len(m.SettlementFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFillBidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFillBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAssets = append(m.TotalAssets, types.Coin{})
 			if err := m.TotalAssets[len(m.TotalAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -11766,6 +12551,177 @@ func (m *MsgMarketUpdateUserSettleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMarketUpdateAutoMatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketUpdateAutoMatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketUpdateAutoMatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoMatch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketUpdateAutoMatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketUpdateAutoMatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketUpdateAutoMatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -67,6 +67,8 @@ type IntegrationCLITestSuite struct {
 	addr4Spendable sdk.Coins
 	addr5Spendable sdk.Coins
 
+	addr4Entry *hold.HoldEntry
+
 	flagAsText     string
 	flagAsJSON     string
 	flagOffset     string
@@ -152,6 +154,16 @@ func (s *IntegrationCLITestSuite) SetupSuite() {
 	// - None of another denom on hold.
 	s.addr4Desc = "addr with only a little on hold"
 	s.addr4Bal, s.addr4Hold, s.addr4Spendable = newAmounts("addr4", "93acorn,9carrot", "90acorn,30000"+s.cfg.BondDenom)
+	// - Some of the acorn on hold is in a hold entry that will be released in the distant future.
+	addr4Release := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.addr4Entry = &hold.HoldEntry{
+		HoldId:     1,
+		Address:    s.addr4.String(),
+		Holder:     "cli-test",
+		Reason:     "testing hold entries",
+		Amount:     newCoins("60acorn"),
+		Expiration: &addr4Release,
+	}
 
 	// addr5 characteristics:
 	// - Only has bond denom.
@@ -208,6 +220,8 @@ func (s *IntegrationCLITestSuite) SetupSuite() {
 		&hold.AccountHold{Address: s.addr4.String(), Amount: s.addr4Hold},
 		&hold.AccountHold{Address: s.addr5.String(), Amount: s.addr5Hold},
 	)
+	holdGen.Entries = append(holdGen.Entries, s.addr4Entry)
+	holdGen.LastHoldId = 1
 	s.cfg.GenesisState[hold.ModuleName], err = s.cfg.Codec.MarshalJSON(&holdGen)
 	s.Require().NoError(err, "MarshalJSON hold gen state")
 
@@ -319,6 +333,16 @@ func (s *IntegrationCLITestSuite) TestQueryCmd() {
 			args:   []string{"get", s.addr1.String(), s.flagAsText},
 			expOut: s.asYAML(resp(s.addr1Hold)),
 		},
+		{
+			name:   "entry",
+			args:   []string{"entry", "1", s.flagAsText},
+			expOut: s.asYAML(&hold.GetHoldEntryResponse{Entry: s.addr4Entry}),
+		},
+		{
+			name:   "releases",
+			args:   []string{"releases", s.addr4.String(), s.flagAsText},
+			expOut: s.asYAML(&hold.GetUpcomingReleasesResponse{Entries: []*hold.HoldEntry{s.addr4Entry}}),
+		},
 		{
 			name:   "all",
 			args:   []string{"all", s.flagAsText},
@@ -343,8 +367,8 @@ func (s *IntegrationCLITestSuite) TestQueryCmdGetHolds() {
 	cmdGen := func() *cobra.Command {
 		return cli.QueryCmdGetHolds()
 	}
-	resp := func(amount sdk.Coins) *hold.GetHoldsResponse {
-		return &hold.GetHoldsResponse{Amount: amount}
+	resp := func(amount sdk.Coins, entries ...*hold.HoldEntry) *hold.GetHoldsResponse {
+		return &hold.GetHoldsResponse{Amount: amount, Entries: entries}
 	}
 
 	unknownAddr := sdk.AccAddress("unknown_address_____")
@@ -383,12 +407,12 @@ func (s *IntegrationCLITestSuite) TestQueryCmdGetHolds() {
 		{
 			name:   s.addr4Desc + ": get hold as text",
 			args:   []string{s.addr4.String(), s.flagAsText},
-			expOut: s.asYAML(resp(s.addr4Hold)),
+			expOut: s.asYAML(resp(s.addr4Hold, s.addr4Entry)),
 		},
 		{
 			name:   s.addr4Desc + ": get hold as json",
 			args:   []string{s.addr4.String(), s.flagAsJSON},
-			expOut: s.asJSON(resp(s.addr4Hold)),
+			expOut: s.asJSON(resp(s.addr4Hold, s.addr4Entry)),
 		},
 		{
 			name:   s.addr5Desc + ": get hold as text",
@@ -504,6 +528,90 @@ func (s *IntegrationCLITestSuite) TestQueryCmdGetAllHolds() {
 	}
 }
 
+func (s *IntegrationCLITestSuite) TestQueryCmdGetHoldEntry() {
+	cmdGen := func() *cobra.Command {
+		return cli.QueryCmdGetHoldEntry()
+	}
+	resp := &hold.GetHoldEntryResponse{Entry: s.addr4Entry}
+
+	tests := []queryCmdTestCase{
+		{
+			name:   "as text",
+			args:   []string{"1", s.flagAsText},
+			expOut: s.asYAML(resp),
+		},
+		{
+			name:   "as json",
+			args:   []string{"1", s.flagAsJSON},
+			expOut: s.asJSON(resp),
+		},
+		{
+			name:   "unknown hold id",
+			args:   []string{"2"},
+			expErr: "hold entry 2 not found",
+		},
+		{
+			name:   "invalid hold id",
+			args:   []string{"one"},
+			expErr: "invalid hold id \"one\": strconv.ParseUint: parsing \"one\": invalid syntax",
+		},
+		{
+			name:   "no args",
+			args:   []string{},
+			expErr: "accepts 1 arg(s), received 0",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			tc.cmd = cmdGen()
+			s.assertQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *IntegrationCLITestSuite) TestQueryCmdGetUpcomingReleases() {
+	cmdGen := func() *cobra.Command {
+		return cli.QueryCmdGetUpcomingReleases()
+	}
+	resp := &hold.GetUpcomingReleasesResponse{Entries: []*hold.HoldEntry{s.addr4Entry}}
+
+	tests := []queryCmdTestCase{
+		{
+			name:   "as text",
+			args:   []string{s.addr4.String(), s.flagAsText},
+			expOut: s.asYAML(resp),
+		},
+		{
+			name:   "as json",
+			args:   []string{s.addr4.String(), s.flagAsJSON},
+			expOut: s.asJSON(resp),
+		},
+		{
+			name:   "no upcoming releases",
+			args:   []string{s.addr1.String(), s.flagAsText},
+			expOut: s.asYAML(&hold.GetUpcomingReleasesResponse{}),
+		},
+		{
+			name:   "invalid address",
+			args:   []string{"notanaddress"},
+			expErr: "decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			name:   "no args",
+			args:   []string{},
+			expErr: "accepts 1 arg(s), received 0",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			tc.cmd = cmdGen()
+			s.assertQueryCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *IntegrationCLITestSuite) TestHoldsNotInFromSpendable() {
 	// The purpose of these tests is to make sure that the bank module is
 	// being properly informed of the locked hold funds.
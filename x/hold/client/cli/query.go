@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +32,8 @@ func QueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		QueryCmdGetHolds(),
 		QueryCmdGetAllHolds(),
+		QueryCmdGetHoldEntry(),
+		QueryCmdGetUpcomingReleases(),
 	)
 
 	return cmd
@@ -108,3 +111,75 @@ func QueryCmdGetAllHolds() *cobra.Command {
 
 	return cmd
 }
+
+func QueryCmdGetHoldEntry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "entry <hold id>",
+		Aliases: []string{"get-entry", "hold-entry"},
+		Short:   "Get a hold entry by its id.",
+		Example: fmt.Sprintf("$ %s entry 3", exampleQueryCmdBase),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := hold.GetHoldEntryRequest{}
+			req.HoldId, err = strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid hold id %q: %w", args[0], err)
+			}
+
+			var res *hold.GetHoldEntryResponse
+			queryClient := hold.NewQueryClient(clientCtx)
+			res, err = queryClient.GetHoldEntry(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryCmdGetUpcomingReleases() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "releases <address>",
+		Aliases: []string{"upcoming-releases", "timed-holds"},
+		Short:   "Get the hold entries for an address that will be automatically released.",
+		Example: fmt.Sprintf("$ %s releases %s", exampleQueryCmdBase, exampleQueryAddr1),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+			}
+
+			req := hold.GetUpcomingReleasesRequest{
+				Address: args[0],
+			}
+
+			var res *hold.GetUpcomingReleasesResponse
+			queryClient := hold.NewQueryClient(clientCtx)
+			res, err = queryClient.GetUpcomingReleases(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	hold "github.com/provenance-io/provenance/x/hold"
+)
+
+// FlagReason is the flag for providing the reason for a hold.
+const FlagReason = "reason"
+
+// exampleTxCmdBase is the base command that gets a user to one of the tx commands in here.
+var exampleTxCmdBase = fmt.Sprintf("%s tx %s", version.AppName, hold.ModuleName)
+
+func TxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        hold.ModuleName,
+		Short:                      "Transaction commands for the hold module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		TxCmdCreateTimedHold(),
+	)
+
+	return cmd
+}
+
+func TxCmdCreateTimedHold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-timed-hold <amount> <release time>",
+		Aliases: []string{"timed-hold", "time-lock"},
+		Short:   "Put funds on hold until a specific time.",
+		Long: `Put funds in your account on hold until a specific time.
+The funds will be automatically released at the start of the first block at or after the release time.
+The release time must be in RFC 3339 format, e.g. 2006-01-02T15:04:05Z.`,
+		Example: fmt.Sprintf("$ %s create-timed-hold 1000nhash 2030-01-02T15:04:05Z --%s \"vesting\" --from mykey", exampleTxCmdBase, FlagReason),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[0], err)
+			}
+
+			releaseTime, err := time.Parse(time.RFC3339Nano, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid release time %q: %w", args[1], err)
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := &hold.MsgCreateTimedHoldRequest{
+				Address:     clientCtx.GetFromAddress().String(),
+				Amount:      amount,
+				ReleaseTime: releaseTime.UTC(),
+				Reason:      reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "The reason for the hold")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,3 +16,22 @@ func NewEventHoldReleased(addr sdk.AccAddress, amount sdk.Coins) *EventHoldRelea
 		Amount:  amount.String(),
 	}
 }
+
+func NewEventHoldEntryAdded(entry *HoldEntry) *EventHoldEntryAdded {
+	return &EventHoldEntryAdded{
+		HoldId:  entry.HoldId,
+		Address: entry.Address,
+		Holder:  entry.Holder,
+		Amount:  entry.Amount.String(),
+		Reason:  entry.Reason,
+	}
+}
+
+func NewEventHoldEntryReleased(entry *HoldEntry) *EventHoldEntryReleased {
+	return &EventHoldEntryReleased{
+		HoldId:  entry.HoldId,
+		Address: entry.Address,
+		Holder:  entry.Holder,
+		Amount:  entry.Amount.String(),
+	}
+}
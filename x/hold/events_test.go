@@ -108,11 +108,51 @@ func TestNewEventHoldReleased(t *testing.T) {
 	}
 }
 
+func TestNewEventHoldEntryAdded(t *testing.T) {
+	entry := &HoldEntry{
+		HoldId:  5,
+		Address: sdk.AccAddress("entry_address_______").String(),
+		Holder:  "testmod",
+		Reason:  "testing",
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin("fingercoin", 10), sdk.NewInt64Coin("toecoin", 9)),
+	}
+	exp := &EventHoldEntryAdded{
+		HoldId:  5,
+		Address: entry.Address,
+		Holder:  "testmod",
+		Amount:  "10fingercoin,9toecoin",
+		Reason:  "testing",
+	}
+
+	event := NewEventHoldEntryAdded(entry)
+	assert.Equal(t, exp, event, "NewEventHoldEntryAdded")
+}
+
+func TestNewEventHoldEntryReleased(t *testing.T) {
+	entry := &HoldEntry{
+		HoldId:  5,
+		Address: sdk.AccAddress("entry_address_______").String(),
+		Holder:  "testmod",
+		Reason:  "testing",
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin("fingercoin", 10), sdk.NewInt64Coin("toecoin", 9)),
+	}
+	exp := &EventHoldEntryReleased{
+		HoldId:  5,
+		Address: entry.Address,
+		Holder:  "testmod",
+		Amount:  "10fingercoin,9toecoin",
+	}
+
+	event := NewEventHoldEntryReleased(entry)
+	assert.Equal(t, exp, event, "NewEventHoldEntryReleased")
+}
+
 func TestTypedEventToEvent(t *testing.T) {
 	addr := sdk.AccAddress("address_in_the_event")
 	coins := sdk.NewCoins(sdk.NewInt64Coin("elbowcoin", 4), sdk.NewInt64Coin("kneecoin", 2))
 	addrQ := fmt.Sprintf("%q", addr.String())
 	coinsQ := fmt.Sprintf("%q", coins.String())
+	entry := &HoldEntry{HoldId: 12, Address: addr.String(), Holder: "testmod", Reason: "test reason", Amount: coins}
 
 	tests := []struct {
 		name     string
@@ -142,6 +182,33 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventHoldEntryAdded",
+			tev:  NewEventHoldEntryAdded(entry),
+			expEvent: sdk.Event{
+				Type: "provenance.hold.v1.EventHoldEntryAdded",
+				Attributes: []abci.EventAttribute{
+					{Key: "address", Value: addrQ},
+					{Key: "amount", Value: coinsQ},
+					{Key: "hold_id", Value: `"12"`},
+					{Key: "holder", Value: `"testmod"`},
+					{Key: "reason", Value: `"test reason"`},
+				},
+			},
+		},
+		{
+			name: "EventHoldEntryReleased",
+			tev:  NewEventHoldEntryReleased(entry),
+			expEvent: sdk.Event{
+				Type: "provenance.hold.v1.EventHoldEntryReleased",
+				Attributes: []abci.EventAttribute{
+					{Key: "address", Value: addrQ},
+					{Key: "amount", Value: coinsQ},
+					{Key: "hold_id", Value: `"12"`},
+					{Key: "holder", Value: `"testmod"`},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -3,6 +3,8 @@ package hold
 import (
 	"errors"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func DefaultGenesisState() *GenesisState {
@@ -28,5 +30,53 @@ func (g GenesisState) Validate() error {
 			addrs[ah.Address] = i
 		}
 	}
+
+	ids := make(map[uint64]int)
+	entryTotals := make(map[string]sdk.Coins)
+	for i, entry := range g.Entries {
+		if entry == nil {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: cannot be nil", i))
+			continue
+		}
+		if err := entry.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: %w", i, err))
+			continue
+		}
+		if entry.HoldId > g.LastHoldId {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: hold id %d is greater than the last hold id %d",
+				i, entry.HoldId, g.LastHoldId))
+			continue
+		}
+		j, seen := ids[entry.HoldId]
+		if seen {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: duplicate hold id %d also at index %d", i, entry.HoldId, j))
+			continue
+		}
+		ids[entry.HoldId] = i
+		entryTotals[entry.Address] = entryTotals[entry.Address].Add(entry.Amount...)
+	}
+
+	// The funds in the entries must also be included in the holds.
+	for _, ah := range g.Holds {
+		if ah == nil {
+			continue
+		}
+		if total, ok := entryTotals[ah.Address]; ok {
+			if !ah.Amount.IsAllGTE(total) {
+				errs = append(errs, fmt.Errorf("invalid holds: %s has %q on hold but %q in hold entries", ah.Address, ah.Amount, total))
+			}
+			delete(entryTotals, ah.Address)
+		}
+	}
+	for _, entry := range g.Entries {
+		if entry == nil {
+			continue
+		}
+		if total, ok := entryTotals[entry.Address]; ok {
+			errs = append(errs, fmt.Errorf("invalid holds: %s has nothing on hold but %q in hold entries", entry.Address, total))
+			delete(entryTotals, entry.Address)
+		}
+	}
+
 	return errors.Join(errs...)
 }
@@ -154,3 +154,80 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_Validate_Entries(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	holds := []*AccountHold{
+		{Address: addr1, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 100), sdk.NewInt64Coin("steak", 5))},
+		{Address: addr2, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 20))},
+	}
+	entry := func(holdID uint64, addr string, amount int64) *HoldEntry {
+		return &HoldEntry{
+			HoldId:  holdID,
+			Address: addr,
+			Holder:  "testmod",
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin("nhash", amount)),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		genState GenesisState
+		expErr   []string
+	}{
+		{
+			name: "entries within holds",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{entry(1, addr1, 60), entry(2, addr2, 20), entry(4, addr1, 40)},
+				LastHoldId: 4,
+			},
+		},
+		{
+			name:     "nil entry",
+			genState: GenesisState{Holds: holds, Entries: []*HoldEntry{nil}, LastHoldId: 1},
+			expErr:   []string{"invalid entries[0]: cannot be nil"},
+		},
+		{
+			name:     "invalid entry",
+			genState: GenesisState{Holds: holds, Entries: []*HoldEntry{entry(0, addr1, 1)}, LastHoldId: 1},
+			expErr:   []string{"invalid entries[0]: invalid hold id: cannot be zero"},
+		},
+		{
+			name:     "hold id after last hold id",
+			genState: GenesisState{Holds: holds, Entries: []*HoldEntry{entry(1, addr1, 1), entry(3, addr1, 1)}, LastHoldId: 2},
+			expErr:   []string{"invalid entries[1]: hold id 3 is greater than the last hold id 2"},
+		},
+		{
+			name:     "duplicate hold id",
+			genState: GenesisState{Holds: holds, Entries: []*HoldEntry{entry(1, addr1, 1), entry(1, addr2, 1)}, LastHoldId: 2},
+			expErr:   []string{"invalid entries[1]: duplicate hold id 1 also at index 0"},
+		},
+		{
+			name:     "entries more than holds",
+			genState: GenesisState{Holds: holds, Entries: []*HoldEntry{entry(1, addr1, 60), entry(2, addr1, 41)}, LastHoldId: 2},
+			expErr:   []string{"invalid holds: " + addr1 + " has \"100nhash,5steak\" on hold but \"101nhash\" in hold entries"},
+		},
+		{
+			name: "entries without holds",
+			genState: GenesisState{
+				Holds:      holds[:1],
+				Entries:    []*HoldEntry{entry(1, addr2, 1), entry(2, addr2, 2)},
+				LastHoldId: 2,
+			},
+			expErr: []string{"invalid holds: " + addr2 + " has nothing on hold but \"3nhash\" in hold entries"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.genState.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate()")
+		})
+	}
+}
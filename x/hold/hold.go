@@ -1,6 +1,7 @@
 package hold
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,3 +16,22 @@ func (e AccountHold) Validate() error {
 	}
 	return nil
 }
+
+func (e HoldEntry) Validate() error {
+	if e.HoldId == 0 {
+		return errors.New("invalid hold id: cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if len(e.Holder) == 0 {
+		return errors.New("invalid holder: cannot be empty")
+	}
+	if err := e.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if e.Amount.IsZero() {
+		return errors.New("invalid amount: cannot be zero")
+	}
+	return nil
+}
@@ -68,3 +68,66 @@ func TestAccountHold_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestHoldEntry_Validate(t *testing.T) {
+	addr := sdk.AccAddress("control_addr________").String()
+	control := func() HoldEntry {
+		return HoldEntry{
+			HoldId:  3,
+			Address: addr,
+			Holder:  "testmod",
+			Reason:  "testing",
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000)),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*HoldEntry)
+		exp    string
+	}{
+		{
+			name: "control",
+		},
+		{
+			name:   "no reason",
+			modify: func(e *HoldEntry) { e.Reason = "" },
+		},
+		{
+			name:   "zero hold id",
+			modify: func(e *HoldEntry) { e.HoldId = 0 },
+			exp:    "invalid hold id: cannot be zero",
+		},
+		{
+			name:   "invalid address",
+			modify: func(e *HoldEntry) { e.Address = "bad" },
+			exp:    "invalid address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "no holder",
+			modify: func(e *HoldEntry) { e.Holder = "" },
+			exp:    "invalid holder: cannot be empty",
+		},
+		{
+			name:   "invalid amount",
+			modify: func(e *HoldEntry) { e.Amount = sdk.Coins{sdk.Coin{Denom: "badcoin", Amount: sdkmath.NewInt(-50)}} },
+			exp:    "invalid amount: coin -50badcoin amount is not positive",
+		},
+		{
+			name:   "no amount",
+			modify: func(e *HoldEntry) { e.Amount = nil },
+			exp:    "invalid amount: cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entry := control()
+			if tc.modify != nil {
+				tc.modify(&entry)
+			}
+			err := entry.Validate()
+			assertions.AssertErrorValue(t, err, tc.exp, "Validate()")
+		})
+	}
+}
@@ -15,6 +15,9 @@ import (
 // HoldAccountBalancesInvariantHelper exposes the holdAccountBalancesInvariantHelper function for unit tests.
 var HoldAccountBalancesInvariantHelper = holdAccountBalancesInvariantHelper
 
+// Uint64Bz exposes the uint64Bz function for unit tests.
+var Uint64Bz = uint64Bz
+
 // WithBankKeeper returns a new keeper that uses the provided bank keeper for unit tests.
 func (k Keeper) WithBankKeeper(bk hold.BankKeeper) Keeper {
 	k.bankKeeper = bk
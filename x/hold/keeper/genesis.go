@@ -26,6 +26,17 @@ func (k Keeper) InitGenesis(origCtx sdk.Context, genState *hold.GenesisState) {
 			panic(fmt.Errorf("holds[%d]: %w", i, err))
 		}
 	}
+
+	// The funds in the entries are already included in the holds, so we just need to write the entries.
+	store := ctx.KVStore(k.storeKey)
+	for i, entry := range genState.Entries {
+		if err := k.setHoldEntry(store, entry); err != nil {
+			panic(fmt.Errorf("entries[%d]: %w", i, err))
+		}
+	}
+	if genState.LastHoldId != 0 {
+		setLastHoldID(store, genState.LastHoldId)
+	}
 }
 
 // ExportGenesis creates a GenesisState from the current state store.
@@ -38,5 +49,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *hold.GenesisState {
 		panic(err)
 	}
 
+	rv.Entries, err = k.GetAllHoldEntries(ctx)
+	if err != nil {
+		panic(err)
+	}
+	rv.LastHoldId = k.GetLastHoldID(ctx)
+
 	return rv
 }
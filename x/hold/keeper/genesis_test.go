@@ -39,12 +39,39 @@ func (s *TestSuite) TestKeeper_InitGenesis() {
 		}
 		return rv
 	}
+	entryStateEntries := func(entry *hold.HoldEntry) []string {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		s.Require().NoError(err, "sdk.AccAddressFromBech32(%q)", entry.Address)
+		val, err := s.app.AppCodec().Marshal(entry)
+		s.Require().NoError(err, "Marshal(entry %d)", entry.HoldId)
+		return []string{
+			s.stateEntryString(keeper.CreateHoldEntryKey(entry.HoldId), val),
+			s.stateEntryString(keeper.CreateHoldEntryAddrIndexKey(addr, entry.HoldId), []byte{}),
+		}
+	}
 	expStateEntries := func(genState *hold.GenesisState) []string {
 		var rv []string
 		if genState != nil {
 			for _, ah := range genState.Holds {
 				rv = append(rv, ahStateEntries(ah)...)
 			}
+			totals := make(map[string]sdk.Coins)
+			for _, entry := range genState.Entries {
+				rv = append(rv, entryStateEntries(entry)...)
+				totals[entry.Address] = totals[entry.Address].Add(entry.Amount...)
+			}
+			for addrStr, total := range totals {
+				addr, err := sdk.AccAddressFromBech32(addrStr)
+				s.Require().NoError(err, "sdk.AccAddressFromBech32(%q)", addrStr)
+				for _, coin := range total {
+					val, err := coin.Amount.Marshal()
+					s.Require().NoError(err, "%q.Amount.Marshal()", coin)
+					rv = append(rv, s.stateEntryString(keeper.CreateHoldEntryTotalKey(addr, coin.Denom), val))
+				}
+			}
+			if genState.LastHoldId != 0 {
+				rv = append(rv, s.stateEntryString(keeper.KeyLastHoldID, keeper.Uint64Bz(genState.LastHoldId)))
+			}
 			sort.Strings(rv)
 		}
 		return rv
@@ -73,6 +100,21 @@ func (s *TestSuite) TestKeeper_InitGenesis() {
 				accHold(s.addr5, s.initBal),
 			),
 		},
+		{
+			name: "holds with entries",
+			genState: &hold.GenesisState{
+				Holds: []*hold.AccountHold{
+					accHold(s.addr1, s.coins("99banana,53cactus")),
+					accHold(s.addr2, s.coins("42banana")),
+				},
+				Entries: []*hold.HoldEntry{
+					{HoldId: 2, Address: s.addr1.String(), Holder: "testmod", Reason: "second", Amount: s.coins("90banana")},
+					{HoldId: 3, Address: s.addr2.String(), Holder: "testmod", Reason: "third", Amount: s.coins("42banana")},
+					{HoldId: 5, Address: s.addr1.String(), Holder: "othermod", Amount: s.coins("9banana,3cactus")},
+				},
+				LastHoldId: 7,
+			},
+		},
 		{
 			name: "several holds: first insufficient",
 			genState: genStateWithHolds(
@@ -166,6 +208,25 @@ func (s *TestSuite) TestKeeper_ExportGenesis() {
 			},
 			expGenState: genStateWithHolds(accHold(s.addr1, "99banana")),
 		},
+		{
+			name: "with hold entries",
+			setup: func(s *TestSuite, store storetypes.KVStore) {
+				s.requireAddHold(s.addr1, "3"+s.bondDenom)
+				s.requireAddHoldEntry(s.addr2, "5"+s.bondDenom, "testmod")
+				s.requireAddHoldEntry(s.addr1, "7"+s.bondDenom, "othermod")
+			},
+			expGenState: &hold.GenesisState{
+				Holds: []*hold.AccountHold{
+					accHold(s.addr1, "10"+s.bondDenom),
+					accHold(s.addr2, "5"+s.bondDenom),
+				},
+				Entries: []*hold.HoldEntry{
+					{HoldId: 1, Address: s.addr2.String(), Holder: "testmod", Amount: s.coins("5" + s.bondDenom)},
+					{HoldId: 2, Address: s.addr1.String(), Holder: "othermod", Amount: s.coins("7" + s.bondDenom)},
+				},
+				LastHoldId: 2,
+			},
+		},
 		{
 			name: "one entry: bad",
 			setup: func(s *TestSuite, store storetypes.KVStore) {
@@ -37,6 +37,10 @@ func (k Keeper) GetHolds(goCtx context.Context, req *hold.GetHoldsRequest) (*hol
 	if err != nil {
 		return nil, err
 	}
+	resp.Entries, err = k.GetHoldEntries(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
 	return resp, err
 }
 
@@ -50,6 +54,48 @@ func (k Keeper) GetAllHolds(goCtx context.Context, req *hold.GetAllHoldsRequest)
 	return k.paginateAllHolds(sdk.UnwrapSDKContext(goCtx), pageReq)
 }
 
+// GetHoldEntry looks up a single hold entry by its id.
+func (k Keeper) GetHoldEntry(goCtx context.Context, req *hold.GetHoldEntryRequest) (*hold.GetHoldEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.HoldId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "hold id cannot be zero")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entry, err := k.GetHoldEntryByID(ctx, req.HoldId)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, status.Errorf(codes.NotFound, "hold entry %d not found", req.HoldId)
+	}
+	return &hold.GetHoldEntryResponse{Entry: entry}, nil
+}
+
+// GetUpcomingReleases looks up the hold entries for an address that will be automatically released, ordered by release time.
+func (k Keeper) GetUpcomingReleases(goCtx context.Context, req *hold.GetUpcomingReleasesRequest) (*hold.GetUpcomingReleasesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Address) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries, err := k.GetUpcomingHoldReleases(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &hold.GetUpcomingReleasesResponse{Entries: entries}, nil
+}
+
 // paginateAllHolds iterates over hold entries to generate a paginated GetAllHolds result.
 // It's copied from query.FilteredPaginate and tweaked to count results by address instead of iterator entry.
 // It was easier to do it this way than shoehorn a solution into a call to FilteredPaginate.
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +20,8 @@ func (s *TestSuite) TestKeeper_GetHolds() {
 	s.requireSetHoldCoinAmount(store, s.addr3, "date", s.int(34))
 	s.setHoldCoinAmountRaw(store, s.addr4, "dratcoin", "dratvalue")
 	store = nil
+	s.requireAddHold(s.addr5, "3"+s.bondDenom)
+	s.requireAddHoldEntry(s.addr5, "7"+s.bondDenom, "testmod")
 
 	req := func(addr string) *hold.GetHoldsRequest {
 		return &hold.GetHoldsRequest{Address: addr}
@@ -62,6 +66,16 @@ func (s *TestSuite) TestKeeper_GetHolds() {
 			request: req(s.addr3.String()),
 			expResp: resp("89banana,55cactus,34date"),
 		},
+		{
+			name:    "some funds in a hold entry",
+			request: req(s.addr5.String()),
+			expResp: &hold.GetHoldsResponse{
+				Amount: s.coins("10" + s.bondDenom),
+				Entries: []*hold.HoldEntry{
+					{HoldId: 1, Address: s.addr5.String(), Holder: "testmod", Amount: s.coins("7" + s.bondDenom)},
+				},
+			},
+		},
 		{
 			name:    "error getting amount",
 			request: req(s.addr4.String()),
@@ -86,6 +100,106 @@ func (s *TestSuite) TestKeeper_GetHolds() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_GetHoldEntry() {
+	s.requireAddHoldEntry(s.addr1, "7"+s.bondDenom, "testmod")
+	entry := &hold.HoldEntry{HoldId: 1, Address: s.addr1.String(), Holder: "testmod", Amount: s.coins("7" + s.bondDenom)}
+
+	tests := []struct {
+		name    string
+		request *hold.GetHoldEntryRequest
+		expResp *hold.GetHoldEntryResponse
+		expErr  []string
+	}{
+		{
+			name:    "nil request",
+			request: nil,
+			expErr:  []string{"InvalidArgument", "empty request"},
+		},
+		{
+			name:    "zero hold id",
+			request: &hold.GetHoldEntryRequest{HoldId: 0},
+			expErr:  []string{"InvalidArgument", "hold id cannot be zero"},
+		},
+		{
+			name:    "unknown hold id",
+			request: &hold.GetHoldEntryRequest{HoldId: 2},
+			expErr:  []string{"NotFound", "hold entry 2 not found"},
+		},
+		{
+			name:    "known hold id",
+			request: &hold.GetHoldEntryRequest{HoldId: 1},
+			expResp: &hold.GetHoldEntryResponse{Entry: entry},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var response *hold.GetHoldEntryResponse
+			var err error
+			testFunc := func() {
+				response, err = s.keeper.GetHoldEntry(s.ctx, tc.request)
+			}
+			s.Require().NotPanics(testFunc, "GetHoldEntry")
+			s.assertErrorContents(err, tc.expErr, "GetHoldEntry error")
+			s.Assert().Equal(tc.expResp, response, "GetHoldEntry response")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetUpcomingReleases() {
+	releaseTime := s.ctx.BlockTime().Add(time.Hour).UTC()
+	s.requireAddHoldEntry(s.addr1, "7"+s.bondDenom, "testmod")
+	holdID, err := s.keeper.AddHoldEntry(s.ctx, s.addr1, s.coins("3"+s.bondDenom), "testmod", "", &releaseTime)
+	s.Require().NoError(err, "AddHoldEntry with expiration")
+	entry := &hold.HoldEntry{HoldId: holdID, Address: s.addr1.String(), Holder: "testmod", Amount: s.coins("3" + s.bondDenom), Expiration: &releaseTime}
+
+	tests := []struct {
+		name    string
+		request *hold.GetUpcomingReleasesRequest
+		expResp *hold.GetUpcomingReleasesResponse
+		expErr  []string
+	}{
+		{
+			name:    "nil request",
+			request: nil,
+			expErr:  []string{"InvalidArgument", "empty request"},
+		},
+		{
+			name:    "empty address",
+			request: &hold.GetUpcomingReleasesRequest{Address: ""},
+			expErr:  []string{"InvalidArgument", "address cannot be empty"},
+		},
+		{
+			name:    "invalid address",
+			request: &hold.GetUpcomingReleasesRequest{Address: "not1valid"},
+			expErr:  []string{"InvalidArgument", "invalid address"},
+		},
+		{
+			name:    "no upcoming releases",
+			request: &hold.GetUpcomingReleasesRequest{Address: s.addr2.String()},
+			expResp: &hold.GetUpcomingReleasesResponse{},
+		},
+		{
+			name:    "one upcoming release",
+			request: &hold.GetUpcomingReleasesRequest{Address: s.addr1.String()},
+			expResp: &hold.GetUpcomingReleasesResponse{Entries: []*hold.HoldEntry{entry}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			var response *hold.GetUpcomingReleasesResponse
+			var err error
+			testFunc := func() {
+				response, err = s.keeper.GetUpcomingReleases(s.ctx, tc.request)
+			}
+			s.Require().NotPanics(testFunc, "GetUpcomingReleases")
+			s.assertErrorContents(err, tc.expErr, "GetUpcomingReleases error")
+			s.Assert().Equal(tc.expResp, response, "GetUpcomingReleases response")
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_GetAllHolds() {
 	accHold := func(addr sdk.AccAddress, amount string) *hold.AccountHold {
 		return &hold.AccountHold{
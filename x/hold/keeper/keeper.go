@@ -3,6 +3,8 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
@@ -90,17 +92,9 @@ func (k Keeper) ValidateNewHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.
 	return nil
 }
 
-// AddHold puts the provided funds on hold for the provided account.
-func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error {
-	if funds.IsZero() {
-		return nil
-	}
-
-	if err := k.ValidateNewHold(ctx, addr, funds); err != nil {
-		return err
-	}
-
-	store := ctx.KVStore(k.storeKey)
+// increaseHoldCoinAmounts adds the provided funds to the hold coin entries for the provided account.
+// Returns the funds that were added along with any errors encountered.
+func (k Keeper) increaseHoldCoinAmounts(store storetypes.KVStore, addr sdk.AccAddress, funds sdk.Coins) (sdk.Coins, []error) {
 	var fundsAdded sdk.Coins
 	var errs []error
 	for _, toAdd := range funds {
@@ -119,6 +113,23 @@ func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, r
 		}
 		fundsAdded = fundsAdded.Add(toAdd)
 	}
+	return fundsAdded, errs
+}
+
+// AddHold puts the provided funds on hold for the provided account.
+// No hold entry is created for these funds, so they can later be released using ReleaseHold.
+// To create a hold that only the holding module can release, use AddHoldEntry.
+func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error {
+	if funds.IsZero() {
+		return nil
+	}
+
+	if err := k.ValidateNewHold(ctx, addr, funds); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	fundsAdded, errs := k.increaseHoldCoinAmounts(store, addr, funds)
 
 	if !fundsAdded.IsZero() {
 		err := ctx.EventManager().EmitTypedEvent(hold.NewEventHoldAdded(addr, fundsAdded, reason))
@@ -131,6 +142,7 @@ func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, r
 }
 
 // ReleaseHold releases the hold on the provided funds for the provided account.
+// Funds that are part of a hold entry cannot be released this way; use ReleaseHoldByID for those.
 func (k Keeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error {
 	if funds.IsZero() {
 		return nil
@@ -153,9 +165,20 @@ func (k Keeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coin
 			continue
 		}
 
+		entryAmount, err := k.getHoldEntryTotal(store, addr, toRelease.Denom)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get current %s hold entry total for %s: %w", toRelease.Denom, addr, err))
+			continue
+		}
+
 		newAmount := onHold.Sub(toRelease.Amount)
-		if newAmount.IsNegative() {
-			errs = append(errs, fmt.Errorf("cannot release %s from hold for %s: account only has %s%s on hold", toRelease, addr, onHold, toRelease.Denom))
+		if newAmount.LT(entryAmount) {
+			if entryAmount.IsZero() {
+				errs = append(errs, fmt.Errorf("cannot release %s from hold for %s: account only has %s%s on hold", toRelease, addr, onHold, toRelease.Denom))
+			} else {
+				errs = append(errs, fmt.Errorf("cannot release %s from hold for %s: account only has %s%s on hold that is not in a hold entry",
+					toRelease, addr, onHold.Sub(entryAmount), toRelease.Denom))
+			}
 			continue
 		}
 
@@ -292,3 +315,387 @@ func (k Keeper) GetAllAccountHolds(ctx sdk.Context) ([]*hold.AccountHold, error)
 	})
 	return holds, err
 }
+
+// getLastHoldID gets the last hold id assigned to a hold entry.
+func getLastHoldID(store storetypes.KVStore) uint64 {
+	return UnmarshalLastHoldIDValue(store.Get(KeyLastHoldID))
+}
+
+// setLastHoldID sets the last hold id assigned to a hold entry.
+func setLastHoldID(store storetypes.KVStore, holdID uint64) {
+	store.Set(KeyLastHoldID, uint64Bz(holdID))
+}
+
+// nextHoldID finds the next available hold id, updates the last hold id in the store, and returns it.
+func nextHoldID(store storetypes.KVStore) uint64 {
+	holdID := getLastHoldID(store) + 1
+	setLastHoldID(store, holdID)
+	return holdID
+}
+
+// GetLastHoldID gets the last hold id assigned to a hold entry.
+func (k Keeper) GetLastHoldID(ctx sdk.Context) uint64 {
+	return getLastHoldID(ctx.KVStore(k.storeKey))
+}
+
+// getHoldEntry gets (from the store) the hold entry with the provided id.
+// Returns nil, nil if the entry does not exist.
+func (k Keeper) getHoldEntry(store storetypes.KVStore, holdID uint64) (*hold.HoldEntry, error) {
+	bz := store.Get(CreateHoldEntryKey(holdID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv hold.HoldEntry
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("failed to read hold entry %d: %w", holdID, err)
+	}
+	return &rv, nil
+}
+
+// setHoldEntry writes the provided new hold entry (and its index entries) to the store,
+// and adds its funds to the address's hold entry totals. It does not change any hold coin amounts.
+func (k Keeper) setHoldEntry(store storetypes.KVStore, entry *hold.HoldEntry) error {
+	addr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return fmt.Errorf("invalid hold entry %d address %q: %w", entry.HoldId, entry.Address, err)
+	}
+	bz, err := k.cdc.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal hold entry %d: %w", entry.HoldId, err)
+	}
+	for _, coin := range entry.Amount {
+		total, err := k.getHoldEntryTotal(store, addr, coin.Denom)
+		if err != nil {
+			return fmt.Errorf("failed to get current %s hold entry total for %s: %w", coin.Denom, addr, err)
+		}
+		if err = k.setHoldEntryTotal(store, addr, coin.Denom, total.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+	store.Set(CreateHoldEntryKey(entry.HoldId), bz)
+	store.Set(CreateHoldEntryAddrIndexKey(addr, entry.HoldId), []byte{})
+	if entry.Expiration != nil {
+		store.Set(CreateHoldEntryExpirationIndexKey(*entry.Expiration, entry.HoldId), []byte{})
+	}
+	return nil
+}
+
+// deleteHoldEntry removes the provided hold entry (and its index entries) from the store,
+// and subtracts its funds from the address's hold entry totals. It does not change any hold coin amounts.
+func (k Keeper) deleteHoldEntry(store storetypes.KVStore, addr sdk.AccAddress, entry *hold.HoldEntry) error {
+	for _, coin := range entry.Amount {
+		total, err := k.getHoldEntryTotal(store, addr, coin.Denom)
+		if err != nil {
+			return fmt.Errorf("failed to get current %s hold entry total for %s: %w", coin.Denom, addr, err)
+		}
+		newTotal := total.Sub(coin.Amount)
+		if newTotal.IsNegative() {
+			return fmt.Errorf("cannot remove hold entry %d: account %s only has %s%s in hold entries but the entry has %s",
+				entry.HoldId, addr, total, coin.Denom, coin)
+		}
+		if err = k.setHoldEntryTotal(store, addr, coin.Denom, newTotal); err != nil {
+			return err
+		}
+	}
+	store.Delete(CreateHoldEntryKey(entry.HoldId))
+	store.Delete(CreateHoldEntryAddrIndexKey(addr, entry.HoldId))
+	if entry.Expiration != nil {
+		store.Delete(CreateHoldEntryExpirationIndexKey(*entry.Expiration, entry.HoldId))
+	}
+	return nil
+}
+
+// getHoldEntryTotal gets (from the store) the total amount of a denom in all the hold entries of an address.
+func (k Keeper) getHoldEntryTotal(store storetypes.KVStore, addr sdk.AccAddress, denom string) (sdkmath.Int, error) {
+	return UnmarshalHoldCoinValue(store.Get(CreateHoldEntryTotalKey(addr, denom)))
+}
+
+// setHoldEntryTotal updates the store with the total amount of a denom in all the hold entries of an address.
+// If the amount is zero, the total is deleted.
+func (k Keeper) setHoldEntryTotal(store storetypes.KVStore, addr sdk.AccAddress, denom string, amount sdkmath.Int) error {
+	key := CreateHoldEntryTotalKey(addr, denom)
+	if amount.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	amountBz, err := amount.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s%s hold entry total for %s: %w", amount, denom, addr, err)
+	}
+	store.Set(key, amountBz)
+	return nil
+}
+
+// AddHoldEntry puts the provided funds on hold for the provided account and records them in a new hold entry.
+// The holder should be the name of the module placing the hold; only that holder can release it (using ReleaseHoldByID).
+// The expiration is optional, but if provided, must be after the current block time.
+// If there is an expiration, the hold entry will be automatically released at the start of the first block at or after it.
+// So only provide an expiration if the funds are no longer needed after it (e.g. the holder's own record expires then too).
+// Returns the id of the new hold entry.
+func (k Keeper) AddHoldEntry(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string, expiration *time.Time) (uint64, error) {
+	if len(holder) == 0 {
+		return 0, fmt.Errorf("cannot create hold entry for %s: holder cannot be empty", addr)
+	}
+	if err := funds.Validate(); err != nil {
+		return 0, fmt.Errorf("cannot create hold entry for %s: invalid amount %q: %w", addr, funds, err)
+	}
+	if funds.IsZero() {
+		return 0, fmt.Errorf("cannot create hold entry for %s: amount cannot be zero", addr)
+	}
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return 0, fmt.Errorf("cannot create hold entry for %s: expiration %s must be after the current block time %s",
+			addr, expiration.UTC().Format(time.RFC3339Nano), ctx.BlockTime().UTC().Format(time.RFC3339Nano))
+	}
+
+	if err := k.ValidateNewHold(ctx, addr, funds); err != nil {
+		return 0, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if _, errs := k.increaseHoldCoinAmounts(store, addr, funds); len(errs) > 0 {
+		return 0, errors.Join(errs...)
+	}
+
+	entry := &hold.HoldEntry{
+		HoldId:     nextHoldID(store),
+		Address:    addr.String(),
+		Holder:     holder,
+		Reason:     reason,
+		Amount:     funds,
+		Expiration: expiration,
+	}
+	if err := k.setHoldEntry(store, entry); err != nil {
+		return 0, err
+	}
+
+	return entry.HoldId, ctx.EventManager().EmitTypedEvent(hold.NewEventHoldEntryAdded(entry))
+}
+
+// ReleaseHoldByID releases the hold entry with the provided id and the funds held by it.
+// The holder must be the same as the one that created the hold entry.
+func (k Keeper) ReleaseHoldByID(ctx sdk.Context, holder string, holdID uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	entry, err := k.getHoldEntry(store, holdID)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return fmt.Errorf("hold entry %d does not exist", holdID)
+	}
+	if entry.Holder != holder {
+		return fmt.Errorf("cannot release hold entry %d: it is held by %q, not %q", holdID, entry.Holder, holder)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return fmt.Errorf("invalid hold entry %d address %q: %w", holdID, entry.Address, err)
+	}
+
+	return k.releaseHoldEntry(ctx, store, addr, entry)
+}
+
+// releaseHoldEntry reduces the hold coin amounts by the amount in the provided entry, deletes the entry
+// and emits an event about it.
+func (k Keeper) releaseHoldEntry(ctx sdk.Context, store storetypes.KVStore, addr sdk.AccAddress, entry *hold.HoldEntry) error {
+	for _, toRelease := range entry.Amount {
+		onHold, err := k.getHoldCoinAmount(store, addr, toRelease.Denom)
+		if err != nil {
+			return fmt.Errorf("failed to get current %s hold amount for %s: %w", toRelease.Denom, addr, err)
+		}
+		newAmount := onHold.Sub(toRelease.Amount)
+		if newAmount.IsNegative() {
+			return fmt.Errorf("cannot release hold entry %d: account %s only has %s%s on hold but the entry has %s",
+				entry.HoldId, addr, onHold, toRelease.Denom, toRelease)
+		}
+		if err = k.setHoldCoinAmount(store, addr, toRelease.Denom, newAmount); err != nil {
+			return fmt.Errorf("failed to release %s from hold for %s: %w", toRelease, addr, err)
+		}
+	}
+
+	if err := k.deleteHoldEntry(store, addr, entry); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(hold.NewEventHoldEntryReleased(entry))
+}
+
+// GetHoldEntryByID gets the hold entry with the provided id.
+// Returns nil, nil if the entry does not exist.
+func (k Keeper) GetHoldEntryByID(ctx sdk.Context, holdID uint64) (*hold.HoldEntry, error) {
+	return k.getHoldEntry(ctx.KVStore(k.storeKey), holdID)
+}
+
+// IterateHoldEntries iterates over all the hold entries for a given account, ordered by hold id.
+// The process function should return whether to stop: false = keep iterating, true = stop.
+// If an error is encountered while reading from the store, that entry is skipped and an error is
+// returned for it when iteration is completed.
+func (k Keeper) IterateHoldEntries(ctx sdk.Context, addr sdk.AccAddress, process func(*hold.HoldEntry) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	pre := CreateHoldEntryAddrIndexPrefix(addr)
+
+	// Collect the ids first so that the process func is free to alter the entries.
+	var holdIDs []uint64
+	iter := storetypes.KVStorePrefixIterator(store, pre)
+	for ; iter.Valid(); iter.Next() {
+		_, holdID := ParseHoldEntryAddrIndexKey(iter.Key())
+		holdIDs = append(holdIDs, holdID)
+	}
+	iter.Close()
+
+	var errs []error
+	for _, holdID := range holdIDs {
+		entry, err := k.getHoldEntry(store, holdID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read hold entry for account %s: %w", addr, err))
+			continue
+		}
+		if entry == nil {
+			continue
+		}
+		if process(entry) {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetHoldEntries gets all the hold entries for a given account, ordered by hold id.
+func (k Keeper) GetHoldEntries(ctx sdk.Context, addr sdk.AccAddress) ([]*hold.HoldEntry, error) {
+	var rv []*hold.HoldEntry
+	err := k.IterateHoldEntries(ctx, addr, func(entry *hold.HoldEntry) bool {
+		rv = append(rv, entry)
+		return false
+	})
+	return rv, err
+}
+
+// GetHoldEntriesTotal gets the sum of the funds in all of the hold entries for a given account.
+// The totals are kept up to date as entries are added and released, so the entries themselves aren't read.
+func (k Keeper) GetHoldEntriesTotal(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), CreateHoldEntryTotalKeyAddrPrefix(addr))
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var rv sdk.Coins
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key())
+		amount, err := UnmarshalHoldCoinValue(iter.Value())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read hold entry total of %s for account %s: %w", denom, addr, err))
+			continue
+		}
+		rv = rv.Add(sdk.Coin{Denom: denom, Amount: amount})
+	}
+	return rv, errors.Join(errs...)
+}
+
+// IterateAllHoldEntries iterates over all hold entries for all accounts, ordered by hold id.
+// The process function should return whether to stop: false = keep iterating, true = stop.
+// If an error is encountered while reading from the store, that entry is skipped and an error is
+// returned for it when iteration is completed.
+func (k Keeper) IterateAllHoldEntries(ctx sdk.Context, process func(*hold.HoldEntry) bool) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), KeyPrefixHoldEntry)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		var entry hold.HoldEntry
+		if err := k.cdc.Unmarshal(iter.Value(), &entry); err != nil {
+			holdID := ParseHoldEntryKeyUnprefixed(iter.Key())
+			errs = append(errs, fmt.Errorf("failed to read hold entry %d: %w", holdID, err))
+			continue
+		}
+		if process(&entry) {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetAllHoldEntries gets all the hold entries currently in the state store.
+func (k Keeper) GetAllHoldEntries(ctx sdk.Context) ([]*hold.HoldEntry, error) {
+	var rv []*hold.HoldEntry
+	err := k.IterateAllHoldEntries(ctx, func(entry *hold.HoldEntry) bool {
+		rv = append(rv, entry)
+		return false
+	})
+	return rv, err
+}
+
+// getExpiredHoldIDs gets the ids of all hold entries that have an index entry indicating they might be expired.
+// The entries still need to be checked for expiration since the time index has a granularity of one second.
+func getExpiredHoldIDs(store storetypes.KVStore, blockTime time.Time) []uint64 {
+	// The end of an iterator is exclusive, so we look up to the next second.
+	iter := store.Iterator(KeyPrefixHoldEntryExpirationIndex, CreateHoldEntryExpirationIndexPrefixUpTo(blockTime.Add(time.Second)))
+	defer iter.Close()
+
+	var rv []uint64
+	for ; iter.Valid(); iter.Next() {
+		_, holdID := ParseHoldEntryExpirationIndexKey(iter.Key())
+		rv = append(rv, holdID)
+	}
+	return rv
+}
+
+// ReleaseExpiredHolds releases all hold entries that have an expiration at or before the current block time.
+// An EventHoldEntryReleased is emitted for each released hold entry.
+func (k Keeper) ReleaseExpiredHolds(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime()
+	holdIDs := getExpiredHoldIDs(store, blockTime)
+	if len(holdIDs) == 0 {
+		return
+	}
+
+	var errs []error
+	for _, holdID := range holdIDs {
+		entry, err := k.getHoldEntry(store, holdID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if entry == nil || entry.Expiration == nil || entry.Expiration.After(blockTime) {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid hold entry %d address %q: %w", holdID, entry.Address, err))
+			continue
+		}
+
+		// Using a cache context so that if there's a problem with one entry, it doesn't leave things half-done.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.releaseHoldEntry(cacheCtx, cacheCtx.KVStore(k.storeKey), addr, entry); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		writeCache()
+	}
+
+	if len(errs) > 0 {
+		ctx.Logger().With("module", "x/"+hold.ModuleName).Error(
+			fmt.Sprintf("%d error(s) encountered releasing expired holds:\n%v", len(errs), errors.Join(errs...)))
+	}
+}
+
+// GetUpcomingHoldReleases gets all the hold entries for a given account that have an expiration, ordered by expiration.
+func (k Keeper) GetUpcomingHoldReleases(ctx sdk.Context, addr sdk.AccAddress) ([]*hold.HoldEntry, error) {
+	var rv []*hold.HoldEntry
+	err := k.IterateHoldEntries(ctx, addr, func(entry *hold.HoldEntry) bool {
+		if entry.Expiration != nil {
+			rv = append(rv, entry)
+		}
+		return false
+	})
+	// The entries are already ordered by hold id, so a stable sort keeps that as the secondary ordering.
+	slices.SortStableFunc(rv, func(a, b *hold.HoldEntry) int {
+		return a.Expiration.Compare(*b.Expiration)
+	})
+	return rv, err
+}
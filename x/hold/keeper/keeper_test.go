@@ -164,6 +164,25 @@ func (s *TestSuite) requireFundAccount(addr sdk.AccAddress, coins string) {
 	}, "FundAccount(%s, %q)", s.getAddrName(addr), coins)
 }
 
+// requireAddHold calls AddHold, making sure it doesn't panic or return an error.
+func (s *TestSuite) requireAddHold(addr sdk.AccAddress, coins string) {
+	assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+		return s.keeper.AddHold(s.ctx, addr, s.coins(coins), "testing")
+	}, "AddHold(%s, %q)", s.getAddrName(addr), coins)
+}
+
+// requireAddHoldEntry calls AddHoldEntry, making sure it doesn't panic or return an error.
+// Returns the new hold id.
+func (s *TestSuite) requireAddHoldEntry(addr sdk.AccAddress, coins string, holder string) uint64 {
+	var rv uint64
+	assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+		var err error
+		rv, err = s.keeper.AddHoldEntry(s.ctx, addr, s.coins(coins), holder, "", nil)
+		return err
+	}, "AddHoldEntry(%s, %q, %q)", s.getAddrName(addr), coins, holder)
+	return rv
+}
+
 // assertEqualEvents asserts that the expected events equal the actual events.
 // Returns success (true = they're equal, false = they're different).
 func (s *TestSuite) assertEqualEvents(expected, actual sdk.Events, msgAndArgs ...interface{}) bool {
@@ -1316,6 +1335,429 @@ func (s *TestSuite) TestKeeper_GetAllAccountHolds() {
 	})
 }
 
+func (s *TestSuite) TestKeeper_AddHoldEntry() {
+	s.requireFundAccount(s.addr1, "99banana,53cactus")
+	blockTime := time.Date(2024, 3, 14, 12, 0, 0, 0, time.UTC)
+	future := blockTime.Add(time.Hour)
+	past := blockTime.Add(-1 * time.Second)
+
+	makeEvents := func(entry *hold.HoldEntry) sdk.Events {
+		event, err := sdk.TypedEventToEvent(hold.NewEventHoldEntryAdded(entry))
+		s.Require().NoError(err, "TypedEventToEvent EventHoldEntryAdded(%d)", entry.HoldId)
+		return sdk.Events{event}
+	}
+
+	// Tests are ordered since the hold ids and spendable balance depend on the previous state.
+	tests := []struct {
+		name       string
+		addr       sdk.AccAddress
+		funds      sdk.Coins
+		holder     string
+		reason     string
+		expiration *time.Time
+		expErr     []string
+		expEntry   *hold.HoldEntry
+		finalHold  sdk.Coins
+	}{
+		{
+			name:   "empty holder",
+			addr:   s.addr1,
+			funds:  s.coins("1banana"),
+			expErr: []string{"cannot create hold entry for " + s.addr1.String(), "holder cannot be empty"},
+		},
+		{
+			name:   "nil funds",
+			addr:   s.addr1,
+			holder: "testmod",
+			expErr: []string{"cannot create hold entry for " + s.addr1.String(), "amount cannot be zero"},
+		},
+		{
+			name:   "invalid funds",
+			addr:   s.addr1,
+			funds:  sdk.Coins{s.coin(0, "banana")},
+			holder: "testmod",
+			expErr: []string{"cannot create hold entry for " + s.addr1.String(), "invalid amount \"0banana\""},
+		},
+		{
+			name:       "expiration not after block time",
+			addr:       s.addr1,
+			funds:      s.coins("1banana"),
+			holder:     "testmod",
+			expiration: &past,
+			expErr: []string{
+				"cannot create hold entry for " + s.addr1.String(),
+				"expiration 2024-03-14T11:59:59Z must be after the current block time 2024-03-14T12:00:00Z",
+			},
+		},
+		{
+			name:   "insufficient spendable",
+			addr:   s.addr1,
+			funds:  s.coins("100banana"),
+			holder: "testmod",
+			expErr: []string{"spendable balance 99banana is less than hold amount 100banana"},
+		},
+		{
+			name:      "first entry",
+			addr:      s.addr1,
+			funds:     s.coins("90banana,3cactus"),
+			holder:    "testmod",
+			reason:    "the first one",
+			expEntry:  &hold.HoldEntry{HoldId: 1, Address: s.addr1.String(), Holder: "testmod", Reason: "the first one", Amount: s.coins("90banana,3cactus")},
+			finalHold: s.coins("90banana,3cactus"),
+		},
+		{
+			name:   "funds already in an entry are not spendable",
+			addr:   s.addr1,
+			funds:  s.coins("10banana"),
+			holder: "othermod",
+			expErr: []string{"spendable balance 9banana is less than hold amount 10banana"},
+		},
+		{
+			name:       "second entry with expiration",
+			addr:       s.addr1,
+			funds:      s.coins("9banana"),
+			holder:     "othermod",
+			reason:     "the second one",
+			expiration: &future,
+			expEntry: &hold.HoldEntry{HoldId: 2, Address: s.addr1.String(), Holder: "othermod", Reason: "the second one",
+				Amount: s.coins("9banana"), Expiration: &future},
+			finalHold: s.coins("99banana,3cactus"),
+		},
+		{
+			name:      "different address",
+			addr:      s.addr2,
+			funds:     sdk.NewCoins(s.coin(5, s.bondDenom)),
+			holder:    "testmod",
+			expEntry:  &hold.HoldEntry{HoldId: 3, Address: s.addr2.String(), Holder: "testmod", Amount: sdk.NewCoins(s.coin(5, s.bondDenom))},
+			finalHold: sdk.NewCoins(s.coin(5, s.bondDenom)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origHold, err := s.keeper.GetHoldCoins(s.ctx, tc.addr)
+			s.Require().NoError(err, "GetHoldCoins before AddHoldEntry")
+			if len(tc.expErr) > 0 {
+				tc.finalHold = origHold
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockTime(blockTime)
+			var holdID uint64
+			testFunc := func() {
+				holdID, err = s.keeper.AddHoldEntry(ctx, tc.addr, tc.funds, tc.holder, tc.reason, tc.expiration)
+			}
+			s.Require().NotPanics(testFunc, "AddHoldEntry")
+			s.assertErrorContents(err, tc.expErr, "AddHoldEntry error")
+
+			var expEvents sdk.Events
+			var expID uint64
+			if tc.expEntry != nil {
+				expEvents = makeEvents(tc.expEntry)
+				expID = tc.expEntry.HoldId
+			}
+			s.Assert().Equal(expID, holdID, "AddHoldEntry hold id")
+			s.assertEqualEvents(expEvents, em.Events(), "AddHoldEntry events")
+
+			finalHold, _ := s.keeper.GetHoldCoins(s.ctx, tc.addr)
+			s.Assert().Equal(tc.finalHold.String(), finalHold.String(), "final hold")
+
+			if tc.expEntry != nil {
+				entry, err := s.keeper.GetHoldEntryByID(s.ctx, holdID)
+				s.Require().NoError(err, "GetHoldEntryByID(%d)", holdID)
+				s.Assert().Equal(tc.expEntry, entry, "GetHoldEntryByID(%d)", holdID)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ReleaseHoldByID() {
+	s.requireFundAccount(s.addr1, "99banana,53cactus")
+	id1 := s.requireAddHoldEntry(s.addr1, "90banana,3cactus", "testmod")
+	id2 := s.requireAddHoldEntry(s.addr1, "9banana", "othermod")
+	s.requireAddHold(s.addr1, "50cactus")
+
+	makeEvents := func(entry *hold.HoldEntry) sdk.Events {
+		event, err := sdk.TypedEventToEvent(hold.NewEventHoldEntryReleased(entry))
+		s.Require().NoError(err, "TypedEventToEvent EventHoldEntryReleased(%d)", entry.HoldId)
+		return sdk.Events{event}
+	}
+
+	// Tests are ordered since the state depends on the previous state.
+	tests := []struct {
+		name           string
+		holder         string
+		holdID         uint64
+		expErr         string
+		expEntry       *hold.HoldEntry
+		finalHold      sdk.Coins
+		finalInEntries sdk.Coins
+	}{
+		{
+			name:           "unknown hold id",
+			holder:         "testmod",
+			holdID:         3,
+			expErr:         "hold entry 3 does not exist",
+			finalHold:      s.coins("99banana,53cactus"),
+			finalInEntries: s.coins("99banana,3cactus"),
+		},
+		{
+			name:           "wrong holder",
+			holder:         "testmod",
+			holdID:         id2,
+			expErr:         "cannot release hold entry 2: it is held by \"othermod\", not \"testmod\"",
+			finalHold:      s.coins("99banana,53cactus"),
+			finalInEntries: s.coins("99banana,3cactus"),
+		},
+		{
+			name:           "first entry",
+			holder:         "testmod",
+			holdID:         id1,
+			expEntry:       &hold.HoldEntry{HoldId: 1, Address: s.addr1.String(), Holder: "testmod", Amount: s.coins("90banana,3cactus")},
+			finalHold:      s.coins("9banana,50cactus"),
+			finalInEntries: s.coins("9banana"),
+		},
+		{
+			name:           "first entry again",
+			holder:         "testmod",
+			holdID:         id1,
+			expErr:         "hold entry 1 does not exist",
+			finalHold:      s.coins("9banana,50cactus"),
+			finalInEntries: s.coins("9banana"),
+		},
+		{
+			name:           "second entry",
+			holder:         "othermod",
+			holdID:         id2,
+			expEntry:       &hold.HoldEntry{HoldId: 2, Address: s.addr1.String(), Holder: "othermod", Amount: s.coins("9banana")},
+			finalHold:      s.coins("50cactus"),
+			finalInEntries: sdk.Coins{},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.keeper.ReleaseHoldByID(ctx, tc.holder, tc.holdID)
+			}
+			s.Require().NotPanics(testFunc, "ReleaseHoldByID")
+			s.assertErrorValue(err, tc.expErr, "ReleaseHoldByID error")
+
+			var expEvents sdk.Events
+			if tc.expEntry != nil {
+				expEvents = makeEvents(tc.expEntry)
+			}
+			s.assertEqualEvents(expEvents, em.Events(), "ReleaseHoldByID events")
+
+			finalHold, _ := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+			s.Assert().Equal(tc.finalHold.String(), finalHold.String(), "final hold")
+			finalInEntries, err := s.keeper.GetHoldEntriesTotal(s.ctx, s.addr1)
+			s.Assert().NoError(err, "GetHoldEntriesTotal error")
+			s.Assert().Equal(tc.finalInEntries.String(), finalInEntries.String(), "final hold entries total")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ReleaseHold_WithEntries() {
+	s.requireFundAccount(s.addr1, "99banana,53cactus")
+	s.requireAddHoldEntry(s.addr1, "90banana", "testmod")
+	s.requireAddHold(s.addr1, "5banana,3cactus")
+
+	s.Run("more than is outside of entries", func() {
+		err := s.keeper.ReleaseHold(s.ctx, s.addr1, s.coins("6banana"))
+		s.assertErrorValue(err, "cannot release 6banana from hold for "+s.addr1.String()+
+			": account only has 5banana on hold that is not in a hold entry", "ReleaseHold error")
+		finalHold, _ := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+		s.Assert().Equal("95banana,3cactus", finalHold.String(), "final hold")
+	})
+
+	s.Run("all that is outside of entries", func() {
+		err := s.keeper.ReleaseHold(s.ctx, s.addr1, s.coins("5banana,3cactus"))
+		s.Assert().NoError(err, "ReleaseHold error")
+		finalHold, _ := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+		s.Assert().Equal("90banana", finalHold.String(), "final hold")
+	})
+
+	s.Run("funds in an entry", func() {
+		err := s.keeper.ReleaseHold(s.ctx, s.addr1, s.coins("1banana"))
+		s.assertErrorValue(err, "cannot release 1banana from hold for "+s.addr1.String()+
+			": account only has 0banana on hold that is not in a hold entry", "ReleaseHold error")
+		finalHold, _ := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+		s.Assert().Equal("90banana", finalHold.String(), "final hold")
+	})
+}
+
+func (s *TestSuite) TestKeeper_GetHoldEntries() {
+	s.requireFundAccount(s.addr1, "99banana,53cactus")
+	s.requireFundAccount(s.addr2, "42banana")
+	s.requireAddHoldEntry(s.addr1, "10banana", "testmod")
+	s.requireAddHoldEntry(s.addr2, "2banana", "testmod")
+	s.requireAddHoldEntry(s.addr1, "20banana,3cactus", "othermod")
+	s.requireAddHold(s.addr1, "1banana")
+
+	entry1 := &hold.HoldEntry{HoldId: 1, Address: s.addr1.String(), Holder: "testmod", Amount: s.coins("10banana")}
+	entry2 := &hold.HoldEntry{HoldId: 2, Address: s.addr2.String(), Holder: "testmod", Amount: s.coins("2banana")}
+	entry3 := &hold.HoldEntry{HoldId: 3, Address: s.addr1.String(), Holder: "othermod", Amount: s.coins("20banana,3cactus")}
+
+	s.Run("GetHoldEntries addr1", func() {
+		entries, err := s.keeper.GetHoldEntries(s.ctx, s.addr1)
+		s.Assert().NoError(err, "GetHoldEntries error")
+		s.Assert().Equal([]*hold.HoldEntry{entry1, entry3}, entries, "GetHoldEntries result")
+	})
+
+	s.Run("GetHoldEntries addr3", func() {
+		entries, err := s.keeper.GetHoldEntries(s.ctx, s.addr3)
+		s.Assert().NoError(err, "GetHoldEntries error")
+		s.Assert().Empty(entries, "GetHoldEntries result")
+	})
+
+	s.Run("GetHoldEntriesTotal addr1", func() {
+		total, err := s.keeper.GetHoldEntriesTotal(s.ctx, s.addr1)
+		s.Assert().NoError(err, "GetHoldEntriesTotal error")
+		s.Assert().Equal("30banana,3cactus", total.String(), "GetHoldEntriesTotal result")
+	})
+
+	s.Run("GetAllHoldEntries", func() {
+		entries, err := s.keeper.GetAllHoldEntries(s.ctx)
+		s.Assert().NoError(err, "GetAllHoldEntries error")
+		s.Assert().Equal([]*hold.HoldEntry{entry1, entry2, entry3}, entries, "GetAllHoldEntries result")
+	})
+
+	s.Run("GetLastHoldID", func() {
+		s.Assert().Equal(3, int(s.keeper.GetLastHoldID(s.ctx)), "GetLastHoldID")
+	})
+
+	s.getStore().Set(keeper.CreateHoldEntryKey(2), []byte("not a hold entry"))
+
+	s.Run("GetAllHoldEntries with a bad entry", func() {
+		entries, err := s.keeper.GetAllHoldEntries(s.ctx)
+		s.assertErrorContents(err, []string{"failed to read hold entry 2"}, "GetAllHoldEntries error")
+		s.Assert().Equal([]*hold.HoldEntry{entry1, entry3}, entries, "GetAllHoldEntries result")
+	})
+}
+
+func (s *TestSuite) TestKeeper_ReleaseExpiredHolds() {
+	startTime := time.Date(2024, 3, 14, 12, 0, 0, 0, time.UTC)
+	exp1 := startTime.Add(10 * time.Second)
+	exp2 := startTime.Add(10*time.Second + 500*time.Millisecond)
+	exp3 := startTime.Add(20 * time.Second)
+
+	s.requireFundAccount(s.addr1, "99banana,53cactus")
+	s.requireFundAccount(s.addr2, "42banana")
+	ctx := s.ctx.WithBlockTime(startTime)
+	addEntry := func(addr sdk.AccAddress, coins string, expiration *time.Time) *hold.HoldEntry {
+		holdID, err := s.keeper.AddHoldEntry(ctx, addr, s.coins(coins), "testmod", "", expiration)
+		s.Require().NoError(err, "AddHoldEntry(%s, %q)", s.getAddrName(addr), coins)
+		return &hold.HoldEntry{HoldId: holdID, Address: addr.String(), Holder: "testmod", Amount: s.coins(coins), Expiration: expiration}
+	}
+	entry1 := addEntry(s.addr1, "10banana", &exp1)
+	entry2 := addEntry(s.addr2, "2banana", &exp2)
+	entry3 := addEntry(s.addr1, "3cactus", &exp3)
+	entry4 := addEntry(s.addr1, "4banana", nil)
+
+	makeEvents := func(entries ...*hold.HoldEntry) sdk.Events {
+		var rv sdk.Events
+		for _, entry := range entries {
+			event, err := sdk.TypedEventToEvent(hold.NewEventHoldEntryReleased(entry))
+			s.Require().NoError(err, "TypedEventToEvent EventHoldEntryReleased(%d)", entry.HoldId)
+			rv = append(rv, event)
+		}
+		return rv
+	}
+
+	s.Run("GetUpcomingHoldReleases before any are released", func() {
+		entries, err := s.keeper.GetUpcomingHoldReleases(s.ctx, s.addr1)
+		s.Assert().NoError(err, "GetUpcomingHoldReleases error")
+		s.Assert().Equal([]*hold.HoldEntry{entry1, entry3}, entries, "GetUpcomingHoldReleases result")
+	})
+
+	// Tests are ordered since the state depends on the previous state.
+	tests := []struct {
+		name       string
+		blockTime  time.Time
+		expEvents  sdk.Events
+		finalHold1 string
+		finalHold2 string
+	}{
+		{
+			name:       "before any expiration",
+			blockTime:  exp1.Add(-1 * time.Millisecond),
+			finalHold1: "14banana,3cactus",
+			finalHold2: "2banana",
+		},
+		{
+			name:       "same second as two but only one expired",
+			blockTime:  exp1.Add(100 * time.Millisecond),
+			expEvents:  makeEvents(entry1),
+			finalHold1: "4banana,3cactus",
+			finalHold2: "2banana",
+		},
+		{
+			name:       "at the last expiration",
+			blockTime:  exp3,
+			expEvents:  makeEvents(entry2, entry3),
+			finalHold1: "4banana",
+			finalHold2: "",
+		},
+		{
+			name:       "much later",
+			blockTime:  exp3.Add(time.Hour),
+			finalHold1: "4banana",
+			finalHold2: "",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			bctx := s.ctx.WithBlockTime(tc.blockTime).WithEventManager(em)
+			testFunc := func() {
+				s.keeper.ReleaseExpiredHolds(bctx)
+			}
+			s.Require().NotPanics(testFunc, "ReleaseExpiredHolds")
+			s.assertEqualEvents(tc.expEvents, em.Events(), "ReleaseExpiredHolds events")
+
+			finalHold1, _ := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+			s.Assert().Equal(tc.finalHold1, finalHold1.String(), "final hold addr1")
+			finalHold2, _ := s.keeper.GetHoldCoins(s.ctx, s.addr2)
+			s.Assert().Equal(tc.finalHold2, finalHold2.String(), "final hold addr2")
+		})
+	}
+
+	s.Run("remaining entries", func() {
+		entries, err := s.keeper.GetAllHoldEntries(s.ctx)
+		s.Assert().NoError(err, "GetAllHoldEntries error")
+		s.Assert().Equal([]*hold.HoldEntry{entry4}, entries, "GetAllHoldEntries result")
+
+		upcoming, err := s.keeper.GetUpcomingHoldReleases(s.ctx, s.addr1)
+		s.Assert().NoError(err, "GetUpcomingHoldReleases error")
+		s.Assert().Empty(upcoming, "GetUpcomingHoldReleases result")
+	})
+}
+
+func (s *TestSuite) TestKeeper_GetUpcomingHoldReleases() {
+	startTime := time.Date(2024, 3, 14, 12, 0, 0, 0, time.UTC)
+	exp1 := startTime.Add(time.Hour)
+	exp2 := startTime.Add(time.Minute)
+
+	s.requireFundAccount(s.addr1, "99banana")
+	ctx := s.ctx.WithBlockTime(startTime)
+	var entries []*hold.HoldEntry
+	for _, exp := range []*time.Time{&exp1, nil, &exp2, &exp1} {
+		holdID, err := s.keeper.AddHoldEntry(ctx, s.addr1, s.coins("1banana"), "testmod", "", exp)
+		s.Require().NoError(err, "AddHoldEntry")
+		entries = append(entries, &hold.HoldEntry{HoldId: holdID, Address: s.addr1.String(), Holder: "testmod", Amount: s.coins("1banana"), Expiration: exp})
+	}
+
+	expected := []*hold.HoldEntry{entries[2], entries[0], entries[3]}
+	actual, err := s.keeper.GetUpcomingHoldReleases(s.ctx, s.addr1)
+	s.Assert().NoError(err, "GetUpcomingHoldReleases error")
+	s.Assert().Equal(expected, actual, "GetUpcomingHoldReleases result")
+}
+
 func (s *TestSuite) TestVestingAndHoldOverTime() {
 	// This is a bit of a complex test that tracks a vesting account over time
 	// while adding, removing, delegating, undelegating, holding, and releasing funds.
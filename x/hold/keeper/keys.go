@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,9 +15,28 @@ import (
 //
 // Coin on hold:
 // - 0x00<addr len (1 byte)><addr><denom> -> <amount>
+//
+// Hold entries:
+// - 0x01<hold id (8 bytes)> -> protobuf(HoldEntry)
+// - 0x02<addr len (1 byte)><addr><hold id (8 bytes)> -> nil
+// - 0x04<expiration unix seconds (8 bytes)><hold id (8 bytes)> -> nil
+// - 0x05<addr len (1 byte)><addr><denom> -> <amount>
+//
+// Last hold id:
+// - 0x03 -> <hold id (8 bytes)>
 var (
 	// KeyPrefixHoldCoin is the prefix of a hold entry for an address and single denom.
 	KeyPrefixHoldCoin = []byte{0x00}
+	// KeyPrefixHoldEntry is the prefix of an itemized hold entry.
+	KeyPrefixHoldEntry = []byte{0x01}
+	// KeyPrefixHoldEntryAddrIndex is the prefix of an index entry linking an address to a hold entry.
+	KeyPrefixHoldEntryAddrIndex = []byte{0x02}
+	// KeyLastHoldID is the key of the last hold id assigned.
+	KeyLastHoldID = []byte{0x03}
+	// KeyPrefixHoldEntryExpirationIndex is the prefix of an index entry linking an expiration time to a hold entry.
+	KeyPrefixHoldEntryExpirationIndex = []byte{0x04}
+	// KeyPrefixHoldEntryTotal is the prefix of the total of a single denom in all the hold entries of an address.
+	KeyPrefixHoldEntryTotal = []byte{0x05}
 )
 
 // concatBzPlusCap creates a single byte slice consisting of the two provided byte slices with some extra capacity in the underlying array.
@@ -85,3 +107,91 @@ func UnmarshalHoldCoinValue(value []byte) (sdkmath.Int, error) {
 	}
 	return rv, nil
 }
+
+// uint64Bz converts the provided uint64 value to a big-endian byte slice of length 8.
+func uint64Bz(val uint64) []byte {
+	rv := make([]byte, 8)
+	binary.BigEndian.PutUint64(rv, val)
+	return rv
+}
+
+// CreateHoldEntryKey creates the key for a hold entry with the provided id.
+func CreateHoldEntryKey(holdID uint64) []byte {
+	return concatBzPlusCap(KeyPrefixHoldEntry, uint64Bz(holdID), 0)
+}
+
+// ParseHoldEntryKey parses a full hold entry key into its hold id.
+func ParseHoldEntryKey(key []byte) uint64 {
+	return ParseHoldEntryKeyUnprefixed(key[1:])
+}
+
+// ParseHoldEntryKeyUnprefixed parses a hold entry key without the type prefix into its hold id.
+func ParseHoldEntryKeyUnprefixed(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key)
+}
+
+// CreateHoldEntryAddrIndexPrefix creates a hold entry address index key prefix containing the provided address.
+// It's useful for iterating over all hold entries for an address.
+func CreateHoldEntryAddrIndexPrefix(addr sdk.AccAddress) []byte {
+	return concatBzPlusCap(KeyPrefixHoldEntryAddrIndex, address.MustLengthPrefix(addr), 8)
+}
+
+// CreateHoldEntryAddrIndexKey creates a hold entry address index key for the provided address and hold id.
+func CreateHoldEntryAddrIndexKey(addr sdk.AccAddress, holdID uint64) []byte {
+	rv := CreateHoldEntryAddrIndexPrefix(addr)
+	rv = append(rv, uint64Bz(holdID)...)
+	return rv
+}
+
+// ParseHoldEntryAddrIndexKey parses a full hold entry address index key into its address and hold id.
+func ParseHoldEntryAddrIndexKey(key []byte) (sdk.AccAddress, uint64) {
+	addr, idBz := parseLengthPrefixedBz(key[1:])
+	return addr, binary.BigEndian.Uint64(idBz)
+}
+
+// CreateHoldEntryExpirationIndexPrefixUpTo creates a hold entry expiration index key prefix that can be used as
+// the (exclusive) end of an iterator over all hold entries that expire before the provided time's second.
+func CreateHoldEntryExpirationIndexPrefixUpTo(upTo time.Time) []byte {
+	return concatBzPlusCap(KeyPrefixHoldEntryExpirationIndex, uint64Bz(uint64(upTo.Unix())), 0) //nolint:gosec // G115: Negative times are not expected.
+}
+
+// CreateHoldEntryExpirationIndexKey creates a hold entry expiration index key for the provided expiration and hold id.
+func CreateHoldEntryExpirationIndexKey(expiration time.Time, holdID uint64) []byte {
+	rv := concatBzPlusCap(KeyPrefixHoldEntryExpirationIndex, uint64Bz(uint64(expiration.Unix())), 8) //nolint:gosec // G115: Negative times are not expected.
+	rv = append(rv, uint64Bz(holdID)...)
+	return rv
+}
+
+// ParseHoldEntryExpirationIndexKey parses a full hold entry expiration index key into its expiration and hold id.
+// The expiration only has a granularity of one second.
+func ParseHoldEntryExpirationIndexKey(key []byte) (time.Time, uint64) {
+	expiration := time.Unix(int64(binary.BigEndian.Uint64(key[1:9])), 0).UTC() //nolint:gosec // G115: Negative times are not expected.
+	return expiration, binary.BigEndian.Uint64(key[9:])
+}
+
+// CreateHoldEntryTotalKeyAddrPrefix creates a hold entry total key prefix containing the provided address.
+// It's useful for iterating over all hold entry totals for an address.
+func CreateHoldEntryTotalKeyAddrPrefix(addr sdk.AccAddress) []byte {
+	return concatBzPlusCap(KeyPrefixHoldEntryTotal, address.MustLengthPrefix(addr), 0)
+}
+
+// CreateHoldEntryTotalKey creates a hold entry total key for the provided address and denom.
+func CreateHoldEntryTotalKey(addr sdk.AccAddress, denom string) []byte {
+	rv := concatBzPlusCap(KeyPrefixHoldEntryTotal, address.MustLengthPrefix(addr), len(denom))
+	rv = append(rv, []byte(denom)...)
+	return rv
+}
+
+// ParseHoldEntryTotalKey parses a full hold entry total key into its address and denom.
+func ParseHoldEntryTotalKey(key []byte) (sdk.AccAddress, string) {
+	addr, denom := parseLengthPrefixedBz(key[1:])
+	return addr, string(denom)
+}
+
+// UnmarshalLastHoldIDValue parses the store value of the last hold id back into a uint64.
+func UnmarshalLastHoldIDValue(value []byte) uint64 {
+	if len(value) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(value)
+}
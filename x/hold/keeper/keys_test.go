@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -295,3 +296,217 @@ func TestUnmarshalHoldCoinValue(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateHoldEntryKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		holdID uint64
+		exp    []byte
+	}{
+		{name: "zero", holdID: 0, exp: []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{name: "one", holdID: 1, exp: []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 1}},
+		{name: "257", holdID: 257, exp: []byte{0x01, 0, 0, 0, 0, 0, 0, 1, 1}},
+		{name: "max uint64", holdID: 18446744073709551615, exp: []byte{0x01, 255, 255, 255, 255, 255, 255, 255, 255}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var key []byte
+			testFunc := func() {
+				key = keeper.CreateHoldEntryKey(tc.holdID)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryKey")
+			assert.Equal(t, tc.exp, key, "CreateHoldEntryKey result")
+
+			var holdID uint64
+			testFunc = func() {
+				holdID = keeper.ParseHoldEntryKey(key)
+			}
+			require.NotPanics(t, testFunc, "ParseHoldEntryKey")
+			assert.Equal(t, tc.holdID, holdID, "ParseHoldEntryKey result")
+		})
+	}
+}
+
+func TestCreateHoldEntryAddrIndexKey(t *testing.T) {
+	addr20 := sdk.AccAddress("addr_with_20_bytes__")
+	addr32 := sdk.AccAddress("longer__address__with__32__bytes")
+
+	tests := []struct {
+		name      string
+		addr      sdk.AccAddress
+		holdID    uint64
+		expPrefix []byte
+		exp       []byte
+	}{
+		{
+			name:      "20 byte address",
+			addr:      addr20,
+			holdID:    3,
+			expPrefix: concatBzs([]byte{0x02, 20}, addr20),
+			exp:       concatBzs([]byte{0x02, 20}, addr20, []byte{0, 0, 0, 0, 0, 0, 0, 3}),
+		},
+		{
+			name:      "32 byte address",
+			addr:      addr32,
+			holdID:    65536,
+			expPrefix: concatBzs([]byte{0x02, 32}, addr32),
+			exp:       concatBzs([]byte{0x02, 32}, addr32, []byte{0, 0, 0, 0, 0, 1, 0, 0}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var prefix, key []byte
+			testFunc := func() {
+				prefix = keeper.CreateHoldEntryAddrIndexPrefix(tc.addr)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryAddrIndexPrefix")
+			assert.Equal(t, tc.expPrefix, prefix, "CreateHoldEntryAddrIndexPrefix result")
+
+			testFunc = func() {
+				key = keeper.CreateHoldEntryAddrIndexKey(tc.addr, tc.holdID)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryAddrIndexKey")
+			assert.Equal(t, tc.exp, key, "CreateHoldEntryAddrIndexKey result")
+
+			var addr sdk.AccAddress
+			var holdID uint64
+			testFunc = func() {
+				addr, holdID = keeper.ParseHoldEntryAddrIndexKey(key)
+			}
+			require.NotPanics(t, testFunc, "ParseHoldEntryAddrIndexKey")
+			assert.Equal(t, tc.addr, addr, "ParseHoldEntryAddrIndexKey address")
+			assert.Equal(t, tc.holdID, holdID, "ParseHoldEntryAddrIndexKey hold id")
+		})
+	}
+}
+
+func TestCreateHoldEntryTotalKey(t *testing.T) {
+	addr20 := sdk.AccAddress("addr_with_20_bytes__")
+	addr32 := sdk.AccAddress("longer__address__with__32__bytes")
+
+	tests := []struct {
+		name      string
+		addr      sdk.AccAddress
+		denom     string
+		expPrefix []byte
+		exp       []byte
+	}{
+		{
+			name:      "20 byte address",
+			addr:      addr20,
+			denom:     "foocoin",
+			expPrefix: concatBzs([]byte{0x05, 20}, addr20),
+			exp:       concatBzs([]byte{0x05, 20}, addr20, []byte("foocoin")),
+		},
+		{
+			name:      "32 byte address",
+			addr:      addr32,
+			denom:     "barcoin",
+			expPrefix: concatBzs([]byte{0x05, 32}, addr32),
+			exp:       concatBzs([]byte{0x05, 32}, addr32, []byte("barcoin")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var prefix, key []byte
+			testFunc := func() {
+				prefix = keeper.CreateHoldEntryTotalKeyAddrPrefix(tc.addr)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryTotalKeyAddrPrefix")
+			assert.Equal(t, tc.expPrefix, prefix, "CreateHoldEntryTotalKeyAddrPrefix result")
+
+			testFunc = func() {
+				key = keeper.CreateHoldEntryTotalKey(tc.addr, tc.denom)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryTotalKey")
+			assert.Equal(t, tc.exp, key, "CreateHoldEntryTotalKey result")
+
+			var addr sdk.AccAddress
+			var denom string
+			testFunc = func() {
+				addr, denom = keeper.ParseHoldEntryTotalKey(key)
+			}
+			require.NotPanics(t, testFunc, "ParseHoldEntryTotalKey")
+			assert.Equal(t, tc.addr, addr, "ParseHoldEntryTotalKey address")
+			assert.Equal(t, tc.denom, denom, "ParseHoldEntryTotalKey denom")
+		})
+	}
+}
+
+func TestCreateHoldEntryExpirationIndexKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		holdID     uint64
+		expPrefix  []byte
+		exp        []byte
+	}{
+		{
+			name:       "epoch",
+			expiration: time.Unix(0, 0).UTC(),
+			holdID:     1,
+			expPrefix:  []byte{0x04, 0, 0, 0, 0, 0, 0, 0, 0},
+			exp:        []byte{0x04, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "sub-second time",
+			expiration: time.Unix(258, 999_999_999).UTC(),
+			holdID:     257,
+			expPrefix:  []byte{0x04, 0, 0, 0, 0, 0, 0, 1, 2},
+			exp:        []byte{0x04, 0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0, 1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var prefix, key []byte
+			testFunc := func() {
+				prefix = keeper.CreateHoldEntryExpirationIndexPrefixUpTo(tc.expiration)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryExpirationIndexPrefixUpTo")
+			assert.Equal(t, tc.expPrefix, prefix, "CreateHoldEntryExpirationIndexPrefixUpTo result")
+
+			testFunc = func() {
+				key = keeper.CreateHoldEntryExpirationIndexKey(tc.expiration, tc.holdID)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryExpirationIndexKey")
+			assert.Equal(t, tc.exp, key, "CreateHoldEntryExpirationIndexKey result")
+
+			var expiration time.Time
+			var holdID uint64
+			testFunc = func() {
+				expiration, holdID = keeper.ParseHoldEntryExpirationIndexKey(key)
+			}
+			require.NotPanics(t, testFunc, "ParseHoldEntryExpirationIndexKey")
+			assert.Equal(t, tc.expiration.Truncate(time.Second), expiration, "ParseHoldEntryExpirationIndexKey expiration")
+			assert.Equal(t, tc.holdID, holdID, "ParseHoldEntryExpirationIndexKey hold id")
+		})
+	}
+}
+
+func TestUnmarshalLastHoldIDValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value []byte
+		exp   uint64
+	}{
+		{name: "nil", value: nil, exp: 0},
+		{name: "too short", value: []byte{1, 2, 3}, exp: 0},
+		{name: "one", value: []byte{0, 0, 0, 0, 0, 0, 0, 1}, exp: 1},
+		{name: "larger", value: []byte{0, 0, 0, 1, 0, 0, 0, 2}, exp: 4294967298},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual uint64
+			testFunc := func() {
+				actual = keeper.UnmarshalLastHoldIDValue(tc.value)
+			}
+			require.NotPanics(t, testFunc, "UnmarshalLastHoldIDValue")
+			assert.Equal(t, tc.exp, actual, "UnmarshalLastHoldIDValue result")
+		})
+	}
+}
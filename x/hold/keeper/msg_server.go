@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// MsgServer is an alias for a Keeper that implements the hold.MsgServer interface.
+type MsgServer struct {
+	Keeper
+}
+
+func NewMsgServer(k Keeper) hold.MsgServer {
+	return MsgServer{
+		Keeper: k,
+	}
+}
+
+var _ hold.MsgServer = MsgServer{}
+
+// CreateTimedHold puts funds on hold until a specific time, at which point they are automatically released.
+func (k MsgServer) CreateTimedHold(goCtx context.Context, msg *hold.MsgCreateTimedHoldRequest) (*hold.MsgCreateTimedHoldResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	releaseTime := msg.ReleaseTime
+	holdID, err := k.AddHoldEntry(ctx, addr, msg.Amount, hold.ModuleName, msg.Reason, &releaseTime)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &hold.MsgCreateTimedHoldResponse{HoldId: holdID}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+	"github.com/provenance-io/provenance/x/hold/keeper"
+)
+
+func (s *TestSuite) TestMsgServer_CreateTimedHold() {
+	blockTime := time.Date(2024, 3, 14, 12, 0, 0, 0, time.UTC)
+	releaseTime := blockTime.Add(24 * time.Hour)
+	s.requireFundAccount(s.addr1, "99banana")
+
+	tests := []struct {
+		name     string
+		msg      *hold.MsgCreateTimedHoldRequest
+		expErr   string
+		expResp  *hold.MsgCreateTimedHoldResponse
+		expEntry *hold.HoldEntry
+	}{
+		{
+			name:   "release time in the past",
+			msg:    &hold.MsgCreateTimedHoldRequest{Address: s.addr1.String(), Amount: s.coins("5banana"), ReleaseTime: blockTime},
+			expErr: "cannot create hold entry for " + s.addr1.String() + ": expiration 2024-03-14T12:00:00Z must be after the current block time 2024-03-14T12:00:00Z: invalid request",
+		},
+		{
+			name:   "insufficient funds",
+			msg:    &hold.MsgCreateTimedHoldRequest{Address: s.addr1.String(), Amount: s.coins("100banana"), ReleaseTime: releaseTime},
+			expErr: "spendable balance 99banana is less than hold amount 100banana",
+		},
+		{
+			name:    "okay",
+			msg:     &hold.MsgCreateTimedHoldRequest{Address: s.addr1.String(), Amount: s.coins("5banana"), ReleaseTime: releaseTime, Reason: "vesting"},
+			expResp: &hold.MsgCreateTimedHoldResponse{HoldId: 1},
+			expEntry: &hold.HoldEntry{
+				HoldId:     1,
+				Address:    s.addr1.String(),
+				Holder:     hold.ModuleName,
+				Reason:     "vesting",
+				Amount:     s.coins("5banana"),
+				Expiration: &releaseTime,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithBlockTime(blockTime)
+			msgServer := keeper.NewMsgServer(s.keeper)
+			var resp *hold.MsgCreateTimedHoldResponse
+			var err error
+			testFunc := func() {
+				resp, err = msgServer.CreateTimedHold(ctx, tc.msg)
+			}
+			s.Require().NotPanics(testFunc, "CreateTimedHold")
+			if len(tc.expErr) > 0 {
+				s.assertErrorContents(err, []string{tc.expErr}, "CreateTimedHold error")
+			} else {
+				s.Assert().NoError(err, "CreateTimedHold error")
+			}
+			s.Assert().Equal(tc.expResp, resp, "CreateTimedHold response")
+
+			if tc.expEntry != nil {
+				entry, err := s.keeper.GetHoldEntryByID(s.ctx, tc.expEntry.HoldId)
+				s.Assert().NoError(err, "GetHoldEntryByID(%d) error", tc.expEntry.HoldId)
+				s.Assert().Equal(tc.expEntry, entry, "GetHoldEntryByID(%d) result", tc.expEntry.HoldId)
+
+				upcoming, err := s.keeper.GetUpcomingHoldReleases(s.ctx, sdk.MustAccAddressFromBech32(tc.msg.Address))
+				s.Assert().NoError(err, "GetUpcomingHoldReleases error")
+				s.Assert().Equal([]*hold.HoldEntry{tc.expEntry}, upcoming, "GetUpcomingHoldReleases result")
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package hold
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllRequestMsgs defines all the Msg*Request messages.
+var AllRequestMsgs = []sdk.Msg{
+	(*MsgCreateTimedHoldRequest)(nil),
+}
+
+func (m MsgCreateTimedHoldRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		errs = append(errs, fmt.Errorf("invalid address %q: %w", m.Address, err))
+	}
+
+	if m.Amount.IsZero() {
+		errs = append(errs, fmt.Errorf("invalid amount %q: cannot be zero", m.Amount))
+	} else if err := m.Amount.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid amount %q: %w", m.Amount, err))
+	}
+
+	if m.ReleaseTime.IsZero() {
+		errs = append(errs, errors.New("invalid release time: cannot be zero"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,95 @@
+package hold_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil"
+	"github.com/provenance-io/provenance/testutil/assertions"
+
+	. "github.com/provenance-io/provenance/x/hold"
+)
+
+func TestAllMsgsGetSigners(t *testing.T) {
+	msgMakers := []testutil.MsgMaker{
+		func(signer string) sdk.Msg { return &MsgCreateTimedHoldRequest{Address: signer} },
+	}
+
+	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
+}
+
+func TestMsgCreateTimedHoldRequest_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	releaseTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	coins := func(coins string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(coins)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", coins)
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateTimedHoldRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgCreateTimedHoldRequest{Address: addr, Amount: coins("5banana"), ReleaseTime: releaseTime, Reason: "because"},
+		},
+		{
+			name:   "empty address",
+			msg:    MsgCreateTimedHoldRequest{Address: "", Amount: coins("5banana"), ReleaseTime: releaseTime},
+			expErr: []string{`invalid address "": empty address string is not allowed`},
+		},
+		{
+			name:   "bad address",
+			msg:    MsgCreateTimedHoldRequest{Address: "bad", Amount: coins("5banana"), ReleaseTime: releaseTime},
+			expErr: []string{`invalid address "bad": decoding bech32 failed`},
+		},
+		{
+			name:   "nil amount",
+			msg:    MsgCreateTimedHoldRequest{Address: addr, Amount: nil, ReleaseTime: releaseTime},
+			expErr: []string{`invalid amount "": cannot be zero`},
+		},
+		{
+			name: "invalid amount",
+			msg: MsgCreateTimedHoldRequest{
+				Address:     addr,
+				Amount:      sdk.Coins{sdk.Coin{Denom: "banana", Amount: sdkmath.NewInt(-3)}},
+				ReleaseTime: releaseTime,
+			},
+			expErr: []string{`invalid amount "-3banana": coin -3banana amount is not positive`},
+		},
+		{
+			name:   "zero release time",
+			msg:    MsgCreateTimedHoldRequest{Address: addr, Amount: coins("5banana")},
+			expErr: []string{"invalid release time: cannot be zero"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgCreateTimedHoldRequest{},
+			expErr: []string{
+				"invalid address",
+				"invalid amount",
+				"invalid release time",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.msg.ValidateBasic()
+			}
+			require.NotPanics(t, testFunc, "ValidateBasic")
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic error")
+		})
+	}
+}
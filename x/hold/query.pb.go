@@ -76,6 +76,9 @@ var xxx_messageInfo_GetHoldsRequest proto.InternalMessageInfo
 type GetHoldsResponse struct {
 	// amount is the total on hold for the requested address.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// entries are the itemized hold entries for the requested address.
+	// Any part of the amount that is not in one of these entries was placed on hold without a hold entry.
+	Entries []*HoldEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
 func (m *GetHoldsResponse) Reset()         { *m = GetHoldsResponse{} }
@@ -205,51 +208,249 @@ func (m *GetAllHoldsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// GetHoldEntryRequest is the request type for the Query/GetHoldEntry query.
+type GetHoldEntryRequest struct {
+	// hold_id is the unique identifier of the hold entry to look up.
+	HoldId uint64 `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+}
+
+func (m *GetHoldEntryRequest) Reset()         { *m = GetHoldEntryRequest{} }
+func (m *GetHoldEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHoldEntryRequest) ProtoMessage()    {}
+func (*GetHoldEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{4}
+}
+func (m *GetHoldEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHoldEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHoldEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHoldEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHoldEntryRequest.Merge(m, src)
+}
+func (m *GetHoldEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHoldEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHoldEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHoldEntryRequest proto.InternalMessageInfo
+
+func (m *GetHoldEntryRequest) GetHoldId() uint64 {
+	if m != nil {
+		return m.HoldId
+	}
+	return 0
+}
+
+// GetHoldEntryResponse is the response type for the Query/GetHoldEntry query.
+type GetHoldEntryResponse struct {
+	// entry is the requested hold entry.
+	Entry *HoldEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (m *GetHoldEntryResponse) Reset()         { *m = GetHoldEntryResponse{} }
+func (m *GetHoldEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHoldEntryResponse) ProtoMessage()    {}
+func (*GetHoldEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{5}
+}
+func (m *GetHoldEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHoldEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHoldEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHoldEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHoldEntryResponse.Merge(m, src)
+}
+func (m *GetHoldEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHoldEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHoldEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHoldEntryResponse proto.InternalMessageInfo
+
+func (m *GetHoldEntryResponse) GetEntry() *HoldEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+// GetUpcomingReleasesRequest is the request type for the Query/GetUpcomingReleases query.
+type GetUpcomingReleasesRequest struct {
+	// address is the account address to get the upcoming releases for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetUpcomingReleasesRequest) Reset()         { *m = GetUpcomingReleasesRequest{} }
+func (m *GetUpcomingReleasesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUpcomingReleasesRequest) ProtoMessage()    {}
+func (*GetUpcomingReleasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{6}
+}
+func (m *GetUpcomingReleasesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUpcomingReleasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUpcomingReleasesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetUpcomingReleasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUpcomingReleasesRequest.Merge(m, src)
+}
+func (m *GetUpcomingReleasesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUpcomingReleasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUpcomingReleasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUpcomingReleasesRequest proto.InternalMessageInfo
+
+func (m *GetUpcomingReleasesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// GetUpcomingReleasesResponse is the response type for the Query/GetUpcomingReleases query.
+type GetUpcomingReleasesResponse struct {
+	// entries are the hold entries for the address that have a release time, ordered by release time.
+	Entries []*HoldEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *GetUpcomingReleasesResponse) Reset()         { *m = GetUpcomingReleasesResponse{} }
+func (m *GetUpcomingReleasesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUpcomingReleasesResponse) ProtoMessage()    {}
+func (*GetUpcomingReleasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{7}
+}
+func (m *GetUpcomingReleasesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUpcomingReleasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUpcomingReleasesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetUpcomingReleasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUpcomingReleasesResponse.Merge(m, src)
+}
+func (m *GetUpcomingReleasesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUpcomingReleasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUpcomingReleasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUpcomingReleasesResponse proto.InternalMessageInfo
+
+func (m *GetUpcomingReleasesResponse) GetEntries() []*HoldEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetHoldsRequest)(nil), "provenance.hold.v1.GetHoldsRequest")
 	proto.RegisterType((*GetHoldsResponse)(nil), "provenance.hold.v1.GetHoldsResponse")
 	proto.RegisterType((*GetAllHoldsRequest)(nil), "provenance.hold.v1.GetAllHoldsRequest")
 	proto.RegisterType((*GetAllHoldsResponse)(nil), "provenance.hold.v1.GetAllHoldsResponse")
+	proto.RegisterType((*GetHoldEntryRequest)(nil), "provenance.hold.v1.GetHoldEntryRequest")
+	proto.RegisterType((*GetHoldEntryResponse)(nil), "provenance.hold.v1.GetHoldEntryResponse")
+	proto.RegisterType((*GetUpcomingReleasesRequest)(nil), "provenance.hold.v1.GetUpcomingReleasesRequest")
+	proto.RegisterType((*GetUpcomingReleasesResponse)(nil), "provenance.hold.v1.GetUpcomingReleasesResponse")
 }
 
 func init() { proto.RegisterFile("provenance/hold/v1/query.proto", fileDescriptor_e41c9f383440a9df) }
 
 var fileDescriptor_e41c9f383440a9df = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0xfd, 0xa9, 0xfd, 0x95, 0x0b, 0x12, 0x70, 0x80, 0x94, 0x06, 0x70, 0x42, 0x0a,
-	0x6d, 0x14, 0xd4, 0x3b, 0x25, 0xa8, 0x0b, 0x5b, 0x8b, 0xd4, 0x30, 0x96, 0x8c, 0x48, 0x08, 0x5d,
-	0xec, 0xab, 0x6b, 0xe1, 0xdc, 0xe3, 0xe6, 0xce, 0x11, 0x11, 0x62, 0xa0, 0x13, 0x63, 0x25, 0x84,
-	0x90, 0x98, 0x3a, 0x22, 0xa6, 0xbe, 0x8c, 0x8e, 0x95, 0x58, 0x98, 0x00, 0x25, 0x48, 0xe5, 0x65,
-	0x20, 0xdb, 0x67, 0xc5, 0x29, 0x2e, 0x5d, 0xec, 0xb3, 0x9f, 0xef, 0xe3, 0xef, 0xe7, 0xf9, 0x63,
-	0x6c, 0x87, 0x03, 0x18, 0x0a, 0xc9, 0xa5, 0x23, 0xd8, 0x2e, 0x04, 0x2e, 0x1b, 0xb6, 0xd8, 0x5e,
-	0x24, 0x06, 0x23, 0x1a, 0x0e, 0x40, 0x03, 0x21, 0xd3, 0x38, 0x8d, 0xe3, 0x74, 0xd8, 0xaa, 0x5c,
-	0xe3, 0x7d, 0x5f, 0x02, 0x4b, 0xae, 0xa9, 0xac, 0xd2, 0x74, 0x40, 0xf5, 0x41, 0xb1, 0x1e, 0x57,
-	0x22, 0xcd, 0x67, 0xc3, 0x56, 0x4f, 0x68, 0xde, 0x62, 0x21, 0xf7, 0x7c, 0xc9, 0xb5, 0x0f, 0xd2,
-	0x68, 0xed, 0xbc, 0x36, 0x53, 0x39, 0xe0, 0x67, 0xf1, 0x1b, 0x1e, 0x78, 0x90, 0x1c, 0x59, 0x7c,
-	0x32, 0x6f, 0x6f, 0x7b, 0x00, 0x5e, 0x20, 0x18, 0x0f, 0x7d, 0xc6, 0xa5, 0x04, 0x9d, 0x7c, 0x52,
-	0x99, 0xe8, 0x9d, 0x82, 0x32, 0x12, 0xdc, 0x24, 0x5c, 0x5f, 0xc7, 0x57, 0x3a, 0x42, 0x3f, 0x81,
-	0xc0, 0x55, 0x5d, 0xb1, 0x17, 0x09, 0xa5, 0x49, 0x19, 0xff, 0xcf, 0x5d, 0x77, 0x20, 0x94, 0x2a,
-	0xa3, 0x1a, 0x6a, 0x5c, 0xea, 0x66, 0x8f, 0x8f, 0x16, 0xdf, 0x1d, 0x56, 0xad, 0xdf, 0x87, 0x55,
-	0xab, 0xfe, 0x11, 0xe1, 0xab, 0xd3, 0x3c, 0x15, 0x82, 0x54, 0x82, 0x8c, 0xf0, 0x02, 0xef, 0x43,
-	0x24, 0x75, 0x19, 0xd5, 0xfe, 0x6b, 0x94, 0xda, 0x4b, 0x34, 0xad, 0x87, 0xc6, 0xf5, 0x50, 0x53,
-	0x0f, 0x7d, 0x0c, 0xbe, 0xdc, 0xdc, 0x3a, 0xfe, 0x5e, 0xb5, 0xbe, 0xfc, 0xa8, 0x36, 0x3c, 0x5f,
-	0xef, 0x46, 0x3d, 0xea, 0x40, 0x9f, 0x99, 0xe2, 0xd3, 0xdb, 0x9a, 0x72, 0x5f, 0x32, 0x3d, 0x0a,
-	0x85, 0x4a, 0x12, 0xd4, 0xa7, 0xd3, 0xa3, 0xe6, 0xe5, 0x40, 0x78, 0xdc, 0x19, 0xbd, 0x88, 0x3b,
-	0xa2, 0x3e, 0x9f, 0x1e, 0x35, 0x51, 0xd7, 0x18, 0xe6, 0xc8, 0x76, 0x30, 0xe9, 0x08, 0xbd, 0x11,
-	0x04, 0x33, 0x35, 0x6d, 0x61, 0x3c, 0xed, 0x76, 0xd9, 0xa9, 0xa1, 0x46, 0xa9, 0xbd, 0x32, 0x83,
-	0x97, 0x8e, 0x36, 0x83, 0xdc, 0xe6, 0x9e, 0x30, 0xb9, 0xdd, 0x5c, 0x66, 0xce, 0xe7, 0x03, 0xc2,
-	0xd7, 0x67, 0x8c, 0x4c, 0x13, 0xd6, 0xf1, 0x7c, 0xdc, 0x5e, 0x65, 0x7a, 0x50, 0xa5, 0x7f, 0xaf,
-	0x09, 0xdd, 0x70, 0x9c, 0x98, 0x3a, 0x4e, 0xec, 0xa6, 0x6a, 0xd2, 0x29, 0x00, 0x5c, 0xbd, 0x10,
-	0x30, 0xf5, 0xcc, 0x13, 0xb6, 0x0f, 0xe6, 0xf0, 0xfc, 0xd3, 0x58, 0x4a, 0xf6, 0x11, 0x5e, 0xcc,
-	0x66, 0x44, 0x96, 0x8b, 0x38, 0xce, 0x4c, 0xbe, 0x72, 0xef, 0xdf, 0xa2, 0xd4, 0xad, 0xfe, 0x60,
-	0xff, 0xeb, 0xaf, 0xf7, 0x73, 0xf7, 0xc9, 0x32, 0x2b, 0x58, 0xad, 0x9d, 0x48, 0xba, 0x8a, 0xbd,
-	0x36, 0x1b, 0xf3, 0x86, 0xbc, 0x45, 0xb8, 0x94, 0x6b, 0x13, 0x59, 0x39, 0xc7, 0xe2, 0xcc, 0xc0,
-	0x2a, 0xab, 0x17, 0xea, 0x0c, 0xcd, 0xdd, 0x84, 0xe6, 0x16, 0x59, 0x3a, 0x97, 0x66, 0xf3, 0xf9,
-	0xf1, 0xd8, 0x46, 0x27, 0x63, 0x1b, 0xfd, 0x1c, 0xdb, 0xe8, 0x60, 0x62, 0x5b, 0x27, 0x13, 0xdb,
-	0xfa, 0x36, 0xb1, 0x2d, 0x7c, 0xd3, 0x87, 0x02, 0x9f, 0x6d, 0xf4, 0xac, 0x99, 0xdb, 0xcb, 0xa9,
-	0x60, 0xcd, 0x87, 0xbc, 0xcb, 0xab, 0xc4, 0xa7, 0xb7, 0x90, 0xfc, 0x49, 0x0f, 0xff, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x68, 0x91, 0x92, 0x31, 0x04, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0xe1, 0xc7, 0xbf, 0xdf, 0x40, 0xa2, 0x8e, 0x18, 0x97, 0x22, 0x5d, 0x2c, 0x0a, 0x9b,
+	0x35, 0x74, 0xb2, 0x10, 0x34, 0xf1, 0x06, 0x46, 0x56, 0xe3, 0x05, 0x9b, 0xe8, 0xc1, 0xc4, 0x90,
+	0x6e, 0x3b, 0x94, 0xc6, 0xee, 0x4c, 0xe9, 0x74, 0x37, 0x6e, 0x08, 0x07, 0x39, 0x79, 0x32, 0x26,
+	0xc6, 0x8b, 0x27, 0x4e, 0xc6, 0x78, 0xe2, 0x63, 0x10, 0x4f, 0x24, 0x5e, 0x3c, 0xa9, 0x01, 0x13,
+	0xfc, 0x18, 0x66, 0xa6, 0xb3, 0xd9, 0xee, 0xda, 0x65, 0xf1, 0xb2, 0x6d, 0xf7, 0x7d, 0xde, 0x3e,
+	0xcf, 0xf3, 0xbe, 0xcf, 0x14, 0xea, 0x61, 0xc4, 0x1a, 0x84, 0xda, 0xd4, 0x21, 0x78, 0x8b, 0x05,
+	0x2e, 0x6e, 0x94, 0xf1, 0x76, 0x9d, 0x44, 0x4d, 0x33, 0x8c, 0x58, 0xcc, 0x10, 0x6a, 0xd7, 0x4d,
+	0x51, 0x37, 0x1b, 0x65, 0xed, 0x92, 0x5d, 0xf3, 0x29, 0xc3, 0xf2, 0x37, 0x81, 0x69, 0x25, 0x87,
+	0xf1, 0x1a, 0xe3, 0xb8, 0x6a, 0x73, 0x92, 0xf4, 0xe3, 0x46, 0xb9, 0x4a, 0x62, 0xbb, 0x8c, 0x43,
+	0xdb, 0xf3, 0xa9, 0x1d, 0xfb, 0x8c, 0x2a, 0xac, 0x9e, 0xc6, 0xb6, 0x50, 0x0e, 0xf3, 0x5b, 0xf5,
+	0x09, 0x8f, 0x79, 0x4c, 0xde, 0x62, 0x71, 0xa7, 0xfe, 0xbd, 0xe6, 0x31, 0xe6, 0x05, 0x04, 0xdb,
+	0xa1, 0x8f, 0x6d, 0x4a, 0x59, 0x2c, 0x5f, 0xc9, 0x55, 0x75, 0x3a, 0xc3, 0x86, 0xb8, 0x26, 0x65,
+	0x63, 0x19, 0x5e, 0xa8, 0x90, 0xf8, 0x01, 0x0b, 0x5c, 0x6e, 0x91, 0xed, 0x3a, 0xe1, 0x31, 0xca,
+	0xc3, 0x11, 0xdb, 0x75, 0x23, 0xc2, 0x79, 0x1e, 0xcc, 0x80, 0xe2, 0xff, 0x56, 0xeb, 0xf1, 0xee,
+	0xe8, 0xeb, 0xfd, 0x42, 0xee, 0xf7, 0x7e, 0x21, 0x67, 0x1c, 0x01, 0x78, 0xb1, 0xdd, 0xc7, 0x43,
+	0x46, 0x39, 0x41, 0x4d, 0x38, 0x6c, 0xd7, 0x58, 0x9d, 0xc6, 0x79, 0x30, 0xf3, 0x5f, 0x71, 0x6c,
+	0x71, 0xd2, 0x4c, 0xfc, 0x98, 0xc2, 0x8f, 0xa9, 0xfc, 0x98, 0xf7, 0x98, 0x4f, 0x57, 0xd7, 0x0e,
+	0xbf, 0x17, 0x72, 0x9f, 0x7f, 0x14, 0x8a, 0x9e, 0x1f, 0x6f, 0xd5, 0xab, 0xa6, 0xc3, 0x6a, 0x58,
+	0x99, 0x4f, 0x2e, 0x0b, 0xdc, 0x7d, 0x81, 0xe3, 0x66, 0x48, 0xb8, 0x6c, 0xe0, 0x1f, 0x4e, 0x0f,
+	0x4a, 0xe3, 0x01, 0xf1, 0x6c, 0xa7, 0xb9, 0x21, 0x26, 0xc2, 0x3f, 0x9d, 0x1e, 0x94, 0x80, 0xa5,
+	0x08, 0xd1, 0x1d, 0x38, 0x42, 0x68, 0x1c, 0xf9, 0x84, 0xe7, 0x07, 0x24, 0xf7, 0xb4, 0xf9, 0xf7,
+	0x7a, 0x4c, 0x21, 0xf7, 0x3e, 0x8d, 0xa3, 0xa6, 0xd5, 0x42, 0xa7, 0x2c, 0x6d, 0x42, 0x54, 0x21,
+	0xf1, 0x4a, 0x10, 0x74, 0x0c, 0x63, 0x0d, 0xc2, 0xf6, 0x9a, 0xf2, 0xce, 0x0c, 0x28, 0x8e, 0x2d,
+	0xce, 0x75, 0xf8, 0x4a, 0x32, 0xd1, 0x72, 0xb7, 0x6e, 0x7b, 0x44, 0xf5, 0x5a, 0xa9, 0xce, 0x14,
+	0xcf, 0x7b, 0x00, 0x2f, 0x77, 0x10, 0xa9, 0xe9, 0x2d, 0xc3, 0x21, 0xa1, 0x93, 0xab, 0xe1, 0x15,
+	0xb2, 0x0c, 0xac, 0x38, 0x8e, 0xb0, 0x2b, 0x1a, 0xad, 0x04, 0x8d, 0x2a, 0x19, 0x02, 0xe7, 0xfb,
+	0x0a, 0x4c, 0x38, 0xd3, 0x0a, 0x0d, 0x53, 0xca, 0x6a, 0x8f, 0x48, 0x0d, 0xe0, 0x2a, 0x1c, 0x11,
+	0x44, 0x1b, 0xbe, 0x2b, 0xd3, 0x30, 0x68, 0x0d, 0x8b, 0xc7, 0x87, 0xae, 0xf1, 0x08, 0x4e, 0x74,
+	0xe2, 0x95, 0x8f, 0x25, 0x38, 0x24, 0x86, 0xdb, 0x94, 0xf0, 0xbe, 0x8b, 0x48, 0xb0, 0xc6, 0x6d,
+	0xa8, 0x55, 0x48, 0xfc, 0x24, 0x74, 0x58, 0xcd, 0xa7, 0x9e, 0x45, 0x02, 0x62, 0x73, 0xd2, 0x3f,
+	0x91, 0xc6, 0x53, 0x38, 0x95, 0xd9, 0xa7, 0xb4, 0xa4, 0x62, 0x01, 0xfe, 0x25, 0x16, 0x8b, 0x5f,
+	0x06, 0xe1, 0xd0, 0x63, 0x31, 0x37, 0xb4, 0x07, 0xe0, 0x68, 0x2b, 0xe9, 0x68, 0x36, 0xab, 0xbd,
+	0xeb, 0xfc, 0x68, 0x37, 0xce, 0x06, 0x25, 0xd2, 0x8c, 0x5b, 0x7b, 0x5f, 0x7f, 0xbd, 0x1b, 0xb8,
+	0x89, 0x66, 0x71, 0xc6, 0x01, 0xdd, 0xac, 0x53, 0x97, 0xe3, 0x1d, 0xe5, 0x72, 0x17, 0xbd, 0x02,
+	0x70, 0x2c, 0x95, 0x19, 0x34, 0xd7, 0x83, 0xa2, 0x2b, 0xbd, 0xda, 0x7c, 0x5f, 0x9c, 0x52, 0x73,
+	0x5d, 0xaa, 0x99, 0x42, 0x93, 0x3d, 0xd5, 0xa0, 0x37, 0x00, 0x8e, 0xa7, 0x17, 0x8e, 0xe6, 0xcf,
+	0xf0, 0x99, 0x8e, 0x90, 0x56, 0xec, 0x0f, 0x3c, 0xcf, 0x50, 0x64, 0x52, 0xf0, 0x8e, 0x4a, 0xe3,
+	0x2e, 0xfa, 0x98, 0x1c, 0xa4, 0xee, 0xe5, 0x23, 0xb3, 0x07, 0x5d, 0x8f, 0x74, 0x69, 0xf8, 0xdc,
+	0x78, 0xa5, 0xd2, 0x94, 0x2a, 0x8b, 0x68, 0x2e, 0x4b, 0x65, 0xa4, 0xd0, 0xed, 0xed, 0xad, 0x3e,
+	0x3f, 0x3c, 0xd6, 0xc1, 0xd1, 0xb1, 0x0e, 0x7e, 0x1e, 0xeb, 0xe0, 0xed, 0x89, 0x9e, 0x3b, 0x3a,
+	0xd1, 0x73, 0xdf, 0x4e, 0xf4, 0x1c, 0xbc, 0xe2, 0xb3, 0x0c, 0xf2, 0x75, 0xf0, 0xac, 0x94, 0xfa,
+	0x2e, 0xb6, 0x01, 0x0b, 0x3e, 0x4b, 0x53, 0xbe, 0x94, 0xa4, 0xd5, 0x61, 0xf9, 0x25, 0x5f, 0xfa,
+	0x33, 0x00, 0xbc, 0x92, 0xbb, 0x21, 0xb1, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +469,10 @@ type QueryClient interface {
 	GetHolds(ctx context.Context, in *GetHoldsRequest, opts ...grpc.CallOption) (*GetHoldsResponse, error)
 	// GetAllHolds returns all addresses with funds on hold, and the amount held.
 	GetAllHolds(ctx context.Context, in *GetAllHoldsRequest, opts ...grpc.CallOption) (*GetAllHoldsResponse, error)
+	// GetHoldEntry looks up a single hold entry by its id.
+	GetHoldEntry(ctx context.Context, in *GetHoldEntryRequest, opts ...grpc.CallOption) (*GetHoldEntryResponse, error)
+	// GetUpcomingReleases looks up the hold entries for an address that are scheduled to be released.
+	GetUpcomingReleases(ctx context.Context, in *GetUpcomingReleasesRequest, opts ...grpc.CallOption) (*GetUpcomingReleasesResponse, error)
 }
 
 type queryClient struct {
@@ -296,12 +501,34 @@ func (c *queryClient) GetAllHolds(ctx context.Context, in *GetAllHoldsRequest, o
 	return out, nil
 }
 
+func (c *queryClient) GetHoldEntry(ctx context.Context, in *GetHoldEntryRequest, opts ...grpc.CallOption) (*GetHoldEntryResponse, error) {
+	out := new(GetHoldEntryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.hold.v1.Query/GetHoldEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetUpcomingReleases(ctx context.Context, in *GetUpcomingReleasesRequest, opts ...grpc.CallOption) (*GetUpcomingReleasesResponse, error) {
+	out := new(GetUpcomingReleasesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.hold.v1.Query/GetUpcomingReleases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetHolds looks up the funds that are on hold for an address.
 	GetHolds(context.Context, *GetHoldsRequest) (*GetHoldsResponse, error)
 	// GetAllHolds returns all addresses with funds on hold, and the amount held.
 	GetAllHolds(context.Context, *GetAllHoldsRequest) (*GetAllHoldsResponse, error)
+	// GetHoldEntry looks up a single hold entry by its id.
+	GetHoldEntry(context.Context, *GetHoldEntryRequest) (*GetHoldEntryResponse, error)
+	// GetUpcomingReleases looks up the hold entries for an address that are scheduled to be released.
+	GetUpcomingReleases(context.Context, *GetUpcomingReleasesRequest) (*GetUpcomingReleasesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -314,6 +541,12 @@ func (*UnimplementedQueryServer) GetHolds(ctx context.Context, req *GetHoldsRequ
 func (*UnimplementedQueryServer) GetAllHolds(ctx context.Context, req *GetAllHoldsRequest) (*GetAllHoldsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllHolds not implemented")
 }
+func (*UnimplementedQueryServer) GetHoldEntry(ctx context.Context, req *GetHoldEntryRequest) (*GetHoldEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHoldEntry not implemented")
+}
+func (*UnimplementedQueryServer) GetUpcomingReleases(ctx context.Context, req *GetUpcomingReleasesRequest) (*GetUpcomingReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingReleases not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -355,6 +588,42 @@ func _Query_GetAllHolds_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetHoldEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetHoldEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.hold.v1.Query/GetHoldEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetHoldEntry(ctx, req.(*GetHoldEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetUpcomingReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpcomingReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUpcomingReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.hold.v1.Query/GetUpcomingReleases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUpcomingReleases(ctx, req.(*GetUpcomingReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.hold.v1.Query",
@@ -368,6 +637,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllHolds",
 			Handler:    _Query_GetAllHolds_Handler,
 		},
+		{
+			MethodName: "GetHoldEntry",
+			Handler:    _Query_GetHoldEntry_Handler,
+		},
+		{
+			MethodName: "GetUpcomingReleases",
+			Handler:    _Query_GetUpcomingReleases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/hold/v1/query.proto",
@@ -423,6 +700,20 @@ func (m *GetHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -528,6 +819,136 @@ func (m *GetAllHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetHoldEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHoldEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHoldEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HoldId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HoldId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHoldEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHoldEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHoldEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Entry != nil {
+		{
+			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUpcomingReleasesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUpcomingReleasesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUpcomingReleasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUpcomingReleasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUpcomingReleasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUpcomingReleasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -564,6 +985,12 @@ func (m *GetHoldsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -592,20 +1019,273 @@ func (m *GetAllHoldsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetHoldEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HoldId != 0 {
+		n += 1 + sovQuery(uint64(m.HoldId))
+	}
+	return n
+}
+
+func (m *GetHoldEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUpcomingReleasesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUpcomingReleasesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHoldsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHoldsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &HoldEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -628,17 +1308,17 @@ func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetHoldsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllHoldsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -648,23 +1328,27 @@ func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -687,7 +1371,7 @@ func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -710,15 +1394,15 @@ func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetHoldsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllHoldsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -745,8 +1429,44 @@ func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holds = append(m.Holds, &AccountHold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -771,7 +1491,7 @@ func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetHoldEntryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -794,15 +1514,84 @@ func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllHoldsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHoldEntryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHoldEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldId", wireType)
+			}
+			m.HoldId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHoldEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHoldEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHoldEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -829,10 +1618,10 @@ func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Entry == nil {
+				m.Entry = &HoldEntry{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -857,7 +1646,7 @@ func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetUpcomingReleasesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -880,17 +1669,17 @@ func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllHoldsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetUpcomingReleasesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetUpcomingReleasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -900,29 +1689,77 @@ func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holds = append(m.Holds, &AccountHold{})
-			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 99:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUpcomingReleasesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUpcomingReleasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUpcomingReleasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -949,10 +1786,8 @@ func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &HoldEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,12 +7,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	"github.com/provenance-io/provenance/x/hold"
 	"github.com/provenance-io/provenance/x/hold/keeper"
 )
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
 // Value to the corresponding group type.
-func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldCoin):
@@ -21,6 +22,31 @@ func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
 			valBMsg := holdCoinValueMsg(kvB.Value)
 			return fmt.Sprintf("<HoldCoin><%s><%s>: A = %s, B = %s\n", addr, denom, valAMsg, valBMsg)
 
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldEntry):
+			holdID := keeper.ParseHoldEntryKey(kvA.Key)
+			var entryA, entryB hold.HoldEntry
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+			return fmt.Sprintf("<HoldEntry><%d>: A = %s, B = %s\n", holdID, entryA.String(), entryB.String())
+
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldEntryAddrIndex):
+			addr, holdID := keeper.ParseHoldEntryAddrIndexKey(kvA.Key)
+			return fmt.Sprintf("<HoldEntryAddrIndex><%s><%d>: A = %v, B = %v\n", addr, holdID, kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldEntryExpirationIndex):
+			expiration, holdID := keeper.ParseHoldEntryExpirationIndexKey(kvA.Key)
+			return fmt.Sprintf("<HoldEntryExpirationIndex><%d><%d>: A = %v, B = %v\n", expiration.Unix(), holdID, kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldEntryTotal):
+			addr, denom := keeper.ParseHoldEntryTotalKey(kvA.Key)
+			valAMsg := holdCoinValueMsg(kvA.Value)
+			valBMsg := holdCoinValueMsg(kvB.Value)
+			return fmt.Sprintf("<HoldEntryTotal><%s><%s>: A = %s, B = %s\n", addr, denom, valAMsg, valBMsg)
+
+		case bytes.Equal(kvA.Key, keeper.KeyLastHoldID):
+			return fmt.Sprintf("<LastHoldID>: A = %d, B = %d\n",
+				keeper.UnmarshalLastHoldIDValue(kvA.Value), keeper.UnmarshalLastHoldIDValue(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid hold key %X", kvA.Key))
 		}
@@ -2,6 +2,7 @@ package simulation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/hold"
 	"github.com/provenance-io/provenance/x/hold/keeper"
 	"github.com/provenance-io/provenance/x/hold/simulation"
 )
@@ -20,6 +22,8 @@ func TestDecodeStore(t *testing.T) {
 
 	addr0 := sdk.AccAddress("addr0_______________")
 	addr1 := sdk.AccAddress("addr1_______________")
+	entryA := &hold.HoldEntry{HoldId: 3, Address: addr0.String(), Holder: "testmod", Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 99))}
+	entryB := &hold.HoldEntry{HoldId: 3, Address: addr0.String(), Holder: "testmod", Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 12))}
 
 	tests := []struct {
 		name     string
@@ -34,6 +38,36 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: keeper.CreateHoldCoinKey(addr1, "cherry"), Value: []byte("123")},
 			exp:  "<HoldCoin><" + addr0.String() + "><banana>: A = \"99\", B = \"123\"\n",
 		},
+		{
+			name: "HoldEntry",
+			kvA:  kv.Pair{Key: keeper.CreateHoldEntryKey(3), Value: cdc.MustMarshal(entryA)},
+			kvB:  kv.Pair{Key: keeper.CreateHoldEntryKey(3), Value: cdc.MustMarshal(entryB)},
+			exp:  "<HoldEntry><3>: A = " + entryA.String() + ", B = " + entryB.String() + "\n",
+		},
+		{
+			name: "HoldEntryAddrIndex",
+			kvA:  kv.Pair{Key: keeper.CreateHoldEntryAddrIndexKey(addr0, 3), Value: []byte{}},
+			kvB:  kv.Pair{Key: keeper.CreateHoldEntryAddrIndexKey(addr0, 3), Value: []byte{}},
+			exp:  "<HoldEntryAddrIndex><" + addr0.String() + "><3>: A = [], B = []\n",
+		},
+		{
+			name: "HoldEntryExpirationIndex",
+			kvA:  kv.Pair{Key: keeper.CreateHoldEntryExpirationIndexKey(time.Unix(1710417600, 0), 3), Value: []byte{}},
+			kvB:  kv.Pair{Key: keeper.CreateHoldEntryExpirationIndexKey(time.Unix(1710417600, 0), 3), Value: []byte{}},
+			exp:  "<HoldEntryExpirationIndex><1710417600><3>: A = [], B = []\n",
+		},
+		{
+			name: "HoldEntryTotal",
+			kvA:  kv.Pair{Key: keeper.CreateHoldEntryTotalKey(addr0, "banana"), Value: []byte("99")},
+			kvB:  kv.Pair{Key: keeper.CreateHoldEntryTotalKey(addr1, "cherry"), Value: []byte("123")},
+			exp:  "<HoldEntryTotal><" + addr0.String() + "><banana>: A = \"99\", B = \"123\"\n",
+		},
+		{
+			name: "LastHoldID",
+			kvA:  kv.Pair{Key: keeper.KeyLastHoldID, Value: []byte{0, 0, 0, 0, 0, 0, 0, 5}},
+			kvB:  kv.Pair{Key: keeper.KeyLastHoldID, Value: []byte{0, 0, 0, 0, 0, 0, 0, 8}},
+			exp:  "<LastHoldID>: A = 5, B = 8\n",
+		},
 		{
 			name:     "unknown",
 			kvA:      kv.Pair{Key: []byte{0x9a}, Value: []byte{0x9b}},
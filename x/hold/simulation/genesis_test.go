@@ -411,6 +411,7 @@ func TestRandomizedGenState(t *testing.T) {
 		rv := hold.DefaultGenesisState()
 		rv.Holds = make([]*hold.AccountHold, len(holds))
 		copy(rv.Holds, holds)
+		rv.Entries = []*hold.HoldEntry{}
 		return rv
 	}
 	accountHold := func(acc simtypes.Account, amount int64) *hold.AccountHold {
@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/hold/v1/tx.proto
+
+package hold
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateTimedHoldRequest is a request message for the CreateTimedHold endpoint.
+type MsgCreateTimedHoldRequest struct {
+	// address is the bech32 address string of the account with the funds to put on hold.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the funds to put on hold.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// release_time is the time at which the hold is automatically released. It must be in the future.
+	ReleaseTime time.Time `protobuf:"bytes,3,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time"`
+	// reason is an optional human-readable indicator of why this hold is being placed.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgCreateTimedHoldRequest) Reset()         { *m = MsgCreateTimedHoldRequest{} }
+func (m *MsgCreateTimedHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTimedHoldRequest) ProtoMessage()    {}
+func (*MsgCreateTimedHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9db16d4ea14d3f9, []int{0}
+}
+func (m *MsgCreateTimedHoldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTimedHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTimedHoldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateTimedHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTimedHoldRequest.Merge(m, src)
+}
+func (m *MsgCreateTimedHoldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTimedHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTimedHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTimedHoldRequest proto.InternalMessageInfo
+
+func (m *MsgCreateTimedHoldRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgCreateTimedHoldRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgCreateTimedHoldRequest) GetReleaseTime() time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateTimedHoldRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgCreateTimedHoldResponse is a response message for the CreateTimedHold endpoint.
+type MsgCreateTimedHoldResponse struct {
+	// hold_id is the unique identifier of the newly created hold entry.
+	HoldId uint64 `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+}
+
+func (m *MsgCreateTimedHoldResponse) Reset()         { *m = MsgCreateTimedHoldResponse{} }
+func (m *MsgCreateTimedHoldResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTimedHoldResponse) ProtoMessage()    {}
+func (*MsgCreateTimedHoldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9db16d4ea14d3f9, []int{1}
+}
+func (m *MsgCreateTimedHoldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTimedHoldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTimedHoldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateTimedHoldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTimedHoldResponse.Merge(m, src)
+}
+func (m *MsgCreateTimedHoldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTimedHoldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTimedHoldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTimedHoldResponse proto.InternalMessageInfo
+
+func (m *MsgCreateTimedHoldResponse) GetHoldId() uint64 {
+	if m != nil {
+		return m.HoldId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateTimedHoldRequest)(nil), "provenance.hold.v1.MsgCreateTimedHoldRequest")
+	proto.RegisterType((*MsgCreateTimedHoldResponse)(nil), "provenance.hold.v1.MsgCreateTimedHoldResponse")
+}
+
+func init() { proto.RegisterFile("provenance/hold/v1/tx.proto", fileDescriptor_e9db16d4ea14d3f9) }
+
+var fileDescriptor_e9db16d4ea14d3f9 = []byte{
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x36, 0x35, 0xd5, 0x49, 0x40, 0x5c, 0xaa, 0x4d, 0x56, 0xd8, 0x84, 0x9e, 0x42, 0x20,
+	0x33, 0x24, 0xe2, 0xc5, 0x9b, 0x29, 0xf8, 0xe3, 0x50, 0x90, 0xb5, 0x27, 0x41, 0xc2, 0x64, 0x77,
+	0x9c, 0x0e, 0xee, 0xee, 0x5b, 0xf7, 0x4d, 0x42, 0x73, 0x11, 0xf1, 0x2f, 0xe8, 0xd9, 0xbf, 0x40,
+	0x3c, 0xe5, 0xe0, 0xc5, 0xff, 0xa0, 0xc7, 0xe2, 0xc9, 0x93, 0x95, 0xe4, 0x90, 0x7f, 0x43, 0x66,
+	0x77, 0x42, 0x8b, 0x56, 0xf0, 0xb2, 0xbb, 0xdf, 0x7c, 0xdf, 0x9b, 0x99, 0xef, 0x7b, 0x6f, 0xc9,
+	0xfd, 0x2c, 0x87, 0x99, 0x48, 0x79, 0x1a, 0x0a, 0x76, 0x0c, 0x71, 0xc4, 0x66, 0x03, 0xa6, 0x4f,
+	0x68, 0x96, 0x83, 0x06, 0xd7, 0xbd, 0x24, 0xa9, 0x21, 0xe9, 0x6c, 0xe0, 0xdd, 0xe1, 0x89, 0x4a,
+	0x81, 0x15, 0xcf, 0x52, 0xe6, 0xf9, 0x21, 0x60, 0x02, 0xc8, 0x26, 0x1c, 0x05, 0x9b, 0x0d, 0x26,
+	0x42, 0xf3, 0x01, 0x0b, 0x41, 0xa5, 0x96, 0xdf, 0xb3, 0x7c, 0x82, 0xd2, 0x6c, 0x9f, 0xa0, 0xb4,
+	0x44, 0xab, 0x24, 0xc6, 0x05, 0x62, 0x25, 0xb0, 0xd4, 0xae, 0x04, 0x09, 0xe5, 0xba, 0xf9, 0xb2,
+	0xab, 0x6d, 0x09, 0x20, 0x63, 0xc1, 0x0a, 0x34, 0x99, 0xbe, 0x61, 0x5a, 0x25, 0x02, 0x35, 0x4f,
+	0xb2, 0x52, 0xb0, 0xff, 0x6d, 0x8b, 0xb4, 0x0e, 0x51, 0x1e, 0xe4, 0x82, 0x6b, 0x71, 0xa4, 0x12,
+	0x11, 0x3d, 0x83, 0x38, 0x0a, 0xc4, 0xbb, 0xa9, 0x40, 0xed, 0x0e, 0xc9, 0x0e, 0x8f, 0xa2, 0x5c,
+	0x20, 0x36, 0x9d, 0x8e, 0xd3, 0xbd, 0x35, 0x6a, 0x7e, 0xff, 0xda, 0xdf, 0xb5, 0xe7, 0x3e, 0x2e,
+	0x99, 0x97, 0x3a, 0x57, 0xa9, 0x0c, 0x36, 0x42, 0x77, 0x4e, 0x6a, 0x3c, 0x81, 0x69, 0xaa, 0x9b,
+	0x5b, 0x9d, 0x6a, 0xb7, 0x3e, 0x6c, 0x51, 0xab, 0x37, 0x6e, 0xa9, 0x75, 0x4b, 0x0f, 0x40, 0xa5,
+	0xa3, 0x27, 0x67, 0x3f, 0xdb, 0x95, 0x2f, 0x17, 0xed, 0xae, 0x54, 0xfa, 0x78, 0x3a, 0xa1, 0x21,
+	0x24, 0xd6, 0x94, 0x7d, 0xf5, 0x31, 0x7a, 0xcb, 0xf4, 0x3c, 0x13, 0x58, 0x14, 0xe0, 0xa7, 0xf5,
+	0xa2, 0xd7, 0x88, 0x85, 0xe4, 0xe1, 0x7c, 0x6c, 0xf2, 0xc2, 0xcf, 0xeb, 0x45, 0xcf, 0x09, 0xec,
+	0x81, 0xee, 0x53, 0xd2, 0xc8, 0x45, 0x2c, 0x38, 0x8a, 0xb1, 0xf1, 0xd9, 0xac, 0x76, 0x9c, 0x6e,
+	0x7d, 0xe8, 0xd1, 0x32, 0x04, 0xba, 0x09, 0x81, 0x1e, 0x6d, 0x42, 0x18, 0xdd, 0x34, 0x37, 0x38,
+	0xbd, 0x68, 0x3b, 0x41, 0xdd, 0x56, 0x1a, 0xce, 0xbd, 0x47, 0x6a, 0xb9, 0xe0, 0x08, 0x69, 0x73,
+	0xdb, 0xd8, 0x0e, 0x2c, 0x7a, 0xd4, 0xf8, 0xb8, 0x5e, 0xf4, 0x36, 0x4e, 0xf7, 0x1f, 0x12, 0xef,
+	0xba, 0xe8, 0x30, 0x83, 0x14, 0x85, 0xbb, 0x47, 0x76, 0xcc, 0x08, 0x8c, 0x55, 0x54, 0x64, 0xb7,
+	0x1d, 0xd4, 0x0c, 0x7c, 0x1e, 0x0d, 0xdf, 0x93, 0xea, 0x21, 0x4a, 0x37, 0x23, 0xb7, 0xff, 0x28,
+	0x75, 0xfb, 0xf4, 0xef, 0xf9, 0xa1, 0xff, 0xec, 0x8e, 0x47, 0xff, 0x57, 0x5e, 0xde, 0xc8, 0xbb,
+	0xf1, 0xc1, 0xa4, 0x35, 0x7a, 0x7d, 0xb6, 0xf4, 0x9d, 0xf3, 0xa5, 0xef, 0xfc, 0x5a, 0xfa, 0xce,
+	0xe9, 0xca, 0xaf, 0x9c, 0xaf, 0xfc, 0xca, 0x8f, 0x95, 0x5f, 0x21, 0x77, 0x15, 0x5c, 0xb3, 0xe5,
+	0x0b, 0xe7, 0x55, 0xef, 0x4a, 0x83, 0x2e, 0x05, 0x7d, 0x05, 0x57, 0x10, 0x3b, 0x29, 0xfe, 0x87,
+	0x49, 0xad, 0x88, 0xf9, 0xc1, 0xef, 0x01, 0x00, 0xef, 0x8f, 0x96, 0x45, 0x29, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateTimedHold places a hold on funds in an account that is automatically released at a given time.
+	CreateTimedHold(ctx context.Context, in *MsgCreateTimedHoldRequest, opts ...grpc.CallOption) (*MsgCreateTimedHoldResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateTimedHold(ctx context.Context, in *MsgCreateTimedHoldRequest, opts ...grpc.CallOption) (*MsgCreateTimedHoldResponse, error) {
+	out := new(MsgCreateTimedHoldResponse)
+	err := c.cc.Invoke(ctx, "/provenance.hold.v1.Msg/CreateTimedHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateTimedHold places a hold on funds in an account that is automatically released at a given time.
+	CreateTimedHold(context.Context, *MsgCreateTimedHoldRequest) (*MsgCreateTimedHoldResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateTimedHold(ctx context.Context, req *MsgCreateTimedHoldRequest) (*MsgCreateTimedHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTimedHold not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateTimedHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTimedHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTimedHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.hold.v1.Msg/CreateTimedHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTimedHold(ctx, req.(*MsgCreateTimedHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.hold.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTimedHold",
+			Handler:    _Msg_CreateTimedHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/hold/v1/tx.proto",
+}
+
+func (m *MsgCreateTimedHoldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTimedHoldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTimedHoldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTimedHoldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTimedHoldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTimedHoldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HoldId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HoldId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateTimedHoldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTimedHoldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HoldId != 0 {
+		n += 1 + sovTx(uint64(m.HoldId))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateTimedHoldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTimedHoldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTimedHoldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTimedHoldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTimedHoldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTimedHoldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldId", wireType)
+			}
+			m.HoldId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -19,23 +19,25 @@ type IbcHooks struct {
 	ibcHooksKeeper          *keeper.Keeper
 	wasmHooks               *WasmHooks
 	markerHooks             *MarkerHooks
+	packetForwardHooks      *PacketForwardHooks
 	SendPacketPreProcessors []types.PreSendPacketDataProcessingFn
 }
 
-func NewIbcHooks(cdc codec.BinaryCodec, ibcHooksKeeper *keeper.Keeper, ibcKeeper *ibckeeper.Keeper, wasmHooks *WasmHooks, markerHooks *MarkerHooks, preSendPacketDataProcessingFns []types.PreSendPacketDataProcessingFn) IbcHooks {
+func NewIbcHooks(cdc codec.BinaryCodec, ibcHooksKeeper *keeper.Keeper, ibcKeeper *ibckeeper.Keeper, wasmHooks *WasmHooks, markerHooks *MarkerHooks, packetForwardHooks *PacketForwardHooks, preSendPacketDataProcessingFns []types.PreSendPacketDataProcessingFn) IbcHooks {
 	return IbcHooks{
 		cdc:                     cdc,
 		ibcKeeper:               ibcKeeper,
 		ibcHooksKeeper:          ibcHooksKeeper,
 		wasmHooks:               wasmHooks,
 		markerHooks:             markerHooks,
+		packetForwardHooks:      packetForwardHooks,
 		SendPacketPreProcessors: preSendPacketDataProcessingFns,
 	}
 }
 
-// ProperlyConfigured returns false if either wasm or marker hooks are configured incorrectly
+// ProperlyConfigured returns false if any of the wasm, marker, or packet forward hooks are configured incorrectly
 func (h IbcHooks) ProperlyConfigured() bool {
-	return h.wasmHooks.ProperlyConfigured() && h.markerHooks.ProperlyConfigured() && h.ibcHooksKeeper != nil
+	return h.wasmHooks.ProperlyConfigured() && h.markerHooks.ProperlyConfigured() && h.packetForwardHooks != nil && h.packetForwardHooks.ProperlyConfigured() && h.ibcHooksKeeper != nil
 }
 
 // GetSendPacketPreProcessors returns a list of ordered functions to be executed before ibc's SendPacket function in middleware
@@ -43,13 +45,13 @@ func (h IbcHooks) GetSendPacketPreProcessors() []types.PreSendPacketDataProcessi
 	return h.SendPacketPreProcessors
 }
 
-// OnRecvPacketOverride executes wasm or marker hooks for Ics20 packets, if not ics20 packet it will continue to process packet with no override
+// OnRecvPacketOverride executes wasm, marker, or packet forward hooks for Ics20 packets, if not ics20 packet it will continue to process packet with no override
 func (h IbcHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, packet channeltypes.Packet, relayer sdktypes.AccAddress) ibcexported.Acknowledgement {
 	if !h.ProperlyConfigured() {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	isIcs20, _ := isIcs20Packet(packet.GetData())
+	isIcs20, data := isIcs20Packet(packet.GetData())
 	if !isIcs20 {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
@@ -57,6 +59,9 @@ func (h IbcHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, p
 	if err := h.markerHooks.AddUpdateMarker(ctx, packet, h.ibcKeeper); err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMarkerError, err.Error())
 	}
+	if isForwardRouted, _ := jsonStringHasKey(data.GetMemo(), types.IBCForwardKey); isForwardRouted {
+		return h.packetForwardHooks.OnRecvPacketOverride(im, ctx, packet, relayer)
+	}
 	return h.wasmHooks.OnRecvPacketOverride(im, ctx, packet, relayer)
 }
 
@@ -76,12 +81,24 @@ func (h IbcHooks) SendPacketAfterHook(ctx sdktypes.Context,
 	h.wasmHooks.SendPacketAfterHook(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data, sequence, err, processData)
 }
 
-// OnTimeoutPacketOverride returns impl of wasm hook for OnTimeoutPacketOverride
+// OnTimeoutPacketOverride returns impl of wasm hook for OnTimeoutPacketOverride followed by the packet forward timeout handling
 func (h IbcHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdktypes.Context, packet channeltypes.Packet, relayer sdktypes.AccAddress) error {
-	return h.wasmHooks.OnTimeoutPacketOverride(im, ctx, packet, relayer)
+	if err := h.wasmHooks.OnTimeoutPacketOverride(im, ctx, packet, relayer); err != nil {
+		return err
+	}
+	if h.packetForwardHooks != nil {
+		h.packetForwardHooks.OnForwardedPacketTimeout(ctx, packet)
+	}
+	return nil
 }
 
-// OnAcknowledgementPacketOverride returns impl of wasm OnAcknowledgementPacketOverride
+// OnAcknowledgementPacketOverride returns impl of wasm OnAcknowledgementPacketOverride followed by the packet forward ack handling
 func (h IbcHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdktypes.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdktypes.AccAddress) error {
-	return h.wasmHooks.OnAcknowledgementPacketOverride(im, ctx, packet, acknowledgement, relayer)
+	if err := h.wasmHooks.OnAcknowledgementPacketOverride(im, ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	if h.packetForwardHooks != nil {
+		h.packetForwardHooks.OnForwardedPacketAck(ctx, packet, acknowledgement)
+	}
+	return nil
 }
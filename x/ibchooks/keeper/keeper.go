@@ -20,6 +20,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	"github.com/provenance-io/provenance/x/ibchooks/types"
 )
@@ -93,6 +94,11 @@ func GetPacketAckKey(channel string, packetSequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s::%d::ack", channel, packetSequence))
 }
 
+// GetInFlightPacketKey returns the store key of a forwarded packet that is waiting for an ack or timeout
+func GetInFlightPacketKey(channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s::%d::forward", channel, packetSequence))
+}
+
 func GeneratePacketAckValue(packet channeltypes.Packet, contract string) ([]byte, error) {
 	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalidContractAddr, contract)
@@ -176,10 +182,59 @@ func (k Keeper) DeletePacketAckActor(ctx sdk.Context, channel string, packetSequ
 	store.Delete(GetPacketAckKey(channel, packetSequence))
 }
 
+// StoreInFlightPacket stores a received packet that has been forwarded, keyed by the channel and sequence of the forwarded packet
+func (k Keeper) StoreInFlightPacket(ctx sdk.Context, channel string, packetSequence uint64, inFlight types.InFlightPacket) error {
+	bz, err := json.Marshal(inFlight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not marshal in-flight packet")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetInFlightPacketKey(channel, packetSequence), bz)
+	return nil
+}
+
+// GetInFlightPacket returns the received packet that was forwarded as the packet with the given channel and sequence, or nil if there isn't one
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, channel string, packetSequence uint64) (*types.InFlightPacket, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetInFlightPacketKey(channel, packetSequence))
+	if bz == nil {
+		return nil, nil
+	}
+	var inFlight types.InFlightPacket
+	if err := json.Unmarshal(bz, &inFlight); err != nil {
+		return nil, sdkerrors.Wrap(err, "could not unmarshal in-flight packet")
+	}
+	return &inFlight, nil
+}
+
+// DeleteInFlightPacket deletes the in-flight packet once the forwarded packet has been acknowledged or has timed out
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetInFlightPacketKey(channel, packetSequence))
+}
+
+// WriteAcknowledgement writes the acknowledgement of a received packet whose ack was delayed
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, ack exported.Acknowledgement) error {
+	_, channelCapability, err := k.channelKeeper.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+	return k.channelKeeper.WriteAcknowledgement(ctx, channelCapability, packet, ack)
+}
+
 // DeriveIntermediateSender derives the sender address to be used when calling wasm hooks
 func DeriveIntermediateSender(channel, originalSender, bech32Prefix string) (string, error) {
+	return deriveIntermediary(types.SenderPrefix, channel, originalSender, bech32Prefix)
+}
+
+// DeriveForwardIntermediary derives the address that holds the funds of a received packet until they are forwarded
+func DeriveForwardIntermediary(channel, originalSender, bech32Prefix string) (string, error) {
+	return deriveIntermediary(types.ForwardSenderPrefix, channel, originalSender, bech32Prefix)
+}
+
+func deriveIntermediary(prefix, channel, originalSender, bech32Prefix string) (string, error) {
 	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
-	senderHash32 := address.Hash(types.SenderPrefix, []byte(senderStr))
+	senderHash32 := address.Hash(prefix, []byte(senderStr))
 	sender := sdk.AccAddress(senderHash32)
 	return sdk.Bech32ifyAddressBytes(bech32Prefix, sender)
 }
@@ -0,0 +1,274 @@
+package ibchooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/ibchooks/keeper"
+	"github.com/provenance-io/provenance/x/ibchooks/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+type PacketForwardHooks struct {
+	TransferKeeper      types.TransferKeeper
+	BankKeeper          types.BankKeeper
+	ibcHooksKeeper      *keeper.Keeper
+	bech32PrefixAccAddr string
+}
+
+func NewPacketForwardHooks(ibcHooksKeeper *keeper.Keeper, transferKeeper types.TransferKeeper, bankKeeper types.BankKeeper, bech32PrefixAccAddr string) PacketForwardHooks {
+	return PacketForwardHooks{
+		TransferKeeper:      transferKeeper,
+		BankKeeper:          bankKeeper,
+		ibcHooksKeeper:      ibcHooksKeeper,
+		bech32PrefixAccAddr: bech32PrefixAccAddr,
+	}
+}
+
+// ProperlyConfigured returns false when packet forward hooks are configured incorrectly
+func (h PacketForwardHooks) ProperlyConfigured() bool {
+	return h.TransferKeeper != nil && h.BankKeeper != nil && h.ibcHooksKeeper != nil
+}
+
+// OnRecvPacketOverride receives the funds of a packet with a forward memo into an intermediary account and sends them on to the next chain.
+// The received packet is acknowledged once the forwarded packet is acknowledged or has finally timed out.
+func (h PacketForwardHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, packet channeltypes.Packet, relayer sdktypes.AccAddress) ibcexported.Acknowledgement {
+	if !h.ProperlyConfigured() {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+	isIcs20, data := isIcs20Packet(packet.GetData())
+	if !isIcs20 {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	isForwardRouted, forward, err := ValidateAndParseForwardMemo(data.GetMemo())
+	if !isForwardRouted {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrPacketForwardError, err.Error())
+	}
+
+	// The funds are received by an intermediary account for the sender so that they can be sent on from there.
+	channel := packet.GetDestChannel()
+	sender := data.GetSender()
+	intermediary, err := keeper.DeriveForwardIntermediary(channel, sender, h.bech32PrefixAccAddr)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
+	}
+
+	originalPacket := packet
+	data.Receiver = intermediary
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	// Execute the receive
+	ack := im.App.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.GetAmount())
+	if !ok {
+		// This should never happen, as it should've been caught in the underlaying call to OnRecvPacket,
+		// but returning here for completeness
+		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
+	}
+	token := sdktypes.NewCoin(MustExtractDenomFromPacketOnRecv(packet), amount)
+
+	// If the funds can't be forwarded, the error ack causes the receive to be reverted too.
+	if err = h.forwardPacket(ctx, types.NewInFlightPacket(originalPacket, intermediary, token, forward)); err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrPacketForwardError, err.Error())
+	}
+
+	// The ack is written once the forwarded packet is acknowledged or times out.
+	return nil
+}
+
+// OnForwardedPacketAck acknowledges the received packet that a forwarded packet was sent for.
+// If the forwarded packet failed, the received funds are refunded to the original sender.
+// This must be called after the transfer module has processed the forwarded packet's acknowledgement.
+// Errors are logged instead of returned so that they don't stop the forwarded packet's acknowledgement from being processed.
+func (h PacketForwardHooks) OnForwardedPacketAck(ctx sdktypes.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	if !h.ProperlyConfigured() {
+		return
+	}
+	inFlight := h.takeInFlightPacket(ctx, packet)
+	if inFlight == nil {
+		return
+	}
+
+	if IsJSONAckError(acknowledgement) {
+		h.refundPacket(ctx, *inFlight, fmt.Sprintf("forwarded packet %d on %s failed: %s", packet.GetSequence(), packet.GetSourceChannel(), acknowledgement))
+		return
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		h.logError(ctx, *inFlight, "could not unmarshal forwarded packet acknowledgement", err)
+		h.refundPacket(ctx, *inFlight, fmt.Sprintf("forwarded packet %d on %s has an invalid acknowledgement", packet.GetSequence(), packet.GetSourceChannel()))
+		return
+	}
+	if err := h.ibcHooksKeeper.WriteAcknowledgement(ctx, inFlight.OriginalPacket, ack); err != nil {
+		h.logError(ctx, *inFlight, "could not write forwarded packet acknowledgement", err)
+	}
+}
+
+// OnForwardedPacketTimeout resends a forwarded packet that timed out if it has retries remaining.
+// Otherwise, the received funds are refunded to the original sender.
+// This must be called after the transfer module has processed the forwarded packet's timeout.
+// Errors are logged instead of returned so that they don't stop the forwarded packet's timeout from being processed.
+func (h PacketForwardHooks) OnForwardedPacketTimeout(ctx sdktypes.Context, packet channeltypes.Packet) {
+	if !h.ProperlyConfigured() {
+		return
+	}
+	inFlight := h.takeInFlightPacket(ctx, packet)
+	if inFlight == nil {
+		return
+	}
+
+	reason := fmt.Sprintf("forwarded packet %d on %s timed out", packet.GetSequence(), packet.GetSourceChannel())
+	if inFlight.RetriesRemaining > 0 {
+		inFlight.RetriesRemaining--
+		// The resend is done in a cache context so that a failed resend doesn't leave any partial changes behind.
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := h.forwardPacket(cacheCtx, *inFlight)
+		if err == nil {
+			writeCache()
+			return
+		}
+		reason = fmt.Sprintf("%s and could not be resent: %s", reason, err.Error())
+	}
+	h.refundPacket(ctx, *inFlight, reason)
+}
+
+// takeInFlightPacket returns and deletes the in-flight packet that a forwarded packet was sent for.
+// Returns nil if the packet wasn't forwarded by this hook or the in-flight packet can't be read.
+func (h PacketForwardHooks) takeInFlightPacket(ctx sdktypes.Context, packet channeltypes.Packet) *types.InFlightPacket {
+	inFlight, err := h.ibcHooksKeeper.GetInFlightPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if err != nil {
+		h.ibcHooksKeeper.Logger(ctx).Error("could not read in-flight packet",
+			"channel", packet.GetSourceChannel(), "sequence", packet.GetSequence(), "error", err)
+	}
+	if inFlight != nil {
+		h.ibcHooksKeeper.DeleteInFlightPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	}
+	return inFlight
+}
+
+// forwardPacket sends the funds of an in-flight packet from its intermediary to the next chain.
+// The funds are sent as a regular ibc transfer, so they are subject to the same send restrictions and rate limits.
+func (h PacketForwardHooks) forwardPacket(ctx sdktypes.Context, inFlight types.InFlightPacket) error {
+	memo, err := inFlight.Forward.NextMemo()
+	if err != nil {
+		return err
+	}
+	timeout := ctx.BlockTime().Add(inFlight.Forward.GetTimeout())
+	msg := transfertypes.NewMsgTransfer(
+		inFlight.Forward.GetPort(), inFlight.Forward.Channel, inFlight.Token, inFlight.Intermediary, inFlight.Forward.Receiver,
+		clienttypes.ZeroHeight(), uint64(timeout.UnixNano()), memo,
+	)
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+	res, err := h.TransferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return err
+	}
+	return h.ibcHooksKeeper.StoreInFlightPacket(ctx, msg.SourceChannel, res.Sequence, inFlight)
+}
+
+// refundPacket undoes the receipt of an in-flight packet's funds and acknowledges the received packet with an error,
+// so that the chain it came from refunds the original sender.
+// If the funds can't be returned, the error is logged and the error acknowledgement is still written.
+func (h PacketForwardHooks) refundPacket(ctx sdktypes.Context, inFlight types.InFlightPacket, reason string) {
+	// The funds are returned in a cache context so that a failed refund doesn't leave any partial changes behind.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := h.returnFunds(cacheCtx, inFlight); err != nil {
+		h.logError(ctx, inFlight, "could not refund forwarded packet funds", err)
+	} else {
+		writeCache()
+	}
+
+	ack := NewEmitErrorAcknowledgement(ctx, types.ErrPacketForwardError, reason)
+	if err := h.ibcHooksKeeper.WriteAcknowledgement(ctx, inFlight.OriginalPacket, ack); err != nil {
+		h.logError(ctx, inFlight, "could not write forwarded packet error acknowledgement", err)
+	}
+}
+
+// returnFunds puts the funds of an in-flight packet back to where they were when the original packet was received.
+func (h PacketForwardHooks) returnFunds(ctx sdktypes.Context, inFlight types.InFlightPacket) error {
+	intermediary, err := sdktypes.AccAddressFromBech32(inFlight.Intermediary)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid intermediary address")
+	}
+	var data transfertypes.FungibleTokenPacketData
+	if err = json.Unmarshal(inFlight.OriginalPacket.GetData(), &data); err != nil {
+		return sdkerrors.Wrap(err, "could not unmarshal original packet data")
+	}
+
+	// The funds are only being put back to where they came from, so the marker send restrictions don't apply here.
+	bypassCtx := markertypes.WithBypass(ctx)
+	original := inFlight.OriginalPacket
+	coins := sdktypes.NewCoins(inFlight.Token)
+	if transfertypes.ReceiverChainIsSource(original.GetSourcePort(), original.GetSourceChannel(), data.Denom) {
+		// The funds were unescrowed when received, so they go back into escrow.
+		escrowAddress := transfertypes.GetEscrowAddress(original.GetDestPort(), original.GetDestChannel())
+		if err = h.BankKeeper.SendCoins(bypassCtx, intermediary, escrowAddress, coins); err != nil {
+			return sdkerrors.Wrap(err, "could not escrow refunded funds")
+		}
+		totalEscrow := h.TransferKeeper.GetTotalEscrowForDenom(ctx, inFlight.Token.Denom)
+		h.TransferKeeper.SetTotalEscrowForDenom(ctx, totalEscrow.Add(inFlight.Token))
+	} else {
+		// The funds were minted when received, so they are burned.
+		if err = h.BankKeeper.SendCoinsFromAccountToModule(bypassCtx, intermediary, transfertypes.ModuleName, coins); err != nil {
+			return sdkerrors.Wrap(err, "could not burn refunded funds")
+		}
+		if err = h.BankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return sdkerrors.Wrap(err, "could not burn refunded funds")
+		}
+	}
+	return nil
+}
+
+// logError logs an error that occurred while resolving an in-flight packet.
+func (h PacketForwardHooks) logError(ctx sdktypes.Context, inFlight types.InFlightPacket, msg string, err error) {
+	h.ibcHooksKeeper.Logger(ctx).Error(msg, "channel", inFlight.OriginalPacket.GetDestChannel(),
+		"sequence", inFlight.OriginalPacket.GetSequence(), "error", err)
+}
+
+// ValidateAndParseForwardMemo returns the forward metadata of a packet memo, and whether the memo has a forward key
+func ValidateAndParseForwardMemo(memo string) (isForwardRouted bool, forward types.ForwardMetadata, err error) {
+	isForwardRouted, metadata := jsonStringHasKey(memo, types.IBCForwardKey)
+	if !isForwardRouted {
+		return isForwardRouted, forward, nil
+	}
+
+	// Make sure the forward key is a map.
+	if _, ok := metadata[types.IBCForwardKey].(map[string]interface{}); !ok {
+		return isForwardRouted, forward, fmt.Errorf("forward metadata is not a valid JSON map object: %s", memo)
+	}
+	jsonBytes, err := json.Marshal(metadata[types.IBCForwardKey])
+	if err != nil {
+		return isForwardRouted, forward, err
+	}
+	if err = json.Unmarshal(jsonBytes, &forward); err != nil {
+		return isForwardRouted, forward, fmt.Errorf("invalid forward metadata: %w", err)
+	}
+	if err = forward.Validate(); err != nil {
+		return isForwardRouted, forward, err
+	}
+	return isForwardRouted, forward, nil
+}
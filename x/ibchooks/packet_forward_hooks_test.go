@@ -0,0 +1,241 @@
+package ibchooks_test
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	testutil "github.com/provenance-io/provenance/testutil/ibc"
+	"github.com/provenance-io/provenance/x/ibchooks"
+	"github.com/provenance-io/provenance/x/ibchooks/keeper"
+	ibchookstypes "github.com/provenance-io/provenance/x/ibchooks/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// setupForwardPath opens a second transfer channel between chains A and B so that packets received by chain B can be forwarded.
+func (suite *HooksTestSuite) setupForwardPath() *ibctesting.Path {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+	return path
+}
+
+// sendToB sends a transfer from chain A to chain B over the main path and receives it on chain B.
+func (suite *HooksTestSuite) sendToB(msg *transfertypes.MsgTransfer) (channeltypes.Packet, *abci.ExecTxResult) {
+	sendResult, err := suite.chainA.SendMsgsNoCheck(&suite.Suite, msg)
+	suite.Require().NoError(err, "SendMsgsNoCheck()")
+	packet, err := ibctesting.ParsePacketFromEvents(sendResult.GetEvents())
+	suite.Require().NoError(err, "ParsePacketFromEvents() sent packet")
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient(), "EndpointB.UpdateClient()")
+	recvResult, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err, "RecvPacketWithResult() on chain B")
+	return packet, recvResult
+}
+
+// ackOnA relays the acknowledgement that chain B wrote for a packet sent by chain A over the main path.
+func (suite *HooksTestSuite) ackOnA(packet channeltypes.Packet, ack []byte) {
+	suite.requireAckWritten(suite.chainB, packet, ack)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient(), "EndpointA.UpdateClient()")
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ack), "AcknowledgePacket() on chain A")
+}
+
+// requireAckWritten checks that the receiving chain has written the expected acknowledgement for a packet.
+func (suite *HooksTestSuite) requireAckWritten(chain *testutil.TestChain, packet channeltypes.Packet, ack []byte) {
+	commitment, found := chain.GetProvenanceApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found, "GetPacketAcknowledgement() found")
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack), commitment, "acknowledgement commitment")
+}
+
+// timeoutOnB times out a packet sent by chain B, returning the result so that any resent packet can be found.
+func (suite *HooksTestSuite) timeoutOnB(path *ibctesting.Path, packet channeltypes.Packet) *abci.ExecTxResult {
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.coordinator.CommitBlock(suite.chainA.TestChain)
+	suite.Require().NoError(path.EndpointB.UpdateClient(), "EndpointB.UpdateClient()")
+
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	nextSeqRecv, found := suite.chainA.GetProvenanceApp().IBCKeeper.ChannelKeeper.GetNextSequenceRecv(
+		suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found, "GetNextSequenceRecv() found")
+	msg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err, "SendMsgs() timeout on chain B")
+	return res
+}
+
+// forwardIntermediary returns the address on chain B that holds the funds sent from chain A until they're forwarded.
+func (suite *HooksTestSuite) forwardIntermediary() sdk.AccAddress {
+	intermediary, err := keeper.DeriveForwardIntermediary(suite.path.EndpointB.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), sdk.GetConfig().GetBech32AccountAddrPrefix())
+	suite.Require().NoError(err, "DeriveForwardIntermediary()")
+	return sdk.MustAccAddressFromBech32(intermediary)
+}
+
+func (suite *HooksTestSuite) TestForwardPacket() {
+	forwardPath := suite.setupForwardPath()
+	chainAAddr := suite.chainA.SenderAccount.GetAddress()
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","channel":"%s"}}`, chainAAddr, forwardPath.EndpointB.ChannelID)
+	packet, recvResult := suite.sendToB(NewMsgTransfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), chainAAddr.String(), "pfm", memo))
+
+	// The received packet isn't acknowledged until the forwarded one is.
+	_, found := suite.chainB.GetProvenanceApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found, "original packet acknowledged before the forwarded packet")
+
+	forwarded, err := ibctesting.ParsePacketFromEvents(recvResult.GetEvents())
+	suite.Require().NoError(err, "ParsePacketFromEvents() forwarded packet")
+	suite.Require().Equal(forwardPath.EndpointB.ChannelID, forwarded.GetSourceChannel(), "forwarded packet channel")
+	inFlight, err := suite.chainB.GetProvenanceApp().IBCHooksKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwarded.GetSourceChannel(), forwarded.GetSequence())
+	suite.Require().NoError(err, "GetInFlightPacket()")
+	suite.Require().NotNil(inFlight, "in-flight packet")
+	suite.Assert().Equal(packet, inFlight.OriginalPacket, "in-flight original packet")
+
+	// Relay the forwarded packet to chain A and its ack back to chain B.
+	suite.Require().NoError(forwardPath.EndpointA.UpdateClient(), "forward EndpointA.UpdateClient()")
+	fwdRecvResult, err := forwardPath.EndpointA.RecvPacketWithResult(forwarded)
+	suite.Require().NoError(err, "RecvPacketWithResult() on chain A")
+	fwdAck, err := ibctesting.ParseAckFromEvents(fwdRecvResult.GetEvents())
+	suite.Require().NoError(err, "ParseAckFromEvents() forwarded packet")
+	suite.Require().NoError(forwardPath.EndpointB.UpdateClient(), "forward EndpointB.UpdateClient()")
+	suite.Require().NoError(forwardPath.EndpointB.AcknowledgePacket(forwarded, fwdAck), "AcknowledgePacket() on chain B")
+
+	// Chain B passes the forwarded packet's ack on as the ack of the original packet.
+	suite.ackOnA(packet, fwdAck)
+	inFlight, err = suite.chainB.GetProvenanceApp().IBCHooksKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwarded.GetSourceChannel(), forwarded.GetSequence())
+	suite.Require().NoError(err, "GetInFlightPacket() after ack")
+	suite.Assert().Nil(inFlight, "in-flight packet after ack")
+
+	// The funds end up on chain A by way of chain B's second channel.
+	denomOnB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	denomOnA := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(forwardPath.EndpointA.ChannelConfig.PortID, forwardPath.EndpointA.ChannelID, denomOnB.GetFullDenomPath()))
+	balance := suite.chainA.GetProvenanceApp().BankKeeper.GetBalance(suite.chainA.GetContext(), chainAAddr, denomOnA.IBCDenom())
+	suite.Assert().Equal(sdkmath.NewInt(1000), balance.Amount, "chain A receiver balance")
+	balance = suite.chainB.GetProvenanceApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.forwardIntermediary(), denomOnB.IBCDenom())
+	suite.Assert().Equal(sdkmath.ZeroInt(), balance.Amount, "chain B intermediary balance")
+	escrow := transfertypes.GetEscrowAddress(forwardPath.EndpointB.ChannelConfig.PortID, forwardPath.EndpointB.ChannelID)
+	balance = suite.chainB.GetProvenanceApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrow, denomOnB.IBCDenom())
+	suite.Assert().Equal(sdkmath.NewInt(1000), balance.Amount, "chain B forward channel escrow balance")
+}
+
+func (suite *HooksTestSuite) TestForwardPacketRefundedOnAckError() {
+	forwardPath := suite.setupForwardPath()
+	chainAAddr := suite.chainA.SenderAccount.GetAddress()
+	escrowA := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	memo := fmt.Sprintf(`{"forward":{"receiver":"INVALID","channel":"%s"}}`, forwardPath.EndpointB.ChannelID)
+	packet, recvResult := suite.sendToB(NewMsgTransfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), chainAAddr.String(), "pfm", memo))
+	balance := suite.chainA.GetProvenanceApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowA, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdkmath.NewInt(1000), balance.Amount, "chain A escrow balance after send")
+
+	// Chain A rejects the forwarded packet because of its receiver.
+	forwarded, err := ibctesting.ParsePacketFromEvents(recvResult.GetEvents())
+	suite.Require().NoError(err, "ParsePacketFromEvents() forwarded packet")
+	suite.Require().NoError(forwardPath.EndpointA.UpdateClient(), "forward EndpointA.UpdateClient()")
+	fwdRecvResult, err := forwardPath.EndpointA.RecvPacketWithResult(forwarded)
+	suite.Require().NoError(err, "RecvPacketWithResult() on chain A")
+	fwdAck, err := ibctesting.ParseAckFromEvents(fwdRecvResult.GetEvents())
+	suite.Require().NoError(err, "ParseAckFromEvents() forwarded packet")
+	suite.Require().True(ibchooks.IsJSONAckError(fwdAck), "forwarded packet ack is an error")
+	suite.Require().NoError(forwardPath.EndpointB.UpdateClient(), "forward EndpointB.UpdateClient()")
+	suite.Require().NoError(forwardPath.EndpointB.AcknowledgePacket(forwarded, fwdAck), "AcknowledgePacket() on chain B")
+
+	// Chain B undoes the receive and acks the original packet with an error so chain A refunds the sender.
+	suite.ackOnA(packet, channeltypes.NewErrorAcknowledgement(ibchookstypes.ErrPacketForwardError).Acknowledgement())
+	denomOnB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	supply := suite.chainB.GetProvenanceApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomOnB.IBCDenom())
+	suite.Assert().Equal(sdkmath.ZeroInt(), supply.Amount, "chain B supply of the received denom")
+	balance = suite.chainA.GetProvenanceApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowA, sdk.DefaultBondDenom)
+	suite.Assert().Equal(sdkmath.ZeroInt(), balance.Amount, "chain A escrow balance after refund")
+}
+
+func (suite *HooksTestSuite) TestForwardPacketRetriedOnTimeout() {
+	forwardPath := suite.setupForwardPath()
+	chainAAddr := suite.chainA.SenderAccount.GetAddress()
+	escrowA := transfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","channel":"%s","timeout":"1m","retries":1}}`, chainAAddr, forwardPath.EndpointB.ChannelID)
+	packet, recvResult := suite.sendToB(NewMsgTransfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), chainAAddr.String(), "pfm", memo))
+	forwarded, err := ibctesting.ParsePacketFromEvents(recvResult.GetEvents())
+	suite.Require().NoError(err, "ParsePacketFromEvents() forwarded packet")
+
+	// The first timeout resends the packet.
+	timeoutResult := suite.timeoutOnB(forwardPath, forwarded)
+	resent, err := ibctesting.ParsePacketFromEvents(timeoutResult.GetEvents())
+	suite.Require().NoError(err, "ParsePacketFromEvents() resent packet")
+	suite.Assert().Equal(forwarded.GetSequence()+1, resent.GetSequence(), "resent packet sequence")
+	keeper := suite.chainB.GetProvenanceApp().IBCHooksKeeper
+	inFlight, err := keeper.GetInFlightPacket(suite.chainB.GetContext(), forwarded.GetSourceChannel(), forwarded.GetSequence())
+	suite.Require().NoError(err, "GetInFlightPacket() timed out packet")
+	suite.Assert().Nil(inFlight, "in-flight timed out packet")
+	inFlight, err = keeper.GetInFlightPacket(suite.chainB.GetContext(), resent.GetSourceChannel(), resent.GetSequence())
+	suite.Require().NoError(err, "GetInFlightPacket() resent packet")
+	suite.Require().NotNil(inFlight, "in-flight resent packet")
+	suite.Assert().Equal(uint8(0), inFlight.RetriesRemaining, "in-flight resent packet retries remaining")
+
+	// Without any retries left, the second timeout refunds the original packet.
+	timeoutResult = suite.timeoutOnB(forwardPath, resent)
+	_, err = ibctesting.ParsePacketFromEvents(timeoutResult.GetEvents())
+	suite.Require().Error(err, "ParsePacketFromEvents() after the last timeout")
+	suite.ackOnA(packet, channeltypes.NewErrorAcknowledgement(ibchookstypes.ErrPacketForwardError).Acknowledgement())
+	balance := suite.chainA.GetProvenanceApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowA, sdk.DefaultBondDenom)
+	suite.Assert().Equal(sdkmath.ZeroInt(), balance.Amount, "chain A escrow balance after refund")
+	balance = suite.chainB.GetProvenanceApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.forwardIntermediary(), inFlight.Token.Denom)
+	suite.Assert().Equal(sdkmath.ZeroInt(), balance.Amount, "chain B intermediary balance after refund")
+}
+
+func (suite *HooksTestSuite) TestForwardPacketInvalidMemo() {
+	chainAAddr := suite.chainA.SenderAccount.GetAddress()
+	memo := `{"forward":{"receiver":"someone","channel":"bad"}}`
+	_, recvResult := suite.sendToB(NewMsgTransfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), chainAAddr.String(), "pfm", memo))
+	ack, err := ibctesting.ParseAckFromEvents(recvResult.GetEvents())
+	suite.Require().NoError(err, "ParseAckFromEvents()")
+	suite.Assert().Equal(channeltypes.NewErrorAcknowledgement(ibchookstypes.ErrPacketForwardError).Acknowledgement(), ack, "ack of packet with an invalid forward memo")
+}
+
+func (suite *HooksTestSuite) TestForwardPacketRestrictedMarker() {
+	forwardPath := suite.setupForwardPath()
+	chainA := suite.chainA.GetProvenanceApp()
+	chainAAddr := suite.chainA.SenderAccount.GetAddress()
+
+	hotdogs := "hotdogs"
+	marker := markertypes.NewMarkerAccount(
+		chainA.AccountKeeper.NewAccountWithAddress(suite.chainA.GetContext(), markertypes.MustGetMarkerAddress(hotdogs)).(*authtypes.BaseAccount),
+		sdk.NewInt64Coin(hotdogs, 10000),
+		chainAAddr,
+		[]markertypes.AccessGrant{
+			{Address: chainAAddr.String(), Permissions: markertypes.AccessList{markertypes.Access_Transfer, markertypes.Access_Withdraw}},
+		},
+		markertypes.StatusProposed,
+		markertypes.MarkerType_RestrictedCoin,
+		true,  // supply fixed
+		true,  // allow gov
+		false, // no force transfer
+		[]string{},
+	)
+	err := chainA.MarkerKeeper.AddFinalizeAndActivateMarker(suite.chainA.GetContext(), marker)
+	suite.Require().NoError(err, "chainA AddFinalizeAndActivateMarker()")
+	err = chainA.MarkerKeeper.WithdrawCoins(suite.chainA.GetContext(), chainAAddr, chainAAddr, hotdogs, sdk.NewCoins(sdk.NewInt64Coin(hotdogs, 55)))
+	suite.Require().NoError(err, "chainA WithdrawCoins()")
+
+	// The restricted marker on chain B only lets chain A's sender transfer the funds, so the intermediary can't forward them.
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","channel":"%s"}}`, chainAAddr, forwardPath.EndpointB.ChannelID)
+	packet, recvResult := suite.sendToB(NewMsgTransfer(sdk.NewInt64Coin(hotdogs, 55), chainAAddr.String(), "pfm", memo))
+	ack, err := ibctesting.ParseAckFromEvents(recvResult.GetEvents())
+	suite.Require().NoError(err, "ParseAckFromEvents()")
+	suite.Assert().Equal(channeltypes.NewErrorAcknowledgement(ibchookstypes.ErrPacketForwardError).Acknowledgement(), ack, "ack of restricted forward")
+	suite.requireAckWritten(suite.chainB, packet, ack)
+
+	// The error ack reverts the receive, so chain B has none of the funds.
+	denomOnB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, hotdogs))
+	supply := suite.chainB.GetProvenanceApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomOnB.IBCDenom())
+	suite.Assert().Equal(sdkmath.ZeroInt(), supply.Amount, "chain B supply of the received denom")
+	_, err = ibctesting.ParsePacketFromEvents(recvResult.GetEvents())
+	suite.Assert().Error(err, "ParsePacketFromEvents() forwarded packet")
+}
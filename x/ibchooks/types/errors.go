@@ -19,4 +19,5 @@ var (
 	ErrAckPacketMismatch   = errorsmod.Register("wasm-hooks", 10, "packet does not match the expected packet")
 	ErrInvalidContractAddr = errorsmod.Register("wasm-hooks", 11, "invalid contract address")
 	ErrMarkerError         = errorsmod.Register("marker-hooks", 12, "marker error")
+	ErrPacketForwardError  = errorsmod.Register("packet-forward-hooks", 13, "packet forward error")
 )
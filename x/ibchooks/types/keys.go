@@ -6,13 +6,15 @@ const (
 
 	IBCCallbackKey = "ibc_callback"
 	IBCAsyncAckKey = "ibc_async_ack"
+	IBCForwardKey  = "forward"
 
 	MsgEmitAckKey           = "emit_ack"
 	AttributeSender         = "sender"
 	AttributeChannel        = "channel"
 	AttributePacketSequence = "sequence"
 
-	SenderPrefix = "ibc-wasm-hook-intermediary"
+	SenderPrefix        = "ibc-wasm-hook-intermediary"
+	ForwardSenderPrefix = "ibc-packet-forward-intermediary"
 )
 
 var (
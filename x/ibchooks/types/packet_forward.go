@@ -0,0 +1,160 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultForwardTimeout is how long a forwarded packet has to be relayed when the forward memo doesn't have a timeout.
+const DefaultForwardTimeout = 10 * time.Minute
+
+// MaxForwardTimeout is the longest that a forward memo can give a forwarded packet to be relayed.
+const MaxForwardTimeout = 7 * 24 * time.Hour
+
+// ForwardMemo parent forward struct for memo json
+type ForwardMemo struct {
+	Forward ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata child structure for forward memo that defines where the received funds are sent next
+type ForwardMetadata struct {
+	// Receiver is the address that receives the funds on the next chain.
+	Receiver string `json:"receiver"`
+	// Port is the port (on this chain) used to forward the funds. Defaults to transfer.
+	Port string `json:"port,omitempty"`
+	// Channel is the channel (on this chain) used to forward the funds.
+	Channel string `json:"channel"`
+	// Timeout is how long the forwarded packet has to be relayed. Defaults to DefaultForwardTimeout.
+	Timeout Duration `json:"timeout,omitempty"`
+	// Retries is the number of times the forwarded packet is resent if it times out.
+	Retries uint8 `json:"retries,omitempty"`
+	// Next is the memo of the forwarded packet, either a json object or a string.
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// Validate returns an error if the forward metadata is not valid
+func (m ForwardMetadata) Validate() error {
+	if len(m.Receiver) == 0 {
+		return errors.New("forward receiver cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(m.GetPort()); err != nil {
+		return fmt.Errorf("invalid forward port %q: %s", m.Port, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return fmt.Errorf("invalid forward channel %q: %s", m.Channel, err.Error())
+	}
+	if m.Timeout < 0 {
+		return fmt.Errorf("forward timeout %s cannot be negative", time.Duration(m.Timeout))
+	}
+	if m.Timeout > Duration(MaxForwardTimeout) {
+		return fmt.Errorf("forward timeout %s cannot be more than %s", time.Duration(m.Timeout), MaxForwardTimeout)
+	}
+	if _, err := m.NextMemo(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetPort returns the port used to forward the funds
+func (m ForwardMetadata) GetPort() string {
+	if len(m.Port) == 0 {
+		return transfertypes.PortID
+	}
+	return m.Port
+}
+
+// GetTimeout returns how long the forwarded packet has to be relayed, capped at MaxForwardTimeout
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	switch {
+	case m.Timeout <= 0:
+		return DefaultForwardTimeout
+	case m.Timeout > Duration(MaxForwardTimeout):
+		return MaxForwardTimeout
+	}
+	return time.Duration(m.Timeout)
+}
+
+// NextMemo returns the memo to use on the forwarded packet
+func (m ForwardMetadata) NextMemo() (string, error) {
+	next := bytes.TrimSpace(m.Next)
+	if len(next) == 0 || bytes.Equal(next, []byte("null")) {
+		return "", nil
+	}
+	switch next[0] {
+	case '"':
+		var memo string
+		if err := json.Unmarshal(next, &memo); err != nil {
+			return "", fmt.Errorf("invalid forward next: %w", err)
+		}
+		return memo, nil
+	case '{':
+		var memo bytes.Buffer
+		if err := json.Compact(&memo, next); err != nil {
+			return "", fmt.Errorf("invalid forward next: %w", err)
+		}
+		return memo.String(), nil
+	}
+	return "", fmt.Errorf("invalid forward next: %s is not a json object or string", next)
+}
+
+// Duration is a time.Duration that can be provided in json as either a duration string (e.g. "10m") or a number of nanoseconds
+type Duration time.Duration
+
+// MarshalJSON returns the duration as a json duration string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON reads the duration from either a json duration string or a number of nanoseconds
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case float64:
+		*d = Duration(time.Duration(v))
+	case string:
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", v, err)
+		}
+		*d = Duration(duration)
+	default:
+		return fmt.Errorf("invalid duration %s", bz)
+	}
+	return nil
+}
+
+// InFlightPacket is a received packet whose funds have been forwarded, and is waiting on the ack or timeout of the forwarded packet
+type InFlightPacket struct {
+	// OriginalPacket is the received packet that is acknowledged once the forwarded packet is.
+	OriginalPacket channeltypes.Packet `json:"original_packet"`
+	// Intermediary is the address that held the received funds before they were forwarded.
+	Intermediary string `json:"intermediary"`
+	// Token is the received funds, using their denom on this chain.
+	Token sdk.Coin `json:"token"`
+	// Forward is the forward metadata from the received packet's memo.
+	Forward ForwardMetadata `json:"forward"`
+	// RetriesRemaining is the number of times the forwarded packet can still be resent if it times out.
+	RetriesRemaining uint8 `json:"retries_remaining"`
+}
+
+// NewInFlightPacket returns a new in-flight packet that can be retried as many times as the forward metadata allows
+func NewInFlightPacket(originalPacket channeltypes.Packet, intermediary string, token sdk.Coin, forward ForwardMetadata) InFlightPacket {
+	return InFlightPacket{
+		OriginalPacket:   originalPacket,
+		Intermediary:     intermediary,
+		Token:            token,
+		Forward:          forward,
+		RetriesRemaining: forward.Retries,
+	}
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+func (s *IbcHooksTypesTestSuite) TestForwardMetadataValidate() {
+	testCases := []struct {
+		name     string
+		metadata ForwardMetadata
+		expErr   string
+	}{
+		{
+			name:     "valid with defaults",
+			metadata: ForwardMetadata{Receiver: "receiver", Channel: "channel-1"},
+		},
+		{
+			name:     "valid with all fields",
+			metadata: ForwardMetadata{Receiver: "receiver", Port: "transfer", Channel: "channel-1", Timeout: Duration(time.Hour), Retries: 2, Next: json.RawMessage(`{"forward":{}}`)},
+		},
+		{
+			name:     "empty receiver",
+			metadata: ForwardMetadata{Channel: "channel-1"},
+			expErr:   "forward receiver cannot be empty",
+		},
+		{
+			name:     "invalid port",
+			metadata: ForwardMetadata{Receiver: "receiver", Port: "x", Channel: "channel-1"},
+			expErr:   `invalid forward port "x": identifier x has invalid length: 1, must be between 2-128 characters: invalid identifier`,
+		},
+		{
+			name:     "invalid channel",
+			metadata: ForwardMetadata{Receiver: "receiver", Channel: "bad"},
+			expErr:   `invalid forward channel "bad": identifier bad has invalid length: 3, must be between 8-64 characters: invalid identifier`,
+		},
+		{
+			name:     "negative timeout",
+			metadata: ForwardMetadata{Receiver: "receiver", Channel: "channel-1", Timeout: Duration(-time.Second)},
+			expErr:   "forward timeout -1s cannot be negative",
+		},
+		{
+			name:     "max timeout",
+			metadata: ForwardMetadata{Receiver: "receiver", Channel: "channel-1", Timeout: Duration(MaxForwardTimeout)},
+		},
+		{
+			name:     "timeout too long",
+			metadata: ForwardMetadata{Receiver: "receiver", Channel: "channel-1", Timeout: Duration(MaxForwardTimeout + time.Second)},
+			expErr:   "forward timeout 168h0m1s cannot be more than 168h0m0s",
+		},
+		{
+			name:     "invalid next",
+			metadata: ForwardMetadata{Receiver: "receiver", Channel: "channel-1", Next: json.RawMessage(`[1]`)},
+			expErr:   "invalid forward next: [1] is not a json object or string",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.metadata.Validate()
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "Validate()")
+			} else {
+				s.Require().NoError(err, "Validate()")
+			}
+		})
+	}
+}
+
+func (s *IbcHooksTypesTestSuite) TestForwardMetadataDefaults() {
+	metadata := ForwardMetadata{Receiver: "receiver", Channel: "channel-1"}
+	s.Assert().Equal("transfer", metadata.GetPort(), "GetPort() default")
+	s.Assert().Equal(DefaultForwardTimeout, metadata.GetTimeout(), "GetTimeout() default")
+
+	metadata.Port = "custom"
+	metadata.Timeout = Duration(time.Minute)
+	s.Assert().Equal("custom", metadata.GetPort(), "GetPort()")
+	s.Assert().Equal(time.Minute, metadata.GetTimeout(), "GetTimeout()")
+
+	metadata.Timeout = Duration(MaxForwardTimeout + time.Hour)
+	s.Assert().Equal(MaxForwardTimeout, metadata.GetTimeout(), "GetTimeout() over the max")
+}
+
+func (s *IbcHooksTypesTestSuite) TestForwardMetadataNextMemo() {
+	testCases := []struct {
+		name    string
+		next    string
+		expMemo string
+		expErr  string
+	}{
+		{name: "no next", next: ""},
+		{name: "null next", next: "null"},
+		{name: "object next", next: `{ "forward": { "receiver": "r" } }`, expMemo: `{"forward":{"receiver":"r"}}`},
+		{name: "string next", next: `"a memo"`, expMemo: "a memo"},
+		{name: "number next", next: "5", expErr: "invalid forward next: 5 is not a json object or string"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			metadata := ForwardMetadata{Next: json.RawMessage(tc.next)}
+			memo, err := metadata.NextMemo()
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "NextMemo()")
+			} else {
+				s.Require().NoError(err, "NextMemo()")
+			}
+			s.Assert().Equal(tc.expMemo, memo, "NextMemo()")
+		})
+	}
+}
+
+func (s *IbcHooksTypesTestSuite) TestForwardMemoJsonSerialization() {
+	var memo ForwardMemo
+	err := json.Unmarshal([]byte(`{"forward":{"receiver":"receiver","channel":"channel-1","timeout":"5m","retries":2}}`), &memo)
+	s.Require().NoError(err, "Unmarshal() string timeout")
+	s.Assert().Equal(ForwardMetadata{Receiver: "receiver", Channel: "channel-1", Timeout: Duration(5 * time.Minute), Retries: 2}, memo.Forward, "unmarshalled forward memo")
+
+	err = json.Unmarshal([]byte(`{"forward":{"receiver":"receiver","channel":"channel-1","timeout":60000000000}}`), &memo)
+	s.Require().NoError(err, "Unmarshal() nanosecond timeout")
+	s.Assert().Equal(Duration(time.Minute), memo.Forward.Timeout, "unmarshalled nanosecond timeout")
+
+	err = json.Unmarshal([]byte(`{"forward":{"receiver":"receiver","channel":"channel-1","timeout":"soon"}}`), &memo)
+	s.Require().EqualError(err, `invalid duration "soon": time: invalid duration "soon"`, "Unmarshal() invalid timeout")
+
+	bz, err := json.Marshal(ForwardMemo{Forward: ForwardMetadata{Receiver: "receiver", Channel: "channel-1", Timeout: Duration(time.Hour)}})
+	s.Require().NoError(err, "Marshal()")
+	s.Assert().Equal(`{"forward":{"receiver":"receiver","channel":"channel-1","timeout":"1h0m0s"}}`, string(bz), "marshalled forward memo")
+}
@@ -3,6 +3,7 @@ package cli_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -90,6 +91,11 @@ func (s *TestSuite) SetupSuite() {
 
 	s.ratelimiter = "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
 	ratelimitData := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(s.ratelimiter))
+	ratelimitData.RateLimits = []ibcratelimit.RateLimit{
+		ibcratelimit.NewRateLimit("nhash", "channel-0",
+			ibcratelimit.NewQuota(0, 0, sdkmath.NewInt(5000), sdkmath.ZeroInt(), time.Hour),
+			ibcratelimit.NewFlow(sdkmath.NewInt(200_000_000), time.Unix(1_700_000_000, 0).UTC())),
+	}
 
 	ratelimitDataBz, err := s.cfg.Codec.MarshalJSON(ratelimitData)
 	s.Require().NoError(err, "should be able to marshal ibcratelimit genesis state when setting up suite")
@@ -194,3 +200,155 @@ func (s *TestSuite) TestParamsUpdate() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetRateLimits() {
+	clientCtx := s.network.Validators[0].ClientCtx
+	cmd := ibcratelimitcli.GetRateLimitsCmd()
+	args := []string{fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+	s.Require().NoError(err, "ExecTestCLICmd rate-limits")
+	var response ibcratelimit.QueryRateLimitsResponse
+	s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), &response), "UnmarshalJSON rate-limits response")
+	s.Require().Len(response.RateLimits, 1, "rate limits")
+	s.Assert().Equal("nhash", response.RateLimits[0].Denom, "rate limit denom")
+	s.Assert().Equal("channel-0", response.RateLimits[0].ChannelId, "rate limit channel")
+}
+
+func (s *TestSuite) TestGetRateLimit() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expRemaining string
+	}{
+		{
+			name:         "success - rate limit with remaining capacity",
+			args:         []string{"nhash", "channel-0"},
+			expRemaining: "5000",
+		},
+		{
+			name:         "failure - unknown rate limit",
+			args:         []string{"nhash", "channel-1"},
+			expectErrMsg: "rpc error: code = NotFound desc = rpc error: code = NotFound desc = no rate limit for nhash on channel-1: key not found",
+		},
+		{
+			name:         "failure - invalid number of args",
+			args:         []string{"nhash"},
+			expectErrMsg: "accepts 2 arg(s), received 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			clientCtx := s.network.Validators[0].ClientCtx
+			cmd := ibcratelimitcli.GetRateLimitCmd()
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+			if len(tc.expectErrMsg) > 0 {
+				s.EqualError(err, tc.expectErrMsg, "rate-limit error")
+				return
+			}
+			s.Require().NoError(err, "rate-limit error")
+			var response ibcratelimit.QueryRateLimitResponse
+			s.Require().NoError(s.cfg.Codec.UnmarshalJSON(out.Bytes(), &response), "UnmarshalJSON rate-limit response")
+			s.Require().NotNil(response.RemainingSend, "remaining send")
+			s.Assert().Equal(tc.expRemaining, response.RemainingSend.String(), "remaining send")
+			s.Assert().Nil(response.RemainingRecv, "remaining recv")
+		})
+	}
+}
+
+func (s *TestSuite) TestSetRateLimit() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+	}{
+		{
+			name:         "success - rate limit proposed",
+			args:         []string{"nhash", "channel-1", "--max-percent-send", "10", "--max-amount-recv", "1000", "--period", "24h"},
+			expectedCode: 0,
+		},
+		{
+			name:         "failure - invalid amount",
+			args:         []string{"nhash", "channel-1", "--max-amount-send", "lots", "--period", "24h"},
+			expectErrMsg: "invalid --max-amount-send \"lots\"",
+		},
+		{
+			name:         "failure - invalid quota",
+			args:         []string{"nhash", "channel-1", "--max-percent-send", "10"},
+			expectedCode: 12,
+		},
+		{
+			name:         "failure - invalid number of args",
+			args:         []string{"nhash"},
+			expectErrMsg: "accepts 2 arg(s), received 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := ibcratelimitcli.GetCmdSetRateLimit()
+			tc.args = append(tc.args,
+				"--title", "Set a rate limit", "--summary", "See title.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.network)
+		})
+	}
+}
+
+func (s *TestSuite) TestRemoveRateLimit() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+	}{
+		{
+			name:         "success - removal proposed",
+			args:         []string{"nhash", "channel-0"},
+			expectedCode: 0,
+		},
+		{
+			name:         "failure - invalid channel",
+			args:         []string{"nhash", "chan"},
+			expectedCode: 12,
+		},
+		{
+			name:         "failure - invalid number of args",
+			args:         []string{"nhash", "channel-0", "extra"},
+			expectErrMsg: "accepts 2 arg(s), received 3",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := ibcratelimitcli.GetCmdRemoveRateLimit()
+			tc.args = append(tc.args,
+				"--title", "Remove a rate limit", "--summary", "See title.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.network)
+		})
+	}
+}
@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetParamsCmd(),
+		GetRateLimitsCmd(),
+		GetRateLimitCmd(),
 	)
 
 	return queryCmd
@@ -58,3 +60,59 @@ func GetParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetRateLimitsCmd returns the command handler for querying all in-module rate limits.
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all of the in-module rate limits",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query ibcratelimit rate-limits`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.RateLimits(context.Background(), &ibcratelimit.QueryRateLimitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRateLimitCmd returns the command handler for querying the usage and remaining capacity of a rate limit.
+func GetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit <denom> <channel-id>",
+		Short:   "Query an in-module rate limit with its current usage and remaining capacity",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`$ %s query ibcratelimit rate-limit nhash channel-0`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.RateLimit(context.Background(), &ibcratelimit.QueryRateLimitRequest{Denom: args[0], ChannelId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -14,6 +16,14 @@ import (
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 )
 
+const (
+	FlagMaxPercentSend = "max-percent-send"
+	FlagMaxPercentRecv = "max-percent-recv"
+	FlagMaxAmountSend  = "max-amount-send"
+	FlagMaxAmountRecv  = "max-amount-recv"
+	FlagPeriod         = "period"
+)
+
 // NewTxCmd is the top-level command for oracle CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -27,6 +37,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		GetCmdParamsUpdate(),
+		GetCmdSetRateLimit(),
+		GetCmdRemoveRateLimit(),
 	)
 
 	return txCmd
@@ -60,3 +72,109 @@ func GetCmdParamsUpdate() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSetRateLimit is a command to add or replace an in-module rate limit.
+func GetCmdSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-rate-limit <denom> <channel-id>",
+		Short:   "Add or replace an in-module rate limit",
+		Long:    "Submit a set rate limit via governance proposal along with an initial deposit. A new period is started for the rate limit.",
+		Args:    cobra.ExactArgs(2),
+		Aliases: []string{"set"},
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc set-rate-limit nhash channel-0 --max-percent-send 10 --max-percent-recv 10 --period 24h --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			quota, err := parseQuotaFlags(cmd)
+			if err != nil {
+				return err
+			}
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibcratelimit.NewMsgSetRateLimitRequest(authority, args[0], args[1], quota)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagMaxPercentSend, 0, "Max percentage of the denom's supply that can be sent out each period")
+	cmd.Flags().Uint32(FlagMaxPercentRecv, 0, "Max percentage of the denom's supply that can be received each period")
+	cmd.Flags().String(FlagMaxAmountSend, "0", "Max amount of the denom that can be sent out each period")
+	cmd.Flags().String(FlagMaxAmountRecv, "0", "Max amount of the denom that can be received each period")
+	cmd.Flags().Duration(FlagPeriod, 0, "How long the flow is tracked before it's reset, e.g. 24h")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRemoveRateLimit is a command to remove an in-module rate limit.
+func GetCmdRemoveRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rate-limit <denom> <channel-id>",
+		Short:   "Remove an in-module rate limit",
+		Long:    "Submit a remove rate limit via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(2),
+		Aliases: []string{"remove"},
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc remove-rate-limit nhash channel-0 --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibcratelimit.NewMsgRemoveRateLimitRequest(authority, args[0], args[1])
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseQuotaFlags reads the quota flags of a command.
+func parseQuotaFlags(cmd *cobra.Command) (ibcratelimit.Quota, error) {
+	flagSet := cmd.Flags()
+	maxPercentSend, err := flagSet.GetUint32(FlagMaxPercentSend)
+	if err != nil {
+		return ibcratelimit.Quota{}, err
+	}
+	maxPercentRecv, err := flagSet.GetUint32(FlagMaxPercentRecv)
+	if err != nil {
+		return ibcratelimit.Quota{}, err
+	}
+	maxAmountSend, err := parseAmountFlag(cmd, FlagMaxAmountSend)
+	if err != nil {
+		return ibcratelimit.Quota{}, err
+	}
+	maxAmountRecv, err := parseAmountFlag(cmd, FlagMaxAmountRecv)
+	if err != nil {
+		return ibcratelimit.Quota{}, err
+	}
+	period, err := flagSet.GetDuration(FlagPeriod)
+	if err != nil {
+		return ibcratelimit.Quota{}, err
+	}
+	return ibcratelimit.NewQuota(maxPercentSend, maxPercentRecv, maxAmountSend, maxAmountRecv, period), nil
+}
+
+// parseAmountFlag reads an amount flag of a command.
+func parseAmountFlag(cmd *cobra.Command, name string) (sdkmath.Int, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	amount, ok := sdkmath.NewIntFromString(value)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("invalid --%s %q", name, value)
+	}
+	return amount, nil
+}
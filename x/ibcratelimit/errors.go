@@ -8,4 +8,5 @@ var (
 	ErrRateLimitExceeded = cerrs.Register(ModuleName, 2, "rate limit exceeded")
 	ErrBadMessage        = cerrs.Register(ModuleName, 3, "bad message")
 	ErrContractError     = cerrs.Register(ModuleName, 4, "contract error")
+	ErrUnknownRateLimit  = cerrs.Register(ModuleName, 5, "unknown rate limit")
 )
@@ -21,3 +21,19 @@ func NewEventTimeoutRevertFailure(module, packet string) *EventTimeoutRevertFail
 func NewEventParamsUpdated() *EventParamsUpdated {
 	return &EventParamsUpdated{}
 }
+
+// NewEventRateLimitUpdated returns a new EventRateLimitUpdated.
+func NewEventRateLimitUpdated(denom, channelID string) *EventRateLimitUpdated {
+	return &EventRateLimitUpdated{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// NewEventRateLimitRemoved returns a new EventRateLimitRemoved.
+func NewEventRateLimitRemoved(denom, channelID string) *EventRateLimitRemoved {
+	return &EventRateLimitRemoved{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
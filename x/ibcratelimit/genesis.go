@@ -1,5 +1,7 @@
 package ibcratelimit
 
+import "fmt"
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,7 +12,35 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.RateLimits))
+	for i, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit[%d]: %w", i, err)
+		}
+		key := string(GetRateLimitKey(rateLimit.Denom, rateLimit.ChannelId))
+		if seen[key] {
+			return fmt.Errorf("invalid rate limit[%d]: duplicate rate limit for %s on %s", i, rateLimit.Denom, rateLimit.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	seen = make(map[string]bool, len(gs.PendingSendPackets))
+	for i, pending := range gs.PendingSendPackets {
+		if err := pending.Validate(); err != nil {
+			return fmt.Errorf("invalid pending send packet[%d]: %w", i, err)
+		}
+		key := string(GetPendingSendPacketKey(pending.ChannelId, pending.Sequence))
+		if seen[key] {
+			return fmt.Errorf("invalid pending send packet[%d]: duplicate packet %d on %s", i, pending.Sequence, pending.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
 }
 
 // NewGenesisState returns a new instance of GenesisState object
@@ -27,6 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params are all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// rate_limits are the in-module rate limits.
+	RateLimits []RateLimit `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// pending_send_packets are the sent packets whose outflow is undone if they fail or time out.
+	PendingSendPackets []PendingSendPacket `protobuf:"bytes,3,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +73,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.ibcratelimit.v1.GenesisState")
 }
@@ -78,20 +96,26 @@ func init() {
 }
 
 var fileDescriptor_8046e03397972f41 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x42, 0xa8, 0xd4, 0x43, 0x56, 0xa9, 0x57, 0x66, 0x28, 0x25,
-	0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa6, 0x0f, 0x62, 0x41, 0x74, 0x48, 0xa9, 0xe3, 0x31, 0xbb,
-	0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0xb4, 0x52, 0x00, 0x17, 0x8f, 0x3b, 0xc4, 0xae, 0xe0, 0x92,
-	0xc4, 0x92, 0x54, 0x21, 0x07, 0x2e, 0x36, 0x88, 0xbc, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0x92, 0x1e, 0x6e, 0xbb, 0xf5, 0x02, 0xc0, 0x2a, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82,
-	0xea, 0x73, 0xca, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x06, 0x2e, 0xd9, 0xcc,
-	0x7c, 0x3c, 0xa6, 0x05, 0x30, 0x46, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x23, 0x14, 0xea, 0x66, 0xe6, 0x23, 0xf1, 0xf4, 0x2b, 0x50, 0x3c, 0x94, 0xc4, 0x06,
-	0xf6, 0x87, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x58, 0x77, 0xf4, 0x5d, 0x4e, 0x01, 0x00, 0x00,
+	// 296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0xc7, 0x9b, 0x4d, 0x76, 0xc8, 0x3c, 0x95, 0x1d, 0x46, 0xc1, 0x38, 0x06, 0xe2, 0x40, 0x96,
+	0xb0, 0xf9, 0x02, 0xb2, 0x8b, 0x97, 0x1d, 0xc6, 0x76, 0xf3, 0x32, 0xd2, 0xf6, 0xa3, 0x06, 0x6d,
+	0x12, 0x9a, 0x58, 0x7c, 0x01, 0xef, 0x3e, 0xd6, 0x8e, 0x3b, 0x7a, 0x12, 0x69, 0x5f, 0x44, 0x9a,
+	0x56, 0x3a, 0x05, 0x7b, 0xeb, 0xd7, 0xfc, 0xfe, 0xbf, 0xef, 0x4f, 0x82, 0x67, 0x3a, 0x53, 0x39,
+	0x48, 0x2e, 0x23, 0x60, 0x22, 0x8c, 0x32, 0x6e, 0xe1, 0x59, 0xa4, 0xc2, 0xb2, 0x7c, 0xc1, 0x12,
+	0x90, 0x60, 0x84, 0xa1, 0x3a, 0x53, 0x56, 0xf9, 0x41, 0x4b, 0xd2, 0x53, 0x92, 0xe6, 0x8b, 0x60,
+	0x94, 0xa8, 0x44, 0x39, 0x8c, 0x55, 0x5f, 0x75, 0x22, 0xb8, 0xee, 0x70, 0x6b, 0x9e, 0xf1, 0xb4,
+	0x51, 0x07, 0x37, 0x1d, 0x60, 0x35, 0xec, 0xeb, 0x45, 0x0e, 0x9e, 0xbe, 0xf5, 0xf0, 0xf9, 0x7d,
+	0xdd, 0x6c, 0x67, 0xb9, 0x05, 0xff, 0x0e, 0x0f, 0x6a, 0xdb, 0x18, 0x4d, 0xd0, 0x6c, 0xb8, 0x9c,
+	0xd2, 0xff, 0x9b, 0xd2, 0x8d, 0x23, 0x57, 0x67, 0x87, 0xcf, 0x4b, 0x6f, 0xdb, 0xe4, 0xfc, 0x35,
+	0x1e, 0xb6, 0x6b, 0xcc, 0xb8, 0x37, 0xe9, 0xcf, 0x86, 0xcb, 0xab, 0x2e, 0xcd, 0x96, 0x5b, 0x58,
+	0x57, 0x43, 0x63, 0xc2, 0xd9, 0xcf, 0x0f, 0xe3, 0x03, 0x1e, 0x69, 0x90, 0xb1, 0x90, 0xc9, 0xde,
+	0x80, 0x8c, 0xf7, 0x9a, 0x47, 0x4f, 0x60, 0xcd, 0xb8, 0xef, 0xb4, 0xf3, 0xce, 0x76, 0x75, 0x6e,
+	0x07, 0x32, 0xde, 0xb8, 0x54, 0xa3, 0xf7, 0xf5, 0xdf, 0x03, 0xb3, 0x4a, 0x0f, 0x05, 0x41, 0xc7,
+	0x82, 0xa0, 0xaf, 0x82, 0xa0, 0xf7, 0x92, 0x78, 0xc7, 0x92, 0x78, 0x1f, 0x25, 0xf1, 0xf0, 0x85,
+	0x50, 0x1d, 0x4b, 0x36, 0xe8, 0x61, 0x99, 0x08, 0xfb, 0xf8, 0x12, 0xd2, 0x48, 0xa5, 0xac, 0x05,
+	0xe7, 0x42, 0x9d, 0x4c, 0xec, 0xf5, 0xd7, 0x53, 0x84, 0x03, 0x77, 0xfb, 0xb7, 0xdf, 0x03, 0x00,
+	0x43, 0x35, 0x53, 0x97, 0x31, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +138,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +198,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +281,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
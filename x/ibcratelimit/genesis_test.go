@@ -2,6 +2,9 @@ package ibcratelimit_test
 
 import (
 	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 	"github.com/stretchr/testify/assert"
@@ -72,3 +75,55 @@ func TestNewGenesisState(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisValidateRateLimits(t *testing.T) {
+	quota := ibcratelimit.NewQuota(10, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour)
+	flow := ibcratelimit.NewFlow(sdkmath.NewInt(1000), time.Unix(1_700_000_000, 0))
+	rateLimit := ibcratelimit.NewRateLimit("nhash", "channel-0", quota, flow)
+	pending := ibcratelimit.NewPendingSendPacket("channel-0", 4, flow.PeriodStart)
+
+	tests := []struct {
+		name    string
+		genesis ibcratelimit.GenesisState
+		err     string
+	}{
+		{
+			name: "success - rate limits and pending packets",
+			genesis: ibcratelimit.GenesisState{
+				RateLimits:         []ibcratelimit.RateLimit{rateLimit, ibcratelimit.NewRateLimit("nhash", "channel-1", quota, flow)},
+				PendingSendPackets: []ibcratelimit.PendingSendPacket{pending, ibcratelimit.NewPendingSendPacket("channel-0", 5, flow.PeriodStart)},
+			},
+		},
+		{
+			name:    "failure - invalid rate limit",
+			genesis: ibcratelimit.GenesisState{RateLimits: []ibcratelimit.RateLimit{ibcratelimit.NewRateLimit("nhash", "channel-0", ibcratelimit.Quota{}, flow)}},
+			err:     "invalid rate limit[0]: invalid quota: max amounts cannot be empty",
+		},
+		{
+			name:    "failure - duplicate rate limit",
+			genesis: ibcratelimit.GenesisState{RateLimits: []ibcratelimit.RateLimit{rateLimit, rateLimit}},
+			err:     "invalid rate limit[1]: duplicate rate limit for nhash on channel-0",
+		},
+		{
+			name:    "failure - invalid pending packet",
+			genesis: ibcratelimit.GenesisState{PendingSendPackets: []ibcratelimit.PendingSendPacket{ibcratelimit.NewPendingSendPacket("channel-0", 0, flow.PeriodStart)}},
+			err:     "invalid pending send packet[0]: invalid sequence: cannot be zero",
+		},
+		{
+			name:    "failure - duplicate pending packet",
+			genesis: ibcratelimit.GenesisState{PendingSendPackets: []ibcratelimit.PendingSendPacket{pending, pending}},
+			err:     "invalid pending send packet[1]: duplicate packet 4 on channel-0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
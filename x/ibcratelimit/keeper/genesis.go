@@ -13,9 +13,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *ibcratelimit.GenesisState {
 		panic(err)
 	}
 
-	return &ibcratelimit.GenesisState{
+	genState := &ibcratelimit.GenesisState{
 		Params: params,
 	}
+
+	err = k.IterateRateLimits(ctx, func(rateLimit ibcratelimit.RateLimit) bool {
+		genState.RateLimits = append(genState.RateLimits, rateLimit)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IteratePendingSendPackets(ctx, func(pending ibcratelimit.PendingSendPacket) bool {
+		genState.PendingSendPackets = append(genState.PendingSendPackets, pending)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return genState
 }
 
 // InitGenesis new ibcratelimit genesis
@@ -24,4 +42,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *ibcratelimit.GenesisState) {
 		panic(err)
 	}
 	k.SetParams(ctx, data.Params)
+
+	for _, rateLimit := range data.RateLimits {
+		if err := k.StoreRateLimit(ctx, rateLimit); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, pending := range data.PendingSendPackets {
+		if err := k.StorePendingSendPacket(ctx, pending); err != nil {
+			panic(err)
+		}
+	}
 }
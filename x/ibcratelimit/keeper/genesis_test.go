@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
@@ -17,3 +21,25 @@ func (s *TestSuite) TestInitExportGenesis() {
 	exportedGenesis := k.ExportGenesis(s.ctx)
 	s.Assert().Equal(initialGenesis, exportedGenesis)
 }
+
+func (s *TestSuite) TestInitExportGenesisRateLimits() {
+	k := s.app.RateLimitingKeeper
+	start := time.Unix(1_700_000_000, 0).UTC()
+	quota := ibcratelimit.NewQuota(10, 5, sdkmath.ZeroInt(), sdkmath.NewInt(300), time.Hour)
+	flow := ibcratelimit.NewFlow(sdkmath.NewInt(1000), start)
+	flow.Outflow = sdkmath.NewInt(42)
+
+	initialGenesis := ibcratelimit.NewGenesisState(ibcratelimit.DefaultParams())
+	initialGenesis.RateLimits = []ibcratelimit.RateLimit{
+		ibcratelimit.NewRateLimit("nhash", "channel-0", quota, flow),
+		ibcratelimit.NewRateLimit("nhash", "channel-1", quota, ibcratelimit.NewFlow(sdkmath.NewInt(7), start)),
+	}
+	initialGenesis.PendingSendPackets = []ibcratelimit.PendingSendPacket{
+		ibcratelimit.NewPendingSendPacket("channel-0", 3, start),
+		ibcratelimit.NewPendingSendPacket("channel-0", 4, start),
+	}
+
+	k.InitGenesis(s.ctx, initialGenesis)
+	exportedGenesis := k.ExportGenesis(s.ctx)
+	s.Assert().Equal(initialGenesis, exportedGenesis)
+}
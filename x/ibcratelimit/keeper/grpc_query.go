@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
@@ -21,3 +24,44 @@ func (k Keeper) Params(ctx context.Context, _ *ibcratelimit.ParamsRequest) (*ibc
 
 	return &ibcratelimit.ParamsResponse{Params: params}, nil
 }
+
+// RateLimits returns all of the in-module rate limits.
+func (k Keeper) RateLimits(ctx context.Context, _ *ibcratelimit.QueryRateLimitsRequest) (*ibcratelimit.QueryRateLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	resp := &ibcratelimit.QueryRateLimitsResponse{}
+	err := k.IterateRateLimits(sdkCtx, func(rateLimit ibcratelimit.RateLimit) bool {
+		resp.RateLimits = append(resp.RateLimits, rateLimit)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// RateLimit returns a rate limit along with its current usage and remaining capacity.
+func (k Keeper) RateLimit(ctx context.Context, req *ibcratelimit.QueryRateLimitRequest) (*ibcratelimit.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := ibcratelimit.ValidateDenomAndChannel(req.Denom, req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rateLimit, err := k.GetCurrentRateLimit(sdkCtx, req.Denom, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if rateLimit == nil {
+		return nil, status.Errorf(codes.NotFound, "no rate limit for %s on %s", req.Denom, req.ChannelId)
+	}
+
+	return &ibcratelimit.QueryRateLimitResponse{
+		RateLimit:     *rateLimit,
+		RemainingSend: rateLimit.RemainingSend(),
+		RemainingRecv: rateLimit.RemainingRecv(),
+	}, nil
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+// GetRateLimit gets the in-module rate limit of a denom on a channel. Returns nil, nil if there isn't one.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (*ibcratelimit.RateLimit, error) {
+	bz := ctx.KVStore(k.storeKey).Get(ibcratelimit.GetRateLimitKey(denom, channelID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rateLimit ibcratelimit.RateLimit
+	if err := k.cdc.Unmarshal(bz, &rateLimit); err != nil {
+		return nil, err
+	}
+	return &rateLimit, nil
+}
+
+// StoreRateLimit stores an in-module rate limit.
+func (k Keeper) StoreRateLimit(ctx sdk.Context, rateLimit ibcratelimit.RateLimit) error {
+	bz, err := k.cdc.Marshal(&rateLimit)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(ibcratelimit.GetRateLimitKey(rateLimit.Denom, rateLimit.ChannelId), bz)
+	return nil
+}
+
+// DeleteRateLimit deletes the in-module rate limit of a denom on a channel.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelID string) {
+	ctx.KVStore(k.storeKey).Delete(ibcratelimit.GetRateLimitKey(denom, channelID))
+}
+
+// IterateRateLimits processes all the in-module rate limits with the given handler.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, handler func(rateLimit ibcratelimit.RateLimit) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ibcratelimit.RateLimitKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var rateLimit ibcratelimit.RateLimit
+		if err := k.cdc.Unmarshal(it.Value(), &rateLimit); err != nil {
+			return err
+		}
+		if handler(rateLimit) {
+			break
+		}
+	}
+	return nil
+}
+
+// NewRateLimit creates a rate limit for a denom on a channel with a new period starting now.
+func (k Keeper) NewRateLimit(ctx sdk.Context, denom, channelID string, quota ibcratelimit.Quota) ibcratelimit.RateLimit {
+	return ibcratelimit.NewRateLimit(denom, channelID, quota, k.newFlow(ctx, denom))
+}
+
+// GetCurrentRateLimit gets the in-module rate limit of a denom on a channel as of the current block,
+// i.e. with an empty flow if its period has ended. It is not saved. Returns nil, nil if there isn't one.
+//
+// If the channel value is zero (e.g. the denom had no supply when the period started), it is re-read
+// from the denom's current supply, since percentage quotas don't allow anything while it is zero.
+func (k Keeper) GetCurrentRateLimit(ctx sdk.Context, denom, channelID string) (*ibcratelimit.RateLimit, error) {
+	rateLimit, err := k.GetRateLimit(ctx, denom, channelID)
+	if err != nil || rateLimit == nil {
+		return nil, err
+	}
+	switch {
+	case rateLimit.IsPeriodOver(ctx.BlockTime()):
+		rateLimit.Flow = k.newFlow(ctx, denom)
+	case rateLimit.Flow.ChannelValue.IsNil() || rateLimit.Flow.ChannelValue.IsZero():
+		rateLimit.Flow.ChannelValue = k.bankKeeper.GetSupply(ctx, denom).Amount
+	}
+	return rateLimit, nil
+}
+
+// newFlow creates an empty flow for a period starting now, using the denom's current supply as the channel value.
+func (k Keeper) newFlow(ctx sdk.Context, denom string) ibcratelimit.Flow {
+	return ibcratelimit.NewFlow(k.bankKeeper.GetSupply(ctx, denom).Amount, ctx.BlockTime())
+}
+
+// GetPendingSendPacket gets a pending sent packet. Returns nil, nil if there isn't one.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (*ibcratelimit.PendingSendPacket, error) {
+	bz := ctx.KVStore(k.storeKey).Get(ibcratelimit.GetPendingSendPacketKey(channelID, sequence))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var pending ibcratelimit.PendingSendPacket
+	if err := k.cdc.Unmarshal(bz, &pending); err != nil {
+		return nil, err
+	}
+	return &pending, nil
+}
+
+// StorePendingSendPacket stores a pending sent packet.
+func (k Keeper) StorePendingSendPacket(ctx sdk.Context, pending ibcratelimit.PendingSendPacket) error {
+	bz, err := k.cdc.Marshal(&pending)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(ibcratelimit.GetPendingSendPacketKey(pending.ChannelId, pending.Sequence), bz)
+	return nil
+}
+
+// DeletePendingSendPacket deletes a pending sent packet.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(ibcratelimit.GetPendingSendPacketKey(channelID, sequence))
+}
+
+// IteratePendingSendPackets processes all the pending sent packets with the given handler.
+func (k Keeper) IteratePendingSendPackets(ctx sdk.Context, handler func(pending ibcratelimit.PendingSendPacket) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ibcratelimit.PendingSendPacketKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var pending ibcratelimit.PendingSendPacket
+		if err := k.cdc.Unmarshal(it.Value(), &pending); err != nil {
+			return err
+		}
+		if handler(pending) {
+			break
+		}
+	}
+	return nil
+}
+
+// checkAndUpdateModuleRateLimits adds a packet's amount to the flow of the in-module rate limit
+// for its denom and channel, returning an error if that would exceed the quota.
+// Packets without a rate limit are always allowed.
+func (k Keeper) checkAndUpdateModuleRateLimits(ctx sdk.Context, msgType string, packet exported.PacketI) error {
+	flow, err := ibcratelimit.NewPacketFlow(msgType, packet)
+	if err != nil {
+		return err
+	}
+	rateLimit, err := k.GetCurrentRateLimit(ctx, flow.Denom, flow.ChannelID)
+	if err != nil || rateLimit == nil {
+		return err
+	}
+
+	if msgType == ibcratelimit.MsgSendPacket {
+		err = rateLimit.AddOutflow(flow.Amount)
+	} else {
+		err = rateLimit.AddInflow(flow.Amount)
+	}
+	if err != nil {
+		return err
+	}
+	return k.StoreRateLimit(ctx, *rateLimit)
+}
+
+// TrackSentPacket records a sent packet so that its outflow can be undone if it fails or times out.
+// Nothing is recorded if the contract is configured or there isn't an in-module rate limit for the packet.
+func (k Keeper) TrackSentPacket(ctx sdk.Context, packet exported.PacketI) error {
+	if k.IsContractConfigured(ctx) {
+		return nil
+	}
+	flow, err := ibcratelimit.NewPacketFlow(ibcratelimit.MsgSendPacket, packet)
+	if err != nil {
+		return err
+	}
+	rateLimit, err := k.GetRateLimit(ctx, flow.Denom, flow.ChannelID)
+	if err != nil || rateLimit == nil {
+		return err
+	}
+	return k.StorePendingSendPacket(ctx, ibcratelimit.NewPendingSendPacket(flow.ChannelID, packet.GetSequence(), rateLimit.Flow.PeriodStart))
+}
+
+// ForgetSentPacket removes a sent packet from the pending ones, e.g. once it has been successfully acknowledged.
+func (k Keeper) ForgetSentPacket(ctx sdk.Context, packet exported.PacketI) {
+	if packet == nil {
+		return
+	}
+	k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// undoModuleSendRateLimit removes a failed packet's amount from the outflow of its in-module rate limit.
+// The outflow is only changed if the packet was sent during the rate limit's current period.
+func (k Keeper) undoModuleSendRateLimit(ctx sdk.Context, packet exported.PacketI) error {
+	if packet == nil {
+		return ibcratelimit.ErrBadMessage
+	}
+	pending, err := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if err != nil || pending == nil {
+		return err
+	}
+	k.DeletePendingSendPacket(ctx, pending.ChannelId, pending.Sequence)
+
+	flow, err := ibcratelimit.NewPacketFlow(ibcratelimit.MsgSendPacket, packet)
+	if err != nil {
+		return err
+	}
+	rateLimit, err := k.GetRateLimit(ctx, flow.Denom, flow.ChannelID)
+	if err != nil || rateLimit == nil {
+		return err
+	}
+	if !rateLimit.Flow.PeriodStart.Equal(pending.PeriodStart) {
+		return nil
+	}
+	rateLimit.UndoOutflow(flow.Amount)
+	return k.StoreRateLimit(ctx, *rateLimit)
+}
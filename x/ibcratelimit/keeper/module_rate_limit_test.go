@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+const rateLimitDenom = "ratecoin"
+
+// setupRateLimitSupply creates 1000 of the rate limit test denom and sets a block time.
+func (s *TestSuite) setupRateLimitSupply() time.Time {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(now)
+	addr := sdk.AccAddress("rate_limit_holder___")
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(rateLimitDenom, 1000))), "FundAccount")
+	return now
+}
+
+// newSendPacket creates a packet sending the rate limit test denom out through channel-0.
+func newSendPacket(sequence uint64, amount int64) channeltypes.Packet {
+	data, _ := json.Marshal(transfertypes.NewFungibleTokenPacketData(rateLimitDenom, fmt.Sprintf("%d", amount), "sender", "receiver", ""))
+	return channeltypes.NewPacket(data, sequence, "transfer", "channel-0", "transfer", "channel-9", clienttypes.NewHeight(1, 100), 0)
+}
+
+// newRecvPacket creates a packet returning the rate limit test denom to this chain through channel-0.
+func newRecvPacket(amount int64) channeltypes.Packet {
+	denom := transfertypes.GetPrefixedDenom("transfer", "channel-9", rateLimitDenom)
+	data, _ := json.Marshal(transfertypes.NewFungibleTokenPacketData(denom, fmt.Sprintf("%d", amount), "sender", "receiver", ""))
+	return channeltypes.NewPacket(data, 1, "transfer", "channel-9", "transfer", "channel-0", clienttypes.NewHeight(1, 100), 0)
+}
+
+func (s *TestSuite) TestModuleRateLimits() {
+	now := s.setupRateLimitSupply()
+	k := s.app.RateLimitingKeeper
+	quota := ibcratelimit.NewQuota(10, 20, sdkmath.ZeroInt(), sdkmath.NewInt(150), time.Hour)
+	s.Require().NoError(k.StoreRateLimit(s.ctx, k.NewRateLimit(s.ctx, rateLimitDenom, "channel-0", quota)), "StoreRateLimit")
+
+	rateLimit, err := k.GetRateLimit(s.ctx, rateLimitDenom, "channel-0")
+	s.Require().NoError(err, "GetRateLimit")
+	s.Require().NotNil(rateLimit, "GetRateLimit")
+	s.Assert().Equal(ibcratelimit.NewFlow(sdkmath.NewInt(1000), now), rateLimit.Flow, "initial flow")
+
+	check := func(msgType string, packet channeltypes.Packet, expErr string) {
+		err := k.CheckAndUpdateRateLimits(s.ctx, msgType, packet)
+		if len(expErr) > 0 {
+			s.Assert().EqualError(err, expErr, "CheckAndUpdateRateLimits")
+		} else {
+			s.Assert().NoError(err, "CheckAndUpdateRateLimits")
+		}
+	}
+	assertFlow := func(inflow, outflow int64) {
+		rateLimit, err := k.GetRateLimit(s.ctx, rateLimitDenom, "channel-0")
+		s.Require().NoError(err, "GetRateLimit")
+		s.Assert().Equal(sdkmath.NewInt(inflow).String(), rateLimit.Flow.Inflow.String(), "inflow")
+		s.Assert().Equal(sdkmath.NewInt(outflow).String(), rateLimit.Flow.Outflow.String(), "outflow")
+	}
+
+	check(ibcratelimit.MsgSendPacket, newSendPacket(1, 60), "")
+	check(ibcratelimit.MsgSendPacket, newSendPacket(2, 41), "cannot send 41ratecoin through channel-0: only 40 remains in the current period: rate limit exceeded")
+	check(ibcratelimit.MsgSendPacket, newSendPacket(2, 40), "")
+	check(ibcratelimit.MsgRecvPacket, newRecvPacket(150), "")
+	check(ibcratelimit.MsgRecvPacket, newRecvPacket(1), "cannot receive 1ratecoin through channel-0: only 0 remains in the current period: rate limit exceeded")
+	assertFlow(150, 100)
+
+	// Other denoms and channels aren't limited.
+	other, _ := json.Marshal(transfertypes.NewFungibleTokenPacketData("otherdenom", "1000000", "sender", "receiver", ""))
+	check(ibcratelimit.MsgSendPacket, channeltypes.NewPacket(other, 3, "transfer", "channel-0", "transfer", "channel-9", clienttypes.NewHeight(1, 100), 0), "")
+
+	// Once the period is over, a new one starts.
+	s.ctx = s.ctx.WithBlockTime(now.Add(time.Hour))
+	check(ibcratelimit.MsgSendPacket, newSendPacket(4, 100), "")
+	assertFlow(0, 100)
+	rateLimit, err = k.GetRateLimit(s.ctx, rateLimitDenom, "channel-0")
+	s.Require().NoError(err, "GetRateLimit")
+	s.Assert().Equal(now.Add(time.Hour), rateLimit.Flow.PeriodStart, "period start after reset")
+}
+
+func (s *TestSuite) TestModuleRateLimitsZeroChannelValue() {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(now)
+	k := s.app.RateLimitingKeeper
+	quota := ibcratelimit.NewQuota(0, 10, sdkmath.ZeroInt(), sdkmath.NewInt(500), time.Hour)
+	s.Require().NoError(k.StoreRateLimit(s.ctx, k.NewRateLimit(s.ctx, rateLimitDenom, "channel-0", quota)), "StoreRateLimit")
+
+	rateLimit, err := k.GetRateLimit(s.ctx, rateLimitDenom, "channel-0")
+	s.Require().NoError(err, "GetRateLimit")
+	s.Require().NotNil(rateLimit, "GetRateLimit")
+	s.Assert().Equal(sdkmath.ZeroInt().String(), rateLimit.Flow.ChannelValue.String(), "initial channel value")
+
+	// Without any supply, the percentage allows nothing, even though the amount would allow some.
+	err = k.CheckAndUpdateRateLimits(s.ctx, ibcratelimit.MsgRecvPacket, newRecvPacket(1))
+	s.Assert().EqualError(err, "cannot receive 1ratecoin through channel-0: only 0 remains in the current period: rate limit exceeded", "CheckAndUpdateRateLimits without supply")
+
+	// Once there is supply, the channel value is re-read during the same period.
+	s.ctx = s.ctx.WithBlockTime(now.Add(time.Minute))
+	addr := sdk.AccAddress("rate_limit_holder___")
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(rateLimitDenom, 1000))), "FundAccount")
+	s.Assert().NoError(k.CheckAndUpdateRateLimits(s.ctx, ibcratelimit.MsgRecvPacket, newRecvPacket(100)), "CheckAndUpdateRateLimits(100) with supply")
+	err = k.CheckAndUpdateRateLimits(s.ctx, ibcratelimit.MsgRecvPacket, newRecvPacket(1))
+	s.Assert().EqualError(err, "cannot receive 1ratecoin through channel-0: only 0 remains in the current period: rate limit exceeded", "CheckAndUpdateRateLimits(1) with supply")
+
+	rateLimit, err = k.GetRateLimit(s.ctx, rateLimitDenom, "channel-0")
+	s.Require().NoError(err, "GetRateLimit")
+	s.Assert().Equal(sdkmath.NewInt(1000).String(), rateLimit.Flow.ChannelValue.String(), "channel value after re-reading it")
+	s.Assert().Equal(sdkmath.NewInt(100).String(), rateLimit.Flow.Inflow.String(), "inflow")
+	s.Assert().Equal(now, rateLimit.Flow.PeriodStart, "period start")
+}
+
+func (s *TestSuite) TestRevertModuleSentPacket() {
+	now := s.setupRateLimitSupply()
+	k := s.app.RateLimitingKeeper
+	quota := ibcratelimit.NewQuota(0, 0, sdkmath.NewInt(100), sdkmath.ZeroInt(), time.Hour)
+	s.Require().NoError(k.StoreRateLimit(s.ctx, k.NewRateLimit(s.ctx, rateLimitDenom, "channel-0", quota)), "StoreRateLimit")
+
+	send := func(sequence uint64, amount int64) channeltypes.Packet {
+		packet := newSendPacket(sequence, amount)
+		s.Require().NoError(k.CheckAndUpdateRateLimits(s.ctx, ibcratelimit.MsgSendPacket, packet), "CheckAndUpdateRateLimits")
+		s.Require().NoError(k.TrackSentPacket(s.ctx, packet), "TrackSentPacket")
+		return packet
+	}
+	outflow := func() string {
+		rateLimit, err := k.GetRateLimit(s.ctx, rateLimitDenom, "channel-0")
+		s.Require().NoError(err, "GetRateLimit")
+		return rateLimit.Flow.Outflow.String()
+	}
+
+	failed := send(1, 30)
+	acked := send(2, 20)
+	s.Assert().Equal("50", outflow(), "outflow after sends")
+
+	pending, err := k.GetPendingSendPacket(s.ctx, "channel-0", 1)
+	s.Require().NoError(err, "GetPendingSendPacket")
+	s.Assert().Equal(&ibcratelimit.PendingSendPacket{ChannelId: "channel-0", Sequence: 1, PeriodStart: now}, pending, "pending packet")
+
+	s.Require().NoError(k.RevertSentPacket(s.ctx, failed), "RevertSentPacket")
+	s.Assert().Equal("20", outflow(), "outflow after reverting a packet")
+	s.Require().NoError(k.RevertSentPacket(s.ctx, failed), "RevertSentPacket again")
+	s.Assert().Equal("20", outflow(), "outflow after reverting a packet twice")
+
+	k.ForgetSentPacket(s.ctx, acked)
+	pending, err = k.GetPendingSendPacket(s.ctx, "channel-0", 2)
+	s.Require().NoError(err, "GetPendingSendPacket")
+	s.Assert().Nil(pending, "pending packet after it was acknowledged")
+	s.Require().NoError(k.RevertSentPacket(s.ctx, acked), "RevertSentPacket of an acknowledged packet")
+	s.Assert().Equal("20", outflow(), "outflow after reverting an acknowledged packet")
+
+	// A packet from a previous period doesn't change the current period's outflow.
+	old := send(3, 40)
+	s.ctx = s.ctx.WithBlockTime(now.Add(2 * time.Hour))
+	send(4, 10)
+	s.Require().NoError(k.RevertSentPacket(s.ctx, old), "RevertSentPacket from a previous period")
+	s.Assert().Equal("10", outflow(), "outflow after reverting a packet from a previous period")
+}
+
+func (s *TestSuite) TestRateLimitQueries() {
+	now := s.setupRateLimitSupply()
+	k := s.app.RateLimitingKeeper
+	quota := ibcratelimit.NewQuota(10, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour)
+	s.Require().NoError(k.StoreRateLimit(s.ctx, k.NewRateLimit(s.ctx, rateLimitDenom, "channel-0", quota)), "StoreRateLimit")
+	s.Require().NoError(k.CheckAndUpdateRateLimits(s.ctx, ibcratelimit.MsgSendPacket, newSendPacket(1, 30)), "CheckAndUpdateRateLimits")
+
+	all, err := k.RateLimits(s.ctx, &ibcratelimit.QueryRateLimitsRequest{})
+	s.Require().NoError(err, "RateLimits")
+	s.Require().Len(all.RateLimits, 1, "RateLimits")
+	s.Assert().Equal(rateLimitDenom, all.RateLimits[0].Denom, "RateLimits denom")
+
+	seventy := sdkmath.NewInt(70)
+	flow := ibcratelimit.NewFlow(sdkmath.NewInt(1000), now)
+	flow.Outflow = sdkmath.NewInt(30)
+	resp, err := k.RateLimit(s.ctx, &ibcratelimit.QueryRateLimitRequest{Denom: rateLimitDenom, ChannelId: "channel-0"})
+	s.Require().NoError(err, "RateLimit")
+	s.Assert().Equal(ibcratelimit.NewRateLimit(rateLimitDenom, "channel-0", quota, flow), resp.RateLimit, "RateLimit rate limit")
+	s.Assert().Equal(&seventy, resp.RemainingSend, "RateLimit remaining send")
+	s.Assert().Nil(resp.RemainingRecv, "RateLimit remaining recv")
+
+	// After the period ends, the query shows a new period, but it isn't saved.
+	later := s.ctx.WithBlockTime(now.Add(time.Hour))
+	resp, err = k.RateLimit(later, &ibcratelimit.QueryRateLimitRequest{Denom: rateLimitDenom, ChannelId: "channel-0"})
+	s.Require().NoError(err, "RateLimit after the period")
+	s.Assert().Equal(ibcratelimit.NewFlow(sdkmath.NewInt(1000), now.Add(time.Hour)), resp.RateLimit.Flow, "RateLimit flow after the period")
+	stored, err := k.GetRateLimit(later, rateLimitDenom, "channel-0")
+	s.Require().NoError(err, "GetRateLimit")
+	s.Assert().Equal(flow, stored.Flow, "stored flow after querying")
+
+	_, err = k.RateLimit(s.ctx, &ibcratelimit.QueryRateLimitRequest{Denom: "otherdenom", ChannelId: "channel-0"})
+	s.Assert().EqualError(err, "rpc error: code = NotFound desc = no rate limit for otherdenom on channel-0", "RateLimit unknown")
+	_, err = k.RateLimit(s.ctx, &ibcratelimit.QueryRateLimitRequest{Denom: rateLimitDenom})
+	s.Assert().ErrorContains(err, "code = InvalidArgument", "RateLimit without a channel")
+	_, err = k.RateLimit(s.ctx, nil)
+	s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = invalid request", "RateLimit nil request")
+}
+
+func (s *TestSuite) TestSetAndRemoveRateLimit() {
+	now := s.setupRateLimitSupply()
+	authority := s.app.RateLimitingKeeper.GetAuthority()
+	quota := ibcratelimit.NewQuota(0, 0, sdkmath.NewInt(5), sdkmath.NewInt(5), 24*time.Hour)
+
+	tests := []struct {
+		name  string
+		msg   sdk.Msg
+		event *sdk.Event
+		err   string
+	}{
+		{
+			name: "failure - set by non-authority",
+			msg:  ibcratelimit.NewMsgSetRateLimitRequest("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", rateLimitDenom, "channel-0", quota),
+			err:  fmt.Sprintf("expected %q got \"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma\": expected gov account as only signer for proposal message", authority),
+		},
+		{
+			name:  "success - set",
+			msg:   ibcratelimit.NewMsgSetRateLimitRequest(authority, rateLimitDenom, "channel-0", quota),
+			event: typedEventToEvent(ibcratelimit.NewEventRateLimitUpdated(rateLimitDenom, "channel-0")),
+		},
+		{
+			name: "failure - remove by non-authority",
+			msg:  ibcratelimit.NewMsgRemoveRateLimitRequest("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", rateLimitDenom, "channel-0"),
+			err:  fmt.Sprintf("expected %q got \"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma\": expected gov account as only signer for proposal message", authority),
+		},
+		{
+			name: "failure - remove unknown",
+			msg:  ibcratelimit.NewMsgRemoveRateLimitRequest(authority, rateLimitDenom, "channel-1"),
+			err:  "no rate limit for ratecoin on channel-1: unknown rate limit",
+		},
+		{
+			name:  "success - remove",
+			msg:   ibcratelimit.NewMsgRemoveRateLimitRequest(authority, rateLimitDenom, "channel-0"),
+			event: typedEventToEvent(ibcratelimit.NewEventRateLimitRemoved(rateLimitDenom, "channel-0")),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			var err error
+			switch msg := tc.msg.(type) {
+			case *ibcratelimit.MsgSetRateLimitRequest:
+				_, err = s.msgServer.SetRateLimit(ctx, msg)
+			case *ibcratelimit.MsgRemoveRateLimitRequest:
+				_, err = s.msgServer.RemoveRateLimit(ctx, msg)
+			}
+			if len(tc.err) > 0 {
+				s.Assert().EqualError(err, tc.err, "msg server error")
+			} else {
+				s.Assert().NoError(err, "msg server error")
+			}
+
+			events := ctx.EventManager().Events()
+			if tc.event != nil {
+				s.Assert().Equal(sdk.Events{*tc.event}, events, "emitted events")
+			} else {
+				s.Assert().Empty(events, "emitted events")
+			}
+		})
+
+		if tc.name == "success - set" {
+			rateLimit, err := s.app.RateLimitingKeeper.GetRateLimit(s.ctx, rateLimitDenom, "channel-0")
+			s.Require().NoError(err, "GetRateLimit after set")
+			s.Assert().Equal(&ibcratelimit.RateLimit{Denom: rateLimitDenom, ChannelId: "channel-0", Quota: quota, Flow: ibcratelimit.NewFlow(sdkmath.NewInt(1000), now)}, rateLimit, "rate limit after set")
+		}
+	}
+
+	rateLimit, err := s.app.RateLimitingKeeper.GetRateLimit(s.ctx, rateLimitDenom, "channel-0")
+	s.Require().NoError(err, "GetRateLimit after remove")
+	s.Assert().Nil(rateLimit, "rate limit after remove")
+}
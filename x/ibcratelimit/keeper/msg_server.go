@@ -41,3 +41,38 @@ func (k MsgServer) UpdateParams(goCtx context.Context, msg *ibcratelimit.MsgUpda
 
 	return &ibcratelimit.MsgUpdateParamsResponse{}, nil
 }
+
+// SetRateLimit is a governance proposal endpoint for adding or replacing an in-module rate limit.
+func (k MsgServer) SetRateLimit(goCtx context.Context, msg *ibcratelimit.MsgSetRateLimitRequest) (*ibcratelimit.MsgSetRateLimitResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.StoreRateLimit(ctx, k.NewRateLimit(ctx, msg.Denom, msg.ChannelId, msg.Quota)); err != nil {
+		return nil, err
+	}
+	k.emitEvent(ctx, ibcratelimit.NewEventRateLimitUpdated(msg.Denom, msg.ChannelId))
+
+	return &ibcratelimit.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit is a governance proposal endpoint for removing an in-module rate limit.
+func (k MsgServer) RemoveRateLimit(goCtx context.Context, msg *ibcratelimit.MsgRemoveRateLimitRequest) (*ibcratelimit.MsgRemoveRateLimitResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, err := k.GetRateLimit(ctx, msg.Denom, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if rateLimit == nil {
+		return nil, ibcratelimit.ErrUnknownRateLimit.Wrapf("no rate limit for %s on %s", msg.Denom, msg.ChannelId)
+	}
+	k.DeleteRateLimit(ctx, msg.Denom, msg.ChannelId)
+	k.emitEvent(ctx, ibcratelimit.NewEventRateLimitRemoved(msg.Denom, msg.ChannelId))
+
+	return &ibcratelimit.MsgRemoveRateLimitResponse{}, nil
+}
@@ -12,8 +12,12 @@ import (
 )
 
 // CheckAndUpdateRateLimits Updates the rate limiter and checks if rate limit has been exceeded.
+// The contract is used when one is configured, otherwise the in-module rate limits are used.
 func (k Keeper) CheckAndUpdateRateLimits(ctx sdk.Context, msgType string, packet exported.PacketI) error {
 	contract := k.GetContractAddress(ctx)
+	if contract == "" {
+		return k.checkAndUpdateModuleRateLimits(ctx, msgType, packet)
+	}
 
 	contractAddr, err := sdk.AccAddressFromBech32(contract)
 	if err != nil {
@@ -89,15 +93,17 @@ func (k Keeper) buildWasmExecMsg(msgType string, packet exported.PacketI) ([]byt
 	return asJSON, nil
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received.
+// RevertSentPacket Notifies the rate limiter that a sent packet wasn't properly received.
+// The contract is used when one is configured, otherwise the in-module rate limits are used.
 func (k Keeper) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
 	if !k.IsContractConfigured(ctx) {
-		return nil
+		return k.undoModuleSendRateLimit(ctx, packet)
 	}
 
+	k.ForgetSentPacket(ctx, packet)
 	contract := k.GetContractAddress(ctx)
 	return k.UndoSendRateLimit(ctx, contract, packet)
 }
@@ -29,11 +29,24 @@ func (s *TestSuite) TestCheckAndUpdateRateLimits() {
 			mockKeeper: NewMockPermissionedKeeper(true),
 		},
 		{
-			name:     "failure - an invalid contract throws error",
+			name:     "success - no contract uses the in-module rate limits",
+			contract: "",
+			msgType:  ibcratelimit.MsgSendPacket,
+			packet:   NewMockPacket(NewMockSerializedPacketData(), true),
+		},
+		{
+			name:     "failure - no contract and nil packet",
 			contract: "",
 			msgType:  ibcratelimit.MsgSendPacket,
 			packet:   nil,
-			err:      "empty address string is not allowed: contract error",
+			err:      "bad message",
+		},
+		{
+			name:     "failure - an invalid contract throws error",
+			contract: "invalid",
+			msgType:  ibcratelimit.MsgSendPacket,
+			packet:   nil,
+			err:      "decoding bech32 failed: invalid bech32 string length 7: contract error",
 		},
 		{
 			name:     "failure - throws error on bad packet",
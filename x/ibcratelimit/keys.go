@@ -1,5 +1,11 @@
 package ibcratelimit
 
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "ratelimitedibc"
@@ -11,4 +17,24 @@ const (
 var (
 	// ParamsKey is the key to obtain the module's params.
 	ParamsKey = []byte{0x01}
+	// RateLimitKeyPrefix is the prefix of the keys for the in-module rate limits.
+	RateLimitKeyPrefix = []byte{0x02}
+	// PendingSendPacketKeyPrefix is the prefix of the keys for sent packets that might still be undone.
+	PendingSendPacketKeyPrefix = []byte{0x03}
 )
+
+// GetRateLimitKey returns the key for the rate limit of a denom on a channel.
+// Format: 0x02 | len(channel) | channel | denom
+func GetRateLimitKey(denom, channelID string) []byte {
+	key := append([]byte{}, RateLimitKeyPrefix...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, denom...)
+}
+
+// GetPendingSendPacketKey returns the key for a pending sent packet.
+// Format: 0x03 | len(channel) | channel | sequence (8 bytes, big endian)
+func GetPendingSendPacketKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, PendingSendPacketKeyPrefix...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
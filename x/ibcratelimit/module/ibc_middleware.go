@@ -135,11 +135,6 @@ func (im *IBCMiddleware) OnRecvPacket(
 		return ibc.NewEmitErrorAcknowledgement(ctx, ibcratelimit.ErrBadMessage, err.Error())
 	}
 
-	if !im.keeper.IsContractConfigured(ctx) {
-		// The contract has not been configured. Continue as usual
-		return im.app.OnRecvPacket(ctx, packet, relayer)
-	}
-
 	err := im.keeper.CheckAndUpdateRateLimits(ctx, "recv_packet", packet)
 	if err != nil {
 		return ibc.NewEmitErrorAcknowledgement(ctx, err)
@@ -173,6 +168,8 @@ func (im *IBCMiddleware) OnAcknowledgementPacket(
 				ctx.Logger().Error("unable to emit AckRevertFailure event", "err", eventError)
 			}
 		}
+	} else {
+		im.keeper.ForgetSentPacket(ctx, packet)
 	}
 
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
@@ -198,10 +195,11 @@ func (im *IBCMiddleware) OnTimeoutPacket(
 }
 
 // SendPacket implements the ICS4 interface and is called when sending packets.
-// This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
-// the current transfer, in which case it returns an error preventing the IBC send from taking place.
-// If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
-// used, transfers are not prevented and handled by the wrapped IBC app
+// This method checks if the limits have been exceeded for the current transfer, in which case it returns an error
+// preventing the IBC send from taking place. The contract from the middleware's parameters is used when configured,
+// otherwise the module's own rate limits are used.
+// If there isn't a limit for the (channel+denom) being used, transfers are not prevented and handled by the wrapped
+// IBC app
 func (im *IBCMiddleware) SendPacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -219,11 +217,6 @@ func (im *IBCMiddleware) SendPacket(
 		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
-	if !im.keeper.IsContractConfigured(ctx) {
-		// The contract has not been configured. Continue as usual
-		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
-	}
-
 	// We need the full packet so the contract can process it. If it can't be cast to a channeltypes.Packet, this
 	// should fail. The only reason that would happen is if another middleware is modifying the packet, though. In
 	// that case we can modify the middleware order or change this cast so we have all the data we need.
@@ -243,7 +236,18 @@ func (im *IBCMiddleware) SendPacket(
 		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
 	}
 
-	return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	sequence, err = im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	// Now that we know the sequence, keep track of the packet so its outflow can be undone if it fails.
+	packet.Sequence = sequence
+	if err = im.keeper.TrackSentPacket(ctx, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
 }
 
 // WriteAcknowledgement implements the ICS4 Wrapper interface
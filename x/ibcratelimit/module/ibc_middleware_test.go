@@ -529,6 +529,90 @@ func (suite *MiddlewareTestSuite) TestFailedSendTransfer() {
 	suite.Assert().NoError(err)
 }
 
+// setModuleRateLimit adds an in-module rate limit to chain A for a denom on its end of the transfer channel.
+func (suite *MiddlewareTestSuite) setModuleRateLimit(denom string, quota ibcratelimit.Quota) {
+	k := suite.chainA.GetProvenanceApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+	err := k.StoreRateLimit(ctx, k.NewRateLimit(ctx, denom, suite.path.EndpointA.ChannelID, quota))
+	suite.Require().NoError(err, "StoreRateLimit")
+}
+
+// Test the in-module rate limits on sends when there isn't a contract
+func (suite *MiddlewareTestSuite) TestSendTransferWithModuleRateLimit() {
+	suite.setModuleRateLimit(sdk.DefaultBondDenom, ibcratelimit.NewQuota(0, 0, sdkmath.NewInt(100), sdkmath.ZeroInt(), time.Hour))
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sdkmath.NewInt(60)))
+	suite.Assert().NoError(err)
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, sdkmath.NewInt(41)))
+	suite.Assert().Error(err)
+
+	// Move forward one block
+	suite.chainA.NextBlock()
+	err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Assert().NoError(err)
+
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sdkmath.NewInt(40)))
+	suite.Assert().NoError(err)
+}
+
+// Test the in-module rate limits on receives when there isn't a contract
+func (suite *MiddlewareTestSuite) TestRecvTransferWithModuleRateLimit() {
+	denom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	suite.setModuleRateLimit(denom, ibcratelimit.NewQuota(0, 0, sdkmath.ZeroInt(), sdkmath.NewInt(100), time.Hour))
+
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	suite.Assert().NoError(err)
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sdk.DefaultBondDenom, sdkmath.NewInt(1)))
+	suite.Assert().NoError(err)
+}
+
+// Test the in-module rate limits are reverted if a "send" fails
+func (suite *MiddlewareTestSuite) TestFailedSendTransferWithModuleRateLimit() {
+	quota := sdkmath.NewInt(100)
+	suite.setModuleRateLimit(sdk.DefaultBondDenom, ibcratelimit.NewQuota(0, 0, quota, sdkmath.ZeroInt(), time.Hour))
+
+	// Use the whole quota on a transfer that chain B will reject.
+	coins := sdk.NewCoin(sdk.DefaultBondDenom, quota)
+	port := suite.path.EndpointA.ChannelConfig.PortID
+	channel := suite.path.EndpointA.ChannelID
+	accountFrom := suite.chainA.SenderAccount.GetAddress().String()
+	msg := transfertypes.NewMsgTransfer(port, channel, coins, accountFrom, "INVALID", clienttypes.NewHeight(10, 100), 0, "")
+	res, err := suite.chainA.SendMsgsNoCheck(&suite.Suite, msg)
+	suite.Assert().NoError(err)
+
+	// Sending again fails as the quota is filled
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, sdkmath.NewInt(1)))
+	suite.Assert().Error(err)
+
+	suite.chainA.NextBlock()
+	err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Assert().NoError(err)
+	suite.chainA.Coordinator.IncrementTime()
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient(), "EndpointA.UpdateClient")
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient(), "EndpointB.UpdateClient")
+
+	// Relay the failed packet and its error acknowledgement.
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err, "ParsePacketFromEvents")
+	pending, err := suite.chainA.GetProvenanceApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), channel, packet.Sequence)
+	suite.Require().NoError(err, "GetPendingSendPacket")
+	suite.Assert().NotNil(pending, "pending send packet before the ack")
+	txRes, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err, "RecvPacketWithResult")
+	ack, err := ibctesting.ParseAckFromEvents(txRes.Events)
+	suite.Require().NoError(err, "ParseAckFromEvents")
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ack), "AcknowledgePacket")
+
+	pending, err = suite.chainA.GetProvenanceApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), channel, packet.Sequence)
+	suite.Require().NoError(err, "GetPendingSendPacket")
+	suite.Assert().Nil(pending, "pending send packet after the ack")
+
+	// We should be able to send again because the failed packet's outflow has been reverted
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, quota))
+	suite.Assert().NoError(err)
+}
+
 func (suite *MiddlewareTestSuite) TestUnsetRateLimitingContract() {
 	// Setup contract
 	suite.chainA.StoreContractRateLimiterDirect(&suite.Suite)
@@ -11,6 +11,8 @@ import (
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgGovUpdateParamsRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetRateLimitRequest)(nil),
+	(*MsgRemoveRateLimitRequest)(nil),
 }
 
 // ValidateBasic runs stateless validation checks on the message.
@@ -32,3 +34,44 @@ func (m MsgUpdateParamsRequest) ValidateBasic() error {
 	}
 	return m.Params.Validate()
 }
+
+// NewMsgSetRateLimitRequest creates a new SetRateLimit message.
+func NewMsgSetRateLimitRequest(authority, denom, channelID string, quota Quota) *MsgSetRateLimitRequest {
+	return &MsgSetRateLimitRequest{
+		Authority: authority,
+		Denom:     denom,
+		ChannelId: channelID,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgSetRateLimitRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if err := ValidateDenomAndChannel(m.Denom, m.ChannelId); err != nil {
+		return err
+	}
+	if err := m.Quota.Validate(); err != nil {
+		return fmt.Errorf("invalid quota: %w", err)
+	}
+	return nil
+}
+
+// NewMsgRemoveRateLimitRequest creates a new RemoveRateLimit message.
+func NewMsgRemoveRateLimitRequest(authority, denom, channelID string) *MsgRemoveRateLimitRequest {
+	return &MsgRemoveRateLimitRequest{
+		Authority: authority,
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgRemoveRateLimitRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return ValidateDenomAndChannel(m.Denom, m.ChannelId)
+}
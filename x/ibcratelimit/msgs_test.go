@@ -2,9 +2,12 @@ package ibcratelimit_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/testutil"
@@ -16,6 +19,8 @@ func TestAllMsgsGetSigners(t *testing.T) {
 	msgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgGovUpdateParamsRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetRateLimitRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveRateLimitRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -74,3 +79,79 @@ func TestNewMsgUpdateParamsValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetRateLimitValidateBasic(t *testing.T) {
+	authority := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+	quota := NewQuota(10, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour)
+	tests := []struct {
+		name string
+		msg  *MsgSetRateLimitRequest
+		err  string
+	}{
+		{
+			name: "success - valid message",
+			msg:  NewMsgSetRateLimitRequest(authority, "nhash", "channel-0", quota),
+		},
+		{
+			name: "failure - invalid authority",
+			msg:  NewMsgSetRateLimitRequest("authority", "nhash", "channel-0", quota),
+			err:  "invalid authority: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "failure - invalid denom",
+			msg:  NewMsgSetRateLimitRequest(authority, "", "channel-0", quota),
+			err:  "invalid denom \"\": invalid denom: ",
+		},
+		{
+			name: "failure - invalid quota",
+			msg:  NewMsgSetRateLimitRequest(authority, "nhash", "channel-0", NewQuota(0, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour)),
+			err:  "invalid quota: at least one percent or amount must be set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRateLimitValidateBasic(t *testing.T) {
+	authority := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+	tests := []struct {
+		name string
+		msg  *MsgRemoveRateLimitRequest
+		err  string
+	}{
+		{
+			name: "success - valid message",
+			msg:  NewMsgRemoveRateLimitRequest(authority, "nhash", "channel-0"),
+		},
+		{
+			name: "failure - invalid authority",
+			msg:  NewMsgRemoveRateLimitRequest("", "nhash", "channel-0"),
+			err:  "invalid authority: empty address string is not allowed",
+		},
+		{
+			name: "failure - invalid channel",
+			msg:  NewMsgRemoveRateLimitRequest(authority, "nhash", "channel"),
+			err:  "invalid channel id \"channel\": identifier channel has invalid length: 7, must be between 8-64 characters: invalid identifier",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -92,3 +93,51 @@ func UnwrapPacket(packet exported.PacketI) (UnwrappedPacket, error) {
 		TimeoutTimestamp:   packet.GetTimeoutTimestamp(),
 	}, nil
 }
+
+// PacketFlow is the amount of a denom that a transfer packet moves through a channel on this chain.
+type PacketFlow struct {
+	// Denom is the denom as it's known on this chain.
+	Denom string
+	// ChannelID is the channel on this chain that the packet goes through.
+	ChannelID string
+	// Amount is the amount being transferred.
+	Amount sdkmath.Int
+}
+
+// NewPacketFlow determines the denom, channel, and amount of a sent or received transfer packet from this chain's
+// perspective. The msgType must be either MsgSendPacket or MsgRecvPacket.
+func NewPacketFlow(msgType string, packet exported.PacketI) (PacketFlow, error) {
+	if packet == nil {
+		return PacketFlow{}, ErrBadMessage
+	}
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil {
+		return PacketFlow{}, errorsmod.Wrap(ErrBadMessage, err.Error())
+	}
+	amount, ok := sdkmath.NewIntFromString(packetData.Amount)
+	if !ok || amount.IsNegative() {
+		return PacketFlow{}, errorsmod.Wrapf(ErrBadMessage, "invalid packet amount %q", packetData.Amount)
+	}
+
+	var rv PacketFlow
+	switch msgType {
+	case MsgSendPacket:
+		// When sending, the packet denom is the full trace of the denom on this chain.
+		rv.ChannelID = packet.GetSourceChannel()
+		rv.Denom = transfertypes.ParseDenomTrace(packetData.Denom).IBCDenom()
+	case MsgRecvPacket:
+		// When receiving, the packet denom is the trace from the sender's perspective.
+		rv.ChannelID = packet.GetDestChannel()
+		if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetData.Denom) {
+			unprefixed := packetData.Denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+			rv.Denom = transfertypes.ParseDenomTrace(unprefixed).IBCDenom()
+		} else {
+			prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), packetData.Denom)
+			rv.Denom = transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+		}
+	default:
+		return PacketFlow{}, ErrBadMessage
+	}
+	rv.Amount = amount
+	return rv, nil
+}
@@ -4,7 +4,12 @@ import (
 	"encoding/json"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 	"github.com/stretchr/testify/assert"
 )
@@ -128,3 +133,83 @@ func TestUnwrapPacket(t *testing.T) {
 		})
 	}
 }
+
+func TestNewPacketFlow(t *testing.T) {
+	newPacket := func(denom, amount string) channeltypes.Packet {
+		data, _ := json.Marshal(transfertypes.NewFungibleTokenPacketData(denom, amount, "sender", "receiver", ""))
+		return channeltypes.NewPacket(data, 3, "transfer", "channel-1", "transfer", "channel-5", clienttypes.NewHeight(1, 1), 0)
+	}
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()
+	received := transfertypes.ParseDenomTrace("transfer/channel-5/uatom").IBCDenom()
+
+	tests := []struct {
+		name     string
+		msgType  string
+		packet   *channeltypes.Packet
+		expected ibcratelimit.PacketFlow
+		err      string
+	}{
+		{
+			name:     "send of a native denom",
+			msgType:  ibcratelimit.MsgSendPacket,
+			packet:   ptr(newPacket("nhash", "100")),
+			expected: ibcratelimit.PacketFlow{Denom: "nhash", ChannelID: "channel-1", Amount: sdkmath.NewInt(100)},
+		},
+		{
+			name:     "send of a voucher",
+			msgType:  ibcratelimit.MsgSendPacket,
+			packet:   ptr(newPacket("transfer/channel-1/uatom", "7")),
+			expected: ibcratelimit.PacketFlow{Denom: voucher, ChannelID: "channel-1", Amount: sdkmath.NewInt(7)},
+		},
+		{
+			name:     "receive of a denom returning to this chain",
+			msgType:  ibcratelimit.MsgRecvPacket,
+			packet:   ptr(newPacket("transfer/channel-1/nhash", "100")),
+			expected: ibcratelimit.PacketFlow{Denom: "nhash", ChannelID: "channel-5", Amount: sdkmath.NewInt(100)},
+		},
+		{
+			name:     "receive of a foreign denom",
+			msgType:  ibcratelimit.MsgRecvPacket,
+			packet:   ptr(newPacket("uatom", "12")),
+			expected: ibcratelimit.PacketFlow{Denom: received, ChannelID: "channel-5", Amount: sdkmath.NewInt(12)},
+		},
+		{
+			name:    "nil packet",
+			msgType: ibcratelimit.MsgSendPacket,
+			err:     "bad message",
+		},
+		{
+			name:    "invalid msg type",
+			msgType: "bad message type",
+			packet:  ptr(newPacket("nhash", "100")),
+			err:     "bad message",
+		},
+		{
+			name:    "invalid amount",
+			msgType: ibcratelimit.MsgSendPacket,
+			packet:  ptr(newPacket("nhash", "lots")),
+			err:     "invalid packet amount \"lots\": bad message",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var packet exported.PacketI
+			if tc.packet != nil {
+				packet = tc.packet
+			}
+			flow, err := ibcratelimit.NewPacketFlow(tc.msgType, packet)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "NewPacketFlow error")
+				return
+			}
+			assert.NoError(t, err, "NewPacketFlow error")
+			assert.Equal(t, tc.expected, flow, "NewPacketFlow result")
+		})
+	}
+}
+
+// ptr returns a pointer to the provided packet.
+func ptr(packet channeltypes.Packet) *channeltypes.Packet {
+	return &packet
+}
@@ -0,0 +1,223 @@
+package ibcratelimit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxQuotaPercent is the largest percentage that a quota can use.
+const MaxQuotaPercent = 100
+
+// NewRateLimit creates a new RateLimit.
+func NewRateLimit(denom, channelID string, quota Quota, flow Flow) RateLimit {
+	return RateLimit{
+		Denom:     denom,
+		ChannelId: channelID,
+		Quota:     quota,
+		Flow:      flow,
+	}
+}
+
+// NewQuota creates a new Quota.
+func NewQuota(maxPercentSend, maxPercentRecv uint32, maxAmountSend, maxAmountRecv sdkmath.Int, period time.Duration) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		MaxAmountSend:  maxAmountSend,
+		MaxAmountRecv:  maxAmountRecv,
+		Period:         period,
+	}
+}
+
+// NewFlow creates a new Flow for a period that starts at the provided time.
+func NewFlow(channelValue sdkmath.Int, periodStart time.Time) Flow {
+	return Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: channelValue,
+		PeriodStart:  periodStart,
+	}
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket.
+func NewPendingSendPacket(channelID string, sequence uint64, periodStart time.Time) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		PeriodStart: periodStart,
+	}
+}
+
+// ValidateDenomAndChannel checks that the denom and channel id of a rate limit are valid.
+func ValidateDenomAndChannel(denom, channelID string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid denom %q: %w", denom, err)
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return fmt.Errorf("invalid channel id %q: %s", channelID, err.Error())
+	}
+	return nil
+}
+
+// Validate checks that the rate limit is valid.
+func (r RateLimit) Validate() error {
+	if err := ValidateDenomAndChannel(r.Denom, r.ChannelId); err != nil {
+		return err
+	}
+	if err := r.Quota.Validate(); err != nil {
+		return fmt.Errorf("invalid quota: %w", err)
+	}
+	if err := r.Flow.Validate(); err != nil {
+		return fmt.Errorf("invalid flow: %w", err)
+	}
+	return nil
+}
+
+// IsPeriodOver returns true if the rate limit's current period has ended as of the provided time.
+func (r RateLimit) IsPeriodOver(blockTime time.Time) bool {
+	return !blockTime.Before(r.Flow.PeriodStart.Add(r.Quota.Period))
+}
+
+// RemainingSend returns the amount that can still be sent out during the current period.
+// It returns nil if there is no send quota.
+func (r RateLimit) RemainingSend() *sdkmath.Int {
+	return remaining(r.Quota.SendLimit(r.Flow.ChannelValue), r.Flow.Outflow)
+}
+
+// RemainingRecv returns the amount that can still be received during the current period.
+// It returns nil if there is no receive quota.
+func (r RateLimit) RemainingRecv() *sdkmath.Int {
+	return remaining(r.Quota.RecvLimit(r.Flow.ChannelValue), r.Flow.Inflow)
+}
+
+// remaining returns how much is left of a limit, or nil if there is no limit.
+func remaining(limit *sdkmath.Int, used sdkmath.Int) *sdkmath.Int {
+	if limit == nil {
+		return nil
+	}
+	rv := sdkmath.ZeroInt()
+	if limit.GT(used) {
+		rv = limit.Sub(used)
+	}
+	return &rv
+}
+
+// AddOutflow adds an amount sent through the rate limit's channel to its outflow.
+// An error is returned (and the outflow is left unchanged) if it would exceed the send quota.
+func (r *RateLimit) AddOutflow(amount sdkmath.Int) error {
+	outflow := r.Flow.Outflow.Add(amount)
+	if limit := r.Quota.SendLimit(r.Flow.ChannelValue); limit != nil && outflow.GT(*limit) {
+		return ErrRateLimitExceeded.Wrapf("cannot send %s%s through %s: only %s remains in the current period",
+			amount, r.Denom, r.ChannelId, *r.RemainingSend())
+	}
+	r.Flow.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds an amount received through the rate limit's channel to its inflow.
+// An error is returned (and the inflow is left unchanged) if it would exceed the receive quota.
+func (r *RateLimit) AddInflow(amount sdkmath.Int) error {
+	inflow := r.Flow.Inflow.Add(amount)
+	if limit := r.Quota.RecvLimit(r.Flow.ChannelValue); limit != nil && inflow.GT(*limit) {
+		return ErrRateLimitExceeded.Wrapf("cannot receive %s%s through %s: only %s remains in the current period",
+			amount, r.Denom, r.ChannelId, *r.RemainingRecv())
+	}
+	r.Flow.Inflow = inflow
+	return nil
+}
+
+// UndoOutflow removes an amount from the rate limit's outflow, without going below zero.
+func (r *RateLimit) UndoOutflow(amount sdkmath.Int) {
+	if amount.GTE(r.Flow.Outflow) {
+		r.Flow.Outflow = sdkmath.ZeroInt()
+		return
+	}
+	r.Flow.Outflow = r.Flow.Outflow.Sub(amount)
+}
+
+// Validate checks that the quota is valid.
+func (q Quota) Validate() error {
+	if q.MaxPercentSend > MaxQuotaPercent {
+		return fmt.Errorf("max percent send %d cannot be more than %d", q.MaxPercentSend, MaxQuotaPercent)
+	}
+	if q.MaxPercentRecv > MaxQuotaPercent {
+		return fmt.Errorf("max percent recv %d cannot be more than %d", q.MaxPercentRecv, MaxQuotaPercent)
+	}
+	if q.MaxAmountSend.IsNil() || q.MaxAmountRecv.IsNil() {
+		return errors.New("max amounts cannot be empty")
+	}
+	if q.MaxAmountSend.IsNegative() {
+		return fmt.Errorf("max amount send %s cannot be negative", q.MaxAmountSend)
+	}
+	if q.MaxAmountRecv.IsNegative() {
+		return fmt.Errorf("max amount recv %s cannot be negative", q.MaxAmountRecv)
+	}
+	if q.Period <= 0 {
+		return fmt.Errorf("period %s must be positive", q.Period)
+	}
+	if q.MaxPercentSend == 0 && q.MaxPercentRecv == 0 && q.MaxAmountSend.IsZero() && q.MaxAmountRecv.IsZero() {
+		return errors.New("at least one percent or amount must be set")
+	}
+	return nil
+}
+
+// SendLimit returns the max outflow allowed during a period with the provided channel value.
+// It returns nil if there is no send quota.
+func (q Quota) SendLimit(channelValue sdkmath.Int) *sdkmath.Int {
+	return limitOf(q.MaxPercentSend, q.MaxAmountSend, channelValue)
+}
+
+// RecvLimit returns the max inflow allowed during a period with the provided channel value.
+// It returns nil if there is no receive quota.
+func (q Quota) RecvLimit(channelValue sdkmath.Int) *sdkmath.Int {
+	return limitOf(q.MaxPercentRecv, q.MaxAmountRecv, channelValue)
+}
+
+// limitOf returns the smaller of the percentage and absolute limits, or nil if neither applies.
+// A percentage of a zero (or empty) channel value is zero, so nothing can flow until the channel value is positive.
+func limitOf(percent uint32, amount, channelValue sdkmath.Int) *sdkmath.Int {
+	var rv *sdkmath.Int
+	if percent > 0 {
+		limit := sdkmath.ZeroInt()
+		if !channelValue.IsNil() && channelValue.IsPositive() {
+			limit = channelValue.MulRaw(int64(percent)).QuoRaw(MaxQuotaPercent)
+		}
+		rv = &limit
+	}
+	if !amount.IsNil() && amount.IsPositive() && (rv == nil || amount.LT(*rv)) {
+		limit := amount
+		rv = &limit
+	}
+	return rv
+}
+
+// Validate checks that the flow is valid.
+func (f Flow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return fmt.Errorf("inflow %s cannot be negative", f.Inflow)
+	}
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return fmt.Errorf("outflow %s cannot be negative", f.Outflow)
+	}
+	if f.ChannelValue.IsNil() || f.ChannelValue.IsNegative() {
+		return fmt.Errorf("channel value %s cannot be negative", f.ChannelValue)
+	}
+	return nil
+}
+
+// Validate checks that the pending send packet is valid.
+func (p PendingSendPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel id %q: %s", p.ChannelId, err.Error())
+	}
+	if p.Sequence == 0 {
+		return errors.New("invalid sequence: cannot be zero")
+	}
+	return nil
+}
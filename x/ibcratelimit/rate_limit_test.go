@@ -0,0 +1,218 @@
+package ibcratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		quota ibcratelimit.Quota
+		err   string
+	}{
+		{
+			name:  "success - percentages",
+			quota: ibcratelimit.NewQuota(10, 100, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+		},
+		{
+			name:  "success - amounts",
+			quota: ibcratelimit.NewQuota(0, 0, sdkmath.NewInt(1), sdkmath.ZeroInt(), time.Hour),
+		},
+		{
+			name:  "failure - send percent too large",
+			quota: ibcratelimit.NewQuota(101, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			err:   "max percent send 101 cannot be more than 100",
+		},
+		{
+			name:  "failure - recv percent too large",
+			quota: ibcratelimit.NewQuota(0, 200, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			err:   "max percent recv 200 cannot be more than 100",
+		},
+		{
+			name:  "failure - negative send amount",
+			quota: ibcratelimit.NewQuota(1, 0, sdkmath.NewInt(-1), sdkmath.ZeroInt(), time.Hour),
+			err:   "max amount send -1 cannot be negative",
+		},
+		{
+			name:  "failure - negative recv amount",
+			quota: ibcratelimit.NewQuota(1, 0, sdkmath.ZeroInt(), sdkmath.NewInt(-5), time.Hour),
+			err:   "max amount recv -5 cannot be negative",
+		},
+		{
+			name:  "failure - no period",
+			quota: ibcratelimit.NewQuota(1, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0),
+			err:   "period 0s must be positive",
+		},
+		{
+			name:  "failure - no limits",
+			quota: ibcratelimit.NewQuota(0, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			err:   "at least one percent or amount must be set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestQuotaLimits(t *testing.T) {
+	intPtr := func(i int64) *sdkmath.Int {
+		rv := sdkmath.NewInt(i)
+		return &rv
+	}
+	tests := []struct {
+		name         string
+		quota        ibcratelimit.Quota
+		channelValue sdkmath.Int
+		expSend      *sdkmath.Int
+		expRecv      *sdkmath.Int
+	}{
+		{
+			name:         "no limits in either direction",
+			quota:        ibcratelimit.NewQuota(0, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			channelValue: sdkmath.NewInt(1000),
+		},
+		{
+			name:         "percentages of the channel value",
+			quota:        ibcratelimit.NewQuota(10, 25, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			channelValue: sdkmath.NewInt(1000),
+			expSend:      intPtr(100),
+			expRecv:      intPtr(250),
+		},
+		{
+			name:         "percentages of a zero channel value",
+			quota:        ibcratelimit.NewQuota(10, 25, sdkmath.ZeroInt(), sdkmath.NewInt(3), time.Hour),
+			channelValue: sdkmath.ZeroInt(),
+			expSend:      intPtr(0),
+			expRecv:      intPtr(0),
+		},
+		{
+			name:         "percentages of an empty channel value",
+			quota:        ibcratelimit.NewQuota(10, 0, sdkmath.ZeroInt(), sdkmath.NewInt(3), time.Hour),
+			channelValue: sdkmath.Int{},
+			expSend:      intPtr(0),
+			expRecv:      intPtr(3),
+		},
+		{
+			name:         "amounts without percentages and a zero channel value",
+			quota:        ibcratelimit.NewQuota(0, 0, sdkmath.NewInt(7), sdkmath.NewInt(3), time.Hour),
+			channelValue: sdkmath.ZeroInt(),
+			expSend:      intPtr(7),
+			expRecv:      intPtr(3),
+		},
+		{
+			name:         "the smaller of the percentage and amount",
+			quota:        ibcratelimit.NewQuota(10, 25, sdkmath.NewInt(50), sdkmath.NewInt(300), time.Hour),
+			channelValue: sdkmath.NewInt(1000),
+			expSend:      intPtr(50),
+			expRecv:      intPtr(250),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expSend, tc.quota.SendLimit(tc.channelValue), "SendLimit")
+			assert.Equal(t, tc.expRecv, tc.quota.RecvLimit(tc.channelValue), "RecvLimit")
+		})
+	}
+}
+
+func TestRateLimitFlows(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	quota := ibcratelimit.NewQuota(10, 0, sdkmath.ZeroInt(), sdkmath.NewInt(30), time.Hour)
+	rateLimit := ibcratelimit.NewRateLimit("nhash", "channel-0", quota, ibcratelimit.NewFlow(sdkmath.NewInt(1000), start))
+
+	assert.Equal(t, sdkmath.NewInt(100), *rateLimit.RemainingSend(), "RemainingSend before any flow")
+	assert.Equal(t, sdkmath.NewInt(30), *rateLimit.RemainingRecv(), "RemainingRecv before any flow")
+
+	assert.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(60)), "AddOutflow(60)")
+	assert.EqualError(t, rateLimit.AddOutflow(sdkmath.NewInt(41)),
+		"cannot send 41nhash through channel-0: only 40 remains in the current period: rate limit exceeded", "AddOutflow(41)")
+	assert.Equal(t, sdkmath.NewInt(60), rateLimit.Flow.Outflow, "outflow after a rejected send")
+	assert.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(40)), "AddOutflow(40)")
+	assert.Equal(t, sdkmath.ZeroInt(), *rateLimit.RemainingSend(), "RemainingSend when used up")
+
+	assert.NoError(t, rateLimit.AddInflow(sdkmath.NewInt(30)), "AddInflow(30)")
+	assert.EqualError(t, rateLimit.AddInflow(sdkmath.NewInt(1)),
+		"cannot receive 1nhash through channel-0: only 0 remains in the current period: rate limit exceeded", "AddInflow(1)")
+
+	rateLimit.UndoOutflow(sdkmath.NewInt(25))
+	assert.Equal(t, sdkmath.NewInt(75), rateLimit.Flow.Outflow, "outflow after undoing 25")
+	rateLimit.UndoOutflow(sdkmath.NewInt(500))
+	assert.Equal(t, sdkmath.ZeroInt(), rateLimit.Flow.Outflow, "outflow after undoing more than was sent")
+
+	assert.False(t, rateLimit.IsPeriodOver(start.Add(time.Hour-time.Second)), "IsPeriodOver just before the end")
+	assert.True(t, rateLimit.IsPeriodOver(start.Add(time.Hour)), "IsPeriodOver at the end")
+
+	noSendQuota := ibcratelimit.NewRateLimit("nhash", "channel-0", ibcratelimit.NewQuota(0, 5, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour), ibcratelimit.NewFlow(sdkmath.NewInt(1000), start))
+	assert.Nil(t, noSendQuota.RemainingSend(), "RemainingSend without a send quota")
+	assert.NoError(t, noSendQuota.AddOutflow(sdkmath.NewInt(1_000_000)), "AddOutflow without a send quota")
+}
+
+func TestRateLimitValidate(t *testing.T) {
+	quota := ibcratelimit.NewQuota(10, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour)
+	flow := ibcratelimit.NewFlow(sdkmath.NewInt(1000), time.Unix(1_700_000_000, 0))
+	tests := []struct {
+		name      string
+		rateLimit ibcratelimit.RateLimit
+		err       string
+	}{
+		{
+			name:      "success - valid rate limit",
+			rateLimit: ibcratelimit.NewRateLimit("nhash", "channel-0", quota, flow),
+		},
+		{
+			name:      "failure - invalid denom",
+			rateLimit: ibcratelimit.NewRateLimit("x", "channel-0", quota, flow),
+			err:       "invalid denom \"x\": invalid denom: x",
+		},
+		{
+			name:      "failure - invalid channel",
+			rateLimit: ibcratelimit.NewRateLimit("nhash", "bad", quota, flow),
+			err:       "invalid channel id \"bad\": identifier bad has invalid length: 3, must be between 8-64 characters: invalid identifier",
+		},
+		{
+			name:      "failure - invalid quota",
+			rateLimit: ibcratelimit.NewRateLimit("nhash", "channel-0", ibcratelimit.Quota{MaxAmountSend: sdkmath.ZeroInt(), MaxAmountRecv: sdkmath.ZeroInt()}, flow),
+			err:       "invalid quota: period 0s must be positive",
+		},
+		{
+			name:      "failure - invalid flow",
+			rateLimit: ibcratelimit.NewRateLimit("nhash", "channel-0", quota, ibcratelimit.NewFlow(sdkmath.NewInt(-1), time.Time{})),
+			err:       "invalid flow: channel value -1 cannot be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rateLimit.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestPendingSendPacketValidate(t *testing.T) {
+	assert.NoError(t, ibcratelimit.NewPendingSendPacket("channel-0", 1, time.Time{}).Validate(), "valid pending packet")
+	assert.EqualError(t, ibcratelimit.NewPendingSendPacket("channel-0", 0, time.Time{}).Validate(),
+		"invalid sequence: cannot be zero", "pending packet without a sequence")
+	assert.ErrorContains(t, ibcratelimit.NewPendingSendPacket("", 1, time.Time{}).Validate(),
+		"invalid channel id \"\"", "pending packet without a channel")
+}
@@ -22,6 +22,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &attribB)
 
 			return fmt.Sprintf("Params: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], ibcratelimit.RateLimitKeyPrefix):
+			var rateLimitA, rateLimitB ibcratelimit.RateLimit
+
+			cdc.MustUnmarshal(kvA.Value, &rateLimitA)
+			cdc.MustUnmarshal(kvB.Value, &rateLimitB)
+
+			return fmt.Sprintf("RateLimit: A:[%v] B:[%v]\n", rateLimitA, rateLimitB)
+		case bytes.Equal(kvA.Key[:1], ibcratelimit.PendingSendPacketKeyPrefix):
+			var pendingA, pendingB ibcratelimit.PendingSendPacket
+
+			cdc.MustUnmarshal(kvA.Value, &pendingA)
+			cdc.MustUnmarshal(kvB.Value, &pendingB)
+
+			return fmt.Sprintf("PendingSendPacket: A:[%v] B:[%v]\n", pendingA, pendingB)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", ibcratelimit.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -2,9 +2,12 @@ package simulation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/provenance-io/provenance/app"
@@ -20,6 +23,17 @@ func TestDecodeStore(t *testing.T) {
 		p := ibcratelimit.NewParams("contract a")
 		return cdc.MustMarshal(&p)
 	}
+	start := time.Unix(1_700_000_000, 0).UTC()
+	rateLimit := func(denom string) []byte {
+		r := ibcratelimit.NewRateLimit(denom, "channel-0",
+			ibcratelimit.NewQuota(1, 2, sdkmath.NewInt(3), sdkmath.NewInt(4), time.Hour),
+			ibcratelimit.NewFlow(sdkmath.NewInt(5), start))
+		return cdc.MustMarshal(&r)
+	}
+	pending := func(sequence uint64) []byte {
+		p := ibcratelimit.NewPendingSendPacket("channel-0", sequence, start)
+		return cdc.MustMarshal(&p)
+	}
 
 	tests := []struct {
 		name     string
@@ -40,6 +54,18 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: ibcratelimit.ParamsKey, Value: params("contract b")},
 			exp:  "Params: A:[{contract a}] B:[{contract a}]\n",
 		},
+		{
+			name: "success - RateLimitKey",
+			kvA:  kv.Pair{Key: ibcratelimit.GetRateLimitKey("nhash", "channel-0"), Value: rateLimit("nhash")},
+			kvB:  kv.Pair{Key: ibcratelimit.GetRateLimitKey("other", "channel-0"), Value: rateLimit("other")},
+			exp:  "RateLimit: A:[{nhash channel-0 {1 2 3 4 1h0m0s} {0 0 5 2023-11-14 22:13:20 +0000 UTC}}] B:[{other channel-0 {1 2 3 4 1h0m0s} {0 0 5 2023-11-14 22:13:20 +0000 UTC}}]\n",
+		},
+		{
+			name: "success - PendingSendPacketKey",
+			kvA:  kv.Pair{Key: ibcratelimit.GetPendingSendPacketKey("channel-0", 1), Value: pending(1)},
+			kvB:  kv.Pair{Key: ibcratelimit.GetPendingSendPacketKey("channel-0", 2), Value: pending(2)},
+			exp:  "PendingSendPacket: A:[{channel-0 1 2023-11-14 22:13:20 +0000 UTC}] B:[{channel-0 2 2023-11-14 22:13:20 +0000 UTC}]\n",
+		},
 	}
 
 	for _, tc := range tests {
@@ -66,7 +66,9 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     0,
 			accounts: nil,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams(""),
+				Params:             ibcratelimit.NewParams(""),
+				RateLimits:         []ibcratelimit.RateLimit{},
+				PendingSendPackets: []ibcratelimit.PendingSendPacket{},
 			},
 		},
 		{
@@ -74,7 +76,9 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     1,
 			accounts: accs,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams(""),
+				Params:             ibcratelimit.NewParams(""),
+				RateLimits:         []ibcratelimit.RateLimit{},
+				PendingSendPackets: []ibcratelimit.PendingSendPacket{},
 			},
 		},
 		{
@@ -82,7 +86,9 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     2,
 			accounts: accs,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams("cosmos12jszjrc0qhjt0ugt2uh4ptwu0h55pq6qfp9ecl"),
+				Params:             ibcratelimit.NewParams("cosmos12jszjrc0qhjt0ugt2uh4ptwu0h55pq6qfp9ecl"),
+				RateLimits:         []ibcratelimit.RateLimit{},
+				PendingSendPackets: []ibcratelimit.PendingSendPacket{},
 			},
 		},
 	}
@@ -458,7 +458,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000"}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000","max_nav_history_entries":100}`,
 		},
 		{
 			"get testcoin marker json",
@@ -542,7 +542,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				s.cfg.BondDenom,
 			},
-			"accounts: []",
+			"accounts: []\nusage: []",
 		},
 		{
 			"query escrow",
@@ -696,6 +696,38 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"add limited access",
+			markercli.GetCmdAddAccess(),
+			[]string{
+				s.accountAddresses[2].String(),
+				"hotdog",
+				"mint",
+				fmt.Sprintf("--%s=%s", markercli.FlagExpiration, "2100-01-01T00:00:00Z"),
+				fmt.Sprintf("--%s=%s", markercli.FlagTotalCap, "1000"),
+				fmt.Sprintf("--%s=%s", markercli.FlagMaxPerTx, "100"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"add access with invalid total cap",
+			markercli.GetCmdAddAccess(),
+			[]string{
+				s.accountAddresses[2].String(),
+				"hotdog",
+				"mint",
+				fmt.Sprintf("--%s=%s", markercli.FlagTotalCap, "lots"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
 		{
 			"mint supply",
 			markercli.GetCmdMint(),
@@ -2578,6 +2610,7 @@ func (s *IntegrationTestSuite) TestUpdateMarkerParamsCmd() {
 				"true",
 				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 				"1000000",
+				"100",
 			},
 			expectedCode: 0,
 		},
@@ -2588,6 +2621,7 @@ func (s *IntegrationTestSuite) TestUpdateMarkerParamsCmd() {
 				"invalid",
 				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 				"1000000",
+				"100",
 			},
 			expectErr: `invalid enable governance flag: strconv.ParseBool: parsing "invalid": invalid syntax`,
 		},
@@ -2598,9 +2632,21 @@ func (s *IntegrationTestSuite) TestUpdateMarkerParamsCmd() {
 				"true",
 				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 				"invalid",
+				"100",
 			},
 			expectErr: `invalid max supply: "invalid"`,
 		},
+		{
+			name: "update marker params, should fail incorrect max nav history entries",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"many",
+			},
+			expectErr: `invalid max nav history entries: strconv.ParseUint: parsing "many": invalid syntax`,
+		},
 	}
 
 	for _, tc := range testCases {
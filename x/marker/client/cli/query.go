@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -33,6 +35,9 @@ func GetQueryCmd() *cobra.Command {
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
 		NetAssetValuesCmd(),
+		NetAssetValueAtHeightCmd(),
+		NetAssetValueTWAPCmd(),
+		SendLimitsCmd(),
 	)
 	return queryCmd
 }
@@ -334,3 +339,129 @@ func NetAssetValuesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// NetAssetValueAtHeightCmd is the CLI command for querying the net asset value a marker had at a block height.
+func NetAssetValueAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "net-asset-value-at-height <address|denom> <price denom> [height]",
+		Aliases: []string{"nav-at-height", "navah"},
+		Short:   "Get the net asset value a marker had in a price denom at a block height",
+		Long: strings.TrimSpace(`Get the most recent net asset value of a marker in a price denom that was set at or before a block height.
+If no height is provided, the current block height is used.`),
+		Example: fmt.Sprintf(`$ %[1]s query marker net-asset-value-at-height "hotdogcoin" usd
+$ %[1]s query marker net-asset-value-at-height "hotdogcoin" usd 12345`, version.AppName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryNetAssetValueAtHeightRequest{
+				Id:         strings.TrimSpace(args[0]),
+				PriceDenom: strings.TrimSpace(args[1]),
+			}
+			if len(args) > 2 {
+				if req.Height, err = strconv.ParseInt(strings.TrimSpace(args[2]), 10, 64); err != nil {
+					return fmt.Errorf("invalid height %q: %w", args[2], err)
+				}
+			}
+
+			response, err := queryClient.NetAssetValueAtHeight(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query marker %q net asset value at height: %w", req.Id, err)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NetAssetValueTWAPCmd is the CLI command for querying the time-weighted average price of a marker.
+func NetAssetValueTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "net-asset-value-twap <address|denom> <price denom> <start time> [end time]",
+		Aliases: []string{"nav-twap", "navtwap"},
+		Short:   "Get the time-weighted average price per unit of a marker in a price denom",
+		Long: strings.TrimSpace(`Get the average price of one unit of a marker in a price denom from the start time up to (but not including)
+the end time. Each net asset value is weighted by the amount of time it was in effect for.
+Times must be in RFC3339 format. If no end time is provided, the current block time is used.`),
+		Example: fmt.Sprintf(`$ %[1]s query marker net-asset-value-twap "hotdogcoin" usd 2024-01-01T00:00:00Z
+$ %[1]s query marker net-asset-value-twap "hotdogcoin" usd 2024-01-01T00:00:00Z 2024-02-01T00:00:00Z`, version.AppName),
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryNetAssetValueTWAPRequest{
+				Id:         strings.TrimSpace(args[0]),
+				PriceDenom: strings.TrimSpace(args[1]),
+			}
+			startTime, err := time.Parse(time.RFC3339, strings.TrimSpace(args[2]))
+			if err != nil {
+				return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", args[2], time.RFC3339, err)
+			}
+			req.StartTime = startTime
+			if len(args) > 3 {
+				endTime, err := time.Parse(time.RFC3339, strings.TrimSpace(args[3]))
+				if err != nil {
+					return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", args[3], time.RFC3339, err)
+				}
+				req.EndTime = &endTime
+			}
+
+			response, err := queryClient.NetAssetValueTWAP(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query marker %q net asset value twap: %w", req.Id, err)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SendLimitsCmd is the CLI command for querying a restricted marker's send limits, or an account's usage of them.
+func SendLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-limits <address|denom> [account address]",
+		Aliases: []string{"sl"},
+		Short:   "Get a restricted marker's send limits",
+		Long: strings.TrimSpace(`Get a restricted marker's send limits.
+If an account address is provided, the limit that applies to that account is returned along with
+how much the account has sent in the current window.`),
+		Example: fmt.Sprintf(`$ %[1]s query marker send-limits "hotdogcoin"
+$ %[1]s query marker send-limits "hotdogcoin" pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			if len(args) == 1 {
+				var response *types.QuerySendLimitsResponse
+				response, err = queryClient.SendLimits(context.Background(), &types.QuerySendLimitsRequest{Id: id})
+				if err != nil {
+					return fmt.Errorf("failed to query marker %q send limits: %w", id, err)
+				}
+				return clientCtx.PrintProto(response)
+			}
+
+			var response *types.QuerySendLimitUsageResponse
+			req := &types.QuerySendLimitUsageRequest{Id: id, Address: strings.TrimSpace(args[1])}
+			response, err = queryClient.SendLimitUsage(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query marker %q send limit usage of %s: %w", id, req.Address, err)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -56,6 +56,12 @@ const (
 	FlagUsdMills               = "usd-mills"
 	FlagVolume                 = "volume"
 	FlagTargetAddress          = "target-address"
+	FlagTotalCap               = "total-cap"
+	FlagMaxPerTx               = "max-per-tx"
+	FlagDefaultLimit           = "default-limit"
+	FlagRemoveDefaultLimit     = "remove-default-limit"
+	FlagSetAddressLimit        = "set-address-limit"
+	FlagRemoveAddressLimit     = "remove-address-limit"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -89,6 +95,7 @@ func NewTxCmd() *cobra.Command {
 		GetCmdUpdateForcedTransfer(),
 		GetCmdSetAccountData(),
 		GetCmdUpdateSendDenyListRequest(),
+		GetCmdUpdateSendLimits(),
 		GetCmdAddNetAssetValues(),
 		GetCmdSupplyDecreaseProposal(),
 		GetCmdSupplyIncreaseProposal(),
@@ -342,8 +349,13 @@ func GetCmdAddAccess() *cobra.Command {
 		Short:   "Grant access to a marker for the address coins from the marker",
 		Long: strings.TrimSpace(`Grant administrative access to a marker.  From Address must have appropriate
 existing access.  Permissions are appended to any existing access grant.  Valid permissions
-are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
-		Example: fmt.Sprintf(`$ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey`, version.AppName),
+are one of [mint, burn, deposit, withdraw, delete, admin, transfer].
+
+The grant can optionally be limited with an expiration (an RFC 3339 timestamp), a total cap on
+the amount of the marker's denom that can be minted, burned, withdrawn or transferred using it,
+and a max amount for any single one of those actions. Provided limits replace any existing ones.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey
+$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom mint --expiration 2030-01-01T00:00:00Z --total-cap 1000000 --max-per-tx 1000 --from mykey`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -355,6 +367,9 @@ are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
 				return cerrs.Wrapf(err, "grant for invalid address %s", args[0])
 			}
 			grant := types.NewAccessGrant(targetAddr, types.AccessListByNames(args[2]))
+			if err = parseAccessGrantLimitFlags(cmd, grant); err != nil {
+				return err
+			}
 			if err = grant.Validate(); err != nil {
 				return cerrs.Wrapf(err, "invalid access grant permission: %s", args[2])
 			}
@@ -363,10 +378,50 @@ are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp at which the grant expires")
+	cmd.Flags().String(FlagTotalCap, "", "The total amount that can be minted, burned, withdrawn or transferred using the grant")
+	cmd.Flags().String(FlagMaxPerTx, "", "The max amount that can be minted, burned, withdrawn or transferred in a single action using the grant")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parseAccessGrantLimitFlags reads the expiration, total cap, and max per tx flags into the provided grant.
+func parseAccessGrantLimitFlags(cmd *cobra.Command, grant *types.AccessGrant) error {
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return err
+	}
+	if exp != "" {
+		expiration, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", FlagExpiration, err)
+		}
+		grant.Expiration = &expiration
+	}
+
+	for _, limit := range []struct {
+		flag  string
+		field **sdkmath.Int
+	}{
+		{flag: FlagTotalCap, field: &grant.TotalCap},
+		{flag: FlagMaxPerTx, field: &grant.MaxPerTx},
+	} {
+		str, err := cmd.Flags().GetString(limit.flag)
+		if err != nil {
+			return err
+		}
+		if str == "" {
+			continue
+		}
+		amt, ok := sdkmath.NewIntFromString(str)
+		if !ok {
+			return fmt.Errorf("invalid %s: %q", limit.flag, str)
+		}
+		*limit.field = &amt
+	}
+	return nil
+}
+
 // GetCmdDeleteAccess implements the revoke administrative access for a marker command.
 func GetCmdDeleteAccess() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1011,6 +1066,102 @@ func GetCmdUpdateSendDenyListRequest() *cobra.Command {
 	return cmd
 }
 
+// GetCmdUpdateSendLimits returns a CLI command for updating a restricted marker's send limits.
+func GetCmdUpdateSendLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-send-limits <denom>",
+		Aliases: []string{"usl", "send-limits"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update the rolling-window send limits of a restricted marker",
+		Long: strings.TrimSpace(`Update the rolling-window send limits of a restricted marker.
+A limit has the format <amount>/<window>, e.g. 1000/24h is at most 1000 sent in any 24 hour period.
+The default limit applies to all accounts that do not have their own limit.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker update-send-limits hotdogcoin --%[2]s=1000/24h --%[3]s=bech32addr1=5000/24h --%[4]s=bech32addr2`,
+			version.AppName,
+			FlagDefaultLimit,
+			FlagSetAddressLimit,
+			FlagRemoveAddressLimit,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgUpdateSendLimitsRequest{Denom: args[0]}
+
+			defaultLimit, err := flagSet.GetString(FlagDefaultLimit)
+			if err != nil {
+				return err
+			}
+			if len(defaultLimit) > 0 {
+				msg.DefaultLimit, err = ParseSendLimit(defaultLimit)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", FlagDefaultLimit, err)
+				}
+			}
+
+			msg.RemoveDefaultLimit, err = flagSet.GetBool(FlagRemoveDefaultLimit)
+			if err != nil {
+				return err
+			}
+
+			addrLimits, err := flagSet.GetStringSlice(FlagSetAddressLimit)
+			if err != nil {
+				return err
+			}
+			for _, addrLimit := range addrLimits {
+				parts := strings.Split(addrLimit, "=")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid %s %q: expected format <address>=<amount>/<window>", FlagSetAddressLimit, addrLimit)
+				}
+				limit, err := ParseSendLimit(parts[1])
+				if err != nil {
+					return fmt.Errorf("invalid %s %q: %w", FlagSetAddressLimit, addrLimit, err)
+				}
+				msg.SetAddressLimits = append(msg.SetAddressLimits, types.AddressSendLimit{Address: parts[0], Limit: *limit})
+			}
+
+			msg.RemoveAddressLimits, err = flagSet.GetStringSlice(FlagRemoveAddressLimit)
+			if err != nil {
+				return err
+			}
+
+			authSetter := func(authority string) {
+				msg.Authority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	cmd.Flags().String(FlagDefaultLimit, "", "the default send limit, <amount>/<window>")
+	cmd.Flags().Bool(FlagRemoveDefaultLimit, false, "remove the default send limit")
+	cmd.Flags().StringSlice(FlagSetAddressLimit, []string{}, "comma delimited list of account send limits to set, <address>=<amount>/<window>")
+	cmd.Flags().StringSlice(FlagRemoveAddressLimit, []string{}, "comma delimited list of bech32 addresses to remove the account send limits of")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParseSendLimit parses a send limit string with the format <amount>/<window>, e.g. 1000/24h.
+func ParseSendLimit(str string) (*types.SendLimit, error) {
+	parts := strings.Split(str, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid send limit %q: expected format <amount>/<window>", str)
+	}
+	amount, ok := sdkmath.NewIntFromString(parts[0])
+	if !ok {
+		return nil, fmt.Errorf("invalid send limit amount %q", parts[0])
+	}
+	window, err := time.ParseDuration(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid send limit window %q: %w", parts[1], err)
+	}
+	return types.NewSendLimit(amount, window), nil
+}
+
 // GetCmdSetAccountData returns a CLI command for setting a marker's account data.
 func GetCmdSetAccountData() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1593,11 +1744,11 @@ func generateOrBroadcastOptGovProp(clientCtx client.Context, flagSet *pflag.Flag
 // GetUpdateMarkerParamsCmd creates a command to update the marker module's params via governance proposal.
 func GetUpdateMarkerParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply>",
+		Use:     "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply> <max-nav-history-entries>",
 		Short:   "Update the marker module's params via governance proposal",
 		Long:    "Submit an update marker params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(3),
-		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --deposit 50000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(4),
+		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 100 --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -1619,10 +1770,16 @@ func GetUpdateMarkerParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid max supply: %q", args[2])
 			}
 
+			maxNavHistoryEntries, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max nav history entries: %w", err)
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				enableGovernance,
 				unrestrictedDenomRegex,
 				maxSupply,
+				uint32(maxNavHistoryEntries),
 				authority,
 			)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// getAccessGrant returns the access grant in the marker for the provided address, or nil if there isn't one.
+func getAccessGrant(marker types.MarkerAccountI, addr sdk.AccAddress) *types.AccessGrant {
+	for _, grant := range marker.GetAccessList() {
+		if grant.GetAddress().Equals(addr) {
+			return &grant
+		}
+	}
+	return nil
+}
+
+// setAccessGrantExpirationIndexes adds an expiration index entry for each of the marker's access grants that
+// have an expiration. Index entries for grants that have since been changed or removed are left in place;
+// they are checked against the marker when they come due.
+func setAccessGrantExpirationIndexes(store storetypes.KVStore, marker types.MarkerAccountI) {
+	for _, grant := range marker.GetAccessList() {
+		if grant.Expiration != nil {
+			store.Set(types.AccessGrantExpirationIndexKey(*grant.Expiration, marker.GetAddress(), grant.GetAddress()), []byte{})
+		}
+	}
+}
+
+// GetAccessGrantUsage gets the amount that the grantee has used against their access grant on the provided marker.
+func (k Keeper) GetAccessGrantUsage(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) sdkmath.Int {
+	return getAccessGrantUsage(ctx.KVStore(k.storeKey), markerAddr, grantee)
+}
+
+// getAccessGrantUsage gets the amount that the grantee has used against their access grant on the provided marker.
+func getAccessGrantUsage(store storetypes.KVStore, markerAddr, grantee sdk.AccAddress) sdkmath.Int {
+	bz := store.Get(types.AccessGrantUsageKey(markerAddr, grantee))
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+	rv, ok := sdkmath.NewIntFromString(string(bz))
+	if !ok {
+		return sdkmath.ZeroInt()
+	}
+	return rv
+}
+
+// setAccessGrantUsage records the amount that the grantee has used against their access grant on the provided marker.
+func setAccessGrantUsage(store storetypes.KVStore, markerAddr, grantee sdk.AccAddress, used sdkmath.Int) {
+	store.Set(types.AccessGrantUsageKey(markerAddr, grantee), []byte(used.String()))
+}
+
+// deleteAccessGrantUsage removes the record of what the grantee has used against their access grant on the provided marker.
+func deleteAccessGrantUsage(store storetypes.KVStore, markerAddr, grantee sdk.AccAddress) {
+	store.Delete(types.AccessGrantUsageKey(markerAddr, grantee))
+}
+
+// clearAccessGrantUsage removes all records of what has been used against the provided marker's access grants.
+func clearAccessGrantUsage(store storetypes.KVStore, markerAddr sdk.AccAddress) {
+	iter := storetypes.KVStorePrefixIterator(store, types.AccessGrantUsageMarkerPrefix(markerAddr))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// consumeAccessGrantLimits makes sure that the caller's access grant on the marker allows the provided amount to
+// be used, and records that usage against the grant's total cap. An error is returned if the amount is more than
+// the grant's max per tx, or if the amount would put the grant's usage over its total cap.
+// Expiration is checked by the marker's ValidateAddressHasAccess, which must be called before this.
+func (k Keeper) consumeAccessGrantLimits(ctx sdk.Context, marker types.MarkerAccountI, caller sdk.AccAddress, amount sdkmath.Int) error {
+	grant := getAccessGrant(marker, caller)
+	if grant == nil {
+		return nil
+	}
+
+	if grant.MaxPerTx != nil && amount.GT(*grant.MaxPerTx) {
+		return fmt.Errorf("amount %s exceeds the max per tx %s of the access grant for %s on %s marker",
+			amount, grant.MaxPerTx, caller, marker.GetDenom())
+	}
+	if grant.TotalCap == nil {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	used := getAccessGrantUsage(store, marker.GetAddress(), caller).Add(amount)
+	if used.GT(*grant.TotalCap) {
+		return fmt.Errorf("amount %s would exceed the total cap %s of the access grant for %s on %s marker (already used: %s)",
+			amount, grant.TotalCap, caller, marker.GetDenom(), used.Sub(amount))
+	}
+	setAccessGrantUsage(store, marker.GetAddress(), caller, used)
+	return nil
+}
+
+// PruneExpiredAccessGrants removes all access grants that have expired as of the current block time.
+func (k Keeper) PruneExpiredAccessGrants(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.AccessGrantExpirationIndexPrefix, types.AccessGrantExpirationIndexPrefixUpTo(ctx.BlockTime()))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	var errs []error
+	for _, key := range keys {
+		markerAddr, grantee := types.GetAccessGrantExpirationIndexAddresses(key)
+		// The index entry is kept if the revoke fails so that it's tried again in a later block.
+		if err := k.revokeExpiredAccessGrant(ctx, markerAddr, grantee); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		store.Delete(key)
+	}
+
+	if len(errs) > 0 {
+		k.Logger(ctx).Error(fmt.Sprintf("%d error(s) encountered pruning expired access grants", len(errs)), "error", errors.Join(errs...))
+	}
+}
+
+// revokeExpiredAccessGrant removes the grantee's access grant from the marker if it has expired.
+func (k Keeper) revokeExpiredAccessGrant(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) error {
+	marker, err := k.GetMarker(ctx, markerAddr)
+	if err != nil {
+		return fmt.Errorf("could not get marker %s: %w", markerAddr, err)
+	}
+	// The marker and grant might have changed since this index entry was created.
+	if marker == nil {
+		return nil
+	}
+	grant := getAccessGrant(marker, grantee)
+	if grant == nil || !grant.IsExpired(ctx.BlockTime()) {
+		return nil
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err = marker.RevokeAccess(grantee); err != nil {
+		return fmt.Errorf("could not revoke expired access grant for %s on %s marker: %w", grantee, marker.GetDenom(), err)
+	}
+	if err = marker.Validate(); err != nil {
+		return fmt.Errorf("could not revoke expired access grant for %s on %s marker: %w", grantee, marker.GetDenom(), err)
+	}
+	k.SetMarker(cacheCtx, marker)
+	deleteAccessGrantUsage(cacheCtx.KVStore(k.storeKey), markerAddr, grantee)
+	event := types.NewEventMarkerDeleteAccess(grantee.String(), marker.GetDenom(), k.markerModuleAddr.String())
+	if err = cacheCtx.EventManager().EmitTypedEvent(event); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestAccessGrantLimits(t *testing.T) {
+	app := simapp.Setup(t)
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(blockTime)
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	k := app.MarkerKeeper
+	denom := "limitcoin"
+	addr := types.MustGetMarkerAddress(denom)
+	manager := testUserAddress("manager")
+	minter := testUserAddress("minter")
+
+	mac := types.NewEmptyMarkerAccount(denom, manager.String(), []types.AccessGrant{
+		*types.NewAccessGrant(manager, []types.Access{types.Access_Mint, types.Access_Burn, types.Access_Withdraw, types.Access_Admin}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	require.NoError(t, k.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+
+	totalCap, maxPerTx := sdkmath.NewInt(100), sdkmath.NewInt(40)
+	expiration := blockTime.Add(time.Hour)
+	grant := types.NewAccessGrant(minter, []types.Access{types.Access_Mint, types.Access_Withdraw})
+	grant.Expiration, grant.TotalCap, grant.MaxPerTx = &expiration, &totalCap, &maxPerTx
+	require.NoError(t, k.AddAccess(ctx, manager, denom, grant), "AddAccess with limits")
+
+	past := blockTime.Add(-1 * time.Second)
+	expiredGrant := types.NewAccessGrant(testUserAddress("other"), []types.Access{types.Access_Mint})
+	expiredGrant.Expiration = &past
+	err := k.AddAccess(ctx, manager, denom, expiredGrant)
+	assert.EqualError(t, err, "access grant failed: expiration must be after the current block time", "AddAccess already expired")
+
+	// The max per tx applies to each action and nothing is used when it's exceeded.
+	err = k.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 41))
+	assert.ErrorContains(t, err, "amount 41 exceeds the max per tx 40", "MintCoin more than max per tx")
+	assert.Equal(t, "0", k.GetAccessGrantUsage(ctx, addr, minter).String(), "usage after failed mint")
+
+	// Mints and withdraws of the marker's denom both count against the total cap.
+	require.NoError(t, k.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 40)), "MintCoin 40")
+	require.NoError(t, k.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 40)), "MintCoin another 40")
+	assert.Equal(t, "80", k.GetAccessGrantUsage(ctx, addr, minter).String(), "usage after mints")
+	err = k.WithdrawCoins(ctx, minter, minter, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 21)))
+	assert.ErrorContains(t, err, "amount 21 would exceed the total cap 100", "WithdrawCoins over the total cap")
+	require.NoError(t, k.WithdrawCoins(ctx, minter, minter, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))), "WithdrawCoins 20")
+	assert.Equal(t, "100", k.GetAccessGrantUsage(ctx, addr, minter).String(), "usage after withdraw")
+	err = k.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 1))
+	assert.ErrorContains(t, err, "amount 1 would exceed the total cap 100", "MintCoin after reaching the total cap")
+
+	// Grants without limits are not tracked.
+	require.NoError(t, k.MintCoin(ctx, manager, sdk.NewInt64Coin(denom, 500)), "MintCoin by manager")
+	assert.Equal(t, "0", k.GetAccessGrantUsage(ctx, addr, manager).String(), "usage of manager")
+
+	// The access query reports the usage of grants that have a total cap.
+	resp, err := k.Access(ctx, &types.QueryAccessRequest{Id: denom})
+	require.NoError(t, err, "Access query")
+	expUsage := []types.AccessGrantUsage{{Address: minter.String(), Used: sdkmath.NewInt(100)}}
+	assert.Equal(t, expUsage, resp.Usage, "Access query usage")
+
+	// Re-granting without limits keeps the existing limits.
+	require.NoError(t, k.AddAccess(ctx, manager, denom, types.NewAccessGrant(minter, []types.Access{types.Access_Burn})), "AddAccess burn")
+	m, err := k.GetMarker(ctx, addr)
+	require.NoError(t, err, "GetMarker")
+	mintGrant := m.GetAccessList()[len(m.GetAccessList())-1]
+	assert.ElementsMatch(t, types.AccessList{types.Access_Mint, types.Access_Withdraw, types.Access_Burn}, mintGrant.Permissions, "permissions after re-grant")
+	assert.Equal(t, &expiration, mintGrant.Expiration, "expiration after re-grant")
+	assert.Equal(t, &totalCap, mintGrant.TotalCap, "total cap after re-grant")
+	assert.Equal(t, &maxPerTx, mintGrant.MaxPerTx, "max per tx after re-grant")
+
+	// Once expired, the grant can't be used, even before it's pruned.
+	ctx = ctx.WithBlockTime(expiration)
+	err = k.BurnCoin(ctx, minter, sdk.NewInt64Coin(denom, 1))
+	assert.ErrorContains(t, err, "access grant for "+minter.String()+" on limitcoin marker expired", "BurnCoin after expiration")
+
+	// Pruning removes the grant and its usage.
+	k.PruneExpiredAccessGrants(ctx)
+	m, err = k.GetMarker(ctx, addr)
+	require.NoError(t, err, "GetMarker after prune")
+	assert.False(t, m.AddressHasAccess(minter, types.Access_Mint, ctx.BlockTime()), "minter has mint access after prune")
+	assert.True(t, m.AddressHasAccess(manager, types.Access_Mint, ctx.BlockTime()), "manager has mint access after prune")
+	assert.Equal(t, "0", k.GetAccessGrantUsage(ctx, addr, minter).String(), "usage after prune")
+}
+
+func TestExpiredAccessGrantWithoutLimits(t *testing.T) {
+	app := simapp.Setup(t)
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(blockTime)
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	k := app.MarkerKeeper
+	denom := "expirecoin"
+	manager := testUserAddress("manager")
+	deleter := testUserAddress("deleter")
+
+	mac := types.NewEmptyMarkerAccount(denom, manager.String(), []types.AccessGrant{
+		*types.NewAccessGrant(manager, []types.Access{types.Access_Mint, types.Access_Admin}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	require.NoError(t, k.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+
+	expiration := blockTime.Add(time.Hour)
+	grant := types.NewAccessGrant(deleter, []types.Access{types.Access_Delete})
+	grant.Expiration = &expiration
+	require.NoError(t, k.AddAccess(ctx, manager, denom, grant), "AddAccess delete")
+
+	// The delete grant has no limits, but its expiration still applies before it's pruned.
+	ctx = ctx.WithBlockTime(expiration)
+	err := k.CancelMarker(ctx, deleter, denom)
+	assert.ErrorContains(t, err, "access grant for "+deleter.String()+" on expirecoin marker expired", "CancelMarker after expiration")
+}
+
+func TestRemoveAccessClearsUsage(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	app.MarkerKeeper.SetParams(ctx, types.DefaultParams())
+	k := app.MarkerKeeper
+	denom := "usagecoin"
+	addr := types.MustGetMarkerAddress(denom)
+	manager := testUserAddress("manager")
+	minter := testUserAddress("minter")
+
+	mac := types.NewEmptyMarkerAccount(denom, manager.String(), []types.AccessGrant{
+		*types.NewAccessGrant(manager, []types.Access{types.Access_Mint, types.Access_Admin}),
+	})
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply")
+	require.NoError(t, k.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+
+	totalCap := sdkmath.NewInt(10)
+	grant := types.NewAccessGrant(minter, []types.Access{types.Access_Mint})
+	grant.TotalCap = &totalCap
+	require.NoError(t, k.AddAccess(ctx, manager, denom, grant), "AddAccess")
+	require.NoError(t, k.MintCoin(ctx, minter, sdk.NewInt64Coin(denom, 10)), "MintCoin")
+	assert.Equal(t, "10", k.GetAccessGrantUsage(ctx, addr, minter).String(), "usage after mint")
+
+	require.NoError(t, k.RemoveAccess(ctx, manager, denom, minter), "RemoveAccess")
+	assert.Equal(t, "0", k.GetAccessGrantUsage(ctx, addr, minter).String(), "usage after RemoveAccess")
+}
@@ -22,6 +22,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		if m, ok := acc[i].(types.MarkerAccountI); ok {
 			if err := m.Validate(); err == nil {
 				store.Set(types.MarkerStoreKey(m.GetAddress()), m.GetAddress())
+				setMarkerIndexes(store, m)
 			}
 		}
 	}
@@ -49,6 +50,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		denyAddress := sdk.MustAccAddressFromBech32(denyAddress.DenyAddress)
 		k.AddSendDeny(ctx, markerAddr, denyAddress)
 	}
+	for _, mLimits := range data.SendLimits {
+		markerAddr := sdk.MustAccAddressFromBech32(mLimits.Address)
+		if mLimits.DefaultLimit != nil {
+			if err := k.SetDefaultSendLimit(ctx, markerAddr, *mLimits.DefaultLimit); err != nil {
+				panic(err)
+			}
+		}
+		for _, limit := range mLimits.AddressLimits {
+			if err := k.SetAddressSendLimit(ctx, markerAddr, sdk.MustAccAddressFromBech32(limit.Address), limit.Limit); err != nil {
+				panic(err)
+			}
+		}
+	}
 	for _, mNavs := range data.NetAssetValues {
 		for _, nav := range mNavs.NetAssetValues {
 			navCopy := nav
@@ -60,6 +74,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			store.Set(types.NetAssetValueKey(address, navCopy.Price.Denom), bz)
 		}
 	}
+	for _, mHistory := range data.NetAssetValueHistory {
+		address := sdk.MustAccAddressFromBech32(mHistory.Address)
+		for _, entry := range mHistory.Entries {
+			if err := k.SetNetAssetValueHistoryEntry(ctx, address, entry); err != nil {
+				panic(err)
+			}
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -114,5 +136,46 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	var markerSendLimits []types.MarkerSendLimits
+	for i := range markers {
+		markerAddr := markers[i].GetAddress()
+		defaultLimit, err := k.GetDefaultSendLimit(ctx, markerAddr)
+		if err != nil {
+			panic(err)
+		}
+		addressLimits, err := k.GetAddressSendLimits(ctx, markerAddr)
+		if err != nil {
+			panic(err)
+		}
+		if defaultLimit != nil || len(addressLimits) > 0 {
+			markerSendLimits = append(markerSendLimits, types.MarkerSendLimits{
+				Address:       markerAddr.String(),
+				DefaultLimit:  defaultLimit,
+				AddressLimits: addressLimits,
+			})
+		}
+	}
+
+	var markerNavHistory []types.MarkerNetAssetValueHistory
+	for i := range markers {
+		var entries []types.NetAssetValueHistoryEntry
+		err := k.IterateNetAssetValueHistory(ctx, markers[i].GetAddress(), func(entry types.NetAssetValueHistoryEntry) (stop bool) {
+			entries = append(entries, entry)
+			return false
+		})
+		if err != nil {
+			panic(err)
+		}
+		if len(entries) > 0 {
+			markerNavHistory = append(markerNavHistory, types.MarkerNetAssetValueHistory{
+				Address: markers[i].GetAddress().String(),
+				Entries: entries,
+			})
+		}
+	}
+
+	rv := types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	rv.SendLimits = markerSendLimits
+	rv.NetAssetValueHistory = markerNavHistory
+	return rv
 }
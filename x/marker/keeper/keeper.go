@@ -163,6 +163,25 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	}
 	k.authKeeper.SetAccount(ctx, marker)
 	store.Set(types.MarkerStoreKey(marker.GetAddress()), marker.GetAddress())
+	setMarkerIndexes(store, marker)
+}
+
+// setMarkerIndexes adds or removes the provided marker from the fixed supply and destroyed marker
+// indexes so that they reflect the marker's current status and supply settings. It also indexes
+// any of the marker's access grants that have an expiration.
+func setMarkerIndexes(store storetypes.KVStore, marker types.MarkerAccountI) {
+	addr := marker.GetAddress()
+	if marker.GetStatus() == types.StatusActive && marker.HasFixedSupply() {
+		store.Set(types.FixedSupplyMarkerIndexKey(addr), addr)
+	} else {
+		store.Delete(types.FixedSupplyMarkerIndexKey(addr))
+	}
+	if marker.GetStatus() == types.StatusDestroyed {
+		store.Set(types.DestroyedMarkerIndexKey(addr), addr)
+	} else {
+		store.Delete(types.DestroyedMarkerIndexKey(addr))
+	}
+	setAccessGrantExpirationIndexes(store, marker)
 }
 
 // RemoveMarker removes a marker from the auth account store. Note: if the account holds coins this will
@@ -174,6 +193,10 @@ func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	k.RemoveNetAssetValues(ctx, marker.GetAddress())
 	k.ClearSendDeny(ctx, marker.GetAddress())
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
+	store.Delete(types.FixedSupplyMarkerIndexKey(marker.GetAddress()))
+	store.Delete(types.DestroyedMarkerIndexKey(marker.GetAddress()))
+	clearAccessGrantUsage(store, marker.GetAddress())
+	clearSendLimits(store, marker.GetAddress())
 }
 
 // IterateMarkers iterates all markers with the given handler function.
@@ -194,6 +217,42 @@ func (k Keeper) IterateMarkers(ctx sdk.Context, cb func(marker types.MarkerAccou
 	}
 }
 
+// IterateFixedSupplyMarkers iterates all active markers with a fixed supply with the given handler function.
+func (k Keeper) IterateFixedSupplyMarkers(ctx sdk.Context, cb func(marker types.MarkerAccountI) (stop bool)) {
+	k.iterateMarkerIndex(ctx, types.FixedSupplyMarkerIndexPrefix, cb)
+}
+
+// IterateDestroyedMarkers iterates all markers waiting to be destroyed with the given handler function.
+func (k Keeper) IterateDestroyedMarkers(ctx sdk.Context, cb func(marker types.MarkerAccountI) (stop bool)) {
+	k.iterateMarkerIndex(ctx, types.DestroyedMarkerIndexPrefix, cb)
+}
+
+// iterateMarkerIndex iterates the markers in the index with the given prefix. Each index entry's value is
+// the marker's address. Markers are looked up before any are provided to the handler function so that the
+// handler can safely update or remove the markers (and their index entries).
+func (k Keeper) iterateMarkerIndex(ctx sdk.Context, prefix []byte, cb func(marker types.MarkerAccountI) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	var markers []types.MarkerAccountI
+	for ; iterator.Valid(); iterator.Next() {
+		account := k.authKeeper.GetAccount(ctx, iterator.Value())
+		ma, ok := account.(types.MarkerAccountI)
+		if !ok {
+			iterator.Close()
+			panic(fmt.Errorf("invalid account type in marker account index"))
+		}
+		markers = append(markers, ma)
+	}
+	iterator.Close()
+
+	for _, ma := range markers {
+		if cb(ma) {
+			break
+		}
+	}
+}
+
 // GetEscrow returns the balances of all coins held in escrow in the marker
 func (k Keeper) GetEscrow(ctx sdk.Context, marker types.MarkerAccountI) sdk.Coins {
 	return k.bankKeeper.GetAllBalances(ctx, marker.GetAddress())
@@ -319,7 +378,7 @@ func (k Keeper) SetNetAssetValue(ctx sdk.Context, marker types.MarkerAccountI, n
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
 
-	return nil
+	return k.addNetAssetValueHistory(ctx, marker.GetAddress(), netAssetValue, source)
 }
 
 // SetNetAssetValueWithBlockHeight adds/updates a net asset value to marker with a specific block height
@@ -342,7 +401,7 @@ func (k Keeper) SetNetAssetValueWithBlockHeight(ctx sdk.Context, marker types.Ma
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
 
-	return nil
+	return k.addNetAssetValueHistory(ctx, marker.GetAddress(), netAssetValue, source)
 }
 
 // GetNetAssetValue gets the NetAssetValue for a marker denom with a specific price denom.
@@ -403,8 +462,10 @@ func (k Keeper) IterateAllNetAssetValues(ctx sdk.Context, handler func(sdk.AccAd
 	return nil
 }
 
-// RemoveNetAssetValues removes all net asset values for a marker
+// RemoveNetAssetValues removes all net asset values, and their history, for a marker
 func (k Keeper) RemoveNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	k.removeNetAssetValueHistory(ctx, markerAddr)
+
 	store := ctx.KVStore(k.storeKey)
 	it := storetypes.KVStorePrefixIterator(store, types.NetAssetValueKeyPrefix(markerAddr))
 	var keys [][]byte
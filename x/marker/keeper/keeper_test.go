@@ -93,7 +93,7 @@ func TestAccountMapperGetSet(t *testing.T) {
 	require.NotNil(t, acc)
 	mac, ok = acc.(types.MarkerAccountI)
 	require.True(t, ok)
-	require.True(t, mac.AddressHasAccess(user, types.Access_Admin))
+	require.True(t, mac.AddressHasAccess(user, types.Access_Admin, ctx.BlockTime()))
 
 	// add something to the send deny list just to verify removal
 	app.MarkerKeeper.AddSendDeny(ctx, addr, addr)
@@ -234,7 +234,7 @@ func TestManageAccess(t *testing.T) {
 	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "testcoin")
 	require.NoError(t, err)
 	require.NotNil(t, m)
-	require.False(t, m.AddressHasAccess(user2, types.Access_Burn))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Burn, ctx.BlockTime()))
 
 	// Grant access and check (succeeds on a Proposed marker without Admin grant)
 	require.NoError(t,
@@ -250,11 +250,11 @@ func TestManageAccess(t *testing.T) {
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
 	require.NotNil(t, m)
-	require.True(t, m.AddressHasAccess(user2, types.Access_Mint))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Burn))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Admin))
-	require.True(t, m.AddressHasAccess(user2, types.Access_Delete))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw))
+	require.True(t, m.AddressHasAccess(user2, types.Access_Mint, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Burn, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Admin, ctx.BlockTime()))
+	require.True(t, m.AddressHasAccess(user2, types.Access_Delete, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw, ctx.BlockTime()))
 
 	// Remove access and check
 	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user1, "testcoin", user2))
@@ -262,11 +262,11 @@ func TestManageAccess(t *testing.T) {
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
 	require.NotNil(t, m)
-	require.False(t, m.AddressHasAccess(user2, types.Access_Mint))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Burn))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Admin))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Delete))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Mint, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Burn, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Admin, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Delete, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw, ctx.BlockTime()))
 
 	// Finalize marker and check permission enforcement.
 	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user1, m.GetDenom()))
@@ -303,10 +303,10 @@ func TestManageAccess(t *testing.T) {
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
 
-	require.True(t, m.AddressHasAccess(admin, types.Access_Admin))
-	require.True(t, m.AddressHasAccess(user1, types.Access_Burn))
-	require.True(t, m.AddressHasAccess(user2, types.Access_Mint))
-	require.True(t, m.AddressHasAccess(user2, types.Access_Delete))
+	require.True(t, m.AddressHasAccess(admin, types.Access_Admin, ctx.BlockTime()))
+	require.True(t, m.AddressHasAccess(user1, types.Access_Burn, ctx.BlockTime()))
+	require.True(t, m.AddressHasAccess(user2, types.Access_Mint, ctx.BlockTime()))
+	require.True(t, m.AddressHasAccess(user2, types.Access_Delete, ctx.BlockTime()))
 
 	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Delete)))
 	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Burn)))
@@ -339,8 +339,8 @@ func TestCancelProposedByManager(t *testing.T) {
 	m, err := app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
 	// user1 and user2 will not have been assigned delete
-	require.False(t, m.AddressHasAccess(user1, types.Access_Delete))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Delete))
+	require.False(t, m.AddressHasAccess(user1, types.Access_Delete, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Delete, ctx.BlockTime()))
 
 	// Delete marker (fails, marker is not cancelled)
 	require.Error(t, app.MarkerKeeper.DeleteMarker(ctx, user1, "testcoin"), "can only delete markeraccounts in the Cancelled status")
@@ -93,7 +93,7 @@ func (k Keeper) AddAccess(
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
 		if !(caller.Equals(m.GetManager()) && m.GetStatus() == types.StatusFinalized) &&
-			!m.AddressHasAccess(caller, types.Access_Admin) &&
+			!m.AddressHasAccess(caller, types.Access_Admin, ctx.BlockTime()) &&
 			!k.accountControlsAllSupply(ctx, caller, m) {
 			return fmt.Errorf("%s is not authorized to make access list changes against finalized/active %s marker",
 				caller, m.GetDenom())
@@ -105,6 +105,9 @@ func (k Keeper) AddAccess(
 		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
 			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr)
 		}
+		if grant.IsExpired(ctx.BlockTime()) {
+			return fmt.Errorf("access grant failed: expiration must be after the current block time")
+		}
 		if err = m.GrantAccess(grant); err != nil {
 			return fmt.Errorf("access grant failed: %w", err)
 		}
@@ -135,7 +138,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
 		if !(caller.Equals(m.GetManager()) && m.GetStatus() == types.StatusFinalized) &&
-			!m.AddressHasAccess(caller, types.Access_Admin) &&
+			!m.AddressHasAccess(caller, types.Access_Admin, ctx.BlockTime()) &&
 			!k.accountControlsAllSupply(ctx, caller, m) {
 			return fmt.Errorf("%s is not authorized to make access list changes against finalized/active %s marker",
 				caller, m.GetDenom())
@@ -154,6 +157,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 			return err
 		}
 		k.SetMarker(ctx, m)
+		deleteAccessGrantUsage(ctx.KVStore(k.storeKey), m.GetAddress(), remove)
 	// Undefined, Cancelled, Destroyed -- no modifications are supported in these states
 	default:
 		return fmt.Errorf("marker in %s state can not be modified", m.GetStatus())
@@ -176,7 +180,10 @@ func (k Keeper) WithdrawCoins(
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %w", denom, err)
 	}
-	if err = m.ValidateAddressHasAccess(caller, types.Access_Withdraw); err != nil {
+	if err = m.ValidateAddressHasAccess(caller, types.Access_Withdraw, ctx.BlockTime()); err != nil {
+		return err
+	}
+	if err = k.consumeAccessGrantLimits(ctx, m, caller, coins.AmountOf(m.GetDenom())); err != nil {
 		return err
 	}
 
@@ -219,7 +226,10 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %w", coin.Denom, err)
 	}
-	if err = m.ValidateAddressHasAccess(caller, types.Access_Mint); err != nil {
+	if err = m.ValidateAddressHasAccess(caller, types.Access_Mint, ctx.BlockTime()); err != nil {
+		return err
+	}
+	if err = k.consumeAccessGrantLimits(ctx, m, caller, coin.Amount); err != nil {
 		return err
 	}
 
@@ -259,7 +269,10 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %w", coin.Denom, err)
 	}
-	if err = m.ValidateAddressHasAccess(caller, types.Access_Burn); err != nil {
+	if err = m.ValidateAddressHasAccess(caller, types.Access_Burn, ctx.BlockTime()); err != nil {
+		return err
+	}
+	if err = k.consumeAccessGrantLimits(ctx, m, caller, coin.Amount); err != nil {
 		return err
 	}
 
@@ -526,7 +539,7 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	switch m.GetStatus() {
 	case types.StatusFinalized, types.StatusActive:
 		// for active or finalized markers the caller must be assigned permission to perform this action.
-		if err = m.ValidateAddressHasAccess(caller, types.Access_Delete); err != nil {
+		if err = m.ValidateAddressHasAccess(caller, types.Access_Delete, ctx.BlockTime()); err != nil {
 			return err
 		}
 		// for finalized/active we need to ensure the full coin supply has been recalled as it will all be burned.
@@ -540,7 +553,7 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		}
 	case types.StatusProposed:
 		// for a proposed marker either the manager or someone assigned `delete` can perform this action
-		if err = m.ValidateAddressHasAccess(caller, types.Access_Delete); err != nil && !m.GetManager().Equals(caller) {
+		if err = m.ValidateAddressHasAccess(caller, types.Access_Delete, ctx.BlockTime()); err != nil && !m.GetManager().Equals(caller) {
 			return err
 		}
 	case types.StatusCancelled:
@@ -572,7 +585,7 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	}
 
 	// either the manager [set if a proposed marker was cancelled] or someone assigned `delete` can perform this action
-	if err = m.ValidateAddressHasAccess(caller, types.Access_Delete); err != nil && !m.GetManager().Equals(caller) {
+	if err = m.ValidateAddressHasAccess(caller, types.Access_Delete, ctx.BlockTime()); err != nil && !m.GetManager().Equals(caller) {
 		return err
 	}
 
@@ -637,8 +650,11 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
 	}
 
-	adminCanForceTransfer := m.AddressHasAccess(admin, types.Access_ForceTransfer)
-	if err = m.ValidateAddressHasAccess(admin, types.Access_Transfer); err != nil && !adminCanForceTransfer {
+	adminCanForceTransfer := m.AddressHasAccess(admin, types.Access_ForceTransfer, ctx.BlockTime())
+	if err = m.ValidateAddressHasAccess(admin, types.Access_Transfer, ctx.BlockTime()); err != nil && !adminCanForceTransfer {
+		return err
+	}
+	if err = k.consumeAccessGrantLimits(ctx, m, admin, amount.Amount); err != nil {
 		return err
 	}
 
@@ -742,7 +758,10 @@ func (k Keeper) IbcTransferCoin(
 	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
 		return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
 	}
-	if err = m.ValidateAddressHasAccess(admin, types.Access_Transfer); err != nil {
+	if err = m.ValidateAddressHasAccess(admin, types.Access_Transfer, ctx.BlockTime()); err != nil {
+		return err
+	}
+	if err = k.consumeAccessGrantLimits(ctx, m, admin, token.Amount); err != nil {
 		return err
 	}
 	to, err := sdk.AccAddressFromBech32(receiver)
@@ -758,7 +777,7 @@ func (k Keeper) IbcTransferCoin(
 
 	// checking if escrow account has transfer auth, if not add it
 	escrowAccount := ibctypes.GetEscrowAddress(sourcePort, sourceChannel)
-	if !m.AddressHasAccess(escrowAccount, types.Access_Transfer) {
+	if !m.AddressHasAccess(escrowAccount, types.Access_Transfer, ctx.BlockTime()) {
 		err = m.GrantAccess(types.NewAccessGrant(escrowAccount, []types.Access{types.Access_Transfer}))
 		if err != nil {
 			return err
@@ -818,7 +837,7 @@ func (k Keeper) SetMarkerDenomMetadata(ctx sdk.Context, metadata banktypes.Metad
 	if markerErr != nil {
 		return fmt.Errorf("marker not found for %s: %w", metadata.Base, markerErr)
 	}
-	if err := marker.ValidateAddressHasAccess(caller, types.Access_Admin); err != nil && !marker.GetManager().Equals(caller) {
+	if err := marker.ValidateAddressHasAccess(caller, types.Access_Admin, ctx.BlockTime()); err != nil && !marker.GetManager().Equals(caller) {
 		return err
 	}
 
@@ -883,5 +902,5 @@ func (k Keeper) validateSendToMarker(ctx sdk.Context, toAddr, admin sdk.AccAddre
 	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
 		return nil
 	}
-	return marker.ValidateAddressHasAccess(admin, types.Access_Deposit)
+	return marker.ValidateAddressHasAccess(admin, types.Access_Deposit, ctx.BlockTime())
 }
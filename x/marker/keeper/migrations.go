@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +15,24 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2To3 will update the marker store from version 2 to version 3.
+// It builds the fixed supply and destroyed marker indexes used by the begin blocker.
+func (m Migrator) Migrate2To3(ctx sdk.Context) error {
+	logger := m.keeper.Logger(ctx)
+	logger.Info("Starting migration of x/marker from 2 to 3.")
+	store := ctx.KVStore(m.keeper.storeKey)
+	var fixed, destroyed int
+	m.keeper.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		setMarkerIndexes(store, marker)
+		if marker.GetStatus() == types.StatusActive && marker.HasFixedSupply() {
+			fixed++
+		}
+		if marker.GetStatus() == types.StatusDestroyed {
+			destroyed++
+		}
+		return false
+	})
+	logger.Info("Done migrating x/marker from 2 to 3.", "fixed supply markers", fixed, "destroyed markers", destroyed)
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// newIndexTestMarker creates a new marker account for testing the marker indexes.
+func newIndexTestMarker(denom string, status types.MarkerStatus, fixed bool) *types.MarkerAccount {
+	return &types.MarkerAccount{
+		BaseAccount: &authtypes.BaseAccount{Address: types.MustGetMarkerAddress(denom).String()},
+		Manager:     sdk.AccAddress("index_test_manager__").String(),
+		Status:      status,
+		SupplyFixed: fixed,
+		Denom:       denom,
+		Supply:      sdkmath.NewInt(100),
+		MarkerType:  types.MarkerType_Coin,
+	}
+}
+
+// getIndexedDenoms gets the denoms of the markers in the fixed supply and destroyed marker indexes.
+func getIndexedDenoms(ctx sdk.Context, k markerkeeper.Keeper) (fixed []string, destroyed []string) {
+	k.IterateFixedSupplyMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		fixed = append(fixed, marker.GetDenom())
+		return false
+	})
+	k.IterateDestroyedMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		destroyed = append(destroyed, marker.GetDenom())
+		return false
+	})
+	return fixed, destroyed
+}
+
+func TestMarkerIndexes(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	k := app.MarkerKeeper
+
+	fixedActive := newIndexTestMarker("fixedactive", types.StatusActive, true)
+	floatActive := newIndexTestMarker("floatactive", types.StatusActive, false)
+	fixedProposed := newIndexTestMarker("fixedproposed", types.StatusProposed, true)
+	destroyed := newIndexTestMarker("destroyed", types.StatusDestroyed, true)
+	for _, marker := range []*types.MarkerAccount{fixedActive, floatActive, fixedProposed, destroyed} {
+		k.SetNewMarker(ctx, marker)
+	}
+
+	fixed, dest := getIndexedDenoms(ctx, k)
+	assert.ElementsMatch(t, []string{"fixedactive"}, fixed, "fixed supply markers after creation")
+	assert.ElementsMatch(t, []string{"destroyed"}, dest, "destroyed markers after creation")
+
+	// Activate the proposed one and cancel the active one.
+	proposed, err := k.GetMarkerByDenom(ctx, "fixedproposed")
+	require.NoError(t, err, "GetMarkerByDenom(fixedproposed)")
+	require.NoError(t, proposed.SetStatus(types.StatusActive), "SetStatus(active)")
+	k.SetMarker(ctx, proposed)
+	active, err := k.GetMarkerByDenom(ctx, "fixedactive")
+	require.NoError(t, err, "GetMarkerByDenom(fixedactive)")
+	require.NoError(t, active.SetStatus(types.StatusDestroyed), "SetStatus(destroyed)")
+	k.SetMarker(ctx, active)
+
+	fixed, dest = getIndexedDenoms(ctx, k)
+	assert.ElementsMatch(t, []string{"fixedproposed"}, fixed, "fixed supply markers after status changes")
+	assert.ElementsMatch(t, []string{"destroyed", "fixedactive"}, dest, "destroyed markers after status changes")
+
+	// Removing a marker should remove it from the indexes.
+	k.RemoveMarker(ctx, active)
+	fixed, dest = getIndexedDenoms(ctx, k)
+	assert.ElementsMatch(t, []string{"fixedproposed"}, fixed, "fixed supply markers after removal")
+	assert.ElementsMatch(t, []string{"destroyed"}, dest, "destroyed markers after removal")
+}
+
+func TestMigrate2To3(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	k := app.MarkerKeeper
+
+	for _, marker := range []*types.MarkerAccount{
+		newIndexTestMarker("fixedactive", types.StatusActive, true),
+		newIndexTestMarker("floatactive", types.StatusActive, false),
+		newIndexTestMarker("fixedfinal", types.StatusFinalized, true),
+		newIndexTestMarker("destroyed", types.StatusDestroyed, false),
+	} {
+		k.SetNewMarker(ctx, marker)
+	}
+
+	// Delete all the index entries so it looks like they were created before the indexes existed.
+	store := k.GetStore(ctx)
+	for _, prefix := range [][]byte{types.FixedSupplyMarkerIndexPrefix, types.DestroyedMarkerIndexPrefix} {
+		var keys [][]byte
+		iter := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		require.NoError(t, iter.Close(), "iter.Close()")
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	fixed, dest := getIndexedDenoms(ctx, k)
+	require.Empty(t, fixed, "fixed supply markers before migration")
+	require.Empty(t, dest, "destroyed markers before migration")
+
+	migrator := markerkeeper.NewMigrator(k)
+	require.NoError(t, migrator.Migrate2To3(ctx), "Migrate2To3")
+
+	fixed, dest = getIndexedDenoms(ctx, k)
+	assert.ElementsMatch(t, []string{"fixedactive"}, fixed, "fixed supply markers after migration")
+	assert.ElementsMatch(t, []string{"destroyed"}, dest, "destroyed markers after migration")
+}
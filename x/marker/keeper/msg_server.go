@@ -45,7 +45,7 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *types.MsgGrantAllo
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
-	if err = m.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
+	if err = m.ValidateAddressHasAccess(admin, types.Access_Admin, ctx.BlockTime()); err != nil {
 		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
 	}
 	allowance, err := msg.GetFeeAllowanceI()
@@ -594,7 +594,7 @@ func (k msgServer) UpdateRequiredAttributes(goCtx context.Context, msg *types.Ms
 		if !m.HasGovernanceEnabled() {
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
-	case !m.AddressHasAccess(caller, types.Access_Transfer):
+	case !m.AddressHasAccess(caller, types.Access_Transfer, ctx.BlockTime()):
 		return nil, fmt.Errorf("caller does not have authority to update required attributes %s", msg.TransferAuthority)
 	}
 
@@ -666,7 +666,11 @@ func (k msgServer) SetAccountData(goCtx context.Context, msg *types.MsgSetAccoun
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
 	} else {
-		if err = marker.ValidateHasAccess(msg.Signer, types.Access_Deposit); err != nil {
+		signer, err := sdk.AccAddressFromBech32(msg.Signer)
+		if err != nil {
+			return nil, err
+		}
+		if err = marker.ValidateAddressHasAccess(signer, types.Access_Deposit, ctx.BlockTime()); err != nil {
 			return nil, err
 		}
 	}
@@ -696,8 +700,14 @@ func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdat
 		if !marker.HasGovernanceEnabled() {
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
-	} else if err = marker.ValidateHasAccess(msg.Authority, types.Access_Transfer); err != nil {
-		return nil, err
+	} else {
+		authority, err := sdk.AccAddressFromBech32(msg.Authority)
+		if err != nil {
+			return nil, err
+		}
+		if err = marker.ValidateAddressHasAccess(authority, types.Access_Transfer, ctx.BlockTime()); err != nil {
+			return nil, err
+		}
 	}
 
 	markerAddr := marker.GetAddress()
@@ -842,9 +852,71 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	}
 
 	k.SetParams(ctx, msg.Params)
-	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply)); err != nil {
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply, msg.Params.MaxNavHistoryEntries)); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateSendLimits sets or removes the rolling-window send limits of a restricted marker
+func (k msgServer) UpdateSendLimits(goCtx context.Context, msg *types.MsgUpdateSendLimitsRequest) (*types.MsgUpdateSendLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker %s is not a restricted marker", msg.Denom)
+	}
+
+	if msg.Authority == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		authority, err := sdk.AccAddressFromBech32(msg.Authority)
+		if err != nil {
+			return nil, err
+		}
+		if err = marker.ValidateAddressHasAccess(authority, types.Access_Transfer, ctx.BlockTime()); err != nil {
+			return nil, err
+		}
+	}
+
+	markerAddr := marker.GetAddress()
+	if msg.RemoveDefaultLimit {
+		k.RemoveDefaultSendLimit(ctx, markerAddr)
+	}
+	if msg.DefaultLimit != nil {
+		if err = k.SetDefaultSendLimit(ctx, markerAddr, *msg.DefaultLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, addrStr := range msg.RemoveAddressLimits {
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		k.RemoveAddressSendLimit(ctx, markerAddr, addr)
+	}
+
+	for _, limit := range msg.SetAddressLimits {
+		addr, err := sdk.AccAddressFromBech32(limit.Address)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.SetAddressSendLimit(ctx, markerAddr, addr, limit.Limit); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSendLimitsUpdated(msg.Denom, msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateSendLimitsResponse{}, nil
+}
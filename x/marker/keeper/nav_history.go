@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetMaxNavHistoryEntries returns the number of net asset value history entries to retain for each marker and price denom.
+func (k Keeper) GetMaxNavHistoryEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxNavHistoryEntries
+}
+
+// GetNetAssetValueHistory returns the retained net asset value history of a marker in a price denom, oldest first.
+func (k Keeper) GetNetAssetValueHistory(ctx sdk.Context, markerAddr sdk.AccAddress, priceDenom string) ([]types.NetAssetValueHistoryEntry, error) {
+	var rv []types.NetAssetValueHistoryEntry
+	err := k.iterateNetAssetValueHistory(ctx, types.NetAssetValueHistoryKeyPrefix(markerAddr, priceDenom), func(entry types.NetAssetValueHistoryEntry) (stop bool) {
+		rv = append(rv, entry)
+		return false
+	})
+	return rv, err
+}
+
+// IterateNetAssetValueHistory processes the retained net asset value history of a marker, in all price denoms, with the given handler.
+func (k Keeper) IterateNetAssetValueHistory(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(types.NetAssetValueHistoryEntry) (stop bool)) error {
+	return k.iterateNetAssetValueHistory(ctx, types.NetAssetValueHistoryMarkerPrefix(markerAddr), handler)
+}
+
+// iterateNetAssetValueHistory processes the net asset value history entries under the provided key prefix with the given handler.
+func (k Keeper) iterateNetAssetValueHistory(ctx sdk.Context, keyPrefix []byte, handler func(types.NetAssetValueHistoryEntry) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.NetAssetValueHistoryEntry
+		if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			return err
+		}
+		if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetNetAssetValueAtHeight returns the most recent net asset value of a marker in a price denom that was set
+// at or before the provided height. If there isn't one, nil, nil is returned.
+func (k Keeper) GetNetAssetValueAtHeight(ctx sdk.Context, markerAddr sdk.AccAddress, priceDenom string, height uint64) (*types.NetAssetValueHistoryEntry, error) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.NetAssetValueHistoryKeyPrefix(markerAddr, priceDenom)
+	end := types.NetAssetValueHistoryKey(markerAddr, priceDenom, height+1)
+	it := store.ReverseIterator(start, end)
+	defer it.Close()
+	if !it.Valid() {
+		return nil, nil
+	}
+	var entry types.NetAssetValueHistoryEntry
+	if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// SetNetAssetValueHistoryEntry stores a net asset value history entry for a marker.
+// Use addNetAssetValueHistory when a net asset value is being set.
+func (k Keeper) SetNetAssetValueHistoryEntry(ctx sdk.Context, markerAddr sdk.AccAddress, entry types.NetAssetValueHistoryEntry) error {
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	key := types.NetAssetValueHistoryKey(markerAddr, entry.NetAssetValue.Price.Denom, entry.NetAssetValue.UpdatedBlockHeight)
+	ctx.KVStore(k.storeKey).Set(key, bz)
+	return nil
+}
+
+// addNetAssetValueHistory records the provided net asset value in the marker's history.
+// Older entries beyond the max nav history entries param are removed.
+func (k Keeper) addNetAssetValueHistory(ctx sdk.Context, markerAddr sdk.AccAddress, nav types.NetAssetValue, source string) error {
+	maxEntries := k.GetMaxNavHistoryEntries(ctx)
+	if maxEntries > 0 {
+		if err := k.SetNetAssetValueHistoryEntry(ctx, markerAddr, types.NetAssetValueHistoryEntry{NetAssetValue: nav, Source: source, Time: ctx.BlockTime()}); err != nil {
+			return err
+		}
+	}
+	k.pruneNetAssetValueHistory(ctx, types.NetAssetValueHistoryKeyPrefix(markerAddr, nav.Price.Denom), maxEntries)
+	return nil
+}
+
+// pruneNetAssetValueHistory deletes the oldest entries under the provided key prefix so that at most maxEntries remain.
+func (k Keeper) pruneNetAssetValueHistory(ctx sdk.Context, keyPrefix []byte, maxEntries uint32) {
+	store := ctx.KVStore(k.storeKey)
+	var toDelete [][]byte
+	it := storetypes.KVStoreReversePrefixIterator(store, keyPrefix)
+	count := uint32(0)
+	for ; it.Valid(); it.Next() {
+		count++
+		if count > maxEntries {
+			toDelete = append(toDelete, it.Key())
+		}
+	}
+	it.Close()
+
+	for _, key := range toDelete {
+		store.Delete(key)
+	}
+}
+
+// removeNetAssetValueHistory deletes all net asset value history of a marker.
+func (k Keeper) removeNetAssetValueHistory(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	k.pruneNetAssetValueHistory(ctx, types.NetAssetValueHistoryMarkerPrefix(markerAddr), 0)
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestNetAssetValueHistory(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	k := app.MarkerKeeper
+
+	owner := sdk.AccAddress("owner_address_______")
+	denom := "navhistorycoin"
+	marker := &types.MarkerAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		Manager:     owner.String(),
+		Status:      types.StatusProposed,
+		Denom:       denom,
+		Supply:      sdkmath.NewInt(10000),
+		MarkerType:  types.MarkerType_Coin,
+		AccessControl: []types.AccessGrant{
+			{Address: owner.String(), Permissions: types.AccessList{types.Access_Admin}},
+		},
+	}
+	require.NoError(t, k.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+
+	params := k.GetParams(ctx)
+	params.MaxNavHistoryEntries = 3
+	k.SetParams(ctx, params)
+
+	// Each block is 5 seconds after the previous one.
+	blockTime := func(height int64) time.Time {
+		return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(height) * 5 * time.Second)
+	}
+	timeP := func(t time.Time) *time.Time {
+		return &t
+	}
+	setNav := func(height int64, amount int64, volume uint64, source string) {
+		err := k.SetNetAssetValue(ctx.WithBlockHeight(height).WithBlockTime(blockTime(height)), marker, types.NewNetAssetValue(sdk.NewInt64Coin("usd", amount), volume), source)
+		require.NoError(t, err, "SetNetAssetValue at height %d", height)
+	}
+	entry := func(height uint64, amount int64, volume uint64, source string) types.NetAssetValueHistoryEntry {
+		return types.NetAssetValueHistoryEntry{
+			NetAssetValue: types.NetAssetValue{Price: sdk.NewInt64Coin("usd", amount), Volume: volume, UpdatedBlockHeight: height},
+			Source:        source,
+			Time:          blockTime(int64(height)),
+		}
+	}
+
+	setNav(10, 100, 10, owner.String())
+	setNav(20, 400, 20, "exchange market 1")
+	setNav(30, 30, 1, owner.String())
+	setNav(25, 50, 1, "other")
+
+	history, err := k.GetNetAssetValueHistory(ctx, marker.GetAddress(), "usd")
+	require.NoError(t, err, "GetNetAssetValueHistory")
+	assert.Equal(t, []types.NetAssetValueHistoryEntry{
+		entry(20, 400, 20, "exchange market 1"),
+		entry(25, 50, 1, "other"),
+		entry(30, 30, 1, owner.String()),
+	}, history, "history after pruning to 3 entries")
+
+	// A history is kept for each price denom.
+	require.NoError(t, k.SetNetAssetValue(ctx.WithBlockHeight(15), marker, types.NewNetAssetValue(sdk.NewInt64Coin("usdc", 2), 1), "usdc"), "SetNetAssetValue usdc")
+	usdcHistory, err := k.GetNetAssetValueHistory(ctx, marker.GetAddress(), "usdc")
+	require.NoError(t, err, "GetNetAssetValueHistory usdc")
+	assert.Len(t, usdcHistory, 1, "usdc history")
+
+	t.Run("at height", func(t *testing.T) {
+		qctx := ctx.WithBlockHeight(40)
+		tests := []struct {
+			name     string
+			req      *types.QueryNetAssetValueAtHeightRequest
+			expEntry types.NetAssetValueHistoryEntry
+			expErr   string
+		}{
+			{
+				name:   "no price denom",
+				req:    &types.QueryNetAssetValueAtHeightRequest{Id: denom},
+				expErr: "price denom cannot be empty",
+			},
+			{
+				name:   "negative height",
+				req:    &types.QueryNetAssetValueAtHeightRequest{Id: denom, PriceDenom: "usd", Height: -1},
+				expErr: "height cannot be negative: -1",
+			},
+			{
+				name:   "unknown marker",
+				req:    &types.QueryNetAssetValueAtHeightRequest{Id: "unknowncoin", PriceDenom: "usd", Height: 22},
+				expErr: "invalid denom or address",
+			},
+			{
+				name:   "before retained history",
+				req:    &types.QueryNetAssetValueAtHeightRequest{Id: denom, PriceDenom: "usd", Height: 15},
+				expErr: "no usd net asset value history for navhistorycoin at height 15",
+			},
+			{
+				name:     "exact height",
+				req:      &types.QueryNetAssetValueAtHeightRequest{Id: denom, PriceDenom: "usd", Height: 25},
+				expEntry: entry(25, 50, 1, "other"),
+			},
+			{
+				name:     "between entries",
+				req:      &types.QueryNetAssetValueAtHeightRequest{Id: denom, PriceDenom: "usd", Height: 24},
+				expEntry: entry(20, 400, 20, "exchange market 1"),
+			},
+			{
+				name:     "current height",
+				req:      &types.QueryNetAssetValueAtHeightRequest{Id: marker.GetAddress().String(), PriceDenom: "usd"},
+				expEntry: entry(30, 30, 1, owner.String()),
+			},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				resp, err := k.NetAssetValueAtHeight(qctx, tc.req)
+				if len(tc.expErr) > 0 {
+					require.ErrorContains(t, err, tc.expErr, "NetAssetValueAtHeight")
+					return
+				}
+				require.NoError(t, err, "NetAssetValueAtHeight")
+				assert.Equal(t, tc.expEntry, resp.Entry, "NetAssetValueAtHeight entry")
+			})
+		}
+	})
+
+	t.Run("twap", func(t *testing.T) {
+		qctx := ctx.WithBlockHeight(40).WithBlockTime(blockTime(40))
+		tests := []struct {
+			name   string
+			req    *types.QueryNetAssetValueTWAPRequest
+			expRes *types.QueryNetAssetValueTWAPResponse
+			expErr string
+		}{
+			{
+				name:   "no price denom",
+				req:    &types.QueryNetAssetValueTWAPRequest{Id: denom, StartTime: blockTime(1)},
+				expErr: "price denom cannot be empty",
+			},
+			{
+				name:   "end not after start",
+				req:    &types.QueryNetAssetValueTWAPRequest{Id: denom, PriceDenom: "usd", StartTime: blockTime(30), EndTime: timeP(blockTime(30))},
+				expErr: "end time 2024-01-01T00:02:30Z must be after start time 2024-01-01T00:02:30Z",
+			},
+			{
+				name:   "start after current time",
+				req:    &types.QueryNetAssetValueTWAPRequest{Id: denom, PriceDenom: "usd", StartTime: blockTime(50)},
+				expErr: "end time 2024-01-01T00:03:20Z must be after start time 2024-01-01T00:04:10Z",
+			},
+			{
+				name:   "no history in range",
+				req:    &types.QueryNetAssetValueTWAPRequest{Id: denom, PriceDenom: "usd", StartTime: blockTime(1), EndTime: timeP(blockTime(20))},
+				expErr: "no net asset value was in effect from 2024-01-01T00:00:05Z to 2024-01-01T00:01:40Z",
+			},
+			{
+				name: "range covering all entries",
+				req:  &types.QueryNetAssetValueTWAPRequest{Id: denom, PriceDenom: "usd", StartTime: blockTime(1), EndTime: timeP(blockTime(35))},
+				expRes: &types.QueryNetAssetValueTWAPResponse{
+					// 20 for 25 seconds, 50 for 25 seconds, 30 for 25 seconds.
+					AveragePricePerUnit: "33.333333333333333333",
+					PriceDenom:          "usd",
+					StartTime:           blockTime(20),
+					EndTime:             blockTime(35),
+				},
+			},
+			{
+				name: "up to current time",
+				req:  &types.QueryNetAssetValueTWAPRequest{Id: denom, PriceDenom: "usd", StartTime: blockTime(25)},
+				expRes: &types.QueryNetAssetValueTWAPResponse{
+					// 50 for 25 seconds, 30 for 50 seconds.
+					AveragePricePerUnit: "36.666666666666666666",
+					PriceDenom:          "usd",
+					StartTime:           blockTime(25),
+					EndTime:             blockTime(40),
+				},
+			},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				resp, err := k.NetAssetValueTWAP(qctx, tc.req)
+				if len(tc.expErr) > 0 {
+					require.ErrorContains(t, err, tc.expErr, "NetAssetValueTWAP")
+					return
+				}
+				require.NoError(t, err, "NetAssetValueTWAP")
+				assert.Equal(t, tc.expRes, resp, "NetAssetValueTWAP response")
+			})
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		params.MaxNavHistoryEntries = 0
+		k.SetParams(ctx, params)
+		setNav(35, 35, 1, owner.String())
+		history, err := k.GetNetAssetValueHistory(ctx, marker.GetAddress(), "usd")
+		require.NoError(t, err, "GetNetAssetValueHistory")
+		assert.Empty(t, history, "history after disabling")
+	})
+
+	t.Run("removed with marker navs", func(t *testing.T) {
+		k.RemoveNetAssetValues(ctx, marker.GetAddress())
+		count := 0
+		require.NoError(t, k.IterateNetAssetValueHistory(ctx, marker.GetAddress(), func(types.NetAssetValueHistoryEntry) bool {
+			count++
+			return false
+		}), "IterateNetAssetValueHistory")
+		assert.Zero(t, count, "history entries after RemoveNetAssetValues")
+	})
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -139,7 +141,16 @@ func (k Keeper) Access(c context.Context, req *types.QueryAccessRequest) (*types
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryAccessResponse{Accounts: marker.GetAccessList()}, nil
+	resp := &types.QueryAccessResponse{Accounts: marker.GetAccessList()}
+	for _, grant := range resp.Accounts {
+		if grant.TotalCap != nil {
+			resp.Usage = append(resp.Usage, types.AccessGrantUsage{
+				Address: grant.Address,
+				Used:    k.GetAccessGrantUsage(ctx, marker.GetAddress(), grant.GetAddress()),
+			})
+		}
+	}
+	return resp, nil
 }
 
 // DenomMetadata query for metadata on denom
@@ -203,6 +214,130 @@ func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValues
 	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs}, nil
 }
 
+// NetAssetValueAtHeight query for the net asset value of a marker that was in effect at a block height
+func (k Keeper) NetAssetValueAtHeight(c context.Context, req *types.QueryNetAssetValueAtHeightRequest) (*types.QueryNetAssetValueAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.PriceDenom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "price denom cannot be empty")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height cannot be negative: %d", req.Height)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+	entry, err := k.GetNetAssetValueAtHeight(ctx, marker.GetAddress(), req.PriceDenom, uint64(height))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get net asset value history: %v", err)
+	}
+	if entry == nil {
+		return nil, status.Errorf(codes.NotFound, "no %s net asset value history for %s at height %d", req.PriceDenom, marker.GetDenom(), height)
+	}
+	return &types.QueryNetAssetValueAtHeightResponse{Entry: *entry}, nil
+}
+
+// NetAssetValueTWAP query for the time-weighted average price per unit of a marker over a window of time
+func (k Keeper) NetAssetValueTWAP(c context.Context, req *types.QueryNetAssetValueTWAPRequest) (*types.QueryNetAssetValueTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.PriceDenom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "price denom cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime := ctx.BlockTime()
+	if req.EndTime != nil {
+		endTime = *req.EndTime
+	}
+	if !endTime.After(req.StartTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "end time %s must be after start time %s",
+			endTime.UTC().Format(time.RFC3339), req.StartTime.UTC().Format(time.RFC3339))
+	}
+	entries, err := k.GetNetAssetValueHistory(ctx, marker.GetAddress(), req.PriceDenom)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get net asset value history: %v", err)
+	}
+	avg, startTime, err := types.NetAssetValueTWAP(entries, req.StartTime, endTime)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "could not calculate %s average for %s: %v", req.PriceDenom, marker.GetDenom(), err)
+	}
+	return &types.QueryNetAssetValueTWAPResponse{
+		AveragePricePerUnit: avg.String(),
+		PriceDenom:          req.PriceDenom,
+		StartTime:           startTime,
+		EndTime:             endTime,
+	}, nil
+}
+
+// SendLimits query for the default and account-specific send limits of a marker
+func (k Keeper) SendLimits(c context.Context, req *types.QuerySendLimitsRequest) (*types.QuerySendLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QuerySendLimitsResponse{}
+	if resp.DefaultLimit, err = k.GetDefaultSendLimit(ctx, marker.GetAddress()); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get default send limit: %v", err)
+	}
+	if resp.AddressLimits, err = k.GetAddressSendLimits(ctx, marker.GetAddress()); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get address send limits: %v", err)
+	}
+	return resp, nil
+}
+
+// SendLimitUsage query for the send limit that applies to an account and how much of it has been used
+func (k Keeper) SendLimitUsage(c context.Context, req *types.QuerySendLimitUsageRequest) (*types.QuerySendLimitUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, used, err := k.GetSendLimitUsed(ctx, marker.GetAddress(), addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get send limit usage: %v", err)
+	}
+	resp := &types.QuerySendLimitUsageResponse{Limit: limit, Used: used}
+	if limit != nil {
+		remaining := sdkmath.ZeroInt()
+		if limit.Limit.GT(used) {
+			remaining = limit.Limit.Sub(used)
+		}
+		resp.Remaining = &remaining
+	}
+	return resp, nil
+}
+
 // accountForDenomOrAddress attempts to first get a marker by account address and then by denom.
 func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (types.MarkerAccountI, error) {
 	var addrErr, err error
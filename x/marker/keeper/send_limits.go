@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetDefaultSendLimit gets the send limit that applies to accounts without their own limit for the provided marker.
+// Returns nil if the marker does not have a default send limit.
+func (k Keeper) GetDefaultSendLimit(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.SendLimit, error) {
+	return k.getSendLimit(ctx.KVStore(k.storeKey), types.SendLimitDefaultKey(markerAddr))
+}
+
+// SetDefaultSendLimit sets the send limit that applies to accounts without their own limit for the provided marker.
+func (k Keeper) SetDefaultSendLimit(ctx sdk.Context, markerAddr sdk.AccAddress, limit types.SendLimit) error {
+	return k.setSendLimit(ctx.KVStore(k.storeKey), types.SendLimitDefaultKey(markerAddr), limit)
+}
+
+// RemoveDefaultSendLimit removes the default send limit of the provided marker.
+func (k Keeper) RemoveDefaultSendLimit(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.SendLimitDefaultKey(markerAddr))
+}
+
+// GetAddressSendLimit gets the send limit specific to the provided account for the provided marker.
+// Returns nil if the account does not have its own send limit.
+func (k Keeper) GetAddressSendLimit(ctx sdk.Context, markerAddr, addr sdk.AccAddress) (*types.SendLimit, error) {
+	return k.getSendLimit(ctx.KVStore(k.storeKey), types.SendLimitAddressKey(markerAddr, addr))
+}
+
+// SetAddressSendLimit sets the send limit specific to the provided account for the provided marker.
+func (k Keeper) SetAddressSendLimit(ctx sdk.Context, markerAddr, addr sdk.AccAddress, limit types.SendLimit) error {
+	return k.setSendLimit(ctx.KVStore(k.storeKey), types.SendLimitAddressKey(markerAddr, addr), limit)
+}
+
+// RemoveAddressSendLimit removes the send limit specific to the provided account for the provided marker.
+func (k Keeper) RemoveAddressSendLimit(ctx sdk.Context, markerAddr, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.SendLimitAddressKey(markerAddr, addr))
+}
+
+// GetAddressSendLimits gets all the account-specific send limits for the provided marker.
+func (k Keeper) GetAddressSendLimits(ctx sdk.Context, markerAddr sdk.AccAddress) ([]types.AddressSendLimit, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.SendLimitAddressMarkerPrefix(markerAddr))
+	defer iter.Close()
+
+	var rv []types.AddressSendLimit
+	for ; iter.Valid(); iter.Next() {
+		_, addr := types.GetSendLimitAddresses(iter.Key())
+		var limit types.SendLimit
+		if err := k.cdc.Unmarshal(iter.Value(), &limit); err != nil {
+			return nil, fmt.Errorf("could not read send limit of %s for marker %s: %w", addr, markerAddr, err)
+		}
+		rv = append(rv, *types.NewAddressSendLimit(addr, limit))
+	}
+	return rv, nil
+}
+
+// GetSendLimit gets the send limit that applies to the provided account for the provided marker.
+// If the account doesn't have its own limit, the marker's default limit is returned.
+// Returns nil if there isn't a limit that applies to the account.
+func (k Keeper) GetSendLimit(ctx sdk.Context, markerAddr, addr sdk.AccAddress) (*types.SendLimit, error) {
+	limit, err := k.GetAddressSendLimit(ctx, markerAddr, addr)
+	if err != nil || limit != nil {
+		return limit, err
+	}
+	return k.GetDefaultSendLimit(ctx, markerAddr)
+}
+
+// GetSendLimitUsage gets the recent sends of the marker's denom made by the provided account, grouped by period.
+// The result might contain records that are no longer in the account's send limit window.
+func (k Keeper) GetSendLimitUsage(ctx sdk.Context, markerAddr, addr sdk.AccAddress) (types.SendLimitUsage, error) {
+	var rv types.SendLimitUsage
+	bz := ctx.KVStore(k.storeKey).Get(types.SendLimitUsageKey(markerAddr, addr))
+	if len(bz) == 0 {
+		return rv, nil
+	}
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return rv, fmt.Errorf("could not read send limit usage of %s for marker %s: %w", addr, markerAddr, err)
+	}
+	return rv, nil
+}
+
+// setSendLimitUsage records the recent sends of the marker's denom made by the provided account.
+func (k Keeper) setSendLimitUsage(ctx sdk.Context, markerAddr, addr sdk.AccAddress, usage types.SendLimitUsage) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.SendLimitUsageKey(markerAddr, addr)
+	if len(usage.Records) == 0 {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// getSendLimit reads a send limit from the provided key. Returns nil if there isn't one.
+func (k Keeper) getSendLimit(store storetypes.KVStore, key []byte) (*types.SendLimit, error) {
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.SendLimit
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// setSendLimit writes a send limit to the provided key.
+func (k Keeper) setSendLimit(store storetypes.KVStore, key []byte, limit types.SendLimit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&limit)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// clearSendLimits removes all send limits and send limit usage for the provided marker.
+func clearSendLimits(store storetypes.KVStore, markerAddr sdk.AccAddress) {
+	store.Delete(types.SendLimitDefaultKey(markerAddr))
+	for _, prefix := range [][]byte{types.SendLimitAddressMarkerPrefix(markerAddr), types.SendLimitUsageMarkerPrefix(markerAddr)} {
+		iter := storetypes.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// GetSendLimitUsed gets the send limit that applies to the provided account for the provided marker and the amount
+// the account has sent in the current window. If no limit applies, the limit will be nil and the amount used is zero.
+func (k Keeper) GetSendLimitUsed(ctx sdk.Context, markerAddr, addr sdk.AccAddress) (*types.SendLimit, sdkmath.Int, error) {
+	limit, err := k.GetSendLimit(ctx, markerAddr, addr)
+	if err != nil || limit == nil {
+		return limit, sdkmath.ZeroInt(), err
+	}
+	usage, err := k.GetSendLimitUsage(ctx, markerAddr, addr)
+	if err != nil {
+		return nil, sdkmath.ZeroInt(), err
+	}
+	return limit, usage.Prune(ctx.BlockTime(), *limit).Total(), nil
+}
+
+// consumeSendLimit makes sure the provided account can send the provided amount of the marker's denom without going
+// over its send limit, and records the send. Nothing is checked or recorded if there's no limit for the account.
+func (k Keeper) consumeSendLimit(ctx sdk.Context, marker types.MarkerAccountI, fromAddr sdk.AccAddress, amount sdkmath.Int) error {
+	markerAddr := marker.GetAddress()
+	limit, err := k.GetSendLimit(ctx, markerAddr, fromAddr)
+	if err != nil || limit == nil {
+		return err
+	}
+
+	usage, err := k.GetSendLimitUsage(ctx, markerAddr, fromAddr)
+	if err != nil {
+		return err
+	}
+	usage = usage.Prune(ctx.BlockTime(), *limit)
+	used := usage.Total()
+	if used.Add(amount).GT(limit.Limit) {
+		return fmt.Errorf("cannot send %s%s from %s: send limit of %s per %s would be exceeded (already sent: %s)",
+			amount, marker.GetDenom(), fromAddr, limit.Limit, limit.Window, used)
+	}
+	return k.setSendLimitUsage(ctx, markerAddr, fromAddr, usage.Add(ctx.BlockTime(), *limit, amount))
+}
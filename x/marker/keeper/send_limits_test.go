@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	attrTypes "github.com/provenance-io/provenance/x/attribute/types"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSendLimits(t *testing.T) {
+	app := simapp.Setup(t)
+	startTime := time.Unix(1700000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(startTime)
+	k := app.MarkerKeeper
+	msgServer := markerkeeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner_address_______")
+	investor := sdk.AccAddress("investor_address____")
+	bigInvestor := sdk.AccAddress("big_investor_address")
+	recipient := sdk.AccAddress("recipient_address___")
+	transferAgent := sdk.AccAddress("transfer_agent______")
+	expiredAgent := sdk.AccAddress("expired_agent_______")
+	expiredAt := startTime.Add(time.Hour)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, owner))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "kyc.provenance.io", owner, false), "SetNameRecord kyc.provenance.io")
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrTypes.Attribute{
+			Name:          "kyc.provenance.io",
+			Value:         []byte("string value"),
+			Address:       recipient.String(),
+			AttributeType: attrTypes.AttributeType_String,
+		},
+		owner,
+	), "SetAttribute kyc.provenance.io")
+
+	denom := "limitedcoin"
+	marker := &types.MarkerAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+		Manager:     owner.String(),
+		Status:      types.StatusProposed,
+		Denom:       denom,
+		Supply:      sdkmath.NewInt(10000),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessControl: []types.AccessGrant{
+			{Address: owner.String(), Permissions: types.AccessList{types.Access_Admin}},
+			{Address: transferAgent.String(), Permissions: types.AccessList{types.Access_Transfer}},
+			{Address: expiredAgent.String(), Permissions: types.AccessList{types.Access_Transfer}, Expiration: &expiredAt},
+		},
+		RequiredAttributes: []string{"kyc.provenance.io"},
+	}
+	require.NoError(t, k.AddFinalizeAndActivateMarker(ctx, marker), "AddFinalizeAndActivateMarker")
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amt))
+	}
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, investor, coins(1000)), "funding investor")
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, bigInvestor, coins(1000)), "funding big investor")
+
+	// Only an account with transfer access can update the limits.
+	msg := types.NewMsgUpdateSendLimitsRequest(denom, owner, types.NewSendLimit(sdkmath.NewInt(100), time.Hour), false,
+		[]types.AddressSendLimit{*types.NewAddressSendLimit(bigInvestor, *types.NewSendLimit(sdkmath.NewInt(500), time.Hour))}, nil)
+	_, err := msgServer.UpdateSendLimits(ctx, msg)
+	assert.ErrorContains(t, err, "does not have ACCESS_TRANSFER", "UpdateSendLimits by owner without transfer access")
+	msg.Authority = expiredAgent.String()
+	_, err = msgServer.UpdateSendLimits(ctx.WithBlockTime(expiredAt), msg)
+	assert.ErrorContains(t, err, "access grant for "+expiredAgent.String()+" on limitedcoin marker expired at",
+		"UpdateSendLimits by transfer agent with an expired grant")
+	msg.Authority = transferAgent.String()
+	_, err = msgServer.UpdateSendLimits(ctx, msg)
+	require.NoError(t, err, "UpdateSendLimits by transfer agent")
+
+	limitsResp, err := k.SendLimits(ctx, &types.QuerySendLimitsRequest{Id: denom})
+	require.NoError(t, err, "SendLimits query")
+	assert.Equal(t, msg.DefaultLimit, limitsResp.DefaultLimit, "default limit")
+	assert.Equal(t, msg.SetAddressLimits, limitsResp.AddressLimits, "address limits")
+
+	// The default limit applies to the investor over the whole window.
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, investor, recipient, coins(60)), "send 60")
+	err = app.BankKeeper.SendCoins(ctx, investor, recipient, coins(50))
+	assert.ErrorContains(t, err, "send limit of 100 per 1h0m0s would be exceeded (already sent: 60)", "send 50 more")
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, investor, recipient, coins(40)), "send 40 more")
+
+	usageResp, err := k.SendLimitUsage(ctx, &types.QuerySendLimitUsageRequest{Id: denom, Address: investor.String()})
+	require.NoError(t, err, "SendLimitUsage query")
+	assert.Equal(t, msg.DefaultLimit, usageResp.Limit, "usage limit")
+	assert.Equal(t, "100", usageResp.Used.String(), "usage used")
+	if assert.NotNil(t, usageResp.Remaining, "usage remaining") {
+		assert.Equal(t, "0", usageResp.Remaining.String(), "usage remaining")
+	}
+
+	// Once the period of the first send has left the window, its amount is available again.
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	err = app.BankKeeper.SendCoins(ctx, investor, recipient, coins(1))
+	assert.ErrorContains(t, err, "already sent: 100", "send 1 at the end of the window of the first send")
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour + msg.DefaultLimit.UsagePeriod()))
+	err = app.BankKeeper.SendCoins(ctx, investor, recipient, coins(61))
+	assert.ErrorContains(t, err, "already sent: 40", "send 61 after first send leaves window")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, investor, recipient, coins(60)), "send 60 after first send leaves window")
+
+	// An account-specific limit replaces the default.
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, bigInvestor, recipient, coins(500)), "send 500 by big investor")
+
+	// Transfers brokered by an account with transfer access aren't limited (or counted).
+	agentCtx := types.WithTransferAgents(ctx, transferAgent)
+	require.NoError(t, app.BankKeeper.SendCoins(agentCtx, investor, recipient, coins(300)), "send 300 with transfer agent")
+	_, used, err := k.GetSendLimitUsed(ctx, types.MustGetMarkerAddress(denom), investor)
+	require.NoError(t, err, "GetSendLimitUsed")
+	assert.Equal(t, "100", used.String(), "used after transfer agent send")
+
+	// The limits are included in genesis.
+	genState := k.ExportGenesis(ctx)
+	expLimits := []types.MarkerSendLimits{{
+		Address:       types.MustGetMarkerAddress(denom).String(),
+		DefaultLimit:  msg.DefaultLimit,
+		AddressLimits: msg.SetAddressLimits,
+	}}
+	assert.Equal(t, expLimits, genState.SendLimits, "exported send limits")
+
+	// Removing the limits means the sends are no longer limited.
+	msg = types.NewMsgUpdateSendLimitsRequest(denom, transferAgent, nil, true, nil, []string{bigInvestor.String()})
+	_, err = msgServer.UpdateSendLimits(ctx, msg)
+	require.NoError(t, err, "UpdateSendLimits removing limits")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, investor, recipient, coins(200)), "send 200 without limit")
+	usageResp, err = k.SendLimitUsage(ctx, &types.QuerySendLimitUsageRequest{Id: denom, Address: investor.String()})
+	require.NoError(t, err, "SendLimitUsage query without limit")
+	assert.Nil(t, usageResp.Limit, "limit without limit")
+	assert.Nil(t, usageResp.Remaining, "remaining without limit")
+}
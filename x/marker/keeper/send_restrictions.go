@@ -38,7 +38,8 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 
 	// If it's coming from a marker, make sure the withdraw is allowed.
 	admins := types.GetTransferAgents(ctx)
-	if fromMarker, _ := k.GetMarker(ctx, fromAddr); fromMarker != nil {
+	fromMarker, _ := k.GetMarker(ctx, fromAddr)
+	if fromMarker != nil {
 		// The only ways to legitimately send from a marker account is to have a transfer agent with
 		// withdraw permissions, or through a feegrant. The only way to have a feegrant from
 		// a marker account is if an admin creates one using the marker module's GrantAllowance endpoint.
@@ -53,7 +54,7 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 			}
 
 			// Need at least one admin that can make withdrawals.
-			if err := types.ValidateAtLeastOneAddrHasAccess(fromMarker, admins, types.Access_Withdraw); err != nil {
+			if err := types.ValidateAtLeastOneAddrHasAccess(fromMarker, admins, types.Access_Withdraw, ctx.BlockTime()); err != nil {
 				return nil, err
 			}
 		}
@@ -74,21 +75,27 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 	toMarker, _ := k.GetMarker(ctx, toAddr)
 	if toMarker != nil && toMarker.GetMarkerType() == types.MarkerType_RestrictedCoin {
 		if len(admins) > 0 {
-			if err := types.ValidateAtLeastOneAddrHasAccess(toMarker, admins, types.Access_Deposit); err != nil {
+			if err := types.ValidateAtLeastOneAddrHasAccess(toMarker, admins, types.Access_Deposit, ctx.BlockTime()); err != nil {
 				return nil, err
 			}
 		} else {
-			if err := toMarker.ValidateAddressHasAccess(fromAddr, types.Access_Deposit); err != nil {
+			if err := toMarker.ValidateAddressHasAccess(fromAddr, types.Access_Deposit, ctx.BlockTime()); err != nil {
 				return nil, err
 			}
 		}
 	}
 
-	// Check the ability to send each denom involved.
+	// Check the ability to send each denom involved, and that doing so won't exceed any send limits.
+	// Send limits don't apply to funds leaving a marker account since those require an admin anyway.
 	for _, coin := range amt {
 		if err := k.validateSendDenom(ctx, fromAddr, toAddr, admins, coin.Denom, toMarker); err != nil {
 			return nil, err
 		}
+		if fromMarker == nil {
+			if err := k.validateSendLimit(ctx, fromAddr, admins, coin); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return toAddr, nil
@@ -119,7 +126,7 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	}
 
 	// If there's an admin that has transfer access, it's not a normal bank send and there's nothing more to do here.
-	if len(admins) > 0 && types.AtLeastOneAddrHasAccess(marker, admins, types.Access_Transfer) {
+	if len(admins) > 0 && types.AtLeastOneAddrHasAccess(marker, admins, types.Access_Transfer, ctx.BlockTime()) {
 		return nil
 	}
 
@@ -132,7 +139,7 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	}
 
 	// If the fromAddr has transfer access, there's nothing left to check.
-	if marker.AddressHasAccess(fromAddr, types.Access_Transfer) {
+	if marker.AddressHasAccess(fromAddr, types.Access_Transfer, ctx.BlockTime()) {
 		return nil
 	}
 
@@ -188,6 +195,29 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	return nil
 }
 
+// validateSendLimit makes sure a send of the given coin won't put the fromAddr over its send limit for that
+// denom, and records the send. Send limits only apply to restricted markers, and do not apply when the send is
+// being made by (or brokered by) an account with transfer access, or when sending from a bypass account.
+func (k Keeper) validateSendLimit(ctx sdk.Context, fromAddr sdk.AccAddress, admins []sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.Amount.IsPositive() {
+		return nil
+	}
+	marker, err := k.GetMarker(ctx, types.MustGetMarkerAddress(coin.Denom))
+	if err != nil {
+		return err
+	}
+	if marker == nil || marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil
+	}
+	if len(admins) > 0 && types.AtLeastOneAddrHasAccess(marker, admins, types.Access_Transfer, ctx.BlockTime()) {
+		return nil
+	}
+	if marker.AddressHasAccess(fromAddr, types.Access_Transfer, ctx.BlockTime()) || k.IsReqAttrBypassAddr(fromAddr) {
+		return nil
+	}
+	return k.consumeSendLimit(ctx, marker, fromAddr, coin.Amount)
+}
+
 // findMissingAttributes returns all entries in required that don't pass
 // MatchAttribute on at least one of the provided attribute names.
 func findMissingAttributes(required []string, attributes []attrTypes.Attribute) []string {
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -23,6 +24,7 @@ type AccessGrantI interface {
 
 	HasAccess(Access) bool
 	GetAccessList() []Access
+	IsExpired(blockTime time.Time) bool
 
 	AddAccess(Access) error
 	RemoveAccess(Access) error
@@ -88,7 +90,7 @@ func GrantsForAddress(account sdk.AccAddress, grants ...AccessGrant) AccessGrant
 			return grant
 		}
 	}
-	return AccessGrant{account.String(), []Access{}}
+	return AccessGrant{Address: account.String(), Permissions: []Access{}}
 }
 
 // GetAddress returns the account address the access grant belongs to
@@ -110,9 +112,28 @@ func (ag AccessGrant) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(ag.Address); err != nil {
 		return fmt.Errorf("invalid address: %w", err)
 	}
+	if ag.TotalCap != nil && !ag.TotalCap.IsPositive() {
+		return fmt.Errorf("invalid total cap %s: must be positive", ag.TotalCap)
+	}
+	if ag.MaxPerTx != nil && !ag.MaxPerTx.IsPositive() {
+		return fmt.Errorf("invalid max per tx %s: must be positive", ag.MaxPerTx)
+	}
+	if ag.TotalCap != nil && ag.MaxPerTx != nil && ag.MaxPerTx.GT(*ag.TotalCap) {
+		return fmt.Errorf("max per tx %s cannot be greater than total cap %s", ag.MaxPerTx, ag.TotalCap)
+	}
 	return validateAccess(ag.Permissions)
 }
 
+// IsExpired returns true if this grant has an expiration that is at or before the provided block time.
+func (ag AccessGrant) IsExpired(blockTime time.Time) bool {
+	return ag.Expiration != nil && !ag.Expiration.After(blockTime)
+}
+
+// HasLimits returns true if this grant has a total cap or max per tx.
+func (ag AccessGrant) HasLimits() bool {
+	return ag.TotalCap != nil || ag.MaxPerTx != nil
+}
+
 // HasAccess returns true if the current grant contains the specified access type
 func (ag AccessGrant) HasAccess(access Access) bool {
 	if ag.Address == "" {
@@ -142,6 +163,7 @@ func (ag *AccessGrant) RemoveAccess(access Access) error {
 }
 
 // MergeAdd looks for any missing permissions in the given grant and adds them to this instance.
+// Any expiration, total cap, or max per tx that this instance does not have is also taken from the given grant.
 func (ag *AccessGrant) MergeAdd(other AccessGrant) error {
 	if err := other.Validate(); err != nil {
 		return err
@@ -154,6 +176,15 @@ func (ag *AccessGrant) MergeAdd(other AccessGrant) error {
 			ag.Permissions = append(ag.Permissions, p)
 		}
 	}
+	if ag.Expiration == nil {
+		ag.Expiration = other.Expiration
+	}
+	if ag.TotalCap == nil {
+		ag.TotalCap = other.TotalCap
+	}
+	if ag.MaxPerTx == nil {
+		ag.MaxPerTx = other.MaxPerTx
+	}
 	return nil
 }
 
@@ -186,7 +217,17 @@ func (ag AccessGrant) String() string {
 			result = fmt.Sprintf("%s, %s", result, perm)
 		}
 	}
-	return fmt.Sprintf("AccessGrant: %s [%s]", ag.Address, result)
+	rv := fmt.Sprintf("AccessGrant: %s [%s]", ag.Address, result)
+	if ag.Expiration != nil {
+		rv += " expiration: " + ag.Expiration.UTC().Format(time.RFC3339Nano)
+	}
+	if ag.TotalCap != nil {
+		rv += " total cap: " + ag.TotalCap.String()
+	}
+	if ag.MaxPerTx != nil {
+		rv += " max per tx: " + ag.MaxPerTx.String()
+	}
+	return rv
 }
 
 // IsOneOf returns true if the specified Access right is any of the provided options.
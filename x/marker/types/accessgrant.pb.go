@@ -4,19 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -94,6 +99,14 @@ func (Access) EnumDescriptor() ([]byte, []int) {
 type AccessGrant struct {
 	Address     string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Permissions AccessList `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access,castrepeated=AccessList" json:"permissions,omitempty"`
+	// expiration is an optional time at which this grant expires and is automatically removed from the marker.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// total_cap is an optional limit on the total amount of the marker's denom that can be
+	// minted, burned, withdrawn or transferred using this grant.
+	TotalCap *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_cap,json=totalCap,proto3,customtype=cosmossdk.io/math.Int" json:"total_cap,omitempty"`
+	// max_per_tx is an optional limit on the amount of the marker's denom that can be
+	// minted, burned, withdrawn or transferred using this grant in a single action.
+	MaxPerTx *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_per_tx,json=maxPerTx,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_tx,omitempty"`
 }
 
 func (m *AccessGrant) Reset()      { *m = AccessGrant{} }
@@ -128,9 +141,59 @@ func (m *AccessGrant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessGrant proto.InternalMessageInfo
 
+// AccessGrantUsage is the amount of a marker's denom that has been used against an access grant's total_cap.
+type AccessGrantUsage struct {
+	// address is the bech32 address string of the account with the access grant.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// used is the total amount of the marker's denom that has been minted, burned, withdrawn or transferred
+	// using the access grant.
+	Used cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+}
+
+func (m *AccessGrantUsage) Reset()         { *m = AccessGrantUsage{} }
+func (m *AccessGrantUsage) String() string { return proto.CompactTextString(m) }
+func (*AccessGrantUsage) ProtoMessage()    {}
+func (*AccessGrantUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7242c30a84644575, []int{1}
+}
+func (m *AccessGrantUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessGrantUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessGrantUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessGrantUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessGrantUsage.Merge(m, src)
+}
+func (m *AccessGrantUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessGrantUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessGrantUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessGrantUsage proto.InternalMessageInfo
+
+func (m *AccessGrantUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("provenance.marker.v1.Access", Access_name, Access_value)
 	proto.RegisterType((*AccessGrant)(nil), "provenance.marker.v1.AccessGrant")
+	proto.RegisterType((*AccessGrantUsage)(nil), "provenance.marker.v1.AccessGrantUsage")
 }
 
 func init() {
@@ -138,39 +201,48 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0xc7, 0xed, 0xb4, 0x4d, 0xd2, 0x4b, 0x9a, 0xd7, 0xef, 0xa9, 0x88, 0xd4, 0x14, 0xc7, 0x80,
-	0x84, 0x2a, 0x44, 0x6d, 0xb5, 0x6c, 0x6c, 0x4e, 0xec, 0x80, 0xa5, 0xc6, 0x8d, 0x1c, 0x47, 0x91,
-	0x58, 0x2a, 0xd7, 0x39, 0xd2, 0x53, 0xc9, 0x5d, 0x74, 0xe7, 0xa6, 0xf4, 0x1b, 0x20, 0x4f, 0x2c,
-	0x48, 0x2c, 0x96, 0x32, 0x33, 0xf7, 0x43, 0x20, 0xa6, 0x8e, 0x6c, 0xa0, 0x64, 0xe1, 0x63, 0xa0,
-	0xe4, 0x12, 0xe2, 0xa1, 0xdb, 0xf3, 0xdc, 0xff, 0x77, 0x3f, 0x3d, 0xba, 0x7b, 0xc0, 0xf3, 0x11,
-	0xa3, 0x63, 0x44, 0x42, 0x12, 0x21, 0x73, 0x18, 0xb2, 0x4b, 0xc4, 0xcc, 0xf1, 0x91, 0x19, 0x46,
-	0x11, 0xe2, 0x7c, 0xc0, 0x42, 0x12, 0x1b, 0x23, 0x46, 0x63, 0x0a, 0x77, 0xd7, 0x9c, 0x21, 0x38,
-	0x63, 0x7c, 0xa4, 0xee, 0x0e, 0xe8, 0x80, 0x2e, 0x00, 0x73, 0x5e, 0x09, 0x56, 0xdd, 0x8b, 0x28,
-	0x1f, 0x52, 0x7e, 0x26, 0x02, 0xd1, 0x88, 0xe8, 0xe9, 0x17, 0x19, 0x94, 0xac, 0x85, 0xfc, 0xcd,
-	0x5c, 0x0e, 0xab, 0xa0, 0x10, 0xf6, 0xfb, 0x0c, 0x71, 0x5e, 0x95, 0x75, 0xf9, 0x60, 0xdb, 0x5f,
-	0xb5, 0xd0, 0x03, 0xa5, 0x11, 0x62, 0x43, 0xcc, 0x39, 0xa6, 0x84, 0x57, 0x73, 0xfa, 0xc6, 0x41,
-	0xe5, 0x78, 0xdf, 0xb8, 0x6f, 0x0c, 0x43, 0x18, 0xeb, 0x95, 0x6f, 0xbf, 0x6a, 0x40, 0xd4, 0x27,
-	0x98, 0xc7, 0x7e, 0x56, 0xf0, 0x7a, 0xff, 0xd3, 0xa4, 0x26, 0x7d, 0x9d, 0xd4, 0xa4, 0x3f, 0x93,
-	0x9a, 0xfc, 0xe3, 0xf6, 0xb0, 0x9c, 0x19, 0xc3, 0x7d, 0x71, 0x9b, 0x03, 0x79, 0x71, 0x00, 0x9f,
-	0x01, 0x68, 0x35, 0x1a, 0x4e, 0xa7, 0x73, 0xd6, 0xf5, 0x3a, 0x6d, 0xa7, 0xe1, 0x36, 0x5d, 0xc7,
-	0x56, 0x24, 0xb5, 0x94, 0xa4, 0x7a, 0xa1, 0x4b, 0x2e, 0x09, 0xbd, 0x26, 0x70, 0x0f, 0x94, 0x96,
-	0x50, 0xcb, 0xf5, 0x02, 0x45, 0x56, 0x8b, 0x49, 0xaa, 0x6f, 0xb6, 0x30, 0x89, 0x33, 0x51, 0xbd,
-	0xeb, 0x7b, 0x4a, 0x4e, 0x44, 0xf5, 0x2b, 0x46, 0x60, 0x0d, 0x54, 0x96, 0x91, 0xed, 0xb4, 0x4f,
-	0x3b, 0x6e, 0xa0, 0x6c, 0x08, 0xad, 0x8d, 0x46, 0x94, 0xe3, 0x18, 0x3e, 0x01, 0xff, 0x2d, 0x81,
-	0x9e, 0x1b, 0xbc, 0xb5, 0x7d, 0xab, 0xa7, 0x6c, 0xaa, 0xe5, 0x24, 0xd5, 0x8b, 0x3d, 0x1c, 0x5f,
-	0xf4, 0x59, 0x78, 0x0d, 0x1f, 0x83, 0x9d, 0x7f, 0x8e, 0x13, 0x27, 0x70, 0x94, 0x2d, 0x15, 0x24,
-	0xa9, 0x9e, 0xb7, 0xd1, 0x07, 0x14, 0x23, 0xf8, 0x08, 0x94, 0x97, 0xb1, 0x65, 0xb7, 0x5c, 0x4f,
-	0xc9, 0xab, 0xdb, 0x49, 0xaa, 0x6f, 0x59, 0xfd, 0x21, 0x26, 0x19, 0x7d, 0xe0, 0x5b, 0x5e, 0xa7,
-	0xe9, 0xf8, 0x4a, 0x41, 0xe8, 0x03, 0x16, 0x12, 0xfe, 0x1e, 0x31, 0xf8, 0x12, 0x3c, 0x58, 0x22,
-	0xcd, 0x53, 0xbf, 0xe1, 0xac, 0xc1, 0xa2, 0xfa, 0x7f, 0x92, 0xea, 0x3b, 0x4d, 0xca, 0x22, 0xb4,
-	0xa2, 0xeb, 0x37, 0xdf, 0xa7, 0x9a, 0x7c, 0x37, 0xd5, 0xe4, 0xdf, 0x53, 0x4d, 0xfe, 0x3c, 0xd3,
-	0xa4, 0xbb, 0x99, 0x26, 0xfd, 0x9c, 0x69, 0x12, 0x78, 0x88, 0xe9, 0xbd, 0x7f, 0x55, 0x57, 0x32,
-	0xef, 0xde, 0x9e, 0xef, 0x44, 0x5b, 0x7e, 0x77, 0x3c, 0xc0, 0xf1, 0xc5, 0xd5, 0xb9, 0x11, 0xd1,
-	0xa1, 0xb9, 0xbe, 0x74, 0x88, 0x69, 0xa6, 0x33, 0x3f, 0xae, 0xf6, 0x33, 0xbe, 0x19, 0x21, 0x7e,
-	0x9e, 0x5f, 0x2c, 0xd4, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x70, 0xf5, 0x75, 0xc1,
-	0x02, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x86, 0xe3, 0x10, 0x42, 0x98, 0x00, 0xd7, 0x77, 0x04, 0xba, 0xc1, 0x17, 0x62, 0x97, 0x4a,
+	0x15, 0xaa, 0x8a, 0x2d, 0xa8, 0xd4, 0x4a, 0x5d, 0x35, 0x4e, 0x9c, 0xd6, 0x12, 0x84, 0xc8, 0x71,
+	0x84, 0xd4, 0x4d, 0x34, 0x38, 0x83, 0x19, 0x81, 0x3d, 0xd6, 0xcc, 0x04, 0xc2, 0x1b, 0x54, 0x59,
+	0xb1, 0xec, 0x26, 0x12, 0xeb, 0xae, 0x79, 0x08, 0xd4, 0x15, 0xcb, 0xaa, 0x0b, 0xa8, 0x60, 0xd3,
+	0xc7, 0xa8, 0x62, 0x27, 0xc5, 0x0b, 0xda, 0xee, 0xe6, 0xe8, 0xff, 0xce, 0x7f, 0x7e, 0x1d, 0x1f,
+	0x83, 0x67, 0x11, 0xa3, 0x27, 0x38, 0x44, 0xa1, 0x87, 0x8d, 0x00, 0xb1, 0x23, 0xcc, 0x8c, 0x93,
+	0x4d, 0x03, 0x79, 0x1e, 0xe6, 0xdc, 0x67, 0x28, 0x14, 0x7a, 0xc4, 0xa8, 0xa0, 0x70, 0xf1, 0x81,
+	0xd3, 0x13, 0x4e, 0x3f, 0xd9, 0x54, 0x16, 0x7d, 0xea, 0xd3, 0x18, 0x30, 0x46, 0xaf, 0x84, 0x55,
+	0x96, 0x3d, 0xca, 0x03, 0xca, 0x3b, 0x89, 0x90, 0x14, 0x63, 0x49, 0xf5, 0x29, 0xf5, 0x8f, 0xb1,
+	0x11, 0x57, 0xfb, 0xbd, 0x03, 0x43, 0x90, 0x00, 0x73, 0x81, 0x82, 0x28, 0x01, 0xd6, 0xae, 0xb2,
+	0xa0, 0x58, 0x89, 0xa7, 0xbf, 0x1b, 0x4d, 0x87, 0x25, 0x30, 0x83, 0xba, 0x5d, 0x86, 0x39, 0x2f,
+	0x49, 0x9a, 0xb4, 0x3e, 0xeb, 0x4c, 0x4a, 0xd8, 0x00, 0xc5, 0x08, 0xb3, 0x80, 0x70, 0x4e, 0x68,
+	0xc8, 0x4b, 0x59, 0x6d, 0x6a, 0x7d, 0x61, 0x6b, 0x45, 0x7f, 0x2c, 0xa7, 0x9e, 0x38, 0x9a, 0x0b,
+	0x9f, 0x6f, 0x55, 0x90, 0xbc, 0xb7, 0x09, 0x17, 0x4e, 0xda, 0x00, 0xbe, 0x05, 0x00, 0xf7, 0x23,
+	0xc2, 0x90, 0x20, 0x34, 0x2c, 0x4d, 0x69, 0xd2, 0x7a, 0x71, 0x4b, 0xd1, 0x93, 0xbc, 0xfa, 0x24,
+	0xaf, 0xee, 0x4e, 0xf2, 0x9a, 0xb9, 0xf3, 0x5b, 0x55, 0x72, 0x52, 0x3d, 0xf0, 0x15, 0x98, 0x15,
+	0x54, 0xa0, 0xe3, 0x8e, 0x87, 0xa2, 0x52, 0x6e, 0x94, 0xd6, 0x5c, 0xfe, 0x76, 0xa3, 0x2e, 0x25,
+	0x1b, 0xe0, 0xdd, 0x23, 0x9d, 0x50, 0x23, 0x40, 0xe2, 0x50, 0xb7, 0x43, 0xe1, 0x14, 0x62, 0xb6,
+	0x8a, 0x22, 0xf8, 0x1a, 0x80, 0x00, 0xf5, 0x3b, 0x11, 0x66, 0x1d, 0xd1, 0x2f, 0x4d, 0xff, 0xb5,
+	0x31, 0x40, 0xfd, 0x26, 0x66, 0x6e, 0xff, 0xcd, 0xca, 0xc7, 0x0b, 0x35, 0xf3, 0xe9, 0x42, 0xcd,
+	0xfc, 0xb8, 0x50, 0xa5, 0x2f, 0x97, 0x1b, 0x73, 0xa9, 0xcd, 0xd9, 0x6b, 0x1d, 0x20, 0xa7, 0xea,
+	0x36, 0x47, 0x3e, 0xfe, 0xc3, 0x3a, 0x37, 0x41, 0xae, 0xc7, 0x71, 0xb7, 0x94, 0x8d, 0xc7, 0xaf,
+	0x5e, 0xdd, 0xa8, 0x99, 0xdf, 0x47, 0x88, 0xd1, 0xe7, 0x97, 0x59, 0x90, 0x4f, 0x26, 0xc0, 0xa7,
+	0x00, 0x56, 0xaa, 0x55, 0xab, 0xd5, 0xea, 0xb4, 0x1b, 0xad, 0xa6, 0x55, 0xb5, 0xeb, 0xb6, 0x55,
+	0x93, 0x33, 0x4a, 0x71, 0x30, 0xd4, 0x66, 0xda, 0xe1, 0x51, 0x48, 0x4f, 0x43, 0xb8, 0x0c, 0x8a,
+	0x63, 0x68, 0xc7, 0x6e, 0xb8, 0xb2, 0xa4, 0x14, 0x06, 0x43, 0x2d, 0xb7, 0x43, 0x42, 0x91, 0x92,
+	0xcc, 0xb6, 0xd3, 0x90, 0xb3, 0x89, 0x64, 0xf6, 0x58, 0x08, 0x55, 0xb0, 0x30, 0x96, 0x6a, 0x56,
+	0x73, 0xb7, 0x65, 0xbb, 0xf2, 0x54, 0x62, 0x5b, 0xc3, 0x11, 0xe5, 0x44, 0xc0, 0x27, 0xe0, 0x9f,
+	0x31, 0xb0, 0x67, 0xbb, 0xef, 0x6b, 0x4e, 0x65, 0x4f, 0xce, 0x29, 0x73, 0x83, 0xa1, 0x56, 0xd8,
+	0x23, 0xe2, 0xb0, 0xcb, 0xd0, 0x29, 0x5c, 0x05, 0xf3, 0xbf, 0x3c, 0xb6, 0x2d, 0xd7, 0x92, 0xa7,
+	0x15, 0x30, 0x18, 0x6a, 0xf9, 0x1a, 0x3e, 0xc6, 0x02, 0xc3, 0xff, 0xc1, 0xdc, 0x58, 0xae, 0xd4,
+	0x76, 0xec, 0x86, 0x9c, 0x57, 0x66, 0x07, 0x43, 0x6d, 0xba, 0xd2, 0x0d, 0x48, 0x98, 0xb2, 0x77,
+	0x9d, 0x4a, 0xa3, 0x55, 0xb7, 0x1c, 0x79, 0x26, 0xb1, 0x77, 0x19, 0x0a, 0xf9, 0x01, 0x66, 0xf0,
+	0x05, 0x58, 0x1a, 0x23, 0xf5, 0x5d, 0xa7, 0x6a, 0x3d, 0x80, 0x05, 0xe5, 0xdf, 0xc1, 0x50, 0x9b,
+	0xaf, 0x53, 0xe6, 0xe1, 0x09, 0x6d, 0x9e, 0x5d, 0xdd, 0x95, 0xa5, 0xeb, 0xbb, 0xb2, 0xf4, 0xfd,
+	0xae, 0x2c, 0x9d, 0xdf, 0x97, 0x33, 0xd7, 0xf7, 0xe5, 0xcc, 0xd7, 0xfb, 0x72, 0x06, 0xfc, 0x47,
+	0xe8, 0xa3, 0xf7, 0x6b, 0xa6, 0x3f, 0x64, 0x73, 0x74, 0x8a, 0x4d, 0xe9, 0xc3, 0x96, 0x4f, 0xc4,
+	0x61, 0x6f, 0x5f, 0xf7, 0x68, 0x60, 0x3c, 0x34, 0x6d, 0x10, 0x9a, 0xaa, 0x8c, 0xfe, 0xe4, 0xa7,
+	0x16, 0x67, 0x11, 0xe6, 0xfb, 0xf9, 0xf8, 0x8e, 0x5f, 0xfe, 0x1c, 0x00, 0xa5, 0x9e, 0x6b, 0x77,
+	0xf6, 0x03, 0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
@@ -203,6 +275,27 @@ func (this *AccessGrant) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
+	if that1.TotalCap == nil {
+		if this.TotalCap != nil {
+			return false
+		}
+	} else if !this.TotalCap.Equal(*that1.TotalCap) {
+		return false
+	}
+	if that1.MaxPerTx == nil {
+		if this.MaxPerTx != nil {
+			return false
+		}
+	} else if !this.MaxPerTx.Equal(*that1.MaxPerTx) {
+		return false
+	}
 	return true
 }
 func (m *AccessGrant) Marshal() (dAtA []byte, err error) {
@@ -225,21 +318,55 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPerTx != nil {
+		{
+			size := m.MaxPerTx.Size()
+			i -= size
+			if _, err := m.MaxPerTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAccessgrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalCap != nil {
+		{
+			size := m.TotalCap.Size()
+			i -= size
+			if _, err := m.TotalCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAccessgrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAccessgrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Permissions) > 0 {
-		dAtA2 := make([]byte, len(m.Permissions)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Permissions)*10)
+		var j2 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintAccessgrant(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintAccessgrant(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -253,6 +380,46 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccessGrantUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessGrantUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessGrantUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccessgrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAccessgrant(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAccessgrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAccessgrant(v)
 	base := offset
@@ -281,6 +448,33 @@ func (m *AccessGrant) Size() (n int) {
 		}
 		n += 1 + sovAccessgrant(uint64(l)) + l
 	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	if m.TotalCap != nil {
+		l = m.TotalCap.Size()
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	if m.MaxPerTx != nil {
+		l = m.MaxPerTx.Size()
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	return n
+}
+
+func (m *AccessGrantUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovAccessgrant(uint64(l))
 	return n
 }
 
@@ -420,6 +614,230 @@ func (m *AccessGrant) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.TotalCap = &v
+			if err := m.TotalCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerTx", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxPerTx = &v
+			if err := m.MaxPerTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccessgrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessGrantUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccessgrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessGrantUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessGrantUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccessgrant(dAtA[iNdEx:])
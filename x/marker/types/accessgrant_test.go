@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -234,3 +237,81 @@ func TestMergeAddRemovePermissions(t *testing.T) {
 	require.Error(t, roleGrant.MergeAdd(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 	require.Error(t, roleGrant.MergeRemove(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 }
+
+func TestAccessGrantLimits(t *testing.T) {
+	addr := MustGetMarkerAddress("test")
+	intPtr := func(amt int64) *sdkmath.Int {
+		rv := sdkmath.NewInt(amt)
+		return &rv
+	}
+	newGrant := func(totalCap, maxPerTx *sdkmath.Int) AccessGrant {
+		grant := NewAccessGrant(addr, AccessList{Access_Mint})
+		grant.TotalCap = totalCap
+		grant.MaxPerTx = maxPerTx
+		return *grant
+	}
+
+	tests := []struct {
+		name   string
+		grant  AccessGrant
+		expErr string
+	}{
+		{name: "no limits", grant: newGrant(nil, nil)},
+		{name: "total cap only", grant: newGrant(intPtr(10), nil)},
+		{name: "max per tx only", grant: newGrant(nil, intPtr(10))},
+		{name: "max per tx equals total cap", grant: newGrant(intPtr(10), intPtr(10))},
+		{name: "max per tx less than total cap", grant: newGrant(intPtr(10), intPtr(3))},
+		{name: "zero total cap", grant: newGrant(intPtr(0), nil), expErr: "invalid total cap 0: must be positive"},
+		{name: "negative total cap", grant: newGrant(intPtr(-1), nil), expErr: "invalid total cap -1: must be positive"},
+		{name: "zero max per tx", grant: newGrant(nil, intPtr(0)), expErr: "invalid max per tx 0: must be positive"},
+		{
+			name:   "max per tx more than total cap",
+			grant:  newGrant(intPtr(10), intPtr(11)),
+			expErr: "max per tx 11 cannot be greater than total cap 10",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.grant.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestAccessGrantIsExpired(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	grant := NewAccessGrant(MustGetMarkerAddress("test"), AccessList{Access_Mint})
+	assert.False(t, grant.IsExpired(now), "IsExpired without an expiration")
+
+	before, after := now.Add(-1*time.Second), now.Add(time.Second)
+	grant.Expiration = &before
+	assert.True(t, grant.IsExpired(now), "IsExpired with expiration before block time")
+	grant.Expiration = &now
+	assert.True(t, grant.IsExpired(now), "IsExpired with expiration equal to block time")
+	grant.Expiration = &after
+	assert.False(t, grant.IsExpired(now), "IsExpired with expiration after block time")
+}
+
+func TestMergeAddLimits(t *testing.T) {
+	addr := MustGetMarkerAddress("test")
+	exp1, exp2 := time.Unix(1700000000, 0).UTC(), time.Unix(1800000000, 0).UTC()
+	cap1, cap2, max1 := sdkmath.NewInt(100), sdkmath.NewInt(200), sdkmath.NewInt(5)
+
+	existing := NewAccessGrant(addr, AccessList{Access_Mint})
+	existing.Expiration, existing.TotalCap, existing.MaxPerTx = &exp1, &cap1, &max1
+
+	// Limits provided in the new grant are kept, and missing ones are taken from the existing grant.
+	grant := NewAccessGrant(addr, AccessList{Access_Burn})
+	grant.Expiration, grant.TotalCap = &exp2, &cap2
+	require.NoError(t, grant.MergeAdd(*existing), "MergeAdd")
+	assert.True(t, grant.HasAccess(Access_Mint), "HasAccess(mint)")
+	assert.True(t, grant.HasAccess(Access_Burn), "HasAccess(burn)")
+	assert.Equal(t, &exp2, grant.Expiration, "Expiration")
+	assert.Equal(t, &cap2, grant.TotalCap, "TotalCap")
+	assert.Equal(t, &max1, grant.MaxPerTx, "MaxPerTx")
+}
@@ -185,10 +185,19 @@ func NewEventSetNetAssetValue(denom string, price sdk.Coin, volume uint64, sourc
 }
 
 // NewEventMarkerParamsUpdated returns a new instance of EventMarkerParamsUpdated
-func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int) *EventMarkerParamsUpdated {
+func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int, maxNavHistoryEntries uint32) *EventMarkerParamsUpdated {
 	return &EventMarkerParamsUpdated{
 		EnableGovernance:       strconv.FormatBool(allowGovControl),
 		UnrestrictedDenomRegex: denomRegex,
 		MaxSupply:              maxSupply.String(),
+		MaxNavHistoryEntries:   strconv.FormatUint(uint64(maxNavHistoryEntries), 10),
+	}
+}
+
+// NewEventMarkerSendLimitsUpdated returns a new instance of EventMarkerSendLimitsUpdated
+func NewEventMarkerSendLimitsUpdated(denom string, administrator string) *EventMarkerSendLimitsUpdated {
+	return &EventMarkerSendLimitsUpdated{
+		Denom:         denom,
+		Administrator: administrator,
 	}
 }
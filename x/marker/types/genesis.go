@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -30,6 +32,31 @@ func (state GenesisState) Validate() error {
 			}
 		}
 	}
+	for _, mHistory := range state.NetAssetValueHistory {
+		if _, err := sdk.AccAddressFromBech32(mHistory.Address); err != nil {
+			return fmt.Errorf("invalid net asset value history marker address %q: %w", mHistory.Address, err)
+		}
+		for _, entry := range mHistory.Entries {
+			if err := entry.NetAssetValue.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	for _, mLimits := range state.SendLimits {
+		if _, err := sdk.AccAddressFromBech32(mLimits.Address); err != nil {
+			return fmt.Errorf("invalid send limits marker address %q: %w", mLimits.Address, err)
+		}
+		if mLimits.DefaultLimit != nil {
+			if err := mLimits.DefaultLimit.Validate(); err != nil {
+				return err
+			}
+		}
+		for _, limit := range mLimits.AddressLimits {
+			if err := limit.Validate(); err != nil {
+				return err
+			}
+		}
+	}
 
 	return nil
 }
@@ -33,6 +33,10 @@ type GenesisState struct {
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 	// list of denom based denied send addresses
 	DenySendAddresses []DenySendAddress `protobuf:"bytes,4,rep,name=deny_send_addresses,json=denySendAddresses,proto3" json:"deny_send_addresses"`
+	// list of the send limits of restricted markers
+	SendLimits []MarkerSendLimits `protobuf:"bytes,5,rep,name=send_limits,json=sendLimits,proto3" json:"send_limits"`
+	// list of the retained net asset value history of markers
+	NetAssetValueHistory []MarkerNetAssetValueHistory `protobuf:"bytes,6,rep,name=net_asset_value_history,json=netAssetValueHistory,proto3" json:"net_asset_value_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,10 +154,96 @@ func (m *MarkerNetAssetValues) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarkerNetAssetValues proto.InternalMessageInfo
 
+// MarkerNetAssetValueHistory defines the retained net asset value history of a marker
+type MarkerNetAssetValueHistory struct {
+	// address defines the marker address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// entries are the net asset values previously set for the marker, in the order they were set
+	Entries []NetAssetValueHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *MarkerNetAssetValueHistory) Reset()         { *m = MarkerNetAssetValueHistory{} }
+func (m *MarkerNetAssetValueHistory) String() string { return proto.CompactTextString(m) }
+func (*MarkerNetAssetValueHistory) ProtoMessage()    {}
+func (*MarkerNetAssetValueHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{3}
+}
+func (m *MarkerNetAssetValueHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerNetAssetValueHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerNetAssetValueHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerNetAssetValueHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerNetAssetValueHistory.Merge(m, src)
+}
+func (m *MarkerNetAssetValueHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerNetAssetValueHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerNetAssetValueHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerNetAssetValueHistory proto.InternalMessageInfo
+
+// MarkerSendLimits defines the send limits for a marker
+type MarkerSendLimits struct {
+	// address defines the marker address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// default_limit is the limit that applies to accounts without their own limit
+	DefaultLimit *SendLimit `protobuf:"bytes,2,opt,name=default_limit,json=defaultLimit,proto3" json:"default_limit,omitempty"`
+	// address_limits are the account-specific limits
+	AddressLimits []AddressSendLimit `protobuf:"bytes,3,rep,name=address_limits,json=addressLimits,proto3" json:"address_limits"`
+}
+
+func (m *MarkerSendLimits) Reset()         { *m = MarkerSendLimits{} }
+func (m *MarkerSendLimits) String() string { return proto.CompactTextString(m) }
+func (*MarkerSendLimits) ProtoMessage()    {}
+func (*MarkerSendLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{4}
+}
+func (m *MarkerSendLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerSendLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerSendLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerSendLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerSendLimits.Merge(m, src)
+}
+func (m *MarkerSendLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerSendLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerSendLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerSendLimits proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
 	proto.RegisterType((*DenySendAddress)(nil), "provenance.marker.v1.DenySendAddress")
 	proto.RegisterType((*MarkerNetAssetValues)(nil), "provenance.marker.v1.MarkerNetAssetValues")
+	proto.RegisterType((*MarkerNetAssetValueHistory)(nil), "provenance.marker.v1.MarkerNetAssetValueHistory")
+	proto.RegisterType((*MarkerSendLimits)(nil), "provenance.marker.v1.MarkerSendLimits")
 }
 
 func init() {
@@ -161,33 +251,42 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0x86, 0x13, 0x15, 0x6d, 0x47, 0x6b, 0xdb, 0xa9, 0xd0, 0x20, 0x25, 0xfe, 0x14, 0x41, 0x0a,
-	0x4d, 0xd0, 0xee, 0xdc, 0x69, 0x0b, 0x5d, 0xb5, 0x88, 0x42, 0x17, 0x76, 0x11, 0xc6, 0xe4, 0x23,
-	0x0d, 0xad, 0x33, 0x21, 0x33, 0x86, 0x7a, 0x07, 0xdd, 0xb5, 0x97, 0xe0, 0xe5, 0xb8, 0x94, 0xae,
-	0xce, 0xea, 0x70, 0xd0, 0xcd, 0xb9, 0x8c, 0x83, 0x93, 0x04, 0xcd, 0x61, 0x70, 0x37, 0xf3, 0xf1,
-	0xbc, 0xcf, 0x3b, 0x99, 0x0c, 0xea, 0x86, 0x11, 0x8b, 0x81, 0x12, 0xea, 0x82, 0xbd, 0x22, 0xd1,
-	0x4f, 0x88, 0xec, 0x78, 0x60, 0xfb, 0x40, 0x81, 0x07, 0xdc, 0x0a, 0x23, 0x26, 0x18, 0x6e, 0x9c,
-	0x19, 0x2b, 0x61, 0xac, 0x78, 0xd0, 0x6c, 0xf8, 0xcc, 0x67, 0x12, 0xb0, 0x4f, 0xab, 0x84, 0x6d,
-	0x76, 0x94, 0xbe, 0x34, 0x25, 0x91, 0xee, 0xff, 0x02, 0xaa, 0x7d, 0x4e, 0x0a, 0xe6, 0x82, 0x08,
-	0xc0, 0x23, 0x54, 0x0e, 0x49, 0x44, 0x56, 0xdc, 0xd0, 0xdb, 0x7a, 0xbf, 0x3a, 0x7c, 0x63, 0xa9,
-	0x0a, 0xad, 0xa9, 0x64, 0x26, 0xa5, 0xdd, 0x6d, 0x4b, 0x9b, 0xa5, 0x09, 0xfc, 0x11, 0x55, 0x12,
-	0x82, 0x1b, 0x85, 0x76, 0xb1, 0x5f, 0x1d, 0xbe, 0x55, 0x87, 0xbf, 0xc8, 0xd5, 0xd8, 0x75, 0xd9,
-	0x9a, 0x8a, 0xd4, 0x91, 0x25, 0xf1, 0x02, 0xbd, 0xa0, 0x20, 0x1c, 0xc2, 0x39, 0x08, 0x27, 0x26,
-	0xbf, 0xd6, 0xc0, 0x8d, 0xa2, 0xb4, 0xbd, 0xbb, 0x66, 0xfb, 0x0a, 0x62, 0x7c, 0x8a, 0x7c, 0x93,
-	0x89, 0x54, 0x5a, 0xa7, 0xb9, 0x29, 0xfe, 0x8e, 0x5e, 0x79, 0x40, 0x37, 0x0e, 0x07, 0xea, 0x39,
-	0xc4, 0xf3, 0x22, 0xe0, 0x1c, 0xb8, 0x51, 0x92, 0xfa, 0x9e, 0x5a, 0xff, 0x09, 0xe8, 0x66, 0x0e,
-	0xd4, 0x1b, 0x27, 0x78, 0x6a, 0x7e, 0xe9, 0xe5, 0xc7, 0xc0, 0x47, 0x4f, 0xfe, 0x6c, 0x5b, 0xda,
-	0xfd, 0xb6, 0xa5, 0x75, 0x01, 0x3d, 0x7f, 0x94, 0xc2, 0x3d, 0x54, 0x4f, 0x94, 0x59, 0xad, 0xbc,
-	0xde, 0xa7, 0xb3, 0x67, 0xc9, 0x34, 0xc3, 0x3a, 0xa8, 0x26, 0x0f, 0x98, 0x41, 0x05, 0x09, 0x55,
-	0x4f, 0xb3, 0x14, 0xb9, 0xa8, 0xf9, 0xab, 0xa3, 0x86, 0xea, 0xe3, 0xb1, 0x81, 0x2a, 0xf9, 0x96,
-	0x6c, 0x8b, 0xe7, 0x8a, 0xcb, 0xbd, 0xfa, 0xab, 0x72, 0x66, 0xf5, 0xad, 0x9e, 0x4f, 0x34, 0xf1,
-	0x77, 0x07, 0x53, 0xdf, 0x1f, 0x4c, 0xfd, 0xee, 0x60, 0xea, 0xff, 0x8e, 0xa6, 0xb6, 0x3f, 0x9a,
-	0xda, 0xcd, 0xd1, 0xd4, 0xd0, 0xeb, 0x80, 0x29, 0x0b, 0xa6, 0xfa, 0x62, 0xe8, 0x07, 0xe2, 0xc7,
-	0x7a, 0x69, 0xb9, 0x6c, 0x65, 0x9f, 0x91, 0xf7, 0x01, 0xbb, 0xd8, 0xd9, 0xbf, 0xb3, 0x07, 0x2c,
-	0x36, 0x21, 0xf0, 0x65, 0x59, 0xbe, 0xde, 0x0f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d,
-	0x05, 0xd5, 0x32, 0x03, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xed, 0x36, 0x24, 0x30, 0xf9, 0x43, 0x59, 0x22, 0xd5, 0x8a, 0x90, 0xd3, 0x06, 0x8a,
+	0x2a, 0x24, 0x6c, 0x1a, 0x6e, 0xbd, 0xa5, 0x14, 0xc1, 0x81, 0x42, 0x95, 0x48, 0x1c, 0xca, 0x21,
+	0x72, 0xe3, 0x21, 0xb5, 0x48, 0x76, 0x23, 0xef, 0x26, 0x22, 0x6f, 0xc0, 0x8d, 0xf2, 0x06, 0x7d,
+	0x9c, 0x1e, 0xcb, 0x8d, 0x13, 0x42, 0xc9, 0x85, 0xc7, 0x40, 0xd9, 0x5d, 0xe7, 0x9f, 0x16, 0xab,
+	0xb7, 0xec, 0xe4, 0xfb, 0x7e, 0xf3, 0xed, 0xec, 0xc8, 0x50, 0x1b, 0xc4, 0x6c, 0x84, 0x34, 0xa0,
+	0x1d, 0xf4, 0xfb, 0x41, 0xfc, 0x05, 0x63, 0x7f, 0x74, 0xe0, 0x77, 0x91, 0x22, 0x8f, 0xb8, 0x37,
+	0x88, 0x99, 0x60, 0xa4, 0xbc, 0xd0, 0x78, 0x4a, 0xe3, 0x8d, 0x0e, 0x2a, 0xe5, 0x2e, 0xeb, 0x32,
+	0x29, 0xf0, 0x67, 0xbf, 0x94, 0xb6, 0xb2, 0x6b, 0xe4, 0x69, 0x97, 0x92, 0x3c, 0x31, 0x4a, 0x38,
+	0xd2, 0xb0, 0x17, 0xf5, 0x23, 0xa1, 0x54, 0xb5, 0xcb, 0x0c, 0x14, 0xde, 0xa8, 0x18, 0x2d, 0x11,
+	0x08, 0x24, 0x87, 0x90, 0x1d, 0x04, 0x71, 0xd0, 0xe7, 0x8e, 0xbd, 0x63, 0xef, 0xe7, 0xeb, 0x8f,
+	0x3c, 0x53, 0x2c, 0xef, 0x54, 0x6a, 0x8e, 0x32, 0xd7, 0xbf, 0xab, 0x56, 0x53, 0x3b, 0xc8, 0x2b,
+	0xc8, 0x29, 0x05, 0x77, 0x36, 0x76, 0x36, 0xf7, 0xf3, 0xf5, 0xc7, 0x66, 0xf3, 0x89, 0xfc, 0xd5,
+	0xe8, 0x74, 0xd8, 0x90, 0x0a, 0xcd, 0x48, 0x9c, 0xe4, 0x0c, 0xb6, 0x28, 0x8a, 0x76, 0xc0, 0x39,
+	0x8a, 0xf6, 0x28, 0xe8, 0x0d, 0x91, 0x3b, 0x9b, 0x92, 0xf6, 0x2c, 0x8d, 0xf6, 0x1e, 0x45, 0x63,
+	0x66, 0xf9, 0x28, 0x1d, 0x1a, 0x5a, 0xa2, 0x2b, 0x55, 0xf2, 0x09, 0x1e, 0x86, 0x48, 0xc7, 0xed,
+	0xd9, 0x14, 0xda, 0x41, 0x18, 0xc6, 0xc8, 0x39, 0x72, 0x27, 0x23, 0xf1, 0x7b, 0x66, 0xfc, 0x31,
+	0xd2, 0x71, 0x0b, 0x69, 0xd8, 0x50, 0x72, 0x4d, 0x7e, 0x10, 0xae, 0x96, 0x91, 0x93, 0x13, 0xc8,
+	0x4b, 0xae, 0x1c, 0x2f, 0x77, 0xee, 0x48, 0xe8, 0xd3, 0xb4, 0xcc, 0x33, 0xff, 0x3b, 0xa9, 0xd6,
+	0x54, 0xe0, 0xf3, 0x0a, 0xe9, 0xc3, 0xf6, 0xda, 0x1c, 0xda, 0x17, 0x11, 0x17, 0x2c, 0x1e, 0x3b,
+	0x59, 0x89, 0x7e, 0x71, 0xeb, 0x71, 0xbc, 0x55, 0x3e, 0xdd, 0xa4, 0x4c, 0x0d, 0xff, 0x1d, 0xde,
+	0xfd, 0x76, 0x55, 0xb5, 0xfe, 0x5e, 0x55, 0xad, 0x1a, 0xc2, 0xfd, 0xb5, 0x3b, 0x93, 0x3d, 0x28,
+	0xa9, 0x06, 0xc9, 0xd0, 0xe4, 0x72, 0xdc, 0x6b, 0x16, 0x55, 0x35, 0x91, 0xed, 0x42, 0x41, 0x8e,
+	0x37, 0x11, 0x6d, 0x48, 0x51, 0x7e, 0x56, 0xd3, 0x92, 0xa5, 0x36, 0xdf, 0x6d, 0x28, 0x9b, 0x9e,
+	0x8e, 0x38, 0x90, 0x5b, 0xed, 0x92, 0x1c, 0x49, 0xcb, 0xb0, 0x1a, 0xa9, 0x8b, 0xb6, 0x42, 0x36,
+	0xef, 0xc4, 0x52, 0xa2, 0x1f, 0x36, 0x54, 0xfe, 0x3f, 0xbd, 0x94, 0x5c, 0x1f, 0x20, 0x87, 0x54,
+	0xc4, 0xd1, 0x3c, 0x8e, 0x7f, 0x8b, 0x38, 0x1a, 0xfb, 0x9a, 0x8a, 0xf9, 0xcb, 0x24, 0x94, 0xa5,
+	0x4c, 0x3f, 0x6d, 0xd8, 0x5a, 0x5f, 0x96, 0x94, 0x24, 0xc7, 0x50, 0x0c, 0xf1, 0x73, 0x30, 0xec,
+	0x09, 0xb5, 0x86, 0xf2, 0x09, 0xf2, 0xf5, 0xaa, 0x39, 0xcf, 0x1c, 0xd9, 0x2c, 0x68, 0x97, 0x3c,
+	0x91, 0x16, 0x94, 0x34, 0x30, 0x59, 0xe6, 0xcd, 0xb4, 0x65, 0xd6, 0x6f, 0x3b, 0xa7, 0xe9, 0xdb,
+	0x14, 0x35, 0x43, 0x85, 0x5e, 0xdc, 0xe9, 0xa8, 0x7b, 0x3d, 0x71, 0xed, 0x9b, 0x89, 0x6b, 0xff,
+	0x99, 0xb8, 0xf6, 0xe5, 0xd4, 0xb5, 0x6e, 0xa6, 0xae, 0xf5, 0x6b, 0xea, 0x5a, 0xb0, 0x1d, 0x31,
+	0x63, 0x8b, 0x53, 0xfb, 0xac, 0xde, 0x8d, 0xc4, 0xc5, 0xf0, 0xdc, 0xeb, 0xb0, 0xbe, 0xbf, 0x90,
+	0x3c, 0x8f, 0xd8, 0xd2, 0xc9, 0xff, 0x9a, 0x7c, 0xe9, 0xc4, 0x78, 0x80, 0xfc, 0x3c, 0x2b, 0xbf,
+	0x71, 0x2f, 0xff, 0x0d, 0x00, 0x88, 0x5c, 0x17, 0x25, 0x7e, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +309,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NetAssetValueHistory) > 0 {
+		for iNdEx := len(m.NetAssetValueHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValueHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SendLimits) > 0 {
+		for iNdEx := len(m.SendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenySendAddresses) > 0 {
 		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -346,6 +473,106 @@ func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MarkerNetAssetValueHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerNetAssetValueHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerNetAssetValueHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerSendLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerSendLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerSendLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressLimits) > 0 {
+		for iNdEx := len(m.AddressLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DefaultLimit != nil {
+		{
+			size, err := m.DefaultLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -383,6 +610,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SendLimits) > 0 {
+		for _, e := range m.SendLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetAssetValueHistory) > 0 {
+		for _, e := range m.NetAssetValueHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -422,6 +661,48 @@ func (m *MarkerNetAssetValues) Size() (n int) {
 	return n
 }
 
+func (m *MarkerNetAssetValueHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarkerSendLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DefaultLimit != nil {
+		l = m.DefaultLimit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AddressLimits) > 0 {
+		for _, e := range m.AddressLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -592,6 +873,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendLimits = append(m.SendLimits, MarkerSendLimits{})
+			if err := m.SendLimits[len(m.SendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValueHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValueHistory = append(m.NetAssetValueHistory, MarkerNetAssetValueHistory{})
+			if err := m.NetAssetValueHistory[len(m.NetAssetValueHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -843,6 +1192,274 @@ func (m *MarkerNetAssetValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MarkerNetAssetValueHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerNetAssetValueHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerNetAssetValueHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, NetAssetValueHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerSendLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerSendLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerSendLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultLimit == nil {
+				m.DefaultLimit = &SendLimit{}
+			}
+			if err := m.DefaultLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressLimits = append(m.AddressLimits, AddressSendLimit{})
+			if err := m.AddressLimits[len(m.AddressLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
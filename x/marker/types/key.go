@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 
@@ -35,6 +37,30 @@ var (
 
 	// MarkerParamStoreKey key for marker module's params
 	MarkerParamStoreKey = []byte{0x05}
+
+	// FixedSupplyMarkerIndexPrefix prefix for the index of active markers with a fixed supply
+	FixedSupplyMarkerIndexPrefix = []byte{0x06}
+
+	// DestroyedMarkerIndexPrefix prefix for the index of markers waiting to be destroyed
+	DestroyedMarkerIndexPrefix = []byte{0x07}
+
+	// AccessGrantUsagePrefix prefix for the amounts used against capped access grants
+	AccessGrantUsagePrefix = []byte{0x08}
+
+	// AccessGrantExpirationIndexPrefix prefix for the index of access grants by expiration
+	AccessGrantExpirationIndexPrefix = []byte{0x09}
+
+	// SendLimitDefaultPrefix prefix for the default send limits of restricted markers
+	SendLimitDefaultPrefix = []byte{0x0A}
+
+	// SendLimitAddressPrefix prefix for the account-specific send limits of restricted markers
+	SendLimitAddressPrefix = []byte{0x0B}
+
+	// SendLimitUsagePrefix prefix for the recent sends of restricted markers that count towards send limits
+	SendLimitUsagePrefix = []byte{0x0C}
+
+	// NetAssetValueHistoryPrefix prefix for the retained net asset value history of markers
+	NetAssetValueHistoryPrefix = []byte{0x0D}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -59,6 +85,16 @@ func MarkerStoreKey(addr sdk.AccAddress) []byte {
 	return append(MarkerStoreKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
 
+// FixedSupplyMarkerIndexKey returns key [prefix][marker address] for the index of active fixed supply markers
+func FixedSupplyMarkerIndexKey(addr sdk.AccAddress) []byte {
+	return append(FixedSupplyMarkerIndexPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// DestroyedMarkerIndexKey returns key [prefix][marker address] for the index of markers waiting to be destroyed
+func DestroyedMarkerIndexKey(addr sdk.AccAddress) []byte {
+	return append(DestroyedMarkerIndexPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
 // SplitMarkerStoreKey returns an account address given a store key, uses the length prefix to determine length of AccAddress
 func SplitMarkerStoreKey(key []byte) sdk.AccAddress {
 	return sdk.AccAddress(key[2 : key[1]+2])
@@ -103,3 +139,92 @@ func GetMarkerFromNetAssetValueKey(key []byte) sdk.AccAddress {
 	markerAddr := sdk.AccAddress(key[2 : markerKeyLen+2])
 	return markerAddr
 }
+
+// NetAssetValueHistoryMarkerPrefix returns an extended prefix [prefix][marker address] for a marker's net asset value history
+func NetAssetValueHistoryMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(NetAssetValueHistoryPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// NetAssetValueHistoryKeyPrefix returns an extended prefix [prefix][marker address][price denom] for a marker's
+// net asset value history in a price denom
+func NetAssetValueHistoryKeyPrefix(markerAddr sdk.AccAddress, priceDenom string) []byte {
+	return append(NetAssetValueHistoryMarkerPrefix(markerAddr), address.MustLengthPrefix([]byte(priceDenom))...)
+}
+
+// NetAssetValueHistoryKey returns key [prefix][marker address][price denom][height] for a net asset value history entry.
+// The height is stored big-endian so that entries are iterated in the order they were set.
+func NetAssetValueHistoryKey(markerAddr sdk.AccAddress, priceDenom string, height uint64) []byte {
+	return binary.BigEndian.AppendUint64(NetAssetValueHistoryKeyPrefix(markerAddr, priceDenom), height)
+}
+
+// AccessGrantUsageMarkerPrefix returns an extended prefix [prefix][marker address] for the usage of a marker's access grants
+func AccessGrantUsageMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(AccessGrantUsagePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// AccessGrantUsageKey returns key [prefix][marker address][grantee address] for the amount used against an access grant
+func AccessGrantUsageKey(markerAddr, grantee sdk.AccAddress) []byte {
+	return append(AccessGrantUsageMarkerPrefix(markerAddr), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// GetAccessGrantUsageAddresses returns the marker and grantee addresses from an AccessGrantUsageKey
+func GetAccessGrantUsageAddresses(key []byte) (markerAddr sdk.AccAddress, grantee sdk.AccAddress) {
+	markerKeyLen := key[1]
+	granteeKeyLen := key[markerKeyLen+2]
+	markerAddr = sdk.AccAddress(key[2 : markerKeyLen+2])
+	grantee = sdk.AccAddress(key[markerKeyLen+3 : markerKeyLen+3+granteeKeyLen])
+	return
+}
+
+// AccessGrantExpirationIndexPrefixUpTo returns an extended prefix [prefix][expiration] that is after all
+// index entries with an expiration at or before the provided time (to the second).
+func AccessGrantExpirationIndexPrefixUpTo(expiration time.Time) []byte {
+	rv := make([]byte, 0, len(AccessGrantExpirationIndexPrefix)+8)
+	rv = append(rv, AccessGrantExpirationIndexPrefix...)
+	return binary.BigEndian.AppendUint64(rv, uint64(expiration.Unix()+1))
+}
+
+// AccessGrantExpirationIndexKey returns key [prefix][expiration][marker address][grantee address] for the
+// index of access grants by expiration. The expiration is stored as big-endian unix seconds.
+func AccessGrantExpirationIndexKey(expiration time.Time, markerAddr, grantee sdk.AccAddress) []byte {
+	rv := make([]byte, 0, len(AccessGrantExpirationIndexPrefix)+8+2+len(markerAddr)+len(grantee))
+	rv = append(rv, AccessGrantExpirationIndexPrefix...)
+	rv = binary.BigEndian.AppendUint64(rv, uint64(expiration.Unix()))
+	rv = append(rv, address.MustLengthPrefix(markerAddr.Bytes())...)
+	return append(rv, address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// GetAccessGrantExpirationIndexAddresses returns the marker and grantee addresses from an AccessGrantExpirationIndexKey
+func GetAccessGrantExpirationIndexAddresses(key []byte) (markerAddr sdk.AccAddress, grantee sdk.AccAddress) {
+	return GetAccessGrantUsageAddresses(key[8:])
+}
+
+// SendLimitDefaultKey returns key [prefix][marker address] for a marker's default send limit
+func SendLimitDefaultKey(markerAddr sdk.AccAddress) []byte {
+	return append(SendLimitDefaultPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SendLimitAddressMarkerPrefix returns an extended prefix [prefix][marker address] for a marker's account-specific send limits
+func SendLimitAddressMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(SendLimitAddressPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SendLimitAddressKey returns key [prefix][marker address][account address] for an account-specific send limit
+func SendLimitAddressKey(markerAddr, addr sdk.AccAddress) []byte {
+	return append(SendLimitAddressMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// SendLimitUsageMarkerPrefix returns an extended prefix [prefix][marker address] for the recent sends of a marker's denom
+func SendLimitUsageMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(SendLimitUsagePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SendLimitUsageKey returns key [prefix][marker address][account address] for an account's recent sends of a marker's denom
+func SendLimitUsageKey(markerAddr, addr sdk.AccAddress) []byte {
+	return append(SendLimitUsageMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetSendLimitAddresses returns the marker and account addresses from a SendLimitAddressKey or SendLimitUsageKey
+func GetSendLimitAddresses(key []byte) (markerAddr sdk.AccAddress, addr sdk.AccAddress) {
+	return GetAccessGrantUsageAddresses(key)
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -71,3 +73,38 @@ func TestDenySendMarkerPrefix(t *testing.T) {
 	assert.Equal(t, uint8(3), denyKey[0], "should have correct prefix for send deny")
 	assert.Equal(t, denyKey[2:], addr.Bytes(), "should have marker address in iterable prefix")
 }
+
+func TestMarkerIndexKeys(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+
+	fixedKey := FixedSupplyMarkerIndexKey(addr)
+	assert.Equal(t, uint8(6), fixedKey[0], "should have correct prefix for fixed supply marker index key")
+	assert.Equal(t, addr, SplitMarkerStoreKey(fixedKey), "should parse the marker address from the fixed supply marker index key")
+
+	destroyedKey := DestroyedMarkerIndexKey(addr)
+	assert.Equal(t, uint8(7), destroyedKey[0], "should have correct prefix for destroyed marker index key")
+	assert.Equal(t, addr, SplitMarkerStoreKey(destroyedKey), "should parse the marker address from the destroyed marker index key")
+}
+
+func TestAccessGrantKeys(t *testing.T) {
+	markerAddr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	grantee := sdk.AccAddress("grantee_address_____")
+
+	usageKey := AccessGrantUsageKey(markerAddr, grantee)
+	assert.Equal(t, uint8(8), usageKey[0], "should have correct prefix for access grant usage key")
+	assert.True(t, bytes.HasPrefix(usageKey, AccessGrantUsageMarkerPrefix(markerAddr)), "should start with the marker usage prefix")
+	actMarker, actGrantee := GetAccessGrantUsageAddresses(usageKey)
+	assert.Equal(t, markerAddr, actMarker, "marker address from access grant usage key")
+	assert.Equal(t, grantee, actGrantee, "grantee from access grant usage key")
+
+	exp := time.Unix(1700000000, 0)
+	expKey := AccessGrantExpirationIndexKey(exp, markerAddr, grantee)
+	assert.Equal(t, uint8(9), expKey[0], "should have correct prefix for access grant expiration index key")
+	actMarker, actGrantee = GetAccessGrantExpirationIndexAddresses(expKey)
+	assert.Equal(t, markerAddr, actMarker, "marker address from access grant expiration index key")
+	assert.Equal(t, grantee, actGrantee, "grantee from access grant expiration index key")
+	assert.Less(t, string(expKey), string(AccessGrantExpirationIndexPrefixUpTo(exp)), "key should be before the up-to prefix of its expiration")
+	assert.Greater(t, string(expKey), string(AccessGrantExpirationIndexPrefixUpTo(exp.Add(-1*time.Second))), "key should be after the up-to prefix of the second before its expiration")
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -46,8 +47,8 @@ type MarkerAccountI interface {
 
 	HasAccess(string, Access) bool
 	ValidateHasAccess(string, Access) error
-	AddressHasAccess(sdk.AccAddress, Access) bool
-	ValidateAddressHasAccess(sdk.AccAddress, Access) error
+	AddressHasAccess(sdk.AccAddress, Access, time.Time) bool
+	ValidateAddressHasAccess(sdk.AccAddress, Access, time.Time) error
 	AddressListForPermission(Access) []sdk.AccAddress
 
 	HasGovernanceEnabled() bool
@@ -150,20 +151,29 @@ func (ma *MarkerAccount) ValidateHasAccess(addr string, role Access) error {
 }
 
 // AddressHasAccess returns true if the provided address has been assigned the provided
-// role within the current MarkerAccount AccessControl
-func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access) bool {
-	return ma.HasAccess(addr.String(), role)
+// role within the current MarkerAccount AccessControl, and that grant has not expired as of the provided block time.
+func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access, blockTime time.Time) bool {
+	return ma.ValidateAddressHasAccess(addr, role, blockTime) == nil
 }
 
-// ValidateAddressHasAccess returns an error if the provided address does not have the given role in this marker.
-func (ma *MarkerAccount) ValidateAddressHasAccess(addr sdk.AccAddress, role Access) error {
-	return ma.ValidateHasAccess(addr.String(), role)
+// ValidateAddressHasAccess returns an error if the provided address does not have the given role in this marker,
+// or if the address's access grant has expired as of the provided block time.
+func (ma *MarkerAccount) ValidateAddressHasAccess(addr sdk.AccAddress, role Access, blockTime time.Time) error {
+	if err := ma.ValidateHasAccess(addr.String(), role); err != nil {
+		return err
+	}
+	for _, grant := range ma.AccessControl {
+		if grant.GetAddress().Equals(addr) && grant.IsExpired(blockTime) {
+			return fmt.Errorf("access grant for %s on %s marker expired at %s", addr, ma.GetDenom(), grant.Expiration.UTC())
+		}
+	}
+	return nil
 }
 
 // AtLeastOneAddrHasAccess returns true if one or more of the provided addrs has the given role on this marker.
-func AtLeastOneAddrHasAccess(ma MarkerAccountI, addrs []sdk.AccAddress, role Access) bool {
+func AtLeastOneAddrHasAccess(ma MarkerAccountI, addrs []sdk.AccAddress, role Access, blockTime time.Time) bool {
 	for _, addr := range addrs {
-		if ma.HasAccess(addr.String(), role) {
+		if ma.AddressHasAccess(addr, role, blockTime) {
 			return true
 		}
 	}
@@ -171,11 +181,11 @@ func AtLeastOneAddrHasAccess(ma MarkerAccountI, addrs []sdk.AccAddress, role Acc
 }
 
 // ValidateAtLeastOneAddrHasAccess returns an error if there isn't an entry in addrs that has the given role in this marker.
-func ValidateAtLeastOneAddrHasAccess(ma MarkerAccountI, addrs []sdk.AccAddress, role Access) error {
+func ValidateAtLeastOneAddrHasAccess(ma MarkerAccountI, addrs []sdk.AccAddress, role Access, blockTime time.Time) error {
 	if len(addrs) == 1 {
-		return ma.ValidateHasAccess(addrs[0].String(), role)
+		return ma.ValidateAddressHasAccess(addrs[0], role, blockTime)
 	}
-	if !AtLeastOneAddrHasAccess(ma, addrs, role) {
+	if !AtLeastOneAddrHasAccess(ma, addrs, role, blockTime) {
 		strs := make([]string, len(addrs))
 		for i, addr := range addrs {
 			strs[i] = addr.String()
@@ -398,10 +408,10 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 	if err := access.Validate(); err != nil {
 		return err
 	}
-	// Find any existing permissions and append specified permissions
+	// Find any existing permissions (and limits) and append specified permissions
 	for _, ac := range ma.AccessControl {
 		if ac.GetAddress().Equals(access.GetAddress()) {
-			if err := access.MergeAdd(*NewAccessGrant(ac.GetAddress(), ac.GetAccessList())); err != nil {
+			if err := access.MergeAdd(ac); err != nil {
 				return err
 			}
 		}
@@ -411,7 +421,11 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 		return err
 	}
 	// Append the new record
-	ma.AccessControl = append(ma.AccessControl, *NewAccessGrant(access.GetAddress(), access.GetAccessList()))
+	newGrant := NewAccessGrant(access.GetAddress(), access.GetAccessList())
+	if ag, ok := access.(*AccessGrant); ok {
+		newGrant.Expiration, newGrant.TotalCap, newGrant.MaxPerTx = ag.Expiration, ag.TotalCap, ag.MaxPerTx
+	}
+	ma.AccessControl = append(ma.AccessControl, *newGrant)
 	return nil
 }
 
@@ -515,3 +529,51 @@ func (mnav *NetAssetValue) Validate() error {
 
 	return nil
 }
+
+// PricePerUnit returns the price of one unit of the marker in this net asset value's price denom.
+func (mnav NetAssetValue) PricePerUnit() sdkmath.LegacyDec {
+	volume := mnav.Volume
+	if volume < 1 {
+		volume = 1
+	}
+	return sdkmath.LegacyNewDecFromInt(mnav.Price.Amount).QuoInt(sdkmath.NewIntFromUint64(volume))
+}
+
+// NetAssetValueTWAP returns the average price per unit of the provided history entries over the window from
+// startTime up to (but not including) endTime, with each entry weighted by the amount of time it was in effect for.
+// The entries must be in the order they were set. Each entry is in effect from its time until the next entry's.
+// The first time that an entry was in effect for is also returned.
+func NetAssetValueTWAP(entries []NetAssetValueHistoryEntry, startTime, endTime time.Time) (sdkmath.LegacyDec, time.Time, error) {
+	if !endTime.After(startTime) {
+		return sdkmath.LegacyDec{}, time.Time{}, fmt.Errorf("end time %s must be after start time %s",
+			endTime.UTC().Format(time.RFC3339), startTime.UTC().Format(time.RFC3339))
+	}
+
+	total := sdkmath.LegacyZeroDec()
+	var coveredStart time.Time
+	var covered time.Duration
+	for i, entry := range entries {
+		from := entry.Time
+		if from.Before(startTime) {
+			from = startTime
+		}
+		to := endTime
+		if i+1 < len(entries) && entries[i+1].Time.Before(endTime) {
+			to = entries[i+1].Time
+		}
+		if !to.After(from) {
+			continue
+		}
+		if covered == 0 {
+			coveredStart = from
+		}
+		total = total.Add(entry.NetAssetValue.PricePerUnit().MulInt64(int64(to.Sub(from))))
+		covered += to.Sub(from)
+	}
+
+	if covered == 0 {
+		return sdkmath.LegacyDec{}, time.Time{}, fmt.Errorf("no net asset value was in effect from %s to %s",
+			startTime.UTC().Format(time.RFC3339), endTime.UTC().Format(time.RFC3339))
+	}
+	return total.QuoInt64(int64(covered)), coveredStart, nil
+}
@@ -11,15 +11,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -111,6 +115,9 @@ type Params struct {
 	UnrestrictedDenomRegex string `protobuf:"bytes,3,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
 	// maximum amount of supply to allow a marker to be created with
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// maximum number of net asset value history entries to retain for each marker and price denom.
+	// Zero disables net asset value history.
+	MaxNavHistoryEntries uint32 `protobuf:"varint,5,opt,name=max_nav_history_entries,json=maxNavHistoryEntries,proto3" json:"max_nav_history_entries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -168,6 +175,13 @@ func (m *Params) GetUnrestrictedDenomRegex() string {
 	return ""
 }
 
+func (m *Params) GetMaxNavHistoryEntries() uint32 {
+	if m != nil {
+		return m.MaxNavHistoryEntries
+	}
+	return 0
+}
+
 // MarkerAccount holds the marker configuration information in addition to a base account structure.
 type MarkerAccount struct {
 	// base cosmos account information including address and coin holdings.
@@ -293,6 +307,70 @@ func (m *NetAssetValue) GetUpdatedBlockHeight() uint64 {
 	return 0
 }
 
+// NetAssetValueHistoryEntry is a net asset value that was set for a marker, along with where it came from.
+type NetAssetValueHistoryEntry struct {
+	// net_asset_value is the net asset value that was set, with the block height it was set at.
+	NetAssetValue NetAssetValue `protobuf:"bytes,1,opt,name=net_asset_value,json=netAssetValue,proto3" json:"net_asset_value"`
+	// source identifies what set the net asset value, e.g. an account address or an exchange market.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// time is the block time that the net asset value was set at.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *NetAssetValueHistoryEntry) Reset()         { *m = NetAssetValueHistoryEntry{} }
+func (m *NetAssetValueHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*NetAssetValueHistoryEntry) ProtoMessage()    {}
+func (*NetAssetValueHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{3}
+}
+func (m *NetAssetValueHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetAssetValueHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetAssetValueHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetAssetValueHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetAssetValueHistoryEntry.Merge(m, src)
+}
+func (m *NetAssetValueHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetAssetValueHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetAssetValueHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetAssetValueHistoryEntry proto.InternalMessageInfo
+
+func (m *NetAssetValueHistoryEntry) GetNetAssetValue() NetAssetValue {
+	if m != nil {
+		return m.NetAssetValue
+	}
+	return NetAssetValue{}
+}
+
+func (m *NetAssetValueHistoryEntry) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *NetAssetValueHistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // EventMarkerAdd event emitted when marker is added
 type EventMarkerAdd struct {
 	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -307,7 +385,7 @@ func (m *EventMarkerAdd) Reset()         { *m = EventMarkerAdd{} }
 func (m *EventMarkerAdd) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerAdd) ProtoMessage()    {}
 func (*EventMarkerAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{3}
+	return fileDescriptor_f7e2c25c71db7f99, []int{4}
 }
 func (m *EventMarkerAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +467,7 @@ func (m *EventMarkerAddAccess) Reset()         { *m = EventMarkerAddAccess{} }
 func (m *EventMarkerAddAccess) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerAddAccess) ProtoMessage()    {}
 func (*EventMarkerAddAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{4}
+	return fileDescriptor_f7e2c25c71db7f99, []int{5}
 }
 func (m *EventMarkerAddAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,7 +527,7 @@ func (m *EventMarkerAccess) Reset()         { *m = EventMarkerAccess{} }
 func (m *EventMarkerAccess) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerAccess) ProtoMessage()    {}
 func (*EventMarkerAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{5}
+	return fileDescriptor_f7e2c25c71db7f99, []int{6}
 }
 func (m *EventMarkerAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,7 +581,7 @@ func (m *EventMarkerDeleteAccess) Reset()         { *m = EventMarkerDeleteAccess
 func (m *EventMarkerDeleteAccess) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerDeleteAccess) ProtoMessage()    {}
 func (*EventMarkerDeleteAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{6}
+	return fileDescriptor_f7e2c25c71db7f99, []int{7}
 }
 func (m *EventMarkerDeleteAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +641,7 @@ func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
 func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerFinalize) ProtoMessage()    {}
 func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{7}
+	return fileDescriptor_f7e2c25c71db7f99, []int{8}
 }
 func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +694,7 @@ func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
 func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerActivate) ProtoMessage()    {}
 func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{8}
+	return fileDescriptor_f7e2c25c71db7f99, []int{9}
 }
 func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +747,7 @@ func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
 func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerCancel) ProtoMessage()    {}
 func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{9}
+	return fileDescriptor_f7e2c25c71db7f99, []int{10}
 }
 func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +800,7 @@ func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
 func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerDelete) ProtoMessage()    {}
 func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{10}
+	return fileDescriptor_f7e2c25c71db7f99, []int{11}
 }
 func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,7 +854,7 @@ func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
 func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerMint) ProtoMessage()    {}
 func (*EventMarkerMint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{11}
+	return fileDescriptor_f7e2c25c71db7f99, []int{12}
 }
 func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +915,7 @@ func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
 func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerBurn) ProtoMessage()    {}
 func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{12}
+	return fileDescriptor_f7e2c25c71db7f99, []int{13}
 }
 func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +977,7 @@ func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
 func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerWithdraw) ProtoMessage()    {}
 func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{13}
+	return fileDescriptor_f7e2c25c71db7f99, []int{14}
 }
 func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1047,7 @@ func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
 func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerTransfer) ProtoMessage()    {}
 func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{14}
+	return fileDescriptor_f7e2c25c71db7f99, []int{15}
 }
 func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1048,7 +1126,7 @@ func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenom
 func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
 func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{15}
+	return fileDescriptor_f7e2c25c71db7f99, []int{16}
 }
 func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1215,7 @@ func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
 func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
 func (*EventDenomUnit) ProtoMessage()    {}
 func (*EventDenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{16}
+	return fileDescriptor_f7e2c25c71db7f99, []int{17}
 }
 func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1277,7 @@ func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
 func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
 func (*EventSetNetAssetValue) ProtoMessage()    {}
 func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{17}
+	return fileDescriptor_f7e2c25c71db7f99, []int{18}
 }
 func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1261,13 +1339,14 @@ type EventMarkerParamsUpdated struct {
 	EnableGovernance       string `protobuf:"bytes,1,opt,name=enable_governance,json=enableGovernance,proto3" json:"enable_governance,omitempty"`
 	UnrestrictedDenomRegex string `protobuf:"bytes,2,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
 	MaxSupply              string `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	MaxNavHistoryEntries   string `protobuf:"bytes,4,opt,name=max_nav_history_entries,json=maxNavHistoryEntries,proto3" json:"max_nav_history_entries,omitempty"`
 }
 
 func (m *EventMarkerParamsUpdated) Reset()         { *m = EventMarkerParamsUpdated{} }
 func (m *EventMarkerParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerParamsUpdated) ProtoMessage()    {}
 func (*EventMarkerParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{18}
+	return fileDescriptor_f7e2c25c71db7f99, []int{19}
 }
 func (m *EventMarkerParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1317,12 +1396,73 @@ func (m *EventMarkerParamsUpdated) GetMaxSupply() string {
 	return ""
 }
 
+func (m *EventMarkerParamsUpdated) GetMaxNavHistoryEntries() string {
+	if m != nil {
+		return m.MaxNavHistoryEntries
+	}
+	return ""
+}
+
+// EventMarkerSendLimitsUpdated event emitted when a restricted marker's send limits are updated
+type EventMarkerSendLimitsUpdated struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventMarkerSendLimitsUpdated) Reset()         { *m = EventMarkerSendLimitsUpdated{} }
+func (m *EventMarkerSendLimitsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarkerSendLimitsUpdated) ProtoMessage()    {}
+func (*EventMarkerSendLimitsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{20}
+}
+func (m *EventMarkerSendLimitsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarkerSendLimitsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarkerSendLimitsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarkerSendLimitsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarkerSendLimitsUpdated.Merge(m, src)
+}
+func (m *EventMarkerSendLimitsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarkerSendLimitsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarkerSendLimitsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarkerSendLimitsUpdated proto.InternalMessageInfo
+
+func (m *EventMarkerSendLimitsUpdated) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMarkerSendLimitsUpdated) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("provenance.marker.v1.MarkerType", MarkerType_name, MarkerType_value)
 	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
 	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
 	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
 	proto.RegisterType((*NetAssetValue)(nil), "provenance.marker.v1.NetAssetValue")
+	proto.RegisterType((*NetAssetValueHistoryEntry)(nil), "provenance.marker.v1.NetAssetValueHistoryEntry")
 	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
 	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
 	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
@@ -1339,108 +1479,119 @@ func init() {
 	proto.RegisterType((*EventDenomUnit)(nil), "provenance.marker.v1.EventDenomUnit")
 	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.marker.v1.EventSetNetAssetValue")
 	proto.RegisterType((*EventMarkerParamsUpdated)(nil), "provenance.marker.v1.EventMarkerParamsUpdated")
+	proto.RegisterType((*EventMarkerSendLimitsUpdated)(nil), "provenance.marker.v1.EventMarkerSendLimitsUpdated")
 }
 
 func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
 
 var fileDescriptor_f7e2c25c71db7f99 = []byte{
-	// 1535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xbd, 0x6f, 0x1b, 0x47,
-	0x16, 0xe7, 0x52, 0x14, 0x25, 0x0d, 0x25, 0x9a, 0x1e, 0xd1, 0x32, 0xcd, 0x83, 0x29, 0x9a, 0xe7,
-	0x3b, 0xeb, 0x7c, 0x67, 0xd2, 0xd2, 0xc1, 0x40, 0x60, 0xa4, 0xe1, 0x97, 0x1c, 0x22, 0xd6, 0x47,
-	0x96, 0x94, 0x03, 0x1b, 0x01, 0x16, 0xc3, 0xdd, 0x11, 0xb5, 0xd0, 0xee, 0x0e, 0xb3, 0x33, 0xa4,
-	0xa5, 0x20, 0xb5, 0x61, 0xa8, 0x72, 0x99, 0x14, 0x02, 0x04, 0x24, 0x45, 0x80, 0xb4, 0xa9, 0x53,
-	0x1b, 0xa9, 0x5c, 0x06, 0x29, 0x8c, 0xc4, 0x6e, 0x52, 0x04, 0xf9, 0x1b, 0x82, 0xf9, 0xe0, 0x72,
-	0xd7, 0xa2, 0xed, 0x04, 0x8a, 0x3b, 0xbe, 0xcf, 0xf9, 0xbd, 0xb7, 0xbf, 0x99, 0xf7, 0x08, 0xae,
-	0xf4, 0x7d, 0x32, 0xc4, 0x1e, 0xf2, 0x4c, 0x5c, 0x71, 0x91, 0xbf, 0x8f, 0xfd, 0xca, 0x70, 0x55,
-	0xfd, 0x2a, 0xf7, 0x7d, 0xc2, 0x08, 0xcc, 0x8e, 0x5d, 0xca, 0xca, 0x30, 0x5c, 0xcd, 0x67, 0x7b,
-	0xa4, 0x47, 0x84, 0x43, 0x85, 0xff, 0x92, 0xbe, 0xf9, 0x82, 0x49, 0xa8, 0x4b, 0x68, 0x05, 0x0d,
-	0xd8, 0x5e, 0x65, 0xb8, 0xda, 0xc5, 0x0c, 0xad, 0x0a, 0x41, 0xd9, 0x2f, 0x49, 0xbb, 0x21, 0x03,
-	0xa5, 0xf0, 0x4a, 0x68, 0x17, 0x51, 0x1c, 0x84, 0x9a, 0xc4, 0xf6, 0x94, 0xfd, 0xdf, 0x13, 0x91,
-	0x22, 0xd3, 0xc4, 0x94, 0xf6, 0x7c, 0xe4, 0x31, 0xe9, 0x57, 0xfa, 0x45, 0x03, 0xc9, 0x6d, 0xe4,
-	0x23, 0x97, 0xc2, 0xff, 0x81, 0x8c, 0x8b, 0x0e, 0x0c, 0x46, 0x18, 0x72, 0x0c, 0x3a, 0xe8, 0xf7,
-	0x9d, 0xc3, 0x9c, 0x56, 0xd4, 0x56, 0x12, 0xb5, 0x78, 0x4e, 0xd3, 0xd3, 0x2e, 0x3a, 0xe8, 0x70,
-	0x53, 0x5b, 0x58, 0xe0, 0x7f, 0xc1, 0x79, 0xec, 0xa1, 0xae, 0x83, 0x8d, 0x1e, 0x19, 0x62, 0x5f,
-	0x9c, 0x94, 0x8b, 0x17, 0xb5, 0x95, 0x59, 0x3d, 0x23, 0x0d, 0x77, 0x02, 0x3d, 0x7c, 0x0f, 0xe4,
-	0x06, 0x9e, 0x8f, 0x29, 0xf3, 0x6d, 0x93, 0x61, 0xcb, 0xb0, 0xb0, 0x47, 0x5c, 0xc3, 0xc7, 0x3d,
-	0x7c, 0x90, 0x9b, 0x2a, 0x6a, 0x2b, 0x73, 0xfa, 0x52, 0xd8, 0xde, 0xe0, 0x66, 0x9d, 0x5b, 0xe1,
-	0xfb, 0x00, 0x70, 0x50, 0x0a, 0x4e, 0x82, 0xfb, 0xd6, 0x2e, 0x3f, 0x7d, 0xbe, 0x1c, 0xfb, 0xe9,
-	0xf9, 0xf2, 0x05, 0xd9, 0x03, 0x6a, 0xed, 0x97, 0x6d, 0x52, 0x71, 0x11, 0xdb, 0x2b, 0xb7, 0x3c,
-	0xa6, 0xcf, 0xb9, 0xe8, 0x40, 0x82, 0xbc, 0x9d, 0xf8, 0xf5, 0x64, 0x59, 0x2b, 0xfd, 0x9e, 0x00,
-	0x0b, 0x1b, 0xa2, 0x07, 0x55, 0xd3, 0x24, 0x03, 0x8f, 0xc1, 0x16, 0x98, 0xe7, 0x8d, 0x33, 0x90,
-	0x94, 0x45, 0x99, 0xa9, 0xb5, 0x62, 0x59, 0xb5, 0x58, 0x7c, 0x02, 0xd5, 0xd4, 0x72, 0x0d, 0x51,
-	0xac, 0xe2, 0x6a, 0x89, 0x67, 0xcf, 0x97, 0x35, 0x3d, 0xd5, 0x1d, 0xab, 0x60, 0x0e, 0xcc, 0xb8,
-	0xc8, 0x43, 0x3d, 0xec, 0x8b, 0xea, 0xe7, 0xf4, 0x91, 0x08, 0x37, 0x41, 0x5a, 0xf6, 0xdb, 0x30,
-	0x89, 0xc7, 0x7c, 0xe2, 0xe4, 0xa6, 0x8a, 0x53, 0x2b, 0xa9, 0xb5, 0x2b, 0xe5, 0x49, 0x14, 0x29,
-	0x57, 0x85, 0xef, 0x1d, 0xfe, 0x6d, 0x6a, 0x09, 0x5e, 0xa1, 0xbe, 0x20, 0xc3, 0xeb, 0x32, 0x1a,
-	0xde, 0x06, 0x49, 0xca, 0x10, 0x1b, 0x50, 0xd1, 0x86, 0xf4, 0x5a, 0x69, 0x72, 0x1e, 0x59, 0x69,
-	0x5b, 0x78, 0xea, 0x2a, 0x02, 0x66, 0xc1, 0xb4, 0xe8, 0x79, 0x6e, 0x5a, 0x60, 0x94, 0x02, 0xbc,
-	0x05, 0x92, 0xaa, 0xb1, 0xc9, 0x3f, 0xd3, 0x58, 0xe5, 0x0c, 0xab, 0x20, 0x25, 0x8f, 0x33, 0xd8,
-	0x61, 0x1f, 0xe7, 0x66, 0x04, 0x9a, 0xe2, 0x9b, 0xd0, 0x74, 0x0e, 0xfb, 0x58, 0x07, 0x6e, 0xf0,
-	0x1b, 0x5e, 0x01, 0xf3, 0x32, 0x99, 0xb1, 0x6b, 0x1f, 0x60, 0x2b, 0x37, 0x2b, 0x88, 0x93, 0x92,
-	0xba, 0x75, 0xae, 0xe2, 0x9c, 0x41, 0x8e, 0x43, 0x1e, 0x86, 0xf8, 0x15, 0x34, 0x72, 0x4e, 0xb8,
-	0x2f, 0x09, 0xfb, 0x98, 0x66, 0xa3, 0x46, 0xad, 0x81, 0x0b, 0x32, 0x72, 0x97, 0xf8, 0x26, 0xb6,
-	0x0c, 0xe6, 0x23, 0x8f, 0xee, 0x62, 0x3f, 0x07, 0x44, 0xd8, 0xa2, 0x30, 0xae, 0x0b, 0x5b, 0x47,
-	0x99, 0x60, 0x05, 0x2c, 0xfa, 0xf8, 0xd3, 0x81, 0xed, 0x63, 0xcb, 0x40, 0x8c, 0xf9, 0x76, 0x77,
-	0xc0, 0x30, 0xcd, 0xa5, 0x8a, 0x53, 0x2b, 0x73, 0x3a, 0x1c, 0x99, 0xaa, 0x81, 0xe5, 0x76, 0xfe,
-	0xf1, 0xc9, 0x72, 0xec, 0x8b, 0x93, 0xe5, 0xd8, 0x0f, 0xdf, 0xdd, 0x48, 0x47, 0xd8, 0xd5, 0x2a,
-	0x3d, 0xd1, 0xc0, 0xc2, 0x26, 0x66, 0x55, 0x4a, 0x31, 0xbb, 0x87, 0x9c, 0x01, 0x86, 0xb7, 0xc0,
-	0x74, 0xdf, 0xb7, 0x4d, 0xac, 0x98, 0x76, 0x69, 0xc4, 0x34, 0xce, 0xa4, 0x80, 0x69, 0x75, 0x62,
-	0x7b, 0xea, 0xd3, 0x4b, 0x6f, 0xb8, 0x04, 0x92, 0x43, 0xe2, 0x0c, 0x5c, 0x79, 0xb3, 0x12, 0xba,
-	0x92, 0xe0, 0x4d, 0x90, 0x1d, 0xf4, 0x2d, 0xc4, 0xaf, 0x52, 0xd7, 0x21, 0xe6, 0xbe, 0xb1, 0x87,
-	0xed, 0xde, 0x1e, 0x13, 0x77, 0x29, 0xa1, 0x43, 0x65, 0xab, 0x71, 0xd3, 0x07, 0xc2, 0x52, 0xfa,
-	0x56, 0x03, 0xe9, 0xe6, 0x10, 0x7b, 0x4c, 0x41, 0xb5, 0xac, 0x31, 0x27, 0xb4, 0x30, 0x27, 0x96,
-	0x40, 0x12, 0xb9, 0xe2, 0x52, 0x48, 0x3a, 0x2b, 0x89, 0xeb, 0x15, 0xfb, 0xe4, 0x85, 0x1d, 0x31,
-	0x2b, 0xc4, 0xff, 0x44, 0x94, 0xff, 0xcb, 0x51, 0x9a, 0x48, 0xe6, 0x85, 0x49, 0x90, 0x03, 0x33,
-	0xc8, 0xb2, 0x7c, 0x4c, 0xa9, 0xe4, 0x9f, 0x3e, 0x12, 0x4b, 0x5f, 0x6a, 0x20, 0x1b, 0x45, 0x2b,
-	0x6f, 0x07, 0x6c, 0x82, 0xa4, 0xbc, 0x14, 0xaa, 0x91, 0xd7, 0x26, 0xb3, 0x2e, 0x1c, 0x2b, 0xdc,
-	0x55, 0x5b, 0x55, 0xf0, 0xb8, 0xf4, 0x78, 0xb8, 0xf4, 0xab, 0x60, 0x01, 0x59, 0xae, 0xed, 0xd9,
-	0x94, 0xf9, 0x88, 0x11, 0x5f, 0x55, 0x1a, 0x55, 0x96, 0xb6, 0xc0, 0xf9, 0x53, 0xe9, 0xc3, 0xa5,
-	0x68, 0x91, 0x52, 0x60, 0x11, 0xa4, 0xfa, 0xd8, 0x77, 0x6d, 0x4a, 0x6d, 0xe2, 0xd1, 0x5c, 0x5c,
-	0x10, 0x2a, 0xac, 0x2a, 0x7d, 0x0e, 0x2e, 0x86, 0x12, 0x36, 0xb0, 0x83, 0x19, 0x56, 0x69, 0xff,
-	0x05, 0xd2, 0x3e, 0x76, 0xc9, 0x10, 0x1b, 0xd1, 0xec, 0x0b, 0x52, 0x5b, 0x55, 0x67, 0x9c, 0xa5,
-	0x9c, 0x8f, 0xc0, 0x62, 0xe8, 0xf4, 0x75, 0xdb, 0x43, 0x8e, 0xfd, 0x19, 0x7e, 0x0d, 0x39, 0x4e,
-	0xa5, 0x8c, 0xbf, 0x3d, 0x65, 0xd5, 0x64, 0xf6, 0x10, 0xb1, 0xb3, 0xa5, 0x8c, 0x36, 0xbd, 0xce,
-	0x3f, 0xb7, 0xf3, 0x37, 0x26, 0x94, 0x4d, 0x3f, 0x53, 0x42, 0x0c, 0xce, 0x85, 0x12, 0x6e, 0xd8,
-	0xf2, 0xca, 0xa8, 0xab, 0xa4, 0x45, 0xae, 0xd2, 0x59, 0x3e, 0x57, 0xf4, 0x98, 0xda, 0xc0, 0xf7,
-	0xde, 0xc9, 0x31, 0x8f, 0xb4, 0xc8, 0x37, 0xfc, 0xd8, 0x66, 0x7b, 0x96, 0x8f, 0x1e, 0xf2, 0x9c,
-	0x7c, 0xc9, 0x18, 0xf1, 0x50, 0x0a, 0x67, 0x39, 0x09, 0x5e, 0x06, 0x80, 0x91, 0x80, 0xde, 0xf2,
-	0x09, 0x99, 0x63, 0x44, 0x51, 0x9b, 0xbf, 0x5b, 0x61, 0x20, 0xc1, 0x7b, 0xfd, 0x0e, 0x8a, 0x7e,
-	0x0b, 0x14, 0x3e, 0xb3, 0x76, 0x7d, 0xe2, 0x06, 0x0e, 0xf2, 0x41, 0x4b, 0x71, 0xdd, 0x08, 0xed,
-	0x6f, 0x71, 0xf0, 0x8f, 0x10, 0xda, 0x36, 0x66, 0x62, 0x95, 0xd9, 0xc0, 0x0c, 0x59, 0x88, 0x21,
-	0xf8, 0x4f, 0xb0, 0xe0, 0xaa, 0xdf, 0x06, 0x7f, 0xfa, 0x15, 0xf8, 0xf9, 0x91, 0x92, 0xef, 0x1a,
-	0x70, 0x15, 0x64, 0x03, 0x27, 0x0b, 0x53, 0xd3, 0xb7, 0xfb, 0xcc, 0x26, 0x9e, 0xaa, 0x68, 0x71,
-	0x64, 0x6b, 0x8c, 0x4d, 0xf0, 0x3f, 0x20, 0x33, 0x0e, 0xb1, 0x69, 0xdf, 0x41, 0x87, 0xaa, 0xc4,
-	0x73, 0x81, 0xbb, 0x54, 0xc3, 0x7b, 0x91, 0xec, 0x7c, 0x0d, 0x1b, 0x78, 0x36, 0xe3, 0xe5, 0xf2,
-	0xdd, 0xe4, 0xea, 0x1b, 0xde, 0x53, 0x51, 0xca, 0x8e, 0x67, 0x33, 0x1d, 0x8e, 0x31, 0x28, 0x15,
-	0x3d, 0xdd, 0xe2, 0xe9, 0x49, 0x2d, 0x0e, 0x37, 0xc0, 0x43, 0x2e, 0x56, 0x0f, 0x7f, 0xd0, 0x80,
-	0x4d, 0xe4, 0x62, 0x78, 0x0d, 0x04, 0xa8, 0x0d, 0x7a, 0xe8, 0x76, 0x89, 0x23, 0x76, 0x8c, 0x39,
-	0x3d, 0x3d, 0x52, 0xb7, 0x85, 0xb6, 0xf4, 0x89, 0x9a, 0x69, 0x01, 0x8c, 0xd7, 0xdc, 0xe0, 0x3c,
-	0x98, 0xc5, 0x07, 0x7d, 0xe2, 0xe1, 0x60, 0xaa, 0x05, 0xb2, 0x78, 0xb9, 0x1d, 0x1b, 0x51, 0x4c,
-	0xc5, 0x7a, 0xc6, 0x5f, 0x6e, 0x29, 0x96, 0x28, 0xb8, 0x20, 0xb2, 0xb7, 0x31, 0x8b, 0x0e, 0xf3,
-	0xc9, 0x87, 0x64, 0x47, 0x23, 0x5e, 0x31, 0xef, 0xd5, 0x09, 0xae, 0xc6, 0xa6, 0x9a, 0xe0, 0x7c,
-	0x9c, 0x92, 0x81, 0x6f, 0x62, 0xc5, 0x33, 0x25, 0x95, 0x4e, 0x34, 0x90, 0x0b, 0x31, 0x48, 0xae,
-	0xe6, 0x3b, 0x72, 0x9e, 0x4f, 0xde, 0xb9, 0x25, 0x88, 0xbf, 0xb6, 0x73, 0xc7, 0xdf, 0xb8, 0x73,
-	0x5f, 0x8e, 0xec, 0xdc, 0x12, 0xf7, 0x78, 0xa9, 0xbe, 0xfe, 0x48, 0x03, 0x60, 0xbc, 0xd6, 0xc1,
-	0x15, 0x70, 0x71, 0xa3, 0xaa, 0x7f, 0xd8, 0xd4, 0x8d, 0xce, 0xfd, 0xed, 0xa6, 0xb1, 0xb3, 0xd9,
-	0xde, 0x6e, 0xd6, 0x5b, 0xeb, 0xad, 0x66, 0x23, 0x13, 0xcb, 0xa7, 0x8e, 0x8e, 0x8b, 0x33, 0x3b,
-	0xde, 0xbe, 0x47, 0x1e, 0x7a, 0xb0, 0x00, 0x32, 0x61, 0xcf, 0xfa, 0x56, 0x6b, 0x33, 0xa3, 0xe5,
-	0x67, 0x8f, 0x8e, 0x8b, 0x09, 0xbe, 0xfa, 0xc0, 0x32, 0x58, 0x0a, 0xdb, 0xf5, 0x66, 0xbb, 0xa3,
-	0xb7, 0xea, 0x9d, 0x66, 0x23, 0x13, 0xcf, 0xc3, 0xa3, 0xe3, 0x62, 0x5a, 0x0f, 0xd0, 0x72, 0xff,
-	0xeb, 0xdf, 0xc7, 0xc1, 0x7c, 0x78, 0xdb, 0x85, 0x6b, 0xe0, 0x92, 0x4a, 0xd0, 0xee, 0x54, 0x3b,
-	0x3b, 0xed, 0x57, 0xc0, 0x2c, 0x1e, 0x1d, 0x17, 0xcf, 0x49, 0xd7, 0x1d, 0xcf, 0xc2, 0xbb, 0xb6,
-	0x87, 0xad, 0xd0, 0xa1, 0x2a, 0x66, 0x5b, 0xdf, 0xda, 0xde, 0x6a, 0x37, 0x1b, 0x19, 0x4d, 0x1e,
-	0x2a, 0x03, 0xb6, 0x7d, 0xd2, 0x27, 0x14, 0x5b, 0xf0, 0x66, 0x50, 0xae, 0xf2, 0x5f, 0x6f, 0x6d,
-	0x56, 0xef, 0xb6, 0x1e, 0x08, 0x94, 0xa1, 0x13, 0x46, 0x93, 0xd4, 0x82, 0xd7, 0x41, 0x36, 0x1a,
-	0x51, 0xad, 0x77, 0x5a, 0xf7, 0x9a, 0x99, 0xa9, 0x7c, 0xe6, 0xe8, 0xb8, 0x38, 0x2f, 0xdd, 0xc5,
-	0x94, 0xc4, 0xa7, 0xb3, 0xd7, 0xab, 0x9b, 0xf5, 0xe6, 0xdd, 0xbb, 0xcd, 0x46, 0x26, 0x11, 0xce,
-	0x2e, 0x27, 0xa0, 0x33, 0x09, 0x4f, 0x83, 0xb7, 0x6d, 0xeb, 0x7e, 0xb3, 0x91, 0x99, 0x0e, 0x47,
-	0x34, 0x78, 0xef, 0xc8, 0x21, 0xb6, 0xf2, 0xb3, 0x8f, 0xbf, 0x2a, 0xc4, 0xbe, 0xf9, 0xba, 0x10,
-	0xab, 0xf5, 0x9e, 0xbe, 0x28, 0x68, 0xcf, 0x5e, 0x14, 0xb4, 0x9f, 0x5f, 0x14, 0xb4, 0x27, 0x2f,
-	0x0b, 0xb1, 0x67, 0x2f, 0x0b, 0xb1, 0x1f, 0x5f, 0x16, 0x62, 0xe0, 0xa2, 0x4d, 0x26, 0xbe, 0x04,
-	0xdb, 0xda, 0x83, 0xb5, 0x9e, 0xcd, 0xf6, 0x06, 0xdd, 0xb2, 0x49, 0xdc, 0xca, 0xd8, 0xe5, 0x86,
-	0x4d, 0x42, 0x52, 0xe5, 0x60, 0xf4, 0xa7, 0x93, 0xaf, 0x7e, 0xb4, 0x9b, 0x14, 0x7f, 0x36, 0xff,
-	0xff, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xbf, 0x1f, 0xda, 0x40, 0x0f, 0x00, 0x00,
+	// 1687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0x52, 0x14, 0x2d, 0x0e, 0x25, 0x99, 0x19, 0xd1, 0x12, 0xcd, 0xd6, 0x24, 0xcd, 0xa4,
+	0x8d, 0xea, 0x36, 0x64, 0xa4, 0xc2, 0x40, 0x60, 0xf4, 0xc2, 0x2f, 0x25, 0x44, 0x6d, 0x49, 0x59,
+	0x52, 0x2e, 0x62, 0x14, 0x58, 0x0c, 0xb9, 0x23, 0x6a, 0x60, 0xee, 0x0c, 0xbb, 0x33, 0xa4, 0xa5,
+	0xa2, 0xe7, 0x20, 0xd0, 0xc9, 0xc7, 0xf4, 0x20, 0xc0, 0x40, 0x7b, 0x28, 0x90, 0x6b, 0xcf, 0x45,
+	0x8f, 0x41, 0x4f, 0xbe, 0xb5, 0xe8, 0xc1, 0x2d, 0xec, 0x4b, 0x0f, 0x45, 0xff, 0x86, 0x62, 0x3e,
+	0x76, 0xb9, 0x6b, 0x31, 0x4e, 0x0a, 0xd5, 0x37, 0xbe, 0xf7, 0x7b, 0xef, 0xcd, 0xfb, 0x9c, 0x79,
+	0x4b, 0x70, 0x7b, 0xe2, 0xb3, 0x19, 0xa6, 0x88, 0x0e, 0x71, 0xdd, 0x43, 0xfe, 0x63, 0xec, 0xd7,
+	0x67, 0x3b, 0xe6, 0x57, 0x6d, 0xe2, 0x33, 0xc1, 0x60, 0x7e, 0x2e, 0x52, 0x33, 0xc0, 0x6c, 0xa7,
+	0x98, 0x1f, 0xb1, 0x11, 0x53, 0x02, 0x75, 0xf9, 0x4b, 0xcb, 0x16, 0xcb, 0x23, 0xc6, 0x46, 0x63,
+	0x5c, 0x57, 0xd4, 0x60, 0x7a, 0x5c, 0x17, 0xc4, 0xc3, 0x5c, 0x20, 0x6f, 0x62, 0x04, 0x4a, 0x43,
+	0xc6, 0x3d, 0xc6, 0xeb, 0x68, 0x2a, 0x4e, 0xea, 0xb3, 0x9d, 0x01, 0x16, 0x68, 0x47, 0x11, 0x06,
+	0xbf, 0xa9, 0x71, 0x47, 0x5b, 0xd6, 0xc4, 0x6b, 0xaa, 0x03, 0xc4, 0x71, 0xa8, 0x3a, 0x64, 0x84,
+	0x1a, 0xfc, 0x87, 0x0b, 0x43, 0x41, 0xc3, 0x21, 0xe6, 0x7c, 0xe4, 0x23, 0x2a, 0xb4, 0x5c, 0xf5,
+	0xcb, 0x24, 0x48, 0x1f, 0x22, 0x1f, 0x79, 0x1c, 0xfe, 0x04, 0xe4, 0x3c, 0x74, 0xea, 0x08, 0x26,
+	0xd0, 0xd8, 0xe1, 0xd3, 0xc9, 0x64, 0x7c, 0x56, 0xb0, 0x2a, 0xd6, 0x76, 0xaa, 0x99, 0x2c, 0x58,
+	0xf6, 0xba, 0x87, 0x4e, 0xfb, 0x12, 0xea, 0x29, 0x04, 0xfe, 0x18, 0xbc, 0x83, 0x29, 0x1a, 0x8c,
+	0xb1, 0x33, 0x62, 0x33, 0xec, 0xab, 0x93, 0x0a, 0xc9, 0x8a, 0xb5, 0xbd, 0x62, 0xe7, 0x34, 0xf0,
+	0x71, 0xc8, 0x87, 0x1f, 0x81, 0xc2, 0x94, 0xfa, 0x98, 0x0b, 0x9f, 0x0c, 0x05, 0x76, 0x1d, 0x17,
+	0x53, 0xe6, 0x39, 0x3e, 0x1e, 0xe1, 0xd3, 0xc2, 0x52, 0xc5, 0xda, 0xce, 0xd8, 0x9b, 0x51, 0xbc,
+	0x2d, 0x61, 0x5b, 0xa2, 0xf0, 0x67, 0x00, 0x48, 0xa7, 0x8c, 0x3b, 0x29, 0x29, 0xdb, 0xbc, 0xf5,
+	0xf5, 0x8b, 0x72, 0xe2, 0xef, 0x2f, 0xca, 0x37, 0x74, 0x0e, 0xb8, 0xfb, 0xb8, 0x46, 0x58, 0xdd,
+	0x43, 0xe2, 0xa4, 0xd6, 0xa5, 0xc2, 0xce, 0x78, 0xe8, 0xd4, 0x38, 0x79, 0x17, 0x6c, 0x49, 0x6d,
+	0x8a, 0x66, 0xce, 0x09, 0xe1, 0x82, 0xf9, 0x67, 0x0e, 0xa6, 0xc2, 0x27, 0x98, 0x17, 0x96, 0x2b,
+	0xd6, 0xf6, 0x9a, 0x9d, 0xf7, 0xd0, 0xe9, 0x3e, 0x9a, 0x7d, 0xa2, 0xc1, 0x8e, 0xc6, 0xee, 0xa5,
+	0xfe, 0xf5, 0xac, 0x6c, 0x55, 0xff, 0x93, 0x02, 0x6b, 0x0f, 0x54, 0xea, 0x1a, 0xc3, 0x21, 0x9b,
+	0x52, 0x01, 0xbb, 0x60, 0x55, 0xe6, 0xdb, 0x41, 0x9a, 0x56, 0xd9, 0xc9, 0xee, 0x56, 0x6a, 0xa6,
+	0x32, 0xaa, 0x72, 0xa6, 0x16, 0xb5, 0x26, 0xe2, 0xd8, 0xe8, 0x35, 0x53, 0xcf, 0x5f, 0x94, 0x2d,
+	0x3b, 0x3b, 0x98, 0xb3, 0x60, 0x01, 0x5c, 0xf3, 0x10, 0x45, 0x23, 0xec, 0xab, 0xa4, 0x65, 0xec,
+	0x80, 0x84, 0xfb, 0x60, 0x5d, 0x97, 0xc9, 0x19, 0x32, 0x2a, 0x7c, 0x36, 0x2e, 0x2c, 0x55, 0x96,
+	0xb6, 0xb3, 0xbb, 0xb7, 0x6b, 0x8b, 0x5a, 0xaf, 0xd6, 0x50, 0xb2, 0x1f, 0xcb, 0x92, 0x36, 0x53,
+	0x32, 0x31, 0xf6, 0x9a, 0x56, 0x6f, 0x69, 0x6d, 0x78, 0x0f, 0xa4, 0xb9, 0x40, 0x62, 0xca, 0x55,
+	0xf6, 0xd6, 0x77, 0xab, 0x8b, 0xed, 0xe8, 0x48, 0x7b, 0x4a, 0xd2, 0x36, 0x1a, 0x30, 0x0f, 0x96,
+	0x55, 0xa9, 0x54, 0xb6, 0x32, 0xb6, 0x26, 0xe0, 0x5d, 0x90, 0x36, 0xf5, 0x48, 0x7f, 0x97, 0x7a,
+	0x18, 0x61, 0xd8, 0x00, 0x59, 0x7d, 0x9c, 0x23, 0xce, 0x26, 0xb8, 0x70, 0x4d, 0x79, 0x53, 0x79,
+	0x93, 0x37, 0xfd, 0xb3, 0x09, 0xb6, 0x81, 0x17, 0xfe, 0x86, 0xb7, 0xc1, 0xaa, 0x36, 0xe6, 0x1c,
+	0x93, 0x53, 0xec, 0x16, 0x56, 0x54, 0xbf, 0x65, 0x35, 0x6f, 0x4f, 0xb2, 0x64, 0xab, 0xa1, 0xf1,
+	0x98, 0x3d, 0x89, 0xb4, 0x65, 0x98, 0xc8, 0x8c, 0x12, 0xdf, 0x54, 0xf8, 0xbc, 0x3b, 0x83, 0x44,
+	0xed, 0x82, 0x1b, 0x5a, 0xf3, 0x98, 0xf9, 0x43, 0xec, 0x3a, 0xc2, 0x47, 0x94, 0x1f, 0x63, 0xbf,
+	0x00, 0x94, 0xda, 0x86, 0x02, 0xf7, 0x14, 0xd6, 0x37, 0x10, 0xac, 0x83, 0x0d, 0x1f, 0xff, 0x6a,
+	0x4a, 0x7c, 0xec, 0x3a, 0x48, 0x08, 0x9f, 0x0c, 0xa6, 0x02, 0xf3, 0x42, 0xb6, 0xb2, 0xb4, 0x9d,
+	0xb1, 0x61, 0x00, 0x35, 0x42, 0xe4, 0x5e, 0xf1, 0x8b, 0x67, 0xe5, 0xc4, 0x97, 0xcf, 0xca, 0x89,
+	0xbf, 0xfc, 0xf1, 0x83, 0xf5, 0x58, 0x77, 0x75, 0xab, 0x4f, 0x2d, 0xb0, 0xb6, 0x8f, 0x45, 0x83,
+	0x73, 0x2c, 0x1e, 0xa2, 0xf1, 0x14, 0xc3, 0xbb, 0x60, 0x79, 0xe2, 0x93, 0x21, 0x36, 0x9d, 0x76,
+	0x33, 0xe8, 0x34, 0xd9, 0x49, 0x61, 0xa7, 0xb5, 0x18, 0xa1, 0xa6, 0xf4, 0x5a, 0x1a, 0x6e, 0x82,
+	0xf4, 0x8c, 0x8d, 0xa7, 0x9e, 0x1e, 0xc8, 0x94, 0x6d, 0x28, 0xf8, 0x21, 0xc8, 0x4f, 0x27, 0x2e,
+	0x92, 0x13, 0x38, 0x18, 0xb3, 0xe1, 0x63, 0xe7, 0x04, 0x93, 0xd1, 0x89, 0x50, 0x23, 0x98, 0xb2,
+	0xa1, 0xc1, 0x9a, 0x12, 0xfa, 0x44, 0x21, 0xd5, 0x3f, 0x5b, 0xe0, 0x66, 0xcc, 0xa5, 0xc8, 0xa4,
+	0x9c, 0xc1, 0x4f, 0xc1, 0x75, 0x8a, 0x85, 0x83, 0x24, 0xea, 0xcc, 0x24, 0x6c, 0x1c, 0x7d, 0x77,
+	0x71, 0x55, 0x63, 0x96, 0x82, 0x6e, 0xa5, 0xb1, 0x88, 0x37, 0x41, 0x9a, 0xb3, 0xa9, 0x6f, 0xee,
+	0x92, 0x8c, 0x6d, 0x28, 0xf8, 0x11, 0x48, 0xc9, 0xdb, 0x53, 0xb9, 0x9a, 0xdd, 0x2d, 0xd6, 0xf4,
+	0xd5, 0x5a, 0x0b, 0xae, 0xd6, 0x5a, 0x3f, 0xb8, 0x5a, 0x9b, 0x2b, 0xd2, 0xec, 0xd3, 0x7f, 0x94,
+	0x2d, 0x5b, 0x69, 0x54, 0xbf, 0xb2, 0xc0, 0x7a, 0x67, 0x86, 0xa9, 0x30, 0xd9, 0x76, 0xdd, 0x79,
+	0x5b, 0x5b, 0xd1, 0xb6, 0xde, 0x04, 0x69, 0xe4, 0xa9, 0xb9, 0x36, 0x47, 0x6b, 0x4a, 0xb9, 0xa4,
+	0x07, 0x68, 0xc9, 0xb8, 0xa4, 0xa8, 0xe8, 0x08, 0xa7, 0xe2, 0x23, 0x5c, 0x8e, 0x77, 0xba, 0x1e,
+	0x9e, 0x68, 0x1f, 0x17, 0xc0, 0x35, 0xe4, 0xba, 0x3e, 0xe6, 0x5c, 0x8f, 0x90, 0x1d, 0x90, 0xd5,
+	0xdf, 0x5a, 0x20, 0x1f, 0xf7, 0x56, 0x0f, 0x38, 0xec, 0x80, 0xb4, 0x9e, 0x6b, 0x93, 0xe2, 0xf7,
+	0x17, 0xa7, 0x38, 0xaa, 0xab, 0xc4, 0x4d, 0x9a, 0x8d, 0xf2, 0x3c, 0xf4, 0x64, 0x34, 0xf4, 0xf7,
+	0xc0, 0x1a, 0x72, 0x3d, 0x42, 0x09, 0x17, 0x3e, 0x12, 0xcc, 0x37, 0x91, 0xc6, 0x99, 0xd5, 0x03,
+	0xf0, 0xce, 0x25, 0xf3, 0xd1, 0x50, 0xac, 0x58, 0x28, 0xb0, 0x02, 0xb2, 0x13, 0xec, 0x7b, 0x84,
+	0x73, 0xc2, 0x28, 0x2f, 0x24, 0xd5, 0x4c, 0x44, 0x59, 0xd5, 0xdf, 0x80, 0xad, 0x88, 0xc1, 0x36,
+	0x1e, 0x63, 0x81, 0x8d, 0xd9, 0x1f, 0x80, 0x75, 0x1f, 0x7b, 0x6c, 0x86, 0x9d, 0xb8, 0xf5, 0x35,
+	0xcd, 0x6d, 0x98, 0x33, 0xae, 0x12, 0xce, 0xa7, 0x60, 0x23, 0x72, 0xfa, 0x1e, 0xa1, 0x68, 0x4c,
+	0x7e, 0x8d, 0xbf, 0xa1, 0x39, 0x2e, 0x99, 0x4c, 0x7e, 0xbb, 0xc9, 0xc6, 0x50, 0x90, 0x19, 0x12,
+	0x57, 0x33, 0x19, 0x4f, 0x7a, 0x4b, 0x96, 0x7b, 0xfc, 0x7f, 0x34, 0xa8, 0x93, 0x7e, 0x25, 0x83,
+	0x18, 0x5c, 0x8f, 0x18, 0x7c, 0x40, 0xf4, 0xc8, 0x98, 0x51, 0xb2, 0x62, 0xa3, 0x74, 0x95, 0x72,
+	0xc5, 0x8f, 0x69, 0x4e, 0x7d, 0xfa, 0x56, 0x8e, 0xf9, 0xdc, 0x8a, 0xd5, 0xf0, 0x17, 0x44, 0x9c,
+	0xb8, 0x3e, 0x7a, 0x22, 0x6d, 0xca, 0xf5, 0x2a, 0xe8, 0x43, 0x4d, 0x5c, 0xe5, 0x24, 0x78, 0x0b,
+	0x00, 0xc1, 0xc2, 0xf6, 0xd6, 0x57, 0x48, 0x46, 0x30, 0xd3, 0xda, 0xd5, 0xaf, 0xe2, 0x8e, 0x84,
+	0x4f, 0xce, 0x5b, 0x08, 0xfa, 0x5b, 0x5c, 0x91, 0xcf, 0xee, 0xb1, 0xcf, 0xbc, 0x50, 0x40, 0x5f,
+	0x68, 0x59, 0xc9, 0x0b, 0xbc, 0xfd, 0x77, 0x12, 0x7c, 0x2f, 0xe2, 0x6d, 0x0f, 0x0b, 0xb5, 0xc4,
+	0x3d, 0xc0, 0x02, 0xb9, 0x48, 0x20, 0xf8, 0x2e, 0x58, 0xf3, 0xcc, 0x6f, 0x47, 0xbe, 0x5e, 0xc6,
+	0xf9, 0xd5, 0x80, 0x29, 0xd7, 0x25, 0xb8, 0x03, 0xf2, 0xa1, 0x90, 0x8b, 0xf9, 0xd0, 0x27, 0x13,
+	0x41, 0x18, 0x35, 0x11, 0x6d, 0x04, 0x58, 0x7b, 0x0e, 0xc1, 0x1f, 0x81, 0xdc, 0x5c, 0x85, 0xf0,
+	0xc9, 0x18, 0x9d, 0x99, 0x10, 0xaf, 0x87, 0xe2, 0x9a, 0x0d, 0x1f, 0xc6, 0xac, 0xcb, 0x05, 0x74,
+	0x4a, 0x89, 0x90, 0xe1, 0xca, 0xf5, 0xea, 0xbd, 0x37, 0xdc, 0xa7, 0x2a, 0x94, 0x23, 0x4a, 0x84,
+	0x0d, 0xe7, 0x3e, 0x18, 0x16, 0xbf, 0x9c, 0xe2, 0xe5, 0x45, 0x29, 0x8e, 0x26, 0x80, 0x22, 0x0f,
+	0x17, 0xd2, 0xf1, 0x04, 0xec, 0x23, 0x0f, 0xc3, 0xf7, 0x41, 0xe8, 0xb5, 0xc3, 0xcf, 0xbc, 0x01,
+	0x1b, 0xab, 0x35, 0x29, 0x63, 0xaf, 0x07, 0xec, 0x9e, 0xe2, 0x56, 0x7f, 0x69, 0xde, 0xb4, 0xd0,
+	0x8d, 0x6f, 0x98, 0xe0, 0x22, 0x58, 0xc1, 0xa7, 0x13, 0x46, 0x71, 0xf8, 0xaa, 0x85, 0xb4, 0xba,
+	0xb9, 0xc7, 0x04, 0x71, 0xcc, 0xd5, 0x86, 0x99, 0xb1, 0x03, 0xb2, 0xca, 0xc1, 0x0d, 0x65, 0xbd,
+	0x87, 0x45, 0x7c, 0x1f, 0x59, 0x7c, 0x48, 0x3e, 0xd8, 0x52, 0x4c, 0xe7, 0xbd, 0xbe, 0x84, 0x98,
+	0x67, 0x53, 0x53, 0x91, 0x17, 0x3e, 0x15, 0x7d, 0xe1, 0xab, 0x7f, 0xb5, 0x40, 0x21, 0xd2, 0x41,
+	0xfa, 0xa3, 0xe4, 0x48, 0xaf, 0x24, 0x8b, 0xbf, 0x36, 0xb4, 0x13, 0xff, 0xdb, 0xd7, 0x46, 0xf2,
+	0x8d, 0x5f, 0x1b, 0xb7, 0x62, 0x5f, 0x1b, 0xda, 0xef, 0xef, 0xf6, 0x39, 0xa1, 0x63, 0x59, 0xf8,
+	0x39, 0x51, 0x7d, 0x04, 0xbe, 0x1f, 0x1b, 0x0d, 0xea, 0xde, 0x27, 0x1e, 0x11, 0x61, 0x70, 0x57,
+	0xb8, 0x7c, 0xef, 0x7c, 0x6e, 0x01, 0x30, 0x5f, 0x96, 0xe1, 0x36, 0xd8, 0x7a, 0xd0, 0xb0, 0x7f,
+	0xde, 0xb1, 0x9d, 0xfe, 0x67, 0x87, 0x1d, 0xe7, 0x68, 0xbf, 0x77, 0xd8, 0x69, 0x75, 0xf7, 0xba,
+	0x9d, 0x76, 0x2e, 0x51, 0xcc, 0x9e, 0x5f, 0x54, 0xae, 0x1d, 0xd1, 0xc7, 0x94, 0x3d, 0xa1, 0xb0,
+	0x04, 0x72, 0x51, 0xc9, 0xd6, 0x41, 0x77, 0x3f, 0x67, 0x15, 0x57, 0xce, 0x2f, 0x2a, 0x29, 0xb9,
+	0x50, 0xc2, 0x1a, 0xd8, 0x8c, 0xe2, 0x76, 0xa7, 0xd7, 0xb7, 0xbb, 0xad, 0x7e, 0xa7, 0x9d, 0x4b,
+	0x16, 0xe1, 0xf9, 0x45, 0x65, 0xdd, 0x0e, 0x13, 0x28, 0xe5, 0xef, 0xfc, 0x29, 0x09, 0x56, 0xa3,
+	0xdf, 0x10, 0x70, 0x17, 0xdc, 0x34, 0x06, 0x7a, 0xfd, 0x46, 0xff, 0xa8, 0xf7, 0x9a, 0x33, 0x1b,
+	0xe7, 0x17, 0x95, 0xeb, 0x5a, 0xf4, 0x88, 0xba, 0xf8, 0x98, 0x50, 0xec, 0x46, 0x0e, 0x35, 0x3a,
+	0x87, 0xf6, 0xc1, 0xe1, 0x41, 0xaf, 0xd3, 0xce, 0x59, 0xfa, 0x50, 0xad, 0x70, 0xe8, 0xb3, 0x09,
+	0xe3, 0xd8, 0x85, 0x1f, 0x82, 0xad, 0xb8, 0xfc, 0x5e, 0x77, 0xbf, 0x71, 0xbf, 0xfb, 0x48, 0x79,
+	0x19, 0x39, 0x21, 0x78, 0xdc, 0x5d, 0x78, 0x07, 0xe4, 0xe3, 0x1a, 0x8d, 0x56, 0xbf, 0xfb, 0xb0,
+	0x93, 0x5b, 0x2a, 0xe6, 0xce, 0x2f, 0x2a, 0xab, 0x5a, 0x5c, 0x3d, 0xdc, 0xf8, 0xb2, 0xf5, 0x56,
+	0x63, 0xbf, 0xd5, 0xb9, 0x7f, 0xbf, 0xd3, 0xce, 0xa5, 0xa2, 0xd6, 0xf5, 0xa3, 0x3c, 0x5e, 0xe4,
+	0x4f, 0x5b, 0xa6, 0xed, 0xe0, 0xb3, 0x4e, 0x3b, 0xb7, 0x1c, 0xd5, 0x68, 0xcb, 0xdc, 0xb1, 0x33,
+	0xec, 0x16, 0x57, 0xbe, 0xf8, 0x5d, 0x29, 0xf1, 0x87, 0xdf, 0x97, 0x12, 0xcd, 0xd1, 0xd7, 0x2f,
+	0x4b, 0xd6, 0xf3, 0x97, 0x25, 0xeb, 0x9f, 0x2f, 0x4b, 0xd6, 0xd3, 0x57, 0xa5, 0xc4, 0xf3, 0x57,
+	0xa5, 0xc4, 0xdf, 0x5e, 0x95, 0x12, 0x60, 0x8b, 0xb0, 0x85, 0x97, 0xd3, 0xa1, 0xf5, 0x68, 0x77,
+	0x44, 0xc4, 0xc9, 0x74, 0x50, 0x1b, 0x32, 0xaf, 0x3e, 0x17, 0xf9, 0x80, 0xb0, 0x08, 0x55, 0x3f,
+	0x0d, 0xfe, 0x01, 0x90, 0xdb, 0x28, 0x1f, 0xa4, 0xd5, 0xd2, 0xfc, 0xd3, 0xff, 0x0e, 0x00, 0xd7,
+	0x84, 0x9f, 0xf6, 0xee, 0x10, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1474,6 +1625,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MaxSupply.Equal(that1.MaxSupply) {
 		return false
 	}
+	if this.MaxNavHistoryEntries != that1.MaxNavHistoryEntries {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1496,6 +1650,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxNavHistoryEntries != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.MaxNavHistoryEntries))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -1696,6 +1855,54 @@ func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NetAssetValueHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAssetValueHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAssetValueHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMarker(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.NetAssetValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2432,6 +2639,13 @@ func (m *EventMarkerParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxNavHistoryEntries) > 0 {
+		i -= len(m.MaxNavHistoryEntries)
+		copy(dAtA[i:], m.MaxNavHistoryEntries)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.MaxNavHistoryEntries)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.MaxSupply) > 0 {
 		i -= len(m.MaxSupply)
 		copy(dAtA[i:], m.MaxSupply)
@@ -2456,6 +2670,43 @@ func (m *EventMarkerParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventMarkerSendLimitsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarkerSendLimitsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarkerSendLimitsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMarker(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMarker(v)
 	base := offset
@@ -2485,6 +2736,9 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaxSupply.Size()
 	n += 1 + l + sovMarker(uint64(l))
+	if m.MaxNavHistoryEntries != 0 {
+		n += 1 + sovMarker(uint64(m.MaxNavHistoryEntries))
+	}
 	return n
 }
 
@@ -2555,6 +2809,23 @@ func (m *NetAssetValue) Size() (n int) {
 	return n
 }
 
+func (m *NetAssetValueHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAssetValue.Size()
+	n += 1 + l + sovMarker(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMarker(uint64(l))
+	return n
+}
+
 func (m *EventMarkerAdd) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2916,6 +3187,27 @@ func (m *EventMarkerParamsUpdated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarker(uint64(l))
 	}
+	l = len(m.MaxNavHistoryEntries)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkerSendLimitsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
 	return n
 }
 
@@ -3059,6 +3351,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNavHistoryEntries", wireType)
+			}
+			m.MaxNavHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNavHistoryEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -3549,7 +3860,7 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+func (m *NetAssetValueHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3572,17 +3883,17 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetAssetValueHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetAssetValueHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarker
@@ -3592,24 +3903,172 @@ func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if err := m.NetAssetValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
@@ -6059,6 +6518,152 @@ func (m *EventMarkerParamsUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.MaxSupply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNavHistoryEntries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxNavHistoryEntries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkerSendLimitsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkerSendLimitsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkerSendLimitsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,17 +42,17 @@ func TestNewEmptyMarkerValidate(t *testing.T) {
 	require.EqualValues(t, "proposed", m.GetStatus().String())
 	require.True(t, m.HasGovernanceEnabled())
 	require.True(t, m.HasFixedSupply())
-	require.True(t, m.AddressHasAccess(creatorAddr, Access_Mint), "creator was assigned mint permission")
-	require.False(t, m.AddressHasAccess(creatorAddr, Access_Burn), "creator was not assigned burn permission")
+	require.True(t, m.AddressHasAccess(creatorAddr, Access_Mint, time.Time{}), "creator was assigned mint permission")
+	require.False(t, m.AddressHasAccess(creatorAddr, Access_Burn, time.Time{}), "creator was not assigned burn permission")
 	require.ElementsMatch(t, m.AddressListForPermission(Access_Mint), []sdk.AccAddress{creatorAddr})
 
 	require.NoError(t, m.GrantAccess(NewAccessGrant(creatorAddr, []Access{Access_Burn})))
-	require.True(t, m.AddressHasAccess(creatorAddr, Access_Mint), "creator still has mint permission")
-	require.True(t, m.AddressHasAccess(creatorAddr, Access_Burn), "creator also has burn permission")
+	require.True(t, m.AddressHasAccess(creatorAddr, Access_Mint, time.Time{}), "creator still has mint permission")
+	require.True(t, m.AddressHasAccess(creatorAddr, Access_Burn, time.Time{}), "creator also has burn permission")
 
 	require.Error(t, m.RevokeAccess(sdk.AccAddress([]byte{})), "can't revoke for an empty/invalid address")
 	require.NoError(t, m.RevokeAccess(creatorAddr))
-	require.False(t, m.AddressHasAccess(creatorAddr, Access_Burn), "creator permissions were revoked")
+	require.False(t, m.AddressHasAccess(creatorAddr, Access_Burn, time.Time{}), "creator permissions were revoked")
 	require.NoError(t,
 		m.GrantAccess(NewAccessGrant(creatorAddr, []Access{Access_Mint, Access_Admin})), "permissions restored")
 
@@ -469,6 +470,119 @@ func TestNetAssetValueValidate(t *testing.T) {
 	}
 }
 
+func TestNetAssetValueTWAP(t *testing.T) {
+	baseTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(sec int64) time.Time {
+		return baseTime.Add(time.Duration(sec) * time.Second)
+	}
+	entry := func(amount int64, volume, height uint64, sec int64) NetAssetValueHistoryEntry {
+		return NetAssetValueHistoryEntry{
+			NetAssetValue: NetAssetValue{
+				Price:              sdk.NewInt64Coin("usd", amount),
+				Volume:             volume,
+				UpdatedBlockHeight: height,
+			},
+			Source: "test",
+			Time:   at(sec),
+		}
+	}
+	// The blocks aren't evenly spaced, so the second entry is in effect for 3 times as long as the first.
+	entries := []NetAssetValueHistoryEntry{
+		entry(10, 1, 10, 100),
+		entry(40, 2, 20, 200),
+		entry(5, 0, 30, 500),
+	}
+
+	tests := []struct {
+		name     string
+		entries  []NetAssetValueHistoryEntry
+		start    time.Time
+		end      time.Time
+		expAvg   string
+		expStart time.Time
+		expErr   string
+	}{
+		{
+			name:   "end before start",
+			start:  at(200),
+			end:    at(100),
+			expErr: "end time 2024-01-01T00:01:40Z must be after start time 2024-01-01T00:03:20Z",
+		},
+		{
+			name:   "end equals start",
+			start:  at(200),
+			end:    at(200),
+			expErr: "end time 2024-01-01T00:03:20Z must be after start time 2024-01-01T00:03:20Z",
+		},
+		{
+			name:   "no entries",
+			start:  at(100),
+			end:    at(1000),
+			expErr: "no net asset value was in effect from 2024-01-01T00:01:40Z to 2024-01-01T00:16:40Z",
+		},
+		{
+			name:    "range before first entry",
+			entries: entries,
+			start:   at(10),
+			end:     at(100),
+			expErr:  "no net asset value was in effect from 2024-01-01T00:00:10Z to 2024-01-01T00:01:40Z",
+		},
+		{
+			name:     "range within one entry",
+			entries:  entries,
+			start:    at(120),
+			end:      at(180),
+			expAvg:   "10.000000000000000000",
+			expStart: at(120),
+		},
+		{
+			name:     "range across two entries",
+			entries:  entries,
+			start:    at(150),
+			end:      at(250),
+			expAvg:   "15.000000000000000000",
+			expStart: at(150),
+		},
+		{
+			name:     "range starts before first entry",
+			entries:  entries,
+			start:    at(10),
+			end:      at(500),
+			expAvg:   "17.500000000000000000",
+			expStart: at(100),
+		},
+		{
+			name:     "range across all entries with zero volume",
+			entries:  entries,
+			start:    at(100),
+			end:      at(600),
+			expAvg:   "15.000000000000000000",
+			expStart: at(100),
+		},
+		{
+			name:     "range after last entry",
+			entries:  entries,
+			start:    at(600),
+			end:      at(700),
+			expAvg:   "5.000000000000000000",
+			expStart: at(600),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			avg, start, err := NetAssetValueTWAP(tc.entries, tc.start, tc.end)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "NetAssetValueTWAP error")
+				return
+			}
+			require.NoError(t, err, "NetAssetValueTWAP error")
+			assert.Equal(t, tc.expAvg, avg.String(), "NetAssetValueTWAP average")
+			assert.Equal(t, tc.expStart, start, "NetAssetValueTWAP start time")
+		})
+	}
+}
+
 func TestHasAccess(t *testing.T) {
 	addrAll := sdk.AccAddress("addrAll_____________")
 	addrAllButWithdraw := sdk.AccAddress("addrAllButWithdraw__")
@@ -500,6 +614,8 @@ func TestHasAccess(t *testing.T) {
 		},
 	}
 
+	blockTime := time.Now()
+
 	tests := []struct {
 		name   string
 		addr   sdk.AccAddress
@@ -555,7 +671,7 @@ func TestHasAccess(t *testing.T) {
 		t.Run(tc.name+": AddressHasAccess", func(t *testing.T) {
 			var actual bool
 			testFunc := func() {
-				actual = marker.AddressHasAccess(tc.addr, tc.role)
+				actual = marker.AddressHasAccess(tc.addr, tc.role, blockTime)
 			}
 			require.NotPanics(t, testFunc, "AddressHasAccess(%s, %s)", string(tc.addr), tc.role)
 			assert.Equal(t, tc.expHas, actual, "AddressHasAccess(%s, %s) result", string(tc.addr), tc.role)
@@ -564,7 +680,7 @@ func TestHasAccess(t *testing.T) {
 		t.Run(tc.name+": ValidateAddressHasAccess", func(t *testing.T) {
 			var err error
 			testFunc := func() {
-				err = marker.ValidateAddressHasAccess(tc.addr, tc.role)
+				err = marker.ValidateAddressHasAccess(tc.addr, tc.role, blockTime)
 			}
 			require.NotPanics(t, testFunc, "ValidateAddressHasAccess(%s, %s)", string(tc.addr), tc.role)
 			assertions.AssertErrorValue(t, err, expErr, "ValidateAddressHasAccess(%s, %s) error", string(tc.addr), tc.role)
@@ -572,10 +688,82 @@ func TestHasAccess(t *testing.T) {
 	}
 }
 
+func TestAddressHasAccessExpiredGrant(t *testing.T) {
+	addrExpiring := sdk.AccAddress("addrExpiring________")
+	addrForever := sdk.AccAddress("addrForever_________")
+	expiration := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	expiringGrant := NewAccessGrant(addrExpiring, []Access{Access_Admin, Access_Deposit})
+	expiringGrant.Expiration = &expiration
+	marker := MarkerAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(MustGetMarkerAddress("expiringcoin")),
+		Denom:       "expiringcoin",
+		AccessControl: []AccessGrant{
+			*expiringGrant,
+			*NewAccessGrant(addrForever, []Access{Access_Admin}),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		addr      sdk.AccAddress
+		role      Access
+		blockTime time.Time
+		expErr    string
+	}{
+		{
+			name:      "before expiration",
+			addr:      addrExpiring,
+			role:      Access_Admin,
+			blockTime: expiration.Add(-1 * time.Second),
+		},
+		{
+			name:      "at expiration",
+			addr:      addrExpiring,
+			role:      Access_Admin,
+			blockTime: expiration,
+			expErr:    "access grant for " + addrExpiring.String() + " on expiringcoin marker expired at 2024-05-01 12:00:00 +0000 UTC",
+		},
+		{
+			name:      "after expiration",
+			addr:      addrExpiring,
+			role:      Access_Deposit,
+			blockTime: expiration.Add(time.Hour),
+			expErr:    "access grant for " + addrExpiring.String() + " on expiringcoin marker expired at 2024-05-01 12:00:00 +0000 UTC",
+		},
+		{
+			name:      "after expiration: role not granted",
+			addr:      addrExpiring,
+			role:      Access_Mint,
+			blockTime: expiration.Add(time.Hour),
+			expErr:    fmt.Sprintf("%s does not have %s on expiringcoin marker (%s)", addrExpiring, Access_Mint, marker.Address),
+		},
+		{
+			name:      "grant without expiration",
+			addr:      addrForever,
+			role:      Access_Admin,
+			blockTime: expiration.Add(time.Hour),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := marker.ValidateAddressHasAccess(tc.addr, tc.role, tc.blockTime)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateAddressHasAccess error")
+			has := marker.AddressHasAccess(tc.addr, tc.role, tc.blockTime)
+			assert.Equal(t, len(tc.expErr) == 0, has, "AddressHasAccess result")
+			// HasAccess doesn't know the block time, so it doesn't consider expiration.
+			assert.Equal(t, tc.role != Access_Mint, marker.HasAccess(tc.addr.String(), tc.role), "HasAccess result")
+		})
+	}
+}
+
 func TestValidateAtLeastOneAddrHasAccess(t *testing.T) {
 	addr1 := sdk.AccAddress("1_addr______________")
 	addr2 := sdk.AccAddress("2_addr______________")
 	addr3 := sdk.AccAddress("3_addr______________")
+	addr4 := sdk.AccAddress("4_addr______________")
+	blockTime := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	expiration := blockTime.Add(-1 * time.Second)
 
 	denom := "moomoo"
 	ma := &MarkerAccount{
@@ -585,6 +773,7 @@ func TestValidateAtLeastOneAddrHasAccess(t *testing.T) {
 			{Address: addr1.String(), Permissions: AccessList{Access_Mint, Access_Burn}},
 			{Address: addr2.String(), Permissions: AccessList{Access_Deposit, Access_Withdraw}},
 			{Address: addr3.String(), Permissions: AccessList{Access_Transfer, Access_Admin, Access_Delete}},
+			{Address: addr4.String(), Permissions: AccessList{Access_Withdraw}, Expiration: &expiration},
 		},
 	}
 	markerDesc := denom + " marker (" + ma.BaseAccount.Address + ")"
@@ -635,6 +824,13 @@ func TestValidateAtLeastOneAddrHasAccess(t *testing.T) {
 			role:  Access_Mint,
 			exp:   "",
 		},
+		{
+			name:  "one addr: expired grant",
+			addrs: []sdk.AccAddress{addr4},
+			role:  Access_Withdraw,
+			exp: "access grant for " + addr4.String() + " on " + denom + " marker expired at " +
+				expiration.String(),
+		},
 		{
 			name:  "three addrs: no match",
 			addrs: []sdk.AccAddress{addrOther1, addrOther2, addrOther3},
@@ -642,6 +838,19 @@ func TestValidateAtLeastOneAddrHasAccess(t *testing.T) {
 			exp: "none of [\"" + addrOther1.String() + "\" \"" + addrOther2.String() + "\" \"" +
 				addrOther3.String() + "\"] have permission ACCESS_WITHDRAW on " + markerDesc,
 		},
+		{
+			name:  "three addrs: only match is expired",
+			addrs: []sdk.AccAddress{addrOther1, addr4, addrOther3},
+			role:  Access_Withdraw,
+			exp: "none of [\"" + addrOther1.String() + "\" \"" + addr4.String() + "\" \"" +
+				addrOther3.String() + "\"] have permission ACCESS_WITHDRAW on " + markerDesc,
+		},
+		{
+			name:  "three addrs: expired and unexpired match",
+			addrs: []sdk.AccAddress{addr4, addr2, addrOther3},
+			role:  Access_Withdraw,
+			exp:   "",
+		},
 		{
 			name:  "three addrs: match first",
 			addrs: []sdk.AccAddress{addr1, addrOther2, addrOther3},
@@ -667,7 +876,7 @@ func TestValidateAtLeastOneAddrHasAccess(t *testing.T) {
 			expIs := len(tc.exp) == 0
 			var actIs bool
 			testIsFunc := func() {
-				actIs = AtLeastOneAddrHasAccess(ma, tc.addrs, tc.role)
+				actIs = AtLeastOneAddrHasAccess(ma, tc.addrs, tc.role, blockTime)
 			}
 			if assert.NotPanics(t, testIsFunc, "AtLeastOneAddrHasAccess") {
 				assert.Equal(t, expIs, actIs, "result from AtLeastOneAddrHasAccess")
@@ -675,7 +884,7 @@ func TestValidateAtLeastOneAddrHasAccess(t *testing.T) {
 
 			var err error
 			testValFunc := func() {
-				err = ValidateAtLeastOneAddrHasAccess(ma, tc.addrs, tc.role)
+				err = ValidateAtLeastOneAddrHasAccess(ma, tc.addrs, tc.role, blockTime)
 			}
 			if assert.NotPanics(t, testValFunc, "ValidateAtLeastOneAddrHasAccess") {
 				assertions.AssertErrorValue(t, err, tc.exp, "result from ValidateAtLeastOneAddrHasAccess")
@@ -46,6 +46,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgWithdrawEscrowProposalRequest)(nil),
 	(*MsgSetDenomMetadataProposalRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgUpdateSendLimitsRequest)(nil),
 }
 
 func NewMsgFinalizeRequest(denom string, admin sdk.AccAddress) *MsgFinalizeRequest {
@@ -571,6 +572,57 @@ func (msg MsgUpdateSendDenyListRequest) ValidateBasic() error {
 	return err
 }
 
+func NewMsgUpdateSendLimitsRequest(denom string, authority sdk.AccAddress, defaultLimit *SendLimit, removeDefaultLimit bool, setAddressLimits []AddressSendLimit, removeAddressLimits []string) *MsgUpdateSendLimitsRequest {
+	return &MsgUpdateSendLimitsRequest{
+		Denom:               denom,
+		DefaultLimit:        defaultLimit,
+		RemoveDefaultLimit:  removeDefaultLimit,
+		SetAddressLimits:    setAddressLimits,
+		RemoveAddressLimits: removeAddressLimits,
+		Authority:           authority.String(),
+	}
+}
+
+func (msg MsgUpdateSendLimitsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.DefaultLimit == nil && !msg.RemoveDefaultLimit && len(msg.SetAddressLimits) == 0 && len(msg.RemoveAddressLimits) == 0 {
+		return fmt.Errorf("no send limit updates provided")
+	}
+	if msg.DefaultLimit != nil {
+		if msg.RemoveDefaultLimit {
+			return fmt.Errorf("cannot both set and remove the default send limit")
+		}
+		if err := msg.DefaultLimit.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, limit := range msg.SetAddressLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if seen[limit.Address] {
+			return fmt.Errorf("send limit lists contain duplicate entries")
+		}
+		seen[limit.Address] = true
+	}
+	for _, addr := range msg.RemoveAddressLimits {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return err
+		}
+		if seen[addr] {
+			return fmt.Errorf("send limit lists contain duplicate entries")
+		}
+		seen[addr] = true
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
 func NewMsgAddNetAssetValuesRequest(denom, administrator string, netAssetValues []NetAssetValue) *MsgAddNetAssetValuesRequest {
 	return &MsgAddNetAssetValuesRequest{
 		Denom:          denom,
@@ -728,6 +780,7 @@ func NewMsgUpdateParamsRequest(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	maxNavHistoryEntries uint32,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -736,6 +789,7 @@ func NewMsgUpdateParamsRequest(
 			enableGovernance,
 			unrestrictedDenomRegex,
 			maxSupply,
+			maxNavHistoryEntries,
 		),
 	}
 }
@@ -52,6 +52,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgWithdrawEscrowProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetDenomMetadataProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateSendLimitsRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -774,6 +775,91 @@ func TestMsgUpdateSendDenyListRequestValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateSendLimitsRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	setAddr := sdk.AccAddress("setAddr_____________").String()
+	removeAddr := sdk.AccAddress("removeAddr__________").String()
+	limit := SendLimit{Limit: sdkmath.NewInt(100), Window: 24 * time.Hour}
+	addrLimit := AddressSendLimit{Address: setAddr, Limit: limit}
+
+	tests := []struct {
+		name   string
+		msg    MsgUpdateSendLimitsRequest
+		expErr string
+	}{
+		{
+			name: "should succeed",
+			msg:  MsgUpdateSendLimitsRequest{Denom: denom, DefaultLimit: &limit, SetAddressLimits: []AddressSendLimit{addrLimit}, RemoveAddressLimits: []string{removeAddr}, Authority: addr},
+		},
+		{
+			name: "only removing default",
+			msg:  MsgUpdateSendLimitsRequest{Denom: denom, RemoveDefaultLimit: true, Authority: addr},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgUpdateSendLimitsRequest{Denom: "1", DefaultLimit: &limit, Authority: addr},
+			expErr: "invalid denom: 1",
+		},
+		{
+			name:   "no updates",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, Authority: addr},
+			expErr: "no send limit updates provided",
+		},
+		{
+			name:   "set and remove default",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, DefaultLimit: &limit, RemoveDefaultLimit: true, Authority: addr},
+			expErr: "cannot both set and remove the default send limit",
+		},
+		{
+			name:   "invalid default limit amount",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, DefaultLimit: &SendLimit{Limit: sdkmath.ZeroInt(), Window: time.Hour}, Authority: addr},
+			expErr: "invalid send limit 0: must be positive",
+		},
+		{
+			name:   "invalid default limit window",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, DefaultLimit: &SendLimit{Limit: sdkmath.OneInt()}, Authority: addr},
+			expErr: "invalid send limit window 0s: must be positive",
+		},
+		{
+			name:   "invalid address limit address",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, SetAddressLimits: []AddressSendLimit{{Address: "invalid-address", Limit: limit}}, Authority: addr},
+			expErr: "invalid send limit address \"invalid-address\": decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "invalid address limit",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, SetAddressLimits: []AddressSendLimit{{Address: setAddr, Limit: SendLimit{Limit: sdkmath.OneInt()}}}, Authority: addr},
+			expErr: "invalid send limit for " + setAddr + ": invalid send limit window 0s: must be positive",
+		},
+		{
+			name:   "invalid remove address",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, RemoveAddressLimits: []string{"invalid-address"}, Authority: addr},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "address both set and removed",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, SetAddressLimits: []AddressSendLimit{addrLimit}, RemoveAddressLimits: []string{setAddr}, Authority: addr},
+			expErr: "send limit lists contain duplicate entries",
+		},
+		{
+			name:   "invalid authority address",
+			msg:    MsgUpdateSendLimitsRequest{Denom: denom, DefaultLimit: &limit, Authority: "invalid-address"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
 func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 	addr := sdk.AccAddress("addr________________").String()
 	denom := "somedenom"
@@ -1238,6 +1324,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					DefaultMaxNavHistoryEntries,
 				),
 			},
 			expectError: false,
@@ -1250,6 +1337,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"^invalidregex$",
 					sdkmath.NewInt(1000000000000),
+					DefaultMaxNavHistoryEntries,
 				),
 			},
 			expectError:   true,
@@ -1263,6 +1351,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					DefaultMaxNavHistoryEntries,
 				),
 			},
 			expectError:   true,
@@ -14,6 +14,10 @@ const (
 	DefaultMaxSupply = "100000000000000000000"
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultMaxNavHistoryEntries is the default number of net asset value history entries to retain for each marker and price denom.
+	DefaultMaxNavHistoryEntries = 100
+	// MaxMaxNavHistoryEntries is the largest allowed value for max_nav_history_entries.
+	MaxMaxNavHistoryEntries = 10000
 )
 
 // NewParams creates a new parameter object
@@ -21,11 +25,13 @@ func NewParams(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	maxNavHistoryEntries uint32,
 ) Params {
 	return Params{
 		EnableGovernance:       enableGovernance,
 		UnrestrictedDenomRegex: unrestrictedDenomRegex,
 		MaxSupply:              maxSupply,
+		MaxNavHistoryEntries:   maxNavHistoryEntries,
 	}
 }
 
@@ -35,6 +41,7 @@ func DefaultParams() Params {
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
 		StringToBigInt(DefaultMaxSupply),
+		DefaultMaxNavHistoryEntries,
 	)
 }
 
@@ -43,8 +50,13 @@ func (p Params) Validate() error {
 	if len(exp) > 0 && (exp[0:1] == "^" || exp[len(exp)-1:] == "$") {
 		return fmt.Errorf("invalid parameter, validation regex must not contain anchors ^,$")
 	}
-	_, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp))
-	return err
+	if _, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp)); err != nil {
+		return err
+	}
+	if p.MaxNavHistoryEntries > MaxMaxNavHistoryEntries {
+		return fmt.Errorf("max nav history entries %d cannot be greater than %d", p.MaxNavHistoryEntries, MaxMaxNavHistoryEntries)
+	}
+	return nil
 }
 
 func StringToBigInt(val string) sdkmath.Int {
@@ -19,10 +19,13 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, DefaultMaxSupply, p.MaxSupply.String())
 
-	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"))))
+	require.Equal(t, uint32(DefaultMaxNavHistoryEntries), p.MaxNavHistoryEntries)
+
+	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), DefaultMaxNavHistoryEntries)))
+	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), DefaultMaxNavHistoryEntries)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply), DefaultMaxNavHistoryEntries)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"), DefaultMaxNavHistoryEntries)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), 5)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,7 +42,8 @@ func TestDefaultParams(t *testing.T) {
 func TestParamString(t *testing.T) {
 	expected := `enable_governance:true ` +
 		`unrestricted_denom_regex:"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" ` +
-		`max_supply:"100000000000000000000" `
+		`max_supply:"100000000000000000000" ` +
+		`max_nav_history_entries:100 `
 	p := DefaultParams()
 	actual := p.String()
 	require.Equal(t, expected, actual)
@@ -97,6 +101,20 @@ func TestParamsValidate(t *testing.T) {
 			},
 			expectedErr: "error parsing regexp: missing closing ):",
 		},
+		{
+			name: "max nav history entries at max",
+			params: Params{
+				MaxNavHistoryEntries: MaxMaxNavHistoryEntries,
+			},
+			expectedErr: "",
+		},
+		{
+			name: "max nav history entries too large",
+			params: Params{
+				MaxNavHistoryEntries: MaxMaxNavHistoryEntries + 1,
+			},
+			expectedErr: "max nav history entries 10001 cannot be greater than 10000",
+		},
 	}
 
 	for _, tc := range testCases {
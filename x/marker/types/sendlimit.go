@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendLimitUsagePeriods is the number of periods that a send limit's window is divided into when recording usage.
+// All sends made during a period are combined into a single record, so an account's usage has at most
+// SendLimitUsagePeriods + 1 records. A period's sends count against the limit until the whole period has left
+// the window, so a send can count for up to one period longer than the window.
+const SendLimitUsagePeriods = 24
+
+// NewSendLimit creates a new SendLimit.
+func NewSendLimit(limit sdkmath.Int, window time.Duration) *SendLimit {
+	return &SendLimit{Limit: limit, Window: window}
+}
+
+// Validate returns an error if this send limit is invalid.
+func (s SendLimit) Validate() error {
+	if s.Limit.IsNil() || !s.Limit.IsPositive() {
+		return fmt.Errorf("invalid send limit %s: must be positive", s.Limit)
+	}
+	if s.Window <= 0 {
+		return fmt.Errorf("invalid send limit window %s: must be positive", s.Window)
+	}
+	return nil
+}
+
+// UsagePeriod returns the length of the periods that sends are grouped into when recording usage against this limit.
+func (s SendLimit) UsagePeriod() time.Duration {
+	if rv := s.Window / SendLimitUsagePeriods; rv > 0 {
+		return rv
+	}
+	return 1
+}
+
+// NewAddressSendLimit creates a new AddressSendLimit.
+func NewAddressSendLimit(addr sdk.AccAddress, limit SendLimit) *AddressSendLimit {
+	return &AddressSendLimit{Address: addr.String(), Limit: limit}
+}
+
+// Validate returns an error if this address send limit is invalid.
+func (a AddressSendLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid send limit address %q: %w", a.Address, err)
+	}
+	if err := a.Limit.Validate(); err != nil {
+		return fmt.Errorf("invalid send limit for %s: %w", a.Address, err)
+	}
+	return nil
+}
+
+// Prune returns a copy of this usage without the records that are entirely outside the window of the provided limit
+// that ends at the provided time. A record is in the window if the end of its period is after blockTime - window.
+func (u SendLimitUsage) Prune(blockTime time.Time, limit SendLimit) SendLimitUsage {
+	start := blockTime.Add(-limit.Window)
+	period := limit.UsagePeriod()
+	rv := SendLimitUsage{}
+	for _, record := range u.Records {
+		if record.Time.Add(period).After(start) {
+			rv.Records = append(rv.Records, record)
+		}
+	}
+	return rv
+}
+
+// Total returns the sum of the amounts of this usage's records.
+func (u SendLimitUsage) Total() sdkmath.Int {
+	rv := sdkmath.ZeroInt()
+	for _, record := range u.Records {
+		rv = rv.Add(record.Amount)
+	}
+	return rv
+}
+
+// Add returns a copy of this usage with the provided amount added to the record of the provided limit's period that
+// contains the provided time. If the newest record is from that period (or later), the amount is added to it instead
+// of creating a new record.
+func (u SendLimitUsage) Add(blockTime time.Time, limit SendLimit, amount sdkmath.Int) SendLimitUsage {
+	periodStart := blockTime.Truncate(limit.UsagePeriod())
+	rv := SendLimitUsage{Records: make([]SendRecord, len(u.Records), len(u.Records)+1)}
+	copy(rv.Records, u.Records)
+	if last := len(rv.Records) - 1; last >= 0 && !rv.Records[last].Time.Before(periodStart) {
+		rv.Records[last].Amount = rv.Records[last].Amount.Add(amount)
+		return rv
+	}
+	rv.Records = append(rv.Records, SendRecord{Time: periodStart, Amount: amount})
+	return rv
+}
@@ -0,0 +1,1073 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/sendlimit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SendLimit is a limit on the amount of a restricted marker's denom that an account can send in a rolling window.
+type SendLimit struct {
+	// limit is the maximum amount of the marker's denom that can be sent during any window.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// window is the length of the rolling window, e.g. 24h.
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *SendLimit) Reset()         { *m = SendLimit{} }
+func (m *SendLimit) String() string { return proto.CompactTextString(m) }
+func (*SendLimit) ProtoMessage()    {}
+func (*SendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c5bb76234ca1b, []int{0}
+}
+func (m *SendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendLimit.Merge(m, src)
+}
+func (m *SendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendLimit proto.InternalMessageInfo
+
+func (m *SendLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// AddressSendLimit is a send limit that applies to a specific account instead of a marker's default send limit.
+type AddressSendLimit struct {
+	// address is the bech32 address string of the account that the limit applies to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// limit is the send limit for the account.
+	Limit SendLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *AddressSendLimit) Reset()         { *m = AddressSendLimit{} }
+func (m *AddressSendLimit) String() string { return proto.CompactTextString(m) }
+func (*AddressSendLimit) ProtoMessage()    {}
+func (*AddressSendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c5bb76234ca1b, []int{1}
+}
+func (m *AddressSendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressSendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressSendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressSendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressSendLimit.Merge(m, src)
+}
+func (m *AddressSendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressSendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressSendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressSendLimit proto.InternalMessageInfo
+
+func (m *AddressSendLimit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressSendLimit) GetLimit() SendLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return SendLimit{}
+}
+
+// SendRecord is a record of the amount of a restricted marker's denom that an account sent during a period of time.
+type SendRecord struct {
+	// time is the start of the period that the sends were made in.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// amount is the amount of the marker's denom that was sent.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *SendRecord) Reset()         { *m = SendRecord{} }
+func (m *SendRecord) String() string { return proto.CompactTextString(m) }
+func (*SendRecord) ProtoMessage()    {}
+func (*SendRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c5bb76234ca1b, []int{2}
+}
+func (m *SendRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRecord.Merge(m, src)
+}
+func (m *SendRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendRecord proto.InternalMessageInfo
+
+func (m *SendRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// SendLimitUsage is the recent sends of a restricted marker's denom by an account that count towards its send limit.
+type SendLimitUsage struct {
+	// records are the sends, combined by period (1/24 of the limit's window), oldest first.
+	Records []SendRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *SendLimitUsage) Reset()         { *m = SendLimitUsage{} }
+func (m *SendLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*SendLimitUsage) ProtoMessage()    {}
+func (*SendLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_908c5bb76234ca1b, []int{3}
+}
+func (m *SendLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendLimitUsage.Merge(m, src)
+}
+func (m *SendLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendLimitUsage proto.InternalMessageInfo
+
+func (m *SendLimitUsage) GetRecords() []SendRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SendLimit)(nil), "provenance.marker.v1.SendLimit")
+	proto.RegisterType((*AddressSendLimit)(nil), "provenance.marker.v1.AddressSendLimit")
+	proto.RegisterType((*SendRecord)(nil), "provenance.marker.v1.SendRecord")
+	proto.RegisterType((*SendLimitUsage)(nil), "provenance.marker.v1.SendLimitUsage")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/sendlimit.proto", fileDescriptor_908c5bb76234ca1b)
+}
+
+var fileDescriptor_908c5bb76234ca1b = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0x63, 0x38, 0xae, 0xd4, 0x27, 0x21, 0x14, 0x15, 0x71, 0x9c, 0x44, 0x72, 0x3a, 0x31,
+	0xdc, 0x82, 0xad, 0xa6, 0x42, 0x42, 0x74, 0x81, 0x88, 0x05, 0x89, 0x01, 0x05, 0x58, 0xd8, 0x7c,
+	0xb1, 0x49, 0xad, 0xd6, 0x7e, 0x91, 0xed, 0x5c, 0x61, 0x60, 0xe2, 0x0b, 0x74, 0x64, 0xe4, 0xe3,
+	0x74, 0xec, 0x88, 0x18, 0x0a, 0xba, 0x5b, 0xf8, 0x18, 0xc8, 0x71, 0xd2, 0x43, 0x50, 0xd4, 0x2d,
+	0x2f, 0xef, 0xff, 0xde, 0xff, 0xf7, 0xfe, 0x32, 0x7e, 0x50, 0x1b, 0x58, 0x0a, 0xcd, 0x74, 0x29,
+	0xa8, 0x62, 0xe6, 0x50, 0x18, 0xba, 0xdc, 0xa5, 0x56, 0x68, 0x7e, 0x24, 0x95, 0x74, 0xa4, 0x36,
+	0xe0, 0x20, 0xde, 0xd9, 0xa8, 0x48, 0x50, 0x91, 0xe5, 0xee, 0x64, 0xa7, 0x82, 0x0a, 0x5a, 0x01,
+	0xf5, 0x5f, 0x41, 0x3b, 0x49, 0x2a, 0x80, 0xea, 0x48, 0xd0, 0xb6, 0x5a, 0x34, 0xef, 0x29, 0x6f,
+	0x0c, 0x73, 0x12, 0x74, 0xd7, 0x4f, 0xff, 0xee, 0x3b, 0xa9, 0x84, 0x75, 0x4c, 0xd5, 0x41, 0x30,
+	0xfb, 0x8c, 0xf0, 0xf6, 0x6b, 0xa1, 0xf9, 0x4b, 0x0f, 0x10, 0xef, 0xe1, 0x1b, 0x2d, 0xc9, 0x18,
+	0x4d, 0xd1, 0x7c, 0x3b, 0xbf, 0x7f, 0x7a, 0x9e, 0x46, 0xdf, 0xcf, 0xd3, 0x3b, 0x25, 0x58, 0x05,
+	0xd6, 0xf2, 0x43, 0x22, 0x81, 0x2a, 0xe6, 0x0e, 0xc8, 0x0b, 0xed, 0x8a, 0xa0, 0x8d, 0xf7, 0xf1,
+	0xf0, 0x58, 0x6a, 0x0e, 0xc7, 0xe3, 0x6b, 0x53, 0x34, 0x1f, 0x65, 0xf7, 0x48, 0x30, 0x25, 0xbd,
+	0x29, 0x79, 0xde, 0x41, 0xe5, 0x37, 0xfd, 0xc2, 0x2f, 0x3f, 0x52, 0x54, 0x74, 0x23, 0x4f, 0x06,
+	0xbf, 0xbe, 0xa6, 0x68, 0x06, 0xf8, 0xf6, 0x33, 0xce, 0x8d, 0xb0, 0x76, 0xc3, 0x32, 0xc6, 0x5b,
+	0x2c, 0xfc, 0x0b, 0x34, 0x45, 0x5f, 0xc6, 0xfb, 0x3d, 0x65, 0xf0, 0x4b, 0xc9, 0x65, 0x81, 0x91,
+	0x8b, 0x4d, 0xf9, 0xc0, 0xbb, 0x76, 0xb4, 0x9d, 0xe1, 0x27, 0x8c, 0x7d, 0xbf, 0x10, 0x25, 0x18,
+	0x1e, 0x3f, 0xc6, 0x03, 0x9f, 0x4b, 0xeb, 0x33, 0xca, 0x26, 0xff, 0xf0, 0xbf, 0xe9, 0x43, 0x0b,
+	0x07, 0x9c, 0xf8, 0x03, 0xda, 0x89, 0xf8, 0x11, 0x1e, 0x32, 0x05, 0x8d, 0x0e, 0x2c, 0x57, 0x26,
+	0xd6, 0x89, 0x67, 0x05, 0xbe, 0x75, 0x81, 0xf7, 0xd6, 0xb2, 0x4a, 0xc4, 0x4f, 0xf1, 0x96, 0x69,
+	0x61, 0xfc, 0xb5, 0xd7, 0xe7, 0xa3, 0x6c, 0xfa, 0xff, 0xab, 0x02, 0x75, 0x77, 0x56, 0x3f, 0x96,
+	0x57, 0xa7, 0xab, 0x04, 0x9d, 0xad, 0x12, 0xf4, 0x73, 0x95, 0xa0, 0x93, 0x75, 0x12, 0x9d, 0xad,
+	0x93, 0xe8, 0xdb, 0x3a, 0x89, 0xf0, 0x5d, 0x09, 0x97, 0x2e, 0x7b, 0x85, 0xde, 0x65, 0x95, 0x74,
+	0x07, 0xcd, 0x82, 0x94, 0xa0, 0xe8, 0x46, 0xf2, 0x50, 0xc2, 0x1f, 0x15, 0xfd, 0xd0, 0x3f, 0x56,
+	0xf7, 0xb1, 0x16, 0x76, 0x31, 0x6c, 0x73, 0xd9, 0xfb, 0x3d, 0x00, 0x40, 0x8b, 0x26, 0xcb, 0xce,
+	0x02, 0x00, 0x00,
+}
+
+func (this *SendLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendLimit)
+	if !ok {
+		that2, ok := that.(SendLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Limit.Equal(that1.Limit) {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	return true
+}
+func (this *AddressSendLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressSendLimit)
+	if !ok {
+		that2, ok := that.(AddressSendLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Limit.Equal(&that1.Limit) {
+		return false
+	}
+	return true
+}
+func (m *SendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSendlimit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSendlimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressSendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressSendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressSendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSendlimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSendlimit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSendlimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSendlimit(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SendLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSendlimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSendlimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSendlimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovSendlimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovSendlimit(uint64(l))
+	return n
+}
+
+func (m *AddressSendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSendlimit(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovSendlimit(uint64(l))
+	return n
+}
+
+func (m *SendRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSendlimit(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovSendlimit(uint64(l))
+	return n
+}
+
+func (m *SendLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovSendlimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSendlimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSendlimit(x uint64) (n int) {
+	return sovSendlimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSendlimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSendlimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressSendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSendlimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressSendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressSendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSendlimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSendlimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSendlimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSendlimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, SendRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSendlimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSendlimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSendlimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSendlimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSendlimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSendlimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSendlimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSendlimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSendlimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSendlimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSendlimit = fmt.Errorf("proto: unexpected end of group")
+)
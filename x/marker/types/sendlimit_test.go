@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestSendLimitUsagePeriod(t *testing.T) {
+	assert.Equal(t, 150*time.Second, NewSendLimit(sdkmath.NewInt(1), time.Hour).UsagePeriod(), "1h window")
+	assert.Equal(t, time.Hour, NewSendLimit(sdkmath.NewInt(1), 24*time.Hour).UsagePeriod(), "24h window")
+	assert.Equal(t, time.Duration(1), NewSendLimit(sdkmath.NewInt(1), 10).UsagePeriod(), "10ns window")
+}
+
+func TestSendLimitUsage(t *testing.T) {
+	limit := *NewSendLimit(sdkmath.NewInt(100), time.Hour)
+	period := limit.UsagePeriod()
+	start := time.Unix(1700000000, 0).UTC().Truncate(period)
+	usage := SendLimitUsage{}
+	assert.Equal(t, "0", usage.Total().String(), "Total of empty usage")
+
+	usage = usage.Add(start, limit, sdkmath.NewInt(10))
+	usage = usage.Add(start.Add(time.Minute), limit, sdkmath.NewInt(5))
+	usage = usage.Add(start.Add(3*time.Minute), limit, sdkmath.NewInt(20))
+	usage = usage.Add(start.Add(time.Hour), limit, sdkmath.NewInt(40))
+	if assert.Len(t, usage.Records, 3, "records after adding (sends in the same period are combined)") {
+		assert.Equal(t, start, usage.Records[0].Time, "time of first record")
+		assert.Equal(t, start.Add(period), usage.Records[1].Time, "time of second record")
+		assert.Equal(t, start.Add(time.Hour), usage.Records[2].Time, "time of third record")
+	}
+	assert.Equal(t, "75", usage.Total().String(), "Total")
+
+	// A record is kept until its whole period has left the window.
+	pruned := usage.Prune(start.Add(time.Hour), limit)
+	assert.Equal(t, "75", pruned.Total().String(), "Total after pruning at start + 1h")
+	pruned = usage.Prune(start.Add(time.Hour+period), limit)
+	assert.Equal(t, "60", pruned.Total().String(), "Total after pruning at start + 1h + period")
+	assert.Len(t, usage.Records, 3, "records of the original after pruning")
+
+	pruned = usage.Prune(start.Add(2*time.Hour+period), limit)
+	assert.Empty(t, pruned.Records, "records after pruning everything")
+}
+
+func TestSendLimitUsageRecordCount(t *testing.T) {
+	limit := *NewSendLimit(sdkmath.NewInt(1000000), time.Hour)
+	blockTime := time.Unix(1700000000, 0).UTC()
+	usage := SendLimitUsage{}
+	for i := 0; i < 1000; i++ {
+		blockTime = blockTime.Add(7 * time.Second)
+		usage = usage.Prune(blockTime, limit).Add(blockTime, limit, sdkmath.NewInt(1))
+		assert.LessOrEqual(t, len(usage.Records), SendLimitUsagePeriods+1, "records after send %d", i)
+	}
+}
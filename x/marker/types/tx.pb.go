@@ -2901,6 +2901,135 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateSendLimitsRequest defines a msg to set or remove the rolling-window send limits of a restricted marker.
+// signer must have transfer authority
+type MsgUpdateSendLimitsRequest struct {
+	// The denomination of the marker to update.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The limit to apply to accounts that do not have their own limit. If not provided, the existing default is kept.
+	DefaultLimit *SendLimit `protobuf:"bytes,2,opt,name=default_limit,json=defaultLimit,proto3" json:"default_limit,omitempty"`
+	// Whether to remove the existing default limit. Cannot be used with default_limit.
+	RemoveDefaultLimit bool `protobuf:"varint,3,opt,name=remove_default_limit,json=removeDefaultLimit,proto3" json:"remove_default_limit,omitempty"`
+	// Limits to set for specific accounts. These replace any existing limits for those accounts.
+	SetAddressLimits []AddressSendLimit `protobuf:"bytes,4,rep,name=set_address_limits,json=setAddressLimits,proto3" json:"set_address_limits"`
+	// List of bech32 addresses whose specific limits should be removed.
+	RemoveAddressLimits []string `protobuf:"bytes,5,rep,name=remove_address_limits,json=removeAddressLimits,proto3" json:"remove_address_limits,omitempty"`
+	// The signer of the message.  Must have transfer authority to marker or be governance module account address.
+	Authority string `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdateSendLimitsRequest) Reset()         { *m = MsgUpdateSendLimitsRequest{} }
+func (m *MsgUpdateSendLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSendLimitsRequest) ProtoMessage()    {}
+func (*MsgUpdateSendLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{56}
+}
+func (m *MsgUpdateSendLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSendLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSendLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSendLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSendLimitsRequest.Merge(m, src)
+}
+func (m *MsgUpdateSendLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSendLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSendLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSendLimitsRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateSendLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgUpdateSendLimitsRequest) GetDefaultLimit() *SendLimit {
+	if m != nil {
+		return m.DefaultLimit
+	}
+	return nil
+}
+
+func (m *MsgUpdateSendLimitsRequest) GetRemoveDefaultLimit() bool {
+	if m != nil {
+		return m.RemoveDefaultLimit
+	}
+	return false
+}
+
+func (m *MsgUpdateSendLimitsRequest) GetSetAddressLimits() []AddressSendLimit {
+	if m != nil {
+		return m.SetAddressLimits
+	}
+	return nil
+}
+
+func (m *MsgUpdateSendLimitsRequest) GetRemoveAddressLimits() []string {
+	if m != nil {
+		return m.RemoveAddressLimits
+	}
+	return nil
+}
+
+func (m *MsgUpdateSendLimitsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUpdateSendLimitsResponse defines the Msg/UpdateSendLimits response type
+type MsgUpdateSendLimitsResponse struct {
+}
+
+func (m *MsgUpdateSendLimitsResponse) Reset()         { *m = MsgUpdateSendLimitsResponse{} }
+func (m *MsgUpdateSendLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSendLimitsResponse) ProtoMessage()    {}
+func (*MsgUpdateSendLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{57}
+}
+func (m *MsgUpdateSendLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSendLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSendLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSendLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSendLimitsResponse.Merge(m, src)
+}
+func (m *MsgUpdateSendLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSendLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSendLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSendLimitsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
@@ -2958,160 +3087,169 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataProposalResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataProposalResponse")
 	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.marker.v1.MsgUpdateParamsRequest")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.marker.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateSendLimitsRequest)(nil), "provenance.marker.v1.MsgUpdateSendLimitsRequest")
+	proto.RegisterType((*MsgUpdateSendLimitsResponse)(nil), "provenance.marker.v1.MsgUpdateSendLimitsResponse")
 }
 
 func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
 
 var fileDescriptor_bcb203fb73175ed3 = []byte{
-	// 2359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5f, 0x6f, 0x1b, 0x59,
-	0x15, 0xef, 0x38, 0x8e, 0x37, 0x3e, 0x6e, 0xd3, 0xe6, 0x36, 0x6d, 0x27, 0x93, 0xcd, 0xdf, 0x36,
-	0xad, 0xb7, 0x6c, 0xec, 0xc6, 0x0b, 0xdd, 0x6e, 0x58, 0x09, 0x39, 0xc9, 0xa6, 0x54, 0x60, 0x54,
-	0x39, 0x0b, 0x08, 0x5e, 0xac, 0xf1, 0xcc, 0xcd, 0x64, 0x14, 0x7b, 0xc6, 0x9d, 0x7b, 0xed, 0x34,
-	0x2b, 0x21, 0x21, 0xf6, 0x69, 0x9f, 0x58, 0xf6, 0x01, 0x21, 0xc4, 0x03, 0x4f, 0x08, 0xf1, 0x54,
-	0xa1, 0x15, 0x1f, 0x00, 0x09, 0xb1, 0x80, 0x40, 0xab, 0xe5, 0x05, 0xf1, 0xb0, 0xa0, 0x56, 0xa2,
-	0x08, 0xf1, 0x19, 0x00, 0xcd, 0xbd, 0x77, 0xc6, 0x1e, 0x7b, 0xe6, 0xda, 0x49, 0x5c, 0x2d, 0x2f,
-	0x6d, 0xe6, 0x9e, 0x73, 0xee, 0x39, 0xbf, 0x73, 0xcf, 0xb9, 0xf7, 0x9c, 0x93, 0xc0, 0x42, 0xcb,
-	0x73, 0x3b, 0xd8, 0xd1, 0x1d, 0x03, 0x17, 0x9b, 0xba, 0x77, 0x88, 0xbd, 0x62, 0x67, 0xa3, 0x48,
-	0x1f, 0x17, 0x5a, 0x9e, 0x4b, 0x5d, 0x34, 0xdb, 0x25, 0x17, 0x38, 0xb9, 0xd0, 0xd9, 0xd0, 0x66,
-	0xf4, 0xa6, 0xed, 0xb8, 0x45, 0xf6, 0x2f, 0x67, 0xd4, 0xe6, 0x2c, 0xd7, 0xb5, 0x1a, 0xb8, 0xc8,
-	0xbe, 0xea, 0xed, 0xfd, 0xa2, 0xee, 0x1c, 0x07, 0x24, 0xc3, 0x25, 0x4d, 0x97, 0xd4, 0xd8, 0x57,
-	0x91, 0x7f, 0x08, 0xd2, 0xac, 0xe5, 0x5a, 0x2e, 0x5f, 0xf7, 0x7f, 0x12, 0xab, 0x8b, 0x9c, 0xa7,
-	0x58, 0xd7, 0x09, 0x2e, 0x76, 0x36, 0xea, 0x98, 0xea, 0x1b, 0x45, 0xc3, 0xb5, 0x9d, 0x01, 0xba,
-	0x73, 0x18, 0xd2, 0xfd, 0x0f, 0x41, 0xbf, 0x26, 0xe8, 0x4d, 0x62, 0xf9, 0x60, 0x9a, 0xc4, 0x12,
-	0x84, 0x35, 0xbb, 0x6e, 0x14, 0xf5, 0x56, 0xab, 0x61, 0x1b, 0x3a, 0xb5, 0x5d, 0x87, 0x14, 0xa9,
-	0xa7, 0x3b, 0x64, 0x3f, 0x0a, 0x5a, 0x5b, 0x89, 0xf5, 0x89, 0x80, 0xcf, 0x59, 0x6e, 0xc6, 0xb2,
-	0xe8, 0x86, 0x81, 0x09, 0xb1, 0x3c, 0xdd, 0xa1, 0x9c, 0x6f, 0xf5, 0x0f, 0x0a, 0xa8, 0x15, 0x62,
-	0xdd, 0xf7, 0x97, 0xca, 0x8d, 0x86, 0x7b, 0xe4, 0x4b, 0x54, 0xf1, 0xa3, 0x36, 0x26, 0x14, 0xcd,
-	0xc2, 0xa4, 0x89, 0x1d, 0xb7, 0xa9, 0x2a, 0xcb, 0x4a, 0x3e, 0x5b, 0xe5, 0x1f, 0xe8, 0x06, 0x5c,
-	0xd0, 0xcd, 0xa6, 0xed, 0xd8, 0x84, 0x7a, 0x3a, 0x75, 0x3d, 0x35, 0xc5, 0xa8, 0xd1, 0x45, 0xa4,
-	0xc2, 0x4b, 0x4c, 0x0f, 0xc6, 0xea, 0x04, 0xa3, 0x07, 0x9f, 0xe8, 0x2d, 0xc8, 0xea, 0x81, 0x26,
-	0x35, 0xbd, 0xac, 0xe4, 0x73, 0xa5, 0xd9, 0x02, 0x3f, 0x9d, 0x42, 0x70, 0x3a, 0x85, 0xb2, 0x73,
-	0xbc, 0x35, 0xf3, 0xfb, 0x0f, 0xd7, 0x2f, 0xec, 0x62, 0x1c, 0xda, 0xf5, 0xa0, 0xda, 0x95, 0xdc,
-	0x44, 0xdf, 0x7b, 0xfe, 0xe4, 0x76, 0x54, 0xe9, 0xea, 0x3c, 0xcc, 0xc5, 0x80, 0x21, 0x2d, 0xd7,
-	0x21, 0x78, 0xf5, 0xbf, 0x69, 0xb8, 0x5c, 0x21, 0x56, 0xd9, 0x34, 0x2b, 0xcc, 0x21, 0x01, 0xca,
-	0xd7, 0x21, 0xa3, 0x37, 0xdd, 0xb6, 0x43, 0x19, 0xcc, 0x5c, 0x69, 0xae, 0x20, 0x42, 0xc0, 0x3f,
-	0xde, 0x82, 0x38, 0xbe, 0xc2, 0xb6, 0x6b, 0x3b, 0x5b, 0xe9, 0x8f, 0x3e, 0x5d, 0x3a, 0x57, 0x15,
-	0xec, 0x3e, 0xc4, 0xa6, 0xee, 0xe8, 0x16, 0xf6, 0x02, 0x88, 0xe2, 0x13, 0xad, 0xc0, 0xf9, 0x7d,
-	0xcf, 0x6d, 0xd6, 0x74, 0xd3, 0xf4, 0x30, 0x21, 0x0c, 0x65, 0xb6, 0x9a, 0xf3, 0xd7, 0xca, 0x7c,
-	0x09, 0x6d, 0x42, 0x86, 0x50, 0x9d, 0xb6, 0x89, 0x3a, 0xb9, 0xac, 0xe4, 0xa7, 0x4b, 0xab, 0x85,
-	0xb8, 0x48, 0x2e, 0x70, 0x53, 0xf7, 0x18, 0x67, 0x55, 0x48, 0xa0, 0x32, 0xe4, 0x38, 0x47, 0x8d,
-	0x1e, 0xb7, 0xb0, 0x9a, 0x61, 0x1b, 0x2c, 0xcb, 0x36, 0x78, 0xfb, 0xb8, 0x85, 0xab, 0xd0, 0x0c,
-	0x7f, 0x46, 0x5f, 0x86, 0x1c, 0x0f, 0x86, 0x5a, 0xc3, 0x26, 0x54, 0x7d, 0x69, 0x79, 0x22, 0x9f,
-	0x2b, 0xad, 0xc4, 0x6f, 0x51, 0x66, 0x8c, 0xcc, 0xab, 0xc2, 0x03, 0xc0, 0x65, 0xbf, 0x6a, 0x13,
-	0xea, 0x63, 0x25, 0xed, 0x56, 0xab, 0x71, 0x5c, 0xdb, 0xb7, 0x1f, 0x63, 0x53, 0x9d, 0x5a, 0x56,
-	0xf2, 0x53, 0xd5, 0x1c, 0x5f, 0xdb, 0xf5, 0x97, 0xd0, 0x3d, 0x50, 0xd9, 0xb9, 0xd5, 0x2c, 0xb7,
-	0x83, 0x3d, 0xb6, 0x7d, 0xcd, 0x70, 0x1d, 0xea, 0xb9, 0x0d, 0x35, 0xcb, 0xd8, 0xaf, 0x32, 0xfa,
-	0xfd, 0x90, 0xbc, 0xcd, 0xa9, 0xa8, 0x04, 0x57, 0xb8, 0xe4, 0xbe, 0xeb, 0x19, 0xd8, 0xac, 0x05,
-	0xe9, 0xa0, 0x02, 0x13, 0xbb, 0xcc, 0x88, 0xbb, 0x8c, 0xf6, 0xb6, 0x20, 0xa1, 0x22, 0x5c, 0xf6,
-	0xf0, 0xa3, 0xb6, 0xed, 0x61, 0xb3, 0xa6, 0x53, 0xea, 0xd9, 0xf5, 0x36, 0xc5, 0x44, 0xcd, 0x2d,
-	0x4f, 0xe4, 0xb3, 0x55, 0x14, 0x90, 0xca, 0x21, 0x05, 0x2d, 0x41, 0xb6, 0x4d, 0xcc, 0x9a, 0x81,
-	0x1d, 0x4a, 0xd4, 0xf3, 0xcb, 0x4a, 0x3e, 0xbd, 0x95, 0x52, 0x95, 0xea, 0x54, 0x9b, 0x98, 0xdb,
-	0xfe, 0x1a, 0xba, 0x0a, 0x99, 0x8e, 0xdb, 0x68, 0x37, 0xb1, 0x7a, 0xc1, 0xa7, 0x56, 0xc5, 0x17,
-	0x9a, 0xe7, 0x82, 0x4d, 0xbb, 0xd1, 0x20, 0xea, 0x34, 0x23, 0xf9, 0x42, 0x15, 0xff, 0x7b, 0x73,
-	0xc6, 0x8f, 0xcf, 0x48, 0x18, 0xac, 0x5e, 0x85, 0xd9, 0x68, 0x00, 0x8a, 0xc8, 0xfc, 0x99, 0x12,
-	0x44, 0x26, 0x77, 0xf5, 0x38, 0xf2, 0xef, 0x4b, 0x90, 0xe1, 0x87, 0xa4, 0x4e, 0x9c, 0xec, 0x6c,
-	0x85, 0x58, 0x6c, 0x7e, 0x85, 0x00, 0x02, 0x3b, 0x05, 0x80, 0x1f, 0x28, 0x70, 0xb5, 0x42, 0xac,
-	0x1d, 0xdc, 0xc0, 0x14, 0x8f, 0x0f, 0xc3, 0x2d, 0xb8, 0xe8, 0xe1, 0xa6, 0xdb, 0xf1, 0x0f, 0x52,
-	0x64, 0x12, 0x4f, 0xb4, 0x69, 0xb1, 0x2c, 0x92, 0x29, 0xd6, 0xd6, 0x39, 0xb8, 0x36, 0x60, 0x92,
-	0x30, 0xd7, 0x04, 0x54, 0x21, 0xd6, 0xae, 0xed, 0xe8, 0x0d, 0xfb, 0x9d, 0x71, 0xdc, 0x76, 0xb1,
-	0x06, 0x5c, 0x61, 0x87, 0xda, 0xd5, 0x12, 0x51, 0x5e, 0x36, 0xa8, 0xdd, 0xd1, 0xe9, 0x0b, 0x56,
-	0xde, 0xd5, 0x22, 0x94, 0xd7, 0xe1, 0x52, 0x85, 0x58, 0xdb, 0x7e, 0x10, 0x34, 0x5e, 0x94, 0xea,
-	0xcb, 0x30, 0xd3, 0xa3, 0x23, 0xa2, 0x98, 0x9f, 0xc6, 0x8b, 0x55, 0x1c, 0xe8, 0x10, 0x8a, 0xdf,
-	0x55, 0x60, 0xba, 0x42, 0xac, 0x8a, 0xed, 0xd0, 0x33, 0x5f, 0xf8, 0xa7, 0x37, 0x6d, 0x06, 0x2e,
-	0x86, 0x46, 0x44, 0x0d, 0xdb, 0x6a, 0x7b, 0xce, 0x67, 0x6e, 0x18, 0x37, 0x42, 0x18, 0xf6, 0x1f,
-	0x85, 0x45, 0xe8, 0x37, 0x6d, 0x7a, 0x60, 0x7a, 0xfa, 0xd1, 0x38, 0x12, 0x79, 0x01, 0x80, 0xba,
-	0x7d, 0x39, 0x9c, 0xa5, 0x6e, 0xf0, 0x16, 0x1e, 0x87, 0xb8, 0xd3, 0xec, 0xae, 0x92, 0xe0, 0xde,
-	0xf5, 0x71, 0xff, 0xe2, 0x6f, 0x4b, 0x79, 0xcb, 0xa6, 0x07, 0xed, 0x7a, 0xc1, 0x70, 0x9b, 0xa2,
-	0x62, 0x13, 0xff, 0xad, 0x13, 0xf3, 0xb0, 0xe8, 0x3f, 0x8b, 0x84, 0x09, 0x90, 0x1f, 0xfb, 0xb7,
-	0x70, 0x03, 0x5b, 0xba, 0x71, 0x5c, 0xf3, 0x4b, 0x34, 0xf2, 0xf3, 0xe7, 0x4f, 0x6e, 0x2b, 0x81,
-	0xe7, 0x24, 0xb9, 0xd3, 0xc5, 0x2f, 0xfc, 0xf2, 0x3b, 0xee, 0x97, 0xe0, 0x9d, 0x19, 0xff, 0xa1,
-	0x4d, 0xc4, 0xb9, 0x6e, 0x84, 0x52, 0x22, 0xea, 0xdd, 0xc9, 0x3e, 0xef, 0x4a, 0x20, 0x76, 0xa1,
-	0x08, 0x88, 0xff, 0x50, 0xe0, 0x4a, 0x85, 0x58, 0x0f, 0xea, 0x46, 0x3f, 0xca, 0x0f, 0x14, 0x98,
-	0x0a, 0x1f, 0x5f, 0x0e, 0xf4, 0x95, 0x82, 0x5d, 0x37, 0x0a, 0xbd, 0xd5, 0x6a, 0x21, 0xe0, 0x60,
-	0x85, 0x47, 0x77, 0xff, 0xad, 0xaf, 0xf8, 0xc0, 0xff, 0xfa, 0xe9, 0xd2, 0xf6, 0xe0, 0xa9, 0xd9,
-	0x75, 0x63, 0xdd, 0x72, 0x8b, 0x9d, 0x7b, 0xc5, 0xa6, 0x6b, 0xb6, 0x1b, 0x98, 0xf8, 0xf5, 0x6f,
-	0x4f, 0xdd, 0xcb, 0x8f, 0xb2, 0xd7, 0xd8, 0xd0, 0x8e, 0x33, 0x84, 0xbd, 0xca, 0xde, 0xab, 0x08,
-	0x4e, 0xe1, 0x82, 0x3f, 0x2a, 0xa0, 0x55, 0x88, 0xb5, 0x87, 0xe9, 0x8e, 0x1f, 0xe0, 0x15, 0x4c,
-	0x75, 0x53, 0xa7, 0x7a, 0xe0, 0x87, 0x36, 0x4c, 0x35, 0xc5, 0x92, 0x70, 0xc3, 0x42, 0xf7, 0xbc,
-	0x9d, 0xc3, 0xf0, 0xbc, 0x03, 0xb9, 0xad, 0x4d, 0x01, 0xbd, 0x24, 0x0d, 0xd8, 0xc7, 0xbc, 0x57,
-	0x10, 0x60, 0x03, 0x9d, 0xa1, 0xaa, 0x33, 0x20, 0x5d, 0x80, 0xf9, 0x58, 0x38, 0x02, 0xee, 0x9f,
-	0xd3, 0x70, 0x9d, 0x3f, 0xe9, 0xc1, 0x43, 0x15, 0xbc, 0x19, 0xff, 0x0f, 0x45, 0x72, 0x5f, 0xa1,
-	0x3b, 0x79, 0xf6, 0x42, 0x37, 0x33, 0xbe, 0x42, 0xf7, 0xa5, 0x93, 0x15, 0xba, 0x53, 0xa7, 0x2b,
-	0x74, 0xb3, 0x27, 0x2e, 0x74, 0x61, 0xb4, 0x42, 0x37, 0x27, 0x2d, 0x74, 0xcf, 0x27, 0x17, 0xba,
-	0x17, 0x86, 0x17, 0xba, 0x37, 0xe1, 0x86, 0x3c, 0xa8, 0x44, 0xf4, 0xfd, 0x49, 0x81, 0x65, 0x3f,
-	0x3a, 0x99, 0x0b, 0x1f, 0x38, 0x86, 0x87, 0x75, 0x82, 0x1f, 0x7a, 0x6e, 0xcb, 0x25, 0x7a, 0xe3,
-	0xcc, 0xa1, 0xb7, 0x06, 0xd3, 0x54, 0xf7, 0x2c, 0x4c, 0xc3, 0x10, 0x13, 0x59, 0xc3, 0x57, 0x83,
-	0x20, 0xbb, 0x0b, 0x59, 0xbd, 0x4d, 0x0f, 0x5c, 0xcf, 0xa6, 0xc7, 0x3c, 0x46, 0xb7, 0xd4, 0x4f,
-	0x3e, 0x5c, 0x9f, 0x15, 0x5a, 0x04, 0xdb, 0x1e, 0xf5, 0x6c, 0xc7, 0xaa, 0x76, 0x59, 0x37, 0xd1,
-	0x3f, 0x7f, 0xba, 0xa4, 0xf8, 0xd8, 0xbb, 0x6b, 0xab, 0xd7, 0x61, 0x45, 0x82, 0x47, 0xa0, 0xfe,
-	0xa4, 0x17, 0xf5, 0x0e, 0x8e, 0x47, 0x5d, 0x1f, 0x1d, 0x75, 0x51, 0x5c, 0x31, 0xb7, 0x46, 0x7c,
-	0x13, 0x43, 0x07, 0x45, 0x90, 0xa7, 0xc6, 0x87, 0x7c, 0x10, 0x93, 0x40, 0xfe, 0xc3, 0x14, 0xac,
-	0x56, 0x88, 0xf5, 0xf5, 0x96, 0x29, 0x4a, 0xdf, 0x68, 0x80, 0xca, 0x4b, 0x8d, 0x37, 0x41, 0xe3,
-	0x65, 0x7f, 0x2d, 0x2e, 0xea, 0x53, 0x2c, 0xea, 0x55, 0xce, 0x31, 0xb8, 0x35, 0xba, 0x0b, 0xd7,
-	0x74, 0xd3, 0x8c, 0x15, 0x9d, 0x60, 0xa2, 0x57, 0x74, 0xd3, 0x8c, 0x91, 0xbb, 0x0f, 0x28, 0xc8,
-	0xc5, 0x5a, 0xd7, 0x59, 0xe9, 0x21, 0xce, 0x9a, 0x09, 0x64, 0xca, 0xa1, 0xd3, 0xe6, 0x03, 0xa7,
-	0xc5, 0xec, 0xb7, 0xba, 0xc6, 0x6e, 0xe1, 0x64, 0xbf, 0x08, 0xff, 0xfd, 0x4a, 0x81, 0xc5, 0x90,
-	0x2f, 0x7a, 0x1b, 0xc8, 0x7d, 0x97, 0x78, 0xbd, 0xa4, 0x92, 0xaf, 0x97, 0x71, 0xe6, 0xc5, 0x0a,
-	0x2c, 0x25, 0xda, 0x2d, 0xb0, 0xbd, 0xc7, 0x27, 0x51, 0x7b, 0x98, 0x96, 0x0d, 0xc3, 0x0f, 0xcf,
-	0x9d, 0x9e, 0x67, 0x37, 0x1e, 0xd5, 0x2c, 0x4c, 0x76, 0xf4, 0x46, 0x1b, 0x8b, 0xbc, 0xe6, 0x1f,
-	0xe8, 0x0e, 0x64, 0x88, 0x6d, 0x39, 0xc1, 0x83, 0x23, 0x31, 0x5a, 0xf0, 0x6d, 0x5e, 0x0c, 0x2c,
-	0x16, 0x0b, 0x62, 0x8e, 0xd4, 0x6f, 0x8a, 0x30, 0xf4, 0x5f, 0x0a, 0xbc, 0x1c, 0x82, 0xd9, 0xc3,
-	0x8e, 0xb9, 0x83, 0x9d, 0x63, 0xff, 0x85, 0x90, 0x1b, 0x7b, 0x17, 0xae, 0x89, 0xf0, 0x35, 0xb1,
-	0x63, 0x77, 0x5b, 0xda, 0x30, 0x76, 0xaf, 0x70, 0xf2, 0x0e, 0xa3, 0x96, 0x03, 0x22, 0xba, 0x03,
-	0xb3, 0x7e, 0xe0, 0x0e, 0x08, 0xf1, 0xa8, 0x45, 0xba, 0x69, 0xf6, 0x4b, 0x44, 0x0e, 0x2e, 0x7d,
-	0xb6, 0x83, 0x5b, 0x82, 0x85, 0x04, 0xac, 0xc2, 0x1b, 0xbf, 0x56, 0x58, 0x81, 0x51, 0x36, 0xcd,
-	0xaf, 0x61, 0x5a, 0x26, 0x04, 0xd3, 0x6f, 0xf8, 0xa7, 0x30, 0x96, 0xfe, 0x7f, 0x0f, 0x2e, 0x39,
-	0xfe, 0xed, 0xed, 0xef, 0x5a, 0x63, 0x87, 0x1b, 0x4c, 0x33, 0xae, 0xc7, 0x3f, 0xe0, 0x11, 0x13,
-	0xc4, 0x6b, 0x30, 0xed, 0x44, 0xec, 0x8a, 0x2d, 0x92, 0x16, 0xd9, 0x89, 0xc6, 0x60, 0x10, 0x20,
-	0x7f, 0xab, 0xb0, 0x7b, 0xcb, 0x0f, 0x88, 0x5e, 0xb9, 0xfe, 0x3b, 0x3b, 0x1e, 0x6b, 0x77, 0x12,
-	0x93, 0x3a, 0xd5, 0x24, 0x66, 0xac, 0x89, 0xc8, 0x2f, 0x9a, 0x64, 0x20, 0x02, 0xf0, 0x2f, 0x15,
-	0x58, 0xab, 0x10, 0xab, 0xca, 0x22, 0xf2, 0x14, 0x98, 0x63, 0x26, 0x37, 0x3c, 0xc8, 0xfb, 0x26,
-	0x37, 0x63, 0xc5, 0x96, 0x87, 0x9b, 0xc3, 0x6c, 0x16, 0xf0, 0x7e, 0xc3, 0xef, 0xd1, 0xed, 0x03,
-	0xdd, 0xb1, 0x30, 0x1f, 0xae, 0x8e, 0x86, 0xab, 0x0c, 0xe0, 0xe0, 0xa3, 0x9a, 0x98, 0xdc, 0xa6,
-	0x46, 0x9e, 0xdc, 0x66, 0x1d, 0x7c, 0xc4, 0x7f, 0x7c, 0x01, 0xd7, 0x6a, 0x3c, 0x0c, 0x01, 0xf5,
-	0xfd, 0x14, 0x2b, 0x36, 0x82, 0x6e, 0xf6, 0x2d, 0x62, 0x78, 0xee, 0xd1, 0x68, 0x60, 0x8d, 0xb0,
-	0x04, 0x49, 0x0d, 0x6b, 0xcb, 0xef, 0x9c, 0xb4, 0x2d, 0x97, 0x14, 0x69, 0x13, 0x43, 0x8b, 0xb4,
-	0xf4, 0x38, 0x4a, 0x95, 0x24, 0x8f, 0x08, 0xbf, 0x3d, 0x0b, 0x53, 0x3e, 0xd2, 0x38, 0xf5, 0x7b,
-	0xee, 0x33, 0xea, 0x07, 0x4f, 0x5b, 0xb9, 0x4d, 0x27, 0x5d, 0x07, 0x09, 0x20, 0x85, 0x33, 0x7e,
-	0xc2, 0xe7, 0xbb, 0xfc, 0x19, 0x78, 0xa8, 0x7b, 0x7a, 0x33, 0xbc, 0xdf, 0x23, 0x96, 0x28, 0x23,
-	0x5b, 0x82, 0x36, 0x21, 0xd3, 0x62, 0x1b, 0x31, 0xf3, 0x73, 0xa5, 0x97, 0xe3, 0xb3, 0x88, 0x2b,
-	0x0b, 0x2e, 0x44, 0x2e, 0x31, 0x80, 0x82, 0x8f, 0x7a, 0xa3, 0xd6, 0x71, 0xcb, 0x4b, 0xff, 0x9e,
-	0x83, 0x89, 0x0a, 0xb1, 0x50, 0x0d, 0xa6, 0x82, 0x5e, 0x04, 0xe5, 0x13, 0x12, 0x76, 0x60, 0x24,
-	0xac, 0xbd, 0x32, 0x02, 0x27, 0x57, 0xe4, 0x2b, 0x08, 0x9a, 0x1c, 0x89, 0x82, 0xbe, 0xb1, 0xaf,
-	0x44, 0x41, 0xff, 0xe8, 0x16, 0x7d, 0x0b, 0x32, 0x7c, 0xa6, 0x8a, 0x6e, 0x26, 0x0a, 0x45, 0x06,
-	0xbb, 0xda, 0xad, 0xa1, 0x7c, 0xdd, 0xad, 0xf9, 0xd4, 0x54, 0xb2, 0x75, 0x64, 0x74, 0x2b, 0xd9,
-	0x3a, 0x3a, 0x7e, 0x45, 0x7b, 0x90, 0xae, 0xd8, 0x0e, 0x45, 0x37, 0x12, 0x05, 0x7a, 0x26, 0xb3,
-	0xda, 0xda, 0x10, 0xae, 0xee, 0xa6, 0x5b, 0x6d, 0xcf, 0x91, 0x6c, 0xda, 0x33, 0x55, 0x95, 0x6c,
-	0xda, 0x3b, 0xf6, 0x44, 0x75, 0xc8, 0x86, 0xbf, 0xd8, 0x40, 0x92, 0x73, 0xe9, 0xfb, 0x25, 0x8d,
-	0x76, 0x7b, 0x14, 0x56, 0xa1, 0xe3, 0x10, 0xce, 0xf7, 0xfe, 0x42, 0x02, 0xbd, 0x3a, 0xc4, 0x8d,
-	0x51, 0x4d, 0xeb, 0x23, 0x72, 0x77, 0x23, 0x32, 0xb8, 0xe3, 0x24, 0x11, 0xd9, 0x37, 0xe6, 0x95,
-	0x44, 0x64, 0xff, 0x40, 0x54, 0x78, 0x8c, 0xbf, 0x73, 0x72, 0x8f, 0x45, 0x66, 0x49, 0x72, 0x8f,
-	0x45, 0x27, 0x04, 0x3e, 0x88, 0xb0, 0x21, 0x49, 0x06, 0xd1, 0xd7, 0x04, 0x49, 0x40, 0xf4, 0xb7,
-	0x1d, 0xe8, 0x00, 0x72, 0x3d, 0x63, 0x40, 0xf4, 0xb9, 0x44, 0xc9, 0xc1, 0xa1, 0xa8, 0xf6, 0xea,
-	0x68, 0xcc, 0x42, 0xd3, 0x11, 0x5c, 0xea, 0xbf, 0x68, 0xd1, 0x9d, 0xc4, 0x1d, 0x12, 0x06, 0x90,
-	0xda, 0xc6, 0x09, 0x24, 0x84, 0xe2, 0x47, 0x30, 0x1d, 0xfd, 0x95, 0x38, 0x2a, 0x24, 0x6e, 0x12,
-	0xfb, 0x87, 0x00, 0x5a, 0x71, 0x64, 0x7e, 0xa1, 0xf2, 0x03, 0x05, 0xe6, 0x12, 0xc7, 0x3f, 0xe8,
-	0x0d, 0x59, 0x00, 0x48, 0xe7, 0x90, 0xda, 0xe6, 0x69, 0x44, 0x85, 0x51, 0xef, 0x29, 0x70, 0x35,
-	0x7e, 0x34, 0x83, 0xee, 0x26, 0x7b, 0x55, 0x36, 0x9b, 0xd2, 0x5e, 0x3f, 0xb1, 0xdc, 0x80, 0x2d,
-	0xfd, 0xc3, 0x92, 0xa1, 0xb6, 0x24, 0x4c, 0x8c, 0x86, 0xda, 0x92, 0x34, 0x95, 0x41, 0xdf, 0x57,
-	0x40, 0x4d, 0x1a, 0x3d, 0xa0, 0x7b, 0x89, 0xbb, 0x0e, 0x99, 0xe2, 0x68, 0x6f, 0x9c, 0x42, 0x52,
-	0x58, 0xf4, 0xae, 0x02, 0xb3, 0x71, 0xc3, 0x02, 0xf4, 0xf9, 0x21, 0x7b, 0xc6, 0xce, 0x44, 0xb4,
-	0x2f, 0x9c, 0x50, 0xaa, 0x9b, 0x37, 0xd1, 0x11, 0x80, 0x24, 0x6f, 0x62, 0xc7, 0x16, 0x92, 0xbc,
-	0x89, 0x9f, 0x2d, 0xa0, 0xef, 0x00, 0x1a, 0xec, 0xb5, 0x51, 0x69, 0x88, 0xfd, 0x31, 0x43, 0x08,
-	0xed, 0xb5, 0x13, 0xc9, 0x08, 0xf5, 0xef, 0xc0, 0xcc, 0x40, 0x13, 0x8c, 0x36, 0x64, 0x29, 0x17,
-	0xdb, 0xf4, 0x6b, 0xa5, 0x93, 0x88, 0xf4, 0x44, 0x61, 0x52, 0x5f, 0x2a, 0x89, 0xc2, 0x21, 0x3d,
-	0xb9, 0x24, 0x0a, 0x87, 0x35, 0xc1, 0xe8, 0x47, 0x0a, 0xcc, 0x4b, 0xba, 0x49, 0xf4, 0xc5, 0xc4,
-	0xad, 0x87, 0xf7, 0xcd, 0xda, 0x9b, 0xa7, 0x13, 0xee, 0x49, 0x90, 0xb8, 0xb6, 0x4f, 0x92, 0x20,
-	0x92, 0x66, 0x57, 0x92, 0x20, 0xb2, 0xde, 0x92, 0x5d, 0x62, 0xf1, 0x6d, 0x94, 0xe4, 0x12, 0x93,
-	0x76, 0xa2, 0x92, 0x4b, 0x4c, 0xde, 0xaf, 0x05, 0xe1, 0x13, 0xdb, 0xc7, 0xc8, 0xc3, 0x47, 0xd6,
-	0xdf, 0xc9, 0xc3, 0x47, 0xda, 0x34, 0xf9, 0xc5, 0x5e, 0x6f, 0x4b, 0x22, 0x29, 0xf6, 0x62, 0xfa,
-	0x2a, 0x49, 0xb1, 0x17, 0xd7, 0xe7, 0x68, 0x93, 0xdf, 0x7d, 0xfe, 0xe4, 0xb6, 0xb2, 0x65, 0x7d,
-	0xf4, 0x74, 0x51, 0xf9, 0xf8, 0xe9, 0xa2, 0xf2, 0xf7, 0xa7, 0x8b, 0xca, 0xfb, 0xcf, 0x16, 0xcf,
-	0x7d, 0xfc, 0x6c, 0xf1, 0xdc, 0x5f, 0x9e, 0x2d, 0x9e, 0x83, 0x6b, 0xb6, 0x1b, 0xbb, 0xe3, 0x43,
-	0xe5, 0xdb, 0xbd, 0xad, 0x68, 0x97, 0x65, 0xdd, 0x76, 0x7b, 0xbe, 0x8a, 0x8f, 0x83, 0x3f, 0x23,
-	0x64, 0x3d, 0x69, 0x3d, 0xc3, 0xfe, 0x52, 0xef, 0xb5, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5c,
-	0x2e, 0xee, 0x73, 0x9f, 0x29, 0x00, 0x00,
+	// 2478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0x78, 0x6d, 0xd7, 0x7b, 0x36, 0x71, 0xe2, 0x6b, 0xc7, 0x99, 0x4c, 0x12, 0x7b, 0xe3,
+	0xc4, 0x89, 0x13, 0xea, 0x9d, 0xd8, 0xa5, 0xf9, 0x30, 0x95, 0xd0, 0xda, 0x6e, 0x42, 0x44, 0x17,
+	0x45, 0xeb, 0x02, 0xa2, 0x2f, 0xab, 0xd9, 0x99, 0xeb, 0xc9, 0xc8, 0xbb, 0x33, 0x9b, 0xb9, 0xb3,
+	0xeb, 0xb8, 0x12, 0x12, 0xa2, 0x12, 0x52, 0x9f, 0x28, 0x7d, 0x40, 0x08, 0xf1, 0xc0, 0x13, 0x42,
+	0x3c, 0x55, 0xa8, 0xe2, 0x0f, 0x40, 0x42, 0x14, 0x10, 0xa8, 0x2a, 0x2f, 0x88, 0x87, 0x82, 0x12,
+	0x41, 0x11, 0x7f, 0x04, 0xa0, 0x99, 0x7b, 0xe6, 0x6b, 0x77, 0x66, 0xf6, 0xc3, 0x1b, 0x95, 0x97,
+	0xc4, 0x33, 0xe7, 0x9c, 0x7b, 0xce, 0xef, 0xdc, 0x73, 0xef, 0x3d, 0xf7, 0x37, 0x0b, 0x97, 0x5a,
+	0xb6, 0xd5, 0xa1, 0xa6, 0x62, 0xaa, 0x54, 0x6e, 0x2a, 0xf6, 0x01, 0xb5, 0xe5, 0xce, 0x86, 0xec,
+	0x3c, 0x2d, 0xb5, 0x6c, 0xcb, 0xb1, 0xc8, 0x42, 0x28, 0x2e, 0x71, 0x71, 0xa9, 0xb3, 0x21, 0xcd,
+	0x29, 0x4d, 0xc3, 0xb4, 0x64, 0xef, 0x5f, 0xae, 0x28, 0x9d, 0xd7, 0x2d, 0x4b, 0x6f, 0x50, 0xd9,
+	0x7b, 0xaa, 0xb7, 0xf7, 0x65, 0xc5, 0x3c, 0xf2, 0x45, 0xaa, 0xc5, 0x9a, 0x16, 0xab, 0x79, 0x4f,
+	0x32, 0x7f, 0x40, 0xd1, 0x82, 0x6e, 0xe9, 0x16, 0x7f, 0xef, 0xfe, 0x85, 0x6f, 0x97, 0xb8, 0x8e,
+	0x5c, 0x57, 0x18, 0x95, 0x3b, 0x1b, 0x75, 0xea, 0x28, 0x1b, 0xb2, 0x6a, 0x19, 0x66, 0x8f, 0xdc,
+	0x3c, 0x08, 0xe4, 0xee, 0x03, 0xca, 0xcf, 0xa1, 0xbc, 0xc9, 0x74, 0x17, 0x4c, 0x93, 0xe9, 0x28,
+	0x58, 0x35, 0xea, 0xaa, 0xac, 0xb4, 0x5a, 0x0d, 0x43, 0x55, 0x1c, 0xc3, 0x32, 0x99, 0xec, 0xd8,
+	0x8a, 0xc9, 0xf6, 0xe3, 0xa0, 0xa5, 0xcb, 0x89, 0x39, 0x41, 0xf8, 0x5c, 0xe5, 0x5a, 0xa2, 0x8a,
+	0xa2, 0xaa, 0x94, 0x31, 0xdd, 0x56, 0x4c, 0x07, 0xf5, 0xae, 0x26, 0xea, 0x31, 0x6a, 0x6a, 0x0d,
+	0xa3, 0x69, 0xa0, 0xd6, 0xca, 0x1f, 0x04, 0x10, 0x2b, 0x4c, 0x7f, 0xe0, 0x1a, 0x96, 0x1b, 0x0d,
+	0xeb, 0xd0, 0xd5, 0xaf, 0xd2, 0x27, 0x6d, 0xca, 0x1c, 0xb2, 0x00, 0x53, 0x1a, 0x35, 0xad, 0xa6,
+	0x28, 0x14, 0x85, 0xb5, 0x7c, 0x95, 0x3f, 0x90, 0xab, 0x70, 0x4a, 0xd1, 0x9a, 0x86, 0x69, 0x30,
+	0xc7, 0x56, 0x1c, 0xcb, 0x16, 0x27, 0x3c, 0x69, 0xfc, 0x25, 0x11, 0xe1, 0x25, 0x2f, 0x1a, 0x4a,
+	0xc5, 0x9c, 0x27, 0xf7, 0x1f, 0xc9, 0xeb, 0x90, 0x57, 0x7c, 0x4f, 0xe2, 0x64, 0x51, 0x58, 0x2b,
+	0x6c, 0x2e, 0x94, 0xf8, 0x1c, 0x96, 0xfc, 0x39, 0x2c, 0x95, 0xcd, 0xa3, 0xed, 0xb9, 0xdf, 0x7f,
+	0xb8, 0x7e, 0xea, 0x3e, 0xa5, 0x41, 0x5c, 0x0f, 0xab, 0xa1, 0xe5, 0x16, 0xf9, 0xee, 0x67, 0x1f,
+	0xdc, 0x8c, 0x3b, 0x5d, 0xb9, 0x00, 0xe7, 0x13, 0xc0, 0xb0, 0x96, 0x65, 0x32, 0xba, 0xf2, 0xdf,
+	0x49, 0x98, 0xaf, 0x30, 0xbd, 0xac, 0x69, 0x15, 0x2f, 0x1d, 0x3e, 0xca, 0x3b, 0x30, 0xad, 0x34,
+	0xad, 0xb6, 0xe9, 0x78, 0x30, 0x0b, 0x9b, 0xe7, 0x4b, 0x58, 0x28, 0x6e, 0x11, 0x94, 0x70, 0x92,
+	0x4b, 0x3b, 0x96, 0x61, 0x6e, 0x4f, 0x7e, 0xf4, 0xe9, 0xf2, 0x89, 0x2a, 0xaa, 0xbb, 0x10, 0x9b,
+	0x8a, 0xa9, 0xe8, 0xd4, 0xf6, 0x21, 0xe2, 0x23, 0xb9, 0x0c, 0x27, 0xf7, 0x6d, 0xab, 0x59, 0x53,
+	0x34, 0xcd, 0xa6, 0x8c, 0x79, 0x28, 0xf3, 0xd5, 0x82, 0xfb, 0xae, 0xcc, 0x5f, 0x91, 0x2d, 0x98,
+	0x66, 0x8e, 0xe2, 0xb4, 0x99, 0x38, 0x55, 0x14, 0xd6, 0x66, 0x37, 0x57, 0x4a, 0x49, 0xf5, 0x5e,
+	0xe2, 0xa1, 0xee, 0x79, 0x9a, 0x55, 0xb4, 0x20, 0x65, 0x28, 0x70, 0x8d, 0x9a, 0x73, 0xd4, 0xa2,
+	0xe2, 0xb4, 0x37, 0x40, 0x31, 0x6b, 0x80, 0x37, 0x8f, 0x5a, 0xb4, 0x0a, 0xcd, 0xe0, 0x6f, 0xf2,
+	0x15, 0x28, 0xf0, 0x92, 0xa9, 0x35, 0x0c, 0xe6, 0x88, 0x2f, 0x15, 0x73, 0x6b, 0x85, 0xcd, 0xcb,
+	0xc9, 0x43, 0x94, 0x3d, 0x45, 0x2f, 0xab, 0x98, 0x01, 0xe0, 0xb6, 0x6f, 0x18, 0xcc, 0x71, 0xb1,
+	0xb2, 0x76, 0xab, 0xd5, 0x38, 0xaa, 0xed, 0x1b, 0x4f, 0xa9, 0x26, 0xce, 0x14, 0x85, 0xb5, 0x99,
+	0x6a, 0x81, 0xbf, 0xbb, 0xef, 0xbe, 0x22, 0x77, 0x41, 0xf4, 0xe6, 0xad, 0xa6, 0x5b, 0x1d, 0x6a,
+	0x7b, 0xc3, 0xd7, 0x54, 0xcb, 0x74, 0x6c, 0xab, 0x21, 0xe6, 0x3d, 0xf5, 0x45, 0x4f, 0xfe, 0x20,
+	0x10, 0xef, 0x70, 0x29, 0xd9, 0x84, 0xb3, 0xdc, 0x72, 0xdf, 0xb2, 0x55, 0xaa, 0xd5, 0xfc, 0x45,
+	0x23, 0x82, 0x67, 0x36, 0xef, 0x09, 0xef, 0x7b, 0xb2, 0x37, 0x51, 0x44, 0x64, 0x98, 0xb7, 0xe9,
+	0x93, 0xb6, 0x61, 0x53, 0xad, 0xa6, 0x38, 0x8e, 0x6d, 0xd4, 0xdb, 0x0e, 0x65, 0x62, 0xa1, 0x98,
+	0x5b, 0xcb, 0x57, 0x89, 0x2f, 0x2a, 0x07, 0x12, 0xb2, 0x0c, 0xf9, 0x36, 0xd3, 0x6a, 0x2a, 0x35,
+	0x1d, 0x26, 0x9e, 0x2c, 0x0a, 0x6b, 0x93, 0xdb, 0x13, 0xa2, 0x50, 0x9d, 0x69, 0x33, 0x6d, 0xc7,
+	0x7d, 0x47, 0x16, 0x61, 0xba, 0x63, 0x35, 0xda, 0x4d, 0x2a, 0x9e, 0x72, 0xa5, 0x55, 0x7c, 0x22,
+	0x17, 0xb8, 0x61, 0xd3, 0x68, 0x34, 0x98, 0x38, 0xeb, 0x89, 0x5c, 0xa3, 0x8a, 0xfb, 0xbc, 0x35,
+	0xe7, 0xd6, 0x67, 0xac, 0x0c, 0x56, 0x16, 0x61, 0x21, 0x5e, 0x80, 0x58, 0x99, 0x3f, 0x13, 0xfc,
+	0xca, 0xe4, 0xa9, 0x1e, 0xc7, 0xfa, 0xfb, 0x32, 0x4c, 0xf3, 0x49, 0x12, 0x73, 0xc3, 0xcd, 0x2d,
+	0x9a, 0x25, 0xae, 0xaf, 0x00, 0x80, 0x1f, 0x27, 0x02, 0xf8, 0x81, 0x00, 0x8b, 0x15, 0xa6, 0xef,
+	0xd2, 0x06, 0x75, 0xe8, 0xf8, 0x30, 0x5c, 0x87, 0xd3, 0x36, 0x6d, 0x5a, 0x1d, 0xaa, 0xf9, 0x29,
+	0xc4, 0x85, 0x36, 0x8b, 0xaf, 0x71, 0x31, 0x25, 0xc6, 0x7a, 0x1e, 0xce, 0xf5, 0x84, 0x84, 0xe1,
+	0x6a, 0x40, 0x2a, 0x4c, 0xbf, 0x6f, 0x98, 0x4a, 0xc3, 0x78, 0x7b, 0x1c, 0xbb, 0x5d, 0x62, 0x00,
+	0x67, 0x61, 0x3e, 0xe6, 0x25, 0xe6, 0xbc, 0xac, 0x3a, 0x46, 0x47, 0x71, 0x5e, 0xb0, 0xf3, 0xd0,
+	0x0b, 0x3a, 0xaf, 0xc3, 0x99, 0x0a, 0xd3, 0x77, 0xdc, 0x22, 0x68, 0xbc, 0x28, 0xd7, 0xf3, 0x30,
+	0x17, 0xf1, 0x11, 0x73, 0xcc, 0x67, 0xe3, 0xc5, 0x3a, 0xf6, 0x7d, 0xa0, 0xe3, 0x77, 0x04, 0x98,
+	0xad, 0x30, 0xbd, 0x62, 0x98, 0xce, 0xb1, 0x37, 0xfc, 0xd1, 0x43, 0x9b, 0x83, 0xd3, 0x41, 0x10,
+	0xf1, 0xc0, 0xb6, 0xdb, 0xb6, 0xf9, 0xb9, 0x07, 0xc6, 0x83, 0xc0, 0xc0, 0xfe, 0x23, 0x78, 0x15,
+	0xfa, 0x4d, 0xc3, 0x79, 0xac, 0xd9, 0xca, 0xe1, 0x38, 0x16, 0xf2, 0x25, 0x00, 0xc7, 0xea, 0x5a,
+	0xc3, 0x79, 0xc7, 0xf2, 0xcf, 0xc2, 0xa3, 0x00, 0xf7, 0x64, 0x31, 0x97, 0x8d, 0xfb, 0xbe, 0x8b,
+	0xfb, 0x17, 0x7f, 0x5b, 0x5e, 0xd3, 0x0d, 0xe7, 0x71, 0xbb, 0x5e, 0x52, 0xad, 0x26, 0xf6, 0x75,
+	0xf8, 0xdf, 0x3a, 0xd3, 0x0e, 0x64, 0xf7, 0x58, 0x64, 0x9e, 0x01, 0xfb, 0xb1, 0xbb, 0x0b, 0x37,
+	0xa8, 0xae, 0xa8, 0x47, 0x35, 0xb7, 0x91, 0x63, 0x3f, 0xff, 0xec, 0x83, 0x9b, 0x82, 0x9f, 0xb9,
+	0x8c, 0xb5, 0x13, 0xe2, 0xc7, 0xbc, 0xfc, 0x8e, 0xe7, 0xc5, 0x3f, 0x67, 0xc6, 0x3f, 0x69, 0xb9,
+	0xa4, 0xd4, 0x0d, 0xd0, 0x4a, 0xc4, 0xb3, 0x3b, 0xd5, 0x95, 0xdd, 0x0c, 0x88, 0x21, 0x14, 0x84,
+	0xf8, 0x4f, 0x01, 0xce, 0x56, 0x98, 0xfe, 0xb0, 0xae, 0x76, 0xa3, 0x7c, 0x5f, 0x80, 0x99, 0xe0,
+	0xf0, 0xe5, 0x40, 0x6f, 0x94, 0x8c, 0xba, 0x5a, 0x8a, 0xf6, 0xb4, 0x25, 0x5f, 0xc3, 0x6b, 0x3c,
+	0xc2, 0xf1, 0xb7, 0xbf, 0xea, 0x02, 0xff, 0xeb, 0xa7, 0xcb, 0x3b, 0xbd, 0xb3, 0x66, 0xd4, 0xd5,
+	0x75, 0xdd, 0x92, 0x3b, 0x77, 0xe5, 0xa6, 0xa5, 0xb5, 0x1b, 0x94, 0xb9, 0x5d, 0x72, 0xa4, 0x3b,
+	0xe6, 0x53, 0x19, 0x0d, 0x36, 0x88, 0xe3, 0x18, 0x65, 0x2f, 0xc2, 0x62, 0x37, 0x4e, 0x4c, 0xc1,
+	0x1f, 0x05, 0x90, 0x2a, 0x4c, 0xdf, 0xa3, 0xce, 0xae, 0x5b, 0xe0, 0x15, 0xea, 0x28, 0x9a, 0xe2,
+	0x28, 0x7e, 0x1e, 0xda, 0x30, 0xd3, 0xc4, 0x57, 0x98, 0x86, 0x4b, 0xe1, 0x7c, 0x9b, 0x07, 0xc1,
+	0x7c, 0xfb, 0x76, 0xdb, 0x5b, 0x08, 0x7d, 0x33, 0xb3, 0x60, 0x9f, 0xf2, 0x1b, 0x05, 0x82, 0xf5,
+	0x7d, 0x06, 0xae, 0x8e, 0x81, 0xf4, 0x12, 0x5c, 0x48, 0x84, 0x83, 0x70, 0xff, 0x3c, 0x09, 0x57,
+	0xf8, 0x91, 0xee, 0x1f, 0x54, 0xfe, 0x99, 0xf1, 0xff, 0xd0, 0x24, 0x77, 0x35, 0xba, 0x53, 0xc7,
+	0x6f, 0x74, 0xa7, 0xc7, 0xd7, 0xe8, 0xbe, 0x34, 0x5c, 0xa3, 0x3b, 0x33, 0x5a, 0xa3, 0x9b, 0x1f,
+	0xba, 0xd1, 0x85, 0xc1, 0x1a, 0xdd, 0x42, 0x66, 0xa3, 0x7b, 0x32, 0xbd, 0xd1, 0x3d, 0xd5, 0xbf,
+	0xd1, 0xbd, 0x06, 0x57, 0xb3, 0x8b, 0x0a, 0xab, 0xef, 0x4f, 0x02, 0x14, 0xdd, 0xea, 0xf4, 0x52,
+	0xf8, 0xd0, 0x54, 0x6d, 0xaa, 0x30, 0xfa, 0xc8, 0xb6, 0x5a, 0x16, 0x53, 0x1a, 0xc7, 0x2e, 0xbd,
+	0x55, 0x98, 0x75, 0x14, 0x5b, 0xa7, 0x4e, 0x50, 0x62, 0xb8, 0x6a, 0xf8, 0x5b, 0xbf, 0xc8, 0x6e,
+	0x43, 0x5e, 0x69, 0x3b, 0x8f, 0x2d, 0xdb, 0x70, 0x8e, 0x78, 0x8d, 0x6e, 0x8b, 0x9f, 0x7c, 0xb8,
+	0xbe, 0x80, 0x5e, 0x50, 0x6d, 0xcf, 0xb1, 0x0d, 0x53, 0xaf, 0x86, 0xaa, 0x5b, 0xe4, 0x5f, 0x3f,
+	0x5d, 0x16, 0x5c, 0xec, 0xe1, 0xbb, 0x95, 0x2b, 0x70, 0x39, 0x03, 0x0f, 0xa2, 0xfe, 0x24, 0x8a,
+	0x7a, 0x97, 0x26, 0xa3, 0xae, 0x0f, 0x8e, 0x5a, 0xc6, 0x2d, 0xe6, 0xfa, 0x80, 0x67, 0x62, 0x90,
+	0xa0, 0x18, 0xf2, 0x89, 0xf1, 0x21, 0xdf, 0xa5, 0x29, 0xc8, 0x7f, 0x38, 0x01, 0x2b, 0x15, 0xa6,
+	0x7f, 0xbd, 0xa5, 0x61, 0xeb, 0x1b, 0x2f, 0xd0, 0xec, 0x56, 0xe3, 0x35, 0x90, 0x78, 0xdb, 0x5f,
+	0x4b, 0xaa, 0xfa, 0x09, 0xaf, 0xea, 0x45, 0xae, 0xd1, 0x3b, 0x34, 0xb9, 0x0d, 0xe7, 0x14, 0x4d,
+	0x4b, 0x34, 0xcd, 0x79, 0xa6, 0x67, 0x15, 0x4d, 0x4b, 0xb0, 0x7b, 0x00, 0xc4, 0x5f, 0x8b, 0xb5,
+	0x30, 0x59, 0x93, 0x7d, 0x92, 0x35, 0xe7, 0xdb, 0x94, 0x83, 0xa4, 0x5d, 0xf0, 0x93, 0x96, 0x30,
+	0xde, 0xca, 0x2a, 0x5c, 0xc9, 0xcc, 0x0b, 0xe6, 0xef, 0x57, 0x02, 0x2c, 0x05, 0x7a, 0xf1, 0xdd,
+	0x20, 0x3b, 0x77, 0xa9, 0xdb, 0xcb, 0x44, 0xfa, 0xf6, 0x32, 0xce, 0x75, 0x71, 0x19, 0x96, 0x53,
+	0xe3, 0x46, 0x6c, 0xef, 0x72, 0x26, 0x6a, 0x8f, 0x3a, 0x65, 0x55, 0x75, 0xcb, 0x73, 0x37, 0x72,
+	0xec, 0x26, 0xa3, 0x5a, 0x80, 0xa9, 0x8e, 0xd2, 0x68, 0x53, 0x5c, 0xd7, 0xfc, 0x81, 0xdc, 0x82,
+	0x69, 0x66, 0xe8, 0x26, 0xb5, 0xfb, 0x06, 0x8d, 0x7a, 0x5b, 0xa7, 0xfd, 0x88, 0xf1, 0x05, 0xf2,
+	0x48, 0xdd, 0xa1, 0x60, 0xa0, 0xff, 0x16, 0xe0, 0x62, 0x00, 0x66, 0x8f, 0x9a, 0xda, 0x2e, 0x35,
+	0x8f, 0xdc, 0x13, 0x22, 0x3b, 0xd8, 0xdb, 0x70, 0x0e, 0xcb, 0x57, 0xa3, 0xa6, 0x11, 0x5e, 0x69,
+	0x83, 0xda, 0x3d, 0xcb, 0xc5, 0xbb, 0x9e, 0xb4, 0xec, 0x0b, 0xc9, 0x2d, 0x58, 0x70, 0x0b, 0xb7,
+	0xc7, 0x88, 0x57, 0x2d, 0x51, 0x34, 0xad, 0xdb, 0x22, 0x36, 0x71, 0x93, 0xc7, 0x9b, 0xb8, 0x65,
+	0xb8, 0x94, 0x82, 0x15, 0xb3, 0xf1, 0x6b, 0xc1, 0x6b, 0x30, 0xca, 0x9a, 0xf6, 0x35, 0xea, 0x94,
+	0x19, 0xa3, 0xce, 0x37, 0xdc, 0x59, 0x18, 0xcb, 0xfd, 0x7f, 0x0f, 0xce, 0x98, 0xee, 0xee, 0xed,
+	0x8e, 0x5a, 0xf3, 0x26, 0xd7, 0x67, 0x33, 0xae, 0x24, 0x1f, 0xe0, 0xb1, 0x10, 0xf0, 0x34, 0x98,
+	0x35, 0x63, 0x71, 0x25, 0x36, 0x49, 0x4b, 0x70, 0x31, 0x19, 0x03, 0x82, 0xfc, 0xad, 0x00, 0x2b,
+	0x58, 0x10, 0x51, 0xbb, 0xee, 0x3d, 0x3b, 0x19, 0x6b, 0xc8, 0xc4, 0x4c, 0x8c, 0xc4, 0xc4, 0x8c,
+	0x75, 0x21, 0xf2, 0x8d, 0x26, 0x1d, 0x08, 0x02, 0xfe, 0xa5, 0x00, 0xab, 0x15, 0xa6, 0x57, 0xbd,
+	0x8a, 0x1c, 0x01, 0x73, 0x02, 0x73, 0xc3, 0x8b, 0xbc, 0x8b, 0xb9, 0x19, 0x2b, 0xb6, 0x35, 0xb8,
+	0xd6, 0x2f, 0x66, 0x84, 0xf7, 0x1b, 0xbe, 0x8f, 0xee, 0x3c, 0x56, 0x4c, 0x9d, 0x72, 0x72, 0x75,
+	0x30, 0x5c, 0x65, 0x00, 0x93, 0x1e, 0xd6, 0x90, 0xb9, 0x9d, 0x18, 0x98, 0xb9, 0xcd, 0x9b, 0xf4,
+	0x90, 0xff, 0xf9, 0x02, 0xb6, 0xd5, 0x64, 0x18, 0x08, 0xf5, 0xbd, 0x09, 0x28, 0x46, 0x6e, 0xb3,
+	0xaf, 0x33, 0xd5, 0xb6, 0x0e, 0x07, 0x03, 0xab, 0x06, 0x2d, 0xc8, 0x44, 0xbf, 0x6b, 0xf9, 0xad,
+	0x61, 0xaf, 0xe5, 0x19, 0x4d, 0x5a, 0xae, 0x6f, 0x93, 0x36, 0x39, 0x8e, 0x56, 0x25, 0x2d, 0x23,
+	0x98, 0xb7, 0xe7, 0xc1, 0x92, 0x8f, 0x5d, 0x9c, 0xba, 0x33, 0xf7, 0x39, 0xdd, 0x07, 0x47, 0xed,
+	0xdc, 0x66, 0xd3, 0xb6, 0x83, 0x14, 0x90, 0x98, 0x8c, 0x9f, 0x70, 0x7e, 0x97, 0x1f, 0x03, 0x8f,
+	0x14, 0x5b, 0x69, 0x06, 0xfb, 0x7b, 0x2c, 0x12, 0x61, 0xe0, 0x48, 0xdc, 0xef, 0x1f, 0x2d, 0x6f,
+	0x20, 0x2f, 0xfc, 0xc2, 0xe6, 0xc5, 0xe4, 0x55, 0xc4, 0x9d, 0xf9, 0x1b, 0x22, 0xb7, 0xe8, 0x41,
+	0xc1, 0xa9, 0xde, 0x78, 0x74, 0x18, 0xf9, 0xf7, 0x72, 0x20, 0x05, 0x32, 0xf7, 0x00, 0x7b, 0xc3,
+	0xfd, 0xf8, 0xd5, 0xe7, 0x74, 0xda, 0x85, 0x53, 0x1a, 0xdd, 0x57, 0xda, 0x0d, 0xa7, 0xe6, 0x7d,
+	0x2b, 0xc3, 0x10, 0x97, 0x93, 0x43, 0x0c, 0x46, 0xad, 0x9e, 0x44, 0x2b, 0xef, 0xc9, 0x3d, 0xb8,
+	0x83, 0x03, 0x3f, 0x3a, 0x58, 0xce, 0x6b, 0xb9, 0x88, 0x7f, 0xda, 0x47, 0x2c, 0xde, 0x02, 0xc2,
+	0xc2, 0x85, 0xc0, 0xd5, 0x19, 0x72, 0x62, 0xd7, 0x52, 0x4e, 0x0d, 0xcc, 0xaf, 0x1f, 0x03, 0x66,
+	0xea, 0x0c, 0x0b, 0x96, 0x0e, 0x07, 0xec, 0x76, 0x80, 0x18, 0x4d, 0xd7, 0xf0, 0x53, 0xde, 0xbe,
+	0x3c, 0x6f, 0xe3, 0xb6, 0x19, 0xb5, 0x89, 0xcd, 0xed, 0xf4, 0xf1, 0x16, 0x1d, 0xe7, 0x21, 0x7a,
+	0xe7, 0x81, 0xcf, 0xd3, 0xe6, 0x3f, 0x24, 0xc8, 0x55, 0x98, 0x4e, 0x6a, 0x30, 0xe3, 0xdf, 0x19,
+	0xc9, 0x5a, 0xca, 0xc6, 0xda, 0x43, 0xdd, 0x4b, 0x37, 0x06, 0xd0, 0xe4, 0x8e, 0x5c, 0x07, 0xfe,
+	0x65, 0x34, 0xc3, 0x41, 0x17, 0x3d, 0x2f, 0xdd, 0x18, 0x40, 0x13, 0x1d, 0x7c, 0x0b, 0xa6, 0x39,
+	0xf7, 0x4d, 0xae, 0xa5, 0x1a, 0xc5, 0x08, 0x78, 0xe9, 0x7a, 0x5f, 0xbd, 0x70, 0x68, 0xce, 0x6e,
+	0x67, 0x0c, 0x1d, 0xa3, 0xd8, 0xa5, 0xeb, 0x7d, 0xf5, 0x70, 0xe8, 0x3d, 0x98, 0x74, 0xd9, 0x69,
+	0x72, 0x35, 0xd5, 0x20, 0xc2, 0xa0, 0x4b, 0xab, 0x7d, 0xb4, 0xc2, 0x41, 0x5d, 0x66, 0x39, 0x63,
+	0xd0, 0x08, 0xfb, 0x2d, 0xad, 0xf6, 0xd1, 0xc2, 0x41, 0xeb, 0x90, 0x0f, 0x3e, 0x40, 0x91, 0x8c,
+	0x79, 0xe9, 0xfa, 0x98, 0x26, 0xdd, 0x1c, 0x44, 0x15, 0x7d, 0x1c, 0xc0, 0xc9, 0xe8, 0x87, 0x23,
+	0xf2, 0x72, 0x9f, 0x34, 0xc6, 0x3d, 0xad, 0x0f, 0xa8, 0x1d, 0x56, 0xa4, 0x7f, 0x16, 0x65, 0x54,
+	0x64, 0x17, 0x1d, 0x2f, 0xdd, 0x18, 0x40, 0x33, 0x96, 0x31, 0xde, 0x8f, 0x64, 0x67, 0x2c, 0xc6,
+	0xf9, 0x49, 0x37, 0x07, 0x51, 0x0d, 0x41, 0x04, 0x17, 0xc7, 0x74, 0x10, 0x5d, 0x97, 0x55, 0xe9,
+	0xc6, 0x00, 0x9a, 0xe8, 0xe0, 0x31, 0x14, 0x22, 0x74, 0x2d, 0xf9, 0x42, 0xaa, 0x65, 0x2f, 0x79,
+	0x2d, 0xbd, 0x3c, 0x98, 0x32, 0x7a, 0x3a, 0x84, 0x33, 0xdd, 0x07, 0x22, 0xb9, 0x95, 0x3a, 0x42,
+	0x0a, 0x51, 0x2c, 0x6d, 0x0c, 0x61, 0x81, 0x8e, 0x9f, 0xc0, 0x6c, 0xfc, 0xa7, 0x0b, 0xa4, 0x94,
+	0x3a, 0x48, 0xe2, 0x0f, 0x36, 0x24, 0x79, 0x60, 0x7d, 0x74, 0xf9, 0xbe, 0x00, 0xe7, 0x53, 0x69,
+	0x3a, 0x72, 0x2f, 0xab, 0x00, 0x32, 0xf9, 0x62, 0x69, 0x6b, 0x14, 0x53, 0x0c, 0xea, 0x5d, 0x01,
+	0x16, 0x93, 0x29, 0x34, 0x72, 0x3b, 0x3d, 0xab, 0x59, 0x1c, 0xa2, 0x74, 0x67, 0x68, 0xbb, 0x9e,
+	0x58, 0x76, 0xe9, 0x90, 0xb1, 0xec, 0xd2, 0xd1, 0x62, 0x49, 0x63, 0xcf, 0xc8, 0xf7, 0x05, 0x10,
+	0xd3, 0x28, 0x22, 0x72, 0x37, 0x75, 0xd4, 0x3e, 0x6c, 0x9b, 0x74, 0x6f, 0x04, 0x4b, 0x8c, 0xe8,
+	0x1d, 0x01, 0x16, 0x92, 0x48, 0x1d, 0xf2, 0xc5, 0x3e, 0x63, 0x26, 0x72, 0x57, 0xd2, 0xab, 0x43,
+	0x5a, 0x85, 0xeb, 0x26, 0x4e, 0xd5, 0x64, 0xac, 0x9b, 0x44, 0x7a, 0x49, 0x92, 0x07, 0xd6, 0x47,
+	0x97, 0xdf, 0x06, 0xd2, 0xcb, 0x89, 0x90, 0xcd, 0x3e, 0xf1, 0x27, 0x90, 0x45, 0xd2, 0x2b, 0x43,
+	0xd9, 0xa0, 0xfb, 0xb7, 0x61, 0xae, 0x87, 0xac, 0x20, 0x1b, 0x59, 0x4b, 0x2e, 0x91, 0x9c, 0x91,
+	0x36, 0x87, 0x31, 0x89, 0x54, 0x61, 0x1a, 0x7f, 0x90, 0x51, 0x85, 0x7d, 0xb8, 0x13, 0xe9, 0xde,
+	0x08, 0x96, 0x18, 0xd1, 0x8f, 0x04, 0xb8, 0x90, 0x71, 0xeb, 0x27, 0x5f, 0x4a, 0x1d, 0xba, 0x3f,
+	0xbf, 0x21, 0xbd, 0x36, 0x9a, 0x71, 0x64, 0x81, 0x24, 0x5d, 0xcf, 0x33, 0x16, 0x48, 0x06, 0x29,
+	0x21, 0xbd, 0x3a, 0xa4, 0x55, 0x64, 0x13, 0x4b, 0xbe, 0xee, 0x66, 0x6c, 0x62, 0x99, 0x8c, 0x81,
+	0x74, 0x67, 0x68, 0xbb, 0x78, 0xf9, 0x24, 0xde, 0x37, 0xb3, 0xcb, 0x27, 0xeb, 0x1e, 0x2e, 0xdd,
+	0x1b, 0xc1, 0x32, 0x6c, 0xf6, 0xa2, 0x57, 0xc7, 0x8c, 0x66, 0x2f, 0xe1, 0xfe, 0x2b, 0xad, 0x0f,
+	0xa8, 0x1d, 0x36, 0x17, 0xdd, 0x77, 0xa0, 0x8c, 0xe6, 0x22, 0xe5, 0xda, 0x2a, 0x6d, 0x0c, 0x61,
+	0xc1, 0x1d, 0x4b, 0x53, 0xdf, 0x71, 0x7f, 0xf7, 0xb0, 0xad, 0x7f, 0xf4, 0x6c, 0x49, 0xf8, 0xf8,
+	0xd9, 0x92, 0xf0, 0xf7, 0x67, 0x4b, 0xc2, 0x7b, 0xcf, 0x97, 0x4e, 0x7c, 0xfc, 0x7c, 0xe9, 0xc4,
+	0x5f, 0x9e, 0x2f, 0x9d, 0x80, 0x73, 0x86, 0x95, 0x38, 0xea, 0x23, 0xe1, 0xad, 0x28, 0x57, 0x11,
+	0xaa, 0xac, 0x1b, 0x56, 0xe4, 0x49, 0x7e, 0xea, 0xff, 0xca, 0xd4, 0x23, 0x2d, 0xea, 0xd3, 0xde,
+	0x4f, 0x39, 0x5f, 0xf9, 0xdf, 0x00, 0xcb, 0x37, 0xe4, 0xa4, 0xe6, 0x2b, 0x00, 0x00,
 }
 
 func (this *MsgSupplyIncreaseProposalRequest) Equal(that interface{}) bool {
@@ -3455,6 +3593,55 @@ func (this *MsgWithdrawEscrowProposalRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateSendLimitsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateSendLimitsRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateSendLimitsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.DefaultLimit.Equal(that1.DefaultLimit) {
+		return false
+	}
+	if this.RemoveDefaultLimit != that1.RemoveDefaultLimit {
+		return false
+	}
+	if len(this.SetAddressLimits) != len(that1.SetAddressLimits) {
+		return false
+	}
+	for i := range this.SetAddressLimits {
+		if !this.SetAddressLimits[i].Equal(&that1.SetAddressLimits[i]) {
+			return false
+		}
+	}
+	if len(this.RemoveAddressLimits) != len(that1.RemoveAddressLimits) {
+		return false
+	}
+	for i := range this.RemoveAddressLimits {
+		if this.RemoveAddressLimits[i] != that1.RemoveAddressLimits[i] {
+			return false
+		}
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -3526,6 +3713,8 @@ type MsgClient interface {
 	SetDenomMetadataProposal(ctx context.Context, in *MsgSetDenomMetadataProposalRequest, opts ...grpc.CallOption) (*MsgSetDenomMetadataProposalResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateSendLimits sets or removes the rolling-window send limits of a restricted marker.
+	UpdateSendLimits(ctx context.Context, in *MsgUpdateSendLimitsRequest, opts ...grpc.CallOption) (*MsgUpdateSendLimitsResponse, error)
 }
 
 type msgClient struct {
@@ -3788,6 +3977,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest
 	return out, nil
 }
 
+func (c *msgClient) UpdateSendLimits(ctx context.Context, in *MsgUpdateSendLimitsRequest, opts ...grpc.CallOption) (*MsgUpdateSendLimitsResponse, error) {
+	out := new(MsgUpdateSendLimitsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/UpdateSendLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Finalize
@@ -3848,6 +4046,8 @@ type MsgServer interface {
 	SetDenomMetadataProposal(context.Context, *MsgSetDenomMetadataProposalRequest) (*MsgSetDenomMetadataProposalResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the marker module's params.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// UpdateSendLimits sets or removes the rolling-window send limits of a restricted marker.
+	UpdateSendLimits(context.Context, *MsgUpdateSendLimitsRequest) (*MsgUpdateSendLimitsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -3938,6 +4138,9 @@ func (*UnimplementedMsgServer) SetDenomMetadataProposal(ctx context.Context, req
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateSendLimits(ctx context.Context, req *MsgUpdateSendLimitsRequest) (*MsgUpdateSendLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendLimits not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -4447,6 +4650,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateSendLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSendLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSendLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/UpdateSendLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSendLimits(ctx, req.(*MsgUpdateSendLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.marker.v1.Msg",
@@ -4564,6 +4785,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateSendLimits",
+			Handler:    _Msg_UpdateSendLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/marker/v1/tx.proto",
@@ -6645,6 +6870,111 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateSendLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RemoveAddressLimits) > 0 {
+		for iNdEx := len(m.RemoveAddressLimits) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAddressLimits[iNdEx])
+			copy(dAtA[i:], m.RemoveAddressLimits[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoveAddressLimits[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SetAddressLimits) > 0 {
+		for iNdEx := len(m.SetAddressLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SetAddressLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RemoveDefaultLimit {
+		i--
+		if m.RemoveDefaultLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DefaultLimit != nil {
+		{
+			size, err := m.DefaultLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSendLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -7541,15 +7871,60 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgUpdateSendLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DefaultLimit != nil {
+		l = m.DefaultLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RemoveDefaultLimit {
+		n += 2
+	}
+	if len(m.SetAddressLimits) > 0 {
+		for _, e := range m.SetAddressLimits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveAddressLimits) > 0 {
+		for _, s := range m.RemoveAddressLimits {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *MsgUpdateSendLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrantAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -13298,6 +13673,292 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateSendLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSendLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSendLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultLimit == nil {
+				m.DefaultLimit = &SendLimit{}
+			}
+			if err := m.DefaultLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDefaultLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveDefaultLimit = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAddressLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetAddressLimits = append(m.SetAddressLimits, AddressSendLimit{})
+			if err := m.SetAddressLimits[len(m.SetAddressLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAddressLimits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAddressLimits = append(m.RemoveAddressLimits, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSendLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSendLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSendLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
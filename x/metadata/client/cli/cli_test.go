@@ -382,36 +382,41 @@ specification_id: %s`,
 		s.recordSpecID,
 	)
 
-	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"]}",
+	s.scopeSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"contract_spec_ids\":[\"%s\"],\"predecessor_id\":\"\",\"deprecated\":false}",
 		s.scopeSpecID,
 		s.user1AddrStr,
 		s.contractSpecID,
 	)
 	s.scopeSpecAsText = fmt.Sprintf(`contract_spec_ids:
 - %s
+deprecated: false
 description: null
 owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
+predecessor_id: ""
 specification_id: %s`,
 		s.contractSpecID,
 		s.user1AddrStr,
 		s.scopeSpecID,
 	)
 
-	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\"}",
+	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\",\"type_schema\":null,\"predecessor_id\":\"\",\"deprecated\":false}",
 		s.contractSpecID,
 		s.user1AddrStr,
 	)
 	s.contractSpecAsText = fmt.Sprintf(`class_name: contractclassname
+deprecated: false
 description: null
 hash: notreallyasourcehash
 owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
-specification_id: %s`,
+predecessor_id: ""
+specification_id: %s
+type_schema: null`,
 		s.user1AddrStr,
 		s.contractSpecID,
 	)
@@ -438,7 +443,9 @@ type_name: recordtypename`,
 		if len(eKey) == 0 {
 			eKey = "\"\""
 		}
-		return fmt.Sprintf(`encryption_key: %s
+		return fmt.Sprintf(`current_key_id: ""
+encryption_key: %s
+keys: []
 locator_uri: %s
 owner: %s`,
 			eKey,
@@ -447,7 +454,7 @@ owner: %s`,
 		)
 	}
 	locAsJson := func(loc metadatatypes.ObjectStoreLocator) string {
-		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\"}",
+		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\",\"keys\":[],\"current_key_id\":\"\"}",
 			loc.Owner,
 			loc.LocatorUri,
 			loc.EncryptionKey,
@@ -657,12 +664,12 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 		{
 			name:   "get params as json output",
 			args:   []string{s.asJson},
-			expOut: []string{"\"params\":{}"},
+			expOut: []string{"\"params\":{\"max_history_versions\":10,\"max_nav_history_entries\":100}"},
 		},
 		{
 			name:   "get params as text output",
 			args:   []string{s.asText},
-			expOut: []string{"params: {}"},
+			expOut: []string{"params:", "max_history_versions: 10", "max_nav_history_entries: 100"},
 		},
 		{
 			name:   "get params - invalid args",
@@ -672,7 +679,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 		{
 			name:   "get params as json output including request",
 			args:   []string{s.asJson, s.includeRequest},
-			expOut: []string{"\"params\":{}", "\"request\":{\"include_request\":true}"},
+			expOut: []string{"\"params\":{\"max_history_versions\":10,\"max_nav_history_entries\":100}", "\"request\":{\"include_request\":true}"},
 		},
 		{
 			name:   "get locator params as json",
@@ -1771,6 +1778,63 @@ func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 	runQueryCmdTestCases(s, cmd, testCases)
 }
 
+func (s *IntegrationCLITestSuite) TestGetOSLocatorKeysCmd() {
+	cmd := func() *cobra.Command { return cli.GetOSLocatorKeysCmd() }
+
+	testCases := []queryCmdTestCase{
+		{
+			name: "locator with encryption key as text",
+			args: []string{s.user2AddrStr, "2023-01-02T15:04:05Z", s.asText},
+			expOut: []string{
+				"current_key:",
+				"  key_id: " + metadatatypes.InitialOSLocatorKeyID,
+				"  encryption_key: " + s.encryptionKey2.String(),
+				"time: \"2023-01-02T15:04:05Z\"",
+			},
+		},
+		{
+			name: "locator with encryption key as json",
+			args: []string{s.user2AddrStr, "2023-01-02T15:04:05Z", s.asJson},
+			expOut: []string{
+				fmt.Sprintf("\"current_key\":{\"key_id\":\"%s\",\"encryption_key\":\"%s\",",
+					metadatatypes.InitialOSLocatorKeyID, s.encryptionKey2),
+				"\"time\":\"2023-01-02T15:04:05Z\"",
+			},
+		},
+		{
+			name:   "locator without encryption key",
+			args:   []string{s.user1AddrStr, "2023-01-02T15:04:05Z", s.asJson},
+			expOut: []string{"\"current_key\":null,\"valid_keys\":[],\"time\":\"2023-01-02T15:04:05Z\""},
+		},
+		{
+			name: "locator at height",
+			args: []string{s.user2AddrStr, "1", s.asJson},
+			expOut: []string{
+				fmt.Sprintf("\"current_key\":{\"key_id\":\"%s\",\"encryption_key\":\"%s\",",
+					metadatatypes.InitialOSLocatorKeyID, s.encryptionKey2),
+				"\"height\":\"1\"",
+			},
+		},
+		{
+			name:   "invalid owner",
+			args:   []string{"notanaddr"},
+			expErr: "address does not match an existing account: unknown request",
+		},
+		{
+			name:   "invalid time",
+			args:   []string{s.user2AddrStr, "notatime"},
+			expErr: `unable to parse time "notatime" required format is RFC3339 (2006-01-02T15:04:05Z07:00): parsing time "notatime" as "2006-01-02T15:04:05Z07:00": cannot parse "notatime" as "2006"`,
+		},
+		{
+			name:   "unknown owner",
+			args:   []string{s.userOtherAddr.String()},
+			expErr: "no locator bound to address: unknown request",
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, testCases)
+}
+
 func (s *IntegrationCLITestSuite) TestGetAccountDataCmd() {
 	cmd := func() *cobra.Command { return cli.GetAccountDataCmd() }
 
@@ -2533,6 +2597,39 @@ func (s *IntegrationCLITestSuite) TestScopeSpecificationTxCommands() {
 			},
 			expectedCode: 0,
 		},
+		{
+			name: "should successfully update scope specification with predecessor and deprecated",
+			cmd:  addCommand,
+			args: []string{
+				specID.String(),
+				s.accountAddrStr,
+				"owner",
+				s.contractSpecID.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagPredecessor, s.scopeSpecID),
+				fmt.Sprintf("--%s", cli.FlagDeprecated),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "should fail to add scope specification, invalid predecessor",
+			cmd:  addCommand,
+			args: []string{
+				specID.String(),
+				s.accountAddrStr,
+				"owner",
+				s.contractSpecID.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagPredecessor, "invalid"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: `invalid predecessor "invalid": decoding bech32 failed: invalid bech32 string length 7`,
+		},
 		{
 			name: "should fail to add scope specification, invalid spec id format",
 			cmd:  addCommand,
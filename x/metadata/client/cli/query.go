@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -52,14 +54,20 @@ func GetQueryCmd() *cobra.Command {
 		GetMetadataScopeCmd(),
 		GetMetadataSessionCmd(),
 		GetMetadataRecordCmd(),
+		GetMetadataHistoryCmd(),
 		GetMetadataScopeSpecCmd(),
+		GetScopeSpecScopesCmd(),
 		GetMetadataContractSpecCmd(),
 		GetMetadataRecordSpecCmd(),
 		GetOwnershipCmd(),
 		GetValueOwnershipCmd(),
 		GetOSLocatorCmd(),
+		GetOSLocatorKeysCmd(),
 		GetAccountDataCmd(),
 		GetCmdNetAssetValuesQuery(),
+		GetCmdNetAssetValueAtHeightQuery(),
+		GetCmdNetAssetValueTWAPQuery(),
+		GetScopeOffersCmd(),
 	)
 	return queryCmd
 }
@@ -350,6 +358,39 @@ func GetMetadataRecordCmd() *cobra.Command {
 	return cmd
 }
 
+// GetMetadataHistoryCmd returns the command handler for querying the prior versions of a record or session.
+func GetMetadataHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history {record_id|session_id}",
+		Aliases: []string{"h", "versions"},
+		Short:   "Query the retained prior versions of a record or session",
+		Long: fmt.Sprintf(`%[1]s history {record_id} - gets the prior versions of the record with the given id.
+%[1]s history {session_id} - gets the prior versions of the session with the given id.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s history record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3
+%[1]s history session1qxge0zaztu65tx5x5llv5xc9zts9sqlch3sxwn44j50jzgt8rshvqyfrjcr`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := types.MetadataAddressFromBech32(strings.TrimSpace(args[0]))
+			if err != nil {
+				return err
+			}
+			switch {
+			case id.IsRecordAddress():
+				return outputRecordHistory(cmd, id.String())
+			case id.IsSessionAddress():
+				return outputSessionHistory(cmd, id.String())
+			}
+			return fmt.Errorf("unexpected metadata address prefix on %s", id)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "versions")
+
+	return cmd
+}
+
 // GetMetadataScopeSpecCmd returns the command handler for metadata scope specification querying.
 func GetMetadataScopeSpecCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -382,6 +423,27 @@ func GetMetadataScopeSpecCmd() *cobra.Command {
 	return cmd
 }
 
+// GetScopeSpecScopesCmd returns the command handler for querying the scopes that use a scope specification.
+func GetScopeSpecScopesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scopespec-scopes {scope_spec_id|scope_spec_uuid}",
+		Aliases: []string{"sss", "scopespecscopes"},
+		Short:   "Query the ids of the scopes that use a scope specification",
+		Example: fmt.Sprintf(`%[1]s scopespec-scopes scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m
+%[1]s scopespec-scopes dc83ea70-eacd-40fe-9adf-1cf6148bf8a2`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return outputScopeSpecScopes(cmd, strings.TrimSpace(args[0]))
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
+
+	return cmd
+}
+
 // GetMetadataContractSpecCmd returns the command handler for metadata contract specification querying.
 func GetMetadataContractSpecCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -567,6 +629,44 @@ func GetOSLocatorCmd() *cobra.Command {
 	return cmd
 }
 
+// GetOSLocatorKeysCmd returns the command handler for querying the encryption keys of an object store locator.
+func GetOSLocatorKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "locator-keys {owner} [time|height]",
+		Aliases: []string{"lk", "locatorkeys"},
+		Short:   "Query the encryption keys of an object store locator that were valid at a time or block height",
+		Long: fmt.Sprintf(`%[1]s locator-keys {owner} - gets the current and valid keys of the owner's object store locator.
+%[1]s locator-keys {owner} {time} - gets the keys of the owner's object store locator that were valid at that time (RFC3339).
+%[1]s locator-keys {owner} {height} - gets the keys of the owner's object store locator that were valid at that block height.`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`%[1]s locator-keys pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+%[1]s locator-keys pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 2025-01-02T15:04:05Z
+%[1]s locator-keys pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 12345`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var at *time.Time
+			var height int64
+			if len(args) > 1 {
+				arg := strings.TrimSpace(args[1])
+				if h, err := strconv.ParseInt(arg, 10, 64); err == nil {
+					height = h
+				} else {
+					t, err := time.Parse(time.RFC3339, arg)
+					if err != nil {
+						return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", args[1], time.RFC3339, err)
+					}
+					at = &t
+				}
+			}
+			return outputOSLocatorKeys(cmd, strings.TrimSpace(args[0]), at, height)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAccountDataCmd is the CLI command for querying account data for metadata.
 func GetAccountDataCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -799,6 +899,58 @@ func outputRecordsAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputRecordHistory calls the RecordHistory query and outputs the response.
+func outputRecordHistory(cmd *cobra.Command, recordAddr string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RecordHistory(
+		cmd.Context(),
+		&types.RecordHistoryRequest{
+			RecordAddr:     recordAddr,
+			IncludeRequest: includeRequest,
+			Pagination:     pageReq,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputSessionHistory calls the SessionHistory query and outputs the response.
+func outputSessionHistory(cmd *cobra.Command, sessionAddr string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SessionHistory(
+		cmd.Context(),
+		&types.SessionHistoryRequest{
+			SessionAddr:    sessionAddr,
+			IncludeRequest: includeRequest,
+			Pagination:     pageReq,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputOwnership calls the Ownership query and outputs the response.
 func outputOwnership(cmd *cobra.Command, address string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -893,6 +1045,32 @@ func outputScopeSpecsAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputScopeSpecScopes calls the ScopeSpecificationScopes query and outputs the response.
+func outputScopeSpecScopes(cmd *cobra.Command, specificationID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeSpecificationScopes(
+		cmd.Context(),
+		&types.ScopeSpecificationScopesRequest{
+			SpecificationId: specificationID,
+			IncludeRequest:  includeRequest,
+			Pagination:      pageReq,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputContractSpec calls the ContractSpecification query and outputs the response.
 func outputContractSpec(cmd *cobra.Command, specificationID string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -1049,6 +1227,24 @@ func outputOSLocator(cmd *cobra.Command, owner string) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputOSLocatorKeys calls the OSLocatorKeys query and outputs the response.
+func outputOSLocatorKeys(cmd *cobra.Command, owner string, at *time.Time, height int64) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.OSLocatorKeys(
+		cmd.Context(),
+		&types.OSLocatorKeysRequest{Owner: owner, Time: at, Height: height, IncludeRequest: includeRequest},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputOSLocatorsByURI calls the OSLocatorsByURI query and outputs the response.
 func outputOSLocatorsByURI(cmd *cobra.Command, uri string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -1146,6 +1342,168 @@ func GetCmdNetAssetValuesQuery() *cobra.Command {
 	return cmd
 }
 
+// GetCmdNetAssetValueAtHeightQuery is the CLI command for querying the net asset value a scope had at a block height.
+func GetCmdNetAssetValueAtHeightQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "net-asset-value-at-height <scope-id> <price-denom> [height]",
+		Aliases: []string{"nav-at-height", "navah"},
+		Short:   "Get the net asset value a scope had in a price denom at a block height",
+		Long: strings.TrimSpace(`Get the most recent net asset value of a scope in a price denom that was set at or before a block height.
+If no height is provided, the current block height is used.`),
+		Example: fmt.Sprintf(`$ %[1]s net-asset-value-at-height scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel usd
+$ %[1]s net-asset-value-at-height scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel usd 12345`, cmdStart),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryScopeNetAssetValueAtHeightRequest{
+				Id:         strings.TrimSpace(args[0]),
+				PriceDenom: strings.TrimSpace(args[1]),
+			}
+			if _, err = types.MetadataAddressFromBech32(req.Id); err != nil {
+				return err
+			}
+			if len(args) > 2 {
+				if req.Height, err = strconv.ParseInt(strings.TrimSpace(args[2]), 10, 64); err != nil {
+					return fmt.Errorf("invalid height %q: %w", args[2], err)
+				}
+			}
+
+			response, err := queryClient.ScopeNetAssetValueAtHeight(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query scope %q net asset value at height: %w", req.Id, err)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdNetAssetValueTWAPQuery is the CLI command for querying the time-weighted average price of a scope.
+func GetCmdNetAssetValueTWAPQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "net-asset-value-twap <scope-id> <price-denom> <start-time> [end-time]",
+		Aliases: []string{"nav-twap", "navtwap"},
+		Short:   "Get the time-weighted average price per unit of a scope in a price denom",
+		Long: strings.TrimSpace(`Get the average price of one unit of a scope in a price denom from the start time up to (but not including)
+the end time. Each net asset value is weighted by the amount of time it was in effect for.
+Times must be in RFC3339 format. If no end time is provided, the current block time is used.`),
+		Example: fmt.Sprintf(`$ %[1]s net-asset-value-twap scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel usd 2024-01-01T00:00:00Z
+$ %[1]s net-asset-value-twap scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel usd 2024-01-01T00:00:00Z 2024-02-01T00:00:00Z`, cmdStart),
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryScopeNetAssetValueTWAPRequest{
+				Id:         strings.TrimSpace(args[0]),
+				PriceDenom: strings.TrimSpace(args[1]),
+			}
+			if _, err = types.MetadataAddressFromBech32(req.Id); err != nil {
+				return err
+			}
+			startTime, err := time.Parse(time.RFC3339, strings.TrimSpace(args[2]))
+			if err != nil {
+				return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", args[2], time.RFC3339, err)
+			}
+			req.StartTime = startTime
+			if len(args) > 3 {
+				endTime, err := time.Parse(time.RFC3339, strings.TrimSpace(args[3]))
+				if err != nil {
+					return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", args[3], time.RFC3339, err)
+				}
+				req.EndTime = &endTime
+			}
+
+			response, err := queryClient.ScopeNetAssetValueTWAP(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query scope %q net asset value twap: %w", req.Id, err)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetScopeOffersCmd returns the command handler for querying open scope offers.
+func GetScopeOffersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offers [offer-id|seller]",
+		Aliases: []string{"offer", "scope-offers"},
+		Short:   "Query open scope offers",
+		Long: fmt.Sprintf(`%[1]s offers - gets all open scope offers.
+%[1]s offers {offer-id} - gets the open scope offer with the provided id.
+%[1]s offers {seller} - gets all open scope offers made by the provided seller.`, cmdStart),
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s offers
+%[1]s offers 3
+%[1]s offers pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var arg string
+			if len(args) > 0 {
+				arg = strings.TrimSpace(args[0])
+			}
+			if offerID, err := strconv.ParseUint(arg, 10, 64); err == nil {
+				return outputScopeOffer(cmd, offerID)
+			}
+			return outputScopeOffers(cmd, arg)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers")
+
+	return cmd
+}
+
+// outputScopeOffer calls the ScopeOffer query and outputs the response.
+func outputScopeOffer(cmd *cobra.Command, offerID uint64) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeOffer(
+		cmd.Context(),
+		&types.ScopeOfferRequest{OfferId: offerID, IncludeRequest: includeRequest},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputScopeOffers calls the ScopeOffers query and outputs the response.
+func outputScopeOffers(cmd *cobra.Command, seller string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.ScopeOffers(
+		cmd.Context(),
+		&types.ScopeOffersRequest{Seller: seller, IncludeRequest: includeRequest, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // ------------ private generic helper functions ------------
 
 // trimSpaceAndJoin trims leading and trailing whitespace from each arg,
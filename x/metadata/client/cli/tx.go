@@ -4,8 +4,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -15,17 +17,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
+	"github.com/provenance-io/provenance/internal/provcli"
 	attrcli "github.com/provenance-io/provenance/x/attribute/client/cli"
 	"github.com/provenance-io/provenance/x/metadata/types"
 )
 
 const (
-	FlagSigners            = "signers"
-	FlagRequirePartyRollup = "require-party-rollup"
-	AddSwitch              = "add"
-	RemoveSwitch           = "remove"
-	FlagUsdMills           = "usd-mills"
+	FlagSigners               = "signers"
+	FlagRequirePartyRollup    = "require-party-rollup"
+	AddSwitch                 = "add"
+	RemoveSwitch              = "remove"
+	FlagUsdMills              = "usd-mills"
+	FlagTypeSchema            = "type-schema"
+	FlagTypeSchemaFormat      = "type-schema-format"
+	FlagBuyer                 = "buyer"
+	FlagExpiration            = "expiration"
+	FlagBestEffort            = "best-effort"
+	FlagPredecessor           = "predecessor"
+	FlagDeprecated            = "deprecated"
+	FlagPreviousKeyExpiration = "previous-key-expiration"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -46,10 +58,13 @@ func NewTxCmd() *cobra.Command {
 		AddRemoveScopeOwnersCmd(),
 		UpdateValueOwnersCmd(),
 		MigrateValueOwnerCmd(),
+		BatchUpdateScopesCmd(),
+		MigrateScopeSpecCmd(),
 
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
 		ModifyOsLocatorCmd(),
+		RotateOsLocatorKeyCmd(),
 
 		WriteScopeSpecificationCmd(),
 		RemoveScopeSpecificationCmd(),
@@ -71,6 +86,12 @@ func NewTxCmd() *cobra.Command {
 		SetAccountDataCmd(),
 
 		GetCmdAddNetAssetValues(),
+
+		OfferScopeCmd(),
+		AcceptScopeOfferCmd(),
+		CancelScopeOfferCmd(),
+
+		UpdateParamsCmd(),
 	)
 
 	return txCmd
@@ -389,6 +410,113 @@ func MigrateValueOwnerCmd() *cobra.Command {
 	return cmd
 }
 
+// BatchUpdateScopesCmd creates a command for updating many scopes at once.
+func BatchUpdateScopesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch-update-scopes <updates-json-file>",
+		Aliases: []string{"bus"},
+		Short:   "Apply owner, data access, and specification changes to many scopes at once",
+		Long: `Apply owner, data access, and specification changes to many scopes at once.
+The file must contain a JSON object with an "updates" list, e.g.:
+{
+  "updates": [
+    {
+      "scope_id": "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel",
+      "add_owners": [{"address": "pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42", "role": "PARTY_TYPE_AFFILIATE"}],
+      "remove_owners": [],
+      "add_data_access": ["pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk"],
+      "remove_data_access": [],
+      "specification_id": "scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m"
+    }
+  ]
+}
+By default, all updates must succeed. Use --best-effort to apply the ones that can be and report the ones that failed.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata batch-update-scopes updates.json --best-effort`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("could not read updates file %q: %w", args[0], err)
+			}
+			msg := &types.MsgBatchUpdateScopesRequest{}
+			if err = clientCtx.Codec.UnmarshalJSON(contents, msg); err != nil {
+				return fmt.Errorf("could not parse updates file %q: %w", args[0], err)
+			}
+
+			msg.BestEffort, err = cmd.Flags().GetBool(FlagBestEffort)
+			if err != nil {
+				return err
+			}
+			msg.Signers, err = parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagBestEffort, false, "Apply the updates that can be, reporting the ones that failed")
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MigrateScopeSpecCmd creates a command for moving a scope to a successor of its scope specification.
+func MigrateScopeSpecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate-scope-spec <scope-id> <spec-id>",
+		Aliases: []string{"mss"},
+		Short:   "Move a scope to a newer version of its scope specification",
+		Long: `Move a scope to a newer version of its scope specification.
+The new scope specification must list the scope's current specification somewhere in its predecessor lineage,
+and must allow the contract specifications used by the scope's sessions.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata migrate-scope-spec scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m`,
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			if !scopeID.IsScopeAddress() {
+				return fmt.Errorf("invalid scope id: %s", args[0])
+			}
+			specID, err := types.MetadataAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			if !specID.IsScopeSpecificationAddress() {
+				return fmt.Errorf("invalid scope specification id: %s", args[1])
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateScopeSpecRequest(scopeID, specID, signers)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
 func BindOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -485,6 +613,54 @@ func ModifyOsLocatorCmd() *cobra.Command {
 	return cmd
 }
 
+// RotateOsLocatorKeyCmd creates a command for adding a new current encryption key to an object store locator.
+func RotateOsLocatorKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-locator-key [owner] [key-id] [encryption-key]",
+		Short: "Add a new current encryption key to an object store locator on the provenance blockchain",
+		Long: `Add a new current encryption key to an object store locator on the provenance blockchain.
+The previous keys are retained so that objects encrypted with them can still be decrypted.
+Use --previous-key-expiration to provide the time (RFC3339) at which the previous current key is no longer valid.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata rotate-locator-key pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 key2 pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --previous-key-expiration 2025-01-02T15:04:05Z`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := validateAccAddress(args[0], "owner")
+			if err != nil {
+				return err
+			}
+			encryptionKey, err := validateAccAddress(args[2], "encryption key")
+			if err != nil {
+				return err
+			}
+			var expiration *time.Time
+			expStr, err := cmd.Flags().GetString(FlagPreviousKeyExpiration)
+			if err != nil {
+				return err
+			}
+			if len(expStr) > 0 {
+				exp, err := time.Parse(time.RFC3339, expStr)
+				if err != nil {
+					return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", expStr, time.RFC3339, err)
+				}
+				expiration = &exp
+			}
+
+			msg := types.NewMsgRotateOSLocatorKeyRequest(owner, args[1], encryptionKey, expiration)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPreviousKeyExpiration, "", "The time (RFC3339) at which the previous current key is no longer valid (default: it does not expire)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // WriteScopeSpecificationCmd creates a command for adding scope specificiation
 func WriteScopeSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -529,6 +705,14 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 				PartiesInvolved: partyTypes,
 				ContractSpecIds: contractSpecIDs,
 			}
+			scopeSpec.PredecessorId, err = parsePredecessor(cmd)
+			if err != nil {
+				return err
+			}
+			scopeSpec.Deprecated, err = cmd.Flags().GetBool(FlagDeprecated)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgWriteScopeSpecificationRequest(scopeSpec, signers)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -536,6 +720,7 @@ func WriteScopeSpecificationCmd() *cobra.Command {
 	}
 
 	addSignersFlagToCmd(cmd)
+	addSpecVersionFlagsToCmd(cmd, "scope specification")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -555,9 +740,13 @@ classname          - name of contract specification
 description-name   - description name identifier (optional)
 description        - description text (optional, can only be provided with a description-name)
 website-url        - address of website (optional, can only be provided with a description)
-icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)`,
-		Example: fmt.Sprintf(`$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" "hashvalue" "myclassname" --from=mykey`, version.AppName),
-		Args:    cobra.RangeArgs(5, 9),
+icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)
+
+The --type-schema flag takes a file containing either a serialized google.protobuf.FileDescriptorSet or a JSON schema.
+When provided, records written under this contract specification are validated against it.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" "hashvalue" "myclassname" --from=mykey
+$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" "hashvalue" "myclassname" --type-schema loan.pb --type-schema-format proto --from=mykey`, version.AppName),
+		Args: cobra.RangeArgs(5, 9),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -579,6 +768,18 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 				PartiesInvolved: partiesInvolved,
 				ClassName:       args[4],
 			}
+			contractSpecification.TypeSchema, err = parseTypeSchema(cmd)
+			if err != nil {
+				return err
+			}
+			contractSpecification.PredecessorId, err = parsePredecessor(cmd)
+			if err != nil {
+				return err
+			}
+			contractSpecification.Deprecated, err = cmd.Flags().GetBool(FlagDeprecated)
+			if err != nil {
+				return err
+			}
 			sourceValue := args[3]
 			var recordID sdk.AccAddress
 			recordID, err = sdk.AccAddressFromBech32(sourceValue)
@@ -602,6 +803,9 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 		},
 	}
 	addSignersFlagToCmd(cmd)
+	cmd.Flags().String(FlagTypeSchema, "", "file containing the record type schema for this contract specification")
+	cmd.Flags().String(FlagTypeSchemaFormat, "", "format of the type schema file: proto or json (default: json for .json files, otherwise proto)")
+	addSpecVersionFlagsToCmd(cmd, "contract specification")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1265,12 +1469,226 @@ least common denominator to achieve the desired precision.
 	return cmd
 }
 
+// OfferScopeCmd creates a command for offering the value ownership of scopes for a price.
+func OfferScopeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offer-scope <price> <scope-id> [<scope-id 2> ...]",
+		Aliases: []string{"offer"},
+		Short:   "Offer the value ownership of one or more scopes for a price",
+		Long: `Offer the value ownership of one or more scopes for a price.
+The seller must still be the value owner of the scopes when the offer is accepted.
+Use --buyer to restrict who can accept the offer, and --expiration (RFC3339) to have it expire.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata offer-scope 1000usd scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel --buyer pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --expiration 2025-01-02T15:04:05Z`,
+			version.AppName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid price %q: %w", args[0], err)
+			}
+
+			scopeIDs := make([]types.MetadataAddress, len(args)-1)
+			for i, arg := range args[1:] {
+				scopeIDs[i], err = types.MetadataAddressFromBech32(arg)
+				if err != nil {
+					return fmt.Errorf("invalid scope id %q: %w", arg, err)
+				}
+			}
+
+			buyer, err := cmd.Flags().GetString(FlagBuyer)
+			if err != nil {
+				return err
+			}
+			if len(buyer) > 0 {
+				buyer, err = validateAccAddress(buyer, "buyer")
+				if err != nil {
+					return err
+				}
+			}
+
+			var expiration *time.Time
+			expStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if len(expStr) > 0 {
+				exp, err := time.Parse(time.RFC3339, expStr)
+				if err != nil {
+					return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", expStr, time.RFC3339, err)
+				}
+				expiration = &exp
+			}
+
+			msg := types.NewMsgOfferScopeRequest(clientCtx.GetFromAddress().String(), scopeIDs, price, buyer, expiration)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBuyer, "", "The only account allowed to accept the offer")
+	cmd.Flags().String(FlagExpiration, "", "The time (RFC3339) at which the offer expires, e.g. 2025-01-02T15:04:05Z")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// AcceptScopeOfferCmd creates a command for accepting an open scope offer.
+func AcceptScopeOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-scope-offer <offer-id>",
+		Aliases: []string{"accept-offer"},
+		Short:   "Accept an open scope offer, paying its price for the value ownership of its scopes",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata accept-scope-offer 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgAcceptScopeOfferRequest(clientCtx.GetFromAddress().String(), offerID)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CancelScopeOfferCmd creates a command for cancelling an open scope offer.
+func CancelScopeOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-scope-offer <offer-id>",
+		Aliases: []string{"cancel-offer"},
+		Short:   "Cancel an open scope offer",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata cancel-scope-offer 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgCancelScopeOfferRequest(clientCtx.GetFromAddress().String(), offerID)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// UpdateParamsCmd creates a command to update the metadata module's params via governance proposal.
+func UpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-params <max-history-versions> <max-nav-history-entries>",
+		Short:   "Update the metadata module's params via governance proposal",
+		Long:    "Submit an update params via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s tx metadata update-params 10 100 --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			maxHistoryVersions, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max history versions: %w", err)
+			}
+			maxNavHistoryEntries, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max nav history entries: %w", err)
+			}
+			maxHistoryVersions32 := uint32(maxHistoryVersions)     //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
+			maxNavHistoryEntries32 := uint32(maxNavHistoryEntries) //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
+			msg := types.NewMsgUpdateParamsRequest(authority, types.NewParams(maxHistoryVersions32, maxNavHistoryEntries32))
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // addSignersFlagToCmd adds the standard --signers flag to a command.
 // See also: parseSigners.
 func addSignersFlagToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSigners, "", "comma delimited list of bech32 addresses")
 }
 
+// addSpecVersionFlagsToCmd adds the --predecessor and --deprecated flags to a specification command.
+// See also: parsePredecessor.
+func addSpecVersionFlagsToCmd(cmd *cobra.Command, specName string) {
+	cmd.Flags().String(FlagPredecessor, "", fmt.Sprintf("id of the %s that this one supersedes", specName))
+	cmd.Flags().Bool(FlagDeprecated, false, fmt.Sprintf("mark the %s as deprecated so it cannot be used by new entries", specName))
+}
+
+// parsePredecessor reads the specification id provided with the predecessor flag.
+// Returns nil if no predecessor was provided.
+func parsePredecessor(cmd *cobra.Command) (types.MetadataAddress, error) {
+	predecessor, _ := cmd.Flags().GetString(FlagPredecessor)
+	if len(predecessor) == 0 {
+		return nil, nil
+	}
+	rv, err := types.MetadataAddressFromBech32(predecessor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid predecessor %q: %w", predecessor, err)
+	}
+	return rv, nil
+}
+
+// parseTypeSchema reads the record type schema file provided with the type schema flag.
+// Returns nil if no type schema file was provided.
+func parseTypeSchema(cmd *cobra.Command) (*types.RecordTypeSchema, error) {
+	filename, _ := cmd.Flags().GetString(FlagTypeSchema)
+	if len(filename) == 0 {
+		return nil, nil
+	}
+	definition, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read type schema file: %w", err)
+	}
+	formatStr, _ := cmd.Flags().GetString(FlagTypeSchemaFormat)
+	if len(formatStr) == 0 {
+		formatStr = "proto"
+		if strings.HasSuffix(strings.ToLower(filename), ".json") {
+			formatStr = "json"
+		}
+	}
+	var format types.RecordTypeSchemaFormat
+	switch strings.ToLower(formatStr) {
+	case "proto":
+		format = types.RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET
+	case "json":
+		format = types.RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA
+	default:
+		return nil, fmt.Errorf("unknown type schema format %q: must be proto or json", formatStr)
+	}
+	return types.NewRecordTypeSchema(format, definition), nil
+}
+
 // parseSigners checks signers flag for signers, else uses the from address
 // See also: addSignersFlagToCmd
 func parseSigners(cmd *cobra.Command, client *client.Context) ([]string, error) {
@@ -47,3 +47,9 @@ type BankKeeper interface {
 	DenomOwner(ctx context.Context, denom string) (sdk.AccAddress, error)
 	GetScopesForValueOwner(ctx context.Context, valueOwner sdk.AccAddress, pageReq *query.PageRequest) (types.AccMDLinks, *query.PageResponse, error)
 }
+
+// HoldKeeper defines the hold functionality needed by the metadata module.
+type HoldKeeper interface {
+	AddHoldEntry(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string, expiration *time.Time) (uint64, error)
+	ReleaseHoldByID(ctx sdk.Context, holder string, holdID uint64) error
+}
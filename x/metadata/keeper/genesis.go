@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"strings"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -14,6 +12,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	if err := data.Validate(); err != nil {
 		panic(err)
 	}
+	k.SetParams(ctx, data.Params)
 	if data.Scopes != nil {
 		for _, s := range data.Scopes {
 			if err := k.SetScope(ctx, s); err != nil {
@@ -48,16 +47,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 	if data.ObjectStoreLocators != nil {
 		for _, s := range data.ObjectStoreLocators {
-			addr, err := sdk.AccAddressFromBech32(s.Owner)
-			if err != nil {
-				panic(err)
-			}
-			encryptionKey := sdk.AccAddress{}
-			if strings.TrimSpace(s.EncryptionKey) != "" {
-				encryptionKey, _ = sdk.AccAddressFromBech32(s.EncryptionKey)
-			}
-			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri)
-			if err != nil {
+			if err := k.ImportOSLocatorRecord(ctx, s); err != nil {
 				panic(err)
 			}
 		}
@@ -80,6 +70,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+
+	for _, history := range data.NetAssetValueHistory {
+		address, err := types.MetadataAddressFromBech32(history.Address)
+		if err != nil {
+			panic(err)
+		}
+		for _, entry := range history.Entries {
+			k.SetNetAssetValueHistoryEntry(ctx, address, entry)
+		}
+	}
+
+	for _, version := range data.RecordHistory {
+		k.SetRecordVersion(ctx, version)
+	}
+	for _, version := range data.SessionHistory {
+		k.SetSessionVersion(ctx, version)
+	}
+
+	for _, offer := range data.ScopeOffers {
+		k.SetScopeOffer(ctx, offer)
+	}
+	k.SetLastScopeOfferID(ctx, data.LastScopeOfferId)
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -168,5 +180,49 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	var navHistory []types.ScopeNetAssetValueHistory
+	for i := range scopes {
+		var entries []types.NetAssetValueHistoryEntry
+		err := k.IterateNetAssetValueHistory(ctx, scopes[i].ScopeId, func(entry types.NetAssetValueHistoryEntry) (stop bool) {
+			entries = append(entries, entry)
+			return false
+		})
+		if err != nil {
+			panic(err)
+		}
+		if len(entries) > 0 {
+			navHistory = append(navHistory, types.ScopeNetAssetValueHistory{Address: scopes[i].ScopeId.String(), Entries: entries})
+		}
+	}
+
+	rv := types.NewGenesisState(k.GetParams(ctx), oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	rv.NetAssetValueHistory = navHistory
+
+	// record and session history
+	err := k.IterateRecordHistory(ctx, func(version types.RecordVersion) bool {
+		rv.RecordHistory = append(rv.RecordHistory, version)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.IterateSessionHistory(ctx, func(version types.SessionVersion) bool {
+		rv.SessionHistory = append(rv.SessionHistory, version)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// open scope offers
+	err = k.IterateScopeOffers(ctx, func(offer types.ScopeOffer) bool {
+		rv.ScopeOffers = append(rv.ScopeOffers, offer)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+	rv.LastScopeOfferId = k.GetLastScopeOfferID(ctx)
+
+	return rv
 }
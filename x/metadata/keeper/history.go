@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetRecordHistory returns the retained prior versions of a record, oldest first.
+func (k Keeper) GetRecordHistory(ctx sdk.Context, recordID types.MetadataAddress) ([]types.RecordVersion, error) {
+	var rv []types.RecordVersion
+	err := k.iterateRecordHistory(ctx, types.GetRecordHistoryKeyPrefix(recordID), func(version types.RecordVersion) (stop bool) {
+		rv = append(rv, version)
+		return false
+	})
+	return rv, err
+}
+
+// IterateRecordHistory processes the retained prior versions of all records with the given handler.
+func (k Keeper) IterateRecordHistory(ctx sdk.Context, handler func(types.RecordVersion) (stop bool)) error {
+	return k.iterateRecordHistory(ctx, types.RecordHistoryKeyPrefix, handler)
+}
+
+// iterateRecordHistory processes the record versions under the provided key prefix with the given handler.
+func (k Keeper) iterateRecordHistory(ctx sdk.Context, keyPrefix []byte, handler func(types.RecordVersion) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var version types.RecordVersion
+		if err := k.cdc.Unmarshal(it.Value(), &version); err != nil {
+			return err
+		}
+		if handler(version) {
+			break
+		}
+	}
+	return nil
+}
+
+// SetRecordVersion stores a prior version of a record. SetRecord takes care of this when a record is being replaced.
+func (k Keeper) SetRecordVersion(ctx sdk.Context, version types.RecordVersion) {
+	bz := k.cdc.MustMarshal(&version)
+	ctx.KVStore(k.storeKey).Set(types.GetRecordHistoryKey(version.Record.GetRecordAddress(), version.Version), bz)
+}
+
+// addRecordHistory retains the provided record as a prior version that is being replaced by the given signers.
+// Older versions beyond the max history versions param are removed.
+func (k Keeper) addRecordHistory(ctx sdk.Context, record types.Record, signers []string) {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := types.GetRecordHistoryKeyPrefix(record.GetRecordAddress())
+	maxVersions := k.GetMaxHistoryVersions(ctx)
+	if maxVersions > 0 {
+		k.SetRecordVersion(ctx, types.RecordVersion{
+			Record:         record,
+			Version:        nextHistoryVersion(store, keyPrefix),
+			ReplacedHeight: ctx.BlockHeight(),
+			ReplacedTime:   ctx.BlockTime().UTC(),
+			ReplacedBy:     signers,
+		})
+	}
+	pruneHistory(store, keyPrefix, maxVersions)
+}
+
+// GetSessionHistory returns the retained prior versions of a session, oldest first.
+func (k Keeper) GetSessionHistory(ctx sdk.Context, sessionID types.MetadataAddress) ([]types.SessionVersion, error) {
+	var rv []types.SessionVersion
+	err := k.iterateSessionHistory(ctx, types.GetSessionHistoryKeyPrefix(sessionID), func(version types.SessionVersion) (stop bool) {
+		rv = append(rv, version)
+		return false
+	})
+	return rv, err
+}
+
+// IterateSessionHistory processes the retained prior versions of all sessions with the given handler.
+func (k Keeper) IterateSessionHistory(ctx sdk.Context, handler func(types.SessionVersion) (stop bool)) error {
+	return k.iterateSessionHistory(ctx, types.SessionHistoryKeyPrefix, handler)
+}
+
+// iterateSessionHistory processes the session versions under the provided key prefix with the given handler.
+func (k Keeper) iterateSessionHistory(ctx sdk.Context, keyPrefix []byte, handler func(types.SessionVersion) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var version types.SessionVersion
+		if err := k.cdc.Unmarshal(it.Value(), &version); err != nil {
+			return err
+		}
+		if handler(version) {
+			break
+		}
+	}
+	return nil
+}
+
+// SetSessionVersion stores a prior version of a session. SetSession takes care of this when a session is being replaced.
+func (k Keeper) SetSessionVersion(ctx sdk.Context, version types.SessionVersion) {
+	bz := k.cdc.MustMarshal(&version)
+	ctx.KVStore(k.storeKey).Set(types.GetSessionHistoryKey(version.Session.SessionId, version.Version), bz)
+}
+
+// addSessionHistory retains the provided session as a prior version that is being replaced by the given signers.
+// Older versions beyond the max history versions param are removed.
+func (k Keeper) addSessionHistory(ctx sdk.Context, session types.Session, signers []string) {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := types.GetSessionHistoryKeyPrefix(session.SessionId)
+	maxVersions := k.GetMaxHistoryVersions(ctx)
+	if maxVersions > 0 {
+		k.SetSessionVersion(ctx, types.SessionVersion{
+			Session:        session,
+			Version:        nextHistoryVersion(store, keyPrefix),
+			ReplacedHeight: ctx.BlockHeight(),
+			ReplacedTime:   ctx.BlockTime().UTC(),
+			ReplacedBy:     signers,
+		})
+	}
+	pruneHistory(store, keyPrefix, maxVersions)
+}
+
+// nextHistoryVersion returns the version number to use for the next history entry under the provided key prefix.
+func nextHistoryVersion(store storetypes.KVStore, keyPrefix []byte) uint64 {
+	it := storetypes.KVStoreReversePrefixIterator(store, keyPrefix)
+	defer it.Close()
+	if !it.Valid() {
+		return 1
+	}
+	return binary.BigEndian.Uint64(it.Key()[len(keyPrefix):]) + 1
+}
+
+// pruneHistory deletes the oldest history entries under the provided key prefix so that at most maxVersions remain.
+func pruneHistory(store storetypes.KVStore, keyPrefix []byte, maxVersions uint32) {
+	var toDelete [][]byte
+	it := storetypes.KVStoreReversePrefixIterator(store, keyPrefix)
+	count := uint32(0)
+	for ; it.Valid(); it.Next() {
+		count++
+		if count > maxVersions {
+			toDelete = append(toDelete, it.Key())
+		}
+	}
+	it.Close()
+
+	for _, key := range toDelete {
+		store.Delete(key)
+	}
+}
+
+// GetNetAssetValueHistory returns the retained net asset value history of a scope in a price denom, oldest first.
+func (k Keeper) GetNetAssetValueHistory(ctx sdk.Context, scopeID types.MetadataAddress, priceDenom string) ([]types.NetAssetValueHistoryEntry, error) {
+	var rv []types.NetAssetValueHistoryEntry
+	err := k.iterateNetAssetValueHistory(ctx, types.NetAssetValueHistoryKeyPrefix(scopeID, priceDenom), func(entry types.NetAssetValueHistoryEntry) (stop bool) {
+		rv = append(rv, entry)
+		return false
+	})
+	return rv, err
+}
+
+// IterateNetAssetValueHistory processes the retained net asset value history of a scope, in all price denoms, with the given handler.
+func (k Keeper) IterateNetAssetValueHistory(ctx sdk.Context, scopeID types.MetadataAddress, handler func(types.NetAssetValueHistoryEntry) (stop bool)) error {
+	return k.iterateNetAssetValueHistory(ctx, types.NetAssetValueHistoryScopePrefix(scopeID), handler)
+}
+
+// iterateNetAssetValueHistory processes the net asset value history entries under the provided key prefix with the given handler.
+func (k Keeper) iterateNetAssetValueHistory(ctx sdk.Context, keyPrefix []byte, handler func(types.NetAssetValueHistoryEntry) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.NetAssetValueHistoryEntry
+		if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			return err
+		}
+		if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetNetAssetValueAtHeight returns the most recent net asset value of a scope in a price denom that was set
+// at or before the provided height. If there isn't one, nil, nil is returned.
+func (k Keeper) GetNetAssetValueAtHeight(ctx sdk.Context, scopeID types.MetadataAddress, priceDenom string, height uint64) (*types.NetAssetValueHistoryEntry, error) {
+	start := types.NetAssetValueHistoryKeyPrefix(scopeID, priceDenom)
+	end := types.NetAssetValueHistoryKey(scopeID, priceDenom, height+1)
+	it := ctx.KVStore(k.storeKey).ReverseIterator(start, end)
+	defer it.Close()
+	if !it.Valid() {
+		return nil, nil
+	}
+	var entry types.NetAssetValueHistoryEntry
+	if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// SetNetAssetValueHistoryEntry stores a net asset value history entry for a scope.
+// Use addNetAssetValueHistory when a net asset value is being set.
+func (k Keeper) SetNetAssetValueHistoryEntry(ctx sdk.Context, scopeID types.MetadataAddress, entry types.NetAssetValueHistoryEntry) {
+	bz := k.cdc.MustMarshal(&entry)
+	key := types.NetAssetValueHistoryKey(scopeID, entry.NetAssetValue.Price.Denom, entry.NetAssetValue.UpdatedBlockHeight)
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+// addNetAssetValueHistory records the provided net asset value in the scope's history.
+// Older entries beyond the max nav history entries param are removed.
+func (k Keeper) addNetAssetValueHistory(ctx sdk.Context, scopeID types.MetadataAddress, nav types.NetAssetValue, source string) {
+	maxEntries := k.GetMaxNavHistoryEntries(ctx)
+	if maxEntries > 0 {
+		k.SetNetAssetValueHistoryEntry(ctx, scopeID, types.NetAssetValueHistoryEntry{NetAssetValue: nav, Source: source, Time: ctx.BlockTime()})
+	}
+	pruneHistory(ctx.KVStore(k.storeKey), types.NetAssetValueHistoryKeyPrefix(scopeID, nav.Price.Denom), maxEntries)
+}
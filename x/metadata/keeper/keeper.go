@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"net/url"
+	"strings"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
@@ -37,13 +39,22 @@ type Keeper struct {
 
 	// For managing value owners
 	bankKeeper BankKeeper
+
+	// For escrowing offered scopes
+	holdKeeper HoldKeeper
+
+	// the signing authority for the gov proposals.
+	authority string
+
+	// compiled contract specification type schemas
+	typeSchemas *typeSchemaCache
 }
 
 // NewKeeper creates new instances of the metadata Keeper.
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, authKeeper AuthKeeper,
 	authzKeeper AuthzKeeper, attrKeeper AttrKeeper, markerKeeper MarkerKeeper,
-	bankKeeper bankkeeper.BaseKeeper,
+	bankKeeper bankkeeper.BaseKeeper, holdKeeper HoldKeeper,
 ) Keeper {
 	return Keeper{
 		storeKey:     key,
@@ -54,7 +65,28 @@ func NewKeeper(
 		attrKeeper:   attrKeeper,
 		markerKeeper: markerKeeper,
 		bankKeeper:   NewMDBankKeeper(bankKeeper),
+		holdKeeper:   holdKeeper,
+		authority:    authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		typeSchemas:  newTypeSchemaCache(),
+	}
+}
+
+// GetAuthority is signer of the proposal
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// IsAuthority returns true if the provided address bech32 string is the authority address.
+func (k Keeper) IsAuthority(addr string) bool {
+	return strings.EqualFold(k.authority, addr)
+}
+
+// ValidateAuthority returns an error if the provided address is not the authority.
+func (k Keeper) ValidateAuthority(addr string) error {
+	if !k.IsAuthority(addr) {
+		return govtypes.ErrInvalidSigner.Wrapf("expected %q got %q", k.GetAuthority(), addr)
 	}
+	return nil
 }
 
 // Logger returns a module-specific logger.
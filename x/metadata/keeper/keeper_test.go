@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 	"time"
@@ -223,6 +224,171 @@ func (s *KeeperTestSuite) TestModifyOSLocator() {
 	})
 }
 
+func (s *KeeperTestSuite) TestRotateOSLocatorKey() {
+	key2 := sdk.AccAddress("key2________________").String()
+	key3 := sdk.AccAddress("key3________________").String()
+	key4 := sdk.AccAddress("key4________________").String()
+	initialKey := types.OSLocatorKey{KeyId: types.InitialOSLocatorKeyID, EncryptionKey: s.encryptionKey1.String()}
+	at := func(sec int64) time.Time {
+		return time.Unix(sec, 0).UTC()
+	}
+	atP := func(sec int64) *time.Time {
+		rv := at(sec)
+		return &rv
+	}
+	ctx := s.ctx.WithBlockTime(at(10)).WithBlockHeight(100)
+
+	s.Run("locator does not exist", func() {
+		key := types.OSLocatorKey{KeyId: "k2", EncryptionKey: key2}
+		_, err := s.app.MetadataKeeper.RotateOSLocatorKey(ctx, s.user4Addr, key, nil)
+		s.Require().ErrorIs(err, types.ErrAddressNotBound, "RotateOSLocatorKey")
+	})
+
+	s.Run("previous key expiration not after block time", func() {
+		key := types.OSLocatorKey{KeyId: "k2", EncryptionKey: key2}
+		_, err := s.app.MetadataKeeper.RotateOSLocatorKey(ctx, s.ownerAddr1, key, atP(10))
+		s.Require().EqualError(err, "previous key expiration 1970-01-01T00:00:10Z must be after the current block time 1970-01-01T00:00:10Z", "RotateOSLocatorKey")
+	})
+
+	s.Run("no previous key to expire", func() {
+		key := types.OSLocatorKey{KeyId: "k2", EncryptionKey: key2}
+		_, err := s.app.MetadataKeeper.RotateOSLocatorKey(ctx, s.ownerAddr, key, atP(20))
+		s.Require().EqualError(err, "the locator for "+s.ownerAddr.String()+" does not have a current key to expire", "RotateOSLocatorKey")
+	})
+
+	expKey2 := types.OSLocatorKey{KeyId: "k2", EncryptionKey: key2, ActivationTime: at(10), ActivationHeight: 100}
+	s.Run("rotate from initial key", func() {
+		key := types.OSLocatorKey{KeyId: "k2", EncryptionKey: key2}
+		locator, err := s.app.MetadataKeeper.RotateOSLocatorKey(ctx, s.ownerAddr1, key, atP(20))
+		s.Require().NoError(err, "RotateOSLocatorKey")
+		expInitial := initialKey
+		expInitial.ExpirationTime = atP(20)
+		s.Assert().Equal([]types.OSLocatorKey{expInitial, expKey2}, locator.Keys, "Keys")
+		s.Assert().Equal("k2", locator.CurrentKeyId, "CurrentKeyId")
+		s.Assert().Equal(key2, locator.EncryptionKey, "EncryptionKey")
+
+		stored, found := s.app.MetadataKeeper.GetOsLocatorRecord(ctx, s.ownerAddr1)
+		s.Require().True(found, "GetOsLocatorRecord found")
+		s.Assert().Equal(locator, stored, "stored locator")
+	})
+
+	s.Run("duplicate key id", func() {
+		key := types.OSLocatorKey{KeyId: "k2", EncryptionKey: key3}
+		_, err := s.app.MetadataKeeper.RotateOSLocatorKey(ctx, s.ownerAddr1, key, nil)
+		s.Require().EqualError(err, "key id \"k2\" is already used by the locator for "+s.ownerAddr1.String(), "RotateOSLocatorKey")
+	})
+
+	s.Run("modify rotates key", func() {
+		modCtx := s.ctx.WithBlockTime(at(15)).WithBlockHeight(150)
+		err := s.app.MetadataKeeper.ModifyOSLocator(modCtx, s.ownerAddr1, sdk.MustAccAddressFromBech32(key3), s.uri1)
+		s.Require().NoError(err, "ModifyOSLocator")
+		locator, found := s.app.MetadataKeeper.GetOsLocatorRecord(modCtx, s.ownerAddr1)
+		s.Require().True(found, "GetOsLocatorRecord found")
+		s.Require().Len(locator.Keys, 3, "Keys")
+		s.Assert().Equal(types.OSLocatorKey{KeyId: "3", EncryptionKey: key3, ActivationTime: at(15), ActivationHeight: 150}, locator.Keys[2], "new key")
+		s.Assert().Equal("3", locator.CurrentKeyId, "CurrentKeyId")
+		s.Assert().Equal(key3, locator.EncryptionKey, "EncryptionKey")
+	})
+
+	s.Run("modify without key leaves keys", func() {
+		err := s.app.MetadataKeeper.ModifyOSLocator(ctx, s.ownerAddr1, sdk.AccAddress{}, s.uri1)
+		s.Require().NoError(err, "ModifyOSLocator")
+		locator, found := s.app.MetadataKeeper.GetOsLocatorRecord(ctx, s.ownerAddr1)
+		s.Require().True(found, "GetOsLocatorRecord found")
+		s.Assert().Len(locator.Keys, 3, "Keys")
+		s.Assert().Equal(key3, locator.EncryptionKey, "EncryptionKey")
+	})
+
+	expKey3 := types.OSLocatorKey{KeyId: "3", EncryptionKey: key3, ActivationTime: at(15), ExpirationTime: atP(40), ActivationHeight: 150}
+	expKey4 := types.OSLocatorKey{KeyId: "k4", EncryptionKey: key4, ActivationTime: at(25), ActivationHeight: 250}
+	s.Run("rotate again with expiration removes expired keys", func() {
+		rotCtx := s.ctx.WithBlockTime(at(25)).WithBlockHeight(250)
+		key := types.OSLocatorKey{KeyId: "k4", EncryptionKey: key4}
+		locator, err := s.app.MetadataKeeper.RotateOSLocatorKey(rotCtx, s.ownerAddr1, key, atP(40))
+		s.Require().NoError(err, "RotateOSLocatorKey")
+		// The initial key expired at 20, so it's no longer included.
+		s.Assert().Equal([]types.OSLocatorKey{expKey2, expKey3, expKey4}, locator.Keys, "Keys")
+		s.Assert().Equal("k4", locator.CurrentKeyId, "CurrentKeyId")
+	})
+
+	s.Run("query keys at times", func() {
+		tests := []struct {
+			time       int64
+			expCurrent *types.OSLocatorKey
+			expValid   []types.OSLocatorKey
+		}{
+			{time: 12, expCurrent: &expKey2, expValid: []types.OSLocatorKey{expKey2}},
+			{time: 20, expCurrent: &expKey3, expValid: []types.OSLocatorKey{expKey2, expKey3}},
+			{time: 30, expCurrent: &expKey4, expValid: []types.OSLocatorKey{expKey2, expKey3, expKey4}},
+			{time: 40, expCurrent: &expKey4, expValid: []types.OSLocatorKey{expKey2, expKey4}},
+		}
+
+		for _, tc := range tests {
+			req := &types.OSLocatorKeysRequest{Owner: s.ownerAddr1.String(), Time: atP(tc.time)}
+			resp, err := s.app.MetadataKeeper.OSLocatorKeys(ctx, req)
+			s.Require().NoError(err, "OSLocatorKeys(%d)", tc.time)
+			s.Assert().Equal(at(tc.time), resp.Time, "OSLocatorKeys(%d) Time", tc.time)
+			s.Assert().Equal(tc.expCurrent, resp.CurrentKey, "OSLocatorKeys(%d) CurrentKey", tc.time)
+			s.Assert().Equal(tc.expValid, resp.ValidKeys, "OSLocatorKeys(%d) ValidKeys", tc.time)
+		}
+
+		resp, err := s.app.MetadataKeeper.OSLocatorKeys(ctx, &types.OSLocatorKeysRequest{Owner: s.ownerAddr1.String()})
+		s.Require().NoError(err, "OSLocatorKeys at block time")
+		s.Assert().Equal(at(10), resp.Time, "OSLocatorKeys at block time Time")
+
+		_, err = s.app.MetadataKeeper.OSLocatorKeys(ctx, &types.OSLocatorKeysRequest{Owner: s.user4})
+		s.Require().ErrorIs(err, types.ErrAddressNotBound, "OSLocatorKeys unbound owner")
+	})
+
+	s.Run("query keys at heights", func() {
+		queryCtx := s.ctx.WithBlockTime(at(45)).WithBlockHeight(450)
+		tests := []struct {
+			height     int64
+			expCurrent *types.OSLocatorKey
+			expValid   []types.OSLocatorKey
+		}{
+			{height: 50, expCurrent: nil, expValid: nil},
+			{height: 120, expCurrent: &expKey2, expValid: []types.OSLocatorKey{expKey2}},
+			{height: 200, expCurrent: &expKey3, expValid: []types.OSLocatorKey{expKey2, expKey3}},
+			// The block time at height 300 isn't known, so key 3 is still treated as valid then.
+			{height: 300, expCurrent: &expKey4, expValid: []types.OSLocatorKey{expKey2, expKey3, expKey4}},
+			// The block time of the current height is known, and key 3 had expired by then.
+			{height: 450, expCurrent: &expKey4, expValid: []types.OSLocatorKey{expKey2, expKey4}},
+		}
+
+		for _, tc := range tests {
+			req := &types.OSLocatorKeysRequest{Owner: s.ownerAddr1.String(), Height: tc.height}
+			resp, err := s.app.MetadataKeeper.OSLocatorKeys(queryCtx, req)
+			s.Require().NoError(err, "OSLocatorKeys(%d)", tc.height)
+			s.Assert().Equal(tc.height, resp.Height, "OSLocatorKeys(%d) Height", tc.height)
+			s.Assert().Equal(time.Time{}, resp.Time, "OSLocatorKeys(%d) Time", tc.height)
+			s.Assert().Equal(tc.expCurrent, resp.CurrentKey, "OSLocatorKeys(%d) CurrentKey", tc.height)
+			s.Assert().Equal(tc.expValid, resp.ValidKeys, "OSLocatorKeys(%d) ValidKeys", tc.height)
+		}
+
+		_, err := s.app.MetadataKeeper.OSLocatorKeys(queryCtx, &types.OSLocatorKeysRequest{Owner: s.ownerAddr1.String(), Height: 451})
+		s.Require().ErrorContains(err, "height 451 must be positive and cannot be after the current height 450", "OSLocatorKeys after current height")
+		_, err = s.app.MetadataKeeper.OSLocatorKeys(queryCtx, &types.OSLocatorKeysRequest{Owner: s.ownerAddr1.String(), Height: -1})
+		s.Require().ErrorContains(err, "height -1 must be positive", "OSLocatorKeys negative height")
+		_, err = s.app.MetadataKeeper.OSLocatorKeys(queryCtx, &types.OSLocatorKeysRequest{Owner: s.ownerAddr1.String(), Height: 120, Time: atP(12)})
+		s.Require().ErrorContains(err, "cannot provide both a height and a time", "OSLocatorKeys with height and time")
+	})
+
+	s.Run("oldest keys removed once there are too many", func() {
+		var locator types.ObjectStoreLocator
+		for i := 0; i < types.MaxOSLocatorKeys; i++ {
+			rotCtx := s.ctx.WithBlockTime(at(int64(30 + i))).WithBlockHeight(int64(300 + i))
+			key := types.OSLocatorKey{KeyId: fmt.Sprintf("cap%d", i), EncryptionKey: key2}
+			var err error
+			locator, err = s.app.MetadataKeeper.RotateOSLocatorKey(rotCtx, s.ownerAddr1, key, nil)
+			s.Require().NoError(err, "RotateOSLocatorKey %d", i)
+		}
+		s.Require().Len(locator.Keys, types.MaxOSLocatorKeys, "Keys")
+		s.Assert().Equal("cap0", locator.Keys[0].KeyId, "oldest remaining key id")
+		s.Assert().Equal(fmt.Sprintf("cap%d", types.MaxOSLocatorKeys-1), locator.CurrentKeyId, "CurrentKeyId")
+	})
+}
+
 func (s *KeeperTestSuite) TestDeleteOSLocator() {
 	s.Run("delete os locator", func() {
 		// modify os locator
@@ -301,7 +301,7 @@ func (k msgServer) WriteSession(
 
 	msg.Session.Audit = existingAudit.UpdateAudit(ctx.BlockTime(), strings.Join(msg.Signers, ", "), "")
 
-	k.SetSession(ctx, msg.Session)
+	k.SetSession(ctx, msg.Session, msg.GetSignerStrs()...)
 
 	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_WriteSession, msg.GetSignerStrs()))
 	return types.NewMsgWriteSessionResponse(msg.Session.SessionId), nil
@@ -333,7 +333,7 @@ func (k msgServer) WriteRecord(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	k.SetRecord(ctx, msg.Record)
+	k.SetRecord(ctx, msg.Record, msg.GetSignerStrs()...)
 
 	// Remove the old session if it doesn't have any records in it anymore.
 	// Note that the RemoveSession does the record checking part.
@@ -742,6 +742,26 @@ func (k msgServer) ModifyOSLocator(
 	return &types.MsgModifyOSLocatorResponse{Locator: msg.Locator}, nil
 }
 
+// RotateOSLocatorKey adds a new current encryption key to an ObjectStoreLocator, retaining the previous ones.
+func (k msgServer) RotateOSLocatorKey(
+	goCtx context.Context,
+	msg *types.MsgRotateOSLocatorKeyRequest,
+) (*types.MsgRotateOSLocatorKeyResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "RotateOSLocatorKey")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	// already valid address, checked in ValidateBasic
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	key := types.OSLocatorKey{KeyId: msg.KeyId, EncryptionKey: msg.EncryptionKey}
+	locator, err := k.Keeper.RotateOSLocatorKey(ctx, ownerAddr, key, msg.PreviousKeyExpiration)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_RotateOSLocatorKey, msg.GetSignerStrs()))
+	return &types.MsgRotateOSLocatorKeyResponse{Locator: locator}, nil
+}
+
 // SetAccountData associates some basic data with a metadata address.
 // Currently, only scope ids are supported.
 func (k msgServer) SetAccountData(
@@ -787,3 +807,106 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 
 	return &types.MsgAddNetAssetValuesResponse{}, nil
 }
+
+// UpdateParams is a governance proposal endpoint for updating the metadata module's params.
+func (k msgServer) UpdateParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateParamsRequest,
+) (*types.MsgUpdateParamsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "UpdateParams")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	k.SetParams(ctx, msg.Params)
+	k.EmitEvent(ctx, types.NewEventMetadataParamsUpdated(msg.Params))
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// OfferScope offers the value ownership of one or more scopes for sale.
+func (k msgServer) OfferScope(
+	goCtx context.Context,
+	msg *types.MsgOfferScopeRequest,
+) (*types.MsgOfferScopeResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "OfferScope")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offerID, err := k.CreateScopeOffer(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgOfferScopeResponse{OfferId: offerID}, nil
+}
+
+// AcceptScopeOffer accepts a scope offer, escrowing the price from the buyer, then paying the seller and
+// transferring the value ownership of the scopes.
+func (k msgServer) AcceptScopeOffer(
+	goCtx context.Context,
+	msg *types.MsgAcceptScopeOfferRequest,
+) (*types.MsgAcceptScopeOfferResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "AcceptScopeOffer")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.AcceptScopeOffer(ctx, msg.OfferId, msg.Buyer); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgAcceptScopeOfferResponse{}, nil
+}
+
+// CancelScopeOffer cancels a scope offer.
+func (k msgServer) CancelScopeOffer(
+	goCtx context.Context,
+	msg *types.MsgCancelScopeOfferRequest,
+) (*types.MsgCancelScopeOfferResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "CancelScopeOffer")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.CancelScopeOffer(ctx, msg.OfferId, msg.Seller); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgCancelScopeOfferResponse{}, nil
+}
+
+// BatchUpdateScopes applies owner, data access, and specification changes to many existing scopes at once.
+func (k msgServer) BatchUpdateScopes(
+	goCtx context.Context,
+	msg *types.MsgBatchUpdateScopesRequest,
+) (*types.MsgBatchUpdateScopesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "BatchUpdateScopes")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	results, err := k.Keeper.BatchUpdateScopes(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_BatchUpdateScopes, msg.GetSignerStrs()))
+	return &types.MsgBatchUpdateScopesResponse{Results: results}, nil
+}
+
+// MigrateScopeSpec moves a scope to a newer version of its scope specification.
+func (k msgServer) MigrateScopeSpec(
+	goCtx context.Context,
+	msg *types.MsgMigrateScopeSpecRequest,
+) (*types.MsgMigrateScopeSpecResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "MigrateScopeSpec")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	proposed, err := k.ValidateMigrateScopeSpec(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.SetScope(ctx, proposed); err != nil {
+		return nil, fmt.Errorf("could not update scope %q: %w", msg.ScopeId, err)
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_MigrateScopeSpec, msg.GetSignerStrs()))
+	return &types.MsgMigrateScopeSpecResponse{}, nil
+}
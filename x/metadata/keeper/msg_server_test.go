@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +19,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/app"
@@ -2425,3 +2427,412 @@ func (s *MsgServerTestSuite) TestAddNetAssetValue() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestRecordAndSessionHistory() {
+	s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(2, types.DefaultMaxNavHistoryEntries))
+	defer s.app.MetadataKeeper.SetParams(s.ctx, types.DefaultParams())
+
+	cSpecUUID := uuid.New()
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(cSpecUUID),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("historysource"),
+		ClassName:       "historyclass",
+	}
+	s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+	sSpec := types.ScopeSpecification{
+		SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		ContractSpecIds: []types.MetadataAddress{cSpec.SpecificationId},
+	}
+	s.app.MetadataKeeper.SetScopeSpecification(s.ctx, sSpec)
+	rSpec := types.RecordSpecification{
+		SpecificationId:    types.RecordSpecMetadataAddress(cSpecUUID, "loan"),
+		Name:               "loan",
+		TypeName:           "string",
+		ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+		ResponsibleParties: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+	}
+	s.app.MetadataKeeper.SetRecordSpecification(s.ctx, rSpec)
+
+	scopeUUID := uuid.New()
+	scope := types.Scope{
+		ScopeId:         types.ScopeMetadataAddress(scopeUUID),
+		SpecificationId: sSpec.SpecificationId,
+		Owners:          ownerPartyList(s.user1),
+	}
+	s.app.MetadataKeeper.SetScope(s.ctx, scope)
+
+	session := types.Session{
+		SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: cSpec.SpecificationId,
+		Parties:         ownerPartyList(s.user1),
+		Name:            "historyclass",
+	}
+	record := types.Record{
+		Name:      rSpec.Name,
+		SessionId: session.SessionId,
+		Process: types.Process{
+			ProcessId: &types.Process_Hash{Hash: "prochash"},
+			Name:      "proc",
+			Method:    "procmethod",
+		},
+		Outputs:         []types.RecordOutput{{Hash: "output0", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+		SpecificationId: rSpec.SpecificationId,
+	}
+	recordID := record.GetRecordAddress()
+
+	// The first write of each is not a replacement, so nothing is retained.
+	_, err := s.msgServer.WriteSession(s.ctx, &types.MsgWriteSessionRequest{Session: session, Signers: []string{s.user1}})
+	s.Require().NoError(err, "WriteSession initial")
+	_, err = s.msgServer.WriteRecord(s.ctx, &types.MsgWriteRecordRequest{Record: record, Signers: []string{s.user1}})
+	s.Require().NoError(err, "WriteRecord initial")
+	recordHistory, err := s.app.MetadataKeeper.GetRecordHistory(s.ctx, recordID)
+	s.Require().NoError(err, "GetRecordHistory initial")
+	s.Assert().Empty(recordHistory, "record history after initial write")
+
+	// Each amendment retains the prior version, but only the two most recent are kept.
+	for i := 1; i <= 3; i++ {
+		s.ctx = s.ctx.WithBlockHeight(int64(100 + i))
+		record.Outputs[0].Hash = fmt.Sprintf("output%d", i)
+		_, err = s.msgServer.WriteRecord(s.ctx, &types.MsgWriteRecordRequest{Record: record, Signers: []string{s.user1}})
+		s.Require().NoError(err, "WriteRecord amendment %d", i)
+		session.Context = []byte(fmt.Sprintf("context%d", i))
+		_, err = s.msgServer.WriteSession(s.ctx, &types.MsgWriteSessionRequest{Session: session, Signers: []string{s.user1}})
+		s.Require().NoError(err, "WriteSession amendment %d", i)
+	}
+
+	recordResp, err := s.app.MetadataKeeper.RecordHistory(s.ctx, &types.RecordHistoryRequest{RecordAddr: recordID.String()})
+	s.Require().NoError(err, "RecordHistory query")
+	if s.Assert().Len(recordResp.Versions, 2, "record versions") {
+		s.Assert().Equal(uint64(2), recordResp.Versions[0].Version, "record versions[0].Version")
+		s.Assert().Equal("output1", recordResp.Versions[0].Record.Outputs[0].Hash, "record versions[0] output hash")
+		s.Assert().Equal(int64(102), recordResp.Versions[0].ReplacedHeight, "record versions[0].ReplacedHeight")
+		s.Assert().Equal([]string{s.user1}, recordResp.Versions[0].ReplacedBy, "record versions[0].ReplacedBy")
+		s.Assert().Equal(uint64(3), recordResp.Versions[1].Version, "record versions[1].Version")
+		s.Assert().Equal("output2", recordResp.Versions[1].Record.Outputs[0].Hash, "record versions[1] output hash")
+	}
+
+	sessionResp, err := s.app.MetadataKeeper.SessionHistory(s.ctx, &types.SessionHistoryRequest{SessionAddr: session.SessionId.String()})
+	s.Require().NoError(err, "SessionHistory query")
+	if s.Assert().Len(sessionResp.Versions, 2, "session versions") {
+		s.Assert().Equal(uint64(2), sessionResp.Versions[0].Version, "session versions[0].Version")
+		s.Assert().Equal([]byte("context1"), sessionResp.Versions[0].Session.Context, "session versions[0] context")
+		s.Assert().Equal(uint64(3), sessionResp.Versions[1].Version, "session versions[1].Version")
+		s.Assert().Equal([]byte("context2"), sessionResp.Versions[1].Session.Context, "session versions[1] context")
+	}
+
+	// History is included in genesis.
+	genState := s.app.MetadataKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal(recordResp.Versions, genState.RecordHistory, "exported record history")
+	s.Assert().Equal(sessionResp.Versions, genState.SessionHistory, "exported session history")
+
+	// Disabling history removes the retained versions on the next amendment.
+	s.app.MetadataKeeper.SetParams(s.ctx, types.NewParams(0, types.DefaultMaxNavHistoryEntries))
+	record.Outputs[0].Hash = "output4"
+	_, err = s.msgServer.WriteRecord(s.ctx, &types.MsgWriteRecordRequest{Record: record, Signers: []string{s.user1}})
+	s.Require().NoError(err, "WriteRecord with history disabled")
+	recordHistory, err = s.app.MetadataKeeper.GetRecordHistory(s.ctx, recordID)
+	s.Require().NoError(err, "GetRecordHistory with history disabled")
+	s.Assert().Empty(recordHistory, "record history with history disabled")
+}
+
+func (s *MsgServerTestSuite) TestUpdateParams() {
+	authority := s.app.MetadataKeeper.GetAuthority()
+	params := types.NewParams(25, 50)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, types.NewMsgUpdateParamsRequest(s.user1, params))
+	s.Assert().EqualError(err, fmt.Sprintf("expected %q got %q: expected gov account as only signer for proposal message", authority, s.user1), "UpdateParams by non-authority")
+
+	em := sdk.NewEventManager()
+	s.ctx = s.ctx.WithEventManager(em)
+	_, err = s.msgServer.UpdateParams(s.ctx, types.NewMsgUpdateParamsRequest(authority, params))
+	s.Require().NoError(err, "UpdateParams by authority")
+	s.Assert().Equal(params, s.app.MetadataKeeper.GetParams(s.ctx), "params after update")
+	expEvents := sdk.Events{}
+	event, err := sdk.TypedEventToEvent(types.NewEventMetadataParamsUpdated(params))
+	s.Require().NoError(err, "TypedEventToEvent")
+	expEvents = append(expEvents, event)
+	s.AssertEqualEvents(expEvents, em.Events(), "events emitted by UpdateParams")
+}
+
+func (s *MsgServerTestSuite) TestScopeOffers() {
+	seller, buyer := s.user1, s.user2
+	other := s.setNamedUserAccount("other").String()
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin(types.UsdDenom, 1000))), "FundAccount buyer")
+
+	scopeIDs := make([]types.MetadataAddress, 3)
+	for i := range scopeIDs {
+		scopeIDs[i] = types.ScopeMetadataAddress(uuid.New())
+		scope := types.Scope{
+			ScopeId:           scopeIDs[i],
+			SpecificationId:   types.ScopeSpecMetadataAddress(uuid.New()),
+			Owners:            ownerPartyList(seller),
+			ValueOwnerAddress: seller,
+		}
+		s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope %d", i)
+	}
+	price := sdk.NewInt64Coin(types.UsdDenom, 600)
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	valueOwner := func(scopeID types.MetadataAddress) string {
+		owner, err := s.app.MetadataKeeper.GetScopeValueOwner(s.ctx, scopeID)
+		s.Require().NoError(err, "GetScopeValueOwner(%s)", scopeID)
+		return owner.String()
+	}
+
+	// Only the value owner can offer a scope.
+	_, err := s.msgServer.OfferScope(s.ctx, types.NewMsgOfferScopeRequest(buyer, scopeIDs[:1], price, "", nil))
+	s.Assert().EqualError(err, fmt.Sprintf("seller %s is not the value owner of %s: invalid request", buyer, scopeIDs[0]), "OfferScope by non-owner")
+
+	// Offer two scopes to a specific buyer.
+	res, err := s.msgServer.OfferScope(s.ctx, types.NewMsgOfferScopeRequest(seller, scopeIDs[:2], price, buyer, &expiration))
+	s.Require().NoError(err, "OfferScope")
+	s.Require().Equal(uint64(1), res.OfferId, "OfferScope offer id")
+	offer, err := s.app.MetadataKeeper.GetScopeOffer(s.ctx, res.OfferId)
+	s.Require().NoError(err, "GetScopeOffer")
+	s.Require().NotNil(offer, "GetScopeOffer")
+
+	// Only the named buyer can accept, and not after it expires.
+	_, err = s.msgServer.AcceptScopeOffer(s.ctx, types.NewMsgAcceptScopeOfferRequest(other, res.OfferId))
+	s.Assert().EqualError(err, fmt.Sprintf("scope offer 1 can only be accepted by %s: unauthorized: invalid request", buyer), "AcceptScopeOffer by other")
+	_, err = s.msgServer.AcceptScopeOffer(s.ctx.WithBlockTime(expiration), types.NewMsgAcceptScopeOfferRequest(buyer, res.OfferId))
+	s.Assert().ErrorContains(err, "scope offer 1 expired", "AcceptScopeOffer after expiration")
+
+	em := sdk.NewEventManager()
+	_, err = s.msgServer.AcceptScopeOffer(s.ctx.WithEventManager(em), types.NewMsgAcceptScopeOfferRequest(buyer, res.OfferId))
+	s.Require().NoError(err, "AcceptScopeOffer")
+	s.Assert().Equal(buyer, valueOwner(scopeIDs[0]), "value owner of scope 0 after sale")
+	s.Assert().Equal(buyer, valueOwner(scopeIDs[1]), "value owner of scope 1 after sale")
+	s.Assert().Equal(seller, valueOwner(scopeIDs[2]), "value owner of scope 2 after sale")
+	s.Assert().Equal("600", s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, types.UsdDenom).Amount.String(), "seller balance after sale")
+	s.Assert().Equal("400", s.app.BankKeeper.GetBalance(s.ctx, s.user2Addr, types.UsdDenom).Amount.String(), "buyer balance after sale")
+	nav, err := s.app.MetadataKeeper.GetNetAssetValue(s.ctx, scopeIDs[1].Denom(), types.UsdDenom)
+	s.Require().NoError(err, "GetNetAssetValue")
+	s.Assert().Equal(price.String(), nav.Price.String(), "net asset value price")
+	s.Assert().Equal(uint64(2), nav.Volume, "net asset value volume")
+	offer2, err := s.app.MetadataKeeper.GetScopeOffer(s.ctx, res.OfferId)
+	s.Require().NoError(err, "GetScopeOffer after accept")
+	s.Assert().Nil(offer2, "GetScopeOffer after accept")
+	event, err := sdk.TypedEventToEvent(types.NewEventScopeOfferAccepted(*offer, buyer))
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(em.Events(), event, "events emitted by AcceptScopeOffer")
+
+	// An open offer can be cancelled by its seller.
+	res, err = s.msgServer.OfferScope(s.ctx, types.NewMsgOfferScopeRequest(seller, scopeIDs[2:], price, "", nil))
+	s.Require().NoError(err, "OfferScope open")
+	s.Require().Equal(uint64(2), res.OfferId, "OfferScope open offer id")
+	_, err = s.msgServer.CancelScopeOffer(s.ctx, types.NewMsgCancelScopeOfferRequest(buyer, res.OfferId))
+	s.Assert().EqualError(err, fmt.Sprintf("scope offer 2 can only be cancelled by %s: unauthorized: invalid request", seller), "CancelScopeOffer by non-seller")
+	_, err = s.msgServer.CancelScopeOffer(s.ctx, types.NewMsgCancelScopeOfferRequest(seller, res.OfferId))
+	s.Require().NoError(err, "CancelScopeOffer")
+	_, err = s.msgServer.AcceptScopeOffer(s.ctx, types.NewMsgAcceptScopeOfferRequest(buyer, res.OfferId))
+	s.Assert().EqualError(err, "scope offer 2 not found: invalid request", "AcceptScopeOffer after cancel")
+}
+
+func (s *MsgServerTestSuite) TestBatchUpdateScopes() {
+	other := s.setNamedUserAccount("other").String()
+	newSpec := func(predecessor types.MetadataAddress, roles ...types.PartyType) types.MetadataAddress {
+		spec := types.ScopeSpecification{
+			SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{s.user1},
+			PartiesInvolved: roles,
+			PredecessorId:   predecessor,
+		}
+		s.app.MetadataKeeper.SetScopeSpecification(s.ctx, spec)
+		return spec.SpecificationId
+	}
+	specID := newSpec(nil, types.PartyType_PARTY_TYPE_OWNER)
+	newSpecID := newSpec(specID, types.PartyType_PARTY_TYPE_OWNER)
+	originatorSpecID := newSpec(specID, types.PartyType_PARTY_TYPE_ORIGINATOR)
+	otherSpecID := newSpec(nil, types.PartyType_PARTY_TYPE_OWNER)
+
+	newScope := func(owner string) types.MetadataAddress {
+		scope := types.Scope{
+			ScopeId:         types.ScopeMetadataAddress(uuid.New()),
+			SpecificationId: specID,
+			Owners:          ownerPartyList(owner),
+			DataAccess:      []string{owner},
+		}
+		s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope")
+		return scope.ScopeId
+	}
+	scope1, scope2, scope3, scope4 := newScope(s.user1), newScope(s.user1), newScope(s.user1), newScope(s.user2)
+	scope5 := newScope(s.user1)
+	missingScope := types.ScopeMetadataAddress(uuid.New())
+	affiliate := types.Party{Address: other, Role: types.PartyType_PARTY_TYPE_AFFILIATE}
+
+	updates := []types.ScopeUpdate{
+		{ScopeId: scope1, AddOwners: []types.Party{affiliate}, AddDataAccess: []string{other}},
+		{ScopeId: scope2, SpecificationId: newSpecID, RemoveDataAccess: []string{s.user1}},
+		{ScopeId: scope3, SpecificationId: originatorSpecID},
+		{ScopeId: scope4, AddDataAccess: []string{other}},
+		{ScopeId: scope5, SpecificationId: otherSpecID},
+		{ScopeId: missingScope, AddDataAccess: []string{other}},
+	}
+
+	// When not best-effort, any failure fails the whole request.
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err := s.msgServer.BatchUpdateScopes(cacheCtx, types.NewMsgBatchUpdateScopesRequest(updates, false, []string{s.user1}))
+	s.Assert().EqualError(err, fmt.Sprintf("could not update scope %s: missing roles required by spec: ORIGINATOR need 1 have 0: invalid request", scope3), "BatchUpdateScopes all-or-nothing")
+
+	res, err := s.msgServer.BatchUpdateScopes(s.ctx, types.NewMsgBatchUpdateScopesRequest(updates, true, []string{s.user1}))
+	s.Require().NoError(err, "BatchUpdateScopes best-effort")
+	expResults := []types.ScopeUpdateResult{
+		{ScopeId: scope1, Success: true},
+		{ScopeId: scope2, Success: true},
+		{ScopeId: scope3, Error: "missing roles required by spec: ORIGINATOR need 1 have 0"},
+		{ScopeId: scope4, Error: fmt.Sprintf("missing signature: %s", s.user2)},
+		{ScopeId: scope5, Error: fmt.Sprintf("scope specification %s is not a successor of %s", otherSpecID, specID)},
+		{ScopeId: missingScope, Error: fmt.Sprintf("scope not found with id %s", missingScope)},
+	}
+	s.Assert().Equal(expResults, res.Results, "BatchUpdateScopes results")
+
+	scope, _ := s.app.MetadataKeeper.GetScope(s.ctx, scope1)
+	s.Assert().Equal(append(ownerPartyList(s.user1), affiliate), scope.Owners, "scope 1 owners")
+	s.Assert().Equal([]string{s.user1, other}, scope.DataAccess, "scope 1 data access")
+	scope, _ = s.app.MetadataKeeper.GetScope(s.ctx, scope2)
+	s.Assert().Equal(newSpecID, scope.SpecificationId, "scope 2 specification id")
+	s.Assert().Empty(scope.DataAccess, "scope 2 data access")
+	scope, _ = s.app.MetadataKeeper.GetScope(s.ctx, scope3)
+	s.Assert().Equal(specID, scope.SpecificationId, "scope 3 specification id")
+	scope, _ = s.app.MetadataKeeper.GetScope(s.ctx, scope4)
+	s.Assert().Equal([]string{s.user2}, scope.DataAccess, "scope 4 data access")
+	scope, _ = s.app.MetadataKeeper.GetScope(s.ctx, scope5)
+	s.Assert().Equal(specID, scope.SpecificationId, "scope 5 specification id")
+}
+
+func (s *MsgServerTestSuite) TestMigrateScopeSpec() {
+	newContractSpec := func() types.MetadataAddress {
+		spec := types.ContractSpecification{
+			SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{s.user1},
+			PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			Source:          types.NewContractSpecificationSourceHash("migratesource"),
+			ClassName:       "migrateclass",
+		}
+		s.app.MetadataKeeper.SetContractSpecification(s.ctx, spec)
+		return spec.SpecificationId
+	}
+	cSpec1, cSpec2 := newContractSpec(), newContractSpec()
+	newSpec := func(predecessor types.MetadataAddress, deprecated bool, cSpecs ...types.MetadataAddress) types.MetadataAddress {
+		spec := types.ScopeSpecification{
+			SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{s.user1},
+			PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			ContractSpecIds: cSpecs,
+			PredecessorId:   predecessor,
+			Deprecated:      deprecated,
+		}
+		s.app.MetadataKeeper.SetScopeSpecification(s.ctx, spec)
+		return spec.SpecificationId
+	}
+	specV1 := newSpec(nil, false, cSpec1)
+	specV2 := newSpec(specV1, false, cSpec1, cSpec2)
+	specV3 := newSpec(specV2, false, cSpec1)
+	specV3Narrow := newSpec(specV2, false, cSpec2)
+	specDeprecated := newSpec(specV1, true, cSpec1)
+	specOther := newSpec(nil, false, cSpec1)
+
+	scopeUUID := uuid.New()
+	scope := types.Scope{
+		ScopeId:         types.ScopeMetadataAddress(scopeUUID),
+		SpecificationId: specV1,
+		Owners:          ownerPartyList(s.user1),
+	}
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope")
+	session := types.Session{
+		SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: cSpec1,
+		Parties:         ownerPartyList(s.user1),
+		Name:            "migrateclass",
+	}
+	s.app.MetadataKeeper.SetSession(s.ctx, session)
+
+	tests := []struct {
+		name   string
+		specID types.MetadataAddress
+		signer string
+		expErr string
+	}{
+		{
+			name:   "not a successor",
+			specID: specOther,
+			signer: s.user1,
+			expErr: fmt.Sprintf("scope specification %s is not a successor of %s: invalid request", specOther, specV1),
+		},
+		{
+			name:   "session contract spec not allowed",
+			specID: specV3Narrow,
+			signer: s.user1,
+			expErr: fmt.Sprintf("session %s uses contract specification %s that is not allowed by scope specification %s: invalid request",
+				session.SessionId, cSpec1, specV3Narrow),
+		},
+		{
+			name:   "deprecated",
+			specID: specDeprecated,
+			signer: s.user1,
+			expErr: fmt.Sprintf("scope specification %s is deprecated: invalid request", specDeprecated),
+		},
+		{
+			name:   "not signed by owner",
+			specID: specV3,
+			signer: s.user2,
+			expErr: fmt.Sprintf("missing signature: %s: invalid request", s.user1),
+		},
+		{
+			name:   "successor through lineage",
+			specID: specV3,
+			signer: s.user1,
+		},
+		{
+			name:   "already uses spec",
+			specID: specV3,
+			signer: s.user1,
+			expErr: fmt.Sprintf("scope %s already uses scope specification %s: invalid request", scope.ScopeId, specV3),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.MigrateScopeSpec(s.ctx, types.NewMsgMigrateScopeSpecRequest(scope.ScopeId, tc.specID, []string{tc.signer}))
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "MigrateScopeSpec")
+			} else {
+				s.Assert().NoError(err, "MigrateScopeSpec")
+			}
+		})
+	}
+
+	actual, found := s.app.MetadataKeeper.GetScope(s.ctx, scope.ScopeId)
+	s.Require().True(found, "GetScope found")
+	s.Assert().Equal(specV3, actual.SpecificationId, "scope specification id after migration")
+
+	res, err := s.app.MetadataKeeper.ScopeSpecificationScopes(s.ctx, &types.ScopeSpecificationScopesRequest{SpecificationId: specV3.String()})
+	s.Require().NoError(err, "ScopeSpecificationScopes")
+	s.Assert().Equal([]string{scope.ScopeId.String()}, res.ScopeIds, "ScopeSpecificationScopes scope ids")
+
+	s.Run("write spec with a cyclic predecessor", func() {
+		spec, found := s.app.MetadataKeeper.GetScopeSpecification(s.ctx, specV1)
+		s.Require().True(found, "GetScopeSpecification found")
+		spec.PredecessorId = specV3
+		_, err = s.msgServer.WriteScopeSpecification(s.ctx, types.NewMsgWriteScopeSpecificationRequest(spec, []string{s.user1}))
+		s.Assert().EqualError(err, fmt.Sprintf("scope spec %s cannot be a predecessor of itself: invalid request", specV1), "WriteScopeSpecification")
+	})
+
+	s.Run("new session with deprecated contract spec", func() {
+		cSpec, found := s.app.MetadataKeeper.GetContractSpecification(s.ctx, cSpec2)
+		s.Require().True(found, "GetContractSpecification found")
+		cSpec.Deprecated = true
+		s.app.MetadataKeeper.SetContractSpecification(s.ctx, cSpec)
+		newSession := types.Session{
+			SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+			SpecificationId: cSpec2,
+			Parties:         ownerPartyList(s.user1),
+			Name:            "migrateclass",
+		}
+		_, err = s.msgServer.WriteSession(s.ctx, types.NewMsgWriteSessionRequest(newSession, []string{s.user1}))
+		s.Assert().ErrorContains(err, fmt.Sprintf("contract specification %s is deprecated", cSpec2), "WriteSession")
+	})
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -129,43 +131,151 @@ func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) error
 }
 
 // ModifyOSLocator updates an existing os locator entry in the kvstore, returns an error if it doesn't exist.
+// If the encryption key is changed, the new one becomes the locator's current key, and the previous keys are retained.
+// The encryption key of a locator that has rotated keys cannot be removed, so an empty one leaves its keys unchanged.
 func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
 	}
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	if !store.Has(key) {
+	record, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
 		return types.ErrAddressNotBound
 	}
 
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	record.LocatorUri = urlToPersist.String()
+	var rotatedFrom *string
+	if newKey := encryptionKey.String(); newKey != record.EncryptionKey {
+		switch {
+		case len(newKey) > 0:
+			key := types.OSLocatorKey{KeyId: nextOSLocatorKeyID(record), EncryptionKey: newKey}
+			previousKeyID, err := rotateOSLocatorKey(ctx, &record, key, nil)
+			if err != nil {
+				return err
+			}
+			rotatedFrom = &previousKeyID
+		case len(record.Keys) == 0:
+			record.EncryptionKey = ""
+		}
+	}
+
+	if err = k.setOSLocatorRecord(ctx, record); err != nil {
+		return err
+	}
+	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	if rotatedFrom != nil {
+		k.EmitEvent(ctx, types.NewEventOSLocatorKeyRotated(record.Owner, record.CurrentKeyId, *rotatedFrom, nil))
+	}
+	return nil
+}
+
+// RotateOSLocatorKey adds a new current key to an existing os locator entry, retaining the previous keys.
+// If previousKeyExpiration is not nil, the previous current key will no longer be valid as of that time.
+func (k Keeper) RotateOSLocatorKey(ctx sdk.Context, ownerAddr sdk.AccAddress, key types.OSLocatorKey, previousKeyExpiration *time.Time) (types.ObjectStoreLocator, error) {
+	record, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
+		return types.ObjectStoreLocator{}, types.ErrAddressNotBound
+	}
+
+	previousKeyID, err := rotateOSLocatorKey(ctx, &record, key, previousKeyExpiration)
+	if err != nil {
+		return types.ObjectStoreLocator{}, err
+	}
+	if err = k.setOSLocatorRecord(ctx, record); err != nil {
+		return types.ObjectStoreLocator{}, err
+	}
+	k.EmitEvent(ctx, types.NewEventOSLocatorKeyRotated(record.Owner, key.KeyId, previousKeyID, previousKeyExpiration))
+	return record, nil
+}
+
+// rotateOSLocatorKey makes the provided key the current key of the locator, activating it at the current block height and time.
+// If previousKeyExpiration is not nil, it is set as the expiration time of the previous current key.
+// Keys that have expired are removed, and if the locator would then have more than types.MaxOSLocatorKeys keys,
+// the oldest ones are removed too.
+// Returns the id of the previous current key, or an empty string if the locator did not have one.
+func rotateOSLocatorKey(ctx sdk.Context, record *types.ObjectStoreLocator, key types.OSLocatorKey, previousKeyExpiration *time.Time) (string, error) {
+	if record.GetKey(key.KeyId) != nil {
+		return "", fmt.Errorf("key id %q is already used by the locator for %s", key.KeyId, record.Owner)
+	}
+
+	blockTime := ctx.BlockTime()
+	previousKeyID := record.CurrentKeyId
+	if len(record.Keys) == 0 && len(record.EncryptionKey) > 0 {
+		previousKeyID = types.InitialOSLocatorKeyID
+	}
+	if previousKeyExpiration != nil {
+		if len(previousKeyID) == 0 {
+			return "", fmt.Errorf("the locator for %s does not have a current key to expire", record.Owner)
+		}
+		if !previousKeyExpiration.After(blockTime) {
+			return "", fmt.Errorf("previous key expiration %s must be after the current block time %s",
+				previousKeyExpiration.UTC().Format(time.RFC3339), blockTime.UTC().Format(time.RFC3339))
+		}
+	}
+
+	keys := make([]types.OSLocatorKey, 0, len(record.AllKeys())+1)
+	for _, existing := range record.AllKeys() {
+		if existing.KeyId == previousKeyID && previousKeyExpiration != nil {
+			exp := *previousKeyExpiration
+			existing.ExpirationTime = &exp
+		}
+		if !existing.IsExpiredAt(blockTime) {
+			keys = append(keys, existing)
+		}
+	}
+
+	key.ActivationTime = blockTime
+	key.ActivationHeight = ctx.BlockHeight()
+	key.ExpirationTime = nil
+	keys = append(keys, key)
+	if len(keys) > types.MaxOSLocatorKeys {
+		keys = keys[len(keys)-types.MaxOSLocatorKeys:]
+	}
+	record.Keys = keys
+	record.CurrentKeyId = key.KeyId
+	record.EncryptionKey = key.EncryptionKey
+	if err := record.Validate(); err != nil {
+		return "", err
+	}
+	return previousKeyID, nil
+}
+
+// nextOSLocatorKeyID returns a key id, not yet used by the locator, for a key that the owner did not provide an id for.
+func nextOSLocatorKeyID(record types.ObjectStoreLocator) string {
+	for i := len(record.AllKeys()) + 1; ; i++ {
+		keyID := strconv.Itoa(i)
+		if record.GetKey(keyID) == nil {
+			return keyID
+		}
+	}
+}
+
+// setOSLocatorRecord writes the provided os locator entry to the kvstore.
+func (k Keeper) setOSLocatorRecord(ctx sdk.Context, record types.ObjectStoreLocator) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
 	}
-	store.Set(key, bz)
-	k.EmitEvent(ctx, types.NewEventOSLocatorUpdated(record.Owner))
+	ctx.KVStore(k.storeKey).Set(types.GetOSLocatorKey(ownerAddr), bz)
 	return nil
 }
 
 // ImportOSLocatorRecord binds a name to an address in the kvstore.
 // Different from SetOSLocator in that there is less validation here.
 // The uri format is not checked, and the owner address account is not looked up.
+// The provided record is stored as is, including its keys.
 // This also does not emit any events.
-func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
-	key := types.GetOSLocatorKey(ownerAddr)
-	store := ctx.KVStore(k.storeKey)
-	if store.Has(key) {
-		return types.ErrOSLocatorAlreadyBound
-	}
-
-	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, uri)
-	bz, err := k.cdc.Marshal(&record)
+func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, record types.ObjectStoreLocator) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(record.Owner)
 	if err != nil {
 		return err
 	}
-	store.Set(key, bz)
-	return nil
+	if k.OSLocatorExists(ctx, ownerAddr) {
+		return types.ErrOSLocatorAlreadyBound
+	}
+	return k.setOSLocatorRecord(ctx, record)
 }
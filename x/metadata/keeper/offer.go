@@ -0,0 +1,271 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// scopeOfferHolder is the holder of the hold entries escrowing offered scopes.
+const scopeOfferHolder = types.ModuleName
+
+// GetScopeOffer gets the open scope offer with the provided id.
+// Returns nil, nil if the offer does not exist.
+func (k Keeper) GetScopeOffer(ctx sdk.Context, offerID uint64) (*types.ScopeOffer, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetScopeOfferKey(offerID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var offer types.ScopeOffer
+	if err := k.cdc.Unmarshal(bz, &offer); err != nil {
+		return nil, fmt.Errorf("failed to read scope offer %d: %w", offerID, err)
+	}
+	return &offer, nil
+}
+
+// SetScopeOffer writes the provided scope offer to the store, indexing it by expiration if it has one.
+func (k Keeper) SetScopeOffer(ctx sdk.Context, offer types.ScopeOffer) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&offer)
+	store.Set(types.GetScopeOfferKey(offer.OfferId), bz)
+	if offer.Expiration != nil {
+		store.Set(types.GetScopeOfferExpirationIndexKey(*offer.Expiration, offer.OfferId), []byte{0x01})
+	}
+}
+
+// deleteScopeOffer removes the provided scope offer, and its expiration index entry, from the store.
+func (k Keeper) deleteScopeOffer(ctx sdk.Context, offer types.ScopeOffer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScopeOfferKey(offer.OfferId))
+	if offer.Expiration != nil {
+		store.Delete(types.GetScopeOfferExpirationIndexKey(*offer.Expiration, offer.OfferId))
+	}
+}
+
+// IterateScopeOffers processes all open scope offers, ordered by offer id, with the given handler.
+func (k Keeper) IterateScopeOffers(ctx sdk.Context, handler func(offer types.ScopeOffer) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScopeOfferKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var offer types.ScopeOffer
+		if err := k.cdc.Unmarshal(it.Value(), &offer); err != nil {
+			return err
+		}
+		if handler(offer) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetLastScopeOfferID gets the last id assigned to a scope offer.
+func (k Keeper) GetLastScopeOfferID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastScopeOfferIDKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastScopeOfferID sets the last id assigned to a scope offer.
+func (k Keeper) SetLastScopeOfferID(ctx sdk.Context, offerID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastScopeOfferIDKey, binary.BigEndian.AppendUint64(nil, offerID))
+}
+
+// CreateScopeOffer creates a new scope offer from the provided request and escrows the offered scopes' value
+// owner coins in a hold entry until the offer is accepted, cancelled, or expires. Returns the id of the new offer.
+func (k Keeper) CreateScopeOffer(ctx sdk.Context, msg *types.MsgOfferScopeRequest) (uint64, error) {
+	seller, err := sdk.AccAddressFromBech32(msg.Seller)
+	if err != nil {
+		return 0, fmt.Errorf("invalid seller %q: %w", msg.Seller, err)
+	}
+	if msg.Expiration != nil && !msg.Expiration.After(ctx.BlockTime()) {
+		return 0, fmt.Errorf("expiration %s must be after the current block time", msg.Expiration.UTC())
+	}
+
+	for _, scopeID := range msg.ScopeIds {
+		if _, found := k.GetScope(ctx, scopeID); !found {
+			return 0, fmt.Errorf("scope not found with id %s", scopeID)
+		}
+	}
+	if err = k.validateScopeOfferSeller(ctx, seller, msg.ScopeIds); err != nil {
+		return 0, err
+	}
+
+	offerID := k.GetLastScopeOfferID(ctx) + 1
+	holdID, err := k.holdKeeper.AddHoldEntry(ctx, seller, scopeOfferCoins(msg.ScopeIds), scopeOfferHolder,
+		fmt.Sprintf("scope offer %d", offerID), nil)
+	if err != nil {
+		return 0, fmt.Errorf("could not escrow offered scopes: %w", err)
+	}
+	k.SetLastScopeOfferID(ctx, offerID)
+
+	offer := types.ScopeOffer{
+		OfferId:    offerID,
+		Seller:     msg.Seller,
+		ScopeIds:   msg.ScopeIds,
+		Price:      msg.Price,
+		Buyer:      msg.Buyer,
+		Expiration: msg.Expiration,
+		HoldId:     holdID,
+	}
+	k.SetScopeOffer(ctx, offer)
+	k.EmitEvent(ctx, types.NewEventScopeOfferCreated(offer))
+	return offerID, nil
+}
+
+// AcceptScopeOffer closes an open scope offer by paying its price from the buyer to the seller, releasing its scopes
+// from escrow, and sending their value ownership from the seller to the buyer. A net asset value is then recorded
+// for each scope.
+func (k Keeper) AcceptScopeOffer(ctx sdk.Context, offerID uint64, buyer string) error {
+	offer, err := k.GetScopeOffer(ctx, offerID)
+	if err != nil {
+		return err
+	}
+	if offer == nil {
+		return fmt.Errorf("scope offer %d not found", offerID)
+	}
+	if offer.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("scope offer %d expired at %s", offerID, offer.Expiration.UTC())
+	}
+	if len(offer.Buyer) > 0 && offer.Buyer != buyer {
+		return sdkerrors.ErrUnauthorized.Wrapf("scope offer %d can only be accepted by %s", offerID, offer.Buyer)
+	}
+	if offer.Seller == buyer {
+		return fmt.Errorf("scope offer %d cannot be accepted by its seller", offerID)
+	}
+
+	buyerAddr, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil {
+		return fmt.Errorf("invalid buyer %q: %w", buyer, err)
+	}
+	if k.bankKeeper.BlockedAddr(buyerAddr) {
+		return sdkerrors.ErrUnauthorized.Wrapf("buyer %s is not allowed to receive funds", buyer)
+	}
+	sellerAddr, err := sdk.AccAddressFromBech32(offer.Seller)
+	if err != nil {
+		return fmt.Errorf("invalid seller %q: %w", offer.Seller, err)
+	}
+
+	if err = k.validateScopeOfferSeller(ctx, sellerAddr, offer.ScopeIds); err != nil {
+		return err
+	}
+
+	price := sdk.Coins{offer.Price}
+	if err = k.bankKeeper.SendCoins(ctx, buyerAddr, sellerAddr, price); err != nil {
+		return fmt.Errorf("could not pay %s from %s to %s: %w", price, buyer, offer.Seller, err)
+	}
+	if err = k.holdKeeper.ReleaseHoldByID(ctx, scopeOfferHolder, offer.HoldId); err != nil {
+		return fmt.Errorf("could not release offered scopes from escrow: %w", err)
+	}
+	coins := scopeOfferCoins(offer.ScopeIds)
+	if err = k.bankKeeper.SendCoins(ctx, sellerAddr, buyerAddr, coins); err != nil {
+		return fmt.Errorf("could not send scope coins %q from %s to %s: %w", coins, offer.Seller, buyer, err)
+	}
+
+	k.deleteScopeOffer(ctx, *offer)
+	k.EmitEvent(ctx, types.NewEventScopeOfferAccepted(*offer, buyer))
+	k.recordScopeOfferNAVs(ctx, *offer)
+	return nil
+}
+
+// CancelScopeOffer removes an open scope offer, releasing its scopes from escrow.
+func (k Keeper) CancelScopeOffer(ctx sdk.Context, offerID uint64, seller string) error {
+	offer, err := k.GetScopeOffer(ctx, offerID)
+	if err != nil {
+		return err
+	}
+	if offer == nil {
+		return fmt.Errorf("scope offer %d not found", offerID)
+	}
+	if offer.Seller != seller {
+		return sdkerrors.ErrUnauthorized.Wrapf("scope offer %d can only be cancelled by %s", offerID, offer.Seller)
+	}
+
+	if err = k.holdKeeper.ReleaseHoldByID(ctx, scopeOfferHolder, offer.HoldId); err != nil {
+		return fmt.Errorf("could not release offered scopes from escrow: %w", err)
+	}
+	k.deleteScopeOffer(ctx, *offer)
+	k.EmitEvent(ctx, types.NewEventScopeOfferCancelled(*offer))
+	return nil
+}
+
+// PruneExpiredScopeOffers removes all scope offers that have expired, releasing their scopes from escrow.
+func (k Keeper) PruneExpiredScopeOffers(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.ScopeOfferExpirationIndexPrefix, types.GetScopeOfferExpirationIndexPrefixUpTo(ctx.BlockTime()))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	var errs []error
+	for _, key := range keys {
+		offerID := types.GetScopeOfferExpirationIndexOfferID(key)
+		offer, err := k.GetScopeOffer(ctx, offerID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if offer == nil {
+			store.Delete(key)
+			continue
+		}
+		// The index only has the second of the expiration, so it might not have expired yet.
+		if !offer.IsExpired(ctx.BlockTime()) {
+			continue
+		}
+		if err = k.holdKeeper.ReleaseHoldByID(ctx, scopeOfferHolder, offer.HoldId); err != nil {
+			errs = append(errs, fmt.Errorf("could not release scope offer %d from escrow: %w", offer.OfferId, err))
+		}
+		k.deleteScopeOffer(ctx, *offer)
+		k.EmitEvent(ctx, types.NewEventScopeOfferExpired(*offer))
+	}
+
+	if len(errs) > 0 {
+		k.Logger(ctx).Error(fmt.Sprintf("%d error(s) encountered pruning expired scope offers", len(errs)), "error", errors.Join(errs...))
+	}
+}
+
+// validateScopeOfferSeller returns an error if the seller is not the value owner of each of the provided scopes.
+func (k Keeper) validateScopeOfferSeller(ctx sdk.Context, seller sdk.AccAddress, scopeIDs []types.MetadataAddress) error {
+	for _, scopeID := range scopeIDs {
+		owner, err := k.bankKeeper.DenomOwner(ctx, scopeID.Denom())
+		if err != nil {
+			return fmt.Errorf("could not get value owner of %s: %w", scopeID, err)
+		}
+		if !seller.Equals(owner) {
+			return fmt.Errorf("seller %s is not the value owner of %s", seller, scopeID)
+		}
+	}
+	return nil
+}
+
+// scopeOfferCoins returns the value owner coins of the provided scopes.
+func scopeOfferCoins(scopeIDs []types.MetadataAddress) sdk.Coins {
+	coins := make(sdk.Coins, 0, len(scopeIDs))
+	for _, scopeID := range scopeIDs {
+		coins = coins.Add(scopeID.Coin())
+	}
+	return coins
+}
+
+// recordScopeOfferNAVs records the price of an accepted offer as a net asset value of each of its scopes.
+// The volume of each is the number of scopes in the offer. Problems are logged without failing the sale.
+func (k Keeper) recordScopeOfferNAVs(ctx sdk.Context, offer types.ScopeOffer) {
+	source := fmt.Sprintf("x/metadata scope offer %d", offer.OfferId)
+	nav := types.NewNetAssetValue(offer.Price, uint64(len(offer.ScopeIds)))
+	for _, scopeID := range offer.ScopeIds {
+		if err := k.AddSetNetAssetValues(ctx, scopeID, []types.NetAssetValue{nav}, source); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("could not set net asset value of %s from %s: %v", scopeID, source, err))
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+type ScopeOfferKeeperTestSuite struct {
+	suite.Suite
+
+	app *simapp.App
+	ctx sdk.Context
+
+	sellerAddr sdk.AccAddress
+	seller     string
+	buyerAddr  sdk.AccAddress
+	buyer      string
+	otherAddr  sdk.AccAddress
+	other      string
+
+	scopeIDs []types.MetadataAddress
+	price    sdk.Coin
+}
+
+func (s *ScopeOfferKeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T())
+	s.ctx = FreshCtx(s.app).WithBlockTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	s.sellerAddr = sdk.AccAddress("seller______________")
+	s.seller = s.sellerAddr.String()
+	s.buyerAddr = sdk.AccAddress("buyer_______________")
+	s.buyer = s.buyerAddr.String()
+	s.otherAddr = sdk.AccAddress("other_______________")
+	s.other = s.otherAddr.String()
+	for _, addr := range []sdk.AccAddress{s.sellerAddr, s.buyerAddr, s.otherAddr} {
+		s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr))
+	}
+
+	s.scopeIDs = s.newScopes(2)
+	s.price = sdk.NewInt64Coin(types.UsdDenom, 600)
+}
+
+func TestScopeOfferKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeOfferKeeperTestSuite))
+}
+
+// newScopes creates the provided number of scopes, with the seller as their owner and value owner.
+func (s *ScopeOfferKeeperTestSuite) newScopes(count int) []types.MetadataAddress {
+	scopeIDs := make([]types.MetadataAddress, count)
+	for i := range scopeIDs {
+		scopeIDs[i] = types.ScopeMetadataAddress(uuid.New())
+		scope := types.Scope{
+			ScopeId:           scopeIDs[i],
+			SpecificationId:   types.ScopeSpecMetadataAddress(uuid.New()),
+			Owners:            ownerPartyList(s.seller),
+			ValueOwnerAddress: s.seller,
+		}
+		s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope %d", i)
+	}
+	return scopeIDs
+}
+
+// fund gives the provided account the provided amount of usd.
+func (s *ScopeOfferKeeperTestSuite) fund(addr sdk.AccAddress, amount int64) {
+	err := banktestutil.FundAccount(s.ctx, s.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(types.UsdDenom, amount)))
+	s.Require().NoError(err, "FundAccount(%s, %d)", addr, amount)
+}
+
+// createOffer creates an offer of all the test scopes, failing the test if it can't.
+func (s *ScopeOfferKeeperTestSuite) createOffer(buyer string, expiration *time.Time) uint64 {
+	return s.createOfferOf(s.scopeIDs, buyer, expiration)
+}
+
+// createOfferOf creates an offer of the provided scopes, failing the test if it can't.
+func (s *ScopeOfferKeeperTestSuite) createOfferOf(scopeIDs []types.MetadataAddress, buyer string, expiration *time.Time) uint64 {
+	msg := types.NewMsgOfferScopeRequest(s.seller, scopeIDs, s.price, buyer, expiration)
+	offerID, err := s.app.MetadataKeeper.CreateScopeOffer(s.ctx, msg)
+	s.Require().NoError(err, "CreateScopeOffer")
+	return offerID
+}
+
+// assertOfferExists asserts that the scope offer with the provided id does (or doesn't) exist.
+func (s *ScopeOfferKeeperTestSuite) assertOfferExists(ctx sdk.Context, offerID uint64, exists bool) {
+	offer, err := s.app.MetadataKeeper.GetScopeOffer(ctx, offerID)
+	s.Require().NoError(err, "GetScopeOffer(%d)", offerID)
+	if exists {
+		s.Assert().NotNil(offer, "GetScopeOffer(%d)", offerID)
+	} else {
+		s.Assert().Nil(offer, "GetScopeOffer(%d)", offerID)
+	}
+}
+
+// assertValueOwners asserts that all of the test scopes have the provided value owner.
+func (s *ScopeOfferKeeperTestSuite) assertValueOwners(exp string) {
+	for _, scopeID := range s.scopeIDs {
+		owner, err := s.app.MetadataKeeper.GetScopeValueOwner(s.ctx, scopeID)
+		if s.Assert().NoError(err, "GetScopeValueOwner(%s)", scopeID) {
+			s.Assert().Equal(exp, owner.String(), "value owner of %s", scopeID)
+		}
+	}
+}
+
+// assertUsd asserts that the provided account has the expected usd balance and no usd on hold.
+func (s *ScopeOfferKeeperTestSuite) assertUsd(addr sdk.AccAddress, exp string, name string) {
+	balance := s.app.BankKeeper.GetBalance(s.ctx, addr, types.UsdDenom)
+	s.Assert().Equal(exp, balance.Amount.String(), "%s balance", name)
+	onHold, err := s.app.HoldKeeper.GetHoldCoin(s.ctx, addr, types.UsdDenom)
+	if s.Assert().NoError(err, "GetHoldCoin(%s)", name) {
+		s.Assert().True(onHold.IsZero(), "%s usd on hold: %s", name, onHold)
+	}
+}
+
+// assertScopesOnHold asserts that the provided scopes' value owner coins are (or aren't) on hold for the seller.
+func (s *ScopeOfferKeeperTestSuite) assertScopesOnHold(ctx sdk.Context, scopeIDs []types.MetadataAddress, onHold bool) {
+	for _, scopeID := range scopeIDs {
+		held, err := s.app.HoldKeeper.GetHoldCoin(ctx, s.sellerAddr, scopeID.Denom())
+		if s.Assert().NoError(err, "GetHoldCoin(%s)", scopeID) {
+			s.Assert().Equal(onHold, !held.IsZero(), "%s on hold", scopeID)
+		}
+	}
+}
+
+// metadataEvents returns the metadata module events emitted to the provided event manager.
+func metadataEvents(em *sdk.EventManager) sdk.Events {
+	var rv sdk.Events
+	for _, event := range em.Events() {
+		if strings.HasPrefix(event.Type, "provenance.metadata.") {
+			rv = append(rv, event)
+		}
+	}
+	return rv
+}
+
+func (s *ScopeOfferKeeperTestSuite) TestCreateScopeOfferEscrowsScopes() {
+	offerID := s.createOffer("", nil)
+	offer, err := s.app.MetadataKeeper.GetScopeOffer(s.ctx, offerID)
+	s.Require().NoError(err, "GetScopeOffer")
+	s.Assert().NotZero(offer.HoldId, "offer hold id")
+	s.assertScopesOnHold(s.ctx, s.scopeIDs, true)
+
+	// While the offer is open, the seller can't send the scopes elsewhere or offer them again.
+	err = s.app.MetadataKeeper.SetScopeValueOwner(s.ctx, s.scopeIDs[1], s.other)
+	s.Assert().ErrorContains(err, "insufficient funds", "SetScopeValueOwner while offered")
+	s.assertValueOwners(s.seller)
+
+	msg := types.NewMsgOfferScopeRequest(s.seller, s.scopeIDs[1:], s.price, "", nil)
+	_, err = s.app.MetadataKeeper.CreateScopeOffer(s.ctx, msg)
+	s.Assert().ErrorContains(err, "could not escrow offered scopes: ", "CreateScopeOffer of an offered scope")
+}
+
+func (s *ScopeOfferKeeperTestSuite) TestAcceptScopeOffer() {
+	s.fund(s.buyerAddr, 1000)
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	offerID := s.createOffer("", &expiration)
+
+	em := sdk.NewEventManager()
+	err := s.app.MetadataKeeper.AcceptScopeOffer(s.ctx.WithEventManager(em), offerID, s.buyer)
+	s.Require().NoError(err, "AcceptScopeOffer")
+
+	s.assertValueOwners(s.buyer)
+	s.assertScopesOnHold(s.ctx, s.scopeIDs, false)
+	s.assertUsd(s.sellerAddr, "600", "seller")
+	s.assertUsd(s.buyerAddr, "400", "buyer")
+	s.assertOfferExists(s.ctx, offerID, false)
+
+	// The scopes were released from escrow before being sent.
+	var holdEvents []string
+	for _, event := range em.Events() {
+		if event.Type == "provenance.hold.v1.EventHoldEntryAdded" || event.Type == "provenance.hold.v1.EventHoldEntryReleased" {
+			holdEvents = append(holdEvents, event.Type)
+		}
+	}
+	s.Assert().Equal([]string{"provenance.hold.v1.EventHoldEntryReleased"}, holdEvents, "hold events emitted by AcceptScopeOffer")
+
+	// Once accepted, it is no longer pruned when it would have expired.
+	em = sdk.NewEventManager()
+	s.app.MetadataKeeper.PruneExpiredScopeOffers(s.ctx.WithBlockTime(expiration).WithEventManager(em))
+	s.Assert().Empty(em.Events(), "events emitted by PruneExpiredScopeOffers after accept")
+}
+
+func (s *ScopeOfferKeeperTestSuite) TestAcceptScopeOfferInsufficientFunds() {
+	s.fund(s.buyerAddr, 500)
+	offerID := s.createOffer(s.buyer, nil)
+
+	err := s.app.MetadataKeeper.AcceptScopeOffer(s.ctx, offerID, s.buyer)
+	s.Assert().ErrorContains(err, fmt.Sprintf("could not pay %s from %s to %s: ", s.price, s.buyer, s.seller), "AcceptScopeOffer")
+
+	s.assertValueOwners(s.seller)
+	s.assertScopesOnHold(s.ctx, s.scopeIDs, true)
+	s.assertUsd(s.sellerAddr, "0", "seller")
+	s.assertUsd(s.buyerAddr, "500", "buyer")
+	s.assertOfferExists(s.ctx, offerID, true)
+
+	// Once the buyer has enough, it can be accepted.
+	s.fund(s.buyerAddr, 100)
+	err = s.app.MetadataKeeper.AcceptScopeOffer(s.ctx, offerID, s.buyer)
+	s.Require().NoError(err, "AcceptScopeOffer after funding")
+	s.assertValueOwners(s.buyer)
+	s.assertScopesOnHold(s.ctx, s.scopeIDs, false)
+	s.assertUsd(s.sellerAddr, "600", "seller")
+	s.assertUsd(s.buyerAddr, "0", "buyer")
+}
+
+func (s *ScopeOfferKeeperTestSuite) TestCancelScopeOffer() {
+	s.fund(s.buyerAddr, 1000)
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	offerID := s.createOffer("", &expiration)
+
+	err := s.app.MetadataKeeper.CancelScopeOffer(s.ctx, offerID, s.buyer)
+	s.Assert().EqualError(err, fmt.Sprintf("scope offer %d can only be cancelled by %s: unauthorized", offerID, s.seller), "CancelScopeOffer by buyer")
+	s.assertOfferExists(s.ctx, offerID, true)
+	s.assertScopesOnHold(s.ctx, s.scopeIDs, true)
+
+	em := sdk.NewEventManager()
+	err = s.app.MetadataKeeper.CancelScopeOffer(s.ctx.WithEventManager(em), offerID, s.seller)
+	s.Require().NoError(err, "CancelScopeOffer")
+	s.assertOfferExists(s.ctx, offerID, false)
+	s.assertScopesOnHold(s.ctx, s.scopeIDs, false)
+	event, err := sdk.TypedEventToEvent(types.NewEventScopeOfferCancelled(types.ScopeOffer{OfferId: offerID, Seller: s.seller}))
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Equal(sdk.Events{event}, metadataEvents(em), "metadata events emitted by CancelScopeOffer")
+
+	err = s.app.MetadataKeeper.AcceptScopeOffer(s.ctx, offerID, s.buyer)
+	s.Assert().EqualError(err, fmt.Sprintf("scope offer %d not found", offerID), "AcceptScopeOffer after cancel")
+	s.assertValueOwners(s.seller)
+	s.assertUsd(s.buyerAddr, "1000", "buyer")
+
+	// Once cancelled, it is no longer pruned when it would have expired.
+	em = sdk.NewEventManager()
+	s.app.MetadataKeeper.PruneExpiredScopeOffers(s.ctx.WithBlockTime(expiration).WithEventManager(em))
+	s.Assert().Empty(em.Events(), "events emitted by PruneExpiredScopeOffers after cancel")
+
+	// The scopes can be offered again.
+	s.createOffer("", nil)
+}
+
+func (s *ScopeOfferKeeperTestSuite) TestPruneExpiredScopeOffers() {
+	s.fund(s.buyerAddr, 1000)
+	expiration1 := s.ctx.BlockTime().Add(time.Hour)
+	expiration2 := expiration1.Add(500 * time.Millisecond)
+	scopeIDs1 := s.newScopes(1)
+	scopeIDs2 := s.newScopes(1)
+	offerID1 := s.createOfferOf(scopeIDs1, "", &expiration1)
+	offerID2 := s.createOfferOf(scopeIDs2, "", &expiration2)
+	offerID3 := s.createOffer("", nil)
+
+	// An expired offer cannot be accepted even before it's pruned.
+	ctx := s.ctx.WithBlockTime(expiration1)
+	err := s.app.MetadataKeeper.AcceptScopeOffer(ctx, offerID1, s.buyer)
+	s.Assert().ErrorContains(err, fmt.Sprintf("scope offer %d expired at", offerID1), "AcceptScopeOffer after expiration")
+	s.assertUsd(s.buyerAddr, "1000", "buyer")
+
+	// Only the offers that have expired are removed, and their scopes released from escrow.
+	em := sdk.NewEventManager()
+	s.app.MetadataKeeper.PruneExpiredScopeOffers(ctx.WithEventManager(em))
+	s.assertOfferExists(ctx, offerID1, false)
+	s.assertOfferExists(ctx, offerID2, true)
+	s.assertOfferExists(ctx, offerID3, true)
+	s.assertScopesOnHold(ctx, scopeIDs1, false)
+	s.assertScopesOnHold(ctx, scopeIDs2, true)
+	event, err := sdk.TypedEventToEvent(types.NewEventScopeOfferExpired(types.ScopeOffer{OfferId: offerID1, Seller: s.seller}))
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Equal(sdk.Events{event}, metadataEvents(em), "metadata events emitted by PruneExpiredScopeOffers at first expiration")
+
+	ctx = s.ctx.WithBlockTime(expiration2.Add(time.Hour))
+	em = sdk.NewEventManager()
+	s.app.MetadataKeeper.PruneExpiredScopeOffers(ctx.WithEventManager(em))
+	s.assertOfferExists(ctx, offerID2, false)
+	s.assertOfferExists(ctx, offerID3, true)
+	s.assertScopesOnHold(ctx, scopeIDs2, false)
+	s.assertScopesOnHold(ctx, s.scopeIDs, true)
+	event, err = sdk.TypedEventToEvent(types.NewEventScopeOfferExpired(types.ScopeOffer{OfferId: offerID2, Seller: s.seller}))
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Equal(sdk.Events{event}, metadataEvents(em), "metadata events emitted by PruneExpiredScopeOffers at second expiration")
+
+	// The offer without an expiration can still be accepted.
+	err = s.app.MetadataKeeper.AcceptScopeOffer(ctx, offerID3, s.buyer)
+	s.Require().NoError(err, "AcceptScopeOffer without expiration")
+	s.assertValueOwners(s.buyer)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetParams returns the metadata module params.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	err := k.cdc.Unmarshal(bz, &params)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams sets the metadata module params to the store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, bz)
+}
+
+// GetMaxNavHistoryEntries returns the configured parameter for the number of net asset value history entries to retain.
+func (k Keeper) GetMaxNavHistoryEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxNavHistoryEntries
+}
+
+// GetMaxHistoryVersions returns the configured parameter for the number of prior record and session versions to retain.
+func (k Keeper) GetMaxHistoryVersions(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxHistoryVersions
+}
@@ -5,6 +5,7 @@ import (
 	b64 "encoding/base64"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -23,9 +24,10 @@ const defaultLimit = 100
 var _ types.QueryServer = Keeper{}
 
 // Params queries params of metadata module.
-func (k Keeper) Params(_ context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "Params")
-	resp := &types.QueryParamsResponse{Params: types.Params{}}
+	ctx := sdk.UnwrapSDKContext(c)
+	resp := &types.QueryParamsResponse{Params: k.GetParams(ctx)}
 	if req != nil && req.IncludeRequest {
 		resp.Request = req
 	}
@@ -569,6 +571,80 @@ func (k Keeper) RecordsAll(c context.Context, req *types.RecordsAllRequest) (*ty
 	return &retval, nil
 }
 
+// RecordHistory returns the retained prior versions of a record.
+func (k Keeper) RecordHistory(c context.Context, req *types.RecordHistoryRequest) (*types.RecordHistoryResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "RecordHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.RecordHistoryResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.RecordAddr) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty record address")
+	}
+	recordAddr, err := ParseRecordAddr(req.RecordAddr)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRecordHistoryKeyPrefix(recordAddr))
+	retval.Pagination, err = query.Paginate(historyStore, getPageRequest(req), func(_, value []byte) error {
+		var version types.RecordVersion
+		if vErr := k.cdc.Unmarshal(value, &version); vErr != nil {
+			return vErr
+		}
+		retval.Versions = append(retval.Versions, version)
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// SessionHistory returns the retained prior versions of a session.
+func (k Keeper) SessionHistory(c context.Context, req *types.SessionHistoryRequest) (*types.SessionHistoryResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "SessionHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.SessionHistoryResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.SessionAddr) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty session address")
+	}
+	sessionAddr, err := ParseSessionAddr(req.SessionAddr)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSessionHistoryKeyPrefix(sessionAddr))
+	retval.Pagination, err = query.Paginate(historyStore, getPageRequest(req), func(_, value []byte) error {
+		var version types.SessionVersion
+		if vErr := k.cdc.Unmarshal(value, &version); vErr != nil {
+			return vErr
+		}
+		retval.Versions = append(retval.Versions, version)
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
 // Ownership returns a list of scope identifiers that list the given address as a data or value owner.
 func (k Keeper) Ownership(c context.Context, req *types.OwnershipRequest) (*types.OwnershipResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "Ownership")
@@ -755,6 +831,47 @@ func (k Keeper) ScopeSpecificationsAll(c context.Context, req *types.ScopeSpecif
 	return &retval, nil
 }
 
+// ScopeSpecificationScopes returns the ids of the scopes that use a scope specification.
+func (k Keeper) ScopeSpecificationScopes(c context.Context, req *types.ScopeSpecificationScopesRequest) (*types.ScopeSpecificationScopesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeSpecificationScopes")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeSpecificationScopesResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.SpecificationId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("specification id cannot be empty")
+	}
+
+	specAddr, err := ParseScopeSpecID(req.SpecificationId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	scopeStore := prefix.NewStore(store, types.GetScopeSpecScopeCacheIteratorPrefix(specAddr))
+
+	pageRes, err := query.Paginate(scopeStore, req.Pagination, func(key, _ []byte) error {
+		var scopeID types.MetadataAddress
+		if mErr := scopeID.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		retval.ScopeIds = append(retval.ScopeIds, scopeID.String())
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+
+	return &retval, nil
+}
+
 // ContractSpecification returns a specific contract specification by id.
 func (k Keeper) ContractSpecification(c context.Context, req *types.ContractSpecificationRequest) (*types.ContractSpecificationResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ContractSpecification")
@@ -1065,6 +1182,51 @@ func (k Keeper) OSLocator(c context.Context, request *types.OSLocatorRequest) (*
 	return &retval, nil
 }
 
+func (k Keeper) OSLocatorKeys(c context.Context, request *types.OSLocatorKeysRequest) (*types.OSLocatorKeysResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "OSLocatorKeys")
+	if request == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.OSLocatorKeysResponse{}
+	if request.IncludeRequest {
+		retval.Request = request
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	accAddr, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return &retval, types.ErrInvalidAddress
+	}
+	record, exists := k.GetOsLocatorRecord(ctx, accAddr)
+	if !exists {
+		return &retval, types.ErrAddressNotBound
+	}
+
+	switch {
+	case request.Height != 0 && request.Time != nil:
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("cannot provide both a height and a time")
+	case request.Height < 0 || request.Height > ctx.BlockHeight():
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("height %d must be positive and cannot be after the current height %d",
+			request.Height, ctx.BlockHeight())
+	case request.Height != 0 && request.Height == ctx.BlockHeight():
+		// The block time of the current height is known, so the keys can be resolved at it exactly.
+		retval.Height = request.Height
+		retval.CurrentKey, retval.ValidKeys = record.KeysAt(ctx.BlockTime())
+	case request.Height != 0:
+		retval.Height = request.Height
+		retval.CurrentKey, retval.ValidKeys = record.KeysAtHeight(request.Height)
+	default:
+		retval.Time = ctx.BlockTime()
+		if request.Time != nil {
+			retval.Time = *request.Time
+		}
+		retval.CurrentKey, retval.ValidKeys = record.KeysAt(retval.Time)
+	}
+
+	return &retval, nil
+}
+
 func (k Keeper) OSLocatorsByURI(ctx context.Context, request *types.OSLocatorsByURIRequest) (*types.OSLocatorsByURIResponse, error) {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "OSLocatorsByURI")
 	retval := types.OSLocatorsByURIResponse{}
@@ -1355,6 +1517,157 @@ func (k Keeper) ScopeNetAssetValues(c context.Context, req *types.QueryScopeNetA
 	return &types.QueryScopeNetAssetValuesResponse{NetAssetValues: navs}, nil
 }
 
+// ScopeNetAssetValueAtHeight returns the net asset value of a scope that was in effect at a block height.
+func (k Keeper) ScopeNetAssetValueAtHeight(c context.Context, req *types.QueryScopeNetAssetValueAtHeightRequest) (*types.QueryScopeNetAssetValueAtHeightResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeNetAssetValueAtHeight")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.PriceDenom) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("price denom cannot be empty")
+	}
+	if req.Height < 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("height cannot be negative: %d", req.Height)
+	}
+
+	scopeID, err := types.MetadataAddressFromBech32(req.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("error extracting scope address: %v", err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("id %q is not a scope id", req.Id)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+	entry, err := k.GetNetAssetValueAtHeight(ctx, scopeID, req.PriceDenom, uint64(height))
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no %s net asset value history for %s at height %d", req.PriceDenom, scopeID, height)
+	}
+
+	return &types.QueryScopeNetAssetValueAtHeightResponse{Entry: *entry}, nil
+}
+
+// ScopeNetAssetValueTWAP returns the time-weighted average price per unit of a scope over a window of time.
+func (k Keeper) ScopeNetAssetValueTWAP(c context.Context, req *types.QueryScopeNetAssetValueTWAPRequest) (*types.QueryScopeNetAssetValueTWAPResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeNetAssetValueTWAP")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.PriceDenom) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("price denom cannot be empty")
+	}
+
+	scopeID, err := types.MetadataAddressFromBech32(req.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("error extracting scope address: %v", err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("id %q is not a scope id", req.Id)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	endTime := ctx.BlockTime()
+	if req.EndTime != nil {
+		endTime = *req.EndTime
+	}
+	if !endTime.After(req.StartTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("end time %s must be after start time %s",
+			endTime.UTC().Format(time.RFC3339), req.StartTime.UTC().Format(time.RFC3339))
+	}
+	entries, err := k.GetNetAssetValueHistory(ctx, scopeID, req.PriceDenom)
+	if err != nil {
+		return nil, err
+	}
+	avg, startTime, err := types.NetAssetValueTWAP(entries, req.StartTime, endTime)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("could not calculate %s average for %s: %v", req.PriceDenom, scopeID, err)
+	}
+
+	return &types.QueryScopeNetAssetValueTWAPResponse{
+		AveragePricePerUnit: avg.String(),
+		PriceDenom:          req.PriceDenom,
+		StartTime:           startTime,
+		EndTime:             endTime,
+	}, nil
+}
+
+// ScopeOffer returns a single open scope offer.
+func (k Keeper) ScopeOffer(c context.Context, req *types.ScopeOfferRequest) (*types.ScopeOfferResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeOffer")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeOfferResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if req.OfferId == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("offer id cannot be zero")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offer, err := k.GetScopeOffer(ctx, req.OfferId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if offer == nil {
+		return &retval, sdkerrors.ErrNotFound.Wrapf("scope offer %d not found", req.OfferId)
+	}
+	retval.Offer = offer
+
+	return &retval, nil
+}
+
+// ScopeOffers returns all open scope offers, optionally limited to those of a single seller.
+func (k Keeper) ScopeOffers(c context.Context, req *types.ScopeOffersRequest) (*types.ScopeOffersResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "ScopeOffers")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeOffersResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.Seller) > 0 {
+		if _, err := sdk.AccAddressFromBech32(req.Seller); err != nil {
+			return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid seller %q: %v", req.Seller, err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScopeOfferKeyPrefix)
+	var err error
+	retval.Pagination, err = query.FilteredPaginate(offerStore, getPageRequest(req), func(_, value []byte, accumulate bool) (bool, error) {
+		var offer types.ScopeOffer
+		if vErr := k.cdc.Unmarshal(value, &offer); vErr != nil {
+			return false, vErr
+		}
+		if len(req.Seller) > 0 && offer.Seller != req.Seller {
+			return false, nil
+		}
+		if accumulate {
+			retval.Offers = append(retval.Offers, offer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
 // hasPageRequest is just for use with the getPageRequest func below.
 type hasPageRequest interface {
 	GetPagination() *query.PageRequest
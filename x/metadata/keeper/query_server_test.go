@@ -2281,6 +2281,190 @@ func (s *QueryServerTestSuite) TestScopeNetAssetValuesQuery() {
 	}
 }
 
+func (s *QueryServerTestSuite) TestScopeNetAssetValueAtHeightQuery() {
+	app := s.app
+	ctx := s.ctx.WithBlockHeight(40)
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scopeIDNF := types.ScopeMetadataAddress(uuid.New())
+
+	// Each block is 5 seconds after the previous one.
+	blockTime := func(height int64) time.Time {
+		return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(height) * 5 * time.Second)
+	}
+	entry := func(height uint64, amount int64, source string) types.NetAssetValueHistoryEntry {
+		return types.NetAssetValueHistoryEntry{
+			NetAssetValue: types.NetAssetValue{Price: sdk.NewInt64Coin("usd", amount), Volume: 1, UpdatedBlockHeight: height},
+			Source:        source,
+			Time:          blockTime(int64(height)),
+		}
+	}
+	for _, e := range []types.NetAssetValueHistoryEntry{entry(10, 100, "first"), entry(20, 200, "second"), entry(30, 300, "third")} {
+		err := app.MetadataKeeper.SetNetAssetValue(ctx.WithBlockHeight(int64(e.NetAssetValue.UpdatedBlockHeight)).WithBlockTime(e.Time), scopeID, e.NetAssetValue, e.Source)
+		s.Require().NoError(err, "SetNetAssetValue at height %d", e.NetAssetValue.UpdatedBlockHeight)
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.QueryScopeNetAssetValueAtHeightRequest
+		expErr   string
+		expEntry types.NetAssetValueHistoryEntry
+	}{
+		{
+			name:   "bad scope id",
+			req:    &types.QueryScopeNetAssetValueAtHeightRequest{Id: "not-a-scope-id", PriceDenom: "usd"},
+			expErr: "error extracting scope address",
+		},
+		{
+			name:   "not a scope id",
+			req:    &types.QueryScopeNetAssetValueAtHeightRequest{Id: types.ScopeSpecMetadataAddress(uuid.New()).String(), PriceDenom: "usd"},
+			expErr: "is not a scope id",
+		},
+		{
+			name:   "no price denom",
+			req:    &types.QueryScopeNetAssetValueAtHeightRequest{Id: scopeID.String()},
+			expErr: "price denom cannot be empty",
+		},
+		{
+			name:   "negative height",
+			req:    &types.QueryScopeNetAssetValueAtHeightRequest{Id: scopeID.String(), PriceDenom: "usd", Height: -5},
+			expErr: "height cannot be negative: -5",
+		},
+		{
+			name:   "unknown scope",
+			req:    &types.QueryScopeNetAssetValueAtHeightRequest{Id: scopeIDNF.String(), PriceDenom: "usd"},
+			expErr: "no usd net asset value history for " + scopeIDNF.String() + " at height 40",
+		},
+		{
+			name:   "before first entry",
+			req:    &types.QueryScopeNetAssetValueAtHeightRequest{Id: scopeID.String(), PriceDenom: "usd", Height: 9},
+			expErr: "no usd net asset value history for " + scopeID.String() + " at height 9",
+		},
+		{
+			name:     "exact height",
+			req:      &types.QueryScopeNetAssetValueAtHeightRequest{Id: scopeID.String(), PriceDenom: "usd", Height: 20},
+			expEntry: entry(20, 200, "second"),
+		},
+		{
+			name:     "between entries",
+			req:      &types.QueryScopeNetAssetValueAtHeightRequest{Id: scopeID.String(), PriceDenom: "usd", Height: 29},
+			expEntry: entry(20, 200, "second"),
+		},
+		{
+			name:     "current height",
+			req:      &types.QueryScopeNetAssetValueAtHeightRequest{Id: scopeID.String(), PriceDenom: "usd"},
+			expEntry: entry(30, 300, "third"),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := app.MetadataKeeper.ScopeNetAssetValueAtHeight(ctx, tc.req)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr, "ScopeNetAssetValueAtHeight error")
+			} else {
+				s.Require().NoError(err, "ScopeNetAssetValueAtHeight error")
+				s.Assert().Equal(tc.expEntry, resp.Entry, "ScopeNetAssetValueAtHeight entry")
+			}
+		})
+	}
+}
+
+func (s *QueryServerTestSuite) TestScopeNetAssetValueTWAPQuery() {
+	app := s.app
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+
+	// Blocks 10 to 20 are 10 seconds apart, the rest are 5 seconds apart.
+	blockTime := func(height int64) time.Time {
+		rv := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(height) * 5 * time.Second)
+		if height > 10 {
+			rv = rv.Add(time.Duration(min(height, 20)-10) * 5 * time.Second)
+		}
+		return rv
+	}
+	timeP := func(t time.Time) *time.Time {
+		return &t
+	}
+	ctx := s.ctx.WithBlockHeight(40).WithBlockTime(blockTime(40))
+
+	navs := []types.NetAssetValue{
+		{Price: sdk.NewInt64Coin("usd", 100), Volume: 10, UpdatedBlockHeight: 10},
+		{Price: sdk.NewInt64Coin("usd", 40), Volume: 2, UpdatedBlockHeight: 20},
+		{Price: sdk.NewInt64Coin("usd", 30), Volume: 1, UpdatedBlockHeight: 30},
+	}
+	for _, nav := range navs {
+		height := int64(nav.UpdatedBlockHeight)
+		err := app.MetadataKeeper.SetNetAssetValue(ctx.WithBlockHeight(height).WithBlockTime(blockTime(height)), scopeID, nav, "source")
+		s.Require().NoError(err, "SetNetAssetValue at height %d", nav.UpdatedBlockHeight)
+	}
+
+	tests := []struct {
+		name   string
+		req    *types.QueryScopeNetAssetValueTWAPRequest
+		expErr string
+		expRes *types.QueryScopeNetAssetValueTWAPResponse
+	}{
+		{
+			name:   "bad scope id",
+			req:    &types.QueryScopeNetAssetValueTWAPRequest{Id: "not-a-scope-id", PriceDenom: "usd", StartTime: blockTime(1)},
+			expErr: "error extracting scope address",
+		},
+		{
+			name:   "no price denom",
+			req:    &types.QueryScopeNetAssetValueTWAPRequest{Id: scopeID.String(), StartTime: blockTime(1)},
+			expErr: "price denom cannot be empty",
+		},
+		{
+			name:   "end not after start",
+			req:    &types.QueryScopeNetAssetValueTWAPRequest{Id: scopeID.String(), PriceDenom: "usd", StartTime: blockTime(25), EndTime: timeP(blockTime(20))},
+			expErr: "end time 2024-01-01T00:02:30Z must be after start time 2024-01-01T00:02:55Z",
+		},
+		{
+			name:   "start after current time",
+			req:    &types.QueryScopeNetAssetValueTWAPRequest{Id: scopeID.String(), PriceDenom: "usd", StartTime: blockTime(50)},
+			expErr: "end time 2024-01-01T00:04:10Z must be after start time 2024-01-01T00:05:00Z",
+		},
+		{
+			name:   "no history in range",
+			req:    &types.QueryScopeNetAssetValueTWAPRequest{Id: scopeID.String(), PriceDenom: "usd", StartTime: blockTime(1), EndTime: timeP(blockTime(10))},
+			expErr: "no net asset value was in effect from 2024-01-01T00:00:05Z to 2024-01-01T00:00:50Z",
+		},
+		{
+			name: "range across two entries",
+			req:  &types.QueryScopeNetAssetValueTWAPRequest{Id: scopeID.String(), PriceDenom: "usd", StartTime: blockTime(15), EndTime: timeP(blockTime(25))},
+			expRes: &types.QueryScopeNetAssetValueTWAPResponse{
+				// 10 for 50 seconds, 20 for 25 seconds.
+				AveragePricePerUnit: "13.333333333333333333",
+				PriceDenom:          "usd",
+				StartTime:           blockTime(15),
+				EndTime:             blockTime(25),
+			},
+		},
+		{
+			name: "range starting before history up to current time",
+			req:  &types.QueryScopeNetAssetValueTWAPRequest{Id: scopeID.String(), PriceDenom: "usd", StartTime: blockTime(1)},
+			expRes: &types.QueryScopeNetAssetValueTWAPResponse{
+				// 10 for 100 seconds, 20 for 50 seconds, 30 for 50 seconds.
+				AveragePricePerUnit: "17.500000000000000000",
+				PriceDenom:          "usd",
+				StartTime:           blockTime(10),
+				EndTime:             blockTime(40),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := app.MetadataKeeper.ScopeNetAssetValueTWAP(ctx, tc.req)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr, "ScopeNetAssetValueTWAP error")
+			} else {
+				s.Require().NoError(err, "ScopeNetAssetValueTWAP error")
+				s.Assert().Equal(tc.expRes, resp, "ScopeNetAssetValueTWAP response")
+			}
+		})
+	}
+}
+
 // TODO: OSLocatorParams tests
 // TODO: OSLocator tests
 // TODO: OSLocatorsByURI tests
@@ -57,15 +57,19 @@ func (k Keeper) GetRecords(ctx sdk.Context, scopeAddress types.MetadataAddress,
 }
 
 // SetRecord stores a record in the module kv store.
-func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
+// If the record already exists, the version being replaced is retained in its history as replaced by the provided signers.
+func (k Keeper) SetRecord(ctx sdk.Context, record types.Record, signers ...string) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&record)
 
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
-	if store.Has(recordID) {
+	if existing := store.Get(recordID); existing != nil {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
+		var prev types.Record
+		k.cdc.MustUnmarshal(existing, &prev)
+		k.addRecordHistory(ctx, prev, signers)
 	}
 
 	store.Set(recordID, b)
@@ -301,6 +305,61 @@ func (k Keeper) ValidateWriteRecord(
 	// case types.DefinitionType_DEFINITION_TYPE_PROPOSED: ignored
 	// case types.DefinitionType_DEFINITION_TYPE_UNSPECIFIED: ignored
 
+	// If the contract spec has a type schema, make sure the record's types conform to it.
+	contractSpec, found := k.GetContractSpecification(ctx, session.SpecificationId)
+	if found && contractSpec.TypeSchema != nil {
+		if err = k.ValidateRecordTypes(ctx, *contractSpec.TypeSchema, recSpec, proposed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateRecordTypes checks that the value of each of a record's outputs is a value of the type declared by its record
+// specification, that the value of each input with a hash source is a value of the input's type, and that each input
+// sourced from another record has the type declared by that record's specification.
+// See types.CompiledRecordTypeSchema.ValidateValue for how values are checked against the schema.
+func (k Keeper) ValidateRecordTypes(
+	ctx sdk.Context,
+	schema types.RecordTypeSchema,
+	recSpec types.RecordSpecification,
+	record *types.Record,
+) error {
+	compiled, err := k.compileTypeSchema(schema)
+	if err != nil {
+		return fmt.Errorf("invalid contract specification type schema: %w", err)
+	}
+
+	for i, output := range record.Outputs {
+		if err = compiled.ValidateValue(recSpec.TypeName, output.Hash); err != nil {
+			return fmt.Errorf("record %s output %d is not a valid %s: %w", record.Name, i, recSpec.TypeName, err)
+		}
+	}
+
+	for _, input := range record.Inputs {
+		switch source := input.Source.(type) {
+		case *types.RecordInput_Hash:
+			if err = compiled.ValidateValue(input.TypeName, source.Hash); err != nil {
+				return fmt.Errorf("input %s is not a valid %s: %w", input.Name, input.TypeName, err)
+			}
+		case *types.RecordInput_RecordId:
+			sourceRecord, found := k.GetRecord(ctx, source.RecordId)
+			if !found {
+				return fmt.Errorf("input %s source record id %s not found", input.Name, source.RecordId)
+			}
+			sourceSpec, found := k.GetRecordSpecification(ctx, sourceRecord.SpecificationId)
+			if !found {
+				return fmt.Errorf("input %s source record %s type is unknown: record specification %s not found",
+					input.Name, source.RecordId, sourceRecord.SpecificationId)
+			}
+			if sourceSpec.TypeName != input.TypeName {
+				return fmt.Errorf("input %s has TypeName %s but source record %s has type %s",
+					input.Name, input.TypeName, source.RecordId, sourceSpec.TypeName)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -120,6 +120,29 @@ func (s *RecordKeeperTestSuite) TestMetadataRecordGetSetRemove() {
 	s.NotNil(r)
 }
 
+func (s *RecordKeeperTestSuite) TestSetRecordHistory() {
+	ctx := s.FreshCtx()
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	record := types.NewRecord(s.recordName, s.sessionID, *process, []types.RecordInput{}, []types.RecordOutput{}, s.recordSpecID)
+	s.app.MetadataKeeper.SetRecord(ctx, *record, s.user1)
+	history, err := s.app.MetadataKeeper.GetRecordHistory(ctx, s.recordID)
+	s.Require().NoError(err, "GetRecordHistory after create")
+	s.Assert().Empty(history, "history after create")
+	orig, found := s.app.MetadataKeeper.GetRecord(ctx, s.recordID)
+	s.Require().True(found, "GetRecord after create")
+
+	updated := *record
+	updated.Process = *types.NewProcess("processname", &types.Process_Hash{Hash: "HASH2"}, "process_method")
+	s.app.MetadataKeeper.SetRecord(ctx, updated, s.user2)
+	history, err = s.app.MetadataKeeper.GetRecordHistory(ctx, s.recordID)
+	s.Require().NoError(err, "GetRecordHistory after update")
+	if s.Assert().Len(history, 1, "history after update") {
+		s.Assert().Equal(orig, history[0].Record, "history record")
+		s.Assert().Equal(uint64(1), history[0].Version, "history version")
+		s.Assert().Equal([]string{s.user2}, history[0].ReplacedBy, "history replaced by")
+	}
+}
+
 func (s *RecordKeeperTestSuite) TestMetadataRecordIterator() {
 	ctx := s.FreshCtx()
 	for i := 1; i <= 10; i++ {
@@ -1014,3 +1037,132 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 		})
 	}
 }
+
+func (s *RecordKeeperTestSuite) TestValidateRecordTypes() {
+	ctx := s.FreshCtx()
+	schema := *types.NewRecordTypeSchema(types.RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+		[]byte(`{"title":"Loan","type":"object","required":["amount"],"properties":{"amount":{"type":"integer","minimum":1}},`+
+			`"$defs":{"Payment":{"type":"object"},"Borrower":{"type":"object","properties":{"name":{"type":"string"}}}}}`))
+
+	scopeUUID := uuid.New()
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	paymentSpec := types.NewRecordSpecification(types.RecordSpecMetadataAddress(s.contractSpecUUID, "payment"),
+		"payment", nil, "Payment", types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	s.app.MetadataKeeper.SetRecordSpecification(ctx, *paymentSpec)
+	paymentRecord := types.NewRecord("payment", sessionID, types.Process{}, nil,
+		[]types.RecordOutput{{Hash: "paymenthash", Status: types.ResultStatus_RESULT_STATUS_PASS}}, paymentSpec.SpecificationId)
+	s.app.MetadataKeeper.SetRecord(ctx, *paymentRecord)
+	paymentRecordID := types.RecordMetadataAddress(scopeUUID, "payment")
+	orphanRecord := types.NewRecord("orphan", sessionID, types.Process{}, nil,
+		[]types.RecordOutput{{Hash: "orphanhash", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+		types.RecordSpecMetadataAddress(s.contractSpecUUID, "orphan"))
+	s.app.MetadataKeeper.SetRecord(ctx, *orphanRecord)
+	orphanRecordID := types.RecordMetadataAddress(scopeUUID, "orphan")
+
+	loanSpec := types.RecordSpecification{Name: "loan", TypeName: "Loan"}
+	hashInput := func(name, typeName, value string) types.RecordInput {
+		return *types.NewRecordInput(name, &types.RecordInput_Hash{Hash: value}, typeName, types.RecordInputStatus_Proposed)
+	}
+	recordInput := func(name, typeName string, recordID types.MetadataAddress) types.RecordInput {
+		return *types.NewRecordInput(name, &types.RecordInput_RecordId{RecordId: recordID}, typeName, types.RecordInputStatus_Record)
+	}
+	outputs := func(values ...string) []types.RecordOutput {
+		rv := make([]types.RecordOutput, len(values))
+		for i, value := range values {
+			rv[i] = types.RecordOutput{Hash: value, Status: types.ResultStatus_RESULT_STATUS_PASS}
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name    string
+		schema  types.RecordTypeSchema
+		recSpec types.RecordSpecification
+		inputs  []types.RecordInput
+		outputs []types.RecordOutput
+		expErr  string
+	}{
+		{
+			name:    "invalid schema",
+			schema:  types.RecordTypeSchema{Definition: []byte("{}")},
+			recSpec: loanSpec,
+			expErr:  "invalid contract specification type schema: unknown format RECORD_TYPE_SCHEMA_FORMAT_UNSPECIFIED",
+		},
+		{
+			name:    "output type not in schema",
+			schema:  schema,
+			recSpec: types.RecordSpecification{Name: "loan", TypeName: "Mortgage"},
+			outputs: outputs(`{"amount":5}`),
+			expErr:  `record loan output 0 is not a valid Mortgage: type "Mortgage" is not defined in the schema`,
+		},
+		{
+			name:    "output value not json",
+			schema:  schema,
+			recSpec: loanSpec,
+			outputs: outputs("somehash"),
+			expErr:  "record loan output 0 is not a valid Loan: value is not json: invalid character 's' looking for beginning of value",
+		},
+		{
+			name:    "second output value does not conform",
+			schema:  schema,
+			recSpec: loanSpec,
+			outputs: outputs(`{"amount":5}`, `{"amount":0}`),
+			expErr:  "record loan output 1 is not a valid Loan: $.amount: 0 is less than the minimum 1",
+		},
+		{
+			name:    "hash input type not in schema",
+			schema:  schema,
+			recSpec: loanSpec,
+			inputs:  []types.RecordInput{hashInput("borrower", "Borrower", `{}`), hashInput("lender", "Lender", `{}`)},
+			expErr:  `input lender is not a valid Lender: type "Lender" is not defined in the schema`,
+		},
+		{
+			name:    "hash input value does not conform",
+			schema:  schema,
+			recSpec: loanSpec,
+			inputs:  []types.RecordInput{hashInput("borrower", "Borrower", `{"name":5}`)},
+			expErr:  "input borrower is not a valid Borrower: $.name: expected type string, got number",
+		},
+		{
+			name:    "record input type does not match source record",
+			schema:  schema,
+			recSpec: loanSpec,
+			inputs:  []types.RecordInput{recordInput("payment", "Borrower", paymentRecordID)},
+			expErr:  "input payment has TypeName Borrower but source record " + paymentRecordID.String() + " has type Payment",
+		},
+		{
+			name:    "record input source record not found",
+			schema:  schema,
+			recSpec: loanSpec,
+			inputs:  []types.RecordInput{recordInput("payment", "Payment", types.RecordMetadataAddress(scopeUUID, "missing"))},
+			expErr:  "input payment source record id " + types.RecordMetadataAddress(scopeUUID, "missing").String() + " not found",
+		},
+		{
+			name:    "record input source record spec not found",
+			schema:  schema,
+			recSpec: loanSpec,
+			inputs:  []types.RecordInput{recordInput("orphan", "Payment", orphanRecordID)},
+			expErr: "input orphan source record " + orphanRecordID.String() + " type is unknown: record specification " +
+				orphanRecord.SpecificationId.String() + " not found",
+		},
+		{
+			name:    "all types match",
+			schema:  schema,
+			recSpec: loanSpec,
+			inputs:  []types.RecordInput{hashInput("borrower", "Borrower", `{"name":"Bob"}`), recordInput("payment", "Payment", paymentRecordID)},
+			outputs: outputs(`{"amount":5}`, `{"amount":7,"note":"second"}`),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			record := &types.Record{Name: tc.recSpec.Name, SessionId: sessionID, Inputs: tc.inputs, Outputs: tc.outputs}
+			err := s.app.MetadataKeeper.ValidateRecordTypes(ctx, tc.schema, tc.recSpec, record)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ValidateRecordTypes error")
+			} else {
+				s.Assert().NoError(err, "ValidateRecordTypes error")
+			}
+		})
+	}
+}
@@ -473,6 +473,9 @@ func (k Keeper) ValidateWriteScope(
 		if !found {
 			return nil, fmt.Errorf("scope specification %s not found", proposed.SpecificationId)
 		}
+		if scopeSpec.Deprecated && (existing == nil || !existing.SpecificationId.Equals(proposed.SpecificationId)) {
+			return nil, fmt.Errorf("scope specification %s is deprecated", proposed.SpecificationId)
+		}
 
 		if err = validateRolesPresent(proposed.Owners, scopeSpec.PartiesInvolved); err != nil {
 			return nil, err
@@ -889,6 +892,7 @@ func (k Keeper) SetNetAssetValue(ctx sdk.Context, scopeID types.MetadataAddress,
 		return err
 	}
 	store.Set(key, bz)
+	k.addNetAssetValueHistory(ctx, scopeID, netAssetValue, source)
 
 	return nil
 }
@@ -910,9 +914,10 @@ func (k Keeper) IterateNetAssetValues(ctx sdk.Context, scopeID types.MetadataAdd
 	return nil
 }
 
-// RemoveNetAssetValues removes all net asset values for a scope
+// RemoveNetAssetValues removes all net asset values, and their history, for a scope
 func (k Keeper) RemoveNetAssetValues(ctx sdk.Context, scopeID types.MetadataAddress) {
 	store := ctx.KVStore(k.storeKey)
+	pruneHistory(store, types.NetAssetValueHistoryScopePrefix(scopeID), 0)
 	it := storetypes.KVStorePrefixIterator(store, types.NetAssetValueKeyPrefix(scopeID))
 	var keys [][]byte
 	for ; it.Valid(); it.Next() {
@@ -944,6 +949,52 @@ func (k Keeper) SetNetAssetValueWithBlockHeight(ctx sdk.Context, scopeID types.M
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Set(key, bz)
+	k.addNetAssetValueHistory(ctx, scopeID, netAssetValue, source)
 
 	return nil
 }
+
+// ValidateMigrateScopeSpec checks that the scope in the provided msg can be moved to the msg's scope specification.
+// The new scope spec must be a successor of the scope's current one, and each of the scope's sessions must use a
+// contract spec that's allowed by it. Returns the migrated scope.
+func (k Keeper) ValidateMigrateScopeSpec(ctx sdk.Context, msg *types.MsgMigrateScopeSpecRequest) (types.Scope, error) {
+	existing, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return types.Scope{}, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+	if existing.SpecificationId.Equals(msg.SpecificationId) {
+		return types.Scope{}, fmt.Errorf("scope %s already uses scope specification %s", msg.ScopeId, msg.SpecificationId)
+	}
+
+	update := types.ScopeUpdate{ScopeId: msg.ScopeId, SpecificationId: msg.SpecificationId}
+	return k.validateScopeUpdate(ctx, update, msg, make(map[string]error))
+}
+
+// validateScopeSpecMigration returns an error if the existing scope cannot be moved to the provided scope spec.
+// The new scope spec must be a successor of the scope's current one, and must allow the contract spec of each
+// of the scope's sessions.
+func (k Keeper) validateScopeSpecMigration(ctx sdk.Context, existing types.Scope, scopeSpec types.ScopeSpecification) error {
+	if !k.IsScopeSpecSuccessor(ctx, scopeSpec, existing.SpecificationId) {
+		return fmt.Errorf("scope specification %s is not a successor of %s", scopeSpec.SpecificationId, existing.SpecificationId)
+	}
+
+	// The records of a session are defined by its contract spec, so if the session's contract spec
+	// is allowed by the new scope spec, its records still satisfy it too.
+	allowed := make(map[string]bool, len(scopeSpec.ContractSpecIds))
+	for _, id := range scopeSpec.ContractSpecIds {
+		allowed[string(id)] = true
+	}
+	var sessionErr error
+	err := k.IterateSessions(ctx, existing.ScopeId, func(session types.Session) (stop bool) {
+		if !allowed[string(session.SpecificationId)] {
+			sessionErr = fmt.Errorf("session %s uses contract specification %s that is not allowed by scope specification %s",
+				session.SessionId, session.SpecificationId, scopeSpec.SpecificationId)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return fmt.Errorf("could not iterate sessions of scope %s: %w", existing.ScopeId, err)
+	}
+	return sessionErr
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// BatchUpdateScopes applies the updates in the provided request, in order, and returns the result of each.
+// Signers are validated once for each unique set of signing requirements among the scopes being updated.
+// If the request is not best-effort, an error is returned as soon as any update fails.
+func (k Keeper) BatchUpdateScopes(ctx sdk.Context, msg *types.MsgBatchUpdateScopesRequest) ([]types.ScopeUpdateResult, error) {
+	signerResults := make(map[string]error)
+	results := make([]types.ScopeUpdateResult, len(msg.Updates))
+	for i, update := range msg.Updates {
+		results[i].ScopeId = update.ScopeId
+		proposed, err := k.validateScopeUpdate(ctx, update, msg, signerResults)
+		if err == nil {
+			err = k.SetScope(ctx, proposed)
+		}
+		if err != nil {
+			if !msg.BestEffort {
+				return nil, fmt.Errorf("could not update scope %s: %w", update.ScopeId, err)
+			}
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Success = true
+	}
+	return results, nil
+}
+
+// validateScopeUpdate applies the provided update to its existing scope and makes sure the result is valid and that
+// the msg has been signed by those required to change the scope. Signer validation results are cached in
+// signerResults so that scopes with the same signing requirements are only checked once. Returns the updated scope.
+func (k Keeper) validateScopeUpdate(
+	ctx sdk.Context,
+	update types.ScopeUpdate,
+	msg types.MetadataMsg,
+	signerResults map[string]error,
+) (types.Scope, error) {
+	existing, found := k.GetScope(ctx, update.ScopeId)
+	if !found {
+		return types.Scope{}, fmt.Errorf("scope not found with id %s", update.ScopeId)
+	}
+
+	proposed := existing
+	proposed.Owners = append([]types.Party{}, existing.Owners...)
+	proposed.DataAccess = append([]string{}, existing.DataAccess...)
+	if err := proposed.RemoveOwners(update.RemoveOwners); err != nil {
+		return types.Scope{}, err
+	}
+	if err := proposed.AddOwners(update.AddOwners); err != nil {
+		return types.Scope{}, err
+	}
+	for _, da := range update.RemoveDataAccess {
+		if !slices.Contains(proposed.DataAccess, da) {
+			return types.Scope{}, fmt.Errorf("address does not exist in scope data access: %s", da)
+		}
+	}
+	proposed.RemoveDataAccess(update.RemoveDataAccess)
+	for _, da := range update.AddDataAccess {
+		if slices.Contains(proposed.DataAccess, da) {
+			return types.Scope{}, fmt.Errorf("address already exists for data access %s", da)
+		}
+	}
+	proposed.AddDataAccess(update.AddDataAccess)
+	if !update.SpecificationId.Empty() {
+		proposed.SpecificationId = update.SpecificationId
+	}
+
+	if err := proposed.ValidateBasic(); err != nil {
+		return types.Scope{}, err
+	}
+	scopeSpec, found := k.GetScopeSpecification(ctx, proposed.SpecificationId)
+	if !found {
+		return types.Scope{}, fmt.Errorf("scope specification %s not found", proposed.SpecificationId)
+	}
+	if !existing.SpecificationId.Equals(proposed.SpecificationId) {
+		if scopeSpec.Deprecated {
+			return types.Scope{}, fmt.Errorf("scope specification %s is deprecated", proposed.SpecificationId)
+		}
+		if err := k.validateScopeSpecMigration(ctx, existing, scopeSpec); err != nil {
+			return types.Scope{}, err
+		}
+	}
+	if err := validateRolesPresent(proposed.Owners, scopeSpec.PartiesInvolved); err != nil {
+		return types.Scope{}, err
+	}
+	if err := k.validateProvenanceRole(ctx, types.BuildPartyDetails(nil, proposed.Owners)); err != nil {
+		return types.Scope{}, err
+	}
+
+	// Make sure everyone has signed. The value owner isn't changing so we don't care about that one.
+	key := scopeSignersKey(existing, scopeSpec)
+	err, checked := signerResults[key]
+	if !checked {
+		if !existing.RequirePartyRollup {
+			err = k.ValidateSignersWithoutParties(ctx, existing.GetAllOwnerAddresses(), msg)
+		} else {
+			err = k.ValidateSignersWithParties(ctx, existing.Owners, existing.Owners, scopeSpec.PartiesInvolved, msg)
+		}
+		signerResults[key] = err
+	}
+	if err != nil {
+		return types.Scope{}, err
+	}
+
+	return proposed, nil
+}
+
+// scopeSignersKey returns a string that identifies the signers required to change the provided existing scope
+// when the resulting scope uses the provided scope spec.
+func scopeSignersKey(existing types.Scope, scopeSpec types.ScopeSpecification) string {
+	if !existing.RequirePartyRollup {
+		addrs := existing.GetAllOwnerAddresses()
+		slices.Sort(addrs)
+		return "owners:" + strings.Join(addrs, ",")
+	}
+
+	parties := make([]string, len(existing.Owners))
+	for i, party := range existing.Owners {
+		parties[i] = fmt.Sprintf("%s/%s/%t", party.Address, party.Role, party.Optional)
+	}
+	slices.Sort(parties)
+	roles := make([]string, len(scopeSpec.PartiesInvolved))
+	for i, role := range scopeSpec.PartiesInvolved {
+		roles[i] = role.String()
+	}
+	slices.Sort(roles)
+	return "parties:" + strings.Join(parties, ",") + ";roles:" + strings.Join(roles, ",")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -3396,6 +3397,59 @@ func (s *ScopeKeeperTestSuite) TestGetNetAssetValue() {
 	}
 }
 
+func (s *ScopeKeeperTestSuite) TestNetAssetValueHistory() {
+	ctx := s.FreshCtx()
+	params := s.app.MetadataKeeper.GetParams(ctx)
+	params.MaxNavHistoryEntries = 2
+	s.app.MetadataKeeper.SetParams(ctx, params)
+
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	// Each block is 5 seconds after the previous one.
+	blockTime := func(height int64) time.Time {
+		return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(height) * 5 * time.Second)
+	}
+	setNav := func(height int64, denom string, amount int64) {
+		nav := types.NetAssetValue{Price: sdk.NewInt64Coin(denom, amount)}
+		err := s.app.MetadataKeeper.SetNetAssetValue(ctx.WithBlockHeight(height).WithBlockTime(blockTime(height)), scopeID, nav, "source")
+		s.Require().NoError(err, "SetNetAssetValue %s at height %d", denom, height)
+	}
+	entry := func(height uint64, denom string, amount int64) types.NetAssetValueHistoryEntry {
+		return types.NetAssetValueHistoryEntry{
+			NetAssetValue: types.NetAssetValue{Price: sdk.NewInt64Coin(denom, amount), Volume: 1, UpdatedBlockHeight: height},
+			Source:        "source",
+			Time:          blockTime(int64(height)),
+		}
+	}
+
+	setNav(1, "usd", 10)
+	setNav(2, "usd", 20)
+	setNav(2, "eur", 15)
+	setNav(3, "usd", 30)
+
+	usdHistory, err := s.app.MetadataKeeper.GetNetAssetValueHistory(ctx, scopeID, "usd")
+	s.Require().NoError(err, "GetNetAssetValueHistory usd")
+	s.Assert().Equal([]types.NetAssetValueHistoryEntry{entry(2, "usd", 20), entry(3, "usd", 30)}, usdHistory, "usd history")
+
+	eurHistory, err := s.app.MetadataKeeper.GetNetAssetValueHistory(ctx, scopeID, "eur")
+	s.Require().NoError(err, "GetNetAssetValueHistory eur")
+	s.Assert().Equal([]types.NetAssetValueHistoryEntry{entry(2, "eur", 15)}, eurHistory, "eur history")
+
+	// Setting a net asset value again in the same block replaces that block's entry.
+	setNav(3, "usd", 35)
+	usdHistory, err = s.app.MetadataKeeper.GetNetAssetValueHistory(ctx, scopeID, "usd")
+	s.Require().NoError(err, "GetNetAssetValueHistory usd after update")
+	s.Assert().Equal([]types.NetAssetValueHistoryEntry{entry(2, "usd", 20), entry(3, "usd", 35)}, usdHistory, "usd history after update")
+
+	s.app.MetadataKeeper.RemoveNetAssetValues(ctx, scopeID)
+	var remaining []types.NetAssetValueHistoryEntry
+	err = s.app.MetadataKeeper.IterateNetAssetValueHistory(ctx, scopeID, func(entry types.NetAssetValueHistoryEntry) bool {
+		remaining = append(remaining, entry)
+		return false
+	})
+	s.Require().NoError(err, "IterateNetAssetValueHistory")
+	s.Assert().Empty(remaining, "history after RemoveNetAssetValues")
+}
+
 func (s *ScopeKeeperTestSuite) TestAddSetNetAssetValues() {
 	markerDenom := "jackthecat"
 	mAccount := authtypes.NewBaseAccount(markertypes.MustGetMarkerAddress(markerDenom), nil, 0, 0)
@@ -27,13 +27,17 @@ func (k Keeper) GetSession(ctx sdk.Context, id types.MetadataAddress) (session t
 }
 
 // SetSession stores a session in the module kv store.
-func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
+// If the session already exists, the version being replaced is retained in its history as replaced by the provided signers.
+func (k Keeper) SetSession(ctx sdk.Context, session types.Session, signers ...string) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshal(&session)
 
 	var event proto.Message = types.NewEventSessionCreated(session.SessionId)
-	if store.Has(session.SessionId) {
+	if existing := store.Get(session.SessionId); existing != nil {
 		event = types.NewEventSessionUpdated(session.SessionId)
+		var prev types.Session
+		k.cdc.MustUnmarshal(existing, &prev)
+		k.addSessionHistory(ctx, prev, signers)
 	}
 
 	store.Set(session.SessionId, b)
@@ -139,6 +143,9 @@ func (k Keeper) ValidateWriteSession(ctx sdk.Context, existing *types.Session, m
 	if !found {
 		return fmt.Errorf("cannot find contract specification %s", proposed.SpecificationId)
 	}
+	if existing == nil && contractSpec.Deprecated {
+		return fmt.Errorf("contract specification %s is deprecated", proposed.SpecificationId)
+	}
 
 	scopeSpec, found := k.GetScopeSpecification(ctx, scope.SpecificationId)
 	if !found {
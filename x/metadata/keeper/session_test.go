@@ -133,6 +133,28 @@ func (s *SessionKeeperTestSuite) TestSessionGetSetRemove() {
 	s.NotEmpty(sess)
 }
 
+func (s *SessionKeeperTestSuite) TestSetSessionHistory() {
+	ctx := s.FreshCtx()
+	session := types.NewSession("name", s.sessionID, s.contractSpecID, []types.Party{
+		{Address: s.user1, Role: types.PartyType_PARTY_TYPE_AFFILIATE}}, nil)
+	s.app.MetadataKeeper.SetSession(ctx, *session, s.user1)
+	history, err := s.app.MetadataKeeper.GetSessionHistory(ctx, s.sessionID)
+	s.Require().NoError(err, "GetSessionHistory after create")
+	s.Assert().Empty(history, "history after create")
+	orig, found := s.app.MetadataKeeper.GetSession(ctx, s.sessionID)
+	s.Require().True(found, "GetSession after create")
+
+	updated := *session
+	updated.Name = "new name"
+	s.app.MetadataKeeper.SetSession(ctx, updated, s.user1)
+	history, err = s.app.MetadataKeeper.GetSessionHistory(ctx, s.sessionID)
+	s.Require().NoError(err, "GetSessionHistory after update")
+	if s.Assert().Len(history, 1, "history after update") {
+		s.Assert().Equal(orig, history[0].Session, "history session")
+		s.Assert().Equal([]string{s.user1}, history[0].ReplacedBy, "history replaced by")
+	}
+}
+
 func (s *SessionKeeperTestSuite) TestSessionIterator() {
 	ctx := s.FreshCtx()
 	for i := 1; i <= 10; i++ {
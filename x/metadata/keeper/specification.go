@@ -136,7 +136,7 @@ func (k Keeper) RemoveRecordSpecification(ctx sdk.Context, recordSpecID types.Me
 
 // ValidateWriteRecordSpecification compare the proposed contract spec with the existing to make sure the proposed
 // is valid. This assumes that proposed.ValidateBasic() has been run and did not return an error.
-func (k Keeper) ValidateWriteRecordSpecification(_ sdk.Context, existing *types.RecordSpecification, proposed types.RecordSpecification) error {
+func (k Keeper) ValidateWriteRecordSpecification(ctx sdk.Context, existing *types.RecordSpecification, proposed types.RecordSpecification) error {
 	if existing != nil {
 		// IDs must match
 		if !proposed.SpecificationId.Equals(existing.SpecificationId) {
@@ -150,7 +150,20 @@ func (k Keeper) ValidateWriteRecordSpecification(_ sdk.Context, existing *types.
 		}
 	}
 
-	return nil
+	// If the contract spec has a type schema, the record spec can only use types that it defines.
+	contractSpecID, err := proposed.SpecificationId.AsContractSpecAddress()
+	if err != nil {
+		return nil
+	}
+	contractSpec, found := k.GetContractSpecification(ctx, contractSpecID)
+	if !found || contractSpec.TypeSchema == nil {
+		return nil
+	}
+	schema, err := k.compileTypeSchema(*contractSpec.TypeSchema)
+	if err != nil {
+		return fmt.Errorf("invalid contract specification type schema: %w", err)
+	}
+	return validateRecordSpecTypes(schema, proposed)
 }
 
 func (k Keeper) isRecordSpecUsed(_ sdk.Context, _ types.MetadataAddress) bool {
@@ -352,13 +365,39 @@ func (k Keeper) isContractSpecUsed(ctx sdk.Context, contractSpecID types.Metadat
 
 // ValidateWriteContractSpecification compare the proposed contract spec with the existing to make sure the proposed
 // is valid. This assumes that proposed.ValidateBasic() has been run and did not return an error.
-func (k Keeper) ValidateWriteContractSpecification(_ sdk.Context, existing *types.ContractSpecification, proposed types.ContractSpecification) error {
+func (k Keeper) ValidateWriteContractSpecification(ctx sdk.Context, existing *types.ContractSpecification, proposed types.ContractSpecification) error {
 	// IDS must match if there's an existing entry
 	if existing != nil && !proposed.SpecificationId.Equals(existing.SpecificationId) {
 		return fmt.Errorf("cannot update contract spec identifier. expected %s, got %s",
 			existing.SpecificationId, proposed.SpecificationId)
 	}
 
+	if !proposed.PredecessorId.Empty() {
+		if _, found := k.GetContractSpecification(ctx, proposed.PredecessorId); !found {
+			return fmt.Errorf("predecessor contract spec %s not found", proposed.PredecessorId)
+		}
+		if lineageContains(proposed.PredecessorId, proposed.SpecificationId, k.contractSpecPredecessor(ctx)) {
+			return fmt.Errorf("contract spec %s cannot be a predecessor of itself", proposed.SpecificationId)
+		}
+	}
+
+	// If there's a type schema, the existing record specs must only use types that it defines.
+	if proposed.TypeSchema != nil {
+		schema, err := k.compileTypeSchema(*proposed.TypeSchema)
+		if err != nil {
+			return fmt.Errorf("invalid type schema: %w", err)
+		}
+		recSpecs, err := k.GetRecordSpecificationsForContractSpecificationID(ctx, proposed.SpecificationId)
+		if err != nil {
+			return err
+		}
+		for _, recSpec := range recSpecs {
+			if err = validateRecordSpecTypes(schema, *recSpec); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -604,9 +643,62 @@ func (k Keeper) ValidateWriteScopeSpecification(ctx sdk.Context, existing *types
 		}
 	}
 
+	if !proposed.PredecessorId.Empty() {
+		if _, found := k.GetScopeSpecification(ctx, proposed.PredecessorId); !found {
+			return fmt.Errorf("predecessor scope spec %s not found", proposed.PredecessorId)
+		}
+		if lineageContains(proposed.PredecessorId, proposed.SpecificationId, k.scopeSpecPredecessor(ctx)) {
+			return fmt.Errorf("scope spec %s cannot be a predecessor of itself", proposed.SpecificationId)
+		}
+	}
+
 	return nil
 }
 
+// IsScopeSpecSuccessor returns true if the provided scope spec is a newer version of the scope spec with the
+// provided predecessor id, either directly or through other versions.
+func (k Keeper) IsScopeSpecSuccessor(ctx sdk.Context, spec types.ScopeSpecification, predecessorID types.MetadataAddress) bool {
+	return lineageContains(spec.PredecessorId, predecessorID, k.scopeSpecPredecessor(ctx))
+}
+
+// scopeSpecPredecessor returns a function that looks up the predecessor id of a scope spec.
+func (k Keeper) scopeSpecPredecessor(ctx sdk.Context) func(id types.MetadataAddress) (types.MetadataAddress, bool) {
+	return func(id types.MetadataAddress) (types.MetadataAddress, bool) {
+		spec, found := k.GetScopeSpecification(ctx, id)
+		return spec.PredecessorId, found
+	}
+}
+
+// contractSpecPredecessor returns a function that looks up the predecessor id of a contract spec.
+func (k Keeper) contractSpecPredecessor(ctx sdk.Context) func(id types.MetadataAddress) (types.MetadataAddress, bool) {
+	return func(id types.MetadataAddress) (types.MetadataAddress, bool) {
+		spec, found := k.GetContractSpecification(ctx, id)
+		return spec.PredecessorId, found
+	}
+}
+
+// lineageContains returns true if targetID is the startID or one of its predecessors.
+// Predecessors are looked up using the provided getPredecessor function. An empty id (which is what a
+// stored spec without a predecessor has) ends the lineage.
+func lineageContains(
+	startID, targetID types.MetadataAddress,
+	getPredecessor func(id types.MetadataAddress) (types.MetadataAddress, bool),
+) bool {
+	seen := make(map[string]bool)
+	for id := startID; !id.Empty() && !seen[string(id)]; {
+		if id.Equals(targetID) {
+			return true
+		}
+		seen[string(id)] = true
+		predecessorID, found := getPredecessor(id)
+		if !found {
+			return false
+		}
+		id = predecessorID
+	}
+	return false
+}
+
 // getNewContractSpecIDs gets all contract spec ids in proposed that are not in existing.
 func getNewContractSpecIDs(proposed, existing *types.ScopeSpecification) []types.MetadataAddress {
 	if existing == nil || len(existing.ContractSpecIds) == 0 {
@@ -1196,6 +1196,51 @@ func (s *SpecKeeperTestSuite) TestValidateWriteContractSpecification() {
 	}
 }
 
+func (s *SpecKeeperTestSuite) TestValidateWriteSpecTypeSchema() {
+	ctx := s.FreshCtx()
+	contractSpecUUID := uuid.New()
+	contractSpecID := types.ContractSpecMetadataAddress(contractSpecUUID)
+	jsonSchema := func(def string) *types.RecordTypeSchema {
+		return types.NewRecordTypeSchema(types.RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, []byte(def))
+	}
+	contractSpec := types.ContractSpecification{
+		SpecificationId: contractSpecID,
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+		Source:          types.NewContractSpecificationSourceHash("somehash"),
+		ClassName:       "someclass",
+		TypeSchema:      jsonSchema(`{"title":"Loan","$defs":{"Borrower":{}}}`),
+	}
+	s.app.MetadataKeeper.SetContractSpecification(ctx, contractSpec)
+
+	recSpec := func(typeName, inputTypeName string) types.RecordSpecification {
+		return *types.NewRecordSpecification(types.RecordSpecMetadataAddress(contractSpecUUID, "loan"), "loan",
+			[]*types.InputSpecification{types.NewInputSpecification("borrower", inputTypeName, types.NewInputSpecificationSourceHash("hash"))},
+			typeName, types.DefinitionType_DEFINITION_TYPE_RECORD, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER})
+	}
+
+	// Record specs can only use types defined by the contract spec's type schema.
+	err := s.app.MetadataKeeper.ValidateWriteRecordSpecification(ctx, nil, recSpec("Mortgage", "Borrower"))
+	s.Assert().EqualError(err, `record specification loan type "Mortgage" is not defined in the contract specification type schema`,
+		"ValidateWriteRecordSpecification unknown output type")
+	err = s.app.MetadataKeeper.ValidateWriteRecordSpecification(ctx, nil, recSpec("Loan", "Lender"))
+	s.Assert().EqualError(err, `record specification loan input borrower type "Lender" is not defined in the contract specification type schema`,
+		"ValidateWriteRecordSpecification unknown input type")
+	err = s.app.MetadataKeeper.ValidateWriteRecordSpecification(ctx, nil, recSpec("Loan", "Borrower"))
+	s.Require().NoError(err, "ValidateWriteRecordSpecification known types")
+	s.app.MetadataKeeper.SetRecordSpecification(ctx, recSpec("Loan", "Borrower"))
+
+	// A new type schema must still define the types used by the existing record specs.
+	existing := contractSpec
+	contractSpec.TypeSchema = jsonSchema(`{"title":"Loan"}`)
+	err = s.app.MetadataKeeper.ValidateWriteContractSpecification(ctx, &existing, contractSpec)
+	s.Assert().EqualError(err, `record specification loan input borrower type "Borrower" is not defined in the contract specification type schema`,
+		"ValidateWriteContractSpecification schema missing a used type")
+	contractSpec.TypeSchema = jsonSchema(`{"title":"Loan","definitions":{"Borrower":{"type":"object"},"Lender":{}}}`)
+	err = s.app.MetadataKeeper.ValidateWriteContractSpecification(ctx, &existing, contractSpec)
+	s.Assert().NoError(err, "ValidateWriteContractSpecification schema with all used types")
+}
+
 func (s *SpecKeeperTestSuite) TestContractSpecIndexing() {
 	specID := types.ContractSpecMetadataAddress(uuid.New())
 
@@ -1868,6 +1913,34 @@ func (s *SpecKeeperTestSuite) TestScopeSpecIndexing() {
 	})
 }
 
+func (s *SpecKeeperTestSuite) TestIsScopeSpecSuccessor() {
+	ctx := s.FreshCtx()
+	newSpec := func(predecessor types.MetadataAddress) types.ScopeSpecification {
+		spec := types.ScopeSpecification{
+			SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+			OwnerAddresses:  []string{s.user1},
+			PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			PredecessorId:   predecessor,
+		}
+		s.app.MetadataKeeper.SetScopeSpecification(ctx, spec)
+		// Read it back so the predecessor id is what's in state (empty instead of nil when not provided).
+		spec, found := s.app.MetadataKeeper.GetScopeSpecification(ctx, spec.SpecificationId)
+		s.Require().True(found, "GetScopeSpecification")
+		return spec
+	}
+	v1 := newSpec(nil)
+	v2 := newSpec(v1.SpecificationId)
+	v3 := newSpec(v2.SpecificationId)
+	other := newSpec(nil)
+
+	s.Assert().True(s.app.MetadataKeeper.IsScopeSpecSuccessor(ctx, v2, v1.SpecificationId), "v2 successor of v1")
+	s.Assert().True(s.app.MetadataKeeper.IsScopeSpecSuccessor(ctx, v3, v1.SpecificationId), "v3 successor of v1")
+	s.Assert().False(s.app.MetadataKeeper.IsScopeSpecSuccessor(ctx, v1, v2.SpecificationId), "v1 successor of v2")
+	s.Assert().False(s.app.MetadataKeeper.IsScopeSpecSuccessor(ctx, other, v1.SpecificationId), "other successor of v1")
+	s.Assert().False(s.app.MetadataKeeper.IsScopeSpecSuccessor(ctx, v1, nil), "v1 successor of nil")
+	s.Assert().False(s.app.MetadataKeeper.IsScopeSpecSuccessor(ctx, v1, types.MetadataAddress{}), "v1 successor of empty")
+}
+
 func (s *SpecKeeperTestSuite) TestFindMissingMdAddr() {
 	tests := map[string]struct {
 		required []string
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// maxCachedTypeSchemas is the number of compiled record type schemas that are kept in memory.
+const maxCachedTypeSchemas = 100
+
+// typeSchemaCache holds compiled record type schemas so that they aren't parsed again for every record write.
+// Entries are keyed by the hash of the schema's format and definition, so they never need to be invalidated.
+type typeSchemaCache struct {
+	mu      sync.Mutex
+	schemas map[[sha256.Size]byte]*types.CompiledRecordTypeSchema
+}
+
+// newTypeSchemaCache creates a new, empty typeSchemaCache.
+func newTypeSchemaCache() *typeSchemaCache {
+	return &typeSchemaCache{schemas: make(map[[sha256.Size]byte]*types.CompiledRecordTypeSchema)}
+}
+
+// typeSchemaCacheKey returns the key to use in the cache for the provided schema.
+func typeSchemaCacheKey(schema types.RecordTypeSchema) [sha256.Size]byte {
+	return sha256.Sum256(append([]byte{byte(schema.Format)}, schema.Definition...))
+}
+
+// get returns the compiled version of the provided schema, compiling it if it isn't already in the cache.
+func (c *typeSchemaCache) get(schema types.RecordTypeSchema) (*types.CompiledRecordTypeSchema, error) {
+	if c == nil {
+		return schema.Compile()
+	}
+	key := typeSchemaCacheKey(schema)
+
+	c.mu.Lock()
+	compiled, found := c.schemas[key]
+	c.mu.Unlock()
+	if found {
+		return compiled, nil
+	}
+
+	compiled, err := schema.Compile()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.schemas) >= maxCachedTypeSchemas {
+		c.schemas = make(map[[sha256.Size]byte]*types.CompiledRecordTypeSchema)
+	}
+	c.schemas[key] = compiled
+	return compiled, nil
+}
+
+// compileTypeSchema returns the compiled version of the provided record type schema.
+func (k Keeper) compileTypeSchema(schema types.RecordTypeSchema) (*types.CompiledRecordTypeSchema, error) {
+	return k.typeSchemas.get(schema)
+}
+
+// validateRecordSpecTypes returns an error if the provided record specification uses a type that is not defined
+// in the provided schema.
+func validateRecordSpecTypes(schema *types.CompiledRecordTypeSchema, spec types.RecordSpecification) error {
+	if !schema.HasType(spec.TypeName) {
+		return fmt.Errorf("record specification %s type %q is not defined in the contract specification type schema",
+			spec.Name, spec.TypeName)
+	}
+	for _, input := range spec.Inputs {
+		if input != nil && !schema.HasType(input.TypeName) {
+			return fmt.Errorf("record specification %s input %s type %q is not defined in the contract specification type schema",
+				spec.Name, input.Name, input.TypeName)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,6 +19,8 @@ const (
 	TxEndpoint_DeleteScopeOwner      TxEndpoint = "DeleteScopeOwner"
 	TxEndpoint_UpdateValueOwners     TxEndpoint = "UpdateValueOwners"
 	TxEndpoint_MigrateValueOwner     TxEndpoint = "MigrateValueOwner"
+	TxEndpoint_BatchUpdateScopes     TxEndpoint = "BatchUpdateScopes"
+	TxEndpoint_MigrateScopeSpec      TxEndpoint = "MigrateScopeSpec"
 
 	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
 
@@ -36,9 +39,10 @@ const (
 	TxEndpoint_WriteRecordSpecification  TxEndpoint = "WriteRecordSpecification"
 	TxEndpoint_DeleteRecordSpecification TxEndpoint = "DeleteRecordSpecification"
 
-	TxEndpoint_BindOSLocator   TxEndpoint = "BindOSLocator"
-	TxEndpoint_DeleteOSLocator TxEndpoint = "DeleteOSLocator"
-	TxEndpoint_ModifyOSLocator TxEndpoint = "ModifyOSLocator"
+	TxEndpoint_BindOSLocator      TxEndpoint = "BindOSLocator"
+	TxEndpoint_DeleteOSLocator    TxEndpoint = "DeleteOSLocator"
+	TxEndpoint_ModifyOSLocator    TxEndpoint = "ModifyOSLocator"
+	TxEndpoint_RotateOSLocatorKey TxEndpoint = "RotateOSLocatorKey"
 )
 
 func NewEventTxCompleted(endpoint TxEndpoint, signers []string) *EventTxCompleted {
@@ -180,6 +184,19 @@ func NewEventOSLocatorUpdated(owner string) *EventOSLocatorUpdated {
 	}
 }
 
+// NewEventOSLocatorKeyRotated returns a new instance of EventOSLocatorKeyRotated
+func NewEventOSLocatorKeyRotated(owner, keyID, previousKeyID string, previousKeyExpiration *time.Time) *EventOSLocatorKeyRotated {
+	rv := &EventOSLocatorKeyRotated{
+		Owner:         owner,
+		KeyId:         keyID,
+		PreviousKeyId: previousKeyID,
+	}
+	if previousKeyExpiration != nil {
+		rv.PreviousKeyExpiration = previousKeyExpiration.UTC().Format(time.RFC3339)
+	}
+	return rv
+}
+
 func NewEventOSLocatorDeleted(owner string) *EventOSLocatorDeleted {
 	return &EventOSLocatorDeleted{
 		Owner: owner,
@@ -195,3 +212,58 @@ func NewEventSetNetAssetValue(scopeID MetadataAddress, price sdk.Coin, volume ui
 		Volume:  strconv.FormatUint(volume, 10),
 	}
 }
+
+// NewEventMetadataParamsUpdated returns a new instance of EventMetadataParamsUpdated
+func NewEventMetadataParamsUpdated(params Params) *EventMetadataParamsUpdated {
+	return &EventMetadataParamsUpdated{
+		MaxHistoryVersions:   strconv.FormatUint(uint64(params.MaxHistoryVersions), 10),
+		MaxNavHistoryEntries: strconv.FormatUint(uint64(params.MaxNavHistoryEntries), 10),
+	}
+}
+
+// NewEventScopeOfferCreated returns a new instance of EventScopeOfferCreated
+func NewEventScopeOfferCreated(offer ScopeOffer) *EventScopeOfferCreated {
+	return &EventScopeOfferCreated{
+		OfferId:    strconv.FormatUint(offer.OfferId, 10),
+		Seller:     offer.Seller,
+		ScopeAddrs: metadataAddressStrings(offer.ScopeIds),
+		Price:      offer.Price.String(),
+		Buyer:      offer.Buyer,
+	}
+}
+
+// NewEventScopeOfferAccepted returns a new instance of EventScopeOfferAccepted
+func NewEventScopeOfferAccepted(offer ScopeOffer, buyer string) *EventScopeOfferAccepted {
+	return &EventScopeOfferAccepted{
+		OfferId:    strconv.FormatUint(offer.OfferId, 10),
+		Seller:     offer.Seller,
+		Buyer:      buyer,
+		ScopeAddrs: metadataAddressStrings(offer.ScopeIds),
+		Price:      offer.Price.String(),
+	}
+}
+
+// NewEventScopeOfferCancelled returns a new instance of EventScopeOfferCancelled
+func NewEventScopeOfferCancelled(offer ScopeOffer) *EventScopeOfferCancelled {
+	return &EventScopeOfferCancelled{
+		OfferId: strconv.FormatUint(offer.OfferId, 10),
+		Seller:  offer.Seller,
+	}
+}
+
+// NewEventScopeOfferExpired returns a new instance of EventScopeOfferExpired
+func NewEventScopeOfferExpired(offer ScopeOffer) *EventScopeOfferExpired {
+	return &EventScopeOfferExpired{
+		OfferId: strconv.FormatUint(offer.OfferId, 10),
+		Seller:  offer.Seller,
+	}
+}
+
+// metadataAddressStrings returns the bech32 strings of the provided metadata addresses.
+func metadataAddressStrings(addrs []MetadataAddress) []string {
+	rv := make([]string, len(addrs))
+	for i, addr := range addrs {
+		rv[i] = addr.String()
+	}
+	return rv
+}
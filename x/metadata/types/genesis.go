@@ -1,7 +1,56 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	if err := state.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	for i, rv := range state.RecordHistory {
+		if rv.Version == 0 {
+			return fmt.Errorf("invalid record history[%d]: version cannot be zero", i)
+		}
+		if err := rv.Record.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record history[%d]: %w", i, err)
+		}
+	}
+	for i, sv := range state.SessionHistory {
+		if sv.Version == 0 {
+			return fmt.Errorf("invalid session history[%d]: version cannot be zero", i)
+		}
+		if err := sv.Session.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid session history[%d]: %w", i, err)
+		}
+	}
+	for i, history := range state.NetAssetValueHistory {
+		scopeID, err := MetadataAddressFromBech32(history.Address)
+		if err != nil {
+			return fmt.Errorf("invalid net asset value history[%d]: %w", i, err)
+		}
+		if !scopeID.IsScopeAddress() {
+			return fmt.Errorf("invalid net asset value history[%d]: address %s is not a scope id", i, history.Address)
+		}
+		for j, entry := range history.Entries {
+			if err := entry.NetAssetValue.Validate(); err != nil {
+				return fmt.Errorf("invalid net asset value history[%d] entry[%d]: %w", i, j, err)
+			}
+		}
+	}
+	seenOfferIDs := make(map[uint64]bool, len(state.ScopeOffers))
+	for i, offer := range state.ScopeOffers {
+		if err := offer.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scope offer[%d]: %w", i, err)
+		}
+		if offer.OfferId > state.LastScopeOfferId {
+			return fmt.Errorf("invalid scope offer[%d]: offer id %d is greater than the last scope offer id %d",
+				i, offer.OfferId, state.LastScopeOfferId)
+		}
+		if seenOfferIDs[offer.OfferId] {
+			return fmt.Errorf("invalid scope offer[%d]: duplicate offer id %d", i, offer.OfferId)
+		}
+		seenOfferIDs[offer.OfferId] = true
+	}
 	return nil
 }
 
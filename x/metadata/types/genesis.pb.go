@@ -38,6 +38,14 @@ type GenesisState struct {
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
 	// Net asset values assigned to scopes
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,10,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// Retained prior versions of records and sessions
+	RecordHistory  []RecordVersion  `protobuf:"bytes,11,rep,name=record_history,json=recordHistory,proto3" json:"record_history"`
+	SessionHistory []SessionVersion `protobuf:"bytes,12,rep,name=session_history,json=sessionHistory,proto3" json:"session_history"`
+	// Open scope offers and the last offer id assigned
+	ScopeOffers      []ScopeOffer `protobuf:"bytes,13,rep,name=scope_offers,json=scopeOffers,proto3" json:"scope_offers"`
+	LastScopeOfferId uint64       `protobuf:"varint,14,opt,name=last_scope_offer_id,json=lastScopeOfferId,proto3" json:"last_scope_offer_id,omitempty"`
+	// Retained net asset value history of scopes
+	NetAssetValueHistory []ScopeNetAssetValueHistory `protobuf:"bytes,15,rep,name=net_asset_value_history,json=netAssetValueHistory,proto3" json:"net_asset_value_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -114,9 +122,51 @@ func (m *MarkerNetAssetValues) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarkerNetAssetValues proto.InternalMessageInfo
 
+// ScopeNetAssetValueHistory defines the retained net asset value history of a scope
+type ScopeNetAssetValueHistory struct {
+	// address defines the scope address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// entries are the net asset values previously set for the scope, in the order they were set
+	Entries []NetAssetValueHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *ScopeNetAssetValueHistory) Reset()         { *m = ScopeNetAssetValueHistory{} }
+func (m *ScopeNetAssetValueHistory) String() string { return proto.CompactTextString(m) }
+func (*ScopeNetAssetValueHistory) ProtoMessage()    {}
+func (*ScopeNetAssetValueHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a835c20198efc302, []int{2}
+}
+func (m *ScopeNetAssetValueHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeNetAssetValueHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeNetAssetValueHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeNetAssetValueHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeNetAssetValueHistory.Merge(m, src)
+}
+func (m *ScopeNetAssetValueHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeNetAssetValueHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeNetAssetValueHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeNetAssetValueHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.metadata.v1.GenesisState")
 	proto.RegisterType((*MarkerNetAssetValues)(nil), "provenance.metadata.v1.MarkerNetAssetValues")
+	proto.RegisterType((*ScopeNetAssetValueHistory)(nil), "provenance.metadata.v1.ScopeNetAssetValueHistory")
 }
 
 func init() {
@@ -124,42 +174,51 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x6d, 0x5a, 0x92, 0x74, 0x8b, 0x00, 0x2d, 0x69, 0x31, 0x95, 0x70, 0xaa, 0x88, 0x8a,
-	0xa8, 0x50, 0x5b, 0x2d, 0x9c, 0x00, 0x21, 0xb5, 0x1c, 0xb8, 0x00, 0xad, 0x12, 0xc1, 0xa1, 0x42,
-	0xb2, 0x36, 0x9b, 0x6d, 0x30, 0x4d, 0x3c, 0xd6, 0xce, 0x36, 0x82, 0x37, 0xe0, 0x08, 0x6f, 0xd0,
-	0xc7, 0xe9, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0xae, 0xbc, 0x01, 0xca, 0x7a, 0xdd, 0x34, 0x8d,
-	0x37, 0xdc, 0x6c, 0xcf, 0xff, 0xfd, 0xff, 0x8c, 0x3d, 0x5e, 0xf2, 0x20, 0x95, 0x30, 0x10, 0x09,
-	0x4b, 0xb8, 0x08, 0xfb, 0x42, 0xb1, 0x0e, 0x53, 0x2c, 0x1c, 0x6c, 0x87, 0x5d, 0x91, 0x08, 0x8c,
-	0x31, 0x48, 0x25, 0x28, 0xa0, 0xab, 0x13, 0x55, 0x90, 0xab, 0x82, 0xc1, 0xf6, 0x5a, 0xb5, 0x0b,
-	0x5d, 0xd0, 0x92, 0x70, 0x7c, 0x95, 0xa9, 0xd7, 0x36, 0x2c, 0x9e, 0x17, 0x64, 0x26, 0xab, 0x5b,
-	0x64, 0xc8, 0x21, 0x15, 0x46, 0xb3, 0x69, 0xd3, 0xa4, 0x82, 0xc7, 0x47, 0x31, 0x67, 0x2a, 0x86,
-	0xc4, 0x68, 0x1b, 0x16, 0x2d, 0xb4, 0x3f, 0x0b, 0xae, 0x50, 0x81, 0x34, 0xae, 0xf5, 0xbf, 0x25,
-	0x72, 0xe3, 0x75, 0x36, 0x60, 0x4b, 0x31, 0x25, 0xe8, 0x0b, 0x52, 0x4a, 0x99, 0x64, 0x7d, 0xf4,
-	0xdc, 0x75, 0xb7, 0xb1, 0xbc, 0xe3, 0x07, 0xc5, 0x03, 0x07, 0x07, 0x5a, 0xb5, 0xb7, 0x78, 0xf6,
-	0xab, 0xe6, 0x34, 0x0d, 0x43, 0x9f, 0x93, 0x92, 0xee, 0x19, 0xbd, 0x6b, 0xeb, 0x0b, 0x8d, 0xe5,
-	0x9d, 0xfb, 0x36, 0xba, 0x35, 0x56, 0xe5, 0x70, 0x86, 0xd0, 0x5d, 0x52, 0x41, 0x81, 0x18, 0x43,
-	0x82, 0xde, 0x82, 0xc6, 0x6b, 0x56, 0x3c, 0xd3, 0x19, 0x83, 0x0b, 0x8c, 0xbe, 0x24, 0x65, 0x29,
-	0x38, 0xc8, 0x0e, 0x7a, 0x8b, 0xda, 0xc1, 0xda, 0x7e, 0x53, 0xcb, 0x8c, 0x41, 0x0e, 0x51, 0x4e,
-	0xaa, 0xba, 0x99, 0x68, 0xea, 0xad, 0xa2, 0x77, 0x5d, 0x9b, 0x6d, 0xce, 0x9d, 0xa6, 0x75, 0x19,
-	0x31, 0xc6, 0x77, 0x70, 0xa6, 0x82, 0xb4, 0x47, 0xee, 0x72, 0x48, 0x94, 0x64, 0x5c, 0x5d, 0xcd,
-	0x29, 0xe9, 0x9c, 0x2d, 0x5b, 0xce, 0x2b, 0x83, 0x15, 0x45, 0xad, 0xf2, 0xa2, 0x22, 0xd2, 0x23,
-	0xb2, 0x92, 0x4d, 0x77, 0x35, 0xab, 0xac, 0xb3, 0x1e, 0xcd, 0x7f, 0x41, 0x45, 0x49, 0x55, 0x39,
-	0x5b, 0x42, 0x7a, 0x48, 0x28, 0x44, 0x18, 0xf5, 0x80, 0x33, 0x05, 0x32, 0x32, 0x4b, 0x54, 0xd1,
-	0x4b, 0xf4, 0xd0, 0x16, 0xb2, 0xdf, 0x7a, 0x93, 0xe9, 0xa7, 0xb6, 0xe9, 0x16, 0x4c, 0x3f, 0xa6,
-	0x1d, 0xb2, 0x92, 0xad, 0x6e, 0xa4, 0x77, 0x37, 0x0f, 0x41, 0x6f, 0x69, 0xfe, 0x77, 0xd9, 0xd7,
-	0x50, 0x6b, 0xcc, 0x18, 0xc3, 0xfc, 0xbb, 0xc0, 0x4c, 0x05, 0xe9, 0x47, 0x72, 0x3b, 0x11, 0x2a,
-	0x62, 0x88, 0x42, 0x45, 0x03, 0xd6, 0x3b, 0x11, 0xe8, 0x11, 0x1d, 0xf0, 0xd8, 0x16, 0xf0, 0x96,
-	0xc9, 0x63, 0x21, 0xdf, 0x09, 0xb5, 0x3b, 0x86, 0x3e, 0x68, 0xc6, 0x44, 0xdc, 0x4c, 0xa6, 0x9e,
-	0x3e, 0xab, 0x7c, 0x3b, 0xad, 0x39, 0x7f, 0x4e, 0x6b, 0x4e, 0xfd, 0x87, 0x4b, 0xaa, 0x45, 0x20,
-	0xf5, 0x48, 0x99, 0x75, 0x3a, 0x52, 0x60, 0xf6, 0xf3, 0x2d, 0x35, 0xf3, 0x5b, 0xfa, 0xbe, 0xa0,
-	0xb5, 0xec, 0x0f, 0xdb, 0xb0, 0xb5, 0x36, 0xe5, 0xfd, 0xbf, 0x9e, 0xf6, 0x8e, 0xcf, 0x86, 0xbe,
-	0x7b, 0x3e, 0xf4, 0xdd, 0xdf, 0x43, 0xdf, 0xfd, 0x3e, 0xf2, 0x9d, 0xf3, 0x91, 0xef, 0xfc, 0x1c,
-	0xf9, 0x0e, 0xb9, 0x17, 0x83, 0x25, 0xe2, 0xc0, 0x3d, 0x7c, 0xda, 0x8d, 0xd5, 0xa7, 0x93, 0x76,
-	0xc0, 0xa1, 0x1f, 0x4e, 0x44, 0x5b, 0x31, 0x5c, 0xba, 0x0b, 0xbf, 0x4c, 0xce, 0x20, 0xf5, 0x35,
-	0x15, 0xd8, 0x2e, 0xe9, 0xb3, 0xe7, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x60, 0x12,
-	0x92, 0x72, 0x05, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5f, 0x4f, 0xd3, 0x50,
+	0x14, 0x5f, 0x01, 0x37, 0xb8, 0x83, 0x41, 0x2e, 0x03, 0x0a, 0x89, 0xdd, 0xb2, 0x88, 0x2e, 0x28,
+	0x5b, 0x86, 0x3e, 0xa9, 0x31, 0x01, 0x63, 0xd4, 0xf8, 0x07, 0xdc, 0x02, 0x0f, 0xc4, 0xa4, 0xb9,
+	0xb4, 0x77, 0xa3, 0x32, 0x7a, 0x9b, 0x7b, 0x2e, 0x8b, 0x7c, 0x03, 0x1e, 0xd5, 0x4f, 0xc0, 0xc7,
+	0xe1, 0x91, 0x47, 0x9f, 0x8c, 0x81, 0x17, 0x3f, 0x86, 0xe9, 0xed, 0x6d, 0xb7, 0x42, 0x5b, 0x7d,
+	0x5b, 0xef, 0xf9, 0xfd, 0x39, 0xe7, 0x9e, 0x5f, 0x57, 0x74, 0xcf, 0xe3, 0x6c, 0x40, 0x5d, 0xe2,
+	0x5a, 0xb4, 0x79, 0x4c, 0x05, 0xb1, 0x89, 0x20, 0xcd, 0x41, 0xab, 0xd9, 0xa3, 0x2e, 0x05, 0x07,
+	0x1a, 0x1e, 0x67, 0x82, 0xe1, 0xc5, 0x21, 0xaa, 0x11, 0xa2, 0x1a, 0x83, 0xd6, 0x4a, 0xb9, 0xc7,
+	0x7a, 0x4c, 0x42, 0x9a, 0xfe, 0xaf, 0x00, 0xbd, 0xb2, 0x9a, 0xa2, 0x19, 0x31, 0x03, 0x58, 0x2d,
+	0x05, 0x06, 0x16, 0xf3, 0xa8, 0xc2, 0xac, 0xa5, 0x61, 0x3c, 0x6a, 0x39, 0x5d, 0xc7, 0x22, 0xc2,
+	0x61, 0xae, 0xc2, 0xd6, 0x53, 0xb0, 0xec, 0xe0, 0x0b, 0xb5, 0x04, 0x08, 0xc6, 0x95, 0x6a, 0xed,
+	0x0c, 0xa1, 0xe9, 0xd7, 0xc1, 0x80, 0x1d, 0x41, 0x04, 0xc5, 0xcf, 0x51, 0xde, 0x23, 0x9c, 0x1c,
+	0x83, 0xae, 0x55, 0xb5, 0x7a, 0x71, 0xc3, 0x68, 0x24, 0x0f, 0xdc, 0xd8, 0x91, 0xa8, 0xad, 0x89,
+	0x8b, 0x5f, 0x95, 0x5c, 0x5b, 0x71, 0xf0, 0x33, 0x94, 0x97, 0x3d, 0x83, 0x3e, 0x56, 0x1d, 0xaf,
+	0x17, 0x37, 0xee, 0xa6, 0xb1, 0x3b, 0x3e, 0x2a, 0x24, 0x07, 0x14, 0xbc, 0x89, 0x26, 0x81, 0x02,
+	0x38, 0xcc, 0x05, 0x7d, 0x5c, 0xd2, 0x2b, 0xa9, 0xf4, 0x00, 0xa7, 0x04, 0x22, 0x1a, 0x7e, 0x81,
+	0x0a, 0x9c, 0x5a, 0x8c, 0xdb, 0xa0, 0x4f, 0x54, 0xc7, 0xb3, 0xda, 0x6f, 0x4b, 0x98, 0x12, 0x08,
+	0x49, 0xd8, 0x42, 0x65, 0xd9, 0x8c, 0x19, 0xbb, 0x55, 0xd0, 0xef, 0x48, 0xb1, 0xb5, 0xcc, 0x69,
+	0x3a, 0xa3, 0x14, 0x25, 0x3c, 0x0f, 0xb7, 0x2a, 0x80, 0xfb, 0x68, 0xc9, 0x62, 0xae, 0xe0, 0xc4,
+	0x12, 0x37, 0x7d, 0xf2, 0xd2, 0x67, 0x3d, 0xcd, 0xe7, 0xa5, 0xa2, 0x25, 0x59, 0x2d, 0x5a, 0x49,
+	0x45, 0xc0, 0x5d, 0xb4, 0x10, 0x4c, 0x77, 0xd3, 0xab, 0x20, 0xbd, 0x1e, 0x66, 0x5f, 0x50, 0x92,
+	0x53, 0x99, 0xdf, 0x2e, 0x01, 0xde, 0x47, 0x98, 0x99, 0x60, 0xf6, 0x99, 0x45, 0x04, 0xe3, 0xa6,
+	0x0a, 0xd1, 0xa4, 0x0c, 0xd1, 0x83, 0x34, 0x93, 0xed, 0xce, 0xfb, 0x00, 0x1f, 0x4b, 0xd3, 0x2c,
+	0x8b, 0x1f, 0x63, 0x1b, 0x2d, 0x04, 0xd1, 0x35, 0x65, 0x76, 0x43, 0x13, 0xd0, 0xa7, 0xb2, 0xf7,
+	0xb2, 0x2d, 0x49, 0x1d, 0x9f, 0xa3, 0x04, 0xc3, 0xbd, 0xb0, 0x5b, 0x15, 0xc0, 0x9f, 0xd1, 0x9c,
+	0x4b, 0x85, 0x49, 0x00, 0xa8, 0x30, 0x07, 0xa4, 0x7f, 0x42, 0x41, 0x47, 0xd2, 0xe0, 0x51, 0x9a,
+	0xc1, 0x07, 0xc2, 0x8f, 0x28, 0xff, 0x48, 0xc5, 0xa6, 0x4f, 0xda, 0x93, 0x1c, 0x65, 0x51, 0x72,
+	0x63, 0xa7, 0xb8, 0x8d, 0x4a, 0x6a, 0x0f, 0x87, 0x8e, 0x3f, 0xc5, 0xa9, 0x5e, 0x94, 0xda, 0xab,
+	0xd9, 0x0b, 0xd8, 0xa3, 0x7c, 0x24, 0xe9, 0x33, 0x81, 0xc4, 0x9b, 0x40, 0x01, 0xef, 0xa2, 0x59,
+	0x15, 0xfd, 0x48, 0x74, 0x5a, 0x8a, 0xde, 0xff, 0xc7, 0x8b, 0x13, 0x57, 0x2d, 0x29, 0x91, 0x50,
+	0xf6, 0x1d, 0x9a, 0x0e, 0xde, 0x02, 0xd6, 0xed, 0x52, 0x0e, 0xfa, 0x8c, 0xd4, 0xac, 0x65, 0xa6,
+	0x7f, 0xdb, 0x87, 0x2a, 0xbd, 0x22, 0x44, 0x27, 0x80, 0xd7, 0xd1, 0x7c, 0x9f, 0x80, 0x30, 0x47,
+	0x14, 0x4d, 0xc7, 0xd6, 0x4b, 0x55, 0xad, 0x3e, 0xd1, 0x9e, 0xf3, 0x4b, 0x43, 0xfe, 0x5b, 0x1b,
+	0xbb, 0x68, 0xe9, 0xc6, 0x12, 0xa2, 0xd1, 0x66, 0x65, 0x1b, 0xad, 0xcc, 0x36, 0x62, 0xab, 0x50,
+	0xf3, 0x84, 0xb1, 0x75, 0x13, 0x6a, 0x4f, 0x27, 0xcf, 0xce, 0x2b, 0xb9, 0x3f, 0xe7, 0x95, 0x5c,
+	0xed, 0xbb, 0x86, 0xca, 0x49, 0xfb, 0xc4, 0x3a, 0x2a, 0x10, 0xdb, 0xe6, 0x14, 0x82, 0xff, 0xc4,
+	0xa9, 0x76, 0xf8, 0x88, 0x77, 0x13, 0x12, 0x33, 0x96, 0xbd, 0xd5, 0x98, 0x76, 0x72, 0x54, 0x46,
+	0x7a, 0xfa, 0xa1, 0xa1, 0xe5, 0xd4, 0xb9, 0x32, 0x1a, 0xfb, 0x84, 0x0a, 0xd4, 0x15, 0xdc, 0x89,
+	0xfa, 0x69, 0xfd, 0x57, 0x3f, 0x4a, 0xf8, 0x95, 0x2b, 0xa2, 0x5b, 0x0b, 0x75, 0x86, 0x4d, 0x6d,
+	0x1d, 0x5d, 0x5c, 0x19, 0xda, 0xe5, 0x95, 0xa1, 0xfd, 0xbe, 0x32, 0xb4, 0x6f, 0xd7, 0x46, 0xee,
+	0xf2, 0xda, 0xc8, 0xfd, 0xbc, 0x36, 0x72, 0x68, 0xd9, 0x61, 0x29, 0x3e, 0x3b, 0xda, 0xfe, 0x93,
+	0x9e, 0x23, 0x0e, 0x4f, 0x0e, 0x1a, 0x16, 0x3b, 0x6e, 0x0e, 0x41, 0xeb, 0x0e, 0x1b, 0x79, 0x6a,
+	0x7e, 0x1d, 0x7e, 0xaf, 0xc4, 0xa9, 0x47, 0xe1, 0x20, 0x2f, 0xbf, 0x53, 0x8f, 0xff, 0x0e, 0x00,
+	0x77, 0xe3, 0x66, 0x66, 0x9e, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -182,6 +241,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NetAssetValueHistory) > 0 {
+		for iNdEx := len(m.NetAssetValueHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValueHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.LastScopeOfferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastScopeOfferId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ScopeOffers) > 0 {
+		for iNdEx := len(m.ScopeOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SessionHistory) > 0 {
+		for iNdEx := len(m.SessionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RecordHistory) > 0 {
+		for iNdEx := len(m.RecordHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -361,6 +481,50 @@ func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScopeNetAssetValueHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeNetAssetValueHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeNetAssetValueHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -430,6 +594,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RecordHistory) > 0 {
+		for _, e := range m.RecordHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SessionHistory) > 0 {
+		for _, e := range m.SessionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScopeOffers) > 0 {
+		for _, e := range m.ScopeOffers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastScopeOfferId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastScopeOfferId))
+	}
+	if len(m.NetAssetValueHistory) > 0 {
+		for _, e := range m.NetAssetValueHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -452,6 +643,25 @@ func (m *MarkerNetAssetValues) Size() (n int) {
 	return n
 }
 
+func (m *ScopeNetAssetValueHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -825,6 +1035,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordHistory = append(m.RecordHistory, RecordVersion{})
+			if err := m.RecordHistory[len(m.RecordHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionHistory = append(m.SessionHistory, SessionVersion{})
+			if err := m.SessionHistory[len(m.SessionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeOffers = append(m.ScopeOffers, ScopeOffer{})
+			if err := m.ScopeOffers[len(m.ScopeOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScopeOfferId", wireType)
+			}
+			m.LastScopeOfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastScopeOfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValueHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValueHistory = append(m.NetAssetValueHistory, ScopeNetAssetValueHistory{})
+			if err := m.NetAssetValueHistory[len(m.NetAssetValueHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -962,6 +1327,122 @@ func (m *MarkerNetAssetValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScopeNetAssetValueHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeNetAssetValueHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeNetAssetValueHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, NetAssetValueHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
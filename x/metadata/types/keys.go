@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -36,6 +39,16 @@ const (
 //
 // - 0x21<owner_address>: ObjectStoreLocator
 //
+// - 0x25<record_id><version>: RecordVersion
+//
+// - 0x26<session_id><version>: SessionVersion
+//
+// - 0x27<offer_id>: ScopeOffer
+//
+// - 0x28: Last scope offer id (uint64)
+//
+// - 0x29<scope_id><price_denom><height>: NetAssetValueHistoryEntry
+//
 // These keys are used for indexing and more specific iteration.
 // These keys are handled using the stuff in this file.
 // The "..._address" parts are all bytes of an Account Address.
@@ -52,6 +65,8 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x2A<expiration><offer_id>: 0x01
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -86,6 +101,24 @@ var (
 
 	// OSLocatorParamPrefix prefix for os locator params
 	OSLocatorParamPrefix = []byte{0x23}
+
+	// ParamsKey is the key for the metadata module params
+	ParamsKey = []byte{0x24}
+	// RecordHistoryKeyPrefix prefix for prior versions of records
+	RecordHistoryKeyPrefix = []byte{0x25}
+	// SessionHistoryKeyPrefix prefix for prior versions of sessions
+	SessionHistoryKeyPrefix = []byte{0x26}
+
+	// ScopeOfferKeyPrefix prefix for open scope offers
+	ScopeOfferKeyPrefix = []byte{0x27}
+	// LastScopeOfferIDKey is the key for the last scope offer id assigned
+	LastScopeOfferIDKey = []byte{0x28}
+
+	// NetAssetValueHistoryPrefix prefix for the retained net asset value history of scopes
+	NetAssetValueHistoryPrefix = []byte{0x29}
+
+	// ScopeOfferExpirationIndexPrefix prefix for the index of scope offers by expiration
+	ScopeOfferExpirationIndexPrefix = []byte{0x2A}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -152,3 +185,65 @@ func NetAssetValueKeyPrefix(scopeAddr MetadataAddress) []byte {
 func NetAssetValueKey(scopeAddr MetadataAddress, denom string) []byte {
 	return append(NetAssetValueKeyPrefix(scopeAddr), denom...)
 }
+
+// GetRecordHistoryKeyPrefix returns the [prefix][record id] part of a record history key.
+func GetRecordHistoryKeyPrefix(recordID MetadataAddress) []byte {
+	return append(RecordHistoryKeyPrefix, recordID.Bytes()...)
+}
+
+// GetRecordHistoryKey returns key [prefix][record id][version] for a prior version of a record.
+func GetRecordHistoryKey(recordID MetadataAddress, version uint64) []byte {
+	return binary.BigEndian.AppendUint64(GetRecordHistoryKeyPrefix(recordID), version)
+}
+
+// GetSessionHistoryKeyPrefix returns the [prefix][session id] part of a session history key.
+func GetSessionHistoryKeyPrefix(sessionID MetadataAddress) []byte {
+	return append(SessionHistoryKeyPrefix, sessionID.Bytes()...)
+}
+
+// GetSessionHistoryKey returns key [prefix][session id][version] for a prior version of a session.
+func GetSessionHistoryKey(sessionID MetadataAddress, version uint64) []byte {
+	return binary.BigEndian.AppendUint64(GetSessionHistoryKeyPrefix(sessionID), version)
+}
+
+// NetAssetValueHistoryScopePrefix returns the [prefix][scope id] part of a net asset value history key.
+func NetAssetValueHistoryScopePrefix(scopeID MetadataAddress) []byte {
+	return append(NetAssetValueHistoryPrefix, address.MustLengthPrefix(scopeID.Bytes())...)
+}
+
+// NetAssetValueHistoryKeyPrefix returns the [prefix][scope id][price denom] part of a net asset value history key.
+func NetAssetValueHistoryKeyPrefix(scopeID MetadataAddress, priceDenom string) []byte {
+	return append(NetAssetValueHistoryScopePrefix(scopeID), address.MustLengthPrefix([]byte(priceDenom))...)
+}
+
+// NetAssetValueHistoryKey returns key [prefix][scope id][price denom][height] for a net asset value history entry.
+func NetAssetValueHistoryKey(scopeID MetadataAddress, priceDenom string, height uint64) []byte {
+	return binary.BigEndian.AppendUint64(NetAssetValueHistoryKeyPrefix(scopeID, priceDenom), height)
+}
+
+// GetScopeOfferKey returns the store key for the scope offer with the provided id.
+func GetScopeOfferKey(offerID uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, ScopeOfferKeyPrefix...), offerID)
+}
+
+// GetScopeOfferExpirationIndexKey returns key [prefix][expiration][offer id] for the index of scope offers by
+// expiration. The expiration is stored as big-endian unix seconds.
+func GetScopeOfferExpirationIndexKey(expiration time.Time, offerID uint64) []byte {
+	rv := make([]byte, 0, len(ScopeOfferExpirationIndexPrefix)+16)
+	rv = append(rv, ScopeOfferExpirationIndexPrefix...)
+	rv = binary.BigEndian.AppendUint64(rv, uint64(expiration.Unix()))
+	return binary.BigEndian.AppendUint64(rv, offerID)
+}
+
+// GetScopeOfferExpirationIndexPrefixUpTo returns the key that ends an iteration over the scope offer expiration
+// index entries with an expiration at or before the provided time.
+func GetScopeOfferExpirationIndexPrefixUpTo(expiration time.Time) []byte {
+	rv := make([]byte, 0, len(ScopeOfferExpirationIndexPrefix)+8)
+	rv = append(rv, ScopeOfferExpirationIndexPrefix...)
+	return binary.BigEndian.AppendUint64(rv, uint64(expiration.Unix()+1))
+}
+
+// GetScopeOfferExpirationIndexOfferID returns the offer id from a scope offer expiration index key.
+func GetScopeOfferExpirationIndexOfferID(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
@@ -30,3 +30,31 @@ func TestNetAssetValueKey(t *testing.T) {
 	assert.Equal(t, scopeAddr.Bytes(), navKey[2:denomArrLen+2], "should match denom key")
 	assert.Equal(t, "nhash", string(navKey[denomArrLen+2:]))
 }
+
+func TestHistoryKeys(t *testing.T) {
+	scopeUUID := uuid.New()
+	recordID := RecordMetadataAddress(scopeUUID, "loan")
+	recordKey := GetRecordHistoryKey(recordID, 3)
+	assert.Equal(t, RecordHistoryKeyPrefix[0], recordKey[0], "should have correct prefix for record history key")
+	assert.Equal(t, recordID.Bytes(), recordKey[1:len(recordKey)-8], "should contain record id")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 3}, recordKey[len(recordKey)-8:], "should end with version")
+
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	sessionKey := GetSessionHistoryKey(sessionID, 256)
+	assert.Equal(t, SessionHistoryKeyPrefix[0], sessionKey[0], "should have correct prefix for session history key")
+	assert.Equal(t, sessionID.Bytes(), sessionKey[1:len(sessionKey)-8], "should contain session id")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 1, 0}, sessionKey[len(sessionKey)-8:], "should end with version")
+}
+
+func TestNetAssetValueHistoryKey(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	key := NetAssetValueHistoryKey(scopeID, "nhash", 5)
+	assert.Equal(t, NetAssetValueHistoryPrefix[0], key[0], "should have correct prefix for nav history key")
+	assert.Equal(t, byte(len(scopeID)), key[1], "should have scope id length")
+	assert.Equal(t, scopeID.Bytes(), key[2:2+len(scopeID)], "should contain scope id")
+	denomStart := 3 + len(scopeID)
+	assert.Equal(t, byte(5), key[denomStart-1], "should have price denom length")
+	assert.Equal(t, "nhash", string(key[denomStart:denomStart+5]), "should contain price denom")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 5}, key[len(key)-8:], "should end with height")
+	assert.Equal(t, NetAssetValueHistoryKeyPrefix(scopeID, "nhash"), key[:len(key)-8], "should start with key prefix")
+}
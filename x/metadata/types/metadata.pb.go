@@ -25,6 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// max_history_versions is the maximum number of prior versions of each record and session to retain.
+	// Zero disables record and session version history.
+	MaxHistoryVersions uint32 `protobuf:"varint,1,opt,name=max_history_versions,json=maxHistoryVersions,proto3" json:"max_history_versions,omitempty"`
+	// max_nav_history_entries is the maximum number of net asset value history entries to retain for each scope
+	// and price denom. Zero disables net asset value history.
+	MaxNavHistoryEntries uint32 `protobuf:"varint,2,opt,name=max_nav_history_entries,json=maxNavHistoryEntries,proto3" json:"max_nav_history_entries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -60,6 +66,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxHistoryVersions() uint32 {
+	if m != nil {
+		return m.MaxHistoryVersions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNavHistoryEntries() uint32 {
+	if m != nil {
+		return m.MaxNavHistoryEntries
+	}
+	return 0
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -561,53 +581,57 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0x87, 0xe3, 0xc0, 0x0d, 0xe4, 0xe4, 0x2f, 0x73, 0x03, 0xe4, 0xa2, 0x8b, 0x03, 0x41, 0xf7,
-	0x2a, 0x42, 0x25, 0x51, 0x28, 0xed, 0x82, 0xaa, 0xaa, 0xa0, 0x0b, 0x8a, 0xaa, 0x56, 0x28, 0xa8,
-	0x9b, 0x4a, 0x15, 0x32, 0xf6, 0x00, 0x56, 0x15, 0x8f, 0xe5, 0x71, 0x10, 0x7d, 0x0b, 0xd4, 0x27,
-	0xe8, 0x5b, 0x74, 0xd3, 0x07, 0x60, 0xc9, 0xaa, 0xaa, 0xba, 0x40, 0x15, 0x6c, 0xba, 0xe8, 0x43,
-	0x54, 0x1e, 0x8f, 0x3d, 0x67, 0x30, 0x48, 0x51, 0x77, 0x33, 0x67, 0x7e, 0xdf, 0x91, 0xe7, 0x8b,
-	0x8f, 0x01, 0xfe, 0xf3, 0x03, 0x76, 0x4a, 0x3d, 0xcb, 0xb3, 0x69, 0x6f, 0x48, 0x43, 0xcb, 0xb1,
-	0x42, 0xab, 0x77, 0xda, 0x4f, 0xd7, 0x5d, 0x3f, 0x60, 0x21, 0x23, 0x73, 0x2a, 0xd6, 0x4d, 0x8f,
-	0x4e, 0xfb, 0x0b, 0x8d, 0x63, 0x76, 0xcc, 0x44, 0xa4, 0x17, 0xad, 0xe2, 0x74, 0xbb, 0x0a, 0x85,
-	0x3d, 0x2b, 0xb0, 0x86, 0x7c, 0x73, 0xf2, 0xe7, 0xa7, 0x96, 0xd1, 0xfe, 0x6a, 0x40, 0x69, 0xdf,
-	0x66, 0x3e, 0xdd, 0x75, 0x76, 0xbd, 0x23, 0x46, 0xd6, 0x61, 0x9a, 0x47, 0xdb, 0x03, 0xd7, 0x69,
-	0x1a, 0x4b, 0x46, 0xa7, 0xbc, 0x3d, 0x7f, 0x71, 0xd5, 0xca, 0x7d, 0xbf, 0x6a, 0xd5, 0x5e, 0xc9,
-	0xe6, 0x5b, 0x8e, 0x13, 0x50, 0xce, 0x07, 0x53, 0x3c, 0xe6, 0xc8, 0xff, 0x50, 0x4b, 0x98, 0x03,
-	0x3f, 0xa0, 0x47, 0xee, 0x59, 0x33, 0x1f, 0xa1, 0x83, 0x8a, 0x4c, 0xec, 0x89, 0x22, 0x59, 0x83,
-	0xbf, 0xd3, 0x5c, 0xbc, 0x18, 0x8d, 0x5c, 0xa7, 0x39, 0x21, 0xb2, 0x75, 0x99, 0x15, 0x0f, 0xf3,
-	0x66, 0xe4, 0x3a, 0x64, 0x11, 0x20, 0x4e, 0x59, 0x8e, 0x13, 0x34, 0x27, 0x97, 0x8c, 0x4e, 0x71,
-	0x50, 0x14, 0x95, 0xe8, 0x09, 0xd4, 0xb1, 0x68, 0xf2, 0x17, 0x3a, 0x8e, 0xe8, 0xf6, 0xaf, 0x3c,
-	0x54, 0xf6, 0x29, 0xe7, 0x2e, 0xf3, 0xe4, 0xd5, 0x1e, 0x03, 0xf0, 0xb8, 0x30, 0xc6, 0xe5, 0x8a,
-	0x3c, 0x61, 0xc9, 0x2a, 0xcc, 0x28, 0x4e, 0xbf, 0x60, 0x2d, 0x4d, 0xc9, 0x2b, 0xf6, 0x61, 0x16,
-	0x65, 0x33, 0x97, 0x24, 0x69, 0x5e, 0x5d, 0xf3, 0x11, 0xcc, 0x63, 0x44, 0x2e, 0x05, 0x34, 0x29,
-	0xa0, 0x86, 0x82, 0xe2, 0x85, 0xc0, 0x96, 0xa1, 0x9c, 0x64, 0x85, 0x9f, 0x58, 0x40, 0x49, 0xd6,
-	0x84, 0x21, 0x14, 0x11, 0xed, 0x0a, 0x5a, 0x44, 0x74, 0xd9, 0x81, 0x4a, 0xfa, 0x93, 0xb8, 0xde,
-	0x11, 0x6b, 0x4e, 0x2d, 0x19, 0x9d, 0xd2, 0xfa, 0x4a, 0xf7, 0xee, 0x97, 0xaa, 0x8b, 0x5e, 0x95,
-	0x41, 0x89, 0xab, 0x4d, 0xfb, 0x4b, 0x1e, 0xca, 0x03, 0x6a, 0xb3, 0xc0, 0x91, 0xb6, 0x37, 0xa0,
-	0x18, 0x88, 0xfd, 0x18, 0xb2, 0xa7, 0x03, 0x49, 0x92, 0x0e, 0xd4, 0x53, 0x4a, 0x57, 0x5d, 0x4d,
-	0x32, 0xd2, 0x74, 0x0f, 0x1a, 0x2a, 0x99, 0x11, 0x3d, 0x93, 0xa4, 0x95, 0xe7, 0x3e, 0xcc, 0x2a,
-	0xe0, 0xc4, 0xe2, 0x27, 0xd4, 0x39, 0xf0, 0xac, 0x21, 0x95, 0x96, 0x49, 0x42, 0xbc, 0x10, 0x47,
-	0xaf, 0xad, 0x21, 0x25, 0x2d, 0x28, 0x49, 0x04, 0x29, 0x86, 0xb8, 0x24, 0x0c, 0x67, 0xf4, 0x15,
-	0xfe, 0x50, 0xdf, 0x79, 0x1e, 0x6a, 0xe2, 0x70, 0xdf, 0xa7, 0xb6, 0x34, 0xf8, 0x24, 0x69, 0xce,
-	0x7d, 0x6a, 0x8f, 0x61, 0x31, 0x6e, 0x18, 0x37, 0x88, 0xf4, 0x68, 0xb0, 0x2e, 0x73, 0x06, 0x45,
-	0xa5, 0xcf, 0x67, 0xb0, 0xa8, 0x03, 0x68, 0x87, 0xc4, 0x36, 0x11, 0x99, 0x3e, 0xb0, 0xf0, 0x9b,
-	0x7e, 0x05, 0x04, 0x82, 0x66, 0xb6, 0x92, 0x22, 0xc2, 0x99, 0x9e, 0x43, 0xc3, 0xab, 0x72, 0x62,
-	0x80, 0x3f, 0xe7, 0x81, 0x3c, 0x67, 0x5e, 0x18, 0x58, 0x76, 0x88, 0xac, 0x6c, 0x41, 0xdd, 0x96,
-	0xd5, 0x71, 0xc5, 0x54, 0x6d, 0xad, 0x4d, 0x34, 0x71, 0xb7, 0x5b, 0xe8, 0x7a, 0x1a, 0x3a, 0x20,
-	0x0d, 0xbd, 0x84, 0x95, 0x0c, 0xa6, 0x17, 0x90, 0x27, 0x53, 0x6f, 0x81, 0x2f, 0x22, 0x6c, 0x3d,
-	0x00, 0xa2, 0xb3, 0x48, 0x58, 0x1d, 0xb3, 0xc2, 0x59, 0x26, 0x8d, 0xb4, 0x69, 0x69, 0x61, 0xee,
-	0xe3, 0x04, 0xd4, 0xe3, 0x59, 0x44, 0xde, 0x9e, 0x82, 0x9c, 0xa0, 0x71, 0xad, 0x95, 0x03, 0xd4,
-	0x02, 0x4d, 0xcf, 0x9d, 0xc6, 0x08, 0x0e, 0x4b, 0x5f, 0x3b, 0xb0, 0x7c, 0x0b, 0xb9, 0xd7, 0xd6,
-	0xbf, 0x18, 0xcf, 0xb8, 0xda, 0x84, 0x85, 0x5b, 0x8d, 0xb2, 0xe3, 0x3b, 0x87, 0x3b, 0xa0, 0x11,
-	0x56, 0x1f, 0x14, 0x65, 0x39, 0xf6, 0x56, 0x55, 0x84, 0x70, 0xfc, 0x0e, 0x66, 0x33, 0x3f, 0x2f,
-	0x9a, 0xe9, 0xd5, 0xfb, 0x66, 0x3a, 0xfb, 0x8e, 0x0e, 0x88, 0x9d, 0xa9, 0x6d, 0xbf, 0xbf, 0xb8,
-	0x36, 0x8d, 0xcb, 0x6b, 0xd3, 0xf8, 0x71, 0x6d, 0x1a, 0xe7, 0x37, 0x66, 0xee, 0xf2, 0xc6, 0xcc,
-	0x7d, 0xbb, 0x31, 0x73, 0xf0, 0x8f, 0xcb, 0xee, 0xe9, 0xbd, 0x67, 0xbc, 0xdd, 0x38, 0x76, 0xc3,
-	0x93, 0xd1, 0x61, 0xd7, 0x66, 0xc3, 0x9e, 0x0a, 0xad, 0xb9, 0x0c, 0xed, 0x7a, 0x67, 0xea, 0xff,
-	0x83, 0xf0, 0x83, 0x4f, 0xf9, 0x61, 0x41, 0xfc, 0xb1, 0x7f, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xea, 0x47, 0x7e, 0x34, 0x43, 0x08, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x5f, 0x4f, 0x1a, 0x4b,
+	0x18, 0x87, 0x59, 0xf4, 0xa0, 0xbc, 0x80, 0xe0, 0x1c, 0x54, 0x8e, 0x39, 0x82, 0x62, 0xce, 0x09,
+	0x31, 0x15, 0x8a, 0xd5, 0x5e, 0xd8, 0x34, 0x8d, 0x36, 0x8d, 0x9a, 0xa6, 0xc6, 0x60, 0xda, 0x8b,
+	0x26, 0x0d, 0x19, 0x77, 0x47, 0xd9, 0x34, 0xec, 0x90, 0x99, 0x85, 0xe2, 0xb7, 0x30, 0xfd, 0x04,
+	0xfd, 0x16, 0xbd, 0xe9, 0x07, 0xf0, 0xd2, 0xab, 0xa6, 0xe9, 0x85, 0x69, 0xf4, 0xa6, 0x17, 0xfd,
+	0x10, 0xcd, 0xce, 0xce, 0xee, 0xcc, 0xb2, 0x9a, 0x90, 0xde, 0xcd, 0x9f, 0xdf, 0xf3, 0xb2, 0xef,
+	0xc3, 0xbe, 0x04, 0xf8, 0xaf, 0xc7, 0xe8, 0x80, 0x38, 0xd8, 0x31, 0x49, 0xa3, 0x4b, 0x5c, 0x6c,
+	0x61, 0x17, 0x37, 0x06, 0xcd, 0x70, 0x5d, 0xef, 0x31, 0xea, 0x52, 0x34, 0xaf, 0x62, 0xf5, 0xf0,
+	0x6a, 0xd0, 0x5c, 0x2c, 0x9e, 0xd1, 0x33, 0x2a, 0x22, 0x0d, 0x6f, 0xe5, 0xa7, 0xab, 0x1f, 0x20,
+	0x75, 0x84, 0x19, 0xee, 0x72, 0xf4, 0x10, 0x8a, 0x5d, 0x3c, 0x6c, 0x77, 0x6c, 0xee, 0x52, 0x76,
+	0xde, 0x1e, 0x10, 0xc6, 0x6d, 0xea, 0xf0, 0x92, 0xb1, 0x6c, 0xd4, 0x72, 0x2d, 0xd4, 0xc5, 0xc3,
+	0x7d, 0xff, 0xea, 0x8d, 0xbc, 0x41, 0x5b, 0xb0, 0xe0, 0x11, 0x0e, 0x1e, 0x84, 0x14, 0x71, 0x5c,
+	0x66, 0x13, 0x5e, 0x4a, 0x0a, 0xc8, 0x2b, 0x78, 0x88, 0x07, 0x92, 0x7b, 0xe1, 0xdf, 0x6d, 0x4f,
+	0xfe, 0xfc, 0x54, 0x31, 0xaa, 0x5f, 0x0d, 0xc8, 0x1c, 0x9b, 0xb4, 0x47, 0x0e, 0xac, 0x03, 0xe7,
+	0x94, 0xa2, 0x0d, 0x98, 0xe6, 0xde, 0xb6, 0x6d, 0x5b, 0xe2, 0x23, 0xb3, 0xbb, 0x0b, 0x97, 0xd7,
+	0x95, 0xc4, 0xf7, 0xeb, 0x4a, 0xfe, 0x95, 0xec, 0x62, 0xc7, 0xb2, 0x18, 0xe1, 0xbc, 0x35, 0xc5,
+	0x7d, 0x0e, 0xfd, 0x0f, 0xf9, 0x80, 0x69, 0xf7, 0x18, 0x39, 0xb5, 0x87, 0xe2, 0x83, 0xb3, 0xad,
+	0x9c, 0x4c, 0x1c, 0x89, 0x43, 0xb4, 0x0e, 0x7f, 0x87, 0x39, 0x7f, 0xd1, 0xef, 0xdb, 0x56, 0x69,
+	0x42, 0x64, 0x0b, 0x32, 0x2b, 0x1e, 0xe6, 0x75, 0xdf, 0xb6, 0xd0, 0x12, 0x80, 0x9f, 0xc2, 0x96,
+	0xc5, 0x4a, 0x93, 0xcb, 0x46, 0x2d, 0xdd, 0x4a, 0x8b, 0x13, 0xef, 0x09, 0xd4, 0xb5, 0x28, 0xf2,
+	0x97, 0x76, 0xed, 0xd1, 0xd5, 0x5f, 0x49, 0xc8, 0x1d, 0x13, 0xee, 0x29, 0x92, 0xad, 0x3d, 0x06,
+	0xe0, 0xfe, 0xc1, 0x18, 0xcd, 0xa5, 0x79, 0xc0, 0xa2, 0x35, 0x98, 0x55, 0x5c, 0xb4, 0xc1, 0x7c,
+	0x98, 0x92, 0x2d, 0x36, 0x61, 0x4e, 0xcb, 0xc6, 0x9a, 0x44, 0x61, 0x5e, 0xb5, 0xb9, 0x05, 0x0b,
+	0x3a, 0x22, 0x97, 0x02, 0x9a, 0x14, 0x50, 0x51, 0x41, 0xfe, 0x42, 0x60, 0x2b, 0x90, 0x0d, 0xb2,
+	0xc2, 0x8f, 0x2f, 0x20, 0x23, 0xcf, 0x84, 0x21, 0x2d, 0x22, 0xca, 0xa5, 0x22, 0x11, 0x51, 0x65,
+	0x0f, 0x72, 0xe1, 0x57, 0x62, 0x3b, 0xa7, 0xb4, 0x34, 0xb5, 0x6c, 0xd4, 0x32, 0x1b, 0xab, 0xf5,
+	0xbb, 0xdf, 0xde, 0xba, 0xf6, 0xaa, 0xb4, 0x32, 0x5c, 0x6d, 0xaa, 0x5f, 0x92, 0x90, 0x6d, 0x11,
+	0x93, 0x32, 0x4b, 0xda, 0xde, 0x84, 0x34, 0x13, 0xfb, 0x31, 0x64, 0x4f, 0x33, 0x49, 0xa2, 0x1a,
+	0x14, 0x42, 0x2a, 0xaa, 0x7a, 0x26, 0xc8, 0x48, 0xd3, 0x0d, 0x28, 0xaa, 0x64, 0x4c, 0xf4, 0x6c,
+	0x90, 0x56, 0x9e, 0x9b, 0x30, 0xa7, 0x80, 0x0e, 0xe6, 0x1d, 0x62, 0xb5, 0x1d, 0xdc, 0x25, 0xd2,
+	0x32, 0x0a, 0x88, 0x7d, 0x71, 0x75, 0x88, 0xbb, 0x04, 0x55, 0x20, 0x23, 0x11, 0x4d, 0x31, 0xf8,
+	0x47, 0xc2, 0x70, 0x4c, 0x5f, 0xea, 0x0f, 0xf5, 0x5d, 0x24, 0x21, 0x2f, 0x2e, 0x8f, 0x7b, 0xc4,
+	0x94, 0x06, 0x9f, 0x04, 0xc5, 0x79, 0x8f, 0x98, 0x63, 0x58, 0xcc, 0x70, 0x55, 0xc0, 0xd3, 0x13,
+	0x81, 0xa3, 0x32, 0x67, 0xb5, 0xa8, 0xf4, 0xf9, 0x0c, 0x96, 0xa2, 0x80, 0xb6, 0xd3, 0xc4, 0x96,
+	0x34, 0x32, 0x7c, 0x60, 0xe1, 0x37, 0xfc, 0x15, 0x10, 0x88, 0x36, 0xb3, 0xb9, 0x10, 0x11, 0xce,
+	0xa2, 0x39, 0x6d, 0x78, 0x73, 0x5c, 0xaf, 0x57, 0xfd, 0x9c, 0x04, 0xf4, 0x9c, 0x3a, 0x2e, 0xc3,
+	0xa6, 0xab, 0x59, 0xd9, 0x81, 0x82, 0x29, 0x4f, 0xc7, 0x15, 0x33, 0x63, 0x46, 0xca, 0x78, 0x13,
+	0x37, 0x5a, 0x22, 0xaa, 0xa7, 0x18, 0x05, 0xa4, 0xa1, 0x97, 0xb0, 0x1a, 0xc3, 0xa2, 0x07, 0x9a,
+	0xa7, 0x72, 0xb4, 0x84, 0xde, 0x88, 0xb0, 0xf5, 0x00, 0x50, 0x94, 0xd5, 0x84, 0x15, 0x74, 0x56,
+	0x38, 0x8b, 0xa5, 0x35, 0x6d, 0x05, 0x73, 0xa4, 0x76, 0xf5, 0xe3, 0x04, 0x14, 0xfc, 0x59, 0xd4,
+	0xbc, 0x3d, 0x05, 0x39, 0x41, 0xe3, 0x5a, 0xcb, 0x32, 0xad, 0x84, 0x36, 0x3d, 0x77, 0x1a, 0x43,
+	0x7a, 0x58, 0xfa, 0xda, 0x83, 0x95, 0x11, 0xe4, 0x5e, 0x5b, 0xff, 0xea, 0x78, 0xcc, 0xd5, 0x36,
+	0x2c, 0x8e, 0x14, 0x8a, 0x8f, 0xef, 0xbc, 0x5e, 0x41, 0x1b, 0x61, 0xf5, 0x83, 0xa2, 0x2c, 0xfb,
+	0xde, 0x66, 0x14, 0x21, 0x1c, 0xbf, 0x83, 0xb9, 0xd8, 0xd7, 0xab, 0xcd, 0xf4, 0xda, 0x7d, 0x33,
+	0x1d, 0x7f, 0x47, 0x5b, 0xc8, 0x8c, 0x9d, 0xed, 0xbe, 0xbf, 0xbc, 0x29, 0x1b, 0x57, 0x37, 0x65,
+	0xe3, 0xc7, 0x4d, 0xd9, 0xb8, 0xb8, 0x2d, 0x27, 0xae, 0x6e, 0xcb, 0x89, 0x6f, 0xb7, 0xe5, 0x04,
+	0xfc, 0x63, 0xd3, 0x7b, 0x6a, 0x1f, 0x19, 0x6f, 0x37, 0xcf, 0x6c, 0xb7, 0xd3, 0x3f, 0xa9, 0x9b,
+	0xb4, 0xdb, 0x50, 0xa1, 0x75, 0x9b, 0x6a, 0xbb, 0xc6, 0x50, 0xfd, 0x11, 0x71, 0xcf, 0x7b, 0x84,
+	0x9f, 0xa4, 0xc4, 0xbf, 0x8a, 0x47, 0xbf, 0x07, 0x00, 0xfb, 0x71, 0x06, 0x88, 0xac, 0x08, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -629,6 +653,12 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxHistoryVersions != that1.MaxHistoryVersions {
+		return false
+	}
+	if this.MaxNavHistoryEntries != that1.MaxNavHistoryEntries {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -651,6 +681,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxNavHistoryEntries != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxNavHistoryEntries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxHistoryVersions != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxHistoryVersions))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1080,6 +1120,12 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxHistoryVersions != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxHistoryVersions))
+	}
+	if m.MaxNavHistoryEntries != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxNavHistoryEntries))
+	}
 	return n
 }
 
@@ -1296,6 +1342,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHistoryVersions", wireType)
+			}
+			m.MaxHistoryVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHistoryVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNavHistoryEntries", wireType)
+			}
+			m.MaxNavHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNavHistoryEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
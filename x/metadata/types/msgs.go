@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -35,6 +36,13 @@ const (
 	TypeURLMsgDeleteOSLocatorRequest                 = "/provenance.metadata.v1.MsgDeleteOSLocatorRequest"
 	TypeURLMsgModifyOSLocatorRequest                 = "/provenance.metadata.v1.MsgModifyOSLocatorRequest"
 	TypeURLMsgSetAccountDataRequest                  = "/provenance.metadata.v1.MsgSetAccountDataRequest"
+	TypeURLMsgUpdateParamsRequest                    = "/provenance.metadata.v1.MsgUpdateParamsRequest"
+	TypeURLMsgOfferScopeRequest                      = "/provenance.metadata.v1.MsgOfferScopeRequest"
+	TypeURLMsgAcceptScopeOfferRequest                = "/provenance.metadata.v1.MsgAcceptScopeOfferRequest"
+	TypeURLMsgCancelScopeOfferRequest                = "/provenance.metadata.v1.MsgCancelScopeOfferRequest"
+	TypeURLMsgBatchUpdateScopesRequest               = "/provenance.metadata.v1.MsgBatchUpdateScopesRequest"
+	TypeURLMsgMigrateScopeSpecRequest                = "/provenance.metadata.v1.MsgMigrateScopeSpecRequest"
+	TypeURLMsgRotateOSLocatorKeyRequest              = "/provenance.metadata.v1.MsgRotateOSLocatorKeyRequest"
 )
 
 // MetadataMsg extends the sdk.Msg interface with functions common to x/metadata messages.
@@ -76,10 +84,20 @@ var AllRequestMsgs = []MetadataMsg{
 	(*MsgBindOSLocatorRequest)(nil),
 	(*MsgDeleteOSLocatorRequest)(nil),
 	(*MsgModifyOSLocatorRequest)(nil),
+	(*MsgRotateOSLocatorKeyRequest)(nil),
 
 	(*MsgSetAccountDataRequest)(nil),
 
 	(*MsgAddNetAssetValuesRequest)(nil),
+
+	(*MsgUpdateParamsRequest)(nil),
+
+	(*MsgOfferScopeRequest)(nil),
+	(*MsgAcceptScopeOfferRequest)(nil),
+	(*MsgCancelScopeOfferRequest)(nil),
+
+	(*MsgBatchUpdateScopesRequest)(nil),
+	(*MsgMigrateScopeSpecRequest)(nil),
 }
 
 // We still need these deprecated messages to be sdk.Msg for the codec.
@@ -883,6 +901,32 @@ func (msg MsgModifyOSLocatorRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgRotateOSLocatorKeyRequest  ------------------
+
+// NewMsgRotateOSLocatorKeyRequest creates a new msg instance
+func NewMsgRotateOSLocatorKeyRequest(owner, keyID, encryptionKey string, previousKeyExpiration *time.Time) *MsgRotateOSLocatorKeyRequest {
+	return &MsgRotateOSLocatorKeyRequest{
+		Owner:                 owner,
+		KeyId:                 keyID,
+		EncryptionKey:         encryptionKey,
+		PreviousKeyExpiration: previousKeyExpiration,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgRotateOSLocatorKeyRequest) GetSignerStrs() []string {
+	return []string{msg.Owner}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgRotateOSLocatorKeyRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %q: %w", msg.Owner, err)
+	}
+	key := OSLocatorKey{KeyId: msg.KeyId, EncryptionKey: msg.EncryptionKey}
+	return key.Validate()
+}
+
 // ------------------  MsgSetAccountDataRequest  ------------------
 
 // ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
@@ -1000,3 +1044,204 @@ func (msg *SessionIdComponents) GetSessionAddr() (MetadataAddress, error) {
 	ma := SessionMetadataAddress(*scopeUUID, *sessionUUID)
 	return ma, nil
 }
+
+// ------------------  MsgUpdateParamsRequest  ------------------
+
+// NewMsgUpdateParamsRequest creates a new MsgUpdateParamsRequest instance
+func NewMsgUpdateParamsRequest(authority string, params Params) *MsgUpdateParamsRequest {
+	return &MsgUpdateParamsRequest{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgUpdateParamsRequest) GetSignerStrs() []string {
+	return []string{msg.Authority}
+}
+
+// ValidateBasic runs stateless validation on a MsgUpdateParamsRequest.
+func (msg MsgUpdateParamsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return msg.Params.Validate()
+}
+
+// ------------------  MsgOfferScopeRequest  ------------------
+
+// NewMsgOfferScopeRequest creates a new MsgOfferScopeRequest instance
+func NewMsgOfferScopeRequest(seller string, scopeIDs []MetadataAddress, price sdk.Coin, buyer string, expiration *time.Time) *MsgOfferScopeRequest {
+	return &MsgOfferScopeRequest{
+		Seller:     seller,
+		ScopeIds:   scopeIDs,
+		Price:      price,
+		Buyer:      buyer,
+		Expiration: expiration,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgOfferScopeRequest) GetSignerStrs() []string {
+	return []string{msg.Seller}
+}
+
+// ValidateBasic runs stateless validation on a MsgOfferScopeRequest.
+func (msg MsgOfferScopeRequest) ValidateBasic() error {
+	return validateScopeOffer(msg.Seller, msg.ScopeIds, msg.Price, msg.Buyer)
+}
+
+// ------------------  MsgAcceptScopeOfferRequest  ------------------
+
+// NewMsgAcceptScopeOfferRequest creates a new MsgAcceptScopeOfferRequest instance
+func NewMsgAcceptScopeOfferRequest(buyer string, offerID uint64) *MsgAcceptScopeOfferRequest {
+	return &MsgAcceptScopeOfferRequest{
+		Buyer:   buyer,
+		OfferId: offerID,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgAcceptScopeOfferRequest) GetSignerStrs() []string {
+	return []string{msg.Buyer}
+}
+
+// ValidateBasic runs stateless validation on a MsgAcceptScopeOfferRequest.
+func (msg MsgAcceptScopeOfferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer: %w", err)
+	}
+	if msg.OfferId == 0 {
+		return errors.New("offer id cannot be zero")
+	}
+	return nil
+}
+
+// ------------------  MsgCancelScopeOfferRequest  ------------------
+
+// NewMsgCancelScopeOfferRequest creates a new MsgCancelScopeOfferRequest instance
+func NewMsgCancelScopeOfferRequest(seller string, offerID uint64) *MsgCancelScopeOfferRequest {
+	return &MsgCancelScopeOfferRequest{
+		Seller:  seller,
+		OfferId: offerID,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgCancelScopeOfferRequest) GetSignerStrs() []string {
+	return []string{msg.Seller}
+}
+
+// ValidateBasic runs stateless validation on a MsgCancelScopeOfferRequest.
+func (msg MsgCancelScopeOfferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return fmt.Errorf("invalid seller: %w", err)
+	}
+	if msg.OfferId == 0 {
+		return errors.New("offer id cannot be zero")
+	}
+	return nil
+}
+
+// ------------------  MsgBatchUpdateScopesRequest  ------------------
+
+// NewMsgBatchUpdateScopesRequest creates a new msg instance
+func NewMsgBatchUpdateScopesRequest(updates []ScopeUpdate, bestEffort bool, signers []string) *MsgBatchUpdateScopesRequest {
+	return &MsgBatchUpdateScopesRequest{
+		Updates:    updates,
+		BestEffort: bestEffort,
+		Signers:    signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgBatchUpdateScopesRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgBatchUpdateScopesRequest) ValidateBasic() error {
+	if len(msg.Updates) == 0 {
+		return errors.New("at least one update is required")
+	}
+	seen := make(map[string]bool, len(msg.Updates))
+	for i, update := range msg.Updates {
+		if err := update.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid update [%d]: %w", i, err)
+		}
+		key := string(update.ScopeId)
+		if seen[key] {
+			return fmt.Errorf("invalid update [%d]: duplicate scope id %s", i, update.ScopeId)
+		}
+		seen[key] = true
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on a ScopeUpdate.
+func (u ScopeUpdate) ValidateBasic() error {
+	if !u.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", u.ScopeId.String())
+	}
+	if len(u.AddOwners) == 0 && len(u.RemoveOwners) == 0 && len(u.AddDataAccess) == 0 &&
+		len(u.RemoveDataAccess) == 0 && u.SpecificationId.Empty() {
+		return errors.New("no changes provided")
+	}
+	if len(u.AddOwners) > 0 {
+		if err := ValidatePartiesBasic(u.AddOwners); err != nil {
+			return fmt.Errorf("invalid owners to add: %w", err)
+		}
+	}
+	for _, addr := range u.RemoveOwners {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid owner to remove %q: %w", addr, err)
+		}
+	}
+	for _, addr := range u.AddDataAccess {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid data access address to add %q: %w", addr, err)
+		}
+	}
+	for _, addr := range u.RemoveDataAccess {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid data access address to remove %q: %w", addr, err)
+		}
+	}
+	if !u.SpecificationId.Empty() && !u.SpecificationId.IsScopeSpecificationAddress() {
+		return fmt.Errorf("address is not a scope specification id: %v", u.SpecificationId.String())
+	}
+	return nil
+}
+
+// ------------------  MsgMigrateScopeSpecRequest  ------------------
+
+// NewMsgMigrateScopeSpecRequest creates a new msg instance
+func NewMsgMigrateScopeSpecRequest(scopeID, specID MetadataAddress, signers []string) *MsgMigrateScopeSpecRequest {
+	return &MsgMigrateScopeSpecRequest{
+		ScopeId:         scopeID,
+		SpecificationId: specID,
+		Signers:         signers,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgMigrateScopeSpecRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgMigrateScopeSpecRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if !msg.SpecificationId.IsScopeSpecificationAddress() {
+		return fmt.Errorf("address is not a scope specification id: %v", msg.SpecificationId.String())
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -54,6 +55,11 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg {
 			return &MsgModifyOSLocatorRequest{Locator: ObjectStoreLocator{Owner: signer}}
 		},
+		func(signer string) sdk.Msg { return &MsgRotateOSLocatorKeyRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgOfferScopeRequest{Seller: signer} },
+		func(signer string) sdk.Msg { return &MsgAcceptScopeOfferRequest{Buyer: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelScopeOfferRequest{Seller: signer} },
 	}
 
 	multiSignerMsgMakers := []testutil.MsgMakerMulti{
@@ -80,6 +86,8 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signers []string) sdk.Msg { return &MsgDeleteRecordSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgSetAccountDataRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgAddNetAssetValuesRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgBatchUpdateScopesRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgMigrateScopeSpecRequest{Signers: signers} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, singleSignerMsgMakers, multiSignerMsgMakers)
@@ -352,6 +360,137 @@ func TestAddScopeOwnersValidateBasic(t *testing.T) {
 	}
 }
 
+func TestBatchUpdateScopesValidateBasic(t *testing.T) {
+	addr := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	scopeID := ScopeMetadataAddress(uuid.New())
+	notAScopeID := RecordMetadataAddress(uuid.New(), "recordname")
+	notASpecID := ContractSpecMetadataAddress(uuid.New())
+	owner := Party{Address: addr, Role: PartyType_PARTY_TYPE_OWNER}
+
+	cases := []struct {
+		name     string
+		msg      *MsgBatchUpdateScopesRequest
+		errorMsg string
+	}{
+		{
+			name:     "no updates",
+			msg:      NewMsgBatchUpdateScopesRequest(nil, false, []string{addr}),
+			errorMsg: "at least one update is required",
+		},
+		{
+			name:     "not a scope id",
+			msg:      NewMsgBatchUpdateScopesRequest([]ScopeUpdate{{ScopeId: notAScopeID, AddDataAccess: []string{addr}}}, false, []string{addr}),
+			errorMsg: fmt.Sprintf("invalid update [0]: address is not a scope id: %s", notAScopeID),
+		},
+		{
+			name:     "no changes",
+			msg:      NewMsgBatchUpdateScopesRequest([]ScopeUpdate{{ScopeId: scopeID}}, false, []string{addr}),
+			errorMsg: "invalid update [0]: no changes provided",
+		},
+		{
+			name:     "invalid owner to add",
+			msg:      NewMsgBatchUpdateScopesRequest([]ScopeUpdate{{ScopeId: scopeID, AddOwners: []Party{{Address: addr}}}}, false, []string{addr}),
+			errorMsg: "invalid update [0]: invalid owners to add: invalid party type for party " + addr,
+		},
+		{
+			name:     "invalid owner to remove",
+			msg:      NewMsgBatchUpdateScopesRequest([]ScopeUpdate{{ScopeId: scopeID, RemoveOwners: []string{"bad"}}}, false, []string{addr}),
+			errorMsg: `invalid update [0]: invalid owner to remove "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name:     "invalid data access to add",
+			msg:      NewMsgBatchUpdateScopesRequest([]ScopeUpdate{{ScopeId: scopeID, AddDataAccess: []string{"bad"}}}, false, []string{addr}),
+			errorMsg: `invalid update [0]: invalid data access address to add "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name:     "invalid data access to remove",
+			msg:      NewMsgBatchUpdateScopesRequest([]ScopeUpdate{{ScopeId: scopeID, RemoveDataAccess: []string{"bad"}}}, false, []string{addr}),
+			errorMsg: `invalid update [0]: invalid data access address to remove "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name:     "not a scope spec id",
+			msg:      NewMsgBatchUpdateScopesRequest([]ScopeUpdate{{ScopeId: scopeID, SpecificationId: notASpecID}}, false, []string{addr}),
+			errorMsg: fmt.Sprintf("invalid update [0]: address is not a scope specification id: %s", notASpecID),
+		},
+		{
+			name: "duplicate scope",
+			msg: NewMsgBatchUpdateScopesRequest([]ScopeUpdate{
+				{ScopeId: scopeID, AddOwners: []Party{owner}},
+				{ScopeId: scopeID, AddDataAccess: []string{addr}},
+			}, true, []string{addr}),
+			errorMsg: fmt.Sprintf("invalid update [1]: duplicate scope id %s", scopeID),
+		},
+		{
+			name:     "no signers",
+			msg:      NewMsgBatchUpdateScopesRequest([]ScopeUpdate{{ScopeId: scopeID, AddOwners: []Party{owner}}}, false, nil),
+			errorMsg: "at least one signer is required",
+		},
+		{
+			name: "valid",
+			msg: NewMsgBatchUpdateScopesRequest([]ScopeUpdate{
+				{ScopeId: scopeID, AddOwners: []Party{owner}, RemoveDataAccess: []string{addr}},
+				{ScopeId: ScopeMetadataAddress(uuid.New()), SpecificationId: ScopeSpecMetadataAddress(uuid.New())},
+			}, true, []string{addr}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg, "MsgBatchUpdateScopesRequest.ValidateBasic expected error")
+			} else {
+				require.NoError(t, err, "MsgBatchUpdateScopesRequest.ValidateBasic unexpected error")
+			}
+		})
+	}
+}
+
+func TestMigrateScopeSpecValidateBasic(t *testing.T) {
+	addr := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	scopeID := ScopeMetadataAddress(uuid.New())
+	specID := ScopeSpecMetadataAddress(uuid.New())
+	notAScopeID := RecordMetadataAddress(uuid.New(), "recordname")
+	notASpecID := ContractSpecMetadataAddress(uuid.New())
+
+	cases := []struct {
+		name     string
+		msg      *MsgMigrateScopeSpecRequest
+		errorMsg string
+	}{
+		{
+			name:     "not a scope id",
+			msg:      NewMsgMigrateScopeSpecRequest(notAScopeID, specID, []string{addr}),
+			errorMsg: fmt.Sprintf("address is not a scope id: %s", notAScopeID),
+		},
+		{
+			name:     "not a scope spec id",
+			msg:      NewMsgMigrateScopeSpecRequest(scopeID, notASpecID, []string{addr}),
+			errorMsg: fmt.Sprintf("address is not a scope specification id: %s", notASpecID),
+		},
+		{
+			name:     "no signers",
+			msg:      NewMsgMigrateScopeSpecRequest(scopeID, specID, nil),
+			errorMsg: "at least one signer is required",
+		},
+		{
+			name: "valid",
+			msg:  NewMsgMigrateScopeSpecRequest(scopeID, specID, []string{addr}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg, "MsgMigrateScopeSpecRequest.ValidateBasic expected error")
+			} else {
+				require.NoError(t, err, "MsgMigrateScopeSpecRequest.ValidateBasic unexpected error")
+			}
+		})
+	}
+}
+
 func TestDeleteScopeOwnerValidateBasic(t *testing.T) {
 	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
 	actualScopeId := ScopeMetadataAddress(uuid.New())
@@ -752,7 +891,7 @@ func TestBindOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgBindOSLocatorRequest", sdk.MsgTypeURL(bindRequestMsg))
 
 	bz, _ := GetCdc(t).MarshalJSON(bindRequestMsg)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"keys\":[],\"current_key_id\":\"\"}}", string(bz))
 }
 
 func TestModifyOSLocator(t *testing.T) {
@@ -764,7 +903,7 @@ func TestModifyOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgModifyOSLocatorRequest", sdk.MsgTypeURL(modifyRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(modifyRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"keys\":[],\"current_key_id\":\"\"}}", string(bz))
 }
 
 func TestDeleteOSLocator(t *testing.T) {
@@ -776,7 +915,7 @@ func TestDeleteOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgDeleteOSLocatorRequest", sdk.MsgTypeURL(deleteRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(deleteRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"keys\":[],\"current_key_id\":\"\"}}", string(bz))
 }
 
 func TestBindOSLocatorInvalid(t *testing.T) {
@@ -800,6 +939,58 @@ func TestBindOSLocatorInvalidURI(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestMsgRotateOSLocatorKeyRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	encKey := sdk.AccAddress("enc_key_____________").String()
+	expiration := time.Unix(100, 0).UTC()
+
+	tests := []struct {
+		name string
+		msg  *MsgRotateOSLocatorKeyRequest
+		exp  string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgRotateOSLocatorKeyRequest(owner, "key2", encKey, nil),
+		},
+		{
+			name: "valid with previous key expiration",
+			msg:  NewMsgRotateOSLocatorKeyRequest(owner, "key2", encKey, &expiration),
+		},
+		{
+			name: "invalid owner",
+			msg:  NewMsgRotateOSLocatorKeyRequest("notanaddress", "key2", encKey, nil),
+			exp:  "invalid owner address \"notanaddress\"",
+		},
+		{
+			name: "empty key id",
+			msg:  NewMsgRotateOSLocatorKeyRequest(owner, "  ", encKey, nil),
+			exp:  "key id cannot be empty",
+		},
+		{
+			name: "key id too long",
+			msg:  NewMsgRotateOSLocatorKeyRequest(owner, strings.Repeat("k", MaxOSLocatorKeyIDLength+1), encKey, nil),
+			exp:  "exceeds maximum length of 64",
+		},
+		{
+			name: "invalid encryption key",
+			msg:  NewMsgRotateOSLocatorKeyRequest(owner, "key2", "notanaddress", nil),
+			exp:  "invalid encryption key address \"notanaddress\" for key \"key2\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.ErrorContains(t, err, tc.exp, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
 func TestMsgSetAccountDataRequest_ValidateBasic(t *testing.T) {
 	addr1 := sdk.AccAddress("addr1_______________").String()
 	addr2 := sdk.AccAddress("addr2_______________").String()
@@ -916,6 +1107,11 @@ func TestRegisterInterfaces(t *testing.T) {
 			assert.NotNil(t, msgToJSON, "message after UnmarshalJSON")
 
 			msgToAny, _ := registry.Resolve(msgTypeURL)
+			// A nil coin amount is unpacked as zero, so give any coins a non-nil amount.
+			if offer, ok := msgActual.(*MsgOfferScopeRequest); ok {
+				offer.Price = sdk.NewInt64Coin("nhash", 1)
+				msgToAny.(*MsgOfferScopeRequest).Price = sdk.NewInt64Coin("nhash", 1)
+			}
 			asAny, err := cdctypes.NewAnyWithValue(msgToAny)
 			if assert.NoError(t, err, "NewAnyWithValue") {
 				if assert.NotNil(t, asAny, "message wrapped as any") {
@@ -4,10 +4,20 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// InitialOSLocatorKeyID is the key id used for the encryption key of a locator that has never had its key rotated.
+const InitialOSLocatorKeyID = "initial"
+
+// MaxOSLocatorKeyIDLength is the maximum length of an object store locator key id.
+const MaxOSLocatorKeyIDLength = 64
+
+// MaxOSLocatorKeys is the maximum number of keys an object store locator can have.
+const MaxOSLocatorKeys = 20
+
 // NewOSLocatorRecord creates a oslocator for a given address.
 func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) ObjectStoreLocator {
 	return ObjectStoreLocator{
@@ -40,5 +50,130 @@ func (r ObjectStoreLocator) Validate() error {
 				r.Owner, r.EncryptionKey)
 		}
 	}
+
+	if len(r.Keys) == 0 {
+		if len(r.CurrentKeyId) > 0 {
+			return fmt.Errorf("current key id %q not found in locator keys", r.CurrentKeyId)
+		}
+		return nil
+	}
+	if len(r.Keys) > MaxOSLocatorKeys {
+		return fmt.Errorf("locator cannot have more than %d keys, has %d", MaxOSLocatorKeys, len(r.Keys))
+	}
+	keyIDs := make(map[string]bool, len(r.Keys))
+	for i, key := range r.Keys {
+		if err := key.Validate(); err != nil {
+			return fmt.Errorf("invalid locator key [%d]: %w", i, err)
+		}
+		if keyIDs[key.KeyId] {
+			return fmt.Errorf("duplicate locator key id %q", key.KeyId)
+		}
+		keyIDs[key.KeyId] = true
+		if i > 0 && (key.ActivationTime.Before(r.Keys[i-1].ActivationTime) || key.ActivationHeight < r.Keys[i-1].ActivationHeight) {
+			return fmt.Errorf("locator key %q cannot be activated before the key before it", key.KeyId)
+		}
+	}
+	if len(r.CurrentKeyId) > 0 {
+		current := r.GetKey(r.CurrentKeyId)
+		if current == nil {
+			return fmt.Errorf("current key id %q not found in locator keys", r.CurrentKeyId)
+		}
+		if current.EncryptionKey != r.EncryptionKey {
+			return fmt.Errorf("encryption key %s does not match current key %q encryption key %s",
+				r.EncryptionKey, r.CurrentKeyId, current.EncryptionKey)
+		}
+	}
+	return nil
+}
+
+// GetKey returns the key with the provided key id, or nil if this locator does not have it.
+// Locators that have never had their key rotated only have the InitialOSLocatorKeyID key.
+func (r ObjectStoreLocator) GetKey(keyID string) *OSLocatorKey {
+	for _, key := range r.AllKeys() {
+		if key.KeyId == keyID {
+			return &key
+		}
+	}
+	return nil
+}
+
+// AllKeys returns all the keys of this locator, in the order they were activated. If this locator has never had
+// its key rotated, its encryption key is returned as the only key (if it has one), using the InitialOSLocatorKeyID.
+func (r ObjectStoreLocator) AllKeys() []OSLocatorKey {
+	if len(r.Keys) > 0 {
+		return r.Keys
+	}
+	if len(r.EncryptionKey) == 0 {
+		return nil
+	}
+	return []OSLocatorKey{{KeyId: InitialOSLocatorKeyID, EncryptionKey: r.EncryptionKey}}
+}
+
+// KeysAt returns the key that was current at the provided time, and all keys that were valid at it.
+// The current key is nil if the most recently activated key had already expired at that time.
+func (r ObjectStoreLocator) KeysAt(t time.Time) (current *OSLocatorKey, valid []OSLocatorKey) {
+	for _, key := range r.AllKeys() {
+		if key.ActivationTime.After(t) {
+			break
+		}
+		current = nil
+		if key.IsValidAt(t) {
+			valid = append(valid, key)
+			current = &valid[len(valid)-1]
+		}
+	}
+	if current != nil {
+		// Don't hand out a pointer into the valid slice.
+		rv := *current
+		current = &rv
+	}
+	return current, valid
+}
+
+// KeysAtHeight returns the key that was current at the provided block height, and all keys that might have been valid at it.
+// The block times of past heights aren't known, so a key is only treated as expired at that height if it had expired
+// by the activation time of the latest key activated at or before that height.
+func (r ObjectStoreLocator) KeysAtHeight(height int64) (current *OSLocatorKey, valid []OSLocatorKey) {
+	var latest *OSLocatorKey
+	for _, key := range r.AllKeys() {
+		if key.ActivationHeight > height {
+			break
+		}
+		latest = &key
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	return r.KeysAt(latest.ActivationTime)
+}
+
+// Validate checks that this key has an id and a valid encryption key.
+func (k OSLocatorKey) Validate() error {
+	if len(strings.TrimSpace(k.KeyId)) == 0 {
+		return fmt.Errorf("key id cannot be empty")
+	}
+	if len(k.KeyId) > MaxOSLocatorKeyIDLength {
+		return fmt.Errorf("key id %q exceeds maximum length of %d", k.KeyId, MaxOSLocatorKeyIDLength)
+	}
+	if _, err := sdk.AccAddressFromBech32(k.EncryptionKey); err != nil {
+		return fmt.Errorf("invalid encryption key address %q for key %q: %w", k.EncryptionKey, k.KeyId, err)
+	}
+	if k.ActivationHeight < 0 {
+		return fmt.Errorf("key %q activation height %d cannot be negative", k.KeyId, k.ActivationHeight)
+	}
+	if k.ExpirationTime != nil && !k.ExpirationTime.After(k.ActivationTime) {
+		return fmt.Errorf("key %q expiration time %s must be after its activation time %s",
+			k.KeyId, k.ExpirationTime.UTC().Format(time.RFC3339), k.ActivationTime.UTC().Format(time.RFC3339))
+	}
 	return nil
 }
+
+// IsValidAt returns true if this key was activated at or before the provided time, and hasn't yet expired at it.
+func (k OSLocatorKey) IsValidAt(t time.Time) bool {
+	return !k.ActivationTime.After(t) && !k.IsExpiredAt(t)
+}
+
+// IsExpiredAt returns true if this key has an expiration time that is at or before the provided time.
+func (k OSLocatorKey) IsExpiredAt(t time.Time) bool {
+	return k.ExpirationTime != nil && !t.Before(*k.ExpirationTime)
+}
@@ -8,15 +8,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +37,15 @@ type ObjectStoreLocator struct {
 	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
 	// owners encryption key address
 	EncryptionKey string `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// keys are the encryption keys that this locator has had, in the order they were activated.
+	// These are managed by the chain using the RotateOSLocatorKey endpoint, and are ignored in other requests.
+	// A locator that has never been rotated has no keys, and its encryption_key is treated as its only key.
+	// Expired keys are removed when the key is next rotated, and at most 20 keys are kept; when a rotation would
+	// exceed that, the oldest keys are removed.
+	Keys []OSLocatorKey `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys"`
+	// current_key_id is the key_id of the key that should be used to encrypt new objects.
+	// The encryption_key field is always the encryption key of this key.
+	CurrentKeyId string `protobuf:"bytes,5,opt,name=current_key_id,json=currentKeyId,proto3" json:"current_key_id,omitempty"`
 }
 
 func (m *ObjectStoreLocator) Reset()         { *m = ObjectStoreLocator{} }
@@ -89,6 +102,102 @@ func (m *ObjectStoreLocator) GetEncryptionKey() string {
 	return ""
 }
 
+func (m *ObjectStoreLocator) GetKeys() []OSLocatorKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ObjectStoreLocator) GetCurrentKeyId() string {
+	if m != nil {
+		return m.CurrentKeyId
+	}
+	return ""
+}
+
+// OSLocatorKey is an encryption key of an object store locator, along with when it is valid.
+type OSLocatorKey struct {
+	// key_id is the owner-provided identifier of this key. It is unique among the keys of a locator.
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// encryption_key is the encryption key address.
+	EncryptionKey string `protobuf:"bytes,2,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// activation_time is the block time that this key became the current key.
+	ActivationTime time.Time `protobuf:"bytes,3,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+	// expiration_time is the time at which this key is no longer valid. If not set, it does not expire.
+	ExpirationTime *time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	// activation_height is the block height that this key became the current key.
+	ActivationHeight int64 `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *OSLocatorKey) Reset()         { *m = OSLocatorKey{} }
+func (m *OSLocatorKey) String() string { return proto.CompactTextString(m) }
+func (*OSLocatorKey) ProtoMessage()    {}
+func (*OSLocatorKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+}
+func (m *OSLocatorKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OSLocatorKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OSLocatorKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OSLocatorKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OSLocatorKey.Merge(m, src)
+}
+func (m *OSLocatorKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *OSLocatorKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_OSLocatorKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OSLocatorKey proto.InternalMessageInfo
+
+func (m *OSLocatorKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *OSLocatorKey) GetEncryptionKey() string {
+	if m != nil {
+		return m.EncryptionKey
+	}
+	return ""
+}
+
+func (m *OSLocatorKey) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
+func (m *OSLocatorKey) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+func (m *OSLocatorKey) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 // Params defines the parameters for the metadata-locator module methods.
 type OSLocatorParams struct {
 	MaxUriLength uint32 `protobuf:"varint,1,opt,name=max_uri_length,json=maxUriLength,proto3,customtype=uint32" json:"max_uri_length"`
@@ -98,7 +207,7 @@ func (m *OSLocatorParams) Reset()         { *m = OSLocatorParams{} }
 func (m *OSLocatorParams) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParams) ProtoMessage()    {}
 func (*OSLocatorParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+	return fileDescriptor_3d17fc5ccfa1c263, []int{2}
 }
 func (m *OSLocatorParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,6 +238,7 @@ var xxx_messageInfo_OSLocatorParams proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*ObjectStoreLocator)(nil), "provenance.metadata.v1.ObjectStoreLocator")
+	proto.RegisterType((*OSLocatorKey)(nil), "provenance.metadata.v1.OSLocatorKey")
 	proto.RegisterType((*OSLocatorParams)(nil), "provenance.metadata.v1.OSLocatorParams")
 }
 
@@ -137,28 +247,39 @@ func init() {
 }
 
 var fileDescriptor_3d17fc5ccfa1c263 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0x02, 0x31,
-	0x14, 0xc7, 0xef, 0x34, 0x92, 0x58, 0x05, 0x93, 0x0b, 0x51, 0x64, 0x38, 0x0c, 0x89, 0x09, 0x31,
-	0xf1, 0x1a, 0x84, 0xc9, 0x91, 0xc5, 0x41, 0x12, 0x08, 0x84, 0xc5, 0x85, 0x94, 0xb3, 0x29, 0x15,
-	0xda, 0x77, 0x69, 0x0b, 0x72, 0x8b, 0x83, 0x9f, 0xc0, 0x8f, 0xe2, 0xc7, 0x60, 0x64, 0x34, 0x0e,
-	0xc4, 0xc0, 0xe0, 0xd7, 0x30, 0xd7, 0xc3, 0x9c, 0x83, 0xdb, 0x7b, 0xff, 0xfe, 0xde, 0xff, 0xb5,
-	0xff, 0xa2, 0x5a, 0xa4, 0x60, 0x4e, 0x25, 0x91, 0x21, 0xc5, 0x82, 0x1a, 0xf2, 0x48, 0x0c, 0xc1,
-	0xf3, 0x3a, 0x86, 0xd1, 0x13, 0x0d, 0x8d, 0x36, 0xa0, 0x68, 0x10, 0x29, 0x30, 0xe0, 0x9d, 0x66,
-	0x64, 0xf0, 0x4b, 0x06, 0xf3, 0x7a, 0xf9, 0x2c, 0x04, 0x2d, 0x40, 0x63, 0xa1, 0x59, 0x32, 0x28,
-	0x34, 0x4b, 0x07, 0xca, 0x45, 0x06, 0x0c, 0x6c, 0x89, 0x93, 0x2a, 0x55, 0xab, 0x2f, 0xc8, 0xeb,
-	0x58, 0xef, 0x7e, 0xe2, 0xdd, 0x86, 0x90, 0x18, 0x50, 0x5e, 0x11, 0x1d, 0xc0, 0xb3, 0xa4, 0xaa,
-	0xe4, 0x5e, 0xb8, 0xb5, 0xc3, 0x5e, 0xda, 0x78, 0x15, 0x74, 0x34, 0x4d, 0x81, 0xe1, 0x4c, 0xf1,
-	0xd2, 0x9e, 0x3d, 0x43, 0x3b, 0x69, 0xa0, 0xb8, 0x77, 0x89, 0x0a, 0x54, 0x86, 0x2a, 0x8e, 0x0c,
-	0x07, 0x39, 0x9c, 0xd0, 0xb8, 0xb4, 0x6f, 0x99, 0x7c, 0xa6, 0xde, 0xd3, 0xf8, 0x16, 0xbd, 0x7e,
-	0xbf, 0x5f, 0xa5, 0x9e, 0xd5, 0x3b, 0x74, 0xd2, 0xe9, 0xef, 0xd6, 0x76, 0x89, 0x22, 0x42, 0x7b,
-	0x4d, 0x54, 0x10, 0x64, 0x91, 0xac, 0x18, 0x4e, 0xa9, 0x64, 0x66, 0x6c, 0x6f, 0x91, 0x6f, 0x15,
-	0x96, 0xeb, 0x8a, 0xf3, 0xb9, 0xae, 0xe4, 0x66, 0x5c, 0x9a, 0xc6, 0x4d, 0xef, 0x58, 0x90, 0xc5,
-	0x40, 0xf1, 0xb6, 0x65, 0x5a, 0x93, 0xe5, 0xc6, 0x77, 0x57, 0x1b, 0xdf, 0xfd, 0xda, 0xf8, 0xee,
-	0xdb, 0xd6, 0x77, 0x56, 0x5b, 0xdf, 0xf9, 0xd8, 0xfa, 0x0e, 0x3a, 0xe7, 0xf6, 0xb1, 0xff, 0x84,
-	0xd5, 0x75, 0x1f, 0x9a, 0x8c, 0x9b, 0xf1, 0x6c, 0x14, 0x84, 0x20, 0x70, 0x06, 0x5d, 0x73, 0xf8,
-	0xd3, 0xe1, 0x45, 0xf6, 0x17, 0x26, 0x8e, 0xa8, 0x1e, 0xe5, 0x6c, 0x78, 0x8d, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x5f, 0x78, 0x72, 0x38, 0xaf, 0x01, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x93, 0x34, 0x82, 0x4d, 0x9a, 0xc0, 0xaa, 0x80, 0xc9, 0xc1, 0xae, 0xa2, 0x22, 0x45,
+	0x20, 0x6c, 0x35, 0xed, 0x89, 0x03, 0x87, 0x5c, 0xa0, 0x4a, 0x51, 0x2b, 0x97, 0x5e, 0xb8, 0x44,
+	0x1b, 0x67, 0x70, 0x96, 0x64, 0xbd, 0xd6, 0x7a, 0x1d, 0xe2, 0x2b, 0x5f, 0x50, 0x89, 0x1f, 0xe1,
+	0x33, 0x7a, 0xec, 0x11, 0x71, 0x28, 0x28, 0x41, 0xe2, 0x37, 0xd0, 0xae, 0x1d, 0x9c, 0x43, 0x50,
+	0x6f, 0x9e, 0x99, 0xf7, 0xde, 0xcc, 0x7b, 0x2b, 0xa3, 0x6e, 0x24, 0xf8, 0x1c, 0x42, 0x12, 0xfa,
+	0xe0, 0x32, 0x90, 0x64, 0x4c, 0x24, 0x71, 0xe7, 0x87, 0x2e, 0x1f, 0x7d, 0x02, 0x5f, 0xc6, 0x92,
+	0x0b, 0x70, 0x22, 0xc1, 0x25, 0xc7, 0x8f, 0x0b, 0xa4, 0xb3, 0x46, 0x3a, 0xf3, 0xc3, 0xf6, 0x13,
+	0x9f, 0xc7, 0x8c, 0xc7, 0x2e, 0x8b, 0x03, 0x45, 0x64, 0x71, 0x90, 0x11, 0xda, 0x7b, 0x01, 0x0f,
+	0xb8, 0xfe, 0x74, 0xd5, 0x57, 0xde, 0xb5, 0x03, 0xce, 0x83, 0x19, 0xb8, 0xba, 0x1a, 0x25, 0x1f,
+	0x5d, 0x49, 0x19, 0xc4, 0x92, 0xb0, 0x28, 0x03, 0x74, 0x7e, 0x1b, 0x08, 0x9f, 0xe9, 0xed, 0x17,
+	0x6a, 0xfb, 0x29, 0xf7, 0x89, 0xe4, 0x02, 0xef, 0xa1, 0x1d, 0xfe, 0x39, 0x04, 0x61, 0x1a, 0xfb,
+	0x46, 0xf7, 0xbe, 0x97, 0x15, 0xd8, 0x46, 0xf5, 0x59, 0x06, 0x18, 0x26, 0x82, 0x9a, 0x65, 0x3d,
+	0x43, 0x79, 0xeb, 0x52, 0x50, 0xfc, 0x0c, 0x35, 0x21, 0xf4, 0x45, 0x1a, 0x49, 0xca, 0xc3, 0xe1,
+	0x14, 0x52, 0xb3, 0xa2, 0x31, 0xbb, 0x45, 0x77, 0x00, 0x29, 0x7e, 0x8d, 0xaa, 0x53, 0x48, 0x63,
+	0xb3, 0xba, 0x5f, 0xe9, 0xd6, 0x7b, 0x07, 0xce, 0x76, 0xaf, 0xce, 0xd9, 0x45, 0x7e, 0xce, 0x00,
+	0xd2, 0x7e, 0xf5, 0xfa, 0xd6, 0x2e, 0x79, 0x9a, 0x87, 0x0f, 0x50, 0xd3, 0x4f, 0x84, 0x80, 0x50,
+	0xaa, 0x1d, 0x43, 0x3a, 0x36, 0x77, 0xf4, 0x9a, 0x46, 0xde, 0x1d, 0x40, 0x7a, 0x32, 0x7e, 0x85,
+	0xbe, 0xfc, 0xf9, 0xf6, 0x3c, 0xbb, 0xbc, 0xf3, 0xb5, 0x8c, 0x1a, 0x9b, 0x72, 0xf8, 0x11, 0xaa,
+	0xe5, 0xd4, 0xdc, 0xe1, 0x54, 0x71, 0xb6, 0x18, 0x28, 0x6f, 0x33, 0xf0, 0x0e, 0xb5, 0x88, 0x2f,
+	0xe9, 0x9c, 0x68, 0x98, 0xca, 0x54, 0x1b, 0xad, 0xf7, 0xda, 0x4e, 0x16, 0xb8, 0xb3, 0x0e, 0xdc,
+	0x79, 0xbf, 0x0e, 0xbc, 0x7f, 0x4f, 0x39, 0xb8, 0xfa, 0x69, 0x1b, 0x5e, 0xb3, 0x20, 0xab, 0x31,
+	0x3e, 0x41, 0x2d, 0x58, 0x44, 0x54, 0x6c, 0xc8, 0x55, 0xef, 0x94, 0xab, 0x66, 0x52, 0x05, 0x51,
+	0x4b, 0xbd, 0x40, 0x0f, 0x37, 0x2e, 0x9b, 0x00, 0x0d, 0x26, 0x52, 0xa7, 0x53, 0xf1, 0x1e, 0x14,
+	0x83, 0xb7, 0xba, 0xdf, 0x79, 0x83, 0x5a, 0xff, 0x42, 0x39, 0x27, 0x82, 0xb0, 0x18, 0x1f, 0xa3,
+	0x26, 0x23, 0x0b, 0xf5, 0xbc, 0xc3, 0x19, 0x84, 0x81, 0x9c, 0xe8, 0x7c, 0x76, 0xfb, 0x4d, 0x75,
+	0xfc, 0x8f, 0x5b, 0xbb, 0x96, 0xd0, 0x50, 0x1e, 0xf5, 0xbc, 0x06, 0x23, 0x8b, 0x4b, 0x41, 0x4f,
+	0x35, 0xa6, 0x3f, 0xbd, 0x5e, 0x5a, 0xc6, 0xcd, 0xd2, 0x32, 0x7e, 0x2d, 0x2d, 0xe3, 0x6a, 0x65,
+	0x95, 0x6e, 0x56, 0x56, 0xe9, 0xfb, 0xca, 0x2a, 0xa1, 0xa7, 0x94, 0xff, 0xe7, 0x79, 0xcf, 0x8d,
+	0x0f, 0xc7, 0x01, 0x95, 0x93, 0x64, 0xe4, 0xf8, 0x9c, 0xb9, 0x05, 0xe8, 0x25, 0xe5, 0x1b, 0x95,
+	0xbb, 0x28, 0xfe, 0x14, 0x99, 0x46, 0x10, 0x8f, 0x6a, 0x3a, 0x8c, 0xa3, 0xbf, 0x03, 0x00, 0x10,
+	0xa9, 0xc0, 0xd6, 0x4d, 0x03, 0x00, 0x00,
 }
 
 func (m *ObjectStoreLocator) Marshal() (dAtA []byte, err error) {
@@ -181,6 +302,27 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CurrentKeyId) > 0 {
+		i -= len(m.CurrentKeyId)
+		copy(dAtA[i:], m.CurrentKeyId)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.CurrentKeyId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintObjectstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.EncryptionKey) > 0 {
 		i -= len(m.EncryptionKey)
 		copy(dAtA[i:], m.EncryptionKey)
@@ -205,6 +347,66 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OSLocatorKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OSLocatorKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OSLocatorKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintObjectstore(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintObjectstore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EncryptionKey) > 0 {
+		i -= len(m.EncryptionKey)
+		copy(dAtA[i:], m.EncryptionKey)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.EncryptionKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OSLocatorParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +464,42 @@ func (m *ObjectStoreLocator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovObjectstore(uint64(l))
 	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovObjectstore(uint64(l))
+		}
+	}
+	l = len(m.CurrentKeyId)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	return n
+}
+
+func (m *OSLocatorKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	l = len(m.EncryptionKey)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovObjectstore(uint64(l))
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovObjectstore(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -408,6 +646,274 @@ func (m *ObjectStoreLocator) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, OSLocatorKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSLocatorKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSLocatorKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSLocatorKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
@@ -0,0 +1,302 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// keyTime returns the time that is the provided number of seconds after the unix epoch.
+func keyTime(sec int64) time.Time {
+	return time.Unix(sec, 0).UTC()
+}
+
+// keyTimeP returns a pointer to the time that is the provided number of seconds after the unix epoch.
+func keyTimeP(sec int64) *time.Time {
+	rv := keyTime(sec)
+	return &rv
+}
+
+func TestObjectStoreLocatorValidateKeys(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	key1 := sdk.AccAddress("key1________________").String()
+	key2 := sdk.AccAddress("key2________________").String()
+
+	locator := func(current string, encKey string, keys ...OSLocatorKey) ObjectStoreLocator {
+		return ObjectStoreLocator{
+			Owner:         owner,
+			LocatorUri:    "http://foo.com",
+			EncryptionKey: encKey,
+			Keys:          keys,
+			CurrentKeyId:  current,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		locator ObjectStoreLocator
+		exp     string
+	}{
+		{
+			name:    "no keys",
+			locator: locator("", key1),
+		},
+		{
+			name:    "no keys with current key id",
+			locator: locator("k1", key1),
+			exp:     "current key id \"k1\" not found in locator keys",
+		},
+		{
+			name: "two keys",
+			locator: locator("k2", key2,
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationTime: keyTime(1), ExpirationTime: keyTimeP(10)},
+				OSLocatorKey{KeyId: "k2", EncryptionKey: key2, ActivationTime: keyTime(5)},
+			),
+		},
+		{
+			name: "invalid key",
+			locator: locator("k1", key1,
+				OSLocatorKey{KeyId: "", EncryptionKey: key1},
+			),
+			exp: "invalid locator key [0]: key id cannot be empty",
+		},
+		{
+			name: "duplicate key id",
+			locator: locator("k1", key2,
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationTime: keyTime(1)},
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key2, ActivationTime: keyTime(5)},
+			),
+			exp: "duplicate locator key id \"k1\"",
+		},
+		{
+			name: "keys out of order",
+			locator: locator("k2", key2,
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationTime: keyTime(5)},
+				OSLocatorKey{KeyId: "k2", EncryptionKey: key2, ActivationTime: keyTime(1)},
+			),
+			exp: "locator key \"k2\" cannot be activated before the key before it",
+		},
+		{
+			name: "key heights out of order",
+			locator: locator("k2", key2,
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationTime: keyTime(1), ActivationHeight: 5},
+				OSLocatorKey{KeyId: "k2", EncryptionKey: key2, ActivationTime: keyTime(5), ActivationHeight: 4},
+			),
+			exp: "locator key \"k2\" cannot be activated before the key before it",
+		},
+		{
+			name: "negative activation height",
+			locator: locator("k1", key1,
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationTime: keyTime(1), ActivationHeight: -1},
+			),
+			exp: "invalid locator key [0]: key \"k1\" activation height -1 cannot be negative",
+		},
+		{
+			name: "unknown current key id",
+			locator: locator("k3", key2,
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationTime: keyTime(1)},
+			),
+			exp: "current key id \"k3\" not found in locator keys",
+		},
+		{
+			name: "encryption key not current key",
+			locator: locator("k1", key2,
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationTime: keyTime(1)},
+			),
+			exp: "does not match current key \"k1\" encryption key",
+		},
+		{
+			name: "too many keys",
+			locator: func() ObjectStoreLocator {
+				keys := make([]OSLocatorKey, MaxOSLocatorKeys+1)
+				for i := range keys {
+					keys[i] = OSLocatorKey{KeyId: fmt.Sprintf("k%d", i+1), EncryptionKey: key1, ActivationTime: keyTime(int64(i))}
+				}
+				return locator(keys[len(keys)-1].KeyId, key1, keys...)
+			}(),
+			exp: fmt.Sprintf("locator cannot have more than %d keys, has %d", MaxOSLocatorKeys, MaxOSLocatorKeys+1),
+		},
+		{
+			name: "expiration before activation",
+			locator: locator("k1", key1,
+				OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationTime: keyTime(5), ExpirationTime: keyTimeP(5)},
+			),
+			exp: "key \"k1\" expiration time 1970-01-01T00:00:05Z must be after its activation time 1970-01-01T00:00:05Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.locator.Validate()
+			if len(tc.exp) > 0 {
+				require.ErrorContains(t, err, tc.exp, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestObjectStoreLocatorKeysAt(t *testing.T) {
+	key1 := sdk.AccAddress("key1________________").String()
+	key2 := sdk.AccAddress("key2________________").String()
+	key3 := sdk.AccAddress("key3________________").String()
+
+	k1 := OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ExpirationTime: keyTimeP(15)}
+	k2 := OSLocatorKey{KeyId: "k2", EncryptionKey: key2, ActivationTime: keyTime(10), ExpirationTime: keyTimeP(30)}
+	k3 := OSLocatorKey{KeyId: "k3", EncryptionKey: key3, ActivationTime: keyTime(20)}
+	rotated := ObjectStoreLocator{EncryptionKey: key3, Keys: []OSLocatorKey{k1, k2, k3}, CurrentKeyId: "k3"}
+	initial := OSLocatorKey{KeyId: InitialOSLocatorKeyID, EncryptionKey: key1}
+
+	tests := []struct {
+		name       string
+		locator    ObjectStoreLocator
+		at         time.Time
+		expCurrent *OSLocatorKey
+		expValid   []OSLocatorKey
+	}{
+		{
+			name:    "no encryption key",
+			locator: ObjectStoreLocator{},
+			at:      keyTime(5),
+		},
+		{
+			name:       "never rotated",
+			locator:    ObjectStoreLocator{EncryptionKey: key1},
+			at:         keyTime(5),
+			expCurrent: &initial,
+			expValid:   []OSLocatorKey{initial},
+		},
+		{
+			name:       "only first key active",
+			locator:    rotated,
+			at:         keyTime(5),
+			expCurrent: &k1,
+			expValid:   []OSLocatorKey{k1},
+		},
+		{
+			name:       "second key activated",
+			locator:    rotated,
+			at:         keyTime(10),
+			expCurrent: &k2,
+			expValid:   []OSLocatorKey{k1, k2},
+		},
+		{
+			name:       "first key expired",
+			locator:    rotated,
+			at:         keyTime(15),
+			expCurrent: &k2,
+			expValid:   []OSLocatorKey{k2},
+		},
+		{
+			name:       "third key activated",
+			locator:    rotated,
+			at:         keyTime(25),
+			expCurrent: &k3,
+			expValid:   []OSLocatorKey{k2, k3},
+		},
+		{
+			name:       "second key expired",
+			locator:    rotated,
+			at:         keyTime(30),
+			expCurrent: &k3,
+			expValid:   []OSLocatorKey{k3},
+		},
+		{
+			name: "current key expired",
+			locator: ObjectStoreLocator{
+				EncryptionKey: key1,
+				Keys:          []OSLocatorKey{k1},
+				CurrentKeyId:  "k1",
+			},
+			at: keyTime(20),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			current, valid := tc.locator.KeysAt(tc.at)
+			assert.Equal(t, tc.expCurrent, current, "current key")
+			assert.Equal(t, tc.expValid, valid, "valid keys")
+		})
+	}
+}
+
+func TestObjectStoreLocatorKeysAtHeight(t *testing.T) {
+	key1 := sdk.AccAddress("key1________________").String()
+	key2 := sdk.AccAddress("key2________________").String()
+	key3 := sdk.AccAddress("key3________________").String()
+
+	k1 := OSLocatorKey{KeyId: "k1", EncryptionKey: key1, ActivationHeight: 1, ExpirationTime: keyTimeP(15)}
+	k2 := OSLocatorKey{KeyId: "k2", EncryptionKey: key2, ActivationTime: keyTime(10), ActivationHeight: 100, ExpirationTime: keyTimeP(30)}
+	k3 := OSLocatorKey{KeyId: "k3", EncryptionKey: key3, ActivationTime: keyTime(20), ActivationHeight: 200}
+	rotated := ObjectStoreLocator{EncryptionKey: key3, Keys: []OSLocatorKey{k1, k2, k3}, CurrentKeyId: "k3"}
+	initial := OSLocatorKey{KeyId: InitialOSLocatorKeyID, EncryptionKey: key1}
+
+	tests := []struct {
+		name       string
+		locator    ObjectStoreLocator
+		height     int64
+		expCurrent *OSLocatorKey
+		expValid   []OSLocatorKey
+	}{
+		{
+			name:    "no encryption key",
+			locator: ObjectStoreLocator{},
+			height:  5,
+		},
+		{
+			name:       "never rotated",
+			locator:    ObjectStoreLocator{EncryptionKey: key1},
+			height:     5,
+			expCurrent: &initial,
+			expValid:   []OSLocatorKey{initial},
+		},
+		{
+			name:    "before first key",
+			locator: rotated,
+			height:  0,
+		},
+		{
+			name:       "only first key active",
+			locator:    rotated,
+			height:     50,
+			expCurrent: &k1,
+			expValid:   []OSLocatorKey{k1},
+		},
+		{
+			name:       "second key activated",
+			locator:    rotated,
+			height:     100,
+			expCurrent: &k2,
+			expValid:   []OSLocatorKey{k1, k2},
+		},
+		{
+			name:       "first key might have expired",
+			locator:    rotated,
+			height:     199,
+			expCurrent: &k2,
+			expValid:   []OSLocatorKey{k1, k2},
+		},
+		{
+			name:       "third key activated after first key expired",
+			locator:    rotated,
+			height:     200,
+			expCurrent: &k3,
+			expValid:   []OSLocatorKey{k2, k3},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			current, valid := tc.locator.KeysAtHeight(tc.height)
+			assert.Equal(t, tc.expCurrent, current, "current key")
+			assert.Equal(t, tc.expValid, valid, "valid keys")
+		})
+	}
+}
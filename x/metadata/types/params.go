@@ -1,11 +1,35 @@
 package types
 
+import "fmt"
+
+const (
+	// DefaultMaxHistoryVersions is the default number of prior versions of each record and session to retain.
+	DefaultMaxHistoryVersions = 10
+	// MaxMaxHistoryVersions is the largest allowed value for max_history_versions.
+	MaxMaxHistoryVersions = 1000
+	// DefaultMaxNavHistoryEntries is the default number of net asset value history entries to retain for each scope and price denom.
+	DefaultMaxNavHistoryEntries = 100
+	// MaxMaxNavHistoryEntries is the largest allowed value for max_nav_history_entries.
+	MaxMaxNavHistoryEntries = 10000
+)
+
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(maxHistoryVersions, maxNavHistoryEntries uint32) Params {
+	return Params{MaxHistoryVersions: maxHistoryVersions, MaxNavHistoryEntries: maxNavHistoryEntries}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxHistoryVersions, DefaultMaxNavHistoryEntries)
+}
+
+// Validate returns an error if these params are invalid.
+func (p Params) Validate() error {
+	if p.MaxHistoryVersions > MaxMaxHistoryVersions {
+		return fmt.Errorf("max history versions %d cannot be greater than %d", p.MaxHistoryVersions, MaxMaxHistoryVersions)
+	}
+	if p.MaxNavHistoryEntries > MaxMaxNavHistoryEntries {
+		return fmt.Errorf("max nav history entries %d cannot be greater than %d", p.MaxNavHistoryEntries, MaxMaxNavHistoryEntries)
+	}
+	return nil
 }
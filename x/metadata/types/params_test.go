@@ -0,0 +1,21 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, DefaultMaxHistoryVersions, int(params.MaxHistoryVersions))
+	require.NoError(t, params.Validate())
+}
+
+func TestParamsValidate(t *testing.T) {
+	assert.NoError(t, NewParams(0, 0).Validate(), "zero")
+	assert.NoError(t, NewParams(MaxMaxHistoryVersions, MaxMaxNavHistoryEntries).Validate(), "max")
+	assert.EqualError(t, NewParams(MaxMaxHistoryVersions+1, 0).Validate(), "max history versions 1001 cannot be greater than 1000", "too large")
+	assert.EqualError(t, NewParams(0, MaxMaxNavHistoryEntries+1).Validate(), "max nav history entries 10001 cannot be greater than 10000", "too many nav history entries")
+}
@@ -0,0 +1,629 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	// maxJSONSchemaDepth is the maximum number of nested schemas followed when validating a value against a JSON schema.
+	maxJSONSchemaDepth = 100
+	// maxJSONSchemaChecks is the maximum number of times a value (or part of it) is checked against a schema
+	// when validating it against a JSON schema.
+	maxJSONSchemaChecks = 10000
+	// jsonNumberPrecision is the precision used when comparing numbers in JSON values.
+	jsonNumberPrecision = 256
+)
+
+// CompiledRecordTypeSchema is a parsed RecordTypeSchema that record values can be validated against.
+type CompiledRecordTypeSchema struct {
+	// typeNames are the names of all the types defined in the schema.
+	typeNames map[string]bool
+	// protoFiles are the files of a proto descriptor set schema.
+	protoFiles *protoregistry.Files
+	// jsonRoot is the root document of a JSON schema.
+	jsonRoot map[string]interface{}
+	// jsonTypes are the schemas of each type defined in a JSON schema.
+	jsonTypes map[string]interface{}
+}
+
+// Compile parses this schema so that record values can be validated against it.
+//
+// A proto descriptor set must contain all of the files needed to resolve its messages. Each message is a type.
+// In a JSON schema, the title names the root schema, and each entry in $defs (or definitions) is also a type.
+// Only local references (to the root, $defs or definitions) are allowed in a JSON schema.
+func (s RecordTypeSchema) Compile() (*CompiledRecordTypeSchema, error) {
+	switch s.Format {
+	case RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET:
+		return compileProtoTypeSchema(s.Definition)
+	case RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA:
+		return compileJSONTypeSchema(s.Definition)
+	default:
+		return nil, fmt.Errorf("unknown format %s", s.Format)
+	}
+}
+
+// compileProtoTypeSchema parses a serialized google.protobuf.FileDescriptorSet.
+func compileProtoTypeSchema(definition []byte) (*CompiledRecordTypeSchema, error) {
+	var fds descriptorpb.FileDescriptorSet
+	if err := protov2.Unmarshal(definition, &fds); err != nil {
+		return nil, fmt.Errorf("could not parse proto descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(&fds)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve proto descriptor set: %w", err)
+	}
+	rv := &CompiledRecordTypeSchema{typeNames: make(map[string]bool), protoFiles: files}
+	for _, file := range fds.File {
+		addProtoMessageNames(rv.typeNames, file.GetPackage(), file.MessageType)
+	}
+	return rv, nil
+}
+
+// addProtoMessageNames adds the full names of the provided messages (and their nested messages) to the names map.
+func addProtoMessageNames(names map[string]bool, prefix string, messages []*descriptorpb.DescriptorProto) {
+	for _, msg := range messages {
+		name := msg.GetName()
+		if len(prefix) > 0 {
+			name = prefix + "." + name
+		}
+		names[name] = true
+		addProtoMessageNames(names, name, msg.NestedType)
+	}
+}
+
+// compileJSONTypeSchema parses a JSON schema document.
+func compileJSONTypeSchema(definition []byte) (*CompiledRecordTypeSchema, error) {
+	root, err := decodeJSON(definition)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse json schema: %w", err)
+	}
+	doc, ok := root.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("could not parse json schema: the document must be an object")
+	}
+
+	rv := &CompiledRecordTypeSchema{
+		typeNames: make(map[string]bool),
+		jsonRoot:  doc,
+		jsonTypes: make(map[string]interface{}),
+	}
+	if title, isStr := doc["title"].(string); isStr && len(title) > 0 {
+		rv.jsonTypes[title] = doc
+	}
+	for _, key := range []string{"$defs", "definitions"} {
+		defs, isObj := doc[key].(map[string]interface{})
+		if !isObj {
+			continue
+		}
+		for name, def := range defs {
+			rv.jsonTypes[name] = def
+		}
+	}
+	for name := range rv.jsonTypes {
+		rv.typeNames[name] = true
+	}
+
+	if err = rv.checkJSONRefs(doc); err != nil {
+		return nil, err
+	}
+	if err = rv.checkJSONRefCycles(doc, make(map[uintptr]bool), make(map[uintptr]bool)); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// TypeNames returns the names of all the types defined in this schema.
+func (c *CompiledRecordTypeSchema) TypeNames() map[string]bool {
+	rv := make(map[string]bool, len(c.typeNames))
+	for name := range c.typeNames {
+		rv[name] = true
+	}
+	return rv
+}
+
+// HasType returns true if this schema defines a type with the provided name.
+func (c *CompiledRecordTypeSchema) HasType(typeName string) bool {
+	return c.typeNames[typeName]
+}
+
+// ValidateValue returns an error if the provided value is not a value of the named type.
+//
+// For a proto descriptor set, the value must be the base64 encoding of the serialized message, without unknown fields.
+// For a JSON schema, the value must be a JSON document that conforms to the type's schema.
+func (c *CompiledRecordTypeSchema) ValidateValue(typeName, value string) error {
+	if !c.typeNames[typeName] {
+		return fmt.Errorf("type %q is not defined in the schema", typeName)
+	}
+	if c.protoFiles != nil {
+		return c.validateProtoValue(typeName, value)
+	}
+	return c.validateJSONValue(typeName, value)
+}
+
+// validateProtoValue returns an error if the provided value is not a base64 encoded message of the named type.
+func (c *CompiledRecordTypeSchema) validateProtoValue(typeName, value string) error {
+	desc, err := c.protoFiles.FindDescriptorByName(protoreflect.FullName(typeName))
+	if err != nil {
+		return fmt.Errorf("type %q not found: %w", typeName, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return fmt.Errorf("type %q is not a message", typeName)
+	}
+	bz, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return fmt.Errorf("value is not base64 encoded: %w", err)
+	}
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err = protov2.Unmarshal(bz, msg); err != nil {
+		return fmt.Errorf("could not unmarshal value: %w", err)
+	}
+	if hasUnknownFields(msg) {
+		return errors.New("value has unknown fields")
+	}
+	return nil
+}
+
+// hasUnknownFields returns true if the provided message, or any message in it, has unknown fields.
+func hasUnknownFields(msg protoreflect.Message) bool {
+	if len(msg.GetUnknown()) > 0 {
+		return true
+	}
+	found := false
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					found = hasUnknownFields(mv.Message())
+					return !found
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := v.List()
+				for i := 0; i < list.Len() && !found; i++ {
+					found = hasUnknownFields(list.Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			found = hasUnknownFields(v.Message())
+		}
+		return !found
+	})
+	return found
+}
+
+// validateJSONValue returns an error if the provided value is not a JSON document that conforms to the named type.
+func (c *CompiledRecordTypeSchema) validateJSONValue(typeName, value string) error {
+	doc, err := decodeJSON([]byte(value))
+	if err != nil {
+		return fmt.Errorf("value is not json: %w", err)
+	}
+	checks := 0
+	err = c.validateJSON(c.jsonTypes[typeName], doc, "$", 0, &checks)
+	// Once the limit is reached, every check fails, but anyOf and oneOf hide those errors, so this is checked separately.
+	if checks > maxJSONSchemaChecks {
+		return fmt.Errorf("validation exceeds the maximum of %d schema checks", maxJSONSchemaChecks)
+	}
+	return err
+}
+
+// decodeJSON decodes a single JSON value, keeping numbers as json.Number.
+func decodeJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var rv interface{}
+	if err := dec.Decode(&rv); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the json value")
+	}
+	return rv, nil
+}
+
+// checkJSONRefs returns an error if any $ref in the provided schema cannot be resolved.
+func (c *CompiledRecordTypeSchema) checkJSONRefs(schema interface{}) error {
+	switch sch := schema.(type) {
+	case map[string]interface{}:
+		if ref, ok := sch["$ref"].(string); ok {
+			if _, err := c.resolveJSONRef(ref); err != nil {
+				return err
+			}
+		}
+		for _, key := range sortedKeys(sch) {
+			if err := c.checkJSONRefs(sch[key]); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, entry := range sch {
+			if err := c.checkJSONRefs(entry); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkJSONRefCycles returns an error if any schema in the provided document can get back to itself through
+// $ref, allOf, anyOf and oneOf alone. Those all apply to the same value, so validating against it would never end.
+// A $ref under properties, additionalProperties or items is fine, since it applies to a part of the value.
+func (c *CompiledRecordTypeSchema) checkJSONRefCycles(schema interface{}, visiting, done map[uintptr]bool) error {
+	switch sch := schema.(type) {
+	case map[string]interface{}:
+		if err := c.followJSONApplicators(sch, visiting, done); err != nil {
+			return err
+		}
+		for _, key := range sortedKeys(sch) {
+			if err := c.checkJSONRefCycles(sch[key], visiting, done); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, entry := range sch {
+			if err := c.checkJSONRefCycles(entry, visiting, done); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// followJSONApplicators returns an error if the provided schema is reached again by following its $ref, allOf, anyOf
+// and oneOf, and those of the schemas they lead to.
+// The schemas are identified by their map pointers, since the same schema can be reached from more than one place.
+func (c *CompiledRecordTypeSchema) followJSONApplicators(sch map[string]interface{}, visiting, done map[uintptr]bool) error {
+	id := reflect.ValueOf(sch).Pointer()
+	if done[id] {
+		return nil
+	}
+	if visiting[id] {
+		return errors.New("schema refers back to itself through $ref, allOf, anyOf or oneOf without applying to a part of the value")
+	}
+	visiting[id] = true
+
+	var next []interface{}
+	if ref, ok := sch["$ref"].(string); ok {
+		target, err := c.resolveJSONRef(ref)
+		if err != nil {
+			return err
+		}
+		next = append(next, target)
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+		if subs, ok := sch[key].([]interface{}); ok {
+			next = append(next, subs...)
+		}
+	}
+	for _, sub := range next {
+		if subSch, ok := sub.(map[string]interface{}); ok {
+			if err := c.followJSONApplicators(subSch, visiting, done); err != nil {
+				return err
+			}
+		}
+	}
+
+	delete(visiting, id)
+	done[id] = true
+	return nil
+}
+
+// resolveJSONRef returns the schema that the provided $ref refers to.
+func (c *CompiledRecordTypeSchema) resolveJSONRef(ref string) (interface{}, error) {
+	if ref == "#" {
+		return c.jsonRoot, nil
+	}
+	for _, key := range []string{"$defs", "definitions"} {
+		name, ok := strings.CutPrefix(ref, "#/"+key+"/")
+		if !ok {
+			continue
+		}
+		name = strings.NewReplacer("~1", "/", "~0", "~").Replace(name)
+		defs, _ := c.jsonRoot[key].(map[string]interface{})
+		if def, found := defs[name]; found {
+			return def, nil
+		}
+		return nil, fmt.Errorf("$ref %q not found", ref)
+	}
+	return nil, fmt.Errorf("unsupported $ref %q: only references to the root, $defs and definitions are allowed", ref)
+}
+
+// validateJSON returns an error if the provided value does not conform to the provided schema.
+// The following keywords are applied: $ref, type, enum, const, properties, required, additionalProperties,
+// items, minItems, maxItems, minLength, maxLength, minimum, maximum, allOf, anyOf and oneOf.
+// All other keywords are ignored.
+// The checks count is incremented each time this is called, and it fails once that count exceeds maxJSONSchemaChecks.
+func (c *CompiledRecordTypeSchema) validateJSON(schema, value interface{}, path string, depth int, checks *int) error {
+	*checks++
+	if *checks > maxJSONSchemaChecks {
+		return fmt.Errorf("%s: validation exceeds the maximum of %d schema checks", path, maxJSONSchemaChecks)
+	}
+	if depth > maxJSONSchemaDepth {
+		return fmt.Errorf("%s: schema nesting exceeds the maximum depth of %d", path, maxJSONSchemaDepth)
+	}
+	var sch map[string]interface{}
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			return fmt.Errorf("%s: value is not allowed", path)
+		}
+		return nil
+	case map[string]interface{}:
+		sch = s
+	default:
+		return nil
+	}
+
+	if ref, ok := sch["$ref"].(string); ok {
+		target, err := c.resolveJSONRef(ref)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if err = c.validateJSON(target, value, path, depth+1, checks); err != nil {
+			return err
+		}
+	}
+
+	if expType, ok := sch["type"]; ok {
+		if err := checkJSONType(expType, value, path); err != nil {
+			return err
+		}
+	}
+	if enum, ok := sch["enum"].([]interface{}); ok {
+		found := false
+		for _, entry := range enum {
+			if jsonEqual(entry, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+	if constVal, ok := sch["const"]; ok && !jsonEqual(constVal, value) {
+		return fmt.Errorf("%s: value does not equal the required constant", path)
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if required, ok := sch["required"].([]interface{}); ok {
+			for _, entry := range required {
+				name, _ := entry.(string)
+				if _, has := v[name]; !has {
+					return fmt.Errorf("%s: missing required property %q", path, name)
+				}
+			}
+		}
+		props, _ := sch["properties"].(map[string]interface{})
+		addlProps, hasAddlProps := sch["additionalProperties"]
+		for _, key := range sortedKeys(v) {
+			propPath := path + "." + key
+			if propSchema, isProp := props[key]; isProp {
+				if err := c.validateJSON(propSchema, v[key], propPath, depth+1, checks); err != nil {
+					return err
+				}
+				continue
+			}
+			if hasAddlProps {
+				if err := c.validateJSON(addlProps, v[key], propPath, depth+1, checks); err != nil {
+					return err
+				}
+			}
+		}
+	case []interface{}:
+		if err := checkJSONBounds(sch, "minItems", "maxItems", len(v), path, "items"); err != nil {
+			return err
+		}
+		if items, ok := sch["items"]; ok {
+			for i, entry := range v {
+				if err := c.validateJSON(items, entry, fmt.Sprintf("%s[%d]", path, i), depth+1, checks); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		if err := checkJSONBounds(sch, "minLength", "maxLength", utf8.RuneCountInString(v), path, "characters"); err != nil {
+			return err
+		}
+	case json.Number:
+		num, _ := jsonNumber(v)
+		if limit, ok := jsonNumber(sch["minimum"]); ok && num.Cmp(limit) < 0 {
+			return fmt.Errorf("%s: %s is less than the minimum %s", path, v, sch["minimum"])
+		}
+		if limit, ok := jsonNumber(sch["maximum"]); ok && num.Cmp(limit) > 0 {
+			return fmt.Errorf("%s: %s is greater than the maximum %s", path, v, sch["maximum"])
+		}
+	}
+
+	if allOf, ok := sch["allOf"].([]interface{}); ok {
+		for _, sub := range allOf {
+			if err := c.validateJSON(sub, value, path, depth+1, checks); err != nil {
+				return err
+			}
+		}
+	}
+	if anyOf, ok := sch["anyOf"].([]interface{}); ok {
+		matched := false
+		for _, sub := range anyOf {
+			if c.validateJSON(sub, value, path, depth+1, checks) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: value does not match any of the allowed schemas", path)
+		}
+	}
+	if oneOf, ok := sch["oneOf"].([]interface{}); ok {
+		matches := 0
+		for _, sub := range oneOf {
+			if c.validateJSON(sub, value, path, depth+1, checks) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fmt.Errorf("%s: value matches %d of the schemas but must match exactly 1", path, matches)
+		}
+	}
+
+	return nil
+}
+
+// checkJSONType returns an error if the provided value is not one of the types named in expType.
+func checkJSONType(expType, value interface{}, path string) error {
+	var names []string
+	switch t := expType.(type) {
+	case string:
+		names = []string{t}
+	case []interface{}:
+		for _, entry := range t {
+			if name, ok := entry.(string); ok {
+				names = append(names, name)
+			}
+		}
+	default:
+		return nil
+	}
+	for _, name := range names {
+		if jsonTypeMatches(name, value) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: expected type %s, got %s", path, strings.Join(names, " or "), jsonTypeName(value))
+}
+
+// jsonTypeMatches returns true if the provided value is of the named JSON schema type.
+func jsonTypeMatches(name string, value interface{}) bool {
+	switch name {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	case "number":
+		_, ok := jsonNumber(value)
+		return ok
+	case "integer":
+		num, ok := jsonNumber(value)
+		return ok && num.IsInt()
+	}
+	return false
+}
+
+// jsonTypeName returns the name of the JSON schema type of the provided value.
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// checkJSONBounds returns an error if the provided count is outside the bounds given by the named keywords of the schema.
+func checkJSONBounds(sch map[string]interface{}, minKey, maxKey string, count int, path, unit string) error {
+	countNum := new(big.Float).SetPrec(jsonNumberPrecision).SetInt64(int64(count))
+	if limit, ok := jsonNumber(sch[minKey]); ok && countNum.Cmp(limit) < 0 {
+		return fmt.Errorf("%s: has %d %s but must have at least %s", path, count, unit, sch[minKey])
+	}
+	if limit, ok := jsonNumber(sch[maxKey]); ok && countNum.Cmp(limit) > 0 {
+		return fmt.Errorf("%s: has %d %s but must have at most %s", path, count, unit, sch[maxKey])
+	}
+	return nil
+}
+
+// jsonNumber converts the provided value to a big.Float if it is a JSON number.
+func jsonNumber(value interface{}) (*big.Float, bool) {
+	num, ok := value.(json.Number)
+	if !ok {
+		return nil, false
+	}
+	rv, _, err := big.ParseFloat(string(num), 10, jsonNumberPrecision, big.ToNearestEven)
+	if err != nil {
+		return nil, false
+	}
+	return rv, true
+}
+
+// jsonEqual returns true if the two provided JSON values are equal.
+func jsonEqual(a, b interface{}) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for key, aEntry := range av {
+			bEntry, has := bv[key]
+			if !has || !jsonEqual(aEntry, bEntry) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		aNum, aOK := jsonNumber(av)
+		bNum, bOK := jsonNumber(b)
+		return aOK && bOK && aNum.Cmp(bNum) == 0
+	default:
+		return a == b
+	}
+}
+
+// sortedKeys returns the keys of the provided map, sorted.
+func sortedKeys(m map[string]interface{}) []string {
+	rv := make([]string, 0, len(m))
+	for key := range m {
+		rv = append(rv, key)
+	}
+	sort.Strings(rv)
+	return rv
+}
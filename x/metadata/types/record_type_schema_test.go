@@ -0,0 +1,326 @@
+package types
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// mustMarshalFileDescriptorSet marshals a FileDescriptorSet with the provided files, panicking on error.
+func mustMarshalFileDescriptorSet(files ...*descriptorpb.FileDescriptorProto) []byte {
+	bz, err := protov2.Marshal(&descriptorpb.FileDescriptorSet{File: files})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func TestCompileRecordTypeSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   RecordTypeSchema
+		expNames []string
+		expErr   string
+	}{
+		{
+			name: "json schema with local refs",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(`{"title":"Loan","properties":{"payments":{"items":{"$ref":"#/$defs/Payment"}},"parent":{"$ref":"#"}},`+
+					`"$defs":{"Payment":{"type":"object"}}}`)),
+			expNames: []string{"Loan", "Payment"},
+		},
+		{
+			name: "json schema with missing ref",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(`{"title":"Loan","properties":{"payment":{"$ref":"#/$defs/Payment"}}}`)),
+			expErr: `$ref "#/$defs/Payment" not found`,
+		},
+		{
+			name: "json schema with remote ref",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(`{"title":"Loan","properties":{"payment":{"$ref":"https://example.com/payment.json"}}}`)),
+			expErr: `unsupported $ref "https://example.com/payment.json": only references to the root, $defs and definitions are allowed`,
+		},
+		{
+			name:   "json schema not an object",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, []byte(`["Loan"]`)),
+			expErr: "could not parse json schema: the document must be an object",
+		},
+		{
+			name:   "json schema with trailing data",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, []byte(`{"title":"Loan"}{}`)),
+			expErr: "could not parse json schema: unexpected data after the json value",
+		},
+		{
+			name: "json schema with recursive ref in a property",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(`{"title":"Node","oneOf":[{"type":"null"},{"properties":{"next":{"$ref":"#"}}}]}`)),
+			expNames: []string{"Node"},
+		},
+		{
+			name: "json schema with ref to itself",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(`{"title":"Loan","oneOf":[{"$ref":"#"},{"$ref":"#"}]}`)),
+			expErr: "schema refers back to itself through $ref, allOf, anyOf or oneOf without applying to a part of the value",
+		},
+		{
+			name: "json schema with ref cycle through defs",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(`{"title":"Loan","$ref":"#/$defs/A","$defs":{"A":{"allOf":[{"$ref":"#/$defs/B"}]},"B":{"anyOf":[{"type":"null"},{"$ref":"#/$defs/A"}]}}}`)),
+			expErr: "schema refers back to itself through $ref, allOf, anyOf or oneOf without applying to a part of the value",
+		},
+		{
+			name: "json schema with the same def used twice",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(`{"title":"Loan","allOf":[{"$ref":"#/$defs/A"},{"$ref":"#/$defs/A"}],"$defs":{"A":{"type":"object"}}}`)),
+			expNames: []string{"Loan", "A"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			compiled, err := tc.schema.Compile()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Compile")
+				return
+			}
+			require.NoError(t, err, "Compile")
+			var act []string
+			for name := range compiled.TypeNames() {
+				act = append(act, name)
+			}
+			assert.ElementsMatch(t, tc.expNames, act, "TypeNames")
+		})
+	}
+}
+
+func TestCompiledRecordTypeSchemaValidateProtoValue(t *testing.T) {
+	schema := NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET,
+		mustMarshalFileDescriptorSet(&descriptorpb.FileDescriptorProto{
+			Name:    protov2.String("loan.proto"),
+			Package: protov2.String("example.loan.v1"),
+			Syntax:  protov2.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name: protov2.String("Loan"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:     protov2.String("amount"),
+							Number:   protov2.Int32(1),
+							Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+							Type:     descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum(),
+							JsonName: protov2.String("amount"),
+						},
+					},
+				},
+			},
+		}))
+	compiled, err := schema.Compile()
+	require.NoError(t, err, "Compile")
+
+	amount := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1000)
+	unknown := protowire.AppendVarint(protowire.AppendTag(nil, 2, protowire.VarintType), 5)
+	truncated := protowire.AppendTag(nil, 1, protowire.VarintType)
+
+	tests := []struct {
+		name     string
+		typeName string
+		value    string
+		expErr   string
+	}{
+		{
+			name:     "valid message",
+			typeName: "example.loan.v1.Loan",
+			value:    base64.StdEncoding.EncodeToString(amount),
+		},
+		{
+			name:     "empty message",
+			typeName: "example.loan.v1.Loan",
+			value:    "",
+		},
+		{
+			name:     "undefined type",
+			typeName: "example.loan.v1.Mortgage",
+			value:    base64.StdEncoding.EncodeToString(amount),
+			expErr:   `type "example.loan.v1.Mortgage" is not defined in the schema`,
+		},
+		{
+			name:     "not base64",
+			typeName: "example.loan.v1.Loan",
+			value:    "not base64!",
+			expErr:   "value is not base64 encoded",
+		},
+		{
+			name:     "truncated message",
+			typeName: "example.loan.v1.Loan",
+			value:    base64.StdEncoding.EncodeToString(truncated),
+			expErr:   "could not unmarshal value",
+		},
+		{
+			name:     "unknown fields",
+			typeName: "example.loan.v1.Loan",
+			value:    base64.StdEncoding.EncodeToString(append(amount, unknown...)),
+			expErr:   "value has unknown fields",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err = compiled.ValidateValue(tc.typeName, tc.value)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "ValidateValue")
+			} else {
+				assert.NoError(t, err, "ValidateValue")
+			}
+		})
+	}
+}
+
+func TestCompiledRecordTypeSchemaValidateJSONValueTooManyChecks(t *testing.T) {
+	// Each level of the value is checked against both branches, so the work doubles with each level.
+	schema := NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+		[]byte(`{"title":"Tree","oneOf":[{"type":"array","items":{"$ref":"#"}},{"type":"array","items":{"$ref":"#"}}]}`))
+	compiled, err := schema.Compile()
+	require.NoError(t, err, "Compile")
+
+	value := strings.Repeat("[", 30) + strings.Repeat("]", 30)
+	err = compiled.ValidateValue("Tree", value)
+	assert.EqualError(t, err, "validation exceeds the maximum of 10000 schema checks", "ValidateValue")
+}
+
+func TestCompiledRecordTypeSchemaValidateJSONValue(t *testing.T) {
+	schema := NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, []byte(`{
+		"title": "Loan",
+		"type": "object",
+		"required": ["amount", "status"],
+		"properties": {
+			"amount": {"type": "integer", "minimum": 1, "maximum": 1000000},
+			"status": {"enum": ["open", "closed"]},
+			"borrower": {"$ref": "#/$defs/Borrower"},
+			"payments": {"type": "array", "items": {"$ref": "#/definitions/Payment"}, "maxItems": 2}
+		},
+		"additionalProperties": false,
+		"$defs": {
+			"Borrower": {"type": "object", "properties": {"name": {"type": "string", "minLength": 1}}}
+		},
+		"definitions": {
+			"Payment": {"oneOf": [{"type": "number"}, {"type": "string"}]}
+		}
+	}`))
+	compiled, err := schema.Compile()
+	require.NoError(t, err, "Compile")
+
+	tests := []struct {
+		name     string
+		typeName string
+		value    string
+		expErr   string
+	}{
+		{
+			name:     "valid loan",
+			typeName: "Loan",
+			value:    `{"amount":500,"status":"open","borrower":{"name":"Alex"},"payments":[1.5,"2"]}`,
+		},
+		{
+			name:     "valid borrower",
+			typeName: "Borrower",
+			value:    `{"name":"Alex"}`,
+		},
+		{
+			name:     "not json",
+			typeName: "Loan",
+			value:    `amount=500`,
+			expErr:   "value is not json",
+		},
+		{
+			name:     "trailing data",
+			typeName: "Loan",
+			value:    `{"amount":500,"status":"open"} {}`,
+			expErr:   "value is not json: unexpected data after the json value",
+		},
+		{
+			name:     "wrong type",
+			typeName: "Loan",
+			value:    `[500]`,
+			expErr:   "$: expected type object, got array",
+		},
+		{
+			name:     "missing required property",
+			typeName: "Loan",
+			value:    `{"amount":500}`,
+			expErr:   `$: missing required property "status"`,
+		},
+		{
+			name:     "not an integer",
+			typeName: "Loan",
+			value:    `{"amount":1.5,"status":"open"}`,
+			expErr:   "$.amount: expected type integer, got number",
+		},
+		{
+			name:     "below minimum",
+			typeName: "Loan",
+			value:    `{"amount":0,"status":"open"}`,
+			expErr:   "$.amount: 0 is less than the minimum 1",
+		},
+		{
+			name:     "above maximum",
+			typeName: "Loan",
+			value:    `{"amount":1000001,"status":"open"}`,
+			expErr:   "$.amount: 1000001 is greater than the maximum 1000000",
+		},
+		{
+			name:     "not an allowed value",
+			typeName: "Loan",
+			value:    `{"amount":500,"status":"pending"}`,
+			expErr:   "$.status: value is not one of the allowed values",
+		},
+		{
+			name:     "additional property",
+			typeName: "Loan",
+			value:    `{"amount":500,"status":"open","rate":5}`,
+			expErr:   "$.rate: value is not allowed",
+		},
+		{
+			name:     "invalid referenced value",
+			typeName: "Loan",
+			value:    `{"amount":500,"status":"open","borrower":{"name":""}}`,
+			expErr:   "$.borrower.name: has 0 characters but must have at least 1",
+		},
+		{
+			name:     "too many items",
+			typeName: "Loan",
+			value:    `{"amount":500,"status":"open","payments":[1,2,3]}`,
+			expErr:   "$.payments: has 3 items but must have at most 2",
+		},
+		{
+			name:     "item matches none of the schemas",
+			typeName: "Loan",
+			value:    `{"amount":500,"status":"open","payments":[true]}`,
+			expErr:   "$.payments[0]: value matches 0 of the schemas but must match exactly 1",
+		},
+		{
+			name:     "undefined type",
+			typeName: "Lender",
+			value:    `{}`,
+			expErr:   `type "Lender" is not defined in the schema`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err = compiled.ValidateValue(tc.typeName, tc.value)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "ValidateValue")
+			} else {
+				assert.NoError(t, err, "ValidateValue")
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/internal/provutils"
@@ -597,3 +599,106 @@ func NewNetAssetValue(price sdk.Coin, volume uint64) NetAssetValue {
 func (mnav *NetAssetValue) Validate() error {
 	return mnav.Price.Validate()
 }
+
+// PricePerUnit returns the price of one unit of the scope in this net asset value's price denom.
+// A volume of zero is treated as one, since that's what it was assumed to be before the volume field was added.
+func (mnav NetAssetValue) PricePerUnit() sdkmath.LegacyDec {
+	volume := mnav.Volume
+	if volume < 1 {
+		volume = 1
+	}
+	return sdkmath.LegacyNewDecFromInt(mnav.Price.Amount).QuoInt(sdkmath.NewIntFromUint64(volume))
+}
+
+// NetAssetValueTWAP returns the average price per unit of the provided history entries over the window from
+// startTime up to (but not including) endTime, with each entry weighted by the amount of time it was in effect for.
+// The entries must be in the order they were set. Each entry is in effect from its time until the next entry's.
+// The first time that an entry was in effect for is also returned.
+func NetAssetValueTWAP(entries []NetAssetValueHistoryEntry, startTime, endTime time.Time) (sdkmath.LegacyDec, time.Time, error) {
+	if !endTime.After(startTime) {
+		return sdkmath.LegacyDec{}, time.Time{}, fmt.Errorf("end time %s must be after start time %s",
+			endTime.UTC().Format(time.RFC3339), startTime.UTC().Format(time.RFC3339))
+	}
+
+	total := sdkmath.LegacyZeroDec()
+	var coveredStart time.Time
+	var covered time.Duration
+	for i, entry := range entries {
+		from := entry.Time
+		if from.Before(startTime) {
+			from = startTime
+		}
+		to := endTime
+		if i+1 < len(entries) && entries[i+1].Time.Before(endTime) {
+			to = entries[i+1].Time
+		}
+		if !to.After(from) {
+			continue
+		}
+		if covered == 0 {
+			coveredStart = from
+		}
+		total = total.Add(entry.NetAssetValue.PricePerUnit().MulInt64(int64(to.Sub(from))))
+		covered += to.Sub(from)
+	}
+
+	if covered == 0 {
+		return sdkmath.LegacyDec{}, time.Time{}, fmt.Errorf("no net asset value was in effect from %s to %s",
+			startTime.UTC().Format(time.RFC3339), endTime.UTC().Format(time.RFC3339))
+	}
+	return total.QuoInt64(int64(covered)), coveredStart, nil
+}
+
+// ValidateBasic performs basic format checking of data in a ScopeOffer.
+func (o ScopeOffer) ValidateBasic() error {
+	if o.OfferId == 0 {
+		return errors.New("offer id cannot be zero")
+	}
+	if err := validateScopeOffer(o.Seller, o.ScopeIds, o.Price, o.Buyer); err != nil {
+		return err
+	}
+	if o.HoldId == 0 {
+		return errors.New("hold id cannot be zero")
+	}
+	return nil
+}
+
+// IsExpired returns true if this offer has an expiration that is not after the provided time.
+func (o ScopeOffer) IsExpired(blockTime time.Time) bool {
+	return o.Expiration != nil && !o.Expiration.After(blockTime)
+}
+
+// validateScopeOffer checks the fields common to a ScopeOffer and MsgOfferScopeRequest.
+func validateScopeOffer(seller string, scopeIDs []MetadataAddress, price sdk.Coin, buyer string) error {
+	if _, err := sdk.AccAddressFromBech32(seller); err != nil {
+		return fmt.Errorf("invalid seller: %w", err)
+	}
+	if len(scopeIDs) == 0 {
+		return errors.New("at least one scope id is required")
+	}
+	seen := make(map[string]bool, len(scopeIDs))
+	for _, scopeID := range scopeIDs {
+		if err := scopeID.ValidateIsScopeAddress(); err != nil {
+			return err
+		}
+		if seen[string(scopeID)] {
+			return fmt.Errorf("duplicate scope id %s", scopeID)
+		}
+		seen[string(scopeID)] = true
+	}
+	if err := price.Validate(); err != nil {
+		return fmt.Errorf("invalid price: %w", err)
+	}
+	if !price.IsPositive() {
+		return fmt.Errorf("invalid price %q: must be positive", price)
+	}
+	if len(buyer) > 0 {
+		if _, err := sdk.AccAddressFromBech32(buyer); err != nil {
+			return fmt.Errorf("invalid buyer: %w", err)
+		}
+		if buyer == seller {
+			return errors.New("buyer cannot be the seller")
+		}
+	}
+	return nil
+}
@@ -1967,3 +1967,116 @@ func TestNetAssetValueValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestNetAssetValueTWAP(t *testing.T) {
+	baseTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(sec int64) time.Time {
+		return baseTime.Add(time.Duration(sec) * time.Second)
+	}
+	entry := func(amount int64, volume, height uint64, sec int64) NetAssetValueHistoryEntry {
+		return NetAssetValueHistoryEntry{
+			NetAssetValue: NetAssetValue{
+				Price:              sdk.NewInt64Coin("usd", amount),
+				Volume:             volume,
+				UpdatedBlockHeight: height,
+			},
+			Source: "test",
+			Time:   at(sec),
+		}
+	}
+	// The blocks aren't evenly spaced, so the second entry is in effect for 3 times as long as the first.
+	entries := []NetAssetValueHistoryEntry{
+		entry(10, 1, 10, 100),
+		entry(40, 2, 20, 200),
+		entry(5, 0, 30, 500),
+	}
+
+	tests := []struct {
+		name     string
+		entries  []NetAssetValueHistoryEntry
+		start    time.Time
+		end      time.Time
+		expAvg   string
+		expStart time.Time
+		expErr   string
+	}{
+		{
+			name:   "end before start",
+			start:  at(200),
+			end:    at(100),
+			expErr: "end time 2024-01-01T00:01:40Z must be after start time 2024-01-01T00:03:20Z",
+		},
+		{
+			name:   "end equals start",
+			start:  at(200),
+			end:    at(200),
+			expErr: "end time 2024-01-01T00:03:20Z must be after start time 2024-01-01T00:03:20Z",
+		},
+		{
+			name:   "no entries",
+			start:  at(100),
+			end:    at(1000),
+			expErr: "no net asset value was in effect from 2024-01-01T00:01:40Z to 2024-01-01T00:16:40Z",
+		},
+		{
+			name:    "range before first entry",
+			entries: entries,
+			start:   at(10),
+			end:     at(100),
+			expErr:  "no net asset value was in effect from 2024-01-01T00:00:10Z to 2024-01-01T00:01:40Z",
+		},
+		{
+			name:     "range within one entry",
+			entries:  entries,
+			start:    at(120),
+			end:      at(180),
+			expAvg:   "10.000000000000000000",
+			expStart: at(120),
+		},
+		{
+			name:     "range across two entries",
+			entries:  entries,
+			start:    at(150),
+			end:      at(250),
+			expAvg:   "15.000000000000000000",
+			expStart: at(150),
+		},
+		{
+			name:     "range starts before first entry",
+			entries:  entries,
+			start:    at(10),
+			end:      at(500),
+			expAvg:   "17.500000000000000000",
+			expStart: at(100),
+		},
+		{
+			name:     "range across all entries with zero volume",
+			entries:  entries,
+			start:    at(100),
+			end:      at(600),
+			expAvg:   "15.000000000000000000",
+			expStart: at(100),
+		},
+		{
+			name:     "range after last entry",
+			entries:  entries,
+			start:    at(600),
+			end:      at(700),
+			expAvg:   "5.000000000000000000",
+			expStart: at(600),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			avg, start, err := NetAssetValueTWAP(tc.entries, tc.start, tc.end)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "NetAssetValueTWAP error")
+				return
+			}
+			require.NoError(t, err, "NetAssetValueTWAP error")
+			assert.Equal(t, tc.expAvg, avg.String(), "NetAssetValueTWAP average")
+			assert.Equal(t, tc.expStart, start, "NetAssetValueTWAP start time")
+		})
+	}
+}
@@ -28,6 +28,8 @@ const (
 	maxInputSpecificationTypeNameLength = 1000
 	// Default max url length
 	maxURLLength = 2048
+	// Default max length for a RecordTypeSchema.Definition
+	maxRecordTypeSchemaDefinitionLength = 100_000
 )
 
 var (
@@ -91,6 +93,14 @@ func (s ScopeSpecification) ValidateBasic() error {
 				i, PrefixContractSpecification, prefix)
 		}
 	}
+	if !s.PredecessorId.Empty() {
+		if !s.PredecessorId.IsScopeSpecificationAddress() {
+			return fmt.Errorf("invalid predecessor id: %s is not a scope specification id", s.PredecessorId)
+		}
+		if s.PredecessorId.Equals(s.SpecificationId) {
+			return errors.New("a scope specification cannot be its own predecessor")
+		}
+	}
 	return nil
 }
 
@@ -172,9 +182,58 @@ func (s ContractSpecification) ValidateBasic() error {
 		return fmt.Errorf("class name exceeds maximum length (expected <= %d got: %d)",
 			maxContractSpecificationClassNameLength, len(s.ClassName))
 	}
+	if s.TypeSchema != nil {
+		if err = s.TypeSchema.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid type schema: %w", err)
+		}
+	}
+	if !s.PredecessorId.Empty() {
+		if !s.PredecessorId.IsContractSpecificationAddress() {
+			return fmt.Errorf("invalid predecessor id: %s is not a contract specification id", s.PredecessorId)
+		}
+		if s.PredecessorId.Equals(s.SpecificationId) {
+			return errors.New("a contract specification cannot be its own predecessor")
+		}
+	}
+	return nil
+}
+
+// NewRecordTypeSchema creates a new RecordTypeSchema instance.
+func NewRecordTypeSchema(format RecordTypeSchemaFormat, definition []byte) *RecordTypeSchema {
+	return &RecordTypeSchema{
+		Format:     format,
+		Definition: definition,
+	}
+}
+
+// ValidateBasic performs basic format checking of data in a RecordTypeSchema.
+func (s RecordTypeSchema) ValidateBasic() error {
+	if len(s.Definition) == 0 {
+		return errors.New("definition cannot be empty")
+	}
+	if len(s.Definition) > maxRecordTypeSchemaDefinitionLength {
+		return fmt.Errorf("definition exceeds maximum length (expected <= %d got: %d)",
+			maxRecordTypeSchemaDefinitionLength, len(s.Definition))
+	}
+	typeNames, err := s.TypeNames()
+	if err != nil {
+		return err
+	}
+	if len(typeNames) == 0 {
+		return errors.New("definition does not define any types")
+	}
 	return nil
 }
 
+// TypeNames returns the names of all the types defined in this schema.
+func (s RecordTypeSchema) TypeNames() (map[string]bool, error) {
+	compiled, err := s.Compile()
+	if err != nil {
+		return nil, err
+	}
+	return compiled.TypeNames(), nil
+}
+
 // NewRecordSpecification creates a new RecordSpecification instance
 func NewRecordSpecification(
 	specificationID MetadataAddress,
@@ -127,6 +127,43 @@ func (PartyType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1e2d1042057ea889, []int{1}
 }
 
+// RecordTypeSchemaFormat indicates the format of a record type schema definition.
+type RecordTypeSchemaFormat int32
+
+const (
+	// RECORD_TYPE_SCHEMA_FORMAT_UNSPECIFIED is an error condition
+	RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_UNSPECIFIED RecordTypeSchemaFormat = 0
+	// RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET indicates the definition is a serialized
+	// google.protobuf.FileDescriptorSet that includes all of the files its messages depend on. Its type names are the
+	// full names of the messages it defines. Values of these types are the base64 encoding of the serialized message.
+	RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET RecordTypeSchemaFormat = 1
+	// RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA indicates the definition is a JSON schema document. Its type names are the
+	// keys of its top-level "$defs" and "definitions" objects along with its "title". Values of these types are JSON
+	// documents that conform to the type's schema. Only local references ("#", "#/$defs/..." and "#/definitions/...")
+	// are allowed.
+	RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA RecordTypeSchemaFormat = 2
+)
+
+var RecordTypeSchemaFormat_name = map[int32]string{
+	0: "RECORD_TYPE_SCHEMA_FORMAT_UNSPECIFIED",
+	1: "RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET",
+	2: "RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA",
+}
+
+var RecordTypeSchemaFormat_value = map[string]int32{
+	"RECORD_TYPE_SCHEMA_FORMAT_UNSPECIFIED":          0,
+	"RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET": 1,
+	"RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA":          2,
+}
+
+func (x RecordTypeSchemaFormat) String() string {
+	return proto.EnumName(RecordTypeSchemaFormat_name, int32(x))
+}
+
+func (RecordTypeSchemaFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{2}
+}
+
 // ScopeSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
 type ScopeSpecification struct {
 	// unique identifier for this specification on chain
@@ -139,6 +176,11 @@ type ScopeSpecification struct {
 	PartiesInvolved []PartyType `protobuf:"varint,4,rep,packed,name=parties_involved,json=partiesInvolved,proto3,enum=provenance.metadata.v1.PartyType" json:"parties_involved,omitempty"`
 	// A list of contract specification ids allowed for a scope based on this specification.
 	ContractSpecIds []MetadataAddress `protobuf:"bytes,5,rep,name=contract_spec_ids,json=contractSpecIds,proto3,customtype=MetadataAddress" json:"contract_spec_ids"`
+	// predecessor_id is the optional id of the scope specification that this one is a newer version of.
+	// Scopes using a predecessor can be migrated to this specification.
+	PredecessorId MetadataAddress `protobuf:"bytes,6,opt,name=predecessor_id,json=predecessorId,proto3,customtype=MetadataAddress" json:"predecessor_id"`
+	// deprecated, if true, prevents this specification from being used by new scopes.
+	Deprecated bool `protobuf:"varint,7,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 }
 
 func (m *ScopeSpecification) Reset()      { *m = ScopeSpecification{} }
@@ -194,6 +236,13 @@ func (m *ScopeSpecification) GetPartiesInvolved() []PartyType {
 	return nil
 }
 
+func (m *ScopeSpecification) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 // ContractSpecification defines the required parties, resources, conditions, and consideration outputs for a contract
 type ContractSpecification struct {
 	// unique identifier for this specification on chain
@@ -208,12 +257,18 @@ type ContractSpecification struct {
 	// contract
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*ContractSpecification_ResourceId
 	//	*ContractSpecification_Hash
 	Source isContractSpecification_Source `protobuf_oneof:"source"`
 	// name of the class/type of this contract executable
 	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	// type_schema optionally defines the types that records written under this contract specification can use.
+	// When set, record writes are validated against it.
+	TypeSchema *RecordTypeSchema `protobuf:"bytes,8,opt,name=type_schema,json=typeSchema,proto3" json:"type_schema,omitempty"`
+	// predecessor_id is the optional id of the contract specification that this one is a newer version of.
+	PredecessorId MetadataAddress `protobuf:"bytes,9,opt,name=predecessor_id,json=predecessorId,proto3,customtype=MetadataAddress" json:"predecessor_id"`
+	// deprecated, if true, prevents this specification from being used by new sessions.
+	Deprecated bool `protobuf:"varint,10,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 }
 
 func (m *ContractSpecification) Reset()      { *m = ContractSpecification{} }
@@ -313,6 +368,20 @@ func (m *ContractSpecification) GetClassName() string {
 	return ""
 }
 
+func (m *ContractSpecification) GetTypeSchema() *RecordTypeSchema {
+	if m != nil {
+		return m.TypeSchema
+	}
+	return nil
+}
+
+func (m *ContractSpecification) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -321,6 +390,62 @@ func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// RecordTypeSchema defines the set of types that record inputs and outputs can declare.
+// Record output hashes and hash inputs that use these types must be values of the declared type.
+type RecordTypeSchema struct {
+	// format is the format of the definition.
+	Format RecordTypeSchemaFormat `protobuf:"varint,1,opt,name=format,proto3,enum=provenance.metadata.v1.RecordTypeSchemaFormat" json:"format,omitempty"`
+	// definition is either a serialized google.protobuf.FileDescriptorSet or a JSON schema document.
+	Definition []byte `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+}
+
+func (m *RecordTypeSchema) Reset()         { *m = RecordTypeSchema{} }
+func (m *RecordTypeSchema) String() string { return proto.CompactTextString(m) }
+func (*RecordTypeSchema) ProtoMessage()    {}
+func (*RecordTypeSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{2}
+}
+func (m *RecordTypeSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordTypeSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordTypeSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordTypeSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordTypeSchema.Merge(m, src)
+}
+func (m *RecordTypeSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordTypeSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordTypeSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordTypeSchema proto.InternalMessageInfo
+
+func (m *RecordTypeSchema) GetFormat() RecordTypeSchemaFormat {
+	if m != nil {
+		return m.Format
+	}
+	return RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_UNSPECIFIED
+}
+
+func (m *RecordTypeSchema) GetDefinition() []byte {
+	if m != nil {
+		return m.Definition
+	}
+	return nil
+}
+
 // RecordSpecification defines the specification for a Record including allowed/required inputs/outputs
 type RecordSpecification struct {
 	// unique identifier for this specification on chain
@@ -340,7 +465,7 @@ type RecordSpecification struct {
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
 func (*RecordSpecification) ProtoMessage() {}
 func (*RecordSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{2}
+	return fileDescriptor_1e2d1042057ea889, []int{3}
 }
 func (m *RecordSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +539,6 @@ type InputSpecification struct {
 	// source is either on chain (record_id) or off-chain (hash)
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*InputSpecification_RecordId
 	//	*InputSpecification_Hash
 	Source isInputSpecification_Source `protobuf_oneof:"source"`
@@ -423,7 +547,7 @@ type InputSpecification struct {
 func (m *InputSpecification) Reset()      { *m = InputSpecification{} }
 func (*InputSpecification) ProtoMessage() {}
 func (*InputSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{3}
+	return fileDescriptor_1e2d1042057ea889, []int{4}
 }
 func (m *InputSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +644,7 @@ func (m *Description) Reset()         { *m = Description{} }
 func (m *Description) String() string { return proto.CompactTextString(m) }
 func (*Description) ProtoMessage()    {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{4}
+	return fileDescriptor_1e2d1042057ea889, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,8 +704,10 @@ func (m *Description) GetIconUrl() string {
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.DefinitionType", DefinitionType_name, DefinitionType_value)
 	proto.RegisterEnum("provenance.metadata.v1.PartyType", PartyType_name, PartyType_value)
+	proto.RegisterEnum("provenance.metadata.v1.RecordTypeSchemaFormat", RecordTypeSchemaFormat_name, RecordTypeSchemaFormat_value)
 	proto.RegisterType((*ScopeSpecification)(nil), "provenance.metadata.v1.ScopeSpecification")
 	proto.RegisterType((*ContractSpecification)(nil), "provenance.metadata.v1.ContractSpecification")
+	proto.RegisterType((*RecordTypeSchema)(nil), "provenance.metadata.v1.RecordTypeSchema")
 	proto.RegisterType((*RecordSpecification)(nil), "provenance.metadata.v1.RecordSpecification")
 	proto.RegisterType((*InputSpecification)(nil), "provenance.metadata.v1.InputSpecification")
 	proto.RegisterType((*Description)(nil), "provenance.metadata.v1.Description")
@@ -592,63 +718,74 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
+	// 1057 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0x4d, 0x5e, 0x50, 0x6b, 0xa6, 0xdd, 0xae, 0xbb, 0x0b, 0x49, 0x28, 0x12,
-	0x44, 0x95, 0x9a, 0xa8, 0x81, 0x13, 0x37, 0x27, 0x71, 0xb7, 0x23, 0x65, 0xed, 0x68, 0xe2, 0x14,
-	0x2d, 0x17, 0xcb, 0xb5, 0x67, 0x5b, 0x6b, 0x13, 0x8f, 0xe5, 0x71, 0xb2, 0xf4, 0x04, 0x3f, 0x80,
-	0x03, 0x47, 0x8e, 0x48, 0x48, 0xfc, 0x06, 0x7e, 0xc2, 0x1e, 0xf7, 0x88, 0x10, 0xaa, 0x50, 0x2b,
-	0x7e, 0x02, 0x17, 0x4e, 0xc8, 0x63, 0xb7, 0x71, 0x42, 0x8a, 0x38, 0x70, 0xe4, 0xd4, 0x99, 0xf7,
-	0x7d, 0xef, 0xcd, 0x7b, 0xdf, 0x37, 0xe3, 0x06, 0x0e, 0x83, 0x90, 0xcd, 0xa9, 0x6f, 0xfb, 0x0e,
-	0x6d, 0x4f, 0x69, 0x64, 0xbb, 0x76, 0x64, 0xb7, 0xe7, 0xc7, 0x6d, 0x1e, 0x50, 0xc7, 0x7b, 0xe9,
-	0x39, 0x76, 0xe4, 0x31, 0xbf, 0x15, 0x84, 0x2c, 0x62, 0x68, 0x6f, 0xc1, 0x6d, 0xdd, 0x71, 0x5b,
-	0xf3, 0xe3, 0x27, 0xbb, 0x17, 0xec, 0x82, 0x09, 0x4a, 0x3b, 0x5e, 0x25, 0xec, 0x83, 0xdf, 0xf3,
-	0x80, 0x46, 0x0e, 0x0b, 0xe8, 0x28, 0x5b, 0x0a, 0x75, 0x41, 0x5e, 0xaa, 0x6d, 0x79, 0xae, 0x22,
-	0x35, 0xa4, 0xe6, 0x3b, 0xdd, 0xc7, 0x6f, 0xae, 0xeb, 0xb9, 0x5f, 0xae, 0xeb, 0xdb, 0xcf, 0xd3,
-	0xda, 0xaa, 0xeb, 0x86, 0x94, 0x73, 0xb2, 0xbd, 0x94, 0x80, 0x5d, 0xa4, 0x41, 0xd5, 0xa5, 0xdc,
-	0x09, 0xbd, 0x20, 0x0e, 0x28, 0xf9, 0x86, 0xd4, 0xac, 0x76, 0x3e, 0x6c, 0xad, 0x6f, 0xaf, 0xd5,
-	0x5f, 0x50, 0x49, 0x36, 0x0f, 0x7d, 0x0c, 0xdb, 0xec, 0xb5, 0x4f, 0x43, 0xcb, 0x4e, 0x0e, 0xa2,
-	0x5c, 0x29, 0x34, 0x0a, 0xcd, 0x0a, 0xd9, 0x12, 0x61, 0xf5, 0x2e, 0x8a, 0x06, 0x20, 0x07, 0x76,
-	0x18, 0x79, 0x94, 0x5b, 0x9e, 0x3f, 0x67, 0x93, 0x39, 0x75, 0x95, 0x62, 0xa3, 0xd0, 0xdc, 0xea,
-	0x7c, 0xf0, 0xd0, 0xa1, 0x43, 0x3b, 0x8c, 0xae, 0xcc, 0xab, 0x80, 0x92, 0xed, 0x34, 0x15, 0xa7,
-	0x99, 0xa8, 0x07, 0xef, 0x3a, 0xcc, 0x8f, 0x42, 0xdb, 0x89, 0xac, 0x78, 0x32, 0xcb, 0x73, 0xb9,
-	0xb2, 0xd1, 0x28, 0xfc, 0xa3, 0x04, 0x77, 0x19, 0xb1, 0x98, 0xd8, 0xe5, 0x9f, 0x95, 0xbf, 0xfb,
-	0xbe, 0x9e, 0xfb, 0xfa, 0xd7, 0x86, 0x74, 0xf0, 0x53, 0x01, 0x1e, 0xf5, 0x32, 0xe8, 0xff, 0x52,
-	0x2f, 0xa4, 0x36, 0xa1, 0x1a, 0x52, 0xce, 0x66, 0xa1, 0x43, 0xe3, 0xe1, 0x37, 0xc4, 0xf0, 0xc7,
-	0x7f, 0x5e, 0xd7, 0x8f, 0x2e, 0xbc, 0xe8, 0x72, 0x76, 0xde, 0x72, 0xd8, 0xb4, 0xed, 0x30, 0x3e,
-	0x65, 0x3c, 0xfd, 0x73, 0xc4, 0xdd, 0x57, 0xed, 0xe8, 0x2a, 0xa0, 0xbc, 0xa5, 0x3a, 0x4e, 0xda,
-	0xd7, 0x69, 0x8e, 0xc0, 0x5d, 0x1d, 0xec, 0xa2, 0x5d, 0x28, 0x5e, 0xda, 0xfc, 0x52, 0x29, 0x35,
-	0xa4, 0x66, 0xe5, 0x34, 0x47, 0xc4, 0x0e, 0xbd, 0x0f, 0xe0, 0x4c, 0x6c, 0xce, 0x2d, 0xdf, 0x9e,
-	0x52, 0x65, 0x33, 0xc6, 0x48, 0x45, 0x44, 0x74, 0x7b, 0x4a, 0x17, 0x86, 0x75, 0xcb, 0x50, 0x4a,
-	0x4a, 0x1d, 0xfc, 0x91, 0x87, 0x1d, 0x42, 0x1d, 0x16, 0xba, 0xff, 0xbd, 0x71, 0x08, 0x8a, 0xa2,
-	0x91, 0xbc, 0x68, 0x44, 0xac, 0x51, 0x17, 0x4a, 0x9e, 0x1f, 0xcc, 0xa2, 0x44, 0xfc, 0x6a, 0xe7,
-	0xf0, 0x21, 0x49, 0x71, 0xcc, 0x5a, 0xea, 0x89, 0xa4, 0x99, 0xe8, 0x29, 0x54, 0x62, 0x79, 0x92,
-	0x29, 0x8b, 0xa2, 0x78, 0x39, 0x0e, 0xc4, 0x43, 0xa2, 0x67, 0x42, 0xef, 0xd9, 0x24, 0xb2, 0xe2,
-	0x90, 0xd0, 0x7b, 0xab, 0xf3, 0xd1, 0xc3, 0xb7, 0xe5, 0xa5, 0xe7, 0x7b, 0x71, 0x75, 0xe1, 0x1e,
-	0x24, 0xa9, 0xf1, 0x1a, 0x11, 0xd8, 0x09, 0x29, 0x0f, 0x98, 0xcf, 0xbd, 0xf3, 0x09, 0xb5, 0x52,
-	0x5f, 0x95, 0xd2, 0xbf, 0xbd, 0x09, 0x28, 0x93, 0x3d, 0x4c, 0x92, 0x33, 0x4f, 0xe6, 0x07, 0x09,
-	0xd0, 0xdf, 0x47, 0xbc, 0x97, 0x4c, 0xca, 0x48, 0xb6, 0x34, 0x6e, 0x7e, 0x65, 0xdc, 0x0e, 0x54,
-	0x42, 0x61, 0x5f, 0x6c, 0x50, 0x41, 0x18, 0xb4, 0xb3, 0xc6, 0x9c, 0xd3, 0x1c, 0x29, 0x27, 0xbc,
-	0xcc, 0xe5, 0x29, 0x66, 0x2f, 0xcf, 0xda, 0xdb, 0xf1, 0x15, 0x54, 0x33, 0xef, 0x69, 0x6d, 0x77,
-	0x8d, 0xe5, 0xd7, 0x59, 0x10, 0xd0, 0xd2, 0xc3, 0xab, 0x43, 0xf5, 0x35, 0x3d, 0xe7, 0x5e, 0x44,
-	0xad, 0x59, 0x38, 0x49, 0x0d, 0x83, 0x34, 0x34, 0x0e, 0x27, 0x68, 0x1f, 0xca, 0x9e, 0xc3, 0x7c,
-	0x81, 0x6e, 0x08, 0x74, 0x33, 0xde, 0x8f, 0xc3, 0xc9, 0xe1, 0x37, 0x12, 0x6c, 0x2d, 0x7b, 0x84,
-	0xea, 0xf0, 0xb4, 0xaf, 0x9d, 0x60, 0x1d, 0x9b, 0xd8, 0xd0, 0x2d, 0xf3, 0xc5, 0x50, 0xb3, 0xc6,
-	0xfa, 0x68, 0xa8, 0xf5, 0xf0, 0x09, 0xd6, 0xfa, 0x72, 0x0e, 0xbd, 0x07, 0xca, 0x2a, 0x61, 0x48,
-	0x8c, 0xa1, 0x31, 0xd2, 0xfa, 0xb2, 0x84, 0x9e, 0xc0, 0xde, 0x2a, 0x4a, 0xb4, 0x9e, 0x41, 0xfa,
-	0x72, 0x7e, 0x5d, 0xe9, 0x04, 0xb3, 0x06, 0x78, 0x64, 0xca, 0x85, 0xc3, 0x1f, 0xf3, 0x50, 0xb9,
-	0x77, 0x38, 0x2e, 0x35, 0x54, 0x89, 0xf9, 0x62, 0x5d, 0x13, 0xfb, 0xf0, 0x28, 0x83, 0x19, 0x04,
-	0x3f, 0xc3, 0xba, 0x6a, 0x1a, 0x44, 0x96, 0xd0, 0x63, 0xd8, 0xc9, 0x40, 0x23, 0x8d, 0x9c, 0xe1,
-	0x9e, 0x46, 0xe4, 0xfc, 0x0a, 0x80, 0xf5, 0x33, 0x6d, 0x14, 0x67, 0x14, 0x90, 0x02, 0xbb, 0x19,
-	0xa0, 0x37, 0x1e, 0x99, 0x46, 0x1f, 0xab, 0xba, 0x5c, 0x44, 0xbb, 0x20, 0x67, 0x8f, 0xf9, 0x5c,
-	0xd7, 0x88, 0xbc, 0xb1, 0xc2, 0x57, 0x4f, 0x4e, 0xf0, 0x00, 0xab, 0xa6, 0x26, 0x97, 0xd0, 0x1e,
-	0xa0, 0x2c, 0xff, 0xb9, 0x8e, 0xbb, 0xe3, 0x91, 0xbc, 0xb9, 0xd2, 0xee, 0x90, 0x18, 0x67, 0x9a,
-	0xae, 0xea, 0x3d, 0x4d, 0x2e, 0xaf, 0x40, 0x3d, 0x43, 0x37, 0x89, 0x31, 0x18, 0x68, 0x44, 0x86,
-	0x95, 0x73, 0xce, 0xd4, 0x01, 0xee, 0x8b, 0x19, 0xab, 0xdd, 0x57, 0x6f, 0x6e, 0x6a, 0xd2, 0xdb,
-	0x9b, 0x9a, 0xf4, 0xdb, 0x4d, 0x4d, 0xfa, 0xf6, 0xb6, 0x96, 0x7b, 0x7b, 0x5b, 0xcb, 0xfd, 0x7c,
-	0x5b, 0xcb, 0xc1, 0xbe, 0xc7, 0x1e, 0x78, 0x3b, 0x43, 0xe9, 0x8b, 0x4f, 0x33, 0xdf, 0xc4, 0x05,
-	0xe9, 0xc8, 0x63, 0x99, 0x5d, 0xfb, 0xcb, 0xc5, 0xaf, 0x04, 0xf1, 0x95, 0x3c, 0x2f, 0x89, 0xff,
-	0xf6, 0x9f, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xe1, 0xcd, 0x6d, 0x49, 0x08, 0x00, 0x00,
+	0x14, 0x8e, 0x93, 0x34, 0x9b, 0xbc, 0x2c, 0xa9, 0x99, 0x76, 0xbb, 0xd9, 0x5d, 0x48, 0x42, 0x11,
+	0x10, 0x2a, 0x35, 0x51, 0x03, 0x27, 0x0e, 0x48, 0x4e, 0xe2, 0x6c, 0x07, 0xa5, 0xb6, 0x35, 0x76,
+	0x8b, 0x96, 0x8b, 0xe5, 0xda, 0xd3, 0xd6, 0xda, 0xc4, 0xb6, 0x3c, 0x6e, 0x97, 0x72, 0x81, 0x1f,
+	0xc0, 0x81, 0x23, 0x47, 0x04, 0x12, 0xff, 0x04, 0x69, 0x8f, 0x7b, 0x44, 0x08, 0x55, 0xa8, 0xfd,
+	0x0d, 0x5c, 0x38, 0x21, 0x8f, 0xdd, 0xd6, 0x09, 0x49, 0xb5, 0x12, 0x1c, 0x39, 0x65, 0xe6, 0xbd,
+	0xef, 0xbd, 0x79, 0xef, 0x7d, 0xdf, 0x4c, 0x0c, 0x5b, 0x41, 0xe8, 0x9f, 0x51, 0xcf, 0xf2, 0x6c,
+	0xda, 0x9d, 0xd2, 0xc8, 0x72, 0xac, 0xc8, 0xea, 0x9e, 0xed, 0x74, 0x59, 0x40, 0x6d, 0xf7, 0xc8,
+	0xb5, 0xad, 0xc8, 0xf5, 0xbd, 0x4e, 0x10, 0xfa, 0x91, 0x8f, 0x36, 0x6e, 0xb1, 0x9d, 0x6b, 0x6c,
+	0xe7, 0x6c, 0xe7, 0xf1, 0xfa, 0xb1, 0x7f, 0xec, 0x73, 0x48, 0x37, 0x5e, 0x25, 0xe8, 0xcd, 0x5f,
+	0x0a, 0x80, 0x74, 0xdb, 0x0f, 0xa8, 0x9e, 0x4d, 0x85, 0xfa, 0x20, 0xce, 0xe4, 0x36, 0x5d, 0xa7,
+	0x2e, 0xb4, 0x84, 0xf6, 0xfd, 0xfe, 0xc3, 0x97, 0x17, 0xcd, 0xdc, 0x6f, 0x17, 0xcd, 0xd5, 0xbd,
+	0x34, 0xb7, 0xe4, 0x38, 0x21, 0x65, 0x8c, 0xac, 0xce, 0x04, 0x60, 0x07, 0xc9, 0x50, 0x75, 0x28,
+	0xb3, 0x43, 0x37, 0x88, 0x0d, 0xf5, 0x7c, 0x4b, 0x68, 0x57, 0x7b, 0xef, 0x76, 0x16, 0x97, 0xd7,
+	0x19, 0xde, 0x42, 0x49, 0x36, 0x0e, 0x7d, 0x00, 0xab, 0xfe, 0x0b, 0x8f, 0x86, 0xa6, 0x95, 0x1c,
+	0x44, 0x59, 0xbd, 0xd0, 0x2a, 0xb4, 0x2b, 0xa4, 0xc6, 0xcd, 0xd2, 0xb5, 0x15, 0x8d, 0x41, 0x0c,
+	0xac, 0x30, 0x72, 0x29, 0x33, 0x5d, 0xef, 0xcc, 0x9f, 0x9c, 0x51, 0xa7, 0x5e, 0x6c, 0x15, 0xda,
+	0xb5, 0xde, 0x3b, 0xcb, 0x0e, 0xd5, 0xac, 0x30, 0x3a, 0x37, 0xce, 0x03, 0x4a, 0x56, 0xd3, 0x50,
+	0x9c, 0x46, 0xa2, 0x01, 0xbc, 0x69, 0xfb, 0x5e, 0x14, 0x5a, 0x76, 0x64, 0xc6, 0x9d, 0x99, 0xae,
+	0xc3, 0xea, 0x2b, 0xad, 0xc2, 0x9d, 0x23, 0xb8, 0x8e, 0x88, 0x87, 0x89, 0x1d, 0x86, 0x3e, 0x85,
+	0x5a, 0x10, 0x52, 0x87, 0xda, 0x94, 0x31, 0x3f, 0x8c, 0x87, 0x58, 0xba, 0x7b, 0x88, 0x6f, 0x64,
+	0xe0, 0xd8, 0x41, 0x0d, 0x00, 0x87, 0x06, 0x21, 0xb5, 0xad, 0x88, 0x3a, 0xf5, 0x7b, 0x2d, 0xa1,
+	0x5d, 0x26, 0x19, 0xcb, 0x27, 0xe5, 0xef, 0x7f, 0x68, 0xe6, 0xbe, 0xf9, 0xbd, 0x25, 0x6c, 0x5e,
+	0x15, 0xe1, 0xc1, 0x20, 0x73, 0xfa, 0xff, 0x54, 0xde, 0x52, 0x69, 0x40, 0x35, 0xa4, 0xcc, 0x3f,
+	0x0d, 0x6d, 0x1a, 0x37, 0xbf, 0xc2, 0x9b, 0xdf, 0xf9, 0xeb, 0xa2, 0xb9, 0x7d, 0xec, 0x46, 0x27,
+	0xa7, 0x87, 0x1d, 0xdb, 0x9f, 0x76, 0x6d, 0x9f, 0x4d, 0x7d, 0x96, 0xfe, 0x6c, 0x33, 0xe7, 0x79,
+	0x37, 0x3a, 0x0f, 0x28, 0xeb, 0x48, 0xb6, 0x9d, 0xd6, 0xb5, 0x9b, 0x23, 0x70, 0x9d, 0x07, 0x3b,
+	0x68, 0x1d, 0x8a, 0x27, 0x16, 0x3b, 0xe1, 0x8c, 0x56, 0x76, 0x73, 0x84, 0xef, 0xd0, 0xdb, 0x00,
+	0xf6, 0xc4, 0x62, 0xcc, 0xf4, 0xac, 0x29, 0xe5, 0x8c, 0x55, 0x48, 0x85, 0x5b, 0x14, 0x6b, 0x4a,
+	0x11, 0x86, 0x6a, 0x9c, 0xd6, 0x64, 0xf6, 0x09, 0x9d, 0x5a, 0xf5, 0x32, 0x1f, 0x64, 0x7b, 0x59,
+	0x4f, 0x84, 0xda, 0x7e, 0xe8, 0xc4, 0x4d, 0xe9, 0x1c, 0x4f, 0x20, 0xba, 0x59, 0x2f, 0xd0, 0x56,
+	0xe5, 0x5f, 0x68, 0x0b, 0x96, 0x6b, 0xab, 0x5f, 0x86, 0x52, 0xd2, 0xf5, 0xe6, 0x57, 0x20, 0xce,
+	0xd7, 0x84, 0x46, 0x50, 0x3a, 0xf2, 0xc3, 0xa9, 0x15, 0x71, 0x55, 0xd5, 0x7a, 0x9d, 0xd7, 0xed,
+	0x66, 0xc4, 0xa3, 0x48, 0x1a, 0x9d, 0xd4, 0x73, 0xe4, 0x7a, 0xee, 0x8d, 0xc4, 0xee, 0x93, 0x8c,
+	0x65, 0xf3, 0xcf, 0x3c, 0xac, 0x25, 0x29, 0xfe, 0x7b, 0x7d, 0x23, 0x28, 0x72, 0xbe, 0xf2, 0x9c,
+	0x2f, 0xbe, 0x46, 0x7d, 0x28, 0xb9, 0x5e, 0x70, 0x1a, 0x25, 0x1a, 0xad, 0xf6, 0xb6, 0x96, 0xf5,
+	0x85, 0x63, 0xd4, 0x4c, 0x4d, 0x24, 0x8d, 0x44, 0x4f, 0xa0, 0xc2, 0xe9, 0xe6, 0xc9, 0x8b, 0x3c,
+	0x79, 0x39, 0x36, 0x70, 0x2d, 0x3c, 0xe5, 0xb2, 0x3c, 0x9d, 0x44, 0x66, 0x6c, 0xe2, 0xb2, 0xac,
+	0xf5, 0xde, 0x5f, 0x7e, 0xa9, 0xae, 0x27, 0xc1, 0x45, 0x0e, 0x49, 0x68, 0xbc, 0x46, 0x04, 0xd6,
+	0x42, 0xca, 0x02, 0xdf, 0x63, 0xee, 0xe1, 0x84, 0x9a, 0xa9, 0xfc, 0xeb, 0xa5, 0xd7, 0xbd, 0x30,
+	0x28, 0x13, 0xad, 0x25, 0xc1, 0x99, 0x97, 0xe5, 0x27, 0x01, 0xd0, 0x3f, 0x5b, 0xbc, 0x19, 0x99,
+	0x90, 0x19, 0xd9, 0x4c, 0xbb, 0xf9, 0xb9, 0x76, 0x7b, 0x50, 0x09, 0x39, 0x7d, 0x31, 0x41, 0x05,
+	0x4e, 0xd0, 0xda, 0x02, 0x72, 0x76, 0x73, 0xa4, 0x9c, 0xe0, 0x32, 0x77, 0xac, 0x98, 0xbd, 0x63,
+	0x0b, 0x95, 0xf9, 0x35, 0x54, 0x33, 0xcf, 0xce, 0xc2, 0xea, 0x5a, 0xb3, 0x8f, 0x58, 0x81, 0xbb,
+	0xb2, 0x26, 0xd4, 0x84, 0xea, 0x0b, 0x7a, 0xc8, 0xdc, 0x88, 0x9a, 0xa7, 0xe1, 0x24, 0x25, 0x0c,
+	0x52, 0xd3, 0x7e, 0x38, 0x41, 0x8f, 0xa0, 0xec, 0xda, 0xbe, 0xc7, 0xbd, 0x2b, 0xdc, 0x7b, 0x2f,
+	0xde, 0xef, 0x87, 0x93, 0xad, 0x6f, 0x05, 0xa8, 0xcd, 0x72, 0x84, 0x9a, 0xf0, 0x64, 0x28, 0x8f,
+	0xb0, 0x82, 0x0d, 0xac, 0x2a, 0xa6, 0xf1, 0x4c, 0x93, 0xcd, 0x7d, 0x45, 0xd7, 0xe4, 0x01, 0x1e,
+	0x61, 0x79, 0x28, 0xe6, 0xd0, 0x5b, 0x50, 0x9f, 0x07, 0x68, 0x44, 0xd5, 0x54, 0x5d, 0x1e, 0x8a,
+	0x02, 0x7a, 0x0c, 0x1b, 0xf3, 0x5e, 0x22, 0x0f, 0x54, 0x32, 0x14, 0xf3, 0x8b, 0x52, 0x27, 0x3e,
+	0x73, 0x8c, 0x75, 0x43, 0x2c, 0x6c, 0xfd, 0x9c, 0x87, 0xca, 0x0d, 0xc3, 0x71, 0x2a, 0x4d, 0x22,
+	0xc6, 0xb3, 0x45, 0x45, 0x3c, 0x82, 0x07, 0x19, 0x9f, 0x4a, 0xf0, 0x53, 0xac, 0x48, 0x86, 0x4a,
+	0x44, 0x01, 0x3d, 0x84, 0xb5, 0x8c, 0x4b, 0x97, 0xc9, 0x01, 0x1e, 0xc8, 0x44, 0xcc, 0xcf, 0x39,
+	0xb0, 0x72, 0x20, 0xeb, 0x71, 0x44, 0x01, 0xd5, 0x61, 0x3d, 0xe3, 0x18, 0xec, 0xeb, 0x86, 0x3a,
+	0xc4, 0x92, 0x22, 0x16, 0xd1, 0x3a, 0x88, 0xd9, 0x63, 0x3e, 0x57, 0x64, 0x22, 0xae, 0xcc, 0xe1,
+	0xa5, 0xd1, 0x08, 0x8f, 0xb1, 0x64, 0xc8, 0x62, 0x09, 0x6d, 0x00, 0xca, 0xe2, 0xf7, 0x14, 0xdc,
+	0xdf, 0xd7, 0xc5, 0x7b, 0x73, 0xe5, 0x6a, 0x44, 0x3d, 0x90, 0x15, 0x49, 0x19, 0xc8, 0x62, 0x79,
+	0xce, 0x35, 0x50, 0x15, 0x83, 0xa8, 0xe3, 0xb1, 0x4c, 0x44, 0x98, 0x3b, 0xe7, 0x40, 0x1a, 0xe3,
+	0x21, 0xef, 0xb1, 0xba, 0xf5, 0xa3, 0x00, 0x1b, 0x8b, 0x5f, 0x26, 0xf4, 0x21, 0xbc, 0x97, 0x0e,
+	0x35, 0xe9, 0x7f, 0xb0, 0x2b, 0xef, 0x49, 0xe6, 0x48, 0x25, 0x7b, 0x92, 0x31, 0x37, 0xc4, 0x1e,
+	0x74, 0x96, 0x43, 0x35, 0xa2, 0x1a, 0xaa, 0x39, 0x94, 0xf5, 0x01, 0xc1, 0x9a, 0xa1, 0x12, 0x53,
+	0x97, 0x0d, 0x51, 0xb8, 0x3b, 0xfd, 0x67, 0xba, 0xaa, 0xa4, 0x26, 0x31, 0xdf, 0x7f, 0xfe, 0xf2,
+	0xb2, 0x21, 0xbc, 0xba, 0x6c, 0x08, 0x7f, 0x5c, 0x36, 0x84, 0xef, 0xae, 0x1a, 0xb9, 0x57, 0x57,
+	0x8d, 0xdc, 0xaf, 0x57, 0x8d, 0x1c, 0x3c, 0x72, 0xfd, 0x25, 0x17, 0x5c, 0x13, 0xbe, 0xf8, 0x38,
+	0xf3, 0xff, 0x76, 0x0b, 0xda, 0x76, 0xfd, 0xcc, 0xae, 0xfb, 0xe5, 0xed, 0x17, 0x25, 0xff, 0xc7,
+	0x3b, 0x2c, 0xf1, 0x2f, 0xc3, 0x8f, 0xfe, 0x1e, 0x00, 0x50, 0x43, 0x13, 0x2c, 0x75, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -671,6 +808,26 @@ func (m *ScopeSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.PredecessorId.Size()
+		i -= size
+		if _, err := m.PredecessorId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.ContractSpecIds) > 0 {
 		for iNdEx := len(m.ContractSpecIds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -757,6 +914,38 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.PredecessorId.Size()
+		i -= size
+		if _, err := m.PredecessorId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSpecification(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TypeSchema != nil {
+		{
+			size, err := m.TypeSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSpecification(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.ClassName) > 0 {
 		i -= len(m.ClassName)
 		copy(dAtA[i:], m.ClassName)
@@ -774,20 +963,20 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartiesInvolved) > 0 {
-		dAtA5 := make([]byte, len(m.PartiesInvolved)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.PartiesInvolved)*10)
+		var j5 int
 		for _, num := range m.PartiesInvolved {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintSpecification(dAtA, i, uint64(j4))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintSpecification(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -855,6 +1044,41 @@ func (m *ContractSpecification_Hash) MarshalToSizedBuffer(dAtA []byte) (int, err
 	dAtA[i] = 0x32
 	return len(dAtA) - i, nil
 }
+func (m *RecordTypeSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordTypeSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordTypeSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Format != 0 {
+		i = encodeVarintSpecification(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RecordSpecification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -876,20 +1100,20 @@ func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.ResponsibleParties) > 0 {
-		dAtA8 := make([]byte, len(m.ResponsibleParties)*10)
-		var j7 int
+		dAtA9 := make([]byte, len(m.ResponsibleParties)*10)
+		var j8 int
 		for _, num := range m.ResponsibleParties {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j8++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintSpecification(dAtA, i, uint64(j7))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintSpecification(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -1111,6 +1335,11 @@ func (m *ScopeSpecification) Size() (n int) {
 			n += 1 + l + sovSpecification(uint64(l))
 		}
 	}
+	l = m.PredecessorId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	if m.Deprecated {
+		n += 2
+	}
 	return n
 }
 
@@ -1146,6 +1375,15 @@ func (m *ContractSpecification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecification(uint64(l))
 	}
+	if m.TypeSchema != nil {
+		l = m.TypeSchema.Size()
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	l = m.PredecessorId.Size()
+	n += 1 + l + sovSpecification(uint64(l))
+	if m.Deprecated {
+		n += 2
+	}
 	return n
 }
 
@@ -1171,6 +1409,22 @@ func (m *ContractSpecification_Hash) Size() (n int) {
 	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
+func (m *RecordTypeSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Format != 0 {
+		n += 1 + sovSpecification(uint64(m.Format))
+	}
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	return n
+}
+
 func (m *RecordSpecification) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1287,6 +1541,8 @@ func (this *ScopeSpecification) String() string {
 		`OwnerAddresses:` + fmt.Sprintf("%v", this.OwnerAddresses) + `,`,
 		`PartiesInvolved:` + fmt.Sprintf("%v", this.PartiesInvolved) + `,`,
 		`ContractSpecIds:` + fmt.Sprintf("%v", this.ContractSpecIds) + `,`,
+		`PredecessorId:` + fmt.Sprintf("%v", this.PredecessorId) + `,`,
+		`Deprecated:` + fmt.Sprintf("%v", this.Deprecated) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1302,6 +1558,9 @@ func (this *ContractSpecification) String() string {
 		`PartiesInvolved:` + fmt.Sprintf("%v", this.PartiesInvolved) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`ClassName:` + fmt.Sprintf("%v", this.ClassName) + `,`,
+		`TypeSchema:` + strings.Replace(fmt.Sprintf("%v", this.TypeSchema), "RecordTypeSchema", "RecordTypeSchema", 1) + `,`,
+		`PredecessorId:` + fmt.Sprintf("%v", this.PredecessorId) + `,`,
+		`Deprecated:` + fmt.Sprintf("%v", this.Deprecated) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1620,6 +1879,59 @@ func (m *ScopeSpecification) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PredecessorId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -1937,6 +2249,198 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TypeSchema == nil {
+				m.TypeSchema = &RecordTypeSchema{}
+			}
+			if err := m.TypeSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredecessorId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PredecessorId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordTypeSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordTypeSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordTypeSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= RecordTypeSchemaFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definition = append(m.Definition[:0], dAtA[iNdEx:postIndex]...)
+			if m.Definition == nil {
+				m.Definition = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -11,6 +11,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -423,6 +426,20 @@ func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 			"",
 		},
 
+		// TypeSchema tests
+		{
+			"TypeSchema - invalid",
+			&ContractSpecification{
+				SpecificationId: ContractSpecMetadataAddress(uuid.New()),
+				OwnerAddresses:  []string{specTestBech32},
+				PartiesInvolved: []PartyType{PartyType_PARTY_TYPE_OWNER},
+				Source:          NewContractSpecificationSourceHash("somehash"),
+				ClassName:       "someclass",
+				TypeSchema:      NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, nil),
+			},
+			"invalid type schema: definition cannot be empty",
+		},
+
 		// A simple valid ContractSpecification
 		{
 			"simple valid test case",
@@ -451,6 +468,131 @@ func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 	}
 }
 
+func (s *SpecificationTestSuite) TestRecordTypeSchemaTypeNames() {
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			{
+				Name:    protov2.String("loan.proto"),
+				Package: protov2.String("example.loan.v1"),
+				MessageType: []*descriptorpb.DescriptorProto{
+					{
+						Name:       protov2.String("Loan"),
+						NestedType: []*descriptorpb.DescriptorProto{{Name: protov2.String("Payment")}},
+					},
+					{Name: protov2.String("Borrower")},
+				},
+			},
+			{
+				Name:        protov2.String("document.proto"),
+				MessageType: []*descriptorpb.DescriptorProto{{Name: protov2.String("Document")}},
+			},
+		},
+	}
+	fdsBz, err := protov2.Marshal(fds)
+	s.Require().NoError(err, "Marshal FileDescriptorSet")
+
+	tests := []struct {
+		name   string
+		schema RecordTypeSchema
+		exp    []string
+		expErr string
+	}{
+		{
+			name:   "unspecified format",
+			schema: RecordTypeSchema{Definition: []byte("{}")},
+			expErr: "unknown format RECORD_TYPE_SCHEMA_FORMAT_UNSPECIFIED",
+		},
+		{
+			name:   "proto descriptor set",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET, fdsBz),
+			exp:    []string{"Document", "example.loan.v1.Borrower", "example.loan.v1.Loan", "example.loan.v1.Loan.Payment"},
+		},
+		{
+			name:   "proto descriptor set not parsable",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET, []byte{0xff}),
+			expErr: "could not parse proto descriptor set",
+		},
+		{
+			name: "proto descriptor set with missing dependency",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_PROTO_DESCRIPTOR_SET,
+				mustMarshalFileDescriptorSet(&descriptorpb.FileDescriptorProto{
+					Name:        protov2.String("loan.proto"),
+					Dependency:  []string{"missing.proto"},
+					MessageType: []*descriptorpb.DescriptorProto{{Name: protov2.String("Loan")}},
+				})),
+			expErr: "could not resolve proto descriptor set",
+		},
+		{
+			name: "json schema",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(`{"title":"Loan","$defs":{"Payment":{"type":"object"}},"definitions":{"Borrower":{}}}`)),
+			exp: []string{"Borrower", "Loan", "Payment"},
+		},
+		{
+			name:   "json schema not parsable",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, []byte(`{"title":`)),
+			expErr: "could not parse json schema",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			names, err := tc.schema.TypeNames()
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr, "TypeNames error")
+				return
+			}
+			s.Require().NoError(err, "TypeNames error")
+			var act []string
+			for name := range names {
+				act = append(act, name)
+			}
+			s.Assert().ElementsMatch(tc.exp, act, "TypeNames result")
+		})
+	}
+}
+
+func (s *SpecificationTestSuite) TestRecordTypeSchemaValidateBasic() {
+	tests := []struct {
+		name   string
+		schema RecordTypeSchema
+		expErr string
+	}{
+		{
+			name:   "empty definition",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, nil),
+			expErr: "definition cannot be empty",
+		},
+		{
+			name: "definition too long",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA,
+				[]byte(strings.Repeat("x", maxRecordTypeSchemaDefinitionLength+1))),
+			expErr: fmt.Sprintf("definition exceeds maximum length (expected <= %d got: %d)",
+				maxRecordTypeSchemaDefinitionLength, maxRecordTypeSchemaDefinitionLength+1),
+		},
+		{
+			name:   "no types defined",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, []byte(`{"type":"object"}`)),
+			expErr: "definition does not define any types",
+		},
+		{
+			name:   "valid",
+			schema: *NewRecordTypeSchema(RecordTypeSchemaFormat_RECORD_TYPE_SCHEMA_FORMAT_JSON_SCHEMA, []byte(`{"title":"Loan"}`)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := tc.schema.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ValidateBasic")
+			} else {
+				s.Assert().NoError(err, "ValidateBasic")
+			}
+		})
+	}
+}
+
 func (s *SpecificationTestSuite) TestRecordSpecValidateBasic() {
 	contractSpecUUID := uuid.New()
 	tests := []struct {
@@ -1158,6 +1300,8 @@ func (s *SpecificationTestSuite) TestScopeSpecString() {
 		"OwnerAddresses:[cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck]," +
 		"PartiesInvolved:[PARTY_TYPE_OWNER]," +
 		"ContractSpecIds:[contractspec1qd2qmt038k7yc0azq46htdlhgwzqg6cr9l]," +
+		"PredecessorId:," +
+		"Deprecated:false," +
 		"}"
 
 	var actual string
@@ -1195,6 +1339,9 @@ func (s *SpecificationTestSuite) TestContractSpecString() {
 		"PartiesInvolved:[PARTY_TYPE_OWNER]," +
 		"Source:<nil>," +
 		"ClassName:CS 201: Intro to Blockchain," +
+		"TypeSchema:<nil>," +
+		"PredecessorId:," +
+		"Deprecated:false," +
 		"}"
 	var actual string
 	testFunc := func() {
@@ -345,4 +345,88 @@ func (s *IntegrationTestSuite) TestUpdateConversionFeeDenomProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestFeeScheduleProposal() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+		signer       string
+	}{
+		{
+			name:         "success - add fee schedule",
+			args:         []string{"add", "tier1.fees.pb", "/provenance.metadata.v1.MsgWriteRecordRequest=2500"},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "success - update fee schedule",
+			args:         []string{"update", "tier1.fees.pb", "/provenance.metadata.v1.MsgWriteRecordRequest=10000"},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "success - remove fee schedule",
+			args:         []string{"remove", "tier1.fees.pb"},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - no attribute",
+			args:         []string{"add"},
+			expectErrMsg: "requires at least 2 arg(s), only received 1",
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid proposal type",
+			args:         []string{"invalid-type", "tier1.fees.pb"},
+			expectErrMsg: `unknown proposal type "invalid-type"`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - add without discounts",
+			args:         []string{"add", "tier1.fees.pb"},
+			expectErrMsg: "at least one <msg-type>=<bips> discount is required",
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid discount format",
+			args:         []string{"add", "tier1.fees.pb", "/provenance.metadata.v1.MsgWriteRecordRequest"},
+			expectErrMsg: `invalid discount "/provenance.metadata.v1.MsgWriteRecordRequest": expected format <msg-type>=<bips>`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid basis points",
+			args:         []string{"add", "tier1.fees.pb", "/provenance.metadata.v1.MsgWriteRecordRequest=abc"},
+			expectErrMsg: `invalid discount "/provenance.metadata.v1.MsgWriteRecordRequest=abc": unable to parse basis points: strconv.ParseUint: parsing "abc": invalid syntax`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - remove with discounts",
+			args:         []string{"remove", "tier1.fees.pb", "/provenance.metadata.v1.MsgWriteRecordRequest=2500"},
+			expectErrMsg: "remove accepts only an attribute, received 1 discount(s)",
+			signer:       s.accountAddresses[0].String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdFeeScheduleProposal()
+			tc.args = append(tc.args,
+				"--title", "Fee schedule proposal", "--summary", "Changes an attribute based fee schedule.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
 // TODO: Add query tests
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		AllMsgFeesCmd(),
+		AllFeeSchedulesCmd(),
 		ListParamsCmd(),
 	)
 	return queryCmd
@@ -65,6 +66,42 @@ func AllMsgFeesCmd() *cobra.Command {
 	return cmd
 }
 
+// AllFeeSchedulesCmd is the CLI command for listing all fee schedules.
+func AllFeeSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-schedules",
+		Aliases: []string{"fs", "schedules"},
+		Short:   "List all the attribute based fee schedules on the Provenance Blockchain",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryAllFeeSchedulesResponse
+			if response, err = queryClient.QueryAllFeeSchedules(
+				context.Background(),
+				&types.QueryAllFeeSchedulesRequest{Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query fee schedules: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "fee schedules")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // ListParamsCmd is the CLI command for listing all params.
 func ListParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -39,6 +39,7 @@ func NewTxCmd() *cobra.Command {
 		GetCmdMsgFeesProposal(),
 		GetUpdateNhashPerUsdMilProposal(),
 		GetUpdateConversionFeeDenomProposal(),
+		GetCmdFeeScheduleProposal(),
 	)
 
 	return txCmd
@@ -194,3 +195,81 @@ $ %[1]s tx msgfees cfd customcoin --deposit 1000000000nhash
 	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
+
+func GetCmdFeeScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-schedule {add|update|remove} <attribute> [<msg-type>=<bips> ...]",
+		Args:    cobra.MinimumNArgs(2),
+		Aliases: []string{"fs", "f-s"},
+		Short:   "Submit a fee schedule proposal along with an initial deposit",
+		Long: strings.TrimSpace(`Submit a fee schedule proposal along with an initial deposit.
+A fee schedule discounts the additional msg fees paid by fee payers that have the given attribute.
+For add and update, each discount is a msg type and the basis points (1 to 10,000) of its additional fee that are waived.
+A discount of 10,000 basis points is a full exemption. For remove, only the attribute is provided.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees fee-schedule add tier1.fees.pb /provenance.metadata.v1.MsgWriteRecordRequest=2500 --deposit 1000000000nhash
+$ %[1]s tx msgfees fee-schedule update tier1.fees.pb /provenance.metadata.v1.MsgWriteRecordRequest=10000 --deposit 1000000000nhash
+$ %[1]s tx msgfees fee-schedule remove tier1.fees.pb --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			attribute := args[1]
+
+			var msg sdk.Msg
+			switch args[0] {
+			case "add", "update":
+				discounts, err := parseFeeDiscounts(clientCtx, args[2:])
+				if err != nil {
+					return err
+				}
+				feeSchedule := types.NewFeeSchedule(attribute, discounts...)
+				if args[0] == "add" {
+					msg = types.NewMsgAddFeeScheduleProposalRequest(feeSchedule, authority)
+				} else {
+					msg = types.NewMsgUpdateFeeScheduleProposalRequest(feeSchedule, authority)
+				}
+			case "remove":
+				if len(args) > 2 {
+					return fmt.Errorf("remove accepts only an attribute, received %d discount(s)", len(args)-2)
+				}
+				msg = types.NewMsgRemoveFeeScheduleProposalRequest(attribute, authority)
+			default:
+				return fmt.Errorf("unknown proposal type %q", args[0])
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// parseFeeDiscounts converts each <msg-type>=<bips> arg into a fee discount.
+func parseFeeDiscounts(clientCtx client.Context, args []string) ([]types.FeeDiscount, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("at least one <msg-type>=<bips> discount is required")
+	}
+	discounts := make([]types.FeeDiscount, 0, len(args))
+	for _, arg := range args {
+		msgType, bipsStr, found := strings.Cut(arg, "=")
+		if !found || len(msgType) == 0 || len(bipsStr) == 0 {
+			return nil, fmt.Errorf("invalid discount %q: expected format <msg-type>=<bips>", arg)
+		}
+		if _, err := clientCtx.InterfaceRegistry.Resolve(msgType); err != nil {
+			return nil, err
+		}
+		bips, err := strconv.ParseUint(bipsStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid discount %q: unable to parse basis points: %w", arg, err)
+		}
+		discounts = append(discounts, types.NewFeeDiscount(msgType, uint32(bips)))
+	}
+	return discounts, nil
+}
@@ -17,7 +17,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := k.IterateMsgFees(ctx, msgFeeRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, msgFees)
+	feeSchedules := make([]types.FeeSchedule, 0)
+	feeScheduleRecords := func(feeSchedule types.FeeSchedule) bool {
+		feeSchedules = append(feeSchedules, feeSchedule)
+		return false
+	}
+	if err := k.IterateFeeSchedules(ctx, feeScheduleRecords); err != nil {
+		panic(err)
+	}
+	return types.NewGenesisState(params, msgFees, feeSchedules)
 }
 
 // InitGenesis new msgfees genesis
@@ -32,4 +40,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, feeSchedule := range data.FeeSchedules {
+		k.SetFeeSchedule(ctx, feeSchedule)
+	}
 }
@@ -36,6 +36,7 @@ type Keeper struct {
 	txDecoder        sdk.TxDecoder
 	registry         cdctypes.InterfaceRegistry
 	authority        string
+	attrKeeper       types.AttributeKeeper
 }
 
 // NewKeeper returns a AdditionalFeeKeeper. It handles:
@@ -48,6 +49,7 @@ func NewKeeper(
 	simulateFunc baseAppSimulateFunc,
 	txDecoder sdk.TxDecoder,
 	registry cdctypes.InterfaceRegistry,
+	attrKeeper types.AttributeKeeper,
 ) Keeper {
 	return Keeper{
 		storeKey:         key,
@@ -58,6 +60,7 @@ func NewKeeper(
 		txDecoder:        txDecoder,
 		authority:        cosmosauthtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		registry:         registry,
+		attrKeeper:       attrKeeper,
 	}
 }
 
@@ -193,11 +196,13 @@ func (k Keeper) ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, er
 	}
 }
 
-// CalculateAdditionalFeesToBePaid computes the additional fees to be paid for the provided messages.
-func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (types.MsgFeesDistribution, error) {
+// CalculateAdditionalFeesToBePaid computes the additional fees to be paid by the payer for the provided messages.
+// The additional fee of each msg type is discounted by the fee schedules of the attributes that the payer has.
+func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, payer sdk.AccAddress, msgs ...sdk.Msg) (types.MsgFeesDistribution, error) {
 	msgFeesDistribution := types.MsgFeesDistribution{
 		RecipientDistributions: make(map[string]sdk.Coins),
 	}
+	var discounts map[string]uint32
 	assessCustomMsgTypeURL := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
 	for _, msg := range msgs {
 		typeURL := sdk.MsgTypeURL(msg)
@@ -207,7 +212,17 @@ func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg
 		}
 
 		if msgFees != nil {
-			if err := msgFeesDistribution.Increase(msgFees.AdditionalFee, msgFees.RecipientBasisPoints, msgFees.Recipient); err != nil {
+			// The payer's discounts are only looked up once there's a fee that they might apply to.
+			if discounts == nil {
+				if discounts, err = k.GetFeeDiscounts(ctx, payer); err != nil {
+					return msgFeesDistribution, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+				}
+			}
+			additionalFee, err := types.DiscountCoinByBips(msgFees.AdditionalFee, discounts[typeURL])
+			if err != nil {
+				return msgFeesDistribution, err
+			}
+			if err := msgFeesDistribution.Increase(additionalFee, msgFees.RecipientBasisPoints, msgFees.Recipient); err != nil {
 				return msgFeesDistribution, err
 			}
 		}
@@ -234,6 +249,127 @@ func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg
 	return msgFeesDistribution, nil
 }
 
+// SetFeeSchedule sets the attribute-based fee schedule
+func (k Keeper) SetFeeSchedule(ctx sdk.Context, feeSchedule types.FeeSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&feeSchedule)
+	store.Set(types.GetFeeScheduleKey(feeSchedule.Attribute), bz)
+}
+
+// GetFeeSchedule returns the FeeSchedule for the attribute if it exists nil if it does not
+func (k Keeper) GetFeeSchedule(ctx sdk.Context, attribute string) (*types.FeeSchedule, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeScheduleKey(attribute))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var feeSchedule types.FeeSchedule
+	if err := k.cdc.Unmarshal(bz, &feeSchedule); err != nil {
+		return nil, err
+	}
+
+	return &feeSchedule, nil
+}
+
+// RemoveFeeSchedule removes the FeeSchedule of the attribute or returns an error if it does not exist
+func (k Keeper) RemoveFeeSchedule(ctx sdk.Context, attribute string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeScheduleKey(attribute)
+	if !store.Has(key) {
+		return types.ErrFeeScheduleDoesNotExist
+	}
+
+	store.Delete(key)
+
+	return nil
+}
+
+// IterateFeeSchedules iterates all fee schedules with the given handler function.
+func (k Keeper) IterateFeeSchedules(ctx sdk.Context, handle func(feeSchedule types.FeeSchedule) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.FeeScheduleKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.FeeSchedule{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+		if handle(record) {
+			break
+		}
+	}
+	return nil
+}
+
+// AddFeeSchedule adds a new attribute-based fee schedule
+func (k Keeper) AddFeeSchedule(ctx sdk.Context, feeSchedule types.FeeSchedule) error {
+	if err := feeSchedule.Validate(); err != nil {
+		return err
+	}
+
+	existing, err := k.GetFeeSchedule(ctx, feeSchedule.Attribute)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return types.ErrFeeScheduleAlreadyExists
+	}
+
+	k.SetFeeSchedule(ctx, feeSchedule)
+
+	return nil
+}
+
+// UpdateFeeSchedule replaces an existing attribute-based fee schedule
+func (k Keeper) UpdateFeeSchedule(ctx sdk.Context, feeSchedule types.FeeSchedule) error {
+	if err := feeSchedule.Validate(); err != nil {
+		return err
+	}
+
+	existing, err := k.GetFeeSchedule(ctx, feeSchedule.Attribute)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return types.ErrFeeScheduleDoesNotExist
+	}
+
+	k.SetFeeSchedule(ctx, feeSchedule)
+
+	return nil
+}
+
+// GetFeeDiscounts returns the discount basis points, by msg type url, that the payer gets from the fee schedules of its attributes.
+// When the payer has the attributes of more than one fee schedule with a discount on the same msg type, the largest discount is used.
+// A fee schedule whose attribute name can no longer be looked up does not give any discounts.
+func (k Keeper) GetFeeDiscounts(ctx sdk.Context, payer sdk.AccAddress) (map[string]uint32, error) {
+	discounts := make(map[string]uint32)
+	if len(payer) == 0 || k.attrKeeper == nil {
+		return discounts, nil
+	}
+
+	payerAddr := payer.String()
+	err := k.IterateFeeSchedules(ctx, func(feeSchedule types.FeeSchedule) bool {
+		attrs, err := k.attrKeeper.GetAttributes(ctx, payerAddr, feeSchedule.Attribute)
+		if err != nil || len(attrs) == 0 {
+			return false
+		}
+		for _, discount := range feeSchedule.Discounts {
+			if discount.DiscountBasisPoints > discounts[discount.MsgTypeUrl] {
+				discounts[discount.MsgTypeUrl] = discount.DiscountBasisPoints
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return discounts, nil
+}
+
 // sortedKeys gets the keys of a map, sorts them and returns them as a slice.
 func sortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
 	keys := make([]K, 0, len(m))
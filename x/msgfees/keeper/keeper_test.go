@@ -20,6 +20,7 @@ import (
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	msgfeeskeeper "github.com/provenance-io/provenance/x/msgfees/keeper"
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
@@ -209,7 +210,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 			AdditionalModuleFees:   nhashCoins(1_000_000_000),
 			RecipientDistributions: map[string]sdk.Coins{},
 		}
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -220,7 +221,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 			AdditionalModuleFees:   nhashCoins(2_000_000_000),
 			RecipientDistributions: map[string]sdk.Coins{},
 		}
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, msgSend)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, msgSend)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -234,7 +235,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 			},
 		}
 		assessFee := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "recipient1", someAddress.String(), "")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -248,7 +249,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 			},
 		}
 		assessFee := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "recipient1", someAddress.String(), "2500")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -260,7 +261,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 			RecipientDistributions: map[string]sdk.Coins{},
 		}
 		assessFee := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "", someAddress.String(), "")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -276,7 +277,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 		}
 		assessFee1 := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "recipient1", someAddress.String(), "")
 		assessFee2 := types.NewMsgAssessCustomMsgFeeRequest("", nhashCoin(500_000_000), "recipient2", someAddress.String(), "")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee1, &assessFee2)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee1, &assessFee2)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -291,7 +292,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 		}
 		assessFee1 := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "recipient1", someAddress.String(), "")
 		assessFee2 := types.NewMsgAssessCustomMsgFeeRequest("", nhashCoin(500_000_000), "recipient1", someAddress.String(), "")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee1, &assessFee2)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee1, &assessFee2)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -306,7 +307,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 				"sendrecipient": nhashCoins(250_000_000),
 			},
 		}
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -330,7 +331,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 		}
 		assessFee1 := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "sendrecipient", someAddress.String(), "")
 		assessFee2 := types.NewMsgAssessCustomMsgFeeRequest("", nhashCoin(500_000_000), "sendrecipient", someAddress.String(), "")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee1, &assessFee2)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee1, &assessFee2)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -351,7 +352,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 			},
 		}
 		assessFee1 := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "anotherrecipient", someAddress.String(), "")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee1)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee1)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 
@@ -367,7 +368,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 			RecipientDistributions: map[string]sdk.Coins{},
 		}
 		assessFee := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "", someAddress.String(), "")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
@@ -381,12 +382,107 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 			},
 		}
 		assessFee := types.NewMsgAssessCustomMsgFeeRequest("", oneHash, "recipient1", someAddress.String(), "")
-		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee)
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, someAddress, msgSend, &assessFee)
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
 }
 
+func (s *TestSuite) TestFeeSchedules() {
+	app, ctx := s.app, s.ctx
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	s.T().Log("verify that adding a fee schedule works")
+	feeSchedule, err := app.MsgFeesKeeper.GetFeeSchedule(ctx, "tier.fees.pb")
+	s.Require().NoError(err, "GetFeeSchedule before add")
+	s.Require().Nil(feeSchedule, "GetFeeSchedule before add")
+	toAdd := types.NewFeeSchedule("tier.fees.pb", types.NewFeeDiscount(sendTypeURL, 5_000))
+	s.Require().NoError(app.MsgFeesKeeper.AddFeeSchedule(ctx, toAdd), "AddFeeSchedule")
+	err = app.MsgFeesKeeper.AddFeeSchedule(ctx, toAdd)
+	s.Require().ErrorIs(err, types.ErrFeeScheduleAlreadyExists, "AddFeeSchedule again")
+	feeSchedule, err = app.MsgFeesKeeper.GetFeeSchedule(ctx, "tier.fees.pb")
+	s.Require().NoError(err, "GetFeeSchedule after add")
+	s.Require().Equal(&toAdd, feeSchedule, "GetFeeSchedule after add")
+
+	s.T().Log("verify that updating a fee schedule works")
+	toUpdate := types.NewFeeSchedule("tier.fees.pb", types.NewFeeDiscount(sendTypeURL, 10_000))
+	s.Require().NoError(app.MsgFeesKeeper.UpdateFeeSchedule(ctx, toUpdate), "UpdateFeeSchedule")
+	feeSchedule, err = app.MsgFeesKeeper.GetFeeSchedule(ctx, "tier.fees.pb")
+	s.Require().NoError(err, "GetFeeSchedule after update")
+	s.Require().Equal(&toUpdate, feeSchedule, "GetFeeSchedule after update")
+	err = app.MsgFeesKeeper.UpdateFeeSchedule(ctx, types.NewFeeSchedule("other.pb", types.NewFeeDiscount(sendTypeURL, 1)))
+	s.Require().ErrorIs(err, types.ErrFeeScheduleDoesNotExist, "UpdateFeeSchedule that does not exist")
+	err = app.MsgFeesKeeper.UpdateFeeSchedule(ctx, types.NewFeeSchedule("tier.fees.pb"))
+	s.Require().ErrorIs(err, types.ErrInvalidFeeSchedule, "UpdateFeeSchedule without discounts")
+
+	s.T().Log("verify that removing a fee schedule works")
+	s.Require().NoError(app.MsgFeesKeeper.RemoveFeeSchedule(ctx, "tier.fees.pb"), "RemoveFeeSchedule")
+	feeSchedule, err = app.MsgFeesKeeper.GetFeeSchedule(ctx, "tier.fees.pb")
+	s.Require().NoError(err, "GetFeeSchedule after remove")
+	s.Require().Nil(feeSchedule, "GetFeeSchedule after remove")
+	err = app.MsgFeesKeeper.RemoveFeeSchedule(ctx, "tier.fees.pb")
+	s.Require().ErrorIs(err, types.ErrFeeScheduleDoesNotExist, "RemoveFeeSchedule again")
+}
+
+func (s *TestSuite) TestCalculateAdditionalFeesToBePaidWithFeeSchedules() {
+	nhashCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(pioconfig.GetProvenanceConfig().FeeDenom, amount))
+	}
+	owner, partner, maker, nobody := s.addrs[0], s.addrs[1], s.addrs[2], s.addrs[3]
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	assessFeeTypeURL := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
+	oneHash := sdk.NewInt64Coin(pioconfig.GetProvenanceConfig().FeeDenom, 1_000_000_000)
+	msgSend := banktypes.NewMsgSend(owner, owner, nhashCoins(1))
+	s.Require().NoError(s.app.MsgFeesKeeper.SetMsgFee(s.ctx, types.NewMsgFee(sendTypeURL, oneHash, "", 0)), "setting MsgSend fee")
+
+	// Partners are exempt from the MsgSend fee, and market makers get a quarter off.
+	// Market makers that are partners get the larger of the two discounts.
+	for _, name := range []string{"partner.fees.pb", "maker.fees.pb", "unused.fees.pb"} {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, owner, false), "SetNameRecord(%q)", name)
+	}
+	setAttr := func(name string, addr sdk.AccAddress) {
+		attr := attrtypes.NewAttribute(name, addr.String(), attrtypes.AttributeType_String, []byte("yes"), nil)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, owner), "SetAttribute(%q, %s)", name, addr)
+	}
+	setAttr("partner.fees.pb", partner)
+	setAttr("maker.fees.pb", maker)
+	setAttr("partner.fees.pb", owner)
+	setAttr("maker.fees.pb", owner)
+	s.app.MsgFeesKeeper.SetFeeSchedule(s.ctx, types.NewFeeSchedule("partner.fees.pb", types.NewFeeDiscount(sendTypeURL, 10_000)))
+	s.app.MsgFeesKeeper.SetFeeSchedule(s.ctx, types.NewFeeSchedule("maker.fees.pb", types.NewFeeDiscount(sendTypeURL, 2_500), types.NewFeeDiscount(assessFeeTypeURL, 10_000)))
+	s.app.MsgFeesKeeper.SetFeeSchedule(s.ctx, types.NewFeeSchedule("unused.fees.pb", types.NewFeeDiscount(sendTypeURL, 5_000)))
+
+	tests := []struct {
+		name     string
+		payer    sdk.AccAddress
+		msgs     []sdk.Msg
+		expected sdk.Coins
+	}{
+		{name: "no payer", payer: nil, msgs: []sdk.Msg{msgSend}, expected: nhashCoins(1_000_000_000)},
+		{name: "payer without attributes", payer: nobody, msgs: []sdk.Msg{msgSend}, expected: nhashCoins(1_000_000_000)},
+		{name: "exempt payer", payer: partner, msgs: []sdk.Msg{msgSend, msgSend}, expected: nil},
+		{name: "discounted payer", payer: maker, msgs: []sdk.Msg{msgSend, msgSend}, expected: nhashCoins(1_500_000_000)},
+		{name: "payer with overlapping discounts", payer: owner, msgs: []sdk.Msg{msgSend}, expected: nil},
+		{
+			name:  "discount does not apply to custom assessed fees",
+			payer: maker,
+			msgs: []sdk.Msg{
+				msgSend,
+				&types.MsgAssessCustomMsgFeeRequest{Amount: oneHash, From: maker.String()},
+			},
+			expected: nhashCoins(1_750_000_000),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, tc.payer, tc.msgs...)
+			s.Require().NoError(err, "CalculateAdditionalFeesToBePaid")
+			s.Assert().Equal(tc.expected, actual.TotalAdditionalFees, "TotalAdditionalFees")
+		})
+	}
+}
+
 func (s *TestSuite) TestAddMsgFee() {
 	testCases := []struct {
 		name          string
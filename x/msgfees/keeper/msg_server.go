@@ -103,3 +103,42 @@ func (m msgServer) UpdateConversionFeeDenomProposal(goCtx context.Context, req *
 
 	return &types.MsgUpdateConversionFeeDenomProposalResponse{}, nil
 }
+
+func (m msgServer) AddFeeScheduleProposal(goCtx context.Context, req *types.MsgAddFeeScheduleProposalRequest) (*types.MsgAddFeeScheduleProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	err := m.Keeper.AddFeeSchedule(sdk.UnwrapSDKContext(goCtx), req.FeeSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddFeeScheduleProposalResponse{}, nil
+}
+
+func (m msgServer) UpdateFeeScheduleProposal(goCtx context.Context, req *types.MsgUpdateFeeScheduleProposalRequest) (*types.MsgUpdateFeeScheduleProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	err := m.Keeper.UpdateFeeSchedule(sdk.UnwrapSDKContext(goCtx), req.FeeSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeScheduleProposalResponse{}, nil
+}
+
+func (m msgServer) RemoveFeeScheduleProposal(goCtx context.Context, req *types.MsgRemoveFeeScheduleProposalRequest) (*types.MsgRemoveFeeScheduleProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	err := m.Keeper.RemoveFeeSchedule(sdk.UnwrapSDKContext(goCtx), req.Attribute)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeeScheduleProposalResponse{}, nil
+}
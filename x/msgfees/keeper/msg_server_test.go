@@ -270,3 +270,83 @@ func (s *MsgServerTestSuite) TestUpdateConversionFeeDenomProposal() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestFeeScheduleProposals() {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	schedule := types.NewFeeSchedule("tier.fees.pb", types.NewFeeDiscount(sdk.MsgTypeURL(&types.MsgAddMsgFeeProposalRequest{}), 2_500))
+	exemption := types.NewFeeSchedule("tier.fees.pb", types.NewFeeDiscount(sdk.MsgTypeURL(&types.MsgAddMsgFeeProposalRequest{}), 10_000))
+	tests := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			name:     "add expected gov account for signer",
+			msg:      types.NewMsgAddFeeScheduleProposalRequest(schedule, ""),
+			errorMsg: `expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn got : expected gov account as only signer for proposal message`,
+		},
+		{
+			name:     "update fee schedule does not exist",
+			msg:      types.NewMsgUpdateFeeScheduleProposalRequest(exemption, authority),
+			errorMsg: `fee schedule for attribute does not exist`,
+		},
+		{
+			name:     "remove fee schedule does not exist",
+			msg:      types.NewMsgRemoveFeeScheduleProposalRequest(schedule.Attribute, authority),
+			errorMsg: `fee schedule for attribute does not exist`,
+		},
+		{
+			name: "add successful",
+			msg:  types.NewMsgAddFeeScheduleProposalRequest(schedule, authority),
+		},
+		{
+			name:     "add fee schedule already exists",
+			msg:      types.NewMsgAddFeeScheduleProposalRequest(schedule, authority),
+			errorMsg: `fee schedule for attribute already exists`,
+		},
+		{
+			name:     "update expected gov account for signer",
+			msg:      types.NewMsgUpdateFeeScheduleProposalRequest(exemption, ""),
+			errorMsg: `expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn got : expected gov account as only signer for proposal message`,
+		},
+		{
+			name: "update successful",
+			msg:  types.NewMsgUpdateFeeScheduleProposalRequest(exemption, authority),
+		},
+		{
+			name:     "remove expected gov account for signer",
+			msg:      types.NewMsgRemoveFeeScheduleProposalRequest(schedule.Attribute, ""),
+			errorMsg: `expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn got : expected gov account as only signer for proposal message`,
+		},
+		{
+			name: "remove successful",
+			msg:  types.NewMsgRemoveFeeScheduleProposalRequest(schedule.Attribute, authority),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var response interface{}
+			var err error
+			switch msg := tt.msg.(type) {
+			case *types.MsgAddFeeScheduleProposalRequest:
+				response, err = s.msgServer.AddFeeScheduleProposal(s.ctx, msg)
+			case *types.MsgUpdateFeeScheduleProposalRequest:
+				response, err = s.msgServer.UpdateFeeScheduleProposal(s.ctx, msg)
+			case *types.MsgRemoveFeeScheduleProposalRequest:
+				response, err = s.msgServer.RemoveFeeScheduleProposal(s.ctx, msg)
+			default:
+				s.FailNowf("unexpected msg type", "%T", tt.msg)
+			}
+			if len(tt.errorMsg) > 0 {
+				s.Assert().EqualError(err, tt.errorMsg)
+			} else {
+				s.Assert().NoError(err)
+				s.Assert().NotNil(response)
+			}
+		})
+	}
+
+	feeSchedule, err := s.app.MsgFeesKeeper.GetFeeSchedule(s.ctx, schedule.Attribute)
+	s.Require().NoError(err, "GetFeeSchedule after removal")
+	s.Assert().Nil(feeSchedule, "GetFeeSchedule after removal")
+}
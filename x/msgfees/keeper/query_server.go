@@ -50,6 +50,33 @@ func (k Keeper) QueryAllMsgFees(c context.Context, req *types.QueryAllMsgFeesReq
 	return &types.QueryAllMsgFeesResponse{MsgFees: msgFees, Pagination: pageRes}, nil
 }
 
+func (k Keeper) QueryAllFeeSchedules(c context.Context, req *types.QueryAllFeeSchedulesRequest) (*types.QueryAllFeeSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var feeSchedules []types.FeeSchedule
+	store := ctx.KVStore(k.storeKey)
+	feeScheduleStore := prefix.NewStore(store, types.FeeScheduleKeyPrefix)
+	pageRes, err := query.Paginate(feeScheduleStore, req.Pagination, func(_ []byte, value []byte) error {
+		var feeSchedule types.FeeSchedule
+
+		if err := k.cdc.Unmarshal(value, &feeSchedule); err != nil {
+			return err
+		}
+
+		feeSchedules = append(feeSchedules, feeSchedule)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFeeSchedulesResponse{FeeSchedules: feeSchedules, Pagination: pageRes}, nil
+}
+
 func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateTxFeesRequest) (*types.CalculateTxFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
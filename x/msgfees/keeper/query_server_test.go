@@ -25,6 +25,7 @@ import (
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	"github.com/provenance-io/provenance/testutil"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/msgfees/types"
@@ -191,6 +192,42 @@ func (s *QueryServerTestSuite) TestCalculateTxFeesWithAssessCustomFees() {
 	s.Assert().Equal(fmt.Sprintf("%s,%s", additionalAccessedFeesCoin.String(), expectedGasFees.String()), response.TotalFees.String())
 }
 
+func (s *QueryServerTestSuite) TestCalculateTxFeesWithFeeSchedule() {
+	bankSend := banktypes.NewMsgSend(s.user1Addr, s.user2Addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 100)))
+	simulateReq := s.createTxFeesRequest(s.pubkey1, s.privkey1, s.acct1, bankSend)
+	s.Require().NoError(s.app.MsgFeesKeeper.SetMsgFee(s.ctx, types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", sdk.NewInt64Coin(s.cfg.BondDenom, 100), "", 0)))
+	s.app.MsgFeesKeeper.SetFeeSchedule(s.ctx, types.NewFeeSchedule("tier.fees.pb", types.NewFeeDiscount("/cosmos.bank.v1beta1.MsgSend", 2_500)))
+
+	// the fee payer does not have the attribute yet
+	response, err := s.queryClient.CalculateTxFees(s.ctx.Context(), &simulateReq)
+	s.Require().NoError(err)
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 100)), response.AdditionalFees)
+
+	// the fee payer gets a quarter off with the attribute
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "tier.fees.pb", s.user2Addr, false))
+	attr := attrtypes.NewAttribute("tier.fees.pb", s.user1, attrtypes.AttributeType_String, []byte("maker"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user2Addr))
+	response, err = s.queryClient.CalculateTxFees(s.ctx.Context(), &simulateReq)
+	s.Require().NoError(err)
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 75)), response.AdditionalFees)
+	expectedTotalFees := response.AdditionalFees.Add(sdk.NewCoin(s.cfg.BondDenom, s.minGasPrice.Amount.MulRaw(int64(response.EstimatedGas))))
+	s.Assert().Equal(expectedTotalFees, response.TotalFees)
+}
+
+func (s *QueryServerTestSuite) TestQueryAllFeeSchedules() {
+	response, err := s.queryClient.QueryAllFeeSchedules(s.ctx.Context(), &types.QueryAllFeeSchedulesRequest{})
+	s.Require().NoError(err)
+	s.Assert().Empty(response.FeeSchedules)
+
+	schedule1 := types.NewFeeSchedule("maker.fees.pb", types.NewFeeDiscount("/cosmos.bank.v1beta1.MsgSend", 2_500))
+	schedule2 := types.NewFeeSchedule("partner.fees.pb", types.NewFeeDiscount("/cosmos.bank.v1beta1.MsgSend", 10_000))
+	s.app.MsgFeesKeeper.SetFeeSchedule(s.ctx, schedule2)
+	s.app.MsgFeesKeeper.SetFeeSchedule(s.ctx, schedule1)
+	response, err = s.queryClient.QueryAllFeeSchedules(s.ctx.Context(), &types.QueryAllFeeSchedulesRequest{})
+	s.Require().NoError(err)
+	s.Assert().Equal([]types.FeeSchedule{schedule1, schedule2}, response.FeeSchedules)
+}
+
 func (s *QueryServerTestSuite) createTxFeesRequest(pubKey cryptotypes.PubKey, privKey cryptotypes.PrivKey, acct sdk.AccountI, msgs ...sdk.Msg) types.CalculateTxFeesRequest {
 	theTx := s.cfg.TxConfig.NewTxBuilder()
 	s.Require().NoError(theTx.SetMsgs(msgs...))
@@ -12,4 +12,8 @@ var (
 	ErrMsgFeeDoesNotExist  = cerrs.Register(ModuleName, 5, "fee for type does not exist")
 	ErrInvalidFeeProposal  = cerrs.Register(ModuleName, 6, "invalid fee proposal")
 	ErrInvalidBipsValue    = cerrs.Register(ModuleName, 7, "invalid bips amount")
+
+	ErrFeeScheduleAlreadyExists = cerrs.Register(ModuleName, 8, "fee schedule for attribute already exists")
+	ErrFeeScheduleDoesNotExist  = cerrs.Register(ModuleName, 9, "fee schedule for attribute does not exist")
+	ErrInvalidFeeSchedule       = cerrs.Register(ModuleName, 10, "invalid fee schedule")
 )
@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -25,7 +27,12 @@ type MsgFeesKeeper interface {
 	GetFloorGasPrice(ctx sdk.Context) sdk.Coin
 	GetNhashPerUsdMil(ctx sdk.Context) uint64
 	ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error)
-	CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (MsgFeesDistribution, error)
+	CalculateAdditionalFeesToBePaid(ctx sdk.Context, payer sdk.AccAddress, msgs ...sdk.Msg) (MsgFeesDistribution, error)
+}
+
+// AttributeKeeper defines the attribute functionality needed to look up fee schedules.
+type AttributeKeeper interface {
+	GetAttributes(ctx sdk.Context, addr string, name string) ([]attributetypes.Attribute, error)
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
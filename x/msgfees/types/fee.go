@@ -34,6 +34,16 @@ func SplitCoinByBips(coin sdk.Coin, bips uint32) (recipientCoin sdk.Coin, feePay
 	return recipientCoin, feePayoutCoin, nil
 }
 
+// DiscountCoinByBips returns the portion of the coin left to pay once the discount basis points are waived.
+// If bips set to 10,000 nothing is left to pay.
+func DiscountCoinByBips(coin sdk.Coin, bips uint32) (sdk.Coin, error) {
+	if bips > 10_000 {
+		return sdk.Coin{}, ErrInvalidBipsValue.Wrapf("invalid: %v", bips)
+	}
+	amount := coin.Amount.MulRaw(int64(10_000 - bips)).QuoRaw(10_000)
+	return sdk.NewCoin(coin.Denom, amount), nil
+}
+
 // MsgFeesDistribution holds information on message based fees that should be collected.
 type MsgFeesDistribution struct {
 	// TotalAdditionalFees is the total of all additional fees.
@@ -98,3 +98,56 @@ func TestSplitCoinByBips(t *testing.T) {
 		}
 	}
 }
+
+func TestDiscountCoinByBips(t *testing.T) {
+	cases := []struct {
+		name             string
+		coin             sdk.Coin
+		bips             uint32
+		expectedCoin     sdk.Coin
+		expectedErrorMsg string
+	}{
+		{
+			name:         "no discount",
+			coin:         sdk.NewInt64Coin("nhash", 1_000),
+			bips:         0,
+			expectedCoin: sdk.NewInt64Coin("nhash", 1_000),
+		},
+		{
+			name:         "quarter off",
+			coin:         sdk.NewInt64Coin("nhash", 1_000),
+			bips:         2_500,
+			expectedCoin: sdk.NewInt64Coin("nhash", 750),
+		},
+		{
+			name:         "rounds the discounted amount down",
+			coin:         sdk.NewInt64Coin("nhash", 3),
+			bips:         5_000,
+			expectedCoin: sdk.NewInt64Coin("nhash", 1),
+		},
+		{
+			name:         "full exemption",
+			coin:         sdk.NewInt64Coin("nhash", 1_000),
+			bips:         10_000,
+			expectedCoin: sdk.NewInt64Coin("nhash", 0),
+		},
+		{
+			name:             "too many bips",
+			coin:             sdk.NewInt64Coin("nhash", 1_000),
+			bips:             10_001,
+			expectedErrorMsg: "invalid: 10001: invalid bips amount",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			coin, err := DiscountCoinByBips(tc.coin, tc.bips)
+			if len(tc.expectedErrorMsg) > 0 {
+				assert.EqualError(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCoin, coin)
+			}
+		})
+	}
+}
@@ -7,10 +7,11 @@ import (
 )
 
 // NewGenesisState creates new GenesisState object
-func NewGenesisState(params Params, entries []MsgFee) *GenesisState {
+func NewGenesisState(params Params, entries []MsgFee, feeSchedules []FeeSchedule) *GenesisState {
 	return &GenesisState{
-		Params:  params,
-		MsgFees: entries,
+		Params:       params,
+		MsgFees:      entries,
+		FeeSchedules: feeSchedules,
 	}
 }
 
@@ -21,14 +22,25 @@ func (state GenesisState) Validate() error {
 			return err
 		}
 	}
+	seen := make(map[string]bool, len(state.FeeSchedules))
+	for _, s := range state.FeeSchedules {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if seen[s.Attribute] {
+			return ErrInvalidFeeSchedule.Wrapf("duplicate fee schedule for %q", s.Attribute)
+		}
+		seen[s.Attribute] = true
+	}
 	return nil
 }
 
 // DefaultGenesisState returns default state for msgfee module.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:  DefaultParams(),
-		MsgFees: []MsgFee{},
+		Params:       DefaultParams(),
+		MsgFees:      []MsgFee{},
+		FeeSchedules: []FeeSchedule{},
 	}
 }
 
@@ -34,8 +34,15 @@ var (
 	MsgFeeKeyPrefix = []byte{0x00}
 	// MsgFeesParamStoreKey key for msgfees module's params
 	MsgFeesParamStoreKey = []byte{0x01}
+	// FeeScheduleKeyPrefix prefix for attribute-based fee schedule entry
+	FeeScheduleKeyPrefix = []byte{0x02}
 )
 
+// GetFeeScheduleKey takes in an attribute name and returns the key of its fee schedule
+func GetFeeScheduleKey(attribute string) []byte {
+	return append(FeeScheduleKeyPrefix, []byte(attribute)...)
+}
+
 func GetCompositeKey(msgType string, recipient string) string {
 	if len(recipient) == 0 {
 		return msgType
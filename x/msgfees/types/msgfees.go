@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 const (
@@ -46,3 +48,67 @@ func (msg *MsgFee) Validate() error {
 
 	return nil
 }
+
+func NewFeeSchedule(attribute string, discounts ...FeeDiscount) FeeSchedule {
+	return FeeSchedule{
+		Attribute: attribute,
+		Discounts: discounts,
+	}
+}
+
+func NewFeeDiscount(msgTypeURL string, discountBasisPoints uint32) FeeDiscount {
+	return FeeDiscount{
+		MsgTypeUrl:          msgTypeURL,
+		DiscountBasisPoints: discountBasisPoints,
+	}
+}
+
+// Validate returns an error if the fee schedule does not have a valid attribute name or discounts.
+func (s FeeSchedule) Validate() error {
+	if len(s.Attribute) == 0 {
+		return ErrInvalidFeeSchedule.Wrap("attribute cannot be empty")
+	}
+	if s.Attribute != nametypes.NormalizeName(s.Attribute) {
+		return ErrInvalidFeeSchedule.Wrapf("attribute %q must be normalized", s.Attribute)
+	}
+	if err := nametypes.ValidateName(s.Attribute); err != nil {
+		return ErrInvalidFeeSchedule.Wrap(err.Error())
+	}
+	if len(s.Discounts) == 0 {
+		return ErrInvalidFeeSchedule.Wrapf("fee schedule for %q must have at least one discount", s.Attribute)
+	}
+
+	seen := make(map[string]bool, len(s.Discounts))
+	for _, discount := range s.Discounts {
+		if err := discount.Validate(); err != nil {
+			return ErrInvalidFeeSchedule.Wrapf("fee schedule for %q: %v", s.Attribute, err)
+		}
+		if seen[discount.MsgTypeUrl] {
+			return ErrInvalidFeeSchedule.Wrapf("fee schedule for %q has more than one discount for %q", s.Attribute, discount.MsgTypeUrl)
+		}
+		seen[discount.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+// GetDiscountBips returns the basis points of the additional fee of the msg type that are waived by this fee schedule.
+func (s FeeSchedule) GetDiscountBips(msgTypeURL string) uint32 {
+	for _, discount := range s.Discounts {
+		if discount.MsgTypeUrl == msgTypeURL {
+			return discount.DiscountBasisPoints
+		}
+	}
+	return 0
+}
+
+// Validate returns an error if the fee discount does not have a msg type or its basis points are out of range.
+func (d FeeDiscount) Validate() error {
+	if len(d.MsgTypeUrl) == 0 {
+		return ErrEmptyMsgType
+	}
+	if d.DiscountBasisPoints == 0 || d.DiscountBasisPoints > 10_000 {
+		return fmt.Errorf("discount basis points for %q can only be between 1 and 10,000 : %v", d.MsgTypeUrl, d.DiscountBasisPoints)
+	}
+	return nil
+}
@@ -176,6 +176,119 @@ func (m *MsgFee) GetRecipientBasisPoints() uint32 {
 	return 0
 }
 
+// FeeSchedule defines discounts on msg-based fees for accounts that have an attribute.
+type FeeSchedule struct {
+	// attribute is the name of the account attribute that the discounts apply to, e.g. "tier.fees.pb".
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// discounts are the discounts on the additional fees of msg types for accounts with the attribute.
+	Discounts []FeeDiscount `protobuf:"bytes,2,rep,name=discounts,proto3" json:"discounts"`
+}
+
+func (m *FeeSchedule) Reset()         { *m = FeeSchedule{} }
+func (m *FeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*FeeSchedule) ProtoMessage()    {}
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{2}
+}
+func (m *FeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSchedule.Merge(m, src)
+}
+func (m *FeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSchedule proto.InternalMessageInfo
+
+func (m *FeeSchedule) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *FeeSchedule) GetDiscounts() []FeeDiscount {
+	if m != nil {
+		return m.Discounts
+	}
+	return nil
+}
+
+// FeeDiscount defines a discount on the additional fee of a msg type.
+type FeeDiscount struct {
+	// msg_type_url is the type-url of the discounted message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// discount_basis_points is the portion of the additional fee that is waived.
+	// Must be between 1 and 10,000 (inclusive). A discount of 10,000 is a full exemption.
+	//
+	// The fee payer will pay additional_fee * (10,000 - discount_basis_points) / 10,000.
+	DiscountBasisPoints uint32 `protobuf:"varint,2,opt,name=discount_basis_points,json=discountBasisPoints,proto3" json:"discount_basis_points,omitempty"`
+}
+
+func (m *FeeDiscount) Reset()         { *m = FeeDiscount{} }
+func (m *FeeDiscount) String() string { return proto.CompactTextString(m) }
+func (*FeeDiscount) ProtoMessage()    {}
+func (*FeeDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{3}
+}
+func (m *FeeDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDiscount.Merge(m, src)
+}
+func (m *FeeDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDiscount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDiscount proto.InternalMessageInfo
+
+func (m *FeeDiscount) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *FeeDiscount) GetDiscountBasisPoints() uint32 {
+	if m != nil {
+		return m.DiscountBasisPoints
+	}
+	return 0
+}
+
 // EventMsgFee final event property for msg fee on type
 type EventMsgFee struct {
 	MsgType   string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
@@ -188,7 +301,7 @@ func (m *EventMsgFee) Reset()         { *m = EventMsgFee{} }
 func (m *EventMsgFee) String() string { return proto.CompactTextString(m) }
 func (*EventMsgFee) ProtoMessage()    {}
 func (*EventMsgFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{2}
+	return fileDescriptor_0c6265859d114362, []int{4}
 }
 func (m *EventMsgFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +367,7 @@ func (m *EventMsgFees) Reset()         { *m = EventMsgFees{} }
 func (m *EventMsgFees) String() string { return proto.CompactTextString(m) }
 func (*EventMsgFees) ProtoMessage()    {}
 func (*EventMsgFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{3}
+	return fileDescriptor_0c6265859d114362, []int{5}
 }
 func (m *EventMsgFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,6 +406,8 @@ func (m *EventMsgFees) GetMsgFees() []EventMsgFee {
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.msgfees.v1.Params")
 	proto.RegisterType((*MsgFee)(nil), "provenance.msgfees.v1.MsgFee")
+	proto.RegisterType((*FeeSchedule)(nil), "provenance.msgfees.v1.FeeSchedule")
+	proto.RegisterType((*FeeDiscount)(nil), "provenance.msgfees.v1.FeeDiscount")
 	proto.RegisterType((*EventMsgFee)(nil), "provenance.msgfees.v1.EventMsgFee")
 	proto.RegisterType((*EventMsgFees)(nil), "provenance.msgfees.v1.EventMsgFees")
 }
@@ -302,38 +417,42 @@ func init() {
 }
 
 var fileDescriptor_0c6265859d114362 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0xd2, 0x10, 0xc8, 0xa6, 0x2d, 0xc2, 0x0a, 0xc8, 0xad, 0x90, 0x1b, 0x85, 0x4b, 0x38,
-	0x60, 0x93, 0x96, 0x13, 0xc7, 0x14, 0xd2, 0x53, 0xa5, 0xc8, 0xd0, 0x0b, 0x97, 0xd5, 0xc6, 0x99,
-	0x38, 0x2b, 0xd9, 0x3b, 0xd6, 0xee, 0xc6, 0xa2, 0x7f, 0xc1, 0x27, 0xf0, 0x11, 0x7c, 0x03, 0xea,
-	0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0x3e, 0x03, 0xad, 0xed, 0xc4, 0x01, 0xf5, 0xd0, 0xdb, 0xce,
-	0xbe, 0x79, 0x6f, 0xde, 0x5b, 0xcd, 0xd2, 0x97, 0x99, 0xc2, 0x1c, 0x24, 0x97, 0x11, 0x04, 0xa9,
-	0x8e, 0xe7, 0x00, 0x3a, 0xc8, 0x87, 0x9b, 0xa3, 0x9f, 0x29, 0x34, 0xe8, 0x3c, 0xab, 0x9b, 0xfc,
-	0x0d, 0x92, 0x0f, 0x8f, 0xbb, 0x31, 0xc6, 0x58, 0x74, 0x04, 0xf6, 0x54, 0x36, 0x1f, 0x7b, 0x11,
-	0xea, 0x14, 0x75, 0x30, 0xe5, 0x1a, 0x82, 0x7c, 0x38, 0x05, 0xc3, 0x87, 0x41, 0x84, 0x42, 0x96,
-	0x78, 0xff, 0x3b, 0xa1, 0xad, 0x09, 0x57, 0x3c, 0xd5, 0xce, 0x05, 0x7d, 0x32, 0x4f, 0x10, 0x15,
-	0x8b, 0xb9, 0x66, 0x99, 0x12, 0x11, 0xb8, 0x0f, 0x7a, 0x64, 0xd0, 0x39, 0x3d, 0xf2, 0x4b, 0x11,
-	0xdf, 0x8a, 0xf8, 0x95, 0x88, 0x7f, 0x8e, 0x42, 0x8e, 0x9a, 0x37, 0xbf, 0x4e, 0x1a, 0xe1, 0x41,
-	0xc1, 0xbb, 0xe0, 0x7a, 0x62, 0x59, 0xce, 0x2b, 0xfa, 0x54, 0x2e, 0xb8, 0x5e, 0xb0, 0x0c, 0x14,
-	0x5b, 0xea, 0x19, 0x4b, 0x45, 0xe2, 0xee, 0xf5, 0xc8, 0xa0, 0x19, 0x1e, 0x16, 0xc0, 0x04, 0xd4,
-	0x95, 0x9e, 0x5d, 0x8a, 0xc4, 0x79, 0x43, 0xbb, 0x11, 0xca, 0x1c, 0x94, 0x16, 0x28, 0xd9, 0x1c,
-	0x80, 0xcd, 0x40, 0x62, 0xea, 0x36, 0x7b, 0x64, 0xd0, 0x0e, 0x9d, 0x1a, 0x1b, 0x03, 0xbc, 0xb7,
-	0xc8, 0xbb, 0xe6, 0x9f, 0x6f, 0x27, 0x8d, 0xfe, 0x0f, 0x42, 0x5b, 0x97, 0x3a, 0x1e, 0x03, 0x38,
-	0x3d, 0xba, 0x9f, 0xea, 0x98, 0x99, 0xeb, 0x0c, 0xd8, 0x52, 0x25, 0x2e, 0x29, 0xa8, 0x34, 0xd5,
-	0xf1, 0xa7, 0xeb, 0x0c, 0xae, 0x54, 0xe2, 0x8c, 0xe9, 0x21, 0x9f, 0xcd, 0x84, 0x11, 0x28, 0x79,
-	0x62, 0x87, 0xdc, 0x3b, 0x57, 0x4d, 0xb3, 0x93, 0x5e, 0xd0, 0xb6, 0x82, 0x48, 0x64, 0x02, 0xa4,
-	0x29, 0xf2, 0xb4, 0xc3, 0xfa, 0xc2, 0x79, 0x4b, 0x9f, 0x6f, 0x0b, 0x36, 0xe5, 0x5a, 0x68, 0x96,
-	0xa1, 0x90, 0x46, 0x17, 0x61, 0x0e, 0xc2, 0xee, 0x16, 0x1d, 0x59, 0x70, 0x52, 0x60, 0x7d, 0x45,
-	0x3b, 0x1f, 0x72, 0x90, 0xa6, 0x0a, 0x73, 0x44, 0x1f, 0x6f, 0xc2, 0x54, 0x41, 0x1e, 0x55, 0x41,
-	0x9c, 0x2e, 0x7d, 0x18, 0xe1, 0x52, 0x9a, 0xc2, 0x7c, 0x3b, 0x2c, 0x0b, 0x7b, 0x6b, 0xd0, 0xf0,
-	0xa4, 0xf2, 0x53, 0x16, 0xff, 0x3a, 0x6d, 0xfe, 0xe7, 0xb4, 0xff, 0x91, 0xee, 0xef, 0xcc, 0xd4,
-	0xce, 0x79, 0x39, 0xd4, 0x2e, 0x92, 0x4b, 0x7a, 0x7b, 0x83, 0xce, 0x69, 0xdf, 0xbf, 0x73, 0xc7,
-	0xfc, 0x1d, 0x5a, 0xf5, 0x44, 0xd6, 0x9e, 0x15, 0x19, 0x89, 0x9b, 0x95, 0x47, 0x6e, 0x57, 0x1e,
-	0xf9, 0xbd, 0xf2, 0xc8, 0xd7, 0xb5, 0xd7, 0xb8, 0x5d, 0x7b, 0x8d, 0x9f, 0x6b, 0xaf, 0x41, 0x5d,
-	0x81, 0x77, 0xcb, 0x4d, 0xc8, 0xe7, 0xb3, 0x58, 0x98, 0xc5, 0x72, 0xea, 0x47, 0x98, 0x06, 0x75,
-	0xcf, 0x6b, 0x81, 0x3b, 0x55, 0xf0, 0x65, 0xfb, 0x17, 0xec, 0xbb, 0xe8, 0x69, 0xab, 0x58, 0xdd,
-	0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf6, 0x22, 0x0d, 0x2e, 0x03, 0x00, 0x00,
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x18, 0x8c, 0xdb, 0xfc, 0xe9, 0x9f, 0x4d, 0x5b, 0x84, 0x49, 0x91, 0x5b, 0x21, 0x37, 0x32, 0x97,
+	0x70, 0xc0, 0x26, 0x29, 0x27, 0x8e, 0x29, 0xa4, 0xa7, 0x4a, 0x91, 0x4b, 0x2f, 0x5c, 0xac, 0xb5,
+	0xfd, 0xc5, 0x59, 0xc9, 0xde, 0xb5, 0x76, 0xd7, 0x16, 0x7d, 0x0b, 0x1e, 0x81, 0x87, 0xe0, 0x19,
+	0x50, 0x8f, 0x3d, 0x72, 0x42, 0x28, 0xb9, 0xf0, 0x18, 0x68, 0xd7, 0x4e, 0x9c, 0xa2, 0x0a, 0xf5,
+	0xe6, 0x6f, 0xe7, 0x9b, 0x19, 0xcd, 0xac, 0x17, 0xbd, 0xcc, 0x39, 0x2b, 0x81, 0x62, 0x1a, 0x81,
+	0x97, 0x89, 0x64, 0x0e, 0x20, 0xbc, 0x72, 0xb4, 0xfe, 0x74, 0x73, 0xce, 0x24, 0x33, 0x8f, 0x9a,
+	0x25, 0x77, 0x8d, 0x94, 0xa3, 0x93, 0x7e, 0xc2, 0x12, 0xa6, 0x37, 0x3c, 0xf5, 0x55, 0x2d, 0x9f,
+	0xd8, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x88, 0x05, 0x78, 0xe5, 0x28, 0x04, 0x89, 0x47, 0x5e, 0xc4,
+	0x08, 0xad, 0x70, 0xe7, 0x9b, 0x81, 0x3a, 0x33, 0xcc, 0x71, 0x26, 0xcc, 0x0b, 0xf4, 0x64, 0x9e,
+	0x32, 0xc6, 0x83, 0x04, 0x8b, 0x20, 0xe7, 0x24, 0x02, 0x6b, 0x67, 0x60, 0x0c, 0x7b, 0xe3, 0x63,
+	0xb7, 0x12, 0x71, 0x95, 0x88, 0x5b, 0x8b, 0xb8, 0xe7, 0x8c, 0xd0, 0x49, 0xfb, 0xf6, 0xe7, 0x69,
+	0xcb, 0x3f, 0xd0, 0xbc, 0x0b, 0x2c, 0x66, 0x8a, 0x65, 0xbe, 0x42, 0x4f, 0xe9, 0x02, 0x8b, 0x45,
+	0x90, 0x03, 0x0f, 0x0a, 0x11, 0x07, 0x19, 0x49, 0xad, 0xdd, 0x81, 0x31, 0x6c, 0xfb, 0x87, 0x1a,
+	0x98, 0x01, 0xbf, 0x16, 0xf1, 0x25, 0x49, 0xcd, 0x37, 0xa8, 0x1f, 0x31, 0x5a, 0x02, 0x17, 0x84,
+	0xd1, 0x60, 0x0e, 0x10, 0xc4, 0x40, 0x59, 0x66, 0xb5, 0x07, 0xc6, 0xb0, 0xeb, 0x9b, 0x0d, 0x36,
+	0x05, 0x78, 0xaf, 0x90, 0x77, 0xed, 0xdf, 0x5f, 0x4f, 0x5b, 0xce, 0x77, 0x03, 0x75, 0x2e, 0x45,
+	0x32, 0x05, 0x30, 0x07, 0x68, 0x3f, 0x13, 0x49, 0x20, 0x6f, 0x72, 0x08, 0x0a, 0x9e, 0x5a, 0x86,
+	0xa6, 0xa2, 0x4c, 0x24, 0x1f, 0x6f, 0x72, 0xb8, 0xe6, 0xa9, 0x39, 0x45, 0x87, 0x38, 0x8e, 0x89,
+	0x24, 0x8c, 0xe2, 0x54, 0x99, 0x3c, 0x3a, 0x57, 0x43, 0x53, 0x4e, 0x2f, 0x50, 0x97, 0x43, 0x44,
+	0x72, 0x02, 0x54, 0xea, 0x3c, 0x5d, 0xbf, 0x39, 0x30, 0xdf, 0xa2, 0xe7, 0x9b, 0x21, 0x08, 0xb1,
+	0x20, 0x22, 0xc8, 0x19, 0xa1, 0x52, 0xe8, 0x30, 0x07, 0x7e, 0x7f, 0x83, 0x4e, 0x14, 0x38, 0xd3,
+	0x98, 0x23, 0x50, 0x6f, 0x0a, 0x70, 0x15, 0x2d, 0x20, 0x2e, 0x52, 0x6d, 0x81, 0xa5, 0xe4, 0x24,
+	0x2c, 0x24, 0xd4, 0x49, 0x9a, 0x03, 0x73, 0x8a, 0xba, 0x31, 0x11, 0x11, 0x2b, 0x94, 0xea, 0xce,
+	0x60, 0x77, 0xd8, 0x1b, 0x3b, 0xee, 0x83, 0x7f, 0x83, 0xab, 0xfa, 0xaa, 0x57, 0xeb, 0x30, 0x0d,
+	0xd5, 0x89, 0x50, 0x6f, 0x0b, 0x7f, 0x44, 0x83, 0x63, 0x74, 0xb4, 0x66, 0xdf, 0x8f, 0xb6, 0xa3,
+	0xa3, 0x3d, 0x5b, 0x83, 0xdb, 0xc9, 0x38, 0xea, 0x7d, 0x28, 0x81, 0xca, 0xfa, 0x9a, 0x8e, 0xd1,
+	0xff, 0x6b, 0x93, 0xda, 0x60, 0xaf, 0x36, 0x30, 0xfb, 0xe8, 0x3f, 0xcd, 0xd6, 0x6a, 0x5d, 0xbf,
+	0x1a, 0xd4, 0xa9, 0x64, 0x12, 0xa7, 0x75, 0xd3, 0xd5, 0x70, 0xff, 0x0e, 0xda, 0x7f, 0xdd, 0x81,
+	0x73, 0x85, 0xf6, 0xb7, 0x3c, 0x85, 0x79, 0x5e, 0x99, 0xaa, 0x52, 0x2c, 0xe3, 0x9f, 0x7d, 0x6d,
+	0xd1, 0xea, 0xbe, 0xf6, 0xb2, 0x4a, 0x64, 0x42, 0x6e, 0x97, 0xb6, 0x71, 0xb7, 0xb4, 0x8d, 0x5f,
+	0x4b, 0xdb, 0xf8, 0xb2, 0xb2, 0x5b, 0x77, 0x2b, 0xbb, 0xf5, 0x63, 0x65, 0xb7, 0x90, 0x45, 0xd8,
+	0xc3, 0x72, 0x33, 0xe3, 0xd3, 0x59, 0x42, 0xe4, 0xa2, 0x08, 0xdd, 0x88, 0x65, 0x5e, 0xb3, 0xf3,
+	0x9a, 0xb0, 0xad, 0xc9, 0xfb, 0xbc, 0x79, 0xe5, 0xaa, 0x17, 0x11, 0x76, 0xf4, 0xa3, 0x3c, 0xfb,
+	0x33, 0x00, 0xa8, 0x9b, 0x0c, 0x57, 0x08, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -433,6 +552,85 @@ func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Discounts) > 0 {
+		for iNdEx := len(m.Discounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Discounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DiscountBasisPoints != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.DiscountBasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -572,6 +770,41 @@ func (m *MsgFee) Size() (n int) {
 	return n
 }
 
+func (m *FeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.Discounts) > 0 {
+		for _, e := range m.Discounts {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.DiscountBasisPoints != 0 {
+		n += 1 + sovMsgfees(uint64(m.DiscountBasisPoints))
+	}
+	return n
+}
+
 func (m *EventMsgFee) Size() (n int) {
 	if m == nil {
 		return 0
@@ -918,6 +1151,223 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discounts = append(m.Discounts, FeeDiscount{})
+			if err := m.Discounts[len(m.Discounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountBasisPoints", wireType)
+			}
+			m.DiscountBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscountBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventMsgFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
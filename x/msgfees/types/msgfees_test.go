@@ -60,3 +60,77 @@ func TestMsgFeeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestFeeScheduleValidate(t *testing.T) {
+	sendType := "/cosmos.bank.v1beta1.MsgSend"
+	cases := []struct {
+		name     string
+		schedule FeeSchedule
+		errorMsg string
+	}{
+		{
+			"should succeed to validate with a discount and an exemption",
+			NewFeeSchedule("tier.fees.pb", NewFeeDiscount(sendType, 2_500), NewFeeDiscount(sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{}), 10_000)),
+			"",
+		},
+		{
+			"should fail to validate without an attribute",
+			NewFeeSchedule("", NewFeeDiscount(sendType, 2_500)),
+			"attribute cannot be empty: invalid fee schedule",
+		},
+		{
+			"should fail to validate with an attribute that is not normalized",
+			NewFeeSchedule("Tier.Fees.pb", NewFeeDiscount(sendType, 2_500)),
+			`attribute "Tier.Fees.pb" must be normalized: invalid fee schedule`,
+		},
+		{
+			"should fail to validate with an invalid attribute",
+			NewFeeSchedule("tier.fee$.pb", NewFeeDiscount(sendType, 2_500)),
+			`invalid name "tier.fee$.pb": illegal character "$" in name segment "fee$": invalid fee schedule`,
+		},
+		{
+			"should fail to validate without discounts",
+			NewFeeSchedule("tier.fees.pb"),
+			`fee schedule for "tier.fees.pb" must have at least one discount: invalid fee schedule`,
+		},
+		{
+			"should fail to validate with a discount without a msg type",
+			NewFeeSchedule("tier.fees.pb", NewFeeDiscount("", 2_500)),
+			`fee schedule for "tier.fees.pb": msg type is empty: invalid fee schedule`,
+		},
+		{
+			"should fail to validate with a zero discount",
+			NewFeeSchedule("tier.fees.pb", NewFeeDiscount(sendType, 0)),
+			`fee schedule for "tier.fees.pb": discount basis points for "/cosmos.bank.v1beta1.MsgSend" can only be between 1 and 10,000 : 0: invalid fee schedule`,
+		},
+		{
+			"should fail to validate with a discount that is too large",
+			NewFeeSchedule("tier.fees.pb", NewFeeDiscount(sendType, 10_001)),
+			`fee schedule for "tier.fees.pb": discount basis points for "/cosmos.bank.v1beta1.MsgSend" can only be between 1 and 10,000 : 10001: invalid fee schedule`,
+		},
+		{
+			"should fail to validate with two discounts for the same msg type",
+			NewFeeSchedule("tier.fees.pb", NewFeeDiscount(sendType, 2_500), NewFeeDiscount(sendType, 5_000)),
+			`fee schedule for "tier.fees.pb" has more than one discount for "/cosmos.bank.v1beta1.MsgSend": invalid fee schedule`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeeScheduleGetDiscountBips(t *testing.T) {
+	schedule := NewFeeSchedule("tier.fees.pb", NewFeeDiscount("/cosmos.bank.v1beta1.MsgSend", 2_500))
+	require.Equal(t, uint32(2_500), schedule.GetDiscountBips("/cosmos.bank.v1beta1.MsgSend"), "discounted msg type")
+	require.Equal(t, uint32(0), schedule.GetDiscountBips("/cosmos.bank.v1beta1.MsgMultiSend"), "msg type without a discount")
+}
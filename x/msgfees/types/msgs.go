@@ -25,6 +25,9 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgRemoveMsgFeeProposalRequest)(nil),
 	(*MsgUpdateConversionFeeDenomProposalRequest)(nil),
 	(*MsgUpdateNhashPerUsdMilProposalRequest)(nil),
+	(*MsgAddFeeScheduleProposalRequest)(nil),
+	(*MsgUpdateFeeScheduleProposalRequest)(nil),
+	(*MsgRemoveFeeScheduleProposalRequest)(nil),
 }
 
 func NewMsgAssessCustomMsgFeeRequest(
@@ -223,3 +226,63 @@ func (msg *MsgUpdateNhashPerUsdMilProposalRequest) ValidateBasic() error {
 
 	return nil
 }
+
+func NewMsgAddFeeScheduleProposalRequest(feeSchedule FeeSchedule, authority string) *MsgAddFeeScheduleProposalRequest {
+	return &MsgAddFeeScheduleProposalRequest{
+		FeeSchedule: feeSchedule,
+		Authority:   authority,
+	}
+}
+
+func (msg *MsgAddFeeScheduleProposalRequest) ValidateBasic() error {
+	if err := msg.FeeSchedule.Validate(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgUpdateFeeScheduleProposalRequest(feeSchedule FeeSchedule, authority string) *MsgUpdateFeeScheduleProposalRequest {
+	return &MsgUpdateFeeScheduleProposalRequest{
+		FeeSchedule: feeSchedule,
+		Authority:   authority,
+	}
+}
+
+func (msg *MsgUpdateFeeScheduleProposalRequest) ValidateBasic() error {
+	if err := msg.FeeSchedule.Validate(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgRemoveFeeScheduleProposalRequest(attribute string, authority string) *MsgRemoveFeeScheduleProposalRequest {
+	return &MsgRemoveFeeScheduleProposalRequest{
+		Attribute: attribute,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgRemoveFeeScheduleProposalRequest) ValidateBasic() error {
+	if len(msg.Attribute) == 0 {
+		return ErrInvalidFeeSchedule.Wrap("attribute cannot be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
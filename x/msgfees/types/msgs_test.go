@@ -21,6 +21,9 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgRemoveMsgFeeProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateConversionFeeDenomProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateNhashPerUsdMilProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgAddFeeScheduleProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateFeeScheduleProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveFeeScheduleProposalRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -422,6 +425,76 @@ func TestMsgRemoveMsgFeeProposalRequestValidateBasic(t *testing.T) {
 	}
 }
 
+func TestFeeScheduleProposalRequestsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("input111111111111111").String()
+	schedule := NewFeeSchedule("tier.fees.pb", NewFeeDiscount(sdk.MsgTypeURL(&metadatatypes.MsgWriteRecordRequest{}), 5_000))
+
+	cases := []struct {
+		name     string
+		msg      sdk.HasValidateBasic
+		errorMsg string
+	}{
+		{
+			name:     "add valid message",
+			msg:      NewMsgAddFeeScheduleProposalRequest(schedule, authority),
+			errorMsg: "",
+		},
+		{
+			name:     "add invalid fee schedule",
+			msg:      NewMsgAddFeeScheduleProposalRequest(NewFeeSchedule("tier.fees.pb"), authority),
+			errorMsg: `fee schedule for "tier.fees.pb" must have at least one discount: invalid fee schedule`,
+		},
+		{
+			name:     "add invalid authority",
+			msg:      NewMsgAddFeeScheduleProposalRequest(schedule, ""),
+			errorMsg: "empty address string is not allowed",
+		},
+		{
+			name:     "update valid message",
+			msg:      NewMsgUpdateFeeScheduleProposalRequest(schedule, authority),
+			errorMsg: "",
+		},
+		{
+			name:     "update invalid fee schedule",
+			msg:      NewMsgUpdateFeeScheduleProposalRequest(NewFeeSchedule("", schedule.Discounts...), authority),
+			errorMsg: "attribute cannot be empty: invalid fee schedule",
+		},
+		{
+			name:     "update invalid authority",
+			msg:      NewMsgUpdateFeeScheduleProposalRequest(schedule, ""),
+			errorMsg: "empty address string is not allowed",
+		},
+		{
+			name:     "remove valid message",
+			msg:      NewMsgRemoveFeeScheduleProposalRequest("tier.fees.pb", authority),
+			errorMsg: "",
+		},
+		{
+			name:     "remove empty attribute",
+			msg:      NewMsgRemoveFeeScheduleProposalRequest("", authority),
+			errorMsg: "attribute cannot be empty: invalid fee schedule",
+		},
+		{
+			name:     "remove invalid authority",
+			msg:      NewMsgRemoveFeeScheduleProposalRequest("tier.fees.pb", ""),
+			errorMsg: "empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgUpdateNhashPerUsdMilProposalRequestValidateBasic(t *testing.T) {
 	authority := sdk.AccAddress("input111111111111111").String()
 
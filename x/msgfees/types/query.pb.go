@@ -217,6 +217,107 @@ func (m *QueryAllMsgFeesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAllFeeSchedulesRequest queries all the attribute-based fee schedules.
+type QueryAllFeeSchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllFeeSchedulesRequest) Reset()         { *m = QueryAllFeeSchedulesRequest{} }
+func (m *QueryAllFeeSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFeeSchedulesRequest) ProtoMessage()    {}
+func (*QueryAllFeeSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{4}
+}
+func (m *QueryAllFeeSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFeeSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFeeSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllFeeSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFeeSchedulesRequest.Merge(m, src)
+}
+func (m *QueryAllFeeSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFeeSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFeeSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFeeSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryAllFeeSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllFeeSchedulesResponse is the response type for the Query/QueryAllFeeSchedules RPC method.
+type QueryAllFeeSchedulesResponse struct {
+	// fee_schedules are the attribute-based fee schedules.
+	FeeSchedules []FeeSchedule `protobuf:"bytes,1,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllFeeSchedulesResponse) Reset()         { *m = QueryAllFeeSchedulesResponse{} }
+func (m *QueryAllFeeSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFeeSchedulesResponse) ProtoMessage()    {}
+func (*QueryAllFeeSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+}
+func (m *QueryAllFeeSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFeeSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFeeSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllFeeSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFeeSchedulesResponse.Merge(m, src)
+}
+func (m *QueryAllFeeSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFeeSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFeeSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFeeSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryAllFeeSchedulesResponse) GetFeeSchedules() []FeeSchedule {
+	if m != nil {
+		return m.FeeSchedules
+	}
+	return nil
+}
+
+func (m *QueryAllFeeSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // CalculateTxFeesRequest is the request type for the Query RPC method.
 type CalculateTxFeesRequest struct {
 	// tx_bytes is the transaction to simulate.
@@ -232,7 +333,7 @@ func (m *CalculateTxFeesRequest) Reset()         { *m = CalculateTxFeesRequest{}
 func (m *CalculateTxFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*CalculateTxFeesRequest) ProtoMessage()    {}
 func (*CalculateTxFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{4}
+	return fileDescriptor_73f2d53a5aebf81b, []int{6}
 }
 func (m *CalculateTxFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +398,7 @@ func (m *CalculateTxFeesResponse) Reset()         { *m = CalculateTxFeesResponse
 func (m *CalculateTxFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*CalculateTxFeesResponse) ProtoMessage()    {}
 func (*CalculateTxFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+	return fileDescriptor_73f2d53a5aebf81b, []int{7}
 }
 func (m *CalculateTxFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,6 +453,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.msgfees.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryAllMsgFeesRequest)(nil), "provenance.msgfees.v1.QueryAllMsgFeesRequest")
 	proto.RegisterType((*QueryAllMsgFeesResponse)(nil), "provenance.msgfees.v1.QueryAllMsgFeesResponse")
+	proto.RegisterType((*QueryAllFeeSchedulesRequest)(nil), "provenance.msgfees.v1.QueryAllFeeSchedulesRequest")
+	proto.RegisterType((*QueryAllFeeSchedulesResponse)(nil), "provenance.msgfees.v1.QueryAllFeeSchedulesResponse")
 	proto.RegisterType((*CalculateTxFeesRequest)(nil), "provenance.msgfees.v1.CalculateTxFeesRequest")
 	proto.RegisterType((*CalculateTxFeesResponse)(nil), "provenance.msgfees.v1.CalculateTxFeesResponse")
 }
@@ -359,52 +462,58 @@ func init() {
 func init() { proto.RegisterFile("provenance/msgfees/v1/query.proto", fileDescriptor_73f2d53a5aebf81b) }
 
 var fileDescriptor_73f2d53a5aebf81b = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xc7, 0x3b, 0x85, 0x97, 0x1f, 0xf3, 0xf2, 0xe3, 0x7d, 0x47, 0x84, 0xd2, 0x40, 0xc1, 0x25,
-	0x28, 0x34, 0xb2, 0x9b, 0x82, 0x07, 0xa3, 0x27, 0x8a, 0x81, 0x93, 0x09, 0x6e, 0x3c, 0x79, 0x59,
-	0xa7, 0xbb, 0xc3, 0xb8, 0xba, 0xbb, 0xb3, 0x74, 0xa6, 0x4d, 0x7b, 0x33, 0x1e, 0x8c, 0xf1, 0x64,
-	0xa2, 0x27, 0xe3, 0xd9, 0x18, 0x4f, 0xfc, 0x19, 0x9c, 0x0c, 0x89, 0x17, 0x4f, 0x6a, 0xc0, 0x84,
-	0x7f, 0xc3, 0xcc, 0xcc, 0xb6, 0x5d, 0x68, 0x8b, 0x9c, 0xbc, 0xc0, 0xf6, 0x99, 0xe7, 0x99, 0xef,
-	0x67, 0x9e, 0xf9, 0x3e, 0x03, 0xaf, 0xc5, 0x55, 0x56, 0x27, 0x11, 0x8e, 0x5c, 0x62, 0x85, 0x9c,
-	0xee, 0x11, 0xc2, 0xad, 0x7a, 0xc9, 0xda, 0xaf, 0x91, 0x6a, 0xd3, 0x8c, 0xab, 0x4c, 0x30, 0x74,
-	0xb5, 0x93, 0x62, 0x26, 0x29, 0x66, 0xbd, 0x94, 0xff, 0x1f, 0x87, 0x7e, 0xc4, 0x2c, 0xf5, 0x57,
-	0x67, 0xe6, 0xa7, 0x28, 0xa3, 0x4c, 0x7d, 0x5a, 0xf2, 0x2b, 0x89, 0xce, 0x51, 0xc6, 0x68, 0x40,
-	0x2c, 0x1c, 0xfb, 0x16, 0x8e, 0x22, 0x26, 0xb0, 0xf0, 0x59, 0xc4, 0x93, 0xd5, 0xa5, 0xde, 0x00,
-	0x2d, 0x21, 0x9d, 0x54, 0x70, 0x19, 0x0f, 0x19, 0xb7, 0x2a, 0x98, 0x13, 0xab, 0x5e, 0xaa, 0x10,
-	0x81, 0x4b, 0x96, 0xcb, 0xfc, 0x28, 0x59, 0x2f, 0xa6, 0xd7, 0x15, 0x7b, 0x3b, 0x2b, 0xc6, 0xd4,
-	0x8f, 0x94, 0xa2, 0xce, 0x35, 0xa6, 0x20, 0x7a, 0x20, 0x33, 0x76, 0x71, 0x15, 0x87, 0xdc, 0x26,
-	0xfb, 0x35, 0xc2, 0x85, 0x61, 0xc3, 0x2b, 0x67, 0xa2, 0x3c, 0x66, 0x11, 0x27, 0xe8, 0x2e, 0x1c,
-	0x8a, 0x55, 0x24, 0x07, 0x16, 0xc1, 0xca, 0xbf, 0xeb, 0xf3, 0x66, 0xcf, 0x66, 0x98, 0xba, 0xac,
-	0x3c, 0x78, 0xf8, 0x7d, 0x21, 0x63, 0x27, 0x25, 0xc6, 0x63, 0x38, 0xad, 0xf6, 0xdc, 0x0c, 0x82,
-	0xfb, 0x9c, 0x6e, 0x13, 0xd2, 0x52, 0x43, 0xdb, 0x10, 0x76, 0xb8, 0x72, 0x59, 0xb5, 0xf5, 0x75,
-	0x53, 0x1f, 0xc2, 0x94, 0x87, 0x30, 0xf5, 0x05, 0x24, 0x87, 0x30, 0x77, 0x31, 0x25, 0x49, 0xad,
-	0x9d, 0xaa, 0x34, 0x3e, 0x00, 0x38, 0xd3, 0x25, 0x91, 0xa0, 0xdf, 0x86, 0x23, 0x21, 0xa7, 0x8e,
-	0x24, 0xcc, 0x81, 0xc5, 0x81, 0x0b, 0xe0, 0x75, 0xa5, 0x3d, 0x1c, 0xea, 0x1d, 0xd0, 0x4e, 0x0f,
-	0xba, 0x1b, 0x7f, 0xa4, 0xd3, 0xb2, 0x67, 0xf0, 0x5e, 0x01, 0x38, 0xbd, 0x85, 0x03, 0xb7, 0x16,
-	0x60, 0x41, 0x1e, 0x36, 0xd2, 0x1d, 0x98, 0x85, 0x23, 0xa2, 0xe1, 0x54, 0x9a, 0x82, 0xe8, 0xd6,
-	0x8e, 0xd9, 0xc3, 0xa2, 0x51, 0x96, 0x3f, 0xd1, 0x4d, 0x88, 0x3c, 0xb2, 0x87, 0x6b, 0x81, 0x70,
-	0xa4, 0x98, 0xe3, 0x91, 0x88, 0x85, 0x0a, 0x63, 0xd4, 0xfe, 0x2f, 0x59, 0x29, 0x63, 0x4e, 0xee,
-	0xc9, 0x38, 0x5a, 0x86, 0x13, 0x14, 0x73, 0x07, 0x7b, 0x4f, 0x6b, 0x5c, 0x84, 0x24, 0x12, 0xb9,
-	0x81, 0x45, 0xb0, 0x92, 0xb5, 0xc7, 0x29, 0xe6, 0x9b, 0xed, 0xa0, 0xf1, 0x25, 0x0b, 0x67, 0xba,
-	0x50, 0x92, 0x4e, 0xbd, 0x06, 0x70, 0x12, 0x7b, 0x9e, 0x2f, 0x99, 0x71, 0x90, 0xee, 0xd8, 0xec,
-	0x99, 0x53, 0xb7, 0xce, 0xbb, 0xc5, 0xfc, 0xa8, 0xbc, 0x2d, 0xaf, 0xfa, 0xf3, 0x8f, 0x85, 0x15,
-	0xea, 0x8b, 0x27, 0xb5, 0x8a, 0xe9, 0xb2, 0xd0, 0x4a, 0x5c, 0xa8, 0xff, 0xad, 0x71, 0xef, 0x99,
-	0x25, 0x9a, 0x31, 0xe1, 0xaa, 0x80, 0xbf, 0x3f, 0x3d, 0x28, 0x8e, 0x05, 0x84, 0x62, 0xb7, 0xe9,
-	0x48, 0xeb, 0xf2, 0x4f, 0xa7, 0x07, 0x45, 0x60, 0x4f, 0x74, 0x94, 0x55, 0xf3, 0x9f, 0x03, 0x08,
-	0x05, 0x13, 0x2d, 0x8e, 0xec, 0xdf, 0xe2, 0x18, 0x55, 0xa2, 0x0a, 0x61, 0x09, 0x8e, 0x13, 0x2e,
-	0xfc, 0x10, 0x0b, 0xe2, 0x39, 0x14, 0x73, 0xd5, 0xd1, 0x41, 0x7b, 0xac, 0x1d, 0xdc, 0xc1, 0x7c,
-	0xfd, 0x68, 0x00, 0xfe, 0xa3, 0xac, 0x87, 0x5e, 0x02, 0x38, 0xa4, 0xfd, 0x8f, 0x56, 0xfb, 0x38,
-	0xac, 0x7b, 0xe0, 0xf2, 0xc5, 0xcb, 0xa4, 0xea, 0x0b, 0x32, 0x96, 0x5f, 0x7c, 0xfd, 0xf5, 0x36,
-	0xbb, 0x80, 0xe6, 0xad, 0xde, 0x8f, 0x85, 0x9e, 0x37, 0xf4, 0x0e, 0xc0, 0xc9, 0x73, 0xd3, 0x80,
-	0xd6, 0x2e, 0x92, 0xe9, 0x1a, 0xcc, 0xbc, 0x79, 0xd9, 0xf4, 0x84, 0xcc, 0x50, 0x64, 0x73, 0x28,
-	0xdf, 0x87, 0x0c, 0x07, 0x01, 0xfa, 0x08, 0xe0, 0xe4, 0x39, 0xeb, 0xf5, 0xc5, 0xea, 0x3d, 0x2d,
-	0x7d, 0xb1, 0xfa, 0x38, 0xda, 0xb8, 0xa5, 0xb0, 0xcc, 0x3b, 0xa0, 0x68, 0xac, 0xa6, 0xc9, 0x44,
-	0x43, 0x42, 0xb9, 0xad, 0x2a, 0x47, 0x3e, 0x10, 0xd2, 0x52, 0x9e, 0x34, 0x5b, 0xd9, 0x3f, 0x3c,
-	0x2e, 0x80, 0xa3, 0xe3, 0x02, 0xf8, 0x79, 0x5c, 0x00, 0x6f, 0x4e, 0x0a, 0x99, 0xa3, 0x93, 0x42,
-	0xe6, 0xdb, 0x49, 0x21, 0x03, 0x73, 0x3e, 0xeb, 0x4d, 0xb0, 0x0b, 0x1e, 0x6d, 0xa4, 0x8c, 0xd7,
-	0xc9, 0x59, 0xf3, 0x59, 0x5a, 0xb8, 0xd1, 0x6e, 0x8a, 0x72, 0x62, 0x65, 0x48, 0xbd, 0xc5, 0x1b,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x36, 0xb4, 0xcd, 0xe3, 0x7f, 0x06, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xc7, 0x3d, 0xe6, 0xf7, 0xd4, 0x40, 0x3b, 0xa5, 0x60, 0x5c, 0x30, 0x74, 0x29, 0x14, 0x2c,
+	0xd8, 0x95, 0xa1, 0x87, 0xaa, 0x3d, 0x61, 0x2a, 0x73, 0x42, 0xa2, 0xdb, 0x9e, 0x7a, 0xd9, 0x8c,
+	0x77, 0xc7, 0xcb, 0x26, 0xbb, 0x3b, 0xc6, 0x33, 0xb6, 0xec, 0x1b, 0xca, 0x21, 0x8a, 0x72, 0x8a,
+	0x94, 0x9c, 0xa2, 0x9c, 0xa3, 0x28, 0x52, 0x24, 0x4e, 0xf9, 0x1b, 0x38, 0x45, 0x48, 0xb9, 0xe4,
+	0x94, 0x44, 0x10, 0x89, 0x7f, 0x23, 0xda, 0xd9, 0xb1, 0xbd, 0xc6, 0x6b, 0x20, 0x51, 0x94, 0x8b,
+	0xbd, 0x9e, 0x79, 0x6f, 0xbe, 0x9f, 0xf9, 0xee, 0x7b, 0xcf, 0xf0, 0x97, 0x4a, 0x95, 0xd6, 0x89,
+	0x8f, 0x7d, 0x93, 0x68, 0x1e, 0xb3, 0xcb, 0x84, 0x30, 0xad, 0x9e, 0xd7, 0x0e, 0x6b, 0xa4, 0xda,
+	0x54, 0x2b, 0x55, 0xca, 0x29, 0xfa, 0xa9, 0x13, 0xa2, 0xca, 0x10, 0xb5, 0x9e, 0xcf, 0xfc, 0x80,
+	0x3d, 0xc7, 0xa7, 0x9a, 0xf8, 0x0c, 0x23, 0x33, 0x53, 0x36, 0xb5, 0xa9, 0x78, 0xd4, 0x82, 0x27,
+	0xb9, 0x3a, 0x67, 0x53, 0x6a, 0xbb, 0x44, 0xc3, 0x15, 0x47, 0xc3, 0xbe, 0x4f, 0x39, 0xe6, 0x0e,
+	0xf5, 0x99, 0xdc, 0x5d, 0x8a, 0x07, 0x68, 0x09, 0x85, 0x41, 0x59, 0x93, 0x32, 0x8f, 0x32, 0xad,
+	0x84, 0x19, 0xd1, 0xea, 0xf9, 0x12, 0xe1, 0x38, 0xaf, 0x99, 0xd4, 0xf1, 0xe5, 0x7e, 0x2e, 0xba,
+	0x2f, 0xd8, 0xdb, 0x51, 0x15, 0x6c, 0x3b, 0xbe, 0x50, 0x0c, 0x63, 0x95, 0x29, 0x88, 0xfe, 0x09,
+	0x22, 0xf6, 0x71, 0x15, 0x7b, 0x4c, 0x27, 0x87, 0x35, 0xc2, 0xb8, 0xa2, 0xc3, 0x1f, 0xbb, 0x56,
+	0x59, 0x85, 0xfa, 0x8c, 0xa0, 0xbf, 0xe0, 0x70, 0x45, 0xac, 0xa4, 0xc1, 0x22, 0x58, 0xfd, 0x6e,
+	0x73, 0x5e, 0x8d, 0x35, 0x43, 0x0d, 0xd3, 0x0a, 0x83, 0x27, 0xef, 0x16, 0x12, 0xba, 0x4c, 0x51,
+	0x6e, 0xc1, 0x69, 0x71, 0xe6, 0xb6, 0xeb, 0xee, 0x31, 0xbb, 0x48, 0x48, 0x4b, 0x0d, 0x15, 0x21,
+	0xec, 0x70, 0xa5, 0x93, 0xe2, 0xe8, 0x15, 0x35, 0xbc, 0x84, 0x1a, 0x5c, 0x42, 0x0d, 0x5f, 0x80,
+	0xbc, 0x84, 0xba, 0x8f, 0x6d, 0x22, 0x73, 0xf5, 0x48, 0xa6, 0xf2, 0x14, 0xc0, 0x99, 0x1e, 0x09,
+	0x89, 0xfe, 0x07, 0x1c, 0xf5, 0x98, 0x6d, 0x04, 0x84, 0x69, 0xb0, 0x38, 0x70, 0x05, 0x7c, 0x98,
+	0xa9, 0x8f, 0x78, 0xe1, 0x09, 0x68, 0x37, 0x86, 0xee, 0xb7, 0x6b, 0xe9, 0x42, 0xd9, 0x2e, 0x3c,
+	0x02, 0x7f, 0x6e, 0xd1, 0x15, 0x09, 0xf9, 0xd7, 0x3c, 0x20, 0x56, 0xcd, 0xed, 0xe7, 0x02, 0xf8,
+	0x62, 0x17, 0x5e, 0x01, 0x38, 0x17, 0xaf, 0x23, 0xad, 0xd8, 0x83, 0xe3, 0x65, 0x42, 0x0c, 0xd6,
+	0xda, 0x90, 0x7e, 0x28, 0x7d, 0xfc, 0x88, 0x9c, 0x21, 0xdf, 0x68, 0xaa, 0x1c, 0x39, 0xf6, 0xeb,
+	0xf9, 0x73, 0x1f, 0xc0, 0xe9, 0x1d, 0xec, 0x9a, 0x35, 0x17, 0x73, 0xf2, 0x5f, 0x23, 0x5a, 0x21,
+	0xb3, 0x70, 0x94, 0x37, 0x8c, 0x52, 0x93, 0x93, 0xb0, 0xf4, 0x52, 0xfa, 0x08, 0x6f, 0x14, 0x82,
+	0x9f, 0x68, 0x1d, 0x22, 0x8b, 0x94, 0x71, 0xcd, 0xe5, 0x46, 0x20, 0x66, 0x58, 0xc4, 0xa7, 0x9e,
+	0xc0, 0x18, 0xd3, 0xbf, 0x97, 0x3b, 0x05, 0xcc, 0xc8, 0xdf, 0xc1, 0x3a, 0x5a, 0x86, 0x13, 0x36,
+	0x66, 0x06, 0xb6, 0x6e, 0xd7, 0x18, 0xf7, 0x88, 0xcf, 0xd3, 0x03, 0x8b, 0x60, 0x35, 0xa9, 0x8f,
+	0xdb, 0x98, 0x6d, 0xb7, 0x17, 0x95, 0xd7, 0x49, 0x38, 0xd3, 0x83, 0x22, 0xed, 0x7b, 0x00, 0xe0,
+	0x24, 0xb6, 0x2c, 0x27, 0x60, 0xc6, 0x6e, 0xb4, 0xa2, 0x66, 0xbb, 0x6e, 0xdd, 0xba, 0xef, 0x0e,
+	0x75, 0xfc, 0x42, 0x31, 0x30, 0xee, 0xc5, 0xfb, 0x85, 0x55, 0xdb, 0xe1, 0x07, 0xb5, 0x92, 0x6a,
+	0x52, 0x4f, 0x93, 0x5d, 0x1a, 0x7e, 0x6d, 0x30, 0xeb, 0x8e, 0xc6, 0x9b, 0x15, 0xc2, 0x44, 0x02,
+	0x7b, 0x72, 0x71, 0x9c, 0x4b, 0xb9, 0xc4, 0xc6, 0x66, 0xd3, 0x08, 0x5a, 0x9b, 0x3d, 0xbf, 0x38,
+	0xce, 0x01, 0x7d, 0xa2, 0xa3, 0x2c, 0x8a, 0xf3, 0x08, 0x40, 0xc8, 0x29, 0x6f, 0x71, 0x24, 0xbf,
+	0x15, 0xc7, 0x98, 0x10, 0x15, 0x08, 0x4b, 0x70, 0x9c, 0x30, 0xee, 0x78, 0x98, 0x13, 0xcb, 0xb0,
+	0x31, 0x13, 0x8e, 0x0e, 0xea, 0xa9, 0xf6, 0xe2, 0x2e, 0x66, 0x9b, 0x47, 0x43, 0x70, 0x48, 0x14,
+	0x25, 0xba, 0x07, 0xe0, 0x70, 0x38, 0x1f, 0xd0, 0x5a, 0x9f, 0x8a, 0xeb, 0x1d, 0x48, 0x99, 0xdc,
+	0x4d, 0x42, 0xc3, 0x17, 0xa4, 0x2c, 0xdf, 0x7d, 0xf3, 0xf1, 0x51, 0x72, 0x01, 0xcd, 0x6b, 0xf1,
+	0xc3, 0x34, 0x9c, 0x47, 0xe8, 0x31, 0x80, 0x93, 0x97, 0xa6, 0x05, 0xda, 0xb8, 0x4a, 0xa6, 0x67,
+	0x70, 0x65, 0xd4, 0x9b, 0x86, 0x4b, 0x32, 0x45, 0x90, 0xcd, 0xa1, 0x4c, 0x1f, 0x32, 0xec, 0xba,
+	0xe8, 0x25, 0x80, 0x53, 0x71, 0xed, 0x8b, 0x36, 0xaf, 0x11, 0x8b, 0x99, 0x29, 0x99, 0xad, 0xcf,
+	0xca, 0x91, 0x94, 0xeb, 0x82, 0x72, 0x05, 0xfd, 0xda, 0x87, 0xb2, 0x6b, 0x78, 0xa0, 0x67, 0x00,
+	0x4e, 0x5e, 0x6a, 0x95, 0xbe, 0x36, 0xc6, 0x77, 0x77, 0x46, 0xbd, 0x69, 0xb8, 0x04, 0xfc, 0x5d,
+	0x00, 0xaa, 0x7f, 0x82, 0x9c, 0xb2, 0x16, 0x65, 0xe4, 0x8d, 0x00, 0xcf, 0x6c, 0x65, 0x19, 0xc1,
+	0xc0, 0x0f, 0x5a, 0xc0, 0x0a, 0x9a, 0xa3, 0xe0, 0x9c, 0x9c, 0x65, 0xc1, 0xe9, 0x59, 0x16, 0x7c,
+	0x38, 0xcb, 0x82, 0x87, 0xe7, 0xd9, 0xc4, 0xe9, 0x79, 0x36, 0xf1, 0xf6, 0x3c, 0x9b, 0x80, 0x69,
+	0x87, 0xc6, 0x13, 0xec, 0x83, 0xff, 0xb7, 0x22, 0x8d, 0xd2, 0x89, 0xd9, 0x70, 0x68, 0x54, 0xb8,
+	0xd1, 0xb6, 0x47, 0x74, 0x4e, 0x69, 0x58, 0xfc, 0xb7, 0x6e, 0x7d, 0x1a, 0x00, 0x3e, 0xf7, 0xcd,
+	0xf0, 0x4f, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +532,8 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Query all Msgs which have fees associated with them.
 	QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRequest, opts ...grpc.CallOption) (*QueryAllMsgFeesResponse, error)
+	// QueryAllFeeSchedules returns all the attribute-based fee schedules.
+	QueryAllFeeSchedules(ctx context.Context, in *QueryAllFeeSchedulesRequest, opts ...grpc.CallOption) (*QueryAllFeeSchedulesResponse, error)
 	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
 	CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error)
 }
@@ -453,6 +564,15 @@ func (c *queryClient) QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRe
 	return out, nil
 }
 
+func (c *queryClient) QueryAllFeeSchedules(ctx context.Context, in *QueryAllFeeSchedulesRequest, opts ...grpc.CallOption) (*QueryAllFeeSchedulesResponse, error) {
+	out := new(QueryAllFeeSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/QueryAllFeeSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error) {
 	out := new(CalculateTxFeesResponse)
 	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/CalculateTxFees", in, out, opts...)
@@ -468,6 +588,8 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Query all Msgs which have fees associated with them.
 	QueryAllMsgFees(context.Context, *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error)
+	// QueryAllFeeSchedules returns all the attribute-based fee schedules.
+	QueryAllFeeSchedules(context.Context, *QueryAllFeeSchedulesRequest) (*QueryAllFeeSchedulesResponse, error)
 	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
 	CalculateTxFees(context.Context, *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error)
 }
@@ -482,6 +604,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) QueryAllMsgFees(ctx context.Context, req *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAllMsgFees not implemented")
 }
+func (*UnimplementedQueryServer) QueryAllFeeSchedules(ctx context.Context, req *QueryAllFeeSchedulesRequest) (*QueryAllFeeSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllFeeSchedules not implemented")
+}
 func (*UnimplementedQueryServer) CalculateTxFees(ctx context.Context, req *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateTxFees not implemented")
 }
@@ -526,6 +651,24 @@ func _Query_QueryAllMsgFees_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryAllFeeSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFeeSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllFeeSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/QueryAllFeeSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllFeeSchedules(ctx, req.(*QueryAllFeeSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CalculateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalculateTxFeesRequest)
 	if err := dec(in); err != nil {
@@ -557,6 +700,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAllMsgFees",
 			Handler:    _Query_QueryAllMsgFees_Handler,
 		},
+		{
+			MethodName: "QueryAllFeeSchedules",
+			Handler:    _Query_QueryAllFeeSchedules_Handler,
+		},
 		{
 			MethodName: "CalculateTxFees",
 			Handler:    _Query_CalculateTxFees_Handler,
@@ -706,6 +853,90 @@ func (m *QueryAllMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllFeeSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFeeSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFeeSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFeeSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFeeSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFeeSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeSchedules) > 0 {
+		for iNdEx := len(m.FeeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -868,6 +1099,38 @@ func (m *QueryAllMsgFeesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllFeeSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFeeSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeSchedules) > 0 {
+		for _, e := range m.FeeSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *CalculateTxFeesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1257,6 +1520,212 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAllFeeSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFeeSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFeeSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFeeSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFeeSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFeeSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSchedules = append(m.FeeSchedules, FeeSchedule{})
+			if err := m.FeeSchedules[len(m.FeeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CalculateTxFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -637,6 +637,282 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateConversionFeeDenomProposalResponse proto.InternalMessageInfo
 
+// MsgAddFeeScheduleProposalRequest defines a governance proposal to add an attribute-based fee schedule
+type MsgAddFeeScheduleProposalRequest struct {
+	// the fee schedule to add
+	FeeSchedule FeeSchedule `protobuf:"bytes,1,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgAddFeeScheduleProposalRequest) Reset()         { *m = MsgAddFeeScheduleProposalRequest{} }
+func (m *MsgAddFeeScheduleProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFeeScheduleProposalRequest) ProtoMessage()    {}
+func (*MsgAddFeeScheduleProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{12}
+}
+func (m *MsgAddFeeScheduleProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFeeScheduleProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFeeScheduleProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFeeScheduleProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFeeScheduleProposalRequest.Merge(m, src)
+}
+func (m *MsgAddFeeScheduleProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFeeScheduleProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFeeScheduleProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFeeScheduleProposalRequest proto.InternalMessageInfo
+
+func (m *MsgAddFeeScheduleProposalRequest) GetFeeSchedule() FeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return FeeSchedule{}
+}
+
+func (m *MsgAddFeeScheduleProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgAddFeeScheduleProposalResponse defines the Msg/AddFeeScheduleProposal response type
+type MsgAddFeeScheduleProposalResponse struct {
+}
+
+func (m *MsgAddFeeScheduleProposalResponse) Reset()         { *m = MsgAddFeeScheduleProposalResponse{} }
+func (m *MsgAddFeeScheduleProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFeeScheduleProposalResponse) ProtoMessage()    {}
+func (*MsgAddFeeScheduleProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{13}
+}
+func (m *MsgAddFeeScheduleProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFeeScheduleProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFeeScheduleProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFeeScheduleProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFeeScheduleProposalResponse.Merge(m, src)
+}
+func (m *MsgAddFeeScheduleProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFeeScheduleProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFeeScheduleProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFeeScheduleProposalResponse proto.InternalMessageInfo
+
+// MsgUpdateFeeScheduleProposalRequest defines a governance proposal to update a current attribute-based fee schedule
+type MsgUpdateFeeScheduleProposalRequest struct {
+	// the fee schedule to replace the current one with the same attribute
+	FeeSchedule FeeSchedule `protobuf:"bytes,1,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdateFeeScheduleProposalRequest) Reset()         { *m = MsgUpdateFeeScheduleProposalRequest{} }
+func (m *MsgUpdateFeeScheduleProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeScheduleProposalRequest) ProtoMessage()    {}
+func (*MsgUpdateFeeScheduleProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{14}
+}
+func (m *MsgUpdateFeeScheduleProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeScheduleProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeScheduleProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeScheduleProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeScheduleProposalRequest.Merge(m, src)
+}
+func (m *MsgUpdateFeeScheduleProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeScheduleProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeScheduleProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeScheduleProposalRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateFeeScheduleProposalRequest) GetFeeSchedule() FeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return FeeSchedule{}
+}
+
+func (m *MsgUpdateFeeScheduleProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUpdateFeeScheduleProposalResponse defines the Msg/UpdateFeeScheduleProposal response type
+type MsgUpdateFeeScheduleProposalResponse struct {
+}
+
+func (m *MsgUpdateFeeScheduleProposalResponse) Reset()         { *m = MsgUpdateFeeScheduleProposalResponse{} }
+func (m *MsgUpdateFeeScheduleProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeScheduleProposalResponse) ProtoMessage()    {}
+func (*MsgUpdateFeeScheduleProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{15}
+}
+func (m *MsgUpdateFeeScheduleProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeScheduleProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeScheduleProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeScheduleProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeScheduleProposalResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeeScheduleProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeScheduleProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeScheduleProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeScheduleProposalResponse proto.InternalMessageInfo
+
+// MsgRemoveFeeScheduleProposalRequest defines a governance proposal to delete a current attribute-based fee schedule
+type MsgRemoveFeeScheduleProposalRequest struct {
+	// the attribute of the fee schedule to remove
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRemoveFeeScheduleProposalRequest) Reset()         { *m = MsgRemoveFeeScheduleProposalRequest{} }
+func (m *MsgRemoveFeeScheduleProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeScheduleProposalRequest) ProtoMessage()    {}
+func (*MsgRemoveFeeScheduleProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{16}
+}
+func (m *MsgRemoveFeeScheduleProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeScheduleProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeScheduleProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeScheduleProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeScheduleProposalRequest.Merge(m, src)
+}
+func (m *MsgRemoveFeeScheduleProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeScheduleProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeScheduleProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeScheduleProposalRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveFeeScheduleProposalRequest) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *MsgRemoveFeeScheduleProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgRemoveFeeScheduleProposalResponse defines the Msg/RemoveFeeScheduleProposal response type
+type MsgRemoveFeeScheduleProposalResponse struct {
+}
+
+func (m *MsgRemoveFeeScheduleProposalResponse) Reset()         { *m = MsgRemoveFeeScheduleProposalResponse{} }
+func (m *MsgRemoveFeeScheduleProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeScheduleProposalResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeScheduleProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{17}
+}
+func (m *MsgRemoveFeeScheduleProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeScheduleProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeScheduleProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeScheduleProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeScheduleProposalResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeeScheduleProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeScheduleProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeScheduleProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeScheduleProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAssessCustomMsgFeeRequest)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeRequest")
 	proto.RegisterType((*MsgAssessCustomMsgFeeResponse)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeResponse")
@@ -650,64 +926,80 @@ func init() {
 	proto.RegisterType((*MsgUpdateNhashPerUsdMilProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateNhashPerUsdMilProposalResponse")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalRequest")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalResponse")
+	proto.RegisterType((*MsgAddFeeScheduleProposalRequest)(nil), "provenance.msgfees.v1.MsgAddFeeScheduleProposalRequest")
+	proto.RegisterType((*MsgAddFeeScheduleProposalResponse)(nil), "provenance.msgfees.v1.MsgAddFeeScheduleProposalResponse")
+	proto.RegisterType((*MsgUpdateFeeScheduleProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateFeeScheduleProposalRequest")
+	proto.RegisterType((*MsgUpdateFeeScheduleProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateFeeScheduleProposalResponse")
+	proto.RegisterType((*MsgRemoveFeeScheduleProposalRequest)(nil), "provenance.msgfees.v1.MsgRemoveFeeScheduleProposalRequest")
+	proto.RegisterType((*MsgRemoveFeeScheduleProposalResponse)(nil), "provenance.msgfees.v1.MsgRemoveFeeScheduleProposalResponse")
 }
 
 func init() { proto.RegisterFile("provenance/msgfees/v1/tx.proto", fileDescriptor_4c6bb65eaf858b5f) }
 
 var fileDescriptor_4c6bb65eaf858b5f = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0x3b, 0x45,
-	0x14, 0xcf, 0xe4, 0x9b, 0x16, 0x3a, 0xd6, 0x42, 0x86, 0xa8, 0xe9, 0x5a, 0x37, 0x31, 0x07, 0x6d,
-	0x23, 0xd9, 0x35, 0x4d, 0xad, 0x50, 0x50, 0x68, 0x2a, 0xb9, 0x45, 0x42, 0xb4, 0x17, 0x2f, 0xcb,
-	0x66, 0x77, 0xba, 0x19, 0xcc, 0xce, 0xac, 0xfb, 0x36, 0xa1, 0x01, 0x41, 0x11, 0x84, 0xe2, 0xc9,
-	0xb3, 0x22, 0xf4, 0x24, 0xea, 0xa9, 0x07, 0xff, 0x88, 0x1e, 0x8b, 0x27, 0x4f, 0x2a, 0x2d, 0x34,
-	0xfa, 0x5f, 0xc8, 0xee, 0x8e, 0x49, 0xdb, 0x24, 0x1b, 0xfb, 0xc3, 0x9b, 0x97, 0x64, 0x66, 0x3f,
-	0x9f, 0x79, 0xef, 0xf3, 0xde, 0xdb, 0x37, 0x6f, 0xb1, 0xea, 0xf9, 0x62, 0x40, 0xb9, 0xc9, 0x2d,
-	0xaa, 0xbb, 0xe0, 0x1c, 0x51, 0x0a, 0xfa, 0xa0, 0xaa, 0x07, 0xc7, 0x9a, 0xe7, 0x8b, 0x40, 0x90,
-	0x17, 0x26, 0xb8, 0x26, 0x71, 0x6d, 0x50, 0x55, 0xb2, 0xa6, 0xcb, 0xb8, 0xd0, 0xa3, 0xdf, 0x98,
-	0xa9, 0xe4, 0x1c, 0xe1, 0x88, 0x68, 0xa9, 0x87, 0x2b, 0xf9, 0x74, 0xdd, 0x12, 0xe0, 0x0a, 0x30,
-	0x62, 0x20, 0xde, 0x48, 0x48, 0x8d, 0x77, 0x7a, 0xc7, 0x04, 0xaa, 0x0f, 0xaa, 0x1d, 0x1a, 0x98,
-	0x55, 0xdd, 0x12, 0x8c, 0x4b, 0xfc, 0x25, 0x89, 0xbb, 0xe0, 0x84, 0x92, 0x5c, 0x70, 0x62, 0xa0,
-	0x74, 0x8d, 0xf0, 0x46, 0x13, 0x9c, 0x7d, 0x00, 0x0a, 0x70, 0xd0, 0x87, 0x40, 0xb8, 0x4d, 0x70,
-	0x1a, 0x94, 0xb6, 0xe9, 0x27, 0x7d, 0x0a, 0x01, 0x21, 0x38, 0xc3, 0x4d, 0x97, 0xe6, 0x51, 0x11,
-	0x6d, 0xae, 0xb4, 0xa3, 0x35, 0x79, 0x1b, 0x2f, 0x9b, 0xae, 0xe8, 0xf3, 0x20, 0x9f, 0x2e, 0xa2,
-	0xcd, 0xe7, 0xb6, 0xd7, 0x35, 0x29, 0x26, 0x74, 0xaf, 0x49, 0xf7, 0xda, 0x81, 0x60, 0xbc, 0x9e,
-	0x39, 0xff, 0xad, 0x90, 0x6a, 0x4b, 0x3a, 0xd9, 0xc0, 0x2b, 0x3e, 0xb5, 0x98, 0xc7, 0x28, 0x0f,
-	0xf2, 0xcf, 0x22, 0x8b, 0x93, 0x07, 0xa1, 0xab, 0x23, 0x5f, 0xb8, 0xf9, 0x4c, 0xec, 0x2a, 0x5c,
-	0x93, 0x1d, 0xfc, 0xe2, 0x98, 0x60, 0x74, 0x4c, 0x60, 0x60, 0x78, 0x82, 0xf1, 0x00, 0xf2, 0x4b,
-	0x11, 0x2b, 0x37, 0x46, 0xeb, 0x21, 0xd8, 0x8a, 0xb0, 0xbd, 0xec, 0xc9, 0x69, 0x21, 0xf5, 0xe7,
-	0x69, 0x21, 0xf5, 0xc5, 0xe8, 0xac, 0x1c, 0x19, 0x2a, 0x15, 0xf0, 0x2b, 0x73, 0xe2, 0x04, 0x4f,
-	0x70, 0xa0, 0xa5, 0xeb, 0x34, 0x7e, 0x39, 0x64, 0xd8, 0x76, 0x0c, 0xb4, 0x7c, 0xe1, 0x09, 0x30,
-	0x7b, 0xff, 0x24, 0xa2, 0x88, 0x57, 0x5d, 0x70, 0x8c, 0x60, 0xe8, 0x51, 0xa3, 0xef, 0xf7, 0x64,
-	0x42, 0xb0, 0x0b, 0xce, 0x87, 0x43, 0x8f, 0x1e, 0xfa, 0x3d, 0x72, 0x82, 0xf0, 0x9a, 0x69, 0xdb,
-	0x2c, 0x60, 0x82, 0x9b, 0x3d, 0xe3, 0x88, 0xd2, 0xc5, 0xf9, 0x69, 0x84, 0xf9, 0xf9, 0xe9, 0xf7,
-	0xc2, 0xa6, 0xc3, 0x82, 0x6e, 0xbf, 0xa3, 0x59, 0xc2, 0x95, 0x95, 0x95, 0x7f, 0x15, 0xb0, 0x3f,
-	0xd6, 0x43, 0xa7, 0x10, 0x1d, 0x80, 0x6f, 0x46, 0x67, 0xe5, 0xd5, 0x1e, 0x75, 0x4c, 0x6b, 0x68,
-	0x84, 0x05, 0x86, 0x1f, 0x46, 0x67, 0x65, 0xd4, 0x7e, 0x7e, 0xe2, 0xb8, 0x41, 0xe9, 0x82, 0x44,
-	0xcf, 0x4f, 0x6a, 0x66, 0x7e, 0x52, 0xc9, 0x2e, 0x5e, 0x31, 0xfb, 0x41, 0x57, 0xf8, 0x2c, 0x18,
-	0xc6, 0xd9, 0xaf, 0xe7, 0x7f, 0xf9, 0xb9, 0x92, 0x93, 0xb1, 0xed, 0xdb, 0xb6, 0x4f, 0x01, 0x3e,
-	0x08, 0x7c, 0xc6, 0x9d, 0xf6, 0x84, 0xba, 0xb7, 0x16, 0x16, 0x61, 0xb2, 0x2f, 0xa9, 0xf1, 0x1b,
-	0x37, 0x9d, 0x67, 0x59, 0x88, 0xbf, 0xd2, 0x58, 0x6d, 0x82, 0x73, 0xe8, 0xd9, 0x66, 0x40, 0xff,
-	0xaf, 0xc5, 0x7f, 0x5a, 0x8b, 0x57, 0x71, 0x61, 0x6e, 0xaa, 0x65, 0x39, 0xbe, 0x42, 0x51, 0x39,
-	0xda, 0xd4, 0x15, 0x83, 0x07, 0x97, 0xe3, 0x96, 0xde, 0xf4, 0x63, 0xf5, 0xce, 0xd6, 0x22, 0xf5,
-	0x7e, 0x8b, 0xf0, 0x6b, 0xe3, 0x98, 0xde, 0xef, 0x9a, 0xd0, 0x6d, 0x51, 0xff, 0x10, 0xec, 0x26,
-	0xeb, 0xdd, 0xd5, 0xbd, 0x85, 0xb3, 0x3c, 0x24, 0x18, 0x1e, 0xf5, 0x8d, 0x3e, 0xd8, 0x86, 0xcb,
-	0x62, 0xf1, 0x99, 0xf6, 0x1a, 0xbf, 0x75, 0xf2, 0xc9, 0x02, 0xd8, 0xc2, 0xaf, 0x2f, 0x14, 0x27,
-	0x03, 0xf9, 0x1e, 0xe1, 0xf2, 0x98, 0x7b, 0x20, 0xf8, 0x80, 0xfa, 0xc0, 0x04, 0x6f, 0x50, 0xfa,
-	0x1e, 0xe5, 0xc2, 0xbd, 0x1b, 0xcc, 0x9b, 0x38, 0x67, 0x8d, 0x49, 0xe1, 0x0b, 0x6f, 0xd8, 0x21,
-	0x4d, 0x16, 0x83, 0x58, 0x53, 0x06, 0x9e, 0x2c, 0xa6, 0x0a, 0x7e, 0xe3, 0x5f, 0xe9, 0x8c, 0xe3,
-	0xda, 0x1e, 0x2d, 0xe3, 0x67, 0x4d, 0x70, 0xc8, 0x67, 0x98, 0x4c, 0x5f, 0xc7, 0xa4, 0xa6, 0xcd,
-	0x9c, 0x92, 0x5a, 0xd2, 0x90, 0x52, 0x76, 0xee, 0x77, 0x28, 0x16, 0x42, 0x3e, 0xc5, 0xd9, 0xa9,
-	0x5b, 0x88, 0x6c, 0x27, 0x98, 0x9a, 0x33, 0x1a, 0x94, 0xda, 0xbd, 0xce, 0x48, 0xef, 0x5f, 0x22,
-	0x9c, 0x9b, 0xd5, 0x78, 0xe4, 0xad, 0xf9, 0xd6, 0x12, 0xee, 0x44, 0x65, 0xf7, 0xbe, 0xc7, 0x6e,
-	0xe8, 0x98, 0xd5, 0x50, 0x49, 0x3a, 0x12, 0x2e, 0x83, 0x24, 0x1d, 0x49, 0x7d, 0x4b, 0xbe, 0x43,
-	0x78, 0x23, 0xa9, 0x2f, 0xc8, 0x3b, 0x8b, 0x02, 0x4c, 0x6c, 0x76, 0xe5, 0xdd, 0x87, 0x1e, 0x97,
-	0xfa, 0x7e, 0x44, 0xb8, 0xb8, 0xe8, 0x1d, 0x27, 0xfb, 0x8b, 0x9c, 0x2c, 0xec, 0x63, 0xa5, 0xfe,
-	0x18, 0x13, 0xb1, 0x56, 0x65, 0xe9, 0xf3, 0x70, 0x10, 0xd5, 0xd9, 0xf9, 0xa5, 0x8a, 0x2e, 0x2e,
-	0x55, 0xf4, 0xc7, 0xa5, 0x8a, 0xbe, 0xbe, 0x52, 0x53, 0x17, 0x57, 0x6a, 0xea, 0xd7, 0x2b, 0x35,
-	0x85, 0xf3, 0x4c, 0xcc, 0x76, 0xd3, 0x42, 0x1f, 0xd5, 0x6e, 0x8c, 0xbf, 0x09, 0xa7, 0xc2, 0xc4,
-	0x8d, 0x9d, 0x7e, 0x3c, 0xfe, 0xc2, 0x8d, 0xe6, 0x61, 0x67, 0x39, 0xfa, 0x9c, 0xac, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x92, 0xa9, 0x86, 0xe4, 0x04, 0x0b, 0x00, 0x00,
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x97, 0xdf, 0x6b, 0x23, 0x55,
+	0x14, 0xc7, 0x73, 0xbb, 0xd9, 0x85, 0x9e, 0xd6, 0x42, 0x2f, 0x71, 0x4d, 0xc7, 0x38, 0xc9, 0xa6,
+	0xb2, 0x76, 0x2b, 0xcd, 0xd8, 0x76, 0xed, 0x4a, 0x17, 0x85, 0xa6, 0x92, 0x17, 0x89, 0x94, 0xac,
+	0x7d, 0xf1, 0x65, 0x98, 0x64, 0x6e, 0x27, 0x17, 0x33, 0x73, 0xc7, 0x39, 0x37, 0x61, 0x0b, 0x82,
+	0x22, 0x08, 0x8b, 0xfb, 0xe2, 0x83, 0x4f, 0x8a, 0xb0, 0x20, 0x88, 0xfa, 0xd4, 0x07, 0x41, 0xf0,
+	0x2f, 0xd8, 0xc7, 0xc5, 0x27, 0x9f, 0x54, 0x5a, 0xd8, 0xea, 0x7f, 0x21, 0x33, 0x73, 0x9b, 0x64,
+	0xb7, 0x99, 0x89, 0x69, 0xeb, 0x83, 0xb0, 0x2f, 0xed, 0xcc, 0x9c, 0x1f, 0xf7, 0xf3, 0x3d, 0x67,
+	0x72, 0xee, 0x1d, 0xd0, 0xfd, 0x40, 0xf4, 0x98, 0x67, 0x79, 0x2d, 0x66, 0xb8, 0xe8, 0xec, 0x31,
+	0x86, 0x46, 0x6f, 0xd5, 0x90, 0x77, 0x2b, 0x7e, 0x20, 0xa4, 0xa0, 0xcf, 0x0f, 0xec, 0x15, 0x65,
+	0xaf, 0xf4, 0x56, 0xb5, 0x79, 0xcb, 0xe5, 0x9e, 0x30, 0xa2, 0xbf, 0xb1, 0xa7, 0x96, 0x73, 0x84,
+	0x23, 0xa2, 0x4b, 0x23, 0xbc, 0x52, 0x4f, 0x17, 0x5a, 0x02, 0x5d, 0x81, 0x66, 0x6c, 0x88, 0x6f,
+	0x94, 0x49, 0x8f, 0xef, 0x8c, 0xa6, 0x85, 0xcc, 0xe8, 0xad, 0x36, 0x99, 0xb4, 0x56, 0x8d, 0x96,
+	0xe0, 0x9e, 0xb2, 0xbf, 0xa0, 0xec, 0x2e, 0x3a, 0x21, 0x92, 0x8b, 0x8e, 0x32, 0x2c, 0x8e, 0x66,
+	0x3e, 0xc1, 0x8b, 0x9c, 0xca, 0x8f, 0x09, 0x14, 0xea, 0xe8, 0x6c, 0x21, 0x32, 0xc4, 0xed, 0x2e,
+	0x4a, 0xe1, 0xd6, 0xd1, 0xa9, 0x31, 0xd6, 0x60, 0x1f, 0x76, 0x19, 0x4a, 0x4a, 0x21, 0xeb, 0x59,
+	0x2e, 0xcb, 0x93, 0x12, 0x59, 0x9a, 0x6e, 0x44, 0xd7, 0xf4, 0x16, 0x5c, 0xb1, 0x5c, 0xd1, 0xf5,
+	0x64, 0x7e, 0xaa, 0x44, 0x96, 0x66, 0xd6, 0x16, 0x2a, 0x8a, 0x38, 0x64, 0xac, 0x28, 0xc6, 0xca,
+	0xb6, 0xe0, 0x5e, 0x35, 0xfb, 0xf0, 0xf7, 0x62, 0xa6, 0xa1, 0xdc, 0x69, 0x01, 0xa6, 0x03, 0xd6,
+	0xe2, 0x3e, 0x67, 0x9e, 0xcc, 0x5f, 0x8a, 0x32, 0x0e, 0x1e, 0x84, 0x4b, 0xed, 0x05, 0xc2, 0xcd,
+	0x67, 0xe3, 0xa5, 0xc2, 0x6b, 0x7a, 0x13, 0xae, 0xf6, 0x1d, 0xcc, 0xa6, 0x85, 0x1c, 0x4d, 0x5f,
+	0x70, 0x4f, 0x62, 0xfe, 0x72, 0xe4, 0x95, 0xeb, 0x5b, 0xab, 0xa1, 0x71, 0x27, 0xb2, 0x6d, 0xce,
+	0xdf, 0x7b, 0x50, 0xcc, 0xfc, 0xf5, 0xa0, 0x98, 0xf9, 0xf4, 0xf8, 0x60, 0x39, 0x4a, 0x54, 0x2e,
+	0xc2, 0x4b, 0x09, 0x3a, 0xd1, 0x17, 0x1e, 0xb2, 0xf2, 0xe3, 0x29, 0x78, 0x31, 0xf4, 0xb0, 0xed,
+	0xd8, 0xb0, 0x13, 0x08, 0x5f, 0xa0, 0xd5, 0x39, 0x29, 0x44, 0x09, 0x66, 0x5d, 0x74, 0x4c, 0xb9,
+	0xef, 0x33, 0xb3, 0x1b, 0x74, 0x54, 0x41, 0xc0, 0x45, 0xe7, 0xbd, 0x7d, 0x9f, 0xed, 0x06, 0x1d,
+	0x7a, 0x8f, 0xc0, 0x9c, 0x65, 0xdb, 0x5c, 0x72, 0xe1, 0x59, 0x1d, 0x73, 0x8f, 0xb1, 0xf1, 0xf5,
+	0xa9, 0x85, 0xf5, 0xf9, 0xf1, 0x8f, 0xe2, 0x92, 0xc3, 0x65, 0xbb, 0xdb, 0xac, 0xb4, 0x84, 0xab,
+	0xda, 0xaf, 0xfe, 0xad, 0xa0, 0xfd, 0x81, 0x11, 0x2e, 0x8a, 0x51, 0x00, 0x7e, 0x75, 0x7c, 0xb0,
+	0x3c, 0xdb, 0x61, 0x8e, 0xd5, 0xda, 0x37, 0xc3, 0xb7, 0x00, 0xbf, 0x3f, 0x3e, 0x58, 0x26, 0x8d,
+	0xe7, 0x06, 0x0b, 0xd7, 0x18, 0x1b, 0x53, 0xe8, 0xe4, 0xa2, 0x66, 0x93, 0x8b, 0x4a, 0x37, 0x60,
+	0xda, 0xea, 0xca, 0xb6, 0x08, 0xb8, 0xdc, 0x8f, 0xab, 0x5f, 0xcd, 0xff, 0xfa, 0xd3, 0x4a, 0x4e,
+	0x69, 0xdb, 0xb2, 0xed, 0x80, 0x21, 0xde, 0x91, 0x01, 0xf7, 0x9c, 0xc6, 0xc0, 0x75, 0x73, 0x2e,
+	0x6c, 0xc2, 0xe0, 0xbe, 0xac, 0x43, 0x61, 0x74, 0x9d, 0x55, 0x23, 0xfe, 0x9e, 0x02, 0xbd, 0x8e,
+	0xce, 0xae, 0x6f, 0x5b, 0x92, 0x3d, 0xeb, 0xc5, 0x7f, 0xda, 0x8b, 0x6b, 0x50, 0x4c, 0x2c, 0xb5,
+	0x6a, 0xc7, 0xe7, 0x24, 0x6a, 0x47, 0x83, 0xb9, 0xa2, 0x77, 0xe6, 0x76, 0x3c, 0xc1, 0x3b, 0x75,
+	0x5e, 0xde, 0xd1, 0x2c, 0x8a, 0xf7, 0x6b, 0x02, 0xd7, 0xfb, 0x9a, 0xde, 0x6d, 0x5b, 0xd8, 0xde,
+	0x61, 0xc1, 0x2e, 0xda, 0x75, 0xde, 0x79, 0x9a, 0xfb, 0x06, 0xcc, 0x7b, 0xa1, 0x83, 0xe9, 0xb3,
+	0xc0, 0xec, 0xa2, 0x6d, 0xba, 0x3c, 0x86, 0xcf, 0x36, 0xe6, 0xbc, 0x27, 0x22, 0x2f, 0x4c, 0xc0,
+	0x0d, 0x78, 0x65, 0x2c, 0x9c, 0x12, 0xf2, 0x1d, 0x81, 0xe5, 0xbe, 0xef, 0xb6, 0xf0, 0x7a, 0x2c,
+	0x40, 0x2e, 0xbc, 0x1a, 0x63, 0x6f, 0x33, 0x4f, 0xb8, 0x4f, 0x8b, 0x79, 0x0d, 0x72, 0xad, 0xbe,
+	0x53, 0xf8, 0xc2, 0x9b, 0x76, 0xe8, 0xa6, 0x9a, 0x41, 0x5b, 0xa7, 0x12, 0x5c, 0x98, 0xa6, 0x15,
+	0x78, 0xf5, 0x5f, 0x71, 0x2a, 0x5d, 0x3f, 0x13, 0x28, 0xc5, 0x03, 0xa0, 0xc6, 0xd8, 0x9d, 0x56,
+	0x9b, 0xd9, 0xdd, 0xce, 0xa9, 0x57, 0xea, 0x1d, 0x98, 0x0d, 0x25, 0xa0, 0x32, 0x47, 0x2a, 0x66,
+	0xd6, 0xca, 0x95, 0x91, 0xfb, 0x6c, 0x65, 0x28, 0x91, 0xda, 0x71, 0x66, 0xf6, 0x06, 0x8f, 0x2e,
+	0x4c, 0xe8, 0x22, 0x5c, 0x4b, 0x01, 0x57, 0xf2, 0x7e, 0x21, 0xb0, 0xd8, 0x2f, 0xc7, 0xff, 0x4d,
+	0xe1, 0x75, 0x78, 0x39, 0x9d, 0x5d, 0x89, 0xbc, 0x1f, 0x8b, 0x8c, 0x7f, 0x88, 0x29, 0x22, 0x0b,
+	0x30, 0x6d, 0x49, 0x19, 0xf0, 0x66, 0x57, 0x9e, 0x1c, 0x21, 0x06, 0x0f, 0x2e, 0x98, 0x3a, 0x05,
+	0x26, 0xa6, 0x5e, 0xfb, 0x16, 0xe0, 0x52, 0x1d, 0x1d, 0xfa, 0x31, 0xd0, 0xd3, 0x07, 0x01, 0xba,
+	0x9e, 0x50, 0xfa, 0xb4, 0xe3, 0x91, 0x76, 0x73, 0xb2, 0xa0, 0x18, 0x84, 0x7e, 0x04, 0xf3, 0xa7,
+	0xf6, 0x3f, 0xba, 0x96, 0x92, 0x2a, 0xe1, 0x50, 0xa2, 0xad, 0x4f, 0x14, 0xa3, 0x56, 0xff, 0x8c,
+	0x40, 0x6e, 0xd4, 0xc8, 0xa7, 0xaf, 0x27, 0x67, 0x4b, 0xd9, 0x8d, 0xb5, 0x8d, 0x49, 0xc3, 0x86,
+	0x38, 0x46, 0x8d, 0xf2, 0x34, 0x8e, 0x94, 0x6d, 0x48, 0xdb, 0x98, 0x34, 0x4c, 0x71, 0x7c, 0x43,
+	0xa0, 0x90, 0x36, 0x91, 0xe9, 0x9b, 0xe3, 0x04, 0xa6, 0x6e, 0x33, 0xda, 0x5b, 0x67, 0x0d, 0x57,
+	0x7c, 0x3f, 0x10, 0x28, 0x8d, 0x9b, 0xae, 0x74, 0x6b, 0xdc, 0x22, 0x63, 0x77, 0x10, 0xad, 0x7a,
+	0x9e, 0x14, 0x8a, 0xf5, 0x3e, 0x81, 0xab, 0xa3, 0x07, 0x24, 0xbd, 0x95, 0xfa, 0xae, 0x26, 0x0f,
+	0x11, 0xed, 0x8d, 0xc9, 0x03, 0x15, 0xcd, 0x97, 0x04, 0x16, 0x12, 0x87, 0x19, 0xdd, 0x1c, 0xa7,
+	0x37, 0x85, 0xe9, 0xf6, 0x99, 0x62, 0x87, 0xb0, 0x12, 0xa7, 0x55, 0x1a, 0xd6, 0xb8, 0x79, 0xab,
+	0xdd, 0x3e, 0x53, 0x6c, 0x8c, 0xa5, 0x5d, 0xfe, 0x24, 0x3c, 0xbe, 0x56, 0xf9, 0xc3, 0x43, 0x9d,
+	0x3c, 0x3a, 0xd4, 0xc9, 0x9f, 0x87, 0x3a, 0xf9, 0xe2, 0x48, 0xcf, 0x3c, 0x3a, 0xd2, 0x33, 0xbf,
+	0x1d, 0xe9, 0x19, 0xc8, 0x73, 0x31, 0x3a, 0xff, 0x0e, 0x79, 0x7f, 0x7d, 0xe8, 0xd0, 0x3c, 0xf0,
+	0x59, 0xe1, 0x62, 0xe8, 0xce, 0xb8, 0xdb, 0xff, 0x10, 0x8d, 0x4e, 0xd1, 0xcd, 0x2b, 0xd1, 0x47,
+	0xe8, 0xfa, 0x3f, 0x03, 0x00, 0xc9, 0xbf, 0x68, 0x3c, 0x5f, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -737,6 +1029,12 @@ type MsgClient interface {
 	UpdateNhashPerUsdMilProposal(ctx context.Context, in *MsgUpdateNhashPerUsdMilProposalRequest, opts ...grpc.CallOption) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(ctx context.Context, in *MsgUpdateConversionFeeDenomProposalRequest, opts ...grpc.CallOption) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// AddFeeScheduleProposal defines a governance proposal to add an attribute-based fee schedule
+	AddFeeScheduleProposal(ctx context.Context, in *MsgAddFeeScheduleProposalRequest, opts ...grpc.CallOption) (*MsgAddFeeScheduleProposalResponse, error)
+	// UpdateFeeScheduleProposal defines a governance proposal to update a current attribute-based fee schedule
+	UpdateFeeScheduleProposal(ctx context.Context, in *MsgUpdateFeeScheduleProposalRequest, opts ...grpc.CallOption) (*MsgUpdateFeeScheduleProposalResponse, error)
+	// RemoveFeeScheduleProposal defines a governance proposal to delete a current attribute-based fee schedule
+	RemoveFeeScheduleProposal(ctx context.Context, in *MsgRemoveFeeScheduleProposalRequest, opts ...grpc.CallOption) (*MsgRemoveFeeScheduleProposalResponse, error)
 }
 
 type msgClient struct {
@@ -801,6 +1099,33 @@ func (c *msgClient) UpdateConversionFeeDenomProposal(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *msgClient) AddFeeScheduleProposal(ctx context.Context, in *MsgAddFeeScheduleProposalRequest, opts ...grpc.CallOption) (*MsgAddFeeScheduleProposalResponse, error) {
+	out := new(MsgAddFeeScheduleProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/AddFeeScheduleProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeScheduleProposal(ctx context.Context, in *MsgUpdateFeeScheduleProposalRequest, opts ...grpc.CallOption) (*MsgUpdateFeeScheduleProposalResponse, error) {
+	out := new(MsgUpdateFeeScheduleProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/UpdateFeeScheduleProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeScheduleProposal(ctx context.Context, in *MsgRemoveFeeScheduleProposalRequest, opts ...grpc.CallOption) (*MsgRemoveFeeScheduleProposalResponse, error) {
+	out := new(MsgRemoveFeeScheduleProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/RemoveFeeScheduleProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AssessCustomMsgFee endpoint executes the additional fee charges.
@@ -818,6 +1143,12 @@ type MsgServer interface {
 	UpdateNhashPerUsdMilProposal(context.Context, *MsgUpdateNhashPerUsdMilProposalRequest) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(context.Context, *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// AddFeeScheduleProposal defines a governance proposal to add an attribute-based fee schedule
+	AddFeeScheduleProposal(context.Context, *MsgAddFeeScheduleProposalRequest) (*MsgAddFeeScheduleProposalResponse, error)
+	// UpdateFeeScheduleProposal defines a governance proposal to update a current attribute-based fee schedule
+	UpdateFeeScheduleProposal(context.Context, *MsgUpdateFeeScheduleProposalRequest) (*MsgUpdateFeeScheduleProposalResponse, error)
+	// RemoveFeeScheduleProposal defines a governance proposal to delete a current attribute-based fee schedule
+	RemoveFeeScheduleProposal(context.Context, *MsgRemoveFeeScheduleProposalRequest) (*MsgRemoveFeeScheduleProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -842,6 +1173,15 @@ func (*UnimplementedMsgServer) UpdateNhashPerUsdMilProposal(ctx context.Context,
 func (*UnimplementedMsgServer) UpdateConversionFeeDenomProposal(ctx context.Context, req *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversionFeeDenomProposal not implemented")
 }
+func (*UnimplementedMsgServer) AddFeeScheduleProposal(ctx context.Context, req *MsgAddFeeScheduleProposalRequest) (*MsgAddFeeScheduleProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFeeScheduleProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFeeScheduleProposal(ctx context.Context, req *MsgUpdateFeeScheduleProposalRequest) (*MsgUpdateFeeScheduleProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeScheduleProposal not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeScheduleProposal(ctx context.Context, req *MsgRemoveFeeScheduleProposalRequest) (*MsgRemoveFeeScheduleProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeScheduleProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -955,6 +1295,60 @@ func _Msg_UpdateConversionFeeDenomProposal_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddFeeScheduleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFeeScheduleProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFeeScheduleProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/AddFeeScheduleProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFeeScheduleProposal(ctx, req.(*MsgAddFeeScheduleProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeScheduleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeScheduleProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeScheduleProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/UpdateFeeScheduleProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeScheduleProposal(ctx, req.(*MsgUpdateFeeScheduleProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeScheduleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeScheduleProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeScheduleProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/RemoveFeeScheduleProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeScheduleProposal(ctx, req.(*MsgRemoveFeeScheduleProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.msgfees.v1.Msg",
@@ -984,6 +1378,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateConversionFeeDenomProposal",
 			Handler:    _Msg_UpdateConversionFeeDenomProposal_Handler,
 		},
+		{
+			MethodName: "AddFeeScheduleProposal",
+			Handler:    _Msg_AddFeeScheduleProposal_Handler,
+		},
+		{
+			MethodName: "UpdateFeeScheduleProposal",
+			Handler:    _Msg_UpdateFeeScheduleProposal_Handler,
+		},
+		{
+			MethodName: "RemoveFeeScheduleProposal",
+			Handler:    _Msg_RemoveFeeScheduleProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/msgfees/v1/tx.proto",
@@ -1419,24 +1825,210 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddFeeScheduleProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAssessCustomMsgFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddFeeScheduleProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFeeScheduleProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.FeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFeeScheduleProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFeeScheduleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFeeScheduleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeScheduleProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeScheduleProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeScheduleProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.FeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeScheduleProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeScheduleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeScheduleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeScheduleProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeScheduleProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeScheduleProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeScheduleProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeScheduleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeScheduleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAssessCustomMsgFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1615,6 +2207,80 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddFeeScheduleProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeSchedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFeeScheduleProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeeScheduleProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeSchedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFeeScheduleProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeScheduleProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeScheduleProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2006,11 +2672,436 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMsgFeeProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2038,9 +3129,9 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -2093,7 +3184,7 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2116,10 +3207,10 @@ func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMsgFeeProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2143,7 +3234,7 @@ func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2166,17 +3257,17 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
 			}
-			var stringLen uint64
+			m.NhashPerUsdMil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2186,29 +3277,16 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2218,60 +3296,127 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2299,9 +3444,9 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -2354,7 +3499,7 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,10 +3522,10 @@ func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2404,7 +3549,7 @@ func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFeeScheduleProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,17 +3572,17 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFeeScheduleProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFeeScheduleProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2447,23 +3592,24 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			if err := m.FeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2518,7 +3664,7 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFeeScheduleProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,10 +3687,10 @@ func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFeeScheduleProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFeeScheduleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2568,7 +3714,7 @@ func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeeScheduleProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2591,17 +3737,17 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeeScheduleProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeeScheduleProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
 			}
-			m.NhashPerUsdMil = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2611,11 +3757,25 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
@@ -2669,7 +3829,7 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeeScheduleProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,10 +3852,10 @@ func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeeScheduleProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeeScheduleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2719,7 +3879,7 @@ func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeScheduleProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,15 +3902,15 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeScheduleProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeScheduleProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2778,7 +3938,7 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
+			m.Attribute = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2833,7 +3993,7 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeScheduleProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2856,10 +4016,10 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeScheduleProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeScheduleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -42,8 +42,9 @@ type IntegrationTestSuite struct {
 	accountAddr sdk.AccAddress
 	accountKey  *secp256k1.PrivKey
 
-	port   string
-	oracle string
+	port        string
+	oracle      string
+	queryResult oracletypes.OracleQueryResult
 }
 
 func TestIntegrationTestSuite(t *testing.T) {
@@ -87,10 +88,21 @@ func (s *IntegrationTestSuite) SetupSuite() {
 
 	s.port = oracletypes.PortID
 	s.oracle = "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
+	s.queryResult = oracletypes.OracleQueryResult{
+		Sequence:       3,
+		Channel:        "channel-1",
+		Query:          []byte(`{"query_version":{}}`),
+		Status:         oracletypes.QueryStatus_QUERY_STATUS_SUCCESS,
+		Result:         []byte(`{"version":"1"}`),
+		RequestHeight:  1,
+		ResponseHeight: 2,
+	}
 
 	testutil.MutateGenesisState(s.T(), &s.cfg, oracletypes.ModuleName, &oracletypes.GenesisState{}, func(oracleData *oracletypes.GenesisState) *oracletypes.GenesisState {
 		oracleData.PortId = s.port
 		oracleData.Oracle = s.oracle
+		oracleData.QueryResults = []oracletypes.OracleQueryResult{s.queryResult}
+		oracleData.NamedOracles = []oracletypes.NamedOracle{oracletypes.NewNamedOracle("price-feed", s.oracle)}
 		return oracleData
 	})
 
@@ -146,6 +158,99 @@ func (s *IntegrationTestSuite) TestQueryOracleAddress() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryNamedOracleAddress() {
+	testCases := []struct {
+		name            string
+		oracleName      string
+		expectErrMsg    string
+		expectedAddress string
+	}{
+		{
+			name:            "success - query for named oracle address",
+			oracleName:      "price-feed",
+			expectedAddress: s.oracle,
+		},
+		{
+			name:         "failure - unknown named oracle",
+			oracleName:   "unknown",
+			expectErrMsg: `rpc error: code = NotFound desc = rpc error: code = NotFound desc = no oracle named "unknown": unknown oracle: key not found`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := s.network.Validators[0].ClientCtx
+			args := []string{fmt.Sprintf("--%s=%s", oraclecli.FlagName, tc.oracleName), fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, oraclecli.GetQueryOracleAddressCmd(), args)
+			if len(tc.expectErrMsg) > 0 {
+				s.EqualError(err, tc.expectErrMsg, "should have correct error message for invalid QueryOracleAddress")
+			} else {
+				var response types.QueryOracleAddressResponse
+				s.NoError(err, "should have no error message for valid QueryOracleAddress")
+				err = s.cfg.Codec.UnmarshalJSON(out.Bytes(), &response)
+				s.NoError(err, "should have no error message when unmarshalling response to QueryOracleAddress")
+				s.Equal(tc.expectedAddress, response.Address, "should have the correct oracle address")
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryOracles() {
+	clientCtx := s.network.Validators[0].ClientCtx
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, oraclecli.GetQueryOraclesCmd(), []string{fmt.Sprintf("--%s=json", cmtcli.OutputFlag)})
+	s.Require().NoError(err, "should have no error message for valid QueryOracles")
+	var response types.QueryOraclesResponse
+	err = s.cfg.Codec.UnmarshalJSON(out.Bytes(), &response)
+	s.Require().NoError(err, "should have no error message when unmarshalling response to QueryOracles")
+	s.Equal([]types.NamedOracle{types.NewNamedOracle("price-feed", s.oracle)}, response.Oracles, "should have the correct named oracles")
+}
+
+func (s *IntegrationTestSuite) TestQueryOracleQueryResult() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expected     *types.OracleQueryResult
+	}{
+		{
+			name:     "success - query for query result",
+			args:     []string{"channel-1", "3"},
+			expected: &s.queryResult,
+		},
+		{
+			name:         "failure - invalid sequence",
+			args:         []string{"channel-1", "x"},
+			expectErrMsg: `invalid sequence "x": strconv.ParseUint: parsing "x": invalid syntax`,
+		},
+		{
+			name:         "failure - unknown query result",
+			args:         []string{"channel-1", "4"},
+			expectErrMsg: "rpc error: code = NotFound desc = rpc error: code = NotFound desc = no query result for sequence 4 on channel channel-1: key not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := s.network.Validators[0].ClientCtx
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, oraclecli.GetQueryOracleQueryResultCmd(), args)
+			if len(tc.expectErrMsg) > 0 {
+				s.EqualError(err, tc.expectErrMsg, "should have correct error message for invalid OracleQueryResult")
+			} else {
+				var response types.QueryOracleQueryResultResponse
+				s.NoError(err, "should have no error message for valid OracleQueryResult")
+				err = s.cfg.Codec.UnmarshalJSON(out.Bytes(), &response)
+				s.NoError(err, "should have no error message when unmarshalling response to OracleQueryResult")
+				s.Equal(*tc.expected, response.Result, "should have the correct query result")
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestOracleUpdate() {
 	testCases := []struct {
 		name         string
@@ -189,11 +294,55 @@ func (s *IntegrationTestSuite) TestOracleUpdate() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestOracleRemove() {
+	testCases := []struct {
+		name         string
+		oracleName   string
+		expectErrMsg string
+		expectedCode uint32
+		signer       string
+	}{
+		{
+			name:         "success - remove proposal submitted",
+			oracleName:   "price-feed",
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - unable to pass validate basic with bad name",
+			oracleName:   "Price Feed",
+			expectErrMsg: `oracle name "Price Feed" must start with a lowercase letter or number and only contain lowercase letters, numbers, '.', '_', or '-': invalid proposal message`,
+			expectedCode: 12,
+			signer:       s.accountAddresses[0].String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := oraclecli.GetCmdOracleRemove()
+			args := []string{
+				tc.oracleName,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				"--title", "Remove the oracle", "--summary", "Remove it real good",
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			}
+			testcli.NewTxExecutor(cmd, args).
+				WithExpCode(tc.expectedCode).
+				WithExpInRawLog([]string{tc.expectErrMsg}).
+				Execute(s.T(), s.network)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestSendQuery() {
 	testCases := []struct {
 		name         string
 		query        string
 		channel      string
+		oracleName   string
 		expectErrMsg []string
 		expectedCode uint32
 		expInRawLog  []string
@@ -207,6 +356,15 @@ func (s *IntegrationTestSuite) TestSendQuery() {
 			expInRawLog:  []string{"module does not own channel capability", "channel capability not found"},
 			signer:       s.accountAddresses[0].String(),
 		},
+		{
+			name:         "success - a valid message to a named oracle was attempted to be sent on ibc",
+			query:        "{}",
+			channel:      "channel-1",
+			oracleName:   "price-feed",
+			expectedCode: 9,
+			expInRawLog:  []string{"module does not own channel capability", "channel capability not found"},
+			signer:       s.accountAddresses[0].String(),
+		},
 		{
 			name:         "failure - invalid query data",
 			query:        "abc",
@@ -242,6 +400,9 @@ func (s *IntegrationTestSuite) TestSendQuery() {
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			}
+			if len(tc.oracleName) > 0 {
+				args = append(args, fmt.Sprintf("--%s=%s", oraclecli.FlagOracle, tc.oracleName))
+			}
 
 			testcli.NewTxExecutor(cmd, args).
 				WithExpInErrMsg(tc.expectErrMsg).
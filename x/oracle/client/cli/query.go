@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetQueryOracleAddressCmd(),
+		GetQueryOraclesCmd(),
+		GetQueryOracleQueryResultCmd(),
 	)
 	return queryCmd
 }
@@ -32,10 +35,11 @@ func GetQueryCmd() *cobra.Command {
 func GetQueryOracleAddressCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "address",
-		Short:   "Returns the address of the module's oracle",
+		Short:   "Returns the address of the module's oracle or of a named oracle",
 		Args:    cobra.ExactArgs(0),
 		Aliases: []string{"a"},
-		Example: fmt.Sprintf(`%[1]s q oracle address`, version.AppName),
+		Example: fmt.Sprintf(`%[1]s q oracle address
+%[1]s q oracle address --name price-feed`, version.AppName),
 		RunE: func(cmd *cobra.Command, _ []string) (err error) {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -44,7 +48,12 @@ func GetQueryOracleAddressCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryOracleAddressRequest{}
+			name, err := cmd.Flags().GetString(FlagName)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOracleAddressRequest{Name: name}
 
 			res, err := queryClient.OracleAddress(context.Background(), params)
 			if err != nil {
@@ -55,6 +64,79 @@ func GetQueryOracleAddressCmd() *cobra.Command {
 		},
 	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagName, "", "The name of the oracle")
+
+	return cmd
+}
+
+// GetQueryOraclesCmd queries for all the named oracles
+func GetQueryOraclesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "oracles",
+		Short:   "Returns all the named oracles",
+		Args:    cobra.ExactArgs(0),
+		Aliases: []string{"o"},
+		Example: fmt.Sprintf(`%[1]s q oracle oracles`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryOraclesRequest{}
+
+			res, err := queryClient.Oracles(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryOracleQueryResultCmd queries for the result of a query sent to another chain's oracle
+func GetQueryOracleQueryResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-result <channel-id> <sequence>",
+		Short:   "Returns the result of a query sent to an oracle on a remote chain",
+		Args:    cobra.ExactArgs(2),
+		Aliases: []string{"qr"},
+		Example: fmt.Sprintf(`%[1]s q oracle query-result channel-1 5`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %q: %w", args[1], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryOracleQueryResultRequest{
+				Channel:  args[0],
+				Sequence: sequence,
+			}
+
+			res, err := queryClient.OracleQueryResult(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
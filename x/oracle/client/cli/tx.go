@@ -17,6 +17,13 @@ import (
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
 
+const (
+	// FlagName is the flag for the name of an oracle to update
+	FlagName = "name"
+	// FlagOracle is the flag for the name of the oracle to query on another chain
+	FlagOracle = "oracle"
+)
+
 // NewTxCmd is the top-level command for oracle CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -30,6 +37,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdSendQuery(),
 		GetCmdOracleUpdate(),
+		GetCmdOracleRemove(),
 	)
 
 	return txCmd
@@ -38,12 +46,14 @@ func NewTxCmd() *cobra.Command {
 // GetCmdOracleUpdate is a command to update the address of the module's oracle
 func GetCmdOracleUpdate() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update <address>",
-		Short:   "Update the module's oracle address",
-		Long:    "Submit an update oracle via governance proposal along with an initial deposit.",
+		Use:   "update <address>",
+		Short: "Update the module's oracle address",
+		Long: `Submit an update oracle via governance proposal along with an initial deposit.
+If a --name is provided, the oracle registered under that name is added or updated instead of the module's default oracle.`,
 		Args:    cobra.ExactArgs(1),
 		Aliases: []string{"u"},
-		Example: fmt.Sprintf(`%[1]s tx oracle update pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --deposit 50000nhash`, version.AppName),
+		Example: fmt.Sprintf(`%[1]s tx oracle update pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --deposit 50000nhash
+%[1]s tx oracle update pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --name price-feed --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -53,7 +63,44 @@ func GetCmdOracleUpdate() *cobra.Command {
 			flagSet := cmd.Flags()
 			authority := provcli.GetAuthority(flagSet)
 
+			name, err := flagSet.GetString(FlagName)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateOracle(authority, args[0])
+			msg.Name = name
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagName, "", "The name to register the oracle under")
+
+	return cmd
+}
+
+// GetCmdOracleRemove is a command to remove a named oracle
+func GetCmdOracleRemove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <name>",
+		Short:   "Remove a named oracle",
+		Long:    "Submit a remove oracle via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"r"},
+		Example: fmt.Sprintf(`%[1]s tx oracle remove price-feed --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			msg := types.NewMsgRemoveOracle(authority, args[0])
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
@@ -72,7 +119,8 @@ func GetCmdSendQuery() *cobra.Command {
 		Short:   "Send a query to an oracle on a remote chain via IBC",
 		Args:    cobra.ExactArgs(2),
 		Aliases: []string{"sq"},
-		Example: fmt.Sprintf(`%[1]s tx oracle send-query channel-1 '{"query_version":{}}'`, version.AppName),
+		Example: fmt.Sprintf(`%[1]s tx oracle send-query channel-1 '{"query_version":{}}'
+%[1]s tx oracle send-query channel-1 '{"query_version":{}}' --oracle price-feed`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -86,16 +134,23 @@ func GetCmdSendQuery() *cobra.Command {
 				return errors.New("query data must be json")
 			}
 
+			oracleName, err := cmd.Flags().GetString(FlagOracle)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendQueryOracle(
 				clientCtx.GetFromAddress().String(),
 				channelID,
 				queryData,
 			)
+			msg.OracleName = oracleName
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagOracle, "", "The name of the oracle to query on the other chain (default is its default oracle)")
 
 	return cmd
 }
@@ -9,9 +9,27 @@ import (
 // ExportGenesis returns a GenesisState for a given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	oracle, _ := k.GetOracle(ctx)
+
+	var results []types.OracleQueryResult
+	err := k.IterateQueryResults(ctx, func(result types.OracleQueryResult) bool {
+		results = append(results, result)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var namedOracles []types.NamedOracle
+	k.IterateNamedOracles(ctx, func(namedOracle types.NamedOracle) bool {
+		namedOracles = append(namedOracles, namedOracle)
+		return false
+	})
+
 	return &types.GenesisState{
-		PortId: k.GetPort(ctx),
-		Oracle: oracle.String(),
+		PortId:       k.GetPort(ctx),
+		Oracle:       oracle.String(),
+		QueryResults: results,
+		NamedOracles: namedOracles,
 	}
 }
 
@@ -36,4 +54,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		oracle = sdk.MustAccAddressFromBech32(genState.Oracle)
 	}
 	k.SetOracle(ctx, oracle)
+
+	for _, namedOracle := range genState.NamedOracles {
+		k.SetNamedOracle(ctx, namedOracle.Name, sdk.MustAccAddressFromBech32(namedOracle.Address))
+	}
+
+	for _, result := range genState.QueryResults {
+		if err := k.SetQueryResult(ctx, result); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -8,6 +8,44 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	genesis := s.app.OracleKeeper.ExportGenesis(s.ctx)
 	s.Assert().Equal("oracle", genesis.PortId, "should export the correct port")
 	s.Assert().Equal("", genesis.Oracle, "should export the correct oracle address")
+	s.Assert().Empty(genesis.QueryResults, "should export no query results")
+	s.Assert().Empty(genesis.NamedOracles, "should export no named oracles")
+}
+
+func (s *KeeperTestSuite) TestGenesisNamedOracles() {
+	genesis := types.NewGenesisState("oracle", "")
+	genesis.NamedOracles = []types.NamedOracle{
+		types.NewNamedOracle("attestations", "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"),
+		types.NewNamedOracle("price-feed", "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"),
+	}
+	s.app.OracleKeeper.InitGenesis(s.ctx, genesis)
+
+	exported := s.app.OracleKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal(genesis.NamedOracles, exported.NamedOracles, "should export the imported named oracles")
+}
+
+func (s *KeeperTestSuite) TestGenesisQueryResults() {
+	results := []types.OracleQueryResult{
+		types.NewPendingOracleQueryResult("channel-1", 4, []byte("{}"), 90, ""),
+		{
+			Sequence:       2,
+			Channel:        "channel-2",
+			Query:          []byte("{}"),
+			Status:         types.QueryStatus_QUERY_STATUS_TIMEOUT,
+			RequestHeight:  80,
+			ResponseHeight: 85,
+		},
+	}
+	genesis := types.NewGenesisState("oracle", "")
+	genesis.QueryResults = results
+	s.app.OracleKeeper.InitGenesis(s.ctx, genesis)
+
+	exported := s.app.OracleKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal(results, exported.QueryResults, "should export the imported query results")
+
+	s.app.OracleKeeper.PruneQueryResults(s.ctx.WithBlockHeight(85 + types.QueryResultRetentionBlocks))
+	exported = s.app.OracleKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal(results[:1], exported.QueryResults, "should only prune the completed query result")
 }
 
 func (s *KeeperTestSuite) TestInitGenesis() {
@@ -17,8 +17,10 @@ import (
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
 
-// QueryOracle sends an ICQ to the other chain's module
-func (k Keeper) QueryOracle(ctx sdk.Context, query wasmtypes.RawContractMessage, channel string) (uint64, error) {
+// QueryOracle sends an ICQ to the other chain's module and records it as pending.
+// The oracleName selects the other chain's named oracle, or its default oracle when empty.
+// The optional callbackAddress is the contract to sudo-call once the query has a result.
+func (k Keeper) QueryOracle(ctx sdk.Context, query wasmtypes.RawContractMessage, channel, oracleName, callbackAddress string) (uint64, error) {
 	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(k.GetPort(ctx), channel))
 	if !found {
 		return 0, cerrs.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
@@ -26,6 +28,7 @@ func (k Keeper) QueryOracle(ctx sdk.Context, query wasmtypes.RawContractMessage,
 
 	q := types.QueryOracleRequest{
 		Query: query,
+		Name:  oracleName,
 	}
 
 	reqs := []abci.RequestQuery{
@@ -41,5 +44,11 @@ func (k Keeper) QueryOracle(ctx sdk.Context, query wasmtypes.RawContractMessage,
 		return 0, err
 	}
 
+	result := types.NewPendingOracleQueryResult(channel, seq, query, ctx.BlockHeight(), callbackAddress)
+	result.OracleName = oracleName
+	if err = k.SetQueryResult(ctx, result); err != nil {
+		return 0, err
+	}
+
 	return seq, nil
 }
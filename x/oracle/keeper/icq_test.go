@@ -53,7 +53,7 @@ func (s *KeeperTestSuite) TestQueryOracle() {
 			if tc.ics4Mock {
 				s.app.OracleKeeper = s.app.OracleKeeper.WithMockICS4Wrapper(&keeper.MockICS4Wrapper{})
 			}
-			sequence, err := s.app.OracleKeeper.QueryOracle(s.ctx, tc.query, tc.channel)
+			sequence, err := s.app.OracleKeeper.QueryOracle(s.ctx, tc.query, tc.channel, "", "")
 			s.Assert().Equal(int(tc.sequence), int(sequence), "should have correct sequence")
 			if len(tc.err) > 0 {
 				s.Assert().EqualError(err, tc.err, "should have the correct error")
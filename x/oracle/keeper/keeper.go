@@ -26,6 +26,7 @@ type Keeper struct {
 	portKeeper      types.PortKeeper
 	scopedKeeper    types.ScopedKeeper
 	wasmQueryServer wasmtypes.QueryServer
+	contractKeeper  types.ContractKeeper
 
 	// the signing authority for the gov proposals
 	authority string
@@ -40,6 +41,7 @@ func NewKeeper(
 	portKeeper types.PortKeeper,
 	scopedKeeper types.ScopedKeeper,
 	wasmQueryServer wasmtypes.QueryServer,
+	contractKeeper types.ContractKeeper,
 ) *Keeper {
 	return &Keeper{
 		storeKey: storeKey,
@@ -51,6 +53,7 @@ func NewKeeper(
 		portKeeper:      portKeeper,
 		scopedKeeper:    scopedKeeper,
 		wasmQueryServer: wasmQueryServer,
+		contractKeeper:  contractKeeper,
 		authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	}
 }
@@ -131,3 +131,21 @@ func (m MockScopedKeeper) AuthenticateCapability(ctx sdk.Context, cap *capabilit
 func (m MockScopedKeeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
 	return nil
 }
+
+type MockContractKeeper struct {
+	Calls   [][]byte
+	Err     error
+	GasUsed uint64
+}
+
+func (k Keeper) WithMockContractKeeper(contractKeeper types.ContractKeeper) Keeper {
+	k.contractKeeper = contractKeeper
+	return k
+}
+
+func (m *MockContractKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.Calls = append(m.Calls, msg)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("mock_sudo", sdk.NewAttribute("contract", contractAddress.String())))
+	ctx.GasMeter().ConsumeGas(m.GasUsed, "mock sudo")
+	return nil, m.Err
+}
@@ -29,16 +29,37 @@ func (s msgServer) UpdateOracle(goCtx context.Context, msg *types.MsgUpdateOracl
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s got %s", s.Keeper.GetAuthority(), msg.GetAuthority())
 	}
 
-	s.Keeper.SetOracle(ctx, sdk.MustAccAddressFromBech32(msg.Address))
+	oracle := sdk.MustAccAddressFromBech32(msg.Address)
+	if len(msg.Name) > 0 {
+		s.Keeper.SetNamedOracle(ctx, msg.Name, oracle)
+	} else {
+		s.Keeper.SetOracle(ctx, oracle)
+	}
 
 	return &types.MsgUpdateOracleResponse{}, nil
 }
 
+// RemoveOracle removes a named oracle
+func (s msgServer) RemoveOracle(goCtx context.Context, msg *types.MsgRemoveOracleRequest) (*types.MsgRemoveOracleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != s.Keeper.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s got %s", s.Keeper.GetAuthority(), msg.GetAuthority())
+	}
+
+	if _, err := s.Keeper.GetNamedOracle(ctx, msg.Name); err != nil {
+		return nil, err
+	}
+	s.Keeper.RemoveNamedOracle(ctx, msg.Name)
+
+	return &types.MsgRemoveOracleResponse{}, nil
+}
+
 // SendQueryOracle sends an icq to another chain's oracle
 func (s msgServer) SendQueryOracle(goCtx context.Context, msg *types.MsgSendQueryOracleRequest) (*types.MsgSendQueryOracleResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	seq, err := s.QueryOracle(ctx, msg.Query, msg.Channel)
+	seq, err := s.QueryOracle(ctx, msg.Query, msg.Channel, msg.OracleName, msg.CallbackAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +35,15 @@ func (s *KeeperTestSuite) TestUpdateOracle() {
 			},
 			res: &types.MsgUpdateOracleResponse{},
 		},
+		{
+			name: "success - named oracle is updated",
+			req: &types.MsgUpdateOracleRequest{
+				Address:   s.accountAddresses[0].String(),
+				Authority: authority,
+				Name:      "price-feed",
+			},
+			res: &types.MsgUpdateOracleResponse{},
+		},
 	}
 
 	for _, tc := range tests {
@@ -56,6 +65,57 @@ func (s *KeeperTestSuite) TestUpdateOracle() {
 			} else {
 				s.Assert().NoError(err, "should not have error")
 				s.Assert().Equal(tc.res, res, "should have the correct response")
+				oracle, err := s.app.OracleKeeper.GetOracleByName(s.ctx, tc.req.Name)
+				s.Assert().NoError(err, "GetOracleByName")
+				s.Assert().Equal(tc.req.Address, oracle.String(), "should have updated the oracle")
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRemoveOracle() {
+	authority := s.app.OracleKeeper.GetAuthority()
+	s.app.OracleKeeper.SetNamedOracle(s.ctx, "price-feed", s.accountAddresses[0])
+
+	tests := []struct {
+		name string
+		req  *types.MsgRemoveOracleRequest
+		res  *types.MsgRemoveOracleResponse
+		err  string
+	}{
+		{
+			name: "failure - authority does not match module authority",
+			req:  types.NewMsgRemoveOracle("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "price-feed"),
+			err:  fmt.Sprintf("expected authority %s got cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma: unauthorized", authority),
+		},
+		{
+			name: "failure - unknown oracle",
+			req:  types.NewMsgRemoveOracle(authority, "unknown"),
+			err:  `no oracle named "unknown": unknown oracle`,
+		},
+		{
+			name: "success - oracle is removed",
+			req:  types.NewMsgRemoveOracle(authority, "price-feed"),
+			res:  &types.MsgRemoveOracleResponse{},
+		},
+		{
+			name: "failure - oracle was already removed",
+			req:  types.NewMsgRemoveOracle(authority, "price-feed"),
+			err:  `no oracle named "price-feed": unknown oracle`,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.RemoveOracle(s.ctx, tc.req)
+			if len(tc.err) > 0 {
+				s.Assert().Nil(res, "should have nil response")
+				s.Assert().EqualError(err, tc.err, "should have correct error")
+			} else {
+				s.Assert().NoError(err, "should not have error")
+				s.Assert().Equal(tc.res, res, "should have the correct response")
+				_, err = s.app.OracleKeeper.GetNamedOracle(s.ctx, tc.req.Name)
+				s.Assert().Error(err, "should have removed the oracle")
 			}
 		})
 	}
@@ -94,6 +154,18 @@ func (s *KeeperTestSuite) TestSendQueryOracle() {
 				Sequence: 1,
 			},
 			mockChannel: true,
+		}, {
+			name: "success - a packet should be sent to a named oracle",
+			req: &types.MsgSendQueryOracleRequest{
+				Query:      []byte("{}"),
+				Channel:    "channel-1",
+				Authority:  "authority",
+				OracleName: "price-feed",
+			},
+			res: &types.MsgSendQueryOracleResponse{
+				Sequence: 1,
+			},
+			mockChannel: true,
 		},
 	}
 
@@ -119,6 +191,11 @@ func (s *KeeperTestSuite) TestSendQueryOracle() {
 			} else {
 				s.Assert().NoError(err, "should not have error")
 				s.Assert().Equal(tc.res, res, "should have the correct response")
+				result, err := s.app.OracleKeeper.GetQueryResult(s.ctx, tc.req.Channel, res.Sequence)
+				s.Assert().NoError(err, "GetQueryResult")
+				if s.Assert().NotNil(result, "should record the query") {
+					s.Assert().Equal(tc.req.OracleName, result.OracleName, "should record the oracle name")
+				}
 			}
 		})
 	}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/oracle/types"
@@ -23,3 +25,46 @@ func (k Keeper) GetOracle(ctx sdk.Context) (oracle sdk.AccAddress, err error) {
 
 	return oracle, err
 }
+
+// SetNamedOracle Sets the address of the oracle registered under the provided name.
+func (k Keeper) SetNamedOracle(ctx sdk.Context, name string, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNamedOracleKey(name), oracle)
+}
+
+// GetNamedOracle Gets the address of the oracle registered under the provided name.
+func (k Keeper) GetNamedOracle(ctx sdk.Context, name string) (sdk.AccAddress, error) {
+	store := ctx.KVStore(k.storeKey)
+	oracle := store.Get(types.GetNamedOracleKey(name))
+	if len(oracle) == 0 {
+		return sdk.AccAddress{}, types.ErrUnknownOracle.Wrapf("no oracle named %q", name)
+	}
+	return oracle, nil
+}
+
+// RemoveNamedOracle Removes the oracle registered under the provided name.
+func (k Keeper) RemoveNamedOracle(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetNamedOracleKey(name))
+}
+
+// IterateNamedOracles Iterates over all the named oracles in name order.
+func (k Keeper) IterateNamedOracles(ctx sdk.Context, handler func(oracle types.NamedOracle) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.NamedOracleKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		name := string(it.Key()[len(types.NamedOracleKeyPrefix):])
+		if handler(types.NewNamedOracle(name, sdk.AccAddress(it.Value()).String())) {
+			break
+		}
+	}
+}
+
+// GetOracleByName Gets the oracle to route a query to.
+// An empty name is the module's default oracle, otherwise it's the oracle registered under that name.
+func (k Keeper) GetOracleByName(ctx sdk.Context, name string) (sdk.AccAddress, error) {
+	if len(name) == 0 {
+		return k.GetOracle(ctx)
+	}
+	return k.GetNamedOracle(ctx, name)
+}
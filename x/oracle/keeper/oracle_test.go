@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
 )
 
 func (s *KeeperTestSuite) TestGetSetOracle() {
@@ -36,3 +38,41 @@ func (s *KeeperTestSuite) TestGetSetOracle() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestNamedOracles() {
+	oracle1 := sdk.MustAccAddressFromBech32("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma")
+	oracle2 := s.accountAddresses[0]
+
+	_, err := s.app.OracleKeeper.GetNamedOracle(s.ctx, "price-feed")
+	s.Assert().EqualError(err, `no oracle named "price-feed": unknown oracle`, "GetNamedOracle before set")
+
+	s.app.OracleKeeper.SetOracle(s.ctx, oracle1)
+	s.app.OracleKeeper.SetNamedOracle(s.ctx, "price-feed", oracle2)
+	s.app.OracleKeeper.SetNamedOracle(s.ctx, "attestations", oracle1)
+
+	oracle, err := s.app.OracleKeeper.GetNamedOracle(s.ctx, "price-feed")
+	s.Assert().NoError(err, "GetNamedOracle after set")
+	s.Assert().Equal(oracle2, oracle, "GetNamedOracle after set")
+
+	oracle, err = s.app.OracleKeeper.GetOracleByName(s.ctx, "")
+	s.Assert().NoError(err, "GetOracleByName default")
+	s.Assert().Equal(oracle1, oracle, "GetOracleByName default")
+
+	oracle, err = s.app.OracleKeeper.GetOracleByName(s.ctx, "price-feed")
+	s.Assert().NoError(err, "GetOracleByName named")
+	s.Assert().Equal(oracle2, oracle, "GetOracleByName named")
+
+	var oracles []types.NamedOracle
+	s.app.OracleKeeper.IterateNamedOracles(s.ctx, func(namedOracle types.NamedOracle) bool {
+		oracles = append(oracles, namedOracle)
+		return false
+	})
+	s.Assert().Equal([]types.NamedOracle{
+		types.NewNamedOracle("attestations", oracle1.String()),
+		types.NewNamedOracle("price-feed", oracle2.String()),
+	}, oracles, "IterateNamedOracles")
+
+	s.app.OracleKeeper.RemoveNamedOracle(s.ctx, "price-feed")
+	_, err = s.app.OracleKeeper.GetOracleByName(s.ctx, "price-feed")
+	s.Assert().EqualError(err, `no oracle named "price-feed": unknown oracle`, "GetOracleByName after remove")
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// SetQueryResult stores an oracle query result.
+// A completed result is also scheduled to be pruned after the retention window.
+func (k Keeper) SetQueryResult(ctx sdk.Context, result types.OracleQueryResult) error {
+	bz, err := k.cdc.Marshal(&result)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueryResultKey(result.Channel, result.Sequence), bz)
+	if result.IsDone() {
+		store.Set(types.GetQueryResultExpirationKey(result.GetPruneHeight(), result.Channel, result.Sequence), []byte{})
+	}
+	return nil
+}
+
+// GetQueryResult gets an oracle query result. Returns nil, nil if the result doesn't exist.
+func (k Keeper) GetQueryResult(ctx sdk.Context, channel string, sequence uint64) (*types.OracleQueryResult, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetQueryResultKey(channel, sequence))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var result types.OracleQueryResult
+	if err := k.cdc.Unmarshal(bz, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// IterateQueryResults processes all the stored oracle query results with the given handler.
+func (k Keeper) IterateQueryResults(ctx sdk.Context, handler func(result types.OracleQueryResult) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueryResultKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var result types.OracleQueryResult
+		if err := k.cdc.Unmarshal(it.Value(), &result); err != nil {
+			return err
+		}
+		if handler(result) {
+			break
+		}
+	}
+	return nil
+}
+
+// PruneQueryResults deletes the completed oracle query results whose retention window has ended.
+func (k Keeper) PruneQueryResults(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	it := store.Iterator(types.QueryResultExpirationKeyPrefix, types.GetQueryResultExpirationPrefix(ctx.BlockHeight()+1))
+	var toDelete [][]byte
+	for ; it.Valid(); it.Next() {
+		toDelete = append(toDelete, it.Key())
+	}
+	it.Close()
+
+	for _, key := range toDelete {
+		store.Delete(key)
+		channel, sequence, ok := types.ParseQueryResultExpirationKey(key)
+		if !ok {
+			k.Logger(ctx).Error("invalid oracle query result expiration key", "key", fmt.Sprintf("%X", key))
+			continue
+		}
+		store.Delete(types.GetQueryResultKey(channel, sequence))
+	}
+}
+
+// recordQueryResponse updates the result of a sent oracle query and then calls its callback contract.
+func (k Keeper) recordQueryResponse(ctx sdk.Context, channel string, sequence uint64, status types.QueryStatus, data []byte, errMsg string) error {
+	result, err := k.GetQueryResult(ctx, channel, sequence)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		// Queries sent before results were stored won't have a pending result.
+		result = &types.OracleQueryResult{Channel: channel, Sequence: sequence}
+	}
+	result.Status = status
+	result.Result = data
+	result.Error = errMsg
+	result.ResponseHeight = ctx.BlockHeight()
+	if err = k.SetQueryResult(ctx, *result); err != nil {
+		return err
+	}
+
+	k.runCallback(ctx, *result)
+	return nil
+}
+
+// runCallback sudo-calls the callback contract of an oracle query (if it has one) with its result.
+// A failing callback does not fail the packet handling; an EventOracleQueryCallbackError is emitted instead.
+func (k Keeper) runCallback(ctx sdk.Context, result types.OracleQueryResult) {
+	if len(result.CallbackAddress) == 0 || k.contractKeeper == nil {
+		return
+	}
+
+	err := k.sudoCallback(ctx, result)
+	if err == nil {
+		return
+	}
+
+	k.Logger(ctx).Error("oracle query callback failed", "sequence", result.Sequence, "contract", result.CallbackAddress, "error", err)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventOracleQueryCallbackError{
+		Channel:    result.Channel,
+		SequenceId: strconv.FormatUint(result.Sequence, 10),
+		Contract:   result.CallbackAddress,
+		Error:      err.Error(),
+	})
+	if err != nil {
+		k.Logger(ctx).Error("oracle query callback error was unable to emit event", "sequence", result.Sequence, "error", err)
+	}
+}
+
+// sudoCallback sudo-calls the callback contract of an oracle query using at most the CallbackGasLimit.
+// State changes made by the contract are only kept if it succeeds.
+func (k Keeper) sudoCallback(ctx sdk.Context, result types.OracleQueryResult) (err error) {
+	contract, err := sdk.AccAddressFromBech32(result.CallbackAddress)
+	if err != nil {
+		return fmt.Errorf("invalid callback address: %w", err)
+	}
+	msg, err := json.Marshal(types.NewOracleQueryCallback(result))
+	if err != nil {
+		return fmt.Errorf("could not marshal callback message: %w", err)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := storetypes.NewGasMeter(types.CallbackGasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "oracle query callback")
+		if r := recover(); r != nil {
+			oog, isOOG := r.(storetypes.ErrorOutOfGas)
+			if !isOOG {
+				panic(r)
+			}
+			err = fmt.Errorf("out of gas in location: %v", oog.Descriptor)
+		}
+	}()
+
+	if _, err = k.contractKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/x/oracle/keeper"
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestQueryResultLifecycle() {
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockChannelKeeper(&keeper.MockChannelKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockICS4Wrapper(&keeper.MockICS4Wrapper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockScopedKeeper(&keeper.MockScopedKeeper{})
+
+	sequence, err := s.app.OracleKeeper.QueryOracle(s.ctx, []byte("{}"), "channel-1", "", "")
+	s.Require().NoError(err, "QueryOracle")
+
+	result, err := s.app.OracleKeeper.GetQueryResult(s.ctx, "channel-1", sequence)
+	s.Require().NoError(err, "GetQueryResult pending")
+	s.Assert().Equal(&types.OracleQueryResult{
+		Sequence:      sequence,
+		Channel:       "channel-1",
+		Query:         []byte("{}"),
+		Status:        types.QueryStatus_QUERY_STATUS_PENDING,
+		RequestHeight: 100,
+	}, result, "pending query result")
+
+	ackCtx := s.ctx.WithBlockHeight(105)
+	packet := channeltypes.Packet{Sequence: sequence, SourceChannel: "channel-1", DestinationChannel: "oracle-channel"}
+	ack := channeltypes.NewResultAcknowledgement(s.createICQResponse(s.app.AppCodec(), "{}"))
+	s.Require().NoError(s.app.OracleKeeper.OnAcknowledgementPacket(ackCtx, packet, ack), "OnAcknowledgementPacket")
+
+	expected := types.OracleQueryResult{
+		Sequence:       sequence,
+		Channel:        "channel-1",
+		Query:          []byte("{}"),
+		Status:         types.QueryStatus_QUERY_STATUS_SUCCESS,
+		Result:         []byte("{}"),
+		RequestHeight:  100,
+		ResponseHeight: 105,
+	}
+	resp, err := s.app.OracleKeeper.OracleQueryResult(s.ctx, &types.QueryOracleQueryResultRequest{Channel: "channel-1", Sequence: sequence})
+	s.Require().NoError(err, "OracleQueryResult after ack")
+	s.Assert().Equal(expected, resp.Result, "query result after ack")
+
+	pruneHeight := 105 + types.QueryResultRetentionBlocks
+	s.app.OracleKeeper.PruneQueryResults(s.ctx.WithBlockHeight(pruneHeight - 1))
+	result, err = s.app.OracleKeeper.GetQueryResult(s.ctx, "channel-1", sequence)
+	s.Require().NoError(err, "GetQueryResult before prune height")
+	s.Assert().NotNil(result, "query result before prune height")
+
+	s.app.OracleKeeper.PruneQueryResults(s.ctx.WithBlockHeight(pruneHeight))
+	result, err = s.app.OracleKeeper.GetQueryResult(s.ctx, "channel-1", sequence)
+	s.Require().NoError(err, "GetQueryResult at prune height")
+	s.Assert().Nil(result, "query result at prune height")
+}
+
+func (s *KeeperTestSuite) TestQueryResultResponses() {
+	tests := []struct {
+		name     string
+		respond  func(ctx sdk.Context, packet channeltypes.Packet) error
+		expected types.OracleQueryResult
+	}{
+		{
+			name: "ack error",
+			respond: func(ctx sdk.Context, packet channeltypes.Packet) error {
+				return s.app.OracleKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewErrorAcknowledgement(errors.New("bad")))
+			},
+			expected: types.OracleQueryResult{
+				Sequence:       3,
+				Channel:        "channel-1",
+				Status:         types.QueryStatus_QUERY_STATUS_ERROR,
+				Error:          "ABCI code: 1: error handling packet: see events for details",
+				ResponseHeight: 100,
+			},
+		},
+		{
+			name: "timeout",
+			respond: func(ctx sdk.Context, packet channeltypes.Packet) error {
+				return s.app.OracleKeeper.OnTimeoutPacket(ctx, packet)
+			},
+			expected: types.OracleQueryResult{
+				Sequence:       3,
+				Channel:        "channel-1",
+				Status:         types.QueryStatus_QUERY_STATUS_TIMEOUT,
+				ResponseHeight: 100,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			packet := channeltypes.Packet{Sequence: 3, SourceChannel: "channel-1", DestinationChannel: "oracle-channel"}
+			s.Require().NoError(tc.respond(s.ctx, packet), "responding to packet")
+			result, err := s.app.OracleKeeper.GetQueryResult(s.ctx, "channel-1", 3)
+			s.Require().NoError(err, "GetQueryResult")
+			s.Assert().Equal(&tc.expected, result, "query result")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryResultCallback() {
+	contract := s.accountAddresses[0].String()
+	success := `{"oracle_query_result":{"channel":"channel-1","sequence":3,"status":"success","result":{}}}`
+
+	tests := []struct {
+		name      string
+		callback  string
+		mock      *keeper.MockContractKeeper
+		expCalls  []string
+		expSudo   bool
+		expErrEvt string
+	}{
+		{
+			name:     "no callback address",
+			mock:     &keeper.MockContractKeeper{},
+			expCalls: nil,
+		},
+		{
+			name:     "callback succeeds",
+			callback: contract,
+			mock:     &keeper.MockContractKeeper{},
+			expCalls: []string{success},
+			expSudo:  true,
+		},
+		{
+			name:      "callback fails",
+			callback:  contract,
+			mock:      &keeper.MockContractKeeper{Err: errors.New("contract failed")},
+			expCalls:  []string{success},
+			expErrEvt: "contract failed",
+		},
+		{
+			name:      "callback runs out of gas",
+			callback:  contract,
+			mock:      &keeper.MockContractKeeper{GasUsed: types.CallbackGasLimit + 1},
+			expCalls:  []string{success},
+			expErrEvt: "out of gas in location: mock sudo",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.app.OracleKeeper = s.app.OracleKeeper.WithMockContractKeeper(tc.mock)
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(s.app.OracleKeeper.SetQueryResult(ctx, types.NewPendingOracleQueryResult("channel-1", 3, []byte("{}"), 90, tc.callback)), "SetQueryResult")
+
+			packet := channeltypes.Packet{Sequence: 3, SourceChannel: "channel-1", DestinationChannel: "oracle-channel"}
+			ack := channeltypes.NewResultAcknowledgement(s.createICQResponse(s.app.AppCodec(), "{}"))
+			s.Require().NoError(s.app.OracleKeeper.OnAcknowledgementPacket(ctx, packet, ack), "OnAcknowledgementPacket")
+
+			var calls []string
+			for _, call := range tc.mock.Calls {
+				calls = append(calls, string(call))
+			}
+			s.Assert().Equal(tc.expCalls, calls, "callback calls")
+
+			var sudoEmitted bool
+			var errEvent sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				switch event.Type {
+				case "mock_sudo":
+					sudoEmitted = true
+				case "provenance.oracle.v1.EventOracleQueryCallbackError":
+					errEvent = event
+				}
+			}
+			s.Assert().Equal(tc.expSudo, sudoEmitted, "whether the callback's state changes were kept")
+			if len(tc.expErrEvt) == 0 {
+				s.Assert().Empty(errEvent.Type, "callback error event")
+				return
+			}
+			expEvent, err := sdk.TypedEventToEvent(&types.EventOracleQueryCallbackError{
+				Channel:    "channel-1",
+				SequenceId: strconv.FormatUint(3, 10),
+				Contract:   contract,
+				Error:      tc.expErrEvt,
+			})
+			s.Require().NoError(err, "TypedEventToEvent")
+			s.Assert().Equal(expEvent, errEvent, "callback error event")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOracleQueryResult() {
+	result := types.OracleQueryResult{
+		Sequence:       2,
+		Channel:        "channel-1",
+		Query:          []byte("{}"),
+		Status:         types.QueryStatus_QUERY_STATUS_SUCCESS,
+		Result:         []byte("{}"),
+		RequestHeight:  90,
+		ResponseHeight: 95,
+	}
+	s.Require().NoError(s.app.OracleKeeper.SetQueryResult(s.ctx, result), "SetQueryResult")
+
+	tests := []struct {
+		name     string
+		req      *types.QueryOracleQueryResultRequest
+		expected *types.QueryOracleQueryResultResponse
+		err      string
+	}{
+		{
+			name: "failure - should handle nil request",
+			req:  nil,
+			err:  "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name: "failure - should handle invalid channel",
+			req:  &types.QueryOracleQueryResultRequest{Channel: "", Sequence: 2},
+			err:  "rpc error: code = InvalidArgument desc = invalid channel id \"\"",
+		},
+		{
+			name: "failure - should handle unknown result",
+			req:  &types.QueryOracleQueryResultRequest{Channel: "channel-1", Sequence: 3},
+			err:  "rpc error: code = NotFound desc = no query result for sequence 3 on channel channel-1",
+		},
+		{
+			name:     "success - should return the query result",
+			req:      &types.QueryOracleQueryResultRequest{Channel: "channel-1", Sequence: 2},
+			expected: &types.QueryOracleQueryResultResponse{Result: result},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.OracleKeeper.OracleQueryResult(s.ctx, tc.req)
+			if len(tc.err) > 0 {
+				s.Assert().EqualError(err, tc.err, "should return the correct error")
+				s.Assert().Nil(resp, "response should be nil")
+			} else {
+				s.Assert().NoError(err, "should not return an error")
+				s.Assert().Equal(tc.expected, resp, "should return the correct response")
+			}
+		})
+	}
+}
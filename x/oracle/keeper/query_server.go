@@ -7,6 +7,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/oracle/types"
@@ -14,13 +16,32 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
-// QueryAddress returns the address of the module's oracle
-func (k Keeper) OracleAddress(goCtx context.Context, _ *types.QueryOracleAddressRequest) (*types.QueryOracleAddressResponse, error) {
+// QueryAddress returns the address of the module's oracle, or of a named oracle
+func (k Keeper) OracleAddress(goCtx context.Context, req *types.QueryOracleAddressRequest) (*types.QueryOracleAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	oracle, _ := k.GetOracle(ctx)
+	if req == nil || len(req.Name) == 0 {
+		oracle, _ := k.GetOracle(ctx)
+		return &types.QueryOracleAddressResponse{Address: oracle.String()}, nil
+	}
+
+	oracle, err := k.GetNamedOracle(ctx, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 	return &types.QueryOracleAddressResponse{Address: oracle.String()}, nil
 }
 
+// Oracles returns all the named oracles
+func (k Keeper) Oracles(goCtx context.Context, _ *types.QueryOraclesRequest) (*types.QueryOraclesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QueryOraclesResponse{}
+	k.IterateNamedOracles(ctx, func(oracle types.NamedOracle) bool {
+		resp.Oracles = append(resp.Oracles, oracle)
+		return false
+	})
+	return resp, nil
+}
+
 // Oracle queries module's oracle
 func (k Keeper) Oracle(goCtx context.Context, req *types.QueryOracleRequest) (*types.QueryOracleResponse, error) {
 	if req == nil {
@@ -31,7 +52,7 @@ func (k Keeper) Oracle(goCtx context.Context, req *types.QueryOracleRequest) (*t
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	addr, err := k.GetOracle(ctx)
+	addr, err := k.GetOracleByName(ctx, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -45,3 +66,23 @@ func (k Keeper) Oracle(goCtx context.Context, req *types.QueryOracleRequest) (*t
 	}
 	return &types.QueryOracleResponse{Data: resp.Data}, nil
 }
+
+// OracleQueryResult returns the stored result of a query sent to another chain's oracle
+func (k Keeper) OracleQueryResult(goCtx context.Context, req *types.QueryOracleQueryResultRequest) (*types.QueryOracleQueryResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := host.ChannelIdentifierValidator(req.Channel); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel id %q", req.Channel)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	result, err := k.GetQueryResult(ctx, req.Channel, req.Sequence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if result == nil {
+		return nil, status.Errorf(codes.NotFound, "no query result for sequence %d on channel %s", req.Sequence, req.Channel)
+	}
+	return &types.QueryOracleQueryResultResponse{Result: *result}, nil
+}
@@ -30,8 +30,20 @@ func (s *KeeperTestSuite) TestOracleAddress() {
 			oracle:   "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
 			expected: &types.QueryOracleAddressResponse{Address: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"},
 		},
+		{
+			name: "failure - should handle unknown named oracle",
+			req:  &types.QueryOracleAddressRequest{Name: "unknown"},
+			err:  `rpc error: code = NotFound desc = no oracle named "unknown": unknown oracle`,
+		},
+		{
+			name:     "success - should return correct named oracle address",
+			req:      &types.QueryOracleAddressRequest{Name: "price-feed"},
+			expected: &types.QueryOracleAddressResponse{Address: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"},
+		},
 	}
 
+	s.app.OracleKeeper.SetNamedOracle(s.ctx, "price-feed", sdk.MustAccAddressFromBech32("cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"))
+
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			if len(tc.oracle) > 0 {
@@ -103,8 +115,29 @@ func (s *KeeperTestSuite) TestOracle() {
 			},
 			mockEnabled: true,
 		},
+		{
+			name: "failure - should handle unknown named oracle",
+			req: &types.QueryOracleRequest{
+				Query: []byte("{}"),
+				Name:  "unknown",
+			},
+			err: `no oracle named "unknown": unknown oracle`,
+		},
+		{
+			name: "success - should route to named oracle",
+			req: &types.QueryOracleRequest{
+				Query: []byte("{}"),
+				Name:  "price-feed",
+			},
+			expected: &types.QueryOracleResponse{
+				Data: []byte("{}"),
+			},
+			mockEnabled: true,
+		},
 	}
 
+	s.app.OracleKeeper.SetNamedOracle(s.ctx, "price-feed", sdk.MustAccAddressFromBech32("cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"))
+
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			if tc.mockEnabled {
@@ -126,3 +159,19 @@ func (s *KeeperTestSuite) TestOracle() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestOracles() {
+	resp, err := s.app.OracleKeeper.Oracles(s.ctx, &types.QueryOraclesRequest{})
+	s.Assert().NoError(err, "Oracles with no named oracles")
+	s.Assert().Equal(&types.QueryOraclesResponse{}, resp, "Oracles with no named oracles")
+
+	s.app.OracleKeeper.SetNamedOracle(s.ctx, "price-feed", sdk.MustAccAddressFromBech32("cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"))
+	s.app.OracleKeeper.SetNamedOracle(s.ctx, "attestations", sdk.MustAccAddressFromBech32("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"))
+
+	resp, err = s.app.OracleKeeper.Oracles(s.ctx, &types.QueryOraclesRequest{})
+	s.Assert().NoError(err, "Oracles with named oracles")
+	s.Assert().Equal(&types.QueryOraclesResponse{Oracles: []types.NamedOracle{
+		types.NewNamedOracle("attestations", "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"),
+		types.NewNamedOracle("price-feed", "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"),
+	}}, resp, "Oracles with named oracles")
+}
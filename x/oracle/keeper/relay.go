@@ -122,6 +122,8 @@ func (k Keeper) OnAcknowledgementPacket(
 			k.Logger(ctx).Error("interchain query ack response was unable to emit event", "sequence", modulePacket.Sequence, "error", err)
 			return err
 		}
+
+		return k.recordQueryResponse(ctx, modulePacket.SourceChannel, modulePacket.Sequence, types.QueryStatus_QUERY_STATUS_SUCCESS, r.Data, "")
 	case *channeltypes.Acknowledgement_Error:
 		err := ctx.EventManager().EmitTypedEvent(&types.EventOracleQueryError{
 			SequenceId: strconv.FormatUint(modulePacket.Sequence, 10),
@@ -135,6 +137,8 @@ func (k Keeper) OnAcknowledgementPacket(
 			k.Logger(ctx).Error("interchain query ack error response was unable to emit event", "sequence", modulePacket.Sequence, "error", err)
 			return err
 		}
+
+		return k.recordQueryResponse(ctx, modulePacket.SourceChannel, modulePacket.Sequence, types.QueryStatus_QUERY_STATUS_ERROR, nil, resp.Error)
 	}
 	return nil
 }
@@ -156,5 +160,5 @@ func (k Keeper) OnTimeoutPacket(
 		return err
 	}
 
-	return nil
+	return k.recordQueryResponse(ctx, modulePacket.SourceChannel, modulePacket.Sequence, types.QueryStatus_QUERY_STATUS_TIMEOUT, nil, "")
 }
@@ -35,7 +35,8 @@ var (
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the oracle module.
@@ -170,6 +171,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock prunes the oracle query results that are past their retention window.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.PruneQueryResults(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
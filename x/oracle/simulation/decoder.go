@@ -13,7 +13,7 @@ import (
 
 // NewDecodeStore returns a decoder function closure that unmarshalls the KVPair's
 // Value
-func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.Equal(kvA.Key[:1], types.OracleStoreKey):
@@ -24,6 +24,16 @@ func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
 			attribB := string(kvB.Value)
 
 			return fmt.Sprintf("Port: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.NamedOracleKeyPrefix):
+			var attribA, attribB sdk.AccAddress = kvA.Value, kvB.Value
+			return fmt.Sprintf("Named Oracle %s: A:[%v] B:[%v]\n", kvA.Key[1:], attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.QueryResultKeyPrefix):
+			var resultA, resultB types.OracleQueryResult
+			cdc.MustUnmarshal(kvA.Value, &resultA)
+			cdc.MustUnmarshal(kvB.Value, &resultB)
+			return fmt.Sprintf("Query Result: A:[%v] B:[%v]\n", resultA, resultB)
+		case bytes.Equal(kvA.Key[:1], types.QueryResultExpirationKeyPrefix):
+			return fmt.Sprintf("Query Result Expiration: A:[%X] B:[%X]\n", kvA.Key, kvB.Key)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -42,6 +42,24 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: types.GetPortStoreKey(), Value: []byte("88")},
 			exp:  "Port: A:[99] B:[88]\n",
 		},
+		{
+			name: "success - NamedOracleKeyPrefix",
+			kvA:  kv.Pair{Key: types.GetNamedOracleKey("feed"), Value: []byte("99")},
+			kvB:  kv.Pair{Key: types.GetNamedOracleKey("feed"), Value: []byte("88")},
+			exp:  "Named Oracle feed: A:[3939] B:[3838]\n",
+		},
+		{
+			name: "success - QueryResultKeyPrefix",
+			kvA:  kv.Pair{Key: types.GetQueryResultKey("channel-1", 1), Value: cdc.MustMarshal(&types.OracleQueryResult{Sequence: 1, Channel: "channel-1"})},
+			kvB:  kv.Pair{Key: types.GetQueryResultKey("channel-1", 1), Value: cdc.MustMarshal(&types.OracleQueryResult{Sequence: 1, Channel: "channel-2"})},
+			exp:  "Query Result: A:[{1 channel-1 [] QUERY_STATUS_UNSPECIFIED []  0 0  }] B:[{1 channel-2 [] QUERY_STATUS_UNSPECIFIED []  0 0  }]\n",
+		},
+		{
+			name: "success - QueryResultExpirationKeyPrefix",
+			kvA:  kv.Pair{Key: types.GetQueryResultExpirationKey(1, "channel-1", 1), Value: []byte{}},
+			kvB:  kv.Pair{Key: types.GetQueryResultExpirationKey(2, "channel-1", 1), Value: []byte{}},
+			exp:  "Query Result Expiration: A:[040000000000000001096368616E6E656C2D310000000000000001] B:[040000000000000002096368616E6E656C2D310000000000000001]\n",
+		},
 	}
 
 	for _, tc := range tests {
@@ -92,8 +92,10 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     0,
 			accounts: nil,
 			expOracleGen: &types.GenesisState{
-				PortId: "vipxlpbshz",
-				Oracle: "",
+				PortId:       "vipxlpbshz",
+				Oracle:       "",
+				QueryResults: []types.OracleQueryResult{},
+				NamedOracles: []types.NamedOracle{},
 			},
 		},
 		{
@@ -101,8 +103,10 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     1,
 			accounts: accs,
 			expOracleGen: &types.GenesisState{
-				PortId: "oracle",
-				Oracle: "",
+				PortId:       "oracle",
+				Oracle:       "",
+				QueryResults: []types.OracleQueryResult{},
+				NamedOracles: []types.NamedOracle{},
 			},
 		},
 		{
@@ -110,8 +114,10 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     2,
 			accounts: accs,
 			expOracleGen: &types.GenesisState{
-				PortId: "knxndtw",
-				Oracle: "cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0",
+				PortId:       "knxndtw",
+				Oracle:       "cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0",
+				QueryResults: []types.OracleQueryResult{},
+				NamedOracles: []types.NamedOracle{},
 			},
 		},
 	}
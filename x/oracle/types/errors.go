@@ -8,4 +8,5 @@ var (
 	ErrInvalidPacketTimeout = cerrs.Register(ModuleName, 3, "invalid packet timeout")
 	ErrInvalidVersion       = cerrs.Register(ModuleName, 4, "invalid version")
 	ErrMissingOracleAddress = cerrs.Register(ModuleName, 5, "missing oracle address")
+	ErrUnknownOracle        = cerrs.Register(ModuleName, 6, "unknown oracle")
 )
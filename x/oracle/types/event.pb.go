@@ -205,33 +205,109 @@ func (m *EventOracleQueryTimeout) GetSequenceId() string {
 	return ""
 }
 
+// EventOracleQueryCallbackError is an event for when the callback contract of an oracle query fails
+type EventOracleQueryCallbackError struct {
+	// channel is the local channel that the oracle query was sent on
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// sequence_id is a unique identifier of the query
+	SequenceId string `protobuf:"bytes,2,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// contract is the address of the callback contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// error is the error returned from the callback contract
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventOracleQueryCallbackError) Reset()         { *m = EventOracleQueryCallbackError{} }
+func (m *EventOracleQueryCallbackError) String() string { return proto.CompactTextString(m) }
+func (*EventOracleQueryCallbackError) ProtoMessage()    {}
+func (*EventOracleQueryCallbackError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98d10c8454ad24d, []int{3}
+}
+func (m *EventOracleQueryCallbackError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOracleQueryCallbackError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOracleQueryCallbackError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOracleQueryCallbackError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOracleQueryCallbackError.Merge(m, src)
+}
+func (m *EventOracleQueryCallbackError) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOracleQueryCallbackError) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOracleQueryCallbackError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOracleQueryCallbackError proto.InternalMessageInfo
+
+func (m *EventOracleQueryCallbackError) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *EventOracleQueryCallbackError) GetSequenceId() string {
+	if m != nil {
+		return m.SequenceId
+	}
+	return ""
+}
+
+func (m *EventOracleQueryCallbackError) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventOracleQueryCallbackError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventOracleQuerySuccess)(nil), "provenance.oracle.v1.EventOracleQuerySuccess")
 	proto.RegisterType((*EventOracleQueryError)(nil), "provenance.oracle.v1.EventOracleQueryError")
 	proto.RegisterType((*EventOracleQueryTimeout)(nil), "provenance.oracle.v1.EventOracleQueryTimeout")
+	proto.RegisterType((*EventOracleQueryCallbackError)(nil), "provenance.oracle.v1.EventOracleQueryCallbackError")
 }
 
 func init() { proto.RegisterFile("provenance/oracle/v1/event.proto", fileDescriptor_e98d10c8454ad24d) }
 
 var fileDescriptor_e98d10c8454ad24d = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33,
-	0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x41, 0xa8,
-	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x54, 0xca, 0xe1, 0x12, 0x77, 0x05, 0x29, 0xf2, 0x07, 0x8b,
-	0x04, 0x96, 0xa6, 0x16, 0x55, 0x06, 0x97, 0x26, 0x27, 0xa7, 0x16, 0x17, 0x0b, 0x49, 0x70, 0xb1,
-	0x27, 0x67, 0x24, 0xe6, 0xe5, 0xa5, 0xe6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8,
-	0x42, 0xf2, 0x5c, 0xdc, 0xc5, 0xa9, 0x85, 0xa5, 0xa9, 0x79, 0xc9, 0xa9, 0xf1, 0x99, 0x29, 0x12,
-	0x4c, 0x60, 0x59, 0x2e, 0x98, 0x90, 0x67, 0x8a, 0x90, 0x18, 0x17, 0x5b, 0x51, 0x6a, 0x71, 0x69,
-	0x4e, 0x89, 0x04, 0x33, 0x58, 0x0e, 0xca, 0x53, 0xca, 0xe0, 0x12, 0x45, 0xb7, 0xcd, 0xb5, 0xa8,
-	0x28, 0xbf, 0x88, 0x12, 0xbb, 0x44, 0xb8, 0x58, 0x53, 0x41, 0x66, 0x40, 0xad, 0x82, 0x70, 0x94,
-	0x42, 0x30, 0xfd, 0x15, 0x92, 0x99, 0x9b, 0x9a, 0x5f, 0x5a, 0x42, 0x81, 0x5d, 0x4e, 0xe9, 0x27,
-	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0xc0, 0x25, 0x9e, 0x99, 0xaf, 0x87, 0x2d, 0x80,
-	0x03, 0x18, 0xa3, 0x8c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11,
-	0x4a, 0x74, 0x33, 0xf3, 0x91, 0x78, 0xfa, 0x15, 0xb0, 0x58, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0xc7, 0x99, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x36, 0xdb, 0x1f, 0x32, 0xd7, 0x01,
-	0x00, 0x00,
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xe3, 0xef, 0x83, 0x02, 0x97, 0x2d, 0x2a, 0x34, 0x42, 0xc2, 0x54, 0x99, 0x58, 0x48,
+	0x54, 0x78, 0x03, 0x50, 0x07, 0x26, 0xfe, 0x75, 0x62, 0x41, 0x8e, 0x7b, 0xd5, 0x44, 0xb8, 0x76,
+	0x70, 0xec, 0x88, 0xbe, 0x03, 0x03, 0x8f, 0xc5, 0xd8, 0x91, 0x11, 0x25, 0x2f, 0x82, 0x9a, 0x36,
+	0xa4, 0x2a, 0x6c, 0x19, 0x7f, 0xf7, 0x1e, 0xdd, 0xa3, 0x63, 0x1f, 0xe8, 0xa7, 0x5a, 0xe5, 0x28,
+	0x99, 0xe4, 0x18, 0x2a, 0xcd, 0xb8, 0xc0, 0x30, 0x1f, 0x84, 0x98, 0xa3, 0x34, 0x41, 0xaa, 0x95,
+	0x51, 0x6e, 0xb7, 0x51, 0x04, 0x4b, 0x45, 0x90, 0x0f, 0x7c, 0x01, 0xbd, 0xe1, 0x42, 0x74, 0x53,
+	0x4d, 0xee, 0x2c, 0xea, 0xd9, 0x83, 0xe5, 0x1c, 0xb3, 0xcc, 0xf5, 0x60, 0x87, 0xc7, 0x4c, 0x4a,
+	0x14, 0x1e, 0xe9, 0x93, 0xd3, 0xbd, 0xfb, 0x1a, 0xdd, 0x13, 0xd8, 0xcf, 0xf0, 0xc5, 0xa2, 0xe4,
+	0xf8, 0x94, 0x8c, 0xbd, 0x7f, 0xd5, 0x16, 0xea, 0xd1, 0xf5, 0xd8, 0x3d, 0x84, 0x8e, 0xc6, 0xcc,
+	0x0a, 0xe3, 0xfd, 0xaf, 0x76, 0x2b, 0xf2, 0x63, 0x38, 0xd8, 0x74, 0x1b, 0x6a, 0xad, 0x74, 0x1b,
+	0xaf, 0x2e, 0x6c, 0xe3, 0xe2, 0xc6, 0xca, 0x6a, 0x09, 0xfe, 0xe8, 0x77, 0xae, 0x51, 0x32, 0x45,
+	0x65, 0x4d, 0x0b, 0x2f, 0xff, 0x8d, 0xc0, 0xf1, 0xe6, 0xd9, 0x2b, 0x26, 0x44, 0xc4, 0xf8, 0x73,
+	0xeb, 0x20, 0x47, 0xb0, 0xcb, 0x95, 0x34, 0x9a, 0xf1, 0xfa, 0xd9, 0x7e, 0xb8, 0x09, 0xb9, 0xb5,
+	0x16, 0xf2, 0x72, 0xf2, 0x51, 0x50, 0x32, 0x2f, 0x28, 0xf9, 0x2a, 0x28, 0x79, 0x2f, 0xa9, 0x33,
+	0x2f, 0xa9, 0xf3, 0x59, 0x52, 0x07, 0x7a, 0x89, 0x0a, 0xfe, 0xfa, 0xef, 0x5b, 0xf2, 0x78, 0x3e,
+	0x49, 0x4c, 0x6c, 0xa3, 0x80, 0xab, 0x69, 0xd8, 0x48, 0xce, 0x12, 0xb5, 0x46, 0xe1, 0x6b, 0x5d,
+	0x22, 0x33, 0x4b, 0x31, 0x8b, 0x3a, 0x55, 0x85, 0x2e, 0xbe, 0x07, 0x00, 0x01, 0xc6, 0xb9, 0x7a,
+	0x66, 0x02, 0x00, 0x00,
 }
 
 func (m *EventOracleQuerySuccess) Marshal() (dAtA []byte, err error) {
@@ -359,6 +435,57 @@ func (m *EventOracleQueryTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOracleQueryCallbackError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOracleQueryCallbackError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOracleQueryCallbackError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SequenceId) > 0 {
+		i -= len(m.SequenceId)
+		copy(dAtA[i:], m.SequenceId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SequenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -429,6 +556,31 @@ func (m *EventOracleQueryTimeout) Size() (n int) {
 	return n
 }
 
+func (m *EventOracleQueryCallbackError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.SequenceId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -841,6 +993,184 @@ func (m *EventOracleQueryTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOracleQueryCallbackError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOracleQueryCallbackError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOracleQueryCallbackError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38,3 +38,8 @@ type ScopedKeeper interface {
 	AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool
 	ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error
 }
+
+// ContractKeeper defines the expected wasm keeper used to call an oracle query's callback contract
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
@@ -29,5 +31,28 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	seen := make(map[string]bool, len(gs.QueryResults))
+	for i, result := range gs.QueryResults {
+		if err = result.Validate(); err != nil {
+			return fmt.Errorf("invalid query result[%d]: %w", i, err)
+		}
+		key := string(GetQueryResultKey(result.Channel, result.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate query result for sequence %d on channel %s", result.Sequence, result.Channel)
+		}
+		seen[key] = true
+	}
+
+	names := make(map[string]bool, len(gs.NamedOracles))
+	for i, oracle := range gs.NamedOracles {
+		if err = oracle.Validate(); err != nil {
+			return fmt.Errorf("invalid named oracle[%d]: %w", i, err)
+		}
+		if names[oracle.Name] {
+			return fmt.Errorf("duplicate named oracle %q", oracle.Name)
+		}
+		names[oracle.Name] = true
+	}
+
 	return nil
 }
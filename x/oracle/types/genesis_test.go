@@ -46,6 +46,63 @@ func TestGenesisValidate(t *testing.T) {
 			state: NewGenesisState(PortID, "abc"),
 			err:   "decoding bech32 failed: invalid bech32 string length 3",
 		},
+		{
+			name: "success - valid query results",
+			state: &GenesisState{
+				PortId: PortID,
+				QueryResults: []OracleQueryResult{
+					NewPendingOracleQueryResult("channel-1", 1, []byte("{}"), 1, ""),
+					NewPendingOracleQueryResult("channel-2", 1, []byte("{}"), 1, ""),
+				},
+			},
+		},
+		{
+			name: "failure - invalid query result",
+			state: &GenesisState{
+				PortId:       PortID,
+				QueryResults: []OracleQueryResult{NewPendingOracleQueryResult("channel-1", 0, []byte("{}"), 1, "")},
+			},
+			err: "invalid query result[0]: invalid sequence: cannot be zero",
+		},
+		{
+			name: "failure - duplicate query result",
+			state: &GenesisState{
+				PortId: PortID,
+				QueryResults: []OracleQueryResult{
+					NewPendingOracleQueryResult("channel-1", 1, []byte("{}"), 1, ""),
+					NewPendingOracleQueryResult("channel-1", 1, []byte("{}"), 2, ""),
+				},
+			},
+			err: "duplicate query result for sequence 1 on channel channel-1",
+		}, {
+			name: "success - valid named oracles",
+			state: &GenesisState{
+				PortId: PortID,
+				NamedOracles: []NamedOracle{
+					NewNamedOracle("attestations", "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"),
+					NewNamedOracle("price-feed", "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"),
+				},
+			},
+		},
+		{
+			name: "failure - invalid named oracle",
+			state: &GenesisState{
+				PortId:       PortID,
+				NamedOracles: []NamedOracle{NewNamedOracle("price-feed", "abc")},
+			},
+			err: `invalid named oracle[0]: invalid address for oracle "price-feed": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name: "failure - duplicate named oracle",
+			state: &GenesisState{
+				PortId: PortID,
+				NamedOracles: []NamedOracle{
+					NewNamedOracle("price-feed", "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"),
+					NewNamedOracle("price-feed", "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"),
+				},
+			},
+			err: `duplicate named oracle "price-feed"`,
+		},
 	}
 
 	for _, tc := range tests {
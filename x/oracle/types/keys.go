@@ -1,6 +1,10 @@
 package types
 
-import icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+import (
+	"encoding/binary"
+
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -31,11 +35,32 @@ const (
 //	PortStoreKey
 //	- 0x02: string
 //	  | 1 |
+//
+//
+//	QueryResultKey
+//	- 0x03<channel_length><channel><sequence>: OracleQueryResult
+//	  | 1 |       1        |    N    |    8    |
+//
+//
+//	QueryResultExpirationKey
+//	- 0x04<prune_height><channel_length><channel><sequence>: []byte{}
+//	  | 1 |      8       |       1        |    N    |    8    |
+//
+//
+//	NamedOracleKey
+//	- 0x05<name>: sdk.AccAddress
+//	  | 1 |  N   |
 var (
 	// OracleStoreKey is the key for the module's oracle address
 	OracleStoreKey = []byte{0x01}
 	// PortStoreKey defines the key to store the port ID in store
 	PortStoreKey = []byte{0x02}
+	// QueryResultKeyPrefix is the prefix of the keys used to store oracle query results
+	QueryResultKeyPrefix = []byte{0x03}
+	// QueryResultExpirationKeyPrefix is the prefix of the keys used to track when oracle query results should be pruned
+	QueryResultExpirationKeyPrefix = []byte{0x04}
+	// NamedOracleKeyPrefix is the prefix of the keys used to store the addresses of named oracles
+	NamedOracleKeyPrefix = []byte{0x05}
 )
 
 // GetOracleStoreKey is a function to get the key for the oracle's address in store
@@ -47,3 +72,53 @@ func GetOracleStoreKey() []byte {
 func GetPortStoreKey() []byte {
 	return PortStoreKey
 }
+
+// GetNamedOracleKey is a function to get the key for a named oracle's address in store
+func GetNamedOracleKey(name string) []byte {
+	key := make([]byte, 0, len(NamedOracleKeyPrefix)+len(name))
+	key = append(key, NamedOracleKeyPrefix...)
+	return append(key, name...)
+}
+
+// GetQueryResultKey is a function to get the key for an oracle query result in store
+func GetQueryResultKey(channel string, sequence uint64) []byte {
+	key := make([]byte, 0, len(QueryResultKeyPrefix)+1+len(channel)+8)
+	key = append(key, QueryResultKeyPrefix...)
+	return append(key, channelSequenceBytes(channel, sequence)...)
+}
+
+// GetQueryResultExpirationKey is a function to get the key used to prune an oracle query result at a block height
+func GetQueryResultExpirationKey(pruneHeight int64, channel string, sequence uint64) []byte {
+	key := GetQueryResultExpirationPrefix(pruneHeight)
+	return append(key, channelSequenceBytes(channel, sequence)...)
+}
+
+// GetQueryResultExpirationPrefix is a function to get the prefix of the keys used to prune oracle query results at a block height
+func GetQueryResultExpirationPrefix(pruneHeight int64) []byte {
+	key := make([]byte, 0, len(QueryResultExpirationKeyPrefix)+8)
+	key = append(key, QueryResultExpirationKeyPrefix...)
+	return binary.BigEndian.AppendUint64(key, uint64(pruneHeight))
+}
+
+// ParseQueryResultExpirationKey is a function to get the channel and sequence from a query result expiration key
+func ParseQueryResultExpirationKey(key []byte) (channel string, sequence uint64, ok bool) {
+	prefixLen := len(QueryResultExpirationKeyPrefix) + 8
+	if len(key) < prefixLen+1 {
+		return "", 0, false
+	}
+	channelLen := int(key[prefixLen])
+	if len(key) != prefixLen+1+channelLen+8 {
+		return "", 0, false
+	}
+	channel = string(key[prefixLen+1 : prefixLen+1+channelLen])
+	sequence = binary.BigEndian.Uint64(key[prefixLen+1+channelLen:])
+	return channel, sequence, true
+}
+
+// channelSequenceBytes is a function to get the length prefixed channel followed by the sequence as bytes
+func channelSequenceBytes(channel string, sequence uint64) []byte {
+	rv := make([]byte, 0, 1+len(channel)+8)
+	rv = append(rv, byte(len(channel)))
+	rv = append(rv, channel...)
+	return binary.BigEndian.AppendUint64(rv, sequence)
+}
@@ -15,3 +15,30 @@ func TestGetPortStoreKey(t *testing.T) {
 	key := GetPortStoreKey()
 	assert.EqualValues(t, PortStoreKey, key[0:1], "must return correct port key")
 }
+
+func TestGetNamedOracleKey(t *testing.T) {
+	key := GetNamedOracleKey("feed")
+	assert.Equal(t, []byte{0x05, 'f', 'e', 'e', 'd'}, key, "must return correct named oracle key")
+}
+
+func TestGetQueryResultKey(t *testing.T) {
+	key := GetQueryResultKey("channel-1", 258)
+	expected := append([]byte{0x03, 9}, []byte("channel-1")...)
+	expected = append(expected, 0, 0, 0, 0, 0, 0, 1, 2)
+	assert.Equal(t, expected, key, "must return correct query result key")
+}
+
+func TestGetQueryResultExpirationKey(t *testing.T) {
+	key := GetQueryResultExpirationKey(260, "channel-1", 258)
+	prefix := GetQueryResultExpirationPrefix(260)
+	assert.Equal(t, []byte{0x04, 0, 0, 0, 0, 0, 0, 1, 4}, prefix, "must return correct query result expiration prefix")
+	assert.Equal(t, prefix, key[:len(prefix)], "key must start with the expiration prefix")
+
+	channel, sequence, ok := ParseQueryResultExpirationKey(key)
+	assert.True(t, ok, "must parse the query result expiration key")
+	assert.Equal(t, "channel-1", channel, "must parse the channel")
+	assert.Equal(t, uint64(258), sequence, "must parse the sequence")
+
+	_, _, ok = ParseQueryResultExpirationKey(key[:len(key)-1])
+	assert.False(t, ok, "must not parse a truncated query result expiration key")
+}
@@ -11,6 +11,7 @@ import (
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgUpdateOracleRequest)(nil),
 	(*MsgSendQueryOracleRequest)(nil),
+	(*MsgRemoveOracleRequest)(nil),
 }
 
 // NewMsgSendQueryOracle creates a new MsgSendQueryOracleRequest
@@ -33,6 +34,19 @@ func (msg MsgSendQueryOracleRequest) ValidateBasic() error {
 	if err := msg.Query.ValidateBasic(); err != nil {
 		return fmt.Errorf("invalid query data: %w", err)
 	}
+	if len(msg.CallbackAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.CallbackAddress); err != nil {
+			return fmt.Errorf("invalid callback address: %w", err)
+		}
+		if msg.CallbackAddress != msg.Authority {
+			return fmt.Errorf("callback address %s must be the authority %s", msg.CallbackAddress, msg.Authority)
+		}
+	}
+	if len(msg.OracleName) > 0 {
+		if err := ValidateOracleName(msg.OracleName); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -52,5 +66,29 @@ func (msg MsgUpdateOracleRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
 		return fmt.Errorf("invalid authority address: %w", err)
 	}
+	if len(msg.Name) > 0 {
+		if err := ValidateOracleName(msg.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewMsgRemoveOracle creates a new MsgRemoveOracleRequest
+func NewMsgRemoveOracle(creator, name string) *MsgRemoveOracleRequest {
+	return &MsgRemoveOracleRequest{
+		Authority: creator,
+		Name:      name,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRemoveOracleRequest) ValidateBasic() error {
+	if err := ValidateOracleName(msg.Name); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
 	return nil
 }
@@ -16,6 +16,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 	msgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgUpdateOracleRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSendQueryOracleRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveOracleRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -61,6 +62,23 @@ func TestMsgUpdateOracleRequestValidateBasic(t *testing.T) {
 			msg:  NewMsgUpdateOracle("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "jackthecat"),
 			err:  "invalid address for oracle: decoding bech32 failed: invalid separator index -1",
 		},
+		{
+			name: "success - valid name",
+			msg: &MsgUpdateOracleRequest{
+				Authority: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Address:   "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Name:      "price-feed",
+			},
+		},
+		{
+			name: "failure - invalid name",
+			msg: &MsgUpdateOracleRequest{
+				Authority: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Address:   "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Name:      "Price Feed",
+			},
+			err: `oracle name "Price Feed" must start with a lowercase letter or number and only contain lowercase letters, numbers, '.', '_', or '-'`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -100,6 +118,54 @@ func TestMsgSendQueryOracleRequestValidateBasic(t *testing.T) {
 			msg:  NewMsgSendQueryOracle("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "channel-1", []byte{}),
 			err:  "invalid query data: invalid",
 		},
+		{
+			name: "success - callback is the authority",
+			msg: &MsgSendQueryOracleRequest{
+				Authority:       "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Channel:         "channel-1",
+				Query:           []byte("{}"),
+				CallbackAddress: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+			},
+		},
+		{
+			name: "failure - invalid callback address",
+			msg: &MsgSendQueryOracleRequest{
+				Authority:       "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Channel:         "channel-1",
+				Query:           []byte("{}"),
+				CallbackAddress: "jackthecat",
+			},
+			err: "invalid callback address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "failure - callback is not the authority",
+			msg: &MsgSendQueryOracleRequest{
+				Authority:       "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Channel:         "channel-1",
+				Query:           []byte("{}"),
+				CallbackAddress: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+			},
+			err: "callback address cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du must be the authority cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+		},
+		{
+			name: "success - valid oracle name",
+			msg: &MsgSendQueryOracleRequest{
+				Authority:  "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Channel:    "channel-1",
+				Query:      []byte("{}"),
+				OracleName: "price-feed",
+			},
+		},
+		{
+			name: "failure - invalid oracle name",
+			msg: &MsgSendQueryOracleRequest{
+				Authority:  "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+				Channel:    "channel-1",
+				Query:      []byte("{}"),
+				OracleName: "-feed",
+			},
+			err: `oracle name "-feed" must start with a lowercase letter or number and only contain lowercase letters, numbers, '.', '_', or '-'`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -113,3 +179,46 @@ func TestMsgSendQueryOracleRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMsgRemoveOracle(t *testing.T) {
+	authority := "creator"
+	name := "price-feed"
+
+	msg := NewMsgRemoveOracle(authority, name)
+	assert.Equal(t, authority, msg.Authority, "must have the correct authority")
+	assert.Equal(t, name, msg.Name, "must have the correct name")
+}
+
+func TestMsgRemoveOracleRequestValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgRemoveOracleRequest
+		err  string
+	}{
+		{
+			name: "success - all fields are valid",
+			msg:  NewMsgRemoveOracle("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "price-feed"),
+		},
+		{
+			name: "failure - invalid authority",
+			msg:  NewMsgRemoveOracle("jackthecat", "price-feed"),
+			err:  "invalid authority address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "failure - empty name",
+			msg:  NewMsgRemoveOracle("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", ""),
+			err:  "oracle name cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "MsgRemoveOracleRequest.ValidateBasic")
+			} else {
+				assert.NoError(t, res, "MsgRemoveOracleRequest.ValidateBasic")
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// QueryResultRetentionBlocks is the number of blocks that a completed oracle query result is kept for.
+	QueryResultRetentionBlocks int64 = 100_800
+	// CallbackGasLimit is the maximum amount of gas that an oracle query's callback contract can use.
+	CallbackGasLimit uint64 = 1_000_000
+	// MaxOracleNameLength is the maximum length of an oracle's name.
+	MaxOracleNameLength = 64
+)
+
+// oracleNameRegex is the format that an oracle's name must have.
+var oracleNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// ValidateOracleName checks that the provided name can be used to register an oracle.
+func ValidateOracleName(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("oracle name cannot be empty")
+	}
+	if len(name) > MaxOracleNameLength {
+		return fmt.Errorf("oracle name %q exceeds max length %d", name, MaxOracleNameLength)
+	}
+	if !oracleNameRegex.MatchString(name) {
+		return fmt.Errorf("oracle name %q must start with a lowercase letter or number and only contain lowercase letters, numbers, '.', '_', or '-'", name)
+	}
+	return nil
+}
+
+// Validate checks that the named oracle is valid.
+func (o NamedOracle) Validate() error {
+	if err := ValidateOracleName(o.Name); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Address); err != nil {
+		return fmt.Errorf("invalid address for oracle %q: %w", o.Name, err)
+	}
+	return nil
+}
+
+// NewPendingOracleQueryResult creates a new OracleQueryResult for a query that has just been sent.
+func NewPendingOracleQueryResult(channel string, sequence uint64, query wasmtypes.RawContractMessage, height int64, callbackAddress string) OracleQueryResult {
+	return OracleQueryResult{
+		Sequence:        sequence,
+		Channel:         channel,
+		Query:           query,
+		Status:          QueryStatus_QUERY_STATUS_PENDING,
+		RequestHeight:   height,
+		CallbackAddress: callbackAddress,
+	}
+}
+
+// NewNamedOracle creates a new NamedOracle.
+func NewNamedOracle(name, address string) NamedOracle {
+	return NamedOracle{
+		Name:    name,
+		Address: address,
+	}
+}
+
+// Validate checks that the oracle query result is valid.
+func (r OracleQueryResult) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.Channel); err != nil {
+		return fmt.Errorf("invalid channel id %q: %s", r.Channel, err.Error())
+	}
+	if r.Sequence == 0 {
+		return fmt.Errorf("invalid sequence: cannot be zero")
+	}
+	if _, known := QueryStatus_name[int32(r.Status)]; !known || r.Status == QueryStatus_QUERY_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid status %s", r.Status)
+	}
+	if len(r.CallbackAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(r.CallbackAddress); err != nil {
+			return fmt.Errorf("invalid callback address: %w", err)
+		}
+	}
+	if len(r.OracleName) > 0 {
+		if err := ValidateOracleName(r.OracleName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsDone returns true if a response or timeout has been received for the query.
+func (r OracleQueryResult) IsDone() bool {
+	return r.Status != QueryStatus_QUERY_STATUS_PENDING && r.Status != QueryStatus_QUERY_STATUS_UNSPECIFIED
+}
+
+// GetPruneHeight returns the block height at which this result should be pruned.
+func (r OracleQueryResult) GetPruneHeight() int64 {
+	return r.ResponseHeight + QueryResultRetentionBlocks
+}
+
+// SimpleString returns the lower-case name of the status without its prefix, e.g. "success".
+func (s QueryStatus) SimpleString() string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "QUERY_STATUS_"))
+}
+
+// OracleQueryCallback is the sudo message sent to the callback contract of an oracle query.
+type OracleQueryCallback struct {
+	OracleQueryResult OracleQueryCallbackResult `json:"oracle_query_result"`
+}
+
+// OracleQueryCallbackResult is the result of an oracle query that is provided to its callback contract.
+type OracleQueryCallbackResult struct {
+	Channel  string                       `json:"channel"`
+	Sequence uint64                       `json:"sequence"`
+	Status   string                       `json:"status"`
+	Result   wasmtypes.RawContractMessage `json:"result,omitempty"`
+	Error    string                       `json:"error,omitempty"`
+}
+
+// NewOracleQueryCallback creates the sudo message for the callback contract of an oracle query.
+func NewOracleQueryCallback(result OracleQueryResult) OracleQueryCallback {
+	return OracleQueryCallback{
+		OracleQueryResult: OracleQueryCallbackResult{
+			Channel:  result.Channel,
+			Sequence: result.Sequence,
+			Status:   result.Status.SimpleString(),
+			Result:   result.Result,
+			Error:    result.Error,
+		},
+	}
+}
@@ -0,0 +1,1097 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/oracle/v1/oracle.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_CosmWasm_wasmd_x_wasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryStatus defines the status of an oracle query.
+type QueryStatus int32
+
+const (
+	// QUERY_STATUS_UNSPECIFIED is an invalid status.
+	QueryStatus_QUERY_STATUS_UNSPECIFIED QueryStatus = 0
+	// QUERY_STATUS_PENDING means the query has been sent but no response has been received.
+	QueryStatus_QUERY_STATUS_PENDING QueryStatus = 1
+	// QUERY_STATUS_SUCCESS means a successful response was received.
+	QueryStatus_QUERY_STATUS_SUCCESS QueryStatus = 2
+	// QUERY_STATUS_ERROR means an error response was received.
+	QueryStatus_QUERY_STATUS_ERROR QueryStatus = 3
+	// QUERY_STATUS_TIMEOUT means the query timed out.
+	QueryStatus_QUERY_STATUS_TIMEOUT QueryStatus = 4
+)
+
+var QueryStatus_name = map[int32]string{
+	0: "QUERY_STATUS_UNSPECIFIED",
+	1: "QUERY_STATUS_PENDING",
+	2: "QUERY_STATUS_SUCCESS",
+	3: "QUERY_STATUS_ERROR",
+	4: "QUERY_STATUS_TIMEOUT",
+}
+
+var QueryStatus_value = map[string]int32{
+	"QUERY_STATUS_UNSPECIFIED": 0,
+	"QUERY_STATUS_PENDING":     1,
+	"QUERY_STATUS_SUCCESS":     2,
+	"QUERY_STATUS_ERROR":       3,
+	"QUERY_STATUS_TIMEOUT":     4,
+}
+
+func (x QueryStatus) String() string {
+	return proto.EnumName(QueryStatus_name, int32(x))
+}
+
+func (QueryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e3dbe534e42aac9f, []int{0}
+}
+
+// OracleQueryResult is the record of an oracle query sent by this chain and its result.
+type OracleQueryResult struct {
+	// sequence is the packet sequence that uniquely identifies the query on its channel.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// channel is the local channel that the query was sent on.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// query contains the query data passed to the oracle.
+	Query github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,3,opt,name=query,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"query,omitempty"`
+	// status is the current status of the query.
+	Status QueryStatus `protobuf:"varint,4,opt,name=status,proto3,enum=provenance.oracle.v1.QueryStatus" json:"status,omitempty"`
+	// result contains the json data returned from the oracle when the query is successful.
+	Result github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,5,opt,name=result,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"result,omitempty"`
+	// error is the error message received when the query fails.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// request_height is the block height that the query was sent at.
+	RequestHeight int64 `protobuf:"varint,7,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
+	// response_height is the block height that the acknowledgement or timeout was received at.
+	ResponseHeight int64 `protobuf:"varint,8,opt,name=response_height,json=responseHeight,proto3" json:"response_height,omitempty"`
+	// callback_address is the optional address of a contract to sudo-call with the result.
+	CallbackAddress string `protobuf:"bytes,9,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+	// oracle_name is the name of the oracle on the other chain that was queried. It is empty for the default oracle.
+	OracleName string `protobuf:"bytes,10,opt,name=oracle_name,json=oracleName,proto3" json:"oracle_name,omitempty"`
+}
+
+func (m *OracleQueryResult) Reset()         { *m = OracleQueryResult{} }
+func (m *OracleQueryResult) String() string { return proto.CompactTextString(m) }
+func (*OracleQueryResult) ProtoMessage()    {}
+func (*OracleQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3dbe534e42aac9f, []int{0}
+}
+func (m *OracleQueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleQueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleQueryResult.Merge(m, src)
+}
+func (m *OracleQueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleQueryResult proto.InternalMessageInfo
+
+func (m *OracleQueryResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *OracleQueryResult) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *OracleQueryResult) GetQuery() github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *OracleQueryResult) GetStatus() QueryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return QueryStatus_QUERY_STATUS_UNSPECIFIED
+}
+
+func (m *OracleQueryResult) GetResult() github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *OracleQueryResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *OracleQueryResult) GetRequestHeight() int64 {
+	if m != nil {
+		return m.RequestHeight
+	}
+	return 0
+}
+
+func (m *OracleQueryResult) GetResponseHeight() int64 {
+	if m != nil {
+		return m.ResponseHeight
+	}
+	return 0
+}
+
+func (m *OracleQueryResult) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
+	}
+	return ""
+}
+
+func (m *OracleQueryResult) GetOracleName() string {
+	if m != nil {
+		return m.OracleName
+	}
+	return ""
+}
+
+// NamedOracle is an oracle contract registered under a name.
+type NamedOracle struct {
+	// name is the unique name that the oracle is registered under.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address of the oracle's contract.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *NamedOracle) Reset()         { *m = NamedOracle{} }
+func (m *NamedOracle) String() string { return proto.CompactTextString(m) }
+func (*NamedOracle) ProtoMessage()    {}
+func (*NamedOracle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3dbe534e42aac9f, []int{1}
+}
+func (m *NamedOracle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamedOracle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamedOracle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedOracle.Merge(m, src)
+}
+func (m *NamedOracle) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedOracle) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedOracle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedOracle proto.InternalMessageInfo
+
+func (m *NamedOracle) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamedOracle) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("provenance.oracle.v1.QueryStatus", QueryStatus_name, QueryStatus_value)
+	proto.RegisterType((*OracleQueryResult)(nil), "provenance.oracle.v1.OracleQueryResult")
+	proto.RegisterType((*NamedOracle)(nil), "provenance.oracle.v1.NamedOracle")
+}
+
+func init() { proto.RegisterFile("provenance/oracle/v1/oracle.proto", fileDescriptor_e3dbe534e42aac9f) }
+
+var fileDescriptor_e3dbe534e42aac9f = []byte{
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6f, 0xd3, 0x3c,
+	0x18, 0xc7, 0xeb, 0x2d, 0xeb, 0x36, 0xef, 0x7d, 0xb7, 0x60, 0x55, 0x60, 0x26, 0x94, 0x76, 0x93,
+	0x10, 0x15, 0xd2, 0x12, 0xad, 0x9c, 0x86, 0xc4, 0x61, 0xcd, 0x02, 0xf4, 0xb0, 0xb6, 0x73, 0x1a,
+	0x21, 0xe0, 0x10, 0xb9, 0xa9, 0x95, 0x56, 0x34, 0x71, 0x67, 0xa7, 0xdd, 0x76, 0xe5, 0x13, 0xc0,
+	0x37, 0xe0, 0x43, 0xf0, 0x21, 0x38, 0x4e, 0x9c, 0x38, 0x21, 0xd4, 0x5e, 0xf8, 0x0c, 0x9c, 0x50,
+	0x9d, 0x84, 0x75, 0xda, 0x24, 0x2e, 0x9c, 0xe2, 0xff, 0xf3, 0xff, 0x3d, 0x4f, 0x1e, 0xdb, 0x8f,
+	0xe1, 0xce, 0x48, 0xf0, 0x09, 0x8b, 0x69, 0x1c, 0x30, 0x8b, 0x0b, 0x1a, 0x0c, 0x99, 0x35, 0xd9,
+	0xcf, 0x56, 0xe6, 0x48, 0xf0, 0x84, 0xa3, 0xd2, 0x15, 0x62, 0x66, 0xc6, 0x64, 0x7f, 0xbb, 0x14,
+	0xf2, 0x90, 0x2b, 0xc0, 0x9a, 0xaf, 0x52, 0x76, 0xfb, 0x7e, 0xc0, 0x65, 0xc4, 0xa5, 0x9f, 0x1a,
+	0xa9, 0x48, 0xad, 0xdd, 0xf7, 0x1a, 0xbc, 0xd3, 0x52, 0xe9, 0x27, 0x63, 0x26, 0x2e, 0x08, 0x93,
+	0xe3, 0x61, 0x82, 0xb6, 0xe1, 0x9a, 0x64, 0xa7, 0x63, 0x16, 0x07, 0x0c, 0x83, 0x0a, 0xa8, 0x6a,
+	0xe4, 0x8f, 0x46, 0x18, 0xae, 0x06, 0x7d, 0x1a, 0xc7, 0x6c, 0x88, 0x97, 0x2a, 0xa0, 0xba, 0x4e,
+	0x72, 0x89, 0x5c, 0xb8, 0x72, 0x3a, 0x2f, 0x82, 0x97, 0x2b, 0xa0, 0xfa, 0x5f, 0xfd, 0xd9, 0xaf,
+	0xef, 0xe5, 0x83, 0x70, 0x90, 0xf4, 0xc7, 0x5d, 0x33, 0xe0, 0x91, 0x65, 0x73, 0x19, 0xbd, 0xa2,
+	0x32, 0xb2, 0xce, 0xa8, 0x8c, 0x7a, 0xd6, 0xb9, 0xfa, 0x5a, 0xc9, 0xc5, 0x88, 0x49, 0x93, 0xd0,
+	0x33, 0x9b, 0xc7, 0x89, 0xa0, 0x41, 0x72, 0xcc, 0xa4, 0xa4, 0x21, 0x23, 0x69, 0x2d, 0x74, 0x00,
+	0x8b, 0x32, 0xa1, 0xc9, 0x58, 0x62, 0xad, 0x02, 0xaa, 0x9b, 0xb5, 0x1d, 0xf3, 0xb6, 0x8d, 0x9b,
+	0xaa, 0x7b, 0x57, 0x81, 0x24, 0x4b, 0x40, 0x1e, 0x2c, 0x0a, 0xb5, 0x1f, 0xbc, 0xf2, 0x2f, 0x1a,
+	0xca, 0x8a, 0xa1, 0x12, 0x5c, 0x61, 0x42, 0x70, 0x81, 0x8b, 0x6a, 0xfb, 0xa9, 0x40, 0x0f, 0xe1,
+	0xa6, 0x98, 0x1f, 0x91, 0x4c, 0xfc, 0x3e, 0x1b, 0x84, 0xfd, 0x04, 0xaf, 0x56, 0x40, 0x75, 0x99,
+	0xfc, 0x9f, 0x45, 0x5f, 0xaa, 0x20, 0x7a, 0x04, 0xb7, 0x04, 0x93, 0x23, 0x1e, 0x4b, 0x96, 0x73,
+	0x6b, 0x8a, 0xdb, 0xcc, 0xc3, 0x19, 0x68, 0x43, 0x3d, 0xa0, 0xc3, 0x61, 0x97, 0x06, 0xef, 0x7c,
+	0xda, 0xeb, 0x09, 0x26, 0x25, 0x5e, 0x9f, 0xff, 0xb0, 0x8e, 0xbf, 0x7e, 0xde, 0x2b, 0x65, 0x97,
+	0x78, 0x98, 0x3a, 0x6e, 0x22, 0x06, 0x71, 0x48, 0xb6, 0xf2, 0x8c, 0x2c, 0x8c, 0xca, 0x70, 0x23,
+	0x3d, 0x22, 0x3f, 0xa6, 0x11, 0xc3, 0x50, 0x35, 0x0c, 0xd3, 0x50, 0x93, 0x46, 0xec, 0xa9, 0xf6,
+	0xf3, 0x53, 0x19, 0xec, 0xbe, 0x85, 0x1b, 0x73, 0xd5, 0x4b, 0x07, 0x01, 0x21, 0xa8, 0x29, 0x1c,
+	0x28, 0x5c, 0xad, 0x51, 0x0d, 0xae, 0xe6, 0x5d, 0x2c, 0xfd, 0xa5, 0x8b, 0x1c, 0x4c, 0x8b, 0x3f,
+	0xfe, 0x08, 0xe0, 0xc6, 0xc2, 0xed, 0xa0, 0x07, 0x10, 0x9f, 0x78, 0x0e, 0x79, 0xed, 0xbb, 0x9d,
+	0xc3, 0x8e, 0xe7, 0xfa, 0x5e, 0xd3, 0x6d, 0x3b, 0x76, 0xe3, 0x79, 0xc3, 0x39, 0xd2, 0x0b, 0x08,
+	0xc3, 0xd2, 0x35, 0xb7, 0xed, 0x34, 0x8f, 0x1a, 0xcd, 0x17, 0x3a, 0xb8, 0xe1, 0xb8, 0x9e, 0x6d,
+	0x3b, 0xae, 0xab, 0x2f, 0xa1, 0xbb, 0x10, 0x5d, 0x73, 0x1c, 0x42, 0x5a, 0x44, 0x5f, 0xbe, 0x91,
+	0xd1, 0x69, 0x1c, 0x3b, 0x2d, 0xaf, 0xa3, 0x6b, 0xf5, 0xf0, 0xcb, 0xd4, 0x00, 0x97, 0x53, 0x03,
+	0xfc, 0x98, 0x1a, 0xe0, 0xc3, 0xcc, 0x28, 0x5c, 0xce, 0x8c, 0xc2, 0xb7, 0x99, 0x51, 0x80, 0xf7,
+	0x06, 0xfc, 0xd6, 0x01, 0x6b, 0x83, 0x37, 0xb5, 0x85, 0xd1, 0xb9, 0x42, 0xf6, 0x06, 0x7c, 0x41,
+	0x59, 0xe7, 0xf9, 0x7b, 0x55, 0x63, 0xd4, 0x2d, 0xaa, 0x57, 0xf6, 0xe4, 0xf7, 0x00, 0x30, 0xe4,
+	0x1d, 0x87, 0xd1, 0x03, 0x00, 0x00,
+}
+
+func (this *OracleQueryResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleQueryResult)
+	if !ok {
+		that2, ok := that.(OracleQueryResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.Channel != that1.Channel {
+		return false
+	}
+	if !bytes.Equal(this.Query, that1.Query) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !bytes.Equal(this.Result, that1.Result) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.RequestHeight != that1.RequestHeight {
+		return false
+	}
+	if this.ResponseHeight != that1.ResponseHeight {
+		return false
+	}
+	if this.CallbackAddress != that1.CallbackAddress {
+		return false
+	}
+	if this.OracleName != that1.OracleName {
+		return false
+	}
+	return true
+}
+func (this *NamedOracle) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NamedOracle)
+	if !ok {
+		that2, ok := that.(NamedOracle)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (m *OracleQueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleQueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleName) > 0 {
+		i -= len(m.OracleName)
+		copy(dAtA[i:], m.OracleName)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OracleName)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ResponseHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResponseHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RequestHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamedOracle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedOracle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OracleQueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovOracle(uint64(m.Sequence))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovOracle(uint64(m.Status))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RequestHeight != 0 {
+		n += 1 + sovOracle(uint64(m.RequestHeight))
+	}
+	if m.ResponseHeight != 0 {
+		n += 1 + sovOracle(uint64(m.ResponseHeight))
+	}
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OracleName)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *NamedOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OracleQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= QueryStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeight", wireType)
+			}
+			m.ResponseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamedOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOracle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOracle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOracle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOracle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOracle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOracle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOracle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOracleQueryResultValidate(t *testing.T) {
+	valid := func() OracleQueryResult {
+		return NewPendingOracleQueryResult("channel-1", 1, []byte("{}"), 1, "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma")
+	}
+	tests := []struct {
+		name   string
+		modify func(r *OracleQueryResult)
+		err    string
+	}{
+		{
+			name:   "success - all fields are valid",
+			modify: func(r *OracleQueryResult) {},
+		},
+		{
+			name:   "failure - invalid channel",
+			modify: func(r *OracleQueryResult) { r.Channel = "x" },
+			err:    "invalid channel id \"x\": identifier x has invalid length: 1, must be between 8-64 characters: invalid identifier",
+		},
+		{
+			name:   "failure - zero sequence",
+			modify: func(r *OracleQueryResult) { r.Sequence = 0 },
+			err:    "invalid sequence: cannot be zero",
+		},
+		{
+			name:   "failure - unspecified status",
+			modify: func(r *OracleQueryResult) { r.Status = QueryStatus_QUERY_STATUS_UNSPECIFIED },
+			err:    "invalid status QUERY_STATUS_UNSPECIFIED",
+		},
+		{
+			name:   "failure - unknown status",
+			modify: func(r *OracleQueryResult) { r.Status = 99 },
+			err:    "invalid status 99",
+		},
+		{
+			name:   "failure - invalid callback address",
+			modify: func(r *OracleQueryResult) { r.CallbackAddress = "abc" },
+			err:    "invalid callback address: decoding bech32 failed: invalid bech32 string length 3",
+		}, {
+			name:   "failure - invalid oracle name",
+			modify: func(r *OracleQueryResult) { r.OracleName = "Feed" },
+			err:    `oracle name "Feed" must start with a lowercase letter or number and only contain lowercase letters, numbers, '.', '_', or '-'`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := valid()
+			tc.modify(&result)
+			err := result.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "OracleQueryResult.Validate")
+			} else {
+				assert.NoError(t, err, "OracleQueryResult.Validate")
+			}
+		})
+	}
+}
+
+func TestValidateOracleName(t *testing.T) {
+	tests := []struct {
+		name       string
+		oracleName string
+		err        string
+	}{
+		{name: "simple", oracleName: "pricefeed"},
+		{name: "with separators", oracleName: "price-feed_v1.2"},
+		{name: "starts with number", oracleName: "1feed"},
+		{name: "max length", oracleName: strings.Repeat("a", MaxOracleNameLength)},
+		{
+			name: "empty",
+			err:  "oracle name cannot be empty",
+		},
+		{
+			name:       "too long",
+			oracleName: strings.Repeat("a", MaxOracleNameLength+1),
+			err:        fmt.Sprintf("oracle name %q exceeds max length 64", strings.Repeat("a", MaxOracleNameLength+1)),
+		},
+		{
+			name:       "upper case",
+			oracleName: "Feed",
+			err:        `oracle name "Feed" must start with a lowercase letter or number and only contain lowercase letters, numbers, '.', '_', or '-'`,
+		},
+		{
+			name:       "starts with separator",
+			oracleName: ".feed",
+			err:        `oracle name ".feed" must start with a lowercase letter or number and only contain lowercase letters, numbers, '.', '_', or '-'`,
+		},
+		{
+			name:       "contains slash",
+			oracleName: "price/feed",
+			err:        `oracle name "price/feed" must start with a lowercase letter or number and only contain lowercase letters, numbers, '.', '_', or '-'`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateOracleName(tc.oracleName)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateOracleName")
+			} else {
+				assert.NoError(t, err, "ValidateOracleName")
+			}
+		})
+	}
+}
+
+func TestNamedOracleValidate(t *testing.T) {
+	assert.NoError(t, NewNamedOracle("feed", "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma").Validate(), "valid named oracle")
+	assert.EqualError(t, NewNamedOracle("", "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma").Validate(), "oracle name cannot be empty", "named oracle without name")
+	assert.EqualError(t, NewNamedOracle("feed", "abc").Validate(), `invalid address for oracle "feed": decoding bech32 failed: invalid bech32 string length 3`, "named oracle with invalid address")
+}
+
+func TestOracleQueryResultIsDone(t *testing.T) {
+	tests := []struct {
+		status QueryStatus
+		done   bool
+	}{
+		{status: QueryStatus_QUERY_STATUS_UNSPECIFIED, done: false},
+		{status: QueryStatus_QUERY_STATUS_PENDING, done: false},
+		{status: QueryStatus_QUERY_STATUS_SUCCESS, done: true},
+		{status: QueryStatus_QUERY_STATUS_ERROR, done: true},
+		{status: QueryStatus_QUERY_STATUS_TIMEOUT, done: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.status.String(), func(t *testing.T) {
+			result := OracleQueryResult{Status: tc.status}
+			assert.Equal(t, tc.done, result.IsDone(), "OracleQueryResult.IsDone")
+		})
+	}
+}
+
+func TestNewOracleQueryCallback(t *testing.T) {
+	result := OracleQueryResult{
+		Sequence: 5,
+		Channel:  "channel-1",
+		Query:    []byte("{}"),
+		Status:   QueryStatus_QUERY_STATUS_SUCCESS,
+		Result:   []byte(`{"price":"1"}`),
+	}
+	bz, err := json.Marshal(NewOracleQueryCallback(result))
+	require.NoError(t, err, "json.Marshal success callback")
+	assert.Equal(t, `{"oracle_query_result":{"channel":"channel-1","sequence":5,"status":"success","result":{"price":"1"}}}`, string(bz), "success callback")
+
+	result.Status = QueryStatus_QUERY_STATUS_ERROR
+	result.Result = nil
+	result.Error = "failed"
+	bz, err = json.Marshal(NewOracleQueryCallback(result))
+	require.NoError(t, err, "json.Marshal error callback")
+	assert.Equal(t, `{"oracle_query_result":{"channel":"channel-1","sequence":5,"status":"error","error":"failed"}}`, string(bz), "error callback")
+}
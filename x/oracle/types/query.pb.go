@@ -33,6 +33,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // QueryOracleAddressRequest queries for the address of the oracle.
 type QueryOracleAddressRequest struct {
+	// The optional name of the oracle. If empty, the default oracle's address is returned.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *QueryOracleAddressRequest) Reset()         { *m = QueryOracleAddressRequest{} }
@@ -68,6 +70,13 @@ func (m *QueryOracleAddressRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryOracleAddressRequest proto.InternalMessageInfo
 
+func (m *QueryOracleAddressRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // QueryOracleAddressResponse contains the address of the oracle.
 type QueryOracleAddressResponse struct {
 	// The address of the oracle
@@ -118,6 +127,8 @@ func (m *QueryOracleAddressResponse) GetAddress() string {
 type QueryOracleRequest struct {
 	// Query contains the query data passed to the oracle.
 	Query github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,1,opt,name=query,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"query,omitempty"`
+	// The optional name of the oracle to query. If empty, the query is sent to the default oracle.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *QueryOracleRequest) Reset()         { *m = QueryOracleRequest{} }
@@ -160,6 +171,13 @@ func (m *QueryOracleRequest) GetQuery() github_com_CosmWasm_wasmd_x_wasm_types.R
 	return nil
 }
 
+func (m *QueryOracleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // QueryOracleResponse contains the result of the query sent to the oracle.
 type QueryOracleResponse struct {
 	// Data contains the json data returned from the oracle.
@@ -206,44 +224,244 @@ func (m *QueryOracleResponse) GetData() github_com_CosmWasm_wasmd_x_wasm_types.R
 	return nil
 }
 
+// QueryOraclesRequest queries for all the named oracles.
+type QueryOraclesRequest struct {
+}
+
+func (m *QueryOraclesRequest) Reset()         { *m = QueryOraclesRequest{} }
+func (m *QueryOraclesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOraclesRequest) ProtoMessage()    {}
+func (*QueryOraclesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_169907f611744c57, []int{4}
+}
+func (m *QueryOraclesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOraclesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOraclesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOraclesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOraclesRequest.Merge(m, src)
+}
+func (m *QueryOraclesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOraclesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOraclesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOraclesRequest proto.InternalMessageInfo
+
+// QueryOraclesResponse contains all the named oracles.
+type QueryOraclesResponse struct {
+	// The registered named oracles.
+	Oracles []NamedOracle `protobuf:"bytes,1,rep,name=oracles,proto3" json:"oracles"`
+}
+
+func (m *QueryOraclesResponse) Reset()         { *m = QueryOraclesResponse{} }
+func (m *QueryOraclesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOraclesResponse) ProtoMessage()    {}
+func (*QueryOraclesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_169907f611744c57, []int{5}
+}
+func (m *QueryOraclesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOraclesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOraclesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOraclesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOraclesResponse.Merge(m, src)
+}
+func (m *QueryOraclesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOraclesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOraclesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOraclesResponse proto.InternalMessageInfo
+
+func (m *QueryOraclesResponse) GetOracles() []NamedOracle {
+	if m != nil {
+		return m.Oracles
+	}
+	return nil
+}
+
+// QueryOracleQueryResultRequest queries for the result of an oracle query.
+type QueryOracleQueryResultRequest struct {
+	// The local channel that the query was sent on.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// The sequence number that uniquely identifies the query on its channel.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryOracleQueryResultRequest) Reset()         { *m = QueryOracleQueryResultRequest{} }
+func (m *QueryOracleQueryResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleQueryResultRequest) ProtoMessage()    {}
+func (*QueryOracleQueryResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_169907f611744c57, []int{6}
+}
+func (m *QueryOracleQueryResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleQueryResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleQueryResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleQueryResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleQueryResultRequest.Merge(m, src)
+}
+func (m *QueryOracleQueryResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleQueryResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleQueryResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleQueryResultRequest proto.InternalMessageInfo
+
+func (m *QueryOracleQueryResultRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *QueryOracleQueryResultRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryOracleQueryResultResponse contains the result of an oracle query.
+type QueryOracleQueryResultResponse struct {
+	// The oracle query and its result.
+	Result OracleQueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+}
+
+func (m *QueryOracleQueryResultResponse) Reset()         { *m = QueryOracleQueryResultResponse{} }
+func (m *QueryOracleQueryResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleQueryResultResponse) ProtoMessage()    {}
+func (*QueryOracleQueryResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_169907f611744c57, []int{7}
+}
+func (m *QueryOracleQueryResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleQueryResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleQueryResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleQueryResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleQueryResultResponse.Merge(m, src)
+}
+func (m *QueryOracleQueryResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleQueryResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleQueryResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleQueryResultResponse proto.InternalMessageInfo
+
+func (m *QueryOracleQueryResultResponse) GetResult() OracleQueryResult {
+	if m != nil {
+		return m.Result
+	}
+	return OracleQueryResult{}
+}
+
 func init() {
 	proto.RegisterType((*QueryOracleAddressRequest)(nil), "provenance.oracle.v1.QueryOracleAddressRequest")
 	proto.RegisterType((*QueryOracleAddressResponse)(nil), "provenance.oracle.v1.QueryOracleAddressResponse")
 	proto.RegisterType((*QueryOracleRequest)(nil), "provenance.oracle.v1.QueryOracleRequest")
 	proto.RegisterType((*QueryOracleResponse)(nil), "provenance.oracle.v1.QueryOracleResponse")
+	proto.RegisterType((*QueryOraclesRequest)(nil), "provenance.oracle.v1.QueryOraclesRequest")
+	proto.RegisterType((*QueryOraclesResponse)(nil), "provenance.oracle.v1.QueryOraclesResponse")
+	proto.RegisterType((*QueryOracleQueryResultRequest)(nil), "provenance.oracle.v1.QueryOracleQueryResultRequest")
+	proto.RegisterType((*QueryOracleQueryResultResponse)(nil), "provenance.oracle.v1.QueryOracleQueryResultResponse")
 }
 
 func init() { proto.RegisterFile("provenance/oracle/v1/query.proto", fileDescriptor_169907f611744c57) }
 
 var fileDescriptor_169907f611744c57 = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0xae, 0xd3, 0x30,
-	0x14, 0xc6, 0xeb, 0x2b, 0xee, 0x45, 0x58, 0xb0, 0x98, 0x4a, 0xdc, 0x1b, 0xaa, 0x50, 0x45, 0x15,
-	0x2a, 0x12, 0x8d, 0x69, 0x99, 0x18, 0x18, 0x68, 0x67, 0x44, 0x9b, 0x0e, 0x48, 0x2c, 0x95, 0x9b,
-	0x58, 0x6e, 0xa4, 0xc6, 0x27, 0x8d, 0xdd, 0x7f, 0x2b, 0xbc, 0x00, 0x12, 0x2f, 0xc0, 0x23, 0x30,
-	0xf0, 0x10, 0x8c, 0x15, 0x2c, 0x4c, 0x08, 0xb5, 0x3c, 0x05, 0x13, 0xaa, 0x9d, 0xaa, 0xad, 0x14,
-	0xa0, 0xc3, 0x9d, 0xe2, 0xf8, 0x7c, 0xfe, 0x7e, 0x9f, 0x7d, 0x0e, 0xae, 0xa6, 0x19, 0xcc, 0xb8,
-	0x64, 0x32, 0xe4, 0x14, 0x32, 0x16, 0x8e, 0x39, 0x9d, 0x35, 0xe9, 0x64, 0xca, 0xb3, 0xa5, 0x9f,
-	0x66, 0xa0, 0x81, 0x94, 0xf7, 0x0a, 0xdf, 0x2a, 0xfc, 0x59, 0xd3, 0x29, 0x0b, 0x10, 0x60, 0x04,
-	0x74, 0xbb, 0xb2, 0x5a, 0xa7, 0x22, 0x00, 0xc4, 0x98, 0x53, 0x96, 0xc6, 0x94, 0x49, 0x09, 0x9a,
-	0xe9, 0x18, 0xa4, 0xca, 0xab, 0x57, 0x21, 0xa8, 0x04, 0xd4, 0xc0, 0x1e, 0xb3, 0x3f, 0xb6, 0xe4,
-	0xdd, 0xc7, 0x57, 0xbd, 0x2d, 0xf3, 0x95, 0x01, 0xbc, 0x88, 0xa2, 0x8c, 0x2b, 0x15, 0xf0, 0xc9,
-	0x94, 0x2b, 0xed, 0x75, 0xb1, 0x53, 0x54, 0x54, 0x29, 0x48, 0xc5, 0x49, 0x0b, 0xdf, 0x64, 0x76,
-	0xeb, 0x12, 0x55, 0x51, 0xfd, 0x56, 0xfb, 0xf2, 0xeb, 0xe7, 0x46, 0x39, 0x77, 0xcf, 0xc5, 0x7d,
-	0x9d, 0xc5, 0x52, 0x04, 0x3b, 0xa1, 0x17, 0x63, 0x72, 0xe0, 0x98, 0x73, 0x48, 0x1f, 0x9f, 0x9b,
-	0x8b, 0x1b, 0x9f, 0xdb, 0xed, 0xe7, 0xbf, 0x7f, 0x3c, 0x78, 0x26, 0x62, 0x3d, 0x9a, 0x0e, 0xfd,
-	0x10, 0x12, 0xda, 0x01, 0x95, 0xbc, 0x66, 0x2a, 0xa1, 0x73, 0xa6, 0x92, 0x88, 0x2e, 0xcc, 0x97,
-	0xea, 0x65, 0xca, 0x95, 0x1f, 0xb0, 0x79, 0x07, 0xa4, 0xce, 0x58, 0xa8, 0x5f, 0x72, 0xa5, 0x98,
-	0xe0, 0x81, 0xf5, 0xf2, 0x46, 0xf8, 0xee, 0x11, 0x2a, 0x4f, 0xdd, 0xc3, 0x37, 0x22, 0xa6, 0xd9,
-	0xf5, 0xa0, 0x8c, 0x55, 0xeb, 0xd3, 0x19, 0x3e, 0x37, 0x28, 0xf2, 0x11, 0xe1, 0x3b, 0x47, 0x8f,
-	0x45, 0xa8, 0x5f, 0xd4, 0x45, 0xff, 0xaf, 0x6f, 0xee, 0x3c, 0x39, 0xfd, 0x80, 0xbd, 0x91, 0xf7,
-	0xf8, 0xed, 0xb7, 0x5f, 0x1f, 0xce, 0x1e, 0x92, 0x1a, 0x2d, 0x1c, 0x29, 0xbb, 0x1a, 0xe4, 0x1d,
-	0x20, 0xef, 0x10, 0xbe, 0xb0, 0x3e, 0xa4, 0xfe, 0x5f, 0xd4, 0x2e, 0xd4, 0xa3, 0x13, 0x94, 0x79,
-	0x9a, 0x9a, 0x49, 0xe3, 0x92, 0xca, 0xbf, 0xd2, 0xb4, 0xc5, 0x97, 0xb5, 0x8b, 0x56, 0x6b, 0x17,
-	0xfd, 0x5c, 0xbb, 0xe8, 0xfd, 0xc6, 0x2d, 0xad, 0x36, 0x6e, 0xe9, 0xfb, 0xc6, 0x2d, 0xe1, 0x7b,
-	0x31, 0x14, 0xc2, 0xba, 0xe8, 0x4d, 0xeb, 0xa0, 0x51, 0x7b, 0x49, 0x23, 0x86, 0x43, 0xd4, 0x62,
-	0x07, 0x33, 0x4d, 0x1b, 0x5e, 0x98, 0x31, 0x7f, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x78,
-	0xa0, 0x70, 0x6f, 0x03, 0x00, 0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0x73, 0x25, 0x6d, 0xc0, 0x85, 0x01, 0x13, 0x44, 0x7a, 0x6a, 0x2f, 0xed, 0xa9, 0x40,
+	0x40, 0xf4, 0x4c, 0x53, 0x24, 0xd4, 0x01, 0xa1, 0xa6, 0x62, 0x04, 0xda, 0xab, 0x10, 0x82, 0x25,
+	0x72, 0x2f, 0xd6, 0xe5, 0xa4, 0x9c, 0x9d, 0x9e, 0x9d, 0xb4, 0x55, 0x95, 0x05, 0x06, 0x56, 0x24,
+	0xfe, 0x01, 0xfe, 0x08, 0x76, 0xd6, 0x8e, 0x15, 0x2c, 0x4c, 0x15, 0x4a, 0xf8, 0x2b, 0x10, 0x03,
+	0x8a, 0xed, 0x4b, 0x13, 0xe5, 0xf2, 0x03, 0x89, 0x29, 0x67, 0xfb, 0xfb, 0xbe, 0xef, 0xf3, 0x9e,
+	0x5f, 0x0c, 0x96, 0xeb, 0x11, 0x6b, 0x12, 0x8a, 0xa9, 0x47, 0x10, 0x8b, 0xb0, 0x57, 0x23, 0xa8,
+	0xb9, 0x8e, 0x0e, 0x1a, 0x24, 0x3a, 0x76, 0xea, 0x11, 0x13, 0x0c, 0x66, 0x2f, 0x14, 0x8e, 0x52,
+	0x38, 0xcd, 0x75, 0x33, 0xeb, 0x33, 0x9f, 0x49, 0x01, 0xea, 0x7e, 0x29, 0xad, 0xb9, 0xe8, 0x33,
+	0xe6, 0xd7, 0x08, 0xc2, 0xf5, 0x00, 0x61, 0x4a, 0x99, 0xc0, 0x22, 0x60, 0x94, 0xeb, 0xd3, 0x05,
+	0x8f, 0xf1, 0x90, 0xf1, 0xb2, 0x0a, 0x53, 0x0b, 0x7d, 0xb4, 0x92, 0x88, 0xa1, 0xd3, 0x49, 0x89,
+	0x8d, 0xc0, 0xc2, 0x6e, 0x17, 0xeb, 0xa5, 0xdc, 0xdc, 0xaa, 0x54, 0x22, 0xc2, 0xb9, 0x4b, 0x0e,
+	0x1a, 0x84, 0x0b, 0x08, 0x41, 0x9a, 0xe2, 0x90, 0xe4, 0x8c, 0x65, 0xa3, 0x70, 0xc5, 0x95, 0xdf,
+	0xf6, 0x0e, 0x30, 0x93, 0x02, 0x78, 0x9d, 0x51, 0x4e, 0x60, 0x11, 0x64, 0xb0, 0xda, 0x52, 0x41,
+	0xa5, 0xdc, 0xb7, 0x2f, 0x6b, 0x59, 0x0d, 0xa5, 0xc5, 0x7b, 0x22, 0x0a, 0xa8, 0xef, 0xc6, 0x42,
+	0xbb, 0x05, 0x60, 0x9f, 0x63, 0x9c, 0x7b, 0x0f, 0xcc, 0xca, 0x7e, 0x49, 0x9f, 0xab, 0xa5, 0x27,
+	0xbf, 0xcf, 0xf3, 0x9b, 0x7e, 0x20, 0xaa, 0x8d, 0x7d, 0xc7, 0x63, 0x21, 0xda, 0x66, 0x3c, 0x7c,
+	0x8d, 0x79, 0x88, 0x0e, 0x31, 0x0f, 0x2b, 0xe8, 0x48, 0xfe, 0x22, 0x71, 0x5c, 0x27, 0xdc, 0x71,
+	0xf1, 0xe1, 0x36, 0xa3, 0x22, 0xc2, 0x9e, 0x78, 0x4e, 0x38, 0xc7, 0x3e, 0x71, 0x95, 0x57, 0xaf,
+	0xa0, 0x99, 0xbe, 0x82, 0xaa, 0xe0, 0xc6, 0x40, 0x7a, 0x5d, 0xc9, 0x2e, 0x48, 0x57, 0xb0, 0xc0,
+	0xff, 0x27, 0xbd, 0xb4, 0xb2, 0x6f, 0x0e, 0x64, 0x8a, 0xbb, 0x6c, 0xbf, 0x01, 0xd9, 0xc1, 0x6d,
+	0x4d, 0xb0, 0x05, 0x32, 0xea, 0xaa, 0xba, 0xbd, 0xbc, 0x54, 0x98, 0x2f, 0xae, 0x38, 0x49, 0x43,
+	0xe3, 0xbc, 0xc0, 0x21, 0xa9, 0xa8, 0xe0, 0x52, 0xfa, 0xf4, 0x3c, 0x9f, 0x72, 0xe3, 0x38, 0xfb,
+	0x15, 0x58, 0xea, 0xb3, 0x96, 0x9f, 0x2e, 0xe1, 0x8d, 0x9a, 0x88, 0xbb, 0x9c, 0x03, 0x19, 0xaf,
+	0x8a, 0x29, 0x25, 0x35, 0x7d, 0xc9, 0xf1, 0x12, 0x9a, 0xe0, 0x32, 0xef, 0x8a, 0xa8, 0xa7, 0xda,
+	0x95, 0x76, 0x7b, 0x6b, 0xdb, 0x07, 0xd6, 0x28, 0x5b, 0xcd, 0xfe, 0x0c, 0xcc, 0x45, 0x72, 0x47,
+	0xda, 0xce, 0x17, 0xef, 0x26, 0xa3, 0x0f, 0x19, 0xe8, 0x02, 0x74, 0x70, 0xf1, 0x4f, 0x1a, 0xcc,
+	0xca, 0x53, 0xf8, 0xd9, 0x00, 0xd7, 0x06, 0x46, 0x0e, 0xa2, 0x64, 0xcb, 0x91, 0xd3, 0x6c, 0x3e,
+	0x9c, 0x3e, 0x40, 0x55, 0x61, 0x3f, 0x78, 0xf7, 0xfd, 0xd7, 0xa7, 0x99, 0x3b, 0x70, 0x15, 0x8d,
+	0xf9, 0x23, 0x95, 0xf5, 0x1c, 0xc3, 0xf7, 0x06, 0x98, 0x53, 0x3e, 0xb0, 0x30, 0x31, 0x55, 0x0c,
+	0x75, 0x6f, 0x0a, 0xa5, 0xa6, 0x59, 0x95, 0x34, 0x16, 0x5c, 0x1c, 0x47, 0x03, 0x3f, 0x18, 0x20,
+	0xa3, 0x02, 0x39, 0x9c, 0x6c, 0xde, 0x6b, 0xce, 0xfd, 0x69, 0xa4, 0x1a, 0xe4, 0xb6, 0x04, 0xc9,
+	0xc3, 0xa5, 0x71, 0x20, 0x1c, 0x7e, 0x35, 0xc0, 0xf5, 0xa1, 0x0b, 0x86, 0x1b, 0x13, 0x13, 0x0d,
+	0x8f, 0xa9, 0xf9, 0xe8, 0xdf, 0x82, 0x34, 0xe7, 0x53, 0xc9, 0xb9, 0x09, 0x1f, 0xa3, 0xd1, 0xcf,
+	0x71, 0x59, 0x4d, 0x1a, 0x3a, 0xd1, 0x73, 0xdf, 0x42, 0x27, 0xf1, 0x98, 0xb7, 0x4a, 0xfe, 0x69,
+	0xdb, 0x32, 0xce, 0xda, 0x96, 0xf1, 0xb3, 0x6d, 0x19, 0x1f, 0x3b, 0x56, 0xea, 0xac, 0x63, 0xa5,
+	0x7e, 0x74, 0xac, 0x14, 0xb8, 0x15, 0xb0, 0x44, 0xa4, 0x1d, 0xe3, 0x6d, 0xb1, 0xef, 0x99, 0xb8,
+	0x90, 0xac, 0x05, 0xac, 0x9f, 0xe2, 0x28, 0xe6, 0x90, 0x4f, 0xc6, 0xfe, 0x9c, 0x7c, 0x8c, 0x37,
+	0xfe, 0x0e, 0x00, 0x7c, 0x6b, 0xeb, 0xca, 0x38, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,6 +480,10 @@ type QueryClient interface {
 	OracleAddress(ctx context.Context, in *QueryOracleAddressRequest, opts ...grpc.CallOption) (*QueryOracleAddressResponse, error)
 	// Oracle forwards a query to the module's oracle
 	Oracle(ctx context.Context, in *QueryOracleRequest, opts ...grpc.CallOption) (*QueryOracleResponse, error)
+	// Oracles returns all the named oracles
+	Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error)
+	// OracleQueryResult returns the stored result of an oracle query sent by this chain
+	OracleQueryResult(ctx context.Context, in *QueryOracleQueryResultRequest, opts ...grpc.CallOption) (*QueryOracleQueryResultResponse, error)
 }
 
 type queryClient struct {
@@ -290,12 +512,34 @@ func (c *queryClient) Oracle(ctx context.Context, in *QueryOracleRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error) {
+	out := new(QueryOraclesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Query/Oracles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleQueryResult(ctx context.Context, in *QueryOracleQueryResultRequest, opts ...grpc.CallOption) (*QueryOracleQueryResultResponse, error) {
+	out := new(QueryOracleQueryResultResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Query/OracleQueryResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// OracleAddress returns the address of the oracle
 	OracleAddress(context.Context, *QueryOracleAddressRequest) (*QueryOracleAddressResponse, error)
 	// Oracle forwards a query to the module's oracle
 	Oracle(context.Context, *QueryOracleRequest) (*QueryOracleResponse, error)
+	// Oracles returns all the named oracles
+	Oracles(context.Context, *QueryOraclesRequest) (*QueryOraclesResponse, error)
+	// OracleQueryResult returns the stored result of an oracle query sent by this chain
+	OracleQueryResult(context.Context, *QueryOracleQueryResultRequest) (*QueryOracleQueryResultResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -308,6 +552,12 @@ func (*UnimplementedQueryServer) OracleAddress(ctx context.Context, req *QueryOr
 func (*UnimplementedQueryServer) Oracle(ctx context.Context, req *QueryOracleRequest) (*QueryOracleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Oracle not implemented")
 }
+func (*UnimplementedQueryServer) Oracles(ctx context.Context, req *QueryOraclesRequest) (*QueryOraclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Oracles not implemented")
+}
+func (*UnimplementedQueryServer) OracleQueryResult(ctx context.Context, req *QueryOracleQueryResultRequest) (*QueryOracleQueryResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleQueryResult not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -349,6 +599,42 @@ func _Query_Oracle_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Oracles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOraclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Oracles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Query/Oracles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Oracles(ctx, req.(*QueryOraclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleQueryResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleQueryResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleQueryResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Query/OracleQueryResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleQueryResult(ctx, req.(*QueryOracleQueryResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.oracle.v1.Query",
@@ -362,6 +648,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Oracle",
 			Handler:    _Query_Oracle_Handler,
 		},
+		{
+			MethodName: "Oracles",
+			Handler:    _Query_Oracles_Handler,
+		},
+		{
+			MethodName: "OracleQueryResult",
+			Handler:    _Query_OracleQueryResult_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/oracle/v1/query.proto",
@@ -387,6 +681,13 @@ func (m *QueryOracleAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -440,6 +741,13 @@ func (m *QueryOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Query) > 0 {
 		i -= len(m.Query)
 		copy(dAtA[i:], m.Query)
@@ -480,6 +788,134 @@ func (m *QueryOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOraclesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOraclesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOraclesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOraclesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOraclesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOraclesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Oracles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleQueryResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleQueryResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleQueryResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleQueryResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleQueryResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -497,6 +933,10 @@ func (m *QueryOracleAddressRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -523,6 +963,10 @@ func (m *QueryOracleRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -539,6 +983,57 @@ func (m *QueryOracleResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOraclesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOraclesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Oracles) > 0 {
+		for _, e := range m.Oracles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleQueryResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryOracleQueryResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -574,6 +1069,38 @@ func (m *QueryOracleAddressRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryOracleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -740,6 +1267,38 @@ func (m *QueryOracleRequest) Unmarshal(dAtA []byte) error {
 				m.Query = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -845,6 +1404,324 @@ func (m *QueryOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOraclesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOraclesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOraclesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, NamedOracle{})
+			if err := m.Oracles[len(m.Oracles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleQueryResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleQueryResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleQueryResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleQueryResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleQueryResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleQueryResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
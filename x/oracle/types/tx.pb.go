@@ -39,6 +39,11 @@ type MsgSendQueryOracleRequest struct {
 	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
 	// The signing authority for the request
 	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The optional address of a contract to sudo-call when the query's acknowledgement, error, or timeout is received.
+	// If provided, it must be the same as the authority.
+	CallbackAddress string `protobuf:"bytes,5,opt,name=callback_address,json=callbackAddress,proto3" json:"callback_address,omitempty"`
+	// The optional name of the oracle to query on the other chain. If empty, the other chain's default oracle is queried.
+	OracleName string `protobuf:"bytes,6,opt,name=oracle_name,json=oracleName,proto3" json:"oracle_name,omitempty"`
 }
 
 func (m *MsgSendQueryOracleRequest) Reset()         { *m = MsgSendQueryOracleRequest{} }
@@ -95,6 +100,20 @@ func (m *MsgSendQueryOracleRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgSendQueryOracleRequest) GetCallbackAddress() string {
+	if m != nil {
+		return m.CallbackAddress
+	}
+	return ""
+}
+
+func (m *MsgSendQueryOracleRequest) GetOracleName() string {
+	if m != nil {
+		return m.OracleName
+	}
+	return ""
+}
+
 // MsgSendQueryOracleResponse contains the id of the oracle query.
 type MsgSendQueryOracleResponse struct {
 	// The sequence number that uniquely identifies the query.
@@ -147,6 +166,8 @@ type MsgUpdateOracleRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// The signing authorities for the request
 	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The optional name to register the oracle under. If empty, the default oracle is updated.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *MsgUpdateOracleRequest) Reset()         { *m = MsgUpdateOracleRequest{} }
@@ -196,6 +217,13 @@ func (m *MsgUpdateOracleRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgUpdateOracleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // MsgUpdateOracleResponse is the response type for updating the oracle.
 type MsgUpdateOracleResponse struct {
 }
@@ -233,47 +261,146 @@ func (m *MsgUpdateOracleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateOracleResponse proto.InternalMessageInfo
 
+// MsgRemoveOracleRequest is the request type for removing a named oracle
+type MsgRemoveOracleRequest struct {
+	// The name of the oracle to remove
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The signing authorities for the request
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRemoveOracleRequest) Reset()         { *m = MsgRemoveOracleRequest{} }
+func (m *MsgRemoveOracleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOracleRequest) ProtoMessage()    {}
+func (*MsgRemoveOracleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{4}
+}
+func (m *MsgRemoveOracleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOracleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOracleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOracleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOracleRequest.Merge(m, src)
+}
+func (m *MsgRemoveOracleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOracleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOracleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOracleRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveOracleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRemoveOracleRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgRemoveOracleResponse is the response type for removing a named oracle.
+type MsgRemoveOracleResponse struct {
+}
+
+func (m *MsgRemoveOracleResponse) Reset()         { *m = MsgRemoveOracleResponse{} }
+func (m *MsgRemoveOracleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOracleResponse) ProtoMessage()    {}
+func (*MsgRemoveOracleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{5}
+}
+func (m *MsgRemoveOracleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOracleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOracleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOracleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOracleResponse.Merge(m, src)
+}
+func (m *MsgRemoveOracleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOracleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOracleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOracleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSendQueryOracleRequest)(nil), "provenance.oracle.v1.MsgSendQueryOracleRequest")
 	proto.RegisterType((*MsgSendQueryOracleResponse)(nil), "provenance.oracle.v1.MsgSendQueryOracleResponse")
 	proto.RegisterType((*MsgUpdateOracleRequest)(nil), "provenance.oracle.v1.MsgUpdateOracleRequest")
 	proto.RegisterType((*MsgUpdateOracleResponse)(nil), "provenance.oracle.v1.MsgUpdateOracleResponse")
+	proto.RegisterType((*MsgRemoveOracleRequest)(nil), "provenance.oracle.v1.MsgRemoveOracleRequest")
+	proto.RegisterType((*MsgRemoveOracleResponse)(nil), "provenance.oracle.v1.MsgRemoveOracleResponse")
 }
 
 func init() { proto.RegisterFile("provenance/oracle/v1/tx.proto", fileDescriptor_66a39dda41c6a784) }
 
 var fileDescriptor_66a39dda41c6a784 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0xd1, 0x96, 0xd2, 0x53, 0x05, 0xd2, 0x29, 0x22, 0x8e, 0x25, 0xdc, 0x2a, 0x53, 0x55,
-	0x11, 0x1f, 0x0d, 0x12, 0x02, 0x24, 0x06, 0xd2, 0x39, 0x02, 0x1c, 0x21, 0x24, 0x16, 0x74, 0xb5,
-	0x4f, 0x17, 0x8b, 0xfa, 0xce, 0xbd, 0x77, 0x4e, 0x93, 0x0d, 0xf1, 0x0b, 0x18, 0x19, 0xf9, 0x09,
-	0x1d, 0xf8, 0x11, 0x8c, 0x55, 0x27, 0x26, 0x84, 0x92, 0xa1, 0x6c, 0xec, 0x4c, 0xc8, 0xbe, 0x04,
-	0xa7, 0xc1, 0xa0, 0x4c, 0xf6, 0xf7, 0xbe, 0xef, 0xde, 0xbd, 0xef, 0xe9, 0x3b, 0x7c, 0x27, 0xd5,
-	0x6a, 0xc8, 0x25, 0x93, 0x21, 0xa7, 0x4a, 0xb3, 0xf0, 0x98, 0xd3, 0xe1, 0x01, 0x35, 0x23, 0x3f,
-	0xd5, 0xca, 0x28, 0x52, 0x2f, 0x69, 0xdf, 0xd2, 0xfe, 0xf0, 0xc0, 0x6d, 0x84, 0x0a, 0x12, 0x05,
-	0x34, 0x01, 0x91, 0xab, 0x13, 0x10, 0x56, 0xee, 0x36, 0x2d, 0xf1, 0xa6, 0x40, 0xd4, 0x82, 0x19,
-	0x55, 0x17, 0x4a, 0x28, 0x5b, 0xcf, 0xff, 0x6c, 0xb5, 0x75, 0x81, 0x70, 0xb3, 0x07, 0xa2, 0xcf,
-	0x65, 0xf4, 0x22, 0xe3, 0x7a, 0xfc, 0xac, 0xb8, 0x23, 0xe0, 0x27, 0x19, 0x07, 0x43, 0xfa, 0x78,
-	0xe3, 0x24, 0xaf, 0x3a, 0x68, 0x17, 0xed, 0x6d, 0x77, 0x9f, 0xfc, 0xfa, 0xb6, 0xf3, 0x48, 0xc4,
-	0x66, 0x90, 0x1d, 0xf9, 0xa1, 0x4a, 0xe8, 0xa1, 0x82, 0xe4, 0x15, 0x83, 0x84, 0x9e, 0x32, 0x48,
-	0x22, 0x3a, 0x2a, 0xbe, 0xd4, 0x8c, 0x53, 0x0e, 0x7e, 0xc0, 0x4e, 0x0f, 0x95, 0x34, 0x9a, 0x85,
-	0xa6, 0xc7, 0x01, 0x98, 0xe0, 0x81, 0xed, 0x45, 0x1c, 0xbc, 0x19, 0x0e, 0x98, 0x94, 0xfc, 0xd8,
-	0x59, 0xdb, 0x45, 0x7b, 0x5b, 0xc1, 0x1c, 0x92, 0x07, 0x78, 0x8b, 0x65, 0x66, 0xa0, 0x74, 0x6c,
-	0xc6, 0xce, 0x7a, 0xce, 0x75, 0x9d, 0x8b, 0xcf, 0xed, 0xfa, 0xcc, 0xc7, 0xd3, 0x28, 0xd2, 0x1c,
-	0xa0, 0x6f, 0x74, 0x2c, 0x45, 0x50, 0x4a, 0x1f, 0xdf, 0x7c, 0x7f, 0x79, 0xb6, 0x5f, 0xe2, 0xd6,
-	0x43, 0xec, 0x56, 0x79, 0x82, 0x54, 0x49, 0xe0, 0xc4, 0xc5, 0x37, 0x20, 0xf7, 0x27, 0x43, 0x5e,
-	0xf8, 0x5a, 0x0f, 0xfe, 0xe0, 0xd6, 0x47, 0x84, 0x6f, 0xf7, 0x40, 0xbc, 0x4c, 0x23, 0x66, 0xf8,
-	0xd5, 0x5d, 0x74, 0xf0, 0x26, 0xb3, 0x03, 0x14, 0xa7, 0xfe, 0x37, 0xda, 0x5c, 0x78, 0xd5, 0xd0,
-	0xb5, 0xd5, 0x0d, 0x91, 0x1f, 0x9f, 0x76, 0xd0, 0x92, 0xa9, 0x26, 0x6e, 0xfc, 0x35, 0x99, 0x75,
-	0xd4, 0xf9, 0x89, 0xf0, 0x5a, 0x0f, 0x04, 0x79, 0x8b, 0xb7, 0x17, 0x79, 0x72, 0xd7, 0xaf, 0x4a,
-	0x8f, 0x5f, 0x6d, 0xd0, 0x6d, 0xaf, 0xa8, 0x9e, 0xad, 0xd1, 0xe0, 0x5b, 0x4b, 0x1b, 0x26, 0xf4,
-	0x9f, 0x1d, 0xaa, 0xf3, 0xe5, 0xde, 0x5b, 0xfd, 0x80, 0xbd, 0xd5, 0xdd, 0x78, 0x77, 0x79, 0xb6,
-	0x8f, 0xba, 0xe2, 0xcb, 0xc4, 0x43, 0xe7, 0x13, 0x0f, 0x7d, 0x9f, 0x78, 0xe8, 0xc3, 0xd4, 0xab,
-	0x9d, 0x4f, 0xbd, 0xda, 0xd7, 0xa9, 0x57, 0xc3, 0x8d, 0x58, 0x55, 0x36, 0x7d, 0x8e, 0x5e, 0x77,
-	0x16, 0xa2, 0x5b, 0x4a, 0xda, 0xb1, 0x5a, 0x40, 0x74, 0x34, 0x7f, 0x85, 0x45, 0x8c, 0x8f, 0xae,
-	0x17, 0xcf, 0xe4, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x66, 0x84, 0x0f, 0xa7, 0x03,
-	0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0x12, 0x41,
+	0x14, 0x67, 0x28, 0xb4, 0x76, 0x24, 0xd6, 0x4c, 0x88, 0x2c, 0x9b, 0xb8, 0x34, 0x9c, 0x9a, 0x46,
+	0x76, 0x2c, 0x26, 0x46, 0x9b, 0x78, 0x10, 0xce, 0xf8, 0x67, 0x89, 0x31, 0xf1, 0x42, 0x86, 0x65,
+	0x32, 0x10, 0xd8, 0x19, 0xba, 0x33, 0x50, 0xb8, 0x19, 0x3f, 0x81, 0x1f, 0xc1, 0x0f, 0xe0, 0xa1,
+	0x07, 0x0f, 0x7e, 0x04, 0x8f, 0x8d, 0x27, 0x0f, 0xc6, 0x18, 0x38, 0xd4, 0xcf, 0xe0, 0xc9, 0x30,
+	0xc3, 0xca, 0x1f, 0xd7, 0x96, 0xf4, 0xb4, 0xf3, 0xde, 0xfb, 0xcd, 0x7b, 0xef, 0xf7, 0x7b, 0x3b,
+	0x0f, 0xde, 0xed, 0x87, 0x62, 0x48, 0x39, 0xe1, 0x3e, 0xc5, 0x22, 0x24, 0x7e, 0x8f, 0xe2, 0xe1,
+	0x11, 0x56, 0x23, 0xb7, 0x1f, 0x0a, 0x25, 0x50, 0x76, 0x11, 0x76, 0x4d, 0xd8, 0x1d, 0x1e, 0xd9,
+	0x39, 0x5f, 0xc8, 0x40, 0x48, 0x1c, 0x48, 0x36, 0x43, 0x07, 0x92, 0x19, 0xb8, 0x9d, 0x37, 0x81,
+	0x86, 0xb6, 0xb0, 0x31, 0xe6, 0xa1, 0x2c, 0x13, 0x4c, 0x18, 0xff, 0xec, 0x64, 0xbc, 0xc5, 0xcf,
+	0x49, 0x98, 0xaf, 0x49, 0x56, 0xa7, 0xbc, 0xf5, 0x72, 0x40, 0xc3, 0xf1, 0x73, 0x5d, 0xc3, 0xa3,
+	0x27, 0x03, 0x2a, 0x15, 0xaa, 0xc3, 0xf4, 0xc9, 0xcc, 0x6b, 0x81, 0x7d, 0x70, 0x90, 0xa9, 0x3c,
+	0xf9, 0xfd, 0xa3, 0xf0, 0x98, 0x75, 0x54, 0x7b, 0xd0, 0x74, 0x7d, 0x11, 0xe0, 0xaa, 0x90, 0xc1,
+	0x6b, 0x22, 0x03, 0x7c, 0x4a, 0x64, 0xd0, 0xc2, 0x23, 0xfd, 0xc5, 0x6a, 0xdc, 0xa7, 0xd2, 0xf5,
+	0xc8, 0x69, 0x55, 0x70, 0x15, 0x12, 0x5f, 0xd5, 0xa8, 0x94, 0x84, 0x51, 0xcf, 0xe4, 0x42, 0x16,
+	0xdc, 0xf1, 0xdb, 0x84, 0x73, 0xda, 0xb3, 0xb6, 0xf6, 0xc1, 0xc1, 0xae, 0x17, 0x99, 0xe8, 0x21,
+	0xdc, 0x25, 0x03, 0xd5, 0x16, 0x61, 0x47, 0x8d, 0xad, 0xd4, 0x2c, 0x56, 0xb1, 0xbe, 0x7e, 0x2a,
+	0x65, 0xe7, 0x3c, 0x9e, 0xb6, 0x5a, 0x21, 0x95, 0xb2, 0xae, 0xc2, 0x0e, 0x67, 0xde, 0x02, 0x8a,
+	0xaa, 0xf0, 0xb6, 0x4f, 0x7a, 0xbd, 0x26, 0xf1, 0xbb, 0x0d, 0x62, 0x40, 0x56, 0xfa, 0x8a, 0xeb,
+	0x7b, 0xd1, 0x8d, 0xb9, 0x1b, 0x15, 0xe0, 0x4d, 0x23, 0x70, 0x83, 0x93, 0x80, 0x5a, 0xdb, 0xba,
+	0x35, 0x68, 0x5c, 0xcf, 0x48, 0x40, 0x8f, 0x6f, 0xbd, 0xbb, 0x38, 0x3b, 0x5c, 0x54, 0x2d, 0x3e,
+	0x82, 0x76, 0x9c, 0x72, 0xb2, 0x2f, 0xb8, 0xa4, 0xc8, 0x86, 0x37, 0xe4, 0x4c, 0x45, 0xee, 0x53,
+	0xad, 0x5e, 0xca, 0xfb, 0x6b, 0x17, 0x3f, 0x02, 0x78, 0xa7, 0x26, 0xd9, 0xab, 0x7e, 0x8b, 0x28,
+	0xba, 0xaa, 0x78, 0x19, 0xee, 0x44, 0x0c, 0xc0, 0x15, 0x0c, 0x22, 0xe0, 0xaa, 0x6c, 0xc9, 0xcd,
+	0x65, 0x43, 0x30, 0xa5, 0xa9, 0x9a, 0x29, 0xe8, 0xf3, 0x31, 0xfa, 0xf5, 0xa1, 0x00, 0xd6, 0x88,
+	0xe6, 0x61, 0xee, 0x9f, 0x6e, 0x0d, 0xcb, 0xe2, 0x48, 0x13, 0xf1, 0x68, 0x20, 0x86, 0x6b, 0x44,
+	0xa2, 0xe4, 0x60, 0x91, 0xfc, 0xba, 0x8d, 0x5e, 0xd2, 0xd4, 0x6a, 0x65, 0xd3, 0x54, 0xf9, 0x7b,
+	0x12, 0x6e, 0xd5, 0x24, 0x43, 0x5d, 0x98, 0x59, 0x6e, 0x1a, 0xdd, 0x73, 0xe3, 0x1e, 0x93, 0x1b,
+	0x3f, 0x09, 0xbb, 0xb4, 0x21, 0x7a, 0x3e, 0x6f, 0x05, 0xf7, 0xd6, 0x7e, 0x05, 0x84, 0xff, 0x9b,
+	0x21, 0xfe, 0xb9, 0xd9, 0xf7, 0x37, 0xbf, 0x30, 0xaf, 0xda, 0x85, 0x99, 0x65, 0x09, 0x2e, 0xa1,
+	0x18, 0x33, 0x23, 0xbb, 0xb4, 0x21, 0xda, 0x14, 0xb3, 0xd3, 0x6f, 0x2f, 0xce, 0x0e, 0x41, 0x85,
+	0x7d, 0x99, 0x38, 0xe0, 0x7c, 0xe2, 0x80, 0x9f, 0x13, 0x07, 0xbc, 0x9f, 0x3a, 0x89, 0xf3, 0xa9,
+	0x93, 0xf8, 0x36, 0x75, 0x12, 0x30, 0xd7, 0x11, 0xb1, 0x19, 0x5f, 0x80, 0x37, 0xe5, 0xa5, 0xb5,
+	0xb1, 0x80, 0x94, 0x3a, 0x62, 0xc9, 0xc2, 0xa3, 0x68, 0x03, 0xea, 0x15, 0xd2, 0xdc, 0xd6, 0x2b,
+	0xea, 0xc1, 0x9f, 0x01, 0x00, 0xa4, 0xe0, 0x69, 0x30, 0x23, 0x05, 0x00, 0x00,
 }
 
 func (this *MsgUpdateOracleRequest) Equal(that interface{}) bool {
@@ -301,6 +428,36 @@ func (this *MsgUpdateOracleRequest) Equal(that interface{}) bool {
 	if this.Authority != that1.Authority {
 		return false
 	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *MsgRemoveOracleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRemoveOracleRequest)
+	if !ok {
+		that2, ok := that.(MsgRemoveOracleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
 	return true
 }
 
@@ -320,6 +477,8 @@ type MsgClient interface {
 	UpdateOracle(ctx context.Context, in *MsgUpdateOracleRequest, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error)
 	// SendQueryOracle sends a query to an oracle on another chain
 	SendQueryOracle(ctx context.Context, in *MsgSendQueryOracleRequest, opts ...grpc.CallOption) (*MsgSendQueryOracleResponse, error)
+	// RemoveOracle is the RPC endpoint for removing a named oracle
+	RemoveOracle(ctx context.Context, in *MsgRemoveOracleRequest, opts ...grpc.CallOption) (*MsgRemoveOracleResponse, error)
 }
 
 type msgClient struct {
@@ -348,12 +507,23 @@ func (c *msgClient) SendQueryOracle(ctx context.Context, in *MsgSendQueryOracleR
 	return out, nil
 }
 
+func (c *msgClient) RemoveOracle(ctx context.Context, in *MsgRemoveOracleRequest, opts ...grpc.CallOption) (*MsgRemoveOracleResponse, error) {
+	out := new(MsgRemoveOracleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/RemoveOracle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateOracle is the RPC endpoint for updating the oracle
 	UpdateOracle(context.Context, *MsgUpdateOracleRequest) (*MsgUpdateOracleResponse, error)
 	// SendQueryOracle sends a query to an oracle on another chain
 	SendQueryOracle(context.Context, *MsgSendQueryOracleRequest) (*MsgSendQueryOracleResponse, error)
+	// RemoveOracle is the RPC endpoint for removing a named oracle
+	RemoveOracle(context.Context, *MsgRemoveOracleRequest) (*MsgRemoveOracleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -366,6 +536,9 @@ func (*UnimplementedMsgServer) UpdateOracle(ctx context.Context, req *MsgUpdateO
 func (*UnimplementedMsgServer) SendQueryOracle(ctx context.Context, req *MsgSendQueryOracleRequest) (*MsgSendQueryOracleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendQueryOracle not implemented")
 }
+func (*UnimplementedMsgServer) RemoveOracle(ctx context.Context, req *MsgRemoveOracleRequest) (*MsgRemoveOracleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOracle not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -407,6 +580,24 @@ func _Msg_SendQueryOracle_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOracleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOracle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/RemoveOracle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOracle(ctx, req.(*MsgRemoveOracleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.oracle.v1.Msg",
@@ -420,6 +611,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendQueryOracle",
 			Handler:    _Msg_SendQueryOracle_Handler,
 		},
+		{
+			MethodName: "RemoveOracle",
+			Handler:    _Msg_RemoveOracle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/oracle/v1/tx.proto",
@@ -445,6 +640,20 @@ func (m *MsgSendQueryOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleName) > 0 {
+		i -= len(m.OracleName)
+		copy(dAtA[i:], m.OracleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CallbackAddress) > 0 {
+		i -= len(m.CallbackAddress)
+		copy(dAtA[i:], m.CallbackAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CallbackAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -517,6 +726,13 @@ func (m *MsgUpdateOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -557,6 +773,66 @@ func (m *MsgUpdateOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRemoveOracleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOracleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOracleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -586,6 +862,14 @@ func (m *MsgSendQueryOracleRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.CallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -615,6 +899,10 @@ func (m *MsgUpdateOracleRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -627,6 +915,32 @@ func (m *MsgUpdateOracleResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRemoveOracleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -760,6 +1074,70 @@ func (m *MsgSendQueryOracleRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -943,6 +1321,38 @@ func (m *MsgUpdateOracleRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1014,6 +1424,170 @@ func (m *MsgUpdateOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRemoveOracleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOracleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
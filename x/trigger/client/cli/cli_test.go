@@ -747,3 +747,203 @@ func (s *IntegrationTestSuite) TestDestroyTrigger() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestAddRecurringTrigger() {
+	testCases := []struct {
+		name         string
+		interval     string
+		start        string
+		flags        []string
+		fileContent  string
+		expectErrMsg string
+		expectedCode uint32
+	}{
+		{
+			name:         "create invalid recurring trigger for past block",
+			interval:     "10",
+			start:        "1",
+			flags:        []string{"--max-runs", "3"},
+			expectedCode: types.ErrInvalidBlockHeight.ABCICode(),
+		},
+		{
+			name:         "create invalid recurring trigger for past time",
+			interval:     "720h",
+			start:        "2000-05-19T13:49:00-04:00",
+			flags:        []string{"--max-runs", "3"},
+			expectedCode: types.ErrInvalidBlockTime.ABCICode(),
+		},
+		{
+			name:         "bad interval",
+			interval:     "abc",
+			start:        "1000",
+			expectErrMsg: "invalid interval \"abc\": must be a number of blocks or a duration",
+		},
+		{
+			name:         "block interval too small",
+			interval:     "2",
+			start:        "1000",
+			expectErrMsg: "block interval 2 cannot be less than 5",
+		},
+		{
+			name:         "no max runs or end time",
+			interval:     "10",
+			start:        "1000",
+			expectErrMsg: "at least one of max runs and end time must be provided",
+		},
+		{
+			name:         "bad start height",
+			interval:     "10",
+			start:        "abc",
+			expectErrMsg: "invalid start block height \"abc\": strconv.ParseUint: parsing \"abc\": invalid syntax",
+		},
+		{
+			name:         "bad start time",
+			interval:     "720h",
+			start:        "abc",
+			expectErrMsg: "unable to parse start time (abc) required format is RFC3339 (2006-01-02T15:04:05Z07:00): parsing time \"abc\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"abc\" as \"2006\"",
+		},
+		{
+			name:         "bad end time",
+			interval:     "720h",
+			start:        "2100-05-19T13:49:00-04:00",
+			flags:        []string{"--end-time", "abc"},
+			expectErrMsg: "unable to parse end time (abc) required format is RFC3339 (2006-01-02T15:04:05Z07:00): parsing time \"abc\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"abc\" as \"2006\"",
+		},
+		{
+			name:         "invalid file format",
+			interval:     "10",
+			start:        "1000",
+			fileContent:  "abc",
+			expectErrMsg: "unable to parse message file: invalid character 'a' looking for beginning of value",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			message := tc.fileContent
+			if len(message) == 0 {
+				message = fmt.Sprintf(`
+				{
+						"@type": "/cosmos.bank.v1beta1.MsgSend",
+						"from_address": "%s",
+						"to_address": "%s",
+						"amount": [
+							{
+								"denom": "nhash",
+								"amount": "10"
+							}
+						]
+				}`, s.accountAddresses[0].String(), s.accountAddresses[1].String())
+			}
+
+			tempDir := s.T().TempDir()
+			messageFile := filepath.Join(tempDir, "msg.json")
+			err := os.WriteFile(messageFile, []byte(message), 0o666)
+			s.Require().NoError(err, "WriteFile(%q, %q)", messageFile, message)
+
+			cmd := triggercli.GetCmdAddRecurringTrigger()
+			args := []string{tc.interval, tc.start, messageFile}
+			args = append(args, tc.flags...)
+			args = append(args,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.network)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestAddCompoundTrigger() {
+	testCases := []struct {
+		name          string
+		operator      string
+		eventsContent string
+		expectErrMsg  string
+		expectedCode  uint32
+	}{
+		{
+			name:     "create invalid compound trigger for past block",
+			operator: "and",
+			eventsContent: `[
+				{"@type": "/provenance.trigger.v1.BlockHeightEvent", "block_height": "1"},
+				{"@type": "/provenance.trigger.v1.TransactionEvent", "name": "event1"}
+			]`,
+			expectedCode: types.ErrInvalidBlockHeight.ABCICode(),
+		},
+		{
+			name:          "bad operator",
+			operator:      "xor",
+			eventsContent: "[]",
+			expectErrMsg:  "invalid operator \"xor\": must be \"and\" or \"or\"",
+		},
+		{
+			name:          "invalid events file format",
+			operator:      "or",
+			eventsContent: "abc",
+			expectErrMsg:  "unable to parse events file: invalid character 'a' looking for beginning of value",
+		},
+		{
+			name:          "invalid event format",
+			operator:      "or",
+			eventsContent: "[{}]",
+			expectErrMsg:  "unable to parse events file: event 0: Any JSON doesn't have '@type'",
+		},
+		{
+			name:     "too few events",
+			operator: "or",
+			eventsContent: `[
+				{"@type": "/provenance.trigger.v1.TransactionEvent", "name": "event1"}
+			]`,
+			expectErrMsg: "compound event must have at least 2 events",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			message := fmt.Sprintf(`
+			{
+					"@type": "/cosmos.bank.v1beta1.MsgSend",
+					"from_address": "%s",
+					"to_address": "%s",
+					"amount": [
+						{
+							"denom": "nhash",
+							"amount": "10"
+						}
+					]
+			}`, s.accountAddresses[0].String(), s.accountAddresses[1].String())
+
+			tempDir := s.T().TempDir()
+			eventsFile := filepath.Join(tempDir, "events.json")
+			err := os.WriteFile(eventsFile, []byte(tc.eventsContent), 0o666)
+			s.Require().NoError(err, "WriteFile(%q, %q)", eventsFile, tc.eventsContent)
+			messageFile := filepath.Join(tempDir, "msg.json")
+			err = os.WriteFile(messageFile, []byte(message), 0o666)
+			s.Require().NoError(err, "WriteFile(%q, %q)", messageFile, message)
+
+			cmd := triggercli.GetCmdAddCompoundTrigger()
+			args := []string{
+				tc.operator,
+				eventsFile,
+				messageFile,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			}
+
+			testcli.NewTxExecutor(cmd, args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.network)
+		})
+	}
+}
@@ -14,12 +14,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/trigger/types"
 )
 
+const (
+	FlagMaxRuns = "max-runs"
+	FlagEndTime = "end-time"
+)
+
 // NewTxCmd is the top-level command for trigger CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -35,6 +41,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdAddTransactionTrigger(),
 		GetCmdAddBlockHeightTrigger(),
 		GetCmdAddBlockTimeTrigger(),
+		GetCmdAddRecurringTrigger(),
+		GetCmdAddCompoundTrigger(),
 		GetCmdDestroyTrigger(),
 	)
 
@@ -48,7 +56,10 @@ func GetCmdAddTransactionTrigger() *cobra.Command {
 		Args:    cobra.ExactArgs(2),
 		Aliases: []string{"tx"},
 		Short:   "Creates a new trigger that fires when a tx event is detected.",
-		Long:    strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the tx event has occurred`),
+		Long: strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the tx event has occurred
+An attribute can have an operator to compare its value in other ways than equality. The operators are:
+ATTRIBUTE_OPERATOR_PREFIX, ATTRIBUTE_OPERATOR_REGEX, ATTRIBUTE_OPERATOR_GT, ATTRIBUTE_OPERATOR_GTE, ATTRIBUTE_OPERATOR_LT,
+ATTRIBUTE_OPERATOR_LTE, ATTRIBUTE_OPERATOR_COINS_GT, and ATTRIBUTE_OPERATOR_COINS_GTE`),
 		Example: fmt.Sprintf(`$ %[1]s tx trigger create-tx-trigger event.json message.json
 		
 Example of event.json contents:
@@ -61,7 +72,8 @@ Example of event.json contents:
 		},
 		{
 			"name": "amount",
-			"value": "100nhash"
+			"value": "1000000pm.sale.pool",
+			"operator": "ATTRIBUTE_OPERATOR_COINS_GT"
 		}
 	]
 }
@@ -233,6 +245,153 @@ Example of message.json contents:
 	return cmd
 }
 
+// GetCmdAddRecurringTrigger is a command to add a trigger for a recurring event.
+func GetCmdAddRecurringTrigger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-recurring-trigger {<block interval> <start height>|<time interval> <start time>} <msg.json>",
+		Args:    cobra.ExactArgs(3),
+		Aliases: []string{"rt", "recurring"},
+		Short:   "Creates a new trigger that fires repeatedly at a block or time interval",
+		Long: strings.TrimSpace(`Creates a new trigger.  This will execute the provided message every interval, starting at the provided block height or time.
+The interval is either a number of blocks (e.g. 100), or a duration (e.g. 720h). It must be at least 5 blocks or 1 minute.
+With a number of blocks, the start is a block height. With a duration, the start is an RFC3339 time.
+The trigger will keep firing until it has fired --max-runs times, or until the --end-time. At least one of them is required.
+A trigger can fire at most 100 times, and the gas for all of its runs is paid when it is created.`),
+		Example: fmt.Sprintf(`$ %[1]s tx trigger create-recurring-trigger 100 5000 message.json --max-runs 10
+$ %[1]s tx trigger create-recurring-trigger 720h 2006-01-02T15:04:05-04:00 message.json --end-time 2007-01-02T15:04:05-04:00`,
+			version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			callerAddr := clientCtx.GetFromAddress()
+
+			event, err := parseRecurringEvent(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			event.MaxRuns, err = cmd.Flags().GetUint64(FlagMaxRuns)
+			if err != nil {
+				return err
+			}
+			endTimeStr, err := cmd.Flags().GetString(FlagEndTime)
+			if err != nil {
+				return err
+			}
+			if len(endTimeStr) > 0 {
+				var endTime time.Time
+				endTime, err = time.Parse(time.RFC3339, endTimeStr)
+				if err != nil {
+					return fmt.Errorf("unable to parse end time (%v) required format is RFC3339 (%v): %w", endTimeStr, time.RFC3339, err)
+				}
+				endTime = endTime.UTC()
+				event.EndTime = &endTime
+			}
+
+			msgs, err := parseMessages(clientCtx.Codec, args[2])
+			if err != nil {
+				return fmt.Errorf("unable to parse message file: %w", err)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no actions added to trigger")
+			}
+
+			msg, err := types.NewCreateTriggerRequest(
+				[]string{callerAddr.String()},
+				event,
+				msgs,
+			)
+			if err != nil {
+				return fmt.Errorf("error creating %T: %w", msg, err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint64(FlagMaxRuns, 0, "The maximum number of times the trigger should fire, up to 100 (default is 100)")
+	cmd.Flags().String(FlagEndTime, "", "The RFC3339 time after which the trigger should no longer fire")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddCompoundTrigger is a command to add a trigger for a compound event.
+func GetCmdAddCompoundTrigger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-compound-trigger {and|or} <events.json> <msg.json>",
+		Args:    cobra.ExactArgs(3),
+		Aliases: []string{"ct", "compound"},
+		Short:   "Creates a new trigger that fires when all (and) or any (or) of several events are detected",
+		Long: strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the events have occurred.
+The events.json file must contain a list of block height, block time, or transaction events.
+Block height and time events are satisfied in every block at or after their height or time.
+Transaction events are only satisfied in the block that they are emitted in.`),
+		Example: fmt.Sprintf(`$ %[1]s tx trigger create-compound-trigger and events.json message.json
+
+Example of events.json contents:
+[
+	{
+		"@type": "/provenance.trigger.v1.BlockHeightEvent",
+		"block_height": "5000"
+	},
+	{
+		"@type": "/provenance.trigger.v1.TransactionEvent",
+		"name": "coin_received",
+		"attributes": [
+			{
+				"name": "receiver",
+				"value": "tp19yjn905u442gh430hw362zrq5m6gj0klhk8ghx"
+			}
+		]
+	}
+]`,
+			version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			callerAddr := clientCtx.GetFromAddress()
+
+			var operator types.CompoundOperator
+			switch strings.ToLower(args[0]) {
+			case "and":
+				operator = types.CompoundOperator_COMPOUND_OPERATOR_AND
+			case "or":
+				operator = types.CompoundOperator_COMPOUND_OPERATOR_OR
+			default:
+				return fmt.Errorf("invalid operator %q: must be \"and\" or \"or\"", args[0])
+			}
+
+			events, err := parseEvents(clientCtx.Codec, args[1])
+			if err != nil {
+				return fmt.Errorf("unable to parse events file: %w", err)
+			}
+
+			msgs, err := parseMessages(clientCtx.Codec, args[2])
+			if err != nil {
+				return fmt.Errorf("unable to parse message file: %w", err)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no actions added to trigger")
+			}
+
+			msg, err := types.NewCreateTriggerRequest(
+				[]string{callerAddr.String()},
+				&types.CompoundEvent{Operator: operator, Events: events},
+				msgs,
+			)
+			if err != nil {
+				return fmt.Errorf("error creating %T: %w", msg, err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdDestroyTrigger is a command to destroy an existing trigger.
 func GetCmdDestroyTrigger() *cobra.Command {
 	cmd := &cobra.Command{
@@ -295,3 +454,51 @@ func parseEvent(path string) (*types.TransactionEvent, error) {
 
 	return &event, nil
 }
+
+// parseRecurringEvent creates a recurring event from an interval and start.
+// The interval is either a number of blocks or a duration, and the start is either a block height or RFC3339 time.
+func parseRecurringEvent(interval, start string) (*types.RecurringEvent, error) {
+	if blockInterval, intErr := strconv.ParseUint(interval, 10, 64); intErr == nil {
+		height, err := strconv.ParseUint(start, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start block height %q: %w", start, err)
+		}
+		return &types.RecurringEvent{BlockInterval: blockInterval, NextBlockHeight: height}, nil
+	}
+
+	timeInterval, err := time.ParseDuration(interval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid interval %q: must be a number of blocks or a duration", interval)
+	}
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse start time (%v) required format is RFC3339 (%v): %w", start, time.RFC3339, err)
+	}
+	return &types.RecurringEvent{TimeInterval: timeInterval, NextTime: startTime.UTC()}, nil
+}
+
+// parseEvents reads and parses a list of trigger events from a file.
+func parseEvents(cdc codec.Codec, path string) ([]*codectypes.Any, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []json.RawMessage
+	if err = json.Unmarshal(contents, &entries); err != nil {
+		return nil, err
+	}
+
+	rv := make([]*codectypes.Any, len(entries))
+	for i, entry := range entries {
+		var event types.TriggerEventI
+		if err = cdc.UnmarshalInterfaceJSON(entry, &event); err != nil {
+			return nil, fmt.Errorf("event %d: %w", i, err)
+		}
+		rv[i], err = codectypes.NewAnyWithValue(event)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w", i, err)
+		}
+	}
+	return rv, nil
+}
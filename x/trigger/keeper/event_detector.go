@@ -2,7 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/trigger/types"
@@ -13,13 +17,34 @@ func (k Keeper) DetectBlockEvents(ctx sdk.Context) {
 	triggers := k.detectTransactionEvents(ctx)
 	triggers = append(triggers, k.detectBlockHeightEvents(ctx)...)
 	triggers = append(triggers, k.detectTimeEvents(ctx)...)
+	triggers = append(triggers, k.detectRecurringEvents(ctx)...)
+	triggers = append(triggers, k.detectCompoundEvents(ctx)...)
 
+	// A trigger is only handled once per block, and a recurring trigger isn't queued again while it still
+	// has a pending run. It stays registered, so it's detected again once that run has been processed.
+	// Since its runs are counted as they're queued, its last run is the only one left once it reaches its max runs.
+	handled := k.getQueuedTriggerIDs(ctx)
 	for _, trigger := range triggers {
+		if handled[trigger.Id] {
+			k.Logger(ctx).Debug(fmt.Sprintf("Trigger %d already has a pending run, skipping...", trigger.Id))
+			continue
+		}
+		handled[trigger.Id] = true
 		k.Logger(ctx).Debug(fmt.Sprintf("Trigger %d added to queue", trigger.Id))
 		k.emitTriggerDetected(ctx, trigger)
 		k.UnregisterTrigger(ctx, trigger)
 		k.QueueTrigger(ctx, trigger)
+		k.registerNextRun(ctx, trigger)
+	}
+}
+
+// getABCIEventHistory Gets all the events that have been emitted in the current block.
+func (k Keeper) getABCIEventHistory(ctx sdk.Context) []abci.Event {
+	abciEventHistory, ok := ctx.EventManager().(sdk.EventManagerWithHistoryI)
+	if !ok {
+		panic("event manager does not implement EventManagerWithHistoryI")
 	}
+	return abciEventHistory.GetABCIEventHistory()
 }
 
 // detectTransactionEvents Detects triggers that have been activated by transaction events.
@@ -29,19 +54,18 @@ func (k Keeper) detectTransactionEvents(ctx sdk.Context) (triggers []types.Trigg
 		return false
 	}
 
-	abciEventHistory, ok := ctx.EventManager().(sdk.EventManagerWithHistoryI)
-	if !ok {
-		panic("event manager does not implement EventManagerWithHistoryI")
-	}
-
-	for _, event := range abciEventHistory.GetABCIEventHistory() {
+	for _, event := range k.getABCIEventHistory(ctx) {
 		matched := k.getMatchingTriggersUntil(ctx, event.GetType(), func(trigger types.Trigger, triggerEvent types.TriggerEventI) bool {
-			if _, isDetected := detectedTriggers[trigger.Id]; isDetected {
+			if detectedTriggers[trigger.Id] {
 				return false
 			}
 			txEvent := triggerEvent.(*types.TransactionEvent)
 			detected := txEvent.Matches(event)
-			detectedTriggers[trigger.Id] = detected
+			// A trigger without attribute operators is only checked against the first event of its type in the block.
+			// A trigger with them is checked against each event of its type until one matches.
+			if detected || !txEvent.UsesOperators() {
+				detectedTriggers[trigger.Id] = true
+			}
 			return detected
 		}, terminator)
 		triggers = append(triggers, matched...)
@@ -81,6 +105,129 @@ func (k Keeper) detectTimeEvents(ctx sdk.Context) (triggers []types.Trigger) {
 	return
 }
 
+// detectRecurringEvents Detects triggers that have been activated by recurring events.
+func (k Keeper) detectRecurringEvents(ctx sdk.Context) (triggers []types.Trigger) {
+	match := func(_ types.Trigger, triggerEvent types.TriggerEventI) bool {
+		return triggerEvent.(*types.RecurringEvent).IsReady(ctx)
+	}
+	terminator := func(_ types.Trigger, triggerEvent types.TriggerEventI) bool {
+		return !triggerEvent.(*types.RecurringEvent).IsReady(ctx)
+	}
+
+	triggers = k.getMatchingTriggersUntil(ctx, types.RecurringBlockHeightPrefix, match, terminator)
+	triggers = append(triggers, k.getMatchingTriggersUntil(ctx, types.RecurringBlockTimePrefix, match, terminator)...)
+	return
+}
+
+// detectCompoundEvents Detects triggers that have been activated by compound events.
+// Only the triggers listened for by one of the current block's transaction event types, or by a block height
+// or time that has been reached, are checked. Only the events emitted in the current block are checked against
+// their transaction events.
+func (k Keeper) detectCompoundEvents(ctx sdk.Context) (triggers []types.Trigger) {
+	var eventTypes []string
+	history := make(map[string][]abci.Event)
+	for _, event := range k.getABCIEventHistory(ctx) {
+		if _, seen := history[event.GetType()]; !seen {
+			eventTypes = append(eventTypes, event.GetType())
+		}
+		history[event.GetType()] = append(history[event.GetType()], event)
+	}
+
+	checked := make(map[types.TriggerID]bool)
+	check := func(trigger types.Trigger) (stop bool, err error) {
+		if checked[trigger.Id] {
+			return false, nil
+		}
+		checked[trigger.Id] = true
+		event, _ := trigger.GetTriggerEventI()
+		if compoundEvent, ok := event.(*types.CompoundEvent); ok && isCompoundEventSatisfied(ctx, compoundEvent, history) {
+			k.Logger(ctx).Debug(fmt.Sprintf("Event detected for trigger %d", trigger.Id))
+			triggers = append(triggers, trigger)
+		}
+		return false, nil
+	}
+
+	var err error
+	for _, eventType := range eventTypes {
+		if err = k.IterateCompoundEventListeners(ctx, eventType, 0, check); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = k.IterateCompoundEventListeners(ctx, types.BlockHeightPrefix, uint64(ctx.BlockHeight()), check)
+	}
+	if err == nil {
+		err = k.IterateCompoundEventListeners(ctx, types.BlockTimePrefix, uint64(ctx.BlockTime().UnixNano()), check)
+	}
+	if err != nil {
+		panic(fmt.Errorf("unable to iterate compound event listeners for matching triggers: %w", err))
+	}
+
+	// They're found in the order of the events they're listened for by, but should be handled in the order they were created.
+	sort.Slice(triggers, func(i, j int) bool { return triggers[i].Id < triggers[j].Id })
+	return
+}
+
+// isCompoundEventSatisfied Checks if all (AND) or any (OR) of a compound event's events are satisfied in the current block.
+// The history is the current block's events, keyed by their type.
+func isCompoundEventSatisfied(ctx sdk.Context, compoundEvent *types.CompoundEvent, history map[string][]abci.Event) bool {
+	events, err := compoundEvent.GetTriggerEvents()
+	if err != nil {
+		return false
+	}
+	for _, event := range events {
+		satisfied := isEventSatisfied(ctx, event, history)
+		if compoundEvent.Operator == types.CompoundOperator_COMPOUND_OPERATOR_OR && satisfied {
+			return true
+		}
+		if compoundEvent.Operator == types.CompoundOperator_COMPOUND_OPERATOR_AND && !satisfied {
+			return false
+		}
+	}
+	return compoundEvent.Operator == types.CompoundOperator_COMPOUND_OPERATOR_AND
+}
+
+// isEventSatisfied Checks if a block height, block time, or transaction event is satisfied in the current block.
+// The history is the current block's events, keyed by their type.
+func isEventSatisfied(ctx sdk.Context, event types.TriggerEventI, history map[string][]abci.Event) bool {
+	switch e := event.(type) {
+	case *types.BlockHeightEvent:
+		return uint64(ctx.BlockHeight()) >= e.GetBlockHeight()
+	case *types.BlockTimeEvent:
+		return !ctx.BlockTime().UTC().Before(e.GetTime().UTC())
+	case *types.TransactionEvent:
+		for _, abciEvent := range history[e.GetName()] {
+			if e.Matches(abciEvent) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// registerNextRun Registers a recurring trigger again for its next run, keeping its id, actions, and gas limit.
+// Nothing is done if the trigger isn't recurring or shouldn't run again.
+func (k Keeper) registerNextRun(ctx sdk.Context, trigger types.Trigger) {
+	event, _ := trigger.GetTriggerEventI()
+	recurringEvent, ok := event.(*types.RecurringEvent)
+	if !ok {
+		return
+	}
+	next := recurringEvent.NextRun(ctx)
+	if next == nil {
+		return
+	}
+
+	eventAny, err := codectypes.NewAnyWithValue(next)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("unable to register next run of trigger %d", trigger.Id), "err", err)
+		return
+	}
+	trigger.Event = eventAny
+	k.SetTrigger(ctx, trigger)
+	k.SetEventListener(ctx, trigger)
+}
+
 // getMatchingTriggersUntil Gets the triggers with a specified prefix that are ready to be activated and fulfill the given condition until a specific ending condition is reached.
 func (k Keeper) getMatchingTriggersUntil(ctx sdk.Context, prefix string, match func(types.Trigger, types.TriggerEventI) bool, terminator func(types.Trigger, types.TriggerEventI) bool) (triggers []types.Trigger) {
 	err := k.IterateEventListeners(ctx, prefix, func(trigger types.Trigger) (stop bool, err error) {
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -298,3 +299,224 @@ func (s *KeeperTestSuite) TestDetectBlockEvents() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestDetectRecurringEvents() {
+	owner := s.accountAddresses[0].String()
+	now := s.ctx.BlockTime()
+	action := &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner}
+	register := func(trigger types.Trigger) {
+		s.app.TriggerKeeper.SetTrigger(s.ctx, trigger)
+		s.app.TriggerKeeper.SetEventListener(s.ctx, trigger)
+		s.app.TriggerKeeper.SetGasLimit(s.ctx, trigger.Id, 1000)
+	}
+	getEvent := func(id uint64) *types.RecurringEvent {
+		trigger, err := s.app.TriggerKeeper.GetTrigger(s.ctx, id)
+		s.Require().NoError(err, "GetTrigger(%d)", id)
+		event, err := trigger.GetTriggerEventI()
+		s.Require().NoError(err, "GetTriggerEventI(%d)", id)
+		_, err = s.app.TriggerKeeper.GetEventListener(s.ctx, event.GetEventPrefix(), event.GetEventOrder(), id)
+		s.Require().NoError(err, "GetEventListener(%d)", id)
+		return event.(*types.RecurringEvent)
+	}
+	queuedIDs := func() []uint64 {
+		var ids []uint64
+		for !s.app.TriggerKeeper.QueueIsEmpty(s.ctx) {
+			ids = append(ids, s.app.TriggerKeeper.QueuePeek(s.ctx).Trigger.Id)
+			s.app.TriggerKeeper.Dequeue(s.ctx)
+		}
+		return ids
+	}
+
+	register(s.CreateTrigger(1, owner, &types.RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: 3}, action))
+	register(s.CreateTrigger(2, owner, &types.RecurringEvent{TimeInterval: time.Hour, NextTime: now.Add(-90 * time.Minute)}, action))
+	register(s.CreateTrigger(3, owner, &types.RecurringEvent{BlockInterval: 5, NextBlockHeight: 101}, action))
+
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	s.Equal([]uint64{1, 2}, queuedIDs(), "queued triggers at height 100")
+	s.Equal(&types.RecurringEvent{BlockInterval: 10, NextBlockHeight: 110, MaxRuns: 3, Runs: 1}, getEvent(1), "trigger 1 event after height 100")
+	s.Equal(&types.RecurringEvent{TimeInterval: time.Hour, NextTime: now.Add(30 * time.Minute), Runs: 1}, getEvent(2), "trigger 2 event after height 100")
+	s.Equal(&types.RecurringEvent{BlockInterval: 5, NextBlockHeight: 101}, getEvent(3), "trigger 3 event after height 100")
+	s.Equal(uint64(1000), s.app.TriggerKeeper.GetGasLimit(s.ctx, 1), "trigger 1 gas limit after height 100")
+
+	s.ctx = s.ctx.WithBlockHeight(110)
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	s.Equal([]uint64{3, 1}, queuedIDs(), "queued triggers at height 110")
+	s.Equal(&types.RecurringEvent{BlockInterval: 10, NextBlockHeight: 120, MaxRuns: 3, Runs: 2}, getEvent(1), "trigger 1 event after height 110")
+	s.Equal(&types.RecurringEvent{BlockInterval: 5, NextBlockHeight: 111, Runs: 1}, getEvent(3), "trigger 3 event after height 110")
+
+	s.ctx = s.ctx.WithBlockHeight(120)
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	s.Equal([]uint64{3, 1}, queuedIDs(), "queued triggers at height 120")
+	_, err := s.app.TriggerKeeper.GetTrigger(s.ctx, 1)
+	s.Error(err, "GetTrigger(1) after max runs reached")
+	s.Equal(&types.RecurringEvent{BlockInterval: 5, NextBlockHeight: 121, Runs: 2}, getEvent(3), "trigger 3 event after height 120")
+}
+
+func (s *KeeperTestSuite) TestDetectRecurringEventsWithPendingRun() {
+	owner := s.accountAddresses[0].String()
+	action := &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner}
+	trigger := s.CreateTrigger(1, owner, &types.RecurringEvent{BlockInterval: 5, NextBlockHeight: 100}, action)
+	s.app.TriggerKeeper.SetTrigger(s.ctx, trigger)
+	s.app.TriggerKeeper.SetEventListener(s.ctx, trigger)
+	s.app.TriggerKeeper.SetGasLimit(s.ctx, trigger.Id, 1000)
+	getEvent := func() *types.RecurringEvent {
+		registered, err := s.app.TriggerKeeper.GetTrigger(s.ctx, trigger.Id)
+		s.Require().NoError(err, "GetTrigger")
+		event, err := registered.GetTriggerEventI()
+		s.Require().NoError(err, "GetTriggerEventI")
+		return event.(*types.RecurringEvent)
+	}
+	queueLen := func() int {
+		items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+		s.Require().NoError(err, "GetAllQueueItems")
+		return len(items)
+	}
+
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	s.Equal(1, queueLen(), "queue length at height 100")
+	s.Equal(&types.RecurringEvent{BlockInterval: 5, NextBlockHeight: 105, Runs: 1}, getEvent(), "event after height 100")
+
+	// The first run hasn't been processed yet, so the trigger isn't queued again.
+	s.ctx = s.ctx.WithBlockHeight(105)
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	s.Equal(1, queueLen(), "queue length at height 105")
+	s.Equal(&types.RecurringEvent{BlockInterval: 5, NextBlockHeight: 105, Runs: 1}, getEvent(), "event after height 105")
+
+	s.app.TriggerKeeper.Dequeue(s.ctx)
+	s.ctx = s.ctx.WithBlockHeight(106)
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	s.Equal(1, queueLen(), "queue length at height 106")
+	s.Equal(&types.RecurringEvent{BlockInterval: 5, NextBlockHeight: 110, Runs: 2}, getEvent(), "event after height 106")
+}
+
+func (s *KeeperTestSuite) TestDetectCompoundEvents() {
+	owner := s.accountAddresses[0].String()
+	action := &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner}
+	compound := func(operator types.CompoundOperator, events ...types.TriggerEventI) *types.CompoundEvent {
+		rv := &types.CompoundEvent{Operator: operator}
+		for _, event := range events {
+			anyEvent, err := codectypes.NewAnyWithValue(event)
+			s.Require().NoError(err, "NewAnyWithValue")
+			rv.Events = append(rv.Events, anyEvent)
+		}
+		return rv
+	}
+	matching := &types.TransactionEvent{Name: "event1", Attributes: []types.Attribute{{Name: "key1", Value: "value1"}}}
+	notMatching := &types.TransactionEvent{Name: "event1", Attributes: []types.Attribute{{Name: "key1", Value: "value2"}}}
+	reached := &types.BlockHeightEvent{BlockHeight: 100}
+	notReached := &types.BlockHeightEvent{BlockHeight: 101}
+	passed := &types.BlockTimeEvent{Time: s.ctx.BlockTime()}
+
+	triggers := []types.Trigger{
+		s.CreateTrigger(1, owner, compound(types.CompoundOperator_COMPOUND_OPERATOR_AND, reached, matching, passed), action),
+		s.CreateTrigger(2, owner, compound(types.CompoundOperator_COMPOUND_OPERATOR_AND, reached, notMatching), action),
+		s.CreateTrigger(3, owner, compound(types.CompoundOperator_COMPOUND_OPERATOR_OR, notReached, matching), action),
+		s.CreateTrigger(4, owner, compound(types.CompoundOperator_COMPOUND_OPERATOR_OR, notReached, notMatching), action),
+		s.CreateTrigger(5, owner, compound(types.CompoundOperator_COMPOUND_OPERATOR_AND, reached, &types.BlockHeightEvent{BlockHeight: 99}), action),
+		s.CreateTrigger(6, owner, compound(types.CompoundOperator_COMPOUND_OPERATOR_OR, notReached, passed), action),
+		s.CreateTrigger(7, owner, compound(types.CompoundOperator_COMPOUND_OPERATOR_AND, reached, notReached), action),
+	}
+	for _, trigger := range triggers {
+		s.app.TriggerKeeper.SetTrigger(s.ctx, trigger)
+		s.app.TriggerKeeper.SetEventListener(s.ctx, trigger)
+	}
+
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+
+	items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+	s.Require().NoError(err, "GetAllQueueItems")
+	var queued []uint64
+	for _, item := range items {
+		queued = append(queued, item.Trigger.Id)
+	}
+	s.Equal([]uint64{1, 3, 5, 6}, queued, "queued triggers after DetectBlockEvents")
+
+	registered, err := s.app.TriggerKeeper.GetAllTriggers(s.ctx)
+	s.Require().NoError(err, "GetAllTriggers")
+	s.Equal([]types.Trigger{triggers[1], triggers[3], triggers[6]}, registered, "registered triggers after DetectBlockEvents")
+}
+
+func (s *KeeperTestSuite) TestDetectTransactionEventPredicates() {
+	owner := s.accountAddresses[0].String()
+	recipient := s.accountAddresses[1].String()
+	action := &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner}
+	transfer := func(amount string) sdk.Event {
+		return sdk.NewEvent("transfer",
+			sdk.NewAttribute("recipient", recipient),
+			sdk.NewAttribute("sender", owner),
+			sdk.NewAttribute("amount", amount),
+		)
+	}
+	transferOver := func(amount string) *types.TransactionEvent {
+		return &types.TransactionEvent{Name: "transfer", Attributes: []types.Attribute{
+			{Name: "recipient", Value: recipient},
+			{Name: "amount", Value: amount, Operator: types.AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT},
+		}}
+	}
+
+	triggers := []types.Trigger{
+		s.CreateTrigger(1, owner, transferOver("1000000pm.sale.pool"), action),
+		s.CreateTrigger(2, owner, transferOver("5000000pm.sale.pool"), action),
+		s.CreateTrigger(3, owner, &types.TransactionEvent{Name: "transfer", Attributes: []types.Attribute{
+			{Name: "recipient", Value: recipient[:10], Operator: types.AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX},
+		}}, action),
+	}
+	for _, trigger := range triggers {
+		s.app.TriggerKeeper.SetTrigger(s.ctx, trigger)
+		s.app.TriggerKeeper.SetEventListener(s.ctx, trigger)
+	}
+	history := sdk.Events{
+		transfer("10nhash"),
+		transfer("10nhash,2000000pm.sale.pool"),
+	}
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManagerWithHistory(history.ToABCIEvents()))
+
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+
+	items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+	s.Require().NoError(err, "GetAllQueueItems")
+	var queued []uint64
+	for _, item := range items {
+		queued = append(queued, item.Trigger.Id)
+	}
+	s.ElementsMatch([]uint64{1, 3}, queued, "queued triggers after DetectBlockEvents")
+
+	registered, err := s.app.TriggerKeeper.GetAllTriggers(s.ctx)
+	s.Require().NoError(err, "GetAllTriggers")
+	s.Equal([]types.Trigger{triggers[1]}, registered, "registered triggers after DetectBlockEvents")
+}
+
+func (s *KeeperTestSuite) TestDetectTransactionEventWithoutOperators() {
+	owner := s.accountAddresses[0].String()
+	recipient := s.accountAddresses[1].String()
+	action := &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner}
+
+	triggers := []types.Trigger{
+		s.CreateTrigger(1, owner, &types.TransactionEvent{Name: "transfer", Attributes: []types.Attribute{
+			{Name: "recipient", Value: recipient},
+		}}, action),
+		s.CreateTrigger(2, owner, &types.TransactionEvent{Name: "transfer", Attributes: []types.Attribute{
+			{Name: "recipient", Value: recipient, Operator: types.AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX},
+		}}, action),
+	}
+	for _, trigger := range triggers {
+		s.app.TriggerKeeper.SetTrigger(s.ctx, trigger)
+		s.app.TriggerKeeper.SetEventListener(s.ctx, trigger)
+	}
+	history := sdk.Events{
+		sdk.NewEvent("transfer", sdk.NewAttribute("recipient", owner)),
+		sdk.NewEvent("transfer", sdk.NewAttribute("recipient", recipient)),
+	}
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManagerWithHistory(history.ToABCIEvents()))
+
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+
+	items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+	s.Require().NoError(err, "GetAllQueueItems")
+	var queued []uint64
+	for _, item := range items {
+		queued = append(queued, item.Trigger.Id)
+	}
+	// Only the first transfer event is checked for a trigger without operators.
+	s.Equal([]uint64{2}, queued, "queued triggers after DetectBlockEvents")
+}
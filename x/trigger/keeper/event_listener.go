@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"math"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -13,22 +14,41 @@ import (
 // SetEventListener Adds the trigger to the event listener store.
 func (k Keeper) SetEventListener(ctx sdk.Context, trigger triggertypes.Trigger) {
 	store := ctx.KVStore(k.storeKey)
-	event, _ := trigger.GetTriggerEventI()
-	store.Set(triggertypes.GetEventListenerKey(event.GetEventPrefix(), event.GetEventOrder(), trigger.GetId()), []byte{})
+	for _, key := range getEventListenerKeys(trigger) {
+		store.Set(key, []byte{})
+	}
 }
 
 // RemoveEventListener Removes the trigger from the event listener store.
 func (k Keeper) RemoveEventListener(ctx sdk.Context, trigger triggertypes.Trigger) bool {
 	store := ctx.KVStore(k.storeKey)
-	event, _ := trigger.GetTriggerEventI()
-	key := triggertypes.GetEventListenerKey(event.GetEventPrefix(), event.GetEventOrder(), trigger.GetId())
-	keyExists := store.Has(key)
-	if keyExists {
-		store.Delete(key)
+	keyExists := false
+	for _, key := range getEventListenerKeys(trigger) {
+		if store.Has(key) {
+			store.Delete(key)
+			keyExists = true
+		}
 	}
 	return keyExists
 }
 
+// getEventListenerKeys Gets the event listener keys of a trigger. A trigger with a CompoundEvent has a compound
+// event listener key for each event it is listened for by. Any other trigger has a single event listener key.
+func getEventListenerKeys(trigger triggertypes.Trigger) [][]byte {
+	event, _ := trigger.GetTriggerEventI()
+	compoundEvent, ok := event.(*triggertypes.CompoundEvent)
+	if !ok {
+		return [][]byte{triggertypes.GetEventListenerKey(event.GetEventPrefix(), event.GetEventOrder(), trigger.GetId())}
+	}
+
+	events, _ := compoundEvent.GetListenerEvents()
+	keys := make([][]byte, 0, len(events))
+	for _, listenerEvent := range events {
+		keys = append(keys, triggertypes.GetCompoundEventListenerKey(listenerEvent.GetEventPrefix(), listenerEvent.GetEventOrder(), trigger.GetId()))
+	}
+	return keys
+}
+
 // GetEventListener Gets the event listener from the store.
 func (k Keeper) GetEventListener(ctx sdk.Context, eventName string, order uint64, triggerID triggertypes.TriggerID) (trigger triggertypes.Trigger, err error) {
 	store := ctx.KVStore(k.storeKey)
@@ -43,7 +63,21 @@ func (k Keeper) GetEventListener(ctx sdk.Context, eventName string, order uint64
 func (k Keeper) IterateEventListeners(ctx sdk.Context, eventName string, handle func(trigger triggertypes.Trigger) (stop bool, err error)) error {
 	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, triggertypes.GetEventListenerPrefix(eventName))
+	return k.iterateEventListenerKeys(ctx, iterator, handle)
+}
+
+// IterateCompoundEventListeners Iterates through the compound event listeners of an event name, in order,
+// up to and including the ones with the provided max order.
+func (k Keeper) IterateCompoundEventListeners(ctx sdk.Context, eventName string, maxOrder uint64, handle func(trigger triggertypes.Trigger) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	start := triggertypes.GetCompoundEventListenerPrefix(eventName)
+	end := storetypes.PrefixEndBytes(triggertypes.GetCompoundEventListenerKey(eventName, maxOrder, math.MaxUint64))
+	iterator := store.Iterator(start, end)
+	return k.iterateEventListenerKeys(ctx, iterator, handle)
+}
 
+// iterateEventListenerKeys Iterates through the event listener keys of an iterator, and closes it when done.
+func (k Keeper) iterateEventListenerKeys(ctx sdk.Context, iterator storetypes.Iterator, handle func(trigger triggertypes.Trigger) (stop bool, err error)) error {
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		triggerID := binary.BigEndian.Uint64(iterator.Key()[41:49])
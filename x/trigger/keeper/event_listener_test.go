@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -169,3 +171,61 @@ func (s *KeeperTestSuite) TestRemoveEventListener() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCompoundEventListeners() {
+	owner := s.accountAddresses[0].String()
+	actions, _ := sdktx.SetMsgs([]sdk.Msg{&types.MsgDestroyTriggerRequest{Id: 1, Authority: owner}})
+	newCompoundTrigger := func(id types.TriggerID, operator types.CompoundOperator, events ...types.TriggerEventI) types.Trigger {
+		compound := &types.CompoundEvent{Operator: operator}
+		for _, event := range events {
+			anyEvent, err := codectypes.NewAnyWithValue(event)
+			s.Require().NoError(err, "NewAnyWithValue")
+			compound.Events = append(compound.Events, anyEvent)
+		}
+		eventAny, err := codectypes.NewAnyWithValue(compound)
+		s.Require().NoError(err, "NewAnyWithValue")
+		return types.NewTrigger(id, owner, eventAny, actions)
+	}
+	later := s.ctx.BlockTime().Add(time.Hour)
+
+	heights := newCompoundTrigger(1, types.CompoundOperator_COMPOUND_OPERATOR_AND,
+		&types.BlockHeightEvent{BlockHeight: 100}, &types.BlockHeightEvent{BlockHeight: 200})
+	withTx := newCompoundTrigger(2, types.CompoundOperator_COMPOUND_OPERATOR_AND,
+		&types.BlockHeightEvent{BlockHeight: 100}, &types.TransactionEvent{Name: "event1"})
+	either := newCompoundTrigger(3, types.CompoundOperator_COMPOUND_OPERATOR_OR,
+		&types.BlockHeightEvent{BlockHeight: 150}, &types.BlockTimeEvent{Time: later})
+	for _, trigger := range []types.Trigger{heights, withTx, either} {
+		s.app.TriggerKeeper.SetTrigger(s.ctx, trigger)
+		s.app.TriggerKeeper.SetEventListener(s.ctx, trigger)
+	}
+
+	getIDs := func(eventName string, maxOrder uint64) []types.TriggerID {
+		var ids []types.TriggerID
+		err := s.app.TriggerKeeper.IterateCompoundEventListeners(s.ctx, eventName, maxOrder, func(trigger types.Trigger) (stop bool, err error) {
+			ids = append(ids, trigger.Id)
+			return false, nil
+		})
+		s.Require().NoError(err, "IterateCompoundEventListeners(%q, %d)", eventName, maxOrder)
+		return ids
+	}
+
+	s.Nil(getIDs(types.BlockHeightPrefix, 149), "block height listeners up to 149")
+	s.Equal([]types.TriggerID{3}, getIDs(types.BlockHeightPrefix, 150), "block height listeners up to 150")
+	s.Equal([]types.TriggerID{3, 1}, getIDs(types.BlockHeightPrefix, 200), "block height listeners up to 200")
+	s.Equal([]types.TriggerID{2}, getIDs("event1", 0), "event1 listeners")
+	s.Nil(getIDs(types.BlockTimePrefix, uint64(later.UnixNano())-1), "block time listeners before later")
+	s.Equal([]types.TriggerID{3}, getIDs(types.BlockTimePrefix, uint64(later.UnixNano())), "block time listeners up to later")
+
+	count := 0
+	err := s.app.TriggerKeeper.IterateEventListeners(s.ctx, types.BlockHeightPrefix, func(_ types.Trigger) (stop bool, err error) {
+		count++
+		return false, nil
+	})
+	s.Require().NoError(err, "IterateEventListeners")
+	s.Equal(0, count, "single block height listeners")
+
+	s.True(s.app.TriggerKeeper.RemoveEventListener(s.ctx, either), "RemoveEventListener")
+	s.False(s.app.TriggerKeeper.RemoveEventListener(s.ctx, either), "RemoveEventListener again")
+	s.Equal([]types.TriggerID{1}, getIDs(types.BlockHeightPrefix, 200), "block height listeners after removing a trigger")
+	s.Nil(getIDs(types.BlockTimePrefix, uint64(later.UnixNano())), "block time listeners after removing a trigger")
+}
@@ -33,16 +33,24 @@ func (k Keeper) RemoveGasLimit(ctx sdk.Context, id types.TriggerID) bool {
 
 // GetGasLimit Gets a gas limit by id
 func (k Keeper) GetGasLimit(ctx sdk.Context, id types.TriggerID) (gasLimit uint64) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetGasLimitKey(id))
-	if bz == nil {
+	gasLimit, found := k.getGasLimit(ctx, id)
+	if !found {
 		// Something is seriously wrong because every trigger should have a gas limit
 		panic("gas limit not found for trigger")
 	}
-	gasLimit = types.GetGasLimitFromBytes(bz)
 	return
 }
 
+// getGasLimit Gets a gas limit by id. The returned boolean is false if the trigger does not have a gas limit.
+func (k Keeper) getGasLimit(ctx sdk.Context, id types.TriggerID) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGasLimitKey(id))
+	if bz == nil {
+		return 0, false
+	}
+	return types.GetGasLimitFromBytes(bz), true
+}
+
 // IterateGasLimits Iterates through all the gas limits.
 func (k Keeper) IterateGasLimits(ctx sdk.Context, handle func(gasLimit types.GasLimit) (stop bool, err error)) error {
 	store := ctx.KVStore(k.storeKey)
@@ -58,6 +58,7 @@ func (s msgServer) DestroyTrigger(goCtx context.Context, msg *types.MsgDestroyTr
 		return nil, types.ErrInvalidTriggerAuthority
 	}
 	s.UnregisterTrigger(ctx, trigger)
+	s.removeQueuedTrigger(ctx, trigger.GetId())
 	s.RemoveGasLimit(ctx, trigger.GetId())
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventTriggerDestroyed{
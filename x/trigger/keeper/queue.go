@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -58,6 +60,40 @@ func (k Keeper) GetAllQueueItems(ctx sdk.Context) (items []types.QueuedTrigger,
 	return
 }
 
+// getQueuedTriggerIDs Gets the ids of all the triggers that are in the queue.
+func (k Keeper) getQueuedTriggerIDs(ctx sdk.Context) map[types.TriggerID]bool {
+	ids := make(map[types.TriggerID]bool)
+	err := k.iterateQueue(ctx, func(item types.QueuedTrigger) (stop bool, err error) {
+		ids[item.Trigger.Id] = true
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("unable to iterate queue: %w", err))
+	}
+	return ids
+}
+
+// removeQueuedTrigger Removes all the queued runs of a trigger, keeping the rest of the queue in order.
+func (k Keeper) removeQueuedTrigger(ctx sdk.Context, id types.TriggerID) {
+	length := k.getQueueLength(ctx)
+	index := k.getQueueStartIndex(ctx)
+	var kept uint64
+	for i := index; i < index+length; i++ {
+		item := k.getQueueItem(ctx, i)
+		if item.Trigger.Id == id {
+			continue
+		}
+		if i != index+kept {
+			k.setQueueItem(ctx, index+kept, item)
+		}
+		kept++
+	}
+	for i := index + kept; i < index+length; i++ {
+		k.removeQueueIndex(ctx, i)
+	}
+	k.setQueueLength(ctx, kept)
+}
+
 // getQueueItem Gets an item from the queue's store.
 func (k Keeper) getQueueItem(ctx sdk.Context, index uint64) (trigger types.QueuedTrigger) {
 	store := ctx.KVStore(k.storeKey)
@@ -26,7 +26,13 @@ func (k Keeper) ProcessTriggers(ctx sdk.Context) {
 	for !k.QueueIsEmpty(ctx) && actionsProcessed < MaximumActions {
 		item := k.QueuePeek(ctx)
 		triggerID := item.GetTrigger().Id
-		gasLimit := k.GetGasLimit(ctx, triggerID)
+		gasLimit, found := k.getGasLimit(ctx, triggerID)
+		if !found || item.GetTrigger().Event == nil {
+			// There's nothing to run it with, so it's dropped without counting against the block's limits.
+			k.Logger(ctx).Error(fmt.Sprintf("Trigger %d or its gas limit not found, skipping...", triggerID))
+			k.Dequeue(ctx)
+			continue
+		}
 		k.Logger(ctx).Debug(fmt.Sprintf("Processing trigger %d with gas limit %d", triggerID, gasLimit))
 
 		if gasLimit+gasConsumed > MaximumQueueGas {
@@ -37,7 +43,10 @@ func (k Keeper) ProcessTriggers(ctx sdk.Context) {
 		gasConsumed += gasLimit
 
 		k.Dequeue(ctx)
-		k.RemoveGasLimit(ctx, triggerID)
+		// A recurring trigger that has been registered again keeps its gas limit for its next run.
+		if _, err := k.GetTrigger(ctx, triggerID); err != nil {
+			k.RemoveGasLimit(ctx, triggerID)
+		}
 
 		actions := item.GetTrigger().Actions
 		err := k.runActions(ctx, gasLimit, actions)
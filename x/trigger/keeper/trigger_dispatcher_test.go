@@ -78,8 +78,8 @@ func (s *KeeperTestSuite) TestProcessTriggers() {
 			blockGas: 2000000,
 		},
 		{
-			name:  "invalid - trigger with missing gas limit",
-			panic: "gas limit not found for trigger",
+			name:     "valid - trigger with missing gas limit is skipped",
+			existing: []types.Trigger{existing1},
 			queue: []types.QueuedTrigger{
 				{
 					BlockHeight: uint64(s.ctx.BlockHeight()),
@@ -312,3 +312,61 @@ func (s *KeeperTestSuite) TestProcessTriggers() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestProcessTriggersKeepsRecurringGasLimit() {
+	owner := s.accountAddresses[0].String()
+	recurring := s.CreateTrigger(1, owner, &types.RecurringEvent{BlockInterval: 10, NextBlockHeight: 100}, &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner})
+	oneShot := s.CreateTrigger(2, owner, &types.BlockHeightEvent{BlockHeight: 100}, &types.MsgDestroyTriggerRequest{Id: 101, Authority: owner})
+	for _, trigger := range []types.Trigger{recurring, oneShot} {
+		s.app.TriggerKeeper.SetTrigger(s.ctx, trigger)
+		s.app.TriggerKeeper.SetEventListener(s.ctx, trigger)
+		s.app.TriggerKeeper.SetGasLimit(s.ctx, trigger.Id, 1000000)
+	}
+
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.ctx = s.ctx.WithBlockGasMeter(storetypes.NewGasMeter(60000000))
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+
+	s.True(s.app.TriggerKeeper.QueueIsEmpty(s.ctx), "queue should be empty after ProcessTriggers")
+	gasLimits, err := s.app.TriggerKeeper.GetAllGasLimits(s.ctx)
+	s.Require().NoError(err, "GetAllGasLimits")
+	s.Equal([]types.GasLimit{{TriggerId: recurring.Id, Amount: 1000000}}, gasLimits, "should only keep the gas limit of the recurring trigger")
+	_, err = s.app.TriggerKeeper.GetTrigger(s.ctx, recurring.Id)
+	s.NoError(err, "recurring trigger should still be registered after ProcessTriggers")
+}
+
+func (s *KeeperTestSuite) TestProcessTriggersDestroyedRecurringTrigger() {
+	owner := s.accountAddresses[0].String()
+	oneShot1 := s.CreateTrigger(1, owner, &types.BlockHeightEvent{BlockHeight: 100}, &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner})
+	recurring := s.CreateTrigger(2, owner, &types.RecurringEvent{BlockInterval: 10, NextBlockHeight: 100}, &types.MsgDestroyTriggerRequest{Id: 101, Authority: owner})
+	oneShot2 := s.CreateTrigger(3, owner, &types.BlockTimeEvent{Time: s.ctx.BlockTime()}, &types.MsgDestroyTriggerRequest{Id: 102, Authority: owner})
+	for _, trigger := range []types.Trigger{oneShot1, recurring, oneShot2} {
+		s.app.TriggerKeeper.SetTrigger(s.ctx, trigger)
+		s.app.TriggerKeeper.SetEventListener(s.ctx, trigger)
+		s.app.TriggerKeeper.SetGasLimit(s.ctx, trigger.Id, 1000)
+	}
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+
+	_, err := s.msgServer.DestroyTrigger(s.ctx, types.NewDestroyTriggerRequest(owner, recurring.Id))
+	s.Require().NoError(err, "DestroyTrigger")
+	items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+	s.Require().NoError(err, "GetAllQueueItems")
+	var queued []uint64
+	for _, item := range items {
+		queued = append(queued, item.Trigger.Id)
+	}
+	s.Equal([]uint64{oneShot1.Id, oneShot2.Id}, queued, "queued triggers after DestroyTrigger")
+	gasLimits, err := s.app.TriggerKeeper.GetAllGasLimits(s.ctx)
+	s.Require().NoError(err, "GetAllGasLimits")
+	s.Equal([]types.GasLimit{{TriggerId: oneShot1.Id, Amount: 1000}, {TriggerId: oneShot2.Id, Amount: 1000}}, gasLimits, "gas limits after DestroyTrigger")
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.ctx = s.ctx.WithBlockGasMeter(storetypes.NewGasMeter(60000000))
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+
+	s.True(s.app.TriggerKeeper.QueueIsEmpty(s.ctx), "queue should be empty after ProcessTriggers")
+	gasLimits, err = s.app.TriggerKeeper.GetAllGasLimits(s.ctx)
+	s.Require().NoError(err, "GetAllGasLimits")
+	s.Empty(gasLimits, "should not have any gas limits after the queued runs are processed")
+}
@@ -7,16 +7,21 @@ import (
 )
 
 // RegisterTrigger Adds the trigger to the trigger, event listener, and gas store
+// The gas limit is used for each of the trigger's runs, so the gas for all of them is consumed here.
 func (k Keeper) RegisterTrigger(ctx sdk.Context, trigger triggertypes.Trigger) {
 	k.SetTrigger(ctx, trigger)
 	k.SetEventListener(ctx, trigger)
 
-	gasLimit := ctx.GasMeter().GasRemaining() - SetGasLimitCost
+	runs := trigger.RemainingRuns()
+	if runs == 0 {
+		runs = 1
+	}
+	gasLimit := (ctx.GasMeter().GasRemaining() - SetGasLimitCost) / runs
 	if gasLimit > MaximumTriggerGas {
 		gasLimit = MaximumTriggerGas
 	}
 	k.SetGasLimit(ctx, trigger.GetId(), gasLimit)
-	ctx.GasMeter().ConsumeGas(gasLimit, "trigger creation")
+	ctx.GasMeter().ConsumeGas(gasLimit*runs, "trigger creation")
 }
 
 // UnregisterTrigger Removes the trigger from the trigger, and event listener
@@ -83,6 +83,24 @@ func (s *KeeperTestSuite) TestRegisterTrigger() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRegisterRecurringTrigger() {
+	owner := s.accountAddresses[0].String()
+	trigger := s.CreateTrigger(1, owner, &types.RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: 4}, &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner})
+
+	meter := storetypes.NewGasMeter(5000000)
+	s.ctx = s.ctx.WithGasMeter(meter)
+	s.app.TriggerKeeper.RegisterTrigger(s.ctx, trigger)
+	s.ctx = s.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	gasLimit := s.app.TriggerKeeper.GetGasLimit(s.ctx, trigger.Id)
+	s.Greater(gasLimit, uint64(0), "gas limit")
+	s.LessOrEqual(gasLimit, uint64(5000000/4), "gas limit should be split between the runs")
+	s.GreaterOrEqual(meter.GasConsumed(), 4*gasLimit, "gas consumed should cover every run")
+
+	s.app.TriggerKeeper.UnregisterTrigger(s.ctx, trigger)
+	s.app.TriggerKeeper.RemoveGasLimit(s.ctx, trigger.Id)
+}
+
 func (s *KeeperTestSuite) TestUnregisterTrigger() {
 	owner := s.accountAddresses[0].String()
 
@@ -29,6 +29,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &attribB)
 
 			return fmt.Sprintf("EventListener: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.CompoundEventListenerKeyPrefix):
+			var attribA, attribB types.Trigger
+
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+
+			return fmt.Sprintf("CompoundEventListener: A:[%v] B:[%v]\n", attribA, attribB)
 		case bytes.Equal(kvA.Key[:1], types.QueueKeyPrefix):
 			var attribA, attribB types.QueuedTrigger
 
@@ -17,6 +17,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&TransactionEvent{},
 		&BlockHeightEvent{},
 		&BlockTimeEvent{},
+		&RecurringEvent{},
+		&CompoundEvent{},
 	)
 
 	registry.RegisterInterface(
@@ -36,4 +38,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*TriggerEventI)(nil),
 		&BlockTimeEvent{},
 	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.RecurringEvent",
+		(*TriggerEventI)(nil),
+		&RecurringEvent{},
+	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.CompoundEvent",
+		(*TriggerEventI)(nil),
+		&CompoundEvent{},
+	)
 }
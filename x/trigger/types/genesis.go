@@ -35,15 +35,31 @@ func (gs GenesisState) Validate() error {
 	if gs.QueueStart == 0 {
 		return fmt.Errorf("invalid queue start")
 	}
-	if len(gs.Triggers)+len(gs.QueuedTriggers) != len(gs.GasLimits) {
-		return fmt.Errorf("gas limit list length must match sum of triggers and queued triggers length")
-	}
 
 	triggers := append([]Trigger{}, gs.Triggers...)
+	queued := make(map[uint64]bool)
 	for _, queuedTrigger := range gs.QueuedTriggers {
+		id := queuedTrigger.Trigger.Id
+		if queued[id] && queuedTrigger.Trigger.IsRecurring() {
+			return fmt.Errorf("recurring trigger id %d cannot be queued more than once", id)
+		}
+		queued[id] = true
 		triggers = append(triggers, queuedTrigger.GetTrigger())
 	}
 
+	// A recurring trigger can be both registered and queued, but it only has one gas limit.
+	expGasLimits := len(triggers)
+	seen := make(map[uint64]bool)
+	for _, trigger := range triggers {
+		if seen[trigger.GetId()] && trigger.IsRecurring() {
+			expGasLimits--
+		}
+		seen[trigger.GetId()] = true
+	}
+	if expGasLimits != len(gs.GasLimits) {
+		return fmt.Errorf("gas limit list length must match sum of triggers and queued triggers length")
+	}
+
 	gasLimitMap := make(map[uint64]bool)
 	for _, gasLimit := range gs.GasLimits {
 		if _, found := gasLimitMap[gasLimit.TriggerId]; found {
@@ -81,7 +97,7 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("trigger or queued trigger does not have a gas limit that matches it with id %d", trigger.GetId())
 		}
 
-		if _, found := triggerMap[trigger.GetId()]; found {
+		if _, found := triggerMap[trigger.GetId()]; found && !trigger.IsRecurring() {
 			return fmt.Errorf("trigger id %d is not unique within the set all triggers and queued triggers", trigger.GetId())
 		}
 		triggerMap[trigger.GetId()] = true
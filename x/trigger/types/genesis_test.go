@@ -39,6 +39,8 @@ func TestGenesisStateValidate(t *testing.T) {
 	badRequest := MustNewCreateTriggerRequest([]string{"addr"}, &TransactionEvent{Name: "", Attributes: []Attribute{}}, []types.Msg{&MsgDestroyTriggerRequest{Id: 1, Authority: ""}})
 	trigger := NewTrigger(1, "owner", request.Event, request.Actions)
 	trigger2 := NewTrigger(2, "owner", request.Event, request.Actions)
+	recurringRequest := MustNewCreateTriggerRequest([]string{"addr"}, &RecurringEvent{BlockInterval: 10, NextBlockHeight: 110, MaxRuns: 5, Runs: 1}, []types.Msg{&MsgDestroyTriggerRequest{Id: 1, Authority: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"}})
+	recurring := NewTrigger(1, "owner", recurringRequest.Event, recurringRequest.Actions)
 
 	tests := []struct {
 		name   string
@@ -274,6 +276,45 @@ func TestGenesisStateValidate(t *testing.T) {
 			modify: nil,
 			err:    "trigger id 1 is not unique within the set all triggers and queued triggers",
 		},
+		{
+			name: "valid - recurring trigger can be both registered and queued with one gas limit",
+			state: &GenesisState{
+				TriggerId:      1,
+				QueueStart:     1,
+				GasLimits:      []GasLimit{{TriggerId: 1, Amount: 1}},
+				Triggers:       []Trigger{recurring},
+				QueuedTriggers: []QueuedTrigger{{BlockHeight: 100, Time: time.Time{}, Trigger: recurring}},
+			},
+			modify: nil,
+			err:    "",
+		},
+		{
+			name: "invalid - recurring trigger both registered and queued needs a gas limit",
+			state: &GenesisState{
+				TriggerId:      1,
+				QueueStart:     1,
+				GasLimits:      []GasLimit{{TriggerId: 1, Amount: 1}, {TriggerId: 2, Amount: 1}},
+				Triggers:       []Trigger{recurring},
+				QueuedTriggers: []QueuedTrigger{{BlockHeight: 100, Time: time.Time{}, Trigger: recurring}},
+			},
+			modify: nil,
+			err:    "gas limit list length must match sum of triggers and queued triggers length",
+		},
+		{
+			name: "invalid - recurring trigger queued more than once",
+			state: &GenesisState{
+				TriggerId:  1,
+				QueueStart: 1,
+				GasLimits:  []GasLimit{{TriggerId: 1, Amount: 1}},
+				Triggers:   []Trigger{recurring},
+				QueuedTriggers: []QueuedTrigger{
+					{BlockHeight: 90, Time: time.Time{}, Trigger: recurring},
+					{BlockHeight: 100, Time: time.Time{}, Trigger: recurring},
+				},
+			},
+			modify: nil,
+			err:    "recurring trigger id 1 cannot be queued more than once",
+		},
 	}
 
 	for _, tc := range tests {
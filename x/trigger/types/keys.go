@@ -41,6 +41,12 @@ const (
 //   - 0x02<event_type_bytes><order_bytes><trigger_id_bytes>: []byte{}
 //     | 1 |       32       |      8      |        8        |
 //
+// The keys prefixed with 0x08 are used to quickly find the compound triggers that might be activated during the detection phase.
+// A compound trigger has one of these for each event that it is listened for by (see CompoundEvent.GetListenerEvents).
+// They have the same layout as the 0x02 keys, using that event's type name and order.
+//   - 0x08<event_type_bytes><order_bytes><trigger_id_bytes>: []byte{}
+//     | 1 |       32       |      8      |        8        |
+//
 // The key in this section is used to track gas limits for triggers.
 // The <trigger_id_bytes> are 8 bytes that match the trigger that the gas limit belongs to.
 //
@@ -74,6 +80,8 @@ var (
 	QueueStartIndexKey = []byte{0x06}
 	// QueueStartIndexKey is the key to obtain the queue's length
 	QueueLengthKey = []byte{0x07}
+	// CompoundEventListenerKeyPrefix is an initial byte to help group all compound event listener keys
+	CompoundEventListenerKeyPrefix = []byte{0x08}
 )
 
 // GetEventListenerKey converts an event name, order, and trigger ID into an event registry key format.
@@ -98,6 +106,29 @@ func GetEventListenerPrefix(eventName string) []byte {
 	return key
 }
 
+// GetCompoundEventListenerKey converts the name and order of an event that a compound trigger is listened for by,
+// and the trigger's ID, into a compound event registry key format.
+func GetCompoundEventListenerKey(eventName string, order uint64, id TriggerID) []byte {
+	triggerIDBytes := make([]byte, TriggerIDLength)
+	binary.BigEndian.PutUint64(triggerIDBytes, id)
+	orderBytes := make([]byte, EventOrderLength)
+	binary.BigEndian.PutUint64(orderBytes, order)
+
+	key := GetCompoundEventListenerPrefix(eventName)
+	key = append(key, orderBytes...)
+	key = append(key, triggerIDBytes...)
+	return key
+}
+
+// GetCompoundEventListenerPrefix converts an event name into a prefix for the compound event registry.
+func GetCompoundEventListenerPrefix(eventName string) []byte {
+	eventNameBytes := GetEventNameBytes(eventName)
+
+	key := CompoundEventListenerKeyPrefix
+	key = append(key, eventNameBytes...)
+	return key
+}
+
 // GetTriggerKey converts a trigger into key format.
 func GetTriggerKey(id TriggerID) []byte {
 	triggerIDBytes := make([]byte, TriggerIDLength)
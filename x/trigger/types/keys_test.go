@@ -31,6 +31,17 @@ func TestGetEventListenerPrefix(t *testing.T) {
 	assert.EqualValues(t, GetEventNameBytes("event"), key[1:33], "should receive correct name bytes for GetEventListenerPrefix")
 }
 
+func TestGetCompoundEventListenerKey(t *testing.T) {
+	key := GetCompoundEventListenerKey("event", 5, 1)
+
+	assert.EqualValues(t, CompoundEventListenerKeyPrefix, key[0:1], "should have correct prefix on GetCompoundEventListenerKey")
+	assert.EqualValues(t, GetEventNameBytes("event"), key[1:33], "should have correct name bytes in GetCompoundEventListenerKey")
+	assert.EqualValues(t, int(5), int(binary.BigEndian.Uint64(key[33:41])), "should have correct order bytes in GetCompoundEventListenerKey")
+	assert.EqualValues(t, int(1), int(binary.BigEndian.Uint64(key[41:49])), "should have correct trigger id bytes in GetCompoundEventListenerKey")
+	assert.EqualValues(t, GetCompoundEventListenerPrefix("event"), key[0:33], "should start with GetCompoundEventListenerPrefix")
+	assert.PanicsWithValue(t, "invalid event name: ", func() { GetCompoundEventListenerKey("", 2, 0) }, "should panic with error message when given invalid event name")
+}
+
 func TestGetTriggerKey(t *testing.T) {
 	key := GetTriggerKey(1)
 	assert.EqualValues(t, TriggerKeyPrefix, key[0:1], "should have correct prefix for GetTriggerKey")
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"regexp"
+	"sync"
+)
+
+// maxCachedAttributeRegexes is the number of compiled attribute regexes that are kept in memory.
+const maxCachedAttributeRegexes = 1000
+
+// attributeRegexes holds the compiled regexes of attributes that use ATTRIBUTE_OPERATOR_REGEX,
+// so that they aren't compiled again every time an event is checked against them.
+var attributeRegexes = &regexCache{regexes: make(map[string]*regexp.Regexp)}
+
+// regexCache is a size-limited cache of compiled regexes, keyed by their pattern.
+type regexCache struct {
+	mu      sync.Mutex
+	regexes map[string]*regexp.Regexp
+}
+
+// get returns the compiled version of the provided pattern, compiling it if it isn't already in the cache.
+func (c *regexCache) get(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	re, found := c.regexes[pattern]
+	c.mu.Unlock()
+	if found {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.regexes) >= maxCachedAttributeRegexes {
+		c.regexes = make(map[string]*regexp.Regexp)
+	}
+	c.regexes[pattern] = re
+	return re, nil
+}
@@ -2,9 +2,13 @@ package types
 
 import (
 	fmt "fmt"
+	"strconv"
 	"strings"
 	time "time"
 
+	cerrs "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,8 +20,22 @@ import (
 type TriggerID = uint64
 
 const (
-	BlockHeightPrefix = "block-height"
-	BlockTimePrefix   = "block-time"
+	BlockHeightPrefix          = "block-height"
+	BlockTimePrefix            = "block-time"
+	RecurringBlockHeightPrefix = "recurring-block-height"
+	RecurringBlockTimePrefix   = "recurring-block-time"
+	CompoundPrefix             = "compound"
+
+	// MaxCompoundEvents is the maximum number of events that a CompoundEvent can have.
+	MaxCompoundEvents = 10
+	// MinRecurringBlockInterval is the smallest block interval that a RecurringEvent can have.
+	MinRecurringBlockInterval = 5
+	// MinRecurringTimeInterval is the smallest time interval that a RecurringEvent can have.
+	MinRecurringTimeInterval = time.Minute
+	// MaxRecurringRuns is the most times that a RecurringEvent can fire.
+	MaxRecurringRuns = 100
+	// MaxAttributeRegexLength is the maximum length of an Attribute value used with ATTRIBUTE_OPERATOR_REGEX.
+	MaxAttributeRegexLength = 256
 )
 
 type TriggerEventI interface {
@@ -31,7 +49,10 @@ type TriggerEventI interface {
 var _ TriggerEventI = &TransactionEvent{}
 var _ TriggerEventI = &BlockHeightEvent{}
 var _ TriggerEventI = &BlockTimeEvent{}
+var _ TriggerEventI = &RecurringEvent{}
+var _ TriggerEventI = &CompoundEvent{}
 var _ codectypes.UnpackInterfacesMessage = (*Trigger)(nil)
+var _ codectypes.UnpackInterfacesMessage = (*CompoundEvent)(nil)
 var _ codectypes.UnpackInterfacesMessage = (*QueuedTrigger)(nil)
 
 // Matches checks if two TransactionEvents have the same type and matching attributes.
@@ -58,17 +79,113 @@ func (e TransactionEvent) Matches(other abci.Event) bool {
 	return true
 }
 
-// Matches checks if two Attributes have the same name and an equal value if one is specified.
+// Matches checks if two Attributes have the same name and a value that satisfies this Attribute's operator.
+// Without an operator, the values must be equal if one is specified.
 func (a Attribute) Matches(other abci.EventAttribute) bool {
 	if a.GetName() != other.GetKey() {
 		return false
 	}
 
-	if a.GetValue() != "" && a.GetValue() != other.GetValue() {
-		return false
+	if a.Operator == AttributeOperator_ATTRIBUTE_OPERATOR_UNSPECIFIED {
+		return a.GetValue() == "" || a.GetValue() == other.GetValue()
 	}
 
-	return true
+	value := other.GetValue()
+	if strings.HasPrefix(value, `"`) {
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+	}
+
+	switch a.Operator {
+	case AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX:
+		return strings.HasPrefix(value, a.GetValue())
+	case AttributeOperator_ATTRIBUTE_OPERATOR_REGEX:
+		re, err := attributeRegexes.get(a.GetValue())
+		return err == nil && re.MatchString(value)
+	case AttributeOperator_ATTRIBUTE_OPERATOR_GT, AttributeOperator_ATTRIBUTE_OPERATOR_GTE,
+		AttributeOperator_ATTRIBUTE_OPERATOR_LT, AttributeOperator_ATTRIBUTE_OPERATOR_LTE:
+		expected, err := sdkmath.LegacyNewDecFromStr(a.GetValue())
+		if err != nil {
+			return false
+		}
+		actual, err := sdkmath.LegacyNewDecFromStr(value)
+		if err != nil {
+			return false
+		}
+		switch a.Operator {
+		case AttributeOperator_ATTRIBUTE_OPERATOR_GT:
+			return actual.GT(expected)
+		case AttributeOperator_ATTRIBUTE_OPERATOR_GTE:
+			return actual.GTE(expected)
+		case AttributeOperator_ATTRIBUTE_OPERATOR_LT:
+			return actual.LT(expected)
+		default:
+			return actual.LTE(expected)
+		}
+	case AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT, AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GTE:
+		threshold, err := sdk.ParseCoinNormalized(a.GetValue())
+		if err != nil {
+			return false
+		}
+		coins, err := sdk.ParseCoinsNormalized(value)
+		if err != nil {
+			return false
+		}
+		amount := coins.AmountOf(threshold.Denom)
+		if a.Operator == AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT {
+			return amount.GT(threshold.Amount)
+		}
+		return amount.GTE(threshold.Amount)
+	}
+
+	return false
+}
+
+// Validate checks if the attribute data is valid.
+func (a Attribute) Validate() error {
+	if strings.TrimSpace(a.Name) == "" {
+		return fmt.Errorf("empty attribute name")
+	}
+	if a.Operator == AttributeOperator_ATTRIBUTE_OPERATOR_UNSPECIFIED {
+		return nil
+	}
+	if _, known := AttributeOperator_name[int32(a.Operator)]; !known {
+		return fmt.Errorf("attribute %q: invalid operator %s", a.Name, a.Operator)
+	}
+	if len(a.Value) == 0 {
+		return fmt.Errorf("attribute %q: value cannot be empty with operator %s", a.Name, a.Operator)
+	}
+
+	switch a.Operator {
+	case AttributeOperator_ATTRIBUTE_OPERATOR_REGEX:
+		if len(a.Value) > MaxAttributeRegexLength {
+			return fmt.Errorf("attribute %q: regex length %d cannot exceed %d", a.Name, len(a.Value), MaxAttributeRegexLength)
+		}
+		if _, err := attributeRegexes.get(a.Value); err != nil {
+			return fmt.Errorf("attribute %q: invalid regex: %w", a.Name, err)
+		}
+	case AttributeOperator_ATTRIBUTE_OPERATOR_GT, AttributeOperator_ATTRIBUTE_OPERATOR_GTE,
+		AttributeOperator_ATTRIBUTE_OPERATOR_LT, AttributeOperator_ATTRIBUTE_OPERATOR_LTE:
+		if _, err := sdkmath.LegacyNewDecFromStr(a.Value); err != nil {
+			return fmt.Errorf("attribute %q: invalid number %q: %w", a.Name, a.Value, err)
+		}
+	case AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT, AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GTE:
+		if _, err := sdk.ParseCoinNormalized(a.Value); err != nil {
+			return fmt.Errorf("attribute %q: invalid coin %q: %w", a.Name, a.Value, err)
+		}
+	}
+	return nil
+}
+
+// UsesOperators returns true if any of this event's attributes have an operator.
+func (e TransactionEvent) UsesOperators() bool {
+	for _, attr := range e.Attributes {
+		if attr.Operator != AttributeOperator_ATTRIBUTE_OPERATOR_UNSPECIFIED {
+			return true
+		}
+	}
+	return false
 }
 
 // GetEventPrefix gets the prefix for a TransactionEvent.
@@ -87,8 +204,8 @@ func (e TransactionEvent) Validate() error {
 		return fmt.Errorf("empty event name")
 	}
 	for _, attribute := range e.Attributes {
-		if strings.TrimSpace(attribute.Name) == "" {
-			return fmt.Errorf("empty attribute name")
+		if err := attribute.Validate(); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -145,6 +262,242 @@ func (e BlockTimeEvent) ValidateContext(ctx sdk.Context) error {
 	return nil
 }
 
+// GetEventPrefix gets the prefix for a RecurringEvent.
+func (e RecurringEvent) GetEventPrefix() string {
+	if e.BlockInterval > 0 {
+		return RecurringBlockHeightPrefix
+	}
+	return RecurringBlockTimePrefix
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e RecurringEvent) GetEventOrder() uint64 {
+	if e.BlockInterval > 0 {
+		return e.NextBlockHeight
+	}
+	return uint64(e.NextTime.UnixNano())
+}
+
+// Validate checks if the event data is valid.
+func (e RecurringEvent) Validate() error {
+	switch {
+	case e.BlockInterval == 0 && e.TimeInterval == 0, e.BlockInterval > 0 && e.TimeInterval != 0:
+		return fmt.Errorf("exactly one of block interval and time interval must be provided")
+	case e.TimeInterval < 0:
+		return fmt.Errorf("time interval %s cannot be negative", e.TimeInterval)
+	case e.BlockInterval > 0 && e.BlockInterval < MinRecurringBlockInterval:
+		return fmt.Errorf("block interval %d cannot be less than %d", e.BlockInterval, MinRecurringBlockInterval)
+	case e.TimeInterval > 0 && e.TimeInterval < MinRecurringTimeInterval:
+		return fmt.Errorf("time interval %s cannot be less than %s", e.TimeInterval, MinRecurringTimeInterval)
+	case e.BlockInterval > 0 && e.NextBlockHeight == 0:
+		return fmt.Errorf("next block height must be provided with a block interval")
+	case e.TimeInterval > 0 && e.NextTime.IsZero():
+		return fmt.Errorf("next time must be provided with a time interval")
+	case e.MaxRuns == 0 && e.EndTime == nil:
+		return fmt.Errorf("at least one of max runs and end time must be provided")
+	case e.MaxRuns > MaxRecurringRuns:
+		return fmt.Errorf("max runs %d cannot be more than %d", e.MaxRuns, MaxRecurringRuns)
+	case e.Runs >= e.RunLimit():
+		return fmt.Errorf("runs %d must be less than max runs %d", e.Runs, e.RunLimit())
+	case e.TimeInterval > 0 && e.EndTime != nil && e.NextTime.After(*e.EndTime):
+		return fmt.Errorf("next time %s cannot be after end time %s", e.NextTime.UTC().Format(time.RFC3339Nano), e.EndTime.UTC().Format(time.RFC3339Nano))
+	}
+	return nil
+}
+
+// ValidateContext checks if this event is valid with the current context.
+func (e RecurringEvent) ValidateContext(ctx sdk.Context) error {
+	if e.BlockInterval > 0 {
+		if e.NextBlockHeight <= uint64(ctx.BlockHeight()) {
+			return ErrInvalidBlockHeight
+		}
+	} else if !e.NextTime.After(ctx.BlockTime()) {
+		return ErrInvalidBlockTime
+	}
+	if e.EndTime != nil && !e.EndTime.After(ctx.BlockTime()) {
+		return ErrInvalidBlockTime.Wrap("end time has already passed")
+	}
+	return nil
+}
+
+// IsReady returns true if the current block is at or after this event's next block height or time.
+func (e RecurringEvent) IsReady(ctx sdk.Context) bool {
+	if e.BlockInterval > 0 {
+		return uint64(ctx.BlockHeight()) >= e.NextBlockHeight
+	}
+	return !ctx.BlockTime().Before(e.NextTime)
+}
+
+// RunLimit returns the most times this event can fire, which is its max runs if set, otherwise MaxRecurringRuns.
+func (e RecurringEvent) RunLimit() uint64 {
+	if e.MaxRuns > 0 {
+		return e.MaxRuns
+	}
+	return MaxRecurringRuns
+}
+
+// RemainingRuns returns the most times this event can still fire.
+// For a time interval, this is also limited by the number of intervals before its end time.
+func (e RecurringEvent) RemainingRuns() uint64 {
+	if e.Runs >= e.RunLimit() {
+		return 0
+	}
+	remaining := e.RunLimit() - e.Runs
+	if e.TimeInterval > 0 && e.EndTime != nil {
+		if e.EndTime.Before(e.NextTime) {
+			return 0
+		}
+		if untilEnd := uint64(e.EndTime.Sub(e.NextTime)/e.TimeInterval) + 1; untilEnd < remaining {
+			remaining = untilEnd
+		}
+	}
+	return remaining
+}
+
+// NextRun returns a copy of this event updated for the run after the current one.
+// The next block height or time is always after the current block's.
+// Returns nil if the event should not fire again.
+func (e RecurringEvent) NextRun(ctx sdk.Context) *RecurringEvent {
+	next := e
+	next.Runs++
+	if next.Runs >= next.RunLimit() {
+		return nil
+	}
+
+	if next.BlockInterval > 0 {
+		curHeight := uint64(ctx.BlockHeight())
+		if next.NextBlockHeight <= curHeight {
+			next.NextBlockHeight += ((curHeight-next.NextBlockHeight)/next.BlockInterval + 1) * next.BlockInterval
+		}
+		if next.EndTime != nil && !ctx.BlockTime().Before(*next.EndTime) {
+			return nil
+		}
+		return &next
+	}
+
+	blockTime := ctx.BlockTime()
+	if !next.NextTime.After(blockTime) {
+		intervals := blockTime.Sub(next.NextTime)/next.TimeInterval + 1
+		next.NextTime = next.NextTime.Add(intervals * next.TimeInterval)
+	}
+	if next.EndTime != nil && next.NextTime.After(*next.EndTime) {
+		return nil
+	}
+	return &next
+}
+
+// GetEventPrefix gets the prefix for a CompoundEvent.
+func (e CompoundEvent) GetEventPrefix() string {
+	return CompoundPrefix
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e CompoundEvent) GetEventOrder() uint64 {
+	return 0
+}
+
+// Validate checks if the event data is valid.
+func (e CompoundEvent) Validate() error {
+	if e.Operator != CompoundOperator_COMPOUND_OPERATOR_AND && e.Operator != CompoundOperator_COMPOUND_OPERATOR_OR {
+		return fmt.Errorf("invalid compound operator %s", e.Operator)
+	}
+	if len(e.Events) < 2 {
+		return fmt.Errorf("compound event must have at least 2 events")
+	}
+	if len(e.Events) > MaxCompoundEvents {
+		return fmt.Errorf("compound event cannot have more than %d events", MaxCompoundEvents)
+	}
+	events, err := e.GetTriggerEvents()
+	if err != nil {
+		return err
+	}
+	for i, event := range events {
+		switch event.(type) {
+		case *BlockHeightEvent, *BlockTimeEvent, *TransactionEvent:
+		default:
+			return fmt.Errorf("compound event %d: unsupported event type %T", i, event)
+		}
+		if err = event.Validate(); err != nil {
+			return fmt.Errorf("compound event %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateContext checks if this event is valid with the current context.
+func (e CompoundEvent) ValidateContext(ctx sdk.Context) error {
+	events, err := e.GetTriggerEvents()
+	if err != nil {
+		return err
+	}
+	for i, event := range events {
+		if err = event.ValidateContext(ctx); err != nil {
+			return cerrs.Wrapf(err, "compound event %d", i)
+		}
+	}
+	return nil
+}
+
+// GetTriggerEvents returns the unpacked events of this CompoundEvent.
+func (e CompoundEvent) GetTriggerEvents() ([]TriggerEventI, error) {
+	rv := make([]TriggerEventI, len(e.Events))
+	for i, eventAny := range e.Events {
+		event, ok := eventAny.GetCachedValue().(TriggerEventI)
+		if !ok {
+			return nil, ErrNoTriggerEvent.Wrapf("failed to get compound event %d", i)
+		}
+		rv[i] = event
+	}
+	return rv, nil
+}
+
+// GetListenerEvents returns the events that a trigger with this CompoundEvent is listened for by.
+// The trigger can only be activated in a block in which at least one of them is satisfied.
+// An OR is listened for by all of its events. Since all the events of an AND must be satisfied in the same block,
+// it is listened for by its first transaction event, or if it has none, by its latest block height and block time events.
+func (e CompoundEvent) GetListenerEvents() ([]TriggerEventI, error) {
+	events, err := e.GetTriggerEvents()
+	if err != nil || e.Operator != CompoundOperator_COMPOUND_OPERATOR_AND {
+		return events, err
+	}
+
+	var latestHeight, latestTime TriggerEventI
+	for _, event := range events {
+		switch event.(type) {
+		case *TransactionEvent:
+			return []TriggerEventI{event}, nil
+		case *BlockHeightEvent:
+			if latestHeight == nil || event.GetEventOrder() > latestHeight.GetEventOrder() {
+				latestHeight = event
+			}
+		case *BlockTimeEvent:
+			if latestTime == nil || event.GetEventOrder() > latestTime.GetEventOrder() {
+				latestTime = event
+			}
+		}
+	}
+
+	var rv []TriggerEventI
+	if latestHeight != nil {
+		rv = append(rv, latestHeight)
+	}
+	if latestTime != nil {
+		rv = append(rv, latestTime)
+	}
+	return rv, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e CompoundEvent) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, eventAny := range e.Events {
+		var event TriggerEventI
+		if err := unpacker.UnpackAny(eventAny, &event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewTrigger creates a new trigger.
 func NewTrigger(id TriggerID, owner string, event *codectypes.Any, action []*codectypes.Any) Trigger {
 	return Trigger{
@@ -167,6 +520,29 @@ func (m Trigger) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, m.Actions)
 }
 
+// RemainingRuns returns the most times this trigger can still run.
+// This is one unless the trigger has a RecurringEvent.
+func (m Trigger) RemainingRuns() uint64 {
+	event, err := m.GetTriggerEventI()
+	if err != nil {
+		return 1
+	}
+	if recurringEvent, ok := event.(*RecurringEvent); ok {
+		return recurringEvent.RemainingRuns()
+	}
+	return 1
+}
+
+// IsRecurring returns true if this trigger has a RecurringEvent.
+func (m Trigger) IsRecurring() bool {
+	event, err := m.GetTriggerEventI()
+	if err != nil {
+		return false
+	}
+	_, ok := event.(*RecurringEvent)
+	return ok
+}
+
 // NewQueuedTrigger creates a new trigger for queueing.
 func NewQueuedTrigger(trigger Trigger, blockTime time.Time, blockHeight uint64) QueuedTrigger {
 	return QueuedTrigger{
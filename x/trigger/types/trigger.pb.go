@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +30,98 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AttributeOperator defines how an Attribute's value is compared to an event attribute's value.
+// For all operators other than ATTRIBUTE_OPERATOR_UNSPECIFIED, an event attribute value
+// that is wrapped in double quotes (e.g. from a typed event) is unquoted before being compared.
+type AttributeOperator int32
+
+const (
+	// ATTRIBUTE_OPERATOR_UNSPECIFIED requires the values to be equal, unless the value is empty.
+	AttributeOperator_ATTRIBUTE_OPERATOR_UNSPECIFIED AttributeOperator = 0
+	// ATTRIBUTE_OPERATOR_PREFIX requires the event attribute value to start with the value.
+	AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX AttributeOperator = 1
+	// ATTRIBUTE_OPERATOR_REGEX requires the event attribute value to match the value as a regular expression.
+	AttributeOperator_ATTRIBUTE_OPERATOR_REGEX AttributeOperator = 2
+	// ATTRIBUTE_OPERATOR_GT requires the event attribute value to be a number greater than the value.
+	AttributeOperator_ATTRIBUTE_OPERATOR_GT AttributeOperator = 3
+	// ATTRIBUTE_OPERATOR_GTE requires the event attribute value to be a number greater than or equal to the value.
+	AttributeOperator_ATTRIBUTE_OPERATOR_GTE AttributeOperator = 4
+	// ATTRIBUTE_OPERATOR_LT requires the event attribute value to be a number less than the value.
+	AttributeOperator_ATTRIBUTE_OPERATOR_LT AttributeOperator = 5
+	// ATTRIBUTE_OPERATOR_LTE requires the event attribute value to be a number less than or equal to the value.
+	AttributeOperator_ATTRIBUTE_OPERATOR_LTE AttributeOperator = 6
+	// ATTRIBUTE_OPERATOR_COINS_GT requires the event attribute value to be coins (e.g. "10nhash,5pm.sale.pool")
+	// with an amount of the value's denom that is greater than the value's amount. The value must be a coin.
+	AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT AttributeOperator = 7
+	// ATTRIBUTE_OPERATOR_COINS_GTE requires the event attribute value to be coins (e.g. "10nhash,5pm.sale.pool")
+	// with an amount of the value's denom that is greater than or equal to the value's amount. The value must be a coin.
+	AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GTE AttributeOperator = 8
+)
+
+var AttributeOperator_name = map[int32]string{
+	0: "ATTRIBUTE_OPERATOR_UNSPECIFIED",
+	1: "ATTRIBUTE_OPERATOR_PREFIX",
+	2: "ATTRIBUTE_OPERATOR_REGEX",
+	3: "ATTRIBUTE_OPERATOR_GT",
+	4: "ATTRIBUTE_OPERATOR_GTE",
+	5: "ATTRIBUTE_OPERATOR_LT",
+	6: "ATTRIBUTE_OPERATOR_LTE",
+	7: "ATTRIBUTE_OPERATOR_COINS_GT",
+	8: "ATTRIBUTE_OPERATOR_COINS_GTE",
+}
+
+var AttributeOperator_value = map[string]int32{
+	"ATTRIBUTE_OPERATOR_UNSPECIFIED": 0,
+	"ATTRIBUTE_OPERATOR_PREFIX":      1,
+	"ATTRIBUTE_OPERATOR_REGEX":       2,
+	"ATTRIBUTE_OPERATOR_GT":          3,
+	"ATTRIBUTE_OPERATOR_GTE":         4,
+	"ATTRIBUTE_OPERATOR_LT":          5,
+	"ATTRIBUTE_OPERATOR_LTE":         6,
+	"ATTRIBUTE_OPERATOR_COINS_GT":    7,
+	"ATTRIBUTE_OPERATOR_COINS_GTE":   8,
+}
+
+func (x AttributeOperator) String() string {
+	return proto.EnumName(AttributeOperator_name, int32(x))
+}
+
+func (AttributeOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{0}
+}
+
+// CompoundOperator defines how the events of a CompoundEvent are combined.
+type CompoundOperator int32
+
+const (
+	// COMPOUND_OPERATOR_UNSPECIFIED is an invalid operator.
+	CompoundOperator_COMPOUND_OPERATOR_UNSPECIFIED CompoundOperator = 0
+	// COMPOUND_OPERATOR_AND requires all of the events to be satisfied in the same block.
+	CompoundOperator_COMPOUND_OPERATOR_AND CompoundOperator = 1
+	// COMPOUND_OPERATOR_OR requires at least one of the events to be satisfied.
+	CompoundOperator_COMPOUND_OPERATOR_OR CompoundOperator = 2
+)
+
+var CompoundOperator_name = map[int32]string{
+	0: "COMPOUND_OPERATOR_UNSPECIFIED",
+	1: "COMPOUND_OPERATOR_AND",
+	2: "COMPOUND_OPERATOR_OR",
+}
+
+var CompoundOperator_value = map[string]int32{
+	"COMPOUND_OPERATOR_UNSPECIFIED": 0,
+	"COMPOUND_OPERATOR_AND":         1,
+	"COMPOUND_OPERATOR_OR":          2,
+}
+
+func (x CompoundOperator) String() string {
+	return proto.EnumName(CompoundOperator_name, int32(x))
+}
+
+func (CompoundOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{1}
+}
+
 // Trigger
 type Trigger struct {
 	// An integer to uniquely identify the trigger.
@@ -319,6 +412,9 @@ type Attribute struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The value of the attribute that the event must have to be considered a match.
 	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// How the value is compared to the event's attribute value. By default, the values must be equal,
+	// and an empty value will match any attribute value.
+	Operator AttributeOperator `protobuf:"varint,3,opt,name=operator,proto3,enum=provenance.trigger.v1.AttributeOperator" json:"operator,omitempty"`
 }
 
 func (m *Attribute) Reset()         { *m = Attribute{} }
@@ -368,13 +464,185 @@ func (m *Attribute) GetValue() string {
 	return ""
 }
 
+func (m *Attribute) GetOperator() AttributeOperator {
+	if m != nil {
+		return m.Operator
+	}
+	return AttributeOperator_ATTRIBUTE_OPERATOR_UNSPECIFIED
+}
+
+// RecurringEvent is an event that fires repeatedly, either every block_interval blocks or every time_interval.
+// After firing, the trigger is registered again (with the same id, actions, and gas limit) until it has
+// fired max_runs times or its next firing would be after the end_time.
+type RecurringEvent struct {
+	// The number of blocks between firings. Exactly one of block_interval and time_interval must be provided.
+	BlockInterval uint64 `protobuf:"varint,1,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// The amount of time between firings. Exactly one of block_interval and time_interval must be provided.
+	TimeInterval time.Duration `protobuf:"bytes,2,opt,name=time_interval,json=timeInterval,proto3,stdduration" json:"time_interval"`
+	// The block height that the trigger should next fire at. Required when using a block_interval.
+	NextBlockHeight uint64 `protobuf:"varint,3,opt,name=next_block_height,json=nextBlockHeight,proto3" json:"next_block_height,omitempty"`
+	// The time that the trigger should next fire at. Required when using a time_interval.
+	NextTime time.Time `protobuf:"bytes,4,opt,name=next_time,json=nextTime,proto3,stdtime" json:"next_time"`
+	// The maximum number of times the trigger should fire, up to 100. Zero means the trigger fires at most 100 times.
+	// At least one of max_runs and end_time must be provided.
+	MaxRuns uint64 `protobuf:"varint,5,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// The time after which the trigger should no longer fire.
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// The number of times the trigger has fired.
+	Runs uint64 `protobuf:"varint,7,opt,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (m *RecurringEvent) Reset()         { *m = RecurringEvent{} }
+func (m *RecurringEvent) String() string { return proto.CompactTextString(m) }
+func (*RecurringEvent) ProtoMessage()    {}
+func (*RecurringEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{6}
+}
+func (m *RecurringEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringEvent.Merge(m, src)
+}
+func (m *RecurringEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringEvent proto.InternalMessageInfo
+
+func (m *RecurringEvent) GetBlockInterval() uint64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *RecurringEvent) GetTimeInterval() time.Duration {
+	if m != nil {
+		return m.TimeInterval
+	}
+	return 0
+}
+
+func (m *RecurringEvent) GetNextBlockHeight() uint64 {
+	if m != nil {
+		return m.NextBlockHeight
+	}
+	return 0
+}
+
+func (m *RecurringEvent) GetNextTime() time.Time {
+	if m != nil {
+		return m.NextTime
+	}
+	return time.Time{}
+}
+
+func (m *RecurringEvent) GetMaxRuns() uint64 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+func (m *RecurringEvent) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *RecurringEvent) GetRuns() uint64 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+// CompoundEvent is an event that combines other events.
+// Each of the events must be a BlockHeightEvent, BlockTimeEvent, or TransactionEvent.
+// A BlockHeightEvent or BlockTimeEvent is satisfied in every block at or after its height or time.
+// A TransactionEvent is only satisfied in a block that has a matching event.
+type CompoundEvent struct {
+	// How the events are combined.
+	Operator CompoundOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=provenance.trigger.v1.CompoundOperator" json:"operator,omitempty"`
+	// The events that are combined.
+	Events []*types.Any `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (m *CompoundEvent) Reset()         { *m = CompoundEvent{} }
+func (m *CompoundEvent) String() string { return proto.CompactTextString(m) }
+func (*CompoundEvent) ProtoMessage()    {}
+func (*CompoundEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{7}
+}
+func (m *CompoundEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompoundEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompoundEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompoundEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompoundEvent.Merge(m, src)
+}
+func (m *CompoundEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompoundEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompoundEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompoundEvent proto.InternalMessageInfo
+
+func (m *CompoundEvent) GetOperator() CompoundOperator {
+	if m != nil {
+		return m.Operator
+	}
+	return CompoundOperator_COMPOUND_OPERATOR_UNSPECIFIED
+}
+
+func (m *CompoundEvent) GetEvents() []*types.Any {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("provenance.trigger.v1.AttributeOperator", AttributeOperator_name, AttributeOperator_value)
+	proto.RegisterEnum("provenance.trigger.v1.CompoundOperator", CompoundOperator_name, CompoundOperator_value)
 	proto.RegisterType((*Trigger)(nil), "provenance.trigger.v1.Trigger")
 	proto.RegisterType((*QueuedTrigger)(nil), "provenance.trigger.v1.QueuedTrigger")
 	proto.RegisterType((*BlockHeightEvent)(nil), "provenance.trigger.v1.BlockHeightEvent")
 	proto.RegisterType((*BlockTimeEvent)(nil), "provenance.trigger.v1.BlockTimeEvent")
 	proto.RegisterType((*TransactionEvent)(nil), "provenance.trigger.v1.TransactionEvent")
 	proto.RegisterType((*Attribute)(nil), "provenance.trigger.v1.Attribute")
+	proto.RegisterType((*RecurringEvent)(nil), "provenance.trigger.v1.RecurringEvent")
+	proto.RegisterType((*CompoundEvent)(nil), "provenance.trigger.v1.CompoundEvent")
 }
 
 func init() {
@@ -382,40 +650,63 @@ func init() {
 }
 
 var fileDescriptor_fe59296a7b42130c = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xa6, 0x2e, 0x21, 0x13, 0x5a, 0x15, 0x2b, 0x95, 0xdc, 0x1c, 0x1c, 0x13, 0x2e, 0xb9,
-	0x64, 0xad, 0xb6, 0x17, 0x54, 0x04, 0x52, 0x2c, 0x81, 0x40, 0xe2, 0x00, 0x26, 0x27, 0x2e, 0x95,
-	0x3f, 0x16, 0x67, 0x45, 0xb2, 0x1b, 0xd9, 0x6b, 0x43, 0x7e, 0x00, 0xf7, 0xfe, 0x04, 0x7e, 0x03,
-	0xea, 0x99, 0x73, 0xc5, 0xa9, 0xe2, 0xc4, 0x09, 0x50, 0x72, 0xe1, 0x67, 0x20, 0xef, 0xae, 0x9b,
-	0x8a, 0x26, 0x12, 0xdc, 0x3c, 0xb3, 0xef, 0xcd, 0x7b, 0xf3, 0x46, 0x86, 0xfb, 0xb3, 0x94, 0x17,
-	0x84, 0x05, 0x2c, 0x22, 0xae, 0x48, 0x69, 0x92, 0x90, 0xd4, 0x2d, 0x0e, 0xab, 0x4f, 0x3c, 0x4b,
-	0xb9, 0xe0, 0xe6, 0xfe, 0x0a, 0x84, 0xab, 0x97, 0xe2, 0xb0, 0x73, 0x10, 0xf1, 0x6c, 0xca, 0xb3,
-	0x53, 0x09, 0x72, 0x55, 0xa1, 0x18, 0x9d, 0x76, 0xc2, 0x13, 0xae, 0xfa, 0xe5, 0x97, 0xee, 0x1e,
-	0x24, 0x9c, 0x27, 0x13, 0xe2, 0xca, 0x2a, 0xcc, 0xdf, 0xba, 0x01, 0x9b, 0xeb, 0xa7, 0xee, 0xdf,
-	0x4f, 0x82, 0x4e, 0x49, 0x26, 0x82, 0xe9, 0x4c, 0x01, 0x7a, 0x5f, 0x10, 0x34, 0x46, 0x4a, 0xdb,
-	0xdc, 0x85, 0x3a, 0x8d, 0x2d, 0xe4, 0xa0, 0xbe, 0xe1, 0xd7, 0x69, 0x6c, 0x62, 0xd8, 0xe6, 0xef,
-	0x19, 0x49, 0xad, 0xba, 0x83, 0xfa, 0x4d, 0xcf, 0xfa, 0x76, 0x3e, 0x68, 0x6b, 0x3b, 0xc3, 0x38,
-	0x4e, 0x49, 0x96, 0xbd, 0x16, 0x29, 0x65, 0x89, 0xaf, 0x60, 0xe6, 0x23, 0xd8, 0x26, 0x05, 0x61,
-	0xc2, 0xda, 0x72, 0x50, 0xbf, 0x75, 0xd4, 0xc6, 0x4a, 0x1c, 0x57, 0xe2, 0x78, 0xc8, 0xe6, 0xde,
-	0xdd, 0xaf, 0xe7, 0x83, 0x1d, 0xad, 0xf8, 0xa4, 0x44, 0x3f, 0xf7, 0x15, 0xcb, 0xc4, 0xd0, 0x08,
-	0x22, 0x41, 0x39, 0xcb, 0x2c, 0xc3, 0xd9, 0xda, 0x34, 0xc0, 0xaf, 0x40, 0x27, 0xc6, 0xef, 0x4f,
-	0x5d, 0xd4, 0xfb, 0x8c, 0x60, 0xe7, 0x55, 0x4e, 0x72, 0x12, 0x57, 0x6b, 0xdc, 0x83, 0x3b, 0xe1,
-	0x84, 0x47, 0xef, 0x4e, 0xc7, 0x84, 0x26, 0x63, 0xa1, 0x17, 0x6a, 0xc9, 0xde, 0x33, 0xd9, 0x32,
-	0x1f, 0x80, 0x51, 0x06, 0x21, 0x17, 0x6b, 0x1d, 0x75, 0x6e, 0xe8, 0x8c, 0xaa, 0x94, 0xbc, 0xdb,
-	0x17, 0x3f, 0xba, 0xb5, 0xb3, 0x9f, 0x5d, 0xe4, 0x4b, 0x86, 0xf9, 0x18, 0x1a, 0xfa, 0x54, 0x7a,
-	0x4b, 0x1b, 0xaf, 0xbd, 0x22, 0xd6, 0x6e, 0x3c, 0xa3, 0x1c, 0xe0, 0x57, 0x24, 0x6d, 0xfa, 0x05,
-	0xec, 0x79, 0x2b, 0x3b, 0x32, 0x86, 0x7f, 0xb0, 0x7d, 0xb2, 0x5f, 0x92, 0x6f, 0xe4, 0xd7, 0x0b,
-	0x60, 0x57, 0x4e, 0x2b, 0x5d, 0xab, 0x59, 0xd5, 0x7e, 0xe8, 0x7f, 0xf7, 0xdb, 0x24, 0xf1, 0x11,
-	0xc1, 0xde, 0x28, 0x0d, 0x58, 0xa6, 0xc2, 0x57, 0x2a, 0x26, 0x18, 0x2c, 0xd0, 0x2a, 0x4d, 0x5f,
-	0x7e, 0x9b, 0x4f, 0x01, 0x02, 0x21, 0x52, 0x1a, 0xe6, 0x82, 0x64, 0x56, 0x5d, 0xde, 0xd1, 0xd9,
-	0x10, 0xd1, 0xb0, 0x02, 0xea, 0x90, 0xae, 0x31, 0x37, 0xf9, 0x78, 0x08, 0xcd, 0x2b, 0xd6, 0x5a,
-	0xfd, 0x36, 0x6c, 0x17, 0xc1, 0x24, 0x57, 0xa7, 0x6d, 0xfa, 0xaa, 0x50, 0xa9, 0x7b, 0xf4, 0x62,
-	0x61, 0xa3, 0xcb, 0x85, 0x8d, 0x7e, 0x2d, 0x6c, 0x74, 0xb6, 0xb4, 0x6b, 0x97, 0x4b, 0xbb, 0xf6,
-	0x7d, 0x69, 0xd7, 0xc0, 0xa2, 0x7c, 0xbd, 0xc7, 0x97, 0xe8, 0xcd, 0x71, 0x42, 0xc5, 0x38, 0x0f,
-	0x71, 0xc4, 0xa7, 0xee, 0x0a, 0x33, 0xa0, 0xfc, 0x5a, 0xe5, 0x7e, 0xb8, 0xfa, 0xcb, 0xc5, 0x7c,
-	0x46, 0xb2, 0xf0, 0x96, 0x8c, 0xfa, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xf1, 0x6b,
-	0x40, 0x08, 0x04, 0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0x38, 0xeb, 0xd8, 0x7e, 0x39, 0x9b, 0xcd, 0xc8, 0x41, 0xeb, 0x70, 0xb7, 0xf6, 0x05,
+	0x21, 0xa2, 0x48, 0x59, 0xeb, 0x72, 0x0d, 0x3a, 0x04, 0xc8, 0x3f, 0x36, 0x77, 0x96, 0x42, 0x6c,
+	0x36, 0x1b, 0xe9, 0x44, 0x63, 0xad, 0xbd, 0xc3, 0x66, 0x21, 0x9e, 0xb1, 0xf6, 0x87, 0x49, 0x4a,
+	0x0a, 0xfa, 0x2b, 0x29, 0x69, 0xe8, 0xa8, 0xd0, 0xd5, 0xd4, 0x27, 0xaa, 0x13, 0x15, 0x15, 0xa0,
+	0xa4, 0xa1, 0xe7, 0x1f, 0x40, 0x3b, 0x33, 0x9b, 0x84, 0xd8, 0x7b, 0x3f, 0xba, 0x99, 0x79, 0xdf,
+	0xf7, 0xde, 0xf7, 0xde, 0xf7, 0xbc, 0x32, 0xbc, 0x3f, 0x0b, 0xd8, 0x9c, 0x50, 0x87, 0x4e, 0x48,
+	0x2b, 0x0a, 0x7c, 0xcf, 0x23, 0x41, 0x6b, 0xfe, 0x20, 0x3d, 0x1a, 0xb3, 0x80, 0x45, 0x0c, 0x6f,
+	0x5c, 0x83, 0x8c, 0x34, 0x32, 0x7f, 0xb0, 0x59, 0x9f, 0xb0, 0x70, 0xca, 0xc2, 0x11, 0x07, 0xb5,
+	0xc4, 0x45, 0x30, 0x36, 0x6b, 0x1e, 0xf3, 0x98, 0x78, 0x4f, 0x4e, 0xf2, 0xb5, 0xee, 0x31, 0xe6,
+	0x9d, 0x92, 0x16, 0xbf, 0x8d, 0xe3, 0xaf, 0x5a, 0x0e, 0x3d, 0x97, 0x21, 0xfd, 0x76, 0xc8, 0x8d,
+	0x03, 0x27, 0xf2, 0x19, 0x95, 0xf1, 0xc6, 0xed, 0x78, 0xe4, 0x4f, 0x49, 0x18, 0x39, 0xd3, 0x99,
+	0x00, 0x6c, 0xfd, 0x8a, 0xa0, 0x68, 0x0b, 0x6d, 0xb8, 0x0a, 0x79, 0xdf, 0xd5, 0x50, 0x13, 0x6d,
+	0x2b, 0x56, 0xde, 0x77, 0xb1, 0x01, 0x05, 0xf6, 0x2d, 0x25, 0x81, 0x96, 0x6f, 0xa2, 0xed, 0x72,
+	0x47, 0xfb, 0xfd, 0xf9, 0x6e, 0x4d, 0xca, 0x6d, 0xbb, 0x6e, 0x40, 0xc2, 0xf0, 0x28, 0x0a, 0x7c,
+	0xea, 0x59, 0x02, 0x86, 0x3f, 0x81, 0x02, 0x99, 0x13, 0x1a, 0x69, 0x2b, 0x4d, 0xb4, 0xbd, 0xb6,
+	0x57, 0x33, 0x44, 0x71, 0x23, 0x2d, 0x6e, 0xb4, 0xe9, 0x79, 0x67, 0xfd, 0xb7, 0xe7, 0xbb, 0x15,
+	0x59, 0xd1, 0x4c, 0xd0, 0x7d, 0x4b, 0xb0, 0xb0, 0x01, 0x45, 0x67, 0x92, 0x68, 0x0f, 0x35, 0xa5,
+	0xb9, 0x92, 0x95, 0xc0, 0x4a, 0x41, 0x8f, 0x94, 0x7f, 0x7e, 0x6c, 0xa0, 0xad, 0x5f, 0x10, 0x54,
+	0xbe, 0x88, 0x49, 0x4c, 0xdc, 0xb4, 0x8d, 0xfb, 0x70, 0x67, 0x7c, 0xca, 0x26, 0xdf, 0x8c, 0x4e,
+	0x88, 0xef, 0x9d, 0x44, 0xb2, 0xa1, 0x35, 0xfe, 0xf6, 0x84, 0x3f, 0xe1, 0x8f, 0x40, 0x49, 0x06,
+	0xc1, 0x1b, 0x5b, 0xdb, 0xdb, 0x5c, 0xa8, 0x63, 0xa7, 0x53, 0xea, 0x94, 0x5e, 0xfc, 0xd9, 0xc8,
+	0x3d, 0xfb, 0xab, 0x81, 0x2c, 0xce, 0xc0, 0x9f, 0x42, 0x51, 0x5a, 0x29, 0xbb, 0xd4, 0x8d, 0xa5,
+	0x2e, 0x1b, 0x52, 0x4d, 0x47, 0x49, 0x12, 0x58, 0x29, 0x49, 0x8a, 0x3e, 0x00, 0xb5, 0x73, 0x2d,
+	0x87, 0x8f, 0xe1, 0x0d, 0x64, 0x3f, 0xda, 0x48, 0xc8, 0x0b, 0xf3, 0xdb, 0x72, 0xa0, 0xca, 0xb3,
+	0x25, 0xaa, 0x45, 0xae, 0xb4, 0x3f, 0xf4, 0xb6, 0xfd, 0x65, 0x95, 0xf8, 0x1e, 0x81, 0x6a, 0x07,
+	0x0e, 0x0d, 0xc5, 0xf0, 0x45, 0x15, 0x0c, 0x0a, 0x75, 0x64, 0x95, 0xb2, 0xc5, 0xcf, 0x78, 0x1f,
+	0xc0, 0x89, 0xa2, 0xc0, 0x1f, 0xc7, 0x11, 0x09, 0xb5, 0x3c, 0xf7, 0xb1, 0x99, 0x31, 0xa2, 0x76,
+	0x0a, 0x94, 0x43, 0xba, 0xc1, 0xcc, 0xd2, 0xf1, 0x1d, 0x82, 0xf2, 0x15, 0x6d, 0xa9, 0x80, 0x1a,
+	0x14, 0xe6, 0xce, 0x69, 0x2c, 0xbc, 0x2d, 0x5b, 0xe2, 0x82, 0x7b, 0x50, 0x62, 0x33, 0x12, 0x38,
+	0x11, 0x13, 0xbe, 0x55, 0xf7, 0xb6, 0x5f, 0x27, 0x6a, 0x20, 0xf1, 0xd6, 0x15, 0x53, 0x9a, 0xf7,
+	0x6f, 0x1e, 0xaa, 0x16, 0x99, 0xc4, 0x41, 0xb2, 0xfb, 0x62, 0x12, 0x1f, 0x40, 0x55, 0x78, 0xe7,
+	0xd3, 0x88, 0x04, 0x73, 0xe7, 0x54, 0xba, 0x57, 0xe1, 0xaf, 0x7d, 0xf9, 0x88, 0x9f, 0x40, 0x25,
+	0x19, 0xf2, 0x35, 0x4a, 0xec, 0x5f, 0x7d, 0xc1, 0x9f, 0x9e, 0xfc, 0x15, 0x0b, 0x7b, 0x7e, 0x48,
+	0xec, 0xb9, 0x93, 0x30, 0xaf, 0x32, 0xed, 0xc0, 0x3a, 0x25, 0x67, 0xd1, 0xe8, 0x7f, 0x1b, 0xb3,
+	0xc2, 0x6b, 0xbe, 0x93, 0x04, 0x6e, 0x6c, 0x17, 0x6e, 0x43, 0x99, 0x63, 0xf9, 0x46, 0x28, 0x6f,
+	0xb1, 0x11, 0xa5, 0x84, 0x96, 0x04, 0x70, 0x1d, 0x4a, 0x53, 0xe7, 0x6c, 0x14, 0xc4, 0x34, 0xd4,
+	0x0a, 0xbc, 0x4a, 0x71, 0xea, 0x9c, 0x59, 0x31, 0x0d, 0xf1, 0xc7, 0x50, 0x22, 0xd4, 0x15, 0xc9,
+	0x57, 0x5f, 0x9b, 0x5c, 0xe1, 0x89, 0x8b, 0x84, 0xba, 0x3c, 0x2f, 0x06, 0x85, 0xe7, 0x2c, 0xf2,
+	0x9c, 0xfc, 0x9c, 0xe5, 0xfc, 0xcf, 0x08, 0x2a, 0x5d, 0x36, 0x9d, 0xb1, 0x98, 0xba, 0x62, 0xe8,
+	0xdd, 0x1b, 0x9e, 0x22, 0xee, 0xe9, 0x87, 0x19, 0x9e, 0xa6, 0xbc, 0x45, 0x4b, 0xf1, 0x67, 0xb0,
+	0xca, 0xbf, 0x3e, 0xe9, 0xae, 0xbe, 0xf1, 0x47, 0x4b, 0xd2, 0x32, 0xe4, 0xee, 0xfc, 0x94, 0x87,
+	0xf5, 0x85, 0x55, 0xc2, 0x5b, 0xa0, 0xb7, 0x6d, 0xdb, 0xea, 0x77, 0x8e, 0x6d, 0x73, 0x34, 0x18,
+	0x9a, 0x56, 0xdb, 0x1e, 0x58, 0xa3, 0xe3, 0xc3, 0xa3, 0xa1, 0xd9, 0xed, 0xef, 0xf7, 0xcd, 0x9e,
+	0x9a, 0xc3, 0xf7, 0xa0, 0xbe, 0x04, 0x33, 0xb4, 0xcc, 0xfd, 0xfe, 0x53, 0x15, 0xe1, 0xbb, 0xa0,
+	0x2d, 0x09, 0x5b, 0xe6, 0x63, 0xf3, 0xa9, 0x9a, 0xc7, 0x75, 0xd8, 0x58, 0x12, 0x7d, 0x6c, 0xab,
+	0x2b, 0x78, 0x13, 0xde, 0x5d, 0x1a, 0x32, 0x55, 0x25, 0x83, 0x76, 0x60, 0xab, 0x85, 0x0c, 0xda,
+	0x81, 0x6d, 0xaa, 0xab, 0xb8, 0x01, 0xef, 0x2d, 0x89, 0x75, 0x07, 0xfd, 0xc3, 0xa3, 0xa4, 0x66,
+	0x11, 0x37, 0xe1, 0xee, 0x2b, 0x00, 0xa6, 0x5a, 0xda, 0xf9, 0x1a, 0xd4, 0xdb, 0xee, 0xe0, 0xfb,
+	0x70, 0xaf, 0x3b, 0xf8, 0x7c, 0x38, 0x38, 0x3e, 0xec, 0x65, 0x0d, 0xa9, 0x0e, 0x1b, 0x8b, 0x90,
+	0xf6, 0x61, 0x4f, 0x45, 0x58, 0x83, 0xda, 0x62, 0x68, 0x60, 0xa9, 0xf9, 0x8e, 0xff, 0xe2, 0x42,
+	0x47, 0x2f, 0x2f, 0x74, 0xf4, 0xf7, 0x85, 0x8e, 0x9e, 0x5d, 0xea, 0xb9, 0x97, 0x97, 0x7a, 0xee,
+	0x8f, 0x4b, 0x3d, 0x07, 0x9a, 0xcf, 0x96, 0xaf, 0xce, 0x10, 0x7d, 0xf9, 0xd0, 0xf3, 0xa3, 0x93,
+	0x78, 0x6c, 0x4c, 0xd8, 0xb4, 0x75, 0x8d, 0xd9, 0xf5, 0xd9, 0x8d, 0x5b, 0xeb, 0xec, 0xea, 0x5f,
+	0x40, 0x74, 0x3e, 0x23, 0xe1, 0x78, 0x95, 0xaf, 0xcf, 0xc3, 0xff, 0x06, 0x00, 0x03, 0x76, 0xd0,
+	0x72, 0x28, 0x08, 0x00, 0x00,
 }
 
 func (this *Trigger) Equal(that interface{}) bool {
@@ -591,6 +882,87 @@ func (this *Attribute) Equal(that interface{}) bool {
 	if this.Value != that1.Value {
 		return false
 	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	return true
+}
+func (this *RecurringEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecurringEvent)
+	if !ok {
+		that2, ok := that.(RecurringEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockInterval != that1.BlockInterval {
+		return false
+	}
+	if this.TimeInterval != that1.TimeInterval {
+		return false
+	}
+	if this.NextBlockHeight != that1.NextBlockHeight {
+		return false
+	}
+	if !this.NextTime.Equal(that1.NextTime) {
+		return false
+	}
+	if this.MaxRuns != that1.MaxRuns {
+		return false
+	}
+	if that1.EndTime == nil {
+		if this.EndTime != nil {
+			return false
+		}
+	} else if !this.EndTime.Equal(*that1.EndTime) {
+		return false
+	}
+	if this.Runs != that1.Runs {
+		return false
+	}
+	return true
+}
+func (this *CompoundEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompoundEvent)
+	if !ok {
+		that2, ok := that.(CompoundEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !this.Events[i].Equal(that1.Events[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Trigger) Marshal() (dAtA []byte, err error) {
@@ -823,6 +1195,11 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Operator != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -840,6 +1217,117 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecurringEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Runs != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTrigger(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxRuns != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.MaxRuns))
+		i--
+		dAtA[i] = 0x28
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTrigger(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.NextBlockHeight != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.NextBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInterval):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTrigger(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockInterval != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompoundEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompoundEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompoundEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrigger(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTrigger(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTrigger(v)
 	base := offset
@@ -949,23 +1437,73 @@ func (m *Attribute) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTrigger(uint64(l))
 	}
+	if m.Operator != 0 {
+		n += 1 + sovTrigger(uint64(m.Operator))
+	}
 	return n
 }
 
-func sovTrigger(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTrigger(x uint64) (n int) {
-	return sovTrigger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Trigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *RecurringEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockInterval != 0 {
+		n += 1 + sovTrigger(uint64(m.BlockInterval))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInterval)
+	n += 1 + l + sovTrigger(uint64(l))
+	if m.NextBlockHeight != 0 {
+		n += 1 + sovTrigger(uint64(m.NextBlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextTime)
+	n += 1 + l + sovTrigger(uint64(l))
+	if m.MaxRuns != 0 {
+		n += 1 + sovTrigger(uint64(m.MaxRuns))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovTrigger(uint64(m.Runs))
+	}
+	return n
+}
+
+func (m *CompoundEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operator != 0 {
+		n += 1 + sovTrigger(uint64(m.Operator))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovTrigger(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTrigger(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrigger(x uint64) (n int) {
+	return sovTrigger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Trigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowTrigger
 			}
 			if iNdEx >= l {
@@ -1625,6 +2163,356 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= AttributeOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBlockHeight", wireType)
+			}
+			m.NextBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompoundEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompoundEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompoundEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= CompoundOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &types.Any{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrigger(dAtA[iNdEx:])
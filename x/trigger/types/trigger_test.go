@@ -1,10 +1,12 @@
 package types
 
 import (
+	"strings"
 	"testing"
 	time "time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -89,6 +91,24 @@ func TestTransactionEventMatches(t *testing.T) {
 			event2:      abci.Event{Type: "name", Attributes: []abci.EventAttribute{{Key: "attr1", Value: "value3"}, {Key: "attr2", Value: "value2"}}},
 			shouldMatch: false,
 		},
+		{
+			name: "valid - transfer of more than a coin amount to an address",
+			event: TransactionEvent{Name: "transfer", Attributes: []Attribute{
+				{Name: "recipient", Value: "addr1"},
+				{Name: "amount", Value: "1000000pm.sale.pool", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT},
+			}},
+			event2:      abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: "addr1"}, {Key: "sender", Value: "addr2"}, {Key: "amount", Value: "5nhash,1000001pm.sale.pool"}}},
+			shouldMatch: true,
+		},
+		{
+			name: "invalid - transfer of a coin amount that isn't more than the threshold",
+			event: TransactionEvent{Name: "transfer", Attributes: []Attribute{
+				{Name: "recipient", Value: "addr1"},
+				{Name: "amount", Value: "1000000pm.sale.pool", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT},
+			}},
+			event2:      abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: "addr1"}, {Key: "sender", Value: "addr2"}, {Key: "amount", Value: "1000000pm.sale.pool"}}},
+			shouldMatch: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -129,6 +149,120 @@ func TestAttributeMatches(t *testing.T) {
 			attr2:       abci.EventAttribute{Key: "attr", Value: "blah"},
 			shouldMatch: false,
 		},
+		{
+			name:        "valid - prefix matches",
+			attr1:       Attribute{Name: "attr", Value: "val", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "value"},
+			shouldMatch: true,
+		},
+		{
+			name:        "invalid - prefix doesn't match",
+			attr1:       Attribute{Name: "attr", Value: "lue", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "value"},
+			shouldMatch: false,
+		},
+		{
+			name:        "valid - prefix matches quoted value",
+			attr1:       Attribute{Name: "attr", Value: "val", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX},
+			attr2:       abci.EventAttribute{Key: "attr", Value: `"value"`},
+			shouldMatch: true,
+		},
+		{
+			name:        "valid - regex matches",
+			attr1:       Attribute{Name: "attr", Value: "^pm\\.sale\\.[a-z]+$", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_REGEX},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "pm.sale.pool"},
+			shouldMatch: true,
+		},
+		{
+			name:        "invalid - regex doesn't match",
+			attr1:       Attribute{Name: "attr", Value: "^pm\\.sale\\.[a-z]+$", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_REGEX},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "pm.sale.pool2"},
+			shouldMatch: false,
+		},
+		{
+			name:        "invalid - invalid regex",
+			attr1:       Attribute{Name: "attr", Value: "(", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_REGEX},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "("},
+			shouldMatch: false,
+		},
+		{
+			name:        "valid - greater than",
+			attr1:       Attribute{Name: "attr", Value: "10", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_GT},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "10.5"},
+			shouldMatch: true,
+		},
+		{
+			name:        "invalid - equal is not greater than",
+			attr1:       Attribute{Name: "attr", Value: "10", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_GT},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "10"},
+			shouldMatch: false,
+		},
+		{
+			name:        "valid - greater than or equal",
+			attr1:       Attribute{Name: "attr", Value: "10", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_GTE},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "10"},
+			shouldMatch: true,
+		},
+		{
+			name:        "valid - less than quoted value",
+			attr1:       Attribute{Name: "attr", Value: "10", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_LT},
+			attr2:       abci.EventAttribute{Key: "attr", Value: `"9"`},
+			shouldMatch: true,
+		},
+		{
+			name:        "invalid - greater is not less than",
+			attr1:       Attribute{Name: "attr", Value: "10", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_LT},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "11"},
+			shouldMatch: false,
+		},
+		{
+			name:        "valid - less than or equal",
+			attr1:       Attribute{Name: "attr", Value: "10", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_LTE},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "10"},
+			shouldMatch: true,
+		},
+		{
+			name:        "invalid - value is not a number",
+			attr1:       Attribute{Name: "attr", Value: "10", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_GTE},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "ten"},
+			shouldMatch: false,
+		},
+		{
+			name:        "valid - coins greater than",
+			attr1:       Attribute{Name: "attr", Value: "100nhash", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "101nhash,5pm.sale.pool"},
+			shouldMatch: true,
+		},
+		{
+			name:        "invalid - coins equal is not greater than",
+			attr1:       Attribute{Name: "attr", Value: "100nhash", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "100nhash"},
+			shouldMatch: false,
+		},
+		{
+			name:        "valid - coins greater than or equal",
+			attr1:       Attribute{Name: "attr", Value: "100nhash", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GTE},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "100nhash"},
+			shouldMatch: true,
+		},
+		{
+			name:        "invalid - coins without the denom",
+			attr1:       Attribute{Name: "attr", Value: "100nhash", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GTE},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "500pm.sale.pool"},
+			shouldMatch: false,
+		},
+		{
+			name:        "invalid - value is not coins",
+			attr1:       Attribute{Name: "attr", Value: "100nhash", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GTE},
+			attr2:       abci.EventAttribute{Key: "attr", Value: "lots"},
+			shouldMatch: false,
+		},
+		{
+			name:        "invalid - names don't match with an operator",
+			attr1:       Attribute{Name: "attr", Value: "val", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX},
+			attr2:       abci.EventAttribute{Key: "blah", Value: "value"},
+			shouldMatch: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -137,6 +271,21 @@ func TestAttributeMatches(t *testing.T) {
 	}
 }
 
+func TestAttributeRegexCache(t *testing.T) {
+	attr := Attribute{Name: "attr", Value: "^[0-9]+nhash$", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_REGEX}
+	require.NoError(t, attr.Validate(), "Validate")
+
+	re, err := attributeRegexes.get(attr.Value)
+	require.NoError(t, err, "get")
+	again, err := attributeRegexes.get(attr.Value)
+	require.NoError(t, err, "get again")
+	assert.Same(t, re, again, "cached regex")
+	assert.True(t, attr.Matches(abci.EventAttribute{Key: "attr", Value: "10nhash"}), "Matches")
+
+	_, err = attributeRegexes.get("[")
+	assert.Error(t, err, "get invalid regex")
+}
+
 func TestTransactionEventGetEventPrefix(t *testing.T) {
 	event := TransactionEvent{Name: "customName"}
 	assert.Equal(t, "customName", event.GetEventPrefix(), "should get correct prefix for GetEventPrefix")
@@ -178,6 +327,41 @@ func TestTransactionEventValidate(t *testing.T) {
 			event: TransactionEvent{Name: "event", Attributes: []Attribute{{Name: "", Value: "value"}, {Name: "attr", Value: "value2"}}},
 			err:   "empty attribute name",
 		},
+		{
+			name:  "valid - attribute operators",
+			event: TransactionEvent{Name: "event", Attributes: []Attribute{{Name: "attr1", Value: "val", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX}, {Name: "attr2", Value: "^v.*$", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_REGEX}, {Name: "attr3", Value: "1.5", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_LTE}, {Name: "attr4", Value: "1000000pm.sale.pool", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GT}}},
+			err:   "",
+		},
+		{
+			name:  "invalid - unknown attribute operator",
+			event: TransactionEvent{Name: "event", Attributes: []Attribute{{Name: "attr", Value: "value", Operator: 100}}},
+			err:   `attribute "attr": invalid operator 100`,
+		},
+		{
+			name:  "invalid - empty attribute value with operator",
+			event: TransactionEvent{Name: "event", Attributes: []Attribute{{Name: "attr", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_PREFIX}}},
+			err:   `attribute "attr": value cannot be empty with operator ATTRIBUTE_OPERATOR_PREFIX`,
+		},
+		{
+			name:  "invalid - attribute regex too long",
+			event: TransactionEvent{Name: "event", Attributes: []Attribute{{Name: "attr", Value: strings.Repeat("a", MaxAttributeRegexLength+1), Operator: AttributeOperator_ATTRIBUTE_OPERATOR_REGEX}}},
+			err:   `attribute "attr": regex length 257 cannot exceed 256`,
+		},
+		{
+			name:  "invalid - attribute regex",
+			event: TransactionEvent{Name: "event", Attributes: []Attribute{{Name: "attr", Value: "(", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_REGEX}}},
+			err:   `attribute "attr": invalid regex: error parsing regexp: missing closing ): ` + "`(`",
+		},
+		{
+			name:  "invalid - attribute number",
+			event: TransactionEvent{Name: "event", Attributes: []Attribute{{Name: "attr", Value: "ten", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_GT}}},
+			err:   `attribute "attr": invalid number "ten": failed to set decimal string with base 10: ten000000000000000000`,
+		},
+		{
+			name:  "invalid - attribute coin",
+			event: TransactionEvent{Name: "event", Attributes: []Attribute{{Name: "attr", Value: "100", Operator: AttributeOperator_ATTRIBUTE_OPERATOR_COINS_GTE}}},
+			err:   `attribute "attr": invalid coin "100": invalid decimal coin expression: 100`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -437,3 +621,461 @@ func TestTriggerGetTriggerEventI(t *testing.T) {
 		})
 	}
 }
+
+func TestRecurringEventGetEventPrefix(t *testing.T) {
+	assert.Equal(t, RecurringBlockHeightPrefix, RecurringEvent{BlockInterval: 10, NextBlockHeight: 100}.GetEventPrefix(), "should have correct prefix for block interval")
+	assert.Equal(t, RecurringBlockTimePrefix, RecurringEvent{TimeInterval: time.Hour, NextTime: time.Now()}.GetEventPrefix(), "should have correct prefix for time interval")
+}
+
+func TestRecurringEventGetEventOrder(t *testing.T) {
+	now := time.Now().UTC()
+	assert.Equal(t, uint64(100), RecurringEvent{BlockInterval: 10, NextBlockHeight: 100}.GetEventOrder(), "should have correct order for block interval")
+	assert.Equal(t, uint64(now.UnixNano()), RecurringEvent{TimeInterval: time.Hour, NextTime: now}.GetEventOrder(), "should have correct order for time interval")
+}
+
+func TestRecurringEventValidate(t *testing.T) {
+	now := time.Now().UTC()
+	before := now.Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		event RecurringEvent
+		err   string
+	}{
+		{
+			name:  "valid - block interval",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: 3, Runs: 2},
+		},
+		{
+			name:  "valid - time interval with end time",
+			event: RecurringEvent{TimeInterval: time.Hour, NextTime: now, EndTime: &now},
+		},
+		{
+			name:  "invalid - no interval",
+			event: RecurringEvent{NextBlockHeight: 100},
+			err:   "exactly one of block interval and time interval must be provided",
+		},
+		{
+			name:  "invalid - both intervals",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, TimeInterval: time.Hour, NextTime: now},
+			err:   "exactly one of block interval and time interval must be provided",
+		},
+		{
+			name:  "invalid - negative time interval",
+			event: RecurringEvent{TimeInterval: -time.Hour, NextTime: now},
+			err:   "time interval -1h0m0s cannot be negative",
+		},
+		{
+			name:  "valid - minimum block interval",
+			event: RecurringEvent{BlockInterval: MinRecurringBlockInterval, NextBlockHeight: 100, MaxRuns: 3},
+		},
+		{
+			name:  "invalid - block interval too small",
+			event: RecurringEvent{BlockInterval: 4, NextBlockHeight: 100},
+			err:   "block interval 4 cannot be less than 5",
+		},
+		{
+			name:  "valid - minimum time interval",
+			event: RecurringEvent{TimeInterval: MinRecurringTimeInterval, NextTime: now, MaxRuns: 3},
+		},
+		{
+			name:  "invalid - time interval too small",
+			event: RecurringEvent{TimeInterval: 59 * time.Second, NextTime: now},
+			err:   "time interval 59s cannot be less than 1m0s",
+		},
+		{
+			name:  "invalid - block interval without next block height",
+			event: RecurringEvent{BlockInterval: 10},
+			err:   "next block height must be provided with a block interval",
+		},
+		{
+			name:  "invalid - time interval without next time",
+			event: RecurringEvent{TimeInterval: time.Hour},
+			err:   "next time must be provided with a time interval",
+		},
+		{
+			name:  "invalid - max runs reached",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: 3, Runs: 3},
+			err:   "runs 3 must be less than max runs 3",
+		},
+		{
+			name:  "invalid - no max runs or end time",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100},
+			err:   "at least one of max runs and end time must be provided",
+		},
+		{
+			name:  "valid - most max runs",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: MaxRecurringRuns},
+		},
+		{
+			name:  "invalid - too many max runs",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: MaxRecurringRuns + 1},
+			err:   "max runs 101 cannot be more than 100",
+		},
+		{
+			name:  "invalid - runs reached limit with end time",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, EndTime: &now, Runs: MaxRecurringRuns},
+			err:   "runs 100 must be less than max runs 100",
+		},
+		{
+			name:  "invalid - next time after end time",
+			event: RecurringEvent{TimeInterval: time.Hour, NextTime: now, EndTime: &before},
+			err:   "next time " + now.Format(time.RFC3339Nano) + " cannot be after end time " + before.Format(time.RFC3339Nano),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.event.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "should have correct error for Validate")
+			} else {
+				assert.NoError(t, res, "should have no error for successful Validate")
+			}
+		})
+	}
+}
+
+func TestRecurringEventValidateContext(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.NewContext(nil, cmtproto.Header{Time: now}, false, nil)
+	ctx = ctx.WithBlockHeight(100)
+	past := now.Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		event RecurringEvent
+		err   string
+	}{
+		{
+			name:  "valid - next block height in the future",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 101},
+		},
+		{
+			name:  "valid - next time in the future",
+			event: RecurringEvent{TimeInterval: time.Hour, NextTime: now.Add(time.Minute)},
+		},
+		{
+			name:  "invalid - next block height is current height",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100},
+			err:   ErrInvalidBlockHeight.Error(),
+		},
+		{
+			name:  "invalid - next time is current time",
+			event: RecurringEvent{TimeInterval: time.Hour, NextTime: now},
+			err:   ErrInvalidBlockTime.Error(),
+		},
+		{
+			name:  "invalid - end time has passed",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 101, EndTime: &past},
+			err:   "end time has already passed: " + ErrInvalidBlockTime.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.event.ValidateContext(ctx)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "should have correct error for ValidateContext")
+			} else {
+				assert.NoError(t, res, "should have no error for successful ValidateContext")
+			}
+		})
+	}
+}
+
+func TestRecurringEventNextRun(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.NewContext(nil, cmtproto.Header{Time: now}, false, nil)
+	ctx = ctx.WithBlockHeight(100)
+	soon := now.Add(30 * time.Minute)
+
+	tests := []struct {
+		name     string
+		event    RecurringEvent
+		expected *RecurringEvent
+	}{
+		{
+			name:     "valid - block interval advances by one interval",
+			event:    RecurringEvent{BlockInterval: 10, NextBlockHeight: 100},
+			expected: &RecurringEvent{BlockInterval: 10, NextBlockHeight: 110, Runs: 1},
+		},
+		{
+			name:     "valid - block interval skips missed runs",
+			event:    RecurringEvent{BlockInterval: 10, NextBlockHeight: 75, MaxRuns: 5, Runs: 1},
+			expected: &RecurringEvent{BlockInterval: 10, NextBlockHeight: 105, MaxRuns: 5, Runs: 2},
+		},
+		{
+			name:     "valid - time interval advances by one interval",
+			event:    RecurringEvent{TimeInterval: time.Hour, NextTime: now},
+			expected: &RecurringEvent{TimeInterval: time.Hour, NextTime: now.Add(time.Hour), Runs: 1},
+		},
+		{
+			name:     "valid - time interval skips missed runs",
+			event:    RecurringEvent{TimeInterval: time.Hour, NextTime: now.Add(-90 * time.Minute)},
+			expected: &RecurringEvent{TimeInterval: time.Hour, NextTime: now.Add(30 * time.Minute), Runs: 1},
+		},
+		{
+			name:     "valid - next time equal to end time",
+			event:    RecurringEvent{TimeInterval: 30 * time.Minute, NextTime: now, EndTime: &soon},
+			expected: &RecurringEvent{TimeInterval: 30 * time.Minute, NextTime: soon, EndTime: &soon, Runs: 1},
+		},
+		{
+			name:  "done - max runs reached",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: 2, Runs: 1},
+		},
+		{
+			name:  "done - next time after end time",
+			event: RecurringEvent{TimeInterval: time.Hour, NextTime: now, EndTime: &soon},
+		},
+		{
+			name:  "done - block interval end time reached",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, EndTime: &now},
+		},
+		{
+			name:  "done - run limit reached without max runs",
+			event: RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, Runs: MaxRecurringRuns - 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.event.NextRun(ctx)
+			assert.Equal(t, tc.expected, res, "should have expected result from NextRun")
+		})
+	}
+}
+
+func TestRecurringEventRemainingRuns(t *testing.T) {
+	now := time.Now().UTC()
+	later := now.Add(150 * time.Minute)
+	before := now.Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		event    RecurringEvent
+		expected uint64
+	}{
+		{
+			name:     "max runs",
+			event:    RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: 5, Runs: 2},
+			expected: 3,
+		},
+		{
+			name:     "block interval with end time",
+			event:    RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, EndTime: &later},
+			expected: MaxRecurringRuns,
+		},
+		{
+			name:     "time interval with end time",
+			event:    RecurringEvent{TimeInterval: time.Hour, NextTime: now, EndTime: &later, Runs: 1},
+			expected: 3,
+		},
+		{
+			name:     "time interval with end time and fewer max runs",
+			event:    RecurringEvent{TimeInterval: time.Hour, NextTime: now, EndTime: &later, MaxRuns: 2},
+			expected: 2,
+		},
+		{
+			name:     "time interval after end time",
+			event:    RecurringEvent{TimeInterval: time.Hour, NextTime: now, EndTime: &before},
+			expected: 0,
+		},
+		{
+			name:     "run limit reached",
+			event:    RecurringEvent{BlockInterval: 10, NextBlockHeight: 100, MaxRuns: 2, Runs: 2},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.event.RemainingRuns(), "RemainingRuns")
+		})
+	}
+}
+
+func TestCompoundEventGetEventPrefix(t *testing.T) {
+	assert.Equal(t, CompoundPrefix, CompoundEvent{}.GetEventPrefix(), "should have correct prefix for CompoundEvent")
+}
+
+func TestCompoundEventGetEventOrder(t *testing.T) {
+	assert.Equal(t, uint64(0), CompoundEvent{}.GetEventOrder(), "should have correct order for CompoundEvent")
+}
+
+func TestCompoundEventValidate(t *testing.T) {
+	newAny := func(event TriggerEventI) *codectypes.Any {
+		anyEvent, err := codectypes.NewAnyWithValue(event)
+		if err != nil {
+			panic(err)
+		}
+		return anyEvent
+	}
+	heightEvent := newAny(&BlockHeightEvent{BlockHeight: 150})
+	txEvent := newAny(&TransactionEvent{Name: "name"})
+	tooMany := make([]*codectypes.Any, MaxCompoundEvents+1)
+	for i := range tooMany {
+		tooMany[i] = heightEvent
+	}
+
+	tests := []struct {
+		name  string
+		event CompoundEvent
+		err   string
+	}{
+		{
+			name:  "valid - and",
+			event: CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_AND, Events: []*codectypes.Any{heightEvent, txEvent}},
+		},
+		{
+			name:  "valid - or",
+			event: CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_OR, Events: []*codectypes.Any{heightEvent, txEvent}},
+		},
+		{
+			name:  "invalid - unspecified operator",
+			event: CompoundEvent{Events: []*codectypes.Any{heightEvent, txEvent}},
+			err:   "invalid compound operator COMPOUND_OPERATOR_UNSPECIFIED",
+		},
+		{
+			name:  "invalid - one event",
+			event: CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_AND, Events: []*codectypes.Any{heightEvent}},
+			err:   "compound event must have at least 2 events",
+		},
+		{
+			name:  "invalid - too many events",
+			event: CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_OR, Events: tooMany},
+			err:   "compound event cannot have more than 10 events",
+		},
+		{
+			name:  "invalid - nested recurring event",
+			event: CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_OR, Events: []*codectypes.Any{heightEvent, newAny(&RecurringEvent{BlockInterval: 10, NextBlockHeight: 100})}},
+			err:   "compound event 1: unsupported event type *types.RecurringEvent",
+		},
+		{
+			name:  "invalid - sub-event is invalid",
+			event: CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_OR, Events: []*codectypes.Any{newAny(&TransactionEvent{}), heightEvent}},
+			err:   "compound event 0: empty event name",
+		},
+		{
+			name:  "invalid - sub-event is not unpacked",
+			event: CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_OR, Events: []*codectypes.Any{heightEvent, {}}},
+			err:   ErrNoTriggerEvent.Wrap("failed to get compound event 1").Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.event.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "should have correct error for Validate")
+			} else {
+				assert.NoError(t, res, "should have no error for successful Validate")
+			}
+		})
+	}
+}
+
+func TestCompoundEventValidateContext(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{Time: time.Now().UTC()}, false, nil)
+	ctx = ctx.WithBlockHeight(100)
+	newEvent := func(height uint64) CompoundEvent {
+		heightEvent, _ := codectypes.NewAnyWithValue(&BlockHeightEvent{BlockHeight: height})
+		txEvent, _ := codectypes.NewAnyWithValue(&TransactionEvent{Name: "name"})
+		return CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_AND, Events: []*codectypes.Any{txEvent, heightEvent}}
+	}
+
+	assert.NoError(t, newEvent(101).ValidateContext(ctx), "should have no error for future block height")
+	assert.EqualError(t, newEvent(100).ValidateContext(ctx), "compound event 1: "+ErrInvalidBlockHeight.Error(), "should have correct error for current block height")
+}
+
+func TestCompoundEventGetListenerEvents(t *testing.T) {
+	now := time.Now().UTC()
+	height100 := &BlockHeightEvent{BlockHeight: 100}
+	height200 := &BlockHeightEvent{BlockHeight: 200}
+	timeNow := &BlockTimeEvent{Time: now}
+	timeLater := &BlockTimeEvent{Time: now.Add(time.Hour)}
+	tx1 := &TransactionEvent{Name: "event1"}
+	tx2 := &TransactionEvent{Name: "event2"}
+	newEvent := func(operator CompoundOperator, events ...TriggerEventI) CompoundEvent {
+		rv := CompoundEvent{Operator: operator}
+		for _, event := range events {
+			anyEvent, err := codectypes.NewAnyWithValue(event)
+			if err != nil {
+				panic(err)
+			}
+			rv.Events = append(rv.Events, anyEvent)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		event    CompoundEvent
+		expected []TriggerEventI
+		err      string
+	}{
+		{
+			name:     "or - all events",
+			event:    newEvent(CompoundOperator_COMPOUND_OPERATOR_OR, height200, tx1, timeNow, height100),
+			expected: []TriggerEventI{height200, tx1, timeNow, height100},
+		},
+		{
+			name:     "and - first transaction event",
+			event:    newEvent(CompoundOperator_COMPOUND_OPERATOR_AND, height200, tx2, timeNow, tx1),
+			expected: []TriggerEventI{tx2},
+		},
+		{
+			name:     "and - latest block height",
+			event:    newEvent(CompoundOperator_COMPOUND_OPERATOR_AND, height100, height200),
+			expected: []TriggerEventI{height200},
+		},
+		{
+			name:     "and - latest block time",
+			event:    newEvent(CompoundOperator_COMPOUND_OPERATOR_AND, timeLater, timeNow),
+			expected: []TriggerEventI{timeLater},
+		},
+		{
+			name:     "and - latest block height and time",
+			event:    newEvent(CompoundOperator_COMPOUND_OPERATOR_AND, timeNow, height200, timeLater, height100),
+			expected: []TriggerEventI{height200, timeLater},
+		},
+		{
+			name:  "invalid - sub-event is not unpacked",
+			event: CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_AND, Events: []*codectypes.Any{{}}},
+			err:   ErrNoTriggerEvent.Wrap("failed to get compound event 0").Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := tc.event.GetListenerEvents()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should have correct error for GetListenerEvents")
+			} else {
+				assert.NoError(t, err, "should have no error for GetListenerEvents")
+			}
+			assert.Equal(t, tc.expected, events, "should have correct events for GetListenerEvents")
+		})
+	}
+}
+
+func TestCompoundEventUnpackInterfaces(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	event := &CompoundEvent{Operator: CompoundOperator_COMPOUND_OPERATOR_OR}
+	for _, e := range []TriggerEventI{&BlockHeightEvent{BlockHeight: 150}, &TransactionEvent{Name: "name"}} {
+		anyEvent, err := codectypes.NewAnyWithValue(e)
+		assert.NoError(t, err, "should have no error from NewAnyWithValue")
+		event.Events = append(event.Events, anyEvent)
+	}
+	bz, err := cdc.MarshalJSON(event)
+	assert.NoError(t, err, "should have no error from MarshalJSON")
+
+	var unpacked CompoundEvent
+	assert.NoError(t, cdc.UnmarshalJSON(bz, &unpacked), "should have no error from UnmarshalJSON")
+	events, err := unpacked.GetTriggerEvents()
+	assert.NoError(t, err, "should have no error from GetTriggerEvents")
+	assert.Equal(t, []TriggerEventI{&BlockHeightEvent{BlockHeight: 150}, &TransactionEvent{Name: "name"}}, events, "should have expected events from GetTriggerEvents")
+}